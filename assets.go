@@ -0,0 +1,340 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"path"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/audio"
+	"github.com/hajimehoshi/ebiten/audio/mp3"
+	"github.com/hajimehoshi/ebiten/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/audio/wav"
+	"github.com/paulcockrell/gametest/resources"
+	"github.com/paulcockrell/gametest/resources/levelgen"
+	"github.com/paulcockrell/gametest/resources/manifest"
+	"github.com/paulcockrell/gametest/resources/sfxr"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// assets is the registry the game loads its assets into. It is set up in
+// main.
+var assets *Assets
+
+// Assets loads the assets listed in the manifest and holds them by id. The
+// simulation's registry, which it embeds, loads the clips and levels; this
+// one loads the images, sounds, shaders and campaigns the game draws and
+// plays. Assets are loaded explicitly, either all those a level needs up
+// front or one at a time behind a loading screen, and looking one up before
+// it is loaded is a bug.
+type Assets struct {
+	*sim.Assets
+
+	read  manifest.ReadFunc
+	audio *audio.Context // nil when running without audio, sounds are then skipped
+
+	images  map[string]*ebiten.Image
+	sounds  map[string]*loadedSound
+	shaders map[string]*ebiten.Shader
+
+	campaigns map[string]*manifest.Campaign
+	tilesets  map[string]*manifest.Tileset
+}
+
+// NewAssets reads the manifest through read, which returns files by their
+// manifest path. Nothing else is loaded until asked for.
+func NewAssets(read manifest.ReadFunc, audioContext *audio.Context) (*Assets, error) {
+	s, err := sim.NewAssets(read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Assets{
+		Assets:  s,
+		read:    read,
+		audio:   audioContext,
+		images:  make(map[string]*ebiten.Image),
+		sounds:  make(map[string]*loadedSound),
+		shaders: make(map[string]*ebiten.Shader),
+
+		campaigns: make(map[string]*manifest.Campaign),
+		tilesets:  make(map[string]*manifest.Tileset),
+	}, nil
+}
+
+// NewPackedAssets returns a registry of the assets packed into the binary
+func NewPackedAssets(audioContext *audio.Context) (*Assets, error) {
+	return NewAssets(resources.File, audioContext)
+}
+
+// NewAssetsFromDir returns a registry of the asset files in dir, which holds
+// manifest.json, rather than the packed ones
+func NewAssetsFromDir(dir string, audioContext *audio.Context) (*Assets, error) {
+	return NewAssets(sim.DirReader(dir), audioContext)
+}
+
+// LoadAll loads every asset in ids that isn't loaded yet
+func (a *Assets) LoadAll(ids []string) error {
+	for _, id := range ids {
+		if err := a.Load(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load loads one asset, doing nothing if it's already loaded. The game's
+// part of it is loaded first, as the simulation's marks it loaded.
+func (a *Assets) Load(id string) error {
+	if a.Loaded(id) {
+		return nil
+	}
+	if _, _, ok := levelgen.ParseID(id); !ok {
+		m, err := a.Manifest().Asset(id)
+		if err != nil {
+			return err
+		}
+		if err := a.decode(m); err != nil {
+			return err
+		}
+	}
+
+	return a.Assets.Load(id)
+}
+
+// decode loads the game's part of an asset
+func (a *Assets) decode(m *manifest.Asset) error {
+	switch m.Type {
+	case manifest.TypeImage:
+		img, err := a.decodeImage(m)
+		if err != nil {
+			return err
+		}
+		ts, err := a.decodeTileset(m)
+		if err != nil {
+			return err
+		}
+		a.images[m.ID] = img
+		a.tilesets[m.ID] = ts
+	case manifest.TypeSound:
+		if a.audio != nil {
+			snd, err := a.decodeSound(m)
+			if err != nil {
+				return err
+			}
+			a.sounds[m.ID] = snd
+		}
+	case manifest.TypeShader:
+		s, err := a.decodeShader(m)
+		if err != nil {
+			return err
+		}
+		a.shaders[m.ID] = s
+	case manifest.TypeCampaign:
+		c, err := a.decodeCampaign(m)
+		if err != nil {
+			return err
+		}
+		a.campaigns[m.ID] = c
+	}
+
+	return nil
+}
+
+// Image returns a loaded image asset
+func (a *Assets) Image(id string) *ebiten.Image {
+	a.MustBeLoaded(id)
+	return a.images[id]
+}
+
+// Tileset returns the animated tiles of a loaded tile sheet asset, or nil if
+// none of its tiles animate
+func (a *Assets) Tileset(id string) *manifest.Tileset {
+	a.MustBeLoaded(id)
+	return a.tilesets[id]
+}
+
+// OpenSound returns a new stream of a loaded sound asset, for one player to
+// play. It returns nil when running without audio.
+func (a *Assets) OpenSound(id string) (SoundStream, error) {
+	a.MustBeLoaded(id)
+	snd := a.sounds[id]
+	if snd == nil {
+		return nil, nil
+	}
+
+	if snd.asset.Stream {
+		return a.decodeStream(snd.asset, snd.data)
+	}
+	return &pcmStream{
+		ReadSeekCloser: audio.BytesReadSeekCloser(snd.data),
+		length:         int64(len(snd.data)),
+	}, nil
+}
+
+// Campaign returns a loaded campaign asset
+func (a *Assets) Campaign(id string) *manifest.Campaign {
+	a.MustBeLoaded(id)
+	return a.campaigns[id]
+}
+
+// Shader returns a loaded shader asset
+func (a *Assets) Shader(id string) *ebiten.Shader {
+	a.MustBeLoaded(id)
+	return a.shaders[id]
+}
+
+// decodeImage decodes an image asset
+func (a *Assets) decodeImage(m *manifest.Asset) (*ebiten.Image, error) {
+	data, err := a.read(m.Path)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding image %q: %v", m.ID, err)
+	}
+	eimg, err := ebiten.NewImageFromImage(img, ebiten.FilterDefault)
+	if err != nil {
+		return nil, fmt.Errorf("error creating image %q: %v", m.ID, err)
+	}
+
+	return eimg, nil
+}
+
+// decodeTileset loads the tileset of a tile sheet asset, returning nil if it
+// has none
+func (a *Assets) decodeTileset(m *manifest.Asset) (*manifest.Tileset, error) {
+	if m.Tileset == "" {
+		return nil, nil
+	}
+
+	data, err := a.read(m.Tileset)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := manifest.ParseTileset(data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading tileset of %q: %v", m.ID, err)
+	}
+
+	return ts, nil
+}
+
+// SoundStream is a sound being decoded to samples at the audio context's
+// sample rate
+type SoundStream interface {
+	audio.ReadSeekCloser
+	Length() int64 // in bytes of decoded samples
+}
+
+// pcmStream plays samples decoded when the sound was loaded
+type pcmStream struct {
+	audio.ReadSeekCloser
+	length int64
+}
+
+func (s *pcmStream) Length() int64 {
+	return s.length
+}
+
+// loadedSound is a sound asset ready to play. Streamed sounds keep the
+// encoded file and are decoded by each player; others are decoded once.
+type loadedSound struct {
+	asset *manifest.Asset
+	data  []byte
+}
+
+// decodeSound loads a sound asset. Sounds that aren't streamed are decoded
+// up front so that any number of players can share the samples.
+func (a *Assets) decodeSound(m *manifest.Asset) (*loadedSound, error) {
+	data, err := a.read(m.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decoding the start of a streamed sound catches bad files at load time
+	s, err := a.decodeStream(m, data)
+	if err != nil {
+		return nil, err
+	}
+	if m.Stream {
+		return &loadedSound{asset: m, data: data}, nil
+	}
+
+	pcm, err := ioutil.ReadAll(s)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding sound %q: %v", m.ID, err)
+	}
+
+	return &loadedSound{asset: m, data: pcm}, nil
+}
+
+// decodeStream starts decoding a sound file, picking the decoder by the
+// file's extension
+func (a *Assets) decodeStream(m *manifest.Asset, data []byte) (SoundStream, error) {
+	src := audio.BytesReadSeekCloser(data)
+
+	var s SoundStream
+	var err error
+	switch path.Ext(m.Path) {
+	case ".wav":
+		s, err = wav.Decode(a.audio, src)
+	case ".ogg":
+		s, err = vorbis.Decode(a.audio, src)
+	case ".mp3":
+		s, err = mp3.Decode(a.audio, src)
+	case ".sfxr":
+		s, err = a.synthesize(data)
+	default:
+		return nil, fmt.Errorf("sound %q has unsupported format %q", m.ID, path.Ext(m.Path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error decoding sound %q: %v", m.ID, err)
+	}
+
+	return s, nil
+}
+
+// synthesize renders a sound effect from sfxr parameters
+func (a *Assets) synthesize(data []byte) (SoundStream, error) {
+	p, err := sfxr.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	pcm := sfxr.PCM(p.Render(a.audio.SampleRate()), 2)
+
+	return &pcmStream{
+		ReadSeekCloser: audio.BytesReadSeekCloser(pcm),
+		length:         int64(len(pcm)),
+	}, nil
+}
+
+func (a *Assets) decodeCampaign(m *manifest.Asset) (*manifest.Campaign, error) {
+	data, err := a.read(m.Path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := manifest.ParseCampaign(data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading campaign %q: %v", m.ID, err)
+	}
+
+	return c, nil
+}
+
+// decodeShader compiles a Kage shader asset
+func (a *Assets) decodeShader(m *manifest.Asset) (*ebiten.Shader, error) {
+	data, err := a.read(m.Path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := ebiten.NewShader(data)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling shader %q: %v", m.ID, err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"log"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+// campaignAsset is the id of the campaign, the levels a run plays in order
+const campaignAsset = "campaign"
+
+// Campaign tracks how far through the campaign asset's levels the player has
+// got. Completing a level unlocks the one after it, and progress is saved with
+// the settings.
+type Campaign struct {
+	settings *Settings
+}
+
+// NewCampaign returns the campaign with the progress saved in settings. The
+// campaign asset must already be loaded.
+func NewCampaign(settings *Settings) *Campaign {
+	return &Campaign{settings: settings}
+}
+
+// levels returns the campaign's levels in order. They are looked up each time
+// so that changes to the campaign file are picked up in dev mode.
+func (c *Campaign) levels() []manifest.CampaignLevel {
+	return assets.Campaign(campaignAsset).Levels
+}
+
+// index returns where a level is in the campaign, or -1 if it isn't in it
+func (c *Campaign) index(id string) int {
+	for i, l := range c.levels() {
+		if l.Level == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// Name returns the name of a level to show the player, which is its id if it
+// isn't in the campaign
+func (c *Campaign) Name(id string) string {
+	if i := c.index(id); i >= 0 {
+		return c.levels()[i].Name
+	}
+	return id
+}
+
+// Completed returns true if the player has completed the level
+func (c *Campaign) Completed(id string) bool {
+	for _, done := range c.settings.Completed {
+		if done == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Unlocked returns true if the level can be played: it is the first level,
+// is unlocked from the start or follows a completed level
+func (c *Campaign) Unlocked(id string) bool {
+	i := c.index(id)
+	if i < 0 {
+		return false
+	}
+
+	levels := c.levels()
+	return i == 0 || levels[i].Unlocked || c.Completed(levels[i-1].Level)
+}
+
+// Current returns the level a session starts on: the first unlocked level
+// that hasn't been completed, or the first level once every one has been
+func (c *Campaign) Current() string {
+	levels := c.levels()
+	for _, l := range levels {
+		if c.Unlocked(l.Level) && !c.Completed(l.Level) {
+			return l.Level
+		}
+	}
+	return levels[0].Level
+}
+
+// Next returns the level after id, or false if id is the last level, isn't
+// in the campaign or the next level is still locked
+func (c *Campaign) Next(id string) (string, bool) {
+	i := c.index(id)
+	levels := c.levels()
+	if i < 0 || i+1 >= len(levels) || !c.Unlocked(levels[i+1].Level) {
+		return "", false
+	}
+	return levels[i+1].Level, true
+}
+
+// IsLast returns true if id is the campaign's last level
+func (c *Campaign) IsLast(id string) bool {
+	levels := c.levels()
+	return levels[len(levels)-1].Level == id
+}
+
+// Complete records that the player has completed a level of the campaign,
+// unlocking the next, and saves the progress
+func (c *Campaign) Complete(id string) {
+	if c.index(id) < 0 || c.Completed(id) {
+		return
+	}
+
+	c.settings.Completed = append(c.settings.Completed, id)
+	if err := c.settings.Save(); err != nil {
+		log.Printf("error saving campaign progress: %v", err)
+	}
+}
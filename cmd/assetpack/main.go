@@ -0,0 +1,228 @@
+// Command assetpack validates the game's asset manifest and packs every file
+// it lists into a Go source file, replacing one file2byteslice run per asset.
+//
+// It fails if an asset listed in the manifest is missing or invalid, and
+// warns about asset files that aren't in the manifest and asset ids that no
+// Go code or campaign refers to. It is run by `go generate ./resources`.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+var (
+	dir    = flag.String("dir", "resources", "resources directory holding manifest.json")
+	output = flag.String("output", "data.go", "file to write, relative to -dir")
+	pkg    = flag.String("package", "resources", "package name of the generated file")
+	src    = flag.String("src", "", "directory of the Go code that loads assets by id, defaults to the parent of -dir")
+	check  = flag.Bool("check", false, "only validate, don't write the packed file")
+)
+
+// packedExts are the file types that are expected to be listed in the
+// manifest. Other files, such as GIMP sources, are ignored.
+var packedExts = map[string]bool{
+	".png":  true,
+	".json": true,
+	".wav":  true,
+	".ogg":  true,
+	".mp3":  true,
+	".sfxr": true,
+	".kage": true,
+}
+
+func main() {
+	flag.Parse()
+	if *src == "" {
+		*src = filepath.Join(*dir, "..")
+	}
+
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "assetpack: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	manifestData, err := ioutil.ReadFile(filepath.Join(*dir, "manifest.json"))
+	if err != nil {
+		return err
+	}
+	m, err := manifest.Parse(manifestData)
+	if err != nil {
+		return err
+	}
+
+	read := func(path string) ([]byte, error) {
+		data, err := ioutil.ReadFile(filepath.Join(*dir, filepath.FromSlash(path)))
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("missing file %s", path)
+		}
+		return data, err
+	}
+
+	if errs := m.Validate(read); len(errs) > 0 {
+		for _, err := range errs {
+			fmt.Fprintf(os.Stderr, "assetpack: %v\n", err)
+		}
+		return fmt.Errorf("%d invalid assets", len(errs))
+	}
+
+	unused, err := unusedFiles(m)
+	if err != nil {
+		return err
+	}
+	for _, f := range unused {
+		fmt.Fprintf(os.Stderr, "assetpack: warning: %s is not in the manifest\n", f)
+	}
+
+	unreferenced, err := unreferencedIDs(m, read)
+	if err != nil {
+		return err
+	}
+	for _, id := range unreferenced {
+		fmt.Fprintf(os.Stderr, "assetpack: warning: asset %q is not used by any code in %s\n", id, *src)
+	}
+
+	if *check {
+		return nil
+	}
+
+	files := map[string][]byte{"manifest.json": manifestData}
+	for _, f := range m.Files() {
+		if files[f], err = read(f); err != nil {
+			return err
+		}
+	}
+
+	out, err := generate(files)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(*dir, *output), out, 0644)
+}
+
+// unusedFiles returns asset files under the resources directory that the
+// manifest doesn't list
+func unusedFiles(m *manifest.Manifest) ([]string, error) {
+	listed := map[string]bool{"manifest.json": true}
+	for _, f := range m.Files() {
+		listed[f] = true
+	}
+
+	var unused []string
+	err := filepath.Walk(*dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !packedExts[strings.ToLower(filepath.Ext(path))] {
+			return nil
+		}
+
+		rel, err := filepath.Rel(*dir, path)
+		if err != nil {
+			return err
+		}
+		if rel = filepath.ToSlash(rel); !listed[rel] {
+			unused = append(unused, rel)
+		}
+		return nil
+	})
+
+	return unused, err
+}
+
+// unreferencedIDs returns the ids of assets that don't appear as a string
+// literal in any Go file under the source directory, such as the game's and
+// its simulation's. The resources directory, holding the packed file, is
+// skipped. Levels listed in a campaign count as used.
+func unreferencedIDs(m *manifest.Manifest, read manifest.ReadFunc) ([]string, error) {
+	resources, err := os.Stat(*dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var code bytes.Buffer
+	err = filepath.Walk(*src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != *src && (os.SameFile(info, resources) || strings.HasPrefix(info.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		code.Write(data)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	campaigned := make(map[string]bool)
+	for _, a := range m.Assets {
+		if a.Type != manifest.TypeCampaign {
+			continue
+		}
+		data, err := read(a.Path)
+		if err != nil {
+			return nil, err
+		}
+		c, err := manifest.ParseCampaign(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range c.Levels {
+			campaigned[l.Level] = true
+		}
+	}
+
+	var unreferenced []string
+	for _, a := range m.Assets {
+		if !campaigned[a.ID] && !bytes.Contains(code.Bytes(), []byte(strconv.Quote(a.ID))) {
+			unreferenced = append(unreferenced, a.ID)
+		}
+	}
+
+	return unreferenced, nil
+}
+
+// generate returns Go source declaring the packed files
+func generate(files map[string][]byte) ([]byte, error) {
+	var paths []string
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by assetpack. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", *pkg)
+	fmt.Fprintf(&b, "var files = map[string][]byte{\n")
+	for _, p := range paths {
+		fmt.Fprintf(&b, "%q: []byte(%q),\n", p, string(files[p]))
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	return format.Source(b.Bytes())
+}
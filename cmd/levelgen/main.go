@@ -0,0 +1,163 @@
+// Command levelgen generates levels and dumps them as PNG previews, drawn
+// with the game's tiles.
+//
+//	levelgen -algorithm caves -seed 42
+//	levelgen -algorithm rooms -seed 1 -n 10 -o previews
+//	levelgen -algorithm caves -seed 42 -json
+//
+// Each level is written to <algorithm>-<seed>.png, with the start outlined in
+// green and virus spawns in red. With -json the level file is written next to
+// it, ready to be edited and added to the manifest. The game plays the same
+// level with -level generated:<algorithm>:<seed>.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/paulcockrell/gametest/resources"
+	"github.com/paulcockrell/gametest/resources/levelgen"
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+// Tile sheet constants, matching the game's
+const (
+	tileSize  = 16
+	tileXNum  = 25
+	tilesPath = "images/tiles.png"
+)
+
+var (
+	algorithm = flag.String("algorithm", levelgen.Caves, "how to generate levels: "+strings.Join(levelgen.Algorithms(), ", "))
+	seed      = flag.Int64("seed", time.Now().UnixNano(), "seed of the first level")
+	count     = flag.Int("n", 1, "how many levels to generate, with seeds counting up from -seed")
+	width     = flag.Int("width", 15, "level width in tiles")
+	height    = flag.Int("height", 15, "level height in tiles")
+	spawns    = flag.Int("spawns", 3, "how many virus spawn points to place")
+	outDir    = flag.String("o", ".", "directory to write to")
+	scale     = flag.Int("scale", 2, "how many pixels each pixel of a preview is drawn as")
+	writeJSON = flag.Bool("json", false, "write each level file next to its preview")
+)
+
+var (
+	startColor = color.RGBA{0x40, 0xe0, 0x60, 0xff}
+	spawnColor = color.RGBA{0xe0, 0x40, 0x40, 0xff}
+)
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "levelgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	data, err := resources.File(tilesPath)
+	if err != nil {
+		return err
+	}
+	tiles, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error decoding %s: %v", tilesPath, err)
+	}
+
+	for i := 0; i < *count; i++ {
+		s := *seed + int64(i)
+		l, err := levelgen.Generate(levelgen.Options{
+			Algorithm: *algorithm,
+			Seed:      s,
+			Width:     *width,
+			Height:    *height,
+			Spawns:    *spawns,
+			Tiles:     levelgen.DefaultTiles,
+		})
+		if err != nil {
+			return fmt.Errorf("seed %d: %v", s, err)
+		}
+
+		name := filepath.Join(*outDir, fmt.Sprintf("%s-%d", *algorithm, s))
+		if err := writePNG(name+".png", preview(l, tiles)); err != nil {
+			return err
+		}
+		if *writeJSON {
+			data, err := manifest.FormatLevel(l, *width)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(name+".json", data, 0644); err != nil {
+				return err
+			}
+		}
+		fmt.Println(levelgen.ID(*algorithm, s))
+	}
+
+	return nil
+}
+
+// preview draws a level's layers with the tile sheet, marking the start and
+// spawns, then scales it up
+func preview(l *manifest.Level, tiles image.Image) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, *width*tileSize, *height*tileSize))
+	for _, layer := range l.Layers {
+		for i, t := range layer {
+			if t == 0 {
+				continue
+			}
+			x, y := i%*width, i / *width
+			at := image.Pt(x*tileSize, y*tileSize)
+			src := image.Pt(t%tileXNum*tileSize, t/tileXNum*tileSize)
+			draw.Draw(img, image.Rectangle{at, at.Add(image.Pt(tileSize, tileSize))}, tiles, src, draw.Over)
+		}
+	}
+
+	if l.Start != nil {
+		outline(img, *l.Start, startColor)
+	}
+	for _, c := range l.Spawns {
+		outline(img, c, spawnColor)
+	}
+
+	out := image.NewRGBA(image.Rect(0, 0, img.Bounds().Dx()**scale, img.Bounds().Dy()**scale))
+	for y := 0; y < out.Bounds().Dy(); y++ {
+		for x := 0; x < out.Bounds().Dx(); x++ {
+			out.Set(x, y, img.At(x / *scale, y / *scale))
+		}
+	}
+	return out
+}
+
+// outline draws a box around a cell
+func outline(img *image.RGBA, c manifest.Cell, col color.RGBA) {
+	x0, y0 := c.X*tileSize, c.Y*tileSize
+	x1, y1 := x0+tileSize-1, y0+tileSize-1
+	for i := 0; i < tileSize; i++ {
+		img.SetRGBA(x0+i, y0, col)
+		img.SetRGBA(x0+i, y1, col)
+		img.SetRGBA(x0, y0+i, col)
+		img.SetRGBA(x1, y0+i, col)
+	}
+}
+
+func writePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
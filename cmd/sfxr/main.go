@@ -0,0 +1,127 @@
+// Command sfxr generates, previews and exports the game's synthesised sound
+// effects.
+//
+//	sfxr -preset laser -seed 7 -o resources/sfx/laser.sfxr
+//	sfxr -play resources/sfx/shoot.sfxr
+//	sfxr -wav shoot.wav resources/sfx/shoot.sfxr
+//
+// With -preset it writes the parameters of a random effect of that kind,
+// otherwise it reads parameters from the file given, or stdin. Effects are
+// played with -play and written to a 16 bit WAV file with -wav. Try a few
+// seeds, play each, and keep the ones you like.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/oto"
+	"github.com/paulcockrell/gametest/resources/sfxr"
+)
+
+var (
+	preset     = flag.String("preset", "", "generate an effect of this kind: "+strings.Join(sfxr.Presets(), ", "))
+	seed       = flag.Int64("seed", time.Now().UnixNano(), "seed of the preset")
+	output     = flag.String("o", "", "file to write the effect's parameters to, - for stdout")
+	play       = flag.Bool("play", false, "play the effect")
+	wavPath    = flag.String("wav", "", "file to export the effect to as WAV")
+	sampleRate = flag.Int("rate", 44100, "sample rate to render at")
+)
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "sfxr: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	p, err := load()
+	if err != nil {
+		return err
+	}
+
+	// With nothing else to do, show the parameters
+	if *output == "" && !*play && *wavPath == "" {
+		*output = "-"
+	}
+	if *output != "" {
+		data, err := p.JSON()
+		if err != nil {
+			return err
+		}
+		if *output == "-" {
+			_, err = os.Stdout.Write(data)
+		} else {
+			err = ioutil.WriteFile(*output, data, 0644)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	samples := p.Render(*sampleRate)
+	if *wavPath != "" {
+		if err := ioutil.WriteFile(*wavPath, sfxr.WAV(samples, *sampleRate), 0644); err != nil {
+			return err
+		}
+	}
+	if *play {
+		return playSamples(samples)
+	}
+
+	return nil
+}
+
+// load returns the effect to work on, from a preset or a parameter file
+func load() (*sfxr.Params, error) {
+	if *preset != "" {
+		if flag.NArg() > 0 {
+			return nil, fmt.Errorf("can't use both -preset and a parameter file")
+		}
+		return sfxr.Preset(*preset, *seed)
+	}
+
+	var data []byte
+	var err error
+	switch flag.NArg() {
+	case 0:
+		data, err = ioutil.ReadAll(os.Stdin)
+	case 1:
+		data, err = ioutil.ReadFile(flag.Arg(0))
+	default:
+		return nil, fmt.Errorf("expected one parameter file, got %d", flag.NArg())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sfxr.Parse(data)
+}
+
+// playSamples plays mono samples and waits for them to finish
+func playSamples(samples []float64) error {
+	const bytesPerSample = 2
+	ctx, err := oto.NewContext(*sampleRate, 1, bytesPerSample, *sampleRate*bytesPerSample/10)
+	if err != nil {
+		return err
+	}
+	defer ctx.Close()
+
+	player := ctx.NewPlayer()
+	defer player.Close()
+	if _, err := player.Write(sfxr.PCM(samples, 1)); err != nil {
+		return err
+	}
+
+	// Let the last buffer drain before closing the context
+	time.Sleep(100 * time.Millisecond)
+
+	return nil
+}
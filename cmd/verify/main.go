@@ -0,0 +1,94 @@
+// Command verify re-runs a recorded replay and checks that it reaches the
+// result it claims, without opening a window, so it can run on a server with
+// no display.
+//
+//	verify replay.bin
+//	verify -score 120 -wave 2 replay.bin
+//
+// The replay is checked against the score, wave and final state hash stored
+// in it, or against the -score, -wave and -hash flags when given. The final
+// result is printed either way. It exits with status 0 if the replay matches,
+// 1 if it doesn't and 2 if it couldn't be run.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/paulcockrell/gametest/sim"
+)
+
+var (
+	score     = flag.Int("score", -1, "claimed final score (defaults to the value stored in the replay)")
+	wave      = flag.Int("wave", -1, "claimed wave reached (defaults to the value stored in the replay)")
+	hash      = flag.String("hash", "", "claimed final state hash in hex (defaults to the value stored in the replay)")
+	assetsDir = flag.String("assets", "", "directory holding manifest.json to load assets from (defaults to the packed assets)")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: verify [flags] replay.bin")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	os.Exit(verify(flag.Arg(0)))
+}
+
+// verify runs the replay at path and returns the exit status
+func verify(path string) int {
+	r, err := sim.LoadReplay(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error loading replay: %v\n", err)
+		return 2
+	}
+
+	claimed := *r
+	if *score >= 0 {
+		claimed.Score = *score
+	}
+	if *wave >= 0 {
+		claimed.Wave = *wave
+	}
+	if *hash != "" {
+		h, err := strconv.ParseUint(*hash, 16, 64)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid hash %q: %v\n", *hash, err)
+			return 2
+		}
+		claimed.Hash = h
+	}
+
+	var a *sim.Assets
+	if *assetsDir != "" {
+		a, err = sim.NewAssets(sim.DirReader(*assetsDir))
+	} else {
+		a, err = sim.NewPackedAssets()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error loading asset manifest: %v\n", err)
+		return 2
+	}
+
+	g, err := r.Run(a)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error running replay: %v\n", err)
+		return 2
+	}
+	stateHash := g.StateHash()
+	fmt.Printf("score: %d\nwave: %d\nhash: %016x\n", g.Score(), g.Wave(), stateHash)
+
+	if g.Score() != claimed.Score || g.Wave() != claimed.Wave || stateHash != claimed.Hash {
+		fmt.Fprintf(os.Stderr, "replay does not match claimed result (score %d, wave %d, hash %016x)\n",
+			claimed.Score, claimed.Wave, claimed.Hash)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,651 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/hajimehoshi/ebiten/text"
+	"github.com/paulcockrell/gametest/resources/levelgen"
+	"github.com/paulcockrell/gametest/resources/manifest"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// EditorTool is what clicking a cell does in the editor
+type EditorTool int
+
+const (
+	ToolPaint  EditorTool = iota // sets the cell's tile on the current layer
+	ToolErase                    // clears the cell on the current layer
+	ToolFill                     // paints every touching cell holding the same tile
+	ToolSpawn                    // adds or removes a virus spawn
+	ToolStart                    // sets where VaxerMan starts
+	ToolExit                     // sets the exit
+	ToolSwitch                   // places a switch of the current trigger group
+	ToolDoor                     // places a door of the current trigger group
+)
+
+// editorTools are the tools' names and the keys that pick them, by tool
+var editorTools = []struct {
+	name string
+	key  ebiten.Key
+}{
+	ToolPaint:  {"Paint", ebiten.KeyB},
+	ToolErase:  {"Erase", ebiten.KeyE},
+	ToolFill:   {"Fill", ebiten.KeyF},
+	ToolSpawn:  {"Spawn", ebiten.KeyS},
+	ToolStart:  {"Start", ebiten.KeyT},
+	ToolExit:   {"Exit", ebiten.KeyX},
+	ToolSwitch: {"Switch", ebiten.KeyG},
+	ToolDoor:   {"Door", ebiten.KeyD},
+}
+
+// defaultTriggers are the switch and door placed by the trigger tools in a
+// group with no tile of that kind, by kind. They are the game's tile sheet's.
+var defaultTriggers = map[string]manifest.TileBehaviour{
+	manifest.TileSwitch: {Tile: 337, Kind: manifest.TileSwitch, Becomes: 338},
+	manifest.TileDoor:   {Tile: 335, Kind: manifest.TileDoor, Becomes: 336},
+}
+
+// layerKeys pick the layer to edit, by layer
+var layerKeys = []ebiten.Key{
+	ebiten.Key1, ebiten.Key2, ebiten.Key3, ebiten.Key4, ebiten.Key5,
+	ebiten.Key6, ebiten.Key7, ebiten.Key8, ebiten.Key9,
+}
+
+// Editor constants
+const (
+	// editorKey opens and closes the editor
+	editorKey = ebiten.KeyF2
+
+	// maxUndo is how many edits can be undone
+	maxUndo = 100
+
+	// defaultTriggerGroup is the trigger group placed on levels with none
+	defaultTriggerGroup = "doors"
+)
+
+var (
+	editorCursorColor  = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	editorSpawnColor   = color.RGBA{0xe0, 0x40, 0x40, 0xff}
+	editorStartColor   = color.RGBA{0x40, 0xe0, 0x60, 0xff}
+	editorExitColor    = color.RGBA{0xe0, 0xc0, 0x40, 0xff}
+	editorTriggerColor = color.RGBA{0x40, 0xa0, 0xff, 0xff}
+	editorPanelColor   = color.RGBA{0x00, 0x00, 0x00, 0xd0}
+)
+
+// Editor is the level editor, opened over the current level in dev mode. It
+// edits the loaded level asset, so a run started after closing it plays the
+// edits, and saves the level back to its file under the resources directory.
+//
+// The editor keeps the whole level from before each edit, which is cheap at
+// the size of a screen, to undo and redo by.
+type Editor struct {
+	dir    string // resources directory levels are saved under
+	active bool
+
+	id    string          // asset id of the level being edited
+	level *manifest.Level // the level asset, as edited so far
+	undo  []*manifest.Level
+	redo  []*manifest.Level
+	saved bool // false while there are edits that haven't been saved
+
+	tool    EditorTool
+	layer   int
+	tile    int    // painted by the paint and fill tools
+	group   string // trigger group placed by the switch and door tools
+	palette bool   // the tile sheet is shown to pick a tile from
+	scroll  int    // how many tiles the palette is scrolled right
+	stroke  bool   // an edit is being made while the mouse is held down
+}
+
+// NewEditor returns a closed editor that saves levels under dir
+func NewEditor(dir string) *Editor {
+	return &Editor{dir: dir, tool: ToolPaint}
+}
+
+// Active returns true while the editor is open
+func (e *Editor) Active() bool {
+	return e != nil && e.active
+}
+
+// open starts editing the loaded level asset with the given id
+func (e *Editor) open(id string) {
+	e.active = true
+	e.id = id
+	e.level = copyLevel(assets.Level(id))
+	e.undo, e.redo = nil, nil
+	e.saved = true
+	e.stroke = false
+	if e.layer >= len(e.level.Layers) {
+		e.layer = 0
+	}
+}
+
+// toggleEditor opens the editor on the current level, dropping the changes
+// the run made to its tiles, or closes it and starts a new run on the edited
+// level
+func (g *Game) toggleEditor() {
+	if g.editor.Active() {
+		g.editor.active = false
+		if !g.editor.saved {
+			g.showNotice("Playing unsaved level")
+		}
+		g.init(time.Now().UnixNano())
+		return
+	}
+
+	g.editor.open(g.levelID)
+	g.level = NewLevelView(sim.NewLevel(g.levelID, assets.Level(g.levelID)))
+	g.showNotice("Editing " + g.levelID)
+}
+
+// Update applies the editor's keys and the mouse to the level, returning a
+// notice to show, if any
+func (e *Editor) Update(l *LevelView) string {
+	if ebiten.IsKeyPressed(ebiten.KeyControl) {
+		switch {
+		case inpututil.IsKeyJustPressed(ebiten.KeyZ) && ebiten.IsKeyPressed(ebiten.KeyShift):
+			return e.restore(l, &e.redo, &e.undo, "Redo")
+		case inpututil.IsKeyJustPressed(ebiten.KeyZ):
+			return e.restore(l, &e.undo, &e.redo, "Undo")
+		case inpututil.IsKeyJustPressed(ebiten.KeyY):
+			return e.restore(l, &e.redo, &e.undo, "Redo")
+		case inpututil.IsKeyJustPressed(ebiten.KeyS):
+			return e.save()
+		}
+		return ""
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		e.palette = !e.palette
+	}
+	if e.palette {
+		return e.updatePalette()
+	}
+
+	for t, tool := range editorTools {
+		if !inpututil.IsKeyJustPressed(tool.key) {
+			continue
+		}
+		// Picking a trigger tool again moves on to the next group
+		if e.tool == EditorTool(t) && e.triggerTool() {
+			e.nextGroup()
+		}
+		e.tool = EditorTool(t)
+		return e.toolName()
+	}
+	for i, k := range layerKeys {
+		if inpututil.IsKeyJustPressed(k) && i < len(e.level.Layers) {
+			e.layer = i
+			return fmt.Sprintf("Layer %d", i+1)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyL) && len(e.level.Layers) < len(layerKeys) {
+		e.begin()
+		e.level.Layers = append(e.level.Layers, make([]int, len(e.level.Layers[0])))
+		e.layer = len(e.level.Layers) - 1
+		e.apply(l)
+		e.stroke = false
+		return fmt.Sprintf("Added layer %d", e.layer+1)
+	}
+
+	i, ok := e.hovered()
+	if ok && inpututil.IsKeyJustPressed(ebiten.KeyQ) {
+		e.tile = e.level.Layers[e.layer][i]
+		return fmt.Sprintf("Tile %d", e.tile)
+	}
+
+	left := ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+	right := ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
+	if !left && !right {
+		e.stroke = false
+		return ""
+	}
+	if !ok {
+		return ""
+	}
+	clicked := inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) ||
+		inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight)
+
+	// The right button erases with the tile tools and removes with the
+	// others. Tools that don't paint act once per click.
+	cell := manifest.Cell{X: i % levelXNum, Y: i / levelXNum}
+	switch {
+	case e.tool == ToolErase, right && (e.tool == ToolPaint || e.tool == ToolFill || e.triggerTool()):
+		e.paint(l, i, 0)
+	case e.tool == ToolPaint:
+		e.paint(l, i, e.tile)
+	case !clicked:
+	case e.tool == ToolFill:
+		e.fill(l, i, e.tile)
+	case e.tool == ToolSpawn:
+		e.begin()
+		e.level.Spawns = removeCell(e.level.Spawns, cell)
+		if left {
+			e.level.Spawns = append(e.level.Spawns, cell)
+		}
+		e.apply(l)
+	case e.tool == ToolStart:
+		e.begin()
+		e.level.Start = cellIf(left, cell)
+		e.apply(l)
+	case e.tool == ToolExit:
+		// Levels won by reaching the exit must keep one, so it can only be
+		// moved
+		if right && e.level.Goal != nil && e.level.Goal.Kind == manifest.GoalExit {
+			return "The exit goal needs an exit"
+		}
+		e.begin()
+		e.level.Exit = cellIf(left, cell)
+		e.apply(l)
+	case e.triggerTool():
+		return e.placeTrigger(l, i)
+	}
+
+	return ""
+}
+
+// triggerTool returns true if the current tool places switches or doors
+func (e *Editor) triggerTool() bool {
+	return e.tool == ToolSwitch || e.tool == ToolDoor
+}
+
+// toolName returns the name of the current tool, with the group it places
+// for the trigger tools
+func (e *Editor) toolName() string {
+	name := editorTools[e.tool].name
+	if e.triggerTool() {
+		name += " " + e.currentGroup()
+	}
+	return name
+}
+
+// triggerGroups returns the groups of the level's switches and doors, in the
+// order the level lists them
+func (e *Editor) triggerGroups() []string {
+	var groups []string
+	seen := make(map[string]bool)
+	for _, b := range e.level.Tiles {
+		if (b.Kind == manifest.TileSwitch || b.Kind == manifest.TileDoor) && !seen[b.Group] {
+			seen[b.Group] = true
+			groups = append(groups, b.Group)
+		}
+	}
+	return groups
+}
+
+// currentGroup returns the trigger group the switch and door tools place:
+// the one picked, else the level's first, else defaultTriggerGroup
+func (e *Editor) currentGroup() string {
+	groups := e.triggerGroups()
+	for _, g := range groups {
+		if g == e.group {
+			return g
+		}
+	}
+	if len(groups) > 0 {
+		return groups[0]
+	}
+	return defaultTriggerGroup
+}
+
+// nextGroup picks the level's trigger group after the current one
+func (e *Editor) nextGroup() {
+	groups := e.triggerGroups()
+	current := e.currentGroup()
+	for i, g := range groups {
+		if g == current {
+			e.group = groups[(i+1)%len(groups)]
+			return
+		}
+	}
+}
+
+// placeTrigger places a switch or door of the current group in cell i on the
+// current layer. Shooting a switch opens every door of its group. A group
+// with no tile of the kind gets the default one, unless another behaviour
+// already uses that tile; each tile belongs to one group, so more groups need
+// more tiles, set up in the level's file.
+func (e *Editor) placeTrigger(l *LevelView, i int) string {
+	kind := manifest.TileSwitch
+	if e.tool == ToolDoor {
+		kind = manifest.TileDoor
+	}
+	group := e.currentGroup()
+
+	t, ok := -1, false
+	for _, b := range e.level.Tiles {
+		if b.Kind == kind && b.Group == group {
+			t, ok = b.Tile, true
+			break
+		}
+	}
+	def := defaultTriggers[kind]
+	if !ok {
+		for _, b := range e.level.Tiles {
+			if b.Tile == def.Tile {
+				return fmt.Sprintf("No %s tile in %s", kind, group)
+			}
+		}
+	}
+	if ok && e.level.Layers[e.layer][i] == t {
+		return ""
+	}
+
+	e.begin()
+	if !ok {
+		def.Group = group
+		e.level.Tiles = append(e.level.Tiles, def)
+		e.group = group
+		t = def.Tile
+	}
+	e.level.Layers[e.layer][i] = t
+	e.apply(l)
+	return ""
+}
+
+// updatePalette scrolls the palette with the mouse wheel or arrow keys, and
+// picks the tile clicked
+func (e *Editor) updatePalette() string {
+	_, wheel := ebiten.Wheel()
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft) || wheel > 0:
+		e.scroll--
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight) || wheel < 0:
+		e.scroll++
+	}
+	if max := tileXNum - levelXNum; e.scroll > max {
+		e.scroll = max
+	}
+	if e.scroll < 0 {
+		e.scroll = 0
+	}
+
+	if !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return ""
+	}
+	x, y := cursor()
+	col, row := x/tileSize+e.scroll, y/tileSize-1
+	if row < 0 || row >= paletteRows() {
+		return ""
+	}
+	e.tile = row*tileXNum + col
+	e.palette = false
+	if e.tool != ToolPaint && e.tool != ToolFill {
+		e.tool = ToolPaint
+	}
+	return fmt.Sprintf("Tile %d", e.tile)
+}
+
+// paletteRows returns how many rows of tiles the tile sheet has
+func paletteRows() int {
+	_, h := assets.Image(tilesAsset).Size()
+	return h / tileSize
+}
+
+// cursor returns where the mouse is on the game screen
+func cursor() (x, y int) {
+	x, y = ebiten.CursorPosition()
+	return x / postScale, y / postScale
+}
+
+// hovered returns the cell under the mouse, or false if it is off the level
+func (e *Editor) hovered() (int, bool) {
+	x, y := cursor()
+	col, row := x/tileSize, y/tileSize
+	if x < 0 || y < 0 || col >= levelXNum || row >= len(e.level.Layers[0])/levelXNum {
+		return 0, false
+	}
+	return row*levelXNum + col, true
+}
+
+// begin keeps the level as it is before an edit, to undo to. An edit made by
+// dragging the mouse is undone in one go.
+func (e *Editor) begin() {
+	if e.stroke {
+		return
+	}
+	e.stroke = true
+
+	e.undo = append(e.undo, copyLevel(e.level))
+	if len(e.undo) > maxUndo {
+		e.undo = e.undo[1:]
+	}
+	e.redo = nil
+}
+
+// apply makes an edit live: the loaded level asset and the level drawn are
+// both replaced by the edited one
+func (e *Editor) apply(l *LevelView) {
+	assets.ReplaceLevel(e.id, e.level)
+	l.Set(e.level)
+	e.saved = false
+}
+
+// restore undoes or redoes an edit, taking the level from the top of from and
+// putting the current one on to to
+func (e *Editor) restore(l *LevelView, from, to *[]*manifest.Level, name string) string {
+	if len(*from) == 0 {
+		return "Nothing to " + name
+	}
+
+	*to = append(*to, e.level)
+	e.level = (*from)[len(*from)-1]
+	*from = (*from)[:len(*from)-1]
+	if e.layer >= len(e.level.Layers) {
+		e.layer = len(e.level.Layers) - 1
+	}
+	e.stroke = false
+	e.apply(l)
+	return name
+}
+
+// paint sets cell i of the current layer to tile t
+func (e *Editor) paint(l *LevelView, i, t int) {
+	if e.level.Layers[e.layer][i] == t {
+		return
+	}
+	e.begin()
+	e.level.Layers[e.layer][i] = t
+	e.apply(l)
+}
+
+// fill paints cell i of the current layer and every cell joined to it, above,
+// below or to the side, holding the same tile
+func (e *Editor) fill(l *LevelView, i, t int) {
+	layer := e.level.Layers[e.layer]
+	from := layer[i]
+	if from == t {
+		return
+	}
+	e.begin()
+
+	rows := len(layer) / levelXNum
+	layer[i] = t
+	queue := []int{i}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		x, y := c%levelXNum, c/levelXNum
+		for _, d := range [4][2]int{{0, -1}, {-1, 0}, {1, 0}, {0, 1}} {
+			nx, ny := x+d[0], y+d[1]
+			if nx < 0 || nx >= levelXNum || ny < 0 || ny >= rows {
+				continue
+			}
+			if n := ny*levelXNum + nx; layer[n] == from {
+				layer[n] = t
+				queue = append(queue, n)
+			}
+		}
+	}
+	e.apply(l)
+}
+
+// save writes the level to its file under the resources directory, unless it
+// isn't a valid level. Generated levels, which have no file, are saved to
+// levels/<algorithm>-<seed>.json, ready to be added to the manifest.
+func (e *Editor) save() string {
+	path, err := e.path()
+	if err != nil {
+		log.Printf("error saving %s: %v", e.id, err)
+		return "Can't save, see the log"
+	}
+	data, err := manifest.FormatLevel(e.level, levelXNum)
+	if err == nil {
+		_, err = manifest.ParseLevel(data)
+	}
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(e.dir, filepath.FromSlash(path)), data, 0644)
+	}
+	if err != nil {
+		log.Printf("error saving %s to %s: %v", e.id, path, err)
+		return "Can't save, see the log"
+	}
+
+	if _, _, ok := levelgen.ParseID(e.id); ok {
+		log.Printf("saved %s to %s, add it to the manifest to play it as a level asset", e.id, path)
+	}
+	e.saved = true
+	return "Saved " + path
+}
+
+// path returns the manifest path of the level's file
+func (e *Editor) path() (string, error) {
+	if algorithm, seed, ok := levelgen.ParseID(e.id); ok {
+		return fmt.Sprintf("levels/%s-%d.json", algorithm, seed), nil
+	}
+	m, err := assets.Manifest().Asset(e.id)
+	if err != nil {
+		return "", err
+	}
+	return m.Path, nil
+}
+
+// Draw draws the spawns, start and exit, the switches and doors of the
+// current group while placing them, the cell under the mouse and the status
+// bar, or the palette while it is open. The status bar is drawn at the
+// top of the screen, or at the bottom while the mouse is near the top.
+func (e *Editor) Draw(screen *ebiten.Image) {
+	bar := 0
+	if e.palette {
+		e.drawPalette(screen)
+	} else {
+		for _, c := range e.level.Spawns {
+			outlineCell(screen, c, editorSpawnColor)
+		}
+		if c := e.level.Start; c != nil {
+			outlineCell(screen, *c, editorStartColor)
+		}
+		if c := e.level.Exit; c != nil {
+			outlineCell(screen, *c, editorExitColor)
+		}
+		if e.triggerTool() {
+			e.drawTriggers(screen)
+		}
+		if i, ok := e.hovered(); ok {
+			outlineCell(screen, manifest.Cell{X: i % levelXNum, Y: i / levelXNum}, editorCursorColor)
+		}
+		if _, y := cursor(); y < tileSize*2 {
+			bar = screenHeight - tileSize
+		}
+	}
+
+	ebitenutil.DrawRect(screen, 0, float64(bar), screenWidth, tileSize, editorPanelColor)
+	status := fmt.Sprintf("%s  Layer %d/%d  Tile %d", e.toolName(), e.layer+1, len(e.level.Layers), e.tile)
+	if !e.saved {
+		status += " *"
+	}
+	text.Draw(screen, status, smallArcadeFont, 4, bar+11, color.White)
+
+	sx, sy := (e.tile%tileXNum)*tileSize, (e.tile/tileXNum)*tileSize
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(screenWidth-tileSize, float64(bar))
+	screen.DrawImage(assets.Image(tilesAsset).SubImage(image.Rect(sx, sy, sx+tileSize, sy+tileSize)).(*ebiten.Image), op)
+}
+
+// drawTriggers outlines every cell holding a switch or door of the current
+// group, showing which doors its switches open
+func (e *Editor) drawTriggers(screen *ebiten.Image) {
+	group := e.currentGroup()
+	linked := make(map[int]bool)
+	for _, b := range e.level.Tiles {
+		if (b.Kind == manifest.TileSwitch || b.Kind == manifest.TileDoor) && b.Group == group {
+			linked[b.Tile] = true
+		}
+	}
+
+	for i := range e.level.Layers[0] {
+		for _, layer := range e.level.Layers {
+			if linked[layer[i]] {
+				outlineCell(screen, manifest.Cell{X: i % levelXNum, Y: i / levelXNum}, editorTriggerColor)
+				break
+			}
+		}
+	}
+}
+
+// drawPalette draws the part of the tile sheet the palette is scrolled to,
+// under the status bar, outlining the selected tile
+func (e *Editor) drawPalette(screen *ebiten.Image) {
+	ebitenutil.DrawRect(screen, 0, 0, screenWidth, screenHeight, editorPanelColor)
+
+	x := e.scroll * tileSize
+	sheet := assets.Image(tilesAsset).SubImage(image.Rect(x, 0, x+screenWidth, paletteRows()*tileSize)).(*ebiten.Image)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(0, tileSize)
+	screen.DrawImage(sheet, op)
+
+	col, row := e.tile%tileXNum-e.scroll, e.tile/tileXNum
+	if col >= 0 && col < levelXNum {
+		outlineCell(screen, manifest.Cell{X: col, Y: row + 1}, editorCursorColor)
+	}
+}
+
+// outlineCell draws a box around a cell
+func outlineCell(screen *ebiten.Image, c manifest.Cell, clr color.Color) {
+	x, y := float64(c.X*tileSize), float64(c.Y*tileSize)
+	ebitenutil.DrawRect(screen, x, y, tileSize, 1, clr)
+	ebitenutil.DrawRect(screen, x, y+tileSize-1, tileSize, 1, clr)
+	ebitenutil.DrawRect(screen, x, y, 1, tileSize, clr)
+	ebitenutil.DrawRect(screen, x+tileSize-1, y, 1, tileSize, clr)
+}
+
+// copyLevel returns a copy of a level that can be edited without changing l.
+// Only what the editor changes is copied; the rest is shared.
+func copyLevel(l *manifest.Level) *manifest.Level {
+	c := *l
+	c.Layers = make([][]int, len(l.Layers))
+	for i, layer := range l.Layers {
+		c.Layers[i] = append([]int(nil), layer...)
+	}
+	c.Spawns = append([]manifest.Cell(nil), l.Spawns...)
+	c.Tiles = append([]manifest.TileBehaviour(nil), l.Tiles...)
+	return &c
+}
+
+// removeCell returns cells without c
+func removeCell(cells []manifest.Cell, c manifest.Cell) []manifest.Cell {
+	var kept []manifest.Cell
+	for _, k := range cells {
+		if k != c {
+			kept = append(kept, k)
+		}
+	}
+	return kept
+}
+
+// cellIf returns c if set is true, or nil
+func cellIf(set bool, c manifest.Cell) *manifest.Cell {
+	if !set {
+		return nil
+	}
+	return &c
+}
@@ -4,10 +4,9 @@ go 1.14
 
 require (
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0
-	github.com/hajimehoshi/ebiten v1.11.7
-	github.com/hajimehoshi/file2byteslice v0.0.0-20200812174855-0e5e8a80490e // indirect
-	github.com/shurcooL/go v0.0.0-20200502201357-93f07166e636 // indirect
-	github.com/shurcooL/go-goon v0.0.0-20170922171312-37c2f522c041 // indirect
-	github.com/shurcooL/goexec v0.0.0-20200425235707-36ff6d2d1adc // indirect
-	golang.org/x/image v0.0.0-20191009234506-e7c1f5e7dbb8
+	github.com/hajimehoshi/ebiten v1.12.12
+	github.com/hajimehoshi/go-mp3 v0.3.1
+	github.com/hajimehoshi/oto v0.6.8
+	github.com/jfreymuth/oggvorbis v1.0.1
+	golang.org/x/image v0.0.0-20200801110659-972c09e46d76
 )
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// Debug overlay colours
+var (
+	hitboxColor      = color.RGBA{0x00, 0xff, 0x00, 0xff}
+	pixelHitboxColor = color.RGBA{0xff, 0x00, 0xff, 0xff}
+)
+
+// drawHitboxes outlines every collider's hitbox, alpha of the way between
+// its previous and current positions. Pixel perfect colliders are drawn in a
+// different colour.
+func drawHitboxes(w *sim.World, screen *ebiten.Image, alpha float64) {
+	w.Each(func(e sim.Entity, c *sim.Components) {
+		if c.Collider == nil || c.Position == nil {
+			return
+		}
+
+		p := c.Position
+		h := sim.ColliderHitbox(c).Translate(lerp(p.PrevX, p.X, alpha), lerp(p.PrevY, p.Y, alpha))
+		clr := hitboxColor
+		if c.Collider.PixelPerfect {
+			clr = pixelHitboxColor
+		}
+
+		if h.Shape == sim.HitboxCircle {
+			const segments = 16
+			for i := 0; i < segments; i++ {
+				a0 := 2 * math.Pi * float64(i) / segments
+				a1 := 2 * math.Pi * float64(i+1) / segments
+				ebitenutil.DrawLine(screen,
+					h.X+h.Radius*math.Cos(a0), h.Y+h.Radius*math.Sin(a0),
+					h.X+h.Radius*math.Cos(a1), h.Y+h.Radius*math.Sin(a1), clr)
+			}
+			return
+		}
+
+		ebitenutil.DrawLine(screen, h.X, h.Y, h.X+h.Width, h.Y, clr)
+		ebitenutil.DrawLine(screen, h.X+h.Width, h.Y, h.X+h.Width, h.Y+h.Height, clr)
+		ebitenutil.DrawLine(screen, h.X+h.Width, h.Y+h.Height, h.X, h.Y+h.Height, clr)
+		ebitenutil.DrawLine(screen, h.X, h.Y+h.Height, h.X, h.Y, clr)
+	})
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/paulcockrell/gametest/resources/levelgen"
+	"github.com/paulcockrell/gametest/resources/manifest"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// reloadInterval is how often dev mode checks asset files for changes
+const reloadInterval = 500 * time.Millisecond
+
+// assetWatcher polls the asset files listed in the manifest for changes. It
+// polls rather than using OS notifications so that reloads happen on the
+// game loop, between simulation steps.
+type assetWatcher struct {
+	dir      string
+	modTimes map[string]time.Time
+	next     time.Time
+}
+
+func newAssetWatcher(dir string) *assetWatcher {
+	return &assetWatcher{
+		dir:      dir,
+		modTimes: make(map[string]time.Time),
+	}
+}
+
+// changed returns the manifest paths of files modified since the last call
+func (w *assetWatcher) changed() []string {
+	now := time.Now()
+	if now.Before(w.next) {
+		return nil
+	}
+	w.next = now.Add(reloadInterval)
+
+	paths := append([]string{"manifest.json"}, assets.Manifest().Files()...)
+
+	var changed []string
+	for _, p := range paths {
+		info, err := os.Stat(filepath.Join(w.dir, filepath.FromSlash(p)))
+		if err != nil {
+			// Editors often replace files by deleting and recreating them, so
+			// a missing file is picked up when it reappears
+			continue
+		}
+		if t, ok := w.modTimes[p]; ok && t.Equal(info.ModTime()) {
+			continue
+		}
+		w.modTimes[p] = info.ModTime()
+		changed = append(changed, p)
+	}
+
+	return changed
+}
+
+// watchAssets turns on dev mode reloading: assets read from dir are
+// reloaded in place whenever their files change
+func (g *Game) watchAssets(dir string) {
+	g.watcher = newAssetWatcher(dir)
+
+	// Record the files' current modification times
+	g.watcher.changed()
+}
+
+// reloadAssets reloads every loaded asset whose files have changed. An asset
+// that fails to load is logged and the old version kept, so a half saved file
+// doesn't end the run.
+func (g *Game) reloadAssets() {
+	changed := g.watcher.changed()
+	if len(changed) == 0 {
+		return
+	}
+
+	files := make(map[string]bool)
+	for _, p := range changed {
+		files[p] = true
+	}
+	if files["manifest.json"] {
+		if err := assets.ReadManifest(); err != nil {
+			log.Printf("error reloading manifest: %v", err)
+			return
+		}
+	}
+
+	for _, id := range assets.IDs() {
+		// Generated levels have no files to change
+		if _, _, ok := levelgen.ParseID(id); ok {
+			continue
+		}
+		m, err := assets.Manifest().Asset(id)
+		if err != nil {
+			log.Printf("error reloading %s: %v", id, err)
+			continue
+		}
+		reload := files["manifest.json"]
+		for _, f := range m.Files() {
+			reload = reload || files[f]
+		}
+		if !reload {
+			continue
+		}
+
+		if err := assets.reload(m); err != nil {
+			log.Printf("error reloading %s: %v", id, err)
+			continue
+		}
+		if g.level != nil && g.level.ID() == id {
+			g.level.Set(assets.Level(id))
+		}
+		if g.level != nil && id == tilesAsset {
+			g.level.invalidate()
+		}
+	}
+
+	if g.sim != nil {
+		g.sim.World().Each(func(e sim.Entity, c *sim.Components) {
+			if c.Animator != nil {
+				c.Animator.Refresh()
+			}
+		})
+	}
+}
+
+// reload loads an asset again, replacing the loaded version in place so that
+// everything holding it picks up the change. Sounds already playing finish
+// with the old version.
+func (a *Assets) reload(m *manifest.Asset) error {
+	if err := a.Assets.Reload(m); err != nil {
+		return err
+	}
+	return a.decode(m)
+}
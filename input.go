@@ -0,0 +1,73 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// readInput samples the keyboard
+func readInput() sim.Input {
+	var in sim.Input
+	if ebiten.IsKeyPressed(ebiten.KeyLeft) {
+		in |= sim.InputLeft
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyRight) {
+		in |= sim.InputRight
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyUp) {
+		in |= sim.InputUp
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyDown) {
+		in |= sim.InputDown
+	}
+	if ebiten.IsKeyPressed(ebiten.KeySpace) {
+		in |= sim.InputFire
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyR) {
+		in |= sim.InputRestart
+	}
+
+	return in
+}
+
+// VolumeInput is a bitmask of the sound setting keys pressed during a single
+// update. These never reach the simulation, so aren't recorded in replays.
+type VolumeInput uint8
+
+const (
+	VolumeMute VolumeInput = 1 << iota
+	VolumeMasterDown
+	VolumeMasterUp
+	VolumeMusicDown
+	VolumeMusicUp
+	VolumeSFXDown
+	VolumeSFXUp
+)
+
+func (in VolumeInput) Has(flags VolumeInput) bool {
+	return in&flags != 0
+}
+
+// volumeKeys maps the keys that change sound settings to their inputs
+var volumeKeys = map[ebiten.Key]VolumeInput{
+	ebiten.KeyM:            VolumeMute,
+	ebiten.KeyMinus:        VolumeMasterDown,
+	ebiten.KeyEqual:        VolumeMasterUp,
+	ebiten.KeyLeftBracket:  VolumeMusicDown,
+	ebiten.KeyRightBracket: VolumeMusicUp,
+	ebiten.KeyComma:        VolumeSFXDown,
+	ebiten.KeyPeriod:       VolumeSFXUp,
+}
+
+// readVolumeInput returns the sound setting keys pressed this update
+func readVolumeInput() VolumeInput {
+	var in VolumeInput
+	for k, v := range volumeKeys {
+		if inpututil.IsKeyJustPressed(k) {
+			in |= v
+		}
+	}
+
+	return in
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"log"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// Feedback constants
+const (
+	// maxShake is how far the screen moves, in pixels, at full trauma
+	maxShake = 6
+
+	// traumaDecay is how much trauma wears off per second
+	traumaDecay = 1.5
+
+	// Trauma added by game events, from 0 to 1
+	killTrauma      = 0.3
+	infectionTrauma = 0.7
+
+	// hitStopTime is how long the simulation freezes when an enemy is shot
+	hitStopTime = 60 * time.Millisecond
+
+	// flashTime is how long a damaged sprite is drawn white
+	flashTime = 100 * time.Millisecond
+
+	// vignetteTime is how long the red vignette takes to fade after
+	// VaxerMan is infected
+	vignetteTime = 600 * time.Millisecond
+)
+
+// Juice gives feedback on hits: screen shake, hit-stop, white flashes and a
+// red vignette. Like sound and particles it is presentation only. Shake uses
+// its own random numbers, and hit-stop holds the simulation clock rather
+// than skipping steps, so replays are unaffected.
+//
+// Every effect can be turned off in the settings, for players who find them
+// uncomfortable.
+type Juice struct {
+	settings *Settings
+	rand     *rand.Rand
+
+	// trauma decays over time, and the screen shakes by its square so that
+	// small hits shake it far less than big ones
+	trauma       float64
+	hitStopUntil time.Time
+	vignetteAt   time.Time // when the vignette was last shown
+	last         time.Time
+
+	canvas   *ebiten.Image // the world is drawn here, then shaken on to the screen
+	vignette *ebiten.Image
+}
+
+// NewJuice returns feedback effects that are enabled by settings
+func NewJuice(settings *Settings) *Juice {
+	canvas, _ := ebiten.NewImage(screenWidth, screenHeight, ebiten.FilterDefault)
+	vignette, _ := ebiten.NewImageFromImage(vignetteImage(screenWidth, screenHeight), ebiten.FilterDefault)
+
+	return &Juice{
+		settings: settings,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		canvas:   canvas,
+		vignette: vignette,
+	}
+}
+
+// Enabled returns true if the player wants screen effects
+func (j *Juice) Enabled() bool {
+	return j.settings.ScreenEffects
+}
+
+// Toggle turns screen effects on or off, saving the settings. It returns a
+// description of the change to show the player.
+func (j *Juice) Toggle() string {
+	j.settings.ScreenEffects = !j.settings.ScreenEffects
+	j.Reset()
+	if err := j.settings.Save(); err != nil {
+		log.Printf("error saving settings: %v", err)
+	}
+
+	if j.settings.ScreenEffects {
+		return "Screen effects on"
+	}
+	return "Screen effects off"
+}
+
+// Reset stops every effect, e.g. when a new run starts
+func (j *Juice) Reset() {
+	j.trauma = 0
+	j.hitStopUntil = time.Time{}
+	j.vignetteAt = time.Time{}
+}
+
+// Shake adds trauma, shaking the screen until it wears off
+func (j *Juice) Shake(trauma float64) {
+	if j.Enabled() {
+		j.trauma = math.Min(j.trauma+trauma, 1)
+	}
+}
+
+// HitStop freezes the simulation for d
+func (j *Juice) HitStop(d time.Duration) {
+	if j.Enabled() {
+		j.hitStopUntil = time.Now().Add(d)
+	}
+}
+
+// Frozen returns true during a hit-stop
+func (j *Juice) Frozen() bool {
+	return time.Now().Before(j.hitStopUntil)
+}
+
+// Flash draws an entity's sprite white for a moment
+func (j *Juice) Flash(c *sim.Components) {
+	if j.Enabled() {
+		c.Flash = &sim.Flash{Until: time.Now().Add(flashTime)}
+	}
+}
+
+// Vignette shows the red vignette, which then fades
+func (j *Juice) Vignette() {
+	if j.Enabled() {
+		j.vignetteAt = time.Now()
+	}
+}
+
+// Update lets trauma wear off. It is called once per frame.
+func (j *Juice) Update() {
+	now := time.Now()
+	if !j.last.IsZero() {
+		j.trauma = math.Max(0, j.trauma-traumaDecay*now.Sub(j.last).Seconds())
+	}
+	j.last = now
+}
+
+// Canvas returns a cleared image to draw the world on, to be passed to Draw
+func (j *Juice) Canvas() *ebiten.Image {
+	j.canvas.Clear()
+	return j.canvas
+}
+
+// Draw draws the world from the canvas on to the screen, shaken, with the
+// vignette over it
+func (j *Juice) Draw(screen *ebiten.Image) {
+	shake := j.trauma * j.trauma * maxShake
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(math.Round(j.vary(shake)), math.Round(j.vary(shake)))
+	screen.DrawImage(j.canvas, op)
+
+	if j.vignetteAt.IsZero() {
+		return
+	}
+	level := 1 - float64(time.Since(j.vignetteAt))/float64(vignetteTime)
+	if level <= 0 {
+		return
+	}
+	op = &ebiten.DrawImageOptions{}
+	op.ColorM.Scale(1, 1, 1, level)
+	screen.DrawImage(j.vignette, op)
+}
+
+// vary returns a random amount up to max either way
+func (j *Juice) vary(max float64) float64 {
+	return (j.rand.Float64()*2 - 1) * max
+}
+
+// vignetteImage returns a red vignette that is clear in the middle and
+// darkens towards the corners
+func vignetteImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	cx, cy := float64(width)/2, float64(height)/2
+	inner, outer := 0.4*cx, math.Hypot(cx, cy)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			d := math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy)
+			t := math.Max(0, math.Min(1, (d-inner)/(outer-inner)))
+			a := uint8(t * t * 0xd0)
+			// Colours are premultiplied by alpha
+			img.SetRGBA(x, y, color.RGBA{R: a, A: a})
+		}
+	}
+
+	return img
+}
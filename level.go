@@ -1,56 +1,233 @@
 package main
 
 import (
-	"bytes"
 	"image"
-	"log"
+	"time"
 
 	"github.com/hajimehoshi/ebiten"
-	"github.com/paulcockrell/gametest/resources/images"
-	"github.com/paulcockrell/gametest/resources/levels"
+	"github.com/paulcockrell/gametest/resources/manifest"
+	"github.com/paulcockrell/gametest/sim"
 )
 
-// Tile constants
+// Tile constants, the simulation's
 const (
-	tileSize = 16
-	tileXNum = 25
+	tileSize   = sim.TileSize
+	tileXNum   = sim.TileXNum
+	levelXNum  = sim.LevelXNum
+	tilesAsset = sim.TilesAsset
 )
 
-var (
-	tilesImage *ebiten.Image
+// Infection overlay constants
+const (
+	// infectionAlpha is how opaque the overlay is over a fully infected cell
+	infectionAlpha = 0.55
 )
 
-func init() {
-	img, _, err := image.Decode(bytes.NewReader(images.Tiles_png))
-	if err != nil {
-		log.Fatalf("error loading tiles images: %v", err)
+// infectionColor is the colour of the infection overlay
+var infectionColor = [3]uint8{110, 200, 40}
+
+// LevelView draws a level of the simulation's. Tiles are drawn in two passes:
+// background tiles under entities and foreground tiles over them. Most tiles
+// never change, so each pass is drawn once to a cached image, which is drawn
+// every frame along with the few cells holding animated tiles. The cache is
+// drawn again whenever the level's tiles change.
+type LevelView struct {
+	*sim.Level
+
+	infectionImage  *ebiten.Image // one pixel per cell, tinted by its infection
+	infectionPixels []byte
+
+	start      time.Time                // tile animations are timed from here
+	animations map[int]*tileAnimation   // by the tile levels place
+	animated   []int                    // cells holding an animated tile
+	tiles      map[int]*ebiten.Image    // sub-images of the tile sheet
+	cache      [2]*ebiten.Image         // every cell that doesn't animate, by pass
+	revision   int                      // the level's revision the cache was drawn at
+	dirty      bool                     // the cache must be drawn again
+	op         *ebiten.DrawImageOptions // reused for every tile drawn
+}
+
+// tileAnimation is a tile drawn as a loop of frames
+type tileAnimation struct {
+	frames []manifest.TileFrame
+	length time.Duration
+}
+
+// frame returns the tile to show at t into the animation
+func (a *tileAnimation) frame(t time.Duration) int {
+	t %= a.length
+	for _, f := range a.frames {
+		t -= time.Duration(f.Duration) * time.Millisecond
+		if t < 0 {
+			return f.Tile
+		}
+	}
+	return a.frames[len(a.frames)-1].Tile
+}
+
+// NewLevelView returns a view drawing l
+func NewLevelView(l *sim.Level) *LevelView {
+	return &LevelView{
+		Level: l,
+		start: time.Now(),
+		dirty: true,
+		op:    &ebiten.DrawImageOptions{},
 	}
-	tilesImage, _ = ebiten.NewImageFromImage(img, ebiten.FilterDefault)
 }
 
-type Level struct {
-	layers [][]int
+// invalidate makes the next draw render the cache and the infection overlay
+// again, picking up changes to the tile sheet, its animations or the size of
+// the level
+func (v *LevelView) invalidate() {
+	v.dirty = true
+	if v.infectionImage != nil {
+		v.infectionImage.Dispose()
+	}
+	v.infectionImage, v.infectionPixels = nil, nil
+}
+
+// tile returns the tile to draw for t, which is the current frame if it is
+// animated
+func (v *LevelView) tile(t int) int {
+	if a, ok := v.animations[t]; ok {
+		return a.frame(time.Since(v.start))
+	}
+	return t
+}
+
+// drawBackground draws the tiles that entities walk over
+func (v *LevelView) drawBackground(screen *ebiten.Image) {
+	v.drawPass(screen, false)
 }
 
-func NewLevel(path string) *Level {
-	level := &Level{
-		layers: levels.LevelOne,
+// drawForeground draws the tiles that entities walk behind
+func (v *LevelView) drawForeground(screen *ebiten.Image) {
+	v.drawPass(screen, true)
+}
+
+func (v *LevelView) drawPass(screen *ebiten.Image, foreground bool) {
+	if v.dirty || v.revision != v.Revision() {
+		v.render()
 	}
 
-	return level
+	v.op.GeoM.Reset()
+	screen.DrawImage(v.cache[pass(foreground)], v.op)
+	for _, i := range v.animated {
+		for _, layer := range v.Layers() {
+			if t := layer[i]; v.Foreground(t) == foreground {
+				v.drawTile(screen, i, v.tile(t))
+			}
+		}
+	}
 }
 
-func (l *Level) draw(screen *ebiten.Image) {
-	const xNum = screenWidth / tileSize
-	for _, l := range l.layers {
-		for i, t := range l {
-			op := &ebiten.DrawImageOptions{}
-			op.GeoM.Translate(float64((i%xNum)*tileSize), float64((i/xNum)*tileSize))
+// pass returns the index of a pass's cache
+func pass(foreground bool) int {
+	if foreground {
+		return 1
+	}
+	return 0
+}
 
-			sx := (t % tileXNum) * tileSize
-			sy := (t / tileXNum) * tileSize
-			tile := tilesImage.SubImage(image.Rect(sx, sy, sx+tileSize, sy+tileSize)).(*ebiten.Image)
-			screen.DrawImage(tile, op)
+// render finds the cells holding animated tiles, then draws every other cell
+// to the caches
+func (v *LevelView) render() {
+	layers := v.Layers()
+	v.tiles = make(map[int]*ebiten.Image)
+	v.animations = make(map[int]*tileAnimation)
+	if ts := assets.Tileset(tilesAsset); ts != nil {
+		for _, a := range ts.Animated {
+			ta := &tileAnimation{frames: a.Frames}
+			for _, f := range a.Frames {
+				ta.length += time.Duration(f.Duration) * time.Millisecond
+			}
+			v.animations[a.Tile] = ta
 		}
 	}
+
+	v.animated = v.animated[:0]
+	for i := range layers[0] {
+		for _, layer := range layers {
+			if _, ok := v.animations[layer[i]]; ok {
+				v.animated = append(v.animated, i)
+				break
+			}
+		}
+	}
+
+	w, h := levelXNum*tileSize, v.Rows()*tileSize
+	for i, c := range v.cache {
+		if c != nil {
+			if cw, ch := c.Size(); cw != w || ch != h {
+				c.Dispose()
+				c = nil
+			}
+		}
+		if c == nil {
+			c, _ = ebiten.NewImage(w, h, ebiten.FilterDefault)
+		}
+		c.Clear()
+		v.cache[i] = c
+	}
+
+	a := 0
+	for i := range layers[0] {
+		if a < len(v.animated) && v.animated[a] == i {
+			a++
+			continue
+		}
+		for _, layer := range layers {
+			t := layer[i]
+			v.drawTile(v.cache[pass(v.Foreground(t))], i, t)
+		}
+	}
+	v.revision = v.Revision()
+	v.dirty = false
+}
+
+// drawTile draws tile t in cell i
+func (v *LevelView) drawTile(dst *ebiten.Image, i, t int) {
+	img, ok := v.tiles[t]
+	if !ok {
+		sx := (t % tileXNum) * tileSize
+		sy := (t / tileXNum) * tileSize
+		img = assets.Image(tilesAsset).SubImage(image.Rect(sx, sy, sx+tileSize, sy+tileSize)).(*ebiten.Image)
+		v.tiles[t] = img
+	}
+
+	v.op.GeoM.Reset()
+	v.op.GeoM.Translate(float64((i%levelXNum)*tileSize), float64((i/levelXNum)*tileSize))
+	dst.DrawImage(img, v.op)
+}
+
+// drawInfection draws the infection over the floor, each cell tinted by how
+// infected it is and blending smoothly into its neighbours
+func (v *LevelView) drawInfection(screen *ebiten.Image) {
+	infection := v.Infection()
+	if infection == nil {
+		return
+	}
+
+	// The level may have changed size since the overlay was made
+	if v.infectionImage == nil || len(infection)*4 != len(v.infectionPixels) {
+		if v.infectionImage != nil {
+			v.infectionImage.Dispose()
+		}
+		v.infectionImage, _ = ebiten.NewImage(levelXNum, v.Rows(), ebiten.FilterDefault)
+		v.infectionPixels = make([]byte, len(infection)*4)
+	}
+	for i, n := range infection {
+		a := infectionAlpha * float64(n) / sim.InfectionMax
+		p := v.infectionPixels[i*4 : i*4+4]
+		// Pixels are premultiplied by alpha
+		p[0] = uint8(float64(infectionColor[0]) * a)
+		p[1] = uint8(float64(infectionColor[1]) * a)
+		p[2] = uint8(float64(infectionColor[2]) * a)
+		p[3] = uint8(0xff * a)
+	}
+	v.infectionImage.ReplacePixels(v.infectionPixels)
+
+	op := &ebiten.DrawImageOptions{Filter: ebiten.FilterLinear}
+	op.GeoM.Scale(tileSize, tileSize)
+	screen.DrawImage(v.infectionImage, op)
 }
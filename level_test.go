@@ -0,0 +1,70 @@
+package main
+
+import (
+	"image"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// benchLevel returns a view of the level with the given asset id, loading
+// its assets from the packed files
+func benchLevel(b *testing.B, id string) *LevelView {
+	if assets == nil {
+		a, err := NewPackedAssets(nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		assets = a
+	}
+	ids, err := assets.Required(id)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if err := assets.LoadAll(ids); err != nil {
+		b.Fatal(err)
+	}
+
+	return NewLevelView(sim.NewLevel(id, assets.Level(id)))
+}
+
+// drawUncached draws every tile of every layer straight from the tile sheet,
+// as levels were drawn before the passes were cached
+func drawUncached(v *LevelView, screen *ebiten.Image) {
+	tiles := assets.Image(tilesAsset)
+	for _, layer := range v.Layers() {
+		for i, t := range layer {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(float64((i%levelXNum)*tileSize), float64((i/levelXNum)*tileSize))
+			sx := (t % tileXNum) * tileSize
+			sy := (t / tileXNum) * tileSize
+			tile := tiles.SubImage(image.Rect(sx, sy, sx+tileSize, sy+tileSize)).(*ebiten.Image)
+			screen.DrawImage(tile, op)
+		}
+	}
+}
+
+func BenchmarkDrawLevel(b *testing.B) {
+	screen, err := ebiten.NewImage(screenWidth, screenHeight, ebiten.FilterDefault)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, id := range []string{"level_one", "level_lab"} {
+		v := benchLevel(b, id)
+
+		b.Run(id+"/cached", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				v.drawBackground(screen)
+				v.drawForeground(screen)
+			}
+		})
+
+		b.Run(id+"/uncached", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				drawUncached(v, screen)
+			}
+		})
+	}
+}
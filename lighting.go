@@ -0,0 +1,290 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// Lighting constants
+const (
+	// playerLightRadius is how far, in pixels, the light VaxerMan carries
+	// reaches
+	playerLightRadius = 72
+
+	// fogExplored is how bright tiles VaxerMan has seen before, but can't
+	// see now, are drawn
+	fogExplored = 0.25
+
+	// glowRadius is the radius of the glow image, which is scaled to the
+	// radius of each light
+	glowRadius = 32
+)
+
+// playerLightColor is the warm colour of VaxerMan's light
+var playerLightColor = [3]uint8{255, 236, 200}
+
+// Lighting darkens levels that have lighting set, leaving only what is lit
+// and in VaxerMan's line of sight. Tiles seen before stay dimly visible,
+// while those not yet explored are black. Only the terrain of explored tiles
+// is remembered: viruses and anything else on tiles out of sight aren't
+// drawn at all.
+//
+// Like particles, lighting is presentation only: the tiles that block sight
+// are listed apart from the solid tiles that block movement, so it never
+// affects the simulation or replays.
+type Lighting struct {
+	level    string // id of the level explored belongs to
+	explored []bool // tiles that have ever been in sight
+	visible  []bool // tiles in sight this frame
+	opaque   []bool
+
+	lightMap *ebiten.Image // light reaching each pixel, multiplied onto the screen
+	sight    *ebiten.Image // one pixel per tile, white where visible
+	memory   *ebiten.Image // one pixel per tile, grey where explored but not visible
+	glow     *ebiten.Image
+	pixels   []byte
+}
+
+// NewLighting returns lighting with nothing explored
+func NewLighting() *Lighting {
+	lightMap, _ := ebiten.NewImage(screenWidth, screenHeight, ebiten.FilterDefault)
+	glow, _ := ebiten.NewImageFromImage(glowImage(glowRadius), ebiten.FilterLinear)
+
+	return &Lighting{
+		lightMap: lightMap,
+		glow:     glow,
+	}
+}
+
+// Reset forgets which tiles have been explored, e.g. when a new run starts
+func (l *Lighting) Reset() {
+	l.level = ""
+}
+
+// Update works out which tiles of level the viewer at x, y can see. It is
+// called each frame before anything is drawn.
+func (l *Lighting) Update(level *LevelView, x, y float64) {
+	cols, rows := levelXNum, level.Rows()
+	if l.level != level.ID() || len(l.explored) != cols*rows {
+		l.resize(cols, rows)
+		l.level = level.ID()
+	}
+	l.updateSight(level, x, y)
+}
+
+// Visible returns true if the tile at x, y was in sight at the last Update
+func (l *Lighting) Visible(x, y float64) bool {
+	col, row := int(math.Floor(x/tileSize)), int(math.Floor(y/tileSize))
+	if col < 0 || col >= levelXNum || row < 0 || row >= len(l.visible)/levelXNum {
+		return false
+	}
+	return l.visible[row*levelXNum+col]
+}
+
+// Draw darkens the screen, on which level has been drawn, lighting it with
+// the level's lights and a light carried by the viewer at x, y
+func (l *Lighting) Draw(screen *ebiten.Image, level *LevelView, x, y float64) {
+	lighting := level.Lighting()
+	l.lightMap.Fill(color.Gray{Y: uint8(lighting.Ambient * 0xff)})
+	l.drawLight(x, y, playerLightRadius, playerLightColor)
+
+	lights := make(map[int]int)
+	for i, light := range lighting.Lights {
+		lights[light.Tile] = i
+	}
+	for _, layer := range level.Layers() {
+		for i, t := range layer {
+			// Animated tiles give off light only on the frames that are
+			// lights, so lamps can flicker
+			if j, ok := lights[level.tile(t)]; ok {
+				light := lighting.Lights[j]
+				cx, cy := sim.TileCentre(i)
+				l.drawLight(cx, cy, light.Radius, light.Color)
+			}
+		}
+	}
+
+	// Keep only the light that reaches tiles in sight, then let explored
+	// tiles show through dimly
+	op := l.fogOptions()
+	op.CompositeMode = ebiten.CompositeModeMultiply
+	l.lightMap.DrawImage(l.sight, op)
+	op = l.fogOptions()
+	op.CompositeMode = ebiten.CompositeModeLighter
+	l.lightMap.DrawImage(l.memory, op)
+
+	op = &ebiten.DrawImageOptions{CompositeMode: ebiten.CompositeModeMultiply}
+	screen.DrawImage(l.lightMap, op)
+}
+
+// resize allocates the tile masks for a level cols by rows tiles in size,
+// with nothing explored. The fog images have a border of a tile either side
+// so that they fade out smoothly at the edges of the screen.
+func (l *Lighting) resize(cols, rows int) {
+	l.explored = make([]bool, cols*rows)
+	l.visible = make([]bool, cols*rows)
+	l.opaque = make([]bool, cols*rows)
+	l.pixels = make([]byte, (cols+2)*(rows+2)*4)
+	l.sight, _ = ebiten.NewImage(cols+2, rows+2, ebiten.FilterDefault)
+	l.memory, _ = ebiten.NewImage(cols+2, rows+2, ebiten.FilterDefault)
+}
+
+// updateSight works out which tiles can be seen from x, y, marks them as
+// explored, and updates the fog images to match
+func (l *Lighting) updateSight(level *LevelView, x, y float64) {
+	opaque := make(map[int]bool)
+	for _, t := range level.Lighting().Opaque {
+		opaque[t] = true
+	}
+	for i := range l.opaque {
+		l.opaque[i] = false
+		for _, layer := range level.Layers() {
+			if opaque[layer[i]] {
+				l.opaque[i] = true
+			}
+		}
+	}
+
+	cols := levelXNum
+	for i := range l.visible {
+		l.visible[i] = l.lineOfSight(x, y, i%cols, i/cols)
+		if l.visible[i] {
+			l.explored[i] = true
+		}
+	}
+
+	l.fillFog(func(i int) uint8 {
+		if l.visible[i] {
+			return 0xff
+		}
+		return 0
+	})
+	l.sight.ReplacePixels(l.pixels)
+	l.fillFog(func(i int) uint8 {
+		if l.explored[i] && !l.visible[i] {
+			return uint8(math.Round(fogExplored * 0xff))
+		}
+		return 0
+	})
+	l.memory.ReplacePixels(l.pixels)
+}
+
+// fillFog sets the pixels of a fog image to the grey level returns for each
+// tile, leaving the border black
+func (l *Lighting) fillFog(level func(i int) uint8) {
+	cols := levelXNum
+	w := cols + 2
+	for i := range l.pixels {
+		l.pixels[i] = 0
+	}
+	for i := range l.visible {
+		p := ((i/cols+1)*w + i%cols + 1) * 4
+		v := level(i)
+		l.pixels[p], l.pixels[p+1], l.pixels[p+2], l.pixels[p+3] = v, v, v, 0xff
+	}
+}
+
+// fogOptions draws a fog image over the screen, each pixel covering a tile
+// and blending smoothly into its neighbours
+func (l *Lighting) fogOptions() *ebiten.DrawImageOptions {
+	op := &ebiten.DrawImageOptions{Filter: ebiten.FilterLinear}
+	op.GeoM.Scale(tileSize, tileSize)
+	op.GeoM.Translate(-tileSize, -tileSize)
+	return op
+}
+
+// lineOfSight returns true if the tile at col, row can be seen from x, y.
+// It steps through every tile the line between them crosses, and is blocked
+// by any opaque tile before the one being looked at, so walls themselves
+// can be seen.
+func (l *Lighting) lineOfSight(x, y float64, col, row int) bool {
+	cols, rows := levelXNum, len(l.visible)/levelXNum
+	c := clampInt(int(math.Floor(x/tileSize)), 0, cols-1)
+	r := clampInt(int(math.Floor(y/tileSize)), 0, rows-1)
+	tx, ty := sim.TileCentre(row*cols + col)
+	dx, dy := tx-x, ty-y
+
+	stepC, tMaxX, tDeltaX := traversal(x, dx, c)
+	stepR, tMaxY, tDeltaY := traversal(y, dy, r)
+
+	// The line crosses one tile boundary per step
+	steps := abs(col-c) + abs(row-r)
+	for i := 0; i < steps-1; i++ {
+		if tMaxX < tMaxY {
+			tMaxX += tDeltaX
+			c += stepC
+		} else {
+			tMaxY += tDeltaY
+			r += stepR
+		}
+		if c < 0 || c >= cols || r < 0 || r >= rows {
+			return false
+		}
+		if l.opaque[r*cols+c] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// traversal returns the direction a line from p heading d steps through
+// tiles along one axis, how far along it, as a fraction of d, the first tile
+// boundary is, and how far apart boundaries are
+func traversal(p, d float64, cell int) (step int, tMax, tDelta float64) {
+	switch {
+	case d > 0:
+		return 1, (float64(cell+1)*tileSize - p) / d, tileSize / d
+	case d < 0:
+		return -1, (float64(cell)*tileSize - p) / d, -tileSize / d
+	default:
+		return 0, math.Inf(1), math.Inf(1)
+	}
+}
+
+// drawLight adds a light's glow to the light map
+func (l *Lighting) drawLight(x, y, radius float64, c [3]uint8) {
+	op := &ebiten.DrawImageOptions{CompositeMode: ebiten.CompositeModeLighter}
+	op.GeoM.Translate(-glowRadius, -glowRadius)
+	op.GeoM.Scale(radius/glowRadius, radius/glowRadius)
+	op.GeoM.Translate(x, y)
+	op.ColorM.Scale(float64(c[0])/0xff, float64(c[1])/0xff, float64(c[2])/0xff, 1)
+	l.lightMap.DrawImage(l.glow, op)
+}
+
+// glowImage returns a white glow that fades out from the middle to radius
+func glowImage(radius int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, radius*2, radius*2))
+	for y := 0; y < radius*2; y++ {
+		for x := 0; x < radius*2; x++ {
+			d := math.Hypot(float64(x-radius)+0.5, float64(y-radius)+0.5) / float64(radius)
+			t := math.Max(0, 1-d)
+			a := uint8(t * t * 0xff)
+			// Colours are premultiplied by alpha
+			img.SetRGBA(x, y, color.RGBA{R: a, G: a, B: a, A: a})
+		}
+	}
+
+	return img
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/text"
+)
+
+// loadBudget is how long the loader may spend loading assets each frame, so
+// that the loading screen keeps being drawn, which matters most in browsers
+const loadBudget = time.Second / 60
+
+// Loader loads a list of assets a few at a time behind a loading screen
+type Loader struct {
+	ids  []string
+	next int
+	err  error
+}
+
+// NewLoader returns a loader for the assets with the given ids. Assets that
+// are already loaded are skipped.
+func NewLoader(ids []string) *Loader {
+	l := &Loader{}
+	for _, id := range ids {
+		if !assets.Loaded(id) {
+			l.ids = append(l.ids, id)
+		}
+	}
+	return l
+}
+
+// Update loads assets until the frame's budget is used up. At least one
+// asset is loaded per call. Loading stops at the first error.
+func (l *Loader) Update() {
+	start := time.Now()
+	for !l.Done() && l.err == nil {
+		id := l.ids[l.next]
+		if err := assets.Load(id); err != nil {
+			l.err = fmt.Errorf("error loading %s: %v", id, err)
+			log.Print(l.err)
+			return
+		}
+		l.next++
+
+		if time.Since(start) >= loadBudget {
+			return
+		}
+	}
+}
+
+// Done returns true once every asset has been loaded
+func (l *Loader) Done() bool {
+	return l.next == len(l.ids)
+}
+
+// Err returns the error that stopped loading, if any
+func (l *Loader) Err() error {
+	return l.err
+}
+
+// Draw draws a progress bar, or the error that stopped loading
+func (l *Loader) Draw(screen *ebiten.Image) {
+	if l.err != nil {
+		lines := []string{"Failed to load the game", ""}
+		lines = append(lines, wrapText(l.err.Error(), screenWidth/smallFontSize-2)...)
+		for i, line := range lines {
+			text.Draw(screen, line, smallArcadeFont, smallFontSize, (i+4)*smallFontSize*2, color.White)
+		}
+		return
+	}
+
+	const barWidth, barHeight = screenWidth / 2, 8
+	x, y := float64(screenWidth-barWidth)/2, float64(screenHeight-barHeight)/2
+	progress := 1.0
+	if len(l.ids) > 0 {
+		progress = float64(l.next) / float64(len(l.ids))
+	}
+
+	msg := "Loading"
+	text.Draw(screen, msg, smallArcadeFont, (screenWidth-len(msg)*smallFontSize)/2, int(y)-smallFontSize, color.White)
+	ebitenutil.DrawRect(screen, x, y, barWidth, barHeight, color.Gray{Y: 0x40})
+	ebitenutil.DrawRect(screen, x, y, barWidth*progress, barHeight, color.White)
+}
+
+// wrapText splits s into lines of at most width characters, breaking at
+// spaces where it can
+func wrapText(s string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(s) {
+		for len(word) > width {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			lines = append(lines, word[:width])
+			word = word[width:]
+		}
+		switch {
+		case line == "":
+			line = word
+		case len(line)+1+len(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -1,31 +1,29 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"image/color"
 	_ "image/png"
 	"log"
-	"math/rand"
+	"math"
 	"time"
 
 	"github.com/golang/freetype/truetype"
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/audio"
-	"github.com/hajimehoshi/ebiten/audio/wav"
 	"github.com/hajimehoshi/ebiten/examples/resources/fonts"
+	"github.com/hajimehoshi/ebiten/inpututil"
 	"github.com/hajimehoshi/ebiten/text"
-	"github.com/paulcockrell/gametest/resources/sfx"
+	"github.com/paulcockrell/gametest/resources/manifest"
+	"github.com/paulcockrell/gametest/sim"
 	"golang.org/x/image/font"
 )
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
-
 // Screen constants
 const (
-	screenWidth   = 240
-	screenHeight  = 240
+	screenWidth   = sim.ScreenWidth
+	screenHeight  = sim.ScreenHeight
 	fontSize      = 12
 	smallFontSize = fontSize / 2
 )
@@ -35,11 +33,12 @@ var (
 	smallArcadeFont font.Face
 )
 
-// Load fonts
-func init() {
+// loadFonts parses the fonts used for text, which are needed before any
+// other asset so that the loading screen can be drawn
+func loadFonts() error {
 	tt, err := truetype.Parse(fonts.ArcadeN_ttf)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("error loading font: %v", err)
 	}
 	const dpi = 72
 	arcadeFont = truetype.NewFace(tt, &truetype.Options{
@@ -52,138 +51,457 @@ func init() {
 		DPI:     dpi,
 		Hinting: font.HintingFull,
 	})
-}
 
-var (
-	audioContext *audio.Context
-	boomPlayer   *audio.Player
-	sneezePlayer *audio.Player
-)
+	return nil
+}
 
+// Music asset ids
 const (
-	sampleRate = 44100
+	gameMusic     = "music_game"
+	gameOverMusic = "music_gameover"
 )
 
-func init() {
-	audioContext, err := audio.NewContext(sampleRate)
-	if err != nil {
-		log.Fatal(err)
+// noticeTime is how long notices, such as volume changes, are shown for
+const noticeTime = 1500 * time.Millisecond
+
+// Game plays runs of the simulation, drawing them and giving the player
+// feedback on what happens in them
+type Game struct {
+	sim      *sim.Game  // the current run
+	levelID  string     // asset id of the level runs start on
+	level    *LevelView // draws the level being played, or edited
+	campaign *Campaign
+	clock    Clock
+
+	debugHitboxes bool // draw collider outlines, toggled with F1
+
+	recordPath string // if set, the replay of each run is written here when it ends
+
+	loader  *Loader       // set while the assets of the level are loading
+	watcher *assetWatcher // set in dev mode to reload assets as they change
+	editor  *Editor       // set in dev mode to edit levels
+
+	sound       *SoundManager
+	particles   *ParticleSystem
+	lighting    *Lighting
+	juice       *Juice
+	post        *PostProcess
+	render      RenderQueue // what is drawn this frame, sorted by layer and depth
+	notice      string      // shown briefly over the game, e.g. a volume change
+	noticeUntil time.Time
+}
+
+// NewGame builds a game on the given level that starts once the level's
+// assets have loaded behind a loading screen. Completing a level of the
+// campaign moves on to the next.
+func NewGame(level, recordPath string, campaign *Campaign, sound *SoundManager, juice *Juice, post *PostProcess) (*Game, error) {
+	g := &Game{
+		recordPath: recordPath,
+		campaign:   campaign,
+		sound:      sound,
+		particles:  NewParticleSystem(),
+		lighting:   NewLighting(),
+		juice:      juice,
+		post:       post,
+	}
+	if err := g.startLevel(level); err != nil {
+		return nil, err
 	}
+	return g, nil
+}
 
-	boomD, err := wav.Decode(audioContext, audio.BytesReadSeekCloser(sfx.Boom_wav))
+// startLevel loads the assets of a level behind the loading screen, then
+// starts a run on it
+func (g *Game) startLevel(id string) error {
+	ids, err := assets.Required(id)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
-	boomPlayer, err = audio.NewPlayer(audioContext, boomD)
-	if err != nil {
-		log.Fatal(err)
+
+	g.levelID = id
+	g.loader = NewLoader(ids)
+	return nil
+}
+
+// init starts a new run of the level, seeded with seed
+func (g *Game) init(seed int64) {
+	g.sim = sim.NewGame(assets.Assets, g.levelID, seed, g)
+	g.level = NewLevelView(g.sim.Level())
+	g.clock = Clock{}
+	g.particles.Clear()
+	g.lighting.Reset()
+	g.juice.Reset()
+}
+
+// Update runs as many fixed simulation steps as the real time since the last
+// call allows
+func (g *Game) Update(screen *ebiten.Image) error {
+	g.updateSound()
+
+	if g.loader != nil {
+		g.loader.Update()
+		if g.loader.Done() {
+			g.loader = nil
+			g.init(time.Now().UnixNano())
+		}
+		return nil
 	}
 
-	sneezeD, err := wav.Decode(audioContext, audio.BytesReadSeekCloser(sfx.Sneeze_wav))
-	if err != nil {
-		log.Fatal(err)
+	particleSystem(g.sim.World(), g.particles)
+	g.particles.Update()
+	g.juice.Update()
+
+	// The run stands still while the level is edited
+	if g.editor != nil && inpututil.IsKeyJustPressed(editorKey) {
+		g.toggleEditor()
 	}
-	sneezePlayer, err = audio.NewPlayer(audioContext, sneezeD)
-	if err != nil {
-		log.Fatal(err)
+	if g.editor.Active() {
+		g.clock.Hold()
+		if msg := g.editor.Update(g.level); msg != "" {
+			g.showNotice(msg)
+		}
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF1) {
+		g.debugHitboxes = !g.debugHitboxes
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyE) {
+		g.showNotice(g.juice.Toggle())
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) {
+		g.showNotice(g.post.Cycle())
+	}
+
+	if g.watcher != nil {
+		g.reloadAssets()
+	}
+
+	in := readInput()
+
+	// If VaxerMan is infected, or the level won, activate the "R" key to
+	// reset the game
+	if g.sim.Over() && in.Has(sim.InputRestart) {
+		g.init(time.Now().UnixNano())
+		return nil
+	}
+
+	// Once the level is won, "N" moves on to the next level of the campaign
+	if next, ok := g.nextLevel(); ok && inpututil.IsKeyJustPressed(ebiten.KeyN) {
+		if err := g.startLevel(next); err != nil {
+			log.Printf("error starting level %q: %v", next, err)
+		}
+		return nil
 	}
+
+	// The game stands still once the level is won
+	if g.sim.Won() {
+		g.clock.Hold()
+		return nil
+	}
+
+	// Time spent in a hit-stop is never simulated, so the steps and the
+	// inputs recorded for them are the same as without it
+	if g.juice.Frozen() {
+		g.clock.Hold()
+		return nil
+	}
+
+	g.clock.Advance()
+	for g.clock.Step() {
+		wasOver := g.sim.Over()
+		g.sim.Step(in)
+		if !wasOver && g.sim.Over() {
+			g.saveReplay()
+			if g.sim.Won() && g.campaign != nil {
+				g.campaign.Complete(g.levelID)
+			}
+		}
+		if g.sim.Won() {
+			break
+		}
+	}
+
+	return nil
 }
 
-type Sprite struct {
-	image                   *ebiten.Image
-	numFrames               int
-	frameOX, frameOY        int
-	frameHeight, frameWidth int
+// nextLevel returns the campaign level to move on to once this one is won,
+// or false if there is none
+func (g *Game) nextLevel() (string, bool) {
+	if !g.sim.Won() || g.campaign == nil {
+		return "", false
+	}
+	return g.campaign.Next(g.levelID)
 }
 
-type Game struct {
-	vaxerman *VaxerMan
-	level    *Level
-	enemies  []*Enemy
+// saveReplay writes the replay of the run that just ended, along with its
+// result, to the record path
+func (g *Game) saveReplay() {
+	if g.recordPath == "" {
+		return
+	}
+
+	if err := g.sim.Replay().Save(g.recordPath); err != nil {
+		log.Printf("error saving replay: %v", err)
+	}
 }
 
-func NewGame() *Game {
-	g := &Game{}
-	g.init()
-	return g
+// PlaySound plays a sound effect of the simulation's, centred
+func (g *Game) PlaySound(id string) {
+	g.sound.PlaySFX(id)
 }
 
-func (g *Game) init() {
-	g.vaxerman = NewVaxerMan(screenWidth/2, screenHeight/2)
-	g.level = NewLevel("resources/levels/level_one.json")
-	g.enemies = make([]*Enemy, 0)
+// PlaySoundFrom plays a sound effect of the simulation's from x, y
+func (g *Game) PlaySoundFrom(id string, x, y float64) {
+	g.sound.PlaySFXAt(id, x, y)
 }
 
-func (g *Game) Update(screen *ebiten.Image) error {
-	// If VaxerMan is infected, activate the "R" key to
-	// reset the game
-	if g.vaxerman.IsDead() && ebiten.IsKeyPressed(ebiten.KeyR) {
-		g.init()
-		return nil
-	}
+// Burst gives off an effect's particles from x, y
+func (g *Game) Burst(effect *sim.ParticleEffect, x, y, angle float64) {
+	g.particles.Burst(effect, x, y, angle)
+}
 
-	g.vaxerman.update()
-	g.updateEnemies()
+// Killed shakes the screen, freezes it for a moment and flashes the enemy
+// that was shot
+func (g *Game) Killed(enemy *sim.Components) {
+	g.juice.Shake(killTrauma)
+	g.juice.HitStop(hitStopTime)
+	g.juice.Flash(enemy)
+}
 
-	return nil
+// Wounded flashes an enemy that was shot but not killed
+func (g *Game) Wounded(enemy *sim.Components) {
+	g.juice.Flash(enemy)
+}
+
+// Infected shakes the screen, flashes VaxerMan and shows the red vignette
+func (g *Game) Infected(vaxerman *sim.Components) {
+	g.juice.Shake(infectionTrauma)
+	g.juice.Flash(vaxerman)
+	g.juice.Vignette()
+}
+
+// showNotice shows a message over the game for noticeTime
+func (g *Game) showNotice(msg string) {
+	g.notice = msg
+	g.noticeUntil = time.Now().Add(noticeTime)
+}
+
+// updateSound applies the volume keys and picks the music for the current
+// scene
+func (g *Game) updateSound() {
+	if msg := g.sound.HandleInput(readVolumeInput()); msg != "" {
+		g.showNotice(msg)
+	}
+
+	switch {
+	case g.loader != nil:
+		g.sound.PlayMusic("")
+	case g.sim.VaxerManDead():
+		g.sound.PlayMusic(gameOverMusic)
+	default:
+		g.sound.PlayMusic(gameMusic)
+	}
+	if g.sim != nil {
+		soundSystem(g.sim.World(), g.sound, g.sim.VaxerMan())
+	}
+	g.sound.Update()
 }
 
+// Draw draws the game to the post processor's scene, then the scene through
+// the post effects to the screen
 func (g *Game) Draw(screen *ebiten.Image) {
-	g.level.draw(screen)
-	g.vaxerman.draw(screen)
-	g.vaxerman.drawBullets(screen)
-	for _, enemy := range g.enemies {
-		enemy.draw(screen)
+	g.drawScene(g.post.Scene())
+	g.post.Draw(screen)
+}
+
+func (g *Game) drawScene(screen *ebiten.Image) {
+	if g.loader != nil {
+		g.loader.Draw(screen)
+		return
+	}
+
+	q := &g.render
+	if g.editor.Active() {
+		q.Add(LayerBackground, 0, g.level.drawBackground)
+		q.Add(LayerForeground, 0, g.level.drawForeground)
+		q.Add(LayerHUD, 0, g.editor.Draw)
+		q.Add(LayerHUD, 1, g.drawNotice)
+		q.Draw(screen, LayerHUD)
+		return
 	}
-	g.drawInfo(screen)
+
+	alpha := g.clock.Alpha()
+	q.Add(LayerBackground, 0, g.level.drawBackground)
+	q.Add(LayerBackground, 1, g.level.drawInfection)
+	var visible func(x, y float64) bool
+	if g.level.Lighting() != nil {
+		x, y := sim.EntityCentre(g.sim.World().Get(g.sim.VaxerMan()))
+		g.lighting.Update(g.level, x, y)
+		visible = g.lighting.Visible
+		q.Add(LayerEffects, 1, func(screen *ebiten.Image) {
+			g.lighting.Draw(screen, g.level, x, y)
+		})
+	}
+	renderSystem(g.sim.World(), q, alpha, visible)
+	q.Add(LayerForeground, 0, g.level.drawForeground)
+	q.Add(LayerEffects, 0, g.particles.Draw)
+	if g.debugHitboxes {
+		q.Add(LayerEffects, 2, func(screen *ebiten.Image) {
+			drawHitboxes(g.sim.World(), screen, alpha)
+		})
+	}
+	q.Add(LayerHUD, 0, g.drawInfo)
+
+	// The world is drawn to the juice canvas so that it can be shaken; the
+	// HUD drawn over it stays still
+	q.Draw(g.juice.Canvas(), LayerEffects)
+	g.juice.Draw(screen)
+	q.Draw(screen, LayerHUD)
 }
 
+// Layout makes the screen postScale times the size of the game, so that
+// post effects have pixels finer than the game's to work with
 func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
-	return screenWidth, screenHeight
+	return screenWidth * postScale, screenHeight * postScale
 }
 
-func (g *Game) updateEnemies() {
-	currentEnemies := make([]*Enemy, 0)
-	for _, enemy := range g.enemies {
-		enemy.update()
+func (g *Game) drawInfo(screen *ebiten.Image) {
+	var texts []string
+	switch {
+	case g.sim.Won():
+		texts = g.completeText()
+	case g.sim.VaxerManDead():
+		texts = []string{"VaxerMan has been infected!", "", "", "", "Press 'R' to restart"}
+	}
+	for i, l := range texts {
+		x := (screenWidth - len(l)*smallFontSize) / 2
+		text.Draw(screen, l, smallArcadeFont, x, (i+20)*smallFontSize, color.White)
+	}
+	health := fmt.Sprintf("Health: %d%%", g.sim.World().Get(g.sim.VaxerMan()).Health.Current)
+	text.Draw(screen, health, smallArcadeFont, 170, 12, color.White)
+	score := fmt.Sprintf("Score: %d", g.sim.Score())
+	text.Draw(screen, score, smallArcadeFont, 8, 12, color.White)
+	wave := fmt.Sprintf("Wave: %d", g.sim.Wave())
+	if goal := g.sim.Level().Goal(); goal != nil && goal.Kind == manifest.GoalWaves {
+		wave = fmt.Sprintf("Wave: %d/%d", g.sim.Wave(), goal.Waves)
+	}
+	text.Draw(screen, wave, smallArcadeFont, 8, 20, color.White)
+	if goal := g.goalText(); goal != "" {
+		text.Draw(screen, goal, smallArcadeFont, 8, 28, color.White)
+	}
 
-		if enemy.HasInfectedPlayer(g.vaxerman) {
-			g.vaxerman.Infect()
-		}
+	g.drawNotice(screen)
+}
 
-		if g.vaxerman.hasShotEnemy(enemy) {
-			enemy.status = EnemyHit
-		}
+// drawNotice draws the notice at the bottom of the screen while it is shown
+func (g *Game) drawNotice(screen *ebiten.Image) {
+	if time.Now().Before(g.noticeUntil) {
+		x := (screenWidth - len(g.notice)*smallFontSize) / 2
+		text.Draw(screen, g.notice, smallArcadeFont, x, screenHeight-8, color.White)
+	}
+}
 
-		if !enemy.IsDead() {
-			currentEnemies = append(currentEnemies, enemy)
-		}
+// goalText returns how far the player is towards the level's goal, for goals
+// the wave count doesn't already show
+func (g *Game) goalText() string {
+	goal := g.sim.Level().Goal()
+	if goal == nil {
+		return ""
 	}
-	g.enemies = currentEnemies
 
-	if len(g.enemies) < MaxEnemies && (rand.Intn(20) == 1) {
-		x, y, vx, vy := GenerateEnemyStartPos()
-		newEnemy := NewEnemy(x, y, vx, vy)
-		g.enemies = append(g.enemies, newEnemy)
+	switch goal.Kind {
+	case manifest.GoalBoss:
+		if boss := g.sim.World().Get(g.sim.Boss()); boss != nil && boss.Health != nil {
+			return fmt.Sprintf("Boss: %d/%d", boss.Health.Current, boss.Health.Max)
+		}
+	case manifest.GoalExit:
+		return "Find the exit"
+	case manifest.GoalCleanse:
+		return fmt.Sprintf("Clean: %d/%d%%", int(g.sim.Level().Disinfected()*100), int(math.Round(goal.Clean*100)))
 	}
+	return ""
 }
 
-func (g *Game) drawInfo(screen *ebiten.Image) {
-	if g.vaxerman.IsDead() {
-		texts := []string{"VaxerMan has been infected!", "", "", "", "Press 'R' to restart"}
-		for i, l := range texts {
-			x := (screenWidth - len(l)*smallFontSize) / 2
-			text.Draw(screen, l, smallArcadeFont, x, (i+20)*smallFontSize, color.White)
+// completeText returns the lines of the level complete screen: the level's
+// name, the stats of the run and what to press next
+func (g *Game) completeText() []string {
+	title, name := "Level complete!", g.levelID
+	if g.campaign != nil {
+		name = g.campaign.Name(g.levelID)
+		if g.campaign.IsLast(g.levelID) {
+			title = "Campaign complete!"
 		}
 	}
-	health := fmt.Sprintf("Health: %d%%", g.vaxerman.Health)
-	text.Draw(screen, health, smallArcadeFont, 170, 12, color.White)
+
+	// Stats are padded to the same width so that they line up when centred
+	secs := g.sim.Ticks() / simRate
+	stat := func(label, value string) string {
+		return fmt.Sprintf("%-8s%6s", label, value)
+	}
+	texts := []string{
+		title,
+		name,
+		"",
+		stat("Time", fmt.Sprintf("%d:%02d", secs/60, secs%60)),
+		stat("Kills", fmt.Sprint(g.sim.Kills())),
+		stat("Score", fmt.Sprint(g.sim.Score())),
+		stat("Health", fmt.Sprintf("%d%%", g.sim.World().Get(g.sim.VaxerMan()).Health.Current)),
+		"",
+	}
+	if _, ok := g.nextLevel(); ok {
+		texts = append(texts, "Press 'N' for the next level")
+	}
+	return append(texts, "Press 'R' to replay")
 }
 
 func main() {
+	recordPath := flag.String("record", "", "write a replay of each run to this file")
+	dev := flag.Bool("dev", false, "load assets from disk and reload them when they change")
+	assetDir := flag.String("assets", "resources", "directory holding manifest.json, used in dev mode")
+	level := flag.String("level", "", `asset id of the level to play, such as "level_lab", or "generated:caves:42" to generate one (default the furthest unlocked level of the campaign)`)
+	flag.Parse()
+
+	if err := loadFonts(); err != nil {
+		log.Fatal(err)
+	}
+	audioContext, err := audio.NewContext(sampleRate)
+	if err != nil {
+		log.Fatalf("error starting audio: %v", err)
+	}
+
+	if *dev {
+		assets, err = NewAssetsFromDir(*assetDir, audioContext)
+	} else {
+		assets, err = NewPackedAssets(audioContext)
+	}
+	if err != nil {
+		log.Fatalf("error loading asset manifest: %v", err)
+	}
+
+	if err := assets.Load(campaignAsset); err != nil {
+		log.Fatalf("error loading campaign: %v", err)
+	}
+	settings := LoadSettings()
+	campaign := NewCampaign(settings)
+	if *level == "" {
+		*level = campaign.Current()
+	}
+	g, err := NewGame(*level, *recordPath, campaign, NewSoundManager(audioContext, settings), NewJuice(settings), NewPostProcess(settings))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *dev {
+		g.watchAssets(*assetDir)
+		g.editor = NewEditor(*assetDir)
+	}
+
+	// Update is called once per rendered frame; the game runs its own fixed
+	// timestep inside it
+	ebiten.SetMaxTPS(ebiten.UncappedTPS)
 	ebiten.SetWindowSize(screenWidth*2, screenHeight*2)
 	ebiten.SetWindowTitle("VaxerMan - Corona Virus Killer")
-	if err := ebiten.RunGame(NewGame()); err != nil {
+	if err := ebiten.RunGame(g); err != nil {
 		log.Fatalf("error starting game %v", err)
 	}
 }
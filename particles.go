@@ -0,0 +1,181 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// particleDt caps the time a single update can move particles on by, so a
+// stalled frame doesn't fling them across the screen
+const particleDt = 0.1
+
+type particle struct {
+	x, y, vx, vy float64
+	age, life    float64
+	effect       *sim.ParticleEffect
+}
+
+// ParticleSystem animates particles given off by bursts and trails. Like
+// sound, particles are presentation only: they run on real time rather than
+// the fixed timestep and have their own random numbers, so they never affect
+// the simulation or replays.
+//
+// Particles are kept in a pool of maxParticles allocated up front. Bursts are
+// dropped when it is full, and trails only use the first half of it, so that
+// they can't crowd out bursts.
+type ParticleSystem struct {
+	pool []particle // the first live entries are alive
+	live int
+
+	rand   *rand.Rand
+	trails map[sim.Entity]float64 // particles owed to each trail
+	last   time.Time
+	dt     float64 // seconds since the previous update
+
+	image    *ebiten.Image // white, drawn tinted for every particle
+	vertices []ebiten.Vertex
+	indices  []uint16
+}
+
+// NewParticleSystem returns a particle system with an empty pool
+func NewParticleSystem() *ParticleSystem {
+	image, _ := ebiten.NewImage(3, 3, ebiten.FilterNearest)
+	image.Fill(color.White)
+
+	return &ParticleSystem{
+		pool:     make([]particle, maxParticles),
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		trails:   make(map[sim.Entity]float64),
+		image:    image,
+		vertices: make([]ebiten.Vertex, 0, maxParticles*4),
+		indices:  make([]uint16, 0, maxParticles*6),
+	}
+}
+
+// Burst gives off an effect's particles from x, y, heading in the direction
+// of angle in radians
+func (s *ParticleSystem) Burst(effect *sim.ParticleEffect, x, y, angle float64) {
+	for i := 0; i < effect.Count; i++ {
+		if !s.spawn(effect, x, y, angle) {
+			return
+		}
+	}
+}
+
+// Trail gives off the particles an entity's trail owes for the time since the
+// last update. Trails stop giving off particles once half the pool is used.
+func (s *ParticleSystem) Trail(e sim.Entity, effect *sim.ParticleEffect, x, y, angle float64) {
+	owed := s.trails[e] + effect.Rate*s.dt
+	for ; owed >= 1 && s.live < len(s.pool)/2; owed-- {
+		s.spawn(effect, x, y, angle)
+	}
+	s.trails[e] = math.Mod(owed, 1)
+}
+
+// StopTrails forgets the trails of every entity not in live
+func (s *ParticleSystem) StopTrails(live map[sim.Entity]bool) {
+	for e := range s.trails {
+		if !live[e] {
+			delete(s.trails, e)
+		}
+	}
+}
+
+// spawn adds a particle, returning false if the pool is full
+func (s *ParticleSystem) spawn(effect *sim.ParticleEffect, x, y, angle float64) bool {
+	if s.live == len(s.pool) {
+		return false
+	}
+
+	a := angle + s.vary(effect.Spread)
+	speed := effect.Speed + s.vary(effect.SpeedSpread)
+	s.pool[s.live] = particle{
+		x: x, y: y,
+		vx: math.Cos(a) * speed, vy: math.Sin(a) * speed,
+		life:   math.Max(effect.Lifetime+s.vary(effect.LifetimeSpread), 0.01),
+		effect: effect,
+	}
+	s.live++
+
+	return true
+}
+
+// vary returns a random amount up to spread either way
+func (s *ParticleSystem) vary(spread float64) float64 {
+	return (s.rand.Float64()*2 - 1) * spread
+}
+
+// Update moves particles on by the real time since the last call and frees
+// those that have died. It is called once per frame.
+func (s *ParticleSystem) Update() {
+	now := time.Now()
+	s.dt = 0
+	if !s.last.IsZero() {
+		s.dt = math.Min(now.Sub(s.last).Seconds(), particleDt)
+	}
+	s.last = now
+
+	dt := s.dt
+	for i := 0; i < s.live; {
+		p := &s.pool[i]
+		p.age += dt
+		if p.age >= p.life {
+			// Keep the pool packed by moving the last live particle here
+			s.live--
+			*p = s.pool[s.live]
+			continue
+		}
+
+		p.vy += p.effect.Gravity * dt
+		drag := math.Max(0, 1-p.effect.Drag*dt)
+		p.vx *= drag
+		p.vy *= drag
+		p.x += p.vx * dt
+		p.y += p.vy * dt
+		i++
+	}
+}
+
+// Clear removes every particle, e.g. when a new run starts
+func (s *ParticleSystem) Clear() {
+	s.live = 0
+	s.trails = make(map[sim.Entity]float64)
+}
+
+// Draw draws every particle as a square in a single draw call
+func (s *ParticleSystem) Draw(screen *ebiten.Image) {
+	if s.live == 0 {
+		return
+	}
+
+	s.vertices = s.vertices[:0]
+	s.indices = s.indices[:0]
+	for i := 0; i < s.live; i++ {
+		p := &s.pool[i]
+		t := p.age / p.life
+		r := lerp(float64(p.effect.From.R), float64(p.effect.To.R), t) / 0xff
+		g := lerp(float64(p.effect.From.G), float64(p.effect.To.G), t) / 0xff
+		b := lerp(float64(p.effect.From.B), float64(p.effect.To.B), t) / 0xff
+		a := lerp(float64(p.effect.From.A), float64(p.effect.To.A), t) / 0xff
+
+		x0, y0 := float32(p.x-p.effect.Size/2), float32(p.y-p.effect.Size/2)
+		x1, y1 := x0+float32(p.effect.Size), y0+float32(p.effect.Size)
+		n := uint16(len(s.vertices))
+		for _, v := range [4][4]float32{{x0, y0, 1, 1}, {x1, y0, 2, 1}, {x0, y1, 1, 2}, {x1, y1, 2, 2}} {
+			// Sample the middle pixel of the image, away from its edges
+			s.vertices = append(s.vertices, ebiten.Vertex{
+				DstX: v[0], DstY: v[1],
+				SrcX: v[2], SrcY: v[3],
+				ColorR: float32(r), ColorG: float32(g), ColorB: float32(b), ColorA: float32(a),
+			})
+		}
+		s.indices = append(s.indices, n, n+1, n+2, n+1, n+3, n+2)
+	}
+
+	screen.DrawTriangles(s.vertices, s.indices, s.image, nil)
+}
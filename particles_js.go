@@ -0,0 +1,8 @@
+//go:build js
+// +build js
+
+package main
+
+// maxParticles is the size of the particle pool. Browsers get a smaller
+// budget, as WebAssembly is slower at moving particles.
+const maxParticles = 256
@@ -0,0 +1,7 @@
+//go:build !js
+// +build !js
+
+package main
+
+// maxParticles is the size of the particle pool
+const maxParticles = 1024
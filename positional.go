@@ -0,0 +1,104 @@
+package main
+
+import (
+	"io"
+	"math"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/audio"
+)
+
+// Positional audio constants, in pixels
+const (
+	// hearingNear is the distance within which sounds play at full volume
+	hearingNear = 48
+
+	// hearingFar is the distance beyond which sounds can't be heard. It is
+	// further than the screen is wide so that enemies can be heard before
+	// they come into view.
+	hearingFar = 360
+
+	// panWidth is how far to one side of the listener a sound has to be to
+	// play from only that side. Sounds at the edge of the screen are still
+	// heard a little from the other side.
+	panWidth = screenWidth
+)
+
+// spatialGains returns the left and right gains of a sound dx, dy pixels from
+// the listener. A sound on top of the listener plays at full volume from both
+// sides.
+func spatialGains(dx, dy float64) (left, right float64) {
+	gain := 1.0
+	if d := math.Hypot(dx, dy); d > hearingNear {
+		gain = math.Max(0, 1-(d-hearingNear)/(hearingFar-hearingNear))
+	}
+
+	// Equal power pan, scaled so that the centre is at unity gain
+	pan := math.Max(-1, math.Min(1, dx/panWidth))
+	angle := (pan + 1) * math.Pi / 4
+	left = math.Cos(angle) * math.Sqrt2 * gain
+	right = math.Sin(angle) * math.Sqrt2 * gain
+
+	return math.Min(left, 1), math.Min(right, 1)
+}
+
+// pannedStream wraps a 16 bit stereo sound stream, the format ebiten's
+// decoders produce, scaling each channel by its own gain. The gains can be
+// changed from the game loop while the audio goroutine reads the stream.
+type pannedStream struct {
+	audio.ReadSeekCloser
+
+	mu          sync.Mutex
+	left, right float64
+}
+
+func newPannedStream(s audio.ReadSeekCloser) *pannedStream {
+	return &pannedStream{ReadSeekCloser: s, left: 1, right: 1}
+}
+
+// SetGains sets the gains of the left and right channels
+func (s *pannedStream) SetGains(left, right float64) {
+	s.mu.Lock()
+	s.left, s.right = left, right
+	s.mu.Unlock()
+}
+
+// Read reads whole stereo frames of 4 bytes, so that every sample is scaled
+// by the gain of its own channel
+func (s *pannedStream) Read(p []byte) (int, error) {
+	const frameSize = 4
+	if len(p) < frameSize {
+		return 0, io.ErrShortBuffer
+	}
+	p = p[:len(p)/frameSize*frameSize]
+
+	n, err := s.ReadSeekCloser.Read(p)
+	if rem := n % frameSize; rem != 0 && err == nil {
+		var m int
+		m, err = io.ReadFull(s.ReadSeekCloser, p[n:n+frameSize-rem])
+		n += m
+		// A stream cut short in the middle of a frame has still ended
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+	}
+
+	s.mu.Lock()
+	left, right := s.left, s.right
+	s.mu.Unlock()
+
+	for i := 0; i+frameSize <= n; i += frameSize {
+		scaleSample(p[i:i+2], left)
+		scaleSample(p[i+2:i+4], right)
+	}
+
+	return n, err
+}
+
+// scaleSample scales a little endian 16 bit sample in place
+func scaleSample(b []byte, gain float64) {
+	v := float64(int16(uint16(b[0]) | uint16(b[1])<<8))
+	v = math.Max(math.MinInt16, math.Min(math.MaxInt16, v*gain))
+	s := uint16(int16(v))
+	b[0], b[1] = byte(s), byte(s>>8)
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// postScale is how many times larger than the game screen post effects are
+// drawn, so that effects like scanlines can be finer than a game pixel
+const postScale = 2
+
+// postEffect is a shader pass over the whole screen
+type postEffect struct {
+	shader   string // asset id
+	uniforms func(s *Settings) map[string]interface{}
+}
+
+// postEffects are the passes that can be chained in the settings, by name
+var postEffects = map[string]postEffect{
+	"crt": {
+		shader: "shader_crt",
+		uniforms: func(s *Settings) map[string]interface{} {
+			return map[string]interface{}{"Curvature": float32(0.08)}
+		},
+	},
+	"scanlines": {
+		shader: "shader_scanlines",
+		uniforms: func(s *Settings) map[string]interface{} {
+			return map[string]interface{}{"Rows": float32(screenHeight), "Intensity": float32(0.35)}
+		},
+	},
+	"bloom": {
+		shader: "shader_bloom",
+		uniforms: func(s *Settings) map[string]interface{} {
+			return map[string]interface{}{"Threshold": float32(0.6), "Strength": float32(1.5), "Spread": float32(postScale)}
+		},
+	},
+	"chromatic": {
+		shader: "shader_chromatic",
+		uniforms: func(s *Settings) map[string]interface{} {
+			return map[string]interface{}{"Amount": float32(postScale)}
+		},
+	},
+	"palette": {
+		shader: "shader_palette",
+		uniforms: func(s *Settings) map[string]interface{} {
+			return map[string]interface{}{"Palette": paletteUniform(s.Palette)}
+		},
+	},
+}
+
+// palettes are the colours the palette effect can remap to, from darkest to
+// lightest
+var palettes = map[string][4]uint32{
+	"gameboy":    {0x0f380f, 0x306230, 0x8bac0f, 0x9bbc0f},
+	"virtualboy": {0x000000, 0x550000, 0xaa0000, 0xff0000},
+	"cga":        {0x000000, 0xff55ff, 0x55ffff, 0xffffff},
+}
+
+// defaultPalette is used when the settings name a palette that doesn't exist
+const defaultPalette = "gameboy"
+
+// paletteUniform returns a palette as the flattened [4]vec3 the shader takes
+func paletteUniform(name string) []float32 {
+	p, ok := palettes[name]
+	if !ok {
+		p = palettes[defaultPalette]
+	}
+
+	var u []float32
+	for _, c := range p {
+		u = append(u, float32(c>>16&0xff)/0xff, float32(c>>8&0xff)/0xff, float32(c&0xff)/0xff)
+	}
+	return u
+}
+
+// postPreset is a chain of post effects that the player can pick in game
+type postPreset struct {
+	name    string
+	effects []string
+	palette string
+}
+
+// postPresets are cycled through in order. Other chains can be set in the
+// settings file.
+var postPresets = []postPreset{
+	{name: "Off"},
+	{name: "CRT", effects: []string{"bloom", "chromatic", "scanlines", "crt"}},
+	{name: "Scanlines", effects: []string{"scanlines"}},
+	{name: "Game Boy", effects: []string{"palette", "scanlines"}, palette: "gameboy"},
+	{name: "Virtual Boy", effects: []string{"palette", "scanlines", "crt"}, palette: "virtualboy"},
+	{name: "CGA", effects: []string{"palette"}, palette: "cga"},
+}
+
+// PostProcess draws the game through the chain of post effects in the
+// settings. The game is drawn to the scene image at its own resolution,
+// which is scaled up by postScale and passed through each effect in turn,
+// the last drawing to the screen.
+type PostProcess struct {
+	settings *Settings
+	scene    *ebiten.Image
+	buffers  [2]*ebiten.Image // scaled up, drawn to by turns
+	warned   map[string]bool  // unknown effects that have been logged
+}
+
+// NewPostProcess returns a post processor using the effects in settings
+func NewPostProcess(settings *Settings) *PostProcess {
+	p := &PostProcess{
+		settings: settings,
+		warned:   make(map[string]bool),
+	}
+	p.scene, _ = ebiten.NewImage(screenWidth, screenHeight, ebiten.FilterDefault)
+	for i := range p.buffers {
+		p.buffers[i], _ = ebiten.NewImage(screenWidth*postScale, screenHeight*postScale, ebiten.FilterDefault)
+	}
+
+	return p
+}
+
+// Scene returns a cleared image to draw the game on, to be passed to Draw
+func (p *PostProcess) Scene() *ebiten.Image {
+	p.scene.Clear()
+	return p.scene
+}
+
+// Draw draws the scene to the screen through the post effects. Effects whose
+// shaders haven't loaded yet, such as on the loading screen, are skipped.
+func (p *PostProcess) Draw(screen *ebiten.Image) {
+	effects := p.effects()
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(postScale, postScale)
+	if len(effects) == 0 {
+		screen.DrawImage(p.scene, op)
+		return
+	}
+
+	src := p.buffers[0]
+	src.Clear()
+	src.DrawImage(p.scene, op)
+
+	w, h := src.Size()
+	for i, e := range effects {
+		dst := screen
+		if i < len(effects)-1 {
+			dst = p.buffers[(i+1)%2]
+		}
+
+		op := &ebiten.DrawRectShaderOptions{CompositeMode: ebiten.CompositeModeCopy}
+		op.Images[0] = src
+		op.Uniforms = e.uniforms(p.settings)
+		op.Uniforms["ScreenSize"] = []float32{float32(w), float32(h)}
+		dst.DrawRectShader(w, h, assets.Shader(e.shader), op)
+		src = dst
+	}
+}
+
+// effects returns the chain of effects in the settings that are ready to use
+func (p *PostProcess) effects() []postEffect {
+	var effects []postEffect
+	for _, name := range p.settings.PostEffects {
+		e, ok := postEffects[name]
+		if !ok {
+			if !p.warned[name] {
+				log.Printf("unknown post effect %q", name)
+				p.warned[name] = true
+			}
+			continue
+		}
+		if assets.Loaded(e.shader) {
+			effects = append(effects, e)
+		}
+	}
+
+	return effects
+}
+
+// Cycle switches to the next preset chain of effects, saving the settings.
+// It returns a description of the change to show the player.
+func (p *PostProcess) Cycle() string {
+	next := postPresets[(p.preset()+1)%len(postPresets)]
+	p.settings.PostEffects = next.effects
+	p.settings.Palette = next.palette
+	if err := p.settings.Save(); err != nil {
+		log.Printf("error saving settings: %v", err)
+	}
+
+	return fmt.Sprintf("Effects: %s", next.name)
+}
+
+// preset returns the index of the preset matching the settings, or -1 if
+// they hold a chain of their own
+func (p *PostProcess) preset() int {
+	for i, pp := range postPresets {
+		if pp.palette == p.settings.Palette && equalStrings(pp.effects, p.settings.PostEffects) {
+			return i
+		}
+	}
+	return -1
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
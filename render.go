@@ -0,0 +1,89 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// RenderLayer is a pass of the frame, drawn bottom to top
+type RenderLayer int
+
+// Render layers
+const (
+	LayerBackground RenderLayer = iota // level tiles under entities
+	LayerEntities                      // sprites, sorted by how far down the screen they stand
+	LayerForeground                    // level tiles over entities, such as roofs and treetops
+	LayerEffects                       // particles, lighting and debug overlays
+	LayerHUD                           // score and notices, drawn unshaken
+)
+
+// renderItem is a sprite, or anything else that draws itself, queued to be
+// drawn in a layer
+type renderItem struct {
+	layer RenderLayer
+	depth float64 // items in a layer are drawn in order of depth
+	order int     // then in the order they were added
+
+	image *ebiten.Image
+	op    ebiten.DrawImageOptions
+	draw  func(screen *ebiten.Image) // used instead of image if set
+}
+
+// RenderQueue collects everything drawn in a frame, then draws it sorted by
+// layer and depth, so that what is drawn over what doesn't depend on the
+// order it was added in. In the top-down view entities lower down the screen
+// are nearer the camera, so entities are queued with the y of their feet as
+// their depth.
+type RenderQueue struct {
+	items []renderItem
+}
+
+// AddImage queues img to be drawn with op
+func (q *RenderQueue) AddImage(layer RenderLayer, depth float64, img *ebiten.Image, op *ebiten.DrawImageOptions) {
+	q.items = append(q.items, renderItem{layer: layer, depth: depth, order: len(q.items), image: img, op: *op})
+}
+
+// Add queues a function that draws to the screen
+func (q *RenderQueue) Add(layer RenderLayer, depth float64, draw func(screen *ebiten.Image)) {
+	q.items = append(q.items, renderItem{layer: layer, depth: depth, order: len(q.items), draw: draw})
+}
+
+// Draw draws and removes the queued items in every layer up to and including
+// last. Items in later layers stay queued, to be drawn to another image.
+func (q *RenderQueue) Draw(screen *ebiten.Image, last RenderLayer) {
+	sort.Slice(q.items, func(i, j int) bool {
+		a, b := &q.items[i], &q.items[j]
+		if a.layer != b.layer {
+			return a.layer < b.layer
+		}
+		if a.depth != b.depth {
+			return a.depth < b.depth
+		}
+		return a.order < b.order
+	})
+
+	n := 0
+	for i := range q.items {
+		it := &q.items[i]
+		if it.layer > last {
+			break
+		}
+		if it.draw != nil {
+			it.draw(screen)
+		} else {
+			screen.DrawImage(it.image, &it.op)
+		}
+		n++
+	}
+
+	// Keep the rest, and drop references to what was drawn
+	rest := copy(q.items, q.items[n:])
+	for i := rest; i < len(q.items); i++ {
+		q.items[i] = renderItem{}
+	}
+	q.items = q.items[:rest]
+	for i := range q.items {
+		q.items[i].order = i
+	}
+}
@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var Bullet_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x008\x00\x00\x00\x0e\b\x06\x00\x00\x00z\xa5߮\x00\x00\x14\xdczTXtRaw profile type exif\x00\x00xڥ\x9ai\x92$)\x92F\xffs\x8a9\x02(\xfbq\x00UD\xe6\x06s\xfcy\x8a{fWfW\xd7TɄgDx\x98\x9b\x19\xa0˷`\x19\xec\u007f\xfe\xfb\x86\xff\xe2\xab\xc6^B\xa9}\xb4\xd9Z\xe4\xab\xcc2e\xf1f\xc4\xcf\xd7z?S,\xef\xe7\xfb\xca?>K\xbf\x1e\x0fѾ\x1f\b\x87\xb2\x9f\xf9\xf9s\xb4\xefq\xe3\xb8p\xbe|\x8f\x9f\xef}\x16\xc7\xeb\x1fn4\xbf7J\xfb\xd7\x0f\xd6\xf7F2\xbe\x03|\x8f\xff\x18(\xa7\xcf\x00Q\xbf7Z\xdf\x1be\xf9\x8e\\>\u007f\xef\xef\xc8m\x8e\xfe\xc7%|\xaf\xf3\xe5\xa7\xef\xef\xf7\x1d\xfcG\xc9]Zm\xa9\x17~\x16\x89\xbd\xb7\xc9\xfb!\xb1t\xe2\xa6>\xd1\xdc\xdf\xf8?\a\xfa\xfd\xef\xf0\xe3TaNb9\xe5\xc8O\xc9\xdfY\xe6\xcf\xf7\xe2{\xbe\x9f\x9d\xf3R.\xbc/\xfc\xf6\x9f\xed\xc57\x06R\xc6\x14\xb8\xf3\xfc\xc6\xf6G\xf0\x89\xe6/\xb1\xf9\xf1\xfb?|\x85\xbf\xb3\xaco9\xfc\x92\xee\x9f\xef\xbee\x10~|P⟗A\xb3\xef\x19\xf9\xb7쵟\xbf\xdf\xf1\xf0\xfb\a\xa9\xfey\xba_N\xff0\xa3^\xbe\xef\xe4s\xfc\xe7\x8d\xea\xf8Qr?\xd3\xfb\xf3\xfb^\x1d\xf7\xdagu\xab4\x96ܾ\x8b\xfa\xb1\xc4\x14\xfc\x1d'n/\x82wY\xe3\xd5\xf9\xae\xbc\xef\xef5y\r\xda\xe5\x90j\x8d'n^'\xcd$\xa4\xf8\xa6\x924\xadtC\xb2\xf7\xe6\xa4\xc3\x1c\x8b\x98\x90`\x119\x92߱A.\xa6\x9c\xfcI:\xaft\xa5S\n\x9a\a\x85q(\x95\xccQ\xb97|\xe7\x92\u07b8\xf3\x8dw\xd2`dM\x9c*\x89\x9b%.\xf9?_\xe1\xef\x9c\xf4W\xaf{\xbd\x97R\xfa\xb4\xc8'`\xccK<\xe0L\xc33\xe7?9\xcd?\xbcߠ\xd6\x17\xe0\x1f\xaf_\v\xf2\x9b\xd8\xcc\xdd\xea\v\xf3`\x81+\xee\xcf-vM\xff\xaa\xad\xfc\x12\x9d9\xaf\xf2\xfbSx\xa9\xeb\xe7z\xbfQa\xec\xcadR&\x05\xb1\xa5\\SK\xb1\x8b\xf4\x94\b\xe4 A+\xc5!\xb9\xc8&\x03\xa9VQ&)%\xe7Fnh\x02\x86\x0e\\\xd3\xd3;W\xaa|\x8e\x83\x92d\xa2\xe6\x96;\xb9\xa1YIV)\x95\xfa\xe9ePC\xab\xe6Zj\xad\xad\xf6:ꬫ\xe5V\x02\x1d\xd6Zo\x0e\xb7\xab\xe7^z\xed\xad\xf7>\xfa\xeck\xe4QF\x1dm\xf41\xc6\x1ck\xca̠q\x9d\xb4\xe3\x1csε\x18sq\xe7\xd5\xc2\xe2\xfcő-;\xef\xb2\xebn\xbb\xef\xb1\xe7^\x87\xf29\xe5\xd4\xd3N?\xe3̳T4+}\xacM\xbb\x0e\x9d\xba,\x19\xa5d\xc5j\xb0f݆M[\x97Z\xbb\xf9\x96[o\xbb\xfd\x8e;\xef\xfa\x99\xb5oV\xff\xed\xf5\x0f\xb2\x96\xbeY\x93\x97)?\xaf\xff\xcc\x1aG{\xff\xfc\x0e\xaf^\xb8\x89猌IId\xbc{\x06(h\xf1\x9cőJ\x11Ϝ\xe7,N\xa1+\xaa0\xc9\xea\xb9\xd1\x14WjRB.\x96\xa4\xde\xf43w\xff\xca\xdc?\xca[h\xe5/\xf3&\u007f7s\xc1S\xf7\xff\xcc\xdc\xcb[\xb0\xfa\x87\xbc\xfdI\xd6\xd4i\xfb\xbc\x8c}\xba\xd0c\x1a3\xdd\xc7\xe76V\x97\xd2Tr\vZ\xd2\xcc\xe3h[\xba\xeb\xbc\xf3\xb4<f#\x90\x80\x99͑l\x1e-\xc5\xda\xca{\x02B\x9d9\xda\u07bb\xf4k\xe9\x9c;L\x01Ln\x1f֬'\xddJ\xcc/H\xb4\xafZ\xb9\xe7h/\xa9\xe9*zNϧn\xd3\x03\xeb\xe7\xc1Je\x8f\xd3\xfa\x04\xd1\xea\u07b7\xe5]/skap\xeb;R=\xb5\xe6D\xe0n\xd1rW\xe3\xe6\xf1\x9c\xb5\x0f\xabݧ.\xf3\xa5S@\xb7\xa5\xd9\xef>̝\xa0\xdaY\xb5\x12^[1\x98l\x1dM\x81\xa7\xbeV\xd53XZ.cT\x1d\xf1̴\x97\xa4\xd3M\xda\xe6H\xdd%\x93\x84\xb8\x8d{\xf3\xa6\x94q\x0f\x89\x9b\xe9\xb4P\x8b\xa9Pj\xc3\xe2]ZZ\xbaY\x18f\x15\x13\xba\xf1\xda\x1a\xd6N3\bz\xf5\xb45\xce5Y\x13\xf1\xdcbDC\xf2\x1c\xc0\xca9!Q7\x00Omَ\x03'\x8c5\xf3%\x00\xdd\U000a6926ÏTB7\\\xa1خ}s\xbd\xe7պ\xc7%\xdb\xe6G\rZ\xf3\xb2\xd9+叀9\xab\xf5\x9ar[BYPn\xe3נ\xfc>\x98\x1a\x8cg\xea\xf7\f\n'\xddm\x91r\xb7\x95\x85\x98\x8a\x1a\x01\x94:N\xb1hy\x8c\v\x86r\xa91\xefC\n\x1a\x95\xba\xa5\xf4\xcdG\x96\xa8$\x14\x037\xcak\xec{\x88Y:\x9bRm\x95$J\xb7\xd6\xc6/\xe5\xd0.-5ʕ\x03e1Rl\xa3\xec!hμb>7\x05\xaf\xf5\x89\x1e\xb5\xaa\xc5\x19\x8c\x92\x9f\f;FڍJ-\xe9\xdcj\xf7V\x12\xb0\xd3M\x8b\xfe\xce9\x1et\xd6-m\xd7L\x84\u06dd\xa9\xa3!'%\xa7\xbf\xaf\xbe*=\xbeS\xa5\xafҩ}M\xc2\xf1F˖\x92\xa86\x1f\xad\r\xdaһ\x9a)\x85jz\xcb2\xfe\xe5R9\xdd\\&\xedZt\xd43\xeb\xdd[\xe1d\x1b\r^Y{q.\\>\x99\x900\xd9\x02\xaa\x1bIl\x94x8ԉ\x8c\xb5\tP\xe3\x92ԁ3*-\x15\xe4,\x19/\x9d\tUY\xcc\xe78z\xc0CLw\x8dc\xf4瑶\x8eBe`S\x98 F\uf5de\x9e\x13e\t\xfc\x1d\xcb*\x93\b\t+\xa3\xbb\x81\xa0\xcd\xcd\xd7rY\x0f\xf4\x109\x16\xfdKYl\x82\xad\x9b\x86\x1a\x9c\x95\x84t\x81\xb9\x16{][\xaf\xce̬\x89Z>\xf9B\f\xd5ߧe}\xd2\xd1,rp\x04()\xc7@U-\xa1\x9e\xad\x9e\x87\xfd\x89\xf6\x9fǺ\xec\x1f\xf7}wu^\xe1\xbei\x11:\x95\x05\xb8\x82G\xb3\x11\xf9\xfa\xa6\xcc\xedU\x06\r\x9a\xbbVj{{\xd4\x11g7\x81ӕ\x10P\xf6\x00_\xad\x93\x86}\xe1\xd0Y]\x82\x10\x8e\x16\x88Ǣ\u007f\xcajt\x00x1!;\xfe\x91\xa5R3\xe5\xd6@\x80\x86Z\x03\xf1\xda.+\x0f\xa8\xa8\xad\x045\xa8\xc2\x16\xb4D\xa1\xaf\x96\x846\x91Y~3*\xa2\xcaO\xc0\xfb\x0fx\xd7\xceI\xab\x01w\x9bb@\xc7\rj\x01\x1cm\xf0\xdan\xb9\xa8\x82\xf0\xbd\x19Jn)\xbaߓ\x916r\xd2P2\x1bri\xa2\xa8\x8a=oA\xc6Y\xba\xb3\t\x01ڏ\x03L3\r\xdf\xc3/\xfdżd\xc2{\xeb\t\bG\x85?C\f\x9amQ\xb1T\xb6\xca\xd9\xed,\x87\xa2\x90\xafP\a?C\x9cg\xfbG1\xfe\x19\xe2\xf0{\x8cA\xf4<\xe8\xa5&u\xba(\xddzj\xbbƥ\x05x\xf0vn2\xa3\xb48\xf9\xa0X^\xc9\x00\x06\x05\xd8ԛ\xf1,*F\xfaN\xcc\xc5\x0f'\xe7<\x17\xcdF\x8d]\x95}\x0e\v\xe9\xc0$\x04N\x83\xc3f\xa8\xeeT`L\xe4\x9bp3b\x84\x98\xa3\x97\xc1\xa9\x83\x16\x18P\xa9I)Y\x13\xb9GR\xdc\x03\x9e%\a$\xa2M\xa3O\xe3\x03\x04B\xa6\x81\xb4\xacy\xfa\xe2\x13\xd3PWdJD\f]єo\xe8Aȗ-jx\x9f\xae\x148k\xf7\xa9\xed8)\bhz\x91\xc0X\xdcJQ\x19\x9eh\xad7H/7O\xef\xcdcs?!\xb0g\xd9\xf9.\a\xf3w\x88\n8t\u007f\xbd\a\x1c;\xd0\bq\x82\x04`\xe2\xect\x04m\xc25a\xe5<\x81\xc0\x01\x17u\xe1\x87y\xf5!\u007f\xa0YȣLԝ\x02\xaf`\xe8aj :IJ\x95\xe0\xd3uG\b'\x10\x19\x01\x13\x10\x925P\xe7+U\xa1\x90g߇\xc3d\f\xb8Q\x1a\x16\xe2&\xc3\xe8x\xb4\x13\xbc\xd9݆\xbcL!I\x90\v\xe5v\x05\x81\x9a\x06!v\x86o\xc58\xb6\xc1\x9a\xb0D\x8a5\xd8\x17\b\x12\x8aO3\xc0\x139\xbf@\x9aj)n\x9c\x9e\xd3)\xa7\x11?\xcd\xd8/e\x82\x01:\xef\x03Wd\xe4\xddJ\x84\xeb\x954W\x00\x15\x96\xb5\xec\n\x87\x81\xb1J\xe85\xd8\a\x8em@+\xc6(.\x97]\x86n\x03'kr<\x02\x97\xd6\x05\x97!\x86\xceR\xb2\xc2hBa\x0eJ\xbc\x83\xb0P\x1f\x1c\x02\xf4\xd2kgڥo\xad.fE\x16`\x01\xa2@\b\x03m\r\x8b$ۈ\x90\xb6\x00P\agZ)\xc1ᬣП}F*\x95&\xb9s\x8c\xd3\xef\xda\x04j\xb3.$O\x99\x88\x9a\b\x1b\x04ǻ\xf8\x88H#\xdd\xec+T\xca-\"\xa7\x96\xc2Z\x17\x91Z\x1c4=\xafeA\xbd\bM\x01R\x10y2\xb0\xfac@\x10\xcb\\\xb1i\xa4+\xab\xf7J\xa6\xc5KD\x01^\xed\xc0х!\xd03\x9dzb\xd2\x00-%\b \x18\f\x8f\xc5\x1d\xb2\xc1\xac9`,J9ܞ\xa9\x9dC}\x00~[ \xb5uzS\xd0@q\xd2gv\x18\x8e\x89\xaaǗT]H\xab#\x17h\x8c4iO\x9a\nѽ_AҨI\x10!\x15\xe2\xc3I\xc3$\x84\x1e\x82i\x1d)q\x9bç4d\x05u\x99\xf3HȸNR\xb6\xa2\xb6@Bi.*\xe6\f\xcd=\x00\x12\xcd\xea\x19\x89\nBw\xeeq\xc9(\xa4\x04v2\x96\xeb\xdc<6\xf5[\xab\xd1\xf0\x1c2ύU\xdag\xf7\xc7Θ\x1a\xa5\xdeе\rT\x8e\x94.\x1c]Ad\x8a\x91L\x00<\x80\x03̏\xd2\xe9\xc0\x008\xce\xda:'S>'\x91\b\x04\xf4\xe3\xbb\xde\xc3E\xa9_7\x19\xb5\xc2\xe7ę\xb43'4Bv\xaa\xa0\xfbW\a\x85a\xf7\r0*8IY҃\x80\x92\xb0F迺\xc0܁@p\xf7\xe5e\r\x0eXZg\xc0\xc7\u05f5\x85\xb9\x9cIT\xe6*>\xea\x19ؖ\x8b\x02\xee\x9e1$\r\x96`^RǕ\xd1B٥\xc1\x85E\x88\x04\xe4\xc1(\xb0\xce\x19\x9dXԛ\x17\x90\xeeQ\x86f#27g\xec\x13D\xe0\"[Q5\xa0\xd4E$\xd8I\x1aH\x19\xf1\x00\x90\xb1\xb4\xb1\xa1\xc8\x06\xbcD\v\xa3R\xae\xc39\xc0\x14i\u05cb\xed\x99\xce@3\xc7\t%\x013\tUM\n\xa1\"\xbao\x85\v\xaa\xa2c\xf0AT.\xba\xecӺ$\xf9\x9eU\x1a\xba\xee\xb5\xed\x1d\x8b\x14 1_\x18\xe8y\b\x1e}\x00\xe9\xa3Q\xf0f6\x83\xa20\xf7\x82\xe75\xd3K\xbeS\xc2\xca\x01o \x8c\x96\xa7lg\xbc^a\x15\xe7\xf5\xda\tt\x9c\xfaT\n*\x11\x9e\x03J\xfd/\x94?\xb2\x937\xe8\xb7\x0e?\xf3n\xa2w\xf9\xac\xeb\xeb@$#\xcd\a\x8e\x80\xb8\xb0\"\x04\r\x0e\x83\x8dT'Q\x85\xc7\a\x92\aH\r\xa7\x1aZ\xc1P\xc0\x04\xa8O$\xdb\x10\xaa\x8e&@\x10S\xb4\a)H=6\"x\xf7\xa0l\xf2\xc5q\xce\xd2W?\f\xd6\xc0ߌ\x0e+\x81\xe6\xf4m\x1cW\xea\x0e\xdb \xc8\x1f\xcb\xecU\xd9*'\x02\b\x8a\r\xb4\xce\x1a\xa1/PM\xf1\v\xe0\x01\xe8(\bc\r\xa0 \xfc\x01^\xe0+\xb1\xa5\x91\xb2\x85\xc8\x00\x84\xe9h\xa6\xae`\x06\x96\x98IP7ךo2\x81\x1b\x1bi\v\xd9;i\x83\xb3\x94J0j4\xe2y:\xda\x02\x9c\xda\x181\x9aj\"L\xe1kŲ\xa0\v\xe1ȋ\x9f@\x93V:\ada\xf63A\xa1\xb5Ŗ\xc9&Z.\x88\x10\t\x1c\xb1\xeb\x1f\x8a\x1co1Yy\x02ߡ\b\xc0\x8d\x0e\xc0듺\xb8\xa3\xa2\xeb\xac\x18z\xa2a\xd5Xe\x06\xdb6\xde\x16\xfa\n,\U000e913aWd\xd2\xc2h\xbe\x93\xe0\x16H{\x02\x89@\\s\xdf5#\x8c\xc9\xec\x14\xbb\b\x19\x03F\x84\xa9\xb8Aanu\x06,#\xc3`p\xa8a\xb0\xac\x0eW{J\x92\xc0\xbe\xb3bB\xb8\x03S\b\x04C\x8e\xea\xa5\xea2$\x8d\x14t}lr\t\xd59h\x9c\x80\xa2f\xb2\twA\x01B\xb9\xf8H\xd70u\xd0\xf6έ\aӏ{\xfc\xe0~\xc9\xe6D\x8fD6\x01\xe3\xd1!\x11\x83\x0f6\xd5\x16\n\x03\x1fR\x8e\x8f<\x19\x80\xa2\xecQ\xf0,k\xeal\x06\xf0RFB\x85*\xa5\xd9#\xc1'ep\x87\x92Le\xf5D\x95\xfa\x86z\x0361\xb9s\x85\x8e\x0fk\xb9\x90\xb07\x19\xde\a@\xf9\xb5\x18\xdd\x1f\xb6W\xfb\xf0\x8fwɣ\af\xe3\x10\x17~+>\xa40\xfaeUk\x9d\xaen::\xb2\fY5\xef\xc1\x81݃t\x84S\bhG\x95a\xc8`S\x85\xa5\xa0\xa33h\aI\xb1a\xea\xe6\x80j\xb8\x87\xb6\x0e\xfb\x97S\xa8~\xa6\x8b\xce\x01\x930\xd7\n\nb\x18\x80)8\xb3Ȋ\xbe\xbdFy\xa2\x00a\x11+H\xf1\xe42&\x1d\x87\x1d\x01\x98\x91%\xca\xfc\xe8\x02\xf4m\x9f\xd3\xf6s%q\xce\xf6\xed|\x8c\xb7w\xfe|\xab\x81\xcbF\xf8t\xfe\xd9?:?\xbf\xce'X\xaf\xf3\x81\x1f)Tt\xb9o\xe3\xa89v\"-ѣ\x04\x04xCb\x01f\xf0?\xc6\x0f\x81\xb8\xf14\x84\x01`sG\xa3\xcc\b\x94o\xbeO\xe0\xfb\f|\x12\x91=Qo\x8e(\x87\xd1qNp\x1a\xfe\xa6\xa4\xe5+\xb9\xbeK\x14\x10}h\x81\a\x9f\xc7\x05\xef\x83\xcf\bB\x00\x9f\xf9c\xadb%\x95-\x8e\r\xd7\xd9\x01\xf7\x93\f\xe9\xb4Y!\xe5\xb1CཟP\x113\xf8|\x80\xc5\x00|\x02}p\xc8x\a\x88\xa0:P\xfaȮ_\xb0\xcd\xe8\xecY;6\xf3i \xe8\xb5\x1b \xe1H\xcb\b\x01B\xa1\xa0\xea\xa5\xf7\xf1\x10\xb4\xd5\v&~{\x12̈9#7\xb4\xd0\xf6\xbd\x13\xd6\xd1&\xca\b\xf7OА\xde\a\xdd\t\xcd/f\x18\x9c\xfaK'\xddP\x00\x16s\xba\x8e\xf6\xba\x03\xf6\xd2\xc2U.\xb7\xf6\xacՕ\x00\xa1\xe1/u-\t\x02E*\x8e\x05\xd22\f\xa3\bv\xb2g\x13/Z0\x8c\x04\xdc\x1fx\xe0z\x11\xf1H, \a\x9a\x82\xed*H\b\\P\a\xeb\"-\x9d\xbf\xdf\x04q\u07be\x15XR`\x864'2\xdb0\xaaP \x13\x84\xd9\xc4\xd1x8\x9c\"\x98\x840:\xd3\xfa\xe6dq. t\xb0\x19\x97\xe8L\xc0\x01\xeaj\xb4\x90P\xd2\x15J\xa4Y\xefj\x98m\x19\xf5\v\xb9\xe4\xbah\x1a\x842\x01oݷ\x84\xb4\x1eo\xeb$\xa8\xf4u\xa3了x\a\x01\xc3\xdb\xf3S\xb7c\x95\x86\x1dĎ\xc5\x19\x02\x16\xb0M]\xb1V\x02V\xe2\xd43ԋ\xbc&_\x00\x02\x15\xe2@\xb3_\xb1:Р\x8f\xb0\xc4\x11\xa4A\x83;\xd2\xc4\tg\xb0\"\xec`\x9cȀ\xeeU(\xe0\x10\xa4\x15\xcb{\xa0\xa0\x1d\xfa@\xc7\x0e\xc2\x0e\xfe\x820x;\xf0\x88\x81'kơ\xe6\xa9\x1b\xb1u\xbdƼ\xac\xa0\xe8CJ]A:\xe6\xccMu\xc9u\x8f8Q\x16T\x00 \a\x11\xf9\x9d\xac\x04\x94O\x02\xd8\xfb\x00\x1d+ԉ\x10&\xeb\xba\xf1\xad6\xea\x89\x02Hc\xcdX\x98r\x82w\xcfd\xea\x9b\xf6q=K\xb5;\x8c\xc8La\xf8#\xb2I\x00\x15\xe1GO54\xea\xc1\xa9\xe3]$\xa2\xf4\x94097\xb6C4\xe0\xaeJ\xf3\xd2p\x86~Z\x89\xd6\xe8\fx\x11*\xc1\x1a\xfc@\xbc\xca\x16\xc8N\xd0y=c\xa5\xd1$\xd0\f\xf0\x04- \x9cm\xc1YL\b\xbdb\v\x05\x86\x9dC\x93\x81\xb2Xgr2iZ\x8c\xebd4ԪuP\xff\x10*\xa2\x06 $\"N`\xb7\x0f\x990\xbcm\x16\x14\x1f\xb2\xc5E\x8bo\xaf\xfaV\xed\xd02\xb8X\xf0\xb4\aʺ,k/J\x16\x15\xec\xba/\xc6\x1fʏw\xf0\xf7\x00S\xa9\xfc\x8c\xcc\x17\x02r\xe6>\x12+.\x8e\x11#X\x8d͎\x81\xba>\xcf?b_\x1b\xb6\x15\xb9\x83@\x8a \xcavq\xdbR\x81\xbb\xba\xe3\x1c\x19\"\xb6\xd0JB{B\xf1\t\x01\x0e\xf2!c\xbc\xf3\x02\xba\rYi\xdb7\xa32\xca\xfcc \x0f\x9e&~\rd\x02\f\xb2\x1bH\x87\xb5\xe6\xc2;\x15\x92\xbc.\x91\xcf÷_'P\x10\xd0\an\xa7q\x81\xa9\x00؊7\x83\x89|\v\x1aJ\x1c\x8f\xa5\xe1\x1eO\x1d}\x06\x12\x13*q\xba\x82R@'\x85q\x18\x9e\xfa\t\x17M\u007f\x88\xeb\xd3\x06 \x8f?\xb6q\xb9DJX<\xcb!q\xbeU\x8bZ(\x18\xf0\xde8'\x0e\xa8\x05\x10\xa2e\xe2s%\x95\xa6\xf5\x93\x98\tPES\xc3@@\xb5s7\xfe\xf9\xedւMАo:\xfb>%\xcd!\x02\xf8\x91\x97\x04i\x02]˷v[Q\xdfaGB\x15\xd0\x13N\x96\xe53*\x00\xa7\xbeC\x10\x80\xeb<\x14\xcc\x1a\xee\x82;\xee\x87\xecf\x14M\x04\x11P\x92\xcdm\xfd\x93y\xe1\xab\xf3>D\xeb\xcdC\x91\xf5↧T\x1a\x18\xfd\xb91\xc69E\xfa\x16%&\x0e\xb0\xe0\xeb\x81{1y\\\n\xde!\xa1;昬\xa25L J0\x8cT\xc9\x13\xfb\x8bz4:\x03c\xef\n\xedR\x94\x10\xbfW\x16e\n3\xf68\xffH\xc8\xe1\xcbȗ\xa2\x80R)\xff4\xdc\x1d\xa5r \xeb\xe2\xba\x1eE\x8f\xb1q#\xb3\x1d\x8d|o{`|\xdbj.\x97\xa0\x12V\xa0-\x909\x86@\xd9\xc0\x1c1\xad\xf2\x9e\xf9\u061c\xbe\xc7M\x81Xʜ\x81\xc7D\x9a\xa2\x15\x14\\\x98\xb8D\xe9\xa3!\xf3\xecU\xf5\x84[Аp>\xdeh\xf9,t\r\xf8\xcb\x13\xd4\xfa{&K\xabe.\x81\x17\xb1\x84\x14\r\x18L\xf8\xd0\xe8\xbe9}\t\x8a\r\xdfE\x00\xees\x98~\x174\x03I\x84O}\x19\x87^\a{\xab\x14\xc2\x0fi\xb0\x12J\x17S\x8cŴ\xe7\x06\xb0&\xaf#\x01H\xe7W\x8c\x12\xbe\x9f\x96pc\x9d\xdc\x1a\x9e\xf8\xb1\xed8́\xe1\xaf@\xb8\xef!\x81\xd9\x15D\xf5\xa7'H&\\\r\x85\xc1\xfc\\Y\"\x19\x9e\xa9\xde= \xee\f\xe9\xef\x8eם\xddSd\xe3\xdd\x10w'\x9b\xd1i>oZ\x94\r:\x1f\b\xa6'\x85E'\x8a\x13\xdd\x05\xf1m\x94\xea\f\x88\xaf:\xe2\xef\xd5\xe1O\x9d\xb6}<˹(\xab\xa5\t\xb7L\x02\xa8\xb5\xe2Hל>4\xed\x97D&\x1b*]H\x9d\xa1\xcf\U00052e2a\xed\x9by\xc8id\xe5v\x8fS\xdcr\x8f\x06T\x15\xac\x18\xeaaE\xa4\x9eo]\xd7'\xa1\xa3\x8b]\xb0-`\xffQ0۷\xe3]\xcb8\xa32k\x14\xe3\x06<\xa0\x0e\xc9\x05\x15\xe1\x82\x16\x11\x9c\xb7\xa9\xbb/\x02\xe6\xc4}hZP\x0e\xbe\xb5\x13\x80V߃\xc6= \x90\v<t|\xe2\xc4B?\n\xee)\xb7\xf1\xc9\xd6\xf0M\x06\xc1d[i\xcd%NGAB\xf7\x19\x89\x16\x10\xbeo\x97Y=\x1eZI\v\xa1\xaa\xe32\xd1\\Q\x1c\xd0d\xf1\xe78\x94\x17\xfa\xb1\xe0iy\xf5\x05t{K\xf8\u007f\x81HP<:\xdb\xcd\xf6j\xeej\xa3cҦ\xdf\x1ch\xe97.\xa0\xcdi\x9c{ڎ\xcd7\x16\xa1\x00\n\xb3\xb9b%?\x04$\xf9㿴}\xcf?7:\x05\x81\xa2o\xdb[_[\xa6\xe3\x9ao\xf9օ\x12(\xdf\xd4uV\xf1\x94bj~Y\x02@\xffvt\x17\x8a\r\x87\x87̋\x86k\x86\xaf+\x96\x1e\xf9Ȫ\x85\xa6\\\x0e\xb28\xc6\xe5[O\v\x93\x91\x98\xec\x82\u05c9>֮\x01D\xdfx\x86\u007f\x0fh'v\xb2\xe9\x1ak\x10NoN/\x80e\xfde&\xf878̥\xe9\xb4\xee\xfbl\xfe\f\xf2ž\xb9\x0f\xc4T\xe1\xcd\xd0[0\xfd\xdb\x1e\x04z}3\xcf\xf7\x04\t\x1c\x90O*\xdc6!}\xe0x\xcf\x18\xa0\x80\x9d\xbf\x12\xa8\xbd\xf1W\x89\xe3\xec\xe8\xd5\xc1}\x19\a\x10É\xd2m\xb1\xb9-\xc8T\x00'\xa3\xbeNȎ\x8e`5Ғ$g\xbaƟ\x9fP\xd6ۣ\x9b\x88\xbfk\xa9\xc5?#:Ƿ\xa5\xfd\x12\xc67\u007f2=\xb0\x90\xef\x12f\x04\x9a\xa9\xdbLDO\x17\xa7\xa9\xc1`\xf5|\x9f\x11f\u007ff\xbb\xbf\xcf\bqm\b\x94\xf7\x880O\xaf\x1bD\x17\xf6\xdd2\xaa\xb6\x8a\xc1\x1cR\xae?\xb8\xb4\xe3\xffÅ5D\xcb\xfe\xb0\x9a3\x16\xe2`\xe4h+\x92\xa3\xd3\xd559Ȉ\x99f\x89/\x86\x94 \xaa\x19\aə\xa8\x88\x04Ƞ / \a\x9d\x12\x04\xdf_\x024(a\x9d\xa4\xae\xfbæ\xb3\x9f\xa9\xa0d\xde\xc3\xd4\r3\xa6\xb7\x8d\x00\x8c\xd0\xf9\xfe4\x14ҏ\ba\"\x0e^\xaf\x93\xfe*\xfe˵Ai\xe2\xd2\uf6c2[\x91~\xaeL\xab\xb9\x9cˀ\x104\xd5I.\xb0Dw\x95\x05\r\xbaO\xc3a\xe6\xf7TK\xdcO\xa0\x1c\xfcY\x02\x99\x00\x9f\\\x1b\x17=\xfe?\x10\xf8\xc4H\xd0\xfa\xd81\xe7P\xea\x9ae\x9d\v\u05f9\xe2\xc1/\x91 u\x9f\x01\x96\x82V2\vi\xae\xf0\x18\xda۟yE\v\xf7\xc1\xf8\xdbQ\x02\xb8\xd0(ͷ\x8e\xf4B#\a|\x8e\xe8k\xb4=`w\xfdA\t5\xb0\x81Q\xf1ǋ\x93\xf1P\x06\\Z \xa9\x80\xb9\xc6l\xa3\x1c\xfbcq\xe0\xb2\xc3\x1fm$\x14\x16F\x8c\x92꿦j\xa0\xbe\xc0\xbc\x8a\x13\x98\xbe\x81[0ʤ*\xa0\xaepg\x95\x0es\x1b\xef\x1bM\xe4\a\xfev\x192J\x81\xb0\x11\x10Y\x84Rfz\xb0\t\xcb\xdbϩ\\\xe0\xb2&\x82\x02[FE\xfa\xa5\x018\x13ͳ5R[\x04\xa1\xfc%\xd8\rh\v\xd0j\uef10N\x14/\xeax\x05fH\xd5m߾\a\xf0\x04˕\xeeq]\xee{-\x00{B(\x01*\xc5E\xa9\xd1\xdd\b\x0e\xa0\x19^\xe3_wqqu\x86\xff\x05\xa4\x14\xb1p\x8b1\xeae\x00\x00\x01\x85iCCPICC profile\x00\x00(\x91}\x91=H\xc3P\x14\x85OS\xa5*\x15\a;\x888\x04\xa9N\x16EE\x1c\xb5\nE\xa8\x10j\x85V\x1dL^\xfa#4iHR\\\x1c\x05ׂ\x83?\x8bU\a\x17g]\x1d\\\x05A\xf0\a\xc4\xc9\xd1I\xd1EJ\xbc/)\xb4\x88\xf1\xc1\xe5}\x9c\xf7\xce\xe1\xbe\xfb\x00\xa1Vb\x9a\xd56\x06h\xbam\xa6\x12q1\x93]\x11C\xaf\xe8D\x88j\x14\x832\xb3\x8cYIJ\xc2w}\xdd#\xc0\xf7\xbb\x18\xcf\xf2\xbf\xf7\xe7\xeaVs\x16\x03\x02\"\xf1\f3L\x9bx\x9dxj\xd368\xef\x13GXQV\x89ωGLj\x90\xf8\x91\xeb\x8a\xc7o\x9c\v.\v<3b\xa6Ss\xc4\x11b\xb1\xd0\xc2J\v\xb3\xa2\xa9\x11O\x12GUM\xa7|!\xe3\xb1\xcay\x8b\xb3V\xaa\xb0F\x9f\xfc\x85\u173e\xbc\xc4u\xaa\x01$\xb0\x80EH\x10\xa1\xa0\x82\r\x94`#F\xbbN\x8a\x85\x14\x9d\xc7}\xfc\xfd\xae_\"\x97B\xae\r0ṛ\f\r\xb2\xeb\a\xff\x83߳\xb5\xf2\x13\xe3^R8\x0e\xb4\xbf8\xce\xc7\x10\x10\xda\x05\xeaU\xc7\xf9>v\x9c\xfa\t\x10|\x06\xae\xf4\xa6\xbf\\\x03\xa6?I\xaf6\xb5\xe8\x11г\r\\\\75e\x0f\xb8\xdc\x01\xfa\x9e\fٔ])H%\xe4\xf3\xc0\xfb\x19}S\x16\xe8\xbd\x05\xbaV\xbd\xb95\xceq\xfa\x00\xa4iV\xc9\x1b\xe0\xe0\x10\x18.P\xf6\x9aϻ;Z\xe7\xf6\xef\x9d\xc6\xfc~\x00r\xd5r\xa7\xe6\xc7AK\x00\x00\x00\x06bKGD\x00\x00\x00\x00\x00\x00\xf9C\xbb\u007f\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe4\t\a\r).A\x8e\xa8\x8b\x00\x00\x00\x19tEXtComment\x00Created with GIMPW\x81\x0e\x17\x00\x00\x01\x0fIDATH\xc7ݖQ\x0e\x83 \f\x86\xab\xe1\x8ax\x04z\xa6z\x04v\xc8\xeeA\xdd*\x94\xb6\xc6\xeca61\x9b\xc8\xc7\x0f\xe5\x97\n\xf0\xf0\x98\xb4\xc6\f\xc0\xf2\xfe5\xe8\xf7\x0fܤ\x01\xb5\xe9\xb48\xc2w\xb9QD\xb8*tL.\x030\xb7\x17\x013o\xff\xf3~i\\n\x19:\x8fcq\xaa\xee\x15=\x87\x9bM\x0f \x00\xac\xdfL\x8d\xa2{V\x00\x80|\x8bUɒ3\xa6\xf5\xccК\x8f\xac\x9c\x84\xa8Y\xe4\xc0\x1e\x9e\xc5\xe4\x84L\x8e\xe2\\\x05\xd8ZH_dm4Rש\x8c\x17\xa7\xf9]\x8d\xb5g\x87Ab\xd75\aE\xc6/{\x1b\xf5LR\xe1#Xܣ\xb389ѩiG\xc3f(~e\x82W\xe7Ց\xe3\x1b\tM\xa1\x8cEv\x03\x85X\xeb\b\x85_\xb4w\t/j\x92ؽHMQO\xd1\xe0i8b,\xceb\xc2\x1c\xdb\xf3LfV\xf1\\\xcfB\x81z-\xd4\xe2\xa3W.\xec\x9a\xe3\xac%R\xe8\xef\x14^\xed0\xfa\xd5\aB\x84{\xfc\xa7\xda\xe3\xe3\r'\x9e\xf3\xdbVK\x9b\xf9\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var Enemy_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x80\x00\x00\x00@\b\x06\x00\x00\x00\xd2\xd6\u007f\u007f\x00\x00\x01\x85iCCPICC profile\x00\x00(\x91}\x91=H\xc3P\x14\x85OS\xa5*\x15\a;\x888\x04\xa9N\x16EE\x1c\xb5\nE\xa8\x10j\x85V\x1dL^\xfa#4iHR\\\x1c\x05ׂ\x83?\x8bU\a\x17g]\x1d\\\x05A\xf0\a\xc4\xc9\xd1I\xd1EJ\xbc/)\xb4\x88\xf1\xc1\xe5}\x9c\xf7\xce\xe1\xbe\xfb\x00\xa1Vb\x9a\xd56\x06h\xbam\xa6\x12q1\x93]\x11C\xaf\xe8D\x88j\x14\x832\xb3\x8cYIJ\xc2w}\xdd#\xc0\xf7\xbb\x18\xcf\xf2\xbf\xf7\xe7\xeaVs\x16\x03\x02\"\xf1\f3L\x9bx\x9dxj\xd368\xef\x13GXQV\x89ωGLj\x90\xf8\x91\xeb\x8a\xc7o\x9c\v.\v<3b\xa6Ss\xc4\x11b\xb1\xd0\xc2J\v\xb3\xa2\xa9\x11O\x12GUM\xa7|!\xe3\xb1\xcay\x8b\xb3V\xaa\xb0F\x9f\xfc\x85\u173e\xbc\xc4u\xaa\x01$\xb0\x80EH\x10\xa1\xa0\x82\r\x94`#F\xbbN\x8a\x85\x14\x9d\xc7}\xfc\xfd\xae_\"\x97B\xae\r0ṛ\f\r\xb2\xeb\a\xff\x83߳\xb5\xf2\x13\xe3^R8\x0e\xb4\xbf8\xce\xc7\x10\x10\xda\x05\xeaU\xc7\xf9>v\x9c\xfa\t\x10|\x06\xae\xf4\xa6\xbf\\\x03\xa6?I\xaf6\xb5\xe8\x11г\r\\\\75e\x0f\xb8\xdc\x01\xfa\x9e\fٔ])H%\xe4\xf3\xc0\xfb\x19}S\x16\xe8\xbd\x05\xbaV\xbd\xb95\xceq\xfa\x00\xa4iV\xc9\x1b\xe0\xe0\x10\x18.P\xf6\x9aϻ;Z\xe7\xf6\xef\x9d\xc6\xfc~\x00r\xd5r\xa7\xe6\xc7AK\x00\x00\x00\x06bKGD\x00\xff\x00\x99\x00\x00\xc1\xe5\xff\x8c\x00\x00\x00\tpHYs\x00\x00.#\x00\x00.#\x01x\xa5?v\x00\x00\x00\atIME\a\xe4\t\a\x0e\x19:\x86T\xf4\\\x00\x00\x00\x19tEXtComment\x00Created with GIMPW\x81\x0e\x17\x00\x00\x1b5IDATx\xda\xed\x9b{\x90dW}\xdf?\xe7ܾ\xb7\xdf\uf79e\x99\x9eWϮ\xf6!\xedJ\xda\x15z\xb2B\xacx(\x02\f\x12\x06l\x88\x12# \x0e2\x95\xa4H9N\x94\x14I\xadS)[\x89]\x01\xec\xd8Qb\x84e\v\xb0\xca6X\x85\x8d\x11\x04\x9bA\x02\x83\xd6B\xcfE\xdaվz\xa6g\xa6\xa7\xbb\xa7\xa7\x1f\xd3\xef\xdb\xf7\x9e\xfc\xd1wV\xb3\xab\xd5\xee\xcc\xee\xac\x1e\xd4\xfc\xaa\xba\xba\xef\xf3w\xce\xef|\u007f\xefӰI\x9b\xb4I\x9b\xb4I\x9b\xb4I\x9b\xb4I\x9b\xb4I\x9b\xb4I\x9b\xf4栝\xf7\xa4\xd5\xea\xefך\xef\x1bM\x0e\xaf+\xc3\xd7b\x10\xe7\xe3\xf1F[\x987\xc3B\x8b\xf50?|\u007fF\xbc\xda`V\xae\x9dy\xdfk%\x8c3\xc7v)ư\xfa\xbd\xe7\xe2\xffz\x80\xe1B\xf9\xbb\xd6z\xe3\x99\x13\xbf\xe7\v\xf7\x9d\xba6\xb5\xf0(0\xa5.\x95 \xce&\xf8s\xf1\xbfT\x8bq\xf8\xfe\x8cX\v\xffK\xa9\x04\x1b-\u007f\xb1^\xe6g2\xde?t\xfbi\xf7\xdc\xff\xd9{/\x99\x10\xd6\xc3\xffR\x00\xf0\xf5\xe2\u007f)\xe7/\xd7˼\x8f\xb6>\xadf\xber\xfe\x9e/\xdcǥ\\\xfc\xb5\xf2\xbf\x14\x9a\xbf^\xfe\x1b9\x8eK5\u007f\xd7z\xcc/\xc0\xe1\xfb\xa68\xcc\x14\xab\xcd\xd0\xfd\x9f\xbd\xb7\u007f\x8d\xa9\xd3Lӥ\x00\xc2z\xf8o\xa4\xeb\xb9\x10\xfe\x97\xc2\x12l\xb4\xfc\xc5z\xd0w\xffg\xef\xe5\x99ʞS\xe7\xf7D\x9e\x01xŹ\x95{7J\x00\x17\xca\u007f\xa3\x16\xe1\xcd:\xff\xb5\xf0w]\xcc\xc0V3~=R\x9f\xf3\xf1\xbfԑ\xf9k1\xff\x8b\xe5\u007f>+ /\x94\xb9\xe7\xe1G\xce\xfa{\xb5?\xda(\xff{\xa1\xfc/e]൘\xff\xb9ƿV\xfe\xe7S\x82u\x01\xe0\x9e/\xdcw\xca\xec\xb4?z'{\"ϰ'\xf2\f\xed\x8f\xdey\x9a\xf9932\xdd(Z/\xff\x8d\xb6\x00\xeb\xe5\u007f\xb1\x00<s\xfc\xaf\x8b\xfcWG\xb4_h?\xac\xbe\xd0~X\xed\xbc'\xad\xcew|)\xa2\xe0\xf5\xf2\xdfhW\xf4\xf3\xc8ߵ\x1e\x14\xae\xe4\x9d;\xef\xdd\u007f\x1a\xca^Y\x94\xd8X\x1f\xbc2\xa1\xf5\xf2\xdfh\xba\x10\xfe\x17\x9b\t\xad~\xfeR\xcc_\xae\xc7\x1c\x1d\xbeo\xeaU\xf3ϕ߇\xef\x9b:\xadb\xb6Qq\xc0z\xf9_\x8a*\xe0\x85\xf0\xbfر\xac<\u007f\xa9\xe6/\u058b³\x95\"O\xcbE/q\xe4\xbd\x16\xfe\x1b]\u007fx\xbd\xe7\u007f!\xfc\xd7*\x03q\xb1\vq&R_\x8bF\xcc\x1b\x95\xffk\xd1\b[]\x96~-S\xde\xf3\xa6'\xafW+\xf6\xe7\xbd\x05\xbc)\x93MڤMڤMڤMڤMڤMڤMڤMz\xcd)\x9dN\u007f \x9dN\u007f\xe9l\xd7\x0e\xa4\xf9\xc3\x03i>\xbc)\xa57\x1f\xadyG\xd0\xc7io\x05\xde\xfd\x9bg\xb96\x16\xe6\x9d@\xf6@\x9a\x04p\a\xf0\xf0\x81\f\x8d\xd7h\x0e7\x03\x19`\xd69\xde\x02\x04\x80\xe76\x97w\x03\x01p+\v(\x90\x0f\xa6'c\x93\xa8\xdaT&ӛJ\xa3a\x12&\x8aֳ\xf1-ԹJ\b\xbe\xb4lG\x0fޙ\x0e\xcfW\xa07\x95\xc9T7p\xbca`\x10\xf0\f\r\r\x8dy\xdc\xee\U0010ddbd\xaf!D\xad&e5\x03'lˊضm\xcd\xcd\xcdi\xc0q\xa0\xb6\x81\xfc\x05\xb0\x15\xb0\x80E\xfa\xddT\x1d\xb0\x9ds\xc2\xf9,\x03\xbd\x9f+\x00\x00\xb41F'P\xa5.\xec\x01\x9eEq\x19.\x0eӃ\xf9&\x9f\xf3\xeb|n\xef\x10|c.\xf8\x9c\xb2\x01x\fx\xfbE\xb8\x1d\x1f\xa0\v!D\xa1P\xe8\x01))\xe5\xd5J\xa9@@\xca=>\xb8.\xa2\x89\xeb\x15\x82&X\x06\xfc\xc4\xd24,)k~\xbf\xbf\xe1v\xbb[\xc1`К\x9e\x9e\xde(k\xa4\x01\xb7\x00m\xe0\t\xc0\x00B@\xc79\xa79\x9f\xe3?W\x00x0\xcd\xff\xceA\xa5\x83\xefC\xc0\xd7\u007f\x9d\xe2\x9f\xfcV\x9a\xba\x1e\xc1\x97\xd8\x0e\x8c\f\xe3.\xb6(\xcfWPC\xf0\xce\xe2\"%\xd3\xf3\xc5?!\xf4\xe4\xfet\xfaG\xc0{/\xd0\x12|\x8a>\xd8\xc2\xc0\xcf\xfc~\xff\x95>\x9f\xef\x838j\x98\x02nd\x9a,\x03\x1cïY\xb0\xaf\fT\x85```\xe0=J\xa9\xef\x00\xdf\x01>\u007f\x112J\x02\x1e\xc0\xd04MO$\x12CJ\xa9f\xa1PX\xd1\xfe\xa0\x03\x04\x17\xe0\x05\xdc@\xdd\xf9L:\x16\xe1\t@\xbdi\x00\xb0?\x9d\xfep\v\x86\xdcB5?\xa9\xa6c\xa1\b\xb7\x1b6ٰݴ\xefH\x19\fźWk\xcb`\xfb4\xdc\x1f\x89\x80G':/Џ@}\xbaBh\xacIh\xb99\xf9\xafK\x1d\xf7\xb4\xcf\xffV\xc05\x95Y\xd3Xn\x05|^\xafכL&c\x83p\xa7\r[\x8aJ\xe9\xbbb\xb1+\xc2B\x84\x83\xc0!!J\xcbJy\x16\xc1_t$-\x1c߰\xcdyQ\x1e\xc8\n\xb1\xa7\b\xa1\x89\x89\x89D\xadV\xfbz\xb9\\\xce\x02\xc5\v\x00@\x04\b\n!t\xafכRJ\xd5\x1c\xf3o8\xf1\x86\xee\xb0\r\x03~gH~\xe0F\xc7\"\x1c|S\x00\xe0\xeetZ\x02[r\xf0oB\x9a\xba2\xa9[%\xddfk\xc0\x0f\x86MZ\xd0}ۍ\x13\x05\xe2q\xe8T\xa1\x13\xd4\xe1\xfa 4\xc1\xe35\xd0,\x8d\xceR\v&\x80\xd9\xde\a\x06\xdb\r\xe2\xbe\x06H\xb6<p5\xd1l\x95\xe6\x81\f\xf3\xe7\xf0\xaf\x83\xc1`pK\xdc\xe3\x19\x99\x841\x05Wِ\x18\x06\u009a62\x86\xcd8]\xd2\b\xbb\x80Tsh,\x10\xc4ą\xd7y\x89\x1f\xf0\xa0\b`b\xa3\x0f\x82p\x17\x85\x90\x1e\x8f\xe7X<\x1ew\x95J%\x13\xa8\xaeaAB\xce\xeb$\xd0\x05\x94eYv\xbb\xdd\xfe\xb1\x10B\x8c\x8d\x8d\xdd\xdc\xeb\xf5\xea\xb9\\.\xeb\xdc#\x9dx\xa3\x0e\x8c\x00\xfa\xf0\xf0\xf0;\x84\x10\xae|>\xff\xb7\x96e\xb5\x80\xe974\x00T\u007f\xc2/\r)\xc4\xde@\x9b=\xd1|\x04\xe5,M\x18\xb4\x01\x18M\xc1\\\x0e|~HN\xa8\xfe\xb5\xef\xf5\x1f\xd6\a4F>1\xdc\x17\xed\xa1\x1a\x1d\xb5D\xee 0\xc1A\x87ţ\xc0{^e,\n\x98\x8bF\xa3\xb7$4\xed\xed\x13Jm/\xacRA\x80+貗\x05P\f\xbc@\x9c?'\xc8O\x89\xb3ӹg\x1a(\x03Al\xaee\x1eA\n\x1dC+\n\xe13\f\xe3\x1dR\xca\x01\xc7D\xffx\r>\xda\xebh\xb4p\ued55R\xbd\x85\x85\x85\xa32\x18\xf4\x8f\xc5㷹\xfa\x99\xc7!\xc7Ex\x81\xa6\x13\fN\x00a]\xd7/\x17B\xe8\xc0\x90\x03\x8e\v\x01@\x18x\x9b\x94R\x8e\x8f\x8f_\xef\x9c[r\xc6\xf5\xfb\x99L\xa6\xbba\x00\xf8%r\x00vr\x0f\x9a\bX4\xdd\x1a\xa9kS\xf0\xe2\"\xa4=pe\x005\x02\xc9\x1c\xc8\x19\xe8\xcd\xd8\xe4\x1f\xc81(\x06q\xc5d_Of\x81\xbd@*\x00\xc3\x06\xb0@j\x04\xda\x12T\xf5\x9cZ\xa7\x01{l\xdbN)M\xf3\x03\xc4\x1d\xa9\xae\x00 \x10\xec\x1b\xe3g\xe6F9fKr\x80\x10\xe2\xa5\x0f\xaazb;\xb5X\xcay&\x80Bw\xd4\xdc\x06\xbf\xa1Ԏ\x17\xa5l/\xe9\xfa\x8b\xc0\x93\xce\"\x9d\x8f\xfc\xce\xeb\xea@\xfb\xe6\xf1\xf1\xff\xa0\xc1\xc0\x98\x10\xa6\x05Z\x16\xa2\xa6\xa6\x15\xe4\xc4Į^\xafwhnn\xee`*\x95گi\xda\ue578AJy\xc4\t\x10[\x8e\x15\xb9\x102\x9c\xd4V\x02\xfb\x9cs\xf3\xab\xac\xce\xc6Y\x00\x93\x0e\x00V\x1b\xbc\x1e\x88\x06\\\xb8|\x1a\xc4\u00a0k\xd0\xd3\xc0\x0f\xaea\xa0bB\xb5CP\x84\x90\x81&\f\xbaaT\xef\xbf\xc8\r\xf8%\xae]\x06\xb1\x9b\aП*\x11jا\x16\xf2l\x94H$$0\x19\x962\x19Rv L\x9b\x06nzH\n\x8e\xa3-X\x1a\xb4c\x84\xc3]&ڂ\u007f҂2\xde\xc02\xae\xde\x1c\xbe\xa5\"\"\x96\x18n\xe22z\x9c\x9c\x8e\x91C\xa3\x8b-\xb7\xd11\x16\xf0t\xcaB\xb6\x9c\xc5Xk\xc4o8\xbf-M\xca\x1d\x1a\x8ch\xab.Z\xe0\x15R\x96\xa4\x94\xf3\x80-\xa5LH)\xb7\n!\xc2N\xf6\xf2\x8c\x03 \xcbI\x15/\x84,\xa0\x04H˲\x9e\a\x94\xa6i\xd7\x00bqqq\xc2\xc9>\xf2\xce\xf7\x85\x03`*M\xa2'\xb9jэ\xa8\xd5\xc1\x18r\x11N9\x9e\xd5\xeb\xc1j\xdaXs&F\n\b\xba\xc0g!\xbd&\xa1-Q\xba\x99\x1c\x9aK\xa0\x85\xfc\x10\x8c\xd0-\xe5\xd1\xda\nm@\x12\xba\xc6\x0f\x992ޠ}\"\x04\x87\xefJo\x17\xdb\xe8^\a\x9c8\x90\xc9,\x9eR7\xbf_\x00[\x83B\f\x05\xb1\xc2Q\xf2\x98\xa4(`\xf0\x04p\xb3\x82\x99\x86\xce\xfc\xb2\xceoNf\x89K\x8bD\x13\xa6I\xa7\x8e\xe1Yx@xr㶊\x8d$\x15n?<\x94\tQ\x12\xb0\x97.o!\xcf\v\x8c.\x9e@\xae%\x13\x19\x04Ɯ\xe8\xde\xeb\x98w\x81RB\n\x18e\x01\x03\xc5\xcd@\t\u007f\xec\xdb*|KǶ\xad,\x14\n\x85BUJ\xf9x\"\x91خiZ\xb0\xd1h\xfcY\xb7ۭZ\x96\xd5uL\xf6\xb8\x03\xc0\xf5\x04\xa2\x1d\xe0\x98m\xdbd\xb3ٓ@orr\xf2_\x01\xaa\xdb\xed\xees\x16\xfe{\x17\r\x00\xe0=.\xf8ӡA\xe7hg\bn\b\xc1L\xff\xb09ݦ\xfaX\x81\xd1Y\xe0C\xa3\x10\xf2\xc05\x1e\xb8\x0e\x8a\xbf\v\xc1\xefBh1\f\xb7\xef\xa2\xf0\x87\v\x84\x8d6\xc1\xeb}\xa7^\x1e\xdd\xceo\xed\xfd*\x0f\xfc\xc7QS\xc3\xc5\xf7\x81_\x05\xbe\xb6RL\xb4\x16\x17/\xbbl`ཻ\xa9\xaaa*\x8c8\x0e\x15\xe0\x13\nn\xbc)\xcf\xc0e\x1e\xe2[\xc2\b\xc6`\xb9\x81*\x15\xf9\xf4W\xe0&{y\xf0\x80Q\x1a\x1c\xbb\x11DV\xd1<\xe4!-\xe0&\x96\xe8b\xd7\u007fG\xa4\xa7\v\xe5\xf2\xd4bm\xf9\x85\xf3\x14y\x84#\x13\x8f\xa3\xe4\xc21\xb3+q\x80\xa9\xd1U.\x94\xf0\x82\xee\xc1\xa3\xb9\xc0\xdb\x03\x9f\x94\xd2o\x9a\xa6RJ5\x95R\x1d\xc0h\xb7\xdb\xcb\xcb\xcb\xcb˫\xde\xef\xba\x00\xb3\xadV\xc5+\x82\xbe\x1d\x16\x80J\xb8\xdd\x01\xa5i.@˵Z\xacr\vj\x8dn\xee4\x00\xfc\x05\xf0C\xb2\x1cK\xfe\x8ba\xe9\xbd\xc6\xe8\x872\xe3\xc0\x02\x04n\xf5\xe2\x0f_\a\xf26\xd0\x17 P\xa2\xe7ϓ\xfb\xfcA\x06\xef\x1eF\x0f\xec\x02+\x043?\x81@\x01\xde\x19\xa2\x137)|.\x0f/\xf5\xb0k|~j7\xbf\xad\x12\x19\xfe|f\xdc۵ũx`ttt,\x06\xd7\xed\xe8;^\xd1Cc\x96(\x05\xb4r\xbbo\x87\xa3\xd4\x15\u03796\xad\xb6\xc6\xe8>?f\x17Z\xcbpg\xac\x8e\xd1l\ta*\xe42\x94[!\x16-/\x02\x98\b+l0\x8eVI\x12\b^o\x04\x82\x83\x9a\xa6]\x93\xcb\xe5~\xd2\xedvs\xd0\x0fzV0\n\\\xe9\xf8\xea\xa6\xf3\xbd\x92\xea\xb9\u007f\x96\xcb}R\x13BT\x842\u007f1\xc8ظ\x9f\x87<\x8ex\xca.\xd7α\xb11_\xb3\xd9\xfci\xb1X<\x9e\xcb\xe5\x9e\x10Bضm{Wɸ\x01\xfc\xec\x02\x94\xb4\t\xfct\xf5\x89Q\xa5\x04\xa0\x11\x8f\u007f\xd19u$\x97\xc9\xe4\x80\x01'\x03i\x03/\xac\v\x00\x9f\x10\x93\xdbwH\xfb\xa3\xf7F\xa7\x85\x1c\x14\x88\x90@)\xc5ҏk\x84\xab\xe0\x8ay\x10#M\x988ڗM\xb5\x86\xccՉ\xa6\x12\xb8\xbc\x1a\xc2\xdd\x04[@\xb4E\xec\x8e\bz<\x81\xb4\\Dw\xf6Xj\x17\t\x8c\x86\x82R\x93\xc1\xcaO\x97\x88P;-\x04\xd7u=\xa5\xc3UC\xaa\x9fr\x98\xe8L\xe3##D\xcdB\xa0\xa3\xc2fY\xcaJ\xbe\xcd\xd2l\x8b\xe1w\xfa\xe8hP\xabk\xec\xf4\x97\xa9)\x9b\x86\xea{\xcaR\xd3\xc7I<(\xb0\xe2^!A\x18\xf1*\x039]\xbfΥ\xd4n\xa5\x94\xe5,\x86u\x06\x00l\xc0t\xbe\xc5*\x8d\x03P\rM\x13B\b\x8e\xeaaO\xd7\xdb5\xf4@\v76Ql\xb4\x9ermk)oU\x124|\xbep\xa5\xd3\xc97,\xab\xb3\x92=8\xefQ\x1bU\vXy\xc9*\xb3`\xad\x8a\x17X\x0f\x1fW\xbf\x9b\x97\xbe\xd6T\xe6\xfb5eݥ|\b\xb4\xfe\x1a\xab2XY\v5۠\x17\xb0\xe8-\v<\x13\a\xfb@\xb3\xb6!\xd9I`\xf7߀\xeb\xad\x0e\xe8\n\xa0\x814$\u0092\x88\xae\x86\xe6\xd1\xf0\xa6\x82\xe8\x11\x03ճ\x01T@\x94\x1f5y\xb9\x1e`\xdbv\xb5\x03s\xd3B0@\b?~ƙ\xa6\xa9R\x133\x18\xedǅ\xc8\xfe\xdd\\2u\am\xfd\x06c\x81\xecWg\x88\xed\x883\xbeo\x8c\a\xff\x1e\xb6\xef\xa83\x9aX\x84)X\x96\x90\x81\xf6cJ\xfd\xac\x99\x8bm\xd3\x04\xa1<P\xb7m\x0fB\xd8Rʞ\xa6i\xf3N\x92\xb0\x9a*\xc0?8)ۄ\xe3\xff\r\xe7>3\x99L\xbeO\b\x11\x14B\xbcm1\xde\xf3\x8e\xed\xce\x12\xa7N\x84:\xcbE\x12\xa5\x9f\x918\xe4\x1d\xbc\xf2Io\x92j\xab\xf5\x99\xa7\xf3\xf9睞\x80\r\xf8.\xd4G\x9f\x8d\xb68/\xfd8ӸP<\xacR\xdf\xcfNL(˲\x1e\x9e\x9d\x9d\xfd\x83\xf5\x94\xa1W,\xc0\xbfO\xd3\xfb\xc5\xcbԂ&p\xda\x1c\n\xa4&\x18\xb8)\x86\xf8\x81\xcd\xf2\xe1ej\x8d\x0e#\xb7\x8f\x82Z\x84\xf8G!\xbe\xbd\x9fګ}\xc0\x1c\U0010a407\xc5/-\x12\xbe\xc5\u0088\xbb(|'\xc7\xe8\rcT2\xcb\xd4g*\b\xb0\xf7\x8d\xf1\x91\xfdS\xa7\xba\x85o\xafT*\x86\xf0x^\x9c\xf6z\t\xd2\xc6O\x17\x18\xa2\x8b\xab&\x85\xb0\xf6(\x95xW\xa2\xae\xc5\xcd\x16\xa1%\x18\xb8r\x10\xf76\x1d\xc6\xe0]\xff\t\xbc%p\xe7aq\xb0\xaf۞&\xfa\x9dB\xa4w\xd3\xf0\xf6\x10<\x86\x0f[\x88X\r\x82U\xb0,\xcb\xda\xe9,\xec\xb9\xea\x93=@%\x12\x89\xb4\xa6i+\xc1\xa0\xb4,\xabD\xcf\ntZ`:z\xd6\xed\xe8T1h+\xca=e7LMK&\x93\xc9\xedR\xcaުT\xae\xe9\xf5z;@\xf3\xe4ɓ\xb9\x8d\xb0\x00\n\x81\xdd?R\x0e&\x14\xeb\xfc\xb3\xcf\n\x00\xee\xd2)\xfc\xb3Y\x9b/\x8f\xcd8\xaf\n\x82\x1d\xb0\xc9\xfe\xf7,C\xb7\xa7\b\xfe\xcb8\x81\x89\x10\xa8k\xfaA\x01\xc3NE\xfes\x90\xfbF?O\xf3\x8c\x00YFv\x0f#\xb6\n\x18\x81\xf1{Ǚ\xfb\xaby\"\x89\x18\xbe\xe8\x10\xf9\xa7_1\xf7h\xb5Zmw\xbb\xddE\xbc\xde\xd68\x96\xee\xc5r\xf9\xf0\xe0\x13\xc2\x1aw\xdbL\x06-\xffV\xbd\x86˶i*/\xba\xe1B\vI\x18\x80d\xbcG\xb7\t\x1d\xe5\x05_\x8bX\x10vXJs\x17\xac\xf8h\xb7Iے\xf8\xf0\xe1\x01\xbf\tv\x17\x94\xdf\xeb\x1d7\f\xe3H\xbd^?\x97lj@\xdb\xef\xf7\xdf'\x84\xb8\x11\xf8\x8aR\xaa\x96\xcdf\xbfϒJ̗_nr\x15\b\xf1u\x11\xa4\xd2n=\xfe\\~\xe6\xe0\xf8\xf8\xf8\a\xfcnwJ)5\xd2/U\b\xcb\x01\xdc\xdf\x00O\x01_\\\xc3\xdaD\x9c\xb8\xa4\xdb\u05ee\x97\xe9\xa0\x10\u007fЅޭJ}F\x82~\xb5(\xbe\xff[*\xf5Õ@uU3M\x03\x1e\x00D&\x93\xf9\xf8\xab\x02 M\xe6\xdf\xfa\xe1#\x01\xd9/aT\xa6\xab\x04\xf4\x00\xfe1\x0f\x03\xf7\x0e\xa1w\\\x10\x18Ch\t\xe0\x04<\xbf\f\xb1\x19\x18\xf1\xf4\xeb*'\x17`\xcc\x03\xa3\x11\x18\xfc5\xc4\xe0#\xe0Y\x00\vĒ \xdeS\xe8\x02ZJP(!\x1f\xaaO\xfc\xd9\xfe\xb4\xf8\x9d\xa9L\xe6q\xe0\x11\xe0J˲\xae\x16B<?\xa5\xfc\x93c\xf8\a\xde\x05\\\xa6T4\x197\xb9b\xdb<<\v\xd9r\x94\xef\xba\xc2|\xfc\xaf\xb2$\x92\x11\"[\x83\xcc\xff\xde,/\x96\ax\xb6\x9a\xe4cc\xd3\\{\x05\xb8\x036\xb3?\x9e\x85\x12\xb4\xeb\xc1S\x93u\x83L*\x85\x19\x8b\x8d\xf4 |\x06\x00\\\x8e\xa9\x8e\x001G\x90fP)]\nA\x034K\bW,\x16\x8b\x15]*2#l\xbc\xf4\x18\xa0\x8e\x86\xaa\xf5\xa0b\xf7\xf3\xf1\xd2\xc7hK\x0f\xd2\xfb\x12\xa86\xc2~\xba\xe7z҂\x9a\x10\xe2\xc5U\xfb\x16\xd6\xd2z\x96g\xd3\xe8\x16t[`َ1pc\xb6\xcf\xd1\xf1t\x9f\xcb*\xb8\xe8+\xfb\x8d\xee0\xd7\xfa\x06\x05\x81\xb7\x85i\x9dh\xd0mw\t\xc4|\xf8\xd2I\xa86@\xb7Q=\x93\xe6\xf4Kx\xad\x04R\x9b\xee\xc7\x1c\"\v\xb9*\xc4\xdd \xbd\xe0\x1d\x86\x84\xa7϶gC\xa1\x8b\xd7k\xd3iw1\xbb\x92\xe8\x0e\xc4[\x97\xda\xef\x1f\xaew\xe6\x0e\x80\xb6?\xc3\x14\xe0UJ\x85\x95R\xbd]`o5,\xae\x1fX\xe4\xe8R\f\xa5V\x17痹\xd1\xee0r\x9d\x8d\x11\x14\xa7\xc4\xd4\x1407&H\xfd\xcfQ\xb4\xaa\xa4[P\xe4\xbe\u07b7d\xab\x1d}\x17\xcaU!歾vw\xcf\"\xa8\x94S\x03H\xaf\xa4\x81a\xa5\x02:`\na\xb4\xc1\x1d\f\x06\xd3\xcfAh\x01x+]n\xa7\x8e\x8e\xbd\x90\x13\xe2ٖ\x94\x87\x80c\uf820\xbb\x15q_?\x84\xef<1\xc7C\xf36ug\x93\xcaZ\xbb\xa2\x83\xc0\x15Nlr\x1ah\x96\x96\x96\n\r\xb0^\x8c\x0e\xab\x97W\xf6\xe5\x16\xcb\xc4\xc4\xc4\x10\xf0\x1b\xaa\x9f-\\\xe5\xcc\xe5\xd5\x01\x10\x87:Q\x1a\"-\xfc\xd1\x1bC\xf4\xf2\xbd\xbeI\x1f\xd3\xfa\xa5\xe8p\x17(`\xb7J\x94\x1e\xf12\xfc\xc902\xb2\xe0\xc45\xc3\xd0;\x86\xea\xfaP\xa6\a\xa9\x9b\x10\xb5\xfb\xe24-(.`\aa9S\xc6\xea\xc0֝\xa0e\x17\xb1Nr\x8f\x89\x96\x00k*\x99L\x0e\xc6\x04\x97߮\x1a\x03M\xe1\xf1\x18\nT\xafEW)\\n\b\x86`Q\x87:\x1a\x15\xdct\x97[h\xbd\xfe\xac\x82WE\x19\x99\x85\xebJm\xb2E\x0f\xa1\xaa\x89\xb6\xd8EoCE\v\xb2\x84\x97\xb2\x13\xf67\xa1\xd7P\xaa)\x84\x88X\x96\x95r|\xd8\xf1\xb3\xc8e\xa5\x01d:\xe6\x1b\xb3\xd3)\xb5l\xbb\xe6\xf1xB\x024\x01\b\xa7\x17\xd8\xeb\xcaX\xa2\xae.o\xb8\\\xb4S\xa9\x89g\x85\x19\xf7\xa1(\xf5͈4\x92\xae\xcb\a\x95Z\xca\xe7\xf3\xeb٥\xe4q,\xd1+*\x88\xd5j\xb5\rX\x85h\xf4\xd5T\xdb\v\\\xefh~\xec\\m\u007f\x17\xc0\xfe\f\xbf2\x95\xe6\xbb\xf61\xfb\xa1\x99\xaf\xce@\x11\xf4z\f\xda=\xf8\xb0S\tb\x1b\x9aw\x82\xf1_\x1f\x83\xdc\x14T[\x10\x19\x05u-|\xb4J\xf5\xc9C\xb4\xfe\xf2G\f\xff\xd3\xe4+\"\x96\xb99\xb0\xaa\xfd$a\xfa\xa0\xe0\x1f\xd4(G\x10\x0e\xac3\xf8\xfd\xfe\xad\x11ԭ{Uf\xdbq\x06\xa9\x98\x06\x8b\xf9~\xb9l\xcc\a\x898\x9ctC\x06\x1fߖan\xf8n\x8d\xc4>0\x04\xc4\xf7\x84Ѽ\xcb\f\x14J\xfc\xf1\xa7ǹ-\xd9d\xcc(\x93\x90\x90'H\x1e\x83gW-\xacRJ\x13Bl\xb1,\xab\xe2T原e\xf15\a3+e\\:\xd5j\xb1\xd0jU&&&\x10\xe0B\xa9S\xdbA\xac\xbaH\xf8\xeb$zR\x0e\x86\xa4\xac=\"\x8c\xb8\xe1\x98\x12\tR\xf3\xb0ˣ\xd4\xc2\x066\xf1\xf2θ\x14}\x93r\xfb\xfetz\xb2g\xdbG\u007f83s\xa8\xd9l\xea\x86a<\x0f\b\x97˥\x9f\xd7\x02<\x98濕\xe7\xf8p\xd4p\xa6톆g\x99\xdeL\x8f\xe4\xdf\xc7\xfa^1\x95\x83X\x03\x1e\xcf\xc25yhiP\xe8\xc0\x8e&\x8b\u007f}\x18}X\x12\xbf\xfd\x16PW@2\xc7\xf2\x91i\xcc\x17\x9f&&\xfa\x9d\x91j\x13\x9aK/\x87\xb0\t\xf8\xafYx\x18@)\xb5T\x80\xcc\xef\x8b\xf4h\aa\xf8\xc0\xd5R\x13\xae]\x94I\x1e\xa93=\r\xf1\b\x8c\r\xc0\x96\"d\x01\xe3\a\x10\x99\x87\x99\x13Y\x8e\xcc\xc5x6;ʭb\x86\xed{\x15\xd1\b\xcc\xff\x04(- \x1aA%D\xccr\x9a@e!D\xabT*\xdd\xdf\xedvO\x9eѝ\xeb8\xd1m\xdd1\xb9\x83NKX\x01\x8cF\xa3\xef\x1b\x89D\x1a'\xeb\xf5oZ\x9af\xeanw\xcd\xea(\x8d\n~\xab\xd3\xefB6{\xbd\x13\xcdn\xf7hCӪ\xa6\x94\xa60\x8cO\x01^!Ē\xe8\xe7V\xd3\x1b\xb4S\xe8%\xc0\x12\x8euhý\xce\xf9?\x06\xfeG\xb1X4\x80oI)\xc5\xf8\xf88\xe7\xaa>\xae\x98\x86c\x96ɷ,S~\xe7x#\xf2\xb1\xb1\xc8r\xd8\x1b0\x8dNKA5\xd6g㯃\xab\xde\xf7`z\b\xcc\x1e\x98Y\xa8\xd51\x9a\x8b\xa8\xb2\x17\xb3\xd0\xc4h\xd7 \xd9\xc3\xe5VXҠ\x96oA\x11\x9aUht8\xe9\x83o\x00\"\f\x8fLe2/\x02\x98\xa6\x99\x11B\xfc\xa8\xa4\xeb)\xa5TR\x87\x88\x89p5\xf1\xb1ԓ\xf4\xea\x15\x06# EߦU\x80\x93sP\xb6\xa1^\xb0\xa8.\xb51lX \xc6H\xbe\x81\xd1nA\x1c\x96\x1b!*\r\x0f;@\u0382j\xc0I\xe0\xf1n\xb7{\xb4\xddn\x97WG\xcc\xce\xc2TV\xf9\\\x17\xb0\x12i\xe0v\xb9\xf6\x00f}a\xe1+M\x9fO\n\xb7\xbbmw\x95\x81\xd9GH\x15h\xf5z\xc5\xc5b\xf1\xe8b\xbf\nW\xbdbb\xe2.\x84\xf0\b\xa8\v!jN\x1bw\xadd;n\xc8r6\x9c\xb0j\xbc&`\xf5\xa0'\xfa\x91\x96m\x83R\xfd\xfb{\u0382w\x00\xa1\x94Z\xe9C\x18\xabΫ\xd3\x00pw\x86\a\xa7\xd2\x04\x16\xf1N<\"\x02\xef\xfed\xa5\xed\xf3FM\x03[\xd1\xf3X\xfd!,\x81\xe8\t\xb4Q\x89\xd5\xf1\"T\a\xe9mA\xadJh\x9bAm\xaeF\xf3\xa9\x02\xfe\xad3\x90\x18\xc3\x1bo#\xc7\x02\xe4\x1e\xad\xc24\x99\x92\x89\xbd\fSwgԿ;\xb3->77w\xd2\xe3\xf1\xd8\xc3\xc3\xc3W;\x83\xf6(!|U<t\x90\xe4\xa9\x10sF\xad9\u009e;\xa2(\x1dQL\nP\xd4\t\xd0\xe2%F\x19}\xd6\u0088\xb6\x11W+\xcan\x1fE\f1\xa8\x94(\x81]\x87\xe3\x99L\xe6\xe15.\xc0\x12 \x97\xe0\x90\x04\xdbn6\x9fP\x96\xd5\xec\xd8vQ\xb4ۋ\xadV\xebss\x98\xc6s\x10>l\xb7CˊH\xa7\xd39\xec\x80'\n$\x8f\xf4\xe5\xeb\xea)u\x9b\x82#\xeb\xd4\xf2\xacc\x8f5\xa7D\xad\x80\xa7\x9dk\xcf\x01\xfc\xb0\x9fU\xf8\x94Ry\x94\xea \xc4A\xe0\x98s\xcf\v\xb6m\x8b\xe9\xe9\xe9\x80\x03\xa6\x8f\xac*\xf9w_Q\n\x16pg\x9c\xc6C\xbf\xac\x1a\x04\xe8אּ\x8e[\xcc\x1e\xcb\xf6cc\x03\\)\x9d\xb1_\x1b!\xffг\xf8\xec\x00\x91\xcb\xe3p\xdd0\x8c\xe6\b]\xa3\x9c,\nP\xa2\x1fg\x17\x81Q\xe8%\xd9\xfb\xa1\xafQ9\xd7d\xdb\xedv\xee\xe4ɓ\x89\x91\x91\x91\xb7\x96u}\xef\x14\xbc+儰7\x01O͂\x872\xb7Pf\x02\xc8Q\xe2(%n\x99\x80\x99Z\x82cK~\x00\xfe\x8e0\x83\xcd\x00\xef\xcbd\tV\xc1\a\xcd\xef\xf7\xfd\xef\xaf\xccLO\x9fX\xc7\x02t\x81f]\x88\x9a\x80j\xa1^?\xd6l6\xeb@\x1b\xd3\xec\x15\n\x85\xec\xd7\xc0\xf8\x1a\x84\xa0\x11\x83F\xd2q!5\xa7\x9a\x18\xc8\xf5\x83E\xe1\x1c/]\x84\xc9\x17g+\uf297\xe3\x15M\xbd\xfa\xfe\x00q.\x17 \xfa\xa5`~\xafA\xe8\xdd\x05\x11\xee\xbc]\xcd\xdd\xf5\x8f\xa4\xfe\xcf\xe5Zg\xdfnW\x91\x05 \x91\x02#\x06\",\xd0\xc3n\xa2\xb5\x0en\x97\x86\x9cL\xc2Ƕ\xc3c9\x18\xef@܂\xa9\x02\xbc{\x1c\x8eڨ\xa7{\xf4:6\xfa\xa4\xf6R驪\xb5\xfc\xad\xf2c\xfb3\xdc\xf3*c\x91@z||\xfcv)\xe5\rJ\xa9\xb7D\x840ǰ\xfc[Ug\x02!\xf4$\x1d1B\x85\x02I\x96\x11Ԁ\xab#y\xaam?\xcbm\xb7\x03\f\xe8i67\a+\xfc\xb0\x91\xe2y\xd3蝄\xe6\x16\xf8\xa6\a\x1e}0\x93\xf9\xea:\xf6\x03\xc8x<\xbe]\b\x11*\x97˖\x94R\x1b\x1e\x1e\xfe\xe7J\xa9V6\x9b\xfd\xfeJ\xe70\x1e\x8f\xc7|>_\xb2\xddn?_,\x16g\x9c]<\xba\xdf\xef\xbfEӴ@4\x1a\xdd\x0f\x9c\x98\x9e\x9e\xbe\xe3u\xdc\xfd\xb5\xd3\x01\xd1\xd1ՙŊ\x05\xf8A\x1b\x91y\fW\xe9[b\xe2\x85\xddJ\xfd\xafq\xcb\xfc[\xac~K`\xa1̧C&\x13\x91eE\xf7x\x1b\xed\x96\x18\x12\xad\xbf\xcd\xe7\x85\x1a\xcbG\x971\x94\xc0U\x17,\x1f\xeb\x10\x1a\xed \v\x12\xabe\xd3(\xb6\b\xc7\xfc\u06dd\xden\xe6<>\uf114\xf2\xb8#\xc0N\x15T\x15-\xf9\x1c\xde\xd0\r\x88\x90\x1b\xe9\x8a\xd2t}\x0f\xaf\b\x02qlf*`\xd3A\xa3\xc7\b\x1d\x9a\xb8X\xb24\x9a\x157]D\xd7\x02\xe5\x06\x9f\rwu\xfbZ\xbdV\x00X\x80U*\x95\x96\x9cl\xc1\xabi\x9a!\xa5\xf4ٶ\xbd\xba\xf5*\x1d\x12Rʕ纀\xd5h4\x964MkG\xa3\xd1\xe6F\xf6\x02.\u008a\x88\xb3Z\x80\xf3с4\u007ft\x19\xdc6\xaa3N\nRw\x8dbh.TEa\xe6-\xe6\xe6\v\x04&\x03\xf8\"\x06\xc5\xe7\x16\x18\x1cH\xa1II\xb7i\xb2x(\xcf\xd0[R,\x1e\xefP}\xa6\xf5\xed\xf7e\x9a\xef]Ǡw$\x93\xc9\xdd~\xbf\xff\x17\x14첕\x1a\xb0\x94J\xba\x84\xf0\xdeBClu\xf6UT\x18\xa0\x81\x8f}L\xf3,C\x1c\xc2C\n8!\xc4\xe1,\xb4\x05\xc4l\xa5\x86\x81\x87\xa63\x99O]\xa8\xe04MӤ\x94\xefRJY\xbd^o\xc1\xd966$\x84\xf0K)C\xb6m\x97\x94R\xab\xfb\xffy@麾\x05X6M\xf3\xd1\xd7\x19\x00\xaf\xe8\x14\xae\xe9\u007f\x01\a2\xfc\xeaT\x9a\xcf\x02\x9fG9\tR\x18zu\x9b\xf9Gf\x99\xcfCpi\x89P\xb2\xcf&\xff\xcc\xfci\xc9\xce\xc2O\xe79^\x1e$K\xf2<F\xe0\x95\xb1A\xb7\xdb5\x95R\xd2\xef\xf7OHg{\xe0\xb5@\x1do\xf3I1R\xf7+\x95\xec\xe0\xc2B\xf0\x02)\xe6pe*B4t\xa5vՕ\x1a\a*\n\xb2\xedV\xebQ\xd34\x9f\xa4\xbfW\u007fz\x1d[\xb4N\xb5q-\xcb\x12\x96e՝\xe3\xae#\xbf\x96RJX\x96\xa59Z\xbe\xba\xc2\xd8\x06l\xd34kN@\xf7z\x92:\xef\x9e\xc0s\xd28²`~\x16\x92\xff\xb8@\xa7\x06\x8dc0>\t\u007fQ\x1b\xe1\x8ac-v\xe5\x96 \x0eOρ\xd7\xec\xab\a@$\xca\xe5Ά\xdd\xf5n\x8clV*\x95<\xf0\xbc\xdf\xef\xff\x81\x13V^\xa1`\xa2\x8a\xb4O\xa2\xc9a\xd4\xccJ\x94s\x12Û\x17\xa2V\x83\x86\x0e\xb3m\xa5\xe6l\xa5\x8aJ\xa9\\\xa3\xd1\xf8\u007f\xf5z}\xf6\"\xf6歘\xf7\xc7/\xe0\xb9\x17x\x83\xd2Z]\xc0o\xf8|\xe1\x0fzt\xf7\x8e\xe0R\xe1\xde]\xfb\xf9ϴx\xbe\xfb\x12\a}\x03\x1c\xf8\xcbb\xea\xbf\fֺ\xf9\xab\xbd\x8b0\bO\xccA\xc4\xe4\x97\xdd\xfdb\xd8\xef\x02\u007fzw\xe6\xe2}\xe0\xe4\xe4\xe4\r\xc0/ٶ\xfd\vB\x88\x01 *\x84x\xc8A\xb7TJ\x8d\t!\x02Nq\xe9\xa5f\xb3\xf9\xf5B\xa1\x90sʡSN\x91g\x93.\xc0\x02$\xf3MW\xb9\x84\xef\xaf\x1f\xcc\xf2GS\xc7\xf8\f\xf0\x145\xbe\\\xabq\xd3m\xcc\u007fy\u007f\xc6iY\xbe\xd8\u007f\xe0\xc14\x02\xd8rw\x86\xff\xbb\x81\xe3}J\b\xf1B\xb9\\\xfe\xa6a\x18\x95@ P\xc8\xe7\xf3{\xddn\xf7t$\x12\x99\x05\xb4\xc5\xc5ŷض\x1dO&\x93\xdfv\x8a\x1e֪\xda\xfe&\x9dA\xff\x1f۲\xb3\xf9\xda\xf4w\xe4\x00\x00\x00\x00IEND\xaeB`\x82")
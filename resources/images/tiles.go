@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var Tiles_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01\x90\x00\x00\x00\xe0\b\x06\x00\x00\x00-\xea0\xb9\x00\x00\"\fzTXtRaw profile type exif\x00\x00xڭ\x9bg\x92[\xb9\x92\x85\xffc\x15\xb3\x04$<\x96\x03\x1b1;\x98\xe5\xcfw@\xaa\xa5V뽈12eX$/n\x9ac\x12(w\xfe\xeb?\xaf\xfb\x0f\xfeԖ\x83K\xb9\xb6\xd2K\xf1\xfcI=\xf50\xf8\xa2\xf9ϟ\xcfg\xf3\xe9}|\u007f\xfa\x8f\xaf\xec\uf3fb\xf3}\x8d\x0f<\x14\xf9\x1c?ߖ\xef\x0fl\xf0x\xfe\xf9\x82\x9a\xbe\x8fϿ?\xee\xea\xfa\xbeO\xfb\xbe\xd1\xf7\a?\xde0\xeaʁ/\xf6w\x91\xdf7\x8a\xe1\xf3\xb8}\xbfw=|\xbe\x18\xe5\x97\xdb\xf9\xfe\x0f\u05ffG\xcb\xf7M\u007f\xff>U\x82\xb13\xef\x17\x83\v'Z\xf4|\f\xf1{\xa5\xf8\xf9?\xf8\xdf\xdf\xc7\xca\x13-\x16\xbe6~2b\x8c\xf6\xcf\xf8\xb9\xef:\xfe\x18\xc0v\xff\x1c?\xbf\xbeψ?\xc3\xf1y\xa3\x1f\xb7U~\x8b\xd3\xf7q\xcb\u007f\x8eߋү+\xb2\xf0}J\xf8\xf9\x83\x97\xead\xdb\xff\xfa\xe7\x97\xf8ݻ۽\xe7sw#\x15G\xb8\xca\xf7\xa6~\xdc\xe2\xfb\x8a'N\xc2\x19\xdf\xcb\n\u007f+\xff3_\xd7\xf7\xb7\xf3\xb7\xf9\xe1\x17Y\xdb\xdc\xeat~\xf2M\xb7@į\xb1\x02\x1bv\xed\xbc\xcf\xcb\x16KL\xe1\x04\xc2\x1dBX!\xbe\xc7\x1a\xe1\xefaE\xa5 \xe9\xaf\xddP\x1d\x99ٱ\x91\x8dE\xe6\"\x0f\x87\xbf\xd6b\xef\xba]\xd7\xe3b\x8d+o\xe3\x99\xc1x3\xe5\xefo\u007f\xdd\xef\x0f\xfco\xff\xfe\xed\x8d\xeeU\x99\x9b)\x98\xa4\xde>\t\x0e*Y\x96\xa1\xcc\xe9#\xcf\"!v\xbf1\xcd/\xbe\xe6>\x9f\xfc\xef\u007f\x94\xd8H\x06\xf3\vs\xe3\x06\x87\x9f\x9f\xb7\x98\xd9~\xd6V|y\x8e>;\x9e\x9a\xfc\xa7_\xac\xee\xef\x1b\x10\"\xae\x9dY\x8cE2\xe0\x8b\xc5l\xc5|\r\xa1\x9a\x11\xc7F~\x06+\x0f1\x85I\x06,\xbb\x1c6\xab\f)\xc6BrZеyM\xb5\xf7ܐ\xc3\xe7a\xe0\x85Dd\x1a\xa5\x92\x1aZ\x87d\xa5\x94S\xa1\xdf\x1a%4\\\x8e9\xe5\x9cK\xae\xb9\xe5\x9eG\x89%\x95\\J\xa9E85j\xac\xa9\xe6Zj\xad\xad\xf6:Zl\xa9\xe5VZm\xad\xf56z\xe8\x11\x18ˮ\x97^{뽏\xc1EG\x1a\xbc\xd7\xe0\xf9\x83\af\x98q\xa6\x99g\x99u\xb6\xd9\xe7X\x94\xcfJ+\xaf\xb2\xeaj\xab\xaf\xb1Î\x1b\bp\xbb\xec\xba\xdb\xee{\x1c;\x94\xd2I'\x9fr\xeai\xa7\x9fq\xa9\xb5\x1bo\xba\xf9\x96[o\xbb\xfd\x8e\xbf\xb2\xf6\xcd\xea߳\xf6{\xe6\xfe}\xd6웵\xf0\x12\xa5\xe7՟Y\xe3\xe1Z\u007f\xbc\x85\tN\xb2rF\xc6B22^\x95\x01\n:(g\xbeYJA\x99S\xce|\x0f\xd1Ř\x01>\xcbJ\xce6e\x8c\f\xa6c!_\xfb+w?3\xf7/\xf3\xe6\x88\xee\xff4o\xe1O\x99sJ\xdd\xffG\xe6\x9cR\xf7K\xe6\xfe\x99\xb7?dm\x8f\a\xb7\xf1%H]HLA\xc8H\xfb\xf1\x84\xd3F\x98\x93\xac\x1e\ue8269\xd7\x0e>\x9f|gj\xe7\x868=\x11)z\x8f|\xe2\x1d;\xa6\xbe\xfah+\xe7=mdW\xe7,gu[5[\xdew^\xbdK$\xf6>s\x8f\x95^\xa7\xf16o\n\x1cl\x16\xb9\xa2\x10\xae\x86xϜ]?\xf5\x83\xd7\x17\x97/\x01\x1bs\xf5<O\x99w\xadux\x9b\xbe\xe6\xb1Zo\x8fz*\xa8\xa7\x05\x9f\xdb犓8\xf6\x15s[\xcb\xdfa}\xe9\x19\x8d\xee\x8f+\xeeA؛\x95\x1c>/̛\x9f\xb6\xecg\xa0\x02c^\\\x90h\xe9*\xb6\xc9\x03\xb1$R\x83\xa7\x06^E@6\xe1qǌ\xa8\xfbʳ\xe2\xa9z\x9by\xf9\xbcB\x1f\\\xe2RC\xb5\xcf\xd8u\x8f\xef\xfdu\xf5Ў\x0f\x93UZ\xeawm\x16ޒ\x1b#q\xbf\x16g]\uf389\x16М7\x99C\xa6\xdc\x14\n\x050\xf2\xac1,*\xcd\x16\x15\x98\xd7\xec\x85\xe7\xf1\xbea\x1c\u007f\xf8\x8e\x16\xe9\x992m\x14\x01KH\xdbVN\x9d8\x14K\x99\u007f\xa9Q~\x83\u007fR\x19\xff\xf6\xb3\xfb\xd3\x0ff\x1e\xf5\xf4Y\xeb\x18y{\n16R\xd8\xfd\xcd\vU#Ԡ\xd2G\xac\x10\v\t\xea%\xeft\\\xa2\xe2ʱ\x16(Tߊ\xed\xcb\xcdľi\x97\x98ύ\x91\x1ceP\xf5R\xf9Ԍn\x9a\xbc\x1f\xcf\xcd6Z\xaf\xee\x18ʊ=P\x90m\xef\x19\xfc=$oR\x04\xfb\xf25\xcdA\xa9\xa4^ci\x87z\xeb\x14\x18\xc8|\xf3\xc8\xe9\xcc\x14H\xd5\xcamX>\xa4\x9f\xb6,\xd9)\x16y\xf4\xe9s\xad\xfb\x90\x9b\x17\xf0R\b\xe4\x99#)\xe7\xb9\xfaH\xadR\xf0<\x962I\xbcw\x9c\x0e{E\x16\bg\xea5\xaer\xed3\xa9\n\xdf\xeb\xf5\x8a\xcd\x1dC,|\ueb3b\xcc\x1c\"\xc5\u007f\xcf*)\xedxN؇\x9b\xabk\xbf\xe2\x8a{I\xa2\x01\xa4\xae\xa6[\x17\x182\xef\xa6/\xc7.(\x8bY\xd3\x1e\xb4\xc2n\x89\xa6\xe7\xaa\x15d\b\xbb\x82\x92\xa0R\x83\xe3\t\x05\xaf\xaa\xa3.]\xf2\x10\rw\x8d6\xa7\x18ø\x8d\xdaa=ĉ\xdb$\x8c9\xcd\x00R\x1aa\x0f\x00\x98\xb7\xb8\xa8-\xee\xaa\xd0R\x04\x01\xb5\xc1ǹ\xc2๎\x88\xf1\n\xa4\xe0H\x15l\x12\n\x12\xa4\x03\n\xdeUc&;\x8a\x00ȓ*w\v\xfeYU\xa0^\\\xe8\xf6y\tq\xb9\xb7\xba\x9b\xc9\xcaEh\x94B\xf8Һ\x15,\n\xe7\xec\xc0zXםq\xa9\x9eS\x1cĝNOg#z\xc8)a\x003\xc80\xa1k\xc1\xcdj\xbd\\O\xa4Φ\xc9K\"\x9b`\x1e\x1fS\xeb,\xb2\xc5S\x8c\x06\xb9\x00\xec\xad5\xee3\xfd\xf9\xb4v\xe9\x17ZX \x02\xcd\xe5$z\xc1\x96\xbd/\x05;I3B+\xb2B0\x96֭j\xb9\xbb\xebx/=m\x8e\x1bA\xcdd\xc0\x04o\xf7^:\xb7\x8a\x867J\xca\xca\xcd%vD\xe1\xdci\x9ed\x04\x04Ρ\xb4\x01B\xeb\xbaS~\fL\x87p\xaeu\xc53\x9dj\x9fHI\xc8\x05\xb2\xb6Xʃ\x0f\xda\u007f\x00\x85\xbe\x17\xe2\x11\xf7\x1c|\x97\x162\xb1,\xc0\x14fZ\xe7\xac:\xb8#\x10\xf1V\xd2\bD \xa8T\xaa'\xb9\x9ao:\x9dkQvi\xfa\b\x85\x00\xad\xa3\x02j\x87\xd0Щ\xb7\xa5\x02˩\xe2\xefh\xef\x16A\xce$̢\x13!\xe2K\xdfT\xb7\xa0\x88\xaev\x86|@\x13ʌ\xa2\x85l\xaf\x9f\xab\xb2\xc8\xd5\x0f6eR\xf8H\xda9f[\x11\xc4'E뮑\x8e\xaf\x96\x1a\x8d\xd1\\\xa62\xb3\xe2\tX'zɬ\xf7\xf8 .qy\x16P\xd2.9\xdf\xdd'\x80@\xc8\xfc:\xb6І\x14S\xadG\x12v\x8dR\xae\x9bP\x04@X\"\xcd\xc4e #n\xab\x0fR\xa2\x00\x81\x89 \x02\xdc\xc4=\x12\x8d\xb5I-w\x15J\x8c~+$\x14\a\xf8\x05k8n*\x80\xad\xb02\x99\xa3P\xef\f\\\x94@\x131\xfc\xe0Y\xc4\xe0\x0e\xfa*\x95zl\x9e\x01\xd0S\x13\x03^x5?\xa8v\x18\xe7\xba\xc2\xe3\xe8\r\xdd\r\xd1\x10\x8e\xf3#\xfe\x83\xe3|\x1a\x88:\x90i\x11GR\x98k')\xa5Q\xecK/\xcb+S\x81\x9eR\xce\xe6\xa8\xd5Y\xfa T\xe41{\xbda\x9a\xad\xa9e7\b\x85x\a\x1a\x0e\xef\x15\xd5:䏒\xa6\t\xc9\xd1`y\xf0\x13\xcc}\x9a\xbc\b\x95\x88&8\x81\x82_i\xd0T\x87\xe6ojR-nŶ\x13\"\xe8\x12\x0f\x836X\xe3\xf0\x9fG}!G`e|%\xe1H\nᅀ:\x99\xa3\u007f\xf9TƸ\\\x93\xfbY\xb1\xf2\x02*fn\xee\t\xb2-\xfd\xc7-\xb1\b\x94\x92\xc4\\|\x8bw\xbcIB\x00\x91ZD\x00-\x8fv1\x12\x1e\a\xa5\x03\xce\xe5\f\x1cBV\a@G\xb0\x9c\xa4'\x99tDl\x81\xbb\xa1B\xc89\xa1r{n\x89\xbf\xb3ִ\xd0\xf6\xa2\xa8\xc6\x16fP\x00\xb3\x06z\x1dĨ\x00\xd2\x01\xf2\x8b\x84\xc4@{\xd3n\xfd@\x88\xa0v\xe2+\x82\xe3n\xa15'ԉ0\xd8\x1bRN!\x1dU\xc8.\xfc\bP](<\x8a\r\xadIE\x06\x92\xb7\xbbϒ4@K\xa6\xa4\x11Q\x97rs\x01b\xe9\xd44\xf2\x82,\xe3\xeb\x16\xa4\x04\xb0{\xda\x1b\xc1\x06\x88\x11\x10\x04\xdb\xe0\"\xe3\x19\xf4x\xb8\xdfA\xfeA/t\xcfF\xb3\x12*\xf7\"\x065\xae\xb3\x85\xe2,\x1b\xc6B\x14\xa2\x9a.\t&@\x87\xfa\xa0\xef\xbb\xe2\x041\xae\x81\x16\x9d\x00rBö\xb6\xaaA+s9\x1aWq\xc5\x11\xb6Q;\xc5\x02)q_\xac\xaa\x8a2GB\x17u\x1a\x98\x18\x06Lr\x03d\xadU\xad\u05ebʐ\x85\xaa\xd82\x1d*k\xc0\xe0\xa1p\x03\xa1r\xf7Wf\ad\bJ\xf0I\xd4\xea\xf4!\xe3\x84\xf9\x90\xc6\x06\xb3\x10\x82\x19\xf1\x85\xcc\xee%\xa2\v\x0e<I\x8cX%\xaa\x86\x06 Exjdv\xa9\xfaN\xe5B\nA\x8e\xb2\x01r\x9a\x05\xfd\xea\xa1d\x94\x1b0\xbdb<\xa6{\x89\x03\xea\xc9\b\xad\x12\xa8\x1c`\x98[F\x91\x05i>\x04Bm\x9d\xb6@\xd36\b_\x05C\xbd\xf4U\b\n7\x82\xee\t\x90o[\x9b\xd2\\\b\xc1\x9d\xf0kH\x88\x8bb\xe7}i\xa5\x9e\x91\xfa\x19`\xbcH>\xbb\xa4\x8f\x00\xd3[\x93~\xf0\xa1S\xf6\xbdWq\x10/;\xed\xaa\xfa\xf6\a;\x1d\"\x88\x80\f\xf4\xfa2ޏ\xcbB\xef\xa8=\x18\x8e\xb57~\n\xe5\x05\xd4\xc4\x0etmAԣ\xf7\xd0M$`B\xed\x9a<\xa1K\xcbr\xe0\x19\xfc\xd7$+go\x04\x8b\xe8Ԍ^GQ\x00\aD#,b@\xc9r[\xa8\xae\x19)\xaf\x06z\xa2\xa4\xbc\xc1_Tk\a'\\\x06\xd5\b\x06\xd6\x1eս\xe7z\xf77J۠c\xa1\xc0\x84_\x1boG\x19\x92K\x82>ih\xf2\x84T\x041\xb6f\bm\x86\x06f\xafU\xb6\xdc\x1a=\vY&\xe9dj(\x83q0\xeb\x80/\x00|\xa0\xf7\xd5\x03\bp\x14\x9c\x8e:\x9c\x17[L\xa1Ҡ\x11\x8as\xd8f\x80\x1f!\x04gs-(\xfa#\x021St\xb9\xbcй\xd2\xfd\xc8أ~7\x98\xf3\xa3\xf7\x1b\xf9\xf6\x13|\xd20d9\xfa\x1e\xb4'\xa9\xf0\x05\xfa\x10\x93\x88l\xc0\xe3\xe4M\xeb\x15\x8f\x85B\xe9\xa18N\xdb\x18\x00Z\x1d\u007f\xbe\xb0\x1ap\x11\xea$P\nv\v\xfc\xef\x02\xbcb3\xf7H\xc5\xd7[\x8b\x80\xf9\x80u0b\xa9\xdcw-Obw\xbc#\"\xa6ȯ\xd1|\x9e\xc4\x12\xc4f\xc8%\\*9ǯ\r\xbf#\xe1\xe5%K63\xc3ͅ^\x98}\xcf#({J\b\xe9Hxf\xb7\vY\x9d\xd46+\ak\xa8)\x1e\x8a>\x14\x17\xa99\n\xa2Ù\x19\x01\xaa\x16\x1c\x94\x83\x84h\xe6\x12B\xc0FR\x11\n\xc8\v\x92\xb6Q;hnd\x1b\x82<6\xccf\xf7r\xb0\x0e\xe8\xe6\x8ex\x06n\xa8\xecMI\xefT\xf6QY*hq\xb5\x06$\x03$\xedsE\n\x83Ҫ\xa0\x9f\x02*q\x1d\x13\xf5~\x1d|kp?\xa4ƒ7\xf7\x0f\x15\xa2\xa6\x81'\xf0\x00X\xf2\xc8Md\x88dR&<\xf0j\x9d\b\x05P/ \xe8\xe0r\x9a\xf9\x80.\x8e'\x1b\x04\x04Q\x1940>\xf6\x11\xb8\xc5J\xe1y0\xfc\x18H\x95\x962y0\xb5\xd7$\xd8\xc8\xce\x15\x98\xf3\xbe@\x06,\xd8\x1cʖ>/\x95.0\x11\x1f^\x87\x02\xc8T\x17\x85KQF\x91?\xd2P#\xd7\xe3\xb1k\xc4\"\"y\xac\x12\xd1E\xdfc9\xfbG\xfaq]\xf2\x89!\x83\xeb\xe9T\xfc\x04VN\x84\x80\xcf%#D\x9f0\xe1\x9c[\x18M\r\x19%d\xcf\x10\x11\x17\xa4>\x95\xa2!\x9b;\x87\x04\x17\xee\x1f;hX\xa21G-4\x13w*\xfb\x88G½`\x8d\x81\xaax`\x0e\xc5`\x0e\xca\"\xd4\x05\nFR\\\xe4b Ȗ\x01{\xa2\x9fy\x06\ue335e\xeb\xc2\b\x92\x8f\xfc\xc7-\xda\x01\x9e\xa9=`\x01\xbbI\xeeZ\x90ব\xa0\xee\x1d\xb1\x10\xach\x90ʱ\x1b\xa5\xc1K\x88P&<\x1a@M#\xe3c\x8cp\x02\b\xa99\xb6\x85:P\xffOo\x02`P%\xd1\xe5J\t\x84\x19\x87\xac%\xfc)q\x85n\x89\x10\xb8\x016v\xd4$`\x82\xcf\xe4\x1e\xbb\x813\x10<\x82j&\bNm\x1c\xe9\x1fz!#\xa0\x81E_X\x11\xea`L\xc1+\xd8\xd3=\"\x87\xf4S/\x84\x80\xf5\xd3\xee\xe9\r\xda\xe0S\x8c\x19VE\x0f\x01\xfex+$\x9e,M\x97F\x9f4\xad\xd4\xe0\x91\xe4\x93aj\x9a@\xc8\xd4L\x1c\x0eJ\xf0\x86G_\x03\xd27\x89\x92\xa6<\x8d\xe7\xeb\xb8\\E\xf7\xa3,\xaf\x9e(1z\x10@\\\vX\x00\xa8\xfa69ĄWC\xfb\xa3\x1419\x8d\xc0R[\x84\x14\x9a\x16\v\xb6]\x11y=И\xa8\xf0a\x1d>tp\x8c\xf0#p\xab5\x12a\xb8\xc5K\x95V\xd6XH\x1b\xb2\xd4\xf0\x86\xd4*B\"A\xbc\\\x18\xbcF \fY/Me\xec\x8c\x1a\x9dD:(y4\xe7\x19\xa5jS\x82\xb2\xb2\xc4+\xe8ep\xfeN\xe4n\x8em\xc9wT\x84`\x02\x02j/\x907\x80\rT\xb1f\x16\xe8\xb8T\v\xf0(T\xb1(\t\xbcR\x96\xae\xe9<\x86\xe8⻧\x8e\x05\x17\xf4.$<4\xf6\xc2O䁟\xc0\x89!l\xba$\xa5C\xfc \xcc\xe0\xef\xc9\v\x86\x06<\xb2\xd2\r\xe3^\xb5w\xb1\n\x17!\xf5`\\\x92\x03\xa7\xaeC\xbf=\xe7Ŋ\x89\xd1EG\xb5C\xa5\xbb\xb1\x90\xa6 2\\\xdf:\xfe\xe0PՔ\x05\xf9/\r\x9a\x05\x83\x83\xa9\xe2#\x1d\x84\xbc\xa4\xa35\xe1\xc0Y\xd1?\xa5\xb3B.\xdb\xd1\xf1N#q\x83\xf6\x80tb\x81\a\xc1O\xf1\xe6C\xa4\x1e\x15\x9d\x02\xdf\xc9\x19i\x16\x95\f\xb3\x83\x03B4\xa3\x9c\x00\xff\xa1\\\xf3\xcc3\xd0G\xa8\x9c\xed\x01\xd7\x05ހ\xf6\x80CH\xca\xe1}\x17\xff8\xda\xf2$pK\x00\xe8{\x04\x01IYSl\x1b\x01\x88\x1c\x02\x8fPi\x92-\x93\xca\xc2f\"F\x17\xc0\x1aP\x00\x98$\x95\n0\xa4\x1d\x04\x1aywX\x92\xfcX*\x17\xfe\xa3\x00\x02\xf6}\x8b\x03I\xa9C[S\x9e\x95z\xe1V!\x81\x80\xea\xba\x1d\x1a%{ \xa0Ǔ\xbc\xae\xc7p\xcc]0\xb5|\xad~5\x80}\xa0\x94\x97\x1c5\x8c\xec\xf2\xc08\x93wC0\xd9D\xc4\x14ʳH\x8e\"\xa2\x17.*\xc0/\xf4y\xf3I|\t\xbeRqP\xa8f\x0e\xb3\xed\x0e2\xc1\xa5e\xbb\xb2\x8a\x9fM\xf3/nk\xd2@\xa8\x17+\xb0$\xba.\x05\xa40\xf5\xaa\nBuƃ4\xa7\v\xe9\xc8Ւ\xa6\xeb\xf8w\xc3\xebR\xa2\xdey,-\x9ag#Tw\xeb\xb7LK\x18e\xfaQ\xb3#\xaa\x14E\x84\x8c@\x1b\x9f\x03{QA]\x97E\xb3f\\\x01n\x96$\xb1\xb6\xd8I?\xbd\xdc\xd7j\xda\xe7@\xbb\xa2\f@\"\x84}\xca\x1a\x1cL\x8d\x8d\xe4WB\xa7\xd64e/\xca\xda\xf5 \r&\xd9NJ\x1dL\xb1\xe2\x96$\a\x05c\x82\rP\t\x97V \x19}\x04.҉\x9a\x82\xfaM\xcfS?\xebH \xb2\x80\x86\x89n\x01\x117\x14\xa2\v\xa5;_\xdbjWZM\x03\x11\x89+\x82M\x05P\xd6\xc8\xea\xd5\x01\x81H*C\u05f6\x9aQ8t\xd0\x12d\f\x95ʨ\xd8\x1b\x14:^diB\x93\xf1\x18\xa6\xe1Fj9\xac\xacmI\xf0F̈́fd\t\xd4:\xe1\xa6@`\x80\xe0\xe1\r\xbe\xa1|\xf5\x9e\xe8]\x03߶\xd3H6@_8Hd\x8f||#\x0fwj&\xc1rfԴ\x04\xb0h\b\x01\xb0\u007f]ȏ牄@5\xfa\x03\xc5\x01\xe8\xc1\"T\xcfA\xafJ\xa8\x19\x1d=S\x1a\x15GJ\xbb]H\x92\xa8\x11\x95\x80\xe5d\x8dp\x1b\xb5$\xc3P\xab\x9cpݒ\xdb\x15\xc7Q\x11\xecQ\rV$=Ņ\xb8bl\xd9B\v\x00\"\xdc\xd5LC?\xdbT/>\x01\x87n\x057\x89\x05\xf1\xf4\b\xb8\x83\xfaxD=\x1d\xaduc\x1dE\x0eU6\xbfVd\xc0\xda\xf0Y\xad\b\x9d@\x8b V\xc9}d\x95\x98\x1e<}* p\xc7\f\xa5#\x1b\xb6ᵙ\x9e\xa9A\x0f\x02g\xe0\x1f\x92\x01 \x98\a\xca\f\x85\x8bGD;\xba\aq\x872\xde\xd8J\x8f\x05ɚk\xe0P\x11\x134\a\xadP\xab6\x0f\xb0\xb8\x12\xb0\x1b\x8fu\x06\xb1#Q\xc5\u007ft\xc9\x00\x11\xd0\"\xf8\a\xc4\xd4\xc2E\xa8\x9e\x17\x17\"!HG\xbc[\xb1p\x1b\xfa\u007f\xb6\xe3,5\xbcR\xc6\xd8X8\xd2j\x92\x05$\r+B\x8f\xb7\x8e\x1e\x9b؇\x0e\xf7\x01X\x88\bM.(\xb4\xdd\xdf\xc0\x95\b\xaa\xbaVv\t\xec!\xfd\xf4\x91&\x14\x9dZ%wk\xe5\xcfD\t\xf9\x06A\v\xe8*\xc1\xa2\xf6\x96G|Q\x89\x99B\x85\x19\xde \xe1X<\xdbU\x8d\x01\xd0+\xe0f7\xee\x19\xaa&\x1d\xc8l\xfa\xc7\xcb\x0e\xe29qw\xb8\x8e\t\x83\xa5[y=\nr\xa4\xc1\n\x9f^\x87\xa2h\x01l\x96\xb0g\x8fx\x12\x02\xf7x\f\x00r\x12\xb8 S\b\xe2\x8b\xf9#2\x9e\x9c\xf9l\x13\x9b\xa4\xe2\x81\x1b\"\xcew@&,\x8e\x12=\xd5Um-l\xc9\xc4u\xd0\n\xe88\xd8\xf4=\vt\x90M\x01\xb0\x01\x12\f6\xf4dh%\xe4\x16\x01L\x9aM\xa3\xc80:2`\xab:j\x88\x10\x8a4\xaafm\xf8\x8d0#n<\xdfG\x16\x81\x00\x01\x84\xc8.\xb2\n\xa2p1\xf5\"\xbaI\v\x9bp\xf7ѾL\xccN\xba\a\xe0\x91E\\\xda\x1f\x01\xb0\xf1\x80\xf5\xc9)<+\xa8k\xd8\a\x16\xe23\xf8ѥ\x11#\nL}\x8d\x1b\x00\xd5\x11QԒwR\xd6h\a\x8a\x90+kP}\f\xa4\xe5-\t\x8f4\x1d$d\x03\x10\x94V\xc1\x05\xd2\xe9\x96\x0e`1;u\x8d,mH\xa4l%8\x8d\xa3\x13\xab-T\x9f\ah'\xe5\\Д\xe8<\xa0\x0e\x99s\xf0\xd5(\xb9\x849\x9a\xfaq\xd3h\xd7\xc0\xfc\x15\xa9\t\x8a2\xc8!/\x87\xc8\r\xd0\x0f\xe8\x94%8\x97(0P\x85\t\xf2E\x9e<\xce\xc0\xbb\U001020d4\x9e\x8c8\xfb\x05\xf1\xe1\x01Չ\b\xed\xaa\xd1\xfat$\xcb\xd0\x1fHH\fe(\x11dD\xbe\x1d\xb8\x98\xa7 \xa7\xf1\xb6\x17\xad\x85\xb9\x01\xf7\xb4Q\x11d\x13\x81=\x94'.\x1f`\xe8\x88\xe8AAF\x14\xaf\xbe\xc50\r\xfew\xbe\f\xe2u\x10\x9b\xf2\x9e\xa8}\x9c]\xd26Q\xbf\xf5\x15\xaeXd\x17\xbe)\xa0\x1a\xc2\xe0ķu\xe8\x1b}x\x94\\\xbdX[\xe7\x84\tA\xa4$\xfb\xc32.Tۯ\x868\xb0\xf2\xc6=\xb0\x82(\xa3!\r\x90\xd0d\x90\u007f^\xee\x89\x04\x9c%\x9dV\x97Q\xe0o0D\x8a\xaf\xb6\xd9\xe1\x87)\xbf\x88ܣ2\xa66f6\xfcś\x1f\x12ц\x95;\x966\x8f\xbd\x13\xdbLU\x0f܁\xe22i`$\xa5\xbf\xbf\\3\xbek\xfe\xfbK\xbawMJ\x92k\xca\x00\x85\xa0\xb1>\xf2\xb7p?\x90\x1f\xf7\t3\xe0ײ\xcc^\x91%\xa7\x18\xb4\xefgt]\xa5\xa9%\xddps\x8e\x9b\xa7\xdf\xff\x1ea\xd6\xf8K\x8c5\xf9\xf8%\xcahc\x82\x88\x83K\xa2\xc4\x03\xf3&\x16_\xa9#\xbcM\xff\x11\x85\r\xbd@\xb2o\xbf\x16\x1b<(\xaa\xf8\x8d0\xbe\xb0\xbe\xb7\x05䐑\xbf_\xc4A\xbe\xffL8\xd7\x1c\xba8O\xa1^\x94Rt\xac^\x165\x97\xe2[$EI\xdaB\xe3\xed\x90\xf5\xaby\aXu\xe0\x15\xa9\xdf\x14\xe0\xf1\r0\xb8$/\xd2\xde\x1e\xc2\xf6\x9a]`\xbbU\xa24\x8dv\x126/\xf1\xcf\xf2a0{î\x0f\r\xa6\xd1K\x04\x8en\xa7\xd2\xfc\xc8\xf4\x1c\x1d\x15D\x06\xe2\nd\xc8\xca\x01\x00\x19\xa9,\x8c\x12>j\xa3^\xa1ϩV\x8ep\xfft\\8d\xd8\xee\x1d\b\x11\xb8\xe1\xb5僠M\n\x87\xd5\x03\xef\x1a\xeeKF\xd2\f\x90\xb0\xd1\"\x88\x9d\xd3hA,R_\xb2\x05\xc3!q\xbb\b\x00\x81\xd4\xc8C\x06L\x9b\xbe\x86Y)ق\xa2\xd9\t\u007f\xe9\tv\x06\x88Qڨ\xae\xba4\x91j\xb8\x93\x90ќ\xe4g\xb9Xv\xa1\xf3a\x83\x1351'!\xe2itK\x92\x85e\xf5\x13 \xed\xda\xce\xda]8\xa7\xc9b\xe1!\xac\x8e\x19\xaa*b\t\nx\xa4\xe1\x99/\x14%b\xc9D\x9b\xda\b_=\x15!\xb9f\xebd\xa2\x9c\xf3\xb0\x91\x15t\xa8lG\xe8\ae\x0f&F\xa1˜!EgԪ\x06&&\xe3\x06\xcfset\x0f\x02\x11\t\x88\x19\xc2\xd6\x0f\xc0\a\xf5֥\xaf\x03\x96\xb6\xd2Κ~\xd7\xfa\x9c\x13\xfa\x05\x85\xd2]\xdb\xcdGt6\x8e\x05\x1d\f05\xaaG\xb3)y4\x96\xbfi\x8d\x83\xa9)\xdcf\x88d+c9\xb45\xf5ђ\x87'>3\xe16\xd7hٴ]\x81'\xa7\xadr}\x1b9\xda\x16\xc5L|\x86إ\xf4\f\xe1\a\xc3\n\xa0\x9a\xe9;\x803\xc0\xb9\xb2z\xd0 \xda\xddm\x84\x1d\xfc\x8dW\x80\x12\x11\x1c\xb8\x18\x9a\xd3\xf0\xc8K\xe6\x1c\xec\x8dO\x87\x80\xb7\xb0\x9b\x14\x10\x06m\xae\x811h\xda\xe5\x00C\xd5\x00ŕ}\x15#Y\x9e\xcfN\xfaz+ѰrOMǷg\xed\xb4\xd5@$+\x94g\x8f\x8bc\"\x1d\x10U\xdd\xf1h\x13\x14\x9d\x9d5\x1c`\xad\x18\xe3\xc7ecE\xe9\xa38W\x83\x1c\xdfNՆ\xb0h\xa2@\xb9\x85\x8b\x9c\x9e:g\x84<SB\x81\xaeQy\xef\xe1\xf8\xaa\xcdݵ\xf3\xf3\xaa\xe6G\x18}\xba\xbbi\n\xf4\xd7Z`r\xee\xff\xc4\x1d\x88\xefc<\x88\xf8\xf3ܡ[\xa3\xc1\xa5\x8aQo\xf6f\xea\x06\x18\xb2\x1ay |3ahA;N\x9b\xe8\xe7\xb7E!m\x14\x80m\x83Np!j\x12d\x8c\x83\xd1\x06P\xaap\x82\xf3\xd3\xf2\x97_\xb5\xb5\xfccޘ\xf5O\xbbԈ\xf1UАx\xf9\x91L\xa3s\x8d\x87P\x1b\xbd\xb9\x844ޓ5\xd0̐n\u007f\xad\x02\bd\x8cX\xa3I\nE\x8d\xf1\x10D\xd3\xfc\xa0\xaa\xe1\xf8\xe9!:/\u007f\xda\t\xf6G\xdf8\x8d{\x10@\xa8\x01\xb9Bm\xb1O\xd4j\xd3\xe6R\x81\x14\x9b\u007f\x1br\x04\x00\xac\xc2\x1b\xf0rV\x81\xb8\xf0\xc8C\xedG\x17IJB\xe26\xe5X\xe18|\x98j8F^\x8d\xd6F\xa0w\xbd\x1a\x97\xafġ\xccL;픤\xc6U\xfd\xb9\xf8\xf0fz\xab\xe3\xf1\xbdw\xe4P\x1b\xeb\xfd\xb3-9ǘ\x1e\x83\x80\xf3\x05\xe8\x808DC^Ě\x8c\x8a@Q\xcf\xf8x\x84\xe0\x1b\xad\xcaZ\xdai\x1aT,4\xa4X\x80\x17\x98\xc4Jh:Ձ#>4\xea6M\v\xfb\xeb\x05\xe4\xd7di\xb4\b\xb8B\xc1Y\x94a\xc2!\x19-\x98\xf7,N3\x10\xdev\xa9RH0\xff\xfcD\x18b\x06\x91a\x046UP,^\xf9_t\x0f\xd5\xd51H\xda\x1f\x84\xdd\x0etϲh\xf8\x8a\xa9\xd1V*\x80v\x0fЎ\xbc\xa1\x8a<\x82?dM\x8e\xd0\xcc\xe5ջv̈́\x860\x96\x8e\xecг\xbbi'\x18\xef\xd9\xee\xa2Ӈ\x93\x1c҆$\xfe\x85k\xf9\xf39͇\xa3'\xe4\xd8\xef\x80\b\x8e\xe8\x0e\xf4\xddhY[\x1a4\x0e.\xe5\xa87\"˯(]\x12;\x9cXB\xe3\x95\x15\xeb\xd1ƽL\x91\xf6\x1a>\xc1ǏW\xa4\x89\xd7f\xa2Ww\xd1xW\xa7Lh5\xc2PF\xa9\x15;\x98\xe85\xdaO[\xcf:\xa6\xc0O\xa8\x18\xb4(\xa4\x03\xc0q\xaf\xa1\xa1b%o\x0f6\\'k 2\b\xae\xeb\xbc\b \x87\xa3\xc7祦]SG\xed\xed\x19\x81\x03\xe4nў\xe0\f\x01\x1d:h6(\xcf|\xd1\xdeBA\xf1\x01_\x9a\vr\x93\xf3\xed\tK\xc6\x0f\xf0UG4\b\xb3\xc3eP\xdaG\x9cOϢHP\xfb\x14\xb0\x97\xf0\x84͠\xe1*\x10@o\xc2T\x91f%\xf3\x94\xc7謽\xa2\xfc`\xc7\xfb\xdd]\xa7\xd5\v.\x13*\x83\xaf\xad\xa2\xc74B[\x82YP\t\xd4\a\xe3\xbaN\x9f\xa0\x83\x97}\x04s}\x03\x93S\"\xe4\x88\xc2\xf0#\xb8\xaaf\xa2Y\xbb\x8e \xa0\xa8U\x9b\x84I#W\x8d\xc0f-\xe6qR\xe2=\xf4+J^\xfe\u007f\xe3\x12\xfb\xc1\x93\x91\xd6*\xb3\xb1\xb1\xebI#&\x9f\xc7\xf0\xeaW\x13\xb2S)ev\xde\x10\xfb\x8b\xf3\xf2\x19I\x81\x9d\xa3\xacł:\x12\v\xc9\xe1\xd6A\x1c\xc3\xff\x16\xf0\x16wD\xe7\x13f\x8f\xbf\xd4\xe9\x1cO\x19\xe3m\xc8T\xc3\fꨙ\x01㚕\xc1\x85X_\xcax\x97\n\xe6\xd0W\xf2P\xf9R\x0f\x9d纷-DL\x918\x9a\x9a\x82\xc2b.\xa0\bS\xee\x11\xb6\x19\xf7\x02\xdei\xd2\x05']\x1d\"\xb8Z\xae\x8eBm\x89\xc0\x05\xbe\x94\xebQ#\x9a\x95\x03\xcd\b2\x9d\xf0M\xc9\x1b\xda\x11YQ5H\xc8Xo\x8a\x0eL\n\x93\xf4\xee\xd7\xea1\xbc\xb2\xedX!t\x13\xc2\x12<t\xd8?\xea\xb9cE\xf0\xca )E\b\xb1\x91\xe9x\n\xfd\x9d\x9eX\x03\x01\xa9+\xf2R\x90\xdf2\xd6|Ė6\x9er\x1az\x11Lr\x85\xaao\x98\x94\xa2C\xaf\xe9Ft\x8b\x0e\f\xa0P\xa06\xec\xb2\x05\xb0;ݐAծ#\x115\xec\x9a<Q\xea-\"\x9a4\xe8\xef>\x0e\xf7\r\xdf\xd2\xc1\x9d-\xd7\nq\xf9\x86\x9f$\x97\xd29\x9aї-\xde[\x9a\x80\x15\xed\xfe\xe9A\xd5\x1a+Q3\xf4\xe5I\xbfu\xb4\xe3#\x86\x8a\x95\xa7\x8ax6\xf6P\xa7\x8bBC\xb1\x14Įƴ\x83:kxk\x9b#V\xedv\x1a5\xb6_v\x8a\x02\xee\x80\xddD\xc6\xc0\xf4\xcdmt\xda\xf6j\xa05)Y\x04\x1e2\x00XD\xa9j\x14w\xe9\xe6\r\xb2\xa1o\xb4\t+\xaa/\x03\xfd\v\x10\xa5銙\xf6MiaH\"k^ûv\x94xі(f\x98\x90jsf\xbe\xed{mެ`~M\x8d\xa2(+\xc0\x03پS҉\xa8\xd5\x03yL\x92Y@i\xa9\x05_\xa8\x84\xbc\xb35H\xbbJP\xe2Ld\xefhʣ\taۗz\x9e\x9a~\f<\xef\x14\x1ei\xcb\xefm}e)M\xccw\x8e\x9a,\x8e\x830\x8c\x91[\xecE\xbe^ӵ\xbe?\xc7@NA\xdbJqcI\xe6\xaeV\x8a\xef\xee\xf9\xe1,\x9a\xd1f\xa3\xa6\x10ch\x9cts\x82\x16{\xcaغ\xfa9\x16C\blQ\x02:A'R\x97B\xab\xfe\xc9\n#ا\xb5\x06rܢ3\x14H\x05\xa8令\x9c \u058b\x16,\xa7\xc8ϪfV\xbe\x8f\xb34f{\x12(\xea\xb8\xcf\xf5\x18\xdf\xe3\xa8 \xdaq\xe8\\\xd2F\xd6ȃ\x93\b\xd4\x02-AO\x9c\x82w~\x87\xec\xea\bE\x93\xef\xa1\xf3i\xf1\xb3\xa1Iw\xcbN\xf2Ԉ\xef\x0f\b9\xe41\xa6\xd7l \xd4I\x87fiHSJ0j\x926u/C\xe7SP\xf2\x13Y\xc6\x03]&\xf5\x03d\xb6\b\xb0\xe3=t\x9e\xca\xd3\x10\\\x17\xe7\xaa\xfd\x14\xa0YCE\x145\xecOJ\xe1\xf07Y\t\xcfC\xf3\xf1 \xc3t8*P\x8aӊ/\xd9i\xc7Y\x83\xe4\xd7\xe7X\x88\xddf}\a\x16t\xcc\x06\xed\xf7\xd9)\xd2&\x05\x99\xd82-W3\x95\x90u\x00\xf5`D@B\b-:`\xdexI@\xf1!;M[\xd32o\xe1\x19\n\rr3x\xad\xb3\x92ۃ\a\x18+\x8f\xa8\x85ِ%\xacΨ\x00\xf0\xf9\xea\xb8\aB\x98<!۴ö鸭\x03yM\xe7qy\x90b\x84\xb0n\xd6۲\xea\xef\xe8\x1e\x9bq3\xc6\x13;\x80.\xa1\x90vt\x90\x99\xa8\t*\xc1\ra\x8d\xfaA\x8a\x10_\t\xa4\x1d\x13\x1fu\xb4\x01\xe0\xd5q\x99(\v%\xe3V\x9f,\xa4ǳ\xce\xdf\x1fu\xffД\x12\xe0\x8a%I\x16j\x02<\xdb<\x049\x87\x83\xa6\x90\xb6]csq2\x92\xba\x0e0ՠ\xe1\xd48\x1b\"\xac\x93\x1b\x9e\xfcu\x14\x192\xe4,\xec\x0f\n#c(\x14V\xb8,Ö\xa2\x03\xb9\x12\xed\xf8\xe1\xc4u\xb8U\xe4\x1a\xa6 \x1dq\xedo\xd8r\xe4\x16\x89Q\xd3Y\x16\xda\x13\xde\x00\xbc|\xd6F҄\x19H$BU\xbbP\xda\x14\xd7N\xa6\x8e\x84z\r\xe1&Mɪ\x05\xe2r\xc0\x80\xd4\xc5ArgK;\xe7\xf8\x89\x90\xc0=\xbcGX\x1eK\x87fJ\xf262j]\x88<NЩ\xd6\x067\xf4\x8c\xc9I8aV\xf0dFu/{\xa6\x06B-S\xf5\xf2\x1d\bfd\xbeΊ`\xfe5\xc7(\x94\xec\xf7(,ځ6@\x8a>\x0f\xbcW\xd3\xd6z\xdf\t}$\xa9\f\x9ci;q\xa5w:֚L\x18m\x00\xbc}D\x00\x94\x05)!\xe7\xb9Y\xaad\xa0\xb64\xe7\xdaJ\xe3\xd0^\xeeqs\xa1/\xb1\x01\x81:\x1dO#Ш\x9f\xb3\xb05!\bHT\xd2$\xb05b\xa5_\xc98\xe0[\xd4`#\xe3\xb9Z\xc7\xe9R\xbf1;\xcd\auz<V\x9d\xfc\xc7RED;\xeeĒN\x1fE\xf1Yډ\xdb<:\xe7F\xa9\x0f\x8d\xc9\xde\xc6\a,\x14\xe4E\xa9\x8e\x16\xd1\xd9pUԱ\x18\x98\x1c\x8e\xd6\xd9\x02dN[\x9e<qWM\x87\xbf5\xff\xd2>ܒH\xacU\x03B\xb8\x00fF\xc8S\xe6<`:X\t\xc0\x0e\x9d\x90\x8aC\xfb0\xd0\r\xf7y\xe7\b:\x8b\t\x82\x15\xcduS\xd1\x19\x1c/\xfe\xa0\xcaԎ\xf1\xa5\x95~\xd5\x01\xe8p\x1c\xda\x06>\x04\xe5\x0f\xac\xa2)\xf5\xde\xe5\x0e\x1d\x1fG߭\x88hzy\vE\xfb\xa3\x1e\x9e\xe2R\x06V\xa1\x86ڕu\a\a\x97\xf6E\x90t\x9a/\xb7\xcf!2\"\xac\x81\xf6E\x00\xea&\xc1N\x84?T\x99\xb2F\x19\xa1\xab\x03q3\b&\xb8\xbdR{\x11٠\xdfk\x01!)>\x9a\x10\xf6ց\x00L?U\x8a\xb3\n\b\x14\t\xa1ЩPX%\xabd&A.\b\x96\x95u:R#\xf69\xef\xdb\u0085\x8en\xcdU\x06\xe4v\x01,}\x87%8\xc8\xe3\x9c\xf4+<2\xd9\vk\xb1 \x1d\x83\xfap\xce\x05\x91F\xaa\xd1^\xf4\xaf~\xe7@}\xec\xcd-\xc0\x18\xb5\x93\a:\x01\x85\x8f\xac\x8f\x88r\"\x80z\v\x1e\aa\xed\xc1\x86\xe9\xf0Kn:\xa9\x1f\"ܛ3\x1aE\a\x88\xbc\x9c \x05\xa9\xa3\x9b8z\xe8\x93B+\x1854\x17\x85-\xa5\xa2Q\x02\x92`\xeb\xc8\xd3\xd6N\x1bjy\xbc\xa12\xfd\x8f\xcdB\xfb\xd3r\x8b\x1b'd\x0e2B\xb4RJBchZ'2a#\x8a\xb8\x1f\x1d\x94\x85\x0ed\xf1\x0eRN\xe7\xa1%?t\"@^\x82\x9c՜vF\x90!F\x0f\x95\x0ez\xe3\xc8\xc0\x9e\xa4\x83\xe4\xac\va\xaf\r&\xe8[\xc7zᐉ\xc1\xa0\xac58O\xd2\x12\xb8\u00a0퇍\xfa\xb8i\x9f\x10\x1cU]FX\x9f\xf3\xe2[F}\x96H\x93\x01\x13(\x87\xa2Ѣi\xd7F~R3v\rB(\xa4W\x9e[\xa7\xdc5l\x04\x04UG[\x9b2ġEB\x9a5ш$]G\xb8\xe8v\xfd\x02\x86Ny\xe1&5i\xd7\xe6\xdb!څ.\x11?>\r\x900\xe4\xa8\x11T_\xa7\xd1%u5G\xcd\xe3\x89X\xa3\t\xb3~{M\x9b\x93b\xe5\x04\v\x00\x92\x941\xb4\xa6}\xb1\xad\xd3\xc2\x17\x88\x827\xdapY\xc8,\xa9\xadcJIO\xcd\x1a\xae\xeb܋\xceHj\x9f\xb4&t\x86N\x12\xc1PMg\xa3Lgm[L\xea=\xd3^\xc1\xd1I\x16\x9e\x01\xd0\x16d\xb8\xb6f\x1au\f\xd2\x039\xf4\xb0\x8eVhl\x0ev\xf6\x91[2\xddR\xd2i\x94\xba\x96\x0e\xfa\x83\xe0YL\x80\aw*[\x8d);\xe9\x03)<\u008d\x86@\x86\xa1\xeb:\xfc\xdd4h\x18\x11\xb6[:3\x8d\x06\xd4\x01\xe7d\xf0\x930\x87Tm\x01#,rS*a\xe5\x13t\x8e\xd7\xcf\x0eW\xb1f\x00a\x8a*\x8f\x0e\xf9j{r\xea\xa0 j\xa6\xc9D\xa1\xeby\b\x0f\x90\"x\xee\x11\u007f\xe6\x906s\xf8\x15\xf5DV\xd7Ёϼ\xec#\xb5\xfc9bE\xe9\x14\xac\xa3\xc1jE\xe6\xc8`\xa7\x04i\xa0\x95r\xd4!\x1a\x1a\xd9\xe9\xe0.\x18\x91u\nCGƒ\xf6ޛ\xfaH\x03\xf2\xa9q9\xfe\xa7꼋68\xde\xf1\xfd\xac\xdf~\x18\xa1\x03\xbf\x88\x9aM\xc6ͻ\xa2\xf3{\xa8*\xa3t\xe9\xe9\uf2f1*~<\x89\xda\xfc\x87\xe57\x98\x0e*\x19@\xd86\xf6\xf6j\"\xac%\r\x83\xa3\x12\"\xe2j\xcb\x1dyH\xb2\x90\f\xc2z==Q\xdft\x1c\xc5v\xb6i,\xdau|5,\xaai\xb4\xaa\xf9\xd4\xc4%\x1e\x9d\x8f\x8f G\xee\x0e\x98\xd6\x1e1W+:^t\xe3\xf3\xa2\xb1\xeb\xf8+(\xe4\xb3\x15)yO:2Ϊ藣\xb0N@\x05\x1e6\x03\xcc5j\xa2\x82\xaaձ\x1bX\xf1\x98f\x88 u\xd5A\xf2\xacCE\xb0\x17\x1dw\x9a\xce?\xbc\re\xfcH\xfb(r\xf4\x01FEs\x8d\x81\aB 5\x9d\xac\xab\x8a\x84~KaG\xa4\x1f\x0f\xb2\xe8\x8d\xf5E\xc3a\x80\xa6N\x1b\xd3\xe5\xef<\xa2`L\x89*\xdaXx\xd9b\x89dcȮ?\xce\x01\x12U\x1a\x03\x91\x9c\x17\x16\x99u5\x8av\xa6f\xef\x04\x13:\x06-dJ\xb7\xb6\xf1t\xee\x99\n\x86\xe2\U000d751d\xe9e\x1aV\xe88\xd9\xde\x1a\x05k\xfa\x89ȧf\r0\x8eW\xa7\xd8dB\xd0\xf2\x9f߹\xc17\x91ۼ?;%\x1f\x03\x02\xf8k\xf2$\x97>\x83\x01\xa8z\xddxG\xddx\xa5\x8e\x12~_W\xa8\xa6w\x02\xff\xbd\xfcǋH\xafUH\xf3\x16G\x18\xb4\xbd4\xbaꀈk\xac\x9e\xdfdz-^\x03Y\x0f\xde\b\xe8kI\xbf擡눋ѩ!\x98\xba\x13\x91\xae\x9b[\xbf\xfcN\rp\bBa\xf3\xe7\x80\xe8iݤ\x91\n\nC\aRt\"N\xbf\xca\xd2t\xc0Rg\x1bc\x003\xb3~\xd7\xe9m\xf0rk\xbe\xcb\x1bm\x9d\x85LC\xbfҦS\xa2\x10f\xb4'\x121\\\x8f\x06\xa5'\x13\xae\x00\x87x\b\"\xc0\x02\xe3\xe4\v~\x85wD\u05fd\xfd\xae\xf9Q\x1c\x18_\xf3h\x10C\x16\a\t\x99\xac\xb3\xfe2\xed\xb7W\x9a:\x86\xb3\xa3\xd7/\x8bI\xbe\xcb\an\xed\xe3!\x1aOq\x1a\xc0\xb6\xa9\xb3T\x14w3\x80\x80{\x0e\x1eG\xbb4\xfb_R\xddS\x9b\xbc\xba\x1aj\xfbh\x802\xf5\x1b\x8bH\x06n\x02\x0e:\xfa]\x1e\xd70U\x00\xde\xd6/\xe65\x9dxPuvn\xc1\xe3P\xd0\xda\n\xa0\xf6\x9b\a\xe0I\x80\xe9Ϗ\x9cS\x9d\xbd\xaf(W}v?\xbe\xf8\xbf~\xe6\x8d4\x8f\xda\xdd\xfd7\xee\xbc\xd10\x81\xb1\u05f6\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00\x06bKGD\x00~\x00~\x00~Vs\xfc\xb0\x00\x00\x00\tpHYs\x00\x00\x06?\x00\x00\x06?\x01 \xca\xc0h\x00\x00\x00\atIME\a\xe4\t\a\x0f\f\x1f\xfb'\xac8\x00\x00 \x00IDATx\xda\xed\xbd{\x8c\x1c\xd7y'\xfa;\xd5\xcf\xe9yt\xf7p\xc8y\x913\x1c>\x864\x15\x92\x1e1$EI\xb6W\xc2\x02\xb6\xecH\x01\x92\xf5M\x16\x11\xe0\x18\x9b\xac\x85\va\xe5\x00\xbb\x1b\xfb\x02\x9b\x9b\xcd^DNp\xef];+\xe0J\xeb,\xb2\xc1\xcaw\x91(\x0e`;\xb1d\xec\xaes7ы\x94)z(S\xe6\x9b\x1c\x0e\xa7\xe7\xc1\xe1\xf4\xf4\xf4\xb3\xfaQ\xe7\xfe\xd1szNWWU\xbf\xaa\xbb\xbag\xbe\x1f0\x98\xee\xaa\xf3\xd59u\xaa\xfa\xfb\x9d\xef\xfb\xcew\x0e@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\xec\x02\xb3\xf3b\xbfs\xf6\x04\xa7.m=\xfe\xfd\xf9ˌz\x81@\xe8N\x1cx\xfe\x9c\xa1\x9e\xbc\xfd\xfa{]\xf7\xbb\xb6\xad\xc1\xbfs\xf6\x04\x9f95LoG\x1bp\xe9\xe22\x91\b\x81Х\xc4\x118\x193<\x9f\x9a\rv\x1d\x91\xd8\xd2\xd0Z\xc8\xe3\x0f\xf3\u007fDoP\x1d\xf8\xdfܿK$B lc\xe28:\xd9\v\x00\xb8:\x97\xecZ\"i\xba\x81\xd5\xc8Ì8r\x8b1z\xb3$xF\x83u\x13\xc9k?\xbc\x8bw\xee\xde \x12!\x10:\x98<\x04q\x1c\x9d\xec\xc5չd\x898d\x88\xe32\x99\xa4f\x83\x1dO\"\xeeV]\x98\x88\xa3>\x88~\x91\x89$\xb7\x18\xc3\x1f\x8e\xfeQM\x16\t\x81@\xe8\\\b\xd20\"\x0f\xfdy\xbdE\xb2\xa3\b\xc4\xcaUE\xe4Q\x1b\x91\xe8I\x04\x00\x11\t\x81\xd0\xc1V\x86\xdddsu.\x89\xc0\xc9\x18\x0e\xa0\xf2ڝd\x95\xd8J \x97..\x03'酲\x95P\x96\x1f\x96\xfe{\x86w\xe1\xd2\xca2h\xb2\x02\x81\xd09\xc4!\xc76\xe4\xb8Fj6h\x1a0\xaf\a\xfak\xe8IE\x8ddJ\x9f\x17~|\xa9\xad\xe4\xd2\x12\x17\x96\xde\xd2\xf0\x8c\x06\x9b\xb2>\x9e[y\xa9!\xb9\xef\xef\xf9\x96-\xf7\xd3\xee\xfa\x85\x15\x92\xbdv\xa1\xfc8\x00\xb0\"Q\x13\x89\x10\bΒG\xe0dl\x930z\xab\x967\x8b}\x98\x95\xadf\x99\x94\x13\xccV\xe0}\xfc\xe9\x19\xdeN\x12\xb1\x9d@\xecvS=\xb7\xf2\x12\x0eN\x85\x1a\x92=\xf6\xe1W\xf0\xf1\x91\u05fa\xba~\x19|\xfd\x16\x10\xa6\x1f/\x81\xd09\xe4a\xae\xf8\xf5\x96\x89L\"\xa2\xac\x1c\xf30\n\xa2\xcbװ\n\xc0o\x95\v\xb6\x95D\x94\xed\xfe\xb0\x85\vh\xa7\xd6O \x10\xec\xc3\xf8\xd33\x86\xe4!\b@\xfc\xb7:o\x05\xb9\x8c<{K\xfc\x19Y'z\x19ߘ\x1f\xe3Oϴ%\xa9\xdb\xddm\x0f\xf0\xc8\xe3\x13\x96篽{o[\xd7O \x10\x9c#\x0fߘ\x1f\x80Z\x13\x01\x18)|يhd教\x05\xa2\x87 \x91V[\"]e\x81TS\u07b5\x96\xe9\xd6\xfa\t\x04\x82\xd3\xe4Q\xae\xb8\x85\xf2\xd6\xff\x17\x10\xb1\t\xb9\xac\x15\xb9\xe8]X\xf2q\xab؈l\xf5\b˥\x1d\x96\x88\xbb\x1b\x1f柼r\xc1\xf0\xf8\xbfx\xf1̎\xa8\x9f@ \xb4\x874\xe4\x11=P\x9c\xf1\x148YI\x14Fy\x1e\xc52\xc1\x9a,\x06=\xf4\xf1\x8fZ\x03\xf0\x02\x81\x93\xb1\x12\x01\x11\x81谖\xe5;\xba~\x02\x81\xd0:\x18/=\"\xdcV\xc1\xd2g\xab\x8cr+2\xa8F&\x8d\x90\x87Y\x80\xbdծ\xac\xae$\x90\xfb\xc9\xf8\x8e\xae\x9f@ \xb4\x9e8\fgL!Vuv\x94(/ʥf\x83\xa6\xa4`䲲\"\x1b\xab\x18\x88\x13\x19\xecnzm\b\x04\x02\x91\xc7֚U\xfaQ\u007fE`\x1c\xb1\n\x8bA\x1cӻ\x8d\x04q\x88|\x11#ח8&\xd7/\x93\x89,cD\"զ\xff\x12\x81\x10Z\x8a/M=b蓻ɳ8ļ\xa6r\xdd~\xfe\xcf\xef\\av* z\x93Z\x0f\x91u\xdd*\x97\x8cQn\x87\x99\xc2\xdeR\xda汆z\xac\x89Zfcɖ\x8bՌ\xafv\xc4?\xba\x96@\xfe\xf4\xdf\xfc\xe3\x1d]\xbf\xdd\xe4\xf1\xc5\xfe\x93\xf8X\xbd_y2\xfb\x10\xa6\xe7\xb6\xc1\xf9'\xf6\x1f\xe6v\xacf\xac\x1f\xbdک\xc8\x1auKt\xbb\xbc\xd95Dֵ\x9d~}\xb3\xe7Wk\xdb\xf5\x01k\xb3eۭ\xfaE?\xa5\xb7\x9a\xbbK\xfe.ΛY\x1e\x85d\x9e\b\x04\x00~\xf8\x9d\xcb\xf8\xfco\x9c\xa8Z\xa6\xd1\xcc\xf1N\xaf\xbfUx#>\xdbй\xedp\xbeYX%\x96\x99\x8d:\xf5\xd39\xf5#J3\xe5b\x96ɼ\xdd\xe4\xab]\x03h]pXn\x97\x955\xa2\u007f\x8e\xe5\xef@oեK\xcc\\d\xb5XD\xe6\xc4\x12\xac 4y\x9d\xac\x1dO \a\xa7B\xf8\xe1w.W-\xb3]\xebo\x15\xe4Q\xfa\x15\x8bQ\xfb\x95*#\xfan;\u007fS]\xb4U\xf1T#\x91jKzף\\\x9aUN\x9d,_\xed\x1avNQ\x15\x03\x80Z\xefKO|V\x04ӌ\xe5eD\xa8V\x04k\xe5\xbar\xf5\xba1r\xf6x\xc9źt\xfe#\xdbH\xb7\xeb\\XN+\xe8n$\x88j\xd0+\\S\x97P\x95s\xdb\xe1|3\xae\x96V_\xa3\x9e\x91\xeav\x94o\xf5,#\xb3\xd1~\xb5\xec\xefV\xec\xe1a\x14\x1f\xd1\xd7]Ѿ\xc9|E\xdbԈ\xafD\"\x02#g\x8fs\xbbH\x84\x82\xe8\x04\\\xc9>\xb4\xfc^\xeb\xb9\xedp\x9e@\xb0\xb2&\xcd\\p\xcd\f\"\xac\x88\xaa\x16K\xc5\xca}\x18~FE\xf4M\x9f\xa1Eb\a\x89\x10\x81\x10\xf0\xe2\xd0/\x19\x1e_V\xe7\xf1F|\xd6\xf4|5t\xb2\xfc\xb2:\x8f\x977>\xb0M\xe1\xd8e\x89\x10\xaa+L\xbbg\x18\x89\xe0s5R\x90cXf\xcf\xda\xe8\\=K\xb9\xd7CbV\xef\x9cl\x15\x19\x91\x88]\xa0\xc5\x14\xbb\xac\xfeV\xe0\xef6ޫ\xaa\x88\x9bq\xfd8-_\xed\xfeZ\xe1r t\x1f9\xe9G\xf0\xfa\x91}-{\x99W[⽞\x89\x04\xad|\x0f\xed\xb2Bh1\xc5.\xaa\xbf\x15\xb8ɳU\xcf7\xa3\xbc;A\x9e\xd0\xdd\xd0/&؊$99{\xbcZ<C\x94\xa9\x96\x01n6\xed֬\xac|\xddZcBVה\xaf\xa1_\br\xc7Z \x00-\xa6h7\xbeWe6\xd2\xf7\x9a\x9c\xad\xd4\xed\xf2ͺ\x1c\b\xf6\xc1n\xf7\xd5\u008f/\xb1⢉\"\x9b<X\xca,O\xcd\x06K\x81i9\xdb\\\x9f8Xˌ2\xbdec$'f\x97=\xfa\\\u07b2\x9c\x15YU++/\n\xb9c\t\x84\x16S\xb4\x0f\x87\x98\x17\x8fxw\x01\xd8\n0\x8b\xef2\xac\xceu\xeby\xbb\x88\x85\x88\xa3\xb5\x90]<\xedZeV^J]\x90\x86\xbc\xbc\x88QҞ>Fb\x94)^\xbe\xb4I%\xc9\x14\xafi\x1eC\xd1\xe7\xa9\xd4b\xfd\xb4\xb2\xbfh1\xc5.\xac\xdfn<5p\x0e\xcb\xea|I\xd1\xca߁\xe2\xd4\xd7G\xbc\xbbL\xcf\t\xc5\xdcM\xe7[1\x9d\x97\xd0\x1a\xcbN\x0f\xbbId\xe1ǗX1O\"\b5\x92)s\xf7\b\x85/\xea,\x8eܷȣ\xdc2\xb1vS\xe9\xc9\xc3(h/\xbb\xb0\xe4r\x15\x840\x99\xb7\xb4r\x8c\x88\xc37\xe6\xb7=\xb1P\xa1W\x94 +Z\x81a\xdf>\xc3\xe3\xd5\xceu\xc3\xf9V(9'VB\xddI\x16H;\xac<3\xe5\x1a}\xd3W\x9a\xc5\x14~F5\x8c\xc1跳\xad'\xfe\xa1?o\x96\x03\"V\xf5\xb5z\xe7\xda\xfd.\xd24^\x82!\xacF\xe9\xd5F\xf0\x9d~\xbe\x95n\x96f0\xf8735\x97]\xfb\xa5K;\xca}e6ʶ\vK\xe7?b#g\x8fsW\xaf\xbb\x82H\x84Eb\x15\xbc7\x1a\xfd_E\xccp\x8fs#\vC&\t\xbduQ\xbaw\x94[^%2ѭ\x04\xac\xb7ЄU%\xdfW!\x99ǎ\xcd\x03\xa1\xc5\x14\xed'\v\x82\xb3P_\x1b\xc2\xc8鱚\xcb/\xbe6\x0f\xdfWVwD\xdf8\xb1\xcal9iTn e\xb4|\xba>\xd0.\xc8@\x8e}\xc89'2i\x98\x05\xe8\xf5n.y\u007f\x113\xe8\xd7\xc1j\x85\xeb\xaa+\t\x84\x16S$t\xb2\x92\xb3\xc3\n\xb9\xf4\xc1\xf9\xaaefN\x9fݑ},\xef\xc5\xd1\n\"\x91\xad\x10\xd9b0z\xaer\xd0\xdbJ\xa1\x9b\xed\xd3aD\x1a\xb2{\xca(.\xa2\xb7dD\xf9j}\xa1'\x0f\xbb\xac\x8f\xae#\x10ZL\xd19\x8b\xe4\x98o/\xaed\x1f\x9a\x9e\x13.\xa3N?\xdf\x0eW\v\xa1u$\xddJ\vD&\x8fF\a\az7\x92\xd1\x06SF\xdfk% \x99@d\x820\xca\xf3\xb0{\xcan\xd7\x12\xc8\xc0\xa0\x0f\x1bkj]\nz`зm\xeao7>V\xef\xe3\x98o/\x8e\xf9\xf6\xd6u\xae\x1bη\xca]g\x97\x05\x12YZ\xa8n\x81\xec@\xcbC\xf4q\xbb\xa6\xf1\x1a\xd5m\xe6\xc22j\x93\xfe\xbb^\xe9\xeb\xc9@o\x9d\xc8\xf9'V\xf2z\x17\x95L\x1azR\x11\xfb\x82\xec\xb8\xd5x7\xd6Ժ\x15\xf2\xa5\x8b\xcbۦ\xfev\x10\x06P\xbeР~\xc4nu\xae\x9b\xcew\xeaj\xbc\x04g![\x1fղ\xb6\xcd\x16/4ڒV\x1cד\x8f\x9e\x10\xf4$d\xb4ۡ\xdeu%\u007f\x17$\xa2\xb76\xc4w;\xddV]\xe9ªW!ߌf\xb6U\xfd\xad\x04\xad\xc6K \xc0\xd0b\x90\x83\xd8\xd5\xf6\xe00J.\xac\xb6\xec\x8a,Sͺ2;'\xe4\xf4և>\x88n\xe72\xee]C \xdf\xdf\xf3-\x1c\xfb\xf0+\r\xc9Ά\xfe\x10\x9e\xe1]]]\u007f;\xb0S2\xcf[E$\xb4\x1ao\xebЎDB\x19FI\x84FDRO\xbb\xac\xf6>7\xb3D\xaa\xb9\xc4\xcc\bKoy\x88\xfb\xf1\x8d\xf9\x91\xba\x91\xb0\xbd\xbf\xba\xc2\x02\xf9\xf8\xc8k\r\xc9y\xb6I\xfd\xad\x86\x9c\xbdݭ\x99卜\xb7sGB'\xc8c\xa7\xac\xbfe\xc7\xfe\xea\xf5B\x1f\xdc\xd6\x13\x89\x91R7r-Y=/}\xf9\xd2}\xc2\xdc\x15\xa6\xaf\xcf(\xdfC\xb6>hK\xdb\x06\xf1\xdc\xcaK\xb6[B\xdb\x1dV\x99\xddV\xc9y\xdd~\xbe\x93\xf0\x85g\u007f\x85\xcc\x0e\x13bne\"\xa1^\t\x9b\x11\x81Yp\xdc\xcc\"\xd2/ubF\x84F\x03\x01#\xf2\xa86\xa1\xc0,\t\x92\xf2@\xea$\x8f\x99Så\x99S\xb6\\\xf3\xceKۚD\xba9\xb3\xbc\x132\xd3\xed\xb0\x06F\xc7Gk.{\xe9\x03\xec\x18\xb43\x91\xb0\x96e\xcfk!\r#W\x93L&f\xb3\xab\x8c\xdcYV\xf9(2\x89\xb4cڮc\x04\xe2\x19\r\"\xb7\x18ky=\x82<F\x8f\x0ec\xc3\xc6͝\x0eN\x85\xdaF\"\x9e\xd1\xe2\v\xc1B\a\xc1\xd7o\xd10t\a \xb2\xb4\x80K\x1f\\\xac\xab\xbc]\xc4\xd5M\xd6H\xab\x12\tE\x12\xa1\x1c;\xb0\xb2<̎\xcb\x16\x8c\xfc\xd9\xcc%fz\x9fU\xf6\x0e1*#\x13G\xbbȤ\xad\x16H\xbbHD\xe0\xc8\xe3\x13X\xbcj\xcftZ;\xad\x99Zȣ\x9d\xa0\xa5L\xecu\xb54\xe4:\xf9\xcd<.\xfe\xe7\xf7\xeb*oG\xbd\xddf\x89\xb4+\aD&\x92Z\xf2<d\x19\xbdҖ\xc9b\xcb\x05Un\x9d\xe8\a\x03\xfa\xbc\x10\xfd\x96\xbb\xf2\x06X\xfa\xf6\x1a\xb5c\xdbL\xe3m\xb9\x82\\\x01F\x8f\x0e\xe3\xfd\xbf\xbdn\xebe'\x0f\x06\x81;\xedS\xf0\xed$Z=\xba)\xb3\xbcS3\xd3\x1bAt\xa6\x17\xe1KI\x8c\x8d\x8c[Z\x1eљ^\x04\x10\xdbQ\x96\x87P\xb0\xad\x9c\x81\xa5_\xcaDo=\b\x8bB\xff\xdd(\x89O\xfenuO\x1fΖ\x93c\xc5ZZF\xa45\x997\xec\x03#\xf2hE\xf2\xa0\xa3\x04\"+\xc8ܲ\xfds\xf2o\xaegJ䱶n\x8f\xf96\x18\xf2c\xeeV\f7\xa3\x19\xa4~z\xbb5\xc4j0\xdd\xd73\xbc\v\xd96\xb9\xb0\xba5\xb3\xbc\xd9\xf3v\x8e\x8e\x9bQ\xe8G'{\xf1\xe1l\x91Dpi\xc1\x92dv\x9a\xe5\xe1\x14\xf4\xee(+\x85mtNV\xfcF\x9bH\t\xeb\xa4\"\vݠ\r\x95I\x86\xc12\x02\x13\xff\x05aȤ\xd8\xd5.,\xfd\x88\xbdU\nX\x8f\xb5\xf5\f\xbe\xf6\xea\xef\xd9r\xado\xbc\xf0\a\x18\f\xf9[\xda^A\xa6N\xe4\x8dtsf\xb9\x1d\xe7\x9dv_\xe9\x95It\xa6\xd7T\xb1l\xb9Cv\x06\x81\xb4;\x0fDX!\x00`\xb4\xb4\xbbY\x8c\xc3(\v\xbc\xde\xc1\x86\xd5r%F\xb1\x19\xab麭&\x0eG,\x90v\x91\x87\x1e\x1f|\xe9\xafq\xfa\xcf\u007f\xa5\xec\xb3\xd91\x01q\xae\xed\x96\xd9\xf2\xc32\x12iG \x9d2\xcf;\xc7U#O\xf9\xac\x96żS\xd0\xee<\x10\xa1|e\"ѓ\x88\x99ա\xb7\n\xe4\xe7X\x16\xb7\x90\xf6\xfc\x90\t\xd1\xc8\x121\xb2\x84\x8c\xac\x9fV\xbb\xab\x1c%\x10\xa7\xc8ÈD\xac\xe0\x14qX\x91H\xab\xb1S\xf7D\xb7+\x91\xb0\x15\x99\xe8Fk'\x15\x8f\xed,\xd7U\xbb\xf3@\xac\x88D\x0f\xbd\x85b\xb6*\xae\xec\xc62\xb2\xac\x8cf{\x19m\neEb\xb2\v\xeb\x89\xfd\x87\xf9;wot\xbf\x05\"\xdcWN\x06\x84\xab\x91\x8a\x9e<j%\x9av\x91H;\xe2 \xb4'z縱\xcc\xf6\x9e\xd8\xc9pbC)\x19\a\x973\x18\xf42\xace\x8b<rk\xd8\x0fW\xaf\x1b\x85d\x1eF˿\xeb\tE\xbfV\x96\xec\x9e4\xb34\xcc\xf637\v\xd4\v\xf2\x10mm\x17\x89\xb4lOt9\xf6ъ`y\xbdл\xa8\xc41=Y\x18\x95s\x1a,t\xb0\xe5uО\xe8\xcd+9\xda\x17\xbd\xf5\x10\n\xb8]xb\xffa>\xe8e\x18\xf21\fzY\x85\x05\"\x94\xb8Y,\xc4*.b\xb4\x96\x95\x99\xac\xd9u\xf4\xc7\xe5\xb6>\xb1\xff0\xefz\x17\x96Ӯ\xabf,\x92\x9dd\x85\xe8A{\xa2\xb7\xdf\xfa X\xf7q\xbb\tZ\x90\x87\xc0\x90o\xf3\xf3r\xa6d\x85\x18Y\t\x96\xfa\xb0J>\x89\xde\xc2\xd0[9Fח\xad\x0fl\xb6W\xb4\xb5ՖHK\b\xa4\xd3\\W\xc2ʐ\xad\r\xa3ϝ\xe0\xba2#\x91V\x06\xd3)\x91\x90\xd0\xe9\xe4\xd1I\x18\xf4\xb22\x12\xd1g\x80\v\x85.\x14\xbfUl\xa4\x9a\x95\"H\xa4\x1a\x84\xeb\xaa\xddh\xa9\x05\xe2\xa4\xebj0\xe4Ǐ\xbe\xfd\x9am\xd7r\x1aNX!\x84\xda@˹\xb7\xa7\u007f\x8dF\xec\xedª\xca-IDo\x81\x18\xc5H\xf43\xa8\xe4\xa5\xd6\xc5y}\xf6\xf8\xc1\xe5ڦ\x06\xcb\xe4\xa1okW\x11\x88\xd3\xd6\xc7O\xf1ϰv\xedO\xf1\xb9#a\xccݲ\xaf\ro]\x8b\xe2\x9e\xf7\xb7\xc0\x1c\xb8'\xd9\ni\ahO\xf4\xee\x1f%oG\v\xa4\xdd[\xda.>9\x04\xbc\xbdj\xa8\xa4\xabY\"\xc2j\x10$b\x94\x9d^KƺY\xddFX\xcb\xf2\xb2`\u007f\xe9\x1e\xee\xde\xe8N\v\xc4\t\xb0\xd0A\xdc[\xff-\xbcu\xedOm\xbd\xee=\xefo\xb5M\x81[Y!Xi}=\xb4'z\xf7@}mȱ\xba}_Yݶ\xa4u\xe0\xf9s\xbc\xa4\x80\x05\xde^-)h\xa1\xd0e\x12ѻ\x94\x04\xc49\xa3\xc4D\x99H\xf4\xe4#\xaea\x18\x871\xb1\x8eֲ\xbc\xbc͛\xf7r\xfb\xf5\xf7\xbak)\x13\xcfh\xd01\x17\x96 \x11\x00\x98\xc8\xfei\x05\x11X\xc1\xac\xbc\x93\xe4\xd1\xea\x9c\x10\xda\x13\xbd;\xad\x0f\xf5\xb5!\x8c\x8e\xef\xc3\xc8\xd8X\xdb\xeb^\x8aDp\xf1\xdf.`\xea\u007f\xf7\xb5Յ\x05\xa0\xadV\x88\x91E\"f:\t\xe5=\xe8e\x18\x8c\xea\x16[\x95\x94\xfe`T\xc5Z\x96\x97\x11\x89\x11d\xe2\x18\xf4\xb2\xb2k\xc8\xe4\x11\n\xe8\xfb\\-\x91\x88\x9e<\xbaʅ5sj\x18\xb8\xf8\x9bx\xf3\xe4\u007f.>\xe8O\x1ehٚWf\xe0\xeb\xb7\xf0O\xc2\xdf\x01\xc2\xc5\xef\x17\x96\xb7\x18|-ˋ\xe7,`U\xfe\xaf\xa2\xbf\xd1v\"\x91\xc9Cl\x925sj\xd8\xd6:\xec\xde\x13]\xe9\xab\f\xfa)\xde\xe2\xff\x9f\xc3xu\xe4Z\xcek\twGg\xa6ײ\x1eV\xbdkfu\xd3r\xed\xcd\u07bf\xd99}R\xa5\x93\x90\xc9\xc3\xc8\"XUy\xd91\xa1\xd8\x05\xc9\xc8D\xa2\x87L\x1cF\xd7Փ\x87\x1c\x9b\r\x05\x80\x9b\xd1\f\x16\xdb\xdc\x1f\xb6[ \x82D\xcaM\x826\xdeQ\x18e\x9bI]XN\x97\x1e\x86\xf0\r\x1e\x9c\na`p\xebA\xc8K\xb5\x9b\x95\a\x80OF\xff\x13\xb0\xbeUաp\x1b\x82\xeb+\x06\xfdk3\xec\xcc<\xff\xb9w\x19O}j\xa2\xacOE_\x1b\x1d\xd3\x1f\x97\xa1\x97\xfbчK\x18\xccy\xca2\xe7\xcd\xdaW\xcby;3\xd1\xf5\x8aO>f\xa6\x18\xcd\xcaX\x95w\xd2\xe2\xa9G\xe97{?z\xf9N\xba\xefJ\v\xa0\x88\xf5\x94\x8a!\x1f+#\x11\xf1]&\xa0\nkE\xb2Z\x8c\\T\xe2:\xfaz\xedZ,\xb6\xe3\\X\xadPrհ\xb1\xa6\xe2֝\xf5\x8aͤ\x06\xbdŎ_O\xa9%\xcbb`\xd0g\xaa\xcc\xcc\xca\x03\xc0\x99\xa9\x10.\xdcYǙ\xa9Pqy\xf7m\x029^Pof\xb7\x90\xfdX\xbd_\"\x0f\xa3ͼ\xf4\xfd]\v\x99\x88Ϣ\xbc\xe8\u007f\x99\x18\xcc\xda'P\xed|\xab\xdd-v\x1f7B=;\x19\xd6\xec\xaeYX\xb4\xbc\xf6R$Ғ\xfb\xa9\xb6\x91\x92S\xb3\xb0\x00\xe03\xb3k\b\x85\xfd\x18\f\xf9\x11\xde\x1c<F\xa3\x99\nE\xae'\x91zP\xab\x9c\x18\x04o\xac\xa9\xa56\x1c\n\xfb14\xbb\x86\xffyr\xb0\xbb\bd\xf4\xe8\xb0m\x1b75K\x1ez\x1c\n\xfbq3\x9a)}6\xda\xeaV\xfe\xae/\u007fp*\x84\x8d5\x15G\x1e\x9f\xc0ܭXI\x89\x01p\x94DF\x8f\x0e\x03?\xbck˵\x9a\xddS\\&\x8fjГ\x87\x99e\xa2'\x8f#\x8fO\xe0\xfd\xbf\xbd^F\"v\xb5\xdfN\xe20\xdbqN>\xae/[oy\xb1\xe0\xe2\x14\x8a\xfe\xeezv2\xacy\x10x\xfaTM\xd7\xd6\xef\xf9\xdd\xc8\xfd\x9bɷ{%ު\xe4\xb1i\x01\xac\xado\x91\xc6`\xa8H(k\xeb\x99\xd2\xc0S\xefv\xaa\x97H\x8c\xdc`\xe2\xfbzJŁ\xb1 \xa2\xd1L\x19y\x1d\x9c\n!\xba\xa9\xb7>\xd3F\x12\xb1\x85@\xae\xbd{\xcf\xd6\xdd\xff\xec\x80h\xcf\xcc\xe00fL\\#\x86?\x1c\xa9\xbc \xc6ѣ\xc3\xe8$\x8c\x1e\x1d\xc6\x0f\xbfsٶ\xeb\xb5\"\xb3[\xff>\xc8}n\xd5\xffr\x19\xd1\xffG\x1e\x9fh{\xfb\x1b\x81Ղy\xb2\xf2\x93\xcb\xd4[\xbe]h\x84\x94\x1a\xb9\u007f+y\xa3]\xf9\x1c\xb3<\x02\xbe\x92\xe5\xa1\x1f\xf9\x9b\xa1\x11\vD\xef\xf6\x92\xaf\xb1\x9eR\x11\n\xf8\xb0\xb6\x9e)\x91\x96\xb0D\xe6n\xc5Jm\t\x05|m#\x11\xdb\\X\x82D\x1c\xc1\xd5e\xe0Ζr}\xffo\xaf\x97:Y\x8c\x18\xe4\xcfb\xe4`\x04\xb9쥋˥ͤ\x00 \x1c\xf6\x97X\xbed\x058\x00;\xc9\xc3ȭ\xd3L^E;\xfb\xbf\x96\xf6\xb5#/\xc4hDl\xb6I\x90\xd1\xd2\xdd\xf5\x96wҍS\xabE\xd0\xec\xfd\xe8\xf7\x10\xef\x04\x88wW\xff\x0e\n\xc5^\vy\b+\xc5*\x8eR\vnGb\x15\x96\xc8̩\xe12\"\xe9\n\vd=\xa5\u2ffe\xbf\x88\u007f\xfaب\xed\xdb\xc8\xd6\xfb`\x01\x18\xb6A\xb0\xb5ln\x9au\xb2^\xd1ɈF3E\xdf\xe7\x9d\xe2\x03\x84C\xf7\v\x14\x83\xfdv@\xe9\xcb\xe3{\xf1E\xfc\xb2o\xb4\xa9\xbc\x8f\x9f\xf3\xe5\xb6\xf6\u007f\xbd\xed\xd3\xc7y\xbe\xa7\xb6f\xbe\x8a\xd1\xd2\xdcv\x96\xeft4{?V2\xed\xec\x9fۯ\xbf\xc7\x0e<\u007f\x8e?r\xe1aY\xdc\xe3֦\xfb\xdah\x00$\x94\xbf\xdez\xd0Ϝ\xb2\x1a<\t\x82\x11V\x88\xb8\x96ލed\x89\x88\x81\x96\xb8\xfe#\x17\x1e\xe2ʙ]hU\x0e\x88m\x16\xc8W>\xbf\x1f\xaf\xfd\xf0.>w$\xec\by܌\x16\x03H\xfa\xccsy4+:\xdaj\xf4\xab'$\xa3r\xf2\xe8C\x8c\x02\x9c \x8f\xe9~\x05\xd7\xe3\x9a-\xd7\xfbܑ0\xbew\xad\\\xa1^\xc9>\xc4M\x9e\x05L\xa6\xbdV\x9c\xf7\xa2\xed\xfd_W\xfb$|O]\xac\xc8\xd8m\xb7r\xadE!\xd6[\xbe\x1a\x16\x17\x8a\xd6\xd8\xe8\xf8>\xcb\xf3Ve\xf4e\xebi\x93\xdd\xf7\xd3.\xc8V\x81\x885\xc8\x03\x9cP\xc0\x87\x9bь\xe5\xf4^\xfdo\xa0\x11\x14\xc9D\xad\xf8\xad\xac\xa7\xd4\xd2\xef\xa2\xdd3\xb3l\v\xa2\u007f\x05\xc0k6\x05u\x1b\xc1\xcdh\x06g$\x85#\x8ft\xf5\x8a\xa8Z'\x1b).Yf-\xcbK\xca\xe7\xfa\xb5h\xdb\xefu\xba_)\x8ej\xe2\xf6X!\x8f}a\x1a\xc0\xf5\n\x12)\x92T\xd6t\xba\xab|~\x903\x1c0\xf8\xb1\xb4\xac\xff\x91\xad\xab}e\xd7\xf72\x1c\n\xfbm\xb1\xe2jY\xe8\xae\x15\b_J\x02#\xa12\xa5o\x84\xc8\xd2BM\xc7\xccʌ\x8d\x8c\x9b\x9e_]Y\x81\vSخ\x18}{\x15C\x9b\xbf\xb5\x83S\xa1\x92\xf5!\x94\xb6\xb0,\x8c\x02\xe72\xb9\xc8eĻ-fq\xc9\xf1\x141(-{塞w\xd9\x12\x11\xf5\xcbֈ\xfe\xb7w(\xec\xc7\xda۫h\xe5z\xe8M\x13\x88\xe8\xa0ѣ\xc3\xf8\xdc-\xe7V߽pg\xbdBY\xc9J\xac.e\xb1\x9cƙ\xe1\x1es\xbf\xfd\xa6\x05\xe0\x84\xf5a\xf7(C<?A\"z\x1c\xa8\"\u007f\xc0\xe1\xfe\xaf\xb5}\xad꿲\x11\xf5\x8dD\xe9s\xe0p_\xd5s\x8d\x96\x1fO0\x9c:\xf5\x98i\x06\xba\x98b{\xf1\xe2\xfbX]i~\xed\x1b\xa3\xbaF#\x11\\\xbc\xf8>\x16n$\x9a\xbe\x9fF\xe5\xdbi\x81ܺ\xb3^\x11\x9f\xd0[\x1dF\x8b\x18\n\x19\x11\x84\xd7[\xd2\xfaxJ\x05\xe1\xe8\x96N)\xafG\xb5\xb4\x96j\x8d\xa78n\x81\x88\x99Jᰃ\xab\xd6ީt\x9b\xc8.\x1f\xf1\x00\xe4i\xbaV\xdf/,\xa7q\xc6bT\x1c\n\xf8\x1c\xbb_1\x02\xb2\v\xb6<\xbf.\xea\u007f;\xfbo\xe9\xfcGl\x04\xc5-O\x9f;r\xaed\x11|p\xa3<H\u007f\xbao+\x06#\xce\xc9ǰ\b|\x90\xb8_S\xf9Hb\xa1\x8c(\xda\x01\xb3\xbaN\xf7\xed5\xbc\x1f\xfd\xfd\x8f'Xɚї\xafE\xbet\xefK\vX\xd0\x11O+!\x8f\xf8\x8d\\Hf+\xdf\n\xb9z\x93\xffd\xcbF\x9f\x84h\xd56\xa7`{\"\xa1\x9eQ+L\xef*?\xfaf\xe4\x8d\xdc&ByY\xf9';_XN\xd7\x15\u05c9F3x\xab\x8aK\xebsG\u0096\xf7\xd0l\xff9\xf9\xfc\x9c\xee\u007f\xa7\xfao<\xc1\xf0\x85gu;[.l\xb9z\xc6F\xc6\xcbb\n\xa7\x17\x8a\xc7\x05\x11\bW\x91\xac@E\xf9Ņ\xf9\xd2qq\xac\x16\x17T5ז\x9d0j\xf7i}\x13\xfb\xca\xcf\xc9\xf7?\x0eV\xd6G\xf2=\xeb\xaf\v\x00c\x00._\xbe\x04\xb4\xc9\x12\x91\x95x\xb5\xa5\xd2帚\x1c\xf4\x96\u007f#\xb2\xfbJ@vc\x19\x11\x82\xb8\xae~U^}\xc0ވ亂@\x16\xaf.W\xfd\xf1\xd6\xf2\x03oV\xdeh\x96\x8f\x18\t\xac\xa7Բ\x91\xac\xf5w\xd52\xc8*\a\xae\x04\u07ba\x16\xc5\xfdd\xbc\xf4}oo?\x00\x94\x1d{\xeb\x1a\x9a\x9al\x10\x8dfZ2\xe2\xb0\xeb\xf99\xd9\xffN\xf6\x9f\x1e\xb2\u0093?/.\xcc\x17\x95\x9f^I\xad\xac\xe0ĉ\x19\x8c\x8e\xef\xab\bf/.\xccW\rj\xeb!b\x14\xed\x80Q\xdb\xf4\xc7\xc4=Yݿ\x90\xab\xf7^[\x85w\xee\xde`\xfa]\t\xad\b\x03(_\xccp\xf0\xc2C\x00[\x16\x85ޅe\xf6\xfe\xca\t\x89\x15\xab\xeb\xbe]\xbe\x02\xb2h\x9b\x11ɭe9Z\xbd/zK\x962\xa96ES_\xae\xd6 k\xad\xf2e\xdf\r|\xef7\xa3\x19\\\x8en\x00\x00~\xbcT\x8cۜ\b\x0f\x98\xce\xdd6kO\xb5YE\xd5f\xfa4{\xff\xadt\x91us\xff;\xdd\u007fzEn\xa6\xd4O\x9c\x98);&\x14\xab\xde\u0088,-t\x8cR5\xb3v\xaa\xb5OܓL\x1eF\xf7_\xcb}\xd6b\x81\xd9M\"\xa6\xf7n\xb1\xfa\xed\x953\xbb\xf0ȅ\x87\b\x05\xcc\aa\xfa\u0dcc\xebq\xad\xe2\xfa\x15\xf5\xbd\xbdj\xd9\xf6\xae\xb0@6\xd6Ԓ9f\xd4!☑\u007f\xdcJY\xd4+\xaf?o\xa6H\x84\xf2\xd2\x1f{z$XUy\r\x86\xfc\x86J\xb1\xf4\xa3\b\x0fT\x90Ƞw\xc0t\xc4\xd2\xe8\xfd۹\xeb\x98]ϯ\x13\xfa߉\xfe\xb3\x82\xf0\xfb\x8f\x8e\xef\xc3\xcc\xe9\xb3\xf8\xf3\xff\xf4jU\x19\x11\xb4n&\xc6!\xeam\xd6\nY]Y\xc1ŋ\xef\x1b\xce\xc6\x1a\x1b\x19\xaf H\xb9\x9c\xfc]_\xd6\ffu\xe9\xaf\xd9.\xebJV\xc4b\x8f\x90Fq;\x12\xab\x88\x89\x88\x85Z\xe5\xa9\xc1\xf5X\xc8F\x04\xd6ʼ\x8f\x96Y \x1bkjM\xb3[\xf4k\xc8TS6\xb5\xca\xd7s\x9d\xbd\xbd\xfden%\xd9\xddd嚱:VRR5\xe4\x16\xc8Iu\x8d\xdc\u007f+\x94_\xb3ϯS\xfaߩ\xfe\xab\xc5\xc5#\b\xe1ĉ\x992%,\x14\xa6<\x02o6@>:\xbeϖ\x91\xfaО=\x963\xbef6\xdb*\x14\xbf؟D\xb4_\xff]\xb8\xea\xcc\xee_\xbe\x86\x1e\xed\x9c4`H\x00\x9b\x8a\xb9\x1e\"\xb9rf\x17p\xe1aɍ+O\xe3\x1d\x18\xf4\xe1\xd2\xc5\xe5\xb2ߓ\x98\xc6kd}\xd4ھv\xa2\xa5;\x12Z\xfd\xa8\xebQV\xb5\x9e\xb3\xcab\x96\xf1\xb9#a\xbcu\xad|ߏ\xcf\x1d\tW\xcc\xc1\xae\xe6\xfa0\xaa\xa7Z\fD\x1ck\xf6\xfe\x9dteur\xffwR\xffu\x82\v\xc6.ԣ\xbc\xf5e\xf5߭\xee\xdf.\x02\xed4\"\x91\x17b\x1c\f\xf9K1\x10\xa3م\xf5\xc6\xe8\x9c \x8e\xb6\x10\x88S\xa8\xe6\x83_[\xcf\xe0sG\u0086k4US^\xf5(%'\xb2\x9d\xa9\xff\x9dS\xb0F\xa3f9~!\x02\xc9\xc2\xfd2\xb4gO\x85\x9b\xa9\x91\x80y\xb7\xc1\xea\xfe\xbb\tb\xb9\x93Z\xcaʉ\u007fVe\x1a%\xb3\xae&\x90\xd2\x1aE\x0e\xc3\xd0_nQV\xcc\xfa\x91\xe5.\xdcY\xc7Z\x96c\xba_iHi\x19\xb9b\xaa\xb9g\x1a\xbaW\x1b\x89ɮ\xe7\xd7\t\xfd\xefD\xff\x01(\xb9i\x16\x17\xe6+|\xfffSj\xcd\xe2\x02\xfa\U00091945\x8a\xf8\x82\x95\x8cS\x04d\x14\xfc\xb7\xfant\xff\xf5\x04仅DD0]\xcc\xc82[\xd5W\x10L\xad\xee+\xa7\xc9\xc36\x02y\xec\vӎ-\xa4h\xb7\vC\xafX\xea\xb58\xaa\xe58\xd8\xe1\u007f\x17.\x9f\xb7lZF\xa5Uϯ\xdd\xfd\xefT\xff\xc9$226\x06|p\xbe\u008f/\\2\x97/_*\x8d\xbc\x85\"\x94G\xe2Fr\xf2T^q\xfd\x8b\x17\xdf/)a#\xc5\xda\xcei\xbc2!\xc8\xed\x9f1pc\xad\xae\xacX\u07bf8fF\x98N\x04\xd1\xedvi\x99\xad\xea\xdb-V\x87\xed\x04\xb2xu\x19\x8f}a\x1a?\xfc\xceeG\xb3\"\x1b\t\xaa\xeaqf\xb8\xc7\xf0\x1e\x8c\xdc2\xfarkY\x8e3\x9b\xb3*\xccp\xfdZ\xb4\xa9>ZU\xad\xe3\x05N>?\xa7\xfbߩ\xfe\x83\x81\xa2\xd4\u007f\x16\xa3\xeb\xcf>\xf3\x9c\xe5\x12$\xd5\x12\x00\x05\xa1\xc8\nU\x04\xb9e\x85-\x96\x1aqB\xd1Z\xc5/j\xb9\u007fq\u007ff$\xdc\xc9.-\xf1YO&z+\xc4\xe8\xf7ae}t\x12i\xd8J \xe2G\xbcxu\x19\x9f\xff\x8d\x13\x8em*\xf5\xc6\u007f\x9f3\x9eS]\xe7\x82yBN\xbf\xf8\x99\xfe\x81\x1f\x18\v\x96\xedH8w+\x86\xebע5+\xa6Zv\xef3\xc2ƚ\x8a\x8bW\xec\xebc\xbb\x9e\x9f\xd3\xfd\xefT\xff\xd5\x03aI\x18\xb9\xa8\xe4龵^K\x1e\xc5w\xbar\x95\xdbZ\xcf\xfdw\xc3}\xd5K&\xf2\xfaXV\x03\xa1N%\r[\t\xe4\xcf>\xba\xc6\x00\xf0\xa7>5\xd1\x11n\xacj\xfbM\xd8\xe5\x86Y[\xcf\x00\xb7*]XՖX\x173\x8f\xe6\x9a\\x\xf2z\\\xb3%Q\xc8\xee\xe7\xe7d\xff;\xd1\u007f\x8d\x90\x88P\xa2\xf2H\xbb\xd5\x10n\xa2\x9a-\xb5\x16X.\xfa%]\xcc\xfa\xa6\x1b]95\xb5ss\u007f\x11\xa3%N\xc4;\xb9\xf8\xe4P\xd7ܗm.,\xa1\x84\x9cZ\x9dV\xafXj\x9dN\xda\xc8\xc8\xd8ꚇj\fD7\x93\x11};\x12\xb3]\xf9\xd9\xf9\xfc\x9c\xec\u007f\xa7\xfa\xef\xe2\x95\xcb\f\x00\x17\xeba\x89\x91\xb6վ\x1b\xf5d\x97\x8b\xf2\xfakֺ\xdeU\xbd\xe4!d\xec$\x11\xb3{h\xa4\xec\xe5˗0\xf7`\xa9k\x94\xacL$\xb7\x01<;=\xcd\xf5\xb1\xd1\xd2;y\xf7FWݓ\xad\x0f\xc1*\xe5\xbf\xe5\x96\xc7\xe6\x1e\x0fze\xd3\xec\x9e\x0fg\x86{l\xbff\xb3h\xd5ȹ\x99\xe7\xd7M\xfdߪ\xfe\x9b\xdc=\xd2\xf6\xf7\u007fh\xcf\x1eÑ\xbdP\xc2rк^\b\xd9j\x96\x83\\W;Ѝ\xe4Q\xed\xb7\xe6\x845\xdc1\x16H't³\xd3\xd3\xdc\xeev\x89\x87l4\xfa\xed\xd6\aު\xe7G\xfd\xef\x98b\xe3\xab++\x86\v\x14\x8a6\xcd=Xj\xd9\xf5\xb7\x9bb\xef\xf6A`W\x13H\xa7\xc0\uea5f\x9d\x9a\xbcF\xfdOh\xb5\xd2&R \xec\b\x02\x19\xf21C\xd7\xc6t\xbf\x82w\x1a\xbc\xe6t\xbfb\xfb5\xb7+\xa8\xff\t\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04BGö\xa5\x9a\xf5\xfb\xfe\x12\bFP#ť\xd9\x17~|\xa9%˄\u007fi\xea\x11\xd3\xf7\xf0&϶m\x1f\x86/\x1f?R֎\xcd]\x1f\xbbF\xbeV\xbc\xf2럮\xe8\xef\x81\xc1\xe2^\xdf\x1bk\xaa\xe1q\x01\xfd\xf9n\x94\u007f\xf5\xd4j\xcd}ut\xb2\x17\x00pu.\xd9\xd0y#\xfc\xec_}\xec\xe8r\xfb\xb6,\xe7~\xe0\xf9s<p2f{\xe3\x1a\xe9P\x92\xefl\xf9\xc0I 5\x1b\xc4\xf8\xd33\xdcn\x12\xf9\xd2\xd4#\xfc\x11\xef.\xd3\xf3\x8f\x00\xc0~\xf0V\x93ȗ\x8f\x1f\xe1\xa7\x1e\x19.;\xb6\xaar\xfe\x83\xeb\xd7Y7\xc8\xd7\x03Y\xa9n\xac\xa9\x15J\xd6L\xf9n\x17\xf9fu\x9b@\xa3\xbf1\xa7\xd14\x81\x8c?=\xc3\x03'c\x15\x1db\x84\xabsIC\xa5\"\x1f3SZVeH\xbe\xbb\xe4\x01\xc07淕D\xfe\xf8ȧ\xf91\xdf^|\xac\xde\a\x00\x98}>ļ\xc0\xfe\xc3-#\x11Yy\xcb#\xd9\xcf>:\x02\x00U\x95\xb8\xd3\xf2͒\x89٨~'\xc8W\x1bDU#\t3\x9d\xb8\xad\tĈ\x1cja];\xcaZ]\x83\xe4;W>p2\x86\xd4lж\x97\xf8\x8f\x8f|\x9a?5p\x0e\xcb\xea|M\xe5\u007f\xd97\xdarKDVF\x8d(&\xa7\xe5\xed\xb2J\xcc\\EV\xed\xe9vy\xab߄ \x84\xabsI\xa4f\x83h\x85禝P\xecb\xd9Z,\x90zͺf\x94\x16\xc9w\x9e|+FS\xc2\xf2\xa8\x17\x87\x98\xb7\xb4纝XU\x9d\r\x05:Y\xbf\x99\vHO`fʷ\xdb\xe5\x8d\xf4\xa1ly\bҐ\x91\x9a\r\x96\x1d\x13\x9fk\xb1X\xb6\x95\x05B \x10\xca\x15\x8e\xecB\x12\x9f\x9f\x9d\x9e\xe6C>\xd62\xf9U\x95[ʷ\x824\x1a\xb1\x88\x84l\xb7\xcb\xd7cu\br\xa8\xc5\xfa\x16e\xe4\xf0@'\x12\x8a-\x04\xa2\xf7\x85\x13\bV?&;\xddWz\xc8ֈ\xd1\xe7+هm\x1d\x91\xeb1\xe4c80f~\xff\xb7#\xb1\xa6\xe4W\xef\xac7\xe5fi\x94,\x1b\xed\x97n\x97\xaffi\xcb\xef\xbbpW\xa5f\x83\xa5و\xbe1\u007f\x05a\bt\x83{\xcbm\xa7r\xa8\x87l\x8cdj\x89\xa3X\x95!yg\xe5\x9dưo\x1f^Y\xfd\x1b\xcb2V\xb3\xb4Z\xe9ʹtq\x19\x00\x10\n\xf8\x10\x0e\xfbͅ#\xb1\xe6\xe4\xefX\xd7\xdfj\x121\xb3v\xf4\x16\x91<\x92\xefvyY\x97\x89߇\x95\xb5\xa0F2x\xc1\xe3\x01\x00\xbc\xbaI$\x82L\x04\x91\xa8\x91\f\x02'ѱ\x96G[]X\xb2\x85\xa2\x0f$\x99\x91\x8f~F\x8fY\x19\x92wV^\x04\x1bn\x90\xa7\x00\x00 \x00IDAT\x02\xadHE\xff\x03\xb0;\x88.\xf0\xca\xea\xdf\xe0&\xcfZ\x17\xca>\xc4\x17\xfbO\xb6\xd4\x12\x89F3\x88F3X[/*\x87\xf5TQ\xe1\xace9nF3\x00\xd6\x1d\x93o\x97奟\x1e+\xfe\xbf\xbc\x12\a\x00|}O\u007f\xc5\xf7htK\x99\x8a\xe3/\x1f\x1d\xda*\xb7y\xac\x1e\xf9\xaf\xef\xe9\xdf\xfan _O\xfb\xeb\xd5w\xf2;^\xb48\xc4\xf7\f\xc2a?\x06\x06}x\xe1֖\x95\xa1'\x13@-\v\xb4w\xa2+\xab\xad\xb3\xb0\x8c\xfc|z3ͬsj\xed4\x92wF\xbe\xd6\xe7\xdf\n\xe2\x10\x96\xc7M\x9eŠ\xd7:\xbep3\x9b\xc5\x1b\xf1Y<\xe2݅\x9b\xea\"\xf9\x15[`\x8d\x18)\xddh4\x83Ws\xb9\x92\xcb\xe6\xe5HQ\x99\x87\x9f)\x96{\xf9M\xa9\xf0J\xaet\xfc\xebo\xae\x96\x14\xea+_\xfc*\x00\xe0\xc57\xbeY\x92\xff\xf6K_\x03\x00\xfc\xf6\xb7\xbea(/\xaek&/C\x90M5\xb7\x96\xd1y\xf9\xf7!\xeb\xb5-\xfd\xb6\xe5\xb6\x12\xd6\a\x00L\x1e\f\x96\xfa\xeb\x85(td\x12\xec\xf8\xe7m\v\x81\xd43%W\xaf\x88R\xb3A\\E\xacB\xc1\xe8\x89E>\xaegx\x92wN\xbe\x96\x1c y\xe4\xd4\n\xeb\xa3V\xf2\x00\x80A/\xc3\xcdl\x16hc,d;C\x1fO0ë9\xa1ԋ\xa3\xeao\xbf\xf45\xbc\xf8\xc67\xa5\xef[\xca}s\xcc.\x8d\xc4QR\xfe\x02\x828\x84\x8c\xf8^\xaf\xfcV\xf9-R\xfbz\r\xf7Z\xfa>U\xfe~W\v\x94\xbf\xe0\xf1\x94\\\x90\xfa\xeb\t2\x01\x80\x17n\xc5\xf0j$Srkɿ\xbb\x1d\xe7²2\xed\xac,\x123ׇYp\x89䝕w\x02\xdf۴\"\x06\xbd\f\x97\xa3\x1b5ɜ\b\x0f\x14I\xa4Ÿ\xb9\xe9R\xd1Ϛ\xba)\xb9Z\xf4\xa86C\xab\x9a|-\xf5\xb7\x83L̬\x91\xd4l\xb0L\x99\x8b\xef/\xbe\xf1M\xbc\xf2ů\x96>\v\x1d![\rr9\x99<\x9a\x91ח\x11D\xf2\xf5=\xfd\xa6\xf9 \xb5\xcc\xc2\x12\xbf\x95\xad\x80y\xa6D\x1ef\xf2\xf2\xf7p؏\x17\xa2(\x91\x88р\xad\x13\b\xa5\xe97k\xfc\xe9\x19.\xccE+?\xbb\x99+C?\"\x95g*ȟ\xf5猾\x93|\xfb\xe5\x8d|\xb4\xb5>\u007f5\x92izM,\x91\xcbqf\xb8\a\u007f}{\xa5&\x99_9\xb0\a\x17\x96\xd3\x00`\xfb\xdaX\xcfNO\xf33S!\\\xb8\xb3\x8e3S!\x00\xc0[ע\x98\xeeWp=\xaeU\xadO\xc8\x03\xa8\x88a\xd4#\xdfh\xfdu[\u007f\xbf\xfein\x14/\x90\x8f}\xfd\xeajI\t\x1aY\x03\x9d\x00\xd9\x12Q#\x19C\x02\x11\xf7'\x13\x80\xd9ZXr0\\\xb8\xac&\x0f\x06\xab\x06\xe3\xf5\xc7\xe6n\xc5\xf0j.W\xb2\xa6\xf4\x04\xb2-\xd6ª\xe6\xc6\xd23\xa6Q\xe2\x8c\xd1\xf7F\\\x1d$\xef\x8c|=\xcf\xdfN\xbcs\xf7\x06{vz\x9a\x1f\x9c\na\xef&)\x98\xb9\xb2D\x10\xf9\xe0T\b\x17\x96\xd3m[XQF\xd5EG/<l\xa9\xbc\xdd\xd0+Y\xb3\xf8\x81\xd9迓\xc8C\xb4\xd17\xe6\xc7ˑ8^6q\xc9\xc9J\xde\xecݖ\aƲkʌ8\xcc\xfaO\x8e\x1b\x89\x81ڶ\f\xa2עX\xc4\f\xacF\xfd\xe0VdC\xf2\x9d)o\xf4\xfc[\x81h4\x83\u007f\xfa\xd8h\xe9G9z\xb4|1\xc1ū˥\x1f\xef\xad;\xeb-{ߋkMMW(\xf9\xebq\r\x8bO\x0eU\x95\xbfrf\x17p\xe1a\xc9zhT\u07b4\xfe\xbb7\xda\xe6\xce\xd2+H#\xf2\xf8\xfc/\xffZS$\xfe\xc3\xef\xfdE\xd3S\xcb\xf4\xed\n\x9c\x8cA\x8d\xf8\xaa\xba\xe8\xcc~#f.\xdezfs\xe9\xfb\xafSgb\xb5e\x16\x96~\x86\x02a\xfb\xc1\xc9\xe7/\x94\xf6\x19l)ݍw\xef\x19\x96\xbdug\xbd\xe5\xd6\xc7\x0f\xae_g\xcfN\x97\x93\xc8\xe2\x93C\xb8\xfd\xfa{5\xd5y\x1b\xc0Z\xb6|\x99\x95w\xee\xde`\xb5*\xffۛ\xae\xacF\xebo\x968\x8c>\xcb.\xa2N\xb4B\xf4m4rW\x19ݫ\x91\"\x97\xddW/\x1f\x1d\xb2\x94\xb7ZwK\x1f;\xea\xc4\xc4¶/e\xa2\xf7\xa3\xa7n$\x108\xdcW\xfa\\*\xb7y\xacԁ\x06\xe7\xc41\x92wN^_\xae\xde\xe7o\xa7\xd2\xd6+]+\xb7W\xab\xdf\xf3!\x1f+e\x95O\xf7+x\xa7N\xe5\xddl\x1b\x9b\xad\xbfVd?\xf7\aHl\xa8X\\K\"\x99-`\xf7'{\xc09\xf0 \x9e\x86\xdf\xebF\u007f\x8f\a\xbf\xcd92\x99\x1c\x86\x83>\xec\xddݏ\xb7o>\x80\xca\x19\x92Y\r\xaf\xbf\xf9\xf7|\x8c\xbf\x85@\xcf\x04\xee\xa8\xc7Q\xe0\x05\x00\xc0\x84r\x19\xf3\xdaI0\xa6`\xaf\xf2^\xb12\x0e\xcc\xf3\xd3P\xc0\xc0\xc0\x91\xe5\x1ck\xac\x80\tׇ\b\xf8\xa7\x90Qc\xe0\\\x05\xe7\x05\xf4\xf4\xecFF]\x02\xe7*zz\x03PX/\xb8\xe6CF\x8d\xa3P\xc8@\xe1\f\xf3\xdai\xa4\xb2\xac\xd4\xfe\xcf\u007f\xf2\xeb\xd8=\xb0\xd9\xfeϧqg\xb3\xfdе\xff\x92\xd4~\xe0w+f\x19\xd6\xf2~[\x11\x938\xf7\xf5\xab\xabe\xee0\xfd\x8c\xd5mE \xb5L嬘\xc2{#\x81\xf1\x04\xc3¦r\x1aOl\xbd\xe3\v\x92\xc22;'\x8e\x91\xbcs\xf2\xd1&\x9e\xbfݐ\x95\xee\x13\xfb\x0fs\x11\vY\xcb\xf2\xb6\xc6;ڹ\x1f\x87\xd3\xf5/<\x88\xc3\a\xe0\xec\xa1ݸ\xbb\xb4\x81TN\xc3\xe2F\x06>\xaf\x1b\xa3\x83\xbdH\xa6sH\xa9Y\x9c9\xbc\v\x9f\x9dZ\xc2_\xcf*\xd8\xdd\xebA\xc1\xeb\xc3\xed\xe58\xf6\xb1\xbfCo\xdf0\xf2\xb9\x1c\xc0\xb4\xd2ꮚ\xb6\x01\xc68\x80\x024\xad\xf8\xde(\xcc\x05\xc68\x0e\xf7܄\x9aYõ\xd4\f\xa6{\xdf\xc7\\\xfeq\xe4\x964,\xae\xf6\x00`\xe0\xe0`\x008\x8a\x83\x9b=A\x1f֓9\xe4\n\x1av\xf5\x0f\xc1\xe3\x01\xdc=n\x14\xd4\x14\x16bZS\xedG\x9d\x0e\xb4j\x01t\x99<Dм\x93\xddXm\xb1@\xaa)\x17\xa1\x9cVWV0\xb4g\x0f\xc6FƁ\xa5\x85\xb22c#\xe3[_\xa4sc#\xe3\x18\x03\x10YZ(\x93\x1f\xdb<\x1f\xd9,+ˋs\xe8+\x9e\xa7\xfa\x1b\xafߎ\xe7o'\x0e<\u007f\x8e?r\xe1!ֲ\x1c\x87\xc2\xfe\xd2T\xd6'\xf6\x1f\xe6\xedp\xe38\xb9\x1f\x87\x13\xf5s\x0e|j\xcf-|\xf2\xc0A\xfcėHt\x03\nc\xc8\xe4\nX\\K\"\xe0\xf3 \xa9\x160\xbf\x14\xc7\xffW\u0603D\"\x81\xa55\x15\td\xe1\xf7\xba\xd0\x1b\x98\xc0\xd5\xc4$\xe2k9\xf4\xf8\xb3p\xf7\xb8\x00\xc6p\x9f?\x0ep\r\x00p\x1fO\x95\xbbD\x13\x87\x91ZK#\xefg\xe8\xeb=\x84\xec\xbd\x1c\xc0]\xd83\xe0\x85\xb7\xdf\x03\xe6\xde4W4\x06\x0e\x06\xa60\x8c\x84z\x00M\x13\U00104f6e\xbf\xc7=\xfe$8ךj?\xb2\xd6.ZaE\x98\xcd\xeaғ\x8b\xde\"\x11\xae\xabN]\x17\xabcb c#\xe3\xe5Jn\xf3sdi\xa1\\y镙\x89|\xb5\xf2F\xe7\xa8\xfe\xfa\xea\x8fHD\xd2\t10A\x1e\x828\x0eN\x85\x00\xaco\xe5M\xbc\xbd\n<\u007f\x8e\xb7#\x16\xe0\xe4~\x1c\xed\xac\u007f#\x95\xc3_^ߋ\v\xab.\u070fn ρON\r!\x9e\xce\xe2\xe6\xe2\x06\xc0\x01\xbfׅ;\xabi,E38\xb8g\x00\x1aS\x01\xad\x98C\x90\xcbqhy\x06\x85\xbb\xa0\xb8\xbcx\xb0\x94\x00\xe7\x00\xdb|B\xe1~\x0f\xa2q\x15\x8a\xc2\x10\xec\xf3\xc0\xdd놖\xd3\xe0\xeb\xf1`q-\x83\xc4@\n\vk\xbd\x18\v\xf7`i=\v\x1eS19\x11\x00s)\xc8%\xf3XYW1:\xda\x03\xc5\xed\u0083\xe5$z|.\x04\x06<P܀\x9a\xca7\xdd~3\x8b@\xdd\xcc\xdf\x10\x8a\xff\xe57+\x13\x14\xcd\b\xe5\xe5\x95xYҥ\xd5\xef\xc9i\xb4e?\x10\xf9|\xbb\x99tue\x05\x91\xa5\x852eG\xf5\xdb\a1/\xdd\xe9\xe7_mz\xeb!i\x01ªSa\x9b\xe9\xef\x1d\xb6\x1fH&\xa7\xc1\xe3Vp\xf7a\nY\x8d\xe3\xe0p?n\xdc\u007f\b-\x97C\x9fWA6\x9b\xc7\xf8`\x1f&F\x06\x90\xd08~\xb6\xb4\x01U\xe3\xe8\xf1\xba\x10\f\xf8P(Ġ\xb89z\x06\\X}\x98\x80\xdf\xe3F\x8f\xcf\x05\x9fGA8\xec\x83\xe2sa0\xe4G(\xd8\v\xb7߃\t\xf6\x0e\\\x1e\x17\xdc=.\x04{=Ř\a\x00O\xbf\x0fL\x01F\xc2^Lz\xdf\x03c\f+\xb1\fFG{\xb0\xdf\xfb\x1e\x14\x85\x01\f\xf0\a\xdc8\xd2\u007f\x15~\xdf\x00|\x01o\xd3\xed7\xfa=\x00[K\xb4\b\xf8\xc6\xfc\xa5\xf5\xb9\xcc\xc8\xc3l!GA\"\xe2w\xd4I\x8b\x99*N7`ue\xa5e\u05ce,-\xe0ĉ\x99\xd2\xe8\xbc\xddJ|\xa7\xd7\xef$\x0e\x85\xfd\xa5麇\xacV\xafm\xe1\xe8\u007f`\xd0W\xb17\xc7v\xab\xff\xc4\xc1\xdd\xc8\x164\xcc\x1c؍~\xaf\v\x8b\x0f\x13\xf87\x9f\xf5\xe2\xe0\x9e~\xec\xe9\xf7\xa2\xcf\xebF\xa8\xaf\a\xf1l\x1e\x13\xe3!<rh\x18>\x9f\x1b\x99|\x01\xbd=\x1e\x80\x15\xc0\xc0\xa1(\x1c)\xb5\x00\x8f\x9b\xa1/\xe4ã{\xef\xe1@\xef\xfb8\x16\xba\x8ac\xbb\xae\xc3\xed\a\x98\x8b\x17\x89\x00\x1c\\\x03b\xc9lQ\x9eq0\x85c\xdfd\x1f\x8e캄y\xed\x1c\xb8\xc61\xbe\xaf\x0f\xc7\xc2ױ\xc0\x9e\x00\xe7\x05\xec\x1e\t\xe0`\xe0'\xb8\xa5~\xa2\xe8~\xd3x\xf3\xedG\xe5\xe6O\xf2 I\xbfa\x94Ѭ+\xb3}I\xf4\xee\xab\x1d\xbb\x1fH-\xab\xb1\xea]%\xb2\xb23r\xa3Ԣ<\xeb\x95\xd1\xcbS\xfdկ%\xcf\fi\xe6\xf97\x8bۯ\xbf\xc7\x0e<\u007f\x8eo\xadXk]\xb6\x1d?\xaev\xbb\xad\x9c\xa8\u007f#\xa9B\xe3@,\xadb\xb0߇\xa0ύ\x9b?\x9fŽ\xe81D\xa2\x19d9\xb0\x9e\xc8\x00\xe0H\xa4T$\x12*\x92\xd9\x02z}\n\xe2i\x15\xf7\xf2\x8f\x01\xc8\x01p\x01(\x86\xc0'\xdd\xef#\x95\x8eaI\xf9\xc7`i\x86\x91\xc2[ȥ\x0e\xc1\xd3\xeb\x02G\x01\xd8\f\x92\xe7\n\x1c\xf7\n3\x18\r'\x00V\x00\n\x1cs\x85\xd3ņ1\x06p\x8e\x9f\xc7\x0fCa\x1c\x9c\xbb\xc0\xa0`N;W,\v\x178\xe7\xd8H5\xd7~\xbd\xa5-\xbf\xf3\xf2J\rf\xb1\x91\x97\x8f\x0e\x95>\x03\x00V\xe2\x9b\xeb_\x95\a\xd0\xc5$\x94N#\x91\xb6f\xa2\x9b\xb1\xa8\xb0B\xac\xac\x91F,\x15#\x99F-\x1e\xaa\xdfX΅\xa9\xa6\x9f\xbf\x9d$\x82\xe7\xcfq\xbcm\xbc\xbcD;s!ڹ\x1f\x87\x93\xf5'\xd2\xc5`\xf2\xc2Z\x12i5\x0f\x9f\x02\xa4\x82\xc7p\u007f=\x8d\f\a&G\x82X\\O\xa0\xdf\xe7\x82\a\f\xb1t\x1e=^7\x8eL\x84\xb0\xf00\x89}\xec2\xe6x\xd1\"\xd8\x1d\xf4\xc2\x1bt#\xd0;\x88k\xc9Ә\x0e\xcc!\x9dZ\xc1<{\x06Z\xbe\x18(g\xf0\x02|\xeb\x11N\xb0K\xb8\xc1\x0e\x83s\xe0\xfeB\x12\xbb\x83^\xf8\x83n\x00\x1c\xd9x\x1e\x0fb*FG\xfcP\xdc.\xac,\xc6\x11\xf0+\xe8\xe9w\x03~\x00\xbc\xf9\xf6W\x1b0\x95\xaf\xca\x1b,\x91\x85\xbcڰ~\xb6\x95L\x1e\xfa\xa5\x82\xb6-\x814j\xa1|韽P|\xc1?8\x0f\x00\x18\x1d߷\xf5\x83_\x98\xc7\xe8\xf8>\x8c\x8c\x8dU\xc8-E\"X\\\x98\xc7\xcc\xe9\xb3$\xef\xa0\xfc\x15\xac7\xf5\xfc[A\"\xb7\xcdN\xb6)\v\xdb\xe9\xfd8\xdaY?c\xc0\xe8`\x1f\xe2\x9bu\xa4\xd5<\xe6\xd63\xc8j\x00g\f\x8b\xd1$\x06z\xdc\xf8\xc4h\b\xeb\xb14\x12\xa9\x1c\x92\xb9\x026\x12Yp\x8d\x03J\x1aL\xe3\xe0J\x01n\x17\ac\x1c\xaa\xfa\x10\x9c\x1f\xc4\xc7k\x93X\x8f\x8e\xa0\xbf'\x8f\a\xb1\x1c\x86]\n\x10\xf4lF\xaf9\xc0\x01\xa6d\xa0i\x00\xd3\\\xd0\n\f\xfe>?>\xd1\u007f\x1d\xd7\x12\xd3X\x8d\xa9\b\xf7zpt\xe0:\x92\xc9\a\xc8\a\xcf\xc1\xe5sa\xc2\xfd\x0f\xc8\xe5\xdd\xd0r\x85\xe6\xdboai\x1b\xad\xd2\x1b~F\x04ƍ]V\xd5~C\xdb҅%n\xb0\x96\xdd\xec\xcc:\xc9LQ\x19\x1d3:N\xf2\xce\xc8_\xd1\xd6my\xfe\x84\xeeD\xafύŵ\x042\xb9\x02f\x0e\xec\xc6FR\xc5\xcd\xc5\x18z\xfd\x1e\xec\x19\f`)\x9a\xc4j,\x83y\xd7\x06\x96\xd6\xd3\x18\xdbݏl4\x85\x8dT\x16`\f\nS\xc09\a4\x05\xd1x\x0e#\x03^p\r``p\xb9]\xe8\xf5j\xf0\x04\xbcȯ\xa5\xa1\x158\x02=\xa3@B\x03\x94b\xf8v\xaep\x1a+\xeb1L\x04{091\x80I\xef\a\xb8\x96\x98\x81\xc69\xc6\xc6z1\xe1~\x1f73g\xa1\xb1#p\a\x14L\xf7\xdcB*\x03x\\}P<.\xf4\xfaXS\xed\xaf\x15\xfa|\x8e-B\x89!5\xeb\xafX\xbctG\xed\x89\xde\xcc~\x10K\x91\b\x00`\xe6\xf4)\x13b\x195\xbd\xee\xe8\xf8(\x16\x17\x16I\xdeA\xf9w3o;\xbe\x1f\b\xc19$29(\f88<\x80\xa5\xd5\r$\xd29(\x8c\xe1Ⱦ]\xb8\xbf\x1aC\xbf߃\xb1p\x1f\"kq\xa4\n\x1c7\x96\xe2\xf0y\\Hdr\b\xf4x\xc09\x03\xc0\xc0\x14\x05\xa1\x80\x0f\xd0\\\xb8Wx\x1c\x91\xf984\x8d\xa1\xa0q\xb8\x12*\x86\xc3^x\xfa\x14d2\xcb\x00F\x01\xad\xa8\xbc\xa7\xdc?Gj`\n\n\x034\x00s\xf9_\x04cڦ\x9b\x8ba\x9e\x9f\x03\x87\x86\xe2|!\x86\xeb\xe9\x83\x00\x0e`2\xfb\x0fȪy$2h\xaa\xfd\xb5X\xde\xfa߀\x91{K\x90F'\xe7|8\xe6ª\x06\xb3Qn\xad\xb0Rr$\xdfzy\x821ڽ\x1f\x87\x13\xf5'\xd29\x04\xbd\x1e\xcc-\xc5p|o\x10\x1b.\x06\xbe\x91E\"\x99\x01c\f\x1a\xe7\xd8H\xab\xe8\xe9\xf1\xc2\xef\a2\xe9\x1c2j\x01CA/\xe2\x99b\\\xa3\xe8\x90\xe2p\xb9\x01(\x1c\x1a\xe7\x18\x19\r\x80s\x86|2\x0f\x8ds\xf8\x83.\x80i\xd0x\x16\xe0\n\xa0\xf0\xe2\xf55\r.\x17\x83\xc65\xccߏ\x83s\x8e\x89\xc9~0\xc6\x10\x89\xc4\x11\xeau\xa1'\xe8\x03s1<XL\xc2\xe7e\xe8\x1d\xf0\x82{\\``H\xa4\xb3͵\xbf\xd1\x01\xf7d\x1eW\xe7\xca\x17\x985\x1btm\xfb=ѫ\xed\xadmd\xce\xd1(t{\xa0\xda,\xac\x9d\xfa\xfc\x8d\xf6\xe3؎\xf5g\xb2\x1a&B^dRY\xfc\xfa\x89\x14\xfeۭ0\x16\xa2\x0fpw9\x86P\xbf\x1f}\x01/\x92\xe9\x1c2\xe9,Rj\x1eS\xbb\x02X.\xa4\x91Ld\xd1\xdf\xe7Ŝv\x02\f\x05ppx\xfaݘt\xbd\r\xc6\\\x80\x87\xa1ǿ\a\b\x01\xe9L\f\x81\x9eA\x00\x05\\M\x9e)\u03a2\xe2E\xd7\xd7=\xed88O\x01\xe0\x18\x0e\xf9\xe1\xeds\xe3\x13}wp-y\b\xbb\x06\xbc\xf0\x0e\xb81\xa1\xbc\x87\xfbx\x02\xa1~\x0f\x14\x9f\vG\anA+\x84\xc1\xc0\x9an?2\xf5\xe9H3B\xe9d\x92h\x8b\x05\xe2\xd4~\x10\x84\xce\x00=\xff\x9d\x89\xa9\xd1 \x96V\x13\x18\xee\xf7ỳnl\xa47\xc09G\x8e\x03\x1b\xe9,\x1e$2\bx\\86>\x88k\xf7V\xf1\xc2\xe3\x1a\xfeۭ]\x98\x8f$q7\x96\xc1\xa4\xebg\xe8\t\xf4#\x95\x8aa\xae\xf0\x8b\xb8\xa7=\xb1ye\x0e\x96\xdc\xcc\xf9\xe0\x1c,Q\\\xe2\x84\xf3\x02>\xd1w\v\xa9\xe4\x06\xae)G\xb1\x8f]\xc0M\xe5\x17\xc0\x18\x83w\xc0\x8b\xfd\xee\x0fp-\xf5(84\xf8\x82^\x1c齍x\"\x01\xce4\xb8\xfbܘT\xce\xe3f\xe6,\xf6\xf2\u007f\x00\xc7t\xd3\xedof\xc0m\xf5;\xe9\x96ߊ#\xfb\x81ԂŅE,E\"\x96\xee\x15\xe1\xbfo\x87\xbc\\v)\x12\xc1\xcc\xe9Sm\xad\xbf\xdb\xe4\x9b}\xfe݊\xaa\xfb\x81\xb4x&X\xbb\xeb_Oe\x90\x03p;\x9a\xc1\xfd\xf5\xe2ڂY\x0e\xf8\xbc.d\xf3\x1af\xa6v#\x9e\xce\xe0\xe3\xc8\x1aԜ\x86?{WA\x9f_\xc5\\,\x83\xac\xc6Q\x80\x1b\xb3\xcbSX^\xcbbd \x0fO\xaf\a\f\fP\x18x\x81\x03\x8a\xb29[\v\xc5\xef`\xb8\xbc\xb8\x1f\xabk*F\xc3>\xcc\xf1\xd3\xf0\xf4\xe71\xe1:\x0f@\x01g\x1a\x8e\xf4\xce\x17\xad\x18h`\x8a\x0f\xa1\xe01x\xd2\x17\xd1\xe3\xdb\x05\xa6\xec\xc5\xfe\xcc,\xee枂Ǔ\xc3\xfazs\xedovO\xd7n\xff=8\xbe\x16\xd6\xe2¼\xa1\x8c\b\xae/.,\x1a*1\xa1\xe0\xcc`\xa7<P\x19'\xb8\xf4\xc1E\xcb\xfe\xe8\xa4\xf6\xb7C\x9e\xf6\x83)W\xe2N\xed\xc7\xd1\xee\xfa\x93\xe9\x1c\xb2j\x01\x01\x8f\v\x99lqZ\xec\xee\x81\x1e\xf4\xf7\xfa\xb0\x91R\xb1\xf00\x0eM\xe1\x18\x1a\xeaÃ\a\t\xdc^K#\xcf5p(\xd8?\x16\xc2|~\x06,\xafb\xefp?\x12\xa9,\xeeF\x92\xe0\x9b-g\f\x18\xec\xf7\xe1\xe1\x86\nM+f\xa13\x06\xec\t\xfa\xd1\x1b\xf2\xa3g\xc0\x0fp \x13\xcfbn\xe0\f2\xb1,Vb\xd9\xd2ZZ\x9aƱ{\xc0\x87X2\x8fl>\x04\x85\x15\tbW\xff0\xfc\xde4\x98\xdf\xddt\xfb\xb1h__v#\x99\xb4m9\xf7\xd2\b\x14\xe5\xeb勼\x03\xa1\x8cF\xc6\xc60:>Z6\xf2\x17\xcaZ\x1fl\x97\xbf7*/\xca\xe9ˌ\x8c\x8d\x95\xaei5\x12\x17\xe5\x9dj\xbf\xe3\xf2\xf7?j\xea\xf9oW4\xbb\x1fǗ\x8f\x1f)#\x80?\xfb\xe8\x1akg\xfd\xb5\"\xc85p\x1f\x03c\x1aF\x82nL\xee*.\xa1~\xe5~\f=>7\x82\x017R\xa9,Bn\r\xc1\xa0\x17\x0fS\x05\xa4\xd5\x1c\xfa|.\xe4\xe2I\xe4<\n\x98˅I\xcf\x05\x04\xc6\x06\xa1\x8d\xe4\x91\xceD\xb1\x80'\xb1\xb8\xb0\x8e\xdd\xe1\x1e<6r\x13\xd9\\\x1c=\xbe]\xd08\a\xd7\nȨi\xfc$r\bg\xf7\xcf\xe2.{\x02\x13\xae\xf7\xd17\xb1\xa7\xf8n\xa5\x96\x11\xe8كt\xe6\x01|\xbeA\xb8\x94\x1e\xb0M\xf7W:\xf3\x00\xf9|\x06\xf7\xb5OC\xe3Z\xd3헧\xdb6\x82\x17.V\xee2i\xb6W\x88\xd12(/\xee\x04\vD.\xa3W\x1cFJL\xc6\xe8\xf8(\x96\"\x912e_m\xd4l\xa6\xe8\xad\xe4\xcd\xce\ve*\xbbk\xe4c\xb2\x8c\x93\xedw\xac\xfe\xfb\xcd=\xff\xed\x88f\xf7\xe3pZ\xbe\x1e\x1c\x9b\x1c\xc0'w\xddBAS\xf0\xdd\xd9AĒ)0E\x01\x18G\xb0σDBE\"\xa3a\xa0GA\"\x9dC*S\x80\v\x1cj&\x87<\x18\x14\xb7\x0f\xae\x80\x1b\xf3\xfc\x17\xb17\xf9.\xee\xf1\xc7P\xc8h`\x8a\x8a]a\x1fF\xb5\xff\x81\x1b\xe9'\xc0\x18\xb07\xf5?q\x1f\x9f\x01瀦1\x84\xfbr\x98˞\x06\xb8\x86\xf9\xfciL&\xdfý\xc29\xece\vH\xa5Vp\xafp\x16\xc8q\xecS\xdeż\xf688\x80\tv\x17\xf7\xf9\xa7\xc0y֖\xf67\v\xb3\xfd@\xac\xcam;\v\xc4\xce<\x00#%U\x8b\xf23\xf3\xcdWS\x9ef.\xaaZ\xdaU\xeb\xb1V\xb6\xdf\xe9\xfa\xe5D';\x9e\xffv\"\x8fF\xf6\xe3pZ\xben\x17V<\x85\x9fiSH&\xf3\xc8\xe7\xd3\xd8\xc8h\xe8\xf3\xbb\x10\xf0y\x8a\x99\xe2LA\x1e\x1c\xda\xe6FO.\xc6\xf1\x89}a\xb8x\x1e\xd1T\x1e\xde^\xa5hU\x00\x98\xe7\xe7\x00\x8d!\x93\xce\xe3\xe8\xe0G\x98/\xcc`Q\xf9G\x80V\x00/\x00\xf3\xcaS`\x9b\xc9{\xb9D\x16j\x0e8\xe6\xfa\x00\xb7\xf3\x9f\x02\xd74\xcc\xe5\x9e\x00\a\xc3=|\xaa8Q\v\x00\xc01\xa7=\xb9\xe9\x13\x03\xe6\xb4O\x831\x86}\xec<\xe6rg\x9bn\xbf\x9dpb\xd9\xff\x8e\xb1@\x9a\x85PT\xcd\xcaת\xd4\xeb9\xdf\xea\xf6\x8bxB\xa3\xedw\xba\xff\b\xd6ʠ\x11\xc5\xe0\xb4|\xadP\xb8\x82\xf8F\x16\x9c3\xb8\x19\x90+(\x88\xa6\n\xe0`XZ\xcb`8\xecA,\x93\xc7FJ\x83\x06\x05\x9c\x17\xb0\xb0\xba\x81p\x8f\v\x89T\x1e\x9f\xc0\xfb\xb8[\xf8\f\x14\x97\x1b\x9c\x03\x8a\v8:\xf8!\xee\xf3'\x91\x8e\xa5\xf1\x89\xa1+\xb8\xc7f\x90\xcf\xe5\xe0\xf2\xb97\xe3#\x1c.\xaf\v\x89\x8d\fz\xfb\x860\x11?\x8f\xbb8\x8d|*\a\x97\xdf\x05\xa6\xb0\xb2\x8d\x02\xb7\x8e+(\xce\xeab\xf0\xf7\fb\x82_\xc4\x1d~\xbc\xa9\xf6\xb7\xea\xddѻ\xaa\xf4\xe8\x14\xa2\xb1er\xb8մ3q\xcel\xb9\xe3j\xa3d\xb3 n\xb3\xa3l\xbb\xd1h\xfdr&\xb8^\xbe\xd6{w\xb2\xffR\xb3A[\x9e?\xa1;\xa1i\f\x1a\x00\x8dq\f\x87\xbdP\xc0\x10\xcf\xe4\x90Ps\xc8k\x00S\x14\xf4\xfa\xdd\xd0\x00\xa4\xb39x\\\x1c}~/4\xae \xaf\x01s\xdaS(\xe4\x188W6\xed\x85\x02\xee\xe34\xb4|\x16\xb1\xb4\x8a\x82\xb6\x06\xb0\x02\xa6z\xde\x01\x98V\xfc\x03ǁ\xc0y\xec\xd9\xe5E\"\xb1\x849\xed\x14rq\x15\az\u007f\n\xc5U\xa9\xd2\x0e\xf4~\b\x97[\x8c\x95\x8b\x16L*\xfd\x00s\xb9G\x9bn\xbf\x9d0sa\xe9\a\x00\x9dd\xa5tD\x1eH\xb3\xa3\xe7Z\xa6\x93\xb6\x12\xcd\xd6\xdf,\xf15[\xbf\x1d\xfdGy \x95?xم$>?;=ͫe\x86\xb7R\xden\xac\xa7\xf2\x18\xe8q#\xa9\xe6\xd0\xe3\xf7 \x95Q1\xd0\xcb\x00\xc6\x10O\x02\xb1d\x01\x9c3\xa4sY|bj\x00'\x83W\xf0\xee\xfc4\xd6\x13\x05\xa4\xd4\xe2> \a{\xdf\xc1\xdd\xfc\xe3\xe0\x05\x05\x8c10\x05\xc8g\n\b\xf7z\xb1\x80\xa7\x00\xce1WxrS\xfd3\xe4\xd3y\xcc\xf5\x9c\x83ۧ\x00\xf0\x01\xbc\x98\x848\x8f_4l\xe3=~\x16L\xb7y\xf9\xdd\xf4Yh\xb9|\xd3\xed\xb7\x834\x1a\xb1(;\x85HZ\x9e\x1e\xab\xcf\x030V`\U000d6b95j\n\xae\xdat\xd4F\x14d\xb5i\xae\xb2\xe2\xb5*Wm\xbao\xad\xf5/.,\x96\xfe\xc45k\xa9ߩ\xfe\xab\xe7\xf9oW\x18m\x144\xe4c80\x164\xfdk\xb5\xbc\xdd(0\x8eh\xb2\x80B\xc1\r\x8d+`\n\xc3\xc4p\x1f\x9e;\xf6\x00\xc1\x80\a\xa9L\x0e\x195\x87^\x8f\x82\xf8F\n\xef\xceO!\x9eP\xb1\x9e\xc9#ρ}\xfc\x12\xee\xaa\xff\b\xa9(\xc7b$\x8d\xf4F\x1e\x80\x1bn\u007f\x0f\x1e\xc4\xf2\x18\xd3f\xa1\xe5\xdd\xc8\xc55\xe4\x12\x05\x00n\xb8|>\xe4\xe2\x05$\xd7s\xe8\xeb\xdd[\xca\xc5\xe0\x1cŅ\x19\x81R\xacD\xfc\x17\xc7\xf3\xe9<\xb4<\xc7T\xcf,\xdc=\x9e\xa6\xdbo\xb7\xbb\xaa\xd1\xf7\xaa\xeb-\x90F\xf3\x00\x8aSd\xf7\x95\x94\x98<\x1a\x97\x15\x97^\x89\xc9V\x8b<\xe5\xb6^y3\xe5YO\x1eD\xab\xeb7\xb2Rdbj\xa6~;\xe4\x9by\xfeە8\xcc\xf6\xe3\b\x05|\b[\xed\x90\x18\x89\xb5T\xden\xe4\x01\xa8\xf9\x1c\xa0\x01\x1b\x05\x86\x02\a6\xe2Y\xbc\x1d\x1f\x83\xc2\v8:\xd1\a\xa6)X^M\x01\x05\x17\xa0\x00\x1a\xe7\xf0\xf9\xdcH\xab9\xfcÝi\xb8\xdcq\x803\xec\x0e\xf9\xb0\x96\xc8@Q\x80\xa5\xb5\x1c4\rxw\xee\x10\x14\xb6\x01Ea\xd8=\xe0A.\x95\xc1Ø\x8a\x82Ơ\xe64\x80e1\xe1z\x0f\xf7\n\x8f\x97\xf2G\xf2\xc9\x1c\x0e\xf4_\xc2}\xf6\x184\xad\xdcϴ\xbf\xe7\x02\"ʧ0\xcfO\xc1\xe5i\xbe\xfdvZ\xacV֢ޢl\x94|:\x96@j\x85\xbcd\xb1P^B!\xe9\x95i\xb5\x19@\"#\xbc\x19\xf9f\xf38D\xb9f\xdb\xdfh\x1e\x8a]\xf57*\xdf\xec\xf3ߎ\xb0ڏ\xa3\xb8\xc0\xe1\xbac\xf2v#\x9d)\xe0\xe8d?\xbc\npl\xd7m\xfc\xe5\x87\xc3H&\n\x18\b\xb8\xe1\xe2\x1aR\xa9\x148w#\x96ʣ\xc0\x81\x81\x80\a\x00C>\x9b\x85\x9b\x01\xfb\x0f\xf4\xe0H\xef-\xa8j\x1c~\xff\x1e0\xa6!\x91\\\x06\xf8)<\x88e1\x1c\xf2\xc1\xdb\xe7Ť\xfbC(.\x86@\xcf\x10\xf8X\x01\xc9\xc4*\u07be;\x8dxb\x11\xf7\n\xe76W\xf5-&\x10\x1e\xe8\x9f\xc5<\u007f\f\x9c\xcb\xe4Q\\\x16e\x81=\x01\xaei\xd8\xcb\xfe\x1e\xf7\xf2O6\xdd\xfev\f@:yfV\xdb\x12\t\xab-cQ\xef\f \xfd\xf1F\xe5\xab\xe5qT\xbb~;\xdao\x95\x87\xe2t\xff՚H\xb8\x13\x961ى\xe8\xf7\xba\x91Md\xe1\xefq\xe3\xfd\xbb\xfb\x00-\x83^\u007f1C\xbc7\xe0\x06׀\xf5\xb4\x86\x9c\u0090S\x80\xfc\xe6v\xb4\xbf\xb0\xb7\x1f\x1aS0\xae\xbc\x8d\xab\x89s\xe0\x9cC\xc9)\xd8\xe7z\x1b\xf3\xda\xe3p)\x05\xec\xdb\xdb\x0f(\x1a\xf2I\x15s\xbd\x9f\x04\xe3\x00\x8f\x17IpRy\x80\xe1\x90\x0fw\v\x8fCMdp\xb8\xffg\xb8\xa7\x9d\x06\xe7\x1a\xe6qz\xd3eU\xd4\xf0\xf9T\x16.\xbf\xbb<\xc0\xce8\xd4D\xbe\xe9\xf6\xe3'\xf6\xf6g-\xf1\x8dN\x9a\xee\xdb\x16\v\xc4J\xb9T\xdb\x0f\xc4\n\xb2\x12m$\x10]-7\xa4\x96Qx\xb3\xf57\x9b\x87Ү\xfaM\xdbt\xbf\xf9\xc1\x05\xa1{\xa10 \xcf\x19b\xc9\x02\xa2\xa9\x02\x18\x18\xb8\xc2\x11Ke\xc1\x99\x82@\x8f\x17\\ˡ\xcf\xeb\x06\xd78b\x99<\xe2j\x01\x03\xc9,\xb8\xa2`\xac\xd7\a\xce\x15\xe4\xe2*\xbc\xfd>\xcc\xe5\x1f\a\x18\x83\xa7߇l<\x83\xe9\xf0e\xcc\xf5\x9d\x02ߜ~\xcb5\xa0\x90\xcec\xae\xf74\x16\xd76\xf0\x99\xd0Op\xb7\xf7,\xe6\xf9钥!\x93\a\x00\x1c\xe8\x9b\xc5}~\x06\x1a\xe7\x80\xc6\xc1\\\f\xf7\xb5O\xc3\xe3\xe6PX\xbe\xa9\xf6\xdbam\xe8ɣ\x96\xb2\x9db\x998\x1e\x031r\r\xd9E\x04\xf5\xc0\xc9<\x92\xed\xb0\x1f\b\xc5@j\x87ا\xc3\b\xb5\xec\xddѬ\xbc\x9d`\nC<\x9dC\xafϏx&\x87\x1e\xbf\x1bP\x80\x02\x80X:\x8f\x1cgp\x03\xf0(@*\x97G\x16\f90\xc4R9\xf4\xf7\xf9\xd1\xd7?\f%\x0e\x1c\n]¼\xf6Xq:/א\x8d\xa9\x00\x03\x02={\x80$/Z\x1f\x00\x14\x97\v\x93}\x97p\xafp\x1a\ncP\x14\x17\xc0\xf5s\xac\x8akfq^\xdc3\xe4\x9ev\x1a\"\xad0\x9f\xc9C\xf1\xba\xa0\xe55\xacm\xe4\x9an\xbfݮ+#kD\u007f\xbe\x93fau\\&\xbaSp:\x8f\xa4[A\x99\xe8\xe5XU9\x0eX\x9c\xaf\x96\t\xfe\xec\xf44o\xa5\xbc\xdd(h\xc0@\xc0\x8bT*\x8b\x1e\x9f\vi5\x8f`\xc0\x03\x851\xb8\x99\x86l>\a\x97[\xc1\xbe=\xfd8\xb5\xe7\x062܃\xff\xf7\xd2.\x14\xc0PЀd\xea6\xc0\xc7q\x8f\x9f\x01\xe7ZQ\xf1\x83\xc1\xd3\xebCd)\x8eD\xe8\x01\xb8\xb6\x0f\x00\xdf$\x05\r\xf7\xd8)\x14\xf7\xff\xf0\xe1n\xe1\f\x00\x8e\xecF\x06\x9e^_ټ\xd2|*\x87\xa9\xde\x0f\xb1\xc0\xce\x15\xad\x0fpL\xf5^\xc4\x02{\x1c{\xbd\xe7\x91I\x9fD|\xa3\xd0T\xfb\xedt[\x19\x91D\xa7\xba\xae\xda\xea\xc2\xeat8\x9dGB\xd898\xf0\xfc9\xebH\xf6\x85\x87-\x95\xb7\x1b\x89L\x0e\x03\x01\x17\xfaz\xdcP\xd3y\xe49G\"Y\x80\x02\xe0\xd8d\bǇ\xae\xe3ͫcH$\x93\xb8\xf4`\x12\xeb\xc9\x02\xa0e\x01\x14W½\xb3\xb9\xfc\b4\x05\xf9\x94\nw\xa0\xb8\xb8\"\x14\x05(p\xdc\xcb\xfd\"\x98\x8bC\x8d\xa9\xf0\x06\xbd\xc5J9G6\x99\a\x14\x86\tv\x11s\xfc\x14\x0e\x85>*\xc6@P$\n\xce\x01w\xc0\x83y<\x06p\xad\xe8\xd2b\f\xf7\xf1\x18\xb8\xa6\x81+Y\x04\x82\x1e,\xaed\x9aj\xbf\x9d\xe4Q+It\xd2,\xac\x96g\xa2\x1b\x9d\xaf%\x13\xb9\x9e<\x8cf\xe5\x9d\xce#\xe9f\xf9j\x99\xe8\x8d>\xffn\xc5\x0f\xae_g\x17\xeeTΒ*\xed\xc7Q\x05W\xce삓\xf2u\xbb\xb0\xc0\xc05\x176Ry$2\x05h\x8c!\xcf4\xc4\xd5<\xd67T\xbc;w\x00\xb9\xbc\x82\x8d\x84\x86\x8d\xb8\x86BA\x01\xe3\xc0F:\x0f\x8d\x03S\xae\x0f\xc0\xc0\x90\x8d\xabX\x89\xaa\x98t\xfd\f\xb9D\x1e\x8a\xc21<\xe8\xc7~\xcf\x150\xe6\xc6t\xf8\xa3\xe2r\xeeP\x90\xdb\xd00\xbf\x9a\xc3\xdcj\x06\xf7\xf3\xc5\xc5\x14\xe7\xb4G\xc1\xe1\xc2^\xf6\x0e&\xdc\xef\"\x9b\xcaa\x9c\xfd\x04\x13\xae\x9f\"\x9f֠Ƴ\x18\xd3\xcec\x9f\xeb\xa7P\x14\x05\v\xda\x13\x9b\xbb\xa47\xd7\xfeV\xa1\x93\x97/i\x89\x05RK&\xb2P&\xe5J\xc8\xf9\xfd@\x9c\xce#\xd9\x0e\xf2\x8d>\xff\xedJ\"k\xd9\xc3\r\xef\xc7q\x1b\x80\x93\xf2\xf5 \xa9p<\xc8e\xc1\xdc@@q\xc1\xcd\x18\xfa\xfc\x1e,grH\xa4r\b\x04\x14\xa4\xd5<\xc2!\x1f\n\x1cH\xa4\x8a\xab\xe0z\xfb\xdc\xf0\x04\xdc\xf8\xbb\x1b\xc7\xc0\xd8\x06F\xc2=\xe8\x0f\xf9\xf1\xd6\xdc\x01\xec\tq,ϭ\x83s\x8ek\x0f\xf7\x81\xb1(\xd6\xf7\x9cD \x00ܜ\x8fc$ԃ\xb1P\x0fVb)\x144\x14I%\x99\x83\x96\a\xceo\x9c\xc4\xee\x90\x17\x0f\xa29,\xe3\x18\xc0\x01M\xcb\x00\fXY\xfd\x05\xec\xea\xf7\"\x91I\xa0\xbf\a\xf0\xf5{\x9bn\u007f+\x89\xc3(\xf7\xa3\xd3\b\xa5\xe9\x17j\xfc\xe9\x19\x1e~F\xadI\x91\xc8\n\xa4\xdb\xfd\xe0\xcf\xef\xfd\xac\xa1\xc2\xd5\xc7R\xf4De\x86\xd7\xef\xff\xa8+\xfbAoMԺ/zj6\b5\x92\xc1\u008f/1\x10\xba\x16\xaf\xfc\xfa\xa7\xb9\x99[fcMEt3\xe0\xffj.\a\xdfX1\xe8\xfc\xca\x17\xbf\x8ag\x9e\xfb_\x8a\nH\xa4\x8a;\x84\x1f~\xef/\xca\xda\xff\xe2\x1b߄\x1a\xc9\xe0\x05\x8f\xa7\"a\xd3(\xa8\xfd\u007f\xbbsMY\xd5?\xfbW\x1fw\xf5\xfbߖ \xbaQY\xb1\xb1PMe\x1b\x1c\xb5\xb6R\xfe\xf5\xd9\x1f\xe1\xf9\xbd\x9fm:\x8fdqa\x11\xaf\xdf\xff\x91\xe1\v\xd8\xc9\xf7߈\x15Jy \xdb\x0f\xd5\xf6\xb3\b\x87\xfdxy%\x0eߘ\xbf\xf4\x8e\xbf\xf8\xc67\xf1\x8a\x89\x02w\x9c\x10\xbf\xf8U\xbc\xf8\xc67\xf1j$\x83\xaf\xc3oJ\x1e\x84\"\x14\xa7*֯\x91$\xbe˟\xf5\xe5\xcdʴ[^\x8c8\xfe\xe3\xdf}\xd7\xf0\xde\xe4u\xab\xaa\xe5\x91\xe8ɣ\x1b\xee\xdfJ\xbe\xd9\x01\x06\xa1\xbb\xc9D\xac\xd3Tͽ\xf2\xe2\x1b\xdf\xec\x98v\xbf\xf8\xc67K\u007f\x04\a,\x101\xb2\xacWQԪt\xcc\xca9%o4\x9anv?\r\xa3::\xf5\xfe\xeb%\x06\xca\x03ٹV\t\x00\xbc\x10\xcd\xe0\xd5H\x06@АD^\xf9\xe2W-IF\u007f^>\xfe\xe2\x1b\xdflZ^\f\bS\xb3A\xfc\xf6\xb7\xbeQl\xb3\xc7\xd3\xf1\xf1\x87mC v\x8d2k\xddW\xbb\x13\xe5ەGҩ\xf7_\x8b\xccN\xc8\x03\xd9\xc90[\bp\xf2`\x10/܊\xe1\xd5H\xa6̕\xa5\u007f\x0f\xcc,\x00\x99$\xe42\xfa\xf2\x8dʋ\xf6\x88؇h\xb3\u07b2\xea\xa4\x04\xbe\x1d\xef\xc2\xdaN\xa0<\x12\xc2N&\x8dZ\\V\x93\a\x83x\xc1\xe3\x81\x1a\xc9 \xfa\xa6y<A\x8ddJJ\xdd*0mv\xbe\x11\xf9\xd4l\xb0Df᰿\x8c<\xf4$\"\x88\x84b\"6Z ;\xdd\xc7m\xb5!\x96\xd1\xf4\xe0\x9d\x06\xbd\xbb\x8f\xac\x90\xed\x83z\xb2\xa3\xc3a?^\x88\x16?\xbf\xfa&\x00\x14-\x12a\t\b\v\xe0\xd5H\x06\x81\x93\xe5\x84 \x97)\xbeC\x95\x96G\xa3\xf2☰>j!MB\v,\x90Zf\xd9TK8\xeb6\xf9Ņ\xc5R>\x88\xde\x12\xd1\xe7\x91\xe87\x85j\xc4r\xe9\xc6\xfe\x13Av\n\xa6o_\x12\xa9\xa6\\\xc5\xc8}\xf2`\xb0H$\x1eO\xc9\"\x11\u007fB\x81\xbf\xe0\xf1 \xfa\xa6\x0f\xd17}\x15\xe5\xc4wq\xde\x0eyq\xccj\x9f\x15#˃\xac\x10\x1b\x83\xe8z\xe5 \x1f3[h\xcf*\xf8\xde\xc9\xf2\xb2\xa2lv?\x8eZ\xea\xdc\x0e\xfdG\x96\xea\xcerkU#\x95p؏\x81A\x1f^\xb8\x15+;& ,\x15q\xcc\xec\xbb\xfe\x9a\x8d\xc8O\x1e\f\x9a&\xf0\x91\xc5\xd1\x06\x02\xa9e\x14Zk\x0e@\xb7\xca7\xbb\x9f\x86\xb8\xfev\xed?q\x8e\\W;\xc3\x1a\xa9u?\v\x11o\xa8\xb6\xbe\x93 \x9cj\x96O\xa3\U0008dd9f\b\xc4f\xe2\x10\nB\x1f\xc0\x92\x8f\xeb\xcb\xcaI\x85\xdd \xdb\fι\x00\x00\b\xb4IDAT\xbf\xf5=a\xfb~\x1a۩\xff\x8cȄ\xe2\x1f\xdb\xcfڨեSm?\x8b\xae\x94\xdf\xe1\xcb\xd1\xdaz\xfb\xa9\xd9`\x99\xe21\v\x9e\x9a͎\xe8\x16\xf9Z\xad\x8c\x9d\xde\u007f\xf2w+9\xc2\xf6#\x13#\x85\\m?\x8bn\x95\xdf\xc9\xef\xb5-\x04b\xa4T\xf5\xca\xc3H\t[\xed\x8f\xdd\r\xf2\xb6\xf4ݍ\x04\xf4\tVۥ\xff\xcc\xce\x11\xb6\xa7۪\x9a+\xc9\xcc\xf5\xd3\xcd\xf2;}Pd\xbb\x01&+\x8cF\x94G\xb7\xcbS\xff\x05\x1b:G\xe8~\xf2\xb0R\xd2V#\xf9\xed$O\x04\xd2&\xe5؈B\xe94y+\x88u\xb0\xac\xdc[\xf5N\xe3\xed\xf6\xfe#\xec\x1c\x18e\xa5\xd7\x13x\xeevy\"\x90\x1aQH\xe6wt\a\xb6j?\x10\x02\xa1[\x89\xc3H\t\x9b\xad'e\xa5\xa4\xbbA\x1eS;\xfby7\xbd\x16\xfd\xc8\xd9\xe3<p\xb8\xaf8\"\xbd\x91(\x1d\x17\xc7J\xa3U\x83s\xe2X\xb7ɗH\xe2\xfcG\x15\xfd\xf7{_\xfb}\xae\xdf\x0f\xe4\x0f\xbe\xf1\xfb̪\xffD};\xa5\xffĹV\xef\a\xf2;gOp\x00\xf8\xf7\xe7/\xb3Z\xca\xd5\vq\xddf\xe5\t\x84\x1dk\x81,\x9d\xff\x88\x8d\xa0\xa8\x04\xc7\x13[\xbf\x87\x05\x9d\xc25:'\x8e\xe9\xbfw\xbc|\x1f\x87\xef\xf6\x83\xba\xfa\xe9\xff\xf9\x0f\xafq#2Y:\xff\x11\x1b9{\x9c\xa7n$vN\xff鈧U\xe4qp*\x84h4\x83/\x1f?\xc2\xff\xec\xa3k̪\\#x6:\xcd\x0f\x85\xfdhF\xfe\aׯ\x13\x89\x10v.\x81\xc8\xcaaue\x05C{\xf6`ld\x1cXZ(+362.i\xcd\x05\xdb\xe4\xc7F\xc61\x06 \xb2\xb4P&/V\xa6\x8al\x96\x95\xe5K\xabV\xf5\x15\xcf\xd7S\xbf(\xbfjA\x0erl\xc3\xe8\xd8\xef}\xed\xf7\xb9|\xcew\xfb\x01\x86\xf6\xec\xe9\xca\xfe\x13\xdf\xeb\x91\xc7fY\xd8D \xb5X\x00_>~\x84\x87\x02>C\v\xa0\xd9`(\x05S\tD Mbld\xbc\\\xc9H\n\xb7Ly蕉\xc9\xf1z\xe5\xf5\xf5\xd7R\x9fY\x9dV\xf5\x8f\x8d\x8c\x97\x94\xa2 \x03#\xc2\xd0\xc3ʍ\xd5\xcd\xfdg\x97|\xb3\x96\x86\x11n\xddY\a\x00\x1c\x18\xdbZiUo\x01\xac\xa7Բ\xadWkE8\xec\xc7Z\x96\xa3Yy\x02\x81\b\xc4\x02\xab++mQ$N\xd5_\x8d\x18\x04\xc9\xc8\xe5j\x91\xe9\x144\xdb\u007f\xedx\xfef\x16\xc0\xcc\xe0pM\xf2\xd1h\x06k뙆\xeb\x17\xe4a\xb5\x18\x9f\xbe|\xbd\x84C \xec8\x02\x89,-\xe0ĉ\x99\xb2\xef\xed$\x91V\xd4\x1fѹ\x86\xec\"\x99ND\xb3\xfdWM\xfe\xf6\xeb\xef5\xdd/\xb2\x05P+\t\f\x86\xfc%\v`\xc8\xc7Jǚ\x81 \x8fh4\x83p\xd8_\xfaoD0\xe2<\x81@\x04\x02\xe0\xe2\x95\xcblue\x85۩\xaceE\xddȵ\xec\xae\xdf\t\xe5\xdd\xec\xfd;\xdd\u007fF\xf2\"V2\xf7`\xc96R\x15ʸ\x1e\x12\xd0+pA>\x83!?\xd6\xd63\xa5\xffF\xc7\xcc\xea\x11\xd74\"\x11=\xc1\x10\bD \x12\xe6\x1e,1\\\xbe\xc4\xcd\xdc\x17V\xc7\xeau\x854#\xd3h\xfd\x86\xf7\xdb¾\xb3\xbb\xddN\xf4\x9fYY;\xfb\xce.\x02З\x97\x8f\x1b\xd5et\\O\x1aFǉ<\bD mP\xaa;\r\xd4w͡Q\x02XU9\x0e\x98\x945\xba\x8e\xbe\x8c\x90\x17K`\xc8\x04a\xf6y`\xd0G.,\x02\x11\b\x81\xe04\x9a%\x00#\x05_\v\xf4\x040w+f\xb8\x97\xb6\xe1`\xe1\x16\xadJL \x02!\x10:\x02¢\xa8g\x16\xd4\xedH\xacL\xbe^\xe8\xe5\aC\xfe\xba\x88A\x96'\x10\xba\x15\xe46!t=\x9e\xd8\u007f\xb8\xa1\x84\x8aw\xee\xde`\x9d O \x10\x81\x10\b\x1dF*\xd3\xfd\n\x00\xe0z\\\xab[Y;-O t\x03ȅE\xe8z\x1cx\xfe\\\xa5\x05\xf0\xf6*\x0e\x8c\x05K\xae\"\xa32\"\x0f\xc5iy\x02\xa1[\xa1P\x17\x10\b\x04\x02\xa1\x11\xd0\b\x88\xb0m\xad\x92ѷ\x8bK^.>9T\xf7h\xdfiy\x02aG\x13\x88\xdedo\xe4\aX\xab|+\xf6ch\xb6\xfd\x04\x02\x81@\x04\xd2 y\xe87\x9bO\xcd\x06\xab*aYi\x1b\xc9\x1b)\xf3f\xf6s\xf8чK\x90\xf7ch\xa4~\x02\x81@ة\xb0=\x88.\xc8\xe3\xe8do\xd9\xf1\xab\x88\xe1\x00\xceq3\xe5+\x93Nj6X!\xff\xe1\xec\x96R\xd7_ǎ\xfd\x18\x9a\xa9\x9f@ \x10\x88@l\xc6չ$\x00T(c=Ɵ\x9e\xe1\x8f>\x97\xc7չ\xad\xd1\xff\x87\xdf\x0fBV\xe8\xb2E\xf0\xe8sy\xa8\x91\x19\xbe\xf0\xe3K̎\xfd\x18\xcc\xea\xff\x93\xcb\xc5\r\x8f\xffŉ;\xa6\xf5\xd3+D \x10\x88@l\x18\xc1\x1b\x91\x87\xfe\xb3(\xa7\x1f\xc1_\x9dK\xe2\xe8do\xa9l\xe0d\xac\xe46\x92\x95\xf7V\x99-\xabC\x90\xc7?\xffƿ\xc6\xee\xcc\b\xb2\xdf\xfa?\x01\x00\xb1\xdf}\x1e\xc1?z\x1d\x00\xe0}\xe9_\xe2\x81\u007f\t\x00\xf0\x1f\xbf\xf6\xc7\x15\x84\xa3\xaf\xffO.Oaطo\xf33\xf0\xb5\x93?5\xad\x9f@ \x10v\"l\x99\xc6;\xfe\xf4\f\x0f\x9c\x8cA\xfc\xc9\xf1\x02\x01aE\x88\xbf\xf1\xa7g\xca\b'\xfa\xa6\xaf\xa4č,\x16q\xfc\xea\\\x12\xd17+\x95\xf7?\xffƿ\x06\x80\x12y\x00(\x91\x87\xfe\xb8(kg\xfd\x04\x02\x81@\x16H\x03\xe4\x11~F-;&,\x06\xa1\x88\xaf\xce%+\x82\xd2E\x99\"\x89\b\xf9\xe8\x9b>\xbc\x87|\xe9\xfb\xa3\xcf\xe57\xe5!)n\x1f\xb6\xea\x9b\xe1x\x90\xb2\xad\xfd\xd17}\xf8\xcd\xd5\xc3\x00\x807\xe2\xb3\xf8\xe2f\x997\xe2\xb3\xf8\xcd\xff\xe3$\x00\xe0+C?/\xab\x9f\xdcX\x04\x02\x81,\x90\x06QH\xe6\xcb\xc8B\xb68\x8c>\xcbe\v\xc9|\x99|\xf8\x19\x15\xe1gTD\xdf\xf4U\xc8G\xdf\xf4\x95\xce\xcb\xf2zx_\xfa\x97\x86\xed4;.\xd7\xff\xd2B?\x86}\xfb0\xecۇ\x17\x87~\t{\xff\xdd\x00\xf6\xfe\xbb\x01\xbc8\xf4K\xa5\xe3/-\xf4[\xd6O \x10\bd\x814\x00\xd9\xe2\x90\xff\x03AC\x921\x93\u007f\x0fy\xa8\x91\f\xae\xce\x15G\xfaj\xc4g*/\x96\xf3\xfeѷ_\xc3g\u007f\xfb+\xc58\xc7\xef>_\x8a\x85l\xc5>\x96J2?\xfa\xf6k\xa6mXV\xe7\x01\x00\x1f\xab\xf7137\x01\x00\xb8\xb4\xf11\x8e\xf9\xf6\xd2\xdbB \x10\bv\x13Hj6\x88\xab\x88\x95}\xd7\a\xc1\x8d\xca\x00\x892y\x11[\x10.\"!\x1f~\xa6(\xf7ޫ(\x9d\x97\xe5\x81\xe2\x1e\v\x15\xc40\b\xe0\xbf\xfcۊ\xf6\xea\x97\xdd\x16\xf5\xbf7\x9e\xc7K\v\xf7\x01\x00W\xb2\x0f\xf1\xd4\u007f9\xb7\xf9\xf9R\xa9\xec\xb7\xc6\xe3\b\xcfU\xd6O \x10\bD ub\xe9\xfcGl\x04ǹ\xb02\x04a\xc8\xffe\x12))\xed\x1b\t,\x9d\xff\x88\x01\xc0\b\x8es5\"\xc76\x8a\xd6Ƈ\xb3\x80\x1a\xc9\xe0\xd1\xe7\x8a\xc1k9VQHn\xcaOO\xf3f\xf6c\x10\xed\x17\xf5\xbf:[\xdcS\xe2\x85\x0fv\x95\xca>\xe2݅WO\xe7\x8a\xe4uR-\xaf\x9f@ \x10\x88@\xec \x11 p\xb8\xafD\x00r\x1e\x88\xf8\x9c\xba\x91(\xc9\byW\xaf\xbb\x92<\xbe\xef\x86\x1a)N\xb5\xfd\xf0\xfb\xee\x12\x89\b\vDX+?\xb8~\x9d=\xb1\xff0\xc7r\xba\xeev\x8b%\xb6\xe5\xfaE\x12\xe4\x95w6plӝu%\xfb\x10\x8f>\x170\xac\x9f@ \x10\x88@l \x11aM\x04Nn\x11\x81\f\xd9ꐡ\x0f\xc4\v\xf2\x10\xc7\xd5H\x06\x1f~ߏG\x9fۊ\xb1\xc8\x01l\xa3\xbd\x16\xeaُ\xc1\xb0\xfe)/pg\x16\x00\xf0ߧ\xbc\xf0m\x92\x98Q\xfd\x04\x02\x81@\x04b\x13\xf4\xf1\x0eq\xcc*f\x90\x9a\r\xe2\xc3\xd9-\v\x05\x00\x86\xfeI\x01\x00\xb0\xfaW\xc5\xe3o\xff_\xc2\xc2)\xbf֯\xfd\xea\xafV,\xa6x\xff\xe2\xe5\xb2\xfd\x18\x8c\xca\xfc\xc5w\xbfˬ\xea\xbf\xf8\xbf\x16E\n\u007f\x95\xb7\xac\x9f@ \x10\x88@l\xb2D\xf41\x11+\xeb\xa3\\f\xf3\xf3\xd9\xe3<p\xb8\x0f\xa9\xa2\x01\x80\xc0\xe1-y\xb9\x9c\xfdmv\xa6~\x02\x81@\xe8F\xb4L\t\x8e\x9c=\xce\xf5J\xba\x9d\xf2\xbf\xf6\xab\xbf\xca\xef_\xbc\f\x00\xd8{\xeaD\x99\xb5ю\xfa\t\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10\b\x04\x02\x81@ \x10j\xc5\xff\x0f^\x9e[\x82\xfc\x1b\xf1@\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var VaxerMan_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\xc0\x00\x00\x01\x80\b\x06\x00\x00\x00\xfb\xf3m\xdf\x00\x00\x01\x85iCCPICC profile\x00\x00(\x91}\x91=H\xc3P\x14\x85OS\xa5*\x15\a;\x888\x04\xa9N\x16EE\x1c\xb5\nE\xa8\x10j\x85V\x1dL^\xfa#4iHR\\\x1c\x05ׂ\x83?\x8bU\a\x17g]\x1d\\\x05A\xf0\a\xc4\xc9\xd1I\xd1EJ\xbc/)\xb4\x88\xf1\xc1\xe5}\x9c\xf7\xce\xe1\xbe\xfb\x00\xa1Vb\x9a\xd56\x06h\xbam\xa6\x12q1\x93]\x11C\xaf\xe8D\x88j\x14\x832\xb3\x8cYIJ\xc2w}\xdd#\xc0\xf7\xbb\x18\xcf\xf2\xbf\xf7\xe7\xeaVs\x16\x03\x02\"\xf1\f3L\x9bx\x9dxj\xd368\xef\x13GXQV\x89ωGLj\x90\xf8\x91\xeb\x8a\xc7o\x9c\v.\v<3b\xa6Ss\xc4\x11b\xb1\xd0\xc2J\v\xb3\xa2\xa9\x11O\x12GUM\xa7|!\xe3\xb1\xcay\x8b\xb3V\xaa\xb0F\x9f\xfc\x85\u173e\xbc\xc4u\xaa\x01$\xb0\x80EH\x10\xa1\xa0\x82\r\x94`#F\xbbN\x8a\x85\x14\x9d\xc7}\xfc\xfd\xae_\"\x97B\xae\r0ṛ\f\r\xb2\xeb\a\xff\x83߳\xb5\xf2\x13\xe3^R8\x0e\xb4\xbf8\xce\xc7\x10\x10\xda\x05\xeaU\xc7\xf9>v\x9c\xfa\t\x10|\x06\xae\xf4\xa6\xbf\\\x03\xa6?I\xaf6\xb5\xe8\x11г\r\\\\75e\x0f\xb8\xdc\x01\xfa\x9e\fٔ])H%\xe4\xf3\xc0\xfb\x19}S\x16\xe8\xbd\x05\xbaV\xbd\xb95\xceq\xfa\x00\xa4iV\xc9\x1b\xe0\xe0\x10\x18.P\xf6\x9aϻ;Z\xe7\xf6\xef\x9d\xc6\xfc~\x00r\xd5r\xa7\xe6\xc7AK\x00\x00\x00\x06bKGD\x00\xff\x00\x99\x00\x00\xc1\xe5\xff\x8c\x00\x00\x00\tpHYs\x00\x00\x03\xf0\x00\x00\x03\xf0\x01\xd8\xe5J\xc4\x00\x00\x00\atIME\a\xe4\t\a\r3%fq\x8b\xd8\x00\x00\x1f0IDATx\xda\xed\x9dOl\x1c\xd7}ǿ\xc3p\x80\xa2\r\x11\xbb)\xcb\xdeJ'\x12\xe0\x03E\xa0\xb4\r\xf50\x90@\xf7Л\x00\x06\x86C\x17P\x81\x06*\x8d\xb5}\x88\x0e\nP\x06(\xea\x02\xe1E\a\xeb\x10\x85\x90#;@\x05\xd4{\x10D \xb7\x1cb\x85\xca\x1c\xa2\xdaq\x00I@\n\xf8\x9f\x8e!X\xc4\x0e\\\xa0\a\"~=̾\x99\xf7fgggvޛ7;\xef\xfb\x05\x16$\x97\xcb\xfd\xbc\u007f\xbf\xf7g8\xfb\xfd\x01\x14EQ\x14EQ\x14EQ\x14剂\x06\u007f+\f\xbe\x17\xf9\xd4\xdc\x04\x80\x00\x80\x9f\xbe\x99\xfc\xf0\x93\xdf\xec\x02\x00\x0e\xf6\xf7\xda\x1a\b\xbe\xf39\x01\x18\xe4\xd7\xfd#\xf1\xe0\xee\n\x1e\u007fx4\xf6\x8b\xdc@\xb0\xd5\x18\xbe\xf39\x01\x18\xe6\au;\xff\xddx\x19\xdfXy\x84O\x8e\xd6\xf0\x8d\x95Gm\x0e\x02\xdf\xf9\x9c\x00,\xf0\x17\xea\x96@v\xba\xfc\xfa\xc9\xd1\x1aVO\xaf\x00\x00\xfe\xe9o\xf6\xac\xaf{\x1e\xf3\xd3\x00\x94\\U\x92\xbd5\xd8-\xda\x1e\x90\xdf0\x00&F\xde\xf3\xd11\x9ez\xf6\xa3t\x10\xb4\x1d\xf9\x9e\xf09\x01X\xe2\xd7Z\x01.\xec$\x0fU\x8f?<§\xef\x9fJ\a\xc7\xf1\xc3{\xd6f\x01\x8f\xf9\x9c\x80,\xf1\xab\x06@\xb0\xbey\x84(\x8a\x10E\x11.\xec\x84c\x83 \x19 \xa1\xb5\xabU\x9e\xf39\x01Y\xe2/VǇx\xee\xa5\uf3be\xbf\x86\v;\xf7\xf1\xd37O\xb4\x8e\x8f\xa2\xb3X>s\xceR\xf7{\xcd\x0f\xd67\x8fD\x14E#^\xc6\xce\a\xe0\xe8%\xe4WT\xc5\x00\b\xc5\xd6\xe0\n\x1e?\xfa\x00\x00F\x03!\x19\x04\xb2\xe3\x01\xa4\x9d\u007f\xb0\u007f\xd5\xf0\x95\x00_\xf9\xa1\x00N\x02w\x01\xd8\u007f~P\xad\x00\xc9\x008~x/\xed\xe8յ\r\xbc\xf7ε\xa2\x8eGVhS\r\xe0\x1b?\x14\x000\x1c\xde\xc2\xf6\xf6Œם\xa4\xab\xd3\xf8\xcfM\xca\xe0\x0f?hR\x80\xad\xc1\x15\xad\xe3\xf5\x9f\xed7@?\xf9\xa1\x18\x0eo\xe1\xf0\xf0\x10\xe7ϟ/}\xa5,\xdbpxk\xc2\xeff)\x83_\xfcŲ\x02Lz\xf3i\x05\xf8\xf6\xf9[\xd8\u07be(\x9a6\x80\x9f|\xe0\xf0\xf0\x10\xfb\xfb7\v\u007f'\x9f\x1f\f.\xa5\\YV\xf55[\x83+8ؿ:S\x19|\xe2\aE\x03`0\xb8\x84\xfd\xfd\x9b\x18\f.\x95\x16@FhI\x01f\x98\x05|\xe7ge\xa8\xa2\xa2\x81\xd2|%\xf2\x87\x1f\xb0\x03\xba\xc8϶a\x9f\xdd\xfc&\x00\xe0\xc9K\x1fC\x06\xa6\f\xc0\xfd\xfd\x9b\xe9\xef\x01\xe0;\xbf\xfeV\xfa\xbd\xa9m`\xdf\xf9\x01;\xa0\xab|\xbd\x1c\xa3\xf7\x12فo\xbc\f\x92?b\x1b\xbc\x12\xe53\x1f\xa1\x18\x15Bd\xdf'\x8f\xc1` \x80P|v\xf3i\xf1\xd9ͧ\xc5\xd6`Wl\rv\x95׃|\xb3\x12Q\x14\xa5\x0f L\xbf\xaa\x8f\xe49\xf2\r\\\x06-,@\xfaC\x1cǈ\xa2\bq\x1ck/R\x9e\v,4\x00\xf9S\xb4|朵;b\xfb\xc6_\xac[\x82\xe53\xe7\xd2\xeb\xe1\xb20j\xa1\xd2\u007fD\xe4\x06\x85)\x91\x9f\xf1ǘ-\xc8g\xbe\\VF\xcb<\xc6\x1e\xea\xf3\xca\xf7\xe4[\xe0+\xcb<\xf9\r\xf8\xb5>\x103\xbaɨ\xec\xd37U^3s\x03\x90O\xbei\xfeB\x1d\xf8\xea\xda\x06V\xd76\xe4\xddv\x13\xb5\xba\xb6\x81\xcb\xd7o\x9b\xbc+\x90|\xf2\xad\xf0\xabF\xa7\xa8\xf1\xb7\xa2\x01\x87|\xf2\xbbȧ(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2\xa8\xf9\x12\xf3\x03\xcc/\x9fr\x14\x00\x02HMHS?Fű\xab\x15{l\x8f\xf9\x9c\x00\f\xf2k\u007f Fv\xfc\xeb/\xbeU\xe8\xd58\x1a\b\xd6\xec\xb1=\xe7s\x020\xcc\x0fLt\xbe\xf4ʗ_-\r\x02\xdf\xf9\x9c\x00,\xf0k\xe7\aP\xc1ң]ZU?\x1f\x1d[_\xf7<\xe6\x97\x06\xa0\xfc:ʜ\"ȷ\x14\x00\xaa\xd4\f)\xab\xa7WR\x9bj\x8b\x8d\xe0=\x9f\x13\x90Y~\xad\x0f\xc4\xe4?\x8b\xa9\xea\xf1\x87GXZZ\xc2\xfa摵\xd9\xcfc>'\x00K\xfc\xda+\xc0\xfa\xe6\xef\v\x9fO\xac\xa9\xa5\u007f\xbe\xbd}\xa0\xa7|N@\x96\xf8\xb5\\!\x96ϜC\x04\xa4\xb6\xe0\xaa=u\x14\x9dM\xed\xab\xe3\xf8>2\xa7^s\xf2\x9d/\x03PfH\x1c\x0f\xc0\xb3\xa3\xefc\xab\x13@\x9f\xf85\n\x99X\x84\x03H#1\xe9h\xa4\x9d/\xfd\xf3\x01\x93\xceh\xe4\xab\a\xc0\xe3\x87\xf7R\xee\xa4\x00|\xe3\u0557T\xb600\x18{˯m\x1c\vdޗr \xc8\xceW\xfd\xf3\xb3\x01\x10\n\xb3~\xfd\xbe\xf2CQ\xee\x87_,\xdd⽩Ud\xff\xf83\xad\x00\xc5~\xf8\xf6\x1b\xc0_~bٞW\x99?\xbe*\xe9\xb5\xdfԳ\xbf\x8f\xfc\xc5Y\n\xf0\xed\xf3\xb7j\x15@Z\x897\xf5\xec\xf7\x93\x9f\x04\xde\xf6\xf6\xc51\xbbv\xd5\x1f_5\xed\xcdk\x92\xd7>\xf9\x95V\x80\xa4\x00\a\xfbW\xc7\xde\\\xf5ǟV\x80\xccYy\xb6\x01\xe0;\u007f\xd2JS\xcf\xc6}\xb6\xe0\xef3?`\at\x9d\xaf\x97\x01\x00\xde~\xe6N\xfa\xfd4\xdb\xf6'/}lt\xfb\xd77~\xc0\x0e\x98\a~\xe2\x8b/\xcb \xf9yv&y\xf8n\xcc%_)@\xea\u007f/\xfd\xf0U\x8f|\xfd!O\xed\xa1\xa9\xff\x06z\xcf/2\x83\x95\xcf\xe9\x9e\xf9\xf6|\xf9\xfb\xc8\x0fj\x14 \xbd\xfe*\x15Eg\x11\xc7\xf7\xd3\u007f@$\xd7\xc6\xed\xf9\xe2\xfb̟\xf6\x9fP\xf2g\xe3W\xfeO\xf0\xf2\x99s\xd8*\xf0fW;\u007f\xf9\xcc9\xab\xbe\xf8>\xf3\xd3\xf7WT\xe8\x93O\xbe\xf9\xe5g\x82ﺶ\x14\x15<O>\xf9\x9d\xe7WY*\xb4O\xe0LH=S\xe55\r\xf6\xdf\xe4\x93o\x87\xbfP!\xfap\xf9\xfam\xac\xaem\x94\xbe\xb0\xc0\xbfݘ/<\xf9\xe4\xdb\xe2\aU\xa2\xaf\xe2\xdfЗ\x9e\xfc\xbe\xf1)\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(j>5\xcf\xf9\x01\xbaP\x06\x9f\xf9\xbdȏ0s~\x00iN\xf4\x93\xdfh7 \xb5\xd5\x10\xae\xcb\xe03\xbf\v\xfd\xef,\x00ă\xbb+\x85\xb6Թ\x86\xb0\xda\x01\x8e\xcb\xe03\xbf\v\xfdoT\vu+\xffn\xbc\f s敒\x89\n\f\xde\t\xd8\xc52\xf8\xcc\xefB\xff;\r\x00\x00\x99#\xaf\xfc\xfa\xc9\xd1\x1aVO\xafh\x8d`[\xd2\x06\xdbU\x19\x9e\x8f\x8e\xb1zz\xc5\x19\xdfe\x1ft\xa1\xff]\x04\xc0ĥ\xef\xf9\xe88\xb5\xa5\xb6\xbd\xef}p7a\xe4y-\x95A<\xb8\x9bXp?\xf5\xecG\x00\x90\xf2\xda\xe4\x03\xc0\xe6K_\xb4\xdd\a]\xe8\u007f\xf7+\xc0\x85\x1d-\x17\x13\x80Ė\xfa\xd3\xf7O\xa5\x8ds\xfc\xf0\x9e\xd5ep}3\xb1\xc1vQ\x86\xa7\x9e\xfd\bKKK\xce\xf8j\xfd\xd5\x01\xd7\x16\xbf\v\xfd\xef*\x00\x82\xf5\xcd#\xc5\xff>\x1ck\x84\xa4\x81B\xab\av\xb5\fy\x9f\xfe\x16\xca\x10,--\x8d\xf1\xe5@l\x83\xef\xb0\xfe]\xe8\u007f+\xaa\x91\x1f L\xed\xa7\x81k\xb8\xb0s_\xb3\xa7\x06\x12\x87\x84\xfc'\xf7\xcd\xcau\x19t\xfe\xfa\xe6}<\xb8\xfb\xe7\xce\xf8\xedֿ\v\xfdo!\xb2+V^sF[]\xdb\xc0{\xef\\\xd3\xfc\xf1\x81̶¼7\u007f;e\x10\x80\b&\xb6\x89\xeb6p\xc9\xefB\xff;Y\x01to{Շ%\x99\r\xae\x15T\x1c\xc6;\xbe\x1be\xa8\xc6\xd7\xcb0\xef\xf5\xefB\xdb;Qb\xeb7\x1c\x0e\vl\xff\xb2\x87\xb4\n,\xfey\x86K\r\xda\xc1\xa9\xdd2\x88\x1b\x10B@\x88\x1b\x0e\xcb\xe0\xb0\xfe]\xe8\u007f\xe7[\xa0d\v\x10b8\xbc\x95&\x15(S\x99?~\xddd\b\xe2\x06\x04v\x00\xbc\t\x04/\xb7[\x86\x94\r\xc9G s\x02\xb4^\x06\a\xf5\xefB\xffwj\v\xa4\xfa\xdd祺 \xab\t\n\xf2\xaf\x19y\xeaϜ\x1e\xc8\xf72\xf8\xca\xee\xc8!8\x14\xf5|\xefu\xe9~\xfaug Y\xa6vˠ\xcf\xc0\x8e\xca\xe0\xb0\xfe]\xe8\xff\x8e]\x05J\xf6q\xaa\xcf}\x99\x0f>\x00|\xe7\xd7\xdf\xca\x1d\x04\x9bV\xbe\xdd2\x14_\x05r\xd9\x0e\xbe\xb2;x ֿ\x0f5o|闟;\x04\x89y+\x83(}}\xc2K<\xe8k\x95a\xce\xfb\xa0\v\xfd\xdf\x1d\xe5\x92\x11\x14\x0f\b\x8b\x89\x12:P\x86P㗕\xc1\xd2\xd5\x10\x97\xf5\xefB\xff\xb7\xb5\x05*n\x80i\x89\n\xb2k\xc2V\xee\v'\xdfo\xbe\xdb\xd9\x1f\x8aG{Qʚ\t>\xeeV\xca\xd0U\xbeE\x9f|\xd7\xf5\xefB\xff;]\x01D\xce\u007f\xbd\xe8\ufafc\xa6\xd1\xec\x93\x1d\xb2\xf6:\xc9O\xae\x82\xd8\xf1\xc9\xefJ\xfd\x1d\xf5\xbf\x15-ԩ|\xce\u007f}\xa2V\xd76p\xf9\xfam\xe3>\xf1\x92?M.\xf96}\xf2\xbbR\u007f\a\xfd\xef|\x05\xa0O=\xf9.\xf9\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14E\xd5Ѽ\xe6\a`^\x00\xb7\xfc\xae\x94\xc1I\x00h7|ICT\xc52Ϻ7\xbd#6\xf9\xdd)\x83\xb3\x00H\xef\xf6{\xfdŷ\n\xcdRG\raś\xbe\x8c\xbdzz\x05\xeb\x9bG6;\xa0\xd3|\xcbmߥ2\x18Ղ\x89\xcaK\xaf\xf8O\x8e\xd6d\xe6\x10\xd1V\xc3K\xf6\xbb\xf12F\xeeɢ͎\xef\n\xdfb\xdbw\xa9\fN\x03`\xac\xf2\xb2\xe2\xd2+^\xfa\xf6ےKv\x19\u007f\xf5\xf4\x8aS~[\xf5\xefJ\x19\x9c\x06\x80*Yq\xb9\x05\x90>\xf1\x16g\xc2R6\x80V\xd8*_\xbaC˼\x01m\xf3]\xb4\xfd\xb42\xcc\xd3*P\xeb\x031e\x9f\x05}\xfca\xe2[?\xda\a\x1b_z\xcb\xd8\x00l\xb1k\U00057596\xd2@\xecQ\xfd]\xf7\u007f\xb7V\x80\xbc/}v\xf8\t\x15\xefz;\x87\xc1I\xec\xf5\xcd\xdf[g\x17\xf1\xe5\xec\xaf\xf2G\xc93Z\xe1\xb7]\xff\xaa\xfd?O\a\xe1\xc5:/^>s\x0e\x11\x80\v;\x89-\xb6\xea\x0f\x1fEgS\xff\xf8\xc46\xfb\xc4hA]\xb2\xcb\xf8\xc9\xe0s\xc7o\xab\xfe])\x83iՈ\xd2\xcc#^.\x85\xaa?\xfcs/}\x17\x8f\x1f}\x90\xbeږ?}\xfbl\xb3\xfc\xf2\x1c\x04]\xad\u007fW\xca\xe0t\x058\t\x12\xb3\xd3\xcc\xfb1\x1a\xfdFV^6\x8c\x9e%$4`\x90꒝u\xe8\xd6\xe0J\xfa\xfe\xd3\xf9\xa1AcX\xf7\xf5\xefF\x19\x9cj\x9a\x1f|\xf1C\xf7\x98w\xcf\x16\xb5\xafN\x18d\x17\xe6 \xe8z\xfd\xbb\xd0\xffη@\x89W~^e\xfe\xf0\xaa\xa4\xd7\xfc\xf6\xf6E\b\x9c \xa8\xb9\xf52ƾq\x82q\a\xe8v\xd8\xc0IP\x9c\x87\xa0\xdd\xfa\xff\xef?|\x81\xaf\xfe\xe7REv\x17\xfa\xdf\xf9\x16(\xd9\xfbmo_D\xde2[\xf5\x87W]\x83\xf3\x9a\xe45O6\xcb0\a+@v\xf8)\xca}U\xcfG\xfed\xe6ß)v\xf5C\xa8\x05va\x0e\x82\xaeֿ\v\xfdߡ-\x90\x9a%\xf0\xedg\xee\xa4\xdfO\xf3\x8d\u007f\xf2\xd2\xc7\xe9!\xaa\xe9Շ>\xb0\x9b\f\xc0\xf6\xebߥ2\xb8\x95v\xe8\x91~\xf0\xaaG\xfc\xb8%\xb8\xb1\x83\x8fK6\xea\xb0u\xab\xf0\x89\x95\x11\xf3U\xffΔ\xc1m\x00L\xf6\x81\x0fs\x9e\xf1v<\xe9\x1d\xb1Qte\xa3\x88\x9d\x95\xb1Wmߥ2\xb8\xdc\x02e\xb7\xc2N\xf3\x86\xb7\xe9KO\xbe\xb7|\xb7\xb3\xff\x04\xdfwͫޢ/~%>Z\xf0\xe4\x9f\xe0\xffo\x9d\xef\xba\xfe\x8e\xfb\xdf\xf9\nP\xc5\xf3ު/~\x15~\x05\xef\xfcƳ_\x15_\xfc>\xd6\xdfq\xff[\xd5B\x95ο|\xfd\xb6S_\xfc*|\x00\xd6}\xf9\xab\xf8\xe2\xf7\xb1\xfe\x0e\xfb\xdf\xf9\n@_|\xf2]\xf2)\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\x9aE\xf3\x9a\x1f\x80|\xf2\x8d\xf0g\xce\x0f0\xf2\u007f\xc4O~3\xf5F1+\x15'\x9f|\x13\xfc\xda\xf9\x01\x1e\xdc])\xf4\x85\xcf\x15ĚG>\xf9\xe4\x9b\xe4/ԅ\xbf\x1b/\x03Ȭ\xb1\xa5d\xa6\x10\x8bw\x02\x92O\xbeq~ms\\i\x89-\xbf~r\xb4\x96\x9a\xc4\xcaB\xd8\x14\xf9\xe4\x9b\xe4/ԉ\xbe\xa2\xa5\xe7\xf9\xe88\xf5\x85\xb7\xb9\xef#\x9f|\x1b\xfcZ+\xc0\x85\x1d-\x19\x1a\x80\xc4\x17\xfe\xd3\xf7O\xa5\x85;~x\xcf\xda2H>\xf9\xa6\xf9U\x03 X\xdf<R\xfc\xdfñB$\x05\fm\xcd\x00\xe4\x93o\x85_\xc3\x1d:L\xfd߁k\xb8\xb0s_\xf3\x87\a\x12\x9bl\xdd\x19ؤ\xc8'\xdf<\u007f&g\xb8յ\r\xbc\xf7\xce5\xcd\x1f\x1e\xc8l\xb1m\xfa\xf3\x93O\xbeI\xfe\xe24\xb0\xfaF\xaa'L\x12\x8d\xd7\n\xc0\x80\xc9\xc4\x18\xe4\x93\xef\x80_\xe4\xed>\xfe(\xf7\x8b'\x9f\xfc\xee\xf3\x83\"\xf8px+\xf5t/S\x99?\xbc\xf4ğ\xa5\xf2\xe4\x93\xdf\x16\xbfp\v\xa4\xfa\xbd祺\x00\xab\xfe\xf0\xf9\xd7l\r\xae I\xa9S\xbf\x11\xc8'\xbf-~0)\n\xeb\xf9\xbe\xeb\xd2\xfd\xe4g\x9b\x05\xc8'\xbf\r~P\xbe\x0f\x83\xe6\xf3^\xe6\x03\x0f\x00\xdf\xf9\xf5\xb7\xd2\xef\x9b_\t \x9f|\x97\xfcDO\x01\xe1\x17\xe3\xde\xf7\xa1\xe6\r/\xfd\xe2s\x87\x10\xd5/^4k\x88\xbc\xe7|\x1d\xbe\x89+\x113\xd5߄\xd2\xf6/JF\xe7S\xfb\xcf\xc0o\xf2\u007f\x80P\xc8D\xc7[\x83݉\x96\xd8q|\x1fQt6\xbd\x1e;\xba\x04\xa5\x98\xa4^\x95{1\x98\xc8R\"\xaf\xf7f\xfc\x18Q\x14\xe5\xf8\xe3e0ɖ,Y\xaf\xac~\x1a?0\xd3\xf1Y\x1f\xa8R\xf3\xf4\x16\xb5\u007f\x14E\xe9\xefM\xb6\xffx\x9e\xe0\x18[\x83ݢ\xfa\x1bn\xffTc&\xc0\x13\xf8A\x83\x00\b\xc5\xe5\xeb\xef\xa4I\x8fe'\x17\x05A\xd9\u007f\xdd\xe4ߙ\x1c\x84\xf9r\xe4\xf9\xf2C\x11v\\\x8a\x93\x01\x99\x0fn\xa5~FYE}0M\a\xfb{c\x83\xdft\xfb\x97q\xe38\xb6\xd8\xfe\xfa*VdT<\v\xaf\xe0*\xd0I\xf0ƫ/\x88\xcb\xd7ok\x99\xbfe\xc4\xe7\x9e(8\x80\xe8\x05\xab\xe2j\\e/\xa8\x0e\xfa\xe3\x87\xf7\x92Y/\xd7\xc9\x16-\xc2\x01\x00\x97\xaf\xdf\x06\x00<~\xf4\x816\xf3'\xb3pl\x904\xb9\x0f\x94O>\xc9\xf6)\x9e\x98F\xab\x82\xe9\xf6\xd7\xf7\xd8\xd9l\xdfF\xfb\xcb\xe0\xd6\xdb!\x1c\xf5\xc5l\xb7b\aU\x96\x1be\x86{\x02\xc0\x1f\xaa6\xd8\xd6\xe0\nV\xd76\xf0ƫ/\xc1D\xa2:}\xa9\x93\xef\x97\xf1._\u007f\a\x00\xf0ƫ/\x18o|\x95\x95\x9f\x9d\xcd\xce\xfeM\xfb\xa0\xed\xf6\xd7\xdb\xc5n\xfb\xcbU \xd4V\xc4&}P\xc1\x1e=l\xd4x\xcd\xf6\xc5\xc9V@κ\xd3O\xf8\xe9\xbe\xd9\xe6\xe7RE~\xd6Uf\xa0\xc0\x1eo\xb6\xf6\xeba\xfb\x03\x80PW\xe3&\xabN\xc7\xfd\xdb\xcbN\xf3\xce\xd2n\x8a\xf9m\xcf^\xb4\xbfg}@Q\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ3\x88\xf9\x01\xc8\xf7\x9a?s~\x00y\x93\x964$U,\xebZ\xf1\x87'\x9f|\x13\xfc\xda\xf9\x01$\xf8\xf5\x17\xdf*4+\x1d\x15Ě?<\xf9\xe4\x9b\xe4/\x98\x80K\xaf\xf6O\x8e\xd6d\xe6\x0e\xd1f\xe5\xc9'\u007fV~\xed\xfc\x00*\\\x82\xa5W\xfb\xf3ѱ\xf5\x8d\x1f\xf9\xe4\x9b\xe4/4)\x8c\x04\x03\xc0\xea\xe9\x95ԧ\xdd\xe2,@>\xf9F\xf9\x95\x13d\x94}8\x1eH,\xaa\x97\x96\x96\xb0\xbeydm\xefG>\xf9\xa6\xf9\xb5W\x80\xf5\xcd\xdf\x17>\u007fa'T\xfc\xdb\xed\x1d\x84\xc8'\xdf$\u007f\xb1\x0e|\xf9\xcc9D\x00.\xec$\x1f\xb8V\xfd٣\xe8l\xeaߞ\xd8t\x9c\x18\xaf<\xf9\xe4\x9b\xe6\u05c8\xd2\"_\x98̟\xfd\xb9\x97\xbe[\xf1s\xa3\xb3\x8a|\xf2\xcd\xf3k\xac\x00'Ab6\x9ay/J\x93\n\t\x97\x05\xd3\xfdzBa\xa6!\xc8'\xdf%\u007f\xaa\x1f{\xf1C\xf7x'\x9f\xfcn\xf1+\xa7H\x9a\xec\xc1^\xecϮJz\xbd7\xf5\x8c'\x9f|\xd3\xfc\xc5j\x91w\x05\xdb\xdb\x17\x91\xb7\xacV\xfd\xd9U\xd7\u07bc&y\xbd\xd7\xd9\xfb\x91O\xbe\r~P\xb5\x00\xd9\xc1BW=\x1f\xf7٢\x9f|\xf2m\xf1g\xca\x12\xf9\xf63w\xd2\xef\xa7\xf9\xb6?y\xe9\xe3\xf4\x10c\xe2\n\x00\xf9\xe4\xb7\xc5\xcf\xf9\xcbg\x87\x0e\xe9Ǯz\xb4\xab\x0f\xc5K\xff\v\x00O5=\xfc\xd4\xe5g\u007f\x17\x1a\xfcw\xfcL\xf5o\u009f\xb9\xfd\x95\x83\xa1\x97\xed_\x87\x1fL\x8b:\xdd\x06|\x0f\xaa/\xa6\xf4\xa6\xcf\xfb\xf6\xab\xff\xb8P]|\xebG\xa1\xba\xfc\xe9\xec\x8c\x1fk\xae\xc1\xd9\xf5\xe1\x13k\xe6\xb8RE9\x02\xf4<\x02\xcdg\xbd\x83\xfd\xab\xca{ƥ\xed?\xc9.\xbe\x89\xb7hY\xfbKOT\xd9\xfe\xf6\xf2\x04\x97\xb5\xff^#W\xea\xa0Z\xe5\xcb\xfd\xe9'\xe5\x0eP\xff\xae\x81K\xb1\xc8\xf9\xcdOL\x18\xa1r-{W\nա\xb8x\x920\xe7ǟ\x0f\x82\"o\xfc\xbc\f\xb5}a\xfb\xcb\\\x00\x93\xcaa\xb9\xfdEIn\x80\xda\xdb\xfbҫ@\xabk\x1b\x15\xfc\xe9Ǖ\x9f\x91\x93\x0e\x98\xd92;8\xd8\xdf\x13[\x83ݒ\xe4\x14\xc9l8\xfe\x0f\x10X\xeb\x88(:\x9b\xb2Ձv\xf9\xfa\xed\xd1`k.\xb5\xfde^\x84\xf1\xc3\xe0InV\xde5\xd9\xf6%\xed\xbf\xab\xad\n\xe3\x13\xa4\xbd\xf6\xd73Ü\x8c\x8d\xb9:\xb9\x1aJW\x00\xe9\x83?\xd9\x17\xbeP_\x03\xf0\xb9>C\x1a\xb1\x0eO-\xb1\xf5\x016\x9e'\xc0lf\x94j\xab@6˚\xea\xf4\x99\xda\xdfV\xdbOh\xff\xf1\x1c\x01-\xb5\xbf(\xc9\x11`n\vTcЗ\xbc\x87Q\xbf\xf8\x12\xaf|\xfdJ\x81̌\xd2,9D\xf92\\\x94\x1b\vFS25i\u007f+^\xfd]h\u007fm\v\x94qf[\xe5z\xe4\xa5ު\x97=\xfd\xe9ݵ?۞\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2(\x8a\xa2\xa6\x89\xf9\x01\xe6\x97O9\n\x00͟]J\xb9K\xb4U\u007fx\x0f\xf9\x9c\x00\f\xf2\x17\xebBU\x8b\xea\xe3\x8f\x14\x1f\xc6\xec\x9eq\x81\x96\xfc\xe1=\xe4\x97\x05\xa0p<\x01\xcc%?\xa8\xdb\xf9c\x1d\x9f\xd3\x0f?\xd85y\v.\xf9\x15\x03\xf0\x87\x1fh\x1fZi}\x02\x98W\xfeb\x93\xd2<\xfc\xdd\x1a\xce\xfcգ\xf4k\xdb\xf2\x8c\xaf\x05\xe0\xf1G\xfa/_\xdb\xd8KW\"K\xabP/\xf95ܡ\x83\x14\xfe\xf0wIb\x02\xd9\xe9\xf2\xeb\U000a956c \x16\x8e+~\xf3\x8b\x03P\xfd\xeab\x02\x98w~\x8d\xfc\x00\xff\x92\xfeP4\xdb-\x9fJ\x12\x14,\x9fZ\x91\xfb3a6\xfa\xbd\xe6s\x02\xb0\xc4_\xa8\n\a0\xb6\xf7\xfd\xbb\u007fN\x1e\xf2w\x9f\xbe\u007f\xaat\u007f\xdc\xf4b\x95\xbf|N@\xb6\xf8\x15\x03@\xa4\x1d\xaev\xbeLH\xa0\x0e\x02\xf55\xa6\xafx\xf9\xcb\xe7\x04d\x8b_\xeb\x10\x9ctv\x9c~/\x13\x12$\x85ɞ\xb7%\u007f\xf9Y\x00\xfe\xfc\xc7z\x00J\xf6\xcf\u007f\x9c\x05\xe0ր|\xd3\x01\x90Zc\xe4=\xd9\xe5\xf7\xe9R\x94\x99a\x99\xbc\n\xe0;\x9f\x13\x90%\xfe\xcc&U\x00\n\x13\x12\x983\x86\"\xbf\x88-\x99j\x00\xae\xaem\xe0\xbdw\xae\xe5\x03\xd0t\x10\xf6\x92_;E\x92\xea\x0e\xa6J\x9a\x11\xe9v!\xe6S\xe4\xf8\xccOlN2?\x9c\xf2\x00\x04\xcc۴\xf8\xccW\xccI\x13\xf3Uh\x0f5[\xc7p8T2s\x80|\xc3|\x8c\xccj\xa3(J\x1fj9\xf4\xcc(\xa6\xae\xc6\xf8\xceO\xa30?\x10\x8a\xd3\xd2讽\xe4\x9ba\xe7\x83M\x0f@阬\x06\xa0\xf2:\xf2\x9b^\x05\x02N\x10E\x91扙\xf7m\xcf\xfcؑ\xbe&Inf$Q\x9a\xc7\xfc\x93\x00\x80\xd0\xf9\x93Mr\x0f\x0f\x0f\xd5\xc1\x83\xe6iK\xfbɯ\x13\x00\xe9!D:\x15\xab\x03Av\xbeL[\xd3,-\x0e\xf9e\a\xf0(\xdd\xef^U\xf7\xba8\x000\x1c\x0e5\xfe\xdb\xcf\xdc\xc1\x01\xf9\x8d/\x83j\x97\xf9\xe4\x01$\x1b\b{#H\xa8E\xdfg7\xbf\x89'/]5:\n|\xe7\xcb2\xa8\xfc\xfcA<\x9b\xfdNF+\x92\xd9+R}\xe2/\x9a\x19\bѨ\x00\xf7\xb5טI\x8fC~\x19_\xbd\x1a\x12E\x11\xb6\xb7\xb7\x95e\x1f\x81\x8d\x8c\xed>\xf2s\xa7l\xe4\x1e\xe3'tço\xf2+\xf2\xb3\xf4L\xe4Wy\xe3\xaa3\xd3X\x96\x90\x82\xbf\xad\xf2\x9a\xc6\xfb?\xf2\xc97\xc9_\xa8\n_]\xdb\xc0\xea\xda\xc6\xd4\xf4<2K\x8a\xc1;\x02\xc9'\xdf\x1a\xbfJ\x84\x8a\x1a\u007f'fd\x90O~W\xf9\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x145\u007fb~\x80\xf9\xe5S\x8e\x02\x80\xf9\x01\xc0\xfc\x00}\x99\x00\x98\x1f`\xbe\xf8e\x01\xe8K~\x00g+\x00\xf3\x030?\x80k\xbeq-4\xf9c\xdas\xb7\xcaO\x03\U0003537d\xb1 |mc\x0f\xafm\xecYr\xa6\xee\x04\xdfu\x00О\xbb\n\xff\xf5\x17\xdf\xe2\x040G\x9a\xfb\xfc\x00˧V\x9c\xf1\x97O\xadh\x8f\xa7\x9eMҖ0?@\xef\x02\xa0\xba=\xb5ͣJ\x11\u007f}\xf3\b˧VR\xbe\x1c\x84m\xf0\xd77\x8f\xb0\xbe\xa9?g\x87\xdf\xcd\t\xa0\xc5\t\xd0u\x00L\xf7Ǘ\x1d\x91\x1f\x10\x06/<L\xe4\xcb \x00\x80\xa5\xa5%'|9\xf0\xed\xf0\xbb9\x01\xb47\x01ړ\xb1\xfc\x00\xeb\x9bn\xed\xb1]\xf3\xe5\xc0w\x91\x1f`}3ƃ\xbb\xd9\x040\xeds\xb3\xa6\xf9ҟ_N\x80\xe6\xf3\x03\xb8\x0f\x00\xe6\a\xe8x~\x00\xd7\x13@\xf2\xfc\x91U\xbe\xbd\xcdu\xcdKaR\xcc\x0f\xc0\xfc\x00-\xf2]\a\x00\xfd\xf9\x99\x1f\xc0\xa9?\u007f7\x02@\xafh\xa6̔\xe8jj\x10\xbb\xbd}\xd1\xe0  _\xf2\xe5 S\xbd1\xf3\x018\x1cޒ|C\x83\xd05\xbf\x13b~\x80i|\x010?\x80\x15~\a\xae\x021?\xc0t>.\xfd\xb7%\xbe\xef\xf9\x01\xdc+5\x1f\xcd\xd2\xd2d3bQ\xf4g3@(\xda\xe2\v@\x88Ԡ\xb6]\xbe\xb8\x01!\x04\x84\xb8a\x8f\xaf\x96AYaR\x93\xd8|\xfde\xbb\xb4ÇM\xbe\xf3\xab@\x85W@\xd4\xfd\x1f\x10B\x8c\"=@8\xf2\xc77\xe6\x0f?\x95/n\x00\xd8\x19=\xf9&\xf0\xf9W\x9en\x8d\xaf\xb1[\xe6K\xfd\xf2b\xac\xd5?x\x19&\xafHM\xe5\xc7q\x8c\xec\x9eh9\xeb[\xbb\"\xe7b\v\x94\xa9\xba?\xbe\x9d\xca\x17\xf1\x81\xd8!?\x1e{M\x1b\xfcI\x831\x9b\xe0\xda\xf5\xe7\x0f\xe2\xb8\x15~\xeb\xdb\x1f̉?~\xb6\x04\xb7\xcf\u05f7@\xfe\xd5\xdfb~\x80n\xec\xffs\x95\x9f\xe55\xbd\xe7\v}p\xb0\xfd;.\xe6\a0\xcc\a@\u007f~\xb3|\xe7\x87`\xfaӓ\xef\x92OQ\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14EQT]1?\x00\xf9^\xf3\x99\x1f\x80|\xaf\xf9\xcc\x0f@\xbe\xd7|\xe6\a \xdfk>\xf3\x03\x90\xef5\xbfF\x94\x06\xe2\xc1ݿL\xed\xb1'9\x04\x1f\u007ft$\rS\r\xcf\x00\xe4\x93o\x9e?\xf7\xf9\x01\xc8'\xbf\t\xbf\xe2!x\xb2=6\x908\x06'\xbf;U\xba?k\x10\xfd\xe4\x93o\x85o,?\x80,\xa0\xfa\x1a\xc3W\xbe\xc8'\xdf8\xdfX~\x00\xf5y[\"\x9f|\xd3\xfcڗAk\xd8c\xbb\xb6\a'\x9f|\xa3\x01\x90\x16B\x8a\xf9\x01\xc8\xf7\x88\xaf9\xfdj\x1e\x99\x89\x19\x92j\x9c\x14Z\xf0\x83$\x9f|\xf3|\xe6\a \xdfk~P\xb7\x10\tL-\xc8ձW\r\x87\xb7pxx\x88\xfd\xfd\x9b\xa3gN\ff)!\x9f|s\xfc\xba\xf9\x01~\x11E\xd1\xc2\xc1\xfe\xde\x13@\xb8\xce\xfc\x00\xe4\xb7\xcdWSP\xb5\xcd\xcf\xef\xbf\xfe\v\b\xff\xafk\xf9\x01\xc8\xef7\u007f܄\xb7\x19\xbf\xd6\np\xf9\xfam\x00\xc0\x1b\xaf\xbe\x80(\x8a\x9e\x8b\xa2\xb3\xbf\x02\xb0~\xb0\xbf\xf7\xa7\a\xc9\x12\x05 \xcb\x0e\x92\xe4\a\xb8j\xf4D\xaeے\x9fM\xf7\x83\xe5|s\xea.?\xf1\xe3\xdf\xdeޖۅ\xde\xd5_\xee\xfb\xe5~\xbf:\xbf\xf9\x16Hl\rv\xd3\xeb\xaeJC\xfc\xed\xf1\xc3{\xbf\x8a\xa2\xe8\xd98\x8e\xc7ޫ\xed\xfc\x00\xc5\xf9\t`\xa5\fE\xfc\xe53\xe7\x94\x01\xd8.?\x8ecm\x82\x02NZ\xaf\xbf\xc5\xf6\xd7.\u007f\x1e\xec\xef\xa5A\xa0\xd6\u007f\x96\xf1\x17\xccZ\x80\x9c~\x05\xe0\xd9,\xa0ҜP\xa6\x1a^\xa8\xa7\xfdq~\x96\xba\xd3f\x9e\xde(\x8aҎ\xd7\xcb\x10B\xa6P-h'\xa3\xb9z'\x1c\x0e\xd3\xfdqA\x1f\x19\xc9\x0es\xf9\xfamm\x02\xcc\xd7?۟\xef5\xb8\xc8R\xce\a\x90\x96\xa1\x88\x9f\x05\x82\x96\x93\xac\xb4\f\x8b\r\n\x15\x94\x1dVL+\xbb\xccUԱ'\xa2`\x9bf\xe5\x93I\xc7\x0f\xef\xc9\xd9F\xe5Cf\x92\a\x92\xffLZRP\xd2\xdeV\xad\xc8\xdfx\xf5\x05\xc8 \x18\x0f섯N\x00\x05\x93d\xa3z\x8f\xfa3\xffQ\xc8@\x1doq\x1c\xa7V\xee\xd9J8\xf3 .\x9d}\xda\xfc\x00\xb4V\xa9\xe2\x0e\x988;\x1a_\x05J\xdeW\x94\ai;\xeddq\xf5\x996\x06\xaa\xf6\x93\xcd>\xa8=N\xe7\xc1\xbb}\x9e<\xe7\x85\xe3\xf2\t\xc7m\xc4\xfc\x00\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14\xd5\x051?\xc0\xfc\xf2)G\x01@\u007fz\xb7|N\x00\x06\xf93ۢ\xac\xaemh\xb7\xc7\xe6\x06\x82u\u007fxO\xf9\x9c\x00\f\xf3k\x1bcM\xea\xf8\x16\x06\x81\xcf|\xed\xf3\x10\x0e\x02\xb0\xb7\xfc\x05P]\x97\x18\x0e\x87i竷\x1b\x17Ɇ3s\x9f\xf9\v\x15\xa3O\x94E\x9d\x94\xa5\x0f\x82\xf8Χ,\xaa\xf2\n\x90\xdfs\x15u\xfe\xbf\xff\xe3\xdfcum\xc3\xc6,\xe05_~\xce\xd8U\x00\xf6\x99_k\vT\x06\u007f\xfc\xe8\x03\xfc\xeb\u007f\xfcLy\x8d\xf9\xb3\x90\x87|m\xf9w\x10\x80\xbd\xe7/T-@\xfd\x01\"\x8c\x0e\x00O\xf9\x9c\x80,\xf3+\xaf\x00҉\xd7\xf4k\xc97\x13|\x16\x02\xd0\v\xfeB\x95\x02\f\x06\x03\xc4q\\\xa9c\x8b]\x13\x9a5\x80\xa7|\x00\x99ɓ\xab\t\xa0\xef\xfcŪ\x05\x00B\xc4q\f\x99\u007fC\xf5dW\xa1E\x06E&\x1a\xc0W\xbe\x94d繶\x03\xb0\xef\xfcJ\xbe@\xd2eW5>\x8a\n\n\x98\xbd\xe6*Lz\x03y\xc8\x17\x9f\xdd|\x1a?\x03\xb0\xbf\xbf\x9f\xbeg\x8b\x01\xe8\r?(-\xc0W\xff\r\xdb\xdb\x175\a^\xa9I\xfe\xec\xc9\xef\x1a\xfb\x81zϗ\xabN\xf2\xde\xe3\xbe\xf8\xf9\\X\x05\x01H~\x93\x00\x98T\x80i2Py\xf2Ge(\xbb\xf479A\x84\xb1[\x11\xbc\xe0\x97\x06@>\x1fS\x8b\xfb?\xdf\xf9\xda (ʅE\xbe\x19\xfe\xc2\xe4\xc0\bg:U\x8f\x96\xa6\xa6\xd7\xc0|\xe7\xa7\xe58\xd8߫\xb5\xaf%\xbf\x1e\xbf\xe4\x10|\x12\x8c2k u߭\x10\x85\xe6\xe4;__\xa5\xe38\x16\x93\x0e\x80\xe4Ϯ\xc5i\x83`\xf45\x8d\xaa\xa2\xe5H\x9d!\x97Ϝ\x03\x8c]\n\xf4\x9d?y\xa9W\xcb@\xfe\xec\xfc\x1a\xf6\xe8\xc9up5\x1b_\xfeR_\xee\xd0b؞\x9c\xfc1~\xae\xa3ɯϯz+\x84P\x130$'\xed\x93`\x04\b\xf2\xa0i7.\xcdv(%\x9f|\xf3\xfcJ\x9f\a\xd8\x1a\xec֮\x94\xc9;\x02\xc9'\xdf\x16?\xa8\x16}\x95\xffΆ?<\xf9\xe4\xbb\xe4S\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14E\xf5[\u0086\xcf\t\xe5\\\x8bl\x82y\x8eIM̏0\x83\xe6&A\x86\x9c\x81\x83\x8c\xd3\x1a_܀\xc0\x0e\x807\x81\xe0\xe5\xf6\xf9E\xed\xce\xfc\b-\a\x802\x00\xd1v\x03\xa4\x03\x10@\x10\xb4\xcbW\xd9.\xf8j\xfb\apfO\x9e\x16\xa3\x03\xf9\x11\x9c\x04\x80\xb7\xfe\xf4\xe2\x06D\xf0r\xf6\xb3\x8b\xfa\xcb2x\x9e\x9f\xc1\x8a*\xdd\x0e\xed\xb3?}\xf02\xe0\xb8\xfe\xce\x0f\xff}\xbe\x00\xc0\x04\x19s0\x06\xe5\n\xe4\u009e\\\xfd\xb4I\x1f\xf3#T\n\x00\xfa\xd3o;\x1f\x00\xbe\xe7gp\x15\x00\xf4\xa7w\xcb\xd7\xe4\xbb=\xba\xf3-\x10\xfd\xe9\xdd%Ȩ_\xbe\xfe\xe5Gh;\x00\xe8O\uf5af\xc9\xf7\xfc\f\xceV\x00\xfa\xd3;\xe33?B\v\xaa|+\x04\xfd\xe9\xdd\xf0\x99\x1f\xc1M\x00П\xde-_\x93\xef\xf9\x19l\xaa\xb6=:\xfd\xe9\xdb\xe1{\x9e\x1f\xc1y\x00\x00\xf4\xa7w\xc9g~\x84\x0e\x04@:\b\xe8O\xdf:\x9f\xf9\x11:r\x15\x88\xfe\xf4n\xf8̏С\xab@\xf4\xa7w\xc2g~\x84\xae\x04@\xe1R\xa7\x0e\x04\xfa\xd33?B\x9b\xed\xef(\x00\xe8O\xdf9\xbeW\xf9\x11ܜ\x01\x94\xcaП\x9e|g|\xa7\x01@\u007fz\xf2]\xf2\xed\x1f\xf0\xa6G\u007f忣?=\xf9\xa6\xf9\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14EQ\x14E\xd5\xd5\xdcأ\x93O\xbe\r~0+\xd8c\u007fz\xf2{į[X\xe1\xb9?=\xf9=\xe3\au\xe1\x1e\xfbӓ\xdfC>\xed\xd1)\xafU\xe9\xf3\x00r\xdf\xe5\xc8\x1e\x9c|\xf2\xad\xf1+\xaf\x00\xbe\xfbӓ\xdfO~\xad\x0f\xc5O\xb7\aG\xce\x1e\xdc\xec\a\x82\xc8'\xdf4\xbfr\x82\x8c\xfa\x05\xec\x85?=\xf9=\xe7W\xde\x02\xf9\xeeOO~?\xf9_\xa9\x12}\xcb\xcb˸s\xe7\x0e\xfe\xe2O\x04\xfel寧\xc2M\xfbӓO\xbe-\xfe\xd43\x80\xef\xfe\xf4\xe4\xf7\x9b_\xe9\x10\xec\xbb?=\xf9\xfd\xe5WH\x90qS\xb3\xc7\xceـW\xbeLUw\xf9#\x9f\xfc6\xf8\xb5\x13dLSO\xfc\xe9\xc9\xf7\x84_\xba\x05R\xc1\xff\xfd\x8b\x03|\xfd\xeb_G\xb8~\x1eO\bQr\xfaN\x8dT\x1b\xdf\v\xa2\xf2\xab\xfaӛ\\z\xbb\xc4g\xfb\xdb\xe1/L^\x19B%\xaa\xf6\xf0\xdb\xdf\xfe\x16q\x1c\xe3\xe4\xc1!>\x0f\x02\x88/\xbf,\xfc\xc3|\xea\xa0\x19U\xe8O_\xe5\xf2V\x1f\xfd\xf9e\xfbK\xe5\xdb\xff\xf8\xe1=\xd4\xcdc\xc0\xf6/\xdf\x02ɓ\xb7\x90\x11\xb5\xf9\xca\xf7\x01\x00w\u007f\xf4\x03DQ\x84p\xfd<\xbe\xf6\xe5\x1f\x11\x04\v\x93N\xe2\x06\xee\x06\fu\u007f\xfaQpM\x9a\t\xda\xe0\x97\xcdB\xf6\xf8\xfa\xc4\x12\xae\x9f\a\x00|\xed\xcb?\xe2\u007f\x1eň\xe3X\xeb\x9fj}\xcb\xf6\xafp\x15\xe8$\x80\xbc\a\xbb`\xd9\xfd\xc3\xc2W\xf0ė_\xe2\xf8\xd1/\xf3\xa7qC\xb7¦\xfe\xf4B=\xe9\xe7\x135t\xc9\x1f߬ҽ\xac\xd6\xf8'\x0f\x92K\x83\u007fX??6\xebo\xbe\xf2}5\b\x8c\xd6\u007f\xf9\xcc9\x1c?\xbc7\xb1\rre1\xb0\r\xb3\xdf\xfe3%Ȉ\xe3\xb8l\x90\x19\xbf\x0f\\\xcenr\xef{\xb0\xbf\a\xc4\xf7\xe1\xc2\x1f_\u0382\x05\x1d\x8e\xad\xc1.>\x0f\x02l\xae\x9f\xc7\xdd\x1f\xfd\xc0$?\x88\xe3X\x14t\x04Z\x92P'\x98\xf4\nL\xcaOfh\xd9\xfe\x9f\a\x81\\\x8dL\xb5\x816\x06\x92\xfe\x8f\v\xb7\xddI\x9f\x84\x95\xcf\x02\xb3~\x1e (yX\xd3\xe7A\xa0|ء]\u007f\xfc\xcdW\xbe\x87\xad\xc1.\xc2Ѭ\x1b\xc7\xf1\x18_v\xfc\xa4Cj\xf3}q\xfbm^Ҧ\n?鋃\xfd\xbd\xb4\rLK\xb6\xa9\xf2\xfe)?\x8e\xe3 \x8ec\x84\xeb\xe7\xb15\xd8\xc5\xe6+߫|\x0e\x98\xc5\x1e݅ݵ\xa8\xc0\x17\x13>'\x1a\x18旖\xc1a[\xd9b\xd7mWa\xa3\f\xb6ڿo\xde\xed\xf4\xa7g\x9bR\x14EQ\x14EQ\x14EM\xd4\xff\x03\xf6\xd39\x9b\xa0Q\x98\x9f\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -0,0 +1,152 @@
+package levelgen
+
+import "math/rand"
+
+// Cave constants
+const (
+	// caveFloorChance is the chance of each cell starting as floor
+	caveFloorChance = 0.58
+
+	// caveSmoothing is how many times the cave is smoothed
+	caveSmoothing = 4
+)
+
+// caves fills the level with random noise, then smooths it into caves: each
+// cell turns to wall if most of its eight neighbours are wall, and to floor if
+// most are floor
+func caves(r *rand.Rand, g *grid) {
+	for y := 0; y < g.h; y++ {
+		for x := 0; x < g.w; x++ {
+			g.set(x, y, r.Float64() < caveFloorChance)
+		}
+	}
+
+	next := newGrid(g.w, g.h)
+	for i := 0; i < caveSmoothing; i++ {
+		for y := 0; y < g.h; y++ {
+			for x := 0; x < g.w; x++ {
+				// Cells off the level count as wall
+				walls := 0
+				for dy := -1; dy <= 1; dy++ {
+					for dx := -1; dx <= 1; dx++ {
+						if (dx != 0 || dy != 0) && !g.isFloor(x+dx, y+dy) {
+							walls++
+						}
+					}
+				}
+				floor := g.isFloor(x, y)
+				switch {
+				case walls > 4:
+					floor = false
+				case walls < 4:
+					floor = true
+				}
+				next.set(x, y, floor)
+			}
+		}
+		g.floor, next.floor = next.floor, g.floor
+	}
+}
+
+// Room constants
+const (
+	// roomMin and roomMax are the smallest and largest sizes of a room side,
+	// in tiles
+	roomMin = 3
+	roomMax = 5
+
+	// roomTries is how many rooms are tried per room placed, as rooms that
+	// overlap others are thrown away
+	roomTries = 8
+)
+
+// rect is a room, in tiles
+type rect struct {
+	x, y, w, h int
+}
+
+// centre returns the top left cell of the 2x2 cells in the middle of the room
+func (r rect) centre() (x, y int) {
+	return r.x + (r.w-1)/2, r.y + (r.h-1)/2
+}
+
+// overlaps returns true if the rooms overlap or touch
+func (r rect) overlaps(o rect) bool {
+	return r.x <= o.x+o.w && o.x <= r.x+r.w && r.y <= o.y+o.h && o.y <= r.y+r.h
+}
+
+// rooms places rooms at random where they don't overlap, joining each to the
+// one before it by a corridor two tiles wide
+func rooms(r *rand.Rand, g *grid) {
+	want := g.w * g.h / 40
+	if want < 2 {
+		want = 2
+	}
+
+	var placed []rect
+	for try := 0; try < want*roomTries && len(placed) < want; try++ {
+		room := rect{w: roomMin + r.Intn(roomMax-roomMin+1), h: roomMin + r.Intn(roomMax-roomMin+1)}
+		if room.w > g.w-2 || room.h > g.h-2 {
+			continue
+		}
+		room.x = 1 + r.Intn(g.w-room.w-1)
+		room.y = 1 + r.Intn(g.h-room.h-1)
+
+		overlaps := false
+		for _, p := range placed {
+			if room.overlaps(p) {
+				overlaps = true
+				break
+			}
+		}
+		if overlaps {
+			continue
+		}
+
+		for y := room.y; y < room.y+room.h; y++ {
+			for x := room.x; x < room.x+room.w; x++ {
+				g.set(x, y, true)
+			}
+		}
+		if len(placed) > 0 {
+			corridor(r, g, placed[len(placed)-1], room)
+		}
+		placed = append(placed, room)
+	}
+}
+
+// corridor carves an L shaped corridor two tiles wide between the middles of
+// two rooms, turning its corner either way at random
+func corridor(r *rand.Rand, g *grid, from, to rect) {
+	x0, y0 := from.centre()
+	x1, y1 := to.centre()
+	if r.Intn(2) == 0 {
+		carveH(g, x0, x1, y0)
+		carveV(g, y0, y1, x1)
+	} else {
+		carveV(g, y0, y1, x0)
+		carveH(g, x0, x1, y1)
+	}
+}
+
+// carveH carves two rows of floor from x0 to x1, starting at row y
+func carveH(g *grid, x0, x1, y int) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	for x := x0; x <= x1+1; x++ {
+		g.set(x, y, true)
+		g.set(x, y+1, true)
+	}
+}
+
+// carveV carves two columns of floor from y0 to y1, starting at column x
+func carveV(g *grid, y0, y1, x int) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	for y := y0; y <= y1+1; y++ {
+		g.set(x, y, true)
+		g.set(x+1, y, true)
+	}
+}
@@ -0,0 +1,311 @@
+// Package levelgen generates levels: caves grown by a cellular automaton, or
+// rooms joined by corridors. Every generated level is connected, with a
+// start, an exit as far from it as possible and spawn points for viruses.
+// Reaching the exit completes the level. The same options always give the same
+// level, so a generated level can be named by its algorithm and seed alone.
+package levelgen
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+// Algorithms
+const (
+	Caves = "caves" // smoothed random noise
+	Rooms = "rooms" // rectangular rooms joined by corridors
+)
+
+// Algorithms returns the names of the generation algorithms
+func Algorithms() []string {
+	names := make([]string, 0, len(generators))
+	for name := range generators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// generator carves floor out of a grid of walls
+type generator func(r *rand.Rand, g *grid)
+
+var generators = map[string]generator{
+	Caves: caves,
+	Rooms: rooms,
+}
+
+// Generation constants
+const (
+	// minSize is the fewest tiles wide or high a level can be
+	minSize = 8
+
+	// maxAttempts is how many times a level too small to play is generated
+	// again before giving up
+	maxAttempts = 20
+
+	// decorationChance is the chance of each floor cell being decorated
+	decorationChance = 0.08
+)
+
+// Tiles are the tiles a level is built from
+type Tiles struct {
+	Floor       int   // laid everywhere
+	Decorations []int // scattered over the floor
+	Wall        int   // the top of a wall
+	WallFace    int   // the front of a wall, used where there is floor below it
+	Exit        int
+}
+
+// DefaultTiles are the game's tiles
+var DefaultTiles = Tiles{
+	Floor:       243,
+	Decorations: []int{218, 219, 244},
+	Wall:        340,
+	WallFace:    341,
+	Exit:        342,
+}
+
+// Options control what is generated
+type Options struct {
+	Algorithm     string
+	Seed          int64
+	Width, Height int // in tiles
+	Spawns        int // how many virus spawn points to place
+	Tiles         Tiles
+}
+
+// Generate generates a level
+func Generate(o Options) (*manifest.Level, error) {
+	carve, ok := generators[o.Algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unknown algorithm %q, want one of %s", o.Algorithm, strings.Join(Algorithms(), ", "))
+	}
+	if o.Width < minSize || o.Height < minSize {
+		return nil, fmt.Errorf("level is %dx%d, want at least %dx%d", o.Width, o.Height, minSize, minSize)
+	}
+
+	r := rand.New(rand.NewSource(o.Seed))
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		g := newGrid(o.Width, o.Height)
+		carve(r, g)
+		blocks := g.connect()
+		// A level needs at least a quarter of its cells to be worth playing
+		if g.floorCount() < o.Width*o.Height/4 {
+			continue
+		}
+		return g.level(r, blocks, o), nil
+	}
+
+	return nil, errors.New("couldn't generate a level with enough floor")
+}
+
+// ID returns the id of a generated level, such as "generated:caves:42", which
+// the game loads like a level asset
+func ID(algorithm string, seed int64) string {
+	return manifest.GeneratedPrefix + algorithm + ":" + strconv.FormatInt(seed, 10)
+}
+
+// ParseID returns the algorithm and seed of a generated level's id, or false
+// if id isn't one
+func ParseID(id string) (algorithm string, seed int64, ok bool) {
+	if !strings.HasPrefix(id, manifest.GeneratedPrefix) {
+		return "", 0, false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(id, manifest.GeneratedPrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", 0, false
+	}
+	seed, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return parts[0], seed, true
+}
+
+// grid is a level being generated, true where there is floor
+type grid struct {
+	w, h  int
+	floor []bool
+}
+
+func newGrid(w, h int) *grid {
+	return &grid{w: w, h: h, floor: make([]bool, w*h)}
+}
+
+func (g *grid) in(x, y int) bool {
+	return x >= 0 && x < g.w && y >= 0 && y < g.h
+}
+
+func (g *grid) isFloor(x, y int) bool {
+	return g.in(x, y) && g.floor[y*g.w+x]
+}
+
+// set makes a cell floor or wall, leaving the border of the level wall
+func (g *grid) set(x, y int, floor bool) {
+	if x > 0 && x < g.w-1 && y > 0 && y < g.h-1 {
+		g.floor[y*g.w+x] = floor
+	}
+}
+
+func (g *grid) floorCount() int {
+	n := 0
+	for _, f := range g.floor {
+		if f {
+			n++
+		}
+	}
+	return n
+}
+
+// isBlock returns true if the 2x2 cells with x, y at the top left are all
+// floor. VaxerMan's feet are a tile wide, so only gaps two tiles wide are
+// sure to let VaxerMan through, and anywhere in a block of floor is
+// somewhere to stand.
+func (g *grid) isBlock(x, y int) bool {
+	return g.isFloor(x, y) && g.isFloor(x+1, y) && g.isFloor(x, y+1) && g.isFloor(x+1, y+1)
+}
+
+// connect keeps the largest set of blocks of floor that can reach each other,
+// turning every other cell into wall, and returns those blocks by the index
+// of their top left cell in order
+func (g *grid) connect() []int {
+	component := make([]int, len(g.floor))
+	id := 0
+	var largest []int
+	for i := range g.floor {
+		if component[i] != 0 || !g.isBlock(i%g.w, i/g.w) {
+			continue
+		}
+		id++
+		blocks := g.flood(i, component, id)
+		if len(blocks) > len(largest) {
+			largest = blocks
+		}
+	}
+
+	keep := make([]bool, len(g.floor))
+	for _, i := range largest {
+		for _, d := range []int{0, 1, g.w, g.w + 1} {
+			keep[i+d] = true
+		}
+	}
+	g.floor = keep
+	sort.Ints(largest)
+	return largest
+}
+
+// flood marks every block reachable from block i with id, returning them
+func (g *grid) flood(i int, component []int, id int) []int {
+	component[i] = id
+	blocks := []int{i}
+	for next := 0; next < len(blocks); next++ {
+		for _, n := range g.neighbours(blocks[next]) {
+			if component[n] == 0 && g.isBlock(n%g.w, n/g.w) {
+				component[n] = id
+				blocks = append(blocks, n)
+			}
+		}
+	}
+	return blocks
+}
+
+// neighbours returns the cells above, left of, right of and below cell i
+func (g *grid) neighbours(i int) []int {
+	x, y := i%g.w, i/g.w
+	var ns []int
+	for _, d := range [4][2]int{{0, -1}, {-1, 0}, {1, 0}, {0, 1}} {
+		if g.in(x+d[0], y+d[1]) {
+			ns = append(ns, (y+d[1])*g.w+x+d[0])
+		}
+	}
+	return ns
+}
+
+// distances returns how many steps each block is from block from, or -1 for
+// cells that aren't blocks
+func (g *grid) distances(from int) []int {
+	dist := make([]int, len(g.floor))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[from] = 0
+	queue := []int{from}
+	for next := 0; next < len(queue); next++ {
+		i := queue[next]
+		for _, n := range g.neighbours(i) {
+			if dist[n] < 0 && g.isBlock(n%g.w, n/g.w) {
+				dist[n] = dist[i] + 1
+				queue = append(queue, n)
+			}
+		}
+	}
+	return dist
+}
+
+// level lays tiles over the grid and places the start, exit and spawns.
+// The start is in a random block, the exit in the block furthest from it and
+// the spawns in blocks at least half as far.
+func (g *grid) level(r *rand.Rand, blocks []int, o Options) *manifest.Level {
+	start := blocks[r.Intn(len(blocks))]
+	dist := g.distances(start)
+	exit := start
+	for _, i := range blocks {
+		if dist[i] > dist[exit] {
+			exit = i
+		}
+	}
+
+	var far []int
+	for _, i := range blocks {
+		if i != exit && dist[i]*2 >= dist[exit] {
+			far = append(far, i)
+		}
+	}
+	r.Shuffle(len(far), func(i, j int) {
+		far[i], far[j] = far[j], far[i]
+	})
+	if len(far) > o.Spawns {
+		far = far[:o.Spawns]
+	}
+	sort.Ints(far)
+
+	t := o.Tiles
+	ground := make([]int, len(g.floor))
+	top := make([]int, len(g.floor))
+	for i, floor := range g.floor {
+		ground[i] = t.Floor
+		if floor && len(t.Decorations) > 0 && r.Float64() < decorationChance {
+			ground[i] = t.Decorations[r.Intn(len(t.Decorations))]
+		}
+		switch x, y := i%g.w, i/g.w; {
+		case floor:
+		case g.isFloor(x, y+1):
+			top[i] = t.WallFace
+		default:
+			top[i] = t.Wall
+		}
+	}
+	top[exit] = t.Exit
+
+	l := &manifest.Level{
+		Layers: [][]int{ground, top},
+		Solid:  []int{t.Wall, t.WallFace},
+		Start:  g.cell(start),
+		Exit:   g.cell(exit),
+		Goal:   &manifest.Goal{Kind: manifest.GoalExit},
+	}
+	for _, i := range far {
+		l.Spawns = append(l.Spawns, *g.cell(i))
+	}
+	return l
+}
+
+func (g *grid) cell(i int) *manifest.Cell {
+	return &manifest.Cell{X: i % g.w, Y: i / g.w}
+}
@@ -0,0 +1,185 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FormatLevel encodes a level file laid out the way level files are written by
+// hand: each layer row by row, width tiles to a row, with lists of numbers and
+// the objects in lists, such as tile behaviours, on one line each
+func FormatLevel(l *Level, width int) ([]byte, error) {
+	if width <= 0 {
+		return nil, fmt.Errorf("level width %d is not positive", width)
+	}
+	data, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	v, err := decodeValue(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &formatter{width: width}
+	f.value(v, "", false)
+	f.buf.WriteByte('\n')
+	return f.buf.Bytes(), nil
+}
+
+// jsonValue is a decoded JSON value that keeps the order of object keys: a
+// scalar holds its encoding, arrays their elements and objects their keys and
+// values in order
+type jsonValue struct {
+	scalar string
+	array  []jsonValue
+	keys   []string
+	values []jsonValue
+	kind   byte // 's', '[' or '{'
+}
+
+func decodeValue(dec *json.Decoder) (jsonValue, error) {
+	t, err := dec.Token()
+	if err != nil {
+		return jsonValue{}, err
+	}
+
+	switch t {
+	case json.Delim('['):
+		v := jsonValue{kind: '['}
+		for dec.More() {
+			e, err := decodeValue(dec)
+			if err != nil {
+				return jsonValue{}, err
+			}
+			v.array = append(v.array, e)
+		}
+		_, err := dec.Token()
+		return v, err
+	case json.Delim('{'):
+		v := jsonValue{kind: '{'}
+		for dec.More() {
+			k, err := dec.Token()
+			if err != nil {
+				return jsonValue{}, err
+			}
+			e, err := decodeValue(dec)
+			if err != nil {
+				return jsonValue{}, err
+			}
+			v.keys = append(v.keys, k.(string))
+			v.values = append(v.values, e)
+		}
+		_, err := dec.Token()
+		return v, err
+	}
+
+	s, err := json.Marshal(t)
+	return jsonValue{kind: 's', scalar: string(s)}, err
+}
+
+// flat returns true if v holds no arrays or objects
+func (v jsonValue) flat() bool {
+	for _, e := range append(v.array, v.values...) {
+		if e.kind != 's' {
+			return false
+		}
+	}
+	return true
+}
+
+// inline returns true if v fits on one line: a scalar, a flat array or an
+// object holding only scalars and flat arrays
+func (v jsonValue) inline() bool {
+	for _, e := range append(v.array, v.values...) {
+		if e.kind != 's' && (e.kind == '{' || !e.flat()) {
+			return false
+		}
+	}
+	return true
+}
+
+type formatter struct {
+	buf   bytes.Buffer
+	width int
+}
+
+// value writes v, indented by indent. Flat arrays in layers are split into
+// rows.
+func (f *formatter) value(v jsonValue, indent string, layers bool) {
+	switch {
+	case v.kind == 's':
+		f.buf.WriteString(v.scalar)
+	case v.kind == '[' && len(v.array) == 0:
+		f.buf.WriteString("[]")
+	case v.kind == '[' && v.flat() && layers:
+		f.buf.WriteString("[\n")
+		for i := 0; i < len(v.array); i += f.width {
+			end := i + f.width
+			if end > len(v.array) {
+				end = len(v.array)
+			}
+			if i > 0 {
+				f.buf.WriteString(",\n")
+			}
+			f.buf.WriteString(indent + "\t")
+			f.line(v.array[i:end])
+		}
+		f.buf.WriteString("\n" + indent + "]")
+	case v.kind == '[' && v.flat():
+		f.buf.WriteByte('[')
+		f.line(v.array)
+		f.buf.WriteByte(']')
+	case v.kind == '[':
+		f.buf.WriteString("[\n")
+		for i, e := range v.array {
+			if i > 0 {
+				f.buf.WriteString(",\n")
+			}
+			f.buf.WriteString(indent + "\t")
+			if e.kind == '{' && e.inline() {
+				f.inlineObject(e)
+			} else {
+				f.value(e, indent+"\t", layers)
+			}
+		}
+		f.buf.WriteString("\n" + indent + "]")
+	case v.kind == '{':
+		f.buf.WriteString("{\n")
+		for i, k := range v.keys {
+			if i > 0 {
+				f.buf.WriteString(",\n")
+			}
+			fmt.Fprintf(&f.buf, "%s\t%q: ", indent, k)
+			f.value(v.values[i], indent+"\t", indent == "" && k == "layers")
+		}
+		f.buf.WriteString("\n" + indent + "}")
+	}
+}
+
+// line writes scalars separated by commas
+func (f *formatter) line(vs []jsonValue) {
+	s := make([]string, len(vs))
+	for i, v := range vs {
+		s[i] = v.scalar
+	}
+	f.buf.WriteString(strings.Join(s, ", "))
+}
+
+// inlineObject writes an object on one line
+func (f *formatter) inlineObject(v jsonValue) {
+	f.buf.WriteByte('{')
+	for i, k := range v.keys {
+		if i > 0 {
+			f.buf.WriteString(", ")
+		}
+		fmt.Fprintf(&f.buf, "%q: ", k)
+		f.value(v.values[i], "", false)
+	}
+	f.buf.WriteByte('}')
+}
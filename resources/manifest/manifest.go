@@ -0,0 +1,787 @@
+// Package manifest describes the game's assets and checks that asset files
+// match their descriptions. It is shared by the game, which loads assets by
+// id, and the assetpack tool, which packs and validates them.
+package manifest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"image"
+	_ "image/png"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hajimehoshi/go-mp3"
+	"github.com/jfreymuth/oggvorbis"
+	"github.com/paulcockrell/gametest/resources/sfxr"
+)
+
+// Asset types
+const (
+	TypeImage    = "image"
+	TypeSound    = "sound"
+	TypeLevel    = "level"
+	TypeShader   = "shader"
+	TypeCampaign = "campaign"
+)
+
+// GeneratedPrefix starts the ids of generated levels, which aren't in the
+// manifest but can be played like level assets
+const GeneratedPrefix = "generated:"
+
+// Asset is a single manifest entry. Paths are relative to the resources
+// directory and use forward slashes.
+type Asset struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Path string `json:"path"`
+
+	// Sprite metadata for images. Images split into frames must have
+	// dimensions divisible by the frame size. Animations is the path of an
+	// Aseprite JSON export describing the image's animation clips.
+	FrameWidth   int    `json:"frameWidth,omitempty"`
+	FrameHeight  int    `json:"frameHeight,omitempty"`
+	Animations   string `json:"animations,omitempty"`
+	PixelPerfect bool   `json:"pixelPerfect,omitempty"`
+
+	// Tileset is the path of a JSON file describing the animated tiles of a
+	// tile sheet. Tile sheets must have a frame size, which is the tile size.
+	Tileset string `json:"tileset,omitempty"`
+
+	// Stream marks sounds that are decoded while they play rather than when
+	// loaded, which saves memory for long music tracks
+	Stream bool `json:"stream,omitempty"`
+
+	// Preload marks assets every level needs, which are loaded at startup.
+	// Other assets are loaded when a level that lists them in Assets starts.
+	Preload bool     `json:"preload,omitempty"`
+	Assets  []string `json:"assets,omitempty"`
+}
+
+// Files returns the paths of every file the asset is made from
+func (a *Asset) Files() []string {
+	files := []string{a.Path}
+	if a.Animations != "" {
+		files = append(files, a.Animations)
+	}
+	if a.Tileset != "" {
+		files = append(files, a.Tileset)
+	}
+	return files
+}
+
+// Manifest lists every asset the game uses
+type Manifest struct {
+	Assets []*Asset `json:"assets"`
+
+	byID map[string]*Asset
+}
+
+// Parse decodes a manifest and checks its entries are well formed
+func Parse(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("error decoding manifest: %v", err)
+	}
+
+	m.byID = make(map[string]*Asset)
+	for i, a := range m.Assets {
+		switch {
+		case a.ID == "":
+			return nil, fmt.Errorf("asset %d has no id", i)
+		case m.byID[a.ID] != nil:
+			return nil, fmt.Errorf("asset %q is listed twice", a.ID)
+		case a.Path == "":
+			return nil, fmt.Errorf("asset %q has no path", a.ID)
+		case a.Type != TypeImage && a.Type != TypeSound && a.Type != TypeLevel && a.Type != TypeShader && a.Type != TypeCampaign:
+			return nil, fmt.Errorf("asset %q has unknown type %q", a.ID, a.Type)
+		case (a.FrameWidth == 0) != (a.FrameHeight == 0):
+			return nil, fmt.Errorf("asset %q must set both frameWidth and frameHeight", a.ID)
+		case a.Stream && a.Type != TypeSound:
+			return nil, fmt.Errorf("asset %q is streamed but only sounds can be", a.ID)
+		}
+		m.byID[a.ID] = a
+	}
+
+	for _, a := range m.Assets {
+		if len(a.Assets) > 0 && a.Type != TypeLevel {
+			return nil, fmt.Errorf("asset %q lists assets but only levels can", a.ID)
+		}
+		for _, id := range a.Assets {
+			switch dep := m.byID[id]; {
+			case dep == nil:
+				return nil, fmt.Errorf("level %q needs unknown asset %q", a.ID, id)
+			case dep.Type == TypeLevel:
+				return nil, fmt.Errorf("level %q lists level %q as an asset", a.ID, id)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Asset returns the asset with the given id
+func (m *Manifest) Asset(id string) (*Asset, error) {
+	a, ok := m.byID[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown asset %q", id)
+	}
+	return a, nil
+}
+
+// Files returns the paths of every file used by the manifest, sorted
+func (m *Manifest) Files() []string {
+	var files []string
+	for _, a := range m.Assets {
+		files = append(files, a.Files()...)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// ReadFunc returns the contents of a file by its manifest path
+type ReadFunc func(path string) ([]byte, error)
+
+// Validate reads every asset and checks its contents match its type and
+// metadata. It returns one error per problem found.
+func (m *Manifest) Validate(read ReadFunc) []error {
+	var errs []error
+	for _, a := range m.Assets {
+		data, err := read(a.Path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("asset %q: %v", a.ID, err))
+			continue
+		}
+
+		switch a.Type {
+		case TypeImage:
+			err = validateImage(a, data, read)
+		case TypeSound:
+			err = validateSound(a, data)
+		case TypeLevel:
+			_, err = ParseLevel(data)
+		case TypeShader:
+			err = validateShader(data)
+		case TypeCampaign:
+			err = m.validateCampaign(data)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("asset %q (%s): %v", a.ID, a.Path, err))
+		}
+	}
+
+	return errs
+}
+
+func validateImage(a *Asset, data []byte, read ReadFunc) error {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("invalid image: %v", err)
+	}
+
+	if a.FrameWidth > 0 {
+		if cfg.Width%a.FrameWidth != 0 || cfg.Height%a.FrameHeight != 0 {
+			return fmt.Errorf("image size %dx%d is not divisible by frame size %dx%d",
+				cfg.Width, cfg.Height, a.FrameWidth, a.FrameHeight)
+		}
+	}
+
+	if a.Tileset != "" {
+		if err := validateTileset(a, cfg, read); err != nil {
+			return err
+		}
+	}
+
+	if a.Animations == "" {
+		return nil
+	}
+
+	animations, err := read(a.Animations)
+	if err != nil {
+		return err
+	}
+
+	// Only the frame rectangles are checked here; the game's loader checks
+	// the tags and slices
+	var sheet struct {
+		Frames json.RawMessage `json:"frames"`
+	}
+	if err := json.Unmarshal(animations, &sheet); err != nil {
+		return fmt.Errorf("invalid animations %s: %v", a.Animations, err)
+	}
+	type frame struct {
+		Frame struct{ X, Y, W, H int } `json:"frame"`
+	}
+	var frames []frame
+	if err := json.Unmarshal(sheet.Frames, &frames); err != nil {
+		byName := map[string]frame{}
+		if err := json.Unmarshal(sheet.Frames, &byName); err != nil {
+			return fmt.Errorf("invalid animations %s: %v", a.Animations, err)
+		}
+		for _, f := range byName {
+			frames = append(frames, f)
+		}
+	}
+	bounds := image.Rect(0, 0, cfg.Width, cfg.Height)
+	for _, f := range frames {
+		r := image.Rect(f.Frame.X, f.Frame.Y, f.Frame.X+f.Frame.W, f.Frame.Y+f.Frame.H)
+		if !r.In(bounds) {
+			return fmt.Errorf("animations %s has frame %v outside the %dx%d image", path.Base(a.Animations), r, cfg.Width, cfg.Height)
+		}
+	}
+
+	return nil
+}
+
+// validateTileset checks every frame of the tileset's animations is a tile
+// of the sheet
+func validateTileset(a *Asset, cfg image.Config, read ReadFunc) error {
+	if a.FrameWidth == 0 {
+		return errors.New("tile sheet has no frame size")
+	}
+	data, err := read(a.Tileset)
+	if err != nil {
+		return err
+	}
+	ts, err := ParseTileset(data)
+	if err != nil {
+		return fmt.Errorf("tileset %s: %v", path.Base(a.Tileset), err)
+	}
+
+	tiles := (cfg.Width / a.FrameWidth) * (cfg.Height / a.FrameHeight)
+	for _, anim := range ts.Animated {
+		for _, f := range anim.Frames {
+			if f.Tile >= tiles {
+				return fmt.Errorf("tileset %s animates tile %d with tile %d, but the sheet has %d tiles",
+					path.Base(a.Tileset), anim.Tile, f.Tile, tiles)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateShader checks data is a Kage shader with a Fragment function. The
+// shader itself is only compiled by the game, as that needs ebiten.
+func validateShader(data []byte) error {
+	f, err := parser.ParseFile(token.NewFileSet(), "", data, 0)
+	if err != nil {
+		return fmt.Errorf("invalid shader: %v", err)
+	}
+	if f.Scope.Lookup("Fragment") == nil {
+		return errors.New("shader has no Fragment function")
+	}
+
+	return nil
+}
+
+// Level is a level file: tile layers drawn bottom to top, each listing the
+// tile index of every cell row by row
+type Level struct {
+	Layers [][]int `json:"layers"`
+
+	// Foreground lists the tiles drawn over entities, such as roofs and
+	// treetops, so that entities can walk behind them
+	Foreground []int `json:"foreground,omitempty"`
+
+	// Solid lists the tiles that block movement and bullets, such as walls
+	Solid []int `json:"solid,omitempty"`
+
+	// Tiles lists the tiles that react to gameplay
+	Tiles []TileBehaviour `json:"tiles,omitempty"`
+
+	// Start is the cell VaxerMan starts in, or nil for the middle of the
+	// screen
+	Start *Cell `json:"start,omitempty"`
+
+	// Exit is the cell that completes the level when VaxerMan reaches it, or
+	// nil if the level has no exit
+	Exit *Cell `json:"exit,omitempty"`
+
+	// Spawns lists the cells viruses appear from. Without them viruses come
+	// in from the edges of the screen.
+	Spawns []Cell `json:"spawns,omitempty"`
+
+	// Lighting makes the level dark, lit only by lights. Levels without it
+	// are fully lit and visible.
+	Lighting *Lighting `json:"lighting,omitempty"`
+
+	// Infection makes the level's floor catch the virus, which spreads from
+	// cell to cell. Levels without it have no infection.
+	Infection *Infection `json:"infection,omitempty"`
+
+	// Goal is what completes the level. Levels without one are played until
+	// VaxerMan dies.
+	Goal *Goal `json:"goal,omitempty"`
+}
+
+// Goal kinds
+const (
+	GoalWaves   = "waves"   // survive Waves waves
+	GoalBoss    = "boss"    // kill the Boss
+	GoalExit    = "exit"    // reach the level's exit
+	GoalCleanse = "cleanse" // clean Clean of the level's infection
+)
+
+// Goal is what the player must do to complete a level
+type Goal struct {
+	Kind string `json:"kind"`
+
+	// Waves is how many waves of viruses must be survived, for waves goals
+	Waves int `json:"waves,omitempty"`
+
+	// Clean is the fraction of the floor that must be clean, for cleanse
+	// goals
+	Clean float64 `json:"clean,omitempty"`
+
+	// Boss is the virus that must be killed, for boss goals
+	Boss *Boss `json:"boss,omitempty"`
+}
+
+// Boss is a virus that takes many bullets to kill and chases VaxerMan. It
+// appears from the level's first spawn, or the top of the screen if the level
+// has none.
+type Boss struct {
+	Health int     `json:"health"` // how many bullets it takes
+	Speed  float64 `json:"speed"`  // in pixels per second
+	Damage int     `json:"damage"` // health VaxerMan loses each time it's touched
+}
+
+// Cell is a cell of a level, counted in tiles from the top left
+type Cell struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Infection describes how the virus spreads over a level's floor. Cells
+// holding a contaminated tile start fully infected. Chances and rates are per
+// second; amounts are fractions of full infection, from 0 to 1.
+type Infection struct {
+	// Grow is how much infection an infected cell gains each second
+	Grow float64 `json:"grow"`
+
+	// Spread is the chance each second that a heavily infected cell infects
+	// each of its clean neighbours
+	Spread float64 `json:"spread"`
+
+	// Cleanse is how much infection a bullet removes from the cell it hits.
+	// The bullet is used up doing so.
+	Cleanse float64 `json:"cleanse"`
+
+	// Spawn is the chance each second that a virus crawls out of a heavily
+	// infected cell
+	Spawn float64 `json:"spawn,omitempty"`
+
+	// Slow is how much VaxerMan is slowed on a fully infected cell
+	Slow float64 `json:"slow,omitempty"`
+
+	// Damage is the health VaxerMan loses each second on a heavily infected
+	// cell
+	Damage int `json:"damage,omitempty"`
+}
+
+// Lighting describes how a dark level is lit
+type Lighting struct {
+	// Ambient is how bright the level is away from lights, from 0 to 1
+	Ambient float64 `json:"ambient"`
+
+	// Opaque lists the tiles that block line of sight
+	Opaque []int `json:"opaque,omitempty"`
+
+	// Lights lists the tiles that give off light
+	Lights []TileLight `json:"lights,omitempty"`
+}
+
+// Tile behaviour kinds
+const (
+	TileContaminated = "contaminated" // cleaned by bullets, starts infected on levels with infection
+	TileCrate        = "crate"        // solid, broken by bullets, may drop a pickup
+	TileDoor         = "door"         // solid until a switch in its group is shot
+	TileSwitch       = "switch"       // solid, opens the doors in its group when shot
+)
+
+// Pickup kinds crates can drop
+const (
+	PickupHealth = "health"
+)
+
+// TileBehaviour makes every cell holding Tile react to gameplay. Once it has
+// taken Hits bullets, or been opened for doors, the tile turns into Becomes.
+type TileBehaviour struct {
+	Tile    int    `json:"tile"`
+	Kind    string `json:"kind"`
+	Becomes int    `json:"becomes"`
+	Hits    int    `json:"hits,omitempty"`  // defaults to 1
+	Drop    string `json:"drop,omitempty"`  // pickup a crate drops when broken
+	Group   string `json:"group,omitempty"` // links switches to the doors they open
+}
+
+// TileLight is light given off by every cell holding a tile
+type TileLight struct {
+	Tile   int      `json:"tile"`
+	Radius float64  `json:"radius"` // in pixels
+	Color  [3]uint8 `json:"color"`
+}
+
+// ParseLevel decodes a level file and checks its layers are the same size
+func ParseLevel(data []byte) (*Level, error) {
+	l := &Level{}
+	if err := json.Unmarshal(data, l); err != nil {
+		return nil, fmt.Errorf("invalid level: %v", err)
+	}
+	if len(l.Layers) == 0 {
+		return nil, errors.New("level has no layers")
+	}
+	for i, layer := range l.Layers {
+		if len(layer) != len(l.Layers[0]) {
+			return nil, fmt.Errorf("layer %d has %d tiles, want %d", i, len(layer), len(l.Layers[0]))
+		}
+		for j, t := range layer {
+			if t < 0 {
+				return nil, fmt.Errorf("layer %d has negative tile %d at %d", i, t, j)
+			}
+		}
+	}
+
+	if err := validateTiles(l.Tiles); err != nil {
+		return nil, err
+	}
+
+	cells := append([]Cell(nil), l.Spawns...)
+	for _, c := range []*Cell{l.Start, l.Exit} {
+		if c != nil {
+			cells = append(cells, *c)
+		}
+	}
+	for _, c := range cells {
+		if c.X < 0 || c.Y < 0 {
+			return nil, fmt.Errorf("cell %d,%d is off the level", c.X, c.Y)
+		}
+	}
+
+	if lt := l.Lighting; lt != nil {
+		if lt.Ambient < 0 || lt.Ambient > 1 {
+			return nil, fmt.Errorf("ambient light %v is not between 0 and 1", lt.Ambient)
+		}
+		for _, light := range lt.Lights {
+			if light.Radius <= 0 {
+				return nil, fmt.Errorf("light of tile %d has no radius", light.Tile)
+			}
+		}
+	}
+
+	if in := l.Infection; in != nil {
+		if err := validateInfection(in); err != nil {
+			return nil, err
+		}
+	}
+
+	if g := l.Goal; g != nil {
+		if err := validateGoal(l, g); err != nil {
+			return nil, err
+		}
+	}
+
+	return l, nil
+}
+
+// validateGoal checks a level's goal is complete and the level has what the
+// goal needs
+func validateGoal(l *Level, g *Goal) error {
+	switch g.Kind {
+	case GoalWaves:
+		if g.Waves < 1 {
+			return fmt.Errorf("waves goal has %d waves, want at least 1", g.Waves)
+		}
+	case GoalBoss:
+		switch b := g.Boss; {
+		case b == nil:
+			return errors.New("boss goal has no boss")
+		case b.Health < 1:
+			return fmt.Errorf("boss has %d health, want at least 1", b.Health)
+		case b.Speed <= 0:
+			return errors.New("boss can't move")
+		case b.Damage < 0:
+			return fmt.Errorf("boss damage %d is negative", b.Damage)
+		}
+	case GoalExit:
+		if l.Exit == nil {
+			return errors.New("exit goal but the level has no exit")
+		}
+	case GoalCleanse:
+		if l.Infection == nil {
+			return errors.New("cleanse goal but the level has no infection")
+		}
+		if g.Clean <= 0 || g.Clean > 1 {
+			return fmt.Errorf("cleanse goal %v is not between 0 and 1", g.Clean)
+		}
+	default:
+		return fmt.Errorf("unknown goal kind %q", g.Kind)
+	}
+
+	return nil
+}
+
+// Campaign is a campaign file: the levels played in order, each unlocked by
+// completing the one before it
+type Campaign struct {
+	Levels []CampaignLevel `json:"levels"`
+}
+
+// CampaignLevel is a level of a campaign. The first level is always
+// unlocked; Unlocked makes a later one playable from the start too.
+type CampaignLevel struct {
+	Level    string `json:"level"` // asset id, or the id of a generated level
+	Name     string `json:"name"`  // shown to the player
+	Unlocked bool   `json:"unlocked,omitempty"`
+}
+
+// ParseCampaign decodes a campaign file and checks it has levels, each named
+// and listed once
+func ParseCampaign(data []byte) (*Campaign, error) {
+	c := &Campaign{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("invalid campaign: %v", err)
+	}
+	if len(c.Levels) == 0 {
+		return nil, errors.New("campaign has no levels")
+	}
+
+	seen := make(map[string]bool)
+	for i, l := range c.Levels {
+		switch {
+		case l.Level == "":
+			return nil, fmt.Errorf("campaign level %d has no level", i)
+		case l.Name == "":
+			return nil, fmt.Errorf("campaign level %q has no name", l.Level)
+		case seen[l.Level]:
+			return nil, fmt.Errorf("campaign lists level %q twice", l.Level)
+		}
+		seen[l.Level] = true
+	}
+
+	return c, nil
+}
+
+// validateCampaign checks a campaign file and that each of its levels is a
+// level asset or generated
+func (m *Manifest) validateCampaign(data []byte) error {
+	c, err := ParseCampaign(data)
+	if err != nil {
+		return err
+	}
+	for _, l := range c.Levels {
+		if strings.HasPrefix(l.Level, GeneratedPrefix) {
+			continue
+		}
+		if a := m.byID[l.Level]; a == nil || a.Type != TypeLevel {
+			return fmt.Errorf("campaign level %q is not a level asset", l.Level)
+		}
+	}
+
+	return nil
+}
+
+// validateInfection checks the amounts and chances of an infection are
+// between 0 and 1
+func validateInfection(in *Infection) error {
+	fractions := []struct {
+		name  string
+		value float64
+	}{
+		{"grow", in.Grow},
+		{"spread", in.Spread},
+		{"cleanse", in.Cleanse},
+		{"spawn", in.Spawn},
+		{"slow", in.Slow},
+	}
+	for _, f := range fractions {
+		if f.value < 0 || f.value > 1 {
+			return fmt.Errorf("infection %s %v is not between 0 and 1", f.name, f.value)
+		}
+	}
+	if in.Cleanse == 0 {
+		return errors.New("infection can't be cleansed")
+	}
+	if in.Damage < 0 {
+		return fmt.Errorf("infection damage %d is negative", in.Damage)
+	}
+
+	return nil
+}
+
+// Tileset is a tileset file, describing how the tiles of a tile sheet
+// animate. Levels place the first frame of an animation, or its Tile, and it
+// is drawn animated.
+type Tileset struct {
+	Animated []TileAnimation `json:"animated"`
+}
+
+// TileAnimation is a tile drawn as a loop of other tiles
+type TileAnimation struct {
+	Tile   int         `json:"tile"`
+	Frames []TileFrame `json:"frames"`
+}
+
+// TileFrame is a tile shown for Duration milliseconds
+type TileFrame struct {
+	Tile     int `json:"tile"`
+	Duration int `json:"duration"`
+}
+
+// ParseTileset decodes a tileset file and checks every animation has frames
+// and each tile is animated at most once
+func ParseTileset(data []byte) (*Tileset, error) {
+	ts := &Tileset{}
+	if err := json.Unmarshal(data, ts); err != nil {
+		return nil, fmt.Errorf("invalid tileset: %v", err)
+	}
+
+	animated := make(map[int]bool)
+	for _, anim := range ts.Animated {
+		if animated[anim.Tile] {
+			return nil, fmt.Errorf("tile %d is animated twice", anim.Tile)
+		}
+		animated[anim.Tile] = true
+		if len(anim.Frames) == 0 {
+			return nil, fmt.Errorf("animation of tile %d has no frames", anim.Tile)
+		}
+		for _, f := range anim.Frames {
+			if f.Tile < 0 || f.Duration <= 0 {
+				return nil, fmt.Errorf("animation of tile %d has invalid frame %+v", anim.Tile, f)
+			}
+		}
+	}
+
+	return ts, nil
+}
+
+// validateTiles checks tile behaviours are complete, and that each tile has
+// at most one
+func validateTiles(tiles []TileBehaviour) error {
+	seen := make(map[int]bool)
+	for _, b := range tiles {
+		if seen[b.Tile] {
+			return fmt.Errorf("tile %d has more than one behaviour", b.Tile)
+		}
+		seen[b.Tile] = true
+
+		switch b.Kind {
+		case TileContaminated, TileCrate:
+		case TileDoor, TileSwitch:
+			if b.Group == "" {
+				return fmt.Errorf("%s tile %d has no group", b.Kind, b.Tile)
+			}
+		default:
+			return fmt.Errorf("tile %d has unknown kind %q", b.Tile, b.Kind)
+		}
+		if b.Becomes == b.Tile || b.Becomes < 0 {
+			return fmt.Errorf("%s tile %d can't become tile %d", b.Kind, b.Tile, b.Becomes)
+		}
+		if b.Hits < 0 {
+			return fmt.Errorf("%s tile %d has negative hits", b.Kind, b.Tile)
+		}
+		if b.Drop != "" && b.Drop != PickupHealth {
+			return fmt.Errorf("tile %d drops unknown pickup %q", b.Tile, b.Drop)
+		}
+	}
+
+	return nil
+}
+
+func validateSound(a *Asset, data []byte) error {
+	switch path.Ext(a.Path) {
+	case ".wav":
+		return validateWAV(data)
+	case ".ogg":
+		return validateOgg(data)
+	case ".mp3":
+		return validateMP3(data)
+	case ".sfxr":
+		_, err := sfxr.Parse(data)
+		return err
+	default:
+		return fmt.Errorf("unsupported sound format %q", path.Ext(a.Path))
+	}
+}
+
+// validateWAV checks data is a RIFF WAVE file with a PCM format chunk and a
+// data chunk
+func validateWAV(data []byte) error {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return errors.New("not a RIFF WAVE file")
+	}
+
+	var hasFormat, hasData bool
+	for p := 12; p+8 <= len(data); {
+		id := string(data[p : p+4])
+		size := int(binary.LittleEndian.Uint32(data[p+4 : p+8]))
+		body := data[p+8:]
+		if size > len(body) {
+			return fmt.Errorf("chunk %q is truncated", id)
+		}
+
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return errors.New("format chunk is too short")
+			}
+			if format := binary.LittleEndian.Uint16(body[0:2]); format != 1 {
+				return fmt.Errorf("audio format %d is not PCM", format)
+			}
+			if channels := binary.LittleEndian.Uint16(body[2:4]); channels != 1 && channels != 2 {
+				return fmt.Errorf("unsupported channel count %d", channels)
+			}
+			if bits := binary.LittleEndian.Uint16(body[14:16]); bits != 8 && bits != 16 {
+				return fmt.Errorf("unsupported bits per sample %d", bits)
+			}
+			hasFormat = true
+		case "data":
+			hasData = true
+		}
+
+		// Chunks are padded to an even size
+		p += 8 + size + size%2
+	}
+
+	if !hasFormat {
+		return errors.New("missing format chunk")
+	}
+	if !hasData {
+		return errors.New("missing data chunk")
+	}
+
+	return nil
+}
+
+// validateOgg checks data is an Ogg Vorbis stream the game can play
+func validateOgg(data []byte) error {
+	format, err := oggvorbis.GetFormat(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("invalid ogg vorbis: %v", err)
+	}
+	if format.Channels != 1 && format.Channels != 2 {
+		return fmt.Errorf("unsupported channel count %d", format.Channels)
+	}
+
+	return nil
+}
+
+// validateMP3 checks data is an MP3 stream with at least one frame
+func validateMP3(data []byte) error {
+	d, err := mp3.NewDecoder(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("invalid mp3: %v", err)
+	}
+	if d.Length() <= 0 {
+		return errors.New("mp3 has no audio")
+	}
+
+	return nil
+}
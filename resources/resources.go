@@ -0,0 +1,18 @@
+// Package resources holds the game's assets, packed into the binary from the
+// files listed in manifest.json. Run `go generate ./resources` after adding or
+// changing an asset.
+package resources
+
+//go:generate go run ../cmd/assetpack -dir . -output data.go
+
+import "fmt"
+
+// File returns the packed contents of the asset file at path, relative to
+// the resources directory
+func File(path string) ([]byte, error) {
+	data, ok := files[path]
+	if !ok {
+		return nil, fmt.Errorf("asset file %s was not packed, run go generate ./resources", path)
+	}
+	return data, nil
+}
@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package sfx
-
-var Boom_wav = []byte("RIFF\xcc\xe2\x01\x00WAVEfmt \x10\x00\x00\x00\x01\x00\x01\x00D\xac\x00\x00\x88X\x01\x00\x02\x00\x10\x00data\xa8\xe2\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd0\x15\xc8\x15\xc0\x15\xb8\x15\xaf\x15\xa7\x15\x9f\x15\x97\x15\x8e\x15\x86\x15~\x15v\x15m\x15e\x15]\x15U\x15L\x15D\x15<\x154\x15+\x15#\x15\x1b\x15\x13\x15\n\x15\x02\x15[\x00\xc2\xeb\xca\xeb\xd2\xeb\xda\xeb\xe2\xeb\xea\xeb\xf2\xeb\xfa\xeb\x02\xec\n\xec\x12\xec\x1a\xec\"\xec*\xec2\xec9\xecA\xecI\xecQ\xecY\xeca\xeci\xecq\xecy\xec\x81\xec\x89\xec\xa3\x01\xa2\x01\xa2\x01\xa1\x01\xa0\x01\xa0\x01\x9f\x01\x9e\x01\x9d\x01\x9d\x01\x9c\x01\x9b\x01\x9b\x01\x9a\x01\x99\x01\x99\x01\x98\x01\x97\x01\x97\x01\x96\x01\x95\x01\x95\x01\x94\x01\x93\x01\x93\x01\x92\x01%\n\xb2\x12\xaa\x12\xa2\x12\x9a\x12\x92\x12\x8a\x12\x82\x12z\x12r\x12j\x12b\x12Z\x12R\x12J\x12B\x12:\x122\x12*\x12\"\x12\x1a\x12\x12\x12\n\x12\x02\x12\xfa\x11\xf2\x111\nv\x02t\x02s\x02r\x02q\x02p\x02o\x02n\x02m\x02l\x02j\x02i\x02h\x02g\x02f\x02e\x02d\x02c\x02b\x02a\x02_\x02^\x02]\x02\\\x02[\x02Z\x02\b\x10\x01\x10\xf9\x0f\xf2\x0f\xea\x0f\xe3\x0f\xdb\x0f\xd4\x0f\xcc\x0f\xc5\x0f\xbd\x0f\xb6\x0f\xae\x0f\xa6\x0f\x9f\x0f\x97\x0f\x90\x0f\x88\x0f\x81\x0fy\x0fr\x0fj\x0fc\x0f[\x0fT\x0fL\x0f\xb7\x03+\xf8/\xf83\xf87\xf8;\xf8?\xf8C\xf8F\xf8J\xf8N\xf8R\xf8V\xf8Z\xf8^\xf8b\xf8e\xf8i\xf8m\xf8q\xf8u\xf8y\xf8}\xf8\x80\xf8\x84\xf8\x88\xf8\x8c\xf8X\fR\fK\fE\f?\f8\f2\f+\f%\f\x1e\f\x18\f\x12\f\v\f\x05\f\xfe\v\xf8\v\xf1\v\xeb\v\xe5\v\xde\v\xd8\v\xd1\v\xcb\v\xc5\v\xbe\v\xb8\v\xc2\x04\xd4\xfd\xd5\xfd\xd6\xfd\xd7\xfd\xd9\xfd\xda\xfd\xdb\xfd\xdc\xfd\xdd\xfd\xdf\xfd\xe0\xfd\xe1\xfd\xe2\xfd\xe3\xfd\xe5\xfd\xe6\xfd\xe7\xfd\xe8\xfd\xe9\xfd\xeb\xfd\xec\xfd\xed\xfd\xee\xfd\xef\xfd\xf0\xfd\xf2\xfd \x11\x16\x11\f\x11\x02\x11\xf8\x10\xee\x10\xe4\x10\xda\x10\xd0\x10\xc6\x10\xbc\x10\xb2\x10\xa8\x10\x9e\x10\x94\x10\x8a\x10\x80\x10v\x10l\x10b\x10X\x10N\x10D\x10:\x100\x10&\x10\xc2\vb\a]\aX\aT\aO\aK\aF\aA\a=\a8\a4\a/\a*\a&\a!\a\x1d\a\x18\a\x14\a\x0f\a\n\a\x06\a\x01\a\xfd\x06\xf8\x06\xf3\x06\xef\x06\x02\x00\x1c\xf9!\xf9%\xf9*\xf9.\xf93\xf98\xf9<\xf9A\xf9E\xf9J\xf9O\xf9S\xf9X\xf9\\\xf9a\xf9e\xf9j\xf9o\xf9s\xf9\xdc\xf7G\xf6N\xf6U\xf6\\\xf6b\xf6i\xf6p\xf6w\xf6~\xf6\x85\xf6\x8b\xf6\x92\xf6\x99\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\x9b\xf6\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\f\xb9\fX\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02X\x02\xda\x05[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t[\t\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xc2\x06\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xf7\x04B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06B\x06\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4\x00\xf4.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\fK\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05\x12\x02\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfe\xd0\xf9\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01b\xfa\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\xbd\xf3\x97\xf4p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5p\xf5\f\xfc\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xec\x002\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xff2\xffi\x02\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05~\xfd[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5[\xf5X\xfcT\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03\xe5\xfdu\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8O\xfe(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04\xac\x051\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a1\a\xd2\x05s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04\x89\a\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa4\x02\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\xaa\xfa\b\xffe\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03,\x00\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfcD\xf8\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8-\xf8\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfdD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\v\xad\xfe\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5u\xf5\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\x00\xfa\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\xf7\xfd\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\xf0\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\xd1\x06\x17\b^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t^\t\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xea\x06\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\x18\xff'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6'\xf6t\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0et\x0eR\f0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\nx\t\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\x1c\am\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\t\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01\xc1\x01!\x04\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x81\x06\x1d\xfe\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3v\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0e\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\a\xdf\av\xfe\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5\f\xf5m\xfa\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xffe\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfae\xfaX\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5\v\xfe\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06\xbd\x06Z\x04\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xd6\x05\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xb5\t\xc6\b\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x95\xf3\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf86\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v6\v0\xfe(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1(\xf1\xaa\xf8+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00e\x02\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xc4\xff\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xd6\xfc\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe5\x06\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc~\xfc\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfba\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xeb\xff\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xe1\x02\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\t\xbe\tu\x00,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5?\xf5\xf6\xfa\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa\f\xfa{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6{\xf6+\x00\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\x1d\x05C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfbX\x00\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05\x8b\x05u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1Y\xf2<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3<\xf3\x8c\xfc\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x96\xf2t\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2\xe4\xf8w\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xfft\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06t\x06\xcc\xff\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\"\xf9\xde\x01\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\n\x9b\no\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1o\xf1\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xe8\b\xaa\nl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\fl\f\xb1\x01\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf61\xffk\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\ak\a\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\x82\xfa\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7\x8c\xf7(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b(\b\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7-\xf7\xc5\xf7\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8]\xf8]\xf8]\xf8]\xf8]\xf8\xc1\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7&\xf7'\xf7'\xf7'\xf7'\xf7'\xf7'\xf7'\xf7'\xf7'\xf7'\xf7'\xf7'\xf7'\xf7\xac\xf42\xf22\xf22\xf22\xf22\xf22\xf22\xf22\xf22\xf23\xf23\xf23\xf23\xf23\xf23\xf23\xf23\xf23\xf23\xf23\xf23\xf23\xf23\xf2\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x12\xf4\x12\xf4\x12\xf4\x12\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x13\xf4\x14\xf4\x14\xf4\x14\xf4\x14\xf4\x14\xf4\x14\xf4Z\x01\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\xa0\x0e\x9f\x0e\x9f\x0e\x9f\x0e\x9f\x0e\xbd\x06\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\xd7\xfb\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x1e\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbb\xfa\xbc\xfa\xbc\xfa\x99\x00w\x06w\x06w\x06w\x06w\x06w\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06v\x06\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc8\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xc7\t\xf4\t\"\n\"\n\"\n\"\n\"\n\"\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n!\n\xc8\xfeo\xf3o\xf3o\xf3o\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa3\n\xa2\n\xa2\n\xa2\n\xa2\n\xa2\n\xa2\n\xa2\n\xa2\n\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb\x12\xfb/\xfdL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xff\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa7\xf7\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd5\xf3\xd6\xf3\xd6\xf3\xd6\xf3\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xcf\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2\xd0\xf2d\xf6d\xf6d\xf6d\xf6d\xf6d\xf6d\xf6d\xf6d\xf6d\xf6d\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6e\xf6\xfd\x00\x95\v\x95\v\x95\v\x95\v\x95\v\x95\v\x95\v\x95\v\x95\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x94\v\x93\vS\aS\aS\aS\aS\aS\aS\aS\aS\aS\aS\aS\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\aR\at\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4t\xf4u\xf4u\xf4u\xf4u\xf4u\xf4u\xf4u\xf4u\xf4u\xf4u\xf4u\xf4u\xf4u\xf4\xf5\xfct\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05t\x05s\x05s\x05s\x05\x89\xfb\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\x9f\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\xa0\xf1\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1b\a\x1b\a\x1b\a\x1b\aF\x06F\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06E\x06\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x92\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\x93\xf3\xea\xfa@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02@\x02\xf6\xfa\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xad\xf3\xad\xf3\xad\xf3\xad\xf3\xad\xf3\xad\xf3\xad\xf3\xad\xf3\xad\xf3\xad\xf3\xad\xf3\x8d\xfek\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tj\tj\tj\tj\tj\tj\tj\t\xfc\a\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8c\x06\xe7\tB\rB\rB\rB\rB\rA\rA\rA\rA\rA\rA\rA\rA\rA\rA\rA\rA\rA\rA\rA\rA\rA\r@\r@\r@\r@\r@\r@\r@\r\x14\x03\xe9\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xd4\xfe\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbd\x04\xbc\x04\xbc\x04\xbc\x04\xbc\x04\xbc\x04\xbc\x04\xbc\x04\xbc\x04\xbc\x04\xb7\x00\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfcO\xf8\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xec\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf34\xf7z\xfaz\xfaz\xfaz\xfaz\xfaz\xfaz\xfaz\xfaz\xfaz\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa{\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb2\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\xc1\a\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x82\x01\x81\x01\x81\x01U\x05(\t(\t(\t(\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t'\t&\t&\tZ\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Y\x02Y\x02Z\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfbT\x00O\x05O\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05N\x05\xc7\xfc?\xf4?\xf4?\xf4?\xf4?\xf4?\xf4?\xf4?\xf4?\xf4?\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4@\xf4A\xf4\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xfa\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xa1\x03K\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfeK\xfe,\xf5,\xf5,\xf5,\xf5,\xf5,\xf5,\xf5,\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5-\xf5.\xf5q\xf9\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\xf6\xf6g\xf8g\xf8g\xf8g\xf8g\xf8g\xf8g\xf8g\xf8g\xf8g\xf8g\xf8g\xf8h\xf8h\xf8h\xf8h\xf8h\xf8h\xf8h\xf8h\xf8h\xf8h\xf8h\xf8\xbd\x00\x14\t\x14\t\x14\t\x14\t\x14\t\x14\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\f\xf5\f\xf5\f\xf5%\t%\t%\t%\t%\t%\t%\t%\t%\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t$\t\xd1\x02\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfcb\x04b\x04b\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04a\x04k\xfdt\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6t\xf6u\xf6u\xf6u\xf6u\xf6u\xf6u\xf6u\xf6u\xf6u\xf6\xaa\xfa\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\xe0\xfe\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\x10\xfb\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\"\x00\x91\x00\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\xf2\f\xf2\f\xf2\f\xf2\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf1\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\fN\vN\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vL\vL\v\xf6\x01\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xd8\x00\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\xe8\x05\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\x94\ag\fg\fg\fg\fg\fg\fg\fg\fg\fg\fg\fg\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\ff\f#\x05\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe3\xfd\xe3\xfd\xe3\xfd\xe3\xfd\xe3\xfd\xe3\xfd\xe3\xfd\xe3\xfd\xe3\xfd\x1f\xffZ\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00Z\x00\xc4\xfd.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb.\xfb\xe0\x02\x92\n\x92\n\x92\n\x92\n\x92\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x91\n\x90\n\x90\n\x90\n\x90\nL\t\b\b\b\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\x06\b\x06\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b+\b*\b*\b*\b*\b*\b*\b*\b*\b*\b*\b\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1d\x06G\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdG\xfdH\xfdH\xfdH\xfdH\xfdH\xfdH\xfdH\xfdH\xfdH\xfdH\xfdX\xfch\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfbh\xfb#\xf7\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xdf\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\xe0\xf2\x05\xfb(\x03(\x03(\x03(\x03(\x03(\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf4\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9b\x06\x9b\x06\x9b\x06\x9b\x06\x9b\x06\x87\xfcr\xf2r\xf2r\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2s\xf2t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x01\x8c\xfb$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5$\xf5%\xf5%\xf5%\xf5%\xf5%\xf5%\xf5%\xf5%\xf5%\xf5%\xf5%\xf5n\xf8\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\xec\x01\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x03\x1a\x034\x06M\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tM\tL\tL\tL\tL\tL\tL\tL\tL\tL\t_\x06q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03q\x03j\x02c\x01c\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01b\x01!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\xde\x03\x8a\xfb4\xf34\xf34\xf34\xf34\xf34\xf34\xf34\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf35\xf36\xf36\xf36\xf36\xf36\xf36\xf3\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x89\xf7\x8a\xf7\x8a\xf7\x8a\xf7\x8a\xf7\x8a\xf7\x8a\xf7\x8a\xf7\x8a\xf7\x8a\xf7\x8a\xf7\x8a\xf7E\xffE\xffE\xffE\xffE\xffE\xffE\xffE\xffE\xffE\xffE\xffE\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xffF\xff\xf6\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xef\xfc6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb6\xfb\x9f\x01\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2.\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf2/\xf20\xf20\xf2/\f/\f/\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f.\f-\f-\f-\f-\f-\f-\f-\f-\f-\f-\f-\f9\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x059\x058\x05\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5\xef\xf5y\xf5\x02\xf5\x02\xf5\x02\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5q\xfd\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\a\xfe1\xf61\xf61\xf61\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf62\xf63\xf63\xf63\xf63\xf63\xf6\x8f\xf8\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\x01\xfa\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x16\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9\x17\xf9e\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfa\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\xb4\x06\x99\n\x99\n\x99\n\x99\n\x99\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x98\n\x97\n\x97\n\x97\n\x97\n\x97\n\x97\n\x97\n\xcf\x03\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd/\xfaU\xf7U\xf7U\xf7U\xf7U\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7V\xf7W\xf7W\xf7\xec\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xeb\n\xea\n\xea\n\xea\n\xea\n\xea\n\xea\n\xea\n\xea\n\xea\n\xea\n\xea\n \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc\x0f\xff\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xbd\xfd}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9}\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9\x89\f\x89\f\x89\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x88\f\x87\f\x87\f\x87\f\x87\f\x87\f\x87\f\x87\f\x87\f\x87\f\x87\f\x87\f\x87\f\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\x01\x00\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x16\a\x16\a\x16\a\x16\a\x16\a\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x85\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\\\xf9\\\xf9\\\xf9\\\xf9\\\xf9\\\xf9\\\xf9\\\xf9\\\xf9\\\xf9\\\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9s\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbs\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbt\xfbO\x04+\r+\r+\r+\r+\r+\r+\r+\r+\r+\r+\r*\r*\r*\r*\r*\r*\r*\r*\r*\r*\r*\r*\r*\r*\r*\r*\r)\r)\r)\r)\r)\r\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc1\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfdL\xfb&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9'\xf9C\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfb:\xfe0\x010\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3\xea\xf5\xea\xf5\xea\xf5\xea\xf5\xea\xf5\xea\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xeb\xf5\xec\xf5\xec\xf5\xec\xf5\xec\xf5\xec\xf5@\xff\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x92\b\x92\b\x92\b\x92\b\x92\b\x92\b\x92\b\x92\b\x92\b\x92\b\xd9\xfe\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5\x1f\xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5 \xf5\xfc\xff\xd7\n\xd7\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd6\n\xd5\n\xd5\n\xd5\n\xd5\n\xd5\n\xd5\n\xd5\n\xd5\n\xd5\n\xd5\n\x8a\v@\f@\f@\f@\f@\f@\f@\f?\f?\f?\f?\f?\f?\f?\f?\f?\f?\f?\f?\f?\f?\f?\f?\f?\f>\f>\f>\f>\f>\f>\f>\f>\f\xc1\x01D\xf7D\xf7D\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7E\xf7F\xf7F\xf7F\xf7F\xf7F\xf7\x9f\xf8\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9x\xf7\xf6\xf4\xf6\xf4\xf6\xf4\xf6\xf4\xf6\xf4\xf6\xf4\xf6\xf4\xf6\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf7\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xbb\xfd}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06|\x06|\x06|\x06|\x06\x06\x02\x90\xfd\x90\xfd\x90\xfd\x90\xfd\x90\xfd\x90\xfd\x90\xfd\x90\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x91\xfd\x8a\a\x8a\a\x8a\a\x8a\a\x8a\a\x8a\a\x8a\a\x8a\a\x8a\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\x89\a\xff\x01u\xfcu\xfcu\xfcu\xfcu\xfcu\xfcu\xfcu\xfcu\xfcu\xfcu\xfcu\xfcu\xfcu\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfcv\xfc\xf4\xfcq\xfdq\xfdq\xfdq\xfdq\xfdq\xfdq\xfdq\xfdq\xfdq\xfdq\xfdq\xfdq\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfd\xd7\xff<\x02<\x02<\x02<\x02<\x02<\x02<\x02<\x02<\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02;\x02\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd1\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfc\xd2\xfcZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vZ\vY\vY\vY\vY\vY\vY\vY\vY\vY\vY\vY\vY\vY\vY\vY\v\x1e\a\xe3\x02\xe3\x02\xe3\x02\xe3\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03T\x03\x13\xff\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd2\xfa\xd2\xfa\xd2\xfa\x9b\xffb\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04J\fJ\fJ\fJ\fJ\fJ\fJ\fJ\fJ\fJ\fJ\fI\fI\fI\fI\fI\fI\fI\fI\fI\fI\fI\fI\fI\fI\fI\fI\fI\fH\fH\fH\fH\fH\f\xc4\x00A\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5B\xf5C\xf5C\xf5C\xf5C\xf5C\xf5C\xf5C\xf5C\xf5C\xf5C\xf5C\xf5C\xf5\x0e\xf3\x0e\xf3\x0e\xf3\x0e\xf3\x0e\xf3\x0e\xf3\x0e\xf3\x0e\xf3\x0e\xf3\x0e\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x0f\xf3\x10\xf3\x10\xf3\x10\xf3\x10\xf3\x10\xf3\x10\xf3\x10\xf3\x8c\xff\a\f\a\f\a\f\a\f\a\f\a\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x05\f\x05\f\x9c\t3\a3\a3\a3\a3\a3\a3\a3\a3\a3\a3\a3\a3\a3\a3\a3\a2\a2\a2\a2\a2\a2\a2\a2\a2\a2\a2\a2\a2\a2\a2\a2\a2\a\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05n\x05\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfam\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf3o\xf31\xfc\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf2\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xd8\x06\xc0\b\xc0\b\xc0\b\xc0\b\xc0\b\xc0\b\xc0\b\xc0\b\xc0\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\b\xbf\bh\t\x12\n\x12\n\x12\n\x12\n\x12\n\x12\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x11\n\x10\n\x10\n\x10\n\x10\n\x10\n\x10\n\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\x1a\x04\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd7\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8f\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\x8e\x04\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xbc\xff\xc4\x04\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcc\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xcb\t\xad\x02\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb^\x00+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05*\x05*\x05l\x02\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xffT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfdT\xfd\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x9f\x03\x82\xfcd\xf5d\xf5d\xf5d\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5e\xf5f\xf5f\xf5f\xf5f\xf5f\xf5f\xf5f\xf5f\xf5f\xf5f\xf5\xc4\xfe\"\b\"\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b!\b \b \b \b \b \b \bI\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3K\xf3\xd9\xfae\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xe0\x00\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a%\a$\a$\a$\a$\a$\a$\a$\a$\a$\a$\a$\a$\a$\a$\a$\a$\a\xe5\x05\xa7\x04\xa7\x04\xa7\x04\xa7\x04\xa7\x04\xa7\x04\xa7\x04\xa7\x04\xa7\x04\xa7\x04\xa7\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04E\rE\rE\rE\rE\rE\rE\rE\rE\rE\rE\rE\rE\rE\rE\rD\rD\rD\rD\rD\rD\rD\rD\rD\rD\rD\rD\rD\rD\rD\rC\rC\rC\rC\r%\x00\b\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\t\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\n\xf3\v\xf3\v\xf3\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xab\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\x96\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5=\xfb\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xd0\v\xcf\v\xcf\v\xcf\v\xcf\v\xcf\v\xcf\v\xcf\v\xa5\bz\x05z\x05z\x05z\x05z\x05z\x05z\x05z\x05z\x05z\x05z\x05z\x05z\x05z\x05z\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05\xa6\xfc\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd3\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xd4\xf3\xaa\xfe\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t~\t}\t}\t\"\v\"\v\"\v\"\v\"\v\"\v\"\v\"\v\"\v\"\v\"\v\"\v\"\v!\v!\v!\v!\v!\v!\v!\v!\v!\v!\v!\v!\v!\v!\v!\v\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe\xb4\xfe:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x06:\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x069\x06f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x012\v2\v2\v2\v2\v2\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v1\v0\v0\v0\v0\v0\v0\v0\v0\v0\v0\v0\v\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xff\x0e\xffn\xfdn\xfdn\xfdn\xfdn\xfdn\xfdn\xfdn\xfdn\xfdn\xfdn\xfdn\xfdn\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfd\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06q\b\xde\n\xde\n\xde\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\x0f\x04C\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfd\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x15\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa2\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3!\xfd\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9a\xfd\x97\xf4\x97\xf4\x97\xf4\x97\xf4\x97\xf4\x97\xf4\x97\xf4\x97\xf4\x97\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4l\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4m\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4n\xf4\xd0\xff0\v0\v0\v0\v0\v0\v0\v0\v0\v0\v0\v0\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v.\v.\v.\v.\v.\vs\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfds\xfd\x9a\x03\xc3\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc2\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x06\x96\x051\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x041\x04v\xf4v\xf4v\xf4v\xf4v\xf4v\xf4v\xf4v\xf4v\xf4v\xf4v\xf4v\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4x\xf4x\xf4x\xf4x\xf4x\xf4x\xf4\x13\xff\xae\t\xae\t\xae\t\xae\t\xae\t\xae\t\xae\t\xae\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xac\t\xac\t\xac\t\xac\t\xac\t\xac\t\xfc\x05L\x02L\x02L\x02L\x02L\x02L\x02L\x02L\x02L\x02L\x02L\x02L\x02L\x02L\x02L\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02K\x02\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x82\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x83\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\xf1\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfck\xf9\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe4\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5\xe5\xf5H\xfb\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xab\x00\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xdf\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xdd\t}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01}\x01\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd1\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\n\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\a\t\aH\vH\vH\vH\vH\vH\vH\vH\vH\vH\vH\vH\vG\vG\vG\vG\vG\vG\vG\vG\vG\vG\vG\vG\vG\vG\vG\vG\vG\vF\vF\vF\vF\vF\vF\vF\v\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfc\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\xfb\b\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x98\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfbl\vk\vk\vk\vk\vk\vk\vk\vk\vk\vk\vk\vk\vk\vk\vk\vk\vk\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\vj\v\xb7\v\x04\f\x04\f\x04\f\x04\f\x04\f\x04\f\x04\f\x04\f\x04\f\x04\f\x04\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x02\f\x02\f\x02\f\x02\f\x02\f\x02\f\x02\f\xc2\x02\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x02\x06\x02\x06\x02\x06\x02\x06\x02\x06\x02\x06\x02\x06\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb1\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xd3\xff\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf2\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf1\v\xf0\v\xf0\v\xf0\v\xf0\v\xb9\x05\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x82\xff\x83\xff\x83\xff\x83\xff\x83\xff\x83\xff\x83\xff\x83\xff)\xf5)\xf5)\xf5)\xf5)\xf5)\xf5)\xf5)\xf5)\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5*\xf5+\xf5+\xf5+\xf5+\xf5+\xf5+\xf5+\xf5+\xf5+\xf5N\xfbp\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xe5\x04\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\xee\xff\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x10\f\x10\f\x10\f3\vU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nU\nT\nT\nT\nT\nT\nT\nT\nT\nT\nT\nT\nT\nT\nT\nT\nT\nT\n\xb0\x03\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x99\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x98\t\x97\t\x8d\x01\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\xc1\xf6\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xff\xf3\xff\xf3\xff\xf3\xc5\f\xc5\f\xc5\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc4\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc2\f\xc2\f\xc2\f\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8%\xff\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\xe4\xfcD\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4D\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4E\xf4F\xf4F\xf4\xea\xf6\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8e\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9\x8f\xf9f\xfa=\xfb=\xfb=\xfb=\xfb=\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb6\xfd.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff\xbb\xfcH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaH\xfaI\xfaI\xfaI\xfaI\xfaI\xfaI\xfaI\xfaI\xfa\xcb\xf8N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7N\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7O\xf7\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8\xa9\xf8Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7[\xf7[\xf7[\xf7\xba\x06\xba\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb9\x06\xb8\x06\xb8\x06\xb8\x06\xb8\x06\xb8\x06\xb8\x06\xb5\t\xb1\f\xb1\f\xb1\f\xb1\f\xb1\f\xb1\f\xb1\f\xb1\f\xb1\f\xb1\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xb0\f\xaf\f\xaf\f\xaf\f2\x00\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\x98\xfey\ty\ty\ty\ty\ty\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tx\tw\tw\tw\tw\tw\tw\tw\tw\tw\tw\t\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\xe8\xf7\xcb\xf7\xcb\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcc\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xcd\xf7\xa8\t\xa8\t\xa8\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa7\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xa6\t\xbc\x05\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd2\x01\xd1\x01\xd1\x01\xd1\x01\xd1\x01\xd1\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01\xb2\x03\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xff\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\x15\x05\x15\x05\x15\x05\x15\x05\x15\x05\x15\x05\x15\x05\x15\x05\x15\x05\x15\x05\x15\x05\x15\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\x14\x05\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xdb\xfb\xdb\xfb\xdb\xfb\xdb\xfb\xdb\xfb\xdb\xfb\xdb\xfb\xdb\xfb\xb6\x00\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x93\x05\x92\x05\x92\x05\x92\x05\x92\x05\x92\x05\x92\x05\x92\x05\x92\x05\x92\x05\x92\x058\b\xde\n\xde\n\xde\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\xdc\n\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x03\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe)\x05O\fO\fO\fO\fO\fO\fO\fO\fO\fO\fO\fO\fO\fO\fN\fN\fN\fN\fN\fN\fN\fN\fN\fN\fN\fN\fN\fN\fN\fM\fM\fM\fM\fM\fM\fM\fM\f\x91\t\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06Y\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xffY\xff-\xf6-\xf6-\xf6-\xf6-\xf6-\xf6-\xf6-\xf6-\xf6-\xf6-\xf6-\xf6-\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6.\xf6/\xf6/\xf6/\xf6/\xf6/\xf6*\xf4*\xf4*\xf4*\xf4*\xf4*\xf4*\xf4*\xf4*\xf4*\xf4*\xf4*\xf4*\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4+\xf4,\xf4,\xf4,\xf4,\xf4,\xf4,\xf4,\xf4,\xf4,\xf4\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x12\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\xb0\x02N\bN\bN\bN\bN\bN\bN\bN\bN\bN\bN\bN\bN\bN\bN\bN\bN\bN\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\bM\b\xdb\x05j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03j\x03i\x03+\xff\xeb\xfa\xeb\xfa\xeb\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xec\xfa\xa3\xf3\xa3\xf3\xa3\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa6\xf3\xa6\xf3\xa6\xf3\xa6\xf3\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\xa0\x06\xbc\v\xbc\v\xbc\v\xbc\v\xbc\v\xbc\v\xbc\v\xbc\v\xbc\v\xbc\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xba\v\xba\v\xba\v\xba\v\xba\v\xba\v\xba\v\xba\v\xba\v\xba\v\xba\v\x14\x05o\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfeo\xfe\xb2\x01\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\b\f\b\f\b\f\b\f\b\f\b\f\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03g\x05\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xe9\x06\xe9\x06\xe9\x06X\xff\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc6\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc7\xf7\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06t\t*\f*\f*\f*\f*\f)\f)\f)\f)\f)\f)\f)\f)\f)\f)\f)\f)\f)\f)\f)\f)\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f'\f'\ff\nf\nf\nf\nf\nf\nf\nf\nf\nf\nf\nf\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\ne\nd\nd\nd\nd\nd\nd\nd\nd\nd\n\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04\xaa\x04,\x01\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xaf\xfd\xbb\xfb\xc8\xf9\xc8\xf9\xc8\xf9\xc8\xf9\xc8\xf9\xc8\xf9\xc8\xf9\xc8\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xe9\xf9\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\n\xfa\v\xfa\v\xfa\v\xfa\v\xfa\v\xfa\v\xfa\u007f\b\u007f\b\u007f\b\u007f\b\u007f\b\u007f\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b~\b}\b}\b}\b\xdc\x05;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03;\x03:\x03:\x03:\x03:\x03:\x03:\x03:\x03:\x03:\x03\xa1\x03\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\b\x04\t\b\n\f\n\f\n\f\n\f\n\f\n\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\b\f\xab\x06N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01\x94\xff\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xda\xfd\xb5\xfc\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5y\x00y\x00y\x00y\x00y\x00y\x00y\x00y\x00y\x00y\x00y\x00y\x00y\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00x\x00\xb0\x03\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06C\b\xa1\t\xa1\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x9f\t\x8f\t\x8f\t\x8f\t\x8f\t\x8f\t\x8f\t\x8f\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8e\t\x8d\t\x8d\t\x8d\t\x8d\t\x8d\t\x8d\t\x8d\t\x8d\t\x8d\t\x8d\t\x8d\t\x8d\t\xc5\xfe\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\aL\xfe\x87\xf5\x87\xf5\x87\xf5\x87\xf5\x87\xf5\x87\xf5\x87\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x88\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x89\xf5\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\xfa\xffq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbq\xfbr\xfbr\xfbr\xfbr\xfbr\xfbr\xfbr\xfbr\xfb\xef\x04\xef\x04\xef\x04\xef\x04\xef\x04\xef\x04\xef\x04\xef\x04\xef\x04\xef\x04\xef\x04\xef\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x04\xee\x044\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd6\xf98\xf58\xf58\xf58\xf58\xf58\xf58\xf58\xf58\xf58\xf58\xf58\xf58\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf59\xf5:\xf5:\xf5:\xf5:\xf5:\xf5:\xf5:\xf5:\xf5:\xf5\xa9\xf8\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x19\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1a\xfc\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6\x1f\xf6 \xf6 \xf6 \xf6@\x02@\x02@\x02@\x02@\x02@\x02@\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02?\x02\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfax\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03c\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfec\xfeo\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfao\xfap\xfap\xfap\xfap\xfap\xfap\xfa=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xffJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aJ\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\a\xea\xfd\x8a\xf4\x8a\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8c\xf4\x8d\xf4\x8d\xf4\x8d\xf4\x8d\xf4\x8d\xf4)\xfd\xc4\x05\xc4\x05\xc4\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc3\x05\xc2\x05\xc2\x05\xc2\x05\xc2\x05\xc2\x05\xbf\xf6\xbf\xf6\xbf\xf6\xbf\xf6\xbf\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc0\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6\xc1\xf6g\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfcw\xfb\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x86\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\x87\xfa\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xe3\x03\t\v\t\v\t\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\b\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\x06\v\x06\v\x06\v\x13\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfeP\xfa\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf6\x8e\xf62\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x021\x02\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x0f\x01\x1e\x02-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04%\x04%\x04%\x04\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x97\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x98\xf7\x99\xf7\x99\xf7\x99\xf7\x8d\xfe\x81\x05\x81\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\u007f\x05\u007f\x05\u007f\x05\u007f\x05\u007f\x05S\x01'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd(\xfd\xc7\x01h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06h\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06g\x06p\x05z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04z\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04y\x04H\xfd\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x17\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x18\xf6\x19\xf6\x19\xf6\x19\xf6\xf1\xfe\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc9\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc8\a\xc7\a\xc7\a\xc7\a\xc7\a\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\r\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\f\t\v\t]\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5^\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5_\xf5`\xf5`\xf5`\xf5`\xf5`\xf5`\xf5$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe\\\x03\x94\b\x94\b\x94\b\x94\b\x94\b\x94\b\x94\b\x94\b\x94\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x93\b\x92\b\x92\b3\b\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd3\n\xd3\n\xd3\n\xd3\n\xd3\n\xd3\n\xd3\n\xd3\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd2\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xd1\n\xf8\x06\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\r\x00\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8e\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\xa9\x04\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb5\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x94\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9\x95\xf9;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01\xa6\xff\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x10\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfeO\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfeR\xfb\x16\xf8\x16\xf8\x16\xf8\x16\xf8\x16\xf8\x16\xf8\x16\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x18\xf8\x18\xf8\x18\xf8\x18\xf8\x18\xf8\x18\xf8\x18\xf8\x18\xf8\x18\xf8\x18\xf8\x18\xf8\xb5\xfcQ\x01Q\x01Q\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x81\xf5\x82\xf5\x82\xf5\x82\xf5\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9b\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9c\xf9\x9d\xf9\x9d\xf9\x9d\xf9\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00\xf1\xfb\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7\xc0\xf7D\x01\xc9\n\xc9\n\xc9\n\xc9\n\xc9\n\xc9\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc8\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc7\n\xc6\n\xc6\n\xc6\nv\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfev\xfes\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02\x8b\xfb\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa4\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4\xa5\xf4,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00\xb9\x00G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01G\x01o\xfao\xfao\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaY\xf9B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8B\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8C\xf8\xb1\xf9 \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb \xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb\xb2\x02E\nE\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nC\nC\nC\nC\nC\nC\nC\nC\nC\nC\nC\nC\nC\nC\nC\nC\nC\nB\nB\nB\nB\nB\nB\n\x84\n\x84\n\x84\n\x84\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x83\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x82\n\x81\n\x81\n\x81\n\x81\n\x81\n\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\xea\xfc\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xc0\xfb\xc0\xfb\xc0\xfb\xc0\xfb\xc0\xfb\xc0\xfb\xc0\xfb\xc0\xfb\xc0\xfb\xc0\xfb0\xf8\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xa2\xf4\xdc\xf8\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\x16\xfd\v\xff\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xfe\x00\xa8\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00Q\x00\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xff\xf0\xffy\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfe2\xfa2\xfa2\xfa2\xfa2\xfa2\xfa2\xfa2\xfa2\xfa2\xfa2\xfa2\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa3\xfa4\xfa\xbc\x02F\vF\vF\vF\vE\vE\vE\vE\vE\vE\vE\vE\vE\vE\vE\vE\vE\vE\vE\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vD\vV\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04V\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04M\xffD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaD\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfaE\xfa\x12\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x11\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05o\x02\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff<\n<\n<\n<\n<\n<\n<\n<\n<\n<\n<\n<\n<\n;\n;\n;\n;\n;\n;\n;\n;\n;\n;\n;\n;\n;\n;\n;\n;\n;\n:\n:\n:\n:\n:\n:\n:\n:\n:\n:\n:\n:\n:\n\xf3\t\xac\t\xac\t\xac\t\xac\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xab\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xaa\t\xa9\t\xa9\t\xa9\t\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00\xee\xfd\x9e\xfb\x9e\xfb\x9e\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfb\x9f\xfbp\xfaA\xf9A\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9B\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9C\xf9&\xf7\b\xf5\b\xf5\b\xf5\b\xf5\b\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\t\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\n\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\v\xf5\xdd\xf6\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb0\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb1\xf8\xb2\xf8\xb2\xf8\xb2\xf8\xb2\xf8\xb2\xf8\xb2\xf8\xb2\xf8\x0e\xf7j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5k\xf5l\xf5l\xf5l\xf5\xe7\xfdb\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06a\x06`\x06`\x06`\x06`\x06`\x06_\x05]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04]\x04\\\x04\\\x04\\\x04\\\x04\\\x04\\\x04\\\x04\\\x04\\\x04\\\x04\\\x04\\\x04\xc3\xfd)\xf7)\xf7)\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7*\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7,\xf7,\xf7\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb5\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb4\b\xb3\b\xb3\b\xb3\b\xb3\b\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00\x85\x00C\x04\x02\b\x02\b\x02\b\x02\b\x02\b\x02\b\x02\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b?\xf7?\xf7?\xf7?\xf7?\xf7?\xf7?\xf7?\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7@\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7A\xf7\xda\xfcr\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02q\x02I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05w\x00\xa8\xfb\xa8\xfb\xa8\xfb\xa8\xfb\xa8\xfb\xa8\xfb\xa8\xfb\xa8\xfb\xa8\xfb\xa8\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfbp\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05p\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05o\x05\x90\a\xb1\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t\xae\t\xae\t\xae\t\xae\t\xae\t\xae\t\xae\t\xae\tc\xff\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x16\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5\x18\xf5m\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdK\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00K\x00\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xd0\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xcf\x06\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfc\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04[\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04Z\x04/\x04/\x04/\x04/\x04/\x04/\x04/\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04.\x04\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\xc5\xf5\xc5\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc7\xf5\xc8\xf5\xc8\xf5\xc8\xf5\xc8\xf5\xc8\xf5\xc8\xf5\xc8\xf5\xc8\xf5\xc8\xf5\xc8\xf57\xff\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\a\xffk\xf5k\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5l\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5m\xf5n\xf5n\xf5n\xf5n\xf5n\xf5n\xf5n\xf5n\xf5n\xf5n\xf5n\xf5\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8a\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8b\xf8\x8c\xf8\x8c\xf8\xbe\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf0\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8\xf1\xf8G\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfc!\xfa\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfc\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\xed\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfeU\xfe\x06\x00\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01f\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xff\xd9\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd8\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd7\a\xd6\a\xd6\a\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x11\xf8\x11\xf8\x11\xf8\xba\xffb\ab\ab\ab\ab\ab\ab\ab\ab\ab\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\aa\a`\a`\a`\a`\a`\a`\a`\a`\a`\a`\a`\a`\a`\a\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf4\x02\xf4\x02\xf4\x02\xf4\x02?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01?\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01D\bD\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bC\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bB\bA\bA\bA\bA\b#\xff\x03\xf6\x03\xf6\x03\xf6\x03\xf6\x03\xf6\x03\xf6\x03\xf6\x03\xf6\x03\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x05\xf6\x05\xf6\x05\xf6\x05\xf6\x05\xf6\x05\xf6\x05\xf6\x05\xf6\x05\xf6\x05\xf6\x05\xf6&\xfdF\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04D\x04D\x04D\x04D\x04D\x04D\x04D\x04\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd6\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xaa\t\x80\n\x80\n\x80\n\x80\n\x80\n\x80\n\x80\n\x80\n\x80\n\x80\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n\u007f\n~\n~\n~\n~\n~\n~\n~\n~\n~\n~\n~\n~\n~\n~\n~\n~\n}\n}\n}\n}\n}\n\xd1\n\xd1\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xd0\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xcf\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\xce\n\"\x02w\xf9w\xf9w\xf9w\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9x\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9y\xf9\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb \x01[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06Y\x06Y\x06Y\x06Y\x06Y\x06Y\x06Y\x06Y\x06Y\x06\x9c\x00\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe1\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xce\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xbd\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xbf\xf8\xc0\xf8\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02\xe2\x02S\xf7S\xf7S\xf7S\xf7S\xf7S\xf7S\xf7S\xf7S\xf7S\xf7S\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7T\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7U\xf7\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\x80\x00\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb2\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\xb3\xfb\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfci\xfe\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xb2\x00\xee\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff)\xff\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xc7\x05\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdd\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdc\t\xdb\tp\np\np\np\np\np\np\np\np\np\np\np\np\np\no\no\no\no\no\no\no\no\no\no\no\no\no\no\no\no\nn\nn\nn\nn\nn\nn\nn\nn\nn\nn\nn\nn\nn\nn\nn\nm\nm\n\xe3\x03Y\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdY\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfdZ\xfd\xc5\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc6\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xc7\xfa\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xd0\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x044\x03\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01I\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf9\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\x9d\xfcB\xf6B\xf6B\xf6B\xf6B\xf6B\xf6B\xf6B\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6C\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb\xb7\xfb5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc5\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc\xa9\xf7\xa9\xf7\xa9\xf7\xa9\xf7\xa9\xf7\xa9\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xab\xf7\xac\xf7\xac\xf7\xac\xf7\xac\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1f\xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7 \xf7!\xf7\x90\xff\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfe\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\x84\b\v\t\v\t\v\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t\b\t-\xfe-\xfe-\xfe-\xfe-\xfe-\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe.\xfe\xfc\xf9\xc9\xf5\xc9\xf5\xc9\xf5\xc9\xf5\xc9\xf5\xc9\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xca\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcb\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xcc\xf5\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05\xf9\x05,\x01a\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcs\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04s\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xf0\x06<\t;\t;\t;\t;\t;\t;\t;\t;\t;\t;\t;\t;\t;\t;\t;\t;\t;\t:\t:\t:\t:\t:\t:\t:\t:\t:\t:\t:\t:\t:\t:\t:\t:\t:\t9\t9\t9\t9\t\x85\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04o\xfdY\xf6Y\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6[\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6&\xfc\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01\xee\x01G\x00\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa1\xfe\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xe2\xfa\xe2\xfa\xe2\xfa\xe2\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xe4\xfa\xb4\x00\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x84\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\b\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8c\a\x8b\a\x8b\a\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\xea\xf8\"\xfeY\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xe8\xff\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xf8\x02\xf5\x06\xf5\x06\xf5\x06\xf5\x06\xf5\x06\xf5\x06\xf5\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\xf2\x01\xf2\x01\xf2\x01\xf2\x01\xf2\x01\xf2\x01\xf2\x01\xf2\x01\xf2\x01\xf2\x01\xf2\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\xf1\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\xb0\x06\xb0\x06\xb0\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xad\x06\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbf\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xbe\x01\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xad\x06\xad\x06\xad\x06\xad\x06\xad\x06\xad\x06\xad\x06\xad\x06e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01d\x01%\x04\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\x17\aI\aI\aI\aI\aI\aI\aI\aI\aI\aI\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aH\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\aG\a\a\n\a\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x03\n\x03\n\xb6\x01j\xf9j\xf9j\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9k\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9l\xf9\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x03\xfd\x91\xfd\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfeU\xff\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x8a\x00\x11\xf7\x11\xf7\x11\xf7\x11\xf7\x11\xf7\x11\xf7\x11\xf7\x11\xf7\x11\xf7\x11\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x12\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x13\xf7\x14\xf7\x14\xf7\x14\xf7\x14\xf7\x14\xf7\x14\xf7\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xcf\t\xce\t\xce\t\xce\t\xce\t\xce\t\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x12\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\x13\xff\xfc\xfc\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfam\tm\tm\tm\tm\tm\tm\tm\tm\tm\tl\tl\tl\tl\tl\tl\tl\tl\tl\tl\tl\tl\tl\tl\tl\tl\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tk\tj\tj\tj\tj\tj\tj\tj\tj\tj\t)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc)\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc*\xfc\x11\xf6\x11\xf6\x11\xf6\x11\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x12\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x13\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x14\xf6\x15\xf66\xfdV\x04V\x04V\x04V\x04V\x04V\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04U\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x049\xf99\xf99\xf99\xf99\xf99\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9:\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9<\xf9\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd2\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd3\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd4\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04r\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04q\x04\x85\xff\x97\xfa\x97\xfa\x97\xfa\x97\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x98\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\xa6\xf8\xb4\xf6\xb4\xf6\xb4\xf6\xb4\xf6\xb4\xf6\xb4\xf6\xb4\xf6\xb4\xf6\xb4\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb6\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xd5\xfe\xf3\x06\xf3\x06\xf3\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xff\a\xfe\a\xfe\a\xfe\a\xfe\a\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3\xf8X\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbX\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfb\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x0f\xfe\x10\xfe\x10\xfe\x8e\xfc\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\r\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfb\x0f\xfb\x0f\xfb\x0f\xfb\x0f\xfb\xd8\xf8\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa3\xf6\xa4\xf6\xa4\xf6\xa4\xf6\xa4\xf6\xa4\xf6\xa4\xf6\xa4\xf6:\xff:\xff:\xff:\xff:\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xff;\xffg\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04f\x04e\x04e\x04\xc1\x02\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\x1d\x01\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xcf\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd0\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xd1\xfb\xdb\xfc\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe5\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xb3\t\xb3\t\xb3\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb2\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb1\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xaf\t\xaf\t\xaf\t\xaf\tM\x01\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xec\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xee\xf8\xe7\xfa\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfcR\xfc\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb)\x02\x8f\b\x8f\b\x8f\b\x8f\b\x8f\b\x8f\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8c\b\x8c\b\x8c\b\x8c\b\x8c\b\x8c\b\x8c\b\x8c\b\x8c\b\x8c\b\x8c\b\x8c\b\xb1\a\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd5\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd4\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xd3\x06\xa0\x05m\x04m\x04m\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01B\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbB\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfbC\xfb2\x01#\a#\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a\"\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a!\a \a \a \a \a \a \a \a \a \a \a3\x033\x033\x033\x033\x033\x033\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x03\xe7\x00\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfex\xfbR\xf8R\xf8R\xf8R\xf8R\xf8R\xf8R\xf8R\xf8R\xf8R\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8S\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8\x9c\xfb\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe\xe4\xfe/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf8/\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf80\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf81\xf82\xf82\xf8\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xec\xf6\xec\xf6\xec\xf6\xec\xf6\xec\xf6\xec\xf6\xec\xf6\xec\xf6\xec\xf6\xa9\a\xa9\a\xa9\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa8\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa7\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\xa6\a\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x91\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe7\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe5\x06\xe5\x06\xaa\xffn\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8n\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8o\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8p\xf8@\xf9\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x10\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\x11\xfa\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xfa\xf8\xfa\xf8\xfa\xf8\xfa\xf8\xfa\xf8\xfa\xf8\xa0\x00H\bH\bH\bH\bH\bH\bH\bH\bH\bG\bG\bG\bG\bG\bG\bG\bG\bG\bG\bG\bG\bG\bG\bG\bG\bG\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bF\bE\bE\bE\bE\bE\bE\bE\bE\bE\bE\b\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd\x87\xfd/\xfa/\xfa/\xfa/\xfa/\xfa/\xfa/\xfa/\xfa/\xfa/\xfa/\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa0\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\x002\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x062\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x061\x06\x95\a\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf7\b\xf6\b\xf6\b\xf6\b\xf6\b\xf6\b\xf6\b\xf6\b\xf6\b\xf6\b\xf6\b\xf6\b\x8d\a%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06%\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06$\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06#\x06\x01\x04\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\x01\\\xfe\xda\xfa\xda\xfa\xda\xfa\xda\xfa\xda\xfa\xda\xfa\xda\xfa\xda\xfa\xda\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdb\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa\xdc\xfa]\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xdf\xfb\xe0\xfb\xe0\xfb\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xc6\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\t\xfe\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x84\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x86\xf9\x87\xf9\x87\xf9\x87\xf9o\xf8W\xf7W\xf7W\xf7W\xf7W\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7X\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7Z\xf7[\xf7[\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\r\xff]\x06]\x06]\x06]\x06]\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06[\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06\xf7\x03\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01%\xfe\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb9\xfa\xb9\xfa\xb9\xfa\xb9\xfa\xb9\xfa\xb9\xfa\xb9\xfa\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1b\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x8b\xfa\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xf9\xfc\xad\xfaa\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8d\xf8d\xf8\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb5\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xb6\xfb\xaa\xfc\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xb9\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\x84\xfeL\x05L\x05L\x05L\x05L\x05L\x05L\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05K\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05\xc1\x06\xc1\x06\xc1\x06\xc1\x06\xc1\x06\xc1\x06\xc1\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xc0\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbf\x06\xbe\x06\xbe\x06\xbe\x06\xbe\x06\xbe\x06\xbe\x06\xbe\x06\xbe\x06\xbe\x06\xbe\x06\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x81\x04\x81\x04\x81\x04\x81\x04\x81\x04\x81\x04\x81\x04\x81\x04\x81\x04\a\x04\a\x04\a\x04\a\x04\a\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xa5\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa4\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xa2\b\xf9\x06Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05Q\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03X\x03\xc9\xf8\xc9\xf8\xc9\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8Y\xfb\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe6\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\xe7\xfd\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x93\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01\x92\x01Z\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe\x9d\x02\xe0\x06\xe0\x06\xe0\x06\xe0\x06\xe0\x06\xe0\x06\xe0\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xde\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x060\x06\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05\x80\x05-\x02\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdb\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\x8a\xfd8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc\xc2\x00J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05J\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05\xd5\xfe`\xf8`\xf8`\xf8`\xf8`\xf8`\xf8`\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8a\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8b\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8\xf3\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf4\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf5\xf8\xf6\xf8\xf6\xf8\xf6\xf8\xf6\xf8\xf6\xf8\xf6\xf8\xf6\xf8\xd8\xfd\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf3\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf1\x06\xf0\x06\xf0\x06\xf0\x06\x91\a\x91\a\x91\a\x91\a\x91\a\x91\a\x91\a\x91\a\x91\a\x91\a\x91\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x90\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8f\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x8e\a\x94\x06\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x99\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05*\a\xbc\b\xbc\b\xbc\b\xbc\b\xbc\b\xbc\b\xbc\b\xbc\b\xbc\b\xbc\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xbb\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb9\b\xb8\b\xb8\b\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xe9\xfe\xea\xfe\xea\xfe\xea\xfe\xea\xfe\xea\xfe\xea\xfe\xea\xfe\xea\xfe\xea\xfe\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfdW\x01%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05%\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05#\x05#\x05#\x05#\x05#\x05#\x05#\x05#\x05#\x05#\x05#\x05?\x06[\a[\a[\a[\a[\a[\a[\a[\a[\a[\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aZ\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aY\aX\aX\aX\aX\aX\aX\aX\aX\aX\aX\aX\aX\aX\a'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04'\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04%\x04%\x04%\x04%\x04%\x04%\x04%\x04%\x04%\x04%\x04%\x04\xab\x001\xfd1\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd2\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\xf0\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\xca\x01\x84\xf8\x84\xf8\x84\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x85\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x86\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x87\xf8\x88\xf8\x88\xf8\x88\xf8\x88\xf8\xc1\xfb\xc1\xfb\xc1\xfb\xc1\xfb\xc1\xfb\xc1\xfb\xc1\xfb\xc1\xfb\xc1\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc2\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb\xc3\xfb#\xf9#\xf9#\xf9#\xf9#\xf9#\xf9#\xf9#\xf9#\xf9#\xf9#\xf9#\xf9#\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9$\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9%\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9&\xf9 \b \b \b \b \b \b \b \b \b \b \b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1f\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1c\b\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05b\x05a\x05a\x05a\x05a\x05a\x05a\x05a\x05a\x05a\x05a\x05a\x05a\x05a\x05\xd0\x03>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02>\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02M\x03M\x03M\x03M\x03M\x03M\x03M\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03\xbf\xff3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb5\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\xf1\xfd\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x91\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\xb0\xfc\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xa6\xfe~\xfe~\xfe~\xfe~\xfe~\xfe~\xfe~\xfe~\xfe~\xfe~\xfe~\xfe~\xfe~\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfep\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfap\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfaq\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfar\xfa\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa4\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa2\x06\xa1\x06\xa1\x06\xa1\x06\xa1\x06\xa1\x06\xa1\x06\xa1\x06\xa1\x06\xeb\xff3\xf93\xf93\xf93\xf93\xf93\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf94\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf95\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf96\xf9@\x06@\x06@\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06?\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06>\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06=\x06Z\x02y\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfey\xfez\xfez\xfez\xfez\xfez\xfez\xfez\xfez\xfe\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\u007f\xff\x80\xff\x80\xff\x80\xff\x80\xff\x80\xff\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd8\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x06\xd6\x062\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc\xdd\xfb\x86\xfb\x86\xfb\x86\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x87\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x89\xfb\x89\xfb\x89\xfb\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xf0\xfe\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd6\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd5\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xf1\x06\x11\x06\x11\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x10\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0f\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xa8\x01\xa8\x01\xa8\x01\xa8\x01\xa8\x01\xa8\x01\xa8\x01\xa8\x01\xa8\x01\xa8\x01\xa8\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xa7\x01\xce\xf9\xce\xf9\xce\xf9\xce\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xcf\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd0\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xbe\xfd\xbe\xfd\xbe\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xc0\xfd\xc0\xfd\xc0\xfd\xc0\xfd\xc0\xfd\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\x1d\x01\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x89\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x87\x05\x87\x05\x87\x05\x87\x05\x87\x05\x87\x05\x87\x05\x87\x05\x02\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\xff\x03\x96\x04-\x05-\x05-\x05-\x05-\x05-\x05-\x05-\x05-\x05-\x05-\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05*\x05*\x05*\x05*\x05*\x05\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xeb\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\x99\x00J\xfaJ\xfaJ\xfaJ\xfaJ\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaK\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaL\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfaM\xfab\xfeu\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02\x9b\x06\x9b\x06\x9b\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x9a\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x99\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x97\x06\x97\x06\x97\x06\x97\x06\x97\x06\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\x0f\xff\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf5\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfa\xf6\xfaj\x00\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xde\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdc\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\x02\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\xb2\x04e\x06e\x06e\x06e\x06e\x06e\x06e\x06e\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06c\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06b\x06)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01)\x01(\x01(\x01(\x01'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc4\xfc\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x19\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x18\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05\x17\x05W\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfe\x1e\x02\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe3\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05s\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfes\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfet\xfe\xf1\x00o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03n\x03m\x03m\x03m\x03m\x03m\x03m\x03m\x03m\x03m\x03m\x03\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xba\xfex\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfbx\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfby\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfb\x81\xff\x87\x03\x87\x03\x87\x03\x87\x03\x87\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x86\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x85\x03\x9f\xff\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb8\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xb9\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb\xba\xfb1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xff1\xffC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdD\xfdE\xfdE\xfdE\xfdE\xfdE\xfdE\xfdE\xfdE\xfdE\xfdE\xfd\x1d\x01\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf6\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xab\xfdY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfbZ\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb[\xfb\v\x00\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xbb\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xba\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\xb9\x04\x02\x03J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01\xb0\x01\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x14\x02\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\n\x02\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfb\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\x01\xff\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc$\xfc%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\xc3\xff\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01=\xfb=\xfb=\xfb=\xfb=\xfb=\xfb=\xfb=\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb>\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb?\xfb@\xfb@\xfb@\xfb@\xfb@\xfb@\xfb@\xfb@\xfb@\xfb@\xfb@\xfb@\xfbM\xfeX\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01X\x01,\xfe\x00\xfb\x00\xfb\x00\xfb\x00\xfb\x00\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x03\xfb\x89\x06\x89\x06\x89\x06\x89\x06\x89\x06\x89\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x88\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x86\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\x85\x06\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb7\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01\xb6\x01_\x04\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x05\a\x04\a\x04\a\x04\a\x04\a\x04\a\x04\a\x04\a\x04\a\x04\a\x04\a\x04\a\x04\a\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02w\x02w\x02w\x02w\x02w\x02w\x02w\x02w\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcb\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8\xcf\xf8|\xfd'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02'\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02&\x02\x1d\xfe\x13\xfa\x13\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x15\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x17\xfa\x17\xfa\x17\xfa\x17\xfa\x17\xfa\x17\xfa\x17\xfa\x17\xfa\x17\xfa\x17\xfa\x17\xfa]\xff\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa1\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9e\x04\x9e\x04\x9e\x04\x9e\x04\x9e\x04\x9e\x04\x9e\x04\x9e\x04\x9e\x04\x9e\x04\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc1\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xc0\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xbf\x03\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe6\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xea\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\xeb\xfa\a\x06\a\x06\a\x06\a\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x05\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x04\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\xa3\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05B\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05A\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05@\x05?\x05?\x05?\x05?\x05?\x05?\x05?\x05?\x05?\x05\xf0\x03\xf0\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xee\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\x90\x004\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd\xba\xfd\xba\xfd\xba\xfd\xba\xfd\xba\xfd\xba\xfd\xba\xfd\xba\xfd\xba\xfd\xba\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbb\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\xbc\xfd\x18\xfa\x18\xfa\x18\xfa\x18\xfa\x18\xfa\x18\xfa\x18\xfa\x18\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x19\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1a\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1b\xfa\x1c\xfa\x1c\xfa\x1c\xfa\x1c\xfa\x1c\xfa\x1c\xfa\x1c\xfa\x1c\xfal\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05k\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05j\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05i\x05h\x05h\x05h\x05\x99\x02\x99\x02\x99\x02\x99\x02\x99\x02\x99\x02\x99\x02\x99\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02\x97\x02c\x01/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00\xf0\xfc\xaf\xf9\xaf\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb0\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb1\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb2\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb3\xf9\xb4\xf9[\xfc\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xffC\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03B\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00E\xfbE\xfbE\xfbE\xfbE\xfbE\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbF\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbG\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbH\xfbt\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02s\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02r\x02\xe9\x00a\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffx\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04x\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04w\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04v\x04\x8d\xfc\x8d\xfc\x8d\xfc\x8d\xfc\x8d\xfc\x8d\xfc\x8d\xfc\x8d\xfc\x8d\xfc\x8d\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8e\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x90\xfc\x90\xfc\xdc\x02\xdc\x02\xdc\x02\xdc\x02\xdc\x02\xdc\x02\xdc\x02\xdc\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc&\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc'\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc(\xfc\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff \xff\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01`\x03\xbd\x05\xbd\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x058\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x008\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa1\x00\xa0\x00\xae\xfd\xbb\xfa\xbb\xfa\xbb\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbc\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbd\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbe\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xbf\xfa\xd2\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe6\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xea\xfa\xea\xfa\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc4\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc5\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\xc6\xf9\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x80\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x81\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc\x82\xfc_\xfb<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa-\xfd\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00(\x017\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x027\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x026\x025\x025\x025\x025\x025\x025\x025\x025\x02i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01G\xfe%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb'\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfb(\xfbr\x00\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbc\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xbb\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xba\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xb9\x05\xe9\x03\xe9\x03\xe9\x03\xe9\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe7\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\x9e\x02V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01\x02\x03\xaf\x04\xaf\x04\xaf\x04\xaf\x04\xaf\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xad\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xac\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xb8\x04\xc5\x04\xc5\x04\xc5\x04\xc5\x04\xc5\x04\xc5\x04\xc5\x04\xc5\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc4\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc3\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc2\x04\xc1\x04\xc1\x04\xc1\x04\xc1\x04\xc1\x04i\x03\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x10\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe2\xfd\xe3\xfd\xe3\xfd\xe3\xfd\xe3\xfdO\x00\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\xbb\x02\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x81\x05\x1e\x04\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb9\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\xb8\x02\x01\x04J\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05H\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05G\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05E\x05\xa9\x00\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc_\x02_\x02_\x02_\x02_\x02_\x02_\x02_\x02_\x02_\x02_\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02]\x02-\x05-\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05,\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05)\x05(\x05(\x05\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\x94\xfe7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff8\xff\x0f\x02\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe7\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe6\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe5\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe4\x04\xe3\x04\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xad\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xffc\xfd\xc6\xfb\xc6\xfb\xc6\xfb\xc6\xfb\xc6\xfb\xc6\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc7\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc8\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xc9\xfb\xca\xfb\xca\xfb\xca\xfb\xca\xfb\xca\xfb\xd6\xfa\xe3\xf9\xe3\xf9\xe3\xf9\xe3\xf9\xe3\xf9\xe3\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe6\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\x12\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa<\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa=\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa>\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa?\xfa\x85\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcb\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcc\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xcd\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xce\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\xe3\xff%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01b\x03\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9e\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9d\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9c\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9b\x05\x9a\x05\x9a\x05\xe5\x000\xfc0\xfc0\xfc0\xfc0\xfc0\xfc0\xfc0\xfc0\xfc0\xfc0\xfc0\xfc0\xfc0\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc1\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc2\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc3\xfc\xe3\xfc\x92\xfd\x92\xfd\x92\xfd\x92\xfd\x92\xfd\x92\xfd\x92\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x93\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x94\xfd\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00v\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00V\x00\xb8\x03\xb8\x03\xb8\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x013\xff3\xff3\xff3\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff\xa0\xff\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\x81\xff\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe%\x02%\x02%\x02%\x02%\x02%\x02%\x02%\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd$\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd6\xfa6\xfa6\xfa6\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa7\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa8\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa9\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa:\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa;\xfa<\xfa<\xfa\x14\xfd\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xec\xff\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa6\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa5\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa4\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa3\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa2\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05V\xfeV\xfeV\xfeV\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeW\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeX\xfeN\x03N\x03N\x03N\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03M\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03K\x03J\x03\xab\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xaa\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xa9\x01\xf4\x02@\x04@\x04@\x04@\x04@\x04@\x04@\x04@\x04@\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04?\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04>\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04=\x04<\x04<\x04<\x04<\x04<\x04<\x04<\x04<\x04<\x04<\x04<\x04<\x04<\x041\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x00F\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcG\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcH\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfcI\xfc\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8d\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00\x8c\x00k\x01K\x02K\x02K\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02J\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02I\x02H\x02H\x02H\x02H\x02H\x02H\x02y\xfdy\xfdy\xfdy\xfdy\xfdy\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfdz\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd{\xfd|\xfd|\xfd|\xfd|\xfd|\xfd|\xfd|\xfd|\xfd|\xfd|\xfd|\xfdR\xfc)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb*\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb+\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb,\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfb-\xfbf\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03b\x03\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xba\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbe\xff\xbf\x02\xbf\x02\xbf\x02\xbf\x02\xbf\x02\xbf\x02\xbf\x02\xbf\x02\xbf\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbe\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbd\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xbc\x02\xcf\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xce\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcc\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04\xca\x04t\x00\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc\x1f\xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc \xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc!\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc\"\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc#\xfc;\xfdR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeR\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfeT\xfe\xbe\x00*\x03*\x03*\x03*\x03*\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03)\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03'\x03&\x03&\x03&\x03I\x04I\x04I\x04I\x04I\x04I\x04I\x04I\x04I\x04I\x04I\x04I\x04I\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04H\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04G\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04F\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04E\x04\x1f\x02\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\x0f\x01$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02$\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02X\x02\x8e\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8c\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\x8b\x02\xf5\x02`\x03`\x03`\x03`\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03y\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03x\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03v\x03k\x00`\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfda\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdb\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfdc\xfd\x13\x00\xc4\x02\xc4\x02\xc4\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc3\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x029\x02\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\x1c\x03\x88\x04\x88\x04\x88\x04\x88\x04\x88\x04\x88\x04\x88\x04\x88\x04\x88\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x87\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x86\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x85\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x84\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x83\x04\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\x06\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfc\a\xfcz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfbz\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb{\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb|\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb}\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb~\xfb\u007f\xfb\u007f\xfb\u007f\xfb\u007f\xfb\u007f\xfb\u007f\xfb\u007f\xfb\u007f\xfb\u007f\xfbv\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02v\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02u\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02t\x02s\x02s\x02s\x02s\x02s\x02\xd6\x02\xd6\x02\xd6\x02\xd6\x02\xd6\x02\xd6\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd5\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd2\x02\xd8\x03\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xde\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdc\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xda\x04\xda\x04\xda\x04\xda\x04\xda\x04\xda\x04\xda\x04\xda\x04\xda\x04V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01T\x01.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xffT\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04T\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04S\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04R\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04Q\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04P\x04O\x04O\x04O\x04O\x04O\x04O\x04O\x04O\x04O\x04O\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04N\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04M\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04L\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04K\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04J\x04o\x04\x95\x04\x95\x04\x95\x04\x95\x04\x95\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x94\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x93\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x92\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x91\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x90\x04\x8f\x04\x8f\x04\x8f\x04\x9d\x00\xab\xfc\xab\xfc\xab\xfc\xab\xfc\xab\xfc\xab\xfc\xab\xfc\xab\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xac\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xad\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xae\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xaf\xfc\xa3\xff\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x94\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x9b\x00\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x8f\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x90\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x91\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x92\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfcg\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfch\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfci\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfcj\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfck\xfc\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd'\xfe'\xfe'\xfe'\xfe'\xfe'\xfe'\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe(\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe)\xfe*\xfe*\xfe*\xfe*\xfe*\xfe*\xfe*\xfe{\xff\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00T\x02T\x02T\x02T\x02T\x02T\x02T\x02T\x02T\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02#\x01\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9a\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xff\x9b\xffh\x007\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x015\x015\x015\x015\x015\x015\x013\x033\x033\x033\x033\x033\x033\x033\x033\x033\x033\x033\x033\x033\x033\x033\x033\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x032\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x031\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x030\x03/\x03/\x03/\x03/\x03/\x03/\x03/\x03/\x03/\x03/\x03/\x03W\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffW\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xffX\xff6\xfc6\xfc6\xfc6\xfc6\xfc6\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc7\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc8\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc9\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc:\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc;\xfc.\x00\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04!\x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04 \x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1f\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1e\x04\x1d\x04\x1d\x04\x1d\x04\x1d\x04\x1d\x04\x1d\x04\x1d\x04\x1d\x04\x1d\x04\x1d\x04\x1d\x04E\x04m\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04l\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04k\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04j\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04i\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04h\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04g\x04D\x01#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe$\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe&\xfe&\xfe&\xfe&\xfe&\xfe&\xfe&\xfe&\xfe&\xfe&\xfe\b\xfe\xe9\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xeb\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcb\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcc\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfcd\xfce\xfce\xfce\xfce\xfce\xfce\xfce\xfce\xfce\xfce\xfce\xfce\xfc\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xb0\xfdm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfcn\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfco\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcp\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcq\xfcr\xfcr\xfcr\xfcL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdL\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdM\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdN\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdO\xfdP\xfdP\xfdP\xfdP\xfdP\xfdP\xfdP\xfdP\xfd\xd6\xfc\\\xfc\\\xfc\\\xfc\\\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc]\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc^\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc_\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfc`\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfca\xfc\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\x96\x00\xda\xfe\x1c\xfd\x1c\xfd\x1c\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1d\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1e\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd\x1f\xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd \xfd!\xfd!\xfd\xe8\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb0\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb1\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb3\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb4\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfc\xb5\xfch\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00v\x00u\x00u\x00u\x00u\x00u\x00u\x00u\x00u\x00u\x00u\x00u\x00u\x007\x00\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xffh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfeh\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfei\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfej\xfe,\xfd\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xef\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf0\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf1\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf3\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf4\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf5\xfb\xf6\xff\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf5\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf2\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xf0\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01b\xff=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd=\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd>\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfdA\xfdA\xfdA\xfdA\xfdA\xfdA\xfdA\xfdA\xfdA\xfdA\xfdA\xfdA\xfdA\xfdM\xffW\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01W\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01V\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01U\x01\xb1\xfe\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\f\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\r\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0e\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x10\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x11\xfc\x12\xfc\x12\xfc\x12\xfc\x12\xfc\x12\xfc\x12\xfc\x12\xfc\x12\xfc\x12\xfc\xdb\x03\xdb\x03\xdb\x03\xdb\x03\xdb\x03\xdb\x03\xdb\x03\xdb\x03\xdb\x03\xdb\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xda\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd9\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd6\x03\xd5\x03\xd5\x03\xd5\x03\xd5\x03\xd5\x03\xd5\x03\xd5\x03\xd5\x03\xd5\x03\xd5\x03\xd5\x03K\x00\xc3\xfc\xc3\xfc\xc3\xfc\xc3\xfc\xc3\xfc\xc3\xfc\xc3\xfc\xc3\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc4\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc5\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc6\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc7\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc8\xfc\xc7\xfe\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xba\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb9\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb8\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb7\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb6\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb5\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xb4\x03\xa5\x03\x96\x03\x96\x03\x96\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x95\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x94\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x93\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x92\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\x90\x03\xb0\x01\xd1\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xf6\x00\x1c\x02\x1c\x02\x1c\x02\x1c\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x1a\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x11\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x12\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xfe\xe9\x00\xe9\x00\xe9\x00\xe9\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe8\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\xe7\x00\a\xfd\a\xfd\a\xfd\a\xfd\a\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\f\xfd\a\x03\a\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x04\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03L\x00\x97\xfd\x97\xfd\x97\xfd\x97\xfd\x97\xfd\x97\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x98\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x99\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9c\xfd\x9c\xfd \xff\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xdc\xfc\xdc\xfc\xdc\xfc\xdc\xfc\xdc\xfc\xdc\xfc\xdc\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xdd\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xde\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xdf\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe0\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xe2\xfc\xf5\xfc\b\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xfd-\xfeL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffL\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffM\xffE\x00?\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01>\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01=\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01<\x01_\xff\x81\xfd\x81\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x82\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x83\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x84\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x0e\xff\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x008\xff\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xdf\xfd\xdf\xfd\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01^\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01]\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01\\\x01[\x01[\x01[\x01[\x01[\x01[\x01[\x01[\x01`\x02d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03c\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03b\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03`\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03_\x03A\x00%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd%\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd&\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd'\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd(\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd)\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd*\xfd+\xfd+\xfd+\xfd+\xfd0\x010\x010\x010\x010\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01\xb4\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd7\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd6\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xed\xfc\xed\xfc\xed\xfc\xed\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xee\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xef\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf0\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfc\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xc7\xfe\xad\xfd\xad\xfd\xad\xfd\xad\xfd\xad\xfd\xad\xfd\xad\xfd\xad\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xae\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xaf\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb0\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfdr\xff2\x012\x012\x012\x012\x012\x012\x012\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x011\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x010\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff-\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff.\xff/\xff/\xff/\xff/\xff/\xff/\xff/\xffN\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffn\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffJ\x00&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9d\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\x9f\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xd4\xff\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00\xb9\x00R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x018\x00\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff\xbb\x00R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02R\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02Q\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02O\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02M\x02L\x02L\x02\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xdd\xff\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc2\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xc5\xfe\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa7\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa6\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa5\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa4\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xe5\x01'\x01'\x01'\x01'\x01'\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01~\xff\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfdN\x01N\x01N\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01M\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01L\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01K\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01J\x01\x94\x02\x94\x02\x94\x02\x94\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x92\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x91\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x90\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8f\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02\x8d\x02{\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01z\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01y\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01x\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01w\x01v\x01v\x01v\x01\x8a\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9d\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x9a\x01\x99\x01\x99\x01\x9c\xfd\x9c\xfd\x9c\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa2\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfd\xa3\xfdi\xff.\x01.\x01.\x01.\x01.\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01,\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01*\x01*\x01*\x01*\x01*\x01*\x01*\x01*\x01*\x01*\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x84\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x83\x01\x82\x01\x82\x01\x82\x01L\xfeL\xfeL\xfeL\xfeL\xfeL\xfeL\xfeL\xfeL\xfeL\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeM\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeN\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeO\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeP\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfeQ\xfe\xe3\xffs\x01s\x01s\x01s\x01s\x01s\x01s\x01s\x01s\x01s\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01r\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01q\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01\xc3\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00\x17\x00l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01\xb1\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf6\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xc8\xff\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9a\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9b\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9c\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9d\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9e\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\x9f\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa0\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xa1\xfd\xc8\xff\xee\x01\xee\x01\xee\x01\xee\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xed\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xec\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xeb\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe7\x01\xe7\x01\xac\x01p\x01p\x01p\x01p\x01p\x01p\x01p\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01n\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01m\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01\xe6\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00`\x00_\x00_\x00_\x00_\x00_\x00_\x00_\x00_\x00_\x00_\x00_\x00_\x00_\x00_\x00\xe6\xffk\xffk\xffk\xffk\xffk\xffk\xffk\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xff\xa3\xfe\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdb\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xdf\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x01\xf5\xfd\xf5\xfd\xf5\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfa\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfd\xfd\xfd\xfd\xfd\xfd\xfd\xfd\xda\xff\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb5\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb4\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb2\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb1\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xb0\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01\xaf\x01c\x00\x17\xff\x17\xff\x17\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x19\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xaf\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb2\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb3\xfe\xb4\xfe\xb4\xfe\xb4\xfe*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8b\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01\x87\x01>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xff\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00S\x01S\x01S\x01S\x01S\x01S\x01S\x01S\x01S\x01S\x01S\x01S\x01S\x01S\x01S\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01R\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01Q\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01P\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01O\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01N\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x17\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01^\x00\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xab\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x13\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\xa7\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00=\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00<\x00\x1a\x00\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff3\xff3\xff3\xff3\xff3\xff3\xff3\xff3\xff3\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff4\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff5\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff6\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xff7\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xff\xa0\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xffI\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xba\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb9\x00\xb8\x00\xb8\x00\xb8\x00\xb8\x00\xb8\x00\xb8\x00\xb8\x00\xfe\xfe\xfe\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xffm\x01m\x01m\x01m\x01m\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01k\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01j\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01i\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01h\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01g\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01e\x01e\x01e\x01e\x01e\x01e\x01\v\x01\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb1\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xb0\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xaf\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xae\x00\xad\x00\xad\x00\xad\x00S\x00\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe0\xff\xe1\xff\xe1\xff\xe1\xff\xe1\xff\xe1\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffq\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xfft\xfft\xfft\xfft\xff\r\xff\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa8\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xac\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfef\xff\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x00\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x03\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\x04\xff\\\xff\xb3\xff\xb3\xff\xb3\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb6\xff\xb6\xff\xf2\xfe\xf2\xfe\xf2\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf4\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf8\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xfa\xfe\xfa\xfe\xfa\xfe\xfa\xfe\xfa\xfe\xfa\xfe\x1a\x00;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01;\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x01:\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x019\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x018\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x017\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x016\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x014\x014\x014\x014\x014\x014\x014\x014\x014\x014\x014\x014\x014\x014\x014\x013\x013\x013\x013\x013\x013\x013\x013\x013\x013\x013\x013\x013\x013\x013\x013\x012\x01|\x00\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc7\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xff\xc9\xffx\x00'\x01'\x01'\x01'\x01'\x01'\x01'\x01'\x01'\x01'\x01'\x01'\x01'\x01'\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01&\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01%\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01$\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01#\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01\"\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01!\x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01 \x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1f\x01\x1e\x01\x1e\x01\x1e\x01\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\xcd\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\xeb\xff\x93\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff<\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff=\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff>\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff?\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xff@\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xffA\xff\x80\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbe\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xbf\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc0\xff\xc1\xff\xc1\xff\xc1\xff\x14\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00h\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00g\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00f\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00d\x00d\x00\xf5\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x84\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x85\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x87\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x88\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\x89\xff\xd3\xff\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00'\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x00\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xab\xff\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbd\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbc\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xbb\x00\xba\x00\xba\x00\xba\x00\xba\x00b\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffc\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffd\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xfff\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffj\xffj\xffj\xffj\xffj\xffj\xffj\xffj\xffj\xff\xa1\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd7\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd8\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xd9\xff\xda\xff\xda\xff\xda\xff\xda\xff\xda\xff\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x006\x006\x006\x006\x006\x006\x006\x006\x006\x006\x006\x006\x006\x006\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x005\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x004\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x003\x00\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8c\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8d\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8e\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x90\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x91\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x92\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\x93\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa6\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa7\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa8\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xa9\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xaa\xff\xab\xff\xab\xff\xab\xff\xab\xff\xad\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xd5\xff\xf4\xff\xf4\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xd1\xff\xac\xff\xac\xff\xac\xff\xac\xff\xac\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xad\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xae\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xaf\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb0\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb1\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb2\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb3\xff\xb4\xff\xb4\xff\xb4\xff\xb4\xff\xf3\xff2\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x002\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x001\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x000\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00/\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00-\x00-\x00-\x00-\x00-\x00-\x00-\x00-\x00-\x00\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\r\x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00 \x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1e\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00-\x00-\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00,\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00+\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00)\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00(\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00'\x00&\x00&\x00&\x00&\x00&\x00&\x00&\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\r\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x04\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\xfb\xff\xf0\xff\xf0\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf5\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf6\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf7\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf8\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xf9\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfa\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00")
@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package sfx
-
-var Sneeze_wav = []byte("RIFFl\xbb\x03\x00WAVEfmt \x10\x00\x00\x00\x01\x00\x02\x00D\xac\x00\x00\x10\xb1\x02\x00\x04\x00\x10\x00dataܺ\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x00\x00\x02\x00\x00\x00\xfc\xff\x01\x00\a\x00\xff\xff\xf7\xff\x00\x00\t\x00\x01\x00\xf9\xff\xfe\xff\x02\x00\x03\x00\x02\x00\xfe\xff\xfd\xff\x00\x00\x03\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\xff\xff\x01\x00\x01\x00\xfc\xff\xff\xff\x05\x00\x00\x00\xfb\xff\x03\x00\x04\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xfe\xff\x02\x00\x01\x00\xfd\xff\x00\x00\x03\x00\x00\x00\xfd\xff\x01\x00\x02\x00\xfe\xff\x00\x00\x02\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xfc\xff\xff\xff\x03\x00\x01\x00\xfe\xff\xfe\xff\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\xfe\xff\xff\xff\x03\x00\x03\x00\xfd\xff\xfa\xff\x01\x00\b\x00\x01\x00\xf7\xff\xfc\xff\t\x00\x06\x00\xf8\xff\xfa\xff\x05\x00\x05\x00\xff\xff\xfd\xff\xff\xff\x00\x00\x01\x00\x02\x00\xff\xff\xfd\xff\x02\x00\x03\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\x00\x00\xfd\xff\xfe\xff\x04\x00\x05\x00\xfb\xff\xfa\xff\x06\x00\x05\x00\xfb\xff\xfd\xff\x04\x00\x02\x00\xfd\xff\xfe\xff\x01\x00\x01\x00\x01\x00\x00\x00\xff\xff\x00\x00\x02\x00\x00\x00\xfd\xff\x00\x00\x02\x00\x01\x00\xff\xff\xfe\xff\x00\x00\x01\x00\x02\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x02\x00\xfc\xff\xfe\xff\x03\x00\x01\x00\xff\xff\x00\x00\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x02\x00\xff\xff\xff\xff\x01\x00\xff\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\x05\x00\x01\x00\xfb\xff\xfd\xff\x04\x00\x04\x00\xfe\xff\xfd\xff\xff\xff\x02\x00\x04\x00\xfe\xff\xfc\xff\x03\x00\x02\x00\xfc\xff\xff\xff\x04\x00\x01\x00\xfc\xff\x00\x00\x03\x00\xff\xff\xff\xff\x02\x00\x00\x00\xfd\xff\x00\x00\x02\x00\xff\xff\x00\x00\x02\x00\xff\xff\xfd\xff\x02\x00\x05\x00\xfe\xff\xfa\xff\x01\x00\x05\x00\xff\xff\xfe\xff\x01\x00\xff\xff\x01\x00\x03\x00\xfd\xff\xfd\xff\x04\x00\x04\x00\xfb\xff\xfc\xff\x05\x00\x03\x00\xfd\xff\xfd\xff\x01\x00\x03\x00\x00\x00\xfd\xff\xff\xff\x04\x00\x01\x00\xfc\xff\xff\xff\x03\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\x00\x00\xff\xff\xff\xff\x00\x00\x02\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xff\xff\xfd\xff\x00\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x01\x00\x01\x00\xfe\xff\x01\x00\x01\x00\xfd\xff\x00\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xff\xff\x00\x00\xff\xff\x00\x00\x01\x00\xff\xff\x00\x00\x02\x00\xff\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\x01\x00\x03\x00\xfc\xff\xfe\xff\x06\x00\x02\x00\xfb\xff\x00\x00\x03\x00\xff\xff\xff\xff\x00\x00\x00\x00\x01\x00\xff\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xff\xff\x02\x00\xff\xff\x00\x00\x01\x00\xfe\xff\x00\x00\x02\x00\xfd\xff\x01\x00\x05\x00\xfe\xff\xfc\xff\x00\x00\x02\x00\x02\x00\xff\xff\xfd\xff\x00\x00\x04\x00\x00\x00\xfd\xff\x01\x00\x00\x00\x00\x00\x02\x00\xfd\xff\xfd\xff\x06\x00\x03\x00\xf9\xff\xfd\xff\x06\x00\x03\x00\xfe\xff\xfd\xff\xfe\xff\x03\x00\x05\x00\xfd\xff\xfa\xff\x01\x00\x05\x00\x02\x00\xfd\xff\xfd\xff\x01\x00\x04\x00\x01\x00\xfd\xff\x00\x00\x00\x00\xfe\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x02\x00\xfe\xff\xfd\xff\x02\x00\x03\x00\xff\xff\xfd\xff\x00\x00\x03\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xff\xff\x01\x00\x01\x00\xfe\xff\x01\x00\x03\x00\xfc\xff\xfb\xff\x06\x00\x06\x00\xfa\xff\xfa\xff\x05\x00\a\x00\xfd\xff\xfa\xff\x01\x00\x04\x00\x00\x00\xfc\xff\xff\xff\x04\x00\x03\x00\xfd\xff\xfc\xff\x03\x00\x02\x00\xfd\xff\x01\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x00\x00\xfd\xff\x02\x00\x00\x00\xfe\xff\x03\x00\x00\x00\xfd\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x05\x00\x01\x00\xfc\xff\x02\x00\x03\x00\xfd\xff\xfd\xff\x04\x00\x03\x00\xfc\xff\xfe\xff\x02\x00\x02\x00\x00\x00\xfd\xff\xff\xff\x02\x00\x03\x00\xfe\xff\xfb\xff\x04\x00\x05\x00\xfd\xff\xfc\xff\x00\x00\x04\x00\x01\x00\xfd\xff\xfe\xff\x00\x00\x02\x00\x02\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xfe\xff\xff\xff\x03\x00\xfe\xff\xfd\xff\x04\x00\x02\x00\xfc\xff\xff\xff\x03\x00\x00\x00\xff\xff\x00\x00\xff\xff\x01\x00\x01\x00\x00\x00\x00\x00\xff\xff\xfe\xff\x01\x00\x05\x00\xfe\xff\xf9\xff\x03\x00\x06\x00\xfe\xff\xfd\xff\x00\x00\x01\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x01\x00\x01\x00\xfd\xff\x00\x00\x03\x00\xfe\xff\xff\xff\x02\x00\xff\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x04\x00\xfe\xff\xfd\xff\x01\x00\x02\x00\x00\x00\x00\x00\x01\x00\xff\xff\xfd\xff\x01\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xff\xff\x00\x00\x01\x00\xff\xff\x00\x00\x01\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x00\x00\xff\xff\x00\x00\x02\x00\x01\x00\xfc\xff\xfe\xff\x05\x00\x03\x00\xfb\xff\xfc\xff\x04\x00\x05\x00\xfd\xff\xfc\xff\x02\x00\x02\x00\xff\xff\xff\xff\x01\x00\x00\x00\xff\xff\x02\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xff\xff\xff\xff\x01\x00\x00\x00\xff\xff\x00\x00\x00\x00\x02\x00\x02\x00\xfc\xff\xfd\xff\x05\x00\x03\x00\xfb\xff\xfd\xff\x03\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x00\x00\xff\xff\x00\x00\x01\x00\x00\x00\xfd\xff\x01\x00\x03\x00\xfe\xff\xfd\xff\x03\x00\x02\x00\xfd\xff\x00\x00\x01\x00\xff\xff\x00\x00\x00\x00\x01\x00\x01\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x01\x00\x01\x00\xff\xff\x00\x00\x00\x00\xff\xff\x00\x00\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\xff\xff\xff\xff\x00\x00\x01\x00\xff\xff\xff\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x02\x00\xfe\xff\xfd\xff\x02\x00\x03\x00\x00\x00\xfe\xff\xfe\xff\x00\x00\x02\x00\x02\x00\xfe\xff\xfc\xff\x00\x00\x05\x00\x02\x00\xfb\xff\xfd\xff\x04\x00\x03\x00\xfd\xff\xfc\xff\x01\x00\x03\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x01\x00\xfd\xff\x00\x00\x04\x00\xfe\xff\xfb\xff\x02\x00\x04\x00\xff\xff\xfd\xff\xff\xff\x01\x00\x03\x00\x00\x00\xfb\xff\x01\x00\x05\x00\xff\xff\xfc\xff\xff\xff\x02\x00\x00\x00\x00\x00\x01\x00\xfe\xff\xfe\xff\x02\x00\x04\x00\xff\xff\xfb\xff\xff\xff\x02\x00\x01\x00\x00\x00\xff\xff\xff\xff\x00\x00\x02\x00\x01\x00\xfc\xff\x00\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xfd\xff\xff\xff\x04\x00\xfe\xff\xfd\xff\x03\x00\x01\x00\xfd\xff\x00\x00\x02\x00\xfe\xff\xff\xff\x02\x00\x00\x00\xff\xff\xff\xff\x00\x00\x02\x00\xff\xff\xfd\xff\x01\x00\x03\x00\xff\xff\xfd\xff\x01\x00\x02\x00\xfe\xff\xff\xff\x03\x00\x00\x00\xfb\xff\xff\xff\x06\x00\x01\x00\xfb\xff\x00\x00\x02\x00\x00\x00\x00\x00\xff\xff\xfe\xff\x00\x00\x02\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x02\x00\xfc\xff\xfc\xff\x03\x00\x04\x00\xff\xff\xfb\xff\xfe\xff\x06\x00\x04\x00\xfa\xff\xfa\xff\x06\x00\x06\x00\xf9\xff\xfa\xff\x05\x00\x06\x00\xfd\xff\xfa\xff\x02\x00\x05\x00\xff\xff\xfb\xff\x00\x00\x05\x00\xff\xff\xfc\xff\x01\x00\x01\x00\x00\x00\x02\x00\xfe\xff\xfc\xff\x02\x00\x04\x00\xfe\xff\xfd\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x00\x00\xff\xff\xff\xff\x01\x00\x01\x00\xff\xff\xff\xff\x01\x00\x00\x00\xfe\xff\xff\xff\x03\x00\x02\x00\xfd\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x03\x00\x01\x00\xfd\xff\xff\xff\x01\x00\xff\xff\x01\x00\x03\x00\xfe\xff\xfb\xff\x02\x00\x04\x00\xff\xff\xfe\xff\x00\x00\x01\x00\x01\x00\x00\x00\xff\xff\xfe\xff\x00\x00\x02\x00\xff\xff\xff\xff\x02\x00\x02\x00\xfd\xff\xfc\xff\x02\x00\x03\x00\xff\xff\xfe\xff\x00\x00\x01\x00\x01\x00\xff\xff\xfe\xff\x00\x00\x00\x00\xff\xff\x01\x00\x04\x00\x00\x00\xf9\xff\xff\xff\a\x00\x01\x00\xf8\xff\xfd\xff\b\x00\x04\x00\xfa\xff\xfd\xff\x03\x00\x01\x00\x00\x00\x01\x00\xfe\xff\xfd\xff\x02\x00\x04\x00\xff\xff\xfd\xff\xff\xff\xff\xff\x03\x00\x04\x00\xfc\xff\xfc\xff\x04\x00\x02\x00\xfc\xff\x00\x00\x03\x00\xfe\xff\xfe\xff\x04\x00\xff\xff\xfb\xff\x04\x00\x04\x00\xfb\xff\xfd\xff\x04\x00\x02\x00\xfc\xff\x00\x00\x03\x00\xff\xff\xff\xff\x00\x00\xff\xff\x01\x00\x02\x00\xff\xff\xfc\xff\x01\x00\x05\x00\x00\x00\xfc\xff\xff\xff\x03\x00\x02\x00\xfe\xff\xfc\xff\x01\x00\x06\x00\x00\x00\xfb\xff\x00\x00\x05\x00\x00\x00\xfc\xff\xff\xff\x01\x00\x01\x00\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\xfe\xff\x01\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xff\xff\xff\xff\x01\x00\x00\x00\xff\xff\x02\x00\x01\x00\xfd\xff\x00\x00\x05\x00\x01\x00\xfb\xff\x00\x00\x03\x00\xfe\xff\x00\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xff\xff\x00\x00\x00\x00\x02\x00\x01\x00\x00\x00\x00\x00\xfd\xff\x00\x00\x05\x00\x00\x00\xf9\xff\x00\x00\a\x00\x02\x00\xfc\xff\xff\xff\x04\x00\x01\x00\xfb\xff\x00\x00\x06\x00\x01\x00\xfa\xff\xff\xff\x06\x00\x03\x00\xfd\xff\xfd\xff\x03\x00\x04\x00\xfd\xff\xfc\xff\x03\x00\x05\x00\xfe\xff\xfc\xff\x04\x00\x03\x00\xfc\xff\xff\xff\x03\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x04\x00\xff\xff\xfc\xff\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\xff\xff\x01\x00\x00\x00\x00\x00\x02\x00\x01\x00\xfe\xff\xfe\xff\x05\x00\x02\x00\xfb\xff\x00\x00\x04\x00\x00\x00\xfd\xff\x02\x00\x03\x00\xfd\xff\x00\x00\x03\x00\x00\x00\xff\xff\x00\x00\x01\x00\x01\x00\x00\x00\xff\xff\xff\xff\x00\x00\x03\x00\x03\x00\xfd\xff\xfe\xff\x02\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x00\x00\xfe\xff\x03\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\x03\x00\x00\x00\xff\xff\x01\x00\x01\x00\x00\x00\xfe\xff\x01\x00\x02\x00\xff\xff\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x00\xfe\xff\x00\x00\x04\x00\x00\x00\xfb\xff\x00\x00\b\x00\x02\x00\xf8\xff\xfe\xff\x06\x00\x03\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\x00\x00\xff\xff\xff\xff\x02\x00\x02\x00\xfe\xff\x00\x00\x04\x00\x00\x00\xfc\xff\x01\x00\x06\x00\xff\xff\xf8\xff\x03\x00\v\x00\xfe\xff\xf5\xff\x00\x00\v\x00\x02\x00\xf8\xff\x00\x00\x06\x00\x00\x00\xfd\xff\x02\x00\x03\x00\xfc\xff\xff\xff\x04\x00\x00\x00\xfe\xff\x02\x00\x00\x00\xfd\xff\x02\x00\x04\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x01\x00\xfe\xff\xff\xff\x05\x00\x01\x00\xfa\xff\x01\x00\a\x00\xff\xff\xfb\xff\x03\x00\x03\x00\xfb\xff\xff\xff\x06\x00\x02\x00\xfc\xff\xfd\xff\x04\x00\x06\x00\xfd\xff\xf9\xff\x02\x00\x06\x00\xff\xff\xfe\xff\x01\x00\x00\x00\x00\x00\x03\x00\x01\x00\xfc\xff\xff\xff\x04\x00\x00\x00\xfd\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\x04\x00\x04\x00\xfd\xff\xfd\xff\x02\x00\x03\x00\x01\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x03\x00\x04\x00\xfd\xff\xfd\xff\x03\x00\x01\x00\xfd\xff\x00\x00\x03\x00\xff\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\x01\x00\x03\x00\xfd\xff\xfc\xff\x04\x00\x05\x00\xfd\xff\xfc\xff\x01\x00\x04\x00\x02\x00\xfc\xff\xfb\xff\x03\x00\x06\x00\x00\x00\xfb\xff\xfe\xff\x05\x00\x05\x00\xfd\xff\xfa\xff\x02\x00\x06\x00\xfd\xff\xfc\xff\x04\x00\x02\x00\xfc\xff\x00\x00\x03\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x03\x00\xfd\xff\xfd\xff\x02\x00\x02\x00\xff\xff\x00\x00\xfe\xff\xfd\xff\x05\x00\x04\x00\xf9\xff\xfb\xff\a\x00\x05\x00\xf9\xff\xfc\xff\x05\x00\x02\x00\xff\xff\xff\xff\xfe\xff\xff\xff\x04\x00\x01\x00\xfa\xff\x00\x00\x05\x00\xfe\xff\xfe\xff\x01\x00\xff\xff\x00\x00\x02\x00\xff\xff\xfc\xff\x00\x00\x04\x00\x00\x00\xfc\xff\xfe\xff\x02\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x01\x00\xfe\xff\xff\xff\x01\x00\xfe\xff\xfe\xff\x02\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xfe\xff\xff\xff\x02\x00\xfe\xff\xff\xff\x02\x00\xff\xff\xfd\xff\x02\x00\x03\x00\xfd\xff\xfc\xff\x02\x00\x01\x00\xff\xff\xff\xff\xfe\xff\xff\xff\x01\x00\x02\x00\xff\xff\xfb\xff\x00\x00\x05\x00\x00\x00\xf9\xff\xff\xff\x06\x00\xff\xff\xfa\xff\xff\xff\x04\x00\x04\x00\xfd\xff\xfa\xff\x00\x00\x05\x00\x00\x00\xfb\xff\xfe\xff\x04\x00\x01\x00\xfc\xff\x00\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x00\x00\xfc\xff\x02\x00\x04\x00\xfc\xff\xfa\xff\x03\x00\x06\x00\xfe\xff\xf9\xff\x01\x00\x05\x00\xfd\xff\xfb\xff\x03\x00\x02\x00\xfc\xff\xff\xff\x03\x00\xff\xff\xff\xff\x00\x00\xff\xff\xff\xff\x00\x00\xff\xff\x00\x00\x00\x00\xff\xff\x00\x00\x00\x00\xfe\xff\x00\x00\x01\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x02\x00\xfe\xff\xfc\xff\x00\x00\x05\x00\x00\x00\xfb\xff\xff\xff\x03\x00\xff\xff\xfe\xff\x01\x00\xff\xff\xfd\xff\x04\x00\x02\x00\xf8\xff\xfd\xff\b\x00\x01\x00\xfa\xff\x00\x00\x02\x00\xfe\xff\x00\x00\x02\x00\xfe\xff\xfb\xff\x01\x00\x04\x00\x01\x00\xfc\xff\xfd\xff\x03\x00\x02\x00\xfc\xff\x00\x00\x04\x00\xfe\xff\xfa\xff\x03\x00\x03\x00\xfc\xff\xfe\xff\x04\x00\xff\xff\xfc\xff\x02\x00\x04\x00\xfc\xff\xfa\xff\x00\x00\x04\x00\x01\x00\xfe\xff\xfd\xff\x00\x00\x03\x00\x02\x00\xfc\xff\xfc\xff\x01\x00\x02\x00\x00\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xff\xff\xfc\xff\xff\xff\x02\x00\xff\xff\xff\xff\x02\x00\xff\xff\xfd\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xff\xff\xff\xff\xff\xff\x00\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\xff\xff\xfe\xff\xff\xff\x02\x00\x01\x00\xfd\xff\xfe\xff\x03\x00\xff\xff\xfd\xff\x02\x00\x01\x00\xfd\xff\xff\xff\x01\x00\xff\xff\xff\xff\x01\x00\xff\xff\xfe\xff\x00\x00\x03\x00\x00\x00\xfb\xff\xfe\xff\x05\x00\x03\x00\xfa\xff\xfd\xff\x06\x00\x02\x00\xfb\xff\xfd\xff\x03\x00\x00\x00\xfc\xff\x02\x00\x04\x00\xfc\xff\xfb\xff\x05\x00\x04\x00\xfb\xff\xfc\xff\x03\x00\x03\x00\xfe\xff\xfd\xff\xff\xff\x03\x00\x02\x00\xfb\xff\xfd\xff\x05\x00\x05\x00\xfc\xff\xfb\xff\x01\x00\x01\x00\x02\x00\x00\x00\xfa\xff\xff\xff\a\x00\x03\x00\xfa\xff\xfe\xff\x04\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x03\x00\xfd\xff\xfc\xff\x02\x00\x04\x00\x00\x00\xfe\xff\xfd\xff\xff\xff\x05\x00\x03\x00\xfb\xff\xfc\xff\x03\x00\x05\x00\xff\xff\xfa\xff\x00\x00\b\x00\x01\x00\xf8\xff\xfd\xff\a\x00\x04\x00\xfc\xff\xfe\xff\x02\x00\x00\x00\x00\x00\x02\x00\x00\x00\xfd\xff\x00\x00\x03\x00\x01\x00\xff\xff\xff\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\x04\x00\x00\x00\xfd\xff\x00\x00\x03\x00\x03\x00\xff\xff\xfd\xff\x01\x00\x02\x00\x01\x00\xfe\xff\xff\xff\x04\x00\x02\x00\xfc\xff\xff\xff\x04\x00\x02\x00\xfd\xff\x00\x00\x02\x00\xff\xff\xff\xff\x04\x00\x02\x00\xfc\xff\xff\xff\x05\x00\x00\x00\xfe\xff\x03\x00\x01\x00\xfb\xff\x03\x00\x05\x00\xfe\xff\xff\xff\x02\x00\xfe\xff\x00\x00\x06\x00\x01\x00\xf9\xff\x01\x00\a\x00\x00\x00\xfc\xff\x02\x00\x03\x00\xff\xff\xff\xff\x02\x00\x00\x00\x02\x00\x02\x00\xfd\xff\xfe\xff\x05\x00\x02\x00\xfc\xff\xfe\xff\x05\x00\x03\x00\xff\xff\xff\xff\x01\x00\x01\x00\x01\x00\xff\xff\xff\xff\x01\x00\x04\x00\x01\x00\xfe\xff\x00\x00\x03\x00\x00\x00\x00\x00\x01\x00\xff\xff\x00\x00\x03\x00\xff\xff\x00\x00\x03\x00\x00\x00\xfd\xff\x03\x00\x04\x00\xff\xff\xfc\xff\x01\x00\x04\x00\x03\x00\xff\xff\xfe\xff\x00\x00\x05\x00\x02\x00\xfc\xff\xfd\xff\x05\x00\x04\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\x00\x00\xff\xff\x00\x00\x02\x00\x02\x00\xfe\xff\x00\x00\x03\x00\x02\x00\xff\xff\x00\x00\x00\x00\x02\x00\x02\x00\x00\x00\xfe\xff\x00\x00\x02\x00\x03\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\x01\x00\xff\xff\x00\x00\x04\x00\x03\x00\xfd\xff\xff\xff\x03\x00\x02\x00\xfe\xff\x02\x00\x02\x00\xfe\xff\x02\x00\x05\x00\xfc\xff\xff\xff\x05\x00\x01\x00\xfe\xff\x01\x00\x03\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x06\x00\x00\x00\xfc\xff\x03\x00\x04\x00\xfd\xff\xff\xff\x03\x00\x03\x00\xfd\xff\xff\xff\x05\x00\x04\x00\xfc\xff\xff\xff\x04\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x03\x00\x02\x00\xff\xff\xff\xff\x04\x00\x01\x00\x00\x00\x01\x00\x01\x00\xff\xff\x00\x00\x02\x00\x04\x00\x01\x00\xfd\xff\x00\x00\a\x00\x01\x00\xfc\xff\x01\x00\x04\x00\xff\xff\xff\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x03\x00\x05\x00\xfe\xff\xfd\xff\x01\x00\x05\x00\x03\x00\x00\x00\xfc\xff\x00\x00\x06\x00\x02\x00\xfb\xff\x01\x00\a\x00\x00\x00\xfb\xff\x04\x00\x05\x00\xfd\xff\xfd\xff\x04\x00\x02\x00\xfe\xff\xff\xff\x02\x00\x02\x00\x03\x00\x01\x00\xfe\xff\x00\x00\x04\x00\x00\x00\xfe\xff\x01\x00\x03\x00\x01\x00\xff\xff\x01\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x01\x00\xff\xff\x01\x00\x01\x00\x01\x00\x02\x00\x00\x00\xfd\xff\x02\x00\x05\x00\xff\xff\xfc\xff\x02\x00\x03\x00\x01\x00\xfe\xff\x00\x00\x04\x00\x01\x00\xfc\xff\x01\x00\x05\x00\xfe\xff\xfd\xff\x04\x00\x01\x00\xfd\xff\x02\x00\x05\x00\xfe\xff\xfc\xff\x02\x00\x02\x00\xff\xff\x02\x00\x01\x00\xfd\xff\x00\x00\x05\x00\xff\xff\xfb\xff\x01\x00\x04\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x02\x00\xff\xff\xfe\xff\x03\x00\x02\x00\xfc\xff\xff\xff\x06\x00\xfe\xff\xfb\xff\x05\x00\x05\x00\xfa\xff\xfc\xff\t\x00\x04\x00\xf7\xff\xfd\xff\a\x00\x03\x00\xfc\xff\xfe\xff\x02\x00\x01\x00\x00\x00\x00\x00\xff\xff\x01\x00\x01\x00\xfe\xff\xff\xff\x04\x00\x02\x00\xfa\xff\xff\xff\b\x00\x01\x00\xf8\xff\x00\x00\a\x00\xfe\xff\xfb\xff\x02\x00\x02\x00\x00\x00\x01\x00\x01\x00\xfd\xff\xff\xff\x04\x00\x00\x00\xfc\xff\x00\x00\x02\x00\x01\x00\x01\x00\xff\xff\xfd\xff\x02\x00\x03\x00\xfc\xff\xfe\xff\x06\x00\x01\x00\xfb\xff\x00\x00\x03\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x01\x00\xfe\xff\xfd\xff\x03\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfc\xff\x01\x00\x04\x00\xfb\xff\xfb\xff\x06\x00\x05\x00\xf9\xff\xfc\xff\a\x00\x04\x00\xf8\xff\xfc\xff\x05\x00\x04\x00\xfd\xff\xfc\xff\x01\x00\x04\x00\xff\xff\xfc\xff\xff\xff\x02\x00\x02\x00\xff\xff\xfc\xff\x00\x00\x03\x00\x01\x00\xfb\xff\xfd\xff\x04\x00\x02\x00\xfa\xff\xfe\xff\x04\x00\x01\x00\xfc\xff\x00\x00\xff\xff\xfe\xff\x03\x00\x02\x00\xfa\xff\xfd\xff\x03\x00\x02\x00\xfd\xff\xff\xff\x00\x00\x01\x00\xff\xff\xff\xff\xfe\xff\xfe\xff\x01\x00\x03\x00\xfc\xff\xfb\xff\x01\x00\x05\x00\xfd\xff\xfa\xff\x01\x00\x04\x00\xfe\xff\xfd\xff\x00\x00\x00\x00\xfc\xff\x00\x00\x03\x00\x00\x00\xfb\xff\xfe\xff\x03\x00\x02\x00\xfa\xff\xfb\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\x00\x00\x02\x00\xfd\xff\xfe\xff\x00\x00\x01\x00\xfe\xff\xff\xff\xfe\xff\x00\x00\x01\x00\xff\xff\xfa\xff\xff\xff\x02\x00\x01\x00\xfb\xff\xfd\xff\x01\x00\x00\x00\xfc\xff\x00\x00\x00\x00\xfd\xff\xfd\xff\x02\x00\x00\x00\xfe\xff\xfd\xff\xfe\xff\xff\xff\x02\x00\xfe\xff\xfd\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xf8\xff\xfc\xff\x06\x00\x03\x00\xf7\xff\xfe\xff\x03\x00\x00\x00\xfb\xff\xff\xff\xff\xff\xfe\xff\xff\xff\x01\x00\xfd\xff\xfe\xff\xfe\xff\xff\xff\xff\xff\xff\xff\xfc\xff\xfe\xff\xff\xff\x00\x00\xfe\xff\xfe\xff\xfd\xff\x00\x00\xff\xff\xff\xff\xfd\xff\xff\xff\xff\xff\xff\xff\xfd\xff\xff\xff\x00\x00\xff\xff\xfb\xff\x00\x00\x00\x00\xff\xff\xfd\xff\xfe\xff\xff\xff\x01\x00\xfc\xff\xfc\xff\xff\xff\x05\x00\xfd\xff\xf9\xff\xff\xff\x05\x00\xfe\xff\xf8\xff\xfc\xff\x06\x00\x00\x00\xf7\xff\xfb\xff\a\x00\x00\x00\xf7\xff\xfe\xff\x04\x00\xfb\xff\xfd\xff\x02\x00\xfe\xff\xf9\xff\x02\x00\x04\x00\xfb\xff\xf9\xff\x03\x00\x03\x00\xfd\xff\xf9\xff\x00\x00\x00\x00\xff\xff\xfe\xff\xff\xff\xfe\xff\x00\x00\xff\xff\xfe\xff\xfc\xff\x00\x00\xff\xff\xff\xff\xfe\xff\xff\xff\xfd\xff\xff\xff\x01\x00\xff\xff\xfa\xff\xfd\xff\x02\x00\x03\x00\xfb\xff\xfc\xff\x01\x00\x00\x00\xfb\xff\xff\xff\x01\x00\xfd\xff\xfd\xff\x02\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\xfd\xff\xfd\xff\x00\x00\x00\x00\xfe\xff\xff\xff\xfd\xff\xff\xff\x00\x00\x01\x00\xfc\xff\xfd\xff\x00\x00\x02\x00\x00\x00\xfb\xff\xfa\xff\x03\x00\x04\x00\xfd\xff\xfa\xff\x00\x00\x00\x00\x01\x00\x00\x00\xfb\xff\xfb\xff\x02\x00\x03\x00\xfe\xff\xfa\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\x03\x00\x00\x00\xfc\xff\xfa\xff\x00\x00\x06\x00\x03\x00\xf8\xff\xfa\xff\x04\x00\x05\x00\xfc\xff\xfb\xff\x00\x00\x02\x00\xfd\xff\xff\xff\x00\x00\xff\xff\xff\xff\xfe\xff\xfe\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x03\x00\xfe\xff\xfd\xff\x01\x00\x00\x00\xfd\xff\xfe\xff\xff\xff\x00\x00\x01\x00\xff\xff\xfb\xff\xff\xff\x04\x00\x00\x00\xfb\xff\x01\x00\x03\x00\xfd\xff\xfb\xff\x03\x00\x04\x00\xfa\xff\xfb\xff\x05\x00\x02\x00\xfd\xff\x00\x00\x02\x00\xfc\xff\xfd\xff\x03\x00\x00\x00\xfb\xff\x00\x00\x04\x00\xff\xff\xfd\xff\x01\x00\x00\x00\xfd\xff\x00\x00\x02\x00\xfd\xff\xfc\xff\x03\x00\x03\x00\xfe\xff\xfb\xff\xff\xff\x05\x00\x02\x00\xfb\xff\xfd\xff\x04\x00\x03\x00\xfa\xff\xfc\xff\x04\x00\x03\x00\xfd\xff\xfe\xff\x03\x00\x00\x00\xfe\xff\x01\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x00\x00\xfe\xff\x01\x00\x00\x00\x00\x00\x01\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\xfc\xff\xfe\xff\x04\x00\x01\x00\xfd\xff\x00\x00\x01\x00\x00\x00\xfe\xff\x01\x00\x04\x00\xff\xff\xfb\xff\x02\x00\x06\x00\xff\xff\xfa\xff\x00\x00\x05\x00\x02\x00\xfa\xff\xfc\xff\a\x00\a\x00\xfb\xff\xfb\xff\x03\x00\x04\x00\x01\x00\xfe\xff\xfc\xff\x01\x00\x05\x00\x01\x00\xfe\xff\x01\x00\xfe\xff\x01\x00\x05\x00\x00\x00\xfb\xff\x01\x00\x05\x00\xff\xff\xfc\xff\x02\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\xff\xff\x01\x00\x02\x00\x01\x00\xfd\xff\x00\x00\x04\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x01\x00\xff\xff\x03\x00\x03\x00\x00\x00\xff\xff\x00\x00\x02\x00\x04\x00\xff\xff\xfe\xff\x01\x00\x05\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x05\x00\x02\x00\xfc\xff\xff\xff\x04\x00\x05\x00\xfe\xff\xfc\xff\x02\x00\a\x00\x01\x00\xfd\xff\x00\x00\x05\x00\x02\x00\xff\xff\xfe\xff\x02\x00\x03\x00\x02\x00\x00\x00\x00\x00\x00\x00\x03\x00\x03\x00\x02\x00\xfd\xff\xff\xff\x05\x00\x04\x00\xfd\xff\xff\xff\x04\x00\x04\x00\x01\x00\x00\x00\xfe\xff\x03\x00\x04\x00\xff\xff\xfd\xff\x06\x00\x04\x00\xfe\xff\x00\x00\x04\x00\x03\x00\x01\x00\xfe\xff\x01\x00\x04\x00\x04\x00\xfe\xff\x00\x00\x02\x00\x02\x00\x02\x00\x03\x00\xff\xff\x00\x00\x04\x00\x05\x00\xfc\xff\xfe\xff\x05\x00\x06\x00\xff\xff\xff\xff\x01\x00\x04\x00\x04\x00\x02\x00\xfc\xff\x01\x00\x06\x00\x03\x00\xfe\xff\x01\x00\x02\x00\x02\x00\x02\x00\x01\x00\x00\x00\x04\x00\x01\x00\xff\xff\x02\x00\x05\x00\xfe\xff\x00\x00\x06\x00\x05\x00\xfd\xff\xff\xff\x06\x00\x06\x00\xfd\xff\xfc\xff\x04\x00\b\x00\xff\xff\xfd\xff\x03\x00\x05\x00\xff\xff\x03\x00\x02\x00\xfe\xff\x03\x00\a\x00\xfc\xff\xfe\xff\t\x00\x06\x00\xf8\xff\x00\x00\n\x00\x03\x00\xfc\xff\x02\x00\x03\x00\x01\x00\x02\x00\x05\x00\xfe\xff\xfe\xff\x05\x00\x05\x00\xff\xff\x00\x00\x02\x00\x03\x00\x02\x00\x02\x00\x01\x00\x04\x00\x01\x00\xff\xff\x04\x00\x05\x00\xfc\xff\xfe\xff\a\x00\x05\x00\xfb\xff\x00\x00\a\x00\x01\x00\xfd\xff\x05\x00\x02\x00\xfd\xff\x02\x00\b\x00\x00\x00\xfc\xff\x01\x00\x06\x00\x05\x00\xff\xff\xfb\xff\x05\x00\a\x00\xff\xff\xff\xff\x03\x00\xfe\xff\x00\x00\x05\x00\x03\x00\xff\xff\x01\x00\x00\x00\x02\x00\x06\x00\x01\x00\xfc\xff\x03\x00\x03\x00\x00\x00\x04\x00\x03\x00\xfc\xff\x01\x00\a\x00\x00\x00\xfa\xff\x04\x00\x06\x00\xfe\xff\xfa\xff\x02\x00\a\x00\x02\x00\xfb\xff\x01\x00\a\x00\x02\x00\xfb\xff\x00\x00\x04\x00\x01\x00\x00\x00\x02\x00\x00\x00\x00\x00\x02\x00\x02\x00\xfe\xff\xff\xff\x02\x00\x01\x00\x00\x00\x03\x00\x00\x00\xfe\xff\x01\x00\x04\x00\x02\x00\xfe\xff\xfe\xff\x03\x00\x04\x00\xff\xff\xfd\xff\x04\x00\x02\x00\xfc\xff\xfd\xff\x05\x00\x04\x00\xfd\xff\xfc\xff\x04\x00\x05\x00\x00\x00\xfd\xff\x00\x00\x02\x00\x01\x00\x01\x00\x01\x00\xff\xff\xff\xff\x02\x00\x02\x00\xfe\xff\x01\x00\x02\x00\xff\xff\xff\xff\x04\x00\x01\x00\xfc\xff\x00\x00\x04\x00\x01\x00\xff\xff\x00\x00\x02\x00\x02\x00\x00\x00\xff\xff\x00\x00\x00\x00\xfe\xff\x00\x00\x03\x00\x00\x00\xfe\xff\xff\xff\x04\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x03\x00\x04\x00\xfd\xff\xfd\xff\x04\x00\x01\x00\xfc\xff\x00\x00\x03\x00\x00\x00\xfc\xff\x00\x00\x05\x00\x02\x00\xfc\xff\xfd\xff\x04\x00\x03\x00\xfe\xff\x00\x00\x01\x00\xfe\xff\x01\x00\x03\x00\xfd\xff\xfa\xff\x02\x00\x05\x00\xfe\xff\xfd\xff\x01\x00\x01\x00\xfd\xff\x00\x00\x04\x00\xfd\xff\xfb\xff\x05\x00\x04\x00\xf9\xff\xfc\xff\b\x00\x05\x00\xf8\xff\xfc\xff\x04\x00\x01\x00\xfe\xff\xff\xff\xff\xff\x00\x00\x00\x00\xfe\xff\x00\x00\x04\x00\xfe\xff\xf9\xff\x01\x00\b\x00\xff\xff\xfa\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\xfe\xff\x01\x00\xff\xff\xfb\xff\xff\xff\x04\x00\xff\xff\xfc\xff\xff\xff\x03\x00\x01\x00\xfa\xff\xfc\xff\x04\x00\x04\x00\xfb\xff\xf9\xff\x05\x00\x06\x00\xf9\xff\xf7\xff\x05\x00\x05\x00\xfb\xff\xfb\xff\x01\x00\x01\x00\x01\x00\xfe\xff\xfb\xff\x00\x00\x04\x00\xfc\xff\xfc\xff\x03\x00\x01\x00\xfa\xff\xfd\xff\x02\x00\xff\xff\xfd\xff\x00\x00\xfe\xff\xfc\xff\x00\x00\x03\x00\xff\xff\xfc\xff\xfb\xff\xff\xff\x03\x00\x02\x00\xfa\xff\xfb\xff\x03\x00\x02\x00\xfb\xff\xfd\xff\x01\x00\x02\x00\xfd\xff\xfc\xff\x00\x00\x03\x00\xfe\xff\xfb\xff\xfd\xff\x03\x00\x00\x00\xfc\xff\xfc\xff\x02\x00\x01\x00\xfc\xff\xfb\xff\x01\x00\x01\x00\xfe\xff\xfe\xff\xff\xff\xfc\xff\xff\xff\x04\x00\xfe\xff\xf8\xff\x00\x00\x03\x00\xfc\xff\xfb\xff\x03\x00\x01\x00\xfb\xff\xfd\xff\x03\x00\xff\xff\xfb\xff\xfe\xff\x01\x00\xfd\xff\xff\xff\x01\x00\xfe\xff\xfb\xff\x01\x00\x02\x00\xfe\xff\xfb\xff\x01\x00\xff\xff\xfd\xff\xfe\xff\x01\x00\xfd\xff\xfc\xff\x00\x00\x02\x00\xfc\xff\xfe\xff\x00\x00\xfd\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\xfb\xff\x00\x00\x02\x00\xff\xff\xfb\xff\xfe\xff\x02\x00\xfe\xff\xf9\xff\x00\x00\x03\x00\x00\x00\xfb\xff\xfd\xff\x01\x00\x03\x00\xfd\xff\xfa\xff\xfe\xff\x05\x00\xff\xff\xfc\xff\xfe\xff\x01\x00\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfd\xff\x01\x00\x01\x00\xfc\xff\xfa\xff\x04\x00\x02\x00\xf9\xff\xfb\xff\x03\x00\x00\x00\xfb\xff\xfd\xff\x01\x00\xff\xff\xff\xff\xfd\xff\xfd\xff\xfe\xff\x01\x00\xff\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfe\xff\xfe\xff\x02\x00\xfd\xff\xfa\xff\x01\x00\x06\x00\xfb\xff\xf8\xff\x03\x00\x06\x00\xfa\xff\xf9\xff\xff\xff\x05\x00\x01\x00\xf9\xff\xfb\xff\x04\x00\x00\x00\xfb\xff\xff\xff\x02\x00\xfb\xff\xfe\xff\x03\x00\xff\xff\xfb\xff\xff\xff\x00\x00\xfd\xff\xfd\xff\x02\x00\x00\x00\xfd\xff\xfe\xff\x01\x00\xff\xff\xfe\xff\xff\xff\x00\x00\xfc\xff\xff\xff\x04\x00\x03\x00\xf9\xff\xfc\xff\x04\x00\x03\x00\xfa\xff\xfe\xff\x01\x00\x00\x00\xff\xff\xff\xff\xfd\xff\xff\xff\x00\x00\x01\x00\xfe\xff\xff\xff\x00\x00\x00\x00\xfe\xff\xff\xff\x00\x00\x00\x00\xfe\xff\x00\x00\xfe\xff\xff\xff\x04\x00\x00\x00\xf9\xff\x00\x00\x05\x00\xfe\xff\xfa\xff\x05\x00\x04\x00\xfa\xff\xfd\xff\a\x00\x01\x00\xfb\xff\xfe\xff\x03\x00\x01\x00\x02\x00\x00\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xfe\xff\xfb\xff\x00\x00\x06\x00\x03\x00\xf9\xff\xfe\xff\x05\x00\x03\x00\xfc\xff\xff\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x03\x00\xfb\xff\xfc\xff\a\x00\x05\x00\xf9\xff\xfb\xff\x04\x00\x06\x00\xff\xff\xfe\xff\xfd\xff\x02\x00\x05\x00\x01\x00\xfa\xff\xfe\xff\x05\x00\x04\x00\xfc\xff\xff\xff\x01\x00\x02\x00\x01\x00\x02\x00\xfe\xff\xfd\xff\x02\x00\x05\x00\xfd\xff\xfc\xff\x03\x00\x04\x00\xfd\xff\x00\x00\x04\x00\xff\xff\xfd\xff\x03\x00\xff\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\xff\xff\x01\x00\x04\x00\x01\x00\xfd\xff\xfd\xff\x05\x00\x03\x00\xfc\xff\x01\x00\x05\x00\xfc\xff\xfe\xff\x03\x00\x02\x00\xfe\xff\x02\x00\x01\x00\xff\xff\x01\x00\x03\x00\xff\xff\x00\x00\xff\xff\xff\xff\x01\x00\x03\x00\x00\x00\xff\xff\x00\x00\x01\x00\x01\x00\x00\x00\xfc\xff\x00\x00\x05\x00\x01\x00\xfc\xff\x00\x00\x04\x00\x00\x00\xfd\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x05\x00\x02\x00\xfd\xff\xfe\xff\x04\x00\x03\x00\xff\xff\xfd\xff\x03\x00\x02\x00\xfe\xff\x00\x00\x05\x00\x01\x00\xfc\xff\x00\x00\x06\x00\xff\xff\xfc\xff\x01\x00\x05\x00\x00\x00\xfd\xff\x01\x00\x04\x00\xff\xff\xfc\xff\x01\x00\x04\x00\xff\xff\xff\xff\x01\x00\x01\x00\x01\x00\x02\x00\x00\x00\xff\xff\x00\x00\x03\x00\x02\x00\xff\xff\xff\xff\x03\x00\xff\xff\xff\xff\x06\x00\x04\x00\xfa\xff\x00\x00\a\x00\x02\x00\xfd\xff\x01\x00\x01\x00\x00\x00\x02\x00\x03\x00\xfe\xff\xff\xff\x05\x00\x04\x00\xfa\xff\xfe\xff\t\x00\x03\x00\xf9\xff\xff\xff\a\x00\x03\x00\xfd\xff\x00\x00\x02\x00\x01\x00\x02\x00\x02\x00\xff\xff\xff\xff\x03\x00\x04\x00\x00\x00\xff\xff\x01\x00\x04\x00\x02\x00\xff\xff\xff\xff\x02\x00\x02\x00\x02\x00\xff\xff\x00\x00\x04\x00\x04\x00\xfe\xff\xff\xff\x02\x00\x02\x00\x01\x00\x00\x00\xff\xff\x02\x00\x04\x00\x00\x00\xff\xff\x04\x00\x01\x00\xfe\xff\x02\x00\x01\x00\xff\xff\x02\x00\x04\x00\x00\x00\xfd\xff\x02\x00\a\x00\x01\x00\xfa\xff\xfe\xff\a\x00\x06\x00\xfd\xff\xfc\xff\x02\x00\x04\x00\x03\x00\x02\x00\x00\x00\xfd\xff\x00\x00\b\x00\x04\x00\xfa\xff\xfc\xff\x06\x00\x06\x00\xff\xff\xfd\xff\xff\xff\x03\x00\x03\x00\x01\x00\xfe\xff\x00\x00\x04\x00\x01\x00\xfd\xff\x01\x00\x01\x00\x00\x00\x03\x00\x02\x00\xfb\xff\x02\x00\n\x00\xfe\xff\xf6\xff\x03\x00\n\x00\xff\xff\xfa\xff\x02\x00\x06\x00\x02\x00\xfd\xff\xff\xff\x03\x00\x01\x00\x01\x00\x03\x00\x00\x00\xfd\xff\x03\x00\x06\x00\xfd\xff\xfd\xff\x04\x00\x01\x00\xfd\xff\x02\x00\x03\x00\xfe\xff\xfe\xff\x05\x00\x02\x00\xff\xff\x00\x00\x00\x00\xff\xff\x02\x00\x04\x00\xff\xff\xfd\xff\x03\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x01\x00\x03\x00\xff\xff\x00\x00\x03\x00\x00\x00\xfc\xff\x01\x00\x06\x00\x00\x00\xfd\xff\x00\x00\x02\x00\x01\x00\x00\x00\x00\x00\xff\xff\x00\x00\x02\x00\x00\x00\xfe\xff\x01\x00\x01\x00\x00\x00\x01\x00\x00\x00\xfd\xff\x00\x00\x06\x00\x02\x00\xf9\xff\xff\xff\b\x00\x01\x00\xfa\xff\x00\x00\x05\x00\x00\x00\xfc\xff\x00\x00\x04\x00\x02\x00\xfc\xff\xfd\xff\x04\x00\x02\x00\xfd\xff\xff\xff\x02\x00\x00\x00\xff\xff\x02\x00\x00\x00\xfe\xff\x02\x00\x01\x00\xfd\xff\xff\xff\x02\x00\x02\x00\xff\xff\xfe\xff\xff\xff\x02\x00\x04\x00\xfe\xff\xfa\xff\x00\x00\x05\x00\x03\x00\xfc\xff\xfe\xff\x04\x00\x00\x00\xfd\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfe\xff\x00\x00\x00\x00\x00\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\xfc\xff\xff\xff\x04\x00\x00\x00\xfc\xff\x00\x00\x03\x00\x01\x00\xfc\xff\xff\xff\x04\x00\x01\x00\xfb\xff\xff\xff\x02\x00\x00\x00\x00\x00\x02\x00\xfd\xff\xfe\xff\x02\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\xff\xff\xff\xff\x01\x00\x01\x00\xfd\xff\xfe\xff\x01\x00\x02\x00\xfe\xff\xfc\xff\xff\xff\x04\x00\x02\x00\xfe\xff\xfb\xff\x00\x00\x04\x00\x01\x00\xfb\xff\xfe\xff\x04\x00\x01\x00\xfd\xff\x01\x00\x01\x00\xfe\xff\xfd\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x05\x00\x03\x00\xfc\xff\xfc\xff\x03\x00\x03\x00\xff\xff\xfe\xff\xff\xff\x01\x00\x03\x00\xfe\xff\xfd\xff\x02\x00\x03\x00\xfd\xff\xfd\xff\xff\xff\x02\x00\x03\x00\xff\xff\xf9\xff\xff\xff\x06\x00\x02\x00\xfb\xff\xff\xff\x01\x00\xff\xff\xff\xff\x03\x00\x02\x00\xfb\xff\xfb\xff\x04\x00\x05\x00\xfe\xff\xf9\xff\x01\x00\x04\x00\xff\xff\xfc\xff\x01\x00\x00\x00\xfe\xff\x02\x00\x03\x00\xff\xff\xfc\xff\x00\x00\x04\x00\xff\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\x00\x00\x02\x00\xff\xff\xfb\xff\x01\x00\x02\x00\xfe\xff\xfc\xff\x01\x00\x02\x00\x00\x00\xff\xff\xff\xff\xfe\xff\x02\x00\x00\x00\xfe\xff\xff\xff\x00\x00\xfe\xff\x00\x00\x03\x00\x00\x00\xf9\xff\xff\xff\x05\x00\x03\x00\xfb\xff\xfc\xff\x02\x00\x03\x00\x00\x00\xfe\xff\xfe\xff\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\xfb\xff\x00\x00\x02\x00\xff\xff\xfc\xff\x02\x00\x01\x00\xfc\xff\xfd\xff\x04\x00\x03\x00\xfc\xff\xf9\xff\x02\x00\x05\x00\x00\x00\xfb\xff\xff\xff\x02\x00\x00\x00\xfe\xff\x01\x00\xfe\xff\xfe\xff\xff\xff\xff\xff\x00\x00\x03\x00\xfd\xff\xfd\xff\x02\x00\x02\x00\xfe\xff\x00\x00\x00\x00\xfe\xff\x01\x00\x00\x00\xfa\xff\x03\x00\x03\x00\xfb\xff\xfb\xff\x05\x00\x02\x00\xfc\xff\xfb\xff\x01\x00\x03\x00\x01\x00\xfb\xff\xff\xff\x02\x00\x02\x00\xfe\xff\xfd\xff\xff\xff\x03\x00\xff\xff\xfc\xff\x02\x00\x05\x00\xfa\xff\xfc\xff\x04\x00\x02\x00\xfb\xff\x00\x00\x02\x00\xff\xff\xff\xff\x01\x00\x00\x00\xff\xff\xfe\xff\x02\x00\xff\xff\xff\xff\xfe\xff\x01\x00\x00\x00\xff\xff\xff\xff\x03\x00\xfd\xff\xfc\xff\x03\x00\a\x00\xfa\xff\xf9\xff\x05\x00\x06\x00\xf9\xff\xfd\xff\x05\x00\x01\x00\xfb\xff\x02\x00\x03\x00\xff\xff\xfd\xff\x01\x00\x00\x00\x02\x00\x00\x00\xff\xff\xfe\xff\x01\x00\x02\x00\x01\x00\xff\xff\x01\x00\x01\x00\xff\xff\xfe\xff\x05\x00\x00\x00\xfa\xff\xfe\xff\x06\x00\x02\x00\xfb\xff\xfe\xff\a\x00\x00\x00\xfd\xff\xff\xff\x03\x00\xff\xff\xff\xff\x00\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\a\x00\xff\xff\xfb\xff\xff\xff\x05\x00\x02\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\x00\x00\xff\xff\x01\x00\x00\x00\x02\x00\x01\x00\x00\x00\xfe\xff\x02\x00\x03\x00\x01\x00\xfd\xff\xff\xff\x01\x00\x04\x00\x00\x00\xff\xff\xfd\xff\x02\x00\x03\x00\x01\x00\xfc\xff\x01\x00\x04\x00\x02\x00\xfd\xff\xff\xff\x01\x00\x03\x00\x00\x00\x00\x00\xfe\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x04\x00\x01\x00\xfe\xff\xff\xff\x04\x00\x03\x00\x00\x00\xfd\xff\x01\x00\x02\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x02\x00\xfd\xff\x01\x00\x04\x00\x00\x00\xfb\xff\x03\x00\x01\x00\xff\xff\x00\x00\x03\x00\xfe\xff\xff\xff\x01\x00\x02\x00\x00\x00\x02\x00\xfe\xff\x00\x00\x01\x00\x02\x00\xfe\xff\x02\x00\x01\x00\x00\x00\x01\x00\x03\x00\x00\x00\xff\xff\x01\x00\x04\x00\x00\x00\x00\x00\xff\xff\x01\x00\x02\x00\x03\x00\xfe\xff\xff\xff\x03\x00\x02\x00\xfc\xff\x02\x00\x02\x00\x00\x00\xfe\xff\x02\x00\x03\x00\x02\x00\xfb\xff\x01\x00\x04\x00\x00\x00\xfb\xff\x03\x00\x03\x00\x00\x00\x00\x00\x02\x00\xfd\xff\x03\x00\x04\x00\xfe\xff\xfb\xff\x06\x00\x05\x00\xfd\xff\xfd\xff\x06\x00\x03\x00\xff\xff\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x00\x00\x00\x00\x03\x00\x01\x00\x01\x00\xff\xff\x03\x00\x02\x00\x01\x00\xff\xff\x01\x00\x01\x00\x04\x00\x00\x00\xff\xff\x01\x00\x05\x00\xfe\xff\x00\x00\x04\x00\x04\x00\xfc\xff\x00\x00\x05\x00\x04\x00\xfd\xff\x01\x00\x06\x00\x00\x00\xfd\xff\b\x00\x04\x00\xfb\xff\xff\xff\t\x00\x02\x00\xfd\xff\x04\x00\x05\x00\xfe\xff\x00\x00\x03\x00\x04\x00\x01\x00\x00\x00\xff\xff\x04\x00\x05\x00\xff\xff\xfb\xff\x04\x00\x04\x00\x01\x00\x01\x00\x02\x00\x01\x00\x02\x00\x01\x00\x02\x00\x00\x00\x02\x00\x00\x00\x03\x00\x03\x00\x01\x00\xff\xff\x02\x00\x04\x00\x04\x00\xfe\xff\x01\x00\x06\x00\x02\x00\xfe\xff\x02\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x05\x00\x01\x00\xfe\xff\x05\x00\x04\x00\xfd\xff\xff\xff\a\x00\x01\x00\xfd\xff\x00\x00\x05\x00\x03\x00\x02\x00\xff\xff\x00\x00\x04\x00\x04\x00\x00\x00\x00\x00\x00\x00\x02\x00\x02\x00\x02\x00\x01\x00\x03\x00\x02\x00\xff\xff\x02\x00\x05\x00\x01\x00\xfd\xff\x03\x00\a\x00\xff\xff\xfb\xff\x02\x00\a\x00\x02\x00\xfd\xff\xff\xff\x03\x00\x04\x00\x03\x00\xff\xff\xfe\xff\x01\x00\x04\x00\x02\x00\x01\x00\x00\x00\x01\x00\x02\x00\x03\x00\xff\xff\xfd\xff\x02\x00\b\x00\x01\x00\xfb\xff\x00\x00\x06\x00\x03\x00\x00\x00\xff\xff\x01\x00\x03\x00\x03\x00\x01\x00\x00\x00\x02\x00\x03\x00\x00\x00\xff\xff\x04\x00\x04\x00\x00\x00\xfd\xff\x00\x00\x06\x00\x03\x00\xfd\xff\xff\xff\x02\x00\x02\x00\x01\x00\x01\x00\xff\xff\xfd\xff\x04\x00\x04\x00\xfe\xff\xfe\xff\x02\x00\x03\x00\x00\x00\xff\xff\x01\x00\x00\x00\x00\x00\x03\x00\x03\x00\x00\x00\xff\xff\x04\x00\x02\x00\xff\xff\x01\x00\x01\x00\x00\x00\x02\x00\x03\x00\x00\x00\xff\xff\x05\x00\x02\x00\xfd\xff\x01\x00\x04\x00\xff\xff\xff\xff\x05\x00\x02\x00\xfa\xff\x00\x00\a\x00\x02\x00\xfd\xff\x00\x00\x02\x00\x02\x00\x03\x00\x02\x00\xfc\xff\xfe\xff\x05\x00\x05\x00\xfd\xff\xfc\xff\x03\x00\a\x00\xff\xff\xfc\xff\x01\x00\x06\x00\xff\xff\xff\xff\x02\x00\x00\x00\xff\xff\x05\x00\xff\xff\xfc\xff\x02\x00\a\x00\xfd\xff\xfd\xff\x02\x00\x04\x00\x00\x00\xfe\xff\xfe\xff\x04\x00\x03\x00\xfe\xff\xfd\xff\x05\x00\x01\x00\xfb\xff\x01\x00\b\x00\xff\xff\xf9\xff\x02\x00\t\x00\xfe\xff\xfc\xff\x03\x00\x04\x00\xfe\xff\x03\x00\x03\x00\xff\xff\xfd\xff\x05\x00\x02\x00\x01\x00\xff\xff\x00\x00\x01\x00\x04\x00\xff\xff\xff\xff\xff\xff\x03\x00\x01\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x04\x00\xfa\xff\xfe\xff\x04\x00\x02\x00\xfa\xff\x02\x00\x02\x00\xfc\xff\xfc\xff\x04\x00\x02\x00\xfe\xff\xfb\xff\x01\x00\x02\x00\x03\x00\xfc\xff\xfd\xff\x00\x00\x05\x00\xfe\xff\xfc\xff\xff\xff\x04\x00\xff\xff\xff\xff\xfe\xff\x02\x00\x00\x00\x01\x00\xfd\xff\xfe\xff\x00\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x06\x00\xfa\xff\xfc\xff\x03\x00\x02\x00\xfa\xff\x00\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xfa\xff\xfd\xff\x03\x00\x01\x00\xf9\xff\xff\xff\x01\x00\x01\x00\xfc\xff\x02\x00\x00\x00\xfe\xff\xfa\xff\x00\x00\x01\x00\xff\xff\xf9\xff\xff\xff\x01\x00\x02\x00\xfa\xff\xfd\xff\x00\x00\x01\x00\xfb\xff\xff\xff\x00\x00\xff\xff\xf9\xff\x00\x00\x02\x00\xff\xff\xf7\xff\xff\xff\x03\x00\xff\xff\xfa\xff\x00\x00\xfe\xff\xfc\xff\xff\xff\x04\x00\xfb\xff\xfc\xff\xff\xff\x02\x00\xfd\xff\xfe\xff\xfe\xff\xff\xff\xfc\xff\x01\x00\xff\xff\xfe\xff\xfa\xff\x01\x00\x00\x00\xfd\xff\xfb\xff\xff\xff\x00\x00\x01\x00\xfb\xff\xfd\xff\xff\xff\x02\x00\xfa\xff\xfd\xff\x00\x00\x01\x00\xfa\xff\xfb\xff\x00\x00\x02\x00\xfa\xff\xfc\xff\xff\xff\x00\x00\xfa\xff\x00\x00\x01\x00\xfb\xff\xf8\xff\x03\x00\x02\x00\xfc\xff\xf8\xff\x01\x00\x03\x00\xff\xff\xf9\xff\xfd\xff\x00\x00\x03\x00\xfc\xff\xfc\xff\xfd\xff\x02\x00\x00\x00\xfc\xff\xf9\xff\x02\x00\x02\x00\xfd\xff\xf9\xff\x00\x00\x02\x00\xfe\xff\xfb\xff\xfe\xff\x00\x00\x00\x00\xfb\xff\xfe\xff\x00\x00\x00\x00\xfc\xff\xfe\xff\xff\xff\xff\xff\xfc\xff\xfe\xff\x00\x00\x01\x00\xfa\xff\xfd\xff\x02\x00\x02\x00\xf9\xff\xfc\xff\x02\x00\xff\xff\xf9\xff\x00\x00\x03\x00\xfd\xff\xf9\xff\x02\x00\x01\x00\xfd\xff\xfb\xff\x01\x00\xff\xff\xfc\xff\xfe\xff\x01\x00\xfe\xff\xfd\xff\xfc\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\x01\x00\x00\x00\xfe\xff\xfd\xff\x00\x00\xfe\xff\xfd\xff\x00\x00\x00\x00\xfb\xff\xfd\xff\x03\x00\x01\x00\xfa\xff\xfe\xff\x01\x00\xff\xff\xfd\xff\x00\x00\xff\xff\xfd\xff\xfe\xff\x01\x00\x00\x00\xfe\xff\xfb\xff\x01\x00\x01\x00\xfe\xff\xfd\xff\xfe\xff\x00\x00\xff\xff\xfc\xff\xfd\xff\x01\x00\xff\xff\xfa\xff\x00\x00\x05\x00\xfe\xff\xfb\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\x00\x00\xfe\xff\xfe\xff\x00\x00\xff\xff\xfd\xff\xfe\xff\x02\x00\xff\xff\xfa\xff\xff\xff\x04\x00\x01\x00\xfc\xff\xfd\xff\xff\xff\x01\x00\xff\xff\xfd\xff\x02\x00\x00\x00\xfb\xff\xff\xff\x04\x00\xff\xff\xfc\xff\xfc\xff\xfd\xff\x03\x00\x04\x00\xf9\xff\xfb\xff\x05\x00\x02\x00\xfb\xff\xfe\xff\x01\x00\x00\x00\x00\x00\xfe\xff\xff\xff\x01\x00\xfc\xff\xfd\xff\x03\x00\x01\x00\xf9\xff\xff\xff\x04\x00\x01\x00\xfd\xff\xfe\xff\x00\x00\x01\x00\xff\xff\xff\xff\x01\x00\x01\x00\xff\xff\xfe\xff\xff\xff\x00\x00\xff\xff\x00\x00\xfc\xff\x00\x00\x01\x00\x02\x00\xff\xff\xff\xff\xfe\xff\x00\x00\x03\x00\x01\x00\xfa\xff\x00\x00\x04\x00\x02\x00\xfb\xff\xff\xff\x03\x00\x01\x00\xfd\xff\x00\x00\x01\x00\x01\x00\xfc\xff\x02\x00\x04\x00\xff\xff\xfb\xff\x02\x00\x04\x00\x00\x00\xfd\xff\x02\x00\x01\x00\xff\xff\x00\x00\x03\x00\x00\x00\xff\xff\xfe\xff\x01\x00\x00\x00\x03\x00\xfe\xff\xff\xff\x01\x00\x04\x00\x00\x00\xff\xff\x00\x00\x04\x00\xff\xff\x00\x00\x02\x00\x03\x00\xfc\xff\x01\x00\x05\x00\x02\x00\xfb\xff\x04\x00\x06\x00\x02\x00\xfb\xff\x01\x00\x03\x00\x01\x00\x00\x00\x05\x00\x00\x00\x00\x00\x01\x00\x05\x00\x01\x00\xff\xff\xfe\xff\x04\x00\x03\x00\x01\x00\xfd\xff\x05\x00\x03\x00\xff\xff\xfc\xff\x04\x00\x03\x00\x01\x00\xfd\xff\x05\x00\x04\x00\x00\x00\xfe\xff\x06\x00\x01\x00\xff\xff\x00\x00\a\x00\x01\x00\xfe\xff\x00\x00\a\x00\x01\x00\x00\x00\xff\xff\x04\x00\x04\x00\x05\x00\xfe\xff\x01\x00\x03\x00\x03\x00\x00\x00\a\x00\x01\x00\xfd\xff\x02\x00\n\x00\xff\xff\xff\xff\x02\x00\x06\x00\x00\x00\x02\x00\x01\x00\x04\x00\x00\x00\x02\x00\x00\x00\x06\x00\x01\x00\x02\x00\xff\xff\x03\x00\x02\x00\x06\x00\x00\x00\x01\x00\x01\x00\x05\x00\x02\x00\x05\x00\xff\xff\x01\x00\x05\x00\a\x00\xfb\xff\x00\x00\n\x00\a\x00\xf7\xff\x01\x00\v\x00\x05\x00\xfa\xff\x02\x00\x03\x00\x04\x00\x02\x00\x04\x00\xfd\xff\x04\x00\x06\x00\x03\x00\xfc\xff\x03\x00\x04\x00\x05\x00\xfe\xff\x02\x00\x02\x00\x06\x00\x00\x00\x03\x00\x01\x00\x03\x00\x00\x00\a\x00\x03\x00\xff\xff\x01\x00\n\x00\x01\x00\x00\x00\x02\x00\x06\x00\x00\x00\x04\x00\x02\x00\x04\x00\x02\x00\x05\x00\x00\x00\x03\x00\x04\x00\x06\x00\xfd\xff\x01\x00\x06\x00\x06\x00\xfd\xff\x02\x00\x04\x00\x05\x00\xff\xff\x04\x00\x01\x00\x04\x00\x03\x00\x04\x00\xfc\xff\x02\x00\x06\x00\x06\x00\xfc\xff\x01\x00\x04\x00\a\x00\x02\x00\x02\x00\xfd\xff\x06\x00\a\x00\x02\x00\xfe\xff\x06\x00\x03\x00\x02\x00\x03\x00\x05\x00\xff\xff\x06\x00\x03\x00\x01\x00\x02\x00\a\x00\xff\xff\x02\x00\x06\x00\x02\x00\xfe\xff\t\x00\x02\x00\xff\xff\x00\x00\b\x00\x02\x00\x00\x00\x00\x00\a\x00\x03\x00\x03\x00\xff\xff\x03\x00\x02\x00\x05\x00\x01\x00\x00\x00\x02\x00\t\x00\xfe\xff\xff\xff\x06\x00\b\x00\xfd\xff\xff\xff\x05\x00\a\x00\x00\x00\x03\x00\x02\x00\x05\x00\x04\x00\x02\x00\x00\x00\x06\x00\x01\x00\x02\x00\x04\x00\x04\x00\xfd\xff\x03\x00\t\x00\x03\x00\xf9\xff\x05\x00\a\x00\x01\x00\xfc\xff\x06\x00\x06\x00\x01\x00\xfe\xff\x05\x00\x05\x00\x04\x00\xfd\xff\x01\x00\x05\x00\x05\x00\xfe\xff\x03\x00\x04\x00\x02\x00\x00\x00\x06\x00\x03\x00\x01\x00\x02\x00\x04\x00\x03\x00\x04\x00\x01\x00\x02\x00\x02\x00\x03\x00\x01\x00\x05\x00\x02\x00\x00\x00\x01\x00\x05\x00\x02\x00\x01\x00\x00\x00\x03\x00\x01\x00\x02\x00\x03\x00\x05\x00\xff\xff\x01\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x03\x00\x03\x00\x00\x00\x02\x00\x02\x00\x03\x00\x01\x00\x02\x00\x02\x00\x01\x00\x00\x00\x05\x00\x05\x00\xff\xff\xff\xff\x06\x00\x04\x00\xff\xff\x01\x00\x04\x00\x01\x00\x01\x00\x02\x00\x01\x00\x02\x00\x03\x00\xfd\xff\x01\x00\x06\x00\x02\x00\xfd\xff\x03\x00\x02\x00\x00\x00\x03\x00\x02\x00\xfd\xff\x02\x00\x05\x00\xfe\xff\xfd\xff\x06\x00\x02\x00\xfd\xff\x01\x00\x04\x00\xff\xff\xff\xff\x04\x00\x03\x00\xfe\xff\x00\x00\x05\x00\x01\x00\xfd\xff\x01\x00\x05\x00\x00\x00\xff\xff\x02\x00\x01\x00\x00\x00\x01\x00\x01\x00\x00\x00\xff\xff\xff\xff\x01\x00\x02\x00\xff\xff\xff\xff\x02\x00\xff\xff\x00\x00\x02\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xfb\xff\x03\x00\x05\x00\xfd\xff\xfc\xff\x01\x00\x02\x00\x00\x00\xff\xff\x01\x00\x01\x00\xfe\xff\xfe\xff\x05\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfe\xff\x00\x00\x01\x00\xfe\xff\x00\x00\x02\x00\xff\xff\xfc\xff\x00\x00\x03\x00\xff\xff\xfa\xff\xff\xff\x03\x00\x01\x00\xfe\xff\xff\xff\xfe\xff\xff\xff\x02\x00\x01\x00\xfb\xff\xfd\xff\x02\x00\x01\x00\xfa\xff\xff\xff\x05\x00\xfe\xff\xf8\xff\x01\x00\x05\x00\x00\x00\xf9\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\xff\xff\x00\x00\xff\xff\x01\x00\xfe\xff\xff\xff\xff\xff\xfe\xff\xfe\xff\x01\x00\x00\x00\xfd\xff\xfb\xff\x00\x00\x02\x00\xfe\xff\xfb\xff\x02\x00\x01\x00\xfc\xff\xfc\xff\x04\x00\x01\x00\xfb\xff\xf9\xff\x02\x00\x04\x00\xff\xff\xf9\xff\xfd\xff\x01\x00\x02\x00\xfb\xff\xfd\xff\x00\x00\x01\x00\xfb\xff\x00\x00\x02\x00\xff\xff\xf8\xff\x00\x00\x03\x00\x00\x00\xfa\xff\xfe\xff\xff\xff\x01\x00\xff\xff\xfd\xff\xfa\xff\x00\x00\x01\x00\xff\xff\xf9\xff\xfd\xff\x02\x00\x02\x00\xfa\xff\xfc\xff\x01\x00\x05\x00\xfa\xff\xfa\xff\x01\x00\x05\x00\xfa\xff\xf9\xff\x00\x00\x05\x00\xfb\xff\xfb\xff\xff\xff\x01\x00\xfc\xff\xff\xff\x00\x00\x00\x00\xf8\xff\x00\x00\x04\x00\xff\xff\xf5\xff\xff\xff\x04\x00\xfe\xff\xf7\xff\x01\x00\x00\x00\xfc\xff\xfb\xff\x02\x00\x00\x00\xfc\xff\xf9\xff\x02\x00\x03\x00\xfc\xff\xf6\xff\x03\x00\x04\x00\xfc\xff\xf5\xff\x03\x00\x04\x00\xfd\xff\xf9\xff\xff\xff\xfd\xff\x00\x00\xfe\xff\xfd\xff\xfa\xff\x01\x00\x01\x00\x00\x00\xfb\xff\xfe\xff\xfe\xff\x01\x00\xfc\xff\x00\x00\xfe\xff\xfe\xff\xfc\xff\x00\x00\xfc\xff\x00\x00\xfe\xff\xfa\xff\xfa\xff\x06\x00\x01\x00\xf7\xff\xf9\xff\x05\x00\xff\xff\xfa\xff\xfc\xff\x02\x00\xfd\xff\xff\xff\xfe\xff\xfd\xff\xfb\xff\x01\x00\xfe\xff\xfd\xff\xfb\xff\x02\x00\xff\xff\xfd\xff\xfb\xff\x01\x00\xfc\xff\xfd\xff\xff\xff\xff\xff\xf8\xff\x02\x00\x02\x00\xfa\xff\xf7\xff\x04\x00\x00\x00\xfa\xff\xfc\xff\x02\x00\xfa\xff\xfd\xff\x01\x00\x00\x00\xf6\xff\xfd\xff\x04\x00\x01\x00\xf7\xff\xfc\xff\xff\xff\x02\x00\xfd\xff\xfe\xff\xfc\xff\xff\xff\xff\xff\x00\x00\xfb\xff\xfd\xff\xfe\xff\x01\x00\xfd\xff\xfe\xff\xfc\xff\xff\xff\xfe\xff\x01\x00\xfe\xff\xfd\xff\xf8\xff\x03\x00\x06\x00\xfb\xff\xf2\xff\x01\x00\x05\x00\xfe\xff\xf9\xff\xfe\xff\xfc\xff\x00\x00\x01\x00\xff\xff\xf9\xff\xfc\xff\xfe\xff\x03\x00\xfd\xff\xf9\xff\xfb\xff\x04\x00\x00\x00\xfb\xff\xfb\xff\x03\x00\xfd\xff\xfc\xff\xfe\xff\x02\x00\xfb\xff\xfb\xff\xff\xff\x03\x00\xfc\xff\xfc\xff\xff\xff\x02\x00\xfb\xff\xfc\xff\xff\xff\x04\x00\xfb\xff\xf9\xff\x01\x00\x05\x00\xfa\xff\xfa\xff\x00\x00\x02\x00\xfc\xff\xfe\xff\xff\xff\xfe\xff\xfe\xff\x02\x00\xfc\xff\xfc\xff\xfe\xff\x03\x00\xff\xff\xfc\xff\xfd\xff\x01\x00\xff\xff\xff\xff\xfb\xff\xfe\xff\x00\x00\x03\x00\xfc\xff\xfc\xff\x01\x00\x02\x00\xfb\xff\xff\xff\x02\x00\xff\xff\xfb\xff\x02\x00\xfe\xff\xfe\xff\x00\x00\x00\x00\xfc\xff\xff\xff\x01\x00\x00\x00\xfa\xff\xfe\xff\x02\x00\x01\x00\xfa\xff\xfd\xff\x03\x00\x00\x00\xfb\xff\x02\x00\x01\x00\xfc\xff\xff\xff\x04\x00\xfd\xff\xfb\xff\xff\xff\x05\x00\xff\xff\xfc\xff\xfe\xff\x02\x00\x00\x00\x00\x00\xfd\xff\xfe\xff\x01\x00\x01\x00\xfb\xff\x00\x00\x04\x00\xfd\xff\xfa\xff\x03\x00\x03\x00\xfd\xff\xff\xff\x03\x00\xfe\xff\xfe\xff\x01\x00\x00\x00\xfd\xff\x00\x00\x00\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xfd\xff\xff\xff\x01\x00\xff\xff\x00\x00\x01\x00\x01\x00\xff\xff\x01\x00\xfd\xff\xfc\xff\x05\x00\x04\x00\xf9\xff\xfc\xff\x05\x00\x03\x00\xfe\xff\xff\xff\xfe\xff\x00\x00\x04\x00\x01\x00\xfd\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x04\x00\xfe\xff\xfd\xff\x01\x00\x03\x00\x00\x00\xfe\xff\xff\xff\x03\x00\x02\x00\xfd\xff\xfd\xff\x05\x00\x05\x00\xfc\xff\xfb\xff\x02\x00\x05\x00\x02\x00\xfd\xff\xff\xff\x05\x00\x03\x00\xfb\xff\xff\xff\a\x00\x01\x00\xfa\xff\x00\x00\x04\x00\x03\x00\xff\xff\xfe\xff\xff\xff\x04\x00\x03\x00\x00\x00\xff\xff\xff\xff\x02\x00\x06\x00\x00\x00\xfb\xff\x00\x00\a\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x03\x00\x03\x00\xff\xff\x01\x00\x02\x00\xff\xff\xff\xff\x06\x00\x03\x00\xfd\xff\xfe\xff\x03\x00\x04\x00\x03\x00\xfd\xff\xfe\xff\x04\x00\x05\x00\xfd\xff\x02\x00\x04\x00\x00\x00\x00\x00\x04\x00\xff\xff\x01\x00\x04\x00\x00\x00\xfc\xff\a\x00\x06\x00\xfc\xff\xfe\xff\x06\x00\x02\x00\xff\xff\x00\x00\x03\x00\x01\x00\x03\x00\x00\x00\x00\x00\x00\x00\x05\x00\x03\x00\xff\xff\xff\xff\x04\x00\x03\x00\x01\x00\xfd\xff\x00\x00\x04\x00\x05\x00\xfd\xff\xff\xff\x03\x00\x03\x00\xff\xff\x02\x00\x01\x00\x03\x00\x02\x00\x01\x00\x00\x00\x05\x00\x01\x00\xff\xff\x01\x00\x04\x00\x00\x00\x02\x00\x02\x00\x00\x00\x00\x00\a\x00\x01\x00\xfd\xff\x01\x00\x06\x00\xff\xff\x00\x00\x02\x00\x02\x00\x00\x00\x05\x00\x02\x00\x00\x00\xff\xff\x03\x00\x02\x00\x03\x00\xfe\xff\x01\x00\x04\x00\x04\x00\xfc\xff\x02\x00\x05\x00\xff\xff\xfc\xff\b\x00\x06\x00\xfb\xff\xfb\xff\t\x00\a\x00\xfe\xff\xfc\xff\x04\x00\x02\x00\x03\x00\x03\x00\x00\x00\xfd\xff\x06\x00\x05\x00\xff\xff\xff\xff\x06\x00\x02\x00\x01\x00\x01\x00\x01\x00\x00\x00\x06\x00\x00\x00\xfe\xff\x05\x00\b\x00\xfd\xff\xfe\xff\x05\x00\x03\x00\xfc\xff\x03\x00\x03\x00\x03\x00\xff\xff\x02\x00\x03\x00\x03\x00\xff\xff\x03\x00\x02\x00\x02\x00\x02\x00\x05\x00\xff\xff\x00\x00\x05\x00\x03\x00\xff\xff\x04\x00\x01\x00\x02\x00\x03\x00\x03\x00\x01\x00\x03\x00\x00\x00\x00\x00\x05\x00\x05\x00\xfb\xff\x02\x00\a\x00\x02\x00\xfd\xff\x03\x00\x04\x00\x03\x00\xff\xff\x00\x00\x02\x00\x05\x00\x00\x00\x00\x00\xff\xff\x04\x00\x05\x00\x01\x00\xfb\xff\x05\x00\b\x00\x00\x00\xfa\xff\x04\x00\t\x00\x04\x00\xfb\xff\xfe\xff\a\x00\x06\x00\xfb\xff\x00\x00\a\x00\x01\x00\xfc\xff\a\x00\x05\x00\xfe\xff\xfd\xff\x05\x00\x05\x00\x03\x00\xff\xff\xfe\xff\x03\x00\a\x00\xff\xff\xfe\xff\x02\x00\x04\x00\xfe\xff\x01\x00\x04\x00\x01\x00\xfa\xff\x05\x00\a\x00\xff\xff\xfd\xff\x04\x00\x01\x00\x02\x00\x04\x00\x00\x00\xfd\xff\x06\x00\x04\x00\xfd\xff\x01\x00\x05\x00\xfe\xff\xfe\xff\x02\x00\x05\x00\x01\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xff\xff\xff\xff\x03\x00\x02\x00\x01\x00\x00\x00\x01\x00\x03\x00\x02\x00\xfc\xff\x00\x00\x03\x00\x01\x00\xfc\xff\x01\x00\x02\x00\x01\x00\xff\xff\xff\xff\x01\x00\x06\x00\x00\x00\xfc\xff\x01\x00\x06\x00\x00\x00\xfd\xff\x00\x00\x03\x00\x01\x00\x00\x00\xff\xff\x02\x00\x01\x00\x00\x00\xff\xff\x00\x00\x01\x00\x03\x00\xfe\xff\xff\xff\x02\x00\x04\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\x00\x00\xff\xff\xfe\xff\x02\x00\x02\x00\xff\xff\xfb\xff\x04\x00\x04\x00\xfd\xff\xfd\xff\x04\x00\x01\x00\xff\xff\x01\x00\x00\x00\x01\x00\x03\x00\x00\x00\xfd\xff\x00\x00\x04\x00\xff\xff\xfc\xff\x00\x00\x03\x00\x00\x00\x00\x00\x01\x00\xfe\xff\xfc\xff\x01\x00\x02\x00\x00\x00\xff\xff\xff\xff\xff\xff\x04\x00\x02\x00\xfd\xff\xfe\xff\x02\x00\x02\x00\xff\xff\xfe\xff\xff\xff\x00\x00\x01\x00\xfd\xff\x00\x00\x02\x00\xff\xff\xfd\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x03\x00\xff\xff\xfe\xff\xff\xff\xff\xff\x02\x00\x01\x00\xfb\xff\xfd\xff\x03\x00\x01\x00\xfd\xff\xfd\xff\x00\x00\x02\x00\x01\x00\xfd\xff\xfe\xff\x01\x00\xff\xff\xfe\xff\x00\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x00\x00\xfb\xff\xfe\xff\x02\x00\x01\x00\xfb\xff\xfb\xff\x02\x00\x05\x00\xfd\xff\xf9\xff\x00\x00\x05\x00\xfe\xff\xfa\xff\xfd\xff\x02\x00\x01\x00\xfe\xff\xfb\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\x00\x00\xff\xff\xf9\xff\xff\xff\x05\x00\xfc\xff\xf7\xff\x01\x00\x05\x00\xfb\xff\xfb\xff\x01\x00\xff\xff\xfd\xff\xff\xff\xfe\xff\xff\xff\x00\x00\xfe\xff\xf9\xff\xff\xff\x04\x00\xfd\xff\xf8\xff\xfe\xff\x03\x00\x00\x00\xfa\xff\xfd\xff\x00\x00\x02\x00\xfe\xff\xfa\xff\xfd\xff\x03\x00\xfe\xff\xf9\xff\xff\xff\x04\x00\xfc\xff\xf9\xff\xff\xff\x02\x00\xfe\xff\xf9\xff\xfb\xff\x02\x00\x03\x00\xfb\xff\xf9\xff\x00\x00\x01\x00\xff\xff\xfb\xff\xfc\xff\x01\x00\x02\x00\xfb\xff\xfb\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\xfc\xff\x01\x00\x01\x00\xfb\xff\xf9\xff\x01\x00\x02\x00\xfc\xff\xfa\xff\x00\x00\x01\x00\xfe\xff\xfd\xff\xfe\xff\xfb\xff\x00\x00\x00\x00\xfa\xff\xfc\xff\x02\x00\xfc\xff\xf9\xff\x02\x00\x02\x00\xf8\xff\xfb\xff\x04\x00\xff\xff\xf8\xff\xff\xff\x01\x00\xff\xff\xfc\xff\xfe\xff\xfe\xff\x00\x00\x00\x00\xfb\xff\xfa\xff\x02\x00\x01\x00\xfc\xff\xfa\xff\x00\x00\x02\x00\xfe\xff\xfb\xff\xfe\xff\xfe\xff\x00\x00\xff\xff\xfc\xff\xfb\xff\x03\x00\x01\x00\xf8\xff\xfc\xff\x05\x00\xfe\xff\xf6\xff\xfc\xff\x04\x00\x01\x00\xfa\xff\xf9\xff\xff\xff\x01\x00\x00\x00\xfd\xff\xfc\xff\xfc\xff\x01\x00\x02\x00\xfd\xff\xf9\xff\xff\xff\xff\xff\xff\xff\xfe\xff\xfc\xff\xfc\xff\xff\xff\x00\x00\xfd\xff\xfc\xff\x01\x00\xff\xff\xfe\xff\xfc\xff\xfe\xff\xfe\xff\xfd\xff\xfd\xff\xff\xff\xfd\xff\xfd\xff\xff\xff\xff\xff\xfc\xff\xfc\xff\xff\xff\x00\x00\xfd\xff\xfc\xff\xfe\xff\xff\xff\xfc\xff\xfd\xff\x01\x00\xff\xff\xfb\xff\xfe\xff\x02\x00\x01\x00\xf9\xff\xfb\xff\x02\x00\x01\x00\xfa\xff\xfc\xff\x02\x00\xfe\xff\xfb\xff\x00\x00\x00\x00\xfe\xff\xfc\xff\xff\xff\xff\xff\xfe\xff\xfc\xff\xfe\xff\xff\xff\xfd\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\xfd\xff\xfe\xff\xff\xff\xfe\xff\xfd\xff\xfe\xff\xfe\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\xfe\xff\x02\x00\xfe\xff\xfb\xff\xff\xff\x04\x00\xfd\xff\xf9\xff\x00\x00\x04\x00\xfe\xff\xf9\xff\xfe\xff\x04\x00\x00\x00\xfd\xff\xff\xff\x00\x00\xfe\xff\x00\x00\xff\xff\xfc\xff\xfd\xff\x03\x00\x02\x00\xfc\xff\xfc\xff\xff\xff\x02\x00\x00\x00\xfc\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xfb\xff\xfd\xff\x02\x00\x02\x00\xfd\xff\xfc\xff\x01\x00\x02\x00\xfe\xff\xff\xff\x01\x00\xfd\xff\xff\xff\x02\x00\xff\xff\xff\xff\x01\x00\xfe\xff\xfd\xff\x03\x00\x04\x00\xfb\xff\xfd\xff\x04\x00\x01\x00\xfd\xff\x00\x00\x03\x00\x01\x00\xfe\xff\xff\xff\x01\x00\x00\x00\x01\x00\xff\xff\xff\xff\x00\x00\x02\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x02\x00\xff\xff\x00\x00\x03\x00\x00\x00\x00\x00\x02\x00\x00\x00\x01\x00\x02\x00\xfe\xff\x00\x00\x05\x00\x02\x00\xfc\xff\x00\x00\x04\x00\x02\x00\x00\x00\x01\x00\x01\x00\x02\x00\x01\x00\xfd\xff\x01\x00\x06\x00\x00\x00\xfb\xff\x01\x00\b\x00\x01\x00\xfc\xff\xff\xff\x04\x00\x02\x00\x00\x00\xff\xff\x03\x00\x04\x00\xff\xff\xff\xff\x05\x00\x00\x00\xfe\xff\x02\x00\x04\x00\x01\x00\x00\x00\x02\x00\x02\x00\x03\x00\x02\x00\xfe\xff\x02\x00\x03\x00\x01\x00\x02\x00\x02\x00\xff\xff\x02\x00\x05\x00\x02\x00\xfe\xff\x03\x00\x04\x00\x02\x00\x02\x00\x00\x00\xfe\xff\x06\x00\x06\x00\xfd\xff\xfd\xff\b\x00\x03\x00\xfe\xff\x02\x00\x06\x00\xff\xff\xfd\xff\x03\x00\t\x00\x02\x00\xfc\xff\x01\x00\b\x00\x02\x00\x00\x00\x02\x00\x01\x00\x02\x00\a\x00\x00\x00\xfc\xff\x05\x00\a\x00\xfe\xff\x00\x00\x04\x00\x03\x00\x01\x00\x04\x00\x03\x00\xff\xff\x00\x00\x06\x00\x02\x00\xfc\xff\x00\x00\b\x00\x02\x00\xfe\xff\x01\x00\x05\x00\x01\x00\x01\x00\x02\x00\x01\x00\x01\x00\x04\x00\x02\x00\x01\x00\x01\x00\x04\x00\x02\x00\x01\x00\x00\x00\x02\x00\x06\x00\x02\x00\xfa\xff\x03\x00\n\x00\x00\x00\xf9\xff\x06\x00\b\x00\xff\xff\xff\xff\x04\x00\x02\x00\x02\x00\x04\x00\x02\x00\xfd\xff\x01\x00\a\x00\x05\x00\xfc\xff\xff\xff\x06\x00\x04\x00\xfd\xff\x01\x00\x05\x00\x02\x00\x00\x00\x02\x00\x03\x00\x03\x00\x00\x00\x00\x00\x03\x00\x03\x00\xff\xff\x02\x00\x06\x00\x02\x00\xfc\xff\x02\x00\a\x00\x03\x00\xfe\xff\x00\x00\x05\x00\x05\x00\x00\x00\xff\xff\x04\x00\x05\x00\x00\x00\x01\x00\x06\x00\x01\x00\xff\xff\x06\x00\x04\x00\xfc\xff\x00\x00\b\x00\x02\x00\xff\xff\x03\x00\x03\x00\x01\x00\x04\x00\x04\x00\x00\x00\x01\x00\x04\x00\x03\x00\x02\x00\x02\x00\x03\x00\x03\x00\x02\x00\x00\x00\x02\x00\x06\x00\x03\x00\xfd\xff\x02\x00\t\x00\x03\x00\xfd\xff\x02\x00\x05\x00\x02\x00\x02\x00\x02\x00\x02\x00\x03\x00\x04\x00\x01\x00\x01\x00\x04\x00\x03\x00\x00\x00\x03\x00\x04\x00\x00\x00\x00\x00\x04\x00\x05\x00\x00\x00\x00\x00\x04\x00\x05\x00\x02\x00\x00\x00\x03\x00\x03\x00\x00\x00\x01\x00\a\x00\x04\x00\xfc\xff\x01\x00\t\x00\x03\x00\xfc\xff\x00\x00\a\x00\x05\x00\x00\x00\x00\x00\x03\x00\x04\x00\x05\x00\x00\x00\xff\xff\x03\x00\x06\x00\x03\x00\xfe\xff\x00\x00\a\x00\x04\x00\xfe\xff\xff\xff\x06\x00\x02\x00\xff\xff\x03\x00\x04\x00\x00\x00\x00\x00\x01\x00\x03\x00\x03\x00\x01\x00\xfe\xff\x03\x00\x05\x00\xff\xff\xfd\xff\x05\x00\x04\x00\xff\xff\xfd\xff\x04\x00\x03\x00\xff\xff\x02\x00\x02\x00\xfd\xff\x03\x00\x06\x00\xff\xff\xfb\xff\x06\x00\x06\x00\xfc\xff\xfd\xff\x06\x00\x03\x00\xfe\xff\xff\xff\x04\x00\x01\x00\x00\x00\x02\x00\x03\x00\xff\xff\xfe\xff\x00\x00\x04\x00\x01\x00\x00\x00\x01\x00\xfe\xff\xff\xff\x05\x00\x02\x00\xfd\xff\xfd\xff\x05\x00\x01\x00\xfd\xff\x02\x00\x04\x00\xfc\xff\xfd\xff\x03\x00\x05\x00\xfd\xff\xfe\xff\xff\xff\x01\x00\x00\x00\xfe\xff\xff\xff\x04\x00\xfe\xff\xfb\xff\x01\x00\x06\x00\xfd\xff\xfb\xff\x00\x00\x04\x00\xff\xff\xfe\xff\xfe\xff\x01\x00\x00\x00\xff\xff\xff\xff\x00\x00\xff\xff\xff\xff\xfe\xff\x02\x00\x00\x00\xfc\xff\xfd\xff\x02\x00\x02\x00\x01\x00\xfc\xff\xfc\xff\x01\x00\x06\x00\xfe\xff\xfa\xff\xfe\xff\x03\x00\x02\x00\xff\xff\xfb\xff\xff\xff\x02\x00\x00\x00\xfc\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xfb\xff\x00\x00\x03\x00\x00\x00\xf9\xff\xfc\xff\x02\x00\x02\x00\xfd\xff\xfc\xff\xfe\xff\x01\x00\xfe\xff\xfd\xff\xfe\xff\x01\x00\xfd\xff\xfc\xff\xff\xff\x02\x00\xfe\xff\xfd\xff\xfd\xff\x01\x00\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\xfe\xff\xfe\xff\xfd\xff\x00\x00\xff\xff\xfd\xff\xfe\xff\x00\x00\xfd\xff\xff\xff\x00\x00\xfe\xff\xfa\xff\x01\x00\x03\x00\xfc\xff\xf7\xff\xff\xff\x05\x00\xfe\xff\xf9\xff\xfd\xff\x01\x00\x02\x00\xfb\xff\xfb\xff\xfe\xff\x00\x00\xff\xff\xfd\xff\xfc\xff\xfe\xff\xfe\xff\xfe\xff\xfd\xff\xff\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfd\xff\xfe\xff\xfc\xff\xfe\xff\xff\xff\xff\xff\xfd\xff\xff\xff\xfe\xff\xff\xff\xfd\xff\xfc\xff\xff\xff\x00\x00\xfb\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\xfd\xff\xfc\xff\xfd\xff\x00\x00\x01\x00\xfd\xff\xfb\xff\xfe\xff\x04\x00\xfe\xff\xf8\xff\xfd\xff\x04\x00\xfc\xff\xf8\xff\xfe\xff\x04\x00\xff\xff\xf8\xff\xfd\xff\x03\x00\xfd\xff\xfa\xff\xfc\xff\x00\x00\xff\xff\xfd\xff\xfc\xff\xfe\xff\x01\x00\xff\xff\xf9\xff\xfb\xff\x02\x00\x01\x00\xf9\xff\xfb\xff\x01\x00\x01\x00\xfa\xff\xfc\xff\x01\x00\xff\xff\xfc\xff\xfd\xff\xfe\xff\x00\x00\xfd\xff\xfe\xff\xff\xff\xfe\xff\xfb\xff\xfc\xff\x00\x00\x00\x00\xfd\xff\xfd\xff\xfd\xff\xfe\xff\x00\x00\x01\x00\xfd\xff\xf8\xff\xfd\xff\x06\x00\xfe\xff\xf9\xff\xff\xff\x00\x00\xfc\xff\xff\xff\x00\x00\xfc\xff\xfd\xff\x01\x00\xfd\xff\xfc\xff\x00\x00\xff\xff\xfc\xff\xfe\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\x01\x00\xfe\xff\xfb\xff\xfe\xff\x01\x00\xfd\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfb\xff\x00\x00\x03\x00\xfc\xff\xf9\xff\x01\x00\x04\x00\xfc\xff\xfa\xff\x03\x00\x01\x00\xfa\xff\xfd\xff\x03\x00\x00\x00\xfb\xff\xff\xff\x02\x00\xfd\xff\xfd\xff\x01\x00\x00\x00\xfb\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x00\x00\x00\x00\xfe\xff\xff\xff\xfe\xff\xfd\xff\x00\x00\x02\x00\xfd\xff\xfe\xff\x00\x00\x01\x00\xfe\xff\xff\xff\x02\x00\xfd\xff\xfc\xff\x04\x00\x03\x00\xfb\xff\xfb\xff\x05\x00\x02\x00\xfb\xff\x00\x00\x03\x00\xfe\xff\x00\x00\x01\x00\xff\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\x01\x00\x04\x00\xff\xff\xfe\xff\x01\x00\x03\x00\xff\xff\xff\xff\x01\x00\x01\x00\x00\x00\x00\x00\xfe\xff\x02\x00\x01\x00\x00\x00\x01\x00\x01\x00\xfe\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\a\x00\x03\x00\xfa\xff\x00\x00\x06\x00\xff\xff\x00\x00\x01\x00\x00\x00\x03\x00\x05\x00\xff\xff\xfc\xff\x02\x00\a\x00\x01\x00\xfd\xff\xfe\xff\x06\x00\x06\x00\xfd\xff\xfd\xff\x06\x00\x02\x00\xfe\xff\x02\x00\x05\x00\xfe\xff\xff\xff\x04\x00\x04\x00\xfe\xff\x02\x00\x04\x00\x01\x00\xfe\xff\x05\x00\x06\x00\xff\xff\xfe\xff\a\x00\x03\x00\xfd\xff\x03\x00\x05\x00\xfd\xff\x01\x00\x06\x00\x04\x00\xfb\xff\x01\x00\a\x00\x03\x00\xfc\xff\x02\x00\a\x00\x03\x00\xfe\xff\x03\x00\x03\x00\x00\x00\x03\x00\x05\x00\xfd\xff\x03\x00\x05\x00\x01\x00\x02\x00\x06\x00\x00\x00\xfd\xff\x04\x00\b\x00\xfe\xff\xfe\xff\x03\x00\x06\x00\x02\x00\x03\x00\x02\x00\x02\x00\x01\x00\x04\x00\x02\x00\x02\x00\x04\x00\x04\x00\xff\xff\x02\x00\x06\x00\x05\x00\xff\xff\x01\x00\x04\x00\x04\x00\x02\x00\x05\x00\x01\x00\x00\x00\x05\x00\t\x00\xff\xff\xfe\xff\x06\x00\b\x00\xff\xff\xfe\xff\x03\x00\v\x00\x04\x00\xfb\xff\xfd\xff\r\x00\t\x00\xfa\xff\xfc\xff\f\x00\b\x00\xfe\xff\xfe\xff\x06\x00\x06\x00\x04\x00\x01\x00\x00\x00\x02\x00\a\x00\x04\x00\x02\x00\x01\x00\x03\x00\x02\x00\x06\x00\x05\x00\x01\x00\xff\xff\x06\x00\a\x00\x01\x00\x00\x00\a\x00\x04\x00\x02\x00\x02\x00\x05\x00\x05\x00\x03\x00\x00\x00\x04\x00\x05\x00\x05\x00\x01\x00\x02\x00\x05\x00\x06\x00\x01\x00\x02\x00\x05\x00\x05\x00\xff\xff\x03\x00\b\x00\x06\x00\xfe\xff\xff\xff\t\x00\n\x00\xfd\xff\xff\xff\a\x00\b\x00\x01\x00\x02\x00\x06\x00\x04\x00\x03\x00\x04\x00\x04\x00\x05\x00\x03\x00\x04\x00\x03\x00\x04\x00\x06\x00\x04\x00\x00\x00\x04\x00\x06\x00\x04\x00\x01\x00\x05\x00\x05\x00\x04\x00\x02\x00\x03\x00\a\x00\x06\x00\x01\x00\x03\x00\x05\x00\x04\x00\x03\x00\x05\x00\x02\x00\x02\x00\a\x00\a\x00\x01\x00\x03\x00\x04\x00\x04\x00\x04\x00\x04\x00\x02\x00\x04\x00\x06\x00\x05\x00\x01\x00\x03\x00\x05\x00\x04\x00\x01\x00\x02\x00\x06\x00\b\x00\xff\xff\xff\xff\x06\x00\b\x00\x02\x00\x01\x00\x04\x00\x03\x00\x03\x00\x06\x00\x02\x00\x01\x00\x04\x00\x06\x00\x02\x00\x02\x00\x04\x00\x06\x00\x01\x00\x01\x00\x05\x00\x06\x00\x02\x00\x02\x00\x03\x00\x04\x00\x03\x00\x04\x00\x03\x00\x02\x00\x03\x00\x05\x00\x04\x00\x01\x00\x02\x00\a\x00\x04\x00\x00\x00\x00\x00\x05\x00\x05\x00\x04\x00\xfe\xff\x00\x00\x06\x00\a\x00\x01\x00\x01\x00\x03\x00\x03\x00\x03\x00\x05\x00\x00\x00\x00\x00\x05\x00\x05\x00\xff\xff\x00\x00\b\x00\b\x00\xfc\xff\xfd\xff\b\x00\a\x00\xff\xff\x01\x00\x03\x00\x02\x00\x04\x00\x05\x00\xff\xff\xfe\xff\x04\x00\x06\x00\x00\x00\xff\xff\x03\x00\x06\x00\x01\x00\xff\xff\x02\x00\x04\x00\x01\x00\x00\x00\x02\x00\a\x00\x03\x00\xfd\xff\x02\x00\b\x00\x01\x00\xfc\xff\x01\x00\a\x00\x04\x00\xff\xff\xfe\xff\x04\x00\x05\x00\x03\x00\xff\xff\x00\x00\x04\x00\x06\x00\x02\x00\xfc\xff\x02\x00\b\x00\x01\x00\xfd\xff\x02\x00\a\x00\x04\x00\xfe\xff\xff\xff\x03\x00\x04\x00\x00\x00\x00\x00\x04\x00\x02\x00\xff\xff\x02\x00\x06\x00\x01\x00\xfd\xff\x02\x00\x04\x00\x01\x00\xff\xff\x03\x00\x03\x00\xff\xff\xff\xff\x02\x00\x02\x00\xff\xff\xff\xff\x02\x00\x02\x00\x01\x00\x00\x00\x01\x00\x02\x00\x00\x00\xff\xff\x02\x00\x02\x00\x02\x00\x01\x00\x00\x00\x00\x00\x02\x00\x01\x00\xfd\xff\x01\x00\x04\x00\x00\x00\xfe\xff\x02\x00\x03\x00\x00\x00\xfe\xff\x00\x00\x02\x00\x02\x00\x00\x00\xff\xff\x01\x00\x02\x00\xff\xff\xff\xff\x02\x00\x01\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\xfd\xff\xfd\xff\x00\x00\x02\x00\x01\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\x01\x00\x00\x00\xfd\xff\xfe\xff\x00\x00\xff\xff\x00\x00\xfe\xff\xfc\xff\x00\x00\x02\x00\xfe\xff\xfc\xff\xfe\xff\x02\x00\x00\x00\xfd\xff\xfd\xff\x01\x00\x00\x00\xfa\xff\xff\xff\x03\x00\xfd\xff\xfb\xff\xff\xff\x01\x00\xfc\xff\xfe\xff\x00\x00\xfe\xff\xfc\xff\xff\xff\x01\x00\x00\x00\xfb\xff\xfd\xff\x02\x00\x00\x00\xf9\xff\xff\xff\x03\x00\xfe\xff\xfb\xff\xff\xff\xff\xff\xff\xff\xfe\xff\xfb\xff\xfd\xff\x02\x00\xfd\xff\xfa\xff\xfe\xff\x01\x00\xfe\xff\xff\xff\xfc\xff\xfc\xff\x00\x00\x00\x00\xfb\xff\xfb\xff\xff\xff\xfe\xff\xfc\xff\xff\xff\xfe\xff\x00\x00\xfc\xff\xf8\xff\xfc\xff\x03\x00\x00\x00\xf9\xff\xfa\xff\x01\x00\x01\x00\xfc\xff\xfb\xff\xfe\xff\xfd\xff\xff\xff\xfe\xff\xfb\xff\xfe\xff\x01\x00\xfa\xff\xfb\xff\x00\x00\xff\xff\xfc\xff\xfe\xff\xfd\xff\xfd\xff\x00\x00\x00\x00\xfb\xff\xfd\xff\x00\x00\xfe\xff\xfb\xff\xfe\xff\x01\x00\xfe\xff\xfa\xff\xfe\xff\x02\x00\xfd\xff\xf7\xff\xff\xff\x02\x00\xfc\xff\xfb\xff\x00\x00\xff\xff\xfd\xff\xff\xff\xfb\xff\xfc\xff\x03\x00\xfd\xff\xf6\xff\xfe\xff\x06\x00\xfc\xff\xf5\xff\xfe\xff\x04\x00\xfd\xff\xf9\xff\xfd\xff\xff\xff\xfc\xff\xfd\xff\x00\x00\xfe\xff\xfb\xff\xfb\xff\xff\xff\x01\x00\xfc\xff\xf8\xff\xfc\xff\x03\x00\xff\xff\xf8\xff\xfb\xff\x02\x00\xfd\xff\xfb\xff\xff\xff\xfd\xff\xfa\xff\x00\x00\x01\x00\xfa\xff\xfb\xff\x02\x00\xfd\xff\xfc\xff\xfe\xff\xfe\xff\xfd\xff\xfd\xff\xfb\xff\xfe\xff\x00\x00\xfc\xff\xf9\xff\x00\x00\xff\xff\xfc\xff\xfd\xff\xfe\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfe\xff\xfe\xff\xfc\xff\xfc\xff\xfe\xff\xff\xff\xfa\xff\xfb\xff\xfe\xff\xff\xff\xfd\xff\xfe\xff\xfb\xff\xfb\xff\xff\xff\x00\x00\xfb\xff\xfc\xff\xff\xff\xfc\xff\xfc\xff\x03\x00\xfd\xff\xf7\xff\xfb\xff\x04\x00\x01\x00\xf9\xff\xf8\xff\x00\x00\x04\x00\xfd\xff\xf4\xff\xfe\xff\x05\x00\xfe\xff\xf9\xff\xff\xff\xff\xff\xfe\xff\xfe\xff\xfc\xff\xfd\xff\x01\x00\xfc\xff\xf9\xff\xff\xff\x02\x00\xfc\xff\xfc\xff\xfd\xff\xfc\xff\xfd\xff\x02\x00\xfd\xff\xfb\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfd\xff\x00\x00\xfc\xff\xfd\xff\xff\xff\x00\x00\xfc\xff\xfc\xff\xff\xff\x00\x00\xfe\xff\xfc\xff\xff\xff\x00\x00\xfc\xff\xfe\xff\x02\x00\x01\x00\xfa\xff\xfb\xff\x02\x00\x01\x00\xfc\xff\xfc\xff\xfe\xff\x00\x00\xfe\xff\xfd\xff\xfc\xff\x01\x00\xfe\xff\xfa\xff\xfd\xff\x05\x00\x00\x00\xf9\xff\xfc\xff\x01\x00\x01\x00\xff\xff\xfb\xff\xfd\xff\xff\xff\x01\x00\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\x01\x00\xff\xff\xfc\xff\xfd\xff\x05\x00\x01\x00\xf9\xff\xfd\xff\x04\x00\x00\x00\xfc\xff\xfe\xff\x01\x00\xff\xff\x00\x00\xfc\xff\xfd\xff\x03\x00\x02\x00\xfb\xff\xfe\xff\x01\x00\xff\xff\x00\x00\x02\x00\xfb\xff\xfc\xff\x03\x00\x05\x00\xfb\xff\xfb\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\x01\x00\x03\x00\xfd\xff\xff\xff\x01\x00\xff\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\xff\xff\x05\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x00\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x06\x00\xfe\xff\xfe\xff\x00\x00\x00\x00\x01\x00\x03\x00\xfe\xff\xff\xff\x01\x00\x03\x00\xff\xff\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\xfd\xff\x03\x00\x05\x00\xff\xff\xfa\xff\x05\x00\b\x00\xfd\xff\xf9\xff\x05\x00\a\x00\xfe\xff\xfa\xff\x05\x00\x06\x00\xfc\xff\xfc\xff\a\x00\x05\x00\xfc\xff\xfc\xff\x06\x00\x03\x00\x00\x00\xfe\xff\x00\x00\x03\x00\x04\x00\xfe\xff\xff\xff\x06\x00\x06\x00\xfb\xff\xfd\xff\a\x00\x05\x00\xfc\xff\x00\x00\x04\x00\x03\x00\xff\xff\x02\x00\x06\x00\x03\x00\xfc\xff\x00\x00\b\x00\x05\x00\xfd\xff\x00\x00\x03\x00\x05\x00\x03\x00\x00\x00\x01\x00\x05\x00\x02\x00\x01\x00\x04\x00\x01\x00\xfd\xff\x06\x00\x06\x00\xfd\xff\xfd\xff\b\x00\x06\x00\xfe\xff\xff\xff\a\x00\x05\x00\x00\x00\x01\x00\x03\x00\x01\x00\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x04\x00\x04\x00\x00\x00\x01\x00\x03\x00\x04\x00\x04\x00\x01\x00\x01\x00\x05\x00\x05\x00\x02\x00\x02\x00\x03\x00\x03\x00\x03\x00\x04\x00\x03\x00\x01\x00\x03\x00\x03\x00\x03\x00\x04\x00\x03\x00\x01\x00\x02\x00\x04\x00\x05\x00\x03\x00\x01\x00\x03\x00\a\x00\x03\x00\x00\x00\x04\x00\x05\x00\x02\x00\x02\x00\x05\x00\x03\x00\x01\x00\x03\x00\x03\x00\x04\x00\x02\x00\x02\x00\x05\x00\x05\x00\x03\x00\x02\x00\x06\x00\x04\x00\x01\x00\x03\x00\x06\x00\x03\x00\xff\xff\x04\x00\b\x00\x01\x00\xfe\xff\x06\x00\a\x00\x02\x00\x02\x00\x04\x00\x03\x00\x03\x00\x05\x00\x01\x00\x02\x00\x06\x00\x04\x00\x01\x00\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x01\x00\x03\x00\x06\x00\x02\x00\xff\xff\x06\x00\t\x00\x00\x00\xfe\xff\t\x00\t\x00\x01\x00\xfe\xff\x05\x00\b\x00\a\x00\xff\xff\xff\xff\a\x00\a\x00\xff\xff\x01\x00\a\x00\x05\x00\xfe\xff\x02\x00\a\x00\x05\x00\x01\x00\x02\x00\x03\x00\x06\x00\x05\x00\x04\x00\xfe\xff\x01\x00\x06\x00\x05\x00\xff\xff\xff\xff\b\x00\a\x00\xfc\xff\x01\x00\t\x00\x05\x00\xfc\xff\x02\x00\x06\x00\x04\x00\x04\x00\x03\x00\xff\xff\x06\x00\b\x00\x00\x00\xfd\xff\b\x00\x06\x00\xff\xff\x00\x00\a\x00\x03\x00\x00\x00\x02\x00\x04\x00\x02\x00\x03\x00\x02\x00\x05\x00\x03\x00\x01\x00\x00\x00\b\x00\x04\x00\xfd\xff\xff\xff\n\x00\x05\x00\xfb\xff\xfc\xff\b\x00\t\x00\xfe\xff\xfa\xff\x06\x00\a\x00\x02\x00\xff\xff\x03\x00\x02\x00\x03\x00\x02\x00\x05\x00\x01\x00\xff\xff\x03\x00\b\x00\xff\xff\xfe\xff\x05\x00\x06\x00\xfe\xff\x01\x00\x03\x00\x03\x00\xff\xff\x03\x00\x02\x00\x02\x00\x02\x00\x03\x00\x00\x00\x02\x00\x03\x00\x03\x00\xfe\xff\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\xfe\xff\x02\x00\x04\x00\x02\x00\xff\xff\x03\x00\x01\x00\x01\x00\x01\x00\x05\x00\xff\xff\x01\x00\x04\x00\x03\x00\xfd\xff\x01\x00\x04\x00\x03\x00\xfd\xff\xff\xff\x02\x00\a\x00\x01\x00\xfc\xff\xff\xff\b\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x01\x00\x03\x00\xff\xff\x00\x00\x03\x00\x03\x00\xfb\xff\x01\x00\x05\x00\x00\x00\xfa\xff\x05\x00\x06\x00\xfd\xff\xfb\xff\x05\x00\x02\x00\xfe\xff\xff\xff\x05\x00\x00\x00\x00\x00\x00\x00\x04\x00\xff\xff\x01\x00\x00\x00\x00\x00\xff\xff\x04\x00\x00\x00\xff\xff\x00\x00\x05\x00\xfd\xff\xfd\xff\x02\x00\x03\x00\xfd\xff\xff\xff\x01\x00\x03\x00\xfd\xff\xfd\xff\xff\xff\x06\x00\x01\x00\xfb\xff\xfb\xff\x05\x00\x03\x00\xfd\xff\xfc\xff\x03\x00\xfe\xff\xff\xff\x01\x00\x01\x00\xfa\xff\xff\xff\x03\x00\x01\x00\xfb\xff\x00\x00\x01\x00\x04\x00\xfd\xff\xfb\xff\xfd\xff\a\x00\x01\x00\xfa\xff\xfa\xff\x04\x00\x03\x00\xfc\xff\xf9\xff\x03\x00\x02\x00\xfe\xff\xfc\xff\xff\xff\xfe\xff\x01\x00\xfe\xff\xfd\xff\xfd\xff\x00\x00\xff\xff\x01\x00\xfb\xff\xfb\xff\x00\x00\x02\x00\xf9\xff\xfd\xff\x01\x00\x00\x00\xf9\xff\xfb\xff\x00\x00\x02\x00\xfb\xff\xfc\xff\xfc\xff\x02\x00\xfe\xff\xfd\xff\xf9\xff\xfd\xff\x00\x00\x00\x00\xf8\xff\xfb\xff\x00\x00\x00\x00\xf8\xff\xfb\xff\x00\x00\xff\xff\xf8\xff\xfd\xff\xff\xff\xfc\xff\xfa\xff\xff\xff\xfd\xff\xfc\xff\xfb\xff\xff\xff\xfd\xff\xfc\xff\xfa\xff\xfd\xff\xff\xff\xfd\xff\xf6\xff\xfd\xff\x00\x00\xfe\xff\xf7\xff\xfb\xff\xff\xff\xfc\xff\xf8\xff\xff\xff\xfe\xff\xf8\xff\xf7\xff\x02\x00\x00\x00\xf7\xff\xf5\xff\x00\x00\xff\xff\xfa\xff\xf7\xff\xfb\xff\xff\xff\xfe\xff\xf7\xff\xf9\xff\xfd\xff\xff\xff\xf9\xff\xfb\xff\xfa\xff\xfb\xff\xfd\xff\xfc\xff\xf7\xff\xf9\xff\xfc\xff\xfe\xff\xfc\xff\xf9\xff\xf7\xff\xfe\xff\x01\x00\xfa\xff\xf3\xff\xfe\xff\x02\x00\xf9\xff\xf4\xff\xff\xff\x00\x00\xfa\xff\xf7\xff\xfb\xff\xfd\xff\xff\xff\xf7\xff\xf6\xff\x00\x00\x01\x00\xf3\xff\xf6\xff\x04\x00\xfe\xff\xf2\xff\xfb\xff\x02\x00\xfa\xff\xf6\xff\xff\xff\xfc\xff\xf7\xff\xfa\xff\xfe\xff\xfb\xff\xf9\xff\xfa\xff\xfd\xff\xfc\xff\xf9\xff\xf7\xff\xfd\xff\xfe\xff\xfa\xff\xf9\xff\xfd\xff\xfb\xff\xfb\xff\xfc\xff\xfc\xff\xf8\xff\xfa\xff\xfe\xff\xfb\xff\xf7\xff\xfc\xff\xfe\xff\xfc\xff\xf9\xff\xfb\xff\xfc\xff\xfc\xff\xfc\xff\xfa\xff\xf9\xff\xfe\xff\xfd\xff\xf9\xff\xf9\xff\xfe\xff\xfd\xff\xf7\xff\xfa\xff\x00\x00\xfc\xff\xf7\xff\xf9\xff\xfd\xff\xfd\xff\xfb\xff\xfa\xff\xfa\xff\xfd\xff\xfe\xff\xfb\xff\xfa\xff\xfb\xff\xfb\xff\xfc\xff\xfd\xff\xfa\xff\xf9\xff\xfd\xff\xfe\xff\xfa\xff\xf8\xff\xfe\xff\xfd\xff\xf8\xff\xf8\xff\xfe\xff\x00\x00\xf9\xff\xf5\xff\xfd\xff\x02\x00\xfb\xff\xf4\xff\xfc\xff\xff\xff\xfa\xff\xfa\xff\xfe\xff\xfc\xff\xfa\xff\xfa\xff\xfe\xff\xfc\xff\xfa\xff\xf9\xff\xfb\xff\xfe\xff\xfd\xff\xf8\xff\xfc\xff\xff\xff\xfb\xff\xf7\xff\xfd\xff\xfe\xff\xfa\xff\xf8\xff\xfe\xff\xfc\xff\xfa\xff\xfa\xff\x00\x00\xfd\xff\xf9\xff\xf9\xff\x00\x00\xfe\xff\xf9\xff\xf8\xff\xff\xff\xfe\xff\xfa\xff\xfb\xff\x00\x00\xf9\xff\xfb\xff\xfe\xff\xfc\xff\xf7\xff\xfe\xff\xfe\xff\xfa\xff\xf9\xff\xff\xff\xfc\xff\xfd\xff\xfc\xff\xfd\xff\xf9\xff\xfd\xff\xfd\xff\xfd\xff\xfa\xff\xfc\xff\xfd\xff\x00\x00\xfb\xff\xfb\xff\xfc\xff\x00\x00\xfa\xff\xfb\xff\xfe\xff\x00\x00\xfa\xff\xfc\xff\xfb\xff\xff\xff\xfd\xff\xff\xff\xfa\xff\xfc\xff\xfe\xff\xff\xff\xfa\xff\xfe\xff\xfd\xff\xfe\xff\xfa\xff\x00\x00\xfd\xff\xfd\xff\xfb\xff\xff\xff\xff\xff\x01\x00\xfa\xff\xfd\xff\x00\x00\x01\x00\xfa\xff\x00\x00\xfd\xff\xfd\xff\xfe\xff\x03\x00\xfb\xff\xff\xff\xff\xff\xfe\xff\xfc\xff\x03\x00\xff\xff\xfd\xff\xfc\xff\x03\x00\xfd\xff\xfe\xff\x00\x00\x02\x00\xfc\xff\xff\xff\x01\x00\x03\x00\xfd\xff\x01\x00\xfc\xff\x00\x00\x01\x00\x02\x00\xfb\xff\x00\x00\x00\x00\x01\x00\xfe\xff\x03\x00\xfe\xff\x00\x00\xfe\xff\x03\x00\x02\x00\x02\x00\xfc\xff\x01\x00\x00\x00\x03\x00\x00\x00\x03\x00\xfc\xff\x01\x00\x05\x00\x04\x00\xfa\xff\x00\x00\x04\x00\x06\x00\xfd\xff\xff\xff\x02\x00\x05\x00\xfc\xff\x03\x00\x05\x00\x01\x00\xf9\xff\a\x00\b\x00\xff\xff\xfb\xff\b\x00\x04\x00\x01\x00\xfd\xff\x06\x00\x03\x00\x04\x00\xff\xff\x02\x00\x03\x00\b\x00\x02\x00\xfe\xff\xfe\xff\n\x00\x06\x00\x02\x00\xfb\xff\x03\x00\a\x00\b\x00\xfc\xff\x00\x00\x05\x00\b\x00\x00\x00\x04\x00\x00\x00\x02\x00\x06\x00\b\x00\xfd\xff\x00\x00\x03\x00\n\x00\x03\x00\x01\x00\x00\x00\x06\x00\x04\x00\x05\x00\x01\x00\x04\x00\x01\x00\x06\x00\x03\x00\x05\x00\x00\x00\x05\x00\x05\x00\x05\x00\xfe\xff\x06\x00\x06\x00\x04\x00\x02\x00\x05\x00\xff\xff\b\x00\b\x00\x00\x00\xfd\xff\r\x00\b\x00\xfe\xff\xfe\xff\n\x00\x06\x00\x03\x00\xfe\xff\x06\x00\x06\x00\x06\x00\x01\x00\x05\x00\x04\x00\x04\x00\x00\x00\a\x00\x05\x00\x03\x00\x01\x00\t\x00\x05\x00\x01\x00\x01\x00\v\x00\x06\x00\xfe\xff\xfe\xff\r\x00\n\x00\xfe\xff\xfc\xff\f\x00\b\x00\x00\x00\x01\x00\t\x00\x03\x00\x03\x00\x03\x00\x05\x00\x05\x00\x06\x00\xff\xff\x04\x00\a\x00\x06\x00\xff\xff\x06\x00\x06\x00\x04\x00\x04\x00\a\x00\x02\x00\x03\x00\x06\x00\a\x00\x01\x00\x06\x00\x05\x00\x03\x00\x01\x00\a\x00\x06\x00\x04\x00\x00\x00\x05\x00\b\x00\t\x00\xff\xff\x00\x00\n\x00\t\x00\xfe\xff\x03\x00\t\x00\x06\x00\xfe\xff\a\x00\t\x00\x04\x00\x00\x00\x05\x00\a\x00\a\x00\x02\x00\x02\x00\x04\x00\t\x00\x05\x00\x03\x00\x01\x00\a\x00\a\x00\x02\x00\x02\x00\n\x00\x04\x00\x00\x00\x06\x00\n\x00\x00\x00\x03\x00\t\x00\x05\x00\xff\xff\b\x00\n\x00\x03\x00\x01\x00\a\x00\a\x00\x03\x00\x03\x00\x06\x00\x04\x00\x05\x00\x06\x00\x06\x00\x03\x00\x05\x00\x05\x00\x04\x00\x03\x00\a\x00\x05\x00\x02\x00\a\x00\t\x00\x00\x00\x04\x00\v\x00\x05\x00\xfc\xff\x06\x00\r\x00\x03\x00\xff\xff\x06\x00\b\x00\x05\x00\x02\x00\x04\x00\x06\x00\x06\x00\x02\x00\x04\x00\a\x00\x04\x00\x04\x00\x06\x00\x03\x00\x05\x00\x06\x00\x03\x00\x03\x00\a\x00\x04\x00\x00\x00\a\x00\v\x00\x02\x00\xfe\xff\x05\x00\f\x00\x06\x00\xfb\xff\x02\x00\x0e\x00\x06\x00\xfc\xff\x03\x00\v\x00\x04\x00\xff\xff\x05\x00\x06\x00\x02\x00\x05\x00\x06\x00\x02\x00\x01\x00\x05\x00\a\x00\x03\x00\x00\x00\x04\x00\a\x00\x04\x00\x00\x00\x02\x00\x05\x00\x02\x00\x03\x00\x05\x00\x01\x00\x00\x00\x06\x00\a\x00\xff\xff\xfe\xff\x06\x00\x05\x00\x01\x00\x02\x00\x03\x00\x01\x00\x03\x00\x05\x00\x00\x00\xff\xff\x05\x00\x05\x00\xff\xff\xff\xff\x05\x00\x02\x00\x00\x00\x02\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x02\x00\x02\x00\xfe\xff\x03\x00\x04\x00\xfe\xff\xfe\xff\x06\x00\x04\x00\xfc\xff\xfe\xff\x05\x00\x02\x00\x01\x00\x01\x00\xff\xff\xff\xff\x04\x00\x02\x00\xff\xff\xfe\xff\x01\x00\x02\x00\x02\x00\xfc\xff\xfd\xff\x04\x00\x03\x00\xfa\xff\xfc\xff\x05\x00\x04\x00\xfa\xff\xff\xff\x04\x00\x00\x00\xfc\xff\x03\x00\x02\x00\xfc\xff\xfb\xff\x01\x00\x03\x00\x01\x00\xfa\xff\xfd\xff\x03\x00\x01\x00\xfb\xff\xff\xff\x01\x00\x00\x00\xfd\xff\xfe\xff\xff\xff\x02\x00\xfe\xff\xfe\xff\xfe\xff\x00\x00\x00\x00\x01\x00\xfb\xff\xfb\xff\x02\x00\x03\x00\xf9\xff\xfc\xff\x03\x00\x00\x00\xf8\xff\xff\xff\x04\x00\xfe\xff\xf7\xff\xff\xff\x03\x00\x01\x00\xf7\xff\xfc\xff\x01\x00\x00\x00\xfa\xff\xfc\xff\xff\xff\xff\xff\xfb\xff\xfe\xff\xfd\xff\xff\xff\xfc\xff\xfd\xff\xfd\xff\xff\xff\xfc\xff\xfc\xff\xfe\xff\x02\x00\xf9\xff\xfa\xff\xfd\xff\x03\x00\xfe\xff\xf8\xff\xf9\xff\x02\x00\xfe\xff\xf9\xff\xfa\xff\x01\x00\xfc\xff\xfe\xff\xfc\xff\xfb\xff\xfe\xff\x02\x00\xf6\xff\xf9\xff\x02\x00\x02\x00\xf6\xff\xf9\xff\x00\x00\x01\x00\xf8\xff\xfa\xff\xfc\xff\xff\xff\xfa\xff\xfd\xff\xfc\xff\xfa\xff\xfb\xff\x01\x00\xfa\xff\xfa\xff\xfc\xff\xff\xff\xf9\xff\xfc\xff\xfd\xff\xfb\xff\xf6\xff\xff\xff\xff\xff\xf9\xff\xf5\xff\xff\xff\xfd\xff\xfa\xff\xf9\xff\xfc\xff\xf6\xff\xfd\xff\xfe\xff\xfa\xff\xf6\xff\xff\xff\xfb\xff\xf8\xff\xfa\xff\xff\xff\xf7\xff\xf8\xff\xfd\xff\xfe\xff\xf4\xff\xf9\xff\xff\xff\xfe\xff\xf5\xff\xf9\xff\xfc\xff\xfe\xff\xf9\xff\xfa\xff\xf7\xff\xfc\xff\xfe\xff\xfa\xff\xf4\xff\xfe\xff\xff\xff\xf6\xff\xf2\xff\x00\x00\x00\x00\xf8\xff\xf3\xff\xfc\xff\xfc\xff\xfd\xff\xf7\xff\xf7\xff\xf9\xff\xfe\xff\xf9\xff\xf8\xff\xf7\xff\xfb\xff\xfa\xff\xfb\xff\xf5\xff\xf7\xff\xfc\xff\xfe\xff\xf6\xff\xf8\xff\xfa\xff\xfb\xff\xf6\xff\xfb\xff\xfb\xff\xf9\xff\xf4\xff\xfa\xff\xfe\xff\xfe\xff\xf3\xff\xf5\xff\xfa\xff\xff\xff\xf9\xff\xf5\xff\xf7\xff\x01\x00\xfa\xff\xf3\xff\xf7\xff\x02\x00\xfa\xff\xf3\xff\xf7\xff\xfe\xff\xfa\xff\xfb\xff\xf7\xff\xf7\xff\xfa\xff\xff\xff\xf8\xff\xf6\xff\xf7\xff\xfc\xff\xfb\xff\xf9\xff\xf6\xff\xfd\xff\xfc\xff\xf9\xff\xf7\xff\xfc\xff\xf7\xff\xf7\xff\xfb\xff\xff\xff\xf5\xff\xf4\xff\xff\xff\x01\x00\xf3\xff\xf4\xff\xfe\xff\x00\x00\xf2\xff\xf6\xff\xff\xff\xfe\xff\xf5\xff\xf9\xff\xfb\xff\xfb\xff\xf8\xff\xfd\xff\xf7\xff\xf8\xff\xfb\xff\xfb\xff\xf8\xff\xfc\xff\xf9\xff\xf8\xff\xfa\xff\xfd\xff\xf8\xff\xfb\xff\xfb\xff\xfb\xff\xf7\xff\xfc\xff\xfd\xff\xfb\xff\xf6\xff\xfc\xff\xfe\xff\xfb\xff\xf6\xff\xfc\xff\xfc\xff\xfb\xff\xfb\xff\xfb\xff\xf9\xff\xfc\xff\xfc\xff\xfc\xff\xfa\xff\xfd\xff\xf9\xff\xf9\xff\xff\xff\x00\x00\xf5\xff\xf8\xff\xff\xff\xff\xff\xf9\xff\xfe\xff\xfc\xff\xf6\xff\xfb\xff\x04\x00\xfa\xff\xf7\xff\xfc\xff\xff\xff\xfa\xff\xfd\xff\xfd\xff\xfa\xff\xfa\xff\xff\xff\xfc\xff\xfb\xff\xfc\xff\xfc\xff\xf9\xff\xfd\xff\xff\xff\xfc\xff\xf9\xff\xfd\xff\xff\xff\xfd\xff\xfa\xff\xfe\xff\x00\x00\xfc\xff\xf8\xff\xfe\xff\x01\x00\xfe\xff\xf9\xff\xfc\xff\x01\x00\xff\xff\xf7\xff\xfb\xff\x05\x00\xff\xff\xf5\xff\xff\xff\x03\x00\xf9\xff\xfb\xff\x01\x00\xfd\xff\xfc\xff\x00\x00\xfe\xff\xfc\xff\x01\x00\xfd\xff\xf9\xff\x00\x00\x02\x00\xf9\xff\xfb\xff\x02\x00\x00\x00\xfa\xff\xfc\xff\x01\x00\xff\xff\xfb\xff\xfd\xff\x00\x00\xfe\xff\xfd\xff\xff\xff\x02\x00\xff\xff\xfa\xff\xfc\xff\x03\x00\x04\x00\xf9\xff\xf6\xff\x01\x00\x06\x00\x01\x00\xfb\xff\xfa\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xf8\xff\x00\x00\x05\x00\xfe\xff\xfc\xff\x02\x00\x00\x00\xfc\xff\xfd\xff\x04\x00\x00\x00\xfc\xff\xfd\xff\x02\x00\x03\x00\xfd\xff\xfc\xff\x05\x00\xff\xff\xfa\xff\x01\x00\x05\x00\xfe\xff\xfb\xff\x01\x00\x05\x00\xfe\xff\xff\xff\x00\x00\x00\x00\x00\x00\x02\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\xff\xff\xff\xff\x00\x00\x03\x00\x01\x00\xfe\xff\xff\xff\x04\x00\x04\x00\xfe\xff\xfb\xff\x04\x00\x05\x00\x01\x00\xfb\xff\x01\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x02\x00\x00\x00\x00\x00\x06\x00\x01\x00\xfd\xff\x01\x00\x06\x00\x01\x00\x00\x00\x02\x00\x04\x00\xff\xff\x02\x00\x05\x00\x02\x00\xfd\xff\x03\x00\x06\x00\x03\x00\xff\xff\x02\x00\x02\x00\x04\x00\x03\x00\x02\x00\x01\x00\x05\x00\x02\x00\xff\xff\x02\x00\b\x00\x03\x00\xfe\xff\x00\x00\b\x00\x04\x00\x01\x00\x00\x00\x04\x00\x04\x00\x03\x00\x02\x00\x05\x00\x01\x00\x02\x00\x04\x00\x06\x00\x00\x00\x01\x00\x06\x00\x06\x00\xfe\xff\x02\x00\x06\x00\x06\x00\x01\x00\x03\x00\x06\x00\x05\x00\xff\xff\x03\x00\x05\x00\a\x00\x00\x00\x02\x00\x04\x00\x05\x00\x02\x00\a\x00\x03\x00\xff\xff\x02\x00\v\x00\x04\x00\xff\xff\x03\x00\t\x00\x01\x00\x03\x00\x05\x00\x03\x00\x01\x00\a\x00\x03\x00\x03\x00\x04\x00\a\x00\x02\x00\x04\x00\x04\x00\x06\x00\x03\x00\x04\x00\x03\x00\a\x00\x05\x00\x03\x00\x02\x00\x05\x00\x03\x00\a\x00\x04\x00\x04\x00\x01\x00\x06\x00\x06\x00\x04\x00\x02\x00\x05\x00\x04\x00\x06\x00\x01\x00\x06\x00\x05\x00\x04\x00\x02\x00\a\x00\a\x00\x04\x00\xff\xff\x05\x00\b\x00\x06\x00\xfe\xff\x02\x00\x06\x00\b\x00\x03\x00\x04\x00\x01\x00\x05\x00\a\x00\b\x00\x01\x00\x00\x00\x06\x00\v\x00\x03\x00\x00\x00\x02\x00\t\x00\x04\x00\x02\x00\x01\x00\a\x00\a\x00\x05\x00\xff\xff\x06\x00\b\x00\x04\x00\x00\x00\x06\x00\x05\x00\x06\x00\x02\x00\x05\x00\x06\x00\t\x00\x02\x00\x01\x00\a\x00\b\x00\x00\x00\x04\x00\x05\x00\x06\x00\x03\x00\x05\x00\x03\x00\x05\x00\x05\x00\x05\x00\x02\x00\x05\x00\x03\x00\x06\x00\x05\x00\x04\x00\x03\x00\a\x00\x02\x00\x03\x00\x06\x00\a\x00\xfe\xff\x03\x00\b\x00\x06\x00\x00\x00\x04\x00\x05\x00\x06\x00\x03\x00\x06\x00\x04\x00\x03\x00\x03\x00\a\x00\x02\x00\x01\x00\x04\x00\b\x00\x01\x00\x03\x00\x05\x00\b\x00\x03\x00\x02\x00\x02\x00\b\x00\x04\x00\x00\x00\x04\x00\n\x00\x03\x00\xff\xff\x02\x00\n\x00\x04\x00\x00\x00\x01\x00\b\x00\x05\x00\x03\x00\x01\x00\x03\x00\x02\x00\a\x00\x02\x00\x01\x00\x03\x00\b\x00\x03\x00\x01\x00\xff\xff\a\x00\b\x00\x00\x00\xfc\xff\x06\x00\a\x00\x03\x00\xfe\xff\x04\x00\x05\x00\x03\x00\xfe\xff\x05\x00\x06\x00\x00\x00\xfe\xff\b\x00\x05\x00\xfd\xff\xfd\xff\n\x00\x06\x00\xfc\xff\xfc\xff\t\x00\x04\x00\xff\xff\x02\x00\x04\x00\xfd\xff\x04\x00\x06\x00\x00\x00\xfd\xff\x05\x00\x02\x00\x01\x00\x01\x00\x03\x00\x00\x00\x02\x00\x01\x00\x03\x00\x00\x00\x02\x00\x01\x00\x02\x00\xfe\xff\x02\x00\x03\x00\x00\x00\xfd\xff\x05\x00\x03\x00\x01\x00\xfd\xff\x01\x00\x05\x00\x04\x00\xfa\xff\xff\xff\x06\x00\x04\x00\xfa\xff\xff\xff\x03\x00\x03\x00\xff\xff\x00\x00\xfe\xff\x05\x00\x00\x00\xfe\xff\xff\xff\x04\x00\xfe\xff\xfe\xff\x00\x00\x02\x00\xfd\xff\x00\x00\x01\x00\x01\x00\xfb\xff\x01\x00\x03\x00\x01\x00\xfa\xff\xff\xff\x03\x00\x02\x00\xfa\xff\xff\xff\x02\x00\x01\x00\xfb\xff\x01\x00\x02\x00\x01\x00\xfc\xff\xff\xff\x00\x00\x01\x00\xfe\xff\xff\xff\xfe\xff\x01\x00\xff\xff\x01\x00\xfd\xff\xfe\xff\xff\xff\x02\x00\xfd\xff\xfe\xff\xff\xff\x01\x00\xff\xff\xff\xff\xfc\xff\xff\xff\x01\x00\x00\x00\xfa\xff\x00\x00\x02\x00\x00\x00\xfb\xff\xff\xff\xfe\xff\xff\xff\xfe\xff\xfc\xff\xfe\xff\x04\x00\xfc\xff\xf9\xff\x02\x00\a\x00\xf8\xff\xf7\xff\x03\x00\x04\x00\xfc\xff\xfd\xff\xfc\xff\xff\xff\x02\x00\xfd\xff\xf7\xff\x01\x00\x03\x00\xfc\xff\xf9\xff\x00\x00\x00\x00\xff\xff\xfc\xff\xfc\xff\xfd\xff\x02\x00\xff\xff\xfb\xff\xfe\xff\x00\x00\xfd\xff\x00\x00\xfe\xff\xfa\xff\xfc\xff\x02\x00\xfd\xff\xfb\xff\xff\xff\xff\xff\xfd\xff\xff\xff\xff\xff\xfd\xff\xfb\xff\xfe\xff\xff\xff\xfe\xff\xfb\xff\xfc\xff\xfe\xff\xff\xff\xff\xff\xfb\xff\xf8\xff\x00\x00\x02\x00\xfb\xff\xf8\xff\xfc\xff\xfe\xff\xff\xff\xfc\xff\xfa\xff\xfa\xff\xfd\xff\x00\x00\xff\xff\xf8\xff\xf7\xff\x01\x00\x02\x00\xf6\xff\xf6\xff\x01\x00\x01\x00\xf6\xff\xf9\xff\xff\xff\xfe\xff\xfa\xff\xfb\xff\xfd\xff\xfc\xff\xfc\xff\xfb\xff\xfb\xff\xfd\xff\xfd\xff\xfa\xff\xf8\xff\xfd\xff\xff\xff\xfb\xff\xf8\xff\xfb\xff\xff\xff\xfc\xff\xf8\xff\xfb\xff\xfb\xff\xfa\xff\xfc\xff\xfb\xff\xf9\xff\xfc\xff\xfc\xff\xf8\xff\xf9\xff\xfe\xff\xfc\xff\xf7\xff\xf9\xff\xfb\xff\xfb\xff\xfc\xff\xfa\xff\xf6\xff\xf8\xff\x01\x00\xfd\xff\xf4\xff\xf9\xff\xff\xff\xfb\xff\xf9\xff\xfc\xff\xf7\xff\xf9\xff\xff\xff\xfc\xff\xf7\xff\xfa\xff\xfc\xff\xf9\xff\xfa\xff\xfc\xff\xf9\xff\xf8\xff\xfc\xff\xfd\xff\xf8\xff\xf8\xff\xfc\xff\xfc\xff\xf9\xff\xf9\xff\xfc\xff\xfd\xff\xf9\xff\xf9\xff\xfc\xff\xfb\xff\xf8\xff\xfa\xff\xfc\xff\xfb\xff\xf8\xff\xfb\xff\xfb\xff\xf8\xff\xfb\xff\xff\xff\xf8\xff\xf4\xff\xff\xff\x02\x00\xf4\xff\xf6\xff\xff\xff\xfd\xff\xf9\xff\xfb\xff\xf8\xff\xfc\xff\xfe\xff\xf9\xff\xf6\xff\xfc\xff\xfe\xff\xf9\xff\xf7\xff\xfc\xff\xfc\xff\xfc\xff\xf9\xff\xf9\xff\xfa\xff\xfb\xff\xfd\xff\xfc\xff\xf6\xff\xf9\xff\x00\x00\xfd\xff\xf5\xff\xfa\xff\xff\xff\xfb\xff\xf7\xff\xfb\xff\xfe\xff\xfd\xff\xf7\xff\xf8\xff\xfd\xff\xfe\xff\xfb\xff\xf9\xff\xf8\xff\xfd\xff\xfe\xff\xf9\xff\xf7\xff\x00\x00\xfc\xff\xf5\xff\xfa\xff\x01\x00\xfb\xff\xf5\xff\xf9\xff\xff\xff\xfd\xff\xf8\xff\xf8\xff\xfd\xff\xfc\xff\xfb\xff\xfb\xff\xfb\xff\xfb\xff\xff\xff\xfc\xff\xf7\xff\xfb\xff\xff\xff\xfa\xff\xf9\xff\xfc\xff\xfe\xff\xfc\xff\xfb\xff\xfa\xff\xfc\xff\xfd\xff\xfc\xff\xf9\xff\xfb\xff\xfd\xff\xfe\xff\xfa\xff\xfb\xff\xfe\xff\xff\xff\xf9\xff\xfa\xff\xfe\xff\xfe\xff\xfa\xff\xfb\xff\xfa\xff\xfb\xff\xfe\xff\xfd\xff\xf8\xff\xfb\xff\xfe\xff\xfd\xff\xfc\xff\xfd\xff\xf8\xff\xfc\xff\x00\x00\xfe\xff\xf8\xff\xfb\xff\xfd\xff\xfd\xff\xfc\xff\xfd\xff\xfa\xff\xfc\xff\xff\xff\xff\xff\xfb\xff\xfd\xff\xfb\xff\xfe\xff\xfe\xff\xfd\xff\xfb\xff\xfe\xff\xff\xff\x00\x00\xfc\xff\xfd\xff\xfb\xff\x01\x00\xff\xff\xf9\xff\xf9\xff\x03\x00\x01\x00\xf9\xff\xf9\xff\x01\x00\xfe\xff\xfa\xff\xfe\xff\x01\x00\xfc\xff\xff\xff\xfd\xff\xfc\xff\xfd\xff\x03\x00\xfc\xff\xf9\xff\xfd\xff\x02\x00\xff\xff\xfd\xff\xf7\xff\xfd\xff\x03\x00\x00\x00\xf7\xff\xfd\xff\x02\x00\xfe\xff\xfc\xff\x03\x00\xfb\xff\xf8\xff\xfe\xff\a\x00\xff\xff\xf8\xff\xfb\xff\x06\x00\x03\x00\xf9\xff\xf8\xff\x03\x00\x01\x00\xfd\xff\xfb\xff\x01\x00\x01\x00\xfd\xff\xfb\xff\x01\x00\x01\x00\x00\x00\xfd\xff\xff\xff\xfe\xff\x01\x00\x02\x00\xff\xff\xf9\xff\x00\x00\x03\x00\x01\x00\xfa\xff\xfe\xff\x01\x00\x02\x00\xff\xff\xff\xff\xfd\xff\x03\x00\x00\x00\xfd\xff\xfc\xff\x04\x00\x01\x00\xfd\xff\xfe\xff\x05\x00\xff\xff\xfd\xff\x00\x00\x02\x00\xfc\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x03\x00\x02\x00\x01\x00\xff\xff\x00\x00\x00\x00\a\x00\xff\xff\xfd\xff\x02\x00\x04\x00\xfd\xff\x03\x00\x04\x00\xfe\xff\xfd\xff\b\x00\x02\x00\xfc\xff\x00\x00\a\x00\x01\x00\x01\x00\x02\x00\x02\x00\xfe\xff\x05\x00\x04\x00\x00\x00\xfb\xff\x06\x00\b\x00\x02\x00\xf9\xff\x04\x00\x06\x00\x01\x00\xff\xff\x06\x00\x01\x00\x01\x00\x03\x00\a\x00\xff\xff\xff\xff\x03\x00\t\x00\x00\x00\xff\xff\x03\x00\a\x00\x01\x00\x04\x00\x01\x00\x02\x00\x02\x00\x06\x00\x02\x00\x04\x00\x02\x00\x03\x00\x04\x00\b\x00\x00\x00\x01\x00\x05\x00\t\x00\x00\x00\x02\x00\x05\x00\b\x00\x02\x00\x02\x00\x02\x00\b\x00\x03\x00\x02\x00\x05\x00\a\x00\x01\x00\x02\x00\x06\x00\v\x00\x00\x00\x01\x00\x05\x00\v\x00\x03\x00\x02\x00\x03\x00\b\x00\x04\x00\x06\x00\x02\x00\x03\x00\x03\x00\t\x00\x05\x00\x04\x00\x02\x00\b\x00\x05\x00\x03\x00\x06\x00\b\x00\xfe\xff\x04\x00\v\x00\b\x00\xfd\xff\a\x00\b\x00\x03\x00\x04\x00\f\x00\x02\x00\x01\x00\x06\x00\n\x00\x02\x00\x04\x00\x05\x00\a\x00\x04\x00\a\x00\x03\x00\a\x00\a\x00\x06\x00\x00\x00\b\x00\t\x00\x04\x00\x01\x00\t\x00\x05\x00\x04\x00\x04\x00\a\x00\x03\x00\a\x00\x03\x00\x06\x00\a\x00\b\x00\x01\x00\x05\x00\t\x00\b\x00\xff\xff\x06\x00\b\x00\x06\x00\x01\x00\b\x00\x06\x00\x03\x00\x04\x00\v\x00\x03\x00\x02\x00\x06\x00\b\x00\x03\x00\b\x00\x05\x00\x05\x00\x05\x00\b\x00\x03\x00\x05\x00\x06\x00\b\x00\x04\x00\x04\x00\x01\x00\n\x00\n\x00\x01\x00\xfd\xff\t\x00\v\x00\x04\x00\x00\x00\x06\x00\x06\x00\t\x00\x04\x00\x02\x00\x03\x00\n\x00\x06\x00\x02\x00\x01\x00\a\x00\b\x00\a\x00\xfe\xff\x04\x00\t\x00\b\x00\x01\x00\x03\x00\x06\x00\t\x00\x03\x00\x01\x00\x04\x00\v\x00\x05\x00\x01\x00\x03\x00\t\x00\x06\x00\x04\x00\x02\x00\a\x00\x05\x00\x02\x00\x03\x00\b\x00\x05\x00\x03\x00\x03\x00\b\x00\x03\x00\x03\x00\x04\x00\a\x00\x03\x00\x02\x00\x03\x00\a\x00\x03\x00\x04\x00\x03\x00\x04\x00\x03\x00\x05\x00\x04\x00\x04\x00\x03\x00\x05\x00\x01\x00\x04\x00\x06\x00\x06\x00\x00\x00\x02\x00\x05\x00\a\x00\x02\x00\x02\x00\x01\x00\x06\x00\x04\x00\x04\x00\x03\x00\x04\x00\x02\x00\x04\x00\x05\x00\x04\x00\x01\x00\x01\x00\x03\x00\t\x00\x05\x00\xff\xff\xff\xff\a\x00\b\x00\x03\x00\xfc\xff\x04\x00\b\x00\x04\x00\x01\x00\x03\x00\x02\x00\x03\x00\x02\x00\x05\x00\x02\x00\x00\x00\x03\x00\a\x00\x02\x00\xfe\xff\x04\x00\t\x00\xff\xff\xfe\xff\x04\x00\a\x00\x02\x00\xff\xff\xff\xff\x03\x00\x05\x00\x04\x00\xfe\xff\xff\xff\x04\x00\a\x00\x00\x00\xfe\xff\x04\x00\x05\x00\x01\x00\x00\x00\x03\x00\x04\x00\x01\x00\x00\x00\x01\x00\x04\x00\x02\x00\xff\xff\x02\x00\x03\x00\x01\x00\x02\x00\x02\x00\x00\x00\x01\x00\x04\x00\x02\x00\xfe\xff\x02\x00\x06\x00\x02\x00\xff\xff\xfe\xff\x01\x00\x05\x00\x01\x00\xfc\xff\xff\xff\x06\x00\x03\x00\xfa\xff\xfc\xff\x06\x00\x04\x00\xfb\xff\xfd\xff\x05\x00\x05\x00\xff\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xfe\xff\x00\x00\xff\xff\xfe\xff\x00\x00\x02\x00\x00\x00\xfe\xff\xfe\xff\x02\x00\x03\x00\xfe\xff\xfb\xff\x02\x00\x04\x00\xff\xff\xfb\xff\x01\x00\x04\x00\xff\xff\xfc\xff\x01\x00\x01\x00\xfe\xff\xfd\xff\x02\x00\x00\x00\x00\x00\xfd\xff\xfc\xff\x00\x00\x04\x00\xff\xff\xfa\xff\xfe\xff\x03\x00\xfe\xff\xfe\xff\xfd\xff\xff\xff\x00\x00\x00\x00\xfb\xff\xff\xff\x02\x00\xfd\xff\xfa\xff\x02\x00\x01\x00\xfb\xff\xfa\xff\x00\x00\x02\x00\xff\xff\xf7\xff\xfb\xff\x05\x00\x02\x00\xf7\xff\xfc\xff\x00\x00\xfd\xff\xfc\xff\x02\x00\xfa\xff\xfa\xff\x01\x00\x02\x00\xfb\xff\xfd\xff\xfc\xff\xfe\xff\x02\x00\x00\x00\xf7\xff\xfe\xff\x02\x00\xff\xff\xfa\xff\xfe\xff\xfe\xff\xfe\xff\xfd\xff\xff\xff\xfa\xff\xff\xff\x00\x00\xfd\xff\xf9\xff\x00\x00\x00\x00\xfc\xff\xfc\xff\xff\xff\xfb\xff\xfd\xff\xfe\xff\xff\xff\xfa\xff\xfd\xff\xfd\xff\xfe\xff\xfc\xff\xfc\xff\xfc\xff\xfe\xff\xfb\xff\xfd\xff\xfb\xff\xfc\xff\xfc\xff\xff\xff\xfc\xff\xfc\xff\xfb\xff\xff\xff\xfd\xff\xfd\xff\xf9\xff\xfe\xff\xfd\xff\xfd\xff\xfb\xff\xfe\xff\xfd\xff\xff\xff\xfc\xff\xfb\xff\xfb\xff\x02\x00\xfc\xff\xf9\xff\xfd\xff\x02\x00\xfb\xff\xfd\xff\xfd\xff\xfe\xff\xf8\xff\x00\x00\xfe\xff\xfa\xff\xfb\xff\x04\x00\xfb\xff\xf6\xff\xfc\xff\a\x00\xf7\xff\xf5\xff\x01\x00\x05\x00\xf5\xff\xf8\xff\x02\x00\x03\x00\xf4\xff\xf9\xff\x01\x00\x01\x00\xf6\xff\xfb\xff\xfd\xff\xfe\xff\xfb\xff\xff\xff\xf9\xff\xfd\xff\xfe\xff\xff\xff\xf8\xff\xfd\xff\xfb\xff\xff\xff\xfd\xff\xfc\xff\xf7\xff\x00\x00\xfe\xff\xfb\xff\xfb\xff\x03\x00\xf9\xff\xfb\xff\x00\x00\x00\x00\xf6\xff\xfe\xff\x01\x00\xff\xff\xf7\xff\xff\xff\xfe\xff\x01\x00\xf9\xff\xfa\xff\xfc\xff\x04\x00\xfc\xff\xfa\xff\xfc\xff\x02\x00\xfb\xff\xff\xff\xfc\xff\xfc\xff\xfc\xff\x05\x00\xfe\xff\xf9\xff\xfb\xff\x05\x00\x00\x00\xfa\xff\xf6\xff\x00\x00\x00\x00\x01\x00\xfc\xff\xfd\xff\xf9\xff\x02\x00\x01\x00\xfd\xff\xf7\xff\x02\x00\xff\xff\xfd\xff\xfc\xff\x01\x00\xfc\xff\xff\xff\xfd\xff\xfe\xff\xff\xff\x05\x00\xf9\xff\xfa\xff\x02\x00\x05\x00\xfa\xff\xfb\xff\xfd\xff\x05\x00\x00\x00\xfb\xff\xfa\xff\x03\x00\xff\xff\xfe\xff\xfd\xff\x02\x00\xfc\xff\x00\x00\x00\x00\x01\x00\xfb\xff\x00\x00\x00\x00\xfe\xff\xff\xff\x04\x00\xfc\xff\xfd\xff\xff\xff\x03\x00\xfc\xff\x00\x00\xfe\xff\xff\xff\x01\x00\x03\x00\xf9\xff\xff\xff\x03\x00\x02\x00\xfb\xff\x01\x00\x00\x00\x01\x00\xfd\xff\x01\x00\xfe\xff\xfe\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\xfc\xff\x04\x00\x01\x00\x00\x00\xfd\xff\x01\x00\x00\x00\x02\x00\xfe\xff\xff\xff\x00\x00\x03\x00\xfe\xff\x01\x00\xff\xff\x01\x00\x01\x00\x03\x00\xff\xff\xff\xff\xff\xff\x05\x00\x02\x00\xff\xff\xfc\xff\x02\x00\x04\x00\x02\x00\xfe\xff\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\xff\xff\x00\x00\x06\x00\x02\x00\xff\xff\xff\xff\x05\x00\x00\x00\x00\x00\x03\x00\x03\x00\x00\x00\x01\x00\x01\x00\x04\x00\x01\x00\x00\x00\xff\xff\x05\x00\x06\x00\xfe\xff\xfd\xff\x04\x00\x06\x00\x02\x00\xfd\xff\x03\x00\x04\x00\x02\x00\x00\x00\x01\x00\x01\x00\x05\x00\x02\x00\xfe\xff\x01\x00\t\x00\x04\x00\xfb\xff\xff\xff\b\x00\x06\x00\x00\x00\x00\x00\x02\x00\x04\x00\a\x00\x04\x00\xfc\xff\xff\xff\a\x00\x04\x00\xff\xff\x02\x00\x06\x00\x03\x00\x01\x00\x02\x00\x03\x00\x03\x00\x01\x00\x03\x00\x05\x00\x02\x00\xff\xff\x05\x00\a\x00\x00\x00\xfc\xff\x05\x00\b\x00\x02\x00\xff\xff\x03\x00\a\x00\x04\x00\x00\x00\x03\x00\x06\x00\x04\x00\xfe\xff\x02\x00\a\x00\x05\x00\x00\x00\x03\x00\a\x00\x06\x00\x01\x00\x00\x00\x05\x00\b\x00\x00\x00\xff\xff\a\x00\n\x00\x00\x00\x02\x00\x04\x00\x05\x00\x03\x00\x06\x00\x04\x00\x02\x00\x01\x00\b\x00\x06\x00\x01\x00\x00\x00\x06\x00\x05\x00\x04\x00\x03\x00\x05\x00\x01\x00\x05\x00\x05\x00\x02\x00\xff\xff\x06\x00\a\x00\x05\x00\x00\x00\x05\x00\x06\x00\x05\x00\xff\xff\x05\x00\x06\x00\x04\x00\x00\x00\b\x00\t\x00\x01\x00\xff\xff\t\x00\x03\x00\x04\x00\x05\x00\x05\x00\x01\x00\x06\x00\x06\x00\x06\x00\x01\x00\x04\x00\x04\x00\b\x00\x02\x00\x02\x00\x05\x00\b\x00\x00\x00\x04\x00\x06\x00\x06\x00\x00\x00\x04\x00\x05\x00\x06\x00\x02\x00\a\x00\x04\x00\x03\x00\x04\x00\b\x00\x01\x00\x03\x00\x04\x00\x06\x00\x00\x00\b\x00\a\x00\x01\x00\xff\xff\n\x00\x06\x00\x01\x00\x02\x00\t\x00\x01\x00\x04\x00\x05\x00\a\x00\x00\x00\x04\x00\x03\x00\x06\x00\x04\x00\x03\x00\x00\x00\b\x00\x05\x00\x01\x00\xff\xff\b\x00\x05\x00\x02\x00\xff\xff\x06\x00\x04\x00\x05\x00\x00\x00\x04\x00\x02\x00\a\x00\x03\x00\x03\x00\x02\x00\a\x00\x02\x00\x00\x00\x01\x00\v\x00\x05\x00\xfe\xff\x00\x00\f\x00\x04\x00\xff\xff\x02\x00\b\x00\x00\x00\x03\x00\x05\x00\a\x00\xff\xff\x02\x00\x05\x00\x06\x00\x00\x00\x05\x00\x04\x00\x03\x00\x00\x00\a\x00\x04\x00\x02\x00\x01\x00\x05\x00\x00\x00\x05\x00\x03\x00\x06\x00\xff\xff\x01\x00\x04\x00\a\x00\x01\x00\x02\x00\x01\x00\x05\x00\x03\x00\x05\x00\xfd\xff\x03\x00\x06\x00\x05\x00\xfc\xff\x01\x00\x05\x00\a\x00\x00\x00\x00\x00\x01\x00\t\x00\x04\x00\x00\x00\xff\xff\a\x00\x03\x00\x01\x00\x00\x00\x06\x00\x01\x00\x01\x00\x05\x00\x05\x00\xfe\xff\x03\x00\x05\x00\x05\x00\xfd\xff\x02\x00\x04\x00\x05\x00\xff\xff\x04\x00\x03\x00\x03\x00\x00\x00\x06\x00\x02\x00\x00\x00\xff\xff\a\x00\x01\x00\x00\x00\x00\x00\a\x00\xff\xff\xfe\xff\x01\x00\x06\x00\x00\x00\x01\x00\x00\x00\x02\x00\xff\xff\x06\x00\x00\x00\xfd\xff\x00\x00\t\x00\xfe\xff\xfc\xff\x03\x00\a\x00\xfe\xff\xff\xff\x00\x00\x04\x00\x03\x00\x03\x00\xfd\xff\x03\x00\x01\x00\x00\x00\xff\xff\x03\x00\xff\xff\x02\x00\x01\x00\x00\x00\x00\x00\x05\x00\x00\x00\xff\xff\x00\x00\x04\x00\xfe\xff\x02\x00\x00\x00\xfe\xff\xff\xff\x05\x00\xff\xff\xff\xff\xff\xff\x01\x00\xfe\xff\x03\x00\x01\x00\xfe\xff\xfc\xff\x04\x00\x02\x00\xfe\xff\xf9\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x03\x00\xfc\xff\xfe\xff\x03\x00\x03\x00\xf8\xff\xff\xff\x04\x00\x01\x00\xfa\xff\x01\x00\x02\x00\x00\x00\xfb\xff\x00\x00\x02\x00\xff\xff\xfb\xff\x02\x00\x01\x00\xff\xff\xfc\xff\x02\x00\xfe\xff\xfe\xff\x00\x00\xff\xff\xfc\xff\x02\x00\x00\x00\xfc\xff\xfd\xff\x04\x00\xfe\xff\xfe\xff\xff\xff\x00\x00\xff\xff\x01\x00\xfa\xff\xfd\xff\x01\x00\x01\x00\xfb\xff\xff\xff\x00\x00\x00\x00\xfe\xff\x00\x00\xfc\xff\xfe\xff\x01\x00\x02\x00\xfb\xff\xff\xff\xff\xff\xff\xff\xfe\xff\x01\x00\xfc\xff\xfc\xff\x00\x00\x03\x00\xfc\xff\xfd\xff\xfc\xff\x01\x00\xff\xff\xfd\xff\xfc\xff\x01\x00\xfd\xff\xfd\xff\x00\x00\x01\x00\xfb\xff\x00\x00\xff\xff\xfd\xff\xfc\xff\x02\x00\x00\x00\xfc\xff\xfa\xff\x03\x00\x01\x00\xfc\xff\xfa\xff\x03\x00\x00\x00\xfc\xff\xfe\xff\x02\x00\xfe\xff\xff\xff\xfc\xff\x00\x00\x00\x00\x00\x00\xfb\xff\xfe\xff\x00\x00\xff\xff\xfc\xff\x02\x00\xfe\xff\xfc\xff\xfc\xff\x01\x00\xff\xff\xfe\xff\xfc\xff\xff\xff\xfd\xff\xfd\xff\xff\xff\x02\x00\xfc\xff\xfa\xff\xfd\xff\x04\x00\xff\xff\xfd\xff\xfb\xff\xfe\xff\xff\xff\xff\xff\xfd\xff\xfe\xff\xfc\xff\x00\x00\xff\xff\xff\xff\xfd\xff\xfe\xff\xfe\xff\x00\x00\xfc\xff\xfe\xff\xfe\xff\xff\xff\xfd\xff\xfd\xff\xfd\xff\x00\x00\xff\xff\xfd\xff\xf9\xff\xff\xff\x02\x00\xff\xff\xf9\xff\xfc\xff\xff\xff\x02\x00\xfe\xff\xfa\xff\xfa\xff\x02\x00\x01\x00\xfb\xff\xfa\xff\x02\x00\xff\xff\xfb\xff\xfe\xff\x02\x00\xfb\xff\xfb\xff\xff\xff\xff\xff\xfc\xff\xfe\xff\xfd\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\x00\x00\xfc\xff\xfd\xff\x01\x00\x00\x00\xf8\xff\xfd\xff\x02\x00\xfd\xff\xfc\xff\x01\x00\xfd\xff\xfb\xff\x00\x00\x00\x00\xfa\xff\xfe\xff\x01\x00\xfc\xff\xf9\xff\x02\x00\x04\x00\xf8\xff\xf7\xff\x05\x00\x04\x00\xf8\xff\xfb\xff\x03\x00\xfb\xff\xfa\xff\x03\x00\x01\x00\xf8\xff\xfd\xff\x03\x00\x00\x00\xfb\xff\xfc\xff\xfe\xff\x00\x00\xfe\xff\xfd\xff\xfe\xff\xff\xff\xfe\xff\x00\x00\xfd\xff\xfb\xff\x02\x00\x03\x00\xfa\xff\xf8\xff\x01\x00\x05\x00\xff\xff\xfb\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xfc\xff\xff\xff\x00\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x00\x00\xfb\xff\xff\xff\x03\x00\xff\xff\xfc\xff\x00\x00\x03\x00\xff\xff\xfb\xff\x02\x00\x04\x00\xfb\xff\xfc\xff\x05\x00\x01\x00\xfb\xff\x00\x00\x02\x00\xfe\xff\xff\xff\x00\x00\xfe\xff\x00\x00\x02\x00\xff\xff\xfe\xff\x01\x00\x01\x00\x00\x00\xfd\xff\x00\x00\x03\x00\xff\xff\xfb\xff\x02\x00\x06\x00\xfd\xff\xfa\xff\x06\x00\x02\x00\xfb\xff\x00\x00\x03\x00\xfe\xff\x00\x00\x04\x00\x00\x00\xfc\xff\x03\x00\x04\x00\x00\x00\xfc\xff\xfe\xff\x05\x00\x06\x00\xfb\xff\xfb\xff\x03\x00\b\x00\xff\xff\xfa\xff\x01\x00\x06\x00\x01\x00\xfd\xff\xff\xff\x05\x00\xff\xff\x00\x00\x04\x00\x03\x00\xfd\xff\xff\xff\x05\x00\x04\x00\xff\xff\xff\xff\x01\x00\x05\x00\x00\x00\xff\xff\x04\x00\x03\x00\xfd\xff\x00\x00\x05\x00\x04\x00\xfd\xff\x01\x00\x02\x00\x03\x00\xff\xff\x00\x00\x04\x00\x04\x00\xfb\xff\x00\x00\b\x00\x04\x00\xf9\xff\x01\x00\t\x00\x01\x00\xfa\xff\x06\x00\b\x00\xfc\xff\xfa\xff\n\x00\t\x00\xfb\xff\xf9\xff\t\x00\t\x00\xfe\xff\xfc\xff\a\x00\x06\x00\x01\x00\xff\xff\x03\x00\x04\x00\x04\x00\xff\xff\x00\x00\x05\x00\a\x00\x01\x00\x02\x00\x03\x00\x04\x00\x03\x00\x03\x00\x00\x00\x03\x00\a\x00\x02\x00\xff\xff\x06\x00\x03\x00\x01\x00\x03\x00\x04\x00\x01\x00\x05\x00\x04\x00\x02\x00\x05\x00\x05\x00\xfe\xff\x03\x00\b\x00\x04\x00\x00\x00\x04\x00\x05\x00\x06\x00\x04\x00\x02\x00\x02\x00\a\x00\x03\x00\x02\x00\x06\x00\x05\x00\x00\x00\x05\x00\a\x00\x05\x00\x01\x00\x06\x00\x05\x00\x03\x00\x04\x00\t\x00\x04\x00\x01\x00\x05\x00\b\x00\x03\x00\x05\x00\x03\x00\x03\x00\x04\x00\t\x00\x05\x00\x02\x00\x04\x00\b\x00\x05\x00\x02\x00\x05\x00\a\x00\x03\x00\x04\x00\x05\x00\x04\x00\x06\x00\t\x00\x00\x00\x02\x00\b\x00\x06\x00\x02\x00\x06\x00\x06\x00\x04\x00\x04\x00\a\x00\x04\x00\x03\x00\x04\x00\n\x00\x05\x00\xfe\xff\x06\x00\x10\x00\x03\x00\xfc\xff\a\x00\f\x00\x01\x00\x02\x00\b\x00\a\x00\x01\x00\x04\x00\x06\x00\v\x00\x05\x00\x00\x00\x05\x00\n\x00\x06\x00\x05\x00\x06\x00\x05\x00\x01\x00\b\x00\t\x00\x03\x00\x00\x00\b\x00\t\x00\x05\x00\x03\x00\b\x00\x06\x00\x02\x00\x04\x00\t\x00\x05\x00\x05\x00\x05\x00\x06\x00\x03\x00\a\x00\b\x00\x05\x00\x03\x00\b\x00\x06\x00\x03\x00\a\x00\b\x00\x04\x00\x05\x00\x04\x00\x05\x00\b\x00\t\x00\x02\x00\x02\x00\b\x00\v\x00\x06\x00\x03\x00\x05\x00\t\x00\a\x00\x05\x00\x06\x00\a\x00\x06\x00\x05\x00\x06\x00\t\x00\x06\x00\x03\x00\x06\x00\n\x00\x06\x00\x04\x00\b\x00\a\x00\x04\x00\b\x00\t\x00\a\x00\x03\x00\x06\x00\v\x00\b\x00\x04\x00\a\x00\t\x00\x06\x00\x06\x00\b\x00\x06\x00\x06\x00\b\x00\x06\x00\x05\x00\b\x00\b\x00\a\x00\x06\x00\x06\x00\f\x00\n\x00\x04\x00\x03\x00\r\x00\b\x00\x01\x00\x05\x00\v\x00\a\x00\x04\x00\a\x00\f\x00\a\x00\x03\x00\x06\x00\r\x00\a\x00\x02\x00\a\x00\f\x00\x06\x00\x05\x00\b\x00\v\x00\x06\x00\x03\x00\a\x00\x0e\x00\a\x00\x03\x00\a\x00\n\x00\b\x00\t\x00\x04\x00\x06\x00\n\x00\v\x00\x04\x00\a\x00\v\x00\a\x00\x03\x00\n\x00\n\x00\b\x00\x03\x00\b\x00\b\x00\x06\x00\x06\x00\a\x00\x05\x00\n\x00\t\x00\x06\x00\x02\x00\n\x00\n\x00\b\x00\x02\x00\x06\x00\n\x00\n\x00\x03\x00\x06\x00\b\x00\a\x00\x04\x00\t\x00\b\x00\x04\x00\x04\x00\v\x00\a\x00\x06\x00\x04\x00\a\x00\a\x00\t\x00\x06\x00\x05\x00\x05\x00\n\x00\x06\x00\x06\x00\b\x00\t\x00\x00\x00\x06\x00\f\x00\a\x00\xfe\xff\x05\x00\t\x00\a\x00\x06\x00\a\x00\x03\x00\a\x00\x06\x00\b\x00\x05\x00\x04\x00\x02\x00\n\x00\a\x00\x04\x00\x04\x00\b\x00\x04\x00\x05\x00\x06\x00\x06\x00\x04\x00\t\x00\x04\x00\x06\x00\x06\x00\a\x00\x04\x00\a\x00\x03\x00\x04\x00\a\x00\t\x00\x01\x00\x05\x00\x06\x00\x05\x00\x03\x00\a\x00\x03\x00\x03\x00\x05\x00\b\x00\x01\x00\x05\x00\x06\x00\x05\x00\x00\x00\a\x00\a\x00\x04\x00\x00\x00\b\x00\x04\x00\x03\x00\x01\x00\x05\x00\x03\x00\b\x00\x03\x00\xff\xff\x03\x00\v\x00\x01\x00\x00\x00\x03\x00\b\x00\x02\x00\x03\x00\x02\x00\x06\x00\x06\x00\x02\x00\xfc\xff\a\x00\t\x00\x04\x00\xfd\xff\x04\x00\a\x00\b\x00\xff\xff\x03\x00\x06\x00\x04\x00\xfe\xff\b\x00\x06\x00\x00\x00\xff\xff\a\x00\x03\x00\x03\x00\x01\x00\x02\x00\x01\x00\a\x00\x02\x00\x02\x00\xff\xff\x04\x00\x03\x00\x05\x00\xfd\xff\x02\x00\x04\x00\x06\x00\xff\xff\x00\x00\x00\x00\x06\x00\x01\x00\x01\x00\xfe\xff\x02\x00\x03\x00\x06\x00\xfe\xff\xfc\xff\x02\x00\b\x00\xfe\xff\x01\x00\x00\x00\xff\xff\xff\xff\a\x00\x00\x00\xfd\xff\x03\x00\x06\x00\xfc\xff\x00\x00\x02\x00\x03\x00\xfe\xff\x02\x00\x00\x00\x02\x00\xff\xff\x02\x00\xff\xff\x00\x00\xfe\xff\x01\x00\xfe\xff\x02\x00\x00\x00\xff\xff\xfc\xff\x04\x00\x02\x00\xff\xff\xfa\xff\x01\x00\x02\x00\x02\x00\xfa\xff\x01\x00\x01\x00\xff\xff\xfe\xff\x03\x00\xfe\xff\xfb\xff\xfd\xff\x06\x00\x01\x00\xfb\xff\xf9\xff\x03\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\xfc\xff\x00\x00\xff\xff\xff\xff\xfc\xff\xff\xff\xfe\xff\x00\x00\xff\xff\x00\x00\xfb\xff\xff\xff\xfe\xff\xff\xff\xfa\xff\x00\x00\x01\x00\xfd\xff\xfa\xff\x01\x00\xfd\xff\xfd\xff\xff\xff\x00\x00\xf9\xff\xfc\xff\x01\x00\x00\x00\xf8\xff\xfd\xff\x02\x00\xff\xff\xf6\xff\xff\xff\x03\x00\xfc\xff\xf6\xff\x01\x00\x01\x00\xfc\xff\xfa\xff\xff\xff\xfd\xff\xfd\xff\xfc\xff\xfd\xff\xfc\xff\xfd\xff\xfe\xff\xff\xff\xf8\xff\xfb\xff\x01\x00\xff\xff\xf8\xff\xfc\xff\xfd\xff\xfc\xff\xfd\xff\x01\x00\xfa\xff\xf7\xff\xfd\xff\x03\x00\xfa\xff\xf7\xff\xfa\xff\x00\x00\xfb\xff\xfa\xff\xfd\xff\xfc\xff\xfa\xff\xfe\xff\xfd\xff\xfb\xff\xf8\xff\xfa\xff\xfd\xff\xfd\xff\xfb\xff\xfa\xff\xfa\xff\xfe\xff\xfb\xff\xfa\xff\xfb\xff\xfd\xff\xfa\xff\xfa\xff\xfd\xff\xfd\xff\xfb\xff\xfb\xff\xfa\xff\xfa\xff\xfa\xff\xfe\xff\xfc\xff\xf8\xff\xf9\xff\xfb\xff\xfd\xff\xf8\xff\xf8\xff\xfb\xff\xfc\xff\xf9\xff\xf9\xff\xfb\xff\xfa\xff\xfb\xff\xfc\xff\xf7\xff\xf8\xff\xfc\xff\xfc\xff\xf8\xff\xfb\xff\xfb\xff\xf7\xff\xfa\xff\xfd\xff\xf9\xff\xf9\xff\xfa\xff\xfa\xff\xfa\xff\xfe\xff\xf8\xff\xf6\xff\xfb\xff\xff\xff\xf9\xff\xf6\xff\xfa\xff\xff\xff\xfa\xff\xf6\xff\xf9\xff\xfe\xff\xf8\xff\xf9\xff\xfc\xff\xfc\xff\xf7\xff\xfa\xff\xfc\xff\xfb\xff\xf6\xff\xfa\xff\xfc\xff\xf9\xff\xf4\xff\xfd\xff\xff\xff\xf7\xff\xf5\xff\xff\xff\xfc\xff\xf8\xff\xf6\xff\xfc\xff\xfc\xff\xfb\xff\xf8\xff\xfa\xff\xfc\xff\xfd\xff\xf8\xff\xf8\xff\xfa\xff\xfd\xff\xfc\xff\xfa\xff\xf5\xff\xfb\xff\x00\x00\xfe\xff\xf4\xff\xf9\xff\xff\xff\xfd\xff\xf5\xff\xf9\xff\xfc\xff\xfd\xff\xf9\xff\xfa\xff\xfb\xff\xfe\xff\xfb\xff\xfb\xff\xf8\xff\xfc\xff\xfe\xff\xfd\xff\xf9\xff\xfa\xff\xfa\xff\xfe\xff\xfd\xff\xfd\xff\xf8\xff\xfc\xff\xfe\xff\xfe\xff\xfa\xff\xfa\xff\xfa\xff\xff\xff\xfd\xff\xfb\xff\xf9\xff\xfd\xff\xfd\xff\xfc\xff\xf8\xff\xfd\xff\xfd\xff\xfc\xff\xf9\xff\x00\x00\xfd\xff\xf6\xff\xfb\xff\x04\x00\xfa\xff\xf6\xff\xfc\xff\x01\x00\xfa\xff\xfe\xff\xfc\xff\xf9\xff\xfa\xff\x03\x00\xfc\xff\xfa\xff\xfc\xff\xfe\xff\xfb\xff\x00\x00\xfa\xff\xfa\xff\xfe\xff\x03\x00\xfc\xff\xfc\xff\xfd\xff\x00\x00\xfc\xff\xfd\xff\xfc\xff\xff\xff\xfd\xff\xfe\xff\xfc\xff\xff\xff\xfb\xff\xfe\xff\xff\xff\x00\x00\xf9\xff\xfd\xff\xff\xff\x02\x00\xfb\xff\xfb\xff\xfd\xff\x01\x00\x00\x00\xfe\xff\xf8\xff\xff\xff\x01\x00\xff\xff\xfa\xff\x01\x00\xff\xff\xff\xff\xff\xff\x01\x00\xfb\xff\xfd\xff\x02\x00\x02\x00\xf8\xff\xfc\xff\x03\x00\x04\x00\xfa\xff\xfe\xff\xff\xff\x01\x00\x00\x00\xff\xff\xfd\xff\x01\x00\xff\xff\xff\xff\x00\x00\x03\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\xfb\xff\x01\x00\x01\x00\x01\x00\xfd\xff\xff\xff\x01\x00\x00\x00\xfe\xff\x00\x00\xfc\xff\x02\x00\x04\x00\x01\x00\xfa\xff\x02\x00\a\x00\x00\x00\xf9\xff\x02\x00\b\x00\x02\x00\xfa\xff\x00\x00\x06\x00\x04\x00\xfc\xff\xff\xff\x03\x00\x04\x00\x01\x00\x00\x00\xfe\xff\x05\x00\x02\x00\x01\x00\x00\x00\x03\x00\x02\x00\x03\x00\x03\x00\x03\x00\xff\xff\x03\x00\x04\x00\x03\x00\x01\x00\x04\x00\x05\x00\x02\x00\xff\xff\x03\x00\x04\x00\x03\x00\x00\x00\x04\x00\x01\x00\x04\x00\x05\x00\x02\x00\xff\xff\x05\x00\x06\x00\x01\x00\x02\x00\x06\x00\x01\x00\x04\x00\x06\x00\x02\x00\xff\xff\x06\x00\b\x00\x03\x00\xff\xff\x04\x00\a\x00\a\x00\x02\x00\x02\x00\x04\x00\x05\x00\x04\x00\a\x00\x03\x00\x01\x00\x03\x00\f\x00\a\x00\xff\xff\xff\xff\n\x00\f\x00\x02\x00\xfd\xff\x05\x00\v\x00\x06\x00\x00\x00\x05\x00\v\x00\x06\x00\x01\x00\x06\x00\n\x00\x03\x00\xff\xff\x06\x00\n\x00\a\x00\x00\x00\x02\x00\a\x00\t\x00\x04\x00\x02\x00\x03\x00\b\x00\v\x00\x06\x00\xff\xff\x03\x00\n\x00\t\x00\x02\x00\x02\x00\x05\x00\x06\x00\t\x00\a\x00\x02\x00\x04\x00\b\x00\b\x00\x04\x00\x03\x00\x05\x00\a\x00\x06\x00\x05\x00\x05\x00\a\x00\x03\x00\x05\x00\b\x00\x04\x00\x03\x00\b\x00\a\x00\x04\x00\x06\x00\x06\x00\x05\x00\x04\x00\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\a\x00\b\x00\x06\x00\x02\x00\x03\x00\b\x00\b\x00\x04\x00\x01\x00\a\x00\b\x00\x05\x00\x04\x00\x03\x00\b\x00\a\x00\x00\x00\a\x00\n\x00\x02\x00\x00\x00\v\x00\t\x00\x01\x00\x03\x00\n\x00\a\x00\x05\x00\x04\x00\x05\x00\x05\x00\a\x00\a\x00\x04\x00\x03\x00\a\x00\b\x00\x04\x00\x03\x00\x05\x00\x06\x00\a\x00\x04\x00\x03\x00\x06\x00\t\x00\x05\x00\x04\x00\x06\x00\b\x00\x03\x00\x04\x00\x06\x00\t\x00\x04\x00\x02\x00\x05\x00\b\x00\x04\x00\x03\x00\x05\x00\t\x00\x04\x00\x04\x00\x05\x00\x04\x00\x02\x00\x06\x00\x05\x00\x02\x00\x03\x00\b\x00\x03\x00\x05\x00\x05\x00\x04\x00\x02\x00\b\x00\x04\x00\x03\x00\x04\x00\a\x00\x02\x00\x03\x00\x06\x00\x05\x00\x01\x00\x06\x00\x05\x00\x03\x00\x02\x00\b\x00\x05\x00\x00\x00\x02\x00\b\x00\x03\x00\x01\x00\x02\x00\n\x00\x03\x00\x01\x00\x04\x00\b\x00\x01\x00\x02\x00\x04\x00\x04\x00\xfe\xff\a\x00\x06\x00\x00\x00\xff\xff\b\x00\x03\x00\x01\x00\x03\x00\x02\x00\xff\xff\a\x00\x04\x00\xfe\xff\xfd\xff\a\x00\x03\x00\x00\x00\x00\x00\x04\x00\x01\x00\x03\x00\x01\x00\x02\x00\x01\x00\x05\x00\xff\xff\x01\x00\x02\x00\x05\x00\xff\xff\xff\xff\x00\x00\x04\x00\x00\x00\xfe\xff\xfe\xff\x03\x00\x01\x00\x00\x00\x01\x00\x01\x00\xfe\xff\x03\x00\x01\x00\xff\xff\xfc\xff\x03\x00\x01\x00\x02\x00\xff\xff\x00\x00\xff\xff\x03\x00\x01\x00\x00\x00\xfe\xff\x00\x00\x00\x00\x02\x00\xfd\xff\xfe\xff\x00\x00\x02\x00\xfe\xff\xff\xff\xfe\xff\x01\x00\xff\xff\xff\xff\xfa\xff\x01\x00\x02\x00\xfd\xff\xf9\xff\x02\x00\x01\x00\xfe\xff\xfb\xff\xff\xff\xfe\xff\x00\x00\xfb\xff\xfd\xff\xfc\xff\xfe\xff\xfd\xff\xff\xff\xfa\xff\xfc\xff\xff\xff\xfe\xff\xf9\xff\xfd\xff\x01\x00\xfe\xff\xf6\xff\xff\xff\x02\x00\xfd\xff\xf6\xff\xff\xff\x02\x00\xfd\xff\xf7\xff\x00\x00\xff\xff\xfc\xff\xf8\xff\xff\xff\xff\xff\xfc\xff\xf7\xff\xfd\xff\xff\xff\xfd\xff\xf6\xff\xfc\xff\xff\xff\xfb\xff\xf6\xff\xff\xff\xfc\xff\xf9\xff\xfa\xff\xfe\xff\xfb\xff\xfc\xff\xf9\xff\xfa\xff\xfb\xff\xfe\xff\xf7\xff\xfa\xff\xfb\xff\xfa\xff\xf9\xff\xfd\xff\xfa\xff\xfa\xff\xfa\xff\xfe\xff\xf9\xff\xf8\xff\xfb\xff\x00\x00\xf9\xff\xf5\xff\xfa\xff\x01\x00\xf9\xff\xf6\xff\xf7\xff\xfd\xff\xfb\xff\xfd\xff\xfa\xff\xf9\xff\xfb\xff\xfc\xff\xf9\xff\xfa\xff\xf9\xff\xfa\xff\xf8\xff\xfb\xff\xfb\xff\xfa\xff\xf7\xff\xfa\xff\xfb\xff\xf8\xff\xf6\xff\xfd\xff\xfc\xff\xf7\xff\xf6\xff\xfd\xff\xfd\xff\xf6\xff\xf3\xff\xfd\xff\xff\xff\xf9\xff\xf3\xff\xf9\xff\xfd\xff\xfb\xff\xf6\xff\xf8\xff\xfa\xff\xfc\xff\xf5\xff\xf8\xff\xfd\xff\xfb\xff\xf5\xff\xf7\xff\xfc\xff\xfc\xff\xf9\xff\xf8\xff\xf5\xff\xfa\xff\xfe\xff\xf8\xff\xf2\xff\xf9\xff\xff\xff\xfb\xff\xf6\xff\xfa\xff\xfa\xff\xf8\xff\xfb\xff\xfe\xff\xf5\xff\xf5\xff\xfd\xff\xff\xff\xf5\xff\xf4\xff\xfb\xff\xfc\xff\xf7\xff\xf7\xff\xfb\xff\xfb\xff\xf8\xff\xfa\xff\xfb\xff\xf8\xff\xf7\xff\xfb\xff\xfb\xff\xf6\xff\xf7\xff\xfa\xff\xf9\xff\xfb\xff\xf8\xff\xf6\xff\xfa\xff\xfb\xff\xf7\xff\xf6\xff\xfc\xff\xfa\xff\xf5\xff\xfa\xff\xfd\xff\xf6\xff\xf7\xff\xfa\xff\xf7\xff\xf5\xff\xfc\xff\xfd\xff\xf7\xff\xf5\xff\xfc\xff\xfb\xff\xf7\xff\xf6\xff\xfa\xff\xfb\xff\xf8\xff\xf9\xff\xfe\xff\xfa\xff\xf4\xff\xf7\xff\xfe\xff\xfb\xff\xf6\xff\xf6\xff\xfc\xff\xfb\xff\xf8\xff\xf5\xff\xfa\xff\xfc\xff\xf8\xff\xf5\xff\xfc\xff\xfe\xff\xfb\xff\xf3\xff\xf5\xff\xfd\xff\xff\xff\xf7\xff\xf2\xff\xf8\xff\x01\x00\xfb\xff\xf5\xff\xf7\xff\xfd\xff\xf8\xff\xfa\xff\xfe\xff\xfb\xff\xf2\xff\xfb\xff\xff\xff\xf9\xff\xf5\xff\xfd\xff\xfd\xff\xf9\xff\xf6\xff\xfd\xff\xfc\xff\xfa\xff\xf8\xff\xfe\xff\xfc\xff\xf6\xff\xf8\xff\x03\x00\xfc\xff\xf3\xff\xf6\xff\x04\x00\xff\xff\xf8\xff\xf5\xff\xfd\xff\xff\xff\xfb\xff\xf7\xff\xfd\xff\xfe\xff\xfd\xff\xf7\xff\xfc\xff\xfd\xff\xfd\xff\xf9\xff\xfd\xff\xfc\xff\xfa\xff\xfb\xff\x01\x00\xf9\xff\xf9\xff\x00\x00\x01\x00\xf9\xff\xfc\xff\xff\xff\xfe\xff\xfb\xff\xfd\xff\xfd\xff\xff\xff\xfc\xff\xfb\xff\xfd\xff\x02\x00\xf9\xff\xfa\xff\x00\x00\x02\x00\xf8\xff\xfc\xff\x02\x00\x01\x00\xf8\xff\xfe\xff\x01\x00\xfe\xff\xfb\xff\x02\x00\xfe\xff\xfc\xff\x00\x00\x03\x00\xfb\xff\xfc\xff\x00\x00\x01\x00\xfc\xff\x01\x00\xfe\xff\xfe\xff\xff\xff\x04\x00\xfe\xff\xfc\xff\xff\xff\x03\x00\xfe\xff\xfd\xff\xfe\xff\x03\x00\xfe\xff\xfe\xff\x00\x00\x02\x00\xfe\xff\x00\x00\xfe\xff\x00\x00\x00\x00\x03\x00\x00\x00\xfe\xff\xff\xff\x02\x00\xff\xff\x03\x00\x00\x00\xff\xff\xfc\xff\x03\x00\x01\x00\xff\xff\xff\xff\x04\x00\xfd\xff\x00\x00\x04\x00\x04\x00\xf9\xff\x00\x00\a\x00\x02\x00\xfb\xff\x04\x00\x06\x00\x01\x00\xfc\xff\x02\x00\x03\x00\x04\x00\xfe\xff\xff\xff\x01\x00\x05\x00\x01\x00\x01\x00\xfe\xff\x05\x00\x03\x00\xff\xff\xfe\xff\x05\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x03\x00\x06\x00\x01\x00\xff\xff\x01\x00\b\x00\x03\x00\x00\x00\x00\x00\x06\x00\x04\x00\x03\x00\xff\xff\x04\x00\b\x00\x05\x00\xfc\xff\x03\x00\t\x00\x05\x00\xfd\xff\x03\x00\b\x00\x05\x00\xfe\xff\x03\x00\b\x00\x06\x00\xff\xff\x04\x00\x05\x00\x03\x00\x02\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x00\x04\x00\b\x00\x04\x00\x00\x00\x04\x00\v\x00\x04\x00\x01\x00\x04\x00\x05\x00\x04\x00\x06\x00\x04\x00\x02\x00\x05\x00\n\x00\x06\x00\x02\x00\x04\x00\t\x00\t\x00\x01\x00\xff\xff\t\x00\v\x00\x01\x00\x01\x00\v\x00\b\x00\x01\x00\x03\x00\v\x00\x06\x00\xff\xff\x05\x00\v\x00\a\x00\x01\x00\x03\x00\a\x00\x05\x00\a\x00\a\x00\x04\x00\a\x00\b\x00\x05\x00\x04\x00\a\x00\x06\x00\x05\x00\a\x00\x06\x00\x05\x00\a\x00\a\x00\a\x00\x06\x00\a\x00\x06\x00\x04\x00\a\x00\b\x00\x06\x00\x01\x00\x05\x00\r\x00\a\x00\x02\x00\a\x00\x06\x00\x04\x00\b\x00\b\x00\x03\x00\x05\x00\n\x00\a\x00\x03\x00\x04\x00\x06\x00\a\x00\b\x00\x03\x00\x02\x00\b\x00\v\x00\x04\x00\x00\x00\x06\x00\n\x00\x04\x00\x02\x00\a\x00\b\x00\x01\x00\x04\x00\b\x00\b\x00\x02\x00\x02\x00\b\x00\t\x00\x04\x00\x03\x00\x06\x00\a\x00\x05\x00\a\x00\x04\x00\x00\x00\t\x00\t\x00\x03\x00\x04\x00\b\x00\x05\x00\x05\x00\b\x00\x05\x00\x02\x00\x05\x00\t\x00\n\x00\x05\x00\x02\x00\b\x00\x06\x00\x03\x00\a\x00\a\x00\xff\xff\x05\x00\v\x00\x05\x00\x00\x00\b\x00\a\x00\x01\x00\x02\x00\v\x00\x06\x00\xfd\xff\xff\xff\v\x00\a\x00\xfe\xff\x01\x00\t\x00\x05\x00\x04\x00\x01\x00\x01\x00\x04\x00\b\x00\x01\x00\x01\x00\x05\x00\a\x00\x03\x00\x03\x00\x03\x00\x04\x00\x01\x00\x03\x00\b\x00\a\x00\xfc\xff\xff\xff\b\x00\a\x00\xff\xff\xff\xff\x03\x00\t\x00\x04\x00\xff\xff\x00\x00\b\x00\x02\x00\xfe\xff\x02\x00\a\x00\xfe\xff\x00\x00\a\x00\x06\x00\xfc\xff\x00\x00\x05\x00\x05\x00\x00\x00\x00\x00\x01\x00\x05\x00\x01\x00\x00\x00\x00\x00\x02\x00\x01\x00\x03\x00\xff\xff\xff\xff\x00\x00\x04\x00\x00\x00\x01\x00\x00\x00\x02\x00\x01\x00\x06\x00\xff\xff\xfa\xff\x02\x00\t\x00\xfe\xff\xfb\xff\x02\x00\x03\x00\xfd\xff\x00\x00\xff\xff\xfe\xff\x00\x00\x03\x00\xfd\xff\xfc\xff\x02\x00\x03\x00\xfb\xff\xfb\xff\x00\x00\x05\x00\xfc\xff\xfd\xff\x00\x00\x02\x00\xfc\xff\xfd\xff\xfe\xff\x00\x00\xfd\xff\xfc\xff\xfa\xff\x00\x00\x02\x00\xfc\xff\xf6\xff\x00\x00\x04\x00\xfc\xff\xf6\xff\x00\x00\x01\x00\xfc\xff\xf7\xff\xff\xff\xfe\xff\xfc\xff\xfc\xff\x00\x00\xfb\xff\xfc\xff\xfe\xff\xfc\xff\xf9\xff\x00\x00\xfd\xff\xfa\xff\xf9\xff\xfd\xff\xfb\xff\xfd\xff\xfc\xff\xf9\xff\xf8\xff\xfe\xff\xfe\xff\xfb\xff\xf7\xff\xfc\xff\xfa\xff\xfd\xff\xfc\xff\xfa\xff\xf6\xff\xfb\xff\xfd\xff\xfb\xff\xf7\xff\xfc\xff\xfa\xff\xfb\xff\xfa\xff\xf9\xff\xf8\xff\xfc\xff\xf9\xff\xf9\xff\xfb\xff\xfc\xff\xf5\xff\xfa\xff\xfd\xff\xf9\xff\xf2\xff\xfa\xff\xfe\xff\xfb\xff\xf4\xff\xf8\xff\xfb\xff\xfb\xff\xf6\xff\xfa\xff\xf8\xff\xf8\xff\xf7\xff\xfb\xff\xf6\xff\xf7\xff\xf8\xff\xf8\xff\xf4\xff\xfb\xff\xfa\xff\xf6\xff\xf3\xff\xfb\xff\xf7\xff\xf5\xff\xf6\xff\xfa\xff\xf6\xff\xf9\xff\xf8\xff\xf7\xff\xf5\xff\xfb\xff\xf7\xff\xf4\xff\xf5\xff\xfa\xff\xf8\xff\xf7\xff\xf5\xff\xf6\xff\xf9\xff\xfa\xff\xf4\xff\xf3\xff\xf9\xff\xfc\xff\xf3\xff\xf5\xff\xf8\xff\xfa\xff\xf6\xff\xf7\xff\xf4\xff\xf7\xff\xf6\xff\xf8\xff\xf7\xff\xf7\xff\xf1\xff\xf7\xff\xfa\xff\xf6\xff\xf0\xff\xf6\xff\xf7\xff\xf3\xff\xf4\xff\xfa\xff\xf5\xff\xf2\xff\xf3\xff\xf9\xff\xf7\xff\xf2\xff\xf1\xff\xf8\xff\xf6\xff\xf6\xff\xf4\xff\xf5\xff\xf3\xff\xf8\xff\xf7\xff\xf2\xff\xf3\xff\xf9\xff\xf4\xff\xf2\xff\xf5\xff\xf7\xff\xf4\xff\xf2\xff\xf4\xff\xf7\xff\xf6\xff\xf0\xff\xf3\xff\xf9\xff\xf4\xff\xf1\xff\xf7\xff\xf7\xff\xec\xff\xf3\xff\xfc\xff\xf6\xff\xee\xff\xf5\xff\xf9\xff\xf5\xff\xf1\xff\xf4\xff\xf5\xff\xf1\xff\xf1\xff\xf6\xff\xf7\xff\xf0\xff\xf1\xff\xf5\xff\xf5\xff\xef\xff\xf1\xff\xf5\xff\xf5\xff\xf3\xff\xf2\xff\xf8\xff\xf8\xff\xf5\xff\xf2\xff\xf7\xff\xf6\xff\xf3\xff\xf0\xff\xf7\xff\xf6\xff\xf2\xff\xf2\xff\xf5\xff\xf4\xff\xf1\xff\xf7\xff\xf3\xff\xf0\xff\xf2\xff\xf7\xff\xf1\xff\xf1\xff\xf3\xff\xf2\xff\xf5\xff\xf6\xff\xf5\xff\xf0\xff\xf7\xff\xf9\xff\xf4\xff\xef\xff\xf7\xff\xf8\xff\xf4\xff\xf0\xff\xf4\xff\xf6\xff\xf4\xff\xf2\xff\xf4\xff\xf7\xff\xf0\xff\xf0\xff\xf1\xff\xf8\xff\xf6\xff\xf1\xff\xf3\xff\xf8\xff\xf7\xff\xf2\xff\xf3\xff\xf7\xff\xfa\xff\xf4\xff\xf7\xff\xf9\xff\xf7\xff\xf1\xff\xf5\xff\xfa\xff\xf7\xff\xf2\xff\xf2\xff\xf8\xff\xf7\xff\xf8\xff\xf3\xff\xf3\xff\xf2\xff\xfb\xff\xf7\xff\xf2\xff\xf3\xff\xfc\xff\xf7\xff\xf2\xff\xf4\xff\xf6\xff\xf9\xff\xf4\xff\xf6\xff\xf5\xff\xf9\xff\xfa\xff\xf7\xff\xf5\xff\xf7\xff\xf9\xff\xf6\xff\xf5\xff\xf4\xff\xf6\xff\xf1\xff\xf6\xff\xf8\xff\xf6\xff\xf6\xff\xfa\xff\xf4\xff\xf7\xff\xf5\xff\xfa\xff\xfb\xff\xf6\xff\xf6\xff\xfb\xff\xfc\xff\xf6\xff\xf5\xff\xf6\xff\xf8\xff\xf9\xff\xf8\xff\xf7\xff\xf5\xff\xff\xff\xf6\xff\xf6\xff\xf7\xff\xfb\xff\xf7\xff\xf7\xff\xf3\xff\xf6\xff\xfa\xff\xfa\xff\xf6\xff\xf7\xff\xf9\xff\xfb\xff\xfc\xff\xf8\xff\xfa\xff\xfb\xff\xfa\xff\xf7\xff\xfe\xff\xfc\xff\xf6\xff\xfa\xff\xfa\xff\xfc\xff\xf9\xff\xf9\xff\xf6\xff\xfc\xff\xfa\xff\xfa\xff\xf5\xff\xfa\xff\xf3\xff\xf9\xff\xff\xff\xfd\xff\xfa\xff\xf9\xff\xfa\xff\xff\xff\xfc\xff\xfa\xff\xfa\xff\xfe\xff\x01\x00\xfc\xff\xf7\xff\xfc\xff\xfc\xff\xfc\xff\xfb\xff\xfb\xff\xf8\xff\xff\xff\xfe\xff\xff\xff\xf5\xff\xfb\xff\xfc\xff\xfe\xff\xf9\xff\xf8\xff\xfb\xff\xfc\xff\xfe\xff\xfb\xff\xfb\xff\xfe\xff\xff\xff\x00\x00\xfc\xff\x00\x00\x03\x00\xff\xff\xf8\xff\xfc\xff\x02\x00\xfd\xff\xf6\xff\xfc\xff\xfc\xff\x00\x00\xfa\xff\x04\x00\xfe\xff\x00\x00\xfb\xff\x01\x00\xfc\xff\xfe\xff\x00\x00\x02\x00\x00\x00\x00\x00\x03\x00\x04\x00\x01\x00\x02\x00\x02\x00\x04\x00\x00\x00\x02\x00\xfe\xff\x02\x00\x00\x00\x01\x00\xfa\xff\x01\x00\x02\x00\xff\xff\xf9\xff\x00\x00\x03\x00\x05\x00\xfd\xff\xff\xff\xff\xff\x06\x00\b\x00\xfb\xff\xfe\xff\x05\x00\b\x00\x00\x00\xfe\xff\b\x00\x03\x00\x01\x00\x02\x00\b\x00\x02\x00\x00\x00\xfc\xff\x04\x00\x01\x00\x00\x00\xfe\xff\a\x00\x05\x00\x06\x00\x00\x00\t\x00\x05\x00\x06\x00\x03\x00\x05\x00\x04\x00\x06\x00\x05\x00\x03\x00\x05\x00\n\x00\x04\x00\x06\x00\x05\x00\t\x00\x03\x00\n\x00\x01\x00\t\x00\x06\x00\x04\x00\x00\x00\b\x00\x05\x00\a\x00\x06\x00\a\x00\a\x00\b\x00\x06\x00\b\x00\a\x00\n\x00\b\x00\v\x00\t\x00\x05\x00\a\x00\a\x00\x03\x00\x06\x00\x06\x00\n\x00\x02\x00\t\x00\x05\x00\r\x00\a\x00\n\x00\x03\x00\n\x00\r\x00\n\x00\x03\x00\x04\x00\f\x00\x0f\x00\n\x00\b\x00\t\x00\x10\x00\t\x00\x06\x00\x04\x00\f\x00\v\x00\v\x00\a\x00\t\x00\x06\x00\r\x00\b\x00\n\x00\b\x00\x0f\x00\t\x00\f\x00\v\x00\t\x00\a\x00\n\x00\v\x00\v\x00\f\x00\t\x00\v\x00\x0e\x00\n\x00\v\x00\t\x00\f\x00\b\x00\x0e\x00\n\x00\v\x00\t\x00\v\x00\b\x00\f\x00\x06\x00\r\x00\v\x00\x0e\x00\t\x00\x10\x00\f\x00\f\x00\x0f\x00\x0e\x00\v\x00\b\x00\r\x00\v\x00\t\x00\r\x00\x11\x00\x15\x00\b\x00\v\x00\f\x00\x12\x00\x06\x00\a\x00\r\x00\f\x00\n\x00\v\x00\t\x00\n\x00\v\x00\x11\x00\x0e\x00\x10\x00\v\x00\x0f\x00\r\x00\r\x00\v\x00\x10\x00\x11\x00\r\x00\n\x00\x0f\x00\x0f\x00\x10\x00\b\x00\f\x00\r\x00\x14\x00\n\x00\v\x00\n\x00\x12\x00\n\x00\t\x00\x10\x00\x12\x00\x06\x00\b\x00\x11\x00\x11\x00\x06\x00\f\x00\x10\x00\x11\x00\v\x00\f\x00\r\x00\r\x00\v\x00\r\x00\v\x00\r\x00\f\x00\v\x00\n\x00\x10\x00\t\x00\n\x00\x0e\x00\r\x00\b\x00\n\x00\x0f\x00\x0e\x00\x06\x00\x0f\x00\x12\x00\r\x00\v\x00\x0e\x00\x0f\x00\r\x00\t\x00\x10\x00\x10\x00\t\x00\v\x00\x11\x00\x0f\x00\b\x00\a\x00\x11\x00\f\x00\r\x00\x0e\x00\x10\x00\a\x00\f\x00\x0e\x00\f\x00\x06\x00\n\x00\x10\x00\b\x00\n\x00\x0f\x00\v\x00\v\x00\n\x00\f\x00\r\x00\x10\x00\v\x00\x06\x00\r\x00\x12\x00\n\x00\xff\xff\t\x00\x0e\x00\v\x00\t\x00\t\x00\x0e\x00\a\x00\r\x00\x0f\x00\t\x00\b\x00\b\x00\f\x00\v\x00\t\x00\n\x00\x0e\x00\v\x00\n\x00\x0f\x00\f\x00\x06\x00\x03\x00\x0e\x00\x0e\x00\b\x00\x04\x00\t\x00\n\x00\v\x00\x01\x00\b\x00\x0f\x00\b\x00\x03\x00\n\x00\x0e\x00\x05\x00\x02\x00\t\x00\f\x00\n\x00\a\x00\x06\x00\b\x00\v\x00\x05\x00\x06\x00\b\x00\x06\x00\x06\x00\b\x00\x05\x00\x02\x00\x05\x00\x04\x00\t\x00\t\x00\x04\x00\x02\x00\a\x00\b\x00\x02\x00\x06\x00\b\x00\x05\x00\b\x00\b\x00\x04\x00\x02\x00\b\x00\x03\x00\xff\xff\x04\x00\f\x00\x03\x00\x00\x00\x06\x00\x06\x00\x05\x00\x00\x00\x03\x00\a\x00\x05\x00\x00\x00\x00\x00\x05\x00\x03\x00\x01\x00\xff\xff\x01\x00\x02\x00\x06\x00\x04\x00\xfe\xff\x02\x00\x06\x00\a\x00\xff\xff\xfc\xff\x06\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\xfd\xff\xff\xff\x04\x00\x01\x00\xfa\xff\x00\x00\x04\x00\xff\xff\xfe\xff\x05\x00\xff\xff\xfd\xff\x00\x00\xff\xff\xfa\xff\xff\xff\x02\x00\xff\xff\xfd\xff\x01\x00\x04\x00\xff\xff\xfc\xff\xfe\xff\x01\x00\xff\xff\xf8\xff\x01\x00\x00\x00\xf9\xff\xfe\xff\xff\xff\xfd\xff\xff\xff\xfd\xff\xfa\xff\xf8\xff\xfd\xff\xfd\xff\xfa\xff\xfa\xff\xff\xff\xff\xff\xfb\xff\xfa\xff\xfd\xff\x01\x00\xfb\xff\xf7\xff\xfb\xff\xfe\xff\xfc\xff\xf1\xff\xf9\xff\xfd\xff\xfc\xff\xf7\xff\xfc\xff\xff\xff\xf8\xff\xf7\xff\xf9\xff\x01\x00\xfe\xff\xf4\xff\xf6\xff\xff\xff\xfe\xff\xf2\xff\xf7\xff\xfc\xff\xf9\xff\xf6\xff\xf8\xff\xfc\xff\xfa\xff\xf9\xff\xf5\xff\xfa\xff\xfe\xff\xfb\xff\xf4\xff\xf5\xff\xfd\xff\xfb\xff\xf7\xff\xf1\xff\xf6\xff\xfd\xff\xfe\xff\xf5\xff\xf3\xff\xfb\xff\xfe\xff\xf7\xff\xf2\xff\xfa\xff\xfb\xff\xf3\xff\xf4\xff\xf9\xff\xfa\xff\xf3\xff\xf1\xff\xf8\xff\xfb\xff\xf4\xff\xf3\xff\xf5\xff\xf9\xff\xf6\xff\xf3\xff\xf3\xff\xf7\xff\xf9\xff\xf7\xff\xf4\xff\xf6\xff\xf2\xff\xf2\xff\xf8\xff\xf6\xff\xf0\xff\xef\xff\xf6\xff\xf9\xff\xf5\xff\xf1\xff\xee\xff\xf4\xff\xfa\xff\xf3\xff\xf0\xff\xf1\xff\xf6\xff\xf7\xff\xf7\xff\xf6\xff\xf5\xff\xf5\xff\xf3\xff\xf8\xff\xf2\xff\xf2\xff\xf2\xff\xf6\xff\xf8\xff\xf5\xff\xf9\xff\xf0\xff\xf4\xff\xf5\xff\xf5\xff\xee\xff\xf0\xff\xf4\xff\xf3\xff\xef\xff\xf1\xff\xf2\xff\xf6\xff\xf4\xff\xf4\xff\xf5\xff\xf7\xff\xf4\xff\xf2\xff\xf2\xff\xf6\xff\xf3\xff\xef\xff\xf1\xff\xf8\xff\xf4\xff\xeb\xff\xed\xff\xf8\xff\xef\xff\xf0\xff\xed\xff\xf2\xff\xf4\xff\xf6\xff\xeb\xff\xf2\xff\xfa\xff\xf2\xff\xeb\xff\xf3\xff\xf9\xff\xef\xff\xed\xff\xf5\xff\xf4\xff\xef\xff\xf3\xff\xf8\xff\xf3\xff\xef\xff\xf2\xff\xf6\xff\xf0\xff\xf0\xff\xed\xff\xf4\xff\xf1\xff\xf2\xff\xee\xff\xf1\xff\xef\xff\xf5\xff\xf1\xff\xf1\xff\xf2\xff\xf6\xff\xf2\xff\xed\xff\xf0\xff\xf4\xff\xf5\xff\xf4\xff\xee\xff\xf7\xff\xf5\xff\xf1\xff\xee\xff\xf3\xff\xf0\xff\xef\xff\xef\xff\xf0\xff\xef\xff\xf6\xff\xf0\xff\xf0\xff\xf0\xff\xf7\xff\xf2\xff\xf3\xff\xf0\xff\xf3\xff\xf6\xff\xf2\xff\xf0\xff\xf3\xff\xf2\xff\xee\xff\xf4\xff\xf6\xff\xed\xff\xf2\xff\xf6\xff\xf8\xff\xed\xff\xf6\xff\xf3\xff\xee\xff\xf0\xff\xf6\xff\xf2\xff\xf0\xff\xed\xff\xf4\xff\xf4\xff\xf5\xff\xed\xff\xf3\xff\xf7\xff\xf6\xff\xf2\xff\xf6\xff\xf6\xff\xf1\xff\xf5\xff\xf6\xff\xef\xff\xf2\xff\xf5\xff\xf5\xff\xef\xff\xf5\xff\xf4\xff\xf7\xff\xf1\xff\xf2\xff\xf4\xff\xf9\xff\xef\xff\xf3\xff\xf3\xff\xf7\xff\xf6\xff\xf8\xff\xf5\xff\xf2\xff\xf2\xff\xf9\xff\xf7\xff\xf3\xff\xf7\xff\xf8\xff\xf7\xff\xf5\xff\xf6\xff\xf8\xff\xf3\xff\xf7\xff\xf8\xff\xf9\xff\xf6\xff\xf7\xff\xf6\xff\xf9\xff\xf5\xff\xf8\xff\xf5\xff\xf7\xff\xf9\xff\xf7\xff\xf2\xff\xf8\xff\xfc\xff\xfa\xff\xf5\xff\xf9\xff\xfe\xff\xfd\xff\xf7\xff\xf9\xff\xfc\xff\xfa\xff\xf7\xff\xf9\xff\xfa\xff\xf8\xff\xf5\xff\xfc\xff\xfa\xff\xfe\xff\xf9\xff\xfe\xff\xfa\xff\xfb\xff\xf7\xff\xfb\xff\xf9\xff\xf6\xff\xf9\xff\x00\x00\xfb\xff\xf5\xff\xfb\xff\x01\x00\xfc\xff\xfe\xff\xfc\xff\xfe\xff\x02\x00\x00\x00\xf6\xff\xfa\xff\xfe\xff\xff\xff\xfa\xff\xfa\xff\xf9\xff\xfe\xff\xfd\xff\xfb\xff\xf5\xff\x01\x00\xfc\xff\xfc\xff\xfe\xff\x00\x00\x00\x00\xf8\xff\xf9\xff\xfd\xff\x03\x00\x00\x00\xfb\xff\x02\x00\x05\x00\x00\x00\xfb\xff\xff\xff\x00\x00\x00\x00\xfa\xff\xfc\xff\x00\x00\x04\x00\xf9\xff\xfe\xff\xff\xff\x05\x00\xfe\xff\xff\xff\x00\x00\x01\x00\xff\xff\xfe\xff\x00\x00\x02\x00\x00\x00\x03\x00\x04\x00\x03\x00\x00\x00\x05\x00\x03\x00\x04\x00\x04\x00\x05\x00\x02\x00\x04\x00\xff\xff\xfe\xff\x00\x00\b\x00\x00\x00\xff\xff\x03\x00\a\x00\x02\x00\x03\x00\x01\x00\x06\x00\x06\x00\x06\x00\b\x00\x05\x00\x04\x00\x02\x00\x03\x00\x04\x00\x06\x00\b\x00\x02\x00\x04\x00\t\x00\a\x00\xff\xff\v\x00\t\x00\x04\x00\x03\x00\v\x00\b\x00\x01\x00\x02\x00\b\x00\n\x00\a\x00\x06\x00\v\x00\n\x00\a\x00\a\x00\t\x00\t\x00\b\x00\n\x00\b\x00\a\x00\t\x00\a\x00\x05\x00\n\x00\x0e\x00\b\x00\t\x00\f\x00\a\x00\x03\x00\v\x00\t\x00\x03\x00\b\x00\f\x00\f\x00\a\x00\t\x00\f\x00\n\x00\n\x00\n\x00\v\x00\r\x00\b\x00\n\x00\n\x00\a\x00\t\x00\b\x00\b\x00\r\x00\x12\x00\b\x00\x05\x00\f\x00\x13\x00\t\x00\x02\x00\f\x00\x0f\x00\x0e\x00\x04\x00\b\x00\x0e\x00\v\x00\t\x00\f\x00\x12\x00\x10\x00\t\x00\v\x00\r\x00\f\x00\b\x00\n\x00\b\x00\v\x00\n\x00\n\x00\n\x00\n\x00\f\x00\x0f\x00\x0e\x00\v\x00\v\x00\r\x00\v\x00\n\x00\r\x00\x0f\x00\a\x00\n\x00\x13\x00\x10\x00\x06\x00\r\x00\x14\x00\r\x00\b\x00\r\x00\x0f\x00\v\x00\b\x00\f\x00\x0e\x00\v\x00\n\x00\f\x00\x0e\x00\r\x00\x0e\x00\f\x00\f\x00\r\x00\x0f\x00\f\x00\a\x00\r\x00\x0f\x00\r\x00\t\x00\f\x00\x0e\x00\x10\x00\x0f\x00\r\x00\v\x00\x0f\x00\f\x00\v\x00\t\x00\n\x00\t\x00\f\x00\v\x00\v\x00\r\x00\r\x00\v\x00\v\x00\x0e\x00\x12\x00\x10\x00\b\x00\t\x00\x11\x00\n\x00\t\x00\v\x00\f\x00\a\x00\v\x00\x10\x00\x0e\x00\f\x00\t\x00\r\x00\x10\x00\r\x00\a\x00\a\x00\f\x00\x0e\x00\b\x00\x06\x00\r\x00\x10\x00\b\x00\x04\x00\f\x00\x0f\x00\n\x00\a\x00\r\x00\r\x00\n\x00\x06\x00\f\x00\n\x00\v\x00\a\x00\b\x00\r\x00\x0e\x00\x06\x00\x06\x00\f\x00\r\x00\x05\x00\x06\x00\n\x00\r\x00\b\x00\t\x00\x05\x00\f\x00\x0f\x00\v\x00\x03\x00\t\x00\f\x00\n\x00\x04\x00\t\x00\t\x00\x06\x00\x06\x00\b\x00\t\x00\n\x00\b\x00\x05\x00\x06\x00\x0f\x00\n\x00\x03\x00\x01\x00\f\x00\b\x00\x04\x00\x02\x00\a\x00\b\x00\x06\x00\x03\x00\n\x00\t\x00\b\x00\x06\x00\a\x00\x06\x00\t\x00\x01\x00\x05\x00\x05\x00\v\x00\xff\xff\x02\x00\b\x00\a\x00\x01\x00\x04\x00\a\x00\x06\x00\x03\x00\b\x00\x05\x00\x03\x00\x01\x00\t\x00\x04\x00\x03\x00\x00\x00\x05\x00\x04\x00\x05\x00\x00\x00\x03\x00\x04\x00\x06\x00\x05\x00\x04\x00\x00\x00\x00\x00\x01\x00\x05\x00\xfe\xff\x00\x00\x03\x00\x05\x00\xff\xff\x02\x00\x04\x00\x02\x00\xfc\xff\x02\x00\x05\x00\xff\xff\xfd\xff\x02\x00\xff\xff\xfe\xff\x01\x00\x04\x00\xfd\xff\x01\x00\x02\x00\x02\x00\xfb\xff\x00\x00\x02\x00\xfe\xff\xfa\xff\x02\x00\x01\x00\xf9\xff\xfb\xff\x01\x00\xff\xff\xfd\xff\xff\xff\xfc\xff\xff\xff\x03\x00\xff\xff\xfa\xff\xfe\xff\x01\x00\xfc\xff\xfe\xff\xff\xff\xfd\xff\xfb\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\xfc\xff\xf7\xff\xfb\xff\x01\x00\xfa\xff\xf5\xff\xfc\xff\xff\xff\xfa\xff\xf6\xff\xfb\xff\x03\x00\xf8\xff\xf6\xff\x00\x00\x01\x00\xf8\xff\xf5\xff\x00\x00\xf9\xff\xf4\xff\xfb\xff\xff\xff\xf5\xff\xf9\xff\xfe\xff\xf9\xff\xf8\xff\xfd\xff\xfb\xff\xf4\xff\xf8\xff\xfc\xff\xf8\xff\xf4\xff\xf9\xff\xfc\xff\xf4\xff\xf3\xff\xfe\xff\xff\xff\xf4\xff\xf6\xff\xfe\xff\xfc\xff\xf8\xff\xf9\xff\xf4\xff\xf9\xff\xfb\xff\xf8\xff\xf6\xff\xf8\xff\xf5\xff\xf8\xff\xfd\xff\xf6\xff\xf2\xff\xf8\xff\xff\xff\xf3\xff\xf3\xff\xfa\xff\xf9\xff\xf8\xff\xf7\xff\xf4\xff\xf5\xff\xf9\xff\xfa\xff\xf5\xff\xf3\xff\xfc\xff\xf7\xff\xf4\xff\xf7\xff\xf8\xff\xf4\xff\xf4\xff\xf8\xff\xf2\xff\xf6\xff\xfb\xff\xf7\xff\xee\xff\xf7\xff\xf9\xff\xf6\xff\xf4\xff\xf7\xff\xf4\xff\xf6\xff\xf9\xff\xf4\xff\xf0\xff\xf9\xff\xfb\xff\xf4\xff\xf2\xff\xf9\xff\xf8\xff\xf5\xff\xf3\xff\xf4\xff\xf7\xff\xfa\xff\xf3\xff\xf2\xff\xf3\xff\xf6\xff\xf4\xff\xf6\xff\xf5\xff\xf6\xff\xf2\xff\xfa\xff\xf6\xff\xf7\xff\xf3\xff\xf7\xff\xf9\xff\xf4\xff\xf3\xff\xf9\xff\xf6\xff\xf1\xff\xf5\xff\xfc\xff\xf3\xff\xf2\xff\xf8\xff\xfe\xff\xf5\xff\xf2\xff\xf0\xff\xf9\xff\xf6\xff\xf5\xff\xee\xff\xf3\xff\xf5\xff\xf8\xff\xf2\xff\xf5\xff\xf7\xff\xf6\xff\xf2\xff\xf8\xff\xf7\xff\xf3\xff\xf1\xff\xf8\xff\xf6\xff\xf4\xff\xf2\xff\xf7\xff\xf6\xff\xf4\xff\xee\xff\xf4\xff\xf4\xff\xf8\xff\xf4\xff\xf1\xff\xf0\xff\xfb\xff\xf7\xff\xf4\xff\xee\xff\xf7\xff\xf7\xff\xf9\xff\xf2\xff\xf3\xff\xf5\xff\xfc\xff\xf4\xff\xf4\xff\xf5\xff\xf7\xff\xf6\xff\xf8\xff\xf6\xff\xf1\xff\xef\xff\xff\xff\xf9\xff\xf0\xff\xee\xff\x00\x00\xf8\xff\xf1\xff\xef\xff\xfc\xff\xf6\xff\xf4\xff\xf2\xff\xf8\xff\xf7\xff\xfa\xff\xf4\xff\xf7\xff\xfa\xff\xfa\xff\xf6\xff\xf9\xff\xfa\xff\xf9\xff\xf4\xff\xf9\xff\xf6\xff\xf7\xff\xf5\xff\xf5\xff\xf5\xff\xfc\xff\xf4\xff\xf6\xff\xf8\xff\xfb\xff\xf5\xff\xf8\xff\xf6\xff\xfa\xff\xf9\xff\xf8\xff\xf4\xff\xf9\xff\xfc\xff\xf5\xff\xf3\xff\xfc\xff\xfb\xff\xf6\xff\xf5\xff\xfc\xff\xf9\xff\xf8\xff\xf7\xff\xfa\xff\xf6\xff\xfb\xff\xf9\xff\xfa\xff\xf7\xff\xfc\xff\xf9\xff\xfb\xff\xf9\xff\x00\x00\xfd\xff\xfa\xff\xfa\xff\xfd\xff\xfd\xff\xfc\xff\xfd\xff\xfd\xff\xfa\xff\xff\xff\x00\x00\xfd\xff\xfb\xff\xff\xff\xfe\xff\xfa\xff\xfc\xff\xff\xff\xfb\xff\xfa\xff\xfe\xff\xff\xff\xf9\xff\xff\xff\xff\xff\xfd\xff\xfe\xff\x02\x00\xfd\xff\xfa\xff\x03\x00\x03\x00\xf9\xff\xfb\xff\x03\x00\xfd\xff\xf9\xff\xfe\xff\xfe\xff\xfc\xff\x00\x00\x04\x00\xfc\xff\xfc\xff\x01\x00\x05\x00\xfb\xff\xff\xff\x01\x00\x00\x00\xfe\xff\x05\x00\x03\x00\xf8\xff\xfe\xff\f\x00\x06\x00\xf9\xff\xff\xff\f\x00\x04\x00\xfa\xff\x03\x00\b\x00\xfd\xff\xff\xff\t\x00\x04\x00\xf6\xff\x04\x00\x0e\x00\x01\x00\xfa\xff\t\x00\f\x00\x02\x00\xfe\xff\x06\x00\v\x00\x06\x00\x00\x00\x02\x00\t\x00\b\x00\x00\x00\x01\x00\b\x00\x05\x00\x02\x00\x05\x00\t\x00\x03\x00\xfe\xff\x06\x00\n\x00\x06\x00\x03\x00\b\x00\v\x00\t\x00\x03\x00\x06\x00\t\x00\b\x00\x04\x00\x05\x00\v\x00\v\x00\x05\x00\xfe\xff\v\x00\x10\x00\x04\x00\x01\x00\v\x00\x11\x00\t\x00\x05\x00\t\x00\f\x00\b\x00\x02\x00\t\x00\v\x00\a\x00\x03\x00\n\x00\v\x00\a\x00\a\x00\f\x00\f\x00\t\x00\x06\x00\r\x00\v\x00\a\x00\b\x00\x0e\x00\a\x00\x06\x00\f\x00\r\x00\x05\x00\a\x00\f\x00\x0f\x00\n\x00\f\x00\v\x00\t\x00\n\x00\v\x00\n\x00\x0e\x00\b\x00\n\x00\v\x00\x11\x00\t\x00\a\x00\v\x00\x10\x00\n\x00\f\x00\v\x00\x0e\x00\n\x00\f\x00\n\x00\x10\x00\v\x00\f\x00\f\x00\r\x00\r\x00\x12\x00\a\x00\n\x00\f\x00\x12\x00\b\x00\n\x00\f\x00\x11\x00\f\x00\r\x00\b\x00\x13\x00\x12\x00\n\x00\x06\x00\x14\x00\x10\x00\f\x00\x05\x00\x10\x00\x10\x00\x10\x00\x06\x00\f\x00\x15\x00\x14\x00\a\x00\x0e\x00\x13\x00\x16\x00\f\x00\t\x00\f\x00\x15\x00\x0f\x00\x0e\x00\b\x00\x11\x00\x10\x00\x0f\x00\r\x00\x11\x00\x0f\x00\x12\x00\x0f\x00\x15\x00\f\x00\x12\x00\x0e\x00\x11\x00\t\x00\x10\x00\x0f\x00\x15\x00\f\x00\x11\x00\x10\x00\x14\x00\f\x00\x11\x00\x10\x00\x13\x00\t\x00\x12\x00\x10\x00\x15\x00\f\x00\x11\x00\x10\x00\x18\x00\f\x00\x10\x00\x11\x00\x17\x00\n\x00\x10\x00\x0f\x00\x14\x00\x0e\x00\x11\x00\x0e\x00\x12\x00\x10\x00\x15\x00\x0e\x00\x13\x00\x0f\x00\x15\x00\f\x00\x13\x00\r\x00\x0e\x00\r\x00\x16\x00\x0e\x00\x11\x00\x0f\x00\x15\x00\x0f\x00\x13\x00\x0e\x00\x12\x00\v\x00\x16\x00\x0f\x00\x0f\x00\b\x00\x15\x00\x10\x00\x11\x00\v\x00\x13\x00\x0e\x00\x14\x00\x0f\x00\x11\x00\r\x00\x15\x00\x0e\x00\x11\x00\v\x00\x18\x00\r\x00\x10\x00\f\x00\x15\x00\f\x00\x12\x00\x14\x00\x15\x00\v\x00\x10\x00\x0f\x00\x14\x00\v\x00\x0f\x00\v\x00\x11\x00\r\x00\x12\x00\f\x00\x0f\x00\r\x00\x16\x00\n\x00\x10\x00\f\x00\x10\x00\f\x00\x10\x00\f\x00\x10\x00\b\x00\x11\x00\r\x00\x0e\x00\x06\x00\x0f\x00\x0e\x00\x0e\x00\n\x00\x14\x00\t\x00\v\x00\n\x00\x10\x00\a\x00\f\x00\n\x00\x0e\x00\b\x00\x10\x00\f\x00\r\x00\x05\x00\x0f\x00\v\x00\x0f\x00\x06\x00\v\x00\t\x00\x11\x00\a\x00\n\x00\v\x00\x0f\x00\x04\x00\v\x00\f\x00\f\x00\x01\x00\f\x00\t\x00\f\x00\x01\x00\b\x00\a\x00\x10\x00\x05\x00\b\x00\b\x00\x12\x00\x06\x00\x06\x00\x06\x00\r\x00\x03\x00\x04\x00\x02\x00\r\x00\x01\x00\x03\x00\x05\x00\r\x00\x06\x00\x02\x00\x05\x00\f\x00\x03\x00\b\x00\x01\x00\x06\x00\x01\x00\a\x00\x01\x00\x03\x00\x05\x00\n\x00\xff\xff\x05\x00\x06\x00\x05\x00\xff\xff\x06\x00\x04\x00\x03\x00\xfe\xff\x05\x00\x00\x00\x03\x00\x01\x00\xff\xff\xfb\xff\x04\x00\x03\x00\x01\x00\xfc\xff\x04\x00\x01\x00\x01\x00\xfd\xff\x04\x00\x02\x00\xff\xff\xfc\xff\x04\x00\x01\x00\x01\x00\xfe\xff\x03\x00\xfc\xff\xfe\xff\x01\x00\x02\x00\xfa\xff\xfc\xff\xff\xff\x01\x00\xfb\xff\xfc\xff\x00\x00\x03\x00\xf9\xff\xf9\xff\x00\x00\x03\x00\xf9\xff\xfb\xff\xfb\xff\xfc\xff\xfb\xff\x01\x00\xf8\xff\xf6\xff\xfc\xff\x05\x00\xfc\xff\xf6\xff\xf9\xff\xff\xff\xfb\xff\xfa\xff\xfb\xff\xfc\xff\xf6\xff\xfa\xff\xfb\xff\xfb\xff\xf7\xff\xfa\xff\xf8\xff\xfb\xff\xfd\xff\xfa\xff\xf5\xff\xfb\xff\xfe\xff\xfc\xff\xf5\xff\xf9\xff\xfc\xff\xf9\xff\xf3\xff\xfc\xff\xfc\xff\xf6\xff\xf8\xff\xfa\xff\xfb\xff\xfb\xff\xf8\xff\xf5\xff\xf9\xff\xfc\xff\xf3\xff\xf6\xff\xfa\xff\xf7\xff\xf4\xff\xfa\xff\xf9\xff\xf8\xff\xf7\xff\xf6\xff\xf8\xff\xfc\xff\xf6\xff\xf0\xff\xf7\xff\xfd\xff\xf5\xff\xf1\xff\xf4\xff\xf7\xff\xfb\xff\xf5\xff\xf1\xff\xf4\xff\xf8\xff\xf3\xff\xf4\xff\xf5\xff\xf4\xff\xf7\xff\xf7\xff\xee\xff\xf2\xff\xfc\xff\xf7\xff\xee\xff\xf5\xff\xf8\xff\xf6\xff\xf1\xff\xf4\xff\xf4\xff\xf6\xff\xf0\xff\xf3\xff\xf2\xff\xf8\xff\xf3\xff\xf2\xff\xf0\xff\xf9\xff\xf4\xff\xf2\xff\xf0\xff\xf6\xff\xf1\xff\xf4\xff\xf5\xff\xf0\xff\xea\xff\xf8\xff\xf4\xff\xef\xff\xed\xff\xf8\xff\xf5\xff\xf1\xff\xec\xff\xf5\xff\xf5\xff\xf2\xff\xeb\xff\xf4\xff\xf0\xff\xf0\xff\xef\xff\xf5\xff\xed\xff\xef\xff\xf1\xff\xf5\xff\xed\xff\xf1\xff\xef\xff\xf3\xff\xef\xff\xef\xff\xee\xff\xf5\xff\xed\xff\xee\xff\xf0\xff\xf9\xff\xea\xff\xed\xff\xf1\xff\xfc\xff\xee\xff\xed\xff\xeb\xff\xf8\xff\xf1\xff\xef\xff\xea\xff\xf4\xff\xed\xff\xf2\xff\xf1\xff\xf1\xff\xec\xff\xf6\xff\xef\xff\xf1\xff\xef\xff\xf6\xff\xea\xff\xf0\xff\xef\xff\xf2\xff\xec\xff\xf1\xff\xed\xff\xf6\xff\xed\xff\xf1\xff\xef\xff\xf5\xff\xee\xff\xf1\xff\xed\xff\xf1\xff\xef\xff\xf6\xff\xed\xff\xec\xff\xec\xff\xf8\xff\xf1\xff\xee\xff\xe9\xff\xf5\xff\xf0\xff\xf2\xff\xea\xff\xef\xff\xee\xff\xf7\xff\xe9\xff\xef\xff\xef\xff\xf5\xff\xe7\xff\xf0\xff\xf3\xff\xf4\xff\xe8\xff\xf0\xff\xf1\xff\xf3\xff\xe9\xff\xee\xff\xef\xff\xf4\xff\xe9\xff\xf3\xff\xef\xff\xf1\xff\xea\xff\xf6\xff\xec\xff\xef\xff\xed\xff\xf6\xff\xe8\xff\xf1\xff\xf2\xff\xf1\xff\xe4\xff\xf4\xff\xf4\xff\xf5\xff\xe8\xff\xf1\xff\xf1\xff\xf8\xff\xeb\xff\xef\xff\xee\xff\xf7\xff\xee\xff\xf1\xff\xeb\xff\xf5\xff\xf0\xff\xf3\xff\xea\xff\xf3\xff\xf0\xff\xf7\xff\xeb\xff\xf2\xff\xec\xff\xf9\xff\xeb\xff\xf0\xff\xeb\xff\xf6\xff\xee\xff\xf0\xff\xec\xff\xf8\xff\xf1\xff\xf1\xff\xea\xff\xfc\xff\xf6\xff\xf3\xff\xe8\xff\xf8\xff\xf4\xff\xf6\xff\xeb\xff\xf6\xff\xec\xff\xf9\xff\xf3\xff\xf5\xff\xe7\xff\xf9\xff\xf8\xff\xf6\xff\xe8\xff\xfa\xff\xf8\xff\xf6\xff\xeb\xff\xf9\xff\xf4\xff\xfa\xff\xed\xff\xf7\xff\xf3\xff\xfb\xff\xee\xff\xf6\xff\xf5\xff\xfd\xff\xeb\xff\xf2\xff\xf6\xff\x01\x00\xec\xff\xf3\xff\xf7\xff\x00\x00\xee\xff\xf9\xff\xf6\xff\xf9\xff\xf0\xff\xfa\xff\xf4\xff\xf8\xff\xf7\xff\xf8\xff\xef\xff\xfa\xff\xf7\xff\xfb\xff\xf3\xff\xfb\xff\xf6\xff\xfd\xff\xf7\xff\xf9\xff\xf3\xff\xfd\xff\xf5\xff\xf8\xff\xf5\xff\xfe\xff\xf8\xff\xf9\xff\xf4\xff\xff\xff\xfc\xff\xfb\xff\xf4\xff\xfe\xff\xfd\xff\xfd\xff\xf6\xff\x01\x00\xfb\xff\xfa\xff\xf9\xff\x01\x00\xfb\xff\xfd\xff\xfa\xff\xff\xff\xfa\xff\x01\x00\xfb\xff\xfe\xff\xfa\xff\x03\x00\xfe\xff\xfd\xff\xfa\xff\x03\x00\xfe\xff\xfd\xff\xf9\xff\x03\x00\xfe\xff\xff\xff\xfb\xff\x00\x00\xfe\xff\x03\x00\xfe\xff\xfe\xff\xfd\xff\x06\x00\xfb\xff\x00\x00\x00\x00\x02\x00\xf7\xff\x05\x00\x03\x00\xfe\xff\xf7\xff\b\x00\x04\x00\xfd\xff\xfb\xff\a\x00\x00\x00\x01\x00\x01\x00\x06\x00\xfc\xff\x05\x00\x03\x00\x03\x00\xfe\xff\a\x00\x01\x00\x02\x00\x01\x00\a\x00\xfd\xff\x02\x00\x02\x00\t\x00\xff\xff\x04\x00\x04\x00\a\x00\x01\x00\x05\x00\x01\x00\x03\x00\x03\x00\b\x00\x01\x00\x04\x00\x05\x00\a\x00\x00\x00\a\x00\x06\x00\x06\x00\x01\x00\b\x00\x05\x00\a\x00\x06\x00\b\x00\x00\x00\b\x00\x06\x00\t\x00\x03\x00\a\x00\x06\x00\t\x00\x04\x00\v\x00\b\x00\x06\x00\x05\x00\f\x00\a\x00\a\x00\t\x00\r\x00\x05\x00\n\x00\r\x00\v\x00\x03\x00\t\x00\x0e\x00\f\x00\x05\x00\n\x00\r\x00\v\x00\x06\x00\t\x00\n\x00\v\x00\n\x00\n\x00\f\x00\x0e\x00\n\x00\b\x00\v\x00\x0f\x00\f\x00\b\x00\n\x00\x10\x00\x0e\x00\v\x00\n\x00\x0e\x00\f\x00\x0f\x00\x0e\x00\f\x00\f\x00\x11\x00\x12\x00\x0e\x00\r\x00\x0f\x00\x0f\x00\f\x00\x0f\x00\x10\x00\x0e\x00\f\x00\x0f\x00\x11\x00\x0f\x00\r\x00\x0f\x00\x11\x00\x0f\x00\f\x00\x11\x00\x12\x00\x0f\x00\r\x00\x11\x00\x11\x00\x11\x00\r\x00\r\x00\x0e\x00\x14\x00\x10\x00\x0f\x00\x0e\x00\x13\x00\x10\x00\x12\x00\r\x00\x11\x00\x11\x00\x13\x00\r\x00\x10\x00\x12\x00\x13\x00\x0f\x00\x13\x00\x12\x00\x12\x00\x11\x00\x17\x00\x0e\x00\f\x00\x15\x00\x19\x00\v\x00\x12\x00\x19\x00\x16\x00\t\x00\x15\x00\x16\x00\x12\x00\v\x00\x15\x00\x14\x00\x11\x00\f\x00\x14\x00\x14\x00\x12\x00\r\x00\x13\x00\x12\x00\x14\x00\x0f\x00\x15\x00\x0e\x00\x12\x00\x0f\x00\x17\x00\x0e\x00\x0f\x00\f\x00\x16\x00\x12\x00\x11\x00\r\x00\x17\x00\r\x00\x10\x00\x11\x00\x16\x00\v\x00\x12\x00\x0f\x00\x17\x00\x0e\x00\x10\x00\r\x00\x17\x00\x10\x00\x11\x00\r\x00\x15\x00\x0f\x00\x15\x00\x0e\x00\x12\x00\r\x00\x14\x00\x0e\x00\x14\x00\t\x00\x11\x00\x0f\x00\x15\x00\f\x00\x11\x00\n\x00\x12\x00\x10\x00\x11\x00\b\x00\x12\x00\x10\x00\x10\x00\t\x00\x12\x00\r\x00\x14\x00\r\x00\x12\x00\v\x00\x12\x00\f\x00\x12\x00\n\x00\x10\x00\t\x00\x13\x00\r\x00\x11\x00\t\x00\x11\x00\n\x00\x11\x00\b\x00\x11\x00\f\x00\x13\x00\a\x00\x0f\x00\r\x00\x12\x00\x05\x00\x10\x00\x0f\x00\x12\x00\x06\x00\x0f\x00\v\x00\x0f\x00\b\x00\x0e\x00\x06\x00\x12\x00\b\x00\x0e\x00\b\x00\f\x00\x06\x00\x0f\x00\v\x00\f\x00\a\x00\x10\x00\b\x00\x0f\x00\b\x00\f\x00\x05\x00\x10\x00\t\x00\r\x00\x06\x00\x0e\x00\b\x00\r\x00\x04\x00\x0e\x00\x06\x00\n\x00\x06\x00\x10\x00\x05\x00\b\x00\x06\x00\x0f\x00\x04\x00\b\x00\x02\x00\r\x00\b\x00\t\x00\x02\x00\r\x00\x05\x00\t\x00\x05\x00\n\x00\x01\x00\n\x00\x04\x00\a\x00\x02\x00\f\x00\x01\x00\b\x00\x06\x00\a\x00\xfe\xff\r\x00\x06\x00\x04\x00\xfd\xff\f\x00\x06\x00\x04\x00\x01\x00\n\x00\x01\x00\x06\x00\x02\x00\a\x00\xff\xff\x06\x00\x01\x00\x05\x00\x00\x00\b\x00\xfd\xff\x02\x00\x01\x00\x04\x00\xfd\xff\a\x00\x00\x00\xff\xff\xfd\xff\t\x00\x00\x00\xfe\xff\xf9\xff\a\x00\x01\x00\xfe\xff\xf9\xff\b\x00\x02\x00\xfe\xff\xfb\xff\x04\x00\xfd\xff\x02\x00\xfc\xff\xfe\xff\xf6\xff\a\x00\xff\xff\xf6\xff\xf7\xff\n\x00\xfa\xff\xf7\xff\xfe\xff\x06\x00\xf6\xff\xfd\xff\x01\x00\xff\xff\xf7\xff\x01\x00\xfb\xff\xfe\xff\xf7\xff\xff\xff\xf9\xff\xfb\xff\xf9\xff\xfe\xff\xf9\xff\xfc\xff\xfb\xff\x00\x00\xf6\xff\xfa\xff\xfb\xff\xfc\xff\xf5\xff\xfa\xff\xf9\xff\xfb\xff\xfa\xff\xfe\xff\xf8\xff\xf8\xff\xf6\xff\xfe\xff\xf9\xff\xf7\xff\xf4\xff\xfe\xff\xf6\xff\xf7\xff\xf8\xff\xfb\xff\xf6\xff\xf7\xff\xf4\xff\xf9\xff\xf9\xff\xf7\xff\xef\xff\xfa\xff\xf7\xff\xf5\xff\xf5\xff\xfa\xff\xf6\xff\xf7\xff\xf7\xff\xf9\xff\xf7\xff\xf9\xff\xf5\xff\xf7\xff\xf4\xff\xf8\xff\xf7\xff\xf6\xff\xf0\xff\xf5\xff\xf7\xff\xf6\xff\xf3\xff\xf7\xff\xf6\xff\xf6\xff\xf2\xff\xf3\xff\xf9\xff\xf9\xff\xec\xff\xf1\xff\xfc\xff\xfa\xff\xed\xff\xf0\xff\xf7\xff\xf6\xff\xf0\xff\xf3\xff\xf6\xff\xf7\xff\xf1\xff\xf3\xff\xf3\xff\xf8\xff\xf3\xff\xee\xff\xf1\xff\xf8\xff\xf4\xff\xf1\xff\xef\xff\xf1\xff\xf4\xff\xf4\xff\xef\xff\xf0\xff\xf8\xff\xf5\xff\xef\xff\xf1\xff\xf3\xff\xf4\xff\xf5\xff\xf0\xff\xee\xff\xf4\xff\xf8\xff\xf0\xff\xec\xff\xf4\xff\xf8\xff\xef\xff\xec\xff\xf0\xff\xf4\xff\xf1\xff\xf1\xff\xed\xff\xf0\xff\xf4\xff\xf7\xff\xee\xff\xef\xff\xf3\xff\xf6\xff\xef\xff\xed\xff\xf0\xff\xf6\xff\xf2\xff\xee\xff\xed\xff\xf4\xff\xf5\xff\xf1\xff\xec\xff\xf3\xff\xf3\xff\xf1\xff\xee\xff\xf2\xff\xf2\xff\xf1\xff\xec\xff\xf1\xff\xf1\xff\xf1\xff\xee\xff\xf1\xff\xed\xff\xf4\xff\xf5\xff\xf1\xff\xe9\xff\xf2\xff\xf4\xff\xf3\xff\xee\xff\xef\xff\xef\xff\xf1\xff\xef\xff\xf2\xff\xed\xff\xee\xff\xed\xff\xf3\xff\xef\xff\xef\xff\xee\xff\xf1\xff\xed\xff\xf2\xff\xee\xff\xf1\xff\xef\xff\xf4\xff\xec\xff\xef\xff\xf0\xff\xf4\xff\xed\xff\xef\xff\xee\xff\xf3\xff\xed\xff\xf2\xff\xed\xff\xf1\xff\xf0\xff\xf2\xff\xec\xff\xf2\xff\xf0\xff\xf2\xff\xec\xff\xf4\xff\xee\xff\xef\xff\xf0\xff\xf5\xff\xec\xff\xf3\xff\xf5\xff\xf4\xff\xeb\xff\xf3\xff\xf3\xff\xf3\xff\xee\xff\xf4\xff\xef\xff\xf2\xff\xf2\xff\xf5\xff\xec\xff\xf0\xff\xf4\xff\xf3\xff\xeb\xff\xf5\xff\xf4\xff\xf1\xff\xec\xff\xf9\xff\xf4\xff\xf0\xff\xee\xff\xfa\xff\xf2\xff\xf1\xff\xf2\xff\xf7\xff\xef\xff\xf7\xff\xf4\xff\xf3\xff\xed\xff\xfb\xff\xf6\xff\xf3\xff\xee\xff\xfb\xff\xf4\xff\xf6\xff\xf1\xff\xf7\xff\xf4\xff\xfb\xff\xf3\xff\xf3\xff\xf1\xff\xfe\xff\xf1\xff\xf3\xff\xf4\xff\xfb\xff\xf2\xff\xf9\xff\xf4\xff\xf8\xff\xf5\xff\xfa\xff\xef\xff\xf9\xff\xfd\xff\xfa\xff\xe9\xff\xf9\xff\xfc\xff\xf9\xff\xf0\xff\xf9\xff\xf7\xff\xfc\xff\xf5\xff\xf7\xff\xf6\xff\x00\x00\xf2\xff\xf4\xff\xfa\xff\x04\x00\xf5\xff\xf7\xff\xf8\xff\x00\x00\xf6\xff\xfb\xff\xfa\xff\xfc\xff\xf7\xff\xff\xff\xf8\xff\xfd\xff\xfa\xff\xfe\xff\xf8\xff\xff\xff\xfc\xff\x00\x00\xfb\xff\xfb\xff\xf9\xff\x06\x00\xfc\xff\xf7\xff\xf9\xff\a\x00\xfd\xff\xfb\xff\xf9\xff\x06\x00\xff\xff\xfd\xff\xf9\xff\x05\x00\xff\xff\xfe\xff\xfa\xff\x03\x00\x00\x00\x01\x00\xfb\xff\x00\x00\xfe\xff\x03\x00\xfd\xff\x02\x00\xfb\xff\x01\x00\x01\x00\x04\x00\xfd\xff\x00\x00\x01\x00\b\x00\xff\xff\xff\xff\xff\xff\x06\x00\x01\x00\x00\x00\xfe\xff\x03\x00\x03\x00\x03\x00\xfb\xff\x01\x00\x06\x00\x04\x00\xfc\xff\x05\x00\x04\x00\x01\x00\x00\x00\a\x00\x00\x00\x03\x00\x06\x00\x05\x00\xff\xff\t\x00\x04\x00\x02\x00\x03\x00\f\x00\x04\x00\x02\x00\x05\x00\f\x00\x04\x00\x03\x00\x03\x00\v\x00\x05\x00\x05\x00\x02\x00\b\x00\b\x00\n\x00\x01\x00\t\x00\f\x00\v\x00\x00\x00\v\x00\f\x00\b\x00\x02\x00\x0e\x00\r\x00\a\x00\x04\x00\r\x00\b\x00\x06\x00\x05\x00\f\x00\a\x00\b\x00\x06\x00\r\x00\t\x00\t\x00\b\x00\v\x00\x06\x00\n\x00\r\x00\r\x00\x04\x00\t\x00\f\x00\x0e\x00\b\x00\t\x00\a\x00\x0e\x00\r\x00\f\x00\x05\x00\b\x00\f\x00\x10\x00\t\x00\x06\x00\t\x00\x10\x00\x0f\x00\n\x00\x05\x00\r\x00\x10\x00\n\x00\x04\x00\x11\x00\x11\x00\b\x00\a\x00\x10\x00\x0f\x00\n\x00\b\x00\f\x00\r\x00\x0e\x00\f\x00\t\x00\r\x00\x0f\x00\v\x00\r\x00\n\x00\r\x00\x0e\x00\x0e\x00\v\x00\f\x00\x0e\x00\x0f\x00\t\x00\n\x00\x0f\x00\x11\x00\f\x00\n\x00\x11\x00\x10\x00\a\x00\f\x00\x13\x00\f\x00\t\x00\x11\x00\x10\x00\t\x00\x0e\x00\x13\x00\f\x00\t\x00\x0f\x00\x0e\x00\v\x00\x0f\x00\x10\x00\v\x00\t\x00\x10\x00\x15\x00\f\x00\t\x00\x11\x00\x12\x00\v\x00\x0f\x00\x10\x00\x11\x00\v\x00\x0f\x00\x0f\x00\x0e\x00\v\x00\x0f\x00\x11\x00\x10\x00\r\x00\x10\x00\x11\x00\x0e\x00\r\x00\x0f\x00\x12\x00\x0f\x00\n\x00\x10\x00\x13\x00\x0e\x00\t\x00\x0e\x00\x13\x00\x10\x00\v\x00\x0e\x00\x10\x00\x13\x00\r\x00\f\x00\x0e\x00\x12\x00\f\x00\x0f\x00\x0e\x00\x0f\x00\f\x00\x11\x00\r\x00\x0e\x00\r\x00\x0f\x00\x0f\x00\x10\x00\v\x00\x0f\x00\x0e\x00\x0f\x00\x0e\x00\x12\x00\f\x00\x0e\x00\x10\x00\x12\x00\v\x00\r\x00\r\x00\x10\x00\x0e\x00\x10\x00\t\x00\r\x00\x0e\x00\x10\x00\v\x00\f\x00\f\x00\x11\x00\v\x00\x10\x00\v\x00\r\x00\n\x00\x11\x00\f\x00\f\x00\f\x00\x10\x00\b\x00\r\x00\x0e\x00\x10\x00\x06\x00\x10\x00\x0f\x00\f\x00\x05\x00\x0f\x00\r\x00\r\x00\x06\x00\r\x00\f\x00\x0f\x00\x04\x00\b\x00\f\x00\x10\x00\x04\x00\n\x00\x0e\x00\x10\x00\x04\x00\a\x00\v\x00\x10\x00\a\x00\a\x00\a\x00\x0e\x00\v\x00\r\x00\a\x00\t\x00\n\x00\x0f\x00\x04\x00\n\x00\f\x00\v\x00\x04\x00\r\x00\f\x00\n\x00\x04\x00\n\x00\n\x00\r\x00\x04\x00\a\x00\n\x00\f\x00\x06\x00\v\x00\x06\x00\a\x00\b\x00\r\x00\x05\x00\b\x00\a\x00\t\x00\a\x00\r\x00\x03\x00\x03\x00\t\x00\r\x00\x02\x00\x01\x00\x06\x00\r\x00\a\x00\x02\x00\x02\x00\x0e\x00\t\x00\x05\x00\x05\x00\n\x00\x03\x00\x05\x00\x06\x00\b\x00\x02\x00\x03\x00\x05\x00\a\x00\x04\x00\x06\x00\x04\x00\x04\x00\x04\x00\t\x00\x04\x00\x00\x00\x05\x00\t\x00\x01\x00\x03\x00\b\x00\x06\x00\x03\x00\a\x00\x01\x00\x02\x00\b\x00\b\x00\xfa\xff\x00\x00\v\x00\a\x00\xfd\xff\x02\x00\x02\x00\x03\x00\x02\x00\x05\x00\xff\xff\xff\xff\x00\x00\b\x00\x01\x00\xfe\xff\xfe\xff\x04\x00\x00\x00\x01\x00\x00\x00\x01\x00\xfd\xff\x03\x00\x01\x00\x01\x00\xfc\xff\xfe\xff\x00\x00\x04\x00\xfa\xff\xfd\xff\x00\x00\x02\x00\xfb\xff\x01\x00\xfe\xff\xfd\xff\xfd\xff\x02\x00\xfb\xff\xfe\xff\xfd\xff\xff\xff\xf9\xff\xfe\xff\xfb\xff\xfb\xff\xfb\xff\x01\x00\xfa\xff\xfa\xff\xfd\xff\x00\x00\xfa\xff\xfd\xff\xfa\xff\xfa\xff\xf9\xff\x00\x00\xfb\xff\xf6\xff\xfa\xff\xff\xff\xf6\xff\xfa\xff\xfc\xff\xf9\xff\xf6\xff\xfe\xff\xfb\xff\xfa\xff\xf8\xff\xfd\xff\xf9\xff\xf9\xff\xf7\xff\xfb\xff\xfb\xff\xf8\xff\xf5\xff\xfa\xff\xfb\xff\xf9\xff\xf4\xff\xf8\xff\xfa\xff\xfa\xff\xf3\xff\xf7\xff\xfb\xff\xf7\xff\xf6\xff\xfb\xff\xf6\xff\xf5\xff\xf6\xff\xf9\xff\xf6\xff\xf6\xff\xf6\xff\xf5\xff\xf6\xff\xf8\xff\xf7\xff\xf5\xff\xf3\xff\xf8\xff\xfa\xff\xf6\xff\xf4\xff\xf8\xff\xf8\xff\xf5\xff\xf5\xff\xf7\xff\xf4\xff\xf5\xff\xf8\xff\xf5\xff\xf2\xff\xf5\xff\xf6\xff\xf5\xff\xf5\xff\xf2\xff\xf4\xff\xf9\xff\xf7\xff\xf1\xff\xf1\xff\xf8\xff\xf5\xff\xf0\xff\xf3\xff\xf6\xff\xf4\xff\xf4\xff\xf5\xff\xf3\xff\xf4\xff\xf6\xff\xf3\xff\xf2\xff\xf7\xff\xf7\xff\xef\xff\xf1\xff\xf9\xff\xf7\xff\xee\xff\xf1\xff\xf8\xff\xf5\xff\xf1\xff\xf2\xff\xf3\xff\xf5\xff\xf5\xff\xf4\xff\xf1\xff\xf3\xff\xf4\xff\xf3\xff\xf2\xff\xf4\xff\xf3\xff\xf2\xff\xf5\xff\xf6\xff\xf1\xff\xf0\xff\xf6\xff\xf7\xff\xf0\xff\xf0\xff\xf6\xff\xf6\xff\xf0\xff\xf2\xff\xf8\xff\xf2\xff\xf2\xff\xf4\xff\xf4\xff\xf5\xff\xf7\xff\xf2\xff\xf0\xff\xf6\xff\xf8\xff\xf0\xff\xf0\xff\xf5\xff\xf6\xff\xf2\xff\xf5\xff\xf2\xff\xf1\xff\xf4\xff\xf8\xff\xf4\xff\xf1\xff\xf1\xff\xf5\xff\xf4\xff\xf5\xff\xf0\xff\xef\xff\xf4\xff\xf8\xff\xf2\xff\xef\xff\xf4\xff\xf8\xff\xf1\xff\xf1\xff\xf5\xff\xf8\xff\xf2\xff\xf2\xff\xf5\xff\xf7\xff\xf1\xff\xf7\xff\xf5\xff\xf4\xff\xf4\xff\xf9\xff\xf5\xff\xf2\xff\xf1\xff\xf7\xff\xf9\xff\xf5\xff\xef\xff\xf7\xff\xf8\xff\xf5\xff\xf2\xff\xf9\xff\xf5\xff\xf3\xff\xf5\xff\xf8\xff\xf4\xff\xf5\xff\xf3\xff\xf9\xff\xf4\xff\xf5\xff\xf3\xff\xf8\xff\xf4\xff\xf6\xff\xf3\xff\xf7\xff\xf6\xff\xf8\xff\xf5\xff\xf7\xff\xf5\xff\xf3\xff\xf6\xff\xfa\xff\xf6\xff\xf4\xff\xf3\xff\xfa\xff\xfa\xff\xf7\xff\xee\xff\xf6\xff\xfc\xff\xf8\xff\xef\xff\xf9\xff\xfb\xff\xf7\xff\xf1\xff\xfc\xff\xf9\xff\xf6\xff\xf5\xff\xfa\xff\xf6\xff\xf7\xff\xf9\xff\xfa\xff\xf4\xff\xfa\xff\xf7\xff\xfa\xff\xf5\xff\xf8\xff\xf6\xff\xfd\xff\xf5\xff\xf6\xff\xf8\xff\x00\x00\xf3\xff\xf7\xff\xf8\xff\xfc\xff\xf7\xff\xfa\xff\xf6\xff\xfa\xff\xfb\xff\xfc\xff\xf5\xff\xfb\xff\xfa\xff\xfc\xff\xf9\xff\xfb\xff\xf8\xff\xfc\xff\xf8\xff\xfc\xff\xfa\xff\xfc\xff\xf8\xff\xff\xff\xfc\xff\xfc\xff\xf9\xff\xff\xff\xfb\xff\xfb\xff\xfa\xff\xff\xff\xfb\xff\xfd\xff\xfb\xff\xff\xff\xfa\xff\xfd\xff\xfe\xff\xff\xff\xfa\xff\xff\xff\xfc\xff\xfe\xff\xfc\xff\x02\x00\xfc\xff\xfc\xff\xfd\xff\x05\x00\x00\x00\xfc\xff\xf9\xff\x01\x00\x04\x00\x02\x00\xf7\xff\xfe\xff\x05\x00\x05\x00\xf8\xff\xff\xff\x05\x00\x04\x00\xfb\xff\x03\x00\x01\x00\x01\x00\xfd\xff\x03\x00\xff\xff\x05\x00\x02\x00\x01\x00\xfe\xff\a\x00\x03\x00\x03\x00\xff\xff\x04\x00\x06\x00\a\x00\xff\xff\x03\x00\x05\x00\a\x00\x01\x00\x05\x00\x04\x00\b\x00\x02\x00\x04\x00\x05\x00\a\x00\x01\x00\x06\x00\x04\x00\t\x00\x03\x00\b\x00\x04\x00\x06\x00\x04\x00\b\x00\x06\x00\b\x00\x01\x00\b\x00\t\x00\t\x00\x04\x00\x06\x00\x06\x00\n\x00\x05\x00\a\x00\x05\x00\b\x00\x06\x00\n\x00\b\x00\x06\x00\x05\x00\f\x00\n\x00\b\x00\x02\x00\v\x00\f\x00\t\x00\x03\x00\n\x00\b\x00\v\x00\t\x00\b\x00\x06\x00\r\x00\t\x00\t\x00\f\x00\r\x00\x05\x00\t\x00\r\x00\v\x00\b\x00\v\x00\b\x00\n\x00\t\x00\x0f\x00\n\x00\n\x00\v\x00\r\x00\b\x00\v\x00\f\x00\v\x00\x06\x00\v\x00\x10\x00\x10\x00\b\x00\t\x00\f\x00\x12\x00\t\x00\x05\x00\x0e\x00\x14\x00\t\x00\b\x00\x0e\x00\x12\x00\b\x00\r\x00\x0e\x00\v\x00\t\x00\x12\x00\x0e\x00\n\x00\v\x00\x10\x00\x0f\x00\x0f\x00\t\x00\x0f\x00\x13\x00\x0e\x00\b\x00\x11\x00\x11\x00\x0e\x00\x0e\x00\x11\x00\x0e\x00\x0f\x00\r\x00\x10\x00\x0e\x00\x10\x00\r\x00\x10\x00\r\x00\x0f\x00\x0e\x00\x10\x00\r\x00\x11\x00\x0e\x00\x10\x00\x0e\x00\x11\x00\x12\x00\x0f\x00\b\x00\x11\x00\x14\x00\x0e\x00\n\x00\x12\x00\x10\x00\x0f\x00\x10\x00\x11\x00\x0f\x00\x15\x00\x11\x00\f\x00\x0f\x00\x15\x00\x10\x00\x10\x00\x12\x00\x11\x00\r\x00\x12\x00\x18\x00\x0f\x00\b\x00\x10\x00\x1b\x00\x13\x00\x06\x00\x0e\x00\x19\x00\x15\x00\r\x00\x10\x00\x15\x00\x14\x00\x10\x00\x13\x00\x12\x00\x10\x00\x12\x00\x13\x00\x11\x00\x0f\x00\x15\x00\x14\x00\x0e\x00\x10\x00\x17\x00\x16\x00\x0f\x00\x10\x00\x13\x00\x17\x00\x14\x00\r\x00\x12\x00\x18\x00\x12\x00\r\x00\x13\x00\x14\x00\x11\x00\x12\x00\x16\x00\x12\x00\x10\x00\x13\x00\x14\x00\x10\x00\x0f\x00\x13\x00\x15\x00\x0f\x00\x11\x00\x15\x00\x11\x00\x0f\x00\x14\x00\x14\x00\x0f\x00\x0e\x00\x16\x00\x14\x00\r\x00\x0e\x00\x14\x00\x14\x00\x10\x00\x0e\x00\x15\x00\x11\x00\r\x00\x0f\x00\x16\x00\x0f\x00\n\x00\x10\x00\x19\x00\r\x00\v\x00\x12\x00\x15\x00\v\x00\x0f\x00\x13\x00\x13\x00\r\x00\x10\x00\f\x00\x11\x00\x10\x00\x0e\x00\n\x00\x12\x00\x10\x00\v\x00\r\x00\x12\x00\f\x00\v\x00\x10\x00\x11\x00\v\x00\x0f\x00\x0e\x00\n\x00\f\x00\x14\x00\v\x00\b\x00\f\x00\x11\x00\v\x00\r\x00\f\x00\r\x00\n\x00\x0e\x00\x0f\x00\x0e\x00\x06\x00\r\x00\x10\x00\r\x00\x05\x00\x12\x00\r\x00\b\x00\n\x00\x13\x00\b\x00\f\x00\f\x00\x0f\x00\x06\x00\x0e\x00\n\x00\n\x00\n\x00\x0e\x00\n\x00\n\x00\t\x00\x12\x00\a\x00\t\x00\t\x00\x0f\x00\a\x00\a\x00\b\x00\x10\x00\x04\x00\b\x00\t\x00\n\x00\x04\x00\n\x00\b\x00\a\x00\x05\x00\n\x00\b\x00\t\x00\x01\x00\f\x00\f\x00\x05\x00\x00\x00\r\x00\t\x00\b\x00\x02\x00\t\x00\x05\x00\r\x00\x03\x00\x03\x00\x06\x00\x0e\x00\x00\x00\x04\x00\a\x00\a\x00\xff\xff\n\x00\a\x00\x03\x00\x01\x00\v\x00\x02\x00\x00\x00\x03\x00\f\x00\xfe\xff\x02\x00\a\x00\b\x00\xfa\xff\x02\x00\x06\x00\x03\x00\xfc\xff\x06\x00\x03\x00\x02\x00\xff\xff\x03\x00\x00\x00\x01\x00\xff\xff\x05\x00\xfe\xff\xff\xff\x00\x00\x03\x00\xff\xff\x01\x00\xfc\xff\xff\xff\xff\xff\x03\x00\xfd\xff\xff\xff\xfe\xff\x01\x00\xfe\xff\x01\x00\xfc\xff\xfc\xff\xfb\xff\x02\x00\xfb\xff\xfb\xff\xf9\xff\x01\x00\xfd\xff\xfc\xff\xfa\xff\xfe\xff\xfa\xff\xfd\xff\xfb\xff\xfd\xff\xf8\xff\xfd\xff\xfc\xff\xf9\xff\xf8\xff\xfe\xff\xf9\xff\xf8\xff\xf6\xff\x00\x00\xfa\xff\xf9\xff\xf5\xff\xff\xff\xfb\xff\xf6\xff\xf6\xff\x00\x00\xf7\xff\xf7\xff\xf9\xff\xfa\xff\xf1\xff\xfb\xff\xfd\xff\xf9\xff\xf1\xff\xfb\xff\xfb\xff\xf7\xff\xf2\xff\xfc\xff\xf7\xff\xf7\xff\xf6\xff\xfd\xff\xf6\xff\xf7\xff\xf5\xff\xf8\xff\xf4\xff\xf9\xff\xf6\xff\xf6\xff\xf2\xff\xf5\xff\xf8\xff\xf6\xff\xf0\xff\xf6\xff\xf7\xff\xf5\xff\xf3\xff\xf7\xff\xf1\xff\xf5\xff\xfa\xff\xf8\xff\xec\xff\xf4\xff\xfa\xff\xf7\xff\xed\xff\xf4\xff\xf5\xff\xf4\xff\xf2\xff\xf5\xff\xf4\xff\xf4\xff\xf3\xff\xf4\xff\xf0\xff\xf3\xff\xf5\xff\xf4\xff\xee\xff\xf0\xff\xf4\xff\xf6\xff\xf1\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf4\xff\xf0\xff\xf1\xff\xf1\xff\xf2\xff\xf1\xff\xf4\xff\xef\xff\xef\xff\xf0\xff\xf6\xff\xf1\xff\xed\xff\xed\xff\xf6\xff\xf4\xff\xf0\xff\xee\xff\xf3\xff\xf3\xff\xf3\xff\xf1\xff\xf3\xff\xf1\xff\xf2\xff\xf3\xff\xf4\xff\xf0\xff\xef\xff\xf1\xff\xf2\xff\xf3\xff\xf1\xff\xec\xff\xee\xff\xf6\xff\xf2\xff\xeb\xff\xef\xff\xf6\xff\xee\xff\xed\xff\xf2\xff\xf1\xff\xee\xff\xf1\xff\xf1\xff\xf0\xff\xee\xff\xed\xff\xef\xff\xf3\xff\xf0\xff\xed\xff\xef\xff\xf1\xff\xf0\xff\xef\xff\xee\xff\xf0\xff\xee\xff\xf0\xff\xf1\xff\xef\xff\xea\xff\xf0\xff\xf4\xff\xf1\xff\xea\xff\xed\xff\xf3\xff\xf9\xff\xec\xff\xe9\xff\xf1\xff\xf9\xff\xf0\xff\xeb\xff\xee\xff\xf4\xff\xf0\xff\xf1\xff\xee\xff\xee\xff\xed\xff\xf4\xff\xef\xff\xec\xff\xef\xff\xf2\xff\xee\xff\xef\xff\xf1\xff\xf5\xff\xef\xff\xec\xff\xf0\xff\xf6\xff\xee\xff\xee\xff\xf0\xff\xf2\xff\xef\xff\xf2\xff\xef\xff\xef\xff\xef\xff\xf1\xff\xee\xff\xf0\xff\xf2\xff\xf0\xff\xed\xff\xf2\xff\xf1\xff\xf2\xff\xf1\xff\xf2\xff\xec\xff\xf3\xff\xf3\xff\xef\xff\xe9\xff\xf4\xff\xf5\xff\xef\xff\xec\xff\xf5\xff\xf4\xff\xf1\xff\xec\xff\xf4\xff\xf5\xff\xf0\xff\xef\xff\xf7\xff\xf3\xff\xee\xff\xef\xff\xf7\xff\xf1\xff\xf3\xff\xf1\xff\xf3\xff\xf4\xff\xf6\xff\xef\xff\xf2\xff\xf5\xff\xfa\xff\xf3\xff\xf0\xff\xf5\xff\xfc\xff\xf3\xff\xf0\xff\xf4\xff\xfa\xff\xf5\xff\xf2\xff\xf5\xff\xfb\xff\xf6\xff\xef\xff\xf3\xff\xfe\xff\xf7\xff\xf1\xff\xf4\xff\xf9\xff\xf8\xff\xf7\xff\xf3\xff\xf7\xff\xfb\xff\xf9\xff\xf3\xff\xf8\xff\xfa\xff\xf7\xff\xf4\xff\xfb\xff\xfb\xff\xf9\xff\xf4\xff\xfc\xff\xff\xff\xf9\xff\xef\xff\xfc\xff\xfe\xff\xf8\xff\xf2\xff\xfb\xff\xfb\xff\xfa\xff\xf8\xff\xfb\xff\xf8\xff\xf9\xff\xfd\xff\xfd\xff\xf7\xff\xfa\xff\xfe\xff\xff\xff\xf9\xff\xfb\xff\xfe\xff\xfd\xff\xf9\xff\xfc\xff\xfc\xff\xfb\xff\xfe\xff\xfd\xff\xfa\xff\xfd\xff\x00\x00\xfa\xff\xf9\xff\xff\xff\x00\x00\xfa\xff\xfb\xff\xfc\xff\xfc\xff\xfe\xff\xfd\xff\xf8\xff\xfb\xff\x02\x00\xfd\xff\xfa\xff\xfd\xff\xfe\xff\xfd\xff\x02\x00\xfd\xff\xf8\xff\x01\x00\x06\x00\xfd\xff\xfa\xff\x00\x00\x02\x00\xff\xff\x01\x00\xff\xff\xfe\xff\x00\x00\x03\x00\x02\x00\x00\x00\x00\x00\xff\xff\x02\x00\x04\x00\x02\x00\xfc\xff\xff\xff\x06\x00\x06\x00\x00\x00\xfd\xff\x01\x00\x06\x00\x06\x00\x00\x00\xff\xff\x03\x00\x06\x00\x01\x00\x01\x00\x03\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x05\x00\x03\x00\x04\x00\a\x00\x05\x00\x00\x00\x05\x00\t\x00\a\x00\x03\x00\x06\x00\b\x00\x06\x00\x05\x00\a\x00\a\x00\t\x00\x06\x00\x05\x00\a\x00\n\x00\b\x00\a\x00\x05\x00\b\x00\t\x00\v\x00\a\x00\x06\x00\n\x00\f\x00\a\x00\b\x00\v\x00\v\x00\t\x00\n\x00\r\x00\n\x00\n\x00\x0e\x00\r\x00\t\x00\v\x00\v\x00\v\x00\t\x00\r\x00\f\x00\a\x00\t\x00\x0e\x00\f\x00\t\x00\n\x00\v\x00\x0e\x00\r\x00\b\x00\t\x00\x11\x00\f\x00\t\x00\v\x00\x11\x00\x0e\x00\n\x00\n\x00\x0f\x00\x10\x00\f\x00\a\x00\x0e\x00\x0e\x00\f\x00\n\x00\f\x00\f\x00\x10\x00\v\x00\x0f\x00\x10\x00\x11\x00\a\x00\r\x00\x15\x00\x12\x00\x05\x00\t\x00\x10\x00\x12\x00\n\x00\f\x00\r\x00\x12\x00\x0f\x00\f\x00\v\x00\x11\x00\x0f\x00\r\x00\t\x00\x11\x00\x13\x00\x10\x00\b\x00\x0e\x00\x12\x00\x0f\x00\n\x00\x10\x00\x11\x00\x10\x00\r\x00\x10\x00\x10\x00\x12\x00\v\x00\x10\x00\x11\x00\x12\x00\r\x00\x12\x00\r\x00\x0e\x00\x10\x00\x15\x00\f\x00\r\x00\x0e\x00\x13\x00\x0f\x00\x0e\x00\v\x00\x10\x00\x13\x00\x0f\x00\r\x00\x13\x00\x10\x00\v\x00\r\x00\x15\x00\x11\x00\r\x00\x0e\x00\x11\x00\x10\x00\x11\x00\x0f\x00\x0e\x00\r\x00\x11\x00\x13\x00\x11\x00\r\x00\x0f\x00\x11\x00\x0f\x00\r\x00\x11\x00\x15\x00\x0f\x00\f\x00\x11\x00\x14\x00\x0f\x00\x0f\x00\x14\x00\x11\x00\v\x00\x10\x00\x18\x00\x11\x00\t\x00\r\x00\x15\x00\x13\x00\x11\x00\f\x00\x0f\x00\x13\x00\x14\x00\x0f\x00\x0f\x00\x10\x00\x13\x00\x12\x00\x0e\x00\r\x00\x12\x00\x0f\x00\x0f\x00\v\x00\r\x00\x10\x00\x13\x00\t\x00\t\x00\x0f\x00\x15\x00\n\x00\x06\x00\x0e\x00\x17\x00\r\x00\x04\x00\a\x00\x14\x00\x0e\x00\b\x00\t\x00\x0f\x00\f\x00\b\x00\v\x00\x0f\x00\t\x00\a\x00\f\x00\x0f\x00\n\x00\b\x00\v\x00\f\x00\t\x00\t\x00\r\x00\v\x00\a\x00\b\x00\f\x00\v\x00\b\x00\x06\x00\v\x00\t\x00\a\x00\a\x00\f\x00\n\x00\x05\x00\a\x00\v\x00\b\x00\a\x00\a\x00\x06\x00\a\x00\t\x00\a\x00\x06\x00\a\x00\a\x00\b\x00\v\x00\x03\x00\x05\x00\f\x00\n\x00\x04\x00\a\x00\t\x00\a\x00\b\x00\n\x00\x05\x00\x03\x00\t\x00\v\x00\x05\x00\x03\x00\b\x00\n\x00\x04\x00\x06\x00\t\x00\a\x00\x05\x00\b\x00\x06\x00\x04\x00\a\x00\x06\x00\x00\x00\x06\x00\x05\x00\x04\x00\x05\x00\n\x00\x01\x00\x02\x00\x05\x00\x06\x00\xff\xff\x06\x00\x03\x00\x03\x00\x01\x00\a\x00\x02\x00\x04\x00\x00\x00\x05\x00\x03\x00\x06\x00\xff\xff\x06\x00\x02\x00\a\x00\x01\x00\x04\x00\x02\x00\a\x00\x02\x00\x04\x00\xff\xff\x03\x00\x01\x00\x04\x00\xfc\xff\x02\x00\x03\x00\x04\x00\xfd\xff\x02\x00\x02\x00\x00\x00\xfc\xff\x04\x00\x00\x00\xfe\xff\x00\x00\x06\x00\xfc\xff\xfe\xff\x03\x00\x01\x00\xf8\xff\x04\x00\x04\x00\xfc\xff\xfd\xff\x06\x00\x03\x00\xfb\xff\xfb\xff\x04\x00\x00\x00\xfd\xff\xff\xff\x03\x00\xfd\xff\xfd\xff\x01\x00\x05\x00\xfa\xff\xf9\xff\x01\x00\b\x00\xfb\xff\xf9\xff\x00\x00\x02\x00\xfa\xff\xfe\xff\x00\x00\xfd\xff\xfa\xff\x01\x00\x00\x00\xfc\xff\xf9\xff\xff\xff\xfe\xff\xfb\xff\xfb\xff\x00\x00\xfc\xff\xfb\xff\xfc\xff\xfd\xff\xff\xff\xfb\xff\xf8\xff\xfc\xff\x02\x00\xfc\xff\xf5\xff\xf9\xff\x00\x00\xfd\xff\xf7\xff\xfa\xff\xff\xff\xfa\xff\xf6\xff\xfd\xff\xfc\xff\xf8\xff\xf6\xff\xfc\xff\xfb\xff\xf9\xff\xf7\xff\xf9\xff\xf8\xff\xf9\xff\xf8\xff\xf8\xff\xf7\xff\xfa\xff\xf7\xff\xf7\xff\xf5\xff\xfa\xff\xf8\xff\xf4\xff\xf1\xff\xfc\xff\xfa\xff\xf1\xff\xf0\xff\xfb\xff\xf8\xff\xf5\xff\xf1\xff\xf8\xff\xf6\xff\xf9\xff\xf1\xff\xf3\xff\xf6\xff\xf9\xff\xf2\xff\xf6\xff\xf5\xff\xf5\xff\xf2\xff\xf9\xff\xf6\xff\xf2\xff\xee\xff\xf7\xff\xf6\xff\xf4\xff\xf1\xff\xf5\xff\xf0\xff\xf6\xff\xf5\xff\xf4\xff\xf0\xff\xf5\xff\xf5\xff\xf5\xff\xf1\xff\xf2\xff\xf1\xff\xf5\xff\xf4\xff\xf2\xff\xef\xff\xf5\xff\xf4\xff\xf5\xff\xf1\xff\xf1\xff\xf4\xff\xf6\xff\xf3\xff\xf1\xff\xf1\xff\xf5\xff\xf2\xff\xf3\xff\xf2\xff\xf3\xff\xf3\xff\xf7\xff\xf2\xff\xef\xff\xf1\xff\xfa\xff\xf2\xff\xef\xff\xf4\xff\xf7\xff\xec\xff\xf0\xff\xf9\xff\xf4\xff\xe8\xff\xf1\xff\xf9\xff\xf6\xff\xed\xff\xf1\xff\xf4\xff\xf4\xff\xf2\xff\xf4\xff\xf4\xff\xf1\xff\xf0\xff\xf9\xff\xf4\xff\xee\xff\xf0\xff\xfa\xff\xf3\xff\xe9\xff\xf0\xff\xf9\xff\xf2\xff\xec\xff\xf3\xff\xf4\xff\xef\xff\xf1\xff\xf6\xff\xef\xff\xec\xff\xf3\xff\xf8\xff\xef\xff\xed\xff\xf2\xff\xf5\xff\xf0\xff\xf3\xff\xf3\xff\xf1\xff\xed\xff\xf1\xff\xf8\xff\xf3\xff\xea\xff\xf2\xff\xf9\xff\xf7\xff\xea\xff\xef\xff\xf9\xff\xf3\xff\xef\xff\xf1\xff\xf1\xff\xf4\xff\xf3\xff\xf1\xff\xf1\xff\xf3\xff\xf4\xff\xf2\xff\xf1\xff\xf1\xff\xf5\xff\xf6\xff\xee\xff\xf1\xff\xfb\xff\xf5\xff\xed\xff\xf3\xff\xf7\xff\xf5\xff\xf2\xff\xf0\xff\xf3\xff\xf4\xff\xf3\xff\xf0\xff\xf4\xff\xf5\xff\xf2\xff\xf1\xff\xf4\xff\xf6\xff\xf6\xff\xf2\xff\xee\xff\xf5\xff\xf6\xff\xf4\xff\xf4\xff\xf2\xff\xf1\xff\xfa\xff\xf8\xff\xef\xff\xf0\xff\xfb\xff\xf8\xff\xee\xff\xf3\xff\xf9\xff\xf4\xff\xee\xff\xf6\xff\xfb\xff\xf4\xff\xf0\xff\xf5\xff\xf8\xff\xf9\xff\xf6\xff\xf2\xff\xf2\xff\xfa\xff\xf9\xff\xf1\xff\xf1\xff\xfd\xff\xfb\xff\xf3\xff\xf1\xff\xfd\xff\xfb\xff\xf4\xff\xf6\xff\xfb\xff\xf5\xff\xf6\xff\xfa\xff\xfc\xff\xf5\xff\xf7\xff\xf7\xff\xfa\xff\xf8\xff\xf8\xff\xf6\xff\xf9\xff\xf9\xff\xf8\xff\xf9\xff\xfa\xff\xf6\xff\xfa\xff\xfc\xff\xf9\xff\xf8\xff\xfc\xff\xf9\xff\xf9\xff\xfc\xff\xfc\xff\xf9\xff\xf7\xff\xfb\xff\xfe\xff\xf7\xff\xf7\xff\x00\x00\xff\xff\xf6\xff\xf9\xff\x01\x00\xfe\xff\xfb\xff\xf9\xff\xfd\xff\xfe\xff\x00\x00\xfb\xff\xfb\xff\xfe\xff\xff\xff\xff\xff\x01\x00\xfc\xff\xfb\xff\xff\xff\x03\x00\xfd\xff\xfe\xff\xfd\xff\xfe\xff\xfe\xff\x05\x00\xfe\xff\xfc\xff\xfc\xff\x06\x00\x03\x00\xfd\xff\xf8\xff\a\x00\a\x00\xff\xff\xf9\xff\x05\x00\x06\x00\xff\xff\xfa\xff\a\x00\x02\x00\x00\x00\xff\xff\x05\x00\xfe\xff\x03\x00\x05\x00\x00\x00\xfc\xff\t\x00\x06\x00\xff\xff\xfc\xff\a\x00\x04\x00\x03\x00\x01\x00\x03\x00\x01\x00\a\x00\x05\x00\x03\x00\x00\x00\b\x00\x06\x00\x04\x00\x00\x00\t\x00\x04\x00\x03\x00\x04\x00\t\x00\x03\x00\x05\x00\x05\x00\b\x00\x05\x00\t\x00\x04\x00\b\x00\t\x00\v\x00\x02\x00\x06\x00\a\x00\x0e\x00\x06\x00\x05\x00\x05\x00\x0e\x00\v\x00\a\x00\xff\xff\r\x00\r\x00\x06\x00\xfd\xff\r\x00\x0e\x00\x05\x00\x02\x00\f\x00\b\x00\b\x00\a\x00\v\x00\a\x00\n\x00\b\x00\r\x00\a\x00\b\x00\v\x00\x0f\x00\x05\x00\a\x00\f\x00\x10\x00\x06\x00\v\x00\t\x00\r\x00\t\x00\x10\x00\t\x00\a\x00\a\x00\x13\x00\n\x00\v\x00\a\x00\x11\x00\r\x00\x10\x00\a\x00\r\x00\x0e\x00\x10\x00\x05\x00\x12\x00\x0f\x00\f\x00\a\x00\x15\x00\x0e\x00\v\x00\b\x00\x15\x00\r\x00\f\x00\n\x00\x16\x00\f\x00\f\x00\f\x00\x14\x00\f\x00\x0e\x00\r\x00\x11\x00\b\x00\x13\x00\x0f\x00\x11\x00\n\x00\x11\x00\r\x00\x12\x00\f\x00\x13\x00\v\x00\x11\x00\f\x00\x15\x00\r\x00\x13\x00\v\x00\x12\x00\x0e\x00\x12\x00\n\x00\x16\x00\r\x00\r\x00\b\x00\x16\x00\x10\x00\x12\x00\a\x00\x13\x00\x11\x00\x14\x00\a\x00\x11\x00\f\x00\x13\x00\x0f\x00\x11\x00\x06\x00\x14\x00\x12\x00\x11\x00\b\x00\x15\x00\x0e\x00\x10\x00\f\x00\x16\x00\r\x00\x0f\x00\n\x00\x18\x00\x11\x00\x0e\x00\b\x00\x18\x00\x11\x00\r\x00\n\x00\x17\x00\x0f\x00\x0f\x00\f\x00\x15\x00\x0f\x00\x12\x00\f\x00\x11\x00\r\x00\x12\x00\x11\x00\x13\x00\a\x00\x0f\x00\x15\x00\x15\x00\x04\x00\r\x00\x14\x00\x16\x00\t\x00\x10\x00\x10\x00\x13\x00\f\x00\x13\x00\x0e\x00\x0f\x00\n\x00\x14\x00\x0f\x00\x10\x00\n\x00\x14\x00\x0f\x00\x11\x00\n\x00\x13\x00\x0e\x00\x10\x00\r\x00\x12\x00\f\x00\x11\x00\f\x00\x12\x00\r\x00\x10\x00\v\x00\x12\x00\x0e\x00\x10\x00\f\x00\x12\x00\v\x00\x10\x00\r\x00\x10\x00\v\x00\x0e\x00\v\x00\x12\x00\x0e\x00\x0f\x00\x06\x00\x0e\x00\x13\x00\x10\x00\x05\x00\x0f\x00\x0f\x00\x11\x00\t\x00\x10\x00\f\x00\x10\x00\r\x00\x10\x00\n\x00\x13\x00\v\x00\x0f\x00\v\x00\x12\x00\v\x00\x0f\x00\f\x00\x11\x00\t\x00\x0f\x00\f\x00\x10\x00\b\x00\x10\x00\v\x00\x10\x00\b\x00\r\x00\f\x00\x11\x00\a\x00\r\x00\r\x00\x0f\x00\x04\x00\r\x00\f\x00\f\x00\x04\x00\x0f\x00\n\x00\v\x00\b\x00\r\x00\b\x00\v\x00\x05\x00\f\x00\v\x00\r\x00\xff\xff\b\x00\x10\x00\x0e\x00\xfe\xff\x05\x00\r\x00\x0f\x00\x02\x00\t\x00\a\x00\n\x00\a\x00\n\x00\x03\x00\b\x00\a\x00\t\x00\x02\x00\t\x00\x05\x00\x05\x00\x06\x00\t\x00\xff\xff\x06\x00\x05\x00\a\x00\xff\xff\b\x00\x02\x00\x03\x00\x02\x00\n\x00\x00\x00\x01\x00\x00\x00\b\x00\xff\xff\x02\x00\x04\x00\x05\x00\xfd\xff\x05\x00\x06\x00\x01\x00\xfb\xff\x05\x00\x03\x00\x00\x00\xff\xff\x02\x00\x00\x00\x01\x00\xfd\xff\x03\x00\x03\x00\xfe\xff\xfa\xff\x03\x00\x04\x00\xfe\xff\xfc\xff\x00\x00\x02\x00\x01\x00\xfe\xff\xfc\xff\xfe\xff\xff\xff\xfc\xff\xfc\xff\x01\x00\xfc\xff\xf9\xff\xfc\xff\x01\x00\xfb\xff\xf9\xff\xfa\xff\xfc\xff\xfd\xff\xfc\xff\xf7\xff\xf9\xff\xfe\xff\xf9\xff\xf7\xff\xfb\xff\xfb\xff\xf8\xff\xfc\xff\xf9\xff\xf6\xff\xfa\xff\xfd\xff\xf5\xff\xf8\xff\xfa\xff\xfa\xff\xf5\xff\xfa\xff\xfb\xff\xf5\xff\xf5\xff\xf8\xff\xfa\xff\xfb\xff\xf5\xff\xf4\xff\xf9\xff\xfa\xff\xf6\xff\xf6\xff\xfa\xff\xf7\xff\xf4\xff\xf8\xff\xfa\xff\xf5\xff\xf6\xff\xf6\xff\xfa\xff\xf7\xff\xf3\xff\xf4\xff\xfb\xff\xf8\xff\xf5\xff\xf1\xff\xf5\xff\xf6\xff\xf7\xff\xf0\xff\xf2\xff\xf6\xff\xf6\xff\xf0\xff\xf5\xff\xf3\xff\xf3\xff\xf1\xff\xf6\xff\xef\xff\xf5\xff\xf6\xff\xf2\xff\xed\xff\xf5\xff\xf4\xff\xf2\xff\xf0\xff\xf2\xff\xf2\xff\xf3\xff\xef\xff\xf2\xff\xf1\xff\xf1\xff\xf0\xff\xf6\xff\xee\xff\xf0\xff\xf2\xff\xf6\xff\xee\xff\xef\xff\xef\xff\xf6\xff\xf3\xff\xf2\xff\xec\xff\xf3\xff\xf4\xff\xf3\xff\xea\xff\xf1\xff\xf1\xff\xf1\xff\xed\xff\xf2\xff\xed\xff\xf1\xff\xe9\xff\xf2\xff\xf0\xff\xf0\xff\xe6\xff\xf3\xff\xf0\xff\xee\xff\xe3\xff\xf0\xff\xed\xff\xee\xff\xe6\xff\xee\xff\xeb\xff\xee\xff\xe5\xff\xef\xff\xe9\xff\xeb\xff\xe4\xff\xef\xff\xea\xff\xed\xff\xe4\xff\xef\xff\xe9\xff\xee\xff\xe6\xff\xee\xff\xe8\xff\xf0\xff\xe7\xff\xef\xff\xe8\xff\xf1\xff\xe4\xff\xed\xff\xeb\xff\xef\xff\xe5\xff\xf1\xff\xe9\xff\xed\xff\xe9\xff\xf2\xff\xe6\xff\xed\xff\xec\xff\xf4\xff\xe6\xff\xeb\xff\xed\xff\xf3\xff\xe6\xff\xec\xff\xeb\xff\xf2\xff\xe7\xff\xee\xff\xea\xff\xf2\xff\xe6\xff\xeb\xff\xe8\xff\xf3\xff\xe6\xff\xeb\xff\xeb\xff\xf2\xff\xe5\xff\xed\xff\xeb\xff\xf0\xff\xe4\xff\xef\xff\xe9\xff\xef\xff\xe6\xff\xf1\xff\xe7\xff\xed\xff\xe8\xff\xf3\xff\xea\xff\xed\xff\xe5\xff\xf2\xff\xec\xff\xf0\xff\xe3\xff\xf1\xff\xee\xff\xf2\xff\xe4\xff\xf1\xff\xec\xff\xef\xff\xe7\xff\xf8\xff\xeb\xff\xea\xff\xe6\xff\xfa\xff\xec\xff\xed\xff\xe3\xff\xf2\xff\xeb\xff\xf6\xff\xe7\xff\xee\xff\xe8\xff\xf7\xff\xe7\xff\xf1\xff\xec\xff\xf4\xff\xe5\xff\xf3\xff\xec\xff\xf2\xff\xe1\xff\xf3\xff\xec\xff\xf2\xff\xe4\xff\xf5\xff\xeb\xff\xf4\xff\xe7\xff\xf8\xff\xe7\xff\xf1\xff\xec\xff\xf7\xff\xea\xff\xf2\xff\xe9\xff\xf5\xff\xec\xff\xf4\xff\xe6\xff\xf2\xff\xec\xff\xf7\xff\xe7\xff\xf3\xff\xee\xff\xf7\xff\xe9\xff\xf4\xff\xec\xff\xf7\xff\xee\xff\xf8\xff\xef\xff\xf6\xff\xed\xff\xfb\xff\xef\xff\xf7\xff\xee\xff\xfb\xff\xee\xff\xf8\xff\xf0\xff\xfb\xff\xed\xff\xfa\xff\xf4\xff\xfb\xff\xf0\xff\xfb\xff\xf3\xff\xf9\xff\xf4\xff\xfc\xff\xf0\xff\xfa\xff\xf6\xff\xfa\xff\xef\xff\xfb\xff\xf4\xff\xfa\xff\xef\xff\xfb\xff\xf7\xff\xfd\xff\xf1\xff\xf7\xff\xf8\xff\xfe\xff\xf1\xff\xfc\xff\xf5\xff\xfa\xff\xf5\xff\x03\x00\xf7\xff\xf6\xff\xf8\xff\x04\x00\xf5\xff\xf8\xff\xf8\xff\xff\xff\xf6\xff\xfe\xff\xf9\xff\xff\xff\xf8\xff\xff\xff\xf6\xff\x00\x00\xfb\xff\xfb\xff\xf8\xff\x04\x00\xfa\xff\xfd\xff\xfc\xff\x01\x00\xf7\xff\x01\x00\xfc\xff\x00\x00\xfb\xff\x04\x00\xfc\xff\x00\x00\xff\xff\x03\x00\xfb\xff\x01\x00\xfe\xff\x04\x00\xfc\xff\x02\x00\xfb\xff\x05\x00\x01\x00\x03\x00\xfe\xff\x06\x00\x00\x00\a\x00\x00\x00\x05\x00\x01\x00\b\x00\x02\x00\b\x00\x02\x00\a\x00\x02\x00\b\x00\x06\x00\x06\x00\x02\x00\f\x00\a\x00\a\x00\x05\x00\v\x00\x04\x00\v\x00\b\x00\x06\x00\x04\x00\x0f\x00\b\x00\a\x00\a\x00\x0e\x00\a\x00\b\x00\a\x00\n\x00\x06\x00\f\x00\t\x00\t\x00\a\x00\r\x00\b\x00\v\x00\v\x00\x0e\x00\t\x00\v\x00\r\x00\x13\x00\n\x00\x06\x00\v\x00\x14\x00\x0e\x00\t\x00\n\x00\x0e\x00\x10\x00\x10\x00\n\x00\v\x00\x11\x00\x12\x00\r\x00\r\x00\r\x00\x12\x00\x13\x00\x12\x00\v\x00\x12\x00\x16\x00\x13\x00\n\x00\x0f\x00\x18\x00\x14\x00\f\x00\x14\x00\x16\x00\x12\x00\r\x00\x13\x00\x14\x00\x0f\x00\x12\x00\x15\x00\x11\x00\x12\x00\x14\x00\x13\x00\r\x00\x14\x00\x18\x00\x12\x00\x11\x00\x14\x00\x13\x00\x14\x00\x12\x00\x10\x00\x13\x00\x15\x00\x16\x00\x12\x00\x10\x00\x11\x00\x17\x00\x16\x00\x12\x00\x10\x00\x16\x00\x17\x00\x16\x00\x14\x00\x14\x00\x13\x00\x17\x00\x16\x00\x1b\x00\x14\x00\x13\x00\x1a\x00\x1f\x00\x12\x00\x15\x00\x1c\x00 \x00\x14\x00\x15\x00\x19\x00\x1f\x00\x16\x00\x19\x00\x17\x00\x1c\x00\x19\x00\x1d\x00\x18\x00\x19\x00\x19\x00\x1d\x00\x1a\x00\x1e\x00\x17\x00\x1a\x00\x1b\x00\x1e\x00\x15\x00\x1a\x00\x1b\x00\x1f\x00\x18\x00\x1b\x00\x17\x00!\x00\x1a\x00\x1a\x00\x15\x00$\x00\x1b\x00\x19\x00\x17\x00!\x00\x19\x00\x1f\x00\x1b\x00\x1f\x00\x18\x00\x1f\x00\x18\x00\x1e\x00\x18\x00\x1e\x00\x15\x00 \x00\x1b\x00\x1c\x00\x12\x00\x1d\x00\x1a\x00\x1a\x00\x11\x00\x1f\x00\x1a\x00\x1b\x00\x13\x00\x1f\x00\x1a\x00\x1b\x00\x13\x00 \x00\x1b\x00\x1e\x00\x11\x00\x1d\x00\x1c\x00\x1f\x00\x11\x00\x1d\x00\x1a\x00\x1e\x00\x11\x00\x1a\x00\x18\x00\"\x00\x12\x00\x16\x00\x17\x00\"\x00\x12\x00\x13\x00\x16\x00 \x00\x11\x00\x17\x00\x16\x00\x1c\x00\x10\x00\x1e\x00\x16\x00\x17\x00\x12\x00\x1c\x00\x13\x00\x1a\x00\x14\x00\x18\x00\x10\x00\x1a\x00\x14\x00\x19\x00\x13\x00\x18\x00\x11\x00\x1a\x00\x14\x00\x16\x00\r\x00\x1c\x00\x12\x00\x16\x00\x12\x00\x1b\x00\x0e\x00\x19\x00\x12\x00\x16\x00\f\x00\x1a\x00\x10\x00\x12\x00\x0e\x00\x1a\x00\x0e\x00\x12\x00\x0f\x00\x18\x00\n\x00\x13\x00\x0e\x00\x16\x00\f\x00\x15\x00\r\x00\x15\x00\f\x00\x14\x00\f\x00\x13\x00\n\x00\x14\x00\f\x00\x13\x00\t\x00\x12\x00\t\x00\x14\x00\v\x00\x0e\x00\x04\x00\x15\x00\r\x00\x0e\x00\x04\x00\x11\x00\r\x00\x12\x00\x06\x00\v\x00\a\x00\x14\x00\a\x00\f\x00\x06\x00\x11\x00\b\x00\x10\x00\x02\x00\r\x00\n\x00\x0f\x00\x02\x00\x0f\x00\b\x00\v\x00\x02\x00\r\x00\x04\x00\t\x00\xff\xff\n\x00\a\x00\v\x00\xfd\xff\x06\x00\x05\x00\r\x00\xff\xff\x06\x00\x00\x00\f\x00\x04\x00\a\x00\xfd\xff\n\x00\x03\x00\a\x00\xf9\xff\a\x00\x03\x00\x06\x00\xf8\xff\x05\x00\x01\x00\x05\x00\xfa\xff\x04\x00\xfd\xff\x03\x00\xfa\xff\x05\x00\xfb\xff\x01\x00\xf8\xff\x06\x00\xfb\xff\x01\x00\xf9\xff\x03\x00\xfa\xff\x01\x00\xf6\xff\x02\x00\xfb\xff\x02\x00\xf5\xff\x02\x00\xfc\xff\xfe\xff\xf7\xff\x01\x00\xf8\xff\x01\x00\xfa\xff\xff\xff\xf8\xff\x01\x00\xf8\xff\xff\xff\xfa\xff\xfd\xff\xf3\xff\x00\x00\xfa\xff\xf8\xff\xf4\xff\x00\x00\xf6\xff\xf7\xff\xf1\xff\xfc\xff\xf7\xff\xfa\xff\xf1\xff\xf9\xff\xf7\xff\xfa\xff\xf4\xff\xf6\xff\xf3\xff\xf9\xff\xf2\xff\xf4\xff\xf2\xff\xf9\xff\xf4\xff\xf4\xff\xf0\xff\xf9\xff\xf6\xff\xf3\xff\xf0\xff\xf9\xff\xf1\xff\xf2\xff\xf1\xff\xf5\xff\xf2\xff\xf3\xff\xf0\xff\xf1\xff\xf2\xff\xf4\xff\xef\xff\xf0\xff\xf0\xff\xf2\xff\xf2\xff\xf0\xff\xec\xff\xf1\xff\xf0\xff\xf0\xff\xec\xff\xee\xff\xec\xff\xf3\xff\xef\xff\xeb\xff\xea\xff\xf0\xff\xef\xff\xf1\xff\xeb\xff\xe9\xff\xec\xff\xf4\xff\xea\xff\xeb\xff\xeb\xff\xf1\xff\xee\xff\xec\xff\xe6\xff\xf2\xff\xf1\xff\xe8\xff\xe2\xff\xf3\xff\xee\xff\xe6\xff\xe7\xff\xf1\xff\xee\xff\xea\xff\xe6\xff\xe9\xff\xea\xff\xec\xff\xe8\xff\xe6\xff\xe9\xff\xec\xff\xe9\xff\xe9\xff\xe8\xff\xeb\xff\xe5\xff\xe8\xff\xeb\xff\xe9\xff\xe3\xff\xeb\xff\xec\xff\xe7\xff\xe6\xff\xea\xff\xe7\xff\xe4\xff\xea\xff\xea\xff\xe6\xff\xe4\xff\xe8\xff\xeb\xff\xe8\xff\xe6\xff\xe5\xff\xe8\xff\xeb\xff\xe6\xff\xe5\xff\xe7\xff\xeb\xff\xe4\xff\xe4\xff\xe8\xff\xed\xff\xe3\xff\xe6\xff\xe8\xff\xeb\xff\xe2\xff\xe6\xff\xe8\xff\xe7\xff\xdf\xff\xeb\xff\xeb\xff\xe6\xff\xdd\xff\xe8\xff\xe9\xff\xe5\xff\xdf\xff\xea\xff\xe7\xff\xe4\xff\xe2\xff\xe9\xff\xe3\xff\xe6\xff\xe2\xff\xe8\xff\xe3\xff\xe2\xff\xe1\xff\xec\xff\xe7\xff\xe0\xff\xe1\xff\xea\xff\xe5\xff\xe5\xff\xe1\xff\xe8\xff\xe5\xff\xe8\xff\xe3\xff\xea\xff\xe1\xff\xe6\xff\xe7\xff\xea\xff\xdc\xff\xe5\xff\xe8\xff\xea\xff\xdf\xff\xe4\xff\xe3\xff\xea\xff\xe1\xff\xe4\xff\xe5\xff\xea\xff\xde\xff\xe6\xff\xe7\xff\xe9\xff\xde\xff\xe5\xff\xe4\xff\xeb\xff\xe4\xff\xe6\xff\xdf\xff\xeb\xff\xe7\xff\xe9\xff\xe0\xff\xeb\xff\xe4\xff\xe8\xff\xe4\xff\xee\xff\xe2\xff\xe6\xff\xe7\xff\xef\xff\xe0\xff\xe9\xff\xe8\xff\xec\xff\xde\xff\xea\xff\xe7\xff\xed\xff\xdf\xff\xea\xff\xe9\xff\xf0\xff\xde\xff\xea\xff\xe7\xff\xee\xff\xdf\xff\xed\xff\xe9\xff\xed\xff\xe3\xff\xec\xff\xe5\xff\xf0\xff\xe4\xff\xe8\xff\xe4\xff\xf3\xff\xe8\xff\xea\xff\xe4\xff\xf2\xff\xea\xff\xf0\xff\xe6\xff\xef\xff\xeb\xff\xf3\xff\xe8\xff\xef\xff\xe8\xff\xf2\xff\xea\xff\xf0\xff\xe6\xff\xf4\xff\xed\xff\xf3\xff\xe4\xff\xf0\xff\xed\xff\xf2\xff\xe4\xff\xf3\xff\xee\xff\xf0\xff\xe6\xff\xf3\xff\xec\xff\xf3\xff\xe9\xff\xf0\xff\xea\xff\xf9\xff\xeb\xff\xef\xff\xec\xff\xfa\xff\xee\xff\xf2\xff\xeb\xff\xfb\xff\xf1\xff\xf5\xff\xed\xff\xf9\xff\xf3\xff\xf5\xff\xef\xff\xf7\xff\xf1\xff\xf7\xff\xf0\xff\xf6\xff\xf4\xff\xf9\xff\xec\xff\xf4\xff\xf4\xff\xfa\xff\xef\xff\xf6\xff\xf5\xff\xf9\xff\xf1\xff\xf9\xff\xf2\xff\xf8\xff\xf5\xff\xfa\xff\xf3\xff\xf7\xff\xf3\xff\xfc\xff\xf2\xff\xf8\xff\xf4\xff\xfd\xff\xf2\xff\xf8\xff\xf7\xff\xfd\xff\xf0\xff\xfc\xff\xf9\xff\x00\x00\xf0\xff\xfb\xff\xfd\xff\x06\x00\xf0\xff\xfa\xff\xfb\xff\x05\x00\xf5\xff\xfa\xff\xf7\xff\x03\x00\xf9\xff\xff\xff\xf8\xff\x00\x00\xf8\xff\x00\x00\xf7\xff\x00\x00\xf7\xff\x01\x00\xfc\xff\xfd\xff\xf5\xff\x02\x00\xfe\xff\xfe\xff\xf8\xff\x02\x00\xfb\xff\xff\xff\xf9\xff\x03\x00\xfc\xff\x02\x00\xf9\xff\x03\x00\xfd\xff\x03\x00\xfa\xff\x04\x00\xff\xff\x01\x00\xfc\xff\t\x00\x00\x00\x00\x00\x00\x00\f\x00\xff\xff\x02\x00\x00\x00\n\x00\x05\x00\n\x00\xff\xff\x06\x00\x06\x00\v\x00\xfb\xff\x06\x00\a\x00\t\x00\xfd\xff\a\x00\a\x00\v\x00\xfe\xff\x04\x00\x06\x00\n\x00\xfc\xff\b\x00\b\x00\a\x00\xfe\xff\v\x00\a\x00\x06\x00\x03\x00\f\x00\a\x00\b\x00\x04\x00\f\x00\v\x00\n\x00\x06\x00\f\x00\b\x00\n\x00\v\x00\x0f\x00\b\x00\b\x00\n\x00\x11\x00\r\x00\v\x00\b\x00\x12\x00\r\x00\f\x00\x0e\x00\x12\x00\f\x00\r\x00\v\x00\x14\x00\x0e\x00\r\x00\v\x00\x15\x00\x11\x00\r\x00\t\x00\x15\x00\x10\x00\x10\x00\v\x00\x12\x00\x16\x00\x13\x00\t\x00\x12\x00\x17\x00\x10\x00\n\x00\x14\x00\x15\x00\x0f\x00\r\x00\x17\x00\x10\x00\x11\x00\x15\x00\x16\x00\x0e\x00\x11\x00\x17\x00\x17\x00\x10\x00\x11\x00\x14\x00\x16\x00\x12\x00\x12\x00\x14\x00\x11\x00\x0f\x00\x15\x00\x17\x00\x11\x00\x0f\x00\x14\x00\x18\x00\x14\x00\x0f\x00\x12\x00\x15\x00\x15\x00\x13\x00\x14\x00\x14\x00\x16\x00\x15\x00\x14\x00\x16\x00\x18\x00\x14\x00\x12\x00\x17\x00\x1b\x00\x13\x00\x12\x00\x17\x00\x1b\x00\x17\x00\x14\x00\x16\x00\x17\x00\x16\x00\x16\x00\x15\x00\x19\x00\x15\x00\x17\x00\x14\x00\x14\x00\x15\x00\x16\x00\x13\x00\x14\x00\x18\x00\x19\x00\x14\x00\x12\x00\x16\x00\x17\x00\x14\x00\x12\x00\x15\x00\x16\x00\x14\x00\x11\x00\x14\x00\x17\x00\x12\x00\x13\x00\x19\x00\x17\x00\x14\x00\x14\x00\x1b\x00\x16\x00\x11\x00\x15\x00\x1d\x00\x15\x00\x11\x00\x17\x00\x1b\x00\x15\x00\x12\x00\x17\x00\x18\x00\x18\x00\x12\x00\x11\x00\x17\x00\x1e\x00\x14\x00\b\x00\x16\x00 \x00\x17\x00\f\x00\x13\x00\x18\x00\x16\x00\x12\x00\x15\x00\x13\x00\x16\x00\x16\x00\x15\x00\x11\x00\x14\x00\x17\x00\x14\x00\x13\x00\x11\x00\x10\x00\x16\x00\x14\x00\x11\x00\x10\x00\x15\x00\x15\x00\x13\x00\x13\x00\x16\x00\x13\x00\x12\x00\x13\x00\x16\x00\x13\x00\x15\x00\x13\x00\x13\x00\x16\x00\x19\x00\r\x00\x0e\x00\x19\x00\x19\x00\f\x00\r\x00\x14\x00\x1a\x00\x11\x00\x10\x00\x12\x00\x16\x00\x14\x00\x15\x00\x11\x00\x13\x00\x13\x00\x16\x00\x11\x00\x11\x00\x12\x00\x14\x00\x13\x00\x14\x00\x12\x00\x11\x00\x13\x00\x14\x00\x14\x00\x11\x00\x13\x00\x16\x00\x12\x00\x11\x00\x10\x00\x16\x00\x13\x00\x0e\x00\x10\x00\x18\x00\x11\x00\x11\x00\f\x00\x15\x00\x13\x00\x13\x00\f\x00\x15\x00\x13\x00\x14\x00\x12\x00\x16\x00\r\x00\x10\x00\x11\x00\x1a\x00\x12\x00\f\x00\r\x00\x1c\x00\x13\x00\f\x00\v\x00\x18\x00\x11\x00\x0e\x00\f\x00\x12\x00\x0e\x00\x14\x00\n\x00\x10\x00\v\x00\x15\x00\x0e\x00\r\x00\n\x00\x15\x00\r\x00\x0f\x00\n\x00\x13\x00\v\x00\x13\x00\f\x00\x0f\x00\n\x00\x10\x00\r\x00\x13\x00\a\x00\v\x00\b\x00\x11\x00\b\x00\r\x00\x06\x00\x0e\x00\t\x00\x0f\x00\x06\x00\n\x00\b\x00\x0e\x00\x05\x00\b\x00\x06\x00\x0f\x00\a\x00\x03\x00\x04\x00\x0e\x00\n\x00\t\x00\x00\x00\n\x00\b\x00\b\x00\xff\xff\a\x00\x06\x00\n\x00\xfe\xff\x05\x00\x06\x00\v\x00\xfe\xff\x00\x00\x03\x00\t\x00\xfc\xff\x03\x00\x03\x00\x05\x00\xfc\xff\x01\x00\x00\x00\x05\x00\xfc\xff\x01\x00\xfd\xff\x00\x00\xfd\xff\x03\x00\xf9\xff\xfe\xff\xfb\xff\x01\x00\xf7\xff\xfe\xff\xfd\xff\xfe\xff\xf6\xff\x02\x00\xfc\xff\xfc\xff\xf4\xff\xff\xff\xfd\xff\xfa\xff\xf3\xff\x00\x00\xfb\xff\xfc\xff\xf5\xff\xfc\xff\xf6\xff\xfb\xff\xf6\xff\xfc\xff\xf3\xff\xfa\xff\xf5\xff\xfc\xff\xf6\xff\xf7\xff\xf2\xff\xfc\xff\xf8\xff\xf7\xff\xf3\xff\xfe\xff\xf6\xff\xf5\xff\xf6\xff\xfe\xff\xf4\xff\xf5\xff\xf4\xff\xfe\xff\xf5\xff\xf2\xff\xf2\xff\xfe\xff\xf5\xff\xf4\xff\xef\xff\xfa\xff\xf5\xff\xf6\xff\xec\xff\xf9\xff\xf4\xff\xf7\xff\xee\xff\xfb\xff\xf5\xff\xf3\xff\xeb\xff\xf7\xff\xf2\xff\xf6\xff\xeb\xff\xf6\xff\xf0\xff\xf9\xff\xea\xff\xf5\xff\xef\xff\xf4\xff\xea\xff\xf9\xff\xef\xff\xf4\xff\xee\xff\xf5\xff\xeb\xff\xf6\xff\xee\xff\xf3\xff\xeb\xff\xf6\xff\xee\xff\xef\xff\xea\xff\xf6\xff\xf0\xff\xee\xff\xe3\xff\xf2\xff\xf1\xff\xf2\xff\xe6\xff\xeb\xff\xec\xff\xf6\xff\xeb\xff\xed\xff\xe6\xff\xf0\xff\xf1\xff\xef\xff\xe3\xff\xee\xff\xec\xff\xed\xff\xe4\xff\xec\xff\xe8\xff\xeb\xff\xe7\xff\xf0\xff\xe8\xff\xea\xff\xe4\xff\xed\xff\xe9\xff\xeb\xff\xe7\xff\xee\xff\xe6\xff\xe9\xff\xe4\xff\xee\xff\xe9\xff\xe6\xff\xe3\xff\xf0\xff\xea\xff\xe8\xff\xe2\xff\xef\xff\xea\xff\xe9\xff\xe4\xff\xeb\xff\xed\xff\xe9\xff\xe0\xff\xea\xff\xee\xff\xe7\xff\xe1\xff\xe9\xff\xec\xff\xeb\xff\xe6\xff\xe9\xff\xe9\xff\xec\xff\xea\xff\xe9\xff\xe5\xff\xe9\xff\xe8\xff\xe9\xff\xe4\xff\xe8\xff\xe9\xff\xe8\xff\xe4\xff\xe9\xff\xe7\xff\xe4\xff\xe7\xff\xe9\xff\xe6\xff\xe2\xff\xe7\xff\xe7\xff\xe6\xff\xe5\xff\xe5\xff\xea\xff\xe9\xff\xe3\xff\xe8\xff\xed\xff\xe6\xff\xe3\xff\xeb\xff\xeb\xff\xe7\xff\xe7\xff\xec\xff\xe8\xff\xea\xff\xe9\xff\xeb\xff\xe7\xff\xeb\xff\xe8\xff\xea\xff\xe7\xff\xeb\xff\xe6\xff\xe9\xff\xe7\xff\xec\xff\xe8\xff\xe9\xff\xea\xff\xe9\xff\xe2\xff\xeb\xff\xeb\xff\xe8\xff\xe1\xff\xe7\xff\xea\xff\xe8\xff\xe3\xff\xe7\xff\xea\xff\xe9\xff\xe2\xff\xe7\xff\xe9\xff\xe8\xff\xe6\xff\xe9\xff\xe9\xff\xe5\xff\xe6\xff\xec\xff\xeb\xff\xe6\xff\xe3\xff\xed\xff\xef\xff\xe9\xff\xe4\xff\xec\xff\xec\xff\xe7\xff\xe9\xff\xed\xff\xea\xff\xeb\xff\xe9\xff\xec\xff\xed\xff\xee\xff\xe5\xff\xe9\xff\xf1\xff\xef\xff\xe4\xff\xea\xff\xef\xff\xee\xff\xe9\xff\xec\xff\xef\xff\xf3\xff\xeb\xff\xec\xff\xef\xff\xf4\xff\xeb\xff\xea\xff\xef\xff\xf3\xff\xea\xff\xe9\xff\xed\xff\xf0\xff\xea\xff\xee\xff\xee\xff\xf1\xff\xed\xff\xef\xff\xef\xff\xf1\xff\xed\xff\xee\xff\xf0\xff\xf1\xff\xf1\xff\xf0\xff\xef\xff\xef\xff\xf0\xff\xf4\xff\xed\xff\xed\xff\xf1\xff\xf6\xff\xef\xff\xf2\xff\xf0\xff\xf4\xff\xef\xff\xf7\xff\xf5\xff\xef\xff\xef\xff\xf4\xff\xf1\xff\xf1\xff\xf0\xff\xf5\xff\xf3\xff\xf5\xff\xf1\xff\xf8\xff\xf3\xff\xf4\xff\xf1\xff\xf9\xff\xf1\xff\xf7\xff\xf7\xff\xf6\xff\xf1\xff\xfb\xff\xf4\xff\xf7\xff\xef\xff\xf9\xff\xf7\xff\xf9\xff\xf0\xff\xfa\xff\xf9\xff\xf6\xff\xf1\xff\xfe\xff\xf7\xff\xf2\xff\xf6\xff\xfe\xff\xf7\xff\xf5\xff\xf6\xff\xfd\xff\xf7\xff\xf9\xff\xf8\xff\xfb\xff\xf8\xff\xfb\xff\xfa\xff\xfa\xff\xf7\xff\xfe\xff\xfb\xff\xf9\xff\xf7\xff\x01\x00\xfe\xff\xf7\xff\xf9\xff\x03\x00\xfd\xff\xfc\xff\xfd\xff\xfe\xff\xfb\xff\x01\x00\xfd\xff\xfa\xff\xf9\xff\x05\x00\xfe\xff\xfd\xff\xfb\xff\x06\x00\x01\x00\xfb\xff\xfc\xff\a\x00\x03\x00\x01\x00\xfb\xff\x03\x00\b\x00\x05\x00\xfc\xff\xff\xff\x03\x00\a\x00\xff\xff\x04\x00\x03\x00\x01\x00\x01\x00\a\x00\x04\x00\xfc\xff\x00\x00\n\x00\x03\x00\xff\xff\x06\x00\x05\x00\x03\x00\x04\x00\v\x00\x03\x00\x02\x00\x05\x00\f\x00\x02\x00\x03\x00\x06\x00\b\x00\x02\x00\b\x00\a\x00\a\x00\x02\x00\t\x00\v\x00\n\x00\x02\x00\t\x00\v\x00\t\x00\x00\x00\r\x00\x0e\x00\n\x00\x04\x00\x0f\x00\f\x00\n\x00\b\x00\r\x00\t\x00\f\x00\f\x00\r\x00\b\x00\x10\x00\x0e\x00\x10\x00\v\x00\x12\x00\f\x00\x0f\x00\r\x00\x11\x00\r\x00\n\x00\f\x00\x16\x00\x0f\x00\v\x00\f\x00\x15\x00\x14\x00\x0f\x00\n\x00\x13\x00\x15\x00\x0f\x00\t\x00\x16\x00\x16\x00\x0f\x00\v\x00\x14\x00\x16\x00\x10\x00\n\x00\x14\x00\x1a\x00\x14\x00\v\x00\x11\x00\x1b\x00\x15\x00\v\x00\x12\x00\x19\x00\x16\x00\x0e\x00\x12\x00\x14\x00\x13\x00\x13\x00\x15\x00\x12\x00\x13\x00\x18\x00\x15\x00\x11\x00\x17\x00\x16\x00\x0e\x00\r\x00\x17\x00\x16\x00\x0f\x00\r\x00\x13\x00\x17\x00\x10\x00\f\x00\x13\x00\x1a\x00\x13\x00\x10\x00\x15\x00\x15\x00\x11\x00\x15\x00\x13\x00\x14\x00\x14\x00\x17\x00\x13\x00\x14\x00\x17\x00\x13\x00\x13\x00\x19\x00\x16\x00\x0f\x00\x18\x00\x17\x00\x0e\x00\x11\x00\x1b\x00\x15\x00\x14\x00\x12\x00\x1a\x00\x14\x00\x16\x00\x12\x00\x18\x00\x15\x00\x19\x00\x12\x00\x1a\x00\x17\x00\x1a\x00\x15\x00\x15\x00\x13\x00\x1b\x00\x15\x00\x17\x00\x11\x00\x16\x00\x16\x00\x1d\x00\x10\x00\x15\x00\x16\x00\x1c\x00\x11\x00\x1a\x00\x16\x00\x19\x00\x10\x00\x1a\x00\x17\x00\x19\x00\x10\x00\x18\x00\x14\x00\x1a\x00\x13\x00\x14\x00\x12\x00\x1c\x00\x16\x00\x14\x00\x10\x00\x1b\x00\x14\x00\x18\x00\x13\x00\x1d\x00\x14\x00\x1b\x00\x12\x00\x1a\x00\x15\x00\x19\x00\x12\x00\x1e\x00\x17\x00\x18\x00\x11\x00\x1d\x00\x13\x00\x18\x00\x14\x00\x1a\x00\x12\x00\x1e\x00\x15\x00\x18\x00\x10\x00\x1d\x00\x15\x00\x1a\x00\x10\x00\x19\x00\x12\x00\x1a\x00\x0f\x00\x16\x00\x10\x00\x16\x00\x11\x00\x16\x00\r\x00\x13\x00\r\x00\x15\x00\x0f\x00\x12\x00\x0f\x00\x16\x00\x0f\x00\x12\x00\x10\x00\x18\x00\r\x00\x11\x00\x11\x00\x13\x00\f\x00\x17\x00\r\x00\x12\x00\r\x00\x16\x00\v\x00\x12\x00\x0f\x00\x14\x00\n\x00\x15\x00\x12\x00\x13\x00\t\x00\x13\x00\x0f\x00\x14\x00\v\x00\x12\x00\t\x00\x10\x00\f\x00\x11\x00\x06\x00\x11\x00\b\x00\x0f\x00\v\x00\x0e\x00\x05\x00\x10\x00\t\x00\x11\x00\b\x00\v\x00\x04\x00\x10\x00\x0f\x00\f\x00\x00\x00\n\x00\n\x00\r\x00\x02\x00\a\x00\x01\x00\f\x00\n\x00\b\x00\xfc\xff\t\x00\a\x00\f\x00\x00\x00\x04\x00\x01\x00\n\x00\x03\x00\x03\x00\xfd\xff\x05\x00\x05\x00\a\x00\xfb\xff\x03\x00\a\x00\x02\x00\xfa\xff\x03\x00\x04\x00\x02\x00\xfe\xff\xff\xff\xfd\xff\x02\x00\x02\x00\x01\x00\xfb\xff\x02\x00\xfe\xff\xfb\xff\xfd\xff\x06\x00\xf8\xff\xf5\xff\x00\x00\x05\x00\xf9\xff\xf2\xff\xfc\xff\x04\x00\xfc\xff\xf3\xff\xf8\xff\x02\x00\xfb\xff\xf6\xff\xf8\xff\xfe\xff\xfa\xff\xf5\xff\xf5\xff\xfd\xff\xfa\xff\xf4\xff\xf6\xff\xff\xff\xfa\xff\xf5\xff\xf2\xff\xfa\xff\xf8\xff\xf5\xff\xf1\xff\xf8\xff\xf9\xff\xf9\xff\xf1\xff\xf9\xff\xf7\xff\xf5\xff\xf5\xff\xfb\xff\xee\xff\xf2\xff\xf9\xff\xfa\xff\xf1\xff\xf5\xff\xf3\xff\xf6\xff\xf7\xff\xf5\xff\xed\xff\xf7\xff\xfa\xff\xf6\xff\xef\xff\xf2\xff\xf6\xff\xf5\xff\xf1\xff\xef\xff\xf1\xff\xf6\xff\xf3\xff\xec\xff\xf1\xff\xf5\xff\xf1\xff\xe9\xff\xf0\xff\xf7\xff\xf0\xff\xea\xff\xef\xff\xf4\xff\xee\xff\xe8\xff\xea\xff\xf0\xff\xef\xff\xe8\xff\xeb\xff\xee\xff\xef\xff\xe9\xff\xed\xff\xe9\xff\xea\xff\xec\xff\xf0\xff\xe6\xff\xeb\xff\xec\xff\xed\xff\xea\xff\xe9\xff\xe6\xff\xec\xff\xed\xff\xe6\xff\xe5\xff\xf1\xff\xec\xff\xe2\xff\xe5\xff\xed\xff\xe7\xff\xe8\xff\xe5\xff\xe9\xff\xe4\xff\xec\xff\xe5\xff\xe9\xff\xdf\xff\xe8\xff\xe5\xff\xe7\xff\xe3\xff\xed\xff\xe2\xff\xe1\xff\xe4\xff\xec\xff\xdf\xff\xe0\xff\xe1\xff\xec\xff\xe4\xff\xe2\xff\xdc\xff\xe6\xff\xde\xff\xe4\xff\xdc\xff\xe4\xff\xde\xff\xe8\xff\xe1\xff\xe5\xff\xdc\xff\xe6\xff\xde\xff\xe3\xff\xda\xff\xe5\xff\xdf\xff\xe0\xff\xdb\xff\xe5\xff\xd6\xff\xe0\xff\xdb\xff\xe4\xff\xd9\xff\xe3\xff\xd8\xff\xe3\xff\xd9\xff\xdd\xff\xd6\xff\xe1\xff\xd7\xff\xde\xff\xd7\xff\xe3\xff\xd4\xff\xdf\xff\xd8\xff\xe2\xff\xd7\xff\xe2\xff\xd7\xff\xe3\xff\xd7\xff\xe3\xff\xda\xff\xe3\xff\xd3\xff\xe0\xff\xdb\xff\xe8\xff\xd1\xff\xdf\xff\xd7\xff\xe4\xff\xd6\xff\xe2\xff\xd3\xff\xe1\xff\xd7\xff\xe8\xff\xd2\xff\xe1\xff\xd4\xff\xe8\xff\xd5\xff\xe3\xff\xd1\xff\xe4\xff\xd6\xff\xe5\xff\xd5\xff\xe0\xff\xd6\xff\xe7\xff\xda\xff\xe3\xff\xd5\xff\xe9\xff\xdb\xff\xe9\xff\xd8\xff\xe5\xff\xda\xff\xe6\xff\xdb\xff\xe6\xff\xd5\xff\xe7\xff\xdc\xff\xe9\xff\xd5\xff\xe4\xff\xdd\xff\xeb\xff\xd2\xff\xe1\xff\xe0\xff\xee\xff\xd7\xff\xde\xff\xdf\xff\xef\xff\xda\xff\xdd\xff\xd7\xff\xed\xff\xdf\xff\xe0\xff\xdb\xff\xe9\xff\xdf\xff\xe3\xff\xdf\xff\xe9\xff\xdc\xff\xe3\xff\xdf\xff\xea\xff\xe1\xff\xe6\xff\xdb\xff\xe8\xff\xe6\xff\xe6\xff\xd8\xff\xe7\xff\xe6\xff\xe6\xff\xde\xff\xe5\xff\xe2\xff\xeb\xff\xe1\xff\xe7\xff\xe3\xff\xeb\xff\xe1\xff\xe6\xff\xe4\xff\xeb\xff\xe1\xff\xe9\xff\xe4\xff\xe8\xff\xe4\xff\xe8\xff\xe2\xff\xe8\xff\xe7\xff\xeb\xff\xe2\xff\xec\xff\xe6\xff\xe7\xff\xe2\xff\xec\xff\xe8\xff\xe9\xff\xe4\xff\xea\xff\xe8\xff\xea\xff\xe6\xff\xec\xff\xe4\xff\xeb\xff\xe9\xff\xef\xff\xe6\xff\xeb\xff\xe5\xff\xee\xff\xe6\xff\xec\xff\xe5\xff\xf1\xff\xe7\xff\xee\xff\xe7\xff\xf2\xff\xe3\xff\xec\xff\xe9\xff\xf2\xff\xe6\xff\xf0\xff\xe9\xff\xf1\xff\xe6\xff\xf0\xff\xee\xff\xf5\xff\xe5\xff\xf0\xff\xf0\xff\xf8\xff\xeb\xff\xf2\xff\xef\xff\xf7\xff\xef\xff\xf3\xff\xeb\xff\xf8\xff\xf1\xff\xf7\xff\xf0\xff\xf7\xff\xf4\xff\xfb\xff\xee\xff\xf7\xff\xf3\xff\xf9\xff\xf2\xff\xfe\xff\xf4\xff\xf8\xff\xf1\xff\xfc\xff\xf6\xff\xfb\xff\xef\xff\xfa\xff\xf9\xff\x00\x00\xf2\xff\xf9\xff\xfb\xff\xfc\xff\xf3\xff\xfc\xff\xfa\xff\x00\x00\xf3\xff\xfd\xff\xfc\xff\x01\x00\xf7\xff\xfc\xff\xf8\xff\x01\x00\xfc\xff\xfe\xff\xf5\xff\xff\xff\x04\x00\x02\x00\xf6\xff\x00\x00\x04\x00\x01\x00\xf6\xff\x02\x00\x03\x00\x00\x00\xfd\xff\x02\x00\xff\xff\x05\x00\x00\x00\xff\xff\xfe\xff\x02\x00\x01\x00\a\x00\xff\xff\xfe\xff\x02\x00\x05\x00\x00\x00\xfe\xff\x03\x00\x06\x00\xfe\xff\x04\x00\x06\x00\x03\x00\xfa\xff\x05\x00\x06\x00\x03\x00\xfe\xff\a\x00\a\x00\a\x00\x00\x00\a\x00\f\x00\f\x00\x02\x00\v\x00\f\x00\n\x00\x00\x00\r\x00\t\x00\f\x00\x06\x00\n\x00\t\x00\x12\x00\v\x00\b\x00\x04\x00\x15\x00\n\x00\b\x00\n\x00\x13\x00\n\x00\f\x00\t\x00\x10\x00\r\x00\x0f\x00\t\x00\x10\x00\x13\x00\r\x00\b\x00\x15\x00\x0e\x00\r\x00\x12\x00\x1b\x00\v\x00\x10\x00\x18\x00\x1a\x00\f\x00\x12\x00\x18\x00\x1b\x00\x0f\x00\x16\x00\x16\x00\x19\x00\x10\x00\x17\x00\x17\x00\x15\x00\x15\x00\x1e\x00\x15\x00\x17\x00\x17\x00\x1b\x00\x16\x00\x19\x00\x1b\x00\x1a\x00\x14\x00\x1c\x00\x1b\x00\x1c\x00\x1c\x00\x1f\x00\x18\x00\x1d\x00\x1c\x00\x1e\x00\x18\x00\x1c\x00\x1c\x00 \x00\x1d\x00\x1c\x00\x1a\x00\x1d\x00\x1e\x00\x1b\x00\x19\x00\x1b\x00\x1c\x00\x1c\x00\x1b\x00!\x00\x1f\x00\x18\x00\x1c\x00!\x00\x1d\x00\x1a\x00\x1d\x00\x1e\x00\x1e\x00\x1b\x00\x1d\x00\x1d\x00\x1f\x00\x18\x00!\x00 \x00\x1f\x00\x1b\x00\"\x00!\x00\x1f\x00\x1a\x00 \x00\x1d\x00#\x00\x1e\x00\x1f\x00 \x00$\x00\x1b\x00 \x00#\x00(\x00\x1b\x00\x1d\x00 \x00*\x00\x1b\x00\x1f\x00\x1e\x00&\x00\x1d\x00$\x00\x1d\x00!\x00\x1d\x00#\x00\x1d\x00'\x00\x1e\x00\x1f\x00\x1b\x00-\x00\"\x00\x1e\x00\x18\x00&\x00%\x00%\x00\x1a\x00#\x00#\x00,\x00\x19\x00\"\x00\x1e\x00'\x00\x1a\x00%\x00\x1b\x00 \x00\x1d\x00+\x00\x1c\x00!\x00 \x00,\x00\x17\x00\"\x00%\x00'\x00\x15\x00#\x00 \x00&\x00\x1b\x00$\x00\x1a\x00&\x00\x1b\x00\"\x00\x17\x00\"\x00\x19\x00%\x00\x1c\x00!\x00\x17\x00#\x00\x1a\x00!\x00\x18\x00\"\x00\x19\x00 \x00\x1c\x00&\x00\x19\x00\x1c\x00\x16\x00%\x00\x14\x00\x1c\x00\x14\x00!\x00\x16\x00\x1e\x00\x13\x00#\x00\x14\x00\x1d\x00\r\x00\x1e\x00\x17\x00 \x00\x10\x00\x1d\x00\x14\x00\x1d\x00\x14\x00\x1f\x00\x10\x00\x1c\x00\x17\x00\x19\x00\x0e\x00\x19\x00\x16\x00\x1a\x00\x10\x00\x1b\x00\x10\x00\x19\x00\x13\x00\x19\x00\x0f\x00\x18\x00\x13\x00\x1e\x00\r\x00\x15\x00\r\x00\x1a\x00\f\x00\x10\x00\f\x00\x17\x00\x10\x00\x13\x00\f\x00\x16\x00\x10\x00\x10\x00\x0e\x00\x18\x00\f\x00\x10\x00\x10\x00\x16\x00\a\x00\x13\x00\x0e\x00\x14\x00\b\x00\x17\x00\r\x00\x0f\x00\n\x00\x17\x00\t\x00\x13\x00\x05\x00\x12\x00\a\x00\x13\x00\b\x00\x13\x00\x02\x00\x11\x00\a\x00\x12\x00\x01\x00\x0e\x00\x01\x00\f\x00\x05\x00\x15\x00\xfe\xff\t\x00\n\x00\x14\x00\xfd\xff\n\x00\a\x00\x10\x00\x00\x00\r\x00\x06\x00\v\x00\xff\xff\r\x00\x05\x00\r\x00\xfe\xff\r\x00\x04\x00\v\x00\xfd\xff\v\x00\xfe\xff\n\x00\x04\x00\f\x00\xf8\xff\t\x00\x03\x00\f\x00\xfa\xff\x02\x00\xfe\xff\v\x00\xff\xff\x05\x00\xfb\xff\v\x00\xfb\xff\x03\x00\xf8\xff\t\x00\xfa\xff\xff\xff\xf8\xff\b\x00\xfc\xff\x04\x00\xf6\xff\x04\x00\xfe\xff\x04\x00\xf4\xff\x03\x00\xfe\xff\x06\x00\xf6\xff\x05\x00\xfc\xff\x05\x00\xf8\xff\x02\x00\xf6\xff\x03\x00\xf9\xff\x02\x00\xf6\xff\x03\x00\xfa\xff\x00\x00\xf4\xff\xfd\xff\xf7\xff\x03\x00\xf5\xff\xfb\xff\xf3\xff\x04\x00\xf0\xff\xf7\xff\xf1\xff\xff\xff\xee\xff\xfa\xff\xed\xff\xf9\xff\xf0\xff\xf9\xff\xeb\xff\xf9\xff\xf5\xff\xfd\xff\xe6\xff\xfa\xff\xf2\xff\xf5\xff\xe6\xff\xf5\xff\xf3\xff\xf2\xff\xe9\xff\xfb\xff\xf1\xff\xf2\xff\xe6\xff\xf7\xff\xf2\xff\xf2\xff\xea\xff\xf6\xff\xeb\xff\xef\xff\xe6\xff\xf1\xff\xe8\xff\xec\xff\xe5\xff\xf0\xff\xe7\xff\xed\xff\xe6\xff\xf0\xff\xe6\xff\xe7\xff\xe8\xff\xf3\xff\xe8\xff\xe5\xff\xe5\xff\xf3\xff\xe7\xff\xea\xff\xe5\xff\xec\xff\xe6\xff\xed\xff\xe4\xff\xe5\xff\xe4\xff\xe8\xff\xe3\xff\xe8\xff\xe8\xff\xe7\xff\xe0\xff\xe7\xff\xe1\xff\xdf\xff\xe9\xff\xe9\xff\xde\xff\xe3\xff\xec\xff\xe5\xff\xdd\xff\xdf\xff\xe6\xff\xe4\xff\xe0\xff\xda\xff\xdc\xff\xe6\xff\xe2\xff\xd6\xff\xdd\xff\xeb\xff\xe1\xff\xdd\xff\xdf\xff\xe4\xff\xdf\xff\xe0\xff\xe4\xff\xde\xff\xdd\xff\xe1\xff\xe3\xff\xdd\xff\xda\xff\xde\xff\xe0\xff\xdf\xff\xdc\xff\xe0\xff\xdf\xff\xde\xff\xde\xff\xe0\xff\xdd\xff\xde\xff\xe2\xff\xdf\xff\xde\xff\xe2\xff\xe5\xff\xdd\xff\xda\xff\xe4\xff\xe6\xff\xda\xff\xdc\xff\xe0\xff\xe1\xff\xdf\xff\xdf\xff\xda\xff\xdd\xff\xe0\xff\xde\xff\xdb\xff\xdd\xff\xe0\xff\xdc\xff\xda\xff\xdb\xff\xda\xff\xdb\xff\xda\xff\xd6\xff\xda\xff\xda\xff\xdb\xff\xd9\xff\xda\xff\xd9\xff\xdd\xff\xdc\xff\xdd\xff\xdb\xff\xdb\xff\xdb\xff\xe2\xff\xdb\xff\xd7\xff\xd8\xff\xe0\xff\xdc\xff\xda\xff\xd3\xff\xdb\xff\xde\xff\xd9\xff\xd3\xff\xda\xff\xda\xff\xd8\xff\xd9\xff\xdb\xff\xd9\xff\xda\xff\xd9\xff\xdb\xff\xd6\xff\xd7\xff\xde\xff\xdb\xff\xd8\xff\xd9\xff\xda\xff\xd5\xff\xdb\xff\xdc\xff\xdc\xff\xd4\xff\xdd\xff\xde\xff\xd6\xff\xd0\xff\xdf\xff\xdb\xff\xda\xff\xd7\xff\xe0\xff\xe0\xff\xdc\xff\xdb\xff\xe2\xff\xdb\xff\xda\xff\xdc\xff\xe5\xff\xdd\xff\xdd\xff\xdc\xff\xe6\xff\xda\xff\xdd\xff\xda\xff\xe1\xff\xd9\xff\xdd\xff\xda\xff\xe1\xff\xd9\xff\xe2\xff\xd9\xff\xde\xff\xd8\xff\xe1\xff\xd7\xff\xe4\xff\xdd\xff\xe4\xff\xd9\xff\xe4\xff\xdc\xff\xe3\xff\xdf\xff\xe7\xff\xd7\xff\xe7\xff\xe3\xff\xe6\xff\xd8\xff\xe9\xff\xe3\xff\xe6\xff\xdf\xff\xea\xff\xe0\xff\xe6\xff\xde\xff\xec\xff\xe0\xff\xe9\xff\xdc\xff\xea\xff\xe4\xff\xef\xff\xde\xff\xe9\xff\xe6\xff\xf2\xff\xdc\xff\xea\xff\xe9\xff\xf1\xff\xda\xff\xee\xff\xe7\xff\xef\xff\xe1\xff\xf2\xff\xe5\xff\xf0\xff\xe6\xff\xf3\xff\xe6\xff\xf3\xff\xe8\xff\xf1\xff\xe5\xff\xf4\xff\xe8\xff\xf3\xff\xeb\xff\xf4\xff\xec\xff\xf6\xff\xec\xff\xf3\xff\xf0\xff\xf9\xff\xeb\xff\xf5\xff\xec\xff\xf5\xff\xe8\xff\xf9\xff\xf0\xff\xf1\xff\xec\xff\xfb\xff\xed\xff\xf2\xff\xed\xff\xf8\xff\xee\xff\xf7\xff\xf4\xff\xfb\xff\xef\xff\xfa\xff\xf4\xff\xfa\xff\xf4\xff\xfe\xff\xf3\xff\xfa\xff\xf4\xff\xfd\xff\xf5\xff\xfb\xff\xf3\xff\xfb\xff\xf7\xff\xfa\xff\xf5\xff\xff\xff\xf6\xff\xff\xff\xf8\xff\x01\x00\xfb\xff\x02\x00\xf6\xff\x00\x00\xf9\xff\x04\x00\xfa\xff\a\x00\xfd\xff\a\x00\xfd\xff\x06\x00\xfd\xff\x01\x00\xff\xff\t\x00\x00\x00\x06\x00\x05\x00\a\x00\xfc\xff\b\x00\x04\x00\a\x00\x01\x00\b\x00\x05\x00\x0e\x00\b\x00\t\x00\x01\x00\v\x00\x05\x00\f\x00\x05\x00\v\x00\a\x00\x13\x00\b\x00\x10\x00\x05\x00\x0e\x00\f\x00\x15\x00\b\x00\x0e\x00\f\x00\x15\x00\r\x00\x0e\x00\n\x00\x16\x00\x10\x00\x13\x00\n\x00\x16\x00\f\x00\x10\x00\x11\x00\x15\x00\x06\x00\x15\x00\x16\x00\x1b\x00\n\x00\x16\x00\x14\x00\x19\x00\f\x00\x16\x00\x17\x00\x1c\x00\x0e\x00\x1c\x00\x16\x00\x18\x00\x0f\x00\x1a\x00\x17\x00\x1d\x00\x14\x00\x1d\x00\x18\x00\x1f\x00\x12\x00\x18\x00\x18\x00 \x00\x15\x00\x1d\x00\x16\x00 \x00\x15\x00\x1d\x00\x16\x00\x1b\x00\x13\x00\"\x00\x19\x00\x1c\x00\x17\x00%\x00\x1c\x00\x1c\x00\x12\x00!\x00\x1b\x00\x1a\x00\x14\x00 \x00\x1c\x00\x1b\x00\x14\x00#\x00\x1f\x00\x1d\x00\x15\x00%\x00\x1f\x00\x1d\x00\x18\x00&\x00\x1c\x00\x1c\x00#\x00!\x00\x16\x00\x1c\x00\x1f\x00#\x00\x1a\x00\x1e\x00\x1e\x00%\x00\x1f\x00\x1e\x00\x1f\x00&\x00#\x00#\x00\"\x00\"\x00\x1c\x00$\x00#\x00%\x00\x1e\x00#\x00&\x00&\x00#\x00 \x00\x1f\x00%\x00\"\x00!\x00\"\x00%\x00\x1f\x00!\x00&\x00!\x00\x1f\x00\x1d\x00&\x00\"\x00\x1e\x00\x18\x00+\x00&\x00#\x00\x19\x00)\x00%\x00#\x00\x1a\x00*\x00%\x00$\x00\x1c\x00(\x00#\x00&\x00\x1f\x00&\x00!\x00/\x00 \x00!\x00\x1f\x00/\x00#\x00#\x00\x19\x00,\x00&\x00#\x00\x1b\x00(\x00&\x00%\x00\x1e\x00#\x00\x1f\x00&\x00!\x00%\x00\x1f\x00(\x00!\x00'\x00!\x00'\x00$\x00!\x00\x1d\x00(\x00#\x00 \x00\x1e\x00*\x00\x1e\x00\x1d\x00\x1d\x00$\x00 \x00\x1f\x00\x1c\x00\x1d\x00!\x00'\x00\x19\x00\x16\x00\x1e\x00%\x00\x1b\x00\x18\x00\x1f\x00\x1f\x00\x19\x00\x18\x00\x1d\x00\x1d\x00\x1c\x00\x1e\x00\x17\x00\x19\x00\x1e\x00 \x00\x19\x00\x16\x00\x1a\x00\x1e\x00\x1a\x00\x19\x00\x17\x00\x18\x00\x1b\x00\x1c\x00\x15\x00\x18\x00\x16\x00\x15\x00\x16\x00\x19\x00\x10\x00\x17\x00\x18\x00\x17\x00\x12\x00\x13\x00\x16\x00\x14\x00\x15\x00\x14\x00\x11\x00\x13\x00\x11\x00\x12\x00\x12\x00\x12\x00\x0e\x00\x15\x00\x14\x00\x18\x00\f\x00\x0f\x00\x15\x00\x1b\x00\x0f\x00\x10\x00\x10\x00\x15\x00\x0f\x00\x10\x00\f\x00\x11\x00\r\x00\x10\x00\x11\x00\x11\x00\v\x00\x10\x00\r\x00\x10\x00\t\x00\x0f\x00\v\x00\v\x00\f\x00\x11\x00\t\x00\f\x00\f\x00\x0e\x00\x05\x00\f\x00\x0e\x00\r\x00\x02\x00\x0f\x00\v\x00\v\x00\x03\x00\x0f\x00\a\x00\b\x00\b\x00\x0e\x00\x05\x00\v\x00\x03\x00\a\x00\x04\x00\t\x00\x03\x00\x05\x00\x05\x00\t\x00\x04\x00\x05\x00\x02\x00\x06\x00\x05\x00\x04\x00\x06\x00\a\x00\x02\x00\x06\x00\x01\x00\xff\xff\x00\x00\x05\x00\xff\xff\xfd\xff\xfc\xff\x02\x00\xfe\xff\xfe\xff\xfa\xff\x01\x00\xfa\xff\xff\xff\xf9\xff\x00\x00\xf7\xff\xfb\xff\xfa\xff\x01\x00\xf6\xff\xfd\xff\xf7\xff\xfe\xff\xf4\xff\x02\x00\xfb\xff\xfc\xff\xf7\xff\a\x00\xf7\xff\xf8\xff\xf4\xff\xfc\xff\xf2\xff\xfd\xff\xf5\xff\xf5\xff\xef\xff\xff\xff\xf3\xff\xf5\xff\xf1\xff\xfb\xff\xf1\xff\xfa\xff\xf4\xff\xf6\xff\xec\xff\xf8\xff\xf2\xff\xf1\xff\xec\xff\xf8\xff\xee\xff\xf7\xff\xed\xff\xf3\xff\xef\xff\xf9\xff\xf0\xff\xf0\xff\xec\xff\xf2\xff\xf0\xff\xf1\xff\xea\xff\xeb\xff\xec\xff\xf1\xff\xe8\xff\xed\xff\xe8\xff\xee\xff\xea\xff\xec\xff\xe7\xff\xef\xff\xed\xff\xec\xff\xe3\xff\xee\xff\xea\xff\xef\xff\xe4\xff\xeb\xff\xe9\xff\xf3\xff\xe3\xff\xe6\xff\xe7\xff\xef\xff\xe4\xff\xea\xff\xe8\xff\xee\xff\xe9\xff\xed\xff\xe5\xff\xec\xff\xeb\xff\xe9\xff\xde\xff\xea\xff\xe9\xff\xe9\xff\xe2\xff\xe2\xff\xe8\xff\xe5\xff\xe4\xff\xe5\xff\xe5\xff\xda\xff\xe3\xff\xe3\xff\xdf\xff\xd7\xff\xe4\xff\xe2\xff\xdf\xff\xdc\xff\xdb\xff\xdd\xff\xe1\xff\xdc\xff\xd9\xff\xde\xff\xe4\xff\xdb\xff\xdb\xff\xe3\xff\xe1\xff\xd9\xff\xdf\xff\xdd\xff\xe0\xff\xe0\xff\xe3\xff\xdd\xff\xe1\xff\xdf\xff\xe4\xff\xdb\xff\xe2\xff\xdd\xff\xdf\xff\xdf\xff\xde\xff\xe4\xff\xe4\xff\xd9\xff\xdb\xff\xe5\xff\xe5\xff\xd6\xff\xd9\xff\xe5\xff\xe0\xff\xdb\xff\xda\xff\xdd\xff\xde\xff\xdd\xff\xdb\xff\xdf\xff\xdc\xff\xde\xff\xdc\xff\xdc\xff\xda\xff\xe0\xff\xda\xff\xda\xff\xda\xff\xdf\xff\xda\xff\xdc\xff\xdb\xff\xde\xff\xd8\xff\xda\xff\xd9\xff\xe3\xff\xd7\xff\xda\xff\xdc\xff\xde\xff\xd8\xff\xe2\xff\xd9\xff\xda\xff\xd8\xff\xe2\xff\xd7\xff\xd9\xff\xda\xff\xe4\xff\xd6\xff\xdd\xff\xd9\xff\xdd\xff\xd6\xff\xde\xff\xd6\xff\xde\xff\xd7\xff\xdc\xff\xd8\xff\xe0\xff\xdb\xff\xde\xff\xd8\xff\xe5\xff\xda\xff\xe0\xff\xd7\xff\xe5\xff\xd6\xff\xde\xff\xd5\xff\xdf\xff\xdb\xff\xe2\xff\xdb\xff\xdf\xff\xd7\xff\xdb\xff\xd9\xff\xe2\xff\xd8\xff\xda\xff\xd8\xff\xe3\xff\xda\xff\xdd\xff\xdc\xff\xde\xff\xd6\xff\xda\xff\xdb\xff\xe3\xff\xd8\xff\xde\xff\xd9\xff\xdc\xff\xd4\xff\xdf\xff\xdb\xff\xdb\xff\xd7\xff\xe3\xff\xda\xff\xdc\xff\xdb\xff\xde\xff\xdb\xff\xdc\xff\xd9\xff\xdc\xff\xd9\xff\xda\xff\xdb\xff\xdc\xff\xdb\xff\xe0\xff\xde\xff\xda\xff\xdc\xff\xe4\xff\xda\xff\xdb\xff\xe0\xff\xdf\xff\xdd\xff\xe0\xff\xe1\xff\xe0\xff\xdc\xff\xe1\xff\xdd\xff\xde\xff\xe2\xff\xe6\xff\xdd\xff\xdd\xff\xe4\xff\xeb\xff\xde\xff\xe2\xff\xec\xff\xec\xff\xdf\xff\xe0\xff\xe7\xff\xea\xff\xe5\xff\xe5\xff\xe6\xff\xeb\xff\xea\xff\xea\xff\xe6\xff\xec\xff\xef\xff\xec\xff\xea\xff\xef\xff\xec\xff\xed\xff\xe6\xff\xf2\xff\xed\xff\xef\xff\xea\xff\xf6\xff\xeb\xff\xf0\xff\xea\xff\xf3\xff\xeb\xff\xf4\xff\xed\xff\xf0\xff\xea\xff\xf4\xff\xed\xff\xf3\xff\xf0\xff\xf5\xff\xeb\xff\xf1\xff\xed\xff\xf3\xff\xeb\xff\xf4\xff\xf3\xff\xf9\xff\xed\xff\xf3\xff\xf2\xff\xfb\xff\xf6\xff\xf6\xff\xf4\xff\xf9\xff\xf8\xff\xf8\xff\xf7\xff\xf9\xff\xf7\xff\x00\x00\xfc\xff\xf6\xff\xf9\xff\xf9\xff\xf9\xff\xf8\xff\xfd\xff\xf7\xff\xf8\xff\xfc\xff\xf9\xff\xf8\xff\xf9\xff\xf9\xff\xfd\xff\xf8\xff\xfc\xff\xfd\xff\x00\x00\xf9\xff\xf8\xff\x01\x00\xff\xff\xfc\xff\xfb\xff\xfe\xff\x01\x00\xfc\xff\xfc\xff\xfc\xff\xfe\xff\xfe\xff\xfd\xff\x01\x00\xfc\xff\xfa\xff\x03\x00\x02\x00\xf9\xff\xff\xff\x00\x00\xfe\xff\xfe\xff\x00\x00\x02\x00\xfd\xff\x04\x00\x00\x00\x02\x00\x02\x00\x05\x00\xff\xff\x05\x00\x03\x00\b\x00\x02\x00\n\x00\x03\x00\t\x00\x04\x00\b\x00\x01\x00\b\x00\b\x00\v\x00\x03\x00\v\x00\n\x00\v\x00\b\x00\r\x00\x06\x00\x0e\x00\x0e\x00\n\x00\x01\x00\x0e\x00\x11\x00\b\x00\x06\x00\x0e\x00\v\x00\b\x00\f\x00\x15\x00\f\x00\x0e\x00\f\x00\x0f\x00\x0e\x00\x10\x00\r\x00\f\x00\x13\x00\x16\x00\x12\x00\r\x00\x10\x00\x14\x00\x0f\x00\x11\x00\x11\x00\x14\x00\x13\x00\x10\x00\x12\x00\x15\x00\x10\x00\x10\x00\x13\x00\x15\x00\x15\x00\x12\x00\x0f\x00\x15\x00\x15\x00\x10\x00\x0f\x00\x16\x00\x10\x00\x15\x00\x14\x00\x11\x00\x14\x00\x1a\x00\x16\x00\x10\x00\x16\x00\x1a\x00\x15\x00\x12\x00\x13\x00\x19\x00\x18\x00\x12\x00\x15\x00\x15\x00\x19\x00\x12\x00\x13\x00\x1b\x00\x17\x00\f\x00\x16\x00\x1a\x00\x18\x00\x0e\x00\x14\x00\x1b\x00\x16\x00\x11\x00\x19\x00\x1a\x00\x18\x00\x18\x00\x16\x00\x19\x00!\x00\x1c\x00\x19\x00\x16\x00\x1b\x00\x1a\x00\x17\x00\x18\x00\x1a\x00\x17\x00\x1d\x00\x19\x00\x1a\x00\x19\x00\x19\x00\x15\x00\x1a\x00\x1a\x00\x1a\x00\x16\x00\x1e\x00\x1e\x00\x1f\x00\x1f\x00\x1a\x00\x1b\x00!\x00\x1b\x00\x18\x00\x16\x00\x1d\x00\x1f\x00\x1c\x00\x1e\x00\x1f\x00!\x00\x1a\x00 \x00\x1e\x00\x1e\x00#\x00\x1d\x00#\x00#\x00\x1f\x00\x18\x00#\x00'\x00\x1c\x00\x1b\x00%\x00%\x00 \x00\x1e\x00!\x00\x1d\x00\x1e\x00\x1f\x00\x1c\x00\x16\x00\x1d\x00#\x00 \x00 \x00'\x00 \x00 \x00$\x00#\x00\x1b\x00#\x00'\x00!\x00\x1b\x00\x1d\x00\"\x00#\x00\x1c\x00\x1a\x00$\x00%\x00\x1d\x00\x18\x00\x1c\x00 \x00\x1d\x00\x18\x00\x1a\x00\x1f\x00\x1f\x00\x18\x00\x1a\x00\x1d\x00$\x00\x19\x00\x16\x00\x1c\x00#\x00\x1b\x00\x1a\x00\x1f\x00\x1b\x00\x1b\x00\x1a\x00\"\x00\x1e\x00\x1e\x00\x1b\x00\x1f\x00\x1e\x00\x1d\x00\x18\x00\x19\x00\x1b\x00\x1b\x00\x1e\x00\x1b\x00\x19\x00\x15\x00\x1c\x00\x16\x00\x1e\x00\x1b\x00\x18\x00\x14\x00\x1f\x00\x1e\x00\x1a\x00\x16\x00\x1e\x00\x17\x00\x1d\x00\x17\x00\x1f\x00\x14\x00\x1e\x00\x18\x00\x1e\x00\x11\x00\x1e\x00\x12\x00 \x00\x17\x00\"\x00\x10\x00!\x00\x17\x00\x1e\x00\x0f\x00\x1d\x00\x14\x00\x1a\x00\x12\x00\x1a\x00\r\x00\x1d\x00\x13\x00\x1b\x00\x0e\x00\x1c\x00\x13\x00\x1d\x00\x0f\x00\x1f\x00\x11\x00\"\x00\x14\x00\x1f\x00\x0f\x00\x1e\x00\x12\x00\x1d\x00\x12\x00\"\x00\x0f\x00\x1c\x00\x16\x00\"\x00\x0e\x00\x1a\x00\x14\x00\"\x00\x0e\x00 \x00\x17\x00\x1d\x00\x0f\x00!\x00\x17\x00\x1a\x00\x14\x00%\x00\x15\x00\x17\x00\x13\x00&\x00\x15\x00\x15\x00\x14\x00\"\x00\x10\x00\x16\x00\x0e\x00\x19\x00\x16\x00\x1d\x00\t\x00\x15\x00\x17\x00\x1f\x00\r\x00\x13\x00\x10\x00\x18\x00\a\x00\x14\x00\n\x00\x12\x00\a\x00\x0f\x00\x05\x00\r\x00\a\x00\x10\x00\v\x00\x12\x00\b\x00\v\x00\n\x00\x11\x00\t\x00\x10\x00\f\x00\x0e\x00\t\x00\r\x00\x03\x00\r\x00\n\x00\x13\x00\x01\x00\x0e\x00\f\x00\x14\x00\x03\x00\a\x00\x05\x00\x11\x00\x01\x00\b\x00\b\x00\x0e\x00\xfe\xff\v\x00\x06\x00\x12\x00\xfe\xff\a\x00\x04\x00\r\x00\xfd\xff\x06\x00\x01\x00\v\x00\xfc\xff\t\x00\x03\x00\n\x00\xff\xff\n\x00\xfd\xff\x0e\x00\xfa\xff\x03\x00\xfc\xff\n\x00\xf8\xff\x06\x00\xff\xff\t\x00\xf7\xff\x06\x00\xfe\xff\n\x00\xfa\xff\v\x00\x01\x00\b\x00\xf7\xff\x05\x00\xfe\xff\t\x00\xf9\xff\b\x00\xfc\xff\v\x00\xfa\xff\x06\x00\xfb\xff\a\x00\xfc\xff\a\x00\xfb\xff\x06\x00\xfc\xff\x06\x00\xfa\xff\t\x00\xfe\xff\x04\x00\xf4\xff\x06\x00\xfe\xff\x05\x00\xf6\xff\x06\x00\xfd\xff\b\x00\xfa\xff\x06\x00\xfa\xff\f\x00\xfe\xff\a\x00\xf8\xff\x05\x00\xf8\xff\x03\x00\xf8\xff\x01\x00\xf4\xff\xfe\xff\xf7\xff\xfe\xff\xed\xff\x00\x00\xf8\xff\xfb\xff\xf2\xff\xfb\xff\xf3\xff\xfd\xff\xf4\xff\x01\x00\xf0\xff\xf5\xff\xf9\xff\x03\x00\xf0\xff\xf7\xff\xf8\xff\xfd\xff\xf0\xff\xf6\xff\xfa\xff\xfc\xff\xef\xff\xf1\xff\xf9\xff\xfb\xff\xf4\xff\xf5\xff\xf5\xff\xf6\xff\xf1\xff\xf6\xff\xec\xff\xee\xff\xec\xff\xf5\xff\xeb\xff\xf0\xff\xea\xff\xf4\xff\xee\xff\xee\xff\xea\xff\xf1\xff\xe5\xff\xeb\xff\xe9\xff\xeb\xff\xe7\xff\xea\xff\xe6\xff\xe3\xff\xe1\xff\xe6\xff\xdf\xff\xe4\xff\xe2\xff\xe7\xff\xe3\xff\xdf\xff\xe4\xff\xea\xff\xe4\xff\xda\xff\xe0\xff\xea\xff\xe3\xff\xdc\xff\xe2\xff\xea\xff\xe1\xff\xe0\xff\xdf\xff\xdf\xff\xe2\xff\xdd\xff\xe0\xff\xe0\xff\xe0\xff\xde\xff\xde\xff\xdb\xff\xdc\xff\xd8\xff\xdb\xff\xdc\xff\xdb\xff\xdc\xff\xe0\xff\xd5\xff\xd7\xff\xe1\xff\xdd\xff\xd5\xff\xd7\xff\xe4\xff\xdb\xff\xd4\xff\xe3\xff\xe1\xff\xd6\xff\xd8\xff\xdf\xff\xda\xff\xdc\xff\xdc\xff\xda\xff\xd7\xff\xde\xff\xdd\xff\xd7\xff\xd9\xff\xda\xff\xdc\xff\xdf\xff\xd7\xff\xd3\xff\xd5\xff\xdb\xff\xda\xff\xd4\xff\xdc\xff\xd9\xff\xd9\xff\xd9\xff\xda\xff\xd1\xff\xd7\xff\xde\xff\xd9\xff\xcf\xff\xd7\xff\xdd\xff\xd8\xff\xd5\xff\xdb\xff\xdb\xff\xda\xff\xdb\xff\xde\xff\xd6\xff\xd5\xff\xd9\xff\xdf\xff\xd9\xff\xd8\xff\xda\xff\xdd\xff\xd9\xff\xdc\xff\xdd\xff\xda\xff\xd8\xff\xde\xff\xdd\xff\xd7\xff\xdc\xff\xdf\xff\xd8\xff\xd9\xff\xe0\xff\xdf\xff\xdb\xff\xd9\xff\xdc\xff\xdd\xff\xdd\xff\xd8\xff\xda\xff\xdb\xff\xd7\xff\xd8\xff\xde\xff\xd8\xff\xd5\xff\xd6\xff\xdd\xff\xd5\xff\xd8\xff\xd5\xff\xd3\xff\xd6\xff\xd8\xff\xd2\xff\xd6\xff\xd2\xff\xd8\xff\xd2\xff\xd7\xff\xd7\xff\xdb\xff\xd4\xff\xd7\xff\xd2\xff\xda\xff\xd5\xff\xd2\xff\xd4\xff\xd8\xff\xd5\xff\xd5\xff\xd8\xff\xd7\xff\xd4\xff\xde\xff\xd7\xff\xd5\xff\xd3\xff\xdd\xff\xd8\xff\xdc\xff\xdb\xff\xdf\xff\xd8\xff\xdd\xff\xda\xff\xd5\xff\xd8\xff\xdd\xff\xd4\xff\xdc\xff\xd8\xff\xdd\xff\xd5\xff\xdc\xff\xd1\xff\xde\xff\xd8\xff\xdb\xff\xd7\xff\xdf\xff\xd7\xff\xde\xff\xdb\xff\xda\xff\xd8\xff\xe6\xff\xdb\xff\xdc\xff\xdb\xff\xe1\xff\xdb\xff\xdf\xff\xdc\xff\xdd\xff\xd2\xff\xe1\xff\xd9\xff\xdd\xff\xd4\xff\xde\xff\xe2\xff\xdf\xff\xd2\xff\xdb\xff\xde\xff\xda\xff\xd1\xff\xdb\xff\xe0\xff\xe5\xff\xd9\xff\xdf\xff\xd6\xff\xde\xff\xde\xff\xe1\xff\xd7\xff\xd9\xff\xdb\xff\xe2\xff\xdb\xff\xdc\xff\xd7\xff\xe1\xff\xdc\xff\xdd\xff\xda\xff\xe1\xff\xd9\xff\xe2\xff\xd9\xff\xe5\xff\xda\xff\xe5\xff\xda\xff\xe6\xff\xe2\xff\xe8\xff\xdd\xff\xe9\xff\xdd\xff\xe3\xff\xe4\xff\xea\xff\xe1\xff\xec\xff\xe4\xff\xea\xff\xe0\xff\xe7\xff\xe3\xff\xe7\xff\xe7\xff\xe8\xff\xe9\xff\xe9\xff\xe9\xff\xea\xff\xea\xff\xef\xff\xea\xff\xef\xff\xf0\xff\xf7\xff\xe8\xff\xf5\xff\xf2\xff\xf1\xff\xef\xff\xf5\xff\xe9\xff\xef\xff\xf0\xff\xf2\xff\xea\xff\xee\xff\xf3\xff\xf7\xff\xf1\xff\xf3\xff\xef\xff\xf9\xff\xf7\xff\xf7\xff\xf3\xff\xfc\xff\xfa\xff\xfa\xff\xf0\xff\xfd\xff\xf7\xff\xf9\xff\xf5\xff\x02\x00\xfb\xff\xff\xff\xf9\xff\x04\x00\xf9\xff\x00\x00\xfc\xff\x06\x00\xfc\xff\x03\x00\xfd\xff\x03\x00\xfc\xff\a\x00\x01\x00\x05\x00\x02\x00\a\x00\xfd\xff\b\x00\v\x00\b\x00\xf9\xff\x04\x00\n\x00\n\x00\xff\xff\x06\x00\x05\x00\x03\x00\x05\x00\v\x00\x02\x00\n\x00\b\x00\r\x00\x02\x00\x06\x00\v\x00\x0e\x00\a\x00\x0e\x00\a\x00\r\x00\n\x00\x0f\x00\x03\x00\v\x00\v\x00\x1b\x00\a\x00\x0e\x00\x0f\x00\x16\x00\f\x00\x18\x00\x0e\x00\x17\x00\x10\x00\x17\x00\v\x00\x12\x00\f\x00\x14\x00\f\x00\x15\x00\x0f\x00\x17\x00\x12\x00\x18\x00\x0f\x00\x19\x00\x14\x00 \x00\x14\x00\x1d\x00\x12\x00 \x00\x19\x00 \x00\x15\x00\x1d\x00\x18\x00\x1c\x00\x15\x00 \x00\x14\x00!\x00\x1c\x00$\x00\x1b\x00$\x00\x1f\x00&\x00\x19\x00)\x00!\x00%\x00\x1d\x00*\x00\x1c\x00*\x00\x1d\x00%\x00\x1f\x00'\x00\"\x00$\x00%\x001\x00$\x00&\x00!\x00/\x00&\x00)\x00(\x00)\x00'\x00+\x00,\x00%\x00%\x00-\x001\x004\x00.\x00+\x003\x008\x00-\x00.\x007\x004\x000\x00.\x004\x00.\x00*\x00.\x00-\x00-\x00+\x002\x00-\x00+\x00'\x004\x001\x00/\x00+\x00/\x003\x003\x00-\x00.\x000\x007\x004\x002\x006\x009\x000\x000\x006\x00<\x001\x00/\x007\x00>\x006\x001\x004\x00;\x00=\x008\x003\x006\x008\x006\x005\x00;\x00=\x00;\x006\x00?\x00<\x00>\x009\x00>\x00;\x00:\x007\x00?\x007\x00D\x003\x00@\x00A\x00A\x009\x00H\x00A\x00E\x00:\x00G\x00C\x00E\x00=\x00B\x00?\x00F\x00<\x00A\x00D\x00D\x00;\x00B\x00@\x00E\x00<\x00I\x00B\x00F\x00=\x00E\x00>\x00J\x00?\x00E\x00@\x00I\x009\x00A\x00A\x00B\x009\x00B\x00B\x00B\x00:\x00@\x005\x00B\x00?\x00=\x001\x00C\x00;\x00;\x001\x00F\x00=\x00=\x00:\x00?\x009\x006\x004\x009\x008\x00:\x00/\x002\x009\x002\x00*\x008\x00<\x00-\x00/\x00:\x004\x00.\x00/\x005\x003\x00(\x00/\x001\x006\x00+\x00-\x00.\x006\x000\x00,\x00,\x00.\x00-\x00-\x00)\x00*\x00.\x00.\x00(\x00*\x00+\x00'\x00(\x00%\x00(\x00 \x00\"\x00(\x00!\x00#\x00%\x00$\x00\x1d\x00\x1f\x00\x1e\x00\x1f\x00\"\x00#\x00\x1d\x00 \x00&\x00&\x00\x1d\x00!\x00\x1c\x00(\x00\x1a\x00%\x00\x1d\x00$\x00\x1c\x00#\x00\x1e\x00$\x00\x1d\x00'\x00\x16\x00\x1d\x00\x1c\x00#\x00\x18\x00\x1a\x00\x17\x00!\x00\x1a\x00\x1d\x00\x15\x00\x1d\x00\x13\x00\x19\x00\x10\x00\x1a\x00\x14\x00\x1e\x00\x12\x00\x17\x00\x0f\x00\x15\x00\x15\x00\x15\x00\x0e\x00\x1b\x00\x12\x00\x1a\x00\x11\x00\x15\x00\v\x00\x19\x00\v\x00\x14\x00\x02\x00\x12\x00\x06\x00\x0e\x00\x01\x00\v\x00\xfb\xff\v\x00\x00\x00\b\x00\x00\x00\b\x00\xfa\xff\f\x00\xff\xff\f\x00\xfe\xff\n\x00\xf8\xff\a\x00\xf4\xff\f\x00\xf6\xff\x06\x00\xfa\xff\x06\x00\xf6\xff\x00\x00\xf0\xff\x04\x00\xf7\xff\xfa\xff\xf3\xff\x04\x00\xf0\xff\xf5\xff\xe9\xff\xfc\xff\xe6\xff\xf7\xff\xe3\xff\xfa\xff\xe6\xff\xfc\xff\xe4\xff\xf8\xff\xe2\xff\xfc\xff\xe3\xff\xf7\xff\xe8\xff\xfd\xff\xe2\xff\xf0\xff\xe7\xff\xfb\xff\xe3\xff\xf9\xff\xe3\xff\xf6\xff\xe1\xff\xf5\xff\xdf\xff\xfc\xff\xe3\xff\xf5\xff\xe3\xff\xf8\xff\xe1\xff\xf3\xff\xe2\xff\xf6\xff\xdd\xff\xf3\xff\xde\xff\xf6\xff\xd9\xff\xed\xff\xd8\xff\xef\xff\xda\xff\xf7\xff\xde\xff\xef\xff\xd5\xff\xf4\xff\xda\xff\xef\xff\xd7\xff\xf0\xff\xdb\xff\xea\xff\xd6\xff\xe8\xff\xce\xff\xeb\xff\xd7\xff\xea\xff\xd2\xff\xee\xff\xd8\xff\xed\xff\xd4\xff\xec\xff\xd9\xff\xef\xff\xd7\xff\xee\xff\xd7\xff\xeb\xff\xd9\xff\xef\xff\xd5\xff\xf0\xff\xd6\xff\xf2\xff\xd7\xff\xec\xff\xdb\xff\xf1\xff\xd9\xff\xea\xff\xdb\xff\xf0\xff\xd9\xff\xe7\xff\xd5\xff\xf2\xff\xd7\xff\xea\xff\xd4\xff\xe5\xff\xd8\xff\xe8\xff\xd0\xff\xe7\xff\xd5\xff\xe5\xff\xd7\xff\xe7\xff\xda\xff\xe3\xff\xd4\xff\xe9\xff\xd3\xff\xe9\xff\xd0\xff\xe3\xff\xd3\xff\xe4\xff\xce\xff\xe3\xff\xd3\xff\xe4\xff\xd0\xff\xe9\xff\xdb\xff\xe2\xff\xcf\xff\xdc\xff\xd7\xff\xe0\xff\xc8\xff\xdc\xff\xcd\xff\xdf\xff\xce\xff\xdd\xff\xcb\xff\xdd\xff\xd2\xff\xde\xff\xc8\xff\xdc\xff\xd1\xff\xd4\xff\xcc\xff\xda\xff\xd0\xff\xd7\xff\xd2\xff\xe2\xff\xca\xff\xdc\xff\xd2\xff\xe4\xff\xc8\xff\xe0\xff\xcf\xff\xe7\xff\xcc\xff\xe1\xff\xd0\xff\xe5\xff\xcf\xff\xdb\xff\xcf\xff\xe0\xff\xd1\xff\xde\xff\xd1\xff\xdb\xff\xcd\xff\xe0\xff\xce\xff\xdd\xff\xd1\xff\xe2\xff\xd1\xff\xe3\xff\xca\xff\xe1\xff\xce\xff\xe2\xff\xcc\xff\xe2\xff\xd2\xff\xe2\xff\xd2\xff\xe3\xff\xd6\xff\xe8\xff\xd0\xff\xdf\xff\xd8\xff\xf1\xff\xd7\xff\xe3\xff\xd4\xff\xf1\xff\xde\xff\xe3\xff\xd9\xff\xef\xff\xdb\xff\xe0\xff\xd6\xff\xef\xff\xe3\xff\xe9\xff\xdf\xff\xea\xff\xde\xff\xe9\xff\xdd\xff\xec\xff\xde\xff\xee\xff\xe1\xff\xee\xff\xda\xff\xe9\xff\xe2\xff\xed\xff\xdf\xff\xea\xff\xe2\xff\xfa\xff\xe5\xff\xed\xff\xea\xff\xf4\xff\xe8\xff\xf6\xff\xec\xff\xf4\xff\xeb\xff\xf7\xff\xe9\xff\xf6\xff\xef\xff\xf3\xff\xea\xff\xfc\xff\xee\xff\xf2\xff\xf5\xff\xfb\xff\xf0\xff\xf5\xff\xf4\xff\xf3\xff\xf4\xff\xf6\xff\xf4\xff\xf1\xff\xf5\xff\xf8\xff\xf3\xff\xf1\xff\xf8\xff\xfd\xff\xfb\xff\xf3\xff\xf5\xff\xff\xff\xff\xff\xf7\xff\x00\x00\x02\x00\xfe\xff\xfa\xff\xff\xff\x00\x00\xfb\xff\xf9\xff\x03\x00\x02\x00\xfd\xff\xfe\xff\b\x00\x04\x00\x04\x00\a\x00\x0e\x00\b\x00\x03\x00\n\x00\x0e\x00\x06\x00\x02\x00\n\x00\x0e\x00\t\x00\x06\x00\x05\x00\x0f\x00\b\x00\f\x00\x06\x00\x0f\x00\v\x00\x14\x00\a\x00\x15\x00\f\x00\x0e\x00\x06\x00\x15\x00\r\x00\x14\x00\x04\x00\x1d\x00\x16\x00\x1a\x00\t\x00\x1e\x00\x19\x00\x18\x00\x11\x00\x1f\x00\x10\x00\x19\x00\x1a\x00\x1a\x00\x15\x00\x1c\x00$\x00\x1f\x00\x1e\x00!\x00!\x00$\x00\x1f\x00*\x00\x1f\x00,\x00$\x00'\x00\x1b\x00+\x00*\x00.\x00!\x00'\x00'\x001\x00 \x00/\x00&\x00.\x00#\x002\x00$\x00.\x00!\x005\x00#\x001\x00\x1e\x006\x00\x1f\x001\x00\x17\x008\x00\x1d\x00.\x00 \x00A\x00\x1f\x00;\x00$\x00<\x00%\x00<\x00'\x00@\x00#\x00A\x00+\x00;\x00&\x00C\x00-\x00>\x00*\x00J\x004\x00@\x00-\x00H\x002\x00B\x001\x00I\x007\x00D\x00/\x00D\x00-\x00L\x002\x00B\x001\x00O\x000\x00M\x00.\x00J\x000\x00M\x002\x00S\x000\x00P\x00/\x00R\x00+\x00S\x00.\x00R\x00)\x00S\x00,\x00V\x00-\x00P\x00&\x00Q\x00,\x00M\x00%\x00T\x000\x00K\x00'\x00Z\x00*\x00H\x00$\x00R\x00)\x00S\x00$\x00T\x00$\x00M\x00(\x00R\x00(\x00U\x00'\x00P\x00$\x00M\x00(\x00J\x00&\x00T\x00 \x00K\x00$\x00Q\x00!\x00J\x00(\x00Q\x00&\x00K\x00*\x00U\x00%\x00L\x00%\x00M\x00!\x00M\x00\"\x00Q\x00#\x00N\x00\x1f\x00Q\x00\"\x00O\x00$\x00]\x00 \x00P\x00\x1e\x00P\x00$\x00U\x00\x1f\x00P\x00&\x00T\x00 \x00R\x00#\x00Y\x00\"\x00V\x00\x1e\x00W\x00!\x00T\x00\"\x00S\x00\"\x00K\x00\"\x00T\x00!\x00P\x00\x1d\x00J\x00\x1f\x00M\x00\x1d\x00J\x00#\x00L\x00\x1c\x00E\x00#\x00K\x00\"\x00G\x00#\x00D\x00\"\x00H\x00\x1d\x00H\x00!\x00D\x00\x1a\x00E\x00 \x00A\x00\x1b\x00B\x00\"\x00<\x00\x19\x00?\x00\x1e\x009\x00\x16\x008\x00\x17\x00;\x00\x15\x007\x00\x11\x00;\x00\x12\x006\x00\f\x009\x00\x10\x002\x00\v\x006\x00\r\x002\x00\x04\x002\x00\b\x00)\x00\xfe\xff(\x00\x06\x000\x00\a\x00.\x00\n\x00+\x00\x04\x00&\x00\x03\x00&\x00\f\x00*\x00\b\x00(\x00\n\x00'\x00\xff\xff\"\x00\f\x00$\x00\xff\xff!\x00\b\x00(\x00\x03\x00\x1d\x00\x03\x00#\x00\x03\x00\x1f\x00\x04\x00\x1e\x00\x01\x00\x1c\x00\xff\xff\x17\x00\xfd\xff\x1b\x00\xfd\xff\x14\x00\xf8\xff \x00\xf4\xff\x11\x00\xf4\xff\x15\x00\xf8\xff\x13\x00\xf2\xff\x10\x00\xf5\xff\x0f\x00\xf0\xff\n\x00\xf4\xff\r\x00\xf2\xff\r\x00\xee\xff\v\x00\xf5\xff\b\x00\xf2\xff\a\x00\xf0\xff\a\x00\xef\xff\x00\x00\xee\xff\x04\x00\xe9\xff\xfd\xff\xf2\xff\a\x00\xe9\xff\x04\x00\xf2\xff\xff\xff\xed\xff\xff\xff\xef\xff\xfb\xff\xf0\xff\x01\x00\xf0\xff\xfb\xff\xec\xff\xfd\xff\xec\xff\xf3\xff\xe7\xff\xff\xff\xef\xff\xf4\xff\xed\xff\xf9\xff\xeb\xff\xf2\xff\xed\xff\xf7\xff\xeb\xff\xf1\xff\xe4\xff\xee\xff\xe7\xff\xf2\xff\xeb\xff\xf3\xff\xe6\xff\xf0\xff\xe4\xff\xe9\xff\xe1\xff\xec\xff\xe9\xff\xee\xff\xe1\xff\xf2\xff\xea\xff\xe9\xff\xe1\xff\xeb\xff\xe3\xff\xec\xff\xe3\xff\xeb\xff\xe0\xff\xe9\xff\xe5\xff\xe3\xff\xe2\xff\xeb\xff\xe4\xff\xe1\xff\xea\xff\xe9\xff\xdf\xff\xd6\xff\xe8\xff\xe4\xff\xdf\xff\xdb\xff\xe4\xff\xe5\xff\xe0\xff\xd9\xff\xe7\xff\xdd\xff\xdc\xff\xe1\xff\xe1\xff\xdb\xff\xde\xff\xde\xff\xdc\xff\xce\xff\xdc\xff\xdc\xff\xde\xff\xd0\xff\xd8\xff\xda\xff\xdc\xff\xce\xff\xd6\xff\xcf\xff\xd6\xff\xcf\xff\xd6\xff\xcb\xff\xd2\xff\xc9\xff\xd1\xff\xc0\xff\xd4\xff\xca\xff\xd1\xff\xc4\xff\xd7\xff\xc9\xff\xd4\xff\xc2\xff\xd2\xff\xc8\xff\xdc\xff\xbf\xff\xd9\xff\xce\xff\xdc\xff\xcb\xff\xda\xff\xc9\xff\xde\xff\xce\xff\xe0\xff\xcb\xff\xe1\xff\xd4\xff\xd6\xff\xc7\xff\xe0\xff\xca\xff\xd3\xff\xc6\xff\xe6\xff\xc7\xff\xd4\xff\xc7\xff\xe0\xff\xc3\xff\xd2\xff\xc7\xff\xe1\xff\xc4\xff\xd2\xff\xc6\xff\xdd\xff\xc8\xff\xd7\xff\xc2\xff\xdf\xff\xc6\xff\xdc\xff\xc1\xff\xde\xff\xcc\xff\xe4\xff\xbf\xff\xdc\xff\xc7\xff\xdf\xff\xc0\xff\xde\xff\xc0\xff\xdb\xff\xbe\xff\xdd\xff\xc5\xff\xe0\xff\xc5\xff\xdc\xff\xc8\xff\xed\xff\xcd\xff\xe1\xff\xc8\xff\xe9\xff\xcf\xff\xe2\xff\xc6\xff\xec\xff\xcd\xff\xe5\xff\xcc\xff\xed\xff\xc5\xff\xe7\xff\xcd\xff\xec\xff\xc5\xff\xea\xff\xcb\xff\xeb\xff\xc9\xff\xf0\xff\xc7\xff\xef\xff\xd0\xff\xea\xff\xca\xff\xf0\xff\xcb\xff\xea\xff\xca\xff\xeb\xff\xcc\xff\xee\xff\xcc\xff\xea\xff\xcc\xff\xf2\xff\xcc\xff\xe6\xff\xc6\xff\xed\xff\xc8\xff\xe2\xff\xc9\xff\xee\xff\xc9\xff\xe2\xff\xcb\xff\xeb\xff\xca\xff\xe6\xff\xc8\xff\xec\xff\xc8\xff\xe1\xff\xc5\xff\xef\xff\xc6\xff\xe2\xff\xc7\xff\xee\xff\xca\xff\xee\xff\xc9\xff\xf5\xff\xc9\xff\xed\xff\xc6\xff\xef\xff\xcc\xff\xee\xff\xc1\xff\xf4\xff\xcd\xff\xf4\xff\xc1\xff\xf1\xff\xc6\xff\xf7\xff\xca\xff\xf3\xff\xc8\xff\xfe\xff\xcd\xff\xf4\xff\xc7\xff\xf8\xff\xcb\xff\xf4\xff\xce\xff\xfc\xff\xcc\xff\xf4\xff\xcd\xff\xf7\xff\xcc\xff\xef\xff\xc9\xff\xf7\xff\xd1\xff\xf7\xff\xc4\xff\xfa\xff\xd5\xff\xfb\xff\xc7\xff\xf8\xff\xd1\xff\xf9\xff\xca\xff\xf9\xff\xcf\xff\xf0\xff\xbf\xff\xf4\xff\xc7\xff\xf8\xff\xc7\xff\xf9\xff\xcb\xff\xf8\xff\xc8\xff\xfb\xff\xd4\xff\x01\x00\xcd\xff\xfe\xff\xda\xff\x06\x00\xd4\xff\xfc\xff\xd4\xff\b\x00\xdd\xff\xfd\xff\xd3\xff\x10\x00\xe4\xff\a\x00\xd3\xff\v\x00\xe0\xff\a\x00\xda\xff\x05\x00\xe5\xff\x11\x00\xe0\xff\f\x00\xe7\xff\f\x00\xdc\xff\x16\x00\xe9\xff\x10\x00\xe3\xff\x16\x00\xe9\xff\x11\x00\xe9\xff\x14\x00\xe7\xff\x10\x00\xf1\xff\r\x00\xe1\xff\x17\x00\xed\xff\x15\x00\xe5\xff\x0f\x00\xf0\xff\x15\x00\xef\xff\x17\x00\xec\xff\x15\x00\xf6\xff \x00\xf1\xff\x15\x00\xfd\xff!\x00\xf5\xff\x1e\x00\xfc\xff%\x00\xfa\xff$\x00\xf4\xff!\x00\xf6\xff#\x00\xfc\xff'\x00\xfa\xff&\x00\xfb\xff(\x00\xfd\xff(\x00\x04\x000\x00\xfa\xff\"\x00\xfa\xff'\x00\xfa\xff!\x00\x01\x00)\x00\xf8\xff%\x00\x03\x00*\x00\xfc\xff.\x00\x03\x00*\x00\xff\xff&\x00\b\x00+\x00\x00\x00'\x00\xfd\xff(\x00\xfe\xff&\x00\a\x00+\x00\x02\x00)\x00\x04\x00(\x00\x01\x00%\x00\v\x00'\x00\n\x00,\x00\v\x00&\x00\x06\x00'\x00\x04\x00,\x00\f\x001\x00\x0e\x003\x00\x10\x006\x00\a\x000\x00\x14\x005\x00\x11\x00-\x00\x10\x00.\x00\a\x00)\x00\r\x00&\x00\x11\x00-\x00\x11\x00'\x00\x06\x00)\x00\x12\x00,\x00\x02\x00.\x00\x13\x00(\x00\x0e\x004\x00\x11\x00+\x00\x14\x007\x00\x16\x00.\x00\x1a\x00=\x00\x1c\x00>\x00 \x00:\x00\x1b\x00A\x00!\x00<\x00%\x00F\x00 \x00<\x00%\x00B\x00\"\x00E\x000\x00H\x000\x00@\x002\x00G\x00-\x00K\x006\x00K\x005\x00T\x00<\x00M\x004\x00R\x00;\x00L\x00B\x00X\x00E\x00O\x00E\x00Z\x00E\x00U\x00M\x00`\x00O\x00a\x00X\x00`\x00U\x00^\x00T\x00]\x00X\x00`\x00\\\x00f\x00R\x00]\x00X\x00d\x00S\x00Z\x00V\x00a\x00]\x00c\x00Y\x00`\x00]\x00a\x00O\x00X\x00X\x00]\x00Q\x00[\x00W\x00W\x00L\x00`\x00W\x00Z\x00R\x00\\\x00V\x00]\x00V\x00Q\x00R\x00N\x00L\x00M\x00J\x00K\x00I\x00L\x00I\x00Q\x00I\x00M\x00K\x00V\x00L\x00K\x00O\x00O\x00P\x00M\x00L\x00R\x00K\x00S\x00R\x00R\x00N\x00O\x00M\x00T\x00M\x00S\x00[\x00Z\x00U\x00W\x00W\x00V\x00U\x00L\x00U\x00M\x00U\x00L\x00M\x00I\x00P\x00M\x00Q\x00N\x00P\x00O\x00Y\x00J\x00S\x00H\x00\\\x00O\x00X\x00O\x00X\x00R\x00S\x00P\x00Y\x00N\x00\\\x00L\x00]\x00U\x00c\x00O\x00c\x00Z\x00i\x00V\x00d\x00]\x00n\x00V\x00k\x00Y\x00u\x00Z\x00q\x00^\x00s\x00`\x00r\x00S\x00h\x00Q\x00c\x00G\x00e\x00I\x00[\x00K\x00f\x00J\x00a\x00J\x00f\x00H\x00Z\x00H\x00p\x00J\x00_\x00P\x00h\x00I\x00f\x00O\x00g\x00O\x00g\x00K\x00s\x00K\x00s\x00L\x00q\x00J\x00f\x00H\x00o\x00A\x00n\x00E\x00i\x00D\x00e\x00@\x00b\x00E\x00a\x00?\x00d\x00F\x00a\x00A\x00X\x00B\x00Y\x00C\x00[\x00@\x00c\x00;\x00[\x00=\x00Y\x005\x00_\x004\x00]\x00*\x00Z\x004\x00\\\x00-\x00Z\x00'\x00Y\x00&\x00V\x00%\x00T\x00!\x00O\x00\x1d\x00L\x00$\x00O\x00\"\x00I\x00&\x00M\x00)\x00O\x00(\x00P\x00&\x00Q\x00\x1d\x00H\x00\x17\x00E\x00\x12\x00H\x00\x15\x00<\x00\a\x00C\x00\a\x00:\x00\x00\x00<\x00\v\x007\x00\x05\x00>\x00\x06\x00:\x00\t\x00@\x00\n\x00;\x00\x05\x00?\x00\a\x007\x00\x06\x00A\x00\t\x008\x00\x11\x00D\x00\b\x00>\x00\x02\x00A\x00\xfd\xff1\x00\xfa\xff/\x00\x00\x007\x00\xfc\xff0\x00\xf7\xff/\x00\xf4\xff)\x00\xf5\xff+\x00\xfa\xff5\x00\xec\xff*\x00\xf6\xff(\x00\xfd\xff)\x00\xfa\xff)\x00\x06\x002\x00\xf6\xff\"\x00\x01\x007\x00\x03\x00.\x00\f\x008\x00\x00\x00'\x00\xfe\xff*\x00\xf3\xff\x1b\x00\xf5\xff$\x00\xe9\xff$\x00\xea\xff\x19\x00\xea\xff\"\x00\xf8\xff \x00\xf5\xff'\x00\xf8\xff'\x00\xf9\xff&\x00\xfa\xff,\x00\x06\x00%\x00\f\x000\x00\xfe\xff)\x00\x02\x00.\x00\xfb\xff*\x00\x06\x00+\x00\xfc\xff+\x00\xf8\xff$\x00\xed\xff\x15\x00\xe6\xff\x13\x00\xe1\xff\x11\x00\xdc\xff\x0f\x00\xd4\xff\x05\x00\xce\xff\x06\x00\xd3\xff\x03\x00\xd6\xff\a\x00\xd7\xff\x05\x00\xe0\xff\x06\x00\xd8\xff\x02\x00\xd9\xff\xfc\xff\xdb\xff\xfb\xff\xe0\xff\x05\x00\xe0\xff\xf5\xff\xe0\xff\a\x00\xe1\xff\x05\x00\xe4\xff\f\x00\xe0\xff\x06\x00\xdf\xff\xfe\xff\xc9\xff\xfa\xff\xca\xff\xe3\xff\xd3\xff\xed\xff\xd2\xff\xee\xff\xce\xff\xe6\xff\xd2\xff\xe4\xff\xc3\xff\xe2\xff\xc6\xff\xe6\xff\xc6\xff\xdb\xff\xce\xff\xda\xff\xc2\xff\xd2\xff\xcb\xff\xcf\xff\xb9\xff\xd3\xff\xb6\xff\xc9\xff\xaa\xff\xc0\xff\xa9\xff\xc5\xff\x9c\xff\xb6\xff\x91\xff\xb3\xff\x8f\xff\xa7\xff~\xff\x9f\xff\u007f\xff\x9c\xff\x88\xff\x9f\xff\x95\xff\xad\xff\xa0\xff\xa5\xff\xaa\xff\xbb\xff\xbf\xff\xbd\xff\xbd\xff\xcc\xff\xc1\xff\xcb\xff\xc4\xff\xd2\xff\xca\xff\xcb\xff\xc8\xff\xc9\xff\xd3\xff\xd4\xff\xc6\xff\xd3\xff\xc4\xff\xce\xff\xc2\xff\xcb\xff\xb6\xff\xcd\xff\xad\xff\xb6\xff\x9f\xff\xb1\xff\x9a\xff\xa6\xff\x9f\xff\xa5\xff\x99\xff\xa9\xff\x9e\xff\xa6\xff\x9b\xff\xa2\xff\xab\xff\xa1\xff\xb6\xff\xaa\xff\xb5\xff\xaa\xff\xb8\xff\xa7\xff\xb0\xff\xaf\xff\xbb\xff\xb8\xff\xc0\xff\xc0\xff\xdb\xff\xcb\xff\xd2\xff\xd3\xff\xd5\xff\xd5\xff\xce\xff\xd1\xff\xce\xff\xcb\xff\xbd\xff\xbd\xff\xc1\xff\xc3\xff\xc4\xff\xbc\xff\xd1\xff\xca\xff\xea\xff\xce\xff\xe9\xff\xd6\xff\xe6\xff\xdc\xff\xe1\xff\xd4\xff\xeb\xff\xe2\xff\xf6\xff\xd8\xff\xf1\xff\xe0\xff\xe9\xff\xd4\xff\xeb\xff\xd7\xff\xdd\xff\xd7\xff\xd0\xff\xd3\xff\xd5\xff\xcf\xff\xe1\xff\xd3\xff\xd7\xff\xd8\xff\xcf\xff\xd1\xff\xd8\xff\xd6\xff\xdc\xff\xd2\xff\xe2\xff\xca\xff\xd0\xff\xca\xff\xdb\xff\xcb\xff\xd6\xff\xc0\xff\xf4\xff\xbe\xff\xf1\xff\xca\xff\xe8\xff\xd4\xff\xea\xff\xca\xff\xf6\xff\xd1\xff\xf6\xff\xd8\xff\xe2\xff\xcf\xff\xe3\xff\xc6\xff\xed\xff\xd1\xff\xee\xff\xcb\xff\xee\xff\xd5\xff\xde\xff\xce\xff\xda\xff\xc7\xff\xc6\xff\xba\xff\xcb\xff\xae\xff\xc7\xff\xb2\xff\xd9\xff\xbc\xff\xd1\xff\xba\xff\xe7\xff\xb4\xff\xde\xff\xbc\xff\xed\xff\xc4\xff\xec\xff\xc5\xff\xf8\xff\xc3\xff\xf1\xff\xc1\xff\xef\xff\xc3\xff\xec\xff\xc8\xff\xf2\xff\xbc\xff\xec\xff\xc0\xff\xf5\xff\xc1\xff\xf2\xff\xc5\xff\xef\xff\xd0\xff\xef\xff\xc1\xff\xfb\xff\xc8\xff\a\x00\xcf\xff\v\x00\xda\xff\x1a\x00\xd9\xff.\x00\xde\xff2\x00\xed\xff \x00\xf6\xff-\x00\xf5\xff,\x00\xfd\xffB\x00\x02\x00E\x00\x10\x003\x00\x0f\x00 \x00\n\x00)\x00\xfb\xff.\x00\x06\x001\x00\x10\x00\x1e\x00\b\x00$\x00\xf5\xff#\x00\xf1\xff\"\x00\x00\x008\x00\xfe\xff.\x00\xfc\xff7\x00\xfa\xff;\x00\xf5\xffN\x00\n\x00I\x00\x1b\x00N\x00\x10\x00L\x00\x13\x00K\x00\v\x00A\x00\x1c\x00/\x00\t\x00\x1a\x00\x01\x00\x15\x00\xf0\xff\x1e\x00\xee\xff'\x00\xff\xff\x13\x00\x01\x00\x12\x00\xe5\xff\x04\x00\xe9\xff\n\x00\xe4\xff\x1d\x00\xfa\xff(\x00\xf9\xff-\x00\xfc\xff2\x00\xeb\xffF\x00\x01\x00F\x00\x14\x00B\x00\x10\x00B\x00\v\x00M\x00\x05\x00M\x00\x18\x00I\x00\x15\x00?\x00\f\x00F\x00\b\x00N\x00\t\x00P\x00\x18\x00E\x00\x12\x00Q\x00\n\x00Z\x00\x10\x00M\x00\x17\x00T\x00\x10\x00T\x00\x1b\x00f\x00\x14\x00i\x00(\x00\x82\x00+\x00\x84\x00@\x00\x86\x00N\x00\x97\x00K\x00\x9c\x00k\x00\x8b\x00]\x00\x87\x00S\x00\x9a\x00T\x00\x8e\x00c\x00u\x00T\x00\x87\x00T\x00\x8a\x00f\x00~\x00_\x00\x92\x00O\x00\x98\x00a\x00\x89\x00\\\x00\x9f\x00\\\x00\xa2\x00_\x00\x99\x00b\x00\x9b\x00V\x00\xb9\x00l\x00\xb9\x00y\x00\x99\x00r\x00\x9d\x00g\x00\x88\x00n\x00k\x00W\x00`\x008\x00t\x00C\x00r\x00H\x00y\x00J\x00e\x00G\x00Y\x00G\x00T\x00>\x00f\x00B\x00o\x00D\x00g\x00G\x00h\x00F\x00X\x006\x00g\x00D\x00]\x00=\x00a\x00L\x00f\x00I\x00U\x00C\x00M\x00<\x00D\x000\x008\x00/\x00;\x00\x1c\x00G\x00-\x00[\x00/\x00Q\x008\x00;\x000\x00J\x00)\x00W\x002\x00b\x00M\x00Q\x00A\x00Z\x00(\x00\\\x003\x00]\x00Q\x00V\x00`\x00Q\x00K\x009\x007\x00$\x00=\x00#\x00(\x00\x17\x00)\x00\x00\x00\x16\x00\x1e\x00\x10\x00@\x006\x00B\x00P\x00-\x00?\x00C\x00%\x00^\x00F\x00S\x00V\x00k\x00N\x00m\x00S\x00c\x00L\x00x\x00I\x00\x95\x00k\x00t\x00{\x00V\x00a\x00{\x00O\x00~\x00g\x00]\x00e\x00i\x00Y\x00r\x00[\x00\x80\x00X\x00\x97\x00a\x00\xaf\x00\x92\x00~\x00|\x00\x8f\x00f\x00\xa8\x00c\x00\xa9\x00x\x00\xaf\x00s\x00\xc8\x00l\x00\xdc\x00\x88\x00\xd6\x00\xa3\x00\xcd\x00\xa8\x00\xba\x00\x9f\x00\x9f\x00\x83\x00\xb9\x00m\x00\xb2\x00\x88\x00\x9e\x00\x84\x00\xae\x00n\x00\xa5\x00l\x00\x95\x00\x82\x00\x8b\x00^\x00\x9c\x00q\x00\x99\x00j\x00\x97\x00[\x00\xad\x00l\x00\x87\x00y\x00\x8b\x00f\x00\xa8\x00f\x00\xaa\x00\x80\x00\x8e\x00u\x00\x99\x00[\x00s\x00m\x00T\x00I\x00H\x00-\x00N\x000\x00=\x00B\x00T\x00:\x00V\x004\x00R\x00<\x00\x1b\x009\x00\"\x00\x17\x00\x1c\x00\xf8\xff'\x00\xe4\xff6\x00\xee\xff\x14\x00\v\x00\x06\x00\xfc\xff\xf1\xff\xec\xff\xd9\xff\xee\xff\xd1\xff\xda\xff\xe1\xff\xcb\xff\xda\xff\xc4\xff\xba\xff\xa8\xff\x8e\xff\xb2\xffg\xff\xa4\xffW\xff\x89\xffX\xffl\xffQ\xfft\xffH\xff\x92\xffA\xff{\xff:\xff\\\xff3\xff_\xffK\xff}\xffa\xff\x8e\xffv\xff\x8b\xff\x85\xff\x97\xff|\xff\xa5\xffi\xff\x9f\xff~\xff\x9b\xff\x95\xff\xac\xff\xa5\xff\xb4\xff\x87\xff\xa2\xff\x85\xff\x91\xff\x90\xff\x93\xff\x99\xff\xb5\xff\xa6\xff\xb8\xff\xba\xff\xb0\xff\xb5\xff\xb1\xff\xbb\xff\xb3\xff\xcf\xff\xc7\xff\xe2\xff\xdf\xff\xd7\xff\xe8\xff\xd3\xff\xe3\xff\xff\xff\xe7\xff\a\x00\x04\x00\xfa\xff\xf2\xff\xfe\xff\xf5\xff\x18\x00\x02\x00*\x00\x15\x00G\x00)\x00&\x00;\x00+\x007\x00'\x00(\x001\x00+\x001\x00\x1f\x007\x00)\x00X\x00>\x00f\x00D\x00m\x00K\x00e\x00a\x00\x88\x00t\x00\xc9\x00\x84\x00\xe0\x00\x8d\x00\xb8\x00\xb2\x00\xa5\x00\x9d\x00\xbd\x00\x8c\x00\xc6\x00\xa0\x00\x9b\x00\x86\x00}\x00d\x00}\x00`\x00\x82\x00a\x00q\x00u\x00V\x00_\x00<\x00D\x00S\x009\x00m\x00I\x00a\x00T\x00\x14\x00<\x00\xe1\xff\x05\x00\xcc\xff\xdd\xff\xa2\xff\xdd\xff\xa4\xff\xbe\xff\xb6\xff\xb1\xff\x86\xff\xa7\xffW\xff\x90\xff[\xff\x8c\xff]\xff\x97\xff-\xffc\xff&\xff?\xff;\xffT\xff%\xff^\xff\x17\xffL\xff\x10\xff;\xff\b\xffA\xff\x13\xff-\xff \xff/\xff\x11\xff>\xff\xf8\xfeO\xff\b\xff@\xff\"\xff@\xff\x19\xffX\xff\x0f\xffW\xff\x0f\xffE\xff\x00\xff)\xff\xdf\xfe.\xff\xc7\xfe\x10\xff\xb6\xfe\x11\xff\xbf\xfe\xf6\xfe\xe5\xfe\xfa\xfe\xe8\xfe\x1c\xff\xe8\xfe%\xff\xfb\xfe4\xff\x01\xffR\xff\a\xffN\xff\x1c\xff^\xff-\xffj\xff-\xff\\\xffR\xffn\xffY\xff\x8f\xffE\xff\xb0\xffu\xff\x93\xff\xa5\xff\xb4\xff\xb2\xff\xdc\xff\xc0\xff\xf0\xff\xee\xff\xfe\xff\x1c\x00\x19\x00C\x003\x00m\x00n\x00g\x00q\x00`\x00k\x00\x95\x00c\x00\xa7\x00~\x00|\x00y\x00{\x00t\x00\xa8\x00\x82\x00\xd5\x00\x9e\x00\xd2\x00\xba\x00\xe9\x00\xd1\x00\xc3\x00\xd0\x00\xbc\x00\xac\x00\xe6\x00\xb8\x00\v\x01\xc5\x00\xf9\x00\xbc\x00\xde\x00\x9b\x00\xbc\x00\x98\x00\xb8\x00\x96\x00\xc5\x00\xaa\x00\xd6\x00\xa7\x00\xfa\x00\xa1\x00\x0f\x01\xc6\x00\xfc\x00\xcf\x00\x05\x01\xc5\x00\x06\x01\xbe\x00\xfb\x00\xb7\x00\x00\x01\xba\x00\x00\x01\xde\x00\xef\x00\xd0\x00\xcd\x00\x96\x00\xa4\x00{\x00\x8d\x00k\x00\x90\x00r\x00\x8a\x00z\x00\xaa\x00a\x00\xac\x00]\x00r\x00\x87\x00I\x00[\x00e\x00-\x00d\x00$\x00%\x00\x1c\x00\xdd\xff\xf1\xff\xae\xff\xc0\xff\xab\xff\xb8\xff\xa4\xff\xba\xff\x88\xff\x9f\xffw\xff\x98\xff\x89\xff\x81\xff\xac\xff\x8e\xff\xb7\xff\xa5\xff\xb4\xff\xb1\xff\x94\xff\xa9\xff\xa1\xff\xa3\xff\xae\xff\xaa\xff\xb6\xff\xb7\xff\xcf\xff\xca\xff\xd6\xff\xd7\xff\xc4\xff\xd8\xff\xef\xff\xe1\xff\x10\x00\a\x00\x18\x00\x1b\x00\x13\x00!\x00\f\x00&\x007\x00&\x00\\\x00^\x001\x00c\x00\x1e\x00\x1d\x00#\x00\x18\x00F\x00E\x00\x86\x00i\x00o\x00\x8a\x00[\x00X\x00o\x00Q\x00\xa3\x00\x9b\x00\xd6\x00\xd7\x00\xdf\x00\xe8\x00\xfa\x00\xd5\x00\xf0\x00\xe0\x00\x02\x01\x06\x01\xed\x00\x15\x01\xc9\x00\xf1\x00\xeb\x00\xaf\x00\xee\x00\xea\x00\xdb\x00\x1b\x01&\x01\a\x015\x01.\x01?\x01@\x01b\x01V\x01s\x01\x91\x01Q\x01g\x01l\x01$\x01\x95\x01@\x01I\x01\x82\x01%\x01L\x01G\x01\x1e\x016\x012\x01\xf0\x00\"\x01\xdc\x00\xe9\x00\xdc\x00\xf5\x00\xc3\x00\xf3\x00\xb3\x00\xd0\x00\x9b\x00\xa8\x003\x00t\x00\x05\x00\x15\x00@\x00/\x00I\x00K\x00\xdd\xff-\x00\xa7\xff\xde\xff\xa1\xff\xad\xff\x81\xff\xb9\xff\x94\xff\xbb\xff\x8a\xff\xb1\xff\x15\xff\x81\xff\v\xff3\xff&\xffb\xff\xf2\xfe\x94\xff\x94\xfeA\xff\x85\xfe\xd4\xfe\xa5\xfe\xdf\xfe\xbd\xfe\x12\xff\x94\xfe+\xff9\xfe\xf2\xfe^\xfe\x90\xfe\xa1\xfe\xb8\xfe\xe3\xfe\x18\xff\xbb\xfe7\xff\x88\xfe\xe4\xfe\x98\xfe\xaf\xfe\xb7\xfe\xec\xfe\xb0\xfe\x1e\xff\x98\xfe\x02\xff\x86\xfe\xc7\xfen\xfe\xa0\xfeh\xfe\xcc\xfe\\\xfe\xd9\xfeY\xfe\xd1\xfef\xfe\xa3\xfeH\xfe\xaa\xfeX\xfe\xb2\xfe\xa7\xfe\xcc\xfe\x96\xfe\xec\xfew\xfe\xcc\xfe\x88\xfe\x9f\xfe}\xfe\xed\xfeu\xfe\xf5\xfe\xa4\xfe\xd5\xfe\xbf\xfe\xe0\xfe\x8b\xfe\x13\xff\xa3\xfe+\xff\xbd\xfe;\xff\xcb\xfeM\xff\xcb\xfe3\xff\xca\xfeN\xff\xab\xfed\xff\xc8\xfeO\xff\x05\xff/\xff\xe1\xfeF\xff\x8d\xfeK\xff\x99\xfe\n\xff\xfb\xfe\x12\xff\x1e\xffE\xff\xd5\xfe<\xff\xd0\xfe&\xff\x15\xff4\xff@\xff<\xff&\xffR\xff\xfa\xfeZ\xff\xdd\xfe\x0e\xff\x1d\xff\x06\xffd\xffc\xff\x84\xff\x94\xff\x8f\xff\x9c\xff\xb3\xff\x9a\xff\r\x00\xb8\xff`\x00\n\x00x\x00H\x00\xc6\x00]\x00\x18\x01\x8d\x00o\x01\xc9\x00\xc5\x01\f\x01\v\x02E\x01\xf5\x01R\x01\xc9\x01,\x01\f\x02$\x01+\x02\x97\x01\x14\x02\x9a\x01>\x02f\x01K\x02x\x01Q\x02\xb9\x01o\x02\xd3\x01\x9f\x02\xe6\x01\x9a\x02\xca\x01\xaa\x02\xb1\x01\xfa\x02\r\x02N\x03n\x02`\x03p\x02j\x03B\x02x\x03U\x02\xa3\x03\xdc\x02\x18\x04!\x03\x92\x04C\x03=\x04I\x03\b\x04\x1b\x03X\x04B\x03~\x04\x95\x03\xb8\x04v\x03\xcc\x04\x8e\x03\xa9\x04\xc1\x03\x96\x04\xbe\x03\xcd\x04\xc2\x03\x1c\x05\xd3\x03\xee\x04\xdf\x03=\x05\xb7\x03r\x05\x16\x04{\x05H\x04|\x05\x12\x04I\x05\x11\x04>\x05\x01\x042\x05\f\x04>\x05!\x04I\x05\xf2\x03+\x05\xf7\x03\xed\x04\xe8\x03\x01\x05\xe8\x03D\x05\x16\x040\x05\x0e\x04\x1b\x05\xe1\x03.\x05\xd2\x03A\x05\xf8\x03P\x05\x1c\x04G\x05(\x04\xf7\x04\xfb\x03\x05\x05\xb7\x03\x16\x05\xde\x03\x9f\x04\x06\x04E\x04\x9d\x03\xd9\x03:\x03\x84\x03\xeb\x02 \x03\x85\x02\xac\x02\x85\x02P\x02f\x02\x1c\x02\xdf\x01\xa9\x01\xc9\x01\xcf\x00\x9b\x01\x0f\x00\xfd\x00y\xffR\x00\xf3\xfe\xee\xff\x9f\xfed\xff\xf5\xfd:\xfff\xfd\xf5\xfe\xf4\xfcW\xfe\x9a\xfc\xfe\xfdD\xfc\xd0\xfd\xc7\xfbs\xfd1\xfb\x18\xfd\xc5\xfa{\xfcD\xfa\x14\xfc\xd0\xf9\xa3\xfb\x91\xf9\x8c\xfbt\xf9w\xfbi\xf9;\xfbq\xf9\x18\xfbI\xf9\x10\xfb\xfa\xf8\xe0\xfa\xc6\xf8\xb5\xfa\xc8\xf8n\xfa\xbd\xf8Z\xfa\x94\xf8\u007f\xfaz\xf8e\xfat\xf8;\xfau\xf8F\xfa<\xf8L\xfa\x06\xf8/\xfa\x18\xf8\xe3\xf9\xeb\xf7\xbd\xf9\xb5\xf7\xa0\xf9\xcd\xf7\xa3\xf9\x18\xf8\xd6\xf9U\xf8\x03\xfa\xb4\xf8!\xfa?\xf9v\xfa\xa9\xf9\xfd\xfa.\xfa\x19\xfb\xc1\xfaJ\xfb8\xfb\xbf\xfb\x87\xfb\xfe\xfb.\xfcQ\xfc\xe6\xfc\x03\xfd\x8a\xfd\xa8\xfd\x15\xfe1\xfe\xc1\xfe\x9b\xfeM\xff\x11\xff\xcd\xff^\xff|\x00\xcb\xff\xb1\x00\x17\x00\x00\x01Y\x00\x95\x01\xa9\x00\xf3\x01\x0f\x01\x1a\x02H\x01F\x02\x87\x01Z\x02\xb1\x01\x95\x02\xac\x01\xc9\x02\xaf\x01\xba\x02\xb7\x01v\x02\xa9\x017\x02X\x01\x1d\x02!\x01%\x02M\x01\xf3\x01X\x01\xa1\x01\x0e\x01\xcb\x01\xd2\x00\xdf\x01\xe3\x00\xaf\x01\xe7\x00J\x01\xbf\x00\x17\x01\\\x00\xba\x00\x16\x00\x8e\x00\xc9\xff\x85\x00\xc5\xffS\x00\xc8\xff#\x00u\xff\xe0\xffC\xff\x84\xff:\xffc\xff\x13\xff-\xff\r\xff\xed\xfe\xa9\xfe\xab\xfeu\xfe\xbe\xfe\x88\xfe\xcc\xfe\xad\xfe\xc2\xfe\x98\xfe\xd7\xfe\x83\xfe\xc2\xfe\x8b\xfef\xfe\x88\xfe(\xfeS\xfe)\xfe(\xfe\xee\xfd\x13\xfe\x86\xfd\xc5\xfdi\xfd\x89\xfdW\xfd\x80\xfd\xb8\xfca\xfd\x92\xfc\xf1\xfc\x84\xfc\xfa\xfc\x85\xfc)\xfd\xbf\xfc,\xfd\xfc\xfc?\xfd9\xfd`\xfdn\xfd\xb3\xfd\xa7\xfd\xe0\xfd\x00\xfe0\xfex\xfe\x97\xfe\a\xff\x18\xff\x97\xff\x89\xff8\x00\x02\x00\x86\x00\x86\x00\xc9\x00\xd7\x00\x1d\x01$\x01n\x01\x81\x01\xde\x01\xc5\x01k\x02(\x02\xc1\x02\x98\x02&\x03\r\x03\xdb\x03_\x03\u007f\x04\xec\x03$\x05z\x04\x1b\x06\xf8\x04\xff\x06\xac\x05\x86\a8\x06\xc6\ak\x06<\b\xb6\x06\x1c\t\xfb\x06\x01\n\x9d\a\xb7\nX\b\v\v\xbd\b\x12\v\xd2\bG\v\xe1\b\x8e\v$\t\xba\va\t\xd5\vl\t#\f\x8c\t~\f\xee\t\xf2\fl\nS\r\xc2\n5\r\xec\n\xfa\f\xc8\n~\fr\ni\v\xdf\ti\n\xf9\bk\t+\bX\b\x9e\a)\a\xbe\x06\xc9\x05\xcc\x05e\x04\xad\x04\xe6\x02\x99\x03\xce\x01v\x02\xb5\x00h\x01k\xffl\x00V\xfeR\xffh\xfd]\xfe\xbc\xfc\xd0\xfd\x8a\xfck\xfdZ\xfcN\xfd\xad\xfb\xff\xfc\xa4\xfa\x01\xfc\xa6\xf9\xe1\xfa\xe9\xf8>\xfap\xf8\xe8\xf9\xb5\xf7u\xf9\x8e\xf6\xbb\xf8Z\xf5\xd4\xf7S\xf4:\xf7\xc9\xf3\xd3\xf6P\xf32\xf6n\xf2\x8c\xf5\x9d\xf1\xb5\xf4-\xf1\v\xf4@\xf1\xf6\xf3\t\xf1\xfe\xf3\x92\xf0\xed\xf3\x90\xf0\xae\xf3\x14\xf1\xd2\xf3y\xf1P\xf4v\xf1\x9f\xf4?\xf1d\xf4+\xf1'\xf4\x95\xf16\xf4]\xf2\xc2\xf4\xe1\xf2U\xf5\xfc\xf2\x99\xf5o\xf3\xaf\xf5P\xf4H\xf6\xa7\xf5Z\xf7\xeb\xf6<\xf8\xd3\xf7\xe8\xf8\xf2\xf8\xaa\xf9\xa1\xfa\xdf\xfa\xb5\xfcp\xfcY\xfe\xd1\xfd\xe2\xff\x0e\xff\xf6\x00\x12\x00\f\x02+\x01N\x03\x1e\x02a\x04\x1c\x03\x10\x05\xf4\x03\xa1\x05\xa0\x04\xa2\x06}\x05\xe4\a\\\x06\xf3\b@\a\xdc\t\xc5\aR\n+\b\x8a\n\xaf\b[\v\x02\t\x16\fc\t\x96\f\xc4\t\x1c\r\x15\n\xaf\r\x88\n\x15\x0e\b\v]\x0e\v\v<\x0e\xb1\n\x98\rL\n\xd8\f\xe8\tQ\fe\t\xee\v\xd8\b$\vo\bv\n\xeb\a\xe0\t\x94\aO\tT\a\xc8\b\xd4\x06e\b5\x06\x16\b\xbd\x05\xcd\ay\x05k\a7\x05\xeb\x06\f\x05C\x06\xa6\x04\xa0\x05'\x04D\x05\x00\x04\xae\x04\xba\x03\xc2\x032\x03\xa5\x027\x02K\x01\x00\x01\xcc\xff\xda\xffL\xfe\xad\xfe\x9c\xfcq\xfda\xfb*\xfck\xfa;\xfb<\xf9\x82\xfa<\xf8\xa3\xf9\r\xf7\x9c\xf8\x95\xf5\x90\xf78\xf4c\xf6\x10\xf3u\xf5\x02\xf2\xbc\xf4\xdd\xf0\xfa\xf3\xe3\xefB\xf3\x1b\xef\x0e\xf3i\xee\v\xf3\xf2\xed\xb3\xf2\x85\xeds\xf2\xfc\xec-\xf2\xa7\xec\x1a\xf2\xaa\xecP\xf2\xe6\xeck\xf2;\xedo\xf2h\xed]\xf2\xc3\xed\xa2\xf2s\xeeN\xf3\r\xef\xf0\xf3\xcc\xef.\xf4\xa4\xf0\x8d\xf4<\xf1'\xf5\xe6\xf1\xba\xf5\xb9\xf2*\xf6\xb8\xf3\x8a\xf6q\xf4\x1d\xf7\x00\xf5\xd2\xf7\xb5\xf5[\xf8\xe2\xf6G\xf9\x87\xf8S\xfa\x00\xfaW\xfb1\xfbS\xfc^\xfcY\xfd\xe6\xfdc\xfe\n\x00\xad\xffv\x02b\x01\x03\x05U\x03-\a4\x05\x18\t\xcf\x06\xc8\nN\b\x88\f\xaf\t\x15\x0e\xf5\n\xa2\x0fA\f\xad\x10G\r\xc8\x11\xde\r\x11\x13\xb9\x0e\x10\x14\xbd\x0f\x1d\x15\x98\x10\xf9\x15X\x11w\x16\xd8\x11\t\x17\xf7\x11\xfa\x17-\x12\xe6\x18\xc0\x12a\x19j\x13C\x1a\xa9\x13R\x1b\x1b\x14\x83\x1b\xd0\x14-\x1b\xd4\x143\x1b\xc7\x14\xcb\x1b\xf0\x14\xe0\x1b\xf9\x14\xb1\x1b\xd1\x14\xc7\x1b\x80\x14S\x1b\xbc\x14\xb6\x1a\x97\x14\xbb\x19\xe2\x13i\x18\xf4\x12\xd9\x16\xe8\x11\x1a\x15\xae\x10\xad\x13'\x0f\x9c\x11\xc9\r\x8a\x0f\xcf\vr\r\x15\nL\n\xa3\b=\aq\x06\xd0\x046\x04\xbc\x02\xd9\x02@\x00H\x01\x11\xfe\x80\xffB\xfc\xdf\xfd\xfb\xf9s\xfc\xa3\xf7\xc3\xfaZ\xf5\xf7\xf8m\xf3:\xf7\x1e\xf2\x03\xf6\x0f\xf1`\xf5\x06\xf0t\xf4V\xee\xfb\xf2\xd6\xec\x87\xf1\x10\xec\xa7\xf07\xebA\xf0\xc0\xe9l\xeff\xe8\x06\xee\x9d\xe7\x05\xed\xd4\xe6\xa4\xec7\xe6\xfd\xebu\xe5\x87\xeb0\xe4\x02\xeb:\xe3*\xea7\xe3\xe7\xe9\x9e\xe3Z\xeap\xe3\x92\xeaM\xe3^\xea\xc4\xe3m\xeaU\xe4\x00\xeb\x00\xe5\xe8\xeb\x95\xe5{\xecl\xe6\xb4\xec\xba\xe7\x95\xed\x9b\xe95\xef\x93\xeb\xb6\xf0\xb0\xed\xea\xf1\x8b\xef\x0f\xf3<\xf1\x83\xf4Y\xf3A\xf6\x1e\xf6\x18\xf8\xa5\xf8\x15\xfa\x99\xfa\xb6\xfb\xcc\xfc+\xfd>\xff\x1e\xff\xae\x01\xeb\x00\xf3\x03X\x02\xa5\x05\xeb\x03\xf8\x06t\x05\xc9\b\x95\x06\xf7\n3\b\x89\f\x19\n\xd6\r>\vX\x0fb\f\xa9\x10\xe4\r\x18\x12Z\x0f\x9c\x13\x90\x10\xac\x14\x88\x11\x98\x15L\x12\xb2\x16\xfe\x12\xbc\x17\xb3\x130\x18\n\x14m\x18\xf8\x13\xbb\x18\xe1\x13&\x19\xe0\x13\x84\x19\xfc\x13\x81\x19\xcb\x13s\x19$\x13\xda\x18r\x12\xc2\x17\xae\x11\xd5\x16\xbf\x10\x91\x15\x9e\x0f%\x14n\x0e\xff\x12=\r\xef\x11\x8b\f\x96\x10\xd4\v\x02\x0f\xa9\n\xbe\rx\ta\f\x86\b6\nQ\a7\b\xb3\x05N\x06+\x04i\x04\xa1\x02\x92\x02#\x01\x95\x00\xc1\xff)\xfe\t\xfe\xa3\xfb\x06\xfcB\xf9\x04\xfa5\xf7\x1e\xf8\xec\xf4\x8f\xf6\xbf\xf2\x8e\xf4w\xf1\x9c\xf2\xb7\xefj\xf1l\xed\xfe\xefm\xebv\xee\xa8\xe9\xf9\xec\xdb\xe7\u007f\xeb\xe2\xe5\x1a\xea\x1b\xe4\xbd\xe8\xd4\xe2\xa2\xe7\xf8\xe1\xe2\xe6'\xe1/\xe6+\xe0\xa6\xe5\xce߆\xe5\\ߴ\xe5\x1c\xdf\xdd\xe5@\xdf\v\xe6\xdcߙ\xe6\xf6\xe0\x80\xe7n\xe2\xb8\xe8\xf4\xe3\xf2\xe9o\xe5$\xeb\x94\xe7\x8b\xec\x16\xeaa\xeeA\xecL\xf0V\xee\xf4\xf1\xa3\xf0\x85\xf3M\xf3]\xf5\xb5\xf5\x9c\xf7`\xf8\x82\xf9\x06\xfbk\xfb\xbf\xfd\xa9\xfd\x82\x00\xcd\xff\xd4\x02\x95\x01\xbd\x04H\x03\xb2\x063\x05\xa7\b\xfd\x06\x97\n\x93\b\x82\ft\n\xf2\x0e\xa3\f\x8b\x11\x0e\x0f[\x14O\x11\xe5\x16g\x13\xf3\x18\\\x15\xe2\x1a$\x17\xcc\x1c\x84\x18\x1e\x1fB\x1a\x92!?\x1cl#:\x1e\x0e%\x9d\x1f\xc5&\xc6 )(\xd7!\v)\xb1\"\xa9))#\xc0)*#\xc1)\xdb\"\xf7)\xc7\"\xeb)\xc7\"k)r\"-(\xa6!X&~ \x9b#\x8d\x1e\x8f \xd8\x1b\xb7\x1d\x19\x19\xc4\x1a\xab\x162\x18E\x14\x1f\x15\xbb\x11m\x11\xbd\x0e\x93\r\xbe\v\xd5\t\xdd\b3\x06\xde\x05\x9e\x02\xf9\x02\xb1\xff\xf6\xff/\xfd\xae\xfd\xba\xfa\xd7\xfb\a\xf8\x86\xf9\xb3\xf5$\xf7w\xf3-\xf5\f\xf1\xf8\xf2\x90\xee\xe2\xf0K\xec\xe5\xee \xea!\xed\t\xe8w\xeb0\xe6\xce\xe9R\xe4V\xe8\x19\xe2\xf6\xe6|\xe0b\xe5\x85\xdf\xfa\xe3\xfb\xdd\xd1\xe2[\xdc.\xe1p\xdb\xc3\xdfj\xda\x0f\xdfSٝ\xde\xe9\xd8Qޑؗ\xde]ح\xde\xe4\xd8\xcaޜ\xd9n\xdf\xd9\xd9*\xe0|ڬ\xe0X\xdb4\xe1\x12\xdc1\xe2\vݟ\xe3\x89\xde)\xe5\xb5\xe0\xee\xe6\xfa\xe2$\xe9v\xe5\x90\xebg\xe8\xd2\xedb\xeb8\xf0\x1c\xee\xa8\xf2\x1e\xf12\xf5z\xf4\xbe\xf7\xf3\xf7\xd8\xfap\xfb\xb5\xfd;\xffj\x00\xbe\x02q\x03\x12\x06\x11\x06C\tx\b\xf6\v\xd4\nc\x0e\t\r\xdf\x10\xdb\x0et\x13\xc2\x10\xd5\x15|\x12\x89\x17\x1d\x149\x19U\x15\xd5\x1a\x99\x16\v\x1c\x01\x18\x10\x1d\x02\x19b\x1e\x99\x19I\x1fh\x1a\xe9\x1f\xdb\x1a\xbd '\x1bR!\x9f\x1bZ!\xb9\x1b\x15!\x8a\x1b\xf8 k\x1bu m\x1b\xf5\x1f!\x1b\xce\x1f\xc0\x1aZ\x1fL\x1a\x85\x1eq\x19\x9b\x1d6\x18\xa4\x1c-\x17\xe9\x1b\xf4\x15\xcc\x1a\x05\x15\xb6\x19\xf2\x13\xc4\x18\xd6\x12\xab\x17\xeb\x11y\x16\xda\x10\x1e\x15{\x0f0\x13\xb6\r\xbc\x10\xd6\vS\x0e\xc7\t\xfc\v\xa7\ac\t\x9e\x05\\\x06\xfc\x02F\x03O\x00\x19\x00\xf6\xfd\x93\xfc|\xfb\x88\xf9\xaf\xf8\x8b\xf6\x02\xf6H\xf3g\xf3\xf7\xef\xee\xf0E\xec7\xeex\xe8<\xeb!\xe5\x93\xe8\x93\xe2z\xe6o\xe0\xf5\xe4 ޱ\xe3\v\xdc3\xe21\xda\xea\xe0i\xd8\x16\xe0\xea\xd6p\xdfI֡\xde\xde\xd5f\xdegՎ\xde_աޏղޞ\xd5\xe4\xde\x01\xd6 \xdf\xf7֡\xdf\xf0\u05ce\xe0\xb2\xd84\xe1\xf1\xd9\xd9\xe1\xcf\xdb)\xe3\xed\xdd\xc3\xe4\x91\xdf`\xe6\x8c\xe1\xba\xe7\xe6\xe3B\xe9\x19\xe6-\xeb\x9f\xe8\xf6\xec\x10\xeb\xde\xeer\xed\xf0\xf0j\xf0\xe6\xf2\t\xf4w\xf5|\xf7\x81\xf8\xb5\xfat\xfb\a\xfeP\xfe/\x01$\x01\xee\x03\xa0\x03\x9a\x06\xed\x05{\tj\bl\f\x00\v\xbc\x0f\xf9\r\x99\x13\x1d\x11\x82\x17C\x14\x05\x1b`\x175\x1e\r\x1a<!\x9a\x1c\xd3#\xe3\x1eH'\xd8 \xd0*\\#\xb3-\x11&+0\xde'\xf62\xa3)\xa34_+o5\xd8+>6\xe0+\xa86\x1a,\x007\x00,l6\xc9+\x185\x18+V4\xc8)\xdf3w)\xc92)).1\xd9'A.2&\x9f*\x8f#\x9b'\xa1 l$}\x1e? \xb6\x1b\xa3\x1c\xf2\x17w\x19j\x15p\x16a\x13_\x13\xdb\x10\x96\x0f\n\x0e\x93\v\xa4\n\xae\a\x15\a\xd5\x03\xd8\x03\xcf\x00\x9b\x00>\xfd3\xfeN\xf9N\xfb\xbf\xf6\xfc\xf7m\xf4\xfe\xf5\b\xf1\xdd\xf3g\xed,\xf0\xd6\xe9\xc2\xec\xa7\xe6\xdc\xe9\x18\xe4\x82\xe7\xd2\xe0T\xe5x\xddd\xe2\xb4ښ\xdf\x1b\xd8\xeeݦ\xd5b\xdc*\xd3Z\xdaH\xd0\x1f\xd8a\xce\x05\xd6\xca\xcc\xf7\xd4\x1e\xcbz\xd4\xd7ʹ\xd37\xcb\x1f\xd4 \xcb>\xd5i\xcc\x10\xd6nΣ\xd7\x10\xcf6\xd9\x05\xd0\xcd\xd9q\xd1\xdb\xda\xc2\xd2x\xdc\xf3\xd4\xe4\xdd\xcd\xd7\b\xe0\xdfڛ\xe2\xf1\xdd)\xe5\x05\xe1\x02\xe8\x93\xe4\xb3\xea\t\xe8[\xed\xb4\xea\xc4\xef\r\xee6\xf2\x8f\xf21\xf5M\xf6\xae\xf8\x01\xfa\xcc\xfb\x17\xfe\xd4\xfe\x80\x01w\x02\x9f\x04\x9b\x05\x12\b\xf2\aP\v\xb6\nS\x0e\xdb\r\x88\x11U\x10\xc6\x14\xc6\x12U\x17M\x15{\x19S\x17\xdb\x1b\n\x19u\x1e\x15\x1b\x8c \f\x1d]\"?\x1e\x18$\xf8\x1e\xb5%\x16 \\':!\x18)(\"D*U#\t+\v$ ,h$\xdc,\x17%\x8d,S%\x8c+\x9e$\a+\u007f#&*\xc2\"v(\xe8!\xde&L \x99%\x85\x1e)$\x00\x1d-\"\x99\x1b\xe1\x1f\xc0\x19\xef\x1c\x8c\x17\xe6\x19\x1a\x15\x11\x17r\x12\xdf\x13=\x0f\x8c\x10\xfd\v\xaf\r'\t2\vx\x06E\b\xe4\x03S\x04\xf8\x00S\x00Y\xfdH\xfc\xe8\xf9\xde\xf7\xc3\xf6\xae\xf3\xf9\xf2x\xf0\x81\xef!\xed\xe3\xecj\xe9\r\xeaj\xe6@\xe7;\xe3\xea\xe4\r\xdfc\xe2\x17ہ\xdf\xf4װ\xdcxսڭӄ\xd9\u007fҠ\xd8~\xd1\xf5\xd7nЀ\xd7\x16\xd0]\xd7\xfcϞ\u05f9Ϛ\xd75\xcf~\xd7\xe8\xce%\xd7q\xcf\xfa\xd6[к\xd7\x02\xd2\xd9\xd8E\xd4E\xda;\xd7\xf7\xdc\rۨ\xdf\xf7ހ\xe2\xdc\xe2\x86\xe5\x18\xe6;\xe8\xa8\xe9\xd6\xea\x03\xee\xb1\xed~\xf2@\xf1\a\xf7r\xf5\x1a\xfb\xd2\xf9\xfd\xfe\xd3\xfdb\x03\xba\x01\x98\a\xc7\x05\xf7\n\xc2\b+\r?\v\x96\x0f\xf1\r@\x12\xab\x10Z\x15\xc6\x13\xb3\x18\xd2\x16\xc4\x1b\xe0\x19p\x1f\x01\x1d\xfa\"w D&\xad#M(\xab%\x85*\xd1&\xf6-\xed(\x8e0\xeb+B3\x06.\xe44\x90/\xe84\xb0054\x840\xd63\xd9/D3\x1f/\f3\xf3-93\x1a-\xa71*,\xe2.$*\xb2,\xbc'\x8c*\xe2%\xfb(\x10$,'\x9c\"3$\x94 \x95!\xbe\x1d\x15\x1f\x1f\x1ba\x1b\x91\x18\xa6\x17\x04\x15\x9e\x13\xb8\x11\xa2\x0e\r\x0e\xd2\t\x98\tS\x05\xdd\x05$\x00\x89\x02j\xfb*\xfe)\xf7\xd9\xf9J\xf3\xf3\xf5I\xef\x8f\xf2\x1b\xec>\xef\xb2\xe8\xd2\xebt\xe4\x8f\xe71\xe1\x8a\xe3\xd1\xde\xfb\xe0\x87\xdcs߬ڿ\xddm\xd9I\xdcI\xd8b\xdbk\xd7;\xda\xe2\xd6\x11\xd9\b\xd6E\xd8\xccԬ\xd7\xccӢ֎Ҟ\xd5\x0f\xd1\xf8\xd4\xefϷ\xd4&\xd0C\xd5\xea\xd1Q\xd6\x02\xd4\xd2\xd7|Ճ\xd9Vם\xda\xf1\xd8\x12\xdc\xeb\xd9\xf4݉ۯߧ\xdd\x10\xe2W\xdf\xd1\xe4\xfe\xe1\xe0\xe6\xfb\xe4f\xe9Q\xe7\x13\xecF\xe9\xe3\xed\xa5\xeb\xaa\xefH\xee7\xf2\xcc\xf0\xc1\xf4\x90\xf3\xd0\xf6\x04\xf7\xda\xf8M\xfa\xc2\xfb\xba\xfd\xd6\xfe\xed\x01\xec\x01\xf6\x05_\x05\x9f\t}\bf\r\x0f\v\x05\x11\xbd\r\xfa\x13t\x10v\x169\x13\xe6\x18\xe1\x159\x1b\x10\x18&\x1d\xec\x19\xa0\x1e%\x1bF \x80\x1c7!\xe3\x1d>\"\x8b\x1e\x80#\x16\x1f\xc1#\x1b\x1f\xc9#\xaa\x1e\xad#`\x1e\xba#D\x1e\x12$\xa9\x1e\xc0$X\x1f=%\xd1\x1fN%\xec\x1f\xf9$\x97\x1f\xda#\v\x1fg\"\xf2\x1d\xc0 \xbd\x1c\x81\x1e\x06\x1b\xf5\x1b\xbd\x18^\x19Q\x16\xe2\x16\x18\x14?\x14\xd3\x11\xeb\x10\x8f\x0f\x8f\f\xaf\fe\b\x1e\t\xd4\x05\xe7\x052\x03\xf0\x02\x90\x00\xc4\xff7\xfd\xd4\xfc\xa2\xf8\xdc\xf9k\xf4\b\xf7\xf0\xf0b\xf4p\xee\xfe\xf1\x97\xec\xf7\xef\xe3\xeax\xee{\xe8\xab\xec\x10\xe6B\xea\x03\xe4D\xe8\xa4\xe1R\xe6a\xdf\x05\xe4n\xdd1\xe2Pܯ\xe0<\xdc\xc5߮\xdc\xd7ߩ\xddL\xe0\x8d\xde\xfc\xe0a\xe0\xe1\xe1\x98\xe2\xd8\xe2 \xe5>\xe4;\xe7k\xe6?\xe9T\xe8\x11\xecd\xeaQ\xee\xfa\xec\x88\xf0\x14\xef\x10\xf3L\xf1`\xf5\x02\xf4\xc6\xf6o\xf5\x8f\xf7\a\xf6.\xf8\xce\xf69\xf9\u007f\xf7\xd6\xfa\x84\xf8\xe7\xfd\\\xfa\x98\x01S\xfd\xe1\x03\x8e\x00\x18\x06\xc0\x02\xe5\b\x87\x04\xa4\v\xfd\x06\xa8\r\x8d\t\x04\x0e\xbc\n\x83\x0e\xcd\n\x90\x10\r\f\a\x13\v\x0fW\x14{\x11\xdc\x15\xe0\x12\xcb\x18\xbb\x14\xdf\x1ac\x178\x1b\xa6\x18\xb4\x1a\x16\x18\x9d\x1ai\x17\xa3\x1b\x02\x18\xc0\x1c\t\x1aG\x1d\x06\x1b\xd7\x1d\xf0\x1a\xe1\x1er\x1b0\x1fp\x1cw\x1e|\x1cp\x1c\x12\x1b\x8c\x19\xf6\x18a\x172\x17#\x15\xc9\x15(\x135\x14\x83\x10\x8c\x12T\r&\x10\x9c\n\x01\r\xb0\as\n\x95\x04\xfc\a\xc6\x00l\x04\xd0\xfc\x03\x00\xeb\xf9|\xfc4\xf8,\xfbd\xf73\xfb\xc5\xf6\x9f\xfa\xa7\xf5\xc2\xf9\x86\xf4D\xf9\xd8\xf3\xac\xf8\xbd\xf2\x98\xf7\xf4\xf0\x98\xf5\xd4\xef7\xf4\x9a\xee(\xf4\xab\xed\xc7\xf3g\xed-\xf3\xe5\xec\x9d\xf2\x86\xec\xdc\xf2\xa3\xec\x1b\xf3\x1e\xed\x9c\xf2\x84\xed\x9a\xf1\xa3\xed\b\xf1\xc9\xeci\xf0\x84\xec\u007f\xef\b\xedz\xefD\xed\xbc\xef\x05\xee\xb7\xef\xca\xeeX\xf0V\xef\xc7\xf0\xa2\xef\xb2\xf0=\xef\x84\xf0\x86\xee\xdd\xef_\xee<\xef\x1a\xef\xb8\xef\xbc\xef+\xf0\xc9\xef\xfa\xef\xea\xef\xca\xef\x97\xf0i\xf0\xf1\xf1\xcd\xf1\xdc\xf3\xfb\xf28\xf6\xdf\xf3\xef\xf8b\xf5~\xfbD\xf7\x86\xfd\x11\xf9i\xff\xeb\xfag\x017\xfd*\x03\x9f\xff\x10\x05x\x01\xc8\x06\xfa\x02\x91\b\xc3\x047\nU\x06\xa2\v-\a%\r\xa8\a\xf8\r\xe3\a\x9f\x0e1\bh\x0f\xb5\bu\x10T\tZ\x11C\n\xbe\x11Y\v:\x12\a\f\x8b\x13\xb2\f\xcc\x14\x85\r\xa2\x14\xe8\r\xa4\x13\xbf\r@\x13g\r\xf4\x12\x87\rE\x11\x99\r\x1b\x0f\xe5\fe\r\"\f\b\fj\v\x9f\n\xb5\n:\bh\t'\x06w\av\x04U\x06\x8a\x02T\x05\xec\xff\xe2\x03\x01\xfcv\x01\xea\xf8O\xff:\xf7\xa1\xfek\xf6N\xfeO\xf7<\xfe!\xf8\xbe\xfe\xfb\xf7\x83\xff\xf4\xf6<\xff[\xf57\xfe&\xf3\xbd\xfc5\xf1;\xfa\xa0\xef\xf2\xf7\x80\xee\a\xf7\xcb\xee\xab\xf6]\xf0\xb6\xf6[\xf2x\xf7\xea\xf2\t\xf8\x1a\xf3\x8e\xf7f\xf4\xa4\xf7\xc3\xf5\x18\xf8\xbc\xf6#\xf8\xf5\xf7R\xf8\x85\xfa\x15\xfa\xe5\xfc\xeb\xfc\x96\xff\xbd\xfe\f\x03t\x00Y\x05]\x03\x84\x05)\x05\x93\x05\xa2\x04\u007f\x06\r\x04L\aI\x04\xe4\a\v\x052\b\xdf\x05n\b\"\x061\n\xdd\x06y\vn\b\xfa\v\xb8\b\xb4\f`\bz\rr\b*\r\x05\b\xeb\fk\a7\x0e\xd2\a\"\x10\x1a\t\x95\x12\x87\v\x03\x14\xae\r{\x15:\x0e\x87\x15^\x0e\x8d\x14\xf1\r3\x14\xc2\f;\x13\xcc\v\xe0\x11\x95\n\xaa\x10\x99\t\xed\x0f\x8d\tn\x10f\t\xc1\x0f\x87\t\xaf\r\xb6\b\x11\fj\x06\x11\n\t\x05\xcb\a\x86\x04\x8d\x06\xae\x03\xf6\x04h\x02r\x02\xbf\x00w\x00&\xff{\xfe\xe9\xfe\xa1\xfc\x1d\xfe\xf6\xf95\xfcW\xf6\xb1\xf9v\xf3;\xf7j\xf1 \xf5\xa5\xef-\xf3a\xee\xac\xf1\xaf\xed\xc4\xf0Q\xec\xbd\xf0\xed\xea\xb8\xf0\xd3\xeaQ\xf0\xf9\xe9\xd4\xef\v\xe9\x96\xef\xd8\xe8\xcb\xef\x9c\xe9z\xf0+\xeb\xa1\xf1\\\xec\xfd\xf2\x05\xed\x81\xf4\xc6\xed\xf0\xf5\xa8\xee.\xf7%\xef\x12\xf8g\xef\x95\xf8/\xf0w\xf9\xd1\xf0U\xfah\xf1\x85\xfa\x87\xf2\x9d\xfa\xfc\xf3q\xfb\x8d\xf5\xb5\xfc\xac\xf7\x1b\xfeF\xf9\xa3\xffC\xfaK\x00[\xfb\xce\x00\x05\xfc\xb3\x01[\xfcH\x02s\xfd[\x02\x17\xff\x86\x02\xd8\x00\xcd\x02\v\x02M\x03o\x02\xe6\x03E\x02\xd6\x03e\x02\x8f\x03.\x02&\x03?\x02M\x02\xb7\x031\x025\x05r\x02\xbb\x06\xc5\x02\x80\b\xee\x03\x12\nz\x05\xb6\nu\x06X\v\xef\x06\x87\f\xe9\a\b\x0e\x04\t\xaf\x0f\xe3\t\x13\x11\xc7\n=\x12t\v\x83\x13#\fR\x14a\f7\x14\xc8\v\b\x14G\v\xdc\x13\xdd\nG\x13\xde\t\xd6\x12\xc3\b\x04\x12\x98\aY\x11\xf9\x059\x11\xd2\x04g\x10\x17\x04T\x0f\xbe\x03\xe3\rW\x03\xc1\f\x83\x02\xda\v\xf8\x01\x1a\n`\x01O\ae\x00\xa7\x048\xffa\x017\xfe=\xfd]\xfc\x89\xf8\xac\xf9\xf2\xf3\x93\xf6\x93\xf0\xe0\xf3f\xedI\xf2\xbc\xea\xf1\xf0t\xe8\xb3\xefO\xe5\x02\xee\xbf\xe2w\xec-\xe1\xc1\xeb\xa4\xe0b\xeb\x8e\xe1C\xeb\xea\xe1\xb0\xeb\xdc\xe1O\xec\x98\xe2\xe2\xec\x97\xe3\xa1\xed\x1b\xe5`\xee\xdc\xe5&\xef\x0e\xe6\x06\xef\x81\xe6\x96\xee\xbc\xe6\xed\xee\xb8\xe7w\xefG\xe9c\xf0\x83\xeb\x8f\xf1\xc8\xeeN\xf3\xf5\xf1\x1f\xf6\xf3\xf4K\xf8\xd8\xf7\xe3\xf9\xbd\xf9\xf7\xfb\x18\xfb\x99\xfd\x9b\xfd`\xff\xed\x00\x1b\x02\x16\x04u\x05\x14\a\xdb\bu\t\x95\vP\v\xab\f\xfa\f\xcf\rJ\x0f\x95\x0fl\x11\x8d\x11\x86\x12\xf3\x12\xd0\x13\x1c\x13\xf5\x15\xe2\x13\xe7\x17\xc7\x15\xb4\x18\x8e\x16;\x1b\x13\x16\x1a\x1f\xeb\x17\xa9!@\x1a/#\xbb\x1a\xe2#\xc6\x1a\xdc$%\x1b\xf8%[\x1c\xfe&\xa0\x1d\xae'\x8b\x1d\xe1&\xcb\x1c\xaa&7\x1c\x06'.\x1c\xf2$\xb4\x1b}!y\x18b\x1f\x13\x15%\x1ez\x14\xa1\x1bi\x13\xda\x17\x1f\x10S\x13\x9e\f\x96\x0fz\t\xfb\f\x1e\a\x10\v\xe1\x05l\b\x18\x04t\x05\x98\x00\xcc\x02\xcf\xfd\x1f\xff\t\xfc\xb2\xfa\xc2\xf9B\xf6]\xf6A\xf2>\xf2a\xee\xfa\xeeM\xebL\xed\x90\xe9\xef\xebo\xe7\xcc\xe9\x8f\xe5\xc9\xe7\xd0\xe4\x18\xe7+\xe3\xbe\xe6\xf6\xe0.\xe5\xc9\xde\xef\xe2\xddܖ\xe1\xa2\xdcl\xe24\xddM\xe4\x06\xde?\xe52\xdf\x12\xe6(\xe0.\xe8\x1d\xe0\xc9\xe9\xcc\xdf\xe5\xe9,߮\xe9\xda\xde\xfc\xe9J\xdf\x10\xeb\xb2\xdf:\xec|\xe0\x98\xec:\xe2\x01\xed\u007f\xe4`\xeex\xe6C\xf0\xb3\xe8\x90\xf1\xe5\xea\t\xf3\x88\xecW\xf4\xf4\xed\xc9\xf4\xd4\xee\x9d\xf5\f\xf0\f\xf7\x82\xf2\xa3\xf8\x02\xf6\xd8\xfac\xf9\xb4\xfd5\xfc\xfb\xff{\xfeC\x01\xb6\xff>\x02o\x00\xec\x02\x86\x01!\x03W\x03\x1e\x04\x1b\x05a\x05\xfa\x06\x11\x06S\t\xa4\aB\fH\n\xe9\x0e\xc9\f8\x11G\x0e\x81\x13{\x0fZ\x15\b\x11\xcb\x16\xc4\x11$\x18\x90\x11,\x19\xd9\x11u\x1a\xe4\x12\xd0\x1c\x9e\x14|\x1f\x86\x16\x05!\x99\x17\xce!_\x17\xbb\"8\x17\xa1\"\xd5\x16t!\b\x15\x04 \xb0\x12\x04\x1f\x14\x11t\x1f0\x11\xcb\x1f\xdd\x11\xf7\x1e`\x11\xeb\x1d\xf1\x10\xc4\x1c\xe3\x10\xf9\x1b\x8e\x10\xcb\x1av\x0f\xf8\x17T\r \x14\xc9\nL\x10%\tq\f\x1f\b\x13\b[\x06n\x03\x8c\x03#\xff\x8f\x00h\xfa\xea\xfd\x15\xf5*\xfa\xa4\xef;\xf5\xe7\xe9|\xf0:\xe4\xb2\xecL\xe0c\xe9\xe8\xdd%\xe7P\xdc\v\xe6\x98\xdb\a\xe5\xc8ڰ\xe4\x9e\xda\xc6\xe4+\xdb\x1a\xe5\xf9\xdbK\xe5\xb9\xdc\xee\xe4\xe7\xdd6\xe5\xa8\u07fb\xe6\xc1\xe0\"\xe80\xe2\x06\xe9\xe1\xe3w\xea\x12\xe6\xa5\xec\xc1\xe8\xfd\xee\x95\xea\xa4\xf0\xbb\xeb0\xf1O\xed\xb9\xf1\xf4\xeea\xf3\xc6\xf0?\xf5\xa3\xf3\xf0\xf6H\xf6M\xf9\xfb\xf7\x13\xfc\xc3\xf9w\xfe\x18\xfb2\x00\x05\xfd$\x01\x9e\x00\xd4\x02\x1c\x04\xb2\x05Q\x06\xdc\a\xe2\x06\xb0\bv\a\xd3\b\xcf\a\xfd\b\x9d\b0\t\xa1\n\xc8\t\x10\x0f\xc9\n\x92\x14\xef\r\xe9\x17\x89\x11\xa3\x19\xc4\x12\xaf\x1b1\x13$\x1f\xe0\x14\xb6\"\xf7\x17^%\xe3\x1a\xfe'\xb9\x1c3*\x8a\x1e6+\x9f \xa5+*!\xe1,\x0f!\xda,\x99!\xc2*\xd8\x1f\xdd'\xb3\x1c\xc7#*\x1bf\x1f\u007f\x18\u007f\x1b\xdb\x140\x18H\x12\x1f\x16\x13\x10\x19\x15\xbb\x0e\x99\x13\xd7\r\xe2\x0f\xec\n\x91\n8\x064\x06^\x02\x92\x02\xc5\xff-\xff\xd9\xfc\x10\xfd\xd7\xf9\xb2\xfam\xf8\x99\xf7D\xf72\xf6\x02\xf6\xa4\xf4\xf2\xf40\xf1\xcb\xf2V\xeel\xf0\x96\xeb\xdb\xee\xce\xe7O\xec\x95\xe5\xfe\xe9t\xe5\xc1\xea\xe5\xe5}\xecE\xe7w\xed@\xe9s\xef\xe8\xe9<\xf1Q\xe9f\xf1\x0f\xe8\x85\xf1\xac\xe6\x1f\xf2\xdd\xe5`\xf2\x90\xe5\xa0\xf2\x90\xe5\xd0\xf2\x95\xe5e\xf2\x88\xe5S\xf2\r\xe5~\xf2\x84\xe4\x1c\xf1I\xe4y\xef\xdb\xe3\x95\xeev\xe3\x9c\xed/\xe3/\xec\x96\xe32\xeb}\xe5M\xeb\xa4\xe8\xfa\xed-\xecH\xf1\xb3\xef\xf1\xf2\xf9\xf1\x81\xf4\xea\xf2\x8f\xf5\x99\xf3j\xf5\xe2\xf3D\xf5\xdb\xf4~\xf6?\xf7\xb0\xf8\x85\xfaO\xfb\xdf\xfc\xc3\xfd\xae\xfe\xa9\xff\xdb\x00\x85\x01\r\x03\x03\x03\xc3\x04\x87\x04\r\x06-\x05*\b\x1c\x05\xda\n\x8b\x06\x18\rl\b\xb9\x0f\xb0\tn\x13\x16\f\x1b\x17\xaa\x0f\xf6\x19\xc3\x11\xff\x1b3\x12:\x1c\xb2\x11#\x1b1\x10\xc0\x1a\xff\x0e5\x1bQ\x0e\x02\x1c\t\x0e\x8a\x1cG\x0e\x02\x1d\xbf\x0eI\x1d\x1b\x0f\x85\x1d\x00\x0f\xc9\x1c\xcc\x0e7\x1b\xb2\r\xd5\x1a\xc5\f\xfd\x1aK\rA\x1a\xf4\r\b\x19:\x0e\x01\x17\xc1\x0e\xd0\x13c\x0f\x8b\x10y\x0f+\r)\x0e\xb5\b-\v%\x03\xea\x06\xbc\xfd\xff\x02\xd0\xf8\u007f\x00R\xf4\xa7\xfd\xd0\xf0Q\xfa]\xedA\xf8\xb3\xe9\xd5\xf53\xe8h\xf3\xed\xe7\x03\xf2\xb8\xe7\x9c\xf0\x92\xe8\xfb\xef\xfe\xe9\x95\xf0^\xebo\xf1g\xed\xe4\xf1<\xf0\xfa\xf2\xa7\xf2\xff\xf4p\xf4\x10\xf7\\\xf5\x95\xf8[\xf6&\xf9\x1a\xf7\xbe\xf9\x90\xf6U\xfa1\xf5\xa1\xf9Z\xf4n\xf8\xec\xf4\x8f\xf8\x96\xf5\xcd\xf9\xf9\xf5\xcf\xfaG\xf7\xeb\xfb\x8c\xf8^\xfd_\xf9m\xfe=\xfb\x86\xff\xd8\xfc\x90\x01\xf5\xfb\xf2\x02\x05\xfa\xc3\x01B\xfaJ\x00}\xfb\xab\x01\x05\xfcL\x03\x04\xfe\xf8\x02\xf5\x005\x04\xcb\x00\xa3\x05\x18\x00\xb3\x033\x03\xe7\x02:\x05C\x04\\\x06\xed\x03f\vr\x04\r\x11E\b\xc4\x13\xf4\n\xc7\x16\xa1\v\xc8\x1aR\x0e\x1f\x1co\x11\xdc\x1a\xed\x10\xa1\x19\xc9\r*\x18c\f/\x17\xed\fJ\x17\xb3\rR\x15)\x0e\xc0\x12\x00\f4\x13L\ng\x11d\v\xc6\v\f\t>\a\xc0\x02a\x03\xc9\xfe`\xffj\xfc\xf1\xfdS\xfa\xbf\xff\xbc\xfa\xc8\xff\x06\xfcW\xfe \xfb\xbf\xff\x1d\xfa(\x00\x98\xfa7\xfcI\xf9g\xf8\xb7\xf5\x9b\xf6\x14\xf3\u007f\xf3\xed\xf1\xa5\xf1\xf2\xf0\xfd\xf3\xdc\xf1\x85\xf5\\\xf4t\xf59\xf5d\xf6\x00\xf6\xbe\xf5T\xf77\xf3\xf6\xf5!\xf2(\xf4H\xf2\x9e\xf5j\xf2\xb1\xf8\x1e\xf5c\xfb\xe5\xf8\xb7\xfe\x02\xfa\xc0\x02\x11\xfaq\x05u\xfa8\x06\xdd\xf8\xc2\x05\x8d\xf5\r\x04V\xf3\xd8\x01\xd6\xf1\xbb\x00<\xf0\x1d\x00T\xf0&\xff<\xf2\xa6\xfe\xfc\xf3\xba\xfe2\xf5\xe5\xfe\x0e\xf6\xbe\xfd\xe1\xf5Y\xfb\x8f\xf4r\xf9|\xf3\x1f\xf7\xf7\xf3\xf9\xf4\xee\xf5t\xf6Z\xf8D\xf9\"\xfbv\xfb\xcc\xfd[\xfe\xcb\xfe?\x00z\xfe-\x00\xbe\xfdI\xff\x91\xfc\xfc\xfeC\xfb\xbd\xfe\xf0\xfa\x90\xfd\x9e\xfc&\xfe\xd9\xfe\x14\x01\x03\x01\xdb\x025\x04\xef\x03%\a\v\x05\x95\b.\x05\xa7\b\xf2\x04\x19\b(\x04\"\bL\x02\x18\tV\x01\x91\v\x9b\x02\xbd\x0eR\x04?\x10\x11\x053\x10\xe3\x047\x10\xfa\x03\x05\x10\\\x02\xdd\x0e+\x00\xde\f\xcb\xfd\x95\v\xb7\xfb\x06\f\xe4\xfb\x19\rE\xfe\xf9\r=\x00\x93\x0e\xf2\x00\b\x0e\xf9\x01L\r\x19\x03\xab\f\b\x04\xb7\v\xeb\x03\xae\n\x81\x03\x81\a\xa4\x03(\x03,\x04\xb2\xff\xb7\x04:\xfc|\x03\"\xf8\xfa\x00p\xf4&\xfe\xc7\xf1\xe6\xfb-\xee\xbf\xf9g\xe9:\xf5\xe0\xe5\x18\xf0\x06\xe4\xc9\xed\x1b\xe3@\xed\xf2\xe5\xba\xed\xe5\xeb\xd0\xf0\xc1\xf0\x9b\xf4\xf5\xf3J\xf7\xcf\xf5\xd6\xf8\xeb\xf7\x88\xf92\xfa\xd2\xfaf\xfc\x93\xfc&\xfd{\xfe\x8f\xfc\x18\x00\x99\xfcn\x01\xaf\xfb\x9b\x02\xf1\xf9\r\x03\x8b\xf9\xb3\x01\x17\xfd\xad\x01$\xff\x97\x04\xc6\xfeB\x05\xe6\xff\xbf\x04\xb2\xff\xdd\x06\x87\xfeU\b*\x02\x00\b\x8f\t\xd1\v\x82\f\xfd\x11\x94\v*\x13#\v*\x11\xd8\t\xf0\x0f\xed\a\xfe\r\xd1\a\xe7\v\x1f\tp\vx\b\x99\vJ\bN\v\x91\tn\v\x03\n\xf2\n\xd5\v\xe5\bc\x0f\xef\a\xa0\x12\xb6\b\xf0\x14\xde\b\x06\x17\xde\b6\x18\x0e\vs\x18|\r\xfb\x1aD\x0e\xdb\x1d\x9b\x10\xe9\x1c\x01\x12\xc4\x1aj\x0fz\x19\xd4\r\xb7\x14\x1d\x0e\xbf\rK\n\x86\n\xfa\x04l\bN\x03L\x05\x8f\x01\xd2\x02v\xff\x89\x00}\xfd8\xfd\xad\xf9\x16\xfc\u007f\xf5_\xfd\x18\xf5 \xfcH\xf5\xca\xf9\xb7\xf2\x17\xf9S\xf0\xf3\xf7H\xf0\a\xf6\xdc\xf0g\xf5F\xf0T\xf5\xb8\xef\xc0\xf2\xc4\xee\x93\xef\xa0\xeb\xc4\xec&\xe9.\xea\xdb\xe7\xcb\xe8\xde\xe7\t\xe9\x16\xea\xb9\xe9o\xec&\xeaZ\xed\x0f\xebH\xee@\xec\xbd\xefE\xed\xc5\xf1\xca\xee=\xf5l\xf1\xf1\xf8]\xf4R\xfd\\\xf5W\x01\x9d\xf4\xde\x02\xb7\xf2y\x03\xcd\xf1&\x04\xba\xf2\x90\x04b\xf3\xd4\x04m\xf2\x89\x04\xb9\xf1\xe3\x02\xc0\xf1\xa2\x00\x05\xf1|\xfe\x1c\xf1\xe4\xfc\xd0\xf3\x9e\xfcE\xf7\xc1\xfdT\xfa\xfd\xfe\xab\xfc\xc3\xfe\x1c\xfe\xca\xfd\xc2\xfeV\xfd\xf3\xff\x19\xfe'\x02\x0f\x01\xe2\x03$\x04<\x04\xf2\x05\x8a\x02\x1f\x06\x16\x00C\x05'\xffb\x05\xe9\xfe\xe6\x05O\xff\x89\x053\x01\x84\x05\xce\x03T\x06\x82\x04\xee\x06v\x04\xca\x06\xa6\x05\x03\a\xff\b\xd5\a\xce\v\r\n\x8b\r\xf9\n&\x10~\n\xd6\x11\xe1\t|\x12\xe5\b&\x13\x00\b\xd9\x13b\aY\x14\x8f\a\xd4\x14g\ay\x13H\x06\x17\x11\xb3\x03\xf7\x0f\x00\x01c\x0f\x06\xff\xf6\x0e\xdf\xfcl\x0f\\\xfc\xa0\x10\xe1\xfcF\x0f1\xfd\x02\vW\xfc\xf3\x06W\xfb\xbe\x01\x02\xfbp\xfeY\xfa\x8d\xfd\xf8\xf9C\xfb\xed\xf8\xcf\xf7\xbd\xf7\xb6\xf3\x1b\xf7/\xf0\xe9\xf6\x91\xeb\x86\xf5$\xe9m\xf3\xab\xe9W\xf3P\xea\xf2\xf3\xe0\xebZ\xf4\x9f\xef\xfb\xf4\x86\xf3\xa3\xf6\xf2\xf5\x88\xf7\xe9\xf9\t\xf8G\xffi\xfau\x00\x89\xfdi\x00o\xfe\xcb\x000\xfe\xad\x00\xc3\xffL\xffY\x00\xb8\x00\xbc\xff?\x02\x14\x016\x02\xf4\x01\xe2\x02\xea\x01\xaa\x01H\x03\x9b\xff\x94\x04\x98\x00\x85\x04j\x02\xef\x06\xd6\x02\x19\t\xae\x03\x8c\t\xd6\x06<\v(\x06\x98\rN\x03\xe5\f\xf2\x02\xcf\n\xe2\x02\x00\v\x17\x02W\f\xac\x02S\r\x02\x02\x17\x0fI\xfe\xd2\x0e\xe0\xfe\x1b\f\x9f\x04\xd2\f\xf1\a\b\x0f\v\bP\x0e\x9e\v\x86\f3\x11e\x0f\r\x12t\x12f\x12\xcc\x10\xe7\x14\xba\x0f\xda\x16\xd2\x10\xb1\x17A\x10%\x17\xd4\r\x9a\x15\xba\vS\x13\xef\t\xf3\x12#\t*\x10\xcc\t\x97\nn\a\xbb\x06\"\x03:\x03\x95\x00I\xfe\xa0\xfe\xb1\xf9U\xfa7\xf8\xee\xf5\x8f\xf7\xb8\xf4\xdc\xf7f\xf3\x0e\xfa\x88\xf2\xea\xf8\xc6\xf3\x05\xf5\xf9\xf2\x16\xf4j\xef\xef\xf5\xbe\xee\xc0\xf43\xf0/\xf2e\xee\x97\xf2\xf8\xeb\xcb\xf3\xe5\xeb\x93\xf3\a\xed\x8c\xf3#\xee\x82\xf4`\xefO\xf5&\xf0\xa1\xf5D\xf0\xa1\xf4\x0f\xf1e\xf3a\xf0I\xf5\x8e\xef/\xfa\xaa\xf2\x96\xfc\x03\xf8\x1f\xfd)\xfa(\xffs\xfaD\xff\x85\xfd\xbc\xfaE\xff\xeb\xf5r\xfd\xed\xf4\xe0\xfc\xfd\xf4]\xffB\xf4\a\x01\x8b\xf3\xa3\x00<\xf3\xd8\x00\xa8\xf3\xab\x01\xa4\xf3(\x02\xb6\xf3\xf4\x01\xde\xf4o\x01u\xf6\xa3\x01\xc7\xf7\xd7\x01\xbc\xf7\x05\x01\x8d\xfa\xb1\xff\n\xffK\x01#\x01.\x05\xa3\x04\xb0\x06|\a/\a\x02\a\xa8\a\x83\x03\x96\a\x9c\x01\x0e\x05\xd9\x02V\x04\xb4\x03\x04\a\xc5\x04:\bo\x06\xa0\a\x94\b*\a\xf1\al\bO\x06\x98\b\x1d\x06\xaf\a\xfc\x06\xf8\x06\xd9\a\xfc\x06\xb4\x06\r\a\x15\x06\xa3\x05\x98\x06\xb7\x03\xf4\x06\xe4\x02-\a\xe1\x03\xd3\x06\xa5\x03\r\x06\xb7\x016\x03\xd3\xff\x1f\x00\xab\xfd\xed\xfd\xb5\xfaf\xfc\xc3\xf7\xd1\xfe\xa1\xf7\xe0\x02>\xfa\xf4\x04\xa8\xfbe\x04\xcf\xfar\x02M\xf9\x01\x01N\xf8l\xff\xfe\xf6a\xfd~\xf5r\xfd\xb8\xf4\xe1\xff\x14\xf6c\x01X\xf8\xf9\xfe\xa9\xf8A\xfb\x04\xf8G\xfaG\xf9\xd0\xf9n\xfa\xda\xf6L\xf8\x8b\xf4k\xf5\x1f\xf5\x87\xf6l\xf7K\xf9l\xf9b\xfa*\xfb\xf3\xfa\x1c\xfc\x18\xfc\xd7\xfdG\xfci\xff\xa6\xfc#\xfe\xbe\xfb\xb5\xfb\x9c\xf9[\xfb\x1a\xf8:\xfd\xf7\xf8R\xfe\xc9\xfa\x1b\xffH\xfc\xc8\x00\xa3\xfeR\x01l\x00\xa5\x01\x1e\x01\xda\x02\xe4\x02\xed\x03\xdf\x05\xeb\x05\xef\a3\v\x98\n?\x11\xff\x0f!\x14}\x15\a\x16O\x18\x94\x16m\x19\x97\x154\x19\xd4\x12\xd8\x17\x9f\x0e\x06\x15y\nz\x11\x9f\t\x92\x0f\xae\n+\x11V\t\xf2\x12\xd1\x06:\x11x\x06\xa7\x0e\xa4\x05\xe3\r\xfa\x01\xf1\f\xd1\xff\xce\t.\x03\x9d\bR\x06\xc4\nY\a\x01\v\xe2\b\x12\v\xd0\t\xf0\vT\t\xa0\n\xcb\b#\bz\x06\xf9\x06\x8a\x02\xe4\x03\xc7\x00\xaf\xff\xfc\xff|\xfe\xb3\xfd\xf2\xfd]\xfco\xfch\xfc\xbb\xfb\x04\xfb\x1c\xfb\xf9\xf8\xee\xf8\x97\xf7\xf4\xf6\xaa\xf4\x94\xf5\xad\xf1\xa7\xf1J\xf1\xde\xedm\xf3\xf7\xed<\xf6\x83\xf0a\xf8-\xf2\t\xf9R\xf1\xdc\xf6C\xef!\xf2\x1f\xedt\xee\x97\xea?\xed\xf1\xe7\v\xec\x88\xe6O\xebZ\xe5(\xed\x06\xe6\xd9\xef=\xe97\xf17\xebn\xf1d\xeba\xf1\t\xec\xab\xf1~\xedN\xf4q\xef\xb6\xf7/\xf2\x1f\xf9\x12\xf5\xfc\xfa;\xf7\n\xff\xc3\xfa\x19\x02*\xff\xcf\x01\xee\x00\xb4\x00\n\x01\xd0\xff\xf8\x02\x16\xfe\xd1\x04\xc5\xfb\xa4\x038\xfae\x02\xf3\xfaa\x03v\xfe\xbb\x05\xfd\x01\x9f\b\x93\x03|\n\xe3\x02\xe9\n\x1d\x02|\n\x17\x02\x83\n\u007f\x01q\n\xf5\x01\x1b\t\"\x04\xf3\be\aF\nk\t\x06\f\xb2\nM\rS\n\xb6\r\x9d\b\x11\f\xf9\x06\x1c\nn\x04\xf9\b\\\x03l\a\xda\x04N\x06\xad\x06h\x06\xf7\x06R\a\x8e\a\xef\a'\t\xfd\bD\b(\t\xc1\x05O\a\xe1\x03w\x05h\x02\xcc\x04\x9f\x00\xcd\x03\x00\x00:\x02\xf8\xff|\x01\x86\xff\x06\x01\x84\xfe\x05\x00\v\xfd\x98\xfe\x8a\xfam\xfd\xec\xf7\xd5\xfa\xbd\xf4y\xf7_\xf2\xb7\xf4\a\xf4?\xf30\xf6\xf9\xf2\xc1\xf7\xd4\xf2o\xf8\xb9\xf3b\xf9%\xf4\xf1\xfa\n\xf4S\xfa\a\xf3\x91\xf7\xba\xf0%\xf8T\xef\xd8\xfb4\xf1;\xffT\xf45\xffo\xf6\u007f\xfe\xcb\xf6n\xff\x1a\xf8w\x00\x99\xfa\x12\x00^\xfb\x9e\xffc\xfa\xde\xfei\xfa_\xfe\x95\xfb&\xfe\xb9\xfc5\xff\xa2\xfc=\x02_\xfc\x00\x06\x9a\xfe\xc9\x06\xd3\x01\xb9\x05\xd2\x01s\x06\x88\x01\x9c\a\xfb\x02s\b\xa9\x03\x8c\n^\x04\xe4\x0e\x89\a#\x13j\f\xdd\x16\xaa\x10\xaa\x18\xd9\x13\xac\x16\x8e\x15\xcc\x12\xe1\x14c\x10\xc4\x11\n\x11y\x0f\xe6\x10O\x10\x8f\x10\xd9\x11\x02\x14p\x13\x1c\x15\xb7\x15+\x10\xa1\x14g\v\xaa\x10o\nj\x0f\xc9\n\xac\x0f\xda\n\x01\x0f\x87\n\xdd\x0f\x8b\nR\x110\f\x82\x12\xc1\r'\x14e\f\xa8\x14\x94\aW\x12`\x03g\x0eK\xff\x9b\n\xb3\xfaz\x06\xa6\xf7^\x02n\xf7\x91\xff\x9b\xf7\xff\xfd?\xf7\xeb\xfb\xc2\xf6Y\xfa7\xf5\x9c\xf9\xa9\xf2\x00\xf8\x91\xee\xcd\xf3\x0e\xea4\xee\xa3\xe8\xbd\xea\xce\xeaj\xeb\xa5\xeec\xee\x15\xf10\xf1\xdd\xf0\x8e\xf2\xa3\xef\x95\xf2_\xed\x9e\xf1=\xe9\xa4\xed=\xe5\xaa\xe77\xe4T\xe4\xc9\xe5\xad\xe47\xe9\x95\xe6\xaa\xed.\xe9\x97\xf0&\xec\x84\xf0\x16\xed\x8e\xef\xaa\xeb-\xee)\xea\xb3\xecO\xe9Y\xef\x9e\xea#\xf4\xcf\xeem\xf8\xb9\xf2d\xfb\x99\xf5(\xfev\xf8\xe8\x008\xfbb\x01A\xfdZ\x00\n\xfet\xff\x19\xfe\xdf\xfd\xb2\xfc\x92\xfc\\\xfa\xa9\xfd\x97\xfa\xb8\x00\xae\xfd\v\x05\x80\x01\xaa\bi\x05\x00\nA\b\xaa\n\x01\n\xcb\n\xda\n\xc8\t\x02\n\xbe\b\xf8\aC\nM\bA\rV\f\x17\x10[\x11\x9e\x13\x05\x15.\x15\x9d\x16\xc1\x11i\x15a\r\xbd\x11\xef\n\a\x0fP\n\xa1\r\xf0\nX\r\xcd\vD\rz\r)\rO\x0e\xf4\r\x19\x0f\x98\r\xc5\x0f2\r\xc3\x0eH\r\xc6\f4\r\x92\nD\v\xe6\b\xfa\b\xf6\x06\x8f\a\x87\x04l\x06t\x04\x8e\x05\xa1\x05\xd0\x05\xd3\x03\xd6\x05~\x01\xa1\x04\xc8\xfe\x99\x03r\xfb3\x01E\xf8\xfa\xfc\xca\xf5\x8d\xf8r\xf6\xa6\xf6\xcb\xfa\xac\xf8r\xfe\x94\xfc/\xff\x9c\xfeG\xfe\t\xfe\xe9\xfa\xec\xfc\xe3\xf6\xac\xfa\x98\xf7\v\xf8\xaf\xfa\xee\xf71\xfd)\xf9\xec\xfd\xc2\xf9\x00\xfd\xc4\xf9\a\xfb;\xf95\xfa\x13\xf8\\\xfbm\xf7\x01\xfb\xf7\xf6\xf9\xfa\xfa\xf4\xda\xfa'\xf5\xa9\xf9]\xf6m\xfa3\xf5\xca\xfb\"\xf5\xc7\xfc\x8e\xf6\x89\xfe\x1d\xf9\xfc\xff\b\xfd>\xff\x9a\xfe\x97\xfb\x96\xfd\xcc\xf9\xab\xfb'\xfag\xfa'\xfb(\xfa.\xfd2\xfb:\xff\xd0\xfd\xb3\xfe\xd6\x00\x82\xfc\x9e\x01}\xfbg\xff\"\xfe\xa8\xfe\x1a\x017\x01\x05\x05\xba\x02\xfd\b\x9d\x05\xba\t\x04\tF\f\xbd\n\xbf\x0f\xcb\x0e\xce\x10y\x11\x17\x12\xf3\x10\xa3\x11H\x11i\x0f|\x11\x8b\f_\x10\xc5\vL\x0f\xc1\f\t\x0fS\v\xb1\rN\b1\v\x13\x04\xdc\a\x95\xff\x8f\x05\xb9\xfd\x8e\x04\xad\xfd\xd1\x03t\xfe\xaf\x02h\xfeO\x01\x86\xfd\x81\x00\xbe\xfc\x12\x01\xe1\xfc\x99\x01\x15\xff\xeb\x02\x81\x01v\x04\x87\x01x\x04\xa1\xff\xd9\x03F\xfd\xaf\x01,\xfc\xe8\xfe\x9b\xfb|\xfda\xfb(\xfc8\xfc\x8d\xfb\xdd\xfb\x80\xfcQ\xfa,\xfc\x94\xf8\x0e\xfa\xc0\xf6R\xf8\xc5\xf6$\xf7\x9f\xf9|\xf6\xa0\xfc\xb0\xf8\xb2\xfd\x87\xfbb\xfe\x99\xfb\x02\xfei\xfb\x85\xfb\xba\xfa\"\xfa\xb4\xf8\xdc\xf9'\xf8%\xfa%\xf8\x86\xfa4\xf8M\xf8\xf3\xf7\xde\xf5\xeb\xf5\xc8\xf4\xa3\xf3\xd8\xf3\b\xf2\xf5\xf3c\xf1\x96\xf4\xbf\xf2\xba\xf6\xed\xf4F\xf84\xf6\xac\xf8\xd6\xf6q\xf9\xa6\xf6;\xfbI\xf6\x04\xfe{\xf8\x1f\x00\xc7\xfb\xe2\x02\x1c\xffO\x05\xf3\x02\x06\x05o\x04G\x04\x18\x03v\x04\xe5\x02Z\x04y\x03^\x05\xf6\x03\xdf\x06\x12\x05Q\x061\x05S\x04]\x04\x90\x03#\x04\xd3\x02\xb2\x04O\x03~\x05\xea\x05\xaa\x06\xef\x06\x1c\a=\x062\a\xb9\x04\x85\x06\xb9\x029\x04\"\x01\x96\x03\x19\x00\xc5\x03\x92\x00\xac\x02\xbb\xfe\x1c\x02\x1c\xfd\x84\x006\xfb\x12\xff^\xf8\xfa\xfc\v\xf8P\xfbj\xf6\xd8\xfa5\xf6Y\xf9\xcf\xf6\x9e\xf9\xcc\xf5~\xf9<\xf7b\xfa\xc3\xf7\x94\xfc\xf0\xf6\xdb\xfc+\xf7O\xfd\xf8\xf68\xfd1\xf7\xb9\xfc\xaa\xf7\xcd\xfc\x04\xf9H\xfd\xc4\xf8\x18\xffe\xf8l\xffp\xfa\x17\xff\n\xfb\xc9\xfeo\xfb\xbb\xfd\xcf\xfb\xd9\xfc\xa4\xfb#\xfcf\xfc\x96\xfb{\xfd\xbe\xfa\x85\xfe\xe2\xf9\b\xff\x1e\xf92\x00b\xf8B\x004\xf9\xf9\xff\xd1\xf9\xf6\x00G\xfb\xb5\x00\x02\xfd\xeb\x00\xaf\xfc\xee\x02\xce\xfc<\x06W\xfe\x91\nk\x01\x19\x0e8\x06#\x11\x1d\n\xe2\x14\xd9\rQ\x17W\x11\xb6\x18h\x12\x16\x1a\xb3\x12\xc8\x1b\xff\x13#\x1b\xa3\x15\xf5\x18\xd2\x14\x97\x17H\x13\x04\x16\xcb\x12\x9d\x15C\x12\xd4\x13\xe5\x11\x14\x0fu\x0f\xab\rE\f\xd4\r\xa2\f\x99\r\x80\r\f\x0fP\f\xa8\x0e\x06\v\xdc\x0e:\v\a\x11\x8b\fE\x12\x13\x0ek\x118\x0e\x16\x0e\xe8\v,\v~\ta\b\xeb\az\x04\xae\x05?\x02\x99\x03\x97\x01f\x03\x19\x01{\x03\xc4\xfeX\x02V\xfb\x8f\x00\xe0\xf8\x05\xff\xc4\xf5h\xfdK\xf3\x9c\xfbd\xf2\xd0\xf9\x18\xf1\xf7\xf7\x89\xf0w\xf6\xb7\xf1B\xf6\x84\xf1\xeb\xf6\xfa\xef\xa8\xf57\xf0\x19\xf3u\xef\xe6\xf1J\xebt\xf0\xf9\xe7Y\xed\xe6\xe5\xb7\xea%\xe36\xe8\xfa\xe1\xd9\xe5\xbc\xe2-\xe5\xfb\xe3\"\xe6\xc2\xe4\xdf\xe67\xe4\xd2\xe6\xb9\xe2\xce\xe6\xc9\xe1\xa4\xe6\x05\xe3\xcd\xe6\xf6\xe5\x98\xe8\xdb\xe9|\xea\x1f\xed\b\xedZ\xf0P\xf0T\xf3\xf4\xf3:\xf3\"\xf6\x16\xf2\xb3\xf5\xbc\xf1{\xf5Y\xf1M\xf6\t\xf2\xc4\xf6\xf4\xf3\xe8\xf7f\xf6\x85\xfa\x80\xf9\xd7\xfd\xa0\xfd%\x01/\x01\xd0\x03\xe3\x02\n\x05k\x05S\x06\x1c\b6\b\xcf\b\x13\t\xa4\tq\b\xb8\v\xb5\bn\x0e\xd5\n\"\x11\x85\f\xd9\x12x\f\xec\x12<\r\xc7\x11\xb4\r~\x0f\xc6\v\xa9\fL\n\x1f\f\"\t\xb7\r\x80\b\x16\x10`\t\xf8\x12\n\f\x1b\x14\x9e\x0e.\x15\b\x10\x8c\x17<\x12\xf6\x17G\x13\v\x17\xfd\x12\xf8\x15\xce\x12\xd9\x16\xa5\x12\xdb\x18P\x14Y\x19`\x15\xc5\x18\x9d\x15\xfd\x16\xe0\x14{\x15\x93\x12-\x13.\x11g\x0f\xe0\r\xeb\f\x83\t#\v\xf7\x06\x19\f\xca\x05>\x0ei\x06\xa4\r\x15\x06\xc4\vJ\x04;\n\xc3\x01F\b\x82\x007\x06\xb1\xff\x94\x05#\xfe\xd6\x04\xd1\xfd%\x01T\xfc\xa1\xfe\xad\xf9Q\xfb\xa3\xf9Q\xf84\xf8\x92\xf8&\xf82\xf8Q\xfa0\xf5h\xfa\b\xf2\xd0\xf8\x9b\xf0\xb7\xf7?\xf0\b\xf7-\xf1\xd8\xf6X\xf3\xf8\xf7I\xf4\xbf\xf83\xf4G\xf9C\xf3\v\xfa\x02\xf2`\xf8\x14\xf1-\xf6\xe7\xf0I\xf4\xe6\xf1\x01\xf3\xe7\xf1\xb5\xf3\x12\xf0\xbd\xf3\xfa\xedL\xf24\xeca\xf0!\xeb\xa2\xee\x81\xeb\x8a\xeeY\xec\xdc\xee\xcd\xecu\xef;\xec7\xf0]\xebM\xf0'\xecX\xf0D\xee<\xf1\xce\xf0:\xf3\xb5\xf1\xd0\xf5\xe8\xf0q\xf7\a\xf2_\xf9G\xf2\x0e\xfc\xeb\xf1b\xfc\xfa\xf2\xf8\xfbj\xf48\xfd\x16\xf5\xb7\xfd\x1a\xf6\x9f\xfe\x00\xf8!\x02\\\xf9\v\x04z\xfb\x02\x05\xf3\xfe\x9f\x05Q\x01I\x04N\x03\xb6\x04\x82\x05\xa3\x06c\b\x96\au\vq\b\xac\r\xaf\b\xa4\x0f\xab\b\xa6\x10l\tp\x10\x95\t\xed\x10\x9e\b{\x10\x89\b\x15\x0fO\t\xdc\x0e\x00\t\xad\x0e\xb4\b\xe0\rh\t2\r#\t*\f+\t{\v\xc1\t\xca\v\x90\tG\f\x90\tS\r\x86\tl\x0e\xaa\t\x94\x0e#\n\xda\x0eR\n\x14\x0f\xc6\n\\\x0f\xfa\v\xf0\x0f\xed\f\xb7\x10\x9a\rg\x11+\rk\x10\xac\vi\x0e\xb5\tA\r7\b\x9c\rf\a\xf9\x0ec\b\x9b\x0fB\t\xed\x0eM\ba\r\xa0\x06\xc7\v9\x04\x9d\n\n\x02\x15\n\xa7\x01\xc3\t\x13\x02l\b\xdb\x01^\x06u\x00\xd2\x02\xe2\xfe\xf0\xfe\xb0\xfc<\xfc\x9d\xfb\x93\xfa{\xfb\xb8\xf9\xfd\xfa\xfb\xf8q\xfa\x97\xf5u\xf9`\xf0\xd9\xf6A\xedu\xf4)\xed\a\xf4\x98\xed\xf7\xf4U\xee\xe3\xf5\xa5\xee \xf6\xc3\xed\xeb\xf5}\xee\xd9\xf4\xf5\xf1_\xf5\xad\xf4\xa2\xf7\x8f\xf6\x1a\xf9{\xf8\xf9\xf9E\xf8P\xfaN\xf8<\xfa\x92\xf8q\xfa\xac\xf8\xbd\xf9\xf3\xf8y\xf9i\xf8\x9d\xf82\xf8\x14\xf89\xf77\xf8{\xf4\xb9\xf6X\xf1d\xf4\x8d\xee9\xf2t\xec\xdf\xf0\xbc\xeb\xa3\xf0\xe0\xec\xbc\xf1\xa7\xedo\xf3\xc8\xeb\x14\xf4\xca\xe8\xbe\xf2\x94\xe5\xd1\xf09\xe3m\xefp\xe3R\xef\v\xe5b\xf05\xe6\xc4\xf1\x13\xe8\xf3\xf2\xe6\xeac\xf5\x8f\xec\xd6\xf7\x9c\xeeq\xf8\x16\xf2k\xf8\xfa\xf5>\xf8o\xf81\xf9Y\xfaU\xfa\xe7\xfb\n\xfb3\xfe\xa9\xfcZ\x02_\xff\x84\x06\xc3\x01B\n\xfc\x03\u007f\v\x1c\x05F\v\x18\x05\xfd\na\x05\x97\n|\x06K\f\xa3\a\xb1\x0e\xe8\bz\x0f\x9b\n\xce\x0f\xe6\nJ\x10s\v\xc5\x10\xa1\f\xc9\x11\xf6\f}\x12\xd5\f\xf4\x13\\\r-\x17i\x0f\x98\x19\xd7\x11[\x1c\xc7\x14\xaa!\x17\x19\xed'\x9c\x1e\xa2,\xaa#\xd6-\xfa$\xb0+=#$(\x80!\x16&\xea\x1f\xb8$\xcd\x1e\xeb\"-\x1d\xa4!\xd1\x1a5!\xc3\x19\f \b\x19R\x1dn\x16\xaf\x1a\xec\x12\x1e\x18\x01\x100\x16\xab\r\xf0\x15\xc4\f\x8f\x14`\f\xbf\x13\xd4\v\xb9\x14g\f\x06\x13/\r\xac\x0f\xbe\f\xa9\v\x1b\vn\x06\xc9\ar\x01=\x04S\xfa\xc3\xff\xbe\xf4N\xf9\xf0\xf2k\xf5\xce\xf0(\xf4\x1f\xef\xa3\xf2o\xee\xee\xf1\xdf\xed\xb3\xf0\xaa\xec\xc1\xed\xc5\xe9\x99\xea>\xe6\x97\xe7\x15\xe4\x19\xe5\xef\xe4\xb4\xe4d\xe6\xc0\xe6\b\xe6\n\xe9\xd1\xe6q\xea\xc2\xe7\n\xecq\xe6H\xec`\xe2\xa1\xe9\xd3\xdd9\xe6[ڴ\xe3\x06\xd7\x10\xe1 ם\u07b3\xd8\x02\xde^ڦߏܻ\xe1\x02\xde\xc7\xe2\xc4\u07bb\xe3j\xdf\x15\xe4\x1a\xdf?\xe4\x17\xdfa\xe4\x9e\xe2\x9a\xe5W\xe7$\xea\xc9\xecO\xf0)\xf0r\xf5+\xf0\xf7\xf7+\xf1\xa1\xfa\x1d\xf29\xfd\xa0\xf2\xbd\xfd\xc0\xf2\n\xfd\xd0\xf2B\xfc\xf9\xf4|\xfd\xaf\xf8[\x00\x86\xfd:\x04\xb3\x02\xc9\b\xc7\a\xf1\f\xec\v\xe4\x0f\xde\r\x80\x10P\r3\x0f\x91\f\xee\r{\r\x12\x0e\x84\x0f \x10\x87\x12/\x13[\x15\xad\x16\x95\x16N\x18\u007f\x15\xa2\x17_\x13\x13\x15B\x11\x83\x11\x97\x0f\xf7\r\x82\x0f\x1a\vx\x10&\n\xef\x12\xd9\n\x8f\x16\x00\r\xfd\x18\xfc\x0e\x16\x1a\x83\x10d\x1aI\x12~\x19C\x12M\x18\x8a\x10\x8a\x17\xa5\x0e\xc5\x17*\x0e\x8a\x18B\x10D\x193\x12p\x19=\x13\x8b\x1aC\x14\xdb\x1b7\x14\xc2\x1a\xb5\x12_\x17#\x0f/\x14[\n\xc6\x12\\\a6\x135\x06*\x12\v\x053\x0f=\x02\xaa\x0e\x85\x01d\x0e\"\x03O\f\xc2\x02\x04\b<\x005\x03>\xfb\x01\x01\xa5\xf7q\xff\xc7\xf6(\xfe\xd9\xf5v\xfd\x98\xf6\t\xfe\xb4\xf8\xba\xfd^\xfb\xb3\xf9\x1e\xfb\xdf\xf4\xa9\xf6\xcb\xf2L\xf2\xee\xf2O\xf1+\xf2\xfb\xf1\x1a\xf0R\xf1\xbd\xee\x03\xf0Q\xef5\xf0\x1e\xf0\x81\xf29\xee\xbb\xf4\xd9\xec`\xf4I\xee\x1a\xf4\t\xef\x04\xf5\xad\xed\x97\xf4\x8b\xeb\xdb\xf2/\xec)\xf2q\xeef\xf3\xc1\xed\xc7\xf4\x90\xec\x17\xf6+\xeex\xf7\x92\xf0F\xf8\xb8\xf0\xc3\xf7r\xee>\xf5h\xecL\xf3\x13\xed\xd2\xf3$\xefl\xf5R\xef\x82\xf6\x1e\xef\xc7\xf7\xcd\xf0<\xfa\xe9\xf0;\xfc}\xee\x92\xfb\x16\xed\xaa\xf9\xa2\xee\x9c\xf9\xe4\xf2\x82\xfco\xf5\xf6\xfd\xce\xf6 \xfeq\xf8\u007f\x00\x9e\xf9\xcc\x03\xb1\xfa\xd4\x05I\xfb2\x05\t\xfe\xe1\x04\x15\x02\xca\x06\xb4\x027\a\xb9\x016\x05\x90\x02S\x04N\x06\xff\x06J\n\xcd\nH\v\xc8\v\xf8\n\xfe\nl\fZ\v\xde\fQ\f\x1c\f\xb6\v\xa9\v\x9c\bO\fB\x06\xbf\r\v\aO\r\xd2\a\xd9\v1\b#\f}\te\r\xe3\t\xb1\f]\bJ\n\x81\x05\x93\t\xda\x02F\f\xa5\x03\x81\x0fW\x06\xf9\x0f\x0e\b\xe9\x0e\x8e\t\x98\x0e\xa5\n\xa6\x0e8\n9\x0e-\t\xe4\x0e\xfc\bV\x11-\t\x13\x13\xa4\t@\x12\"\t\xfd\x0f,\am\x0e\a\x06\"\x0ef\x05\x85\x0e\xa1\x04k\x0e7\x049\f+\x03\x15\t\\\x00\xde\x05\x9b\xfcR\x03\xb9\xf9H\x03m\xf9\xbe\x03\x86\xfa\xa9\x029\xfb\x89\x00\xbb\xfbL\xfe\xa0\xfb\xc1\xfc\xc7\xfb\xad\xf9\xa6\xfa\xe9\xf5^\xf7\xac\xf4\x99\xf5A\xf6\xea\xf5~\xf7R\xf7\xb1\xf6\xef\xf8\x87\xf4\x9b\xf9r\xf4\x96\xfa\xe6\xf5\xb9\xfb%\xf6\xff\xfaC\xf7\x8f\xfa\xd0\xf8C\xfb+\xfax\xfb\x1c\xf9d\xfb9\xf6=\xfa\x85\xf6?\xf9\x1f\xf8\x8b\xf9\xc0\xf8\x04\xfaE\xf6K\xf9\a\xf3\x03\xf7~\xf2\r\xf6$\xf2\x93\xf5\xba\xf1}\xf4\xb0\xf0\xdd\xf3\x90\xf0\x1c\xf3\xac\xf0@\xf4\x9a\xee\xd3\xf5\xd7\xeaq\xf6\x19\xe7\xba\xf5\x93\xe5\x1d\xf3$\xe4n\xf0\xf0\xe3\xed\xee\x1f\xe6\x8b\xf0~\xe7\xaf\xf3\xd0\xe6D\xf5\xdf\xe6`\xf5\xa0\xe8\xef\xf5e\xec_\xf7\xeb\xef0\xf9W\xf1\xb1\xf9\xeb\xf2a\xf9'\xf5\x1e\xfa\xdf\xf7\\\xfb\xc9\xfaV\xfca\xfd\x96\xfd>\x00\x18\x00C\x02o\x02B\x02\x8a\x03\xa8\x02\xe8\x03\xe1\x04\xfc\x04j\aE\x06%\t\xd5\x05\xb3\tP\x04\xb7\v\xc7\x04\xae\r\xaa\b\xee\fR\v.\fP\n\xc9\f\xe5\b\xca\x0e\x83\t\xed\x10\xca\v\x91\x12\xcf\rb\x15c\x0f\x93\x19\xe5\x11\xb3\x1c\\\x15\x1b\x1e\xa0\x18\xa7\x1fz\x1au\"{\x1cL%6\x1fm%\x9b\x1f\xf5#L\x1d\x84#\x89\x1a\xa2#\x8d\x19\x1d#\x84\x19p\"7\x19\x19\"\xd7\x18\x93!\xe2\x17\xe5\x1f\xa0\x16\xf5\x1d\xb8\x14y\x1ch\x12Q\x1c\xa0\x10N\x1d\xec\x0fT\x1c]\x10\x9f\x1a\x85\x10\xee\x19\x12\x107\x17\xf9\x0e\xd4\x11\xba\fI\f\xc8\t\xb8\b\xfb\x06{\x05\xbc\x03\xda\x00\x10\x00\x88\xfc\xfb\xfb\xff\xf7\xfb\xf7\xd7\xf4\xdc\xf4\xc6\xf3j\xf3\x89\xf1\xb0\xf2\v\xef\x01\xf2j\xedv\xf0\xbc\xeb3\xee\x16\xeb\xab\xeb\xd7\xea]\xea\xb4\xeb\x18\xebE\xeb\x14\xec#\xe9\x99\xec0\xe8\xeb\xeca\xe7/\xec\xe3\xe78\xec\x8e\xe7\x14\xec\x8d\xe5\xe2\xe9\x0e\xe3\x8f\xe7\xd0\xe0\xa9\xe5\x9b\xdfy\xe4P\xdf\xd1\xe3i\xe0\x1b\xe4|\xe0\xdb\xe4\xceޱ\xe5,\xddF\xe6,ށ\xe6\x06\xe1B\xe7\t\xe3\xfc\xe8\x1c\xe4\x19\xeb\x87\xe4\xdd\xec\xcc\xe5\xd5\xee\x99\xe7\xdf\xf1\xdd\xe8$\xf5h\xea\x9a\xf7D\xed\x06\xfai\xef\xdf\xfb\x0e\xf0\x8e\xfc1\xf0\x86\xfc\x99\xf0R\xfc\x10\xf3\x1f\xfd}\xf8H\x01\xaa\xfe\xbe\x06\x90\x04d\nS\b\xdc\f\xd0\b\"\r\xa4\b\x16\rL\t\x81\r}\f\r\x0e\xad\x0f\x11\x10\xdb\x10e\x11!\x11#\x12\x8b\x0f\xf9\x11\xd9\r\xe7\x10\xac\r7\x10\x1b\x0e]\x0f\x06\x0f\x13\x0e\xcb\x0f\xa6\f/\x10\xb1\v\xfa\x0f\x03\v\xd3\x0fU\nC\x11i\v\xf1\x12\xea\rQ\x15\xb0\x0f\xae\x17\xd1\x10\xe8\x17\x9f\x10\xb9\x16\x8c\x0f\x03\x16\xaa\x0e\xae\x16\x88\x0eT\x18\xd0\x0e\xeb\x19\xcd\x0f\xbd\x19\xb1\x10\x0e\x19u\x0f2\x19\xf9\ru\x17\xc3\f\xb5\x15t\n\x82\x14\x02\b,\x15\xec\x05\xc7\x16`\x05\xa8\x16\xae\x05N\x14Q\x06\x91\x10P\x05\x9b\x10}\x04K\x123\x06\xd7\x11H\x06\xef\x0eR\x04\xb3\a6\x00\x88\x01\xdc\xfb\xd3\xffN\xfb4\x00d\xfce\xff\xb5\xfc\xcc\xfb-\xfb.\xf7'\xf8\xf5\xf3>\xf5D\xf3\xdc\xf3\xd6\xf4\xce\xf3\x92\xf5\xfc\xf4\xb4\xf4-\xf5\xaa\xf4C\xf4h\xf4\xbc\xf4\xaf\xf5\x89\xf5z\xf6\xec\xf7\x95\xf6\xcf\xf9\xe2\xf5\xc3\xf98\xf3\x8a\xf8\xe8\xf1\xa4\xf60\xf0r\xf5\xe5\xee2\xf4C\xf0u\xf4\xb4\xf0X\xf6\x9e\xef#\xf8\x04\xef\xb4\xf8=\xed\xaa\xf7\xf8\xebv\xf4;\xebx\xf2^\xec\x1b\xf3\xf3\xed\xf5\xf5\xe8\xed\x9b\xf8\xd0\xed\x99\xf8[\xec\x9e\xf79\xec\xbd\xf7\xa8\xed\xa7\xf9\xb5\xed\x85\xfbq\xed\x10\xfc\xcf\xed\xb4\xfb\xcb\xee%\xfb>\xf0\xf1\xfb7\xf1\x9d\xfc?\xf2\xd9\xfc\xd3\xf3F\xffl\xf6\xf7\x00\x84\xf9e\x02y\xfbL\x03\xc0\xfdQ\x03\f\x00S\x03\xe1\x01O\x03p\x04\u007f\x04\xce\x06x\x06S\b\x13\b\xf8\a\xc7\b\b\a\x85\a,\x06?\x06Q\x05\xb4\x05y\x05\x04\x05\x05\x05o\x04\x90\x04J\x03x\x05\xf5\x02\xd3\x05\x1f\x03\x03\x06D\x03\"\a`\x04n\t\xc3\x04<\v\xb6\x04\xa5\v6\x04\xd5\v\xf7\x03I\f\xd9\x04v\x0e5\x06\x15\x11b\a?\x11\x9a\b_\x10>\t\x90\x0fS\t1\x0f\xf9\b`\x0fi\b\xf4\x0f]\b.\x11M\b\x13\x11\xf9\x06\xa4\x10\xc5\x05\xfb\x0fM\x05\xf7\x0eT\x05\xdf\x0eS\x05\x1b\x0f\xac\x04\x81\x0eJ\x04\x80\fy\x03\x00\nk\x01`\a\x83\xff\x05\x06Y\xfe\xd3\x06\xe1\xfej\x05\x86\xff\x19\x02\xc5\xfe\x87\xfe\f\xfe.\xfb\x10\xfcp\xfa\xf3\xf9\x1e\xf9Q\xf9\xf4\xf9\xe7\xf8\x1c\xfd\xeb\xfb\x03\xff\xdd\xfe\xce\xff\xce\xfe\xa3\xfcY\xfd\x92\xfa2\xfc\xa1\xfcF\xfe\xdc\xfe,\x00s\x00\xf4\x00\xe2\xfe\xec\xff\xbe\xfc\xda\xfc\x95\xfaY\xfb\xc3\xf8F\xfa`\xf7\xb5\xf9\xdf\xf5\xf8\xf9\x81\xf4\xae\xf9\u007f\xf2\xf0\xf8y\xf0b\xf7\x15\xf0\r\xf6\xdd\xefE\xf5\xe1\xef^\xf4\x17\xf0\xdc\xf4\x83\xf0Z\xf6I\xf0\x16\xf7\xee\xec`\xf6\xd7\xe8\xcb\xf3\xaa\xe6\xad\xf1\x9f\xe6`\xf1\xc3\xe8\x04\xf3h\xe9\xe0\xf3\xba\xe7\x0f\xf3\r\xe6\xb4\xf1\xe6\xe3\v\xf0!\xe3P\xef\xf0\xe5\xb1\xf0\xb3\xe9\xe6\xf2t\xec\xf6\xf3\xdb\xed%\xf4B\xf0t\xf43\xf4.\xf6\xed\xf7\x17\xf9\xea\xfa\xa3\xfb\t\xfd\xaf\xfdy\xff\xea\xff!\x02\x01\x02@\x01\x17\x03o\xffj\x021\x00\u007f\x02}\x03\xd0\x03\n\a\xf3\x04~\a+\x05[\x06\x0f\x04\x12\x05j\x03\xed\x05g\x04\x97\t\x85\x06\x81\x0e\xb8\b}\x13J\v&\x16\xc5\r\x9f\x16b\x0f\x84\x184\x10?\x1d+\x12\x80\"\x95\x15\xaa$\xce\x19\xe8\"\xae\x1c\xaf\x1ff\x1b\x05\x1dJ\x18_\x1b\xd0\x15s\x1a^\x15C\x1b\xd4\x15Q\x1c\xc3\x15\x99\x1bY\x14\n\x19\xf4\x11\b\x17\xc8\x10t\x17\xb7\x10e\x19\x94\x10\x84\x1b\xb3\x11\xfc\x1b\xa6\x12\xbb\x1bH\x12\x96\x1b\f\x11o\x19o\x0fv\x16[\x0f\xf1\x13,\x0f\xc2\x10B\x0ej\f\x03\fe\x06\xb3\a\xda\x00\xce\x02\xd4\xfd\x9a\xfe\xa4\xfb\"\xfb\xe7\xf9\xc7\xf9\xff\xf9\xfb\xf9\u007f\xfaV\xf9\xa8\xf9'\xf7\x8e\xf73\xf4x\xf4\xf0\xf1\xcd\xf2k\xf1\xf2\xf2\xbd\xf1\x0e\xf3C\xf1\x89\xf2\xbf\xf0\xda\xf1h\xf0B\xf0\xf9\xef\xfe\xed\x9e\xef\xb2\xeb;\xef\x85\xeag\xee\x15\xe8\xfd\xec\xff\xe44\xea\xc5\xe2\xba\xe7\xbc\xe1G\xe6\x18\xe3'\xe6\x13\xe5\xce\xe6!\xe6\xb9\xe7\xc1\xe6\x1e\xe9\x82\xe7\xd0\xe9\xee\xe72\xeaN\xe8S\xeb\x9e\xe9\xaa\xed\v\xec\xa8\xf0\x95\xee0\xf3\x11\xef\xce\xf46\xee\xf0\xf5\xa4\xee\xbf\xf6\x12\xefr\xf8T\xefH\xfaj\xef\xd6\xfbc\xef5\xfc@\xf0q\xfb5\xf1\x9b\xfa\xc5\xf3\x15\xfb\xda\xf8c\xfe\x9a\xfe\xe5\x02\x1c\x03O\x06\x18\x05F\br\x04\x92\bR\x03\xeb\aa\x03\xa8\aO\x04\x89\b1\x06\xc5\n\x86\b\xfa\f\xe6\a\xa4\rG\x06i\f\xd1\x05\x90\v\x92\x05Q\vE\x06S\nk\a\x04\tS\b\xe9\aI\t\u007f\aV\n\xdd\x06|\v\x11\x06F\r\x02\a\xfc\x10\x9a\t\xd2\x13\xe9\f\x81\x13\x1d\x0ea\x12>\r\xc9\x10V\fU\x10\x1f\v\x11\x11T\vl\x11\\\f)\x13\xc3\r|\x14\xac\x0e%\x14\x1c\r=\x13\x10\vt\x11p\t\xc3\x10\xef\aO\x11\xd4\x06\xb2\x12x\x05\xbc\x14\xa9\x05\x89\x16z\a\xbc\x16\xfe\bt\x13S\bH\x0f\x01\x06\x1b\f2\x04+\v\xfb\x02\x85\n\x19\x03\xf8\a\xd2\x02\xb9\x03\x10\x01A\xff\u007f\xfej\xfd\x1f\xfc%\xfe\xc4\xfb\xce\xff\xa3\xfc\x10\x00\xd7\xfcv\xfd\x86\xfb\x92\xfa(\xf9\xea\xf9\xe2\xf7$\xfb\x9d\xf7\xbe\xfd\xde\xf7\xf9\xfe@\xf9X\xfe\xd6\xfa-\xfdG\xfc\xb3\xfb\x9f\xfc\x96\xf9\xf0\xfb\x8f\xf7>\xfa>\xf6\x86\xf8I\xf5\v\xf8l\xf4\x91\xf8\xa7\xf3\xc8\xf9j\xf3\xee\xf9\x10\xf3\xef\xf7&\xf3\x12\xf5\xf8\xf2/\xf4O\xf2\x1c\xf5\xbc\xf1\xa9\xf6R\xf2\x90\xf7G\xf3\xd4\xf7K\xf4\x91\xf7{\xf5\xb6\xf8t\xf57\xfb\x15\xf4\x11\xfd\x01\xf3^\xfej\xf2\x89\xfe\xbd\xf1\xc4\xfd\x96\xf1\xa3\xfd\xaf\xf1\n\xfe\x06\xf3l\xfe\x00\xf5\x00\xff\xb3\xf6\xea\xff\x8a\xf7p\x00\xf2\xf7\xf3\xfff\xf9e\xff\x01\xfbo\xffM\xfc\x11\x00\xde\xfd\x1b\x01\xd7\xfe\xa5\x01\xe8\xff\xf7\x01\x8a\x00>\x03[\x00E\x04\xbc\x005\x04N\x01\x86\x04\x9f\x00\xed\x04\x04\xffy\x040\xfe\xc4\x03\u007f\xfem\x03\x85\x00\x9a\x03\xc5\x027\x04\xb9\x035\x04\xd6\x03e\x03\xf7\x03\xf8\x02\xc7\x04%\x03\x92\x06\xcf\x03\x9c\bL\x04\x9d\n\x81\x04\xb7\v\xe0\x04{\vq\x05\xb4\nD\x06o\t\x03\aO\t\x9a\x06\x0f\v_\x06L\fT\x06\xce\v\xd0\x05g\nA\x05\xe4\b\x10\x04\x16\b\x98\x02A\tJ\x02\xb1\v+\x03\x17\rU\x04#\r\xc1\x04$\v\x13\x04C\b\x9e\x02F\x06\x04\x013\x06`\x00m\a#\x01\xb2\ak\x02\xc2\x06\xe1\x02\xa2\x04\xec\x01\xf1\x01\xd3\xff\x8f\x00\xf2\xfe]\x00N\xff\xc2\x01\xb8\xffS\x03\x19\x009\x03\x06\xff\r\x02\xfe\xfd\xf7\xff\xa2\xfd\t\x00\xb8\xfdg\x02\a\xff\x19\x04\x9b\xff\x1a\x04c\xffU\x01;\xfeV\xfe~\xfcF\xfdT\xfc~\xfd\x89\xfc\x87\xfd\x8d\xfcY\xfc;\xfc\xcc\xfa\xd1\xfb\xd0\xf8\x84\xfb\x87\xf6\xa9\xfa.\xf4i\xf9\x84\xf2\xa8\xf7\x82\xf2\xfd\xf6\xd0\xf2\x82\xf7\xac\xf2(\xf7\x8e\xf1+\xf6^\xef>\xf5\xb4\xed\x03\xf5\xf0\xecx\xf5\x80\xec\xa8\xf5\xbe\xeb\xb5\xf4d\xea\x89\xf2\xb3\xe9\xfd\xf1m\xe9*\xf3\x94\xe9s\xf4\x15\xea\n\xf57\xea\x10\xf4\t\xeb\xce\xf2\x1f\xed\x01\xf3'\xef\xb3\xf45\xf0\xdd\xf53\xf1\xf0\xf5\xd7\xf2W\xf6\xa3\xf5\x93\xf6\xd7\xf7\xb5\xf7\x95\xf9\xc1\xf9S\xfb\x06\xfc\x8b\xfb[\xfeU\xfc)\xff\xea\xfd\x92\xff/\x00\xe5\x00\xb1\x01\"\x03\xfc\x01\xfb\x04\x8f\x02\xbb\x05\x18\x05=\x06\x01\t\xdb\a\xe6\nM\t\xcc\t\u007f\t\x0f\tA\b\xbc\n\xd1\a\xb5\r\x96\tb\x12B\f\xc8\x13=\x0f\xb5\x13\xad\x0e\x92\x14S\x0e\xe0\x14=\x10\xb6\x17\v\x122\x1ak\x14\x83\x19'\x15\xa8\x18\xda\x13\xe1\x16\"\x14\x9d\x15 \x13\xd1\x17o\x11\xbc\x18k\x12w\x17\xe1\x11-\x16\xfa\x10R\x14\xcd\x0f\xbe\x13\x8d\r\xa0\x12O\r\xb5\x11,\f\xc0\x12\x00\v5\x13\x8e\f\xa3\x11\xdf\f\xf9\x0e\xe5\v\xe3\f\xf0\n\xf0\v5\t\x85\n\xd7\a\x88\a\xba\x06\xb9\x04l\x05\xa4\x02|\x04\xe5\x00\xfe\x01\x02\x004\xfe\xe3\xfdB\xfc{\xfc~\xfb\xf0\xfc\xa6\xfaQ\xfb\xf3\xf8\x92\xf9\xc2\xf6\xdb\xf7Z\xf4\xa3\xf6L\xf3\x80\xf7)\xf4}\xf6j\xf49\xf6\x14\xf4,\xf7\xee\xf3\x03\xf6\xa7\xf3\x81\xf3L\xf3\xc7\xf0\xc6\xf2\x92\xf0\xa2\xf1\xf7\xf0\\\xf1\xac\xef\xd8\xf0V\xee\xcc\xef;\xee\x15\xf0\xb1\xed\x0f\xf0\xba\xec\xaa\xef\x8d\xed\xed\xeeu\xefP\xef\t\xf0>\xf1\xb1\xeeg\xf1\xb7\xed\f\xf2v\xee\xe0\xf2\xdb\xf0\xfd\xf3\xf1\xf20\xf6\x11\xf4\x8f\xf7\xf3\xf3\xa3\xf9\x81\xf3\xbd\xfaU\xf3\xd0\xfaR\xf3v\xfa\u007f\xf4\x12\xfb}\xf6\x03\xfd\u007f\xf7\x13\xfe<\xf8\x0f\xfe\xca\xf8\xb0\xfe\xa4\xf9\xc1\xff\xb3\xfb\xd8\x00\x8c\xfcU\x01w\xfd\xb5\x01\r\xff\xe0\x02:\xff\xa1\x031\xff\x84\x04\xbe\xff\xb0\x05\xfa\x00\xe0\x06b\x02\xe1\a\x8c\x02\xe1\a\r\x02;\a\x8c\x02l\a\x91\x01\xe6\x06x\x00#\x05\xb4\x01\x91\x04\xc9\x02\x9d\x04\x13\x05b\x05u\x05\xbd\x05\xec\x04/\x04\x9c\x05\x1e\x04\x99\x06t\x04\xf0\t+\x05\xe7\t\x91\x06\x17\n\v\x06\u007f\f\xe7\a\x8c\f\xb2\b\x02\rY\a\xb3\f\xd1\a\xc1\v\xa9\a2\x0e\xc7\a\xc8\f(\b\x83\b\a\x05\xe4\t\x89\x03\x93\tM\x04\xd3\b\xce\x023\tu\x02S\x065\x02k\x06\x03\x01\x00\x06\xcd\x00\xcc\x03p\xff\xb1\x04\xe9\xfe\xa3\x03\x89\xff\xdb\x00\xa5\xfe\xa9\xff\xee\xfd4\xfe \xfdE\xfd+\xfc\xa7\xfcq\xfb$\xfa\xc9\xf9i\xf8\x19\xf9\x9b\xf8y\xf9\x8c\xf8\xe9\xf7\x93\xf8\xa5\xf6\xb5\xf7\xae\xf5\x04\xf7,\xf5\xff\xf8\x85\xf6X\xfa\x90\xf7A\xfb\x17\xf8\xc6\xfdJ\xf9\xa0\xff\n\xfa\x92\x00\x91\xfb\x15\x00\xa2\xfc\xa5\x01X\xfd\x9c\x05K\x00\xe4\x06\xc3\x01I\x06\xaf\x01'\x05s\x02j\x04\r\x02\xf2\x03\xf4\x01y\x01\xba\x014\xff\xff\xffn\xfe\x05\xff\x0f\xfe\x90\xfex\xfcy\xfeu\xfb\xab\xfe\xfa\xfb\xb3\xff_\xfc\xc8\xff>\xfd\xf4\xff_\xfda\x01O\xfd\xcf\x01\xea\xfeg\x022\xff\x96\x03\xe7\xfe\xcc\x04\xf5\xffa\x06%\x01\xe3\x06B\x01^\x06\xa2\xffh\x06\x91\xfej\x06u\xff\x96\x06;\x00\f\x06\xf6\xff\x92\x05Z\x00\xb3\x06\x87\x02K\a\xc0\x04\x0f\a\xf8\x04\xee\x06\xd9\x03\x8f\x06\xc1\x03\xb8\x06\xc8\x03\x8f\x06\x9c\x03.\x05^\x03\x88\x04\x85\x02\xf1\x03\xc6\x02\x0f\x03\x80\x01\xdf\x02{\xff\xfb\x016\xfe9\x01\xd4\xfc@\x00c\xfb\xb8\xfdd\xfaK\xfc\xa8\xf8\x8f\xfa\xbf\xf7\xbe\xf8t\xf8\xe7\xf8\x9d\xf8\xc2\xf8S\xfa\x9d\xf9\x90\xfc\xee\xfa\x80\xfd\x87\xfa\x0e\xfeR\xfb\x87\xfea\xfc\xcc\xfe\xc2\xfco\x00\xda\xfd\x1c\x02\xcc\xfe\x15\x03\xd7\xff\x1c\x05\xef\x01\x89\x05\xd9\x02\xd0\x03^\x02\xef\x02*\x02\xcd\x02]\x01]\x03\x14\x01\x97\x03\xb7\x00b\x03-\x00B\x04>\x01v\x05\r\x02\xd1\x05\xa0\x01\x9b\x05Q\x01\x1e\x05\xda\x00\xf5\x03\x1c\x00\xbf\x02\xee\xfe\x80\x01\xc9\xfd\xd0\x00e\xfd(\x01\xf9\xfd\xec\x00&\xffu\x00\x97\xff\xcc\xff\b\xff5\xffb\xfe\x99\xfe\x85\xfdF\xfe\xa3\xfc\xc8\xfc\xd9\xfc\x9f\xfb\xd0\xfb\x93\xfc\xc0\xfa\x9c\xfdY\xfb\x89\xfe\\\xfc$\x00*\xfd\x9f\x00\xa7\xfe[\x008\xfei\xff\xf8\xfdq\xfe\x0e\xfe \xff\x91\xfd\xfa\xff\xde\xfe\x9c\x01\xd7\x00\xed\x00\xb4\x01\xf8\x00\xa6\x00g\xfe\x1a\xff}\xfc\x99\xfc\x83\xfd>\xfe\x82\xff\x11\x00F\x01&\x00\xc6\xfel\xfes\xfb\x17\xfb\xb1\xfb\x89\xfa\xd9\xfdF\xfd\x94\xff\x90\xfel\xff\xdb\xfe\x88\xfd\x9a\xfeo\xfc\xd8\xfde\xfbN\xfd\xe3\xfa\x16\xfc\xe4\xfa\xaf\xfc\x93\xfc\xbe\xfe\xbf\xfd\xbb\x00\xfb\xfdg\x01\xc3\xfdo\x02{\xfe\xea\x02n\xff@\x03\x83\xff\x18\x03\x1d\x00\xaa\x03H\x01\xb6\x04\xb2\x01\xac\x05\xb8\x02\xa4\x058\x02o\x05]\x03\x9e\x04U\x04\x99\x04\n\x03V\x05\t\x03\xb5\x05\xb9\x03\xe2\x05\xa8\x03\xee\x03%\x02\x18\x01\x1a\x00:\x00S\x00~\x01\xd0\x02\x97\x02\x80\x03\x0e\x03\xa4\x02l\x01\x9e\x01B\x00K\x02W\x004\x02\xf8\x00\x91\x02\xf2\xff\x05\x03g\x00}\x04Z\x02\xea\x04\x15\x03A\x03\xe3\x02|\x01o\x02A\x01\f\x02+\x02\xdd\x02\xa6\x01\xca\x03.\x01\xd6\x03\xb5\x00\x12\x03\xb6\x00r\x02\xfd\x00\xa4\x02\xfd\x01y\x02\xb4\x02\x87\x03\xcb\x02\xa2\x03\x84\x027\x02\xf0\x01\x9c\x00\xe1\x01)\x00K\x02\x13\xff\xe1\x01\xb4\xfe\xd6\x01\xc1\xfe\x14\x02=\xfe\xa7\x01\xfc\xfd\xec\x00=\xfe]\x00\xfd\xfdx\x00\xfa\xfd`\x00v\xfeC\xff\xdb\xfc9\xfe\\\xfba\xfdo\xfb\xa4\xfc\xcb\xfb\xf9\xfc\xc1\xfb\x9e\xfc\xa8\xfb\xa5\xfcT\xfb[\xfd\xab\xfb\xf9\xfc~\xfb\xad\xfc\x80\xfa\x1f\xfb\x9a\xf9P\xfa\r\xfa\x88\xfb\x8f\xfb\xde\xfd\xd3\xfc#\xfe\xed\xfd\xba\xfb\t\xfd9\xfa\xac\xfb'\xf9(\xfb/\xf9\xde\xfa\x89\xfb\x0e\xfcy\xfdW\xfd&\xfd}\xfd\xdc\xfc\xfa\xfb\xce\xfb\x8b\xfb\xa4\xfb\xff\xfb\x81\xfe\xa9\xfc\xc0\xfe1\xfeD\xfd\xc5\xfd\xd9\xfe\x01\xfd\xcb\xfb\xc9\xfc\x96\xf9\x0f\xfb\f\xfc\x8b\xfcy\xfc\x9e\xff3\xff\xd2\x00z\xff\xb7\x01\xc4\xfbE\x01u\xfb\xc3\x01z\xfd,\x02\xe9\xfd2\x02u\xfd\xd7\x01\xf1\xfdX\x02U\xfd?\x04\xfd\xfc\x11\x03\xee\xfc9\x01v\xfb\xc5\x00n\xfb/\x00\\\xfbg\x00 \xfbP\x00\r\xfb`\xffl\xfc\xa1\xfe\xac\xfd\xe8\xfe*\xfc.\xfe\x15\xfb\xac\xfd\x11\xfcx\xfe\xdd\xfc\x95\xfdV\xfd\xa6\xfc\xe0\xfb\xe8\xfbC\xf9Y\xfa\x91\xf8\xa8\xf99\xfaT\xf9\xd7\xfcg\xfat\xff[\xfcH\x00[\xfd\xfc\xfdE\xfd\x13\xfd\xc1\xfc\xb6\xfdL\xfdB\xff\x99\xfd\xa6\x01\x19\xfe1\x03\xe3\xffo\x03\x02\x02\xba\x02\x8f\x02\x95\x01Z\x01\xd7\x01u\x00\x9a\x03P\x01\xc5\x05\xee\x02\xec\x05h\x03h\x03\xc7\x01\x9a\x02c\x00b\x04\xb7\x00\xcf\x06\u007f\x01\x14\tb\x02\x15\nn\x03'\t\a\x04\xf4\bf\x04\xca\t\xd2\x03\x8c\n%\x04\x19\r\xe7\x05#\x10\x15\b\xcf\x10\xba\n\xeb\x10I\vU\x11\xe0\nJ\x0e\x1a\nq\n\xff\x06f\b\x14\x05,\a\\\x05G\x06F\x05\x84\x05\xd9\x03\xb7\x02Q\x02\xd8\x00\xeb\x00\xcf\x01\x19\x017\x01\\\x02x\x008\x02\xb4\x01~\x02\xd2\x01\x12\x03\x98\x00\x12\x02\xa4\xff\xa7\x00z\xfd\xe7\x00\xa8\xfdR\x01]\xff\xbe\x01\xdc\xfe\xb1\x01\x16\xfc\x02\x00\xba\xf9\x05\xfe\xa8\xf7\x1d\xfd\xf3\xf6\xee\xfba\xf8\xe1\xfb\"\xfav\xfd7\xfbq\xfe\x0e\xfc%\xfeZ\xfb\x05\xfe\x03\xfb5\xfd;\xfb\xf7\xfb\xe2\xfaG\xfc\x05\xfa\xe8\xfc\xc3\xfaA\xfd\xca\xfb\xbb\xfd\xc0\xfa%\xfdA\xfa\xa7\xfc\xfa\xfaQ\xfez\xfbu\xffb\xfa&\xfe\xb7\xf7\xda\xfb~\xf5\xea\xfa{\xf6\xf3\xf9\xde\xf6\f\xfa\x0f\xf6\xf4\xf9\x16\xf70\xfa\xd3\xf8\x96\xfbP\xfa\x1a\xfc~\xfb\xc3\xfb\x12\xfb\xb6\xfb\xa3\xfb\x10\xfd\xaf\xfdz\xfe)\xff7\xff\x1e\x00\x87\xff\xc6\x00b\xff1\x00R\xffN\x00+\xff%\x01k\xff\x1e\x01\xd0\xff{\x01n\xff\xef\x00\xc7\xfe\x84\x00\x99\xfdN\x01B\xfeN\x02\x1e\x00c\x045\x01\x8d\x06\x9b\x02\xe9\x06\x90\x03\xd3\x05\xb3\x02*\x03+\x01L\x01/\x00\xf1\x01\xc3\x00\x01\x045\x03\r\x04\x16\x04W\x02I\x02\xb7\x00\xe8\x00K\x00\xf8\x00\x88\x00F\x01\x80\x00\xd1\x00Q\xff5\xff4\xff\xde\xfd}\x00q\xfdb\x00A\xfd\x91\xff\x05\xfd\x84\xff\x89\xfd/\x01\x04\xfft\x03\xc4\x00\xd8\x04\xf7\x00\xc0\x03\xa1\x00m\x02\x17\x00\xc7\x01;\x00\xe2\xff\xae\x00g\xff\x03\x00\x1d\x01E\x000\x02D\x00\x1d\x01\x83\xff\x06\xff\x8c\xfeF\xfdC\xfd\xe4\xfc+\xfc\x80\xfeq\xfc\xa6\xff<\xfe\r\x01\x1c\xff\xf6\x01]\xffH\x00\x11\xff\xba\xfd\xff\xfe\x84\xfd\xd1\xff\x0e\xfe\xe4\xff\x1a\xfd?\xfe\"\xfd\xc6\xfcg\xfc\xfe\xfd\xf0\xfc\xa1\xff\f\xff\xb9\x00\xd4\x00\x17\x026\x03\x9b\x03\xea\x05\xd1\x04%\x06P\x05\xb1\x03e\x04\xd4\x01\xdd\x01\x99\x00)\x012\xff\xa5\x00\x98\xfe\x13\xff\u007f\xfdS\xfeS\xfd}\xfd\xda\xfd\x88\xfd\xef\xfc\x90\xfe\x8c\xfc\xf7\xfe\x06\xfe\xc8\xfe\x83\x00\xce\xffZ\x01\xf8\x00d\x01\x9b\x01o\x01\x8f\x02\xc5\x015\x03\xc2\x01\xc4\x03\xf5\x00e\x03\xc1\xff{\x01\xff\xfe\xc5\xff\xa6\xfe(\xff\xb3\xfe%\x00\x17\x00\xa4\x01.\x01\xee\x01\xbb\x01h\x02\x18\x02\xb2\x02\xbc\x01\x96\x03\n\x01r\x03\x89\x00\x86\x01\x9f\xff\xd8\x00m\xff7\x01{\xffT\x01Y\x00\xae\x018\x01\xa2\x01n\x01\x85\x014\x02*\x03p\x03n\x03\xca\x03\x10\x02\xf4\x02\xb2\x01\xab\x01.\x00E\x00k\xff\x1e\x00.\xffw\xff5\xfee\xfdP\xfe:\xfd\xec\xfd\xc8\xfd7\xfd\x81\xfd\x02\xfd1\xfd\x88\xfd\xa6\xfd\xf9\xfd\x12\xff\xc9\xfe2\x00\xd1\xffS\x01\xee\xff\xcf\x00'\x00\x8b\xff\x95\xff\x03\xfe\xdc\xfd\xcd\xfb\x0e\xfd\xf4\xfa\x95\xfc\xc2\xfb\x86\xfbw\xfc\xbd\xfb\x06\xfd\x11\xfda\xfeI\xfe3\xffp\xff\xb2\xffp\x00x\x00x\x01+\x01\x89\x02\xdb\x01[\x02\xcb\x01\xe2\x00\x0f\x01\xce\xff\x9f\x00\f\x00\xf2\x00F\x00B\x00\xcb\xff\xbd\xfeY\xfe4\xfe\xa3\xfd\xe7\xfd\xc9\xfd\xa0\xfd\xac\xfd\r\xfdP\xfdd\xfc\x89\xfd\xd3\xfc6\xfe\xc0\xfd\xe2\xfdc\xfdV\xfd\xeb\xfd*\xfd\x1d\xff\xb6\xfd\xe5\xfe\n\xff2\xfe\n\xffV\xff\xbb\xfe\x84\x01\xb5\x00\x94\x03\xc2\x02\xbf\x04\xc8\x03\xd8\x03X\x03\xbd\x02Z\x02}\x01o\x01H\x00\x9d\x002\xff-\x00\x91\xfe\xd0\xfe<\xff\x93\xfdn\xff\x9f\xfd\b\x00Z\xfe \x02\xa9\xff8\x04\x8e\x01\x8b\x06\xc5\x02\x99\x06p\x02T\x05\x84\x02\xc9\x04\b\x03\xc2\x03U\x02\x05\x03_\x02\x10\x01(\x02\xa7\xff\xad\x01$\x00J\x01\x90\xff\xd2\x00\x1c\xfe\x9e\x00\xbb\xfd\x9a\x00\xdb\xfd<\x010\xfe\xd3\x01\x1a\xffJ\x02\xd6\xff\xb8\x03\x9f\x00\x18\x04/\x01F\x03\x99\x00P\x03m\x00\xf8\x03\x01\x01\x04\x043\x02R\x03\xfb\x02\x95\x02\xd0\x030\x02\xd1\x04\xd9\x02M\x05\x0e\x03\x18\x05_\x02O\x05\xd1\x01\xa9\x05P\x01\\\x04\xb2\x00\x1d\x02\xf0\xff\xd2\x00\x8c\xfe\x8d\x00\xce\xfd\x11\x01\xb8\xfd\xc7\x01\xf1\xfdf\x02j\xff\x0e\x04A\x01j\x05+\x021\x05\x84\x02i\x04\xe9\x02\x17\x04\a\x03^\x03q\x02*\x01\xb9\x01\xc6\xfe\xd5\x00O\xfc\x9b\xff\xa7\xfa\xc2\xfe\xb5\xfa'\xfeP\xfb.\xfe\\\xfc\x82\xff\xb0\xfc\xcc\x00\xc7\xfc\xfa\xffO\xfe\xd0\xff\x8a\x00\x8f\x01w\x025\x03\xdf\x02\xda\x032\x02\x82\x02\xfa\x01\xae\x00\xdc\x01N\x01g\x01\xa0\x02\xec\x00\xe5\x01\xa9\x00\xea\xff_\x00\xce\xfe\x11\x00\a\xff\xb7\xffu\xff6\xff\f\xff\x9a\xfe\x88\xfe\x88\xfdp\xfe@\xfcM\xfe\x99\xfa\x8a\xfcL\xf9\xf6\xfa\x8a\xf8\x90\xfa\x9a\xf7^\xfa\x95\xf6-\xfa\x85\xf6\x9a\xf9\x9d\xf8)\xfa\x80\xfan\xfc\x95\xfa]\xfdk\xf9\x9d\xfc[\xf8w\xfb\xad\xf8\xcb\xfa \xf9H\xfb^\xf9\t\xfc\x9b\xf9\xa7\xfb\xc5\xfan\xfa\xea\xfaa\xfa\xaa\xfa\x98\xfbD\xfd\x93\xfd\x0f\x00U\xfeG\x02|\xfd%\x02\xa0\xfe\xfd\x00\x8a\xffZ\x01\xab\x00\x98\x00\x05\x00K\x00\xf8\xfd\n\xffA\xfe>\xfd4\xfeU\xfc[\xfd'\xfa\x9f\xfcN\xf8\xa9\xfb\xd6\xf8\x02\xfb\xbf\xf9*\xfb|\xf9T\xfcq\xfa\x96\xfd\xde\xfa^\xfeB\xfa\x92\xfds\xfa+\xfd\x0e\xfa\xab\xfd\xc5\xf9a\xfe\xd1\xf9\xde\xfd\xbb\xf9\xbf\xfce\xfa\x83\xfd\x05\xfcZ\xfeK\xfd>\xff\xfb\xfdD\x00R\xff\xa6\x00\xde\x00\xb9\x00;\x01m\xff\x11\x00\xde\xfd\xc8\xff\xee\xfd\a\x01\x16\xffX\x02\x85\xff$\x02\x89\xfeH\x01\f\xfd_\x02\xa9\xfdP\x04\x15\x01x\x05\xe7\x02\x83\x05\x9b\x02d\x04\xfc\x01`\x03\xa8\x01\xcf\x03X\x02\xd2\x03\xa7\x02\u007f\x02\xff\x01\xff\x01K\x01\xa2\x01\t\x02\xf6\x00\x1f\x02\x86\x01\xba\x00:\x01\xfe\xff\x99\x01Y\x00\x17\x04\x10\x027\x06\xe9\x03\x1f\b\x84\x04#\n\x92\x05\xaf\v$\a\x02\r\xed\a\xd1\f\x06\b=\f\x15\a&\fF\a\xc4\n\xd7\b\x93\n\xce\b-\v\"\a\xcd\nz\x06y\t\xdd\x06\xbf\x06E\a\xdd\x06H\a\xaa\a\xe0\x05\xc3\x05@\x03\x93\x02\n\x02Z\x00F\x01t\x01J\x00\xa1\x02\x17\x00\xae\x017\x00?\x00\xb4\x00\x8e\xff\x89\x01F\xff\x88\x01\xec\xfe\xf3\x00\xa0\xfe\xc1\x01\xb7\xfd?\x01:\xfd#\x01\x0e\xfd\xad\x01\xf7\xfc\xf6\x00)\xfd\xcc\x00\xe7\xfcp\x00\xe8\xfc\x9a\xff\xbd\xfdx\xffA\xfez\xff\xaa\xfd'\xfea\xfdq\xfd\\\xfe\xaf\xfe\t\x00\xd1\xfe\xe3\x00}\xff\xe8\xff]\x00\xd7\xfe\xc9\xff\x9a\xfe\x80\xffk\xfd\xd1\xfe\x89\xfbA\xfd\x89\xfaK\xfd\xf7\xfa-\xfef\xfbc\xfe\xad\xfaN\xfe\xe3\xf9\xd3\xfd\xe4\xf9\xa2\xfd\a\xfa\x04\xfe\xf4\xf8o\xfd\u007f\xf8\x9d\xfb\xff\xf8\xc9\xfa\xbd\xf8)\xfa\xed\xf84\xfa\xbb\xf9\xa2\xfb\xc2\xfaX\xfc\xd4\xfb\xbe\xfc\xd7\xfcz\xfd4\xfd\xb0\xfe\x91\xfc\xa1\xfek\xfc*\xfe\x17\xfc\x89\xfd\xb1\xfc\x91\xfd<\xfd\x1a\xff\x8d\xfd2\xff\x81\xfee\xfer\xfe\v\xfe\xb5\xfd\f\xfd\xd9\xfc\x8c\xfc\v\xfe\xad\xfc\x90\xff\xc5\xfc\r\x00Y\xfc\xc5\x00\xfc\xfc\xe2\xff\xc7\xfd\xbb\xfe\x9c\xfd`\xfe`\xfd\xa3\xfd\x85\xfd\x9c\xfd\x81\xfd\x16\xfd\x15\xfd\xcf\xfaJ\xfcI\xf9\xb0\xfa\x1f\xf9\xcc\xfa!\xfa\x88\xfb\u007f\xfc\xb7\xfb(\xfd\xe4\xfc\xe3\xfch\xfdb\xfcC\xfd(\xfc\xd6\xfc\xc5\xfc\xc6\xfbB\xfd?\xfb\x85\xfe\x87\xfc\xe2\xfe9\xfe\xe7\xfe\x11\xff\n\x00\xb6\xff<\x01\xe7\xff\xfa\x01\xa6\x00E\x02\x01\x02\x90\x02\x9d\x02\xde\x03\xf8\x02L\x04\x92\x02\xbc\x03\xc8\x01\xbf\x04\x9f\x01\xa4\x04\x1f\x02\x18\x04{\x01\xc0\x03\x99\x00\x91\x02\xbb\xff\x15\x03\x1a\xff\xba\x02\xc3\xfe\xb9\x01\xca\xfe+\x02\xa7\xff\x8b\x03`\x00\x88\x05\x9e\x01e\a\x91\x03-\bi\x05\xe2\a/\x06\xa3\a9\x06=\a\r\x06\x19\a\xe6\x05X\x06\xdc\x05\xcf\x04{\x04w\x03\x06\x04\x8a\x02K\x04>\x02d\x03\xf5\x01\x01\x03\xc9\x01y\x02\xab\x01,\x02t\x01\xc0\x02\xd2\x00N\x02`\x01\x96\x01t\x02G\x02\xa4\x03~\x03!\x05B\x04*\x04|\x04\v\x03\x02\x03(\x03~\x02\xf5\x01\xa6\x039\x015\x03\x1c\x01\xb8\x01\xa7\x00I\x00\xd4\x00\xb5\xff\xd4\x01T\x00\xb9\x016\x01e\x02#\x01\xfd\x02\xa3\x00K\x02\x89\x00\xf5\x01\xc7\x00J\x01C\x00\xd2\x00\x9d\xffT\x01\xed\xff\v\x02<\x01\r\x02\x83\x02\xbf\x01\xc6\x02\xbd\x01\x8f\x02\xa3\x01\xf4\x01\xfc\x00\xa8\x00k\xffT\xff\x06\xfd\xb0\xfe\x95\xfcf\xfe\xb2\xfc\\\xfe\x99\xfc\xa6\xfd\x91\xfc\x8f\xfc\xaf\xfc_\xfc\x88\xfc8\xfd\xff\xfb\xb0\xfd\x81\xfby\xfd\x9f\xfb\xab\xfc\xa3\xfc\xf8\xfbk\xfd\xb8\xfc\x19\xfev\xfe\x99\xfeO\xff\x11\xff-\x008\xff\xeb\x00\xbb\xfe\xef\x00\xaa\xfeb\x00\x18\xfe\xad\xff\xbd\xfc\xc3\xfe\xc7\xfcf\xfe\xbf\xfc$\xff\xfb\xfcB\xffz\xfe\xae\xff\xcf\xffm\x00\xb9\x00\x9f\x007\x00\xa6\x00\x98\xfe\x8c\xff\xbe\xfd\x8a\xfe\xd3\xfd\xad\xfe\x99\xfe\xe4\xfe\xbf\xfe&\xffG\xfeL\xff\x96\xfec\xffG\xfe\xb4\xff\x14\xfd\x9f\xffo\xfcU\xfej\xfb^\xfd\xfa\xfa-\xfc\xd8\xfa\x02\xfb\x00\xfa\x99\xfa\xaf\xfa\x0f\xfb\x83\xfb\a\xfc\x1b\xfc\x05\xfcP\xfd\x82\xfcG\xfeP\xfd\x02\xff\xed\xfd\xe2\xfdp\xfe\xbf\xfc\xa3\xfd\xe9\xfc\x11\xfd\xd1\xfd\xb9\xfdX\xff\x9b\xffX\x00\x1e\x01\xa6\x01_\x01\xbd\x01\x80\x01S\x00\xff\x00\xd6\xff\xc3\xff\xbc\xffs\xff\xb8\x00\x12\xff>\x02;\xfft\x03z\x00\xed\x04J\x01\xfc\x05\xdd\x02T\a?\x04\xf3\b\xf1\x04O\tz\x05\xa2\b\x94\x05n\a\xbb\x05\xe5\x06\x9b\x05Y\x06\xa4\x05N\x05\xdb\x048\x04\xbe\x03\xe0\x02\xfd\x02}\x01\xa4\x02\x96\xff\xe7\x01\xca\xfd\xbf\xffJ\xfc\xb8\xfd\xa7\xfcd\xfcF\xfe\xc6\xfd~\xffi\xff\xb9\x01\xbc\xff9\x03\xe1\x00\xbf\x03Q\x01\x86\x03\t\x027\x02\xa3\x01\x12\x01\xaf\xffU\x00O\xfe\x12\xff\x8e\xfe:\xfe\x9d\xfe\xf1\xfe\xec\xfe\xc0\xff\x1d\x00\x1f\x01\x01\x01\x1b\x03\xe6\x02\x80\x03@\x03%\x03$\x02\xe3\x02^\x01\xf8\x02@\x01;\x03\xad\x01\x90\x03\r\x01\xf3\x03\xe8\x00O\x04W\x01\xda\x03\xb4\x01K\x01\xd8\x01\x8c\xff\x95\x00\x8f\xfeU\xff\\\xfd\r\xff\x1a\xfcE\xff5\xfb\xd9\xfe4\xfc\xcf\xfe\x1d\xfd\xe7\xfe\x80\xfc\xfd\xfe5\xfb\a\xff\x0e\xfb\x00\xfe>\xfb\xf3\xfc\xb5\xfa\xd8\xfb2\xfa\x91\xfb\xd8\xfa|\xfbS\xfd{\xfc\x1d\x00\xcb\xfe\x1e\x02\x12\x01\xb1\x02c\x02-\x02\xd3\x01\xf8\x00m\x00\xa2\xfe\xf9\xfe\x19\xfc\xff\xfd\xd6\xfa\xde\xfc\xbc\xfaX\xfc\x1d\xfb\xe5\xfc\x9a\xfc\xc1\xfd\x84\xfc\x99\xfen\xfb<\xfe\xa5\xfb\xe8\xfd\"\xfb\x91\xfd\x9c\xf9\xbb\xfb>\xf76\xf9\x14\xf6I\xf7}\xf7\xe6\xf7y\xf9\xde\xf9\x92\xf9\xb3\xfa\xc7\xf8\x87\xfah\xf8\xb3\xfa\x9d\xf7{\xfa\x92\xf8\xa2\xfa\x9f\xf9\xaa\xfb\xcf\xfaY\xfc\xf0\xfc\xeb\xfd\\\xfft\xffE\x03\x80\x01\xbe\x05z\x03\xa1\x06T\x03\x16\x050\x02X\x04R\x01-\x04\xfd\x00\xb9\x039\x00\xe6\x04\x12\x018\x05\xe7\x02F\a\xc7\x04\xd5\bJ\a\xb9\au\x06d\x06\x01\x05\xb2\x03Z\x04\xa6\x01\b\x02\x1c\x00Y\xffq\xff\x9d\xfe\x98\x00Y\xff:\x02\x02\x01\x90\x04\x03\x031\x061\x03\xec\x05\x15\x04~\x04\x96\x04N\x03\x80\x03\x9e\x02+\x03o\x02\u007f\x02\xa8\x01h\x02\x17\x01\xf7\x028\x03\xae\x04\x82\x05\x9d\x06\xd6\x05\x82\x06\x85\x05E\x05\x9e\x04\xd1\x04@\x04\xaa\x04f\x05c\x04x\x05G\x04A\x06\xb4\x03L\a\\\x05\x9f\x06j\x06\x92\x068\x05\xab\x05\xba\x04*\x03I\x03\x18\x01I\x01\x1b\xff\xde\xff.\xfe\x1c\xfe\b\xff\x86\xfd'\xff\x93\xfeG\xff\xc0\xfe\xe1\xffC\xff4\xff\xbc\xff8\xfe\xf6\xfdd\xfcG\xfc\xb1\xfav\xfa\xd1\xf9\xcc\xf8y\xf9m\xf8d\xfb\xf1\xf8\xc7\xfd\xc8\xfa\x11\x00C\xfd\xe0\x00\xdd\xfe\xe6\xff\xea\xfdK\xff\x19\xfd\xf8\xfd\xe6\xfd\xac\xfc\xab\xfe\xa1\xfb'\xff\xa1\xfb\xd5\xfe\\\xfc\xd7\xfe\xc6\xfc\xd3\xff\r\xfe]\x01}\xffW\x022\x01\x00\x03\xa4\x01\x9a\x02@\x01\xac\x01\x9b\x01y\x01\xff\x01\b\x01\xb5\x02:\x01\xe4\x02|\x01\xd2\x02\r\x02P\x02\xb1\x02\x19\x01\xfe\x01\xb2\xff\x8d\x00\xbe\xfdA\xff?\xfc\x93\xfe\xbd\xfbl\xfe\x8e\xfc\x88\xfe\xdd\xfe\xd7\xfeP\x00\x9e\xffv\xff\xb8\xff\\\xfd\xcc\xfe\r\xfb\xdb\xfdM\xf9B\xfc\xac\xf8W\xfbc\xf8\x10\xfbv\xf8\xff\xf9>\xf9?\xfa\xca\xf9\xf0\xfbk\xfa=\xfd\xed\xfbx\xfe\xa8\xfc%\xff;\xfd\xcc\xfe\xf0\xfc*\xff\xe1\xfb\xe3\xfe\xec\xfbM\xfeM\xfc\xb5\xfeN\xfd\x1a\xffT\xfe\\\xffa\x00@\xffr\x01j\xff\xba\x01\xd9\xfe\"\x02H\xfe\x8a\x00\x94\xfd\xff\x00\x9b\xfc\xc0\x00\xff\xfc\xb8\xffk\xfcb\x00\x95\xfc\x87\xffo\xfd\xe7\xfe\xff\xfdU\xfe\x16\xff\x83\xfc\x81\xfep\xfb\xb8\xfd$\xfbi\xfd\x9d\xfb5\xfde\xfdM\xfe\x93\xfe\x90\xff\t\xff\x1b\x00\xc4\xff\x17\x00\x01\xff\x19\xff\xc5\xfd\x02\xfeH\xfcj\xfd\xf0\xf9\xe3\xfb7\xf9\xdd\xfa\xc2\xf8\xbd\xfa\xf7\xf8m\xfas\xfa&\xfbP\xfbu\xfb\r\xfcd\xfb\xd4\xfb\xc7\xfb)\xfa\x80\xfa\x97\xfa\xe0\xf8\x89\xfb\xec\xf8x\xfc\x1e\xf9\xa5\xfdu\xf9\xd7\xfd\xf9\xf9E\xff\x9f\xfa\x12\x01\n\xfd\xec\x019\xfe\a\x02P\xfe\xf0\x02-\xff\xe5\x03\xc7\x00]\x05\xfb\x02\xfc\a\xf4\x04\x00\b`\x06\xd5\x06\xa2\a\x1d\x06\u007f\a9\x06\x8b\a\x1f\b\xd5\b3\b\x8d\t\xa2\aU\t\xcd\x05\xe4\a\a\x05?\x05\t\a\xb1\x058\aP\a8\a\x92\x06\x89\an\x05\x1d\x06K\x04\t\x05\xb0\x02\xcf\x034\x03:\x03\xeb\x03.\x03\xd9\x03\x1e\x03!\x05>\x03z\x04\xbe\x03`\x04{\x04\xdd\x06Z\x04\xe8\x06\x84\x05@\x06e\x05{\x06+\x04m\x05,\x05\xea\x05)\x05\xdf\x06\x92\x04\xe9\x04\xdb\x04\x91\x02\x9f\x04\xda\x02\x03\x04\xa0\x03\xd3\x04N\x04\x82\x05\x1a\x05\xac\x03\x81\x04\n\x02n\x02\xa2\x02(\x02\xeb\x03\x17\x05\x84\x03\xe2\x05(\x03!\x036\x02\x99\x01\xce\xff\xeb\x00\xfa\xfe\xc0\x00\xab\xfe\xb8\x00\xeb\xfe\xec\xfdg\xffp\xfc\xca\xfd\u007f\xfcB\xfc\x19\xfbh\xfc\x15\xfb\xa0\xfc?\xfb\xb5\xfb\xbf\xf9\x1b\xfb\x02\xfaw\xfaP\xfa\x9d\xf9\xde\xf9\x87\xf9\xfb\xfa\xb1\xf8\x93\xfb\xd9\xf8I\xfb}\xf8\xc0\xfb\xa7\xf8A\xfc\xc4\xfb0\xfd_\xfc+\xfe\xd0\xfc\x1b\xfe%\xfe\xfb\xfeU\xfd\xae\xff\x1b\xfe[\xffd\x00b\x00\x02\x01\xd1\x00n\x00\xa9\xff\xef\x00\x96\xff\xd5\x00\a\x00\x15\x00]\x00z\x00\x13\x01U\x00\xdf\x00\xbf\xff\xcb\xffY\xfe\xbb\xfe\x16\xfd\xc8\xfdH\xfd\xee\xfd\xc5\xfd\x9a\xfe\xe4\xfd5\xfe\v\xfci\xfc\xb5\xf9Z\xfa\x00\xf9\xcd\xf9\t\xf9\xe2\xf90\xfac\xfaW\xfb_\xfb\xac\xfaw\xfb\x02\xfa\xa4\xfb'\xfb\xe1\xfcT\xfcO\xfe\f\xfc\xaa\xfe\xac\xfc!\xff:\xfd\xb5\x00\x98\xfe9\x01\"\x00\xb7\x01R\x00T\x02\xfd\x00u\x02`\x00\xf8\x02!\x01;\x02\xdf\x03#\x02\xff\x04\xb0\x02\xd2\x05\xb3\x02=\x06\xb3\x02\xc0\x05\r\x02\xe1\x035\x01)\x03-\x00\x00\x04\x14\x01\x91\x04B\x02}\x06\t\x02\xab\x04\xfb\x01l\x00>\x00T\x00K\x00Q\x00\xef\x011\x00\x0e\x01\x03\x00\xf7\xff\xad\xfd\x9c\xfet\xfc\xd3\xfe\xfc\xfc\xd9\xff\xed\xfc\x0f\xfei\xfc\xa2\xfc%\xfbV\xfc\x9a\xf9x\xfc\x17\xfa\xf9\xfc5\xfcK\xfd\xd2\xfe}\xfe\xc8\xff\xaa\xffS\xffR\x00-\x01\xa0\x01\xc2\x03\xde\x02X\x05\xf3\x02>\x06\xa9\x02\"\x05\xc0\x02\xaf\x05\xf9\x02\x8f\a\x8c\x03\x89\a\xac\x03f\bd\x03\x93\bH\x03\xfc\x06\xaa\x02\xde\x05\xa0\x01\x9d\x04T\x00\xcb\x03\xa5\xff\xb7\x03\xb0\xff\x96\x02Z\xff\xbe\x01\x15\xff\x8a\x01&\x00\x1b\x00\x1e\x00\xfe\xfee\xff\xf3\xfe>\xff(\xff\x8e\xff\xb8\xfe\xd3\x00\x13\xfd\xd0\x00I\xfc\xc3\x00\x87\xfdS\x02\x9f\xff/\x03\xdb\x00E\x03v\x01\xf0\x03\x11\x02]\x05\x9e\x01#\x05w\x02?\x03\xd8\x03\xd3\x03\xc4\x03r\x040\x05@\x05-\x06\xa7\x06\xf1\x06\xfa\x05\xc3\a\xd6\x04p\a\xa4\x04\a\x06\x93\x04\\\x04\xb9\x02\xc1\x04t\x00\xdb\x04\x13\x00 \x02\x9d\xffe\x00\"\xff\x17\x00\xf0\xfe\x84\xff\xe9\xfd\x84\xfe`\xfd\xb1\xfc\xc5\xfcI\xfb%\xfc\xe0\xfa\xa0\xfc*\xfaB\xfc\xb2\xf9\x17\xfb\x15\xfaB\xfc\xb7\xf9\xa0\xfd\x96\xf9\xba\xfd\xe6\xfaU\xfem\xfb\xea\xfe6\xfcw\xff\xaa\xfc\f\x00\xde\xfc.\x00\x1b\xfe\xdb\x00\x16\xfeb\x01E\xfeC\x01\x1d\xff\xfb\x01\x9e\xff\xa3\x01\xa4\x00\v\x01\xa7\x01\x8b\x01?\x01\xae\x018\x01\xb1\x00\x88\x01\x8c\xff\xca\x00\xb3\xff\xb0\x00F\x00U\x01\xab\x00\xae\x02\xbd\x01<\x03\x06\x02\xc6\x01-\x01\xb3\x01\xe2\x00\x0e\x01=\x01\x14\xff\xc3\x00\xaf\xfe\x88\xffi\xfe'\xffe\xfd\xd3\xff\xb1\xfc\xc7\xff\x97\xfc.\xff\x11\xfdJ\xff\xf8\xfd-\xff\xfa\xfc\x85\xfe\xf3\xfb\xcb\xfdk\xfc:\xfd\xf0\xfb\t\xfc]\xfcx\xfb\xcf\xfc\xea\xfbb\xfc\x10\xfc\xc2\xfd\f\xfd@\xfe\x83\xfd_\xfe\xf5\xfc\x15\xffp\xfd\xc2\xfe\xbe\xfdv\xff\xdc\xfd\xb7\x00j\xfff\x010\x00\x91\x02h\x00\xf2\x02\x8f\x01\xe8\x02\x0f\x02i\x03h\x01Q\x03o\x00\xe6\x02D\x00\x1e\x029\x00\xf6\x01\x18\x00c\x02m\x00\x96\x01\xcb\xffr\x00\x00\x00\xcd\x00B\x01\xd9\x00\xf3\x00u\x00\f\x00\xdf\xffJ\xffx\xfe\xe4\xfe\xfc\xfdR\xff\x87\xfdV\xff:\xfd\xdd\xfe\xd5\xfd2\xff\xf3\xfdU\xff\x13\xfe\xff\xfe\x14\xfe\xaa\xfe\x15\xfe#\xfe\xad\xfd\xa8\xfd\x06\xfd&\xfd:\xfd\xd1\xfc\xfd\xfc\xb6\xfc\xfe\xfcf\xfcr\xfcO\xfc\xda\xfa\x1a\xfc\r\xfam\xfb\xd1\xf9r\xfa@\xf9\xd0\xf9\xce\xf8\xb6\xf9(\xf9}\xf9\x99\xf9z\xf9\a\xfa\xdd\xf9\xfc\xf9\x13\xfa\x9d\xf93\xfa\xbc\xfa\xc3\xfa\xfb\xfb;\xfb\v\xfdt\xfb\xd4\xfe\x97\xfc4\x00|\xfeu\x01Z\x00}\x02c\x02\xb7\x02y\x03-\x03\xfc\x02\xbb\x03;\x03\xe4\x03\xdc\x04\b\x05\x9a\x05\xf1\x06\xf3\x05)\aK\x06\x04\au\x05\x91\x05W\x05\xa9\x03\xee\x04'\x04\x8b\x03[\x04\xb6\x03Q\x04A\x03B\x04\x97\x02\x9f\x047\x03\xf6\x04\xae\x03v\x04\xcb\x03i\x04w\x04\x85\x03\xb8\x04\xfe\x02~\x03c\x03\xe4\x02Z\x03C\x03\xb2\x02\xd5\x03\xd2\x01\xed\x04\xfa\x01\xce\x04\x05\x03D\x04\x88\x03\xae\x04\xb2\x03\xf9\x03\xec\x02\n\x03a\x02\xc3\x02\xf7\x02\xeb\x01\xbb\x03\xc6\x01p\x04\x96\x02\xbf\x04`\x03\x00\x05\xe6\x02\xcf\x05\xb5\x02\x04\x06Q\x03M\x05\xfb\x02\xab\x04$\x03\xc1\x04t\x03*\x04\xd8\x02\v\x03\xa7\x01\x84\x02\x03\x01\x17\x03Z\x01k\x029\x01\xf4\x00\xcf\xff\xe2\xff\xb6\xfd\xfc\xfd\x80\xfcQ\xfc\x1d\xfb\xfa\xfb+\xfa\xd8\xfb\xe5\xfa\x8a\xfbV\xfb\x17\xfb8\xfb\x9e\xfa\xf4\xfaA\xfa\x17\xfb(\xfa)\xfb\xf6\xf9}\xfb\xfb\xf9\x17\xfc!\xfa\xda\xfc\xfc\xfa\x8b\xfdo\xfc4\xfe9\xfdd\xff\x01\xfeu\x00r\xfe\x04\x01\xab\xff\xe5\x01}\x01\xb6\x02s\x02\xee\x02\x00\x03\t\x03\xb9\x02P\x03\x1f\x02l\x02\xd6\x01\xe8\x01\xcf\x01\xed\x01)\x02\x10\x02\xa3\x01\x1a\x02\xe0\x00\xf6\x00\x9b\x00\x03\x00\xb3\xff\xaf\xff=\xfe\xba\xfe\xfd\xfc\x1b\xfe\x1a\xfc\xbb\xfdx\xfa\x8f\xfcj\xf9\x9a\xfa\xa2\xf9\"\xfa\xb3\xf9@\xfbP\xfaO\xfb\xd5\xf9\xbb\xfa\x82\xf8~\xfa#\xf8\x80\xfa\xf2\xf8i\xfb\xb1\xfa\xc1\xfc\xde\xfb\xca\xfd=\xfc\f\xfe\xe3\xfcS\xfe\xbb\xfd\xc2\xff\xa0\xfe~\x00K\xff\x18\x00\xe8\xff\x11\x00\x1d\x00\xde\xff\xed\x00\xd5\xff\r\x02\xe3\xff\xd5\x02\xfc\xff\a\x04x\x00s\x03\x1f\x01\xa7\x02p\x004\x03<\x00Z\x02\x13\x00\xc4\x01\xff\xfe\x0f\x01r\xfe>\xff|\xfdN\xfe\r\xfd\x93\xfd\xcc\xfc\t\xfd\xc1\xfcQ\xfc\xbb\xfc\xe8\xfbl\xfb8\xfb\xda\xfa\xd7\xf9M\xfa\xb9\xf9;\xfa\xcd\xf9\xdb\xfa\x82\xfa\xb0\xfbc\xfb\x19\xfcq\xfb\xd5\xfb\xc7\xfb\x9f\xfc,\xfd\xb0\xfd\x84\xff:\xffs\x02\xb2\x00s\x04:\x02\xa0\x03\xc0\x03\x01\x03\xb6\x02F\x04\xb9\x02\x1f\x060\x04k\ad\x04\xf9\a\xea\x03\xf1\x06X\x03\xae\x05\x1a\x02\xc0\x055\x01\b\x05J\x00\x8d\x04\xce\xfe\xb3\x03\x1b\xff\xb5\x02i\xff\x19\x03;\xff\xe1\x02\x84\xff\xd7\x02q\xffP\x02\xb1\xff\xf1\x00z\x00\xe4\xff@\x00\x96\xfe\x14\x00\x03\xfe\xbb\x00\x94\xfd\xf9\x00\x9e\xfd\x13\x01\x1c\xff\x05\x02\x00\x00\x95\x03+\x01\xfe\x040\x02\xd5\x05v\x02\x1a\x06\x0e\x03\x80\x05\xdf\x02D\x059\x03\xd2\x05a\x04\xda\x06\xbb\x05\x9d\a\x9d\x06J\a\xcb\x06\xe3\x065\aq\a7\a\x97\a\xed\a\xd4\x060\b\xff\x056\a\x1b\x05\xee\x05\xf6\x04\x13\x05o\x04W\x05b\x03A\x05T\x02\x9c\x03\xd3\x00B\x01I\xff\xe2\xfe\x87\xfdf\xfd\xa2\xfc\xbe\xfc\x83\xfbP\xfc\x90\xfa;\xfc\xe5\xfa\x87\xfc\xbf\xfb\xc3\xfc\x13\xfd\x97\xfcn\xfe\x80\xfcy\xfe\x87\xfc\xc8\xfe\xe9\xfbb\xff\xb3\xfbG\xff\x8e\xfc4\x00\xe9\xfd\xd5\x01k\xff\xbe\x02\xd0\xff%\x03\x14\x00C\x03\xf1\x00H\x03/\x02\x8e\x03\xac\x03\xc4\x039\x04\\\x03\x91\x03\x11\x03\x17\x03\"\x02!\x03\x8a\x01\xc1\x03`\x02+\x04\xca\x02z\x03\xcc\x02\xfb\x02\x11\x02U\x02\b\x01\x1e\x02\xe7\x00\x1f\x02\xfa\x00B\x01K\x00\xde\xff\xcf\xff\x1f\xfe\x97\xff\x19\xfd\xa3\xfe\xa3\xfc\xcc\xfd\xfd\xfbC\xfd@\xfbS\xfc\xcb\xf9\xf4\xfb\x99\xf8{\xfbG\xf8\x9a\xfa\xd3\xf8\xe1\xfa\xab\xf9h\xfb\xfb\xf9\x99\xfbu\xfas\xfc\xf1\xfaB\xfd\xbb\xfb\xe5\xfd\xaf\xfcO\xfe<\xfd\x1f\xfe\xf3\xfd\x05\xfe\xd0\xfd\xe5\xfd,\xfe\xb8\xfdt\xff'\xfe\xf7\x00\xc7\xfe{\x02,\xffh\x03\x1b\xffI\x03\xb9\xfea\x01\v\xfe@\x01'\xfe\xf5\x018\xffk\x01\x98\xff\xb6\x01&\xff^\x00\xf8\xfe[\xff\\\xff\xf7\xffK\x00\xf9\xfe\xae\xff\xa1\xfey\xfe?\xfe,\xfe\xdb\xfc\xfc\xfd\x0e\xfd\x1f\xfep\xfdo\xfd\xd9\xfc\x9c\xfc\a\xfc\x95\xfc\xc3\xfa\xe1\xfc6\xfa@\xfd1\xfa\x8a\xfc\xeb\xf9\xbf\xfbz\xf9W\xfb\v\xf9\x1c\xfb7\xf9\x98\xfb/\xfa\x06\xfc\xe4\xfa\xa4\xfbF\xfb\x88\xfb\xb2\xfbP\xfc\xce\xfbm\xfcI\xfc \xfcX\xfcS\xfb`\xfc\x1c\xfa8\xfdo\xfa\x97\xfd\t\xfb\xd2\xfd\xb9\xfa\x81\xfe5\xfbw\xfe\xb8\xfb\xc9\xfe\xe2\xfbC\x00\xbc\xfcK\x026\xfe\x94\x03\xc6\xff\xd4\x03W\x009\x03\b\x01+\x03g\x02\xd8\x03Z\x03)\x04\xec\x03\xac\x04\xd9\x03=\x04\xed\x03z\x03k\x03\xa7\x03|\x02\x89\x03:\x02\xe9\x03g\x02\xc5\x03v\x03-\x03\xdf\x03T\x03\xa1\x02H\x030\x02\xba\x02v\x02R\x02T\x02\x03\x02\xe8\x02\xa1\x01\xb4\x02]\x01\xdd\x01?\x01\x95\x01\xfd\xff%\x02)\xff4\x02\xeb\xff\x8c\x01\x02\x01o\x01B\x014\x01\xcc\x00\xdc\x00c\x00\r\x00\xed\xff0\xff\xbe\xff\x81\xfe\xcf\xff/\xfei\x00K\xfem\x00w\xfe\xef\xffA\xfe\x8f\x00}\xfe\f\x01\x87\xff\xdf\x00[\x00\xe5\x00\x8c\x00v\x01-\x01\xa5\x01\xfd\x01)\x01\x0e\x02\x9b\x00`\x017\x00]\x01\xb5\xffy\x01x\xffT\x01\x01\x00Y\x01\xdd\xffm\x00?\xfei\xff\x04\xfd,\xfe\xfc\xfc!\xfdV\xfdF\xfdI\xfdS\xfd\x94\xfcW\xfcz\xfc`\xfc\xd9\xfc\xfc\xfd\xae\xfd(\xff\x0f\xff\x1a\x004\x00o\x01j\x00B\x02g\x019\x03\xa0\x02\xdf\x03\x85\x02+\x04\xa1\x02\xbf\x048\x031\x05\x9d\x04\x93\x06\x9f\x05\xaa\a\xab\x04t\a\x19\x04\x93\a\x01\x04\xde\a\x90\x03\xc1\ax\x04\xf4\a\xd5\x04y\b:\x04\xfa\ab\x04\xbc\aC\x04&\b\x8c\x03\x91\a7\x03/\a\x98\x02\xa6\x06/\x02\\\x051\x02\x10\x05s\x01\x1e\x05D\x00P\x04\x97\xff]\x03 \xff\xd3\x02\xe6\xfe\xbd\x02\x80\xfe\x92\x02\x94\xfd\xa6\x01\xde\xfc\xc0\x00\x88\xfc:\x00*\xfdh\x00j\xfev\x019\xfe\x1a\x02\x86\xfd\xb3\x01\x98\xfen\x01\x87\xff\x12\x02D\x00\xb6\x02\\\x01\xf7\x02\xd6\x01\x9d\x03\r\x02\xf9\x03\"\x03\x8f\x030\x04B\x03\xe4\x03Y\x02\"\x03\xa3\x01Z\x02\xdc\x01S\x02\xc0\x01d\x02\x10\x01\x8e\x01\x98\xff\xcf\x00w\xfe\xe0\xff\xa3\xfec\xfe\xf9\xfd\xd1\xfcr\xfc\xe7\xfa\xaf\xfa2\xfa\xe6\xf9\xfb\xfa\x01\xfa\xb6\xfb\xe0\xf9\xf4\xfb\xef\xf9t\xfcB\xfa\xd1\xfc\xcf\xfa\xe3\xfd\x14\xfb\x9b\xff,\xfbb\x01\xb9\xfb\x85\x02\xfa\xfc,\x03\xce\xfdv\x04c\xfe\xb1\x04H\xff\xab\x04[\xff%\x04b\xff\xfd\x02y\xff\xde\x02]\xfe\xbe\x02\x86\xfe`\x02\xcb\xfe\xd5\x01\xc9\xfdJ\x01\x06\xfe#\x01\x03\xfd9\x00%\xfb\x94\xff\x1f\xfa%\xff\xc4\xf8\xe7\xfd(\xf8\x12\xfd\r\xf8.\xfd\xe9\xf7\xa9\xfd\x83\xf8:\xfe\x86\xf9\x05\xfeE\xfa\xa5\xfc:\xfb\x9c\xfb\xc9\xfb\xab\xfb\xff\xfb\x06\xfcw\xfc\xe5\xfc$\xfd\x1e\xfe\x06\xffo\xff!\x01L\x00\x97\x01\x11\x00@\x01\x11\x00\xc7\x00\f\x01\xba\x00N\x02\xb8\x00\x8b\x03\x99\x00<\x04\xa5\x00\x95\x04\x04\x01\xde\x05f\x01^\x06\\\x021\x06i\x02\xe0\x05\xc9\x01\x04\x04C\x017\x02\r\x00\xbc\x01\x1e\xff\xb3\x01\xef\xfe\xf3\x00\xaa\xfe\x9f\xff\n\xfer\xfe\xdc\xfd\x88\xfd:\xfd\x12\xfc\xdd\xfb+\xfa\xd2\xf9\xa9\xf8\xdd\xf7\x93\xf7\xcf\xf7\x14\xf7Y\xf8\xc7\xf7\x84\xf8\xc8\xf7\xfe\xf8\xaf\xf7\xd9\xf9\xac\xf7\xd3\xfa2\xf7A\xfb\xb1\xf7\\\xfbl\xf8<\xfcl\xf9\xb6\xfd\xc4\xfa\xbd\xff\xdb\xfb\xdb\x01r\xfd\x89\x03\xd6\xfe6\x04M\xff\x1f\x04W\x00\u007f\x04\xd5\x01B\x05m\x02>\x05&\x03\xc0\x04\xec\x03\x1e\x05]\x04\xd5\x05\x0e\x05\x0e\x06\x86\x05\xe2\x05\x87\x04c\x05|\x03\xdc\x04a\x03\xe9\x04\xa4\x03\x16\x05@\x03O\x05\xf5\x01\x85\x04\xc3\x00\xb3\x03x\xff\xf3\x02(\xfev\x01k\xfdK\x00\f\xfd\xa6\xff\x1a\xfc\x14\xff\xf7\xfb\xd4\xfez\xfcI\xff!\xfco\xfff\xfb\xdf\xfe\xcf\xfap\xfeK\xfa\xa7\xfe\xf4\xfa\x9e\xffe\xfc\x87\x002\xfd!\x01\xd7\xfe\xd4\x01\xa3\x00\xba\x02\x99\x01\x88\x03\xba\x02\xf4\x03\xab\x02\xa5\x03\xeb\x02L\x03\xf6\x04\x06\x04\xe8\x06\xee\x03\xbd\by\x03\xd4\b\x85\x03\xd9\a\n\x03\"\b\xbc\x02R\a\x1f\x02/\x05\x10\x01\x9b\x03\xf7\xff-\x02\xcd\xff\x8d\x01D\x005\x02T\x00\x83\x016\x00x\xff\xaf\xff\xcf\xfd\xe1\xfem\xfc\x17\xfe\xda\xfb\x9e\xfd\x17\xfb\x04\xfd\xba\xf9\xbc\xfb^\xf9\xdd\xfa\x97\xf9\xe4\xfa4\xf9\xca\xfa\xd6\xf8=\xfa\x9d\xf7a\xf9r\xf6\xf3\xf8f\xf7o\xf9c\xf8@\xfa\xf8\xf88\xfa4\xfa\xfb\xf9\x11\xfb\x1b\xfb\r\xfc\x96\xfc\xe5\xfc\xbe\xfc?\xfd\xb6\xfc\xab\xfd\xe3\xfc\xca\xfe$\xfd2\x00*\xfe\x95\x01\xa0\xfe\xea\x02\xa7\xfe\xfb\x03T\xff\xd7\x04\xf0\xff\xda\x05\x1b\x00G\a\x94\x00\xe7\ak\x01~\x06A\x01\x13\x05\xbf\x00\x11\x03q\x00c\x01m\xff1\x01\xff\xff\x15\x01W\x015\x01\xdd\x01|\x00q\x02d\x00\xd4\x02%\x01\xfe\x03\x88\x00\x85\x04m\x00\x02\x03\xcd\x00\x1d\x03m\x00\xc9\x04\xda\x01|\x05\x90\x05<\ax\ab\tD\b\xc0\t\x12\nv\n>\n\xa9\vE\t\x1d\v\x99\bs\nf\b!\v\xcd\b\xe7\v\xef\n\x97\f\xb1\r\xf4\r \x0e\x97\x0eN\r<\r\x8d\v\xa4\v\"\tm\t\x8c\aX\x06\xe8\x05\a\x04\x97\x04\x12\x03\xb4\x04\xc3\x02d\x05\xdc\x02Q\x05[\x02f\x03\xb5\x01\x97\x00\x92\x00]\xfe\xb0\xfeq\xfc\xab\xfc\x95\xfaO\xfb\xd7\xfa3\xfb\xe0\xfb\x9b\xfcT\xfc\x02\xfel\xfd\xfe\xfdN\xfdL\xfd\xb4\xfb\x98\xfcV\xfac\xfb*\xf9\xf0\xf9\xbb\xf8\xe4\xf8O\xf9U\xf8+\xfaP\xf9\xf6\xfa[\xfa\x84\xfcY\xfb\x93\xfd\xde\xfc9\xfd\r\xfd\xdb\xfc0\xfc\x92\xfc\xbc\xfct\xfc\x00\xfe.\xfd\xa1\xfep\xfeO\xff\xd7\xff\a\x00\\\x00\xb1\x00\xf7\xff\u007f\x00K\xff\x00\xff\x80\xfe\xeb\xfd\x1f\xfdF\xfd\xbc\xfb\xe2\xfb\x14\xfb\xf9\xfa+\xfaV\xfbZ\xf8\xa1\xfay\xf7:\xf9n\xf7\xfb\xf9\xcb\xf6\xf9\xfaH\xf6B\xfa\x8e\xf6{\xf9U\xf6\u007f\xf95\xf6\"\xf9\n\xf7\xed\xf8X\xf7\x95\xf9\xba\xf7\xbe\xf9k\xf8\xe9\xf9\xc3\xf9\x99\xfa\xcb\xfbT\xfb[\xfd\xb2\xfc\xb3\xfe\xcd\xfdh\xff\xc9\xfdD\xffb\xfd\xb5\xff\x8e\xfd\xca\x00F\xfe\x94\x01W\xff#\x02!\x00\xdf\x02(\x01\xdd\x03\x96\x02\x8c\x04H\x03{\x04S\x03H\x03\xf3\x02\xc3\x017\x02.\x01\xd2\x01\xba\x00\x97\x01\xa9\x00\x13\x01\x01\x01\xc9\x00\xba\x00Q\x00p\x00\xa5\xff\x1d\xffp\xffY\xfc:\xfe\xae\xfa\xe5\xfc\x8f\xfa\xf9\xfc\x94\xfb\x97\xfd3\xfc{\xfe\xac\xfc5\xff\x97\xfd\xa2\x00\x18\xff/\x02 \x01P\x03\xfc\x017\x048\x02\x1a\x04\x0f\x031\x04^\x05J\x05j\b\xec\x06\x15\vc\b\xb0\f\xaa\t\x87\fm\t\xc1\fS\b\x1f\r\xef\a\xb8\f,\a\x05\f2\x06H\n\xc0\x04\xb0\tM\x03\a\nk\x03\xb5\b \x03\xac\x06\xb6\x01O\x03\xd7\x00\x0e\x00\xe4\xfe4\xff\xcd\xfcZ\xfe\xfa\xfc\xe5\xfd1\xfd\xb9\xfe\x9f\xfd\x19\xff~\xfe#\xff\xa3\xfe:\xff\x80\xff\x11\xfej\x00\xd9\xfc\xde\xffm\xfd\x89\xff\x88\xfe\xee\xff]\xff\xca\x00\xaa\x00*\x02\xd0\x01\x87\x03X\x02\x97\x04\xc0\x02\xec\x04\x01\x03\x05\x04!\x03\xa1\x03\b\x03\xee\x02\xdd\x03\xbc\x01\x11\x05\xa5\x01\xe1\x05\x14\x01\x11\x06B\x00\x8f\x05&\x00\xf3\x04\xf9\xffx\x04T\xffa\x03\xd8\xfd\x06\x02K\xfc\xaf\x003\xfb\xfb\xffw\xfa\xe0\xff\x9f\xfa\x1a\xffe\xfbm\xfd\xfc\xfaH\xfb\x1c\xfah\xf9\r\xfa\xa9\xf8B\xf9a\xf8\xf0\xf7Z\xf7\xff\xf6i\xf6\x10\xf64\xf6o\xf6\xb5\xf6N\xf8\x06\xf8\x1c\xfaf\xf9\xff\xfaX\xfa\x06\xfc\xe2\xfa/\xfd\xcb\xfb\t\xfeg\xfdC\xff\xdb\xfe\xdb\x00\x91\xffS\x02I\x00p\x03h\x01Y\x05\x00\x03\r\a\xf2\x04T\a\x93\x06\xa1\aV\a1\b\xc3\a<\b\xc9\a\xc0\am\aE\a\xaa\x06\x97\x06#\x06\xce\x05\xc5\x05\xb6\x059\x05\x04\x05\xc3\x04\xdc\x03\xed\x03V\x03C\x02\xc1\x02\xe4\x00T\x02\xa0\xff=\x028\xfe\x12\x02B\xfd\x93\x01\xd3\xfc\xfd\x00\x93\xfc\xff\x00\xde\xfb\xee\x00\xdd\xfaJ\x00\xe8\xf9R\xff,\xf9\xaa\xfe\xc0\xf8O\xfe\x11\xf9%\xfek\xfa\x98\xfe}\xfc<\xff:\xfe\xb3\xff]\xff\x15\x00\xab\xff\xb3\x00R\x00\x8c\x01P\x01(\x02\xf7\x01[\x02\xa0\x02\x8e\x02\x04\x03\xe1\x02\x87\x03\n\x03f\x04*\x03\x1f\x05\x00\x03R\x04\xf8\x02\x98\x02\xc6\x029\x01\xe9\x01>\x00\xfd\x00\xfc\xff\x19\x00\xe2\xff\x11\xff\x94\xfeD\xfe7\xfc\x96\xfd\x1f\xfa\xef\xfc\x90\xf8)\xfc\x94\xf6\"\xfb\xb8\xf4\xff\xf9\x8d\xf3(\xf9\xd1\xf2\x18\xf9\xe3\xf2\x14\xf9(\xf3\xd3\xf8\xf5\xf2\xb7\xf8\xea\xf2\xb8\xf8\x87\xf3\xbb\xf8\x91\xf4t\xf87\xf54\xf8\x94\xf5\xd9\xf7\x1c\xf7\xa9\xf7\xaf\xf9\xd1\xf8/\xfc8\xfa\x1b\xfe\xb0\xfa\x8d\xffP\xfb\xc7\x00%\xfc\xd7\x02\xbf\xfc\x86\x05\xf8\xfdu\a\xee\xfe\x99\bF\xff\x88\t\x8a\xff\x8c\nh\x00\xa7\v8\x02?\vf\x03A\t\xdc\x01\xa2\a8\x00F\a\x82\x00\x8d\am\x01R\x06\xc0\x01,\x05\xcb\x00\x99\x03\x84\x00y\x01\xc6\x00\xa2\x00\xcf\x005\xff\xef\x00\x85\xfd\x1f\x00a\xfdR\xffd\xfd\xe6\x00\x04\xfe\x8c\x02\x15\xff\xcf\x02\xf0\xff\xd6\x03!\x00\xaa\x04\xe8\xffz\x04\xdf\x00\xc5\x04\xe0\x02\x06\x06\xf5\x047\a\xdb\aF\b\xa1\v\x06\n\x16\x0e\xff\v\xeb\x0e\xe0\v\x95\x0f\xfa\n[\x0f\xd0\n\t\x0f\x90\t\a\x0f\xed\a\xdf\x0el\a\x90\x0fm\a\xb1\x10\xd5\a\xdd\x0f\f\bk\r\x9b\x06[\n|\x04~\aX\x03\x91\x04\xe3\x01\xbb\x01\xde\xff\xfd\xff\x1d\xfe\xc1\xfez\xfd\x05\xfe\x19\xfe\x8c\xfdR\xfe\xa1\xfbA\xfd\xa2\xf8\x9a\xfb+\xf6\xf8\xf9j\xf4\b\xf9\xae\xf3\xdf\xf8\xfc\xf4\xa4\xf9\xbf\xf6\b\xfb\xe8\xf7R\xfc\xda\xf8\xb4\xfd\xb2\xf9\x9e\xfe@\xf9\xa7\xfe\x97\xf8\x1d\xfe>\xf9\xf2\xfdW\xfb\xf1\xfe\xaf\xfd\x9e\x00v\xff\xce\x01\x0e\x01\x00\x03>\x02(\x04\xa5\x02a\x04E\x02\xd7\x03\xe1\x00\xc5\x02\x13\x00\x86\x01\xb7\x00\xee\x00j\x01z\x01\x98\x01\x1b\x02\x0f\x01\x04\x02\x8e\xff\x9f\x019\xfd\xbf\x00\xcc\xfa5\xfff\xf8;\xfd\x8c\xf63\xfb\u007f\xf5\xde\xf9\xa3\xf4p\xf9\t\xf4I\xf9\xe5\xf2\xc8\xf8\x86\xf1\xd3\xf7\x9d\xf0\xec\xf6\x11\xf0\u007f\xf6|\xf0\xb8\xf57\xf1A\xf5\xbf\xf2\x1b\xf67\xf5n\xf7\x00\xf7\xd7\xf8v\xf7Q\xf9\xd1\xf7?\xf9\xc7\xf8\x04\xfa\xcf\xf9\x15\xfb\xb4\xfa\xbd\xfb\xb2\xfb\xe0\xfb\x9a\xfc\xb6\xfb2\xfd\x04\xfc!\xfd=\xfce\xfc2\xfbZ\xfb\xf0\xf9d\xfa\xed\xf8\xf2\xf9\"\xf8\x81\xf92\xf7P\xf9l\xf6\xdd\xf9\x91\xf6\f\xfbj\xf7\xf7\xfa<\xf8\xd3\xf9D\xf8\x87\xf8\x88\xf7t\xf8\x17\xf86\xfa\xe8\xf9V\xfc\xa2\xfb\x83\xfd\xd3\xfc\xe5\xfd\x00\xfe\xbb\xfe\v\xff!\x00\xe0\xff\x8a\x00\x0e\x00\x18\x01\xed\xff\a\x02\x14\x01\x9c\x02:\x02\x8a\x04d\x02]\aN\x03[\t\xd4\x04\x9f\tB\x05\xa0\t\xd0\x04\xe7\n\x9d\x04\x05\vv\x04p\t\x99\x03%\bD\x02\xe0\bi\x02;\v\x9d\x04\x1e\f*\x060\fr\x06\xb4\v\xce\x06\xc6\v\xfd\x06W\f\xeb\x06n\v\r\x06\x1c\nl\x05\xd1\n\x12\x06\x17\f0\b\x8c\fA\tB\x0e3\n\x00\x10\xd5\f\x9f\x0fP\x0eO\x0e|\rR\r\xfc\f\xa1\f\xa8\r\xdd\v\xc2\r\xdf\n\xa6\r>\v\xa9\x0e\x8b\f\xb5\x10\xe0\r\xd5\x116\x0e3\x110\fh\x0f\xa8\b1\f}\x05Q\b}\x03\xe3\x05\xf9\x02\x12\x05\xce\x04\x1d\x05\xa3\x066\x06\xa6\x06{\x06\xde\x06\xae\x05\xfb\x06Q\x05k\x04-\x03\xd7\xff{\xfe\xdc\xfbd\xfbo\xfa\xc0\xfa\\\xfc\x13\xfb\xd7\xff\a\xfd6\x02\a\x004\x03\u007f\x01\x13\x03c\x01\x99\x00Q\xff\xa0\xfc\x82\xfbN\xf9~\xf8\x0f\xf7\x9a\xf7M\xf7P\xf87\xfb\x14\xfb\xea\xff\xb1\xfeQ\x02\xc5\x00\xbb\x01\x06\x01\xbf\xfe\xf3\xff\xf0\xfaF\xfd]\xf7\x0e\xfa\b\xf5a\xf8C\xf5Z\xf9E\xf8\x1f\xfc\xfd\xfc\x1d\xffO\x00\x90\x01\x9c\x00\xc0\x02F\xff\xa0\x01\xef\xfc\xb0\xfeo\xf9\xea\xfa\xb2\xf6\xca\xf7\xfe\xf64\xf7\xa4\xf9\x11\xf9\xdc\xfc(\xfb\xec\xffT\xfd\x0f\x01I\xffg\xff\xed\xfe)\xfc \xfc\xd7\xf8F\xf9v\xf6\x97\xf7\xa0\xf56\xf7;\xf6\x05\xf8\xf8\xf7\xfc\xf9!\xfa\f\xfd\xe2\xfa\xf2\xfe\x01\xfa\xe8\xfe\xd9\xf8\xd2\xfdB\xf7\xbf\xfb6\xf5\xff\xf8\xa7\xf3\xf6\xf6\x1c\xf4\xbf\xf69\xf6\x16\xf8\x1c\xf8\xee\xf9\xe9\xf8\xe7\xfa4\xf9a\xfb)\xf9\xce\xfb_\xf8I\xfbr\xf7\xce\xf9\xca\xf7\x1b\xf9\xa1\xf9\xb5\xf9\xb6\xfbO\xfb\xf4\xfc\x19\xfd\xc2\xfe9\xfe*\x01\x9b\xff\x18\x02\xa5\x00\x97\x02\xd3\x00!\x04\r\x01\xa6\x06\x99\x01\xc2\aC\x02\x88\al\x03\xdd\b\xe0\x05a\v\xdf\b\a\fz\ny\n[\nj\t\xf7\t\x8a\n\u007f\np\v\xa8\n\xdf\t\xaf\tF\b$\t\b\b\xbb\b\a\bj\b\xf8\x06a\b\xc5\x04\x90\a]\x037\x05-\x03\xc6\x02x\x03\x12\x02\xe6\x04o\x02\x1a\x06\xe5\x028\x06\xdb\x02\x86\x05\xad\x02\x01\x05\x14\x03\xb1\x04\xba\x03\x93\x02\xea\x02\xce\xffv\x00\x14\x00\xc5\xff:\x029\x02\xc7\x03\xa3\x03\xd0\x03\xf8\x02\x87\x03u\x02\xca\x04\xb9\x03\xbc\x04b\x04\xa4\x01\xdb\x01A\xff\xc3\xfeI\x00\x89\xfe\xfd\x02G\x013\x04\x1d\x03\xb6\x04\x10\x03\xb4\x06:\x04S\b\x89\x06\xb5\a\x17\a\t\x06\xb8\x05]\x04\xb2\x03\x91\x03\x8f\x02S\x03i\x03\xe1\x02\x86\x04\xde\x02\x13\x04.\x03\xe0\x02\u007f\x01\t\x02\x17\xfe\\\x01\xc7\xfb2\xff\f\xfa\xba\xfb\xa0\xf7w\xf8\xdd\xf5\x8d\xf70\xf6\"\xf9\xf1\xf7-\xfa\xa5\xf8\u007f\xf9\xed\xf7\xbf\xf9\x06\xf7/\xfb\xea\xf6\x9c\xfb\x02\xf7\xa8\xfa\xe2\xf5H\xfao\xf5\xc6\xfb\xa6\xf7!\xfe\xb7\xfap\xff\x1b\xfc\x98\xffo\xfc\x18\x001\xfd\x03\x01{\xfe+\x01\xbc\xff\x97\x00J\x00\xcb\xff \x00\xba\xff\x84\x00\xf9\xff\x9e\x01\xce\xff\xed\x01\xf1\xfe\xe7\x00)\xfe\x17\xffd\xfd\x9d\xfd7\xfc\x14\xfdB\xfb\xff\xfc\xdf\xfa\x16\xfcf\xfa\xdc\xfa\x12\xfau\xfa<\xfaI\xfa\x1d\xfa\xbe\xf8\x00\xf9d\xf6\x8d\xf7\x13\xf5\xb4\xf6o\xf5\xab\xf6|\xf69\xf7\xb8\xf7Q\xf8\xe7\xf8t\xf9F\xfa\\\xfa0\xfb\x03\xfb\x82\xfa\"\xfb\xaf\xf8\x13\xfb\xdb\xf7&\xfb}\xf8D\xfb\x18\xf9\v\xfcf\xf9\x03\xfd\xab\xfa}\xfd\xa2\xfc\x86\xfd{\xfd>\xfd\x98\xfc\xd3\xfc\x8f\xfb\x96\xfb[\xfb\xe4\xf96\xfc\b\xf9{\xfd\x91\xf9\x9a\xfe\x1b\xfa7\x00\\\xfa\xb7\x01\x93\xfb]\x02\x90\xfdW\x02\xcb\xfe?\x02\xcc\xfe:\x02#\xff\x8d\x03\xaa\x01\xa0\x06\xd8\x05\x02\t\x06\bE\t\xa2\a~\b\xc9\a\x9c\b\x92\t\x91\t\xc0\n,\t\x89\t\x92\x06\x83\x068\x05|\x04S\aD\x05i\n\xc5\x06\xc0\t\xdb\x05\x92\x05\xd4\x02_\x02\xc0\x00[\x01C\x01\xd5\x00\x84\x01y\xff\xc9\xff\x8f\xfd}\xfd\xab\xfe\x91\xfd[\x03/\x01:\x06}\x04?\x05\xbb\x03\x93\x02:\x01\xff\x00x\x00\xe1\x00\x97\x00\x16\x01\x9c\xff1\x01\x89\xfe\xec\x01\xb1\xfe>\x05\xd9\x00\x9e\n\x8c\x04V\f\x86\x060\tQ\x05`\x06\xfe\x03\x16\x06\xe8\x04\x00\x060\x05s\x04}\x03\r\x03\x0f\x033\x04\xa6\x05\xbc\a\x9e\bO\t\xc3\t\xda\x06a\b\x8e\x03\xca\x05\xe7\x017\x04\x98\x00\x82\x02/\xff\x83\xff\x9c\xfe\xa5\xfd5\xff*\xfe\xb4\x01\x8e\x00\x9f\x055\x03\xa1\aP\x04A\x06\n\x03U\x04\u007f\x01\xb8\x03\xd8\x01\xe9\x02\xc5\x01\xae\x01\xb5\xff1\x00\x9f\xfe\x12\xffC\x00k\x00\xfc\x02\xdf\x02\xd2\x03^\x02(\x02)\xff\xdf\xff\x11\xfd\x8d\xfer\xfcA\xfd`\xfb\xb6\xfa\xf1\xf8\xa1\xf8\xc6\xf6\x0e\xf9\xab\xf7h\xfb.\xfb\xc8\xfd\xe3\xfd\xfd\xfe\x8b\xfd\x88\xff\xea\xfb\xea\xff\xd9\xfb1\x00!\xfcS\x00\xf9\xfa\xfe\xffC\xf9\xba\xff\xd1\xf8\x16\x01\x9d\xfb\x94\x03\xfd\xff\x84\x05\xa6\x01\x95\x05P\x00d\x04\x82\xffc\x03\xd6\xff\xc8\x01\x89\xfe|\xfe$\xfb\xbb\xfaY\xf8\xee\xf8l\xf8{\xf9\xd8\xfak\xfa\xa9\xfc\xa0\xfa?\xfc\x99\xfbW\xfbC\xfd\a\xfb\x12\xfd\xab\xf9\f\xfbS\xf6\f\xf9\x0e\xf3\xe5\xf7\xdb\xf1\xaa\xf7\xa4\xf2c\xf7\x9d\xf3M\xf7*\xf42\xf8L\xf5\xb1\xf9\r\xf7\xfd\xf9\xf3\xf7\x1b\xf9U\xf6`\xf7\xdf\xf3*\xf5\v\xf3\xe0\xf3\x9d\xf3\x96\xf4l\xf4\xb6\xf5\xe9\xf4F\xf6V\xf5=\xf7&\xf7W\xf9D\xfa\x85\xfbt\xfcS\xfc\xef\xfb\xaa\xfb<\xfa3\xfb?\xfb\xe1\xfc\xda\xfel\xffe\x01'\x00\x81\x01\x06\x00\xae\x01\x10\x01-\x04\xb2\x02\xfc\x06\xcd\x02\xb9\x06\xa0\x00\xd3\x04\xed\xfeF\x04C\x00\xa8\x04N\x02\xc8\x04\xc0\x01\xfb\x04\xf0\x00\x8e\x04\xa6\x02\xa2\x04;\x05\xc3\x06\x0e\x06\x0e\tA\x05\xa7\t\x99\x04[\b\xf5\x04\x00\a\xe5\x05\xc5\a\x8a\x05\x14\b\xb1\x03K\x06x\x02\xc3\x03\x8e\x02\x99\x03\x03\x02t\bV\x02\xa7\r\x8d\x04\xc5\x0f(\a\xc6\x0f\xe1\t0\x0e\xdb\nF\f~\b\xf3\t\t\x06\xf1\x05\x94\x05\xa1\x02Z\x05\xbe\x03\xf5\x03\xf2\b\x10\x04\xa3\r\xa3\a\x1b\x10\xc4\v\xb0\x10\xf4\f\xd9\r\x1b\n<\t\x8c\x05l\x06!\x04\x11\x04{\x04\xb3\x01\x9d\x01r\x03>\xff\xc7\b\xb5\x03{\f\xb2\n\x9d\f\xd8\f\xc1\n\x96\n\xc3\aU\b\x88\x03\xe5\x06\x10\xff\x12\x05\xb0\xfc\xb2\x02\xc5\xfe\xb2\x01\xee\x03>\x03S\b\xcc\x06)\v\xe5\t\xfb\x0ed\v\b\x10\xb0\n\x17\f|\a\x87\a\x9b\x04\xed\x02\xb5\x02\xf4\xfet\xffs\xfd\xd7\xfcu\xfd\x8f\xfe@\xfd\xc0\x01<\xfe\xc4\x01\xeb\x00\x87\x01\xe4\x01\xf7\x02\x96\x00\xfd\x02\xea\xfe\x91\x00c\xfa\x1a\xfda\xf5 \xfa;\xf4\xb7\xf8{\xf3\xd9\xf7,\xf1\xd6\xf5a\xf1 \xf5\n\xf5`\xf7\\\xf8Y\xf9\x11\xfa'\xfad\xfam\xfb\x1f\xfaz\xfdX\xfb\x86\xff\xa1\xfdY\x01\xd8\xfd\xba\x02v\xfd\xb4\x03O\xfe\xae\x04\a\xfe\xd4\x04|\xfc\a\x03\a\xfb\x85\x00\x89\xf8\x8d\xfeY\xf6\xcd\xfcX\xf7d\xfb\x1a\xfa\xaa\xfah\xfbX\xfb\xa3\xfb^\xfdP\xfb{\xfeA\xf9\xef\xfcM\xf6\x0e\xfa?\xf2Q\xf7\xc2\xedi\xf4\xc1\xec\xfc\xf1\xce\xef\xc0\xf1^\xf3'\xf4T\xf6\xcd\xf7\xd8\xf9 \xfb\xf2\xfb\xb7\xfc\xe4\xfa\xfa\xfbf\xf7\x9c\xf9\xe8\xf2\xff\xf5Q\xf0F\xf2#\xf1\x1a\xf0w\xf3!\xf0S\xf7\xd4\xf2\xd6\xfc\xd4\xf7\xf0\x00\xae\xfb\xcd\xff8\xfc5\xfb\x05\xfb\x12\xf6\xcb\xf8\xe5\xf0[\xf5\x01\xeeu\xf2\xf8\xefL\xf3\xae\xf5]\xf8s\xfc \xfe\v\x03}\x02\x12\bD\x06\xa2\tS\b\x96\x05&\x05\xab\xfd\x9a\xfd\x03\xf9\x83\xf8\xd2\xfb\x16\xf9K\x02\xb9\xfc\xa6\a\xaf\x00|\rK\x05\xb5\x141\v\x98\x19\xef\x0f\xf5\x18\x9e\x10\xe7\x12\xcc\f\xa6\n\x80\x06\x11\x06\xa1\x01%\be\x02K\r\xb1\a\x00\x11\xee\v\xf2\x11\xa2\fT\x13\x13\rC\x16\xff\x0e\xec\x15'\r\xf5\x0e\xbd\x05\xf1\x04\x15\xfe\x98\x00B\xfb\xde\x03Q\xfe\xaa\x06G\x03\x16\x05\xe1\x05o\x020\x06\xf0\x03\xe9\x06\r\b\x90\b\r\t\t\b\xfd\x06$\x05{\x05\x98\x03\xd6\x06\x8f\x05\x03\n5\t\x00\r\xbf\f\xee\v+\x0e2\t\x04\fJ\t\x13\tG\v\xca\x06m\v\t\x05\xe6\bg\x035\a\b\x03\xbb\a\x82\x05W\b\x98\b\x91\x06[\t\x92\x03\x82\bT\x01\xc4\a\xe1\x00\xa5\x05\xb7\x00\xae\x02\xc0\xffh\x00\x9f\xfe\x15\x00\x95\xfc\xbf\x00\"\xfbp\x00\xf8\xfa\xe9\xffv\xf9Q\xff\xae\xf6\xd3\xfdh\xf6\x94\xfb\xf0\xf8\xf7\xfaD\xfbv\xfbZ\xfc\x1f\xfb\x99\xfd*\xfb'\xffg\xfc\xa3\xffL\xfd\xbd\xfc\xb7\xfc\xce\xf6\xb9\xfa\xf6\xf2\xeb\xf8\xd8\xf3\v\xf9\x85\xf5G\xfb\x8b\xf6\xb8\xfd@\xf9`\xff\xbc\xfd\xad\x00\xce\x02e\x02\x90\x04\x1d\x03\x14\x00e\x00\xaf\xf8\xc1\xfaW\xf5\xb0\xf6\xf9\xf57\xf7\xbe\xf5r\xf8\xd9\xf4\x90\xf8\\\xf6c\xfaI\xfb\x05\xff\xad\x00b\x03>\x01*\x04R\xfc\xa1\x01E\xf8t\xffs\xf8\xea\xfe<\xf9\xf1\xfd8\xf9\xf5\xfc\x9f\xf9\x9a\xfd\xf5\xfaR\xff\x98\xfd\xac\x00\xe4\xff\\\x00\xb8\xfe\x12\xfet\xfb \xfb\xba\xf9\xfc\xf9\x8e\xf9f\xf9%\xfa\u007f\xf7\x06\xfbB\xf7\xba\xfb\xe6\xf9\x18\xfc\xfc\xfc\xa7\xfc\xc0\xfdO\xfc.\xfc>\xfa\xd6\xfa\xbc\xf8\x9e\xfb\xb2\xf8\xfd\xfb\xfd\xf8\x93\xf9!\xf9\x98\xf7\xd1\xf9\x84\xf8\xd6\xfb3\xfb\xc8\xfd*\xfcy\xfeW\xfa\x92\xfc\xbf\xf7\xcd\xf9\x85\xf7\xc8\xf9f\xf8\xb8\xfa\xb6\xf6\x93\xf8\xc5\xf3K\xf4A\xf2\xaf\xf2)\xf3\x1d\xf5\xa7\xf5\x8f\xf8\xc0\xf7\xc3\xf8\x02\xf9H\xf7Q\xfb\x05\xfa\x91\xff\x1e\x01\xcf\x02\x10\x05j\x02D\x02\xf5\xff\xf9\xfdr\xfe\xd8\xfc,\xfe/\xfd\x12\xfd\u007f\xfc$\xfbk\xfb\xa9\xfb\x15\xfcq\x00C\x00\x96\x04l\a\xea\x04\x89\rh\x05\x1a\x0e\x17\ak\n\xc5\x06\x8d\b\xac\x04k\tB\x03a\t\xc2\x02#\a\xa4\x04*\x05\xf9\x06\xc6\b\xed\x06u\x0f\t\a\x9a\x11\x11\b\xff\x0e\xa0\a\xaa\x0e\x11\x06\xa6\x10\xc9\x06\x04\x10\x84\a\xa9\x0f\xe8\x06/\x0fr\b\\\fT\t\x10\v\xda\x06\x87\f4\x065\v\x98\a\xa7\a\b\x06U\a\xcf\x05\xed\a\x19\bN\b\xc0\b\x82\t~\n\xf9\b\xb4\f\x1d\b]\v\xc8\b\xca\t\xd0\b\x99\n*\a\x14\n\x02\aX\b\xb8\bD\b\xd0\tu\t\xc0\t#\v\xf4\b\xc7\v\xf3\x06\xfc\n[\x06\xb0\tp\aO\t\xbb\x04\x9b\a&\x01\x13\x05~\x03\xc5\x04\x19\av\x04\xe7\x05\x8a\x01\x1e\x02\xc6\xfeB\xfe+\xffF\xfb\x83\xfdZ\xfbC\xf9\xa1\xfa\xfd\xf7:\xf7<\xf9\xa6\xf6\x0e\xfaf\xfb\xbb\xfa\x8d\xff\x8e\xfb/\xff\xf6\xfb\x9e\xfb\xe6\xfb,\xf7\xc7\xfa~\xf5o\xf9\"\xf7\xd0\xf8 \xf6\xb5\xf7X\xf2\xee\xf7\xc9\xf2(\xfb\xf1\xf6G\xfd)\xf9\xa7\xfb{\xf87\xfa\x98\xf54\xfb\xf3\xf2\xa9\xfa-\xf4\xa3\xf7l\xf67\xf6\xbe\xf5\xe1\xf6+\xf5q\xf8\xe3\xf6\xc5\xf9z\xf8\xb2\xf9\xc7\xf9\x05\xfa\xe3\xf9\xc3\xfb\x84\xf7]\xfc$\xf7\xd6\xfbU\xfa\xf6\xfcu\xfc4\xfe\x1b\xfc\xee\xfe\x06\xfc\xab\x00s\xfc\xdb\x00\xd4\xfc\xbe\xfe}\xfc\x8f\xfe\\\xfan\xff\xda\xf8.\xfe\xbe\xf9q\xfdR\xfat\xfd\x8f\xf9P\xfdW\xf9W\xfeC\xfa\xcc\xfe\xd7\xfa\xe6\xfd\n\xfb\x03\xfe\xd3\xfb\xd6\xfe\xe3\xfc\xd6\xfe\x81\xfe\x0f\xff\xfa\xff\xad\xfeC\xff\x18\xfd\xe2\xfc\xd7\xfc\x12\xfbx\xfd\xed\xfaw\xfb\xe4\xfa\x97\xf9_\xfa\r\xfa\xe1\xfb\x95\xfbi\xff\xb4\xfc\x1e\x03H\xfd\x8c\x04\x82\xfd\xdb\x01X\xfd)\xfd$\xfc\x16\xf9\xdb\xf9\x81\xf5\xa6\xf7k\xf3t\xf6`\xf4\x9b\xf6#\xf7\x9b\xf8Y\xfa)\xfc\xbb\xff\xca\xfe\xa2\x04 \x00=\x03M\x01\xd6\xfei\x01\xd7\xfd\x99\xff\xa2\x001\xfe\xf0\x02\x11\xff\x9a\x034\x01)\x06t\x04\x84\vf\a\xe3\x0f\xce\t\x85\x0fT\fQ\f\xbf\f\x9f\v\xcd\n:\x0e\x01\n\xa4\x12\x14\f\b\x17|\x0e\xf9\x19\xa2\x0f\x1c\x19\xbe\x0f\a\x16\xf6\rI\x13^\v\x14\r\xea\a|\x05\x1e\x03:\x03\xa8\xffT\x06\xc9\xff\xbf\n\xb8\x02\x1b\r\xc9\x05\xc6\x0e\x0e\b?\r\xd3\aN\a\xf3\x03r\x01\u007f\x00\xff\xfd\x84\xff\xfa\xfb$\xfe\x84\xfc\xc2\xfc\xeb\x01+\x00\xed\b\"\a{\f\xe0\v~\v\xec\v&\b\"\t\xa8\x05\xba\x06\xe1\x03\x8a\x05\xdc\x01\x9a\x04\x9c\x03\xc4\x04b\t8\b\xa8\f\xf3\vd\f\xa2\f\xad\n\x12\f\xa7\a\xf2\t;\x03\xf5\x04\xcf\xfe\x02\x01\xea\xfb\xf9\x00\x02\xfb'\x01\v\xfd\x15\x01$\xff\xc8\x02\x04\xfe\xcf\x03?\xfb?\x01\xca\xf7\xa8\xfb[\xf5^\xf6\xe7\xf4@\xf4x\xf5\xb3\xf4\x01\xf7\xb5\xf50\xf9m\xf8\u007f\xfb\xd4\xfc\n\xfdS\xfeU\xfb\xd9\xfc\xba\xf6\xcb\xfa\xd3\xf2\xc5\xf7D\xf2.\xf4\xe3\xf3\xb8\xf3k\xf4\x01\xf7+\xf5P\xfa\xf6\xf7F\xfc\xd4\xfav\xfe\a\xfb\xfd\xff%\xf8\xbd\xfeK\xf5\x0f\xfbc\xf4b\xf7,\xf5\xa9\xf6\xe0\xf6\xf7\xf7T\xf8\xbe\xf8\x0e\xf9\xdf\xf9\x9b\xf9\x15\xfc\x95\xfap\xfd\xce\xfa\xfd\xfc\x02\xf97\xfc+\xf7\"\xfc]\xf7\xf0\xfb\xa9\xf9\x94\xfc\xbb\xfb\xbf\xfeU\xfc_\x00i\xfd\xb7\x00\xdd\xfe\x9c\x01i\xfeb\x02R\xfc\xb5\x00.\xfb\x9a\xfd\xa5\xfbI\xfc\\\xfc\xeb\xfc\xcd\xfd\x10\xfd\x9e\xfe\xbb\xfc\x85\xfe%\xfd\n\xff\x86\xff\xdc\xfe\x8d\x01\xfd\xfde\x01_\xfd'\x01\x88\xfd\xdf\x01\x05\xff\xe6\x01\xc0\x00p\x01\xc7\x01w\x01\xb0\x02\x1c\x01\x91\x03\xcf\x00\xf5\x02\xe4\x00/\x01\t\x00,\x00X\xfe\x1b\xfe\x8e\xfc\x98\xfb\x10\xfa!\xfck\xf8g\xfe\xfa\xf8\xc0\xfd\x13\xf9_\xf9\xdf\xf6\x0f\xf7V\xf5!\xf9x\xf6A\xf9\xdf\xf6\x9d\xf5m\xf5w\xf4c\xf50\xf9m\xf8d\xfc5\xfc\x80\xfay\xfc\x9c\xf9\x18\xfcm\xfb\x18\xfe\xd4\xfc\x84\xffT\xfd\x1d\xff:\xfe\xaa\xfe\xd4\x01\xca\xff\x93\x06|\x02L\v\x9a\x06\xda\rq\n2\rl\n5\v)\b\x16\v\xde\a\x9b\x0e\xab\n0\x10\x80\vJ\x0f\xf5\bx\x0f\x04\t\u007f\x113\fy\x13\x15\x0e\xed\x11)\fp\r3\bD\n\u007f\x04\x05\fb\x04\x1a\x0e\x18\a\f\x0e[\x06u\r\x1c\x05x\n\xaa\x05h\b\xee\x04\x1d\b\xb6\x03\x12\x06\xf7\x02f\x01\xaf\xff\xb2\xfe]\xfcj\x01\xfa\xfe\xc1\x05\xfe\x03\x94\a\xa1\aU\x04#\b3\x01\xf9\x05\xdc\x02X\x053\x05m\x069\x05\x81\x06\v\x04E\x05(\x05<\x06\xf0\a\xd1\b\xf2\t\x82\v/\n\xb2\r\xad\b\xbc\r\xa3\x06\x9c\v\xed\x05\xed\bV\x06\xad\be\x06\x85\t\xf1\x03 \t\r\x00\x95\x06/\xfe-\x04\x05\xfd\xd9\x02\x90\xfb\x8d\x00\xd2\xf9\xb6\xfd_\xf9\x1b\xfbQ\xfcl\xfa/\xff.\xfb\x84\xff\n\xfd4\xfd\x01\xfe\xd9\xfa5\xfb\x98\xf8\x99\xf7+\xf53\xf5\xfd\xf2r\xf4V\xf2!\xf4\xd2\xf2\xba\xf3(\xf4\x92\xf4\x85\xf4\xe6\xf6\x81\xf5x\xf9\xa3\xf6>\xfa\xbf\xf6\xad\xf9\xd7\xf5\xa5\xf8\xe7\xf4\xd3\xf76\xf6\x85\xf8\x91\xf8\a\xfa\x82\xfav\xfb0\xfbp\xfb\xd4\xfa\x8b\xfa\x0e\xfb\xc6\xfaL\xfb\xe2\xf9\n\xfc\xb7\xf7\b\xfc\xc1\xf7i\xfa\x01\xfaM\xfab\xfc\xa6\xfc\xf9\xfe\x04\xff\xa5\x01#\xfe\x1d\x02\xdf\xfb\x17\x00\v\xfb'\xfe\x9c\xfa\xcd\xfb\x98\xf9\xa3\xf9\xc0\xf6\x0e\xf9\xe7\xf4e\xf9\xa1\xf5\xc4\xfa8\xf7\x18\xfc\xbb\xf9\xf2\xfcE\xfb\x18\xfe\x05\xfb\xa7\xfe\x0f\xfb\x06\xfd\x00\xfch\xfbZ\xfcs\xfc\xab\xfa\"\xfe\x80\xf9)\xfe'\xfb\xdf\xfdu\xfd{\xfe\xe1\xfe\x9e\xff#\xff+\x00\xec\xff^\xff\xaa\x00}\xfe7\xffO\xfd\xe6\xfc\xe1\xfb\xe8\xfbt\xfa\xc8\xfb\n\xf9\x91\xfa\xad\xf6\x0f\xf9\xf0\xf3\x0f\xf7\x97\xf3\x96\xf5\xb3\xf4\x03\xf7\xc8\xf5\xd5\xf8\xbd\xf6\xba\xf8!\xf8~\xf8m\xfa\b\xfa&\xfc\xc8\xfb\xb1\xfcE\xfc*\xfc\x17\xfb\xb3\xfb\xc6\xf8\x13\xfcf\xf8\xfd\xfc\x89\xfb\xe2\xfd&\x01G\xff[\x06v\x03\xa1\t\xac\a?\v\x92\t\x03\r\x88\t\xa7\x0f#\n\xf4\x0f\xc7\v\x1b\x0ep\f\xd4\r\x92\v\x12\x12\xdb\n\xa1\x17\x83\r\xbd\x19\xeb\x10\x15\x18\x93\x10\xeb\x14\xb6\r\xbf\x12\x11\f`\x10\x12\vH\x0e2\t`\r`\b\xd1\v\xaa\a\xff\vJ\x06|\x0e\xa5\a3\x0e\x04\tT\n0\x06N\x05\xd2\x01\xbe\x00\xd5\xfe\xe3\xff\xf2\xfc@\x03\xc4\xfe\x1d\x05\xb2\x02$\x03\\\x03\xe4\x01\x81\x03\x03\x03V\x06\xb5\x048\b\xb0\x04n\a\xa8\x02C\x05\xd9\x016\x03\x9a\x04\xd7\x03\xdb\t\xfc\a\xe1\f,\v\x14\vt\v\xa2\x06T\n\x8b\x03Q\b\x0e\x04l\a\x84\x05\xa8\a\xfa\x03\xf2\x05\xd5\xff\xe3\x02@\xfe\xf8\x02\a\x00\x9b\x05!\x00K\x05\xa1\xfb\xc2\x00\xda\xf6t\xfb\x85\xf6$\xfa\xd9\xf9\x96\xfb6\xfe~\xfb\xf3\x00\xff\xfa\xcf\xfea\xfd0\xfbB\xff\x93\xfa\xeb\xfc\x1d\xf9v\xf9\xb3\xf4p\xf5\x88\xef\xd5\xf0)\xed\xfe\xee\x8c\xef\xed\xf0o\xf3\t\xf3k\xf5\x1d\xf5\x9f\xf4\xa7\xf8\xfa\xf2\xdf\xfa\xdf\xf2\xa5\xf9\xd3\xf3\x1e\xf7\xb7\xf4\xfb\xf5\xf2\xf3\xf2\xf5\xbe\xf2\xd2\xf5\xa7\xf2\x99\xf5\x80\xf4o\xf6\xa2\xf7\xd2\xf8E\xf9i\xfa\xcc\xf8C\xf9\xda\xf8Y\xf8\x86\xfb\xe9\xfa\xe3\xfem\xfe\x97\xff\xd5\xff\x11\xfe\xec\x00I\xfc\x8b\x02\xea\xfc\xfd\x02Q\xff\x12\x02M\xffb\xff&\xfd\x8f\xfb\x1c\xfb\xec\xf9-\xfa\xbe\xfa\xcd\xfa\x19\xfb\xc7\xfag\xfa\xab\xf8\xf7\xfa(\xf6Q\xfcw\xf7\xec\xfc\x1d\xfb\x8e\xfc\x9d\xfc\xc7\xfb9\xfb#\xfcb\xf9\x93\xfd\xac\xfa\xd7\xfe\xf4\xfd\x13\x00;\xff\x8a\x00\xd2\xff6\x00\x13\x01\xde\x00\a\x03\x81\x02\xea\x03\xdc\x01\xcf\x02W\xff\xb7\xff\xd7\xfey\xfc\xea\xfe\xe6\xfb\xec\xfc\x97\xfb\x8d\xf9\xac\xfaT\xf64\xfbK\xf53\xfc\xe6\xf5Y\xfc\x19\xf6Q\xfb\xe0\xf59\xfa\xfc\xf6\xd0\xf9\x12\xf9N\xfa@\xfa\xac\xfa\xac\xfa~\xfa\xb6\xfa\x99\xfc\xa8\xfa\x12\xffP\xfc\xca\xffV\xfe\xa9\x00\xd5\xff&\x03m\x01O\a\xe1\x04\xd3\nz\b1\x0e\xfa\t\xc1\x10D\v\x89\x11\xda\f\xda\x11\xc9\rq\x14\xd8\x0e\xdd\x17\x80\x11\xd3\x17\xfb\x121\x15+\x11x\x12=\x0e>\x12\v\f\xc3\x12\xc2\nD\x10\x0e\tK\v\x11\x06l\bG\x03}\v\xf8\x046\x0f'\t\xc0\x0e^\t\x81\tU\x05r\x03\xf0\x00\xd0\x01\u007f\xff\xaa\x02\xe5\xff{\x02`\xff\xa9\x00\x1c\xfd\f\x01\xc2\xfd\xf0\x06\xfb\x03\xb8\f\xd2\n\x89\n\xd3\v\xb3\x01^\x05A\xfd<\x01\xb3\x00\x02\x05\x9e\x06\xca\t\xcb\b+\t\xb0\x06\xe8\x05\xc1\x06\xb2\x05>\v\x9a\tv\rd\f\"\a\xf7\b\xa6\xfc\xe0\x01:\xf9\"\xfe\xb7\xfd\x8d\x00\xf6\x02\xb5\x04\x8a\x01\xfa\x04L\xfb-\x01*\xf9;\xff\x8d\xfb@\x01\xfc\xfcc\x02\\\xfb\x87\xff`\xfb\xaa\xfcE\x01$\xffL\b=\x04D\tr\x05\x12\x03R\x02\xcc\xf9\xa8\xfe\xbe\xf3.\xfaa\xf3\xca\xf5\x8b\xf4c\xf5\x16\xf6\xfc\xf7\xf4\xfa\x9d\xf9;\x02\xb7\xfb\xb1\x04\xc1\xfec\x00\x1a\xff?\xf8\x93\xfc\xd9\xee\xc2\xf7\xb9\xea\xb8\xf2\xb1\xebW\xf1;\xef;\xf3\xde\xf5a\xf7\x9b\xfb\xa5\xfc\xc0\xfe:\x00\xd9\xfd\x88\xff\x04\xfb\b\xfe\xea\xf7h\xfd>\xf7\xd8\xfbX\xf9X\xfb\x93\xfa\xfa\xfb\xa0\xfe.\xff{\x04\xa1\x04u\ay\b\t\x06J\b\xe3\x00\xb7\x04\xaa\xfe\xb6\x00\xf8\xff\"\xff\xd8\x01!\x003\x01\xae\xff\xc9\xfe\x18\xfd\x99\xfe}\xfdF\xfe\x9d\x00?\xfc\xd1\x00\x8a\xf8\x06\xfd\xc1\xf5I\xf9>\xf8!\xf9\xee\xfc\x14\xfc\x1c\xff|\xfe5\xfda\xfeZ\xfb\xf5\xfd\xa3\xfb\xe3\xfe<\xfb\x94\xff\xe8\xf8\x92\xfd9\xf7\x03\xfa\xc9\xf8\x83\xf8\xce\xfc{\xfaE\xff$\xfd\xb5\xffh\xfd]\xfe\x13\xfdI\xfd\xa7\xfc\x01\xfe\xc2\xfb\xb7\xfd\x97\xfaL\xfc{\xf8\xaf\xfa}\xf6\x90\xf9&\xf5\x1a\xfa|\xf5p\xfa\x9b\xf6\xd5\xf8~\xf7L\xf6l\xf7\xea\xf5:\xf7-\xf7\xff\xf8\x80\xf7O\xfa?\xf7\xec\xf9\x9a\xf6\xb3\xf9\xcc\xf7?\xfbi\xfa\x17\xfd\x1a\xfc\xe1\xfce\xfd!\xfd\xe9\xfd\a\xfe\xcb\xfd&\xffH\xfdM\xff\x8d\xfd\xdf\xfe\x92\xfd\x98\xff\xfc\xfc\xee\xff3\xfe\xa3\x00\x02\x01\xc1\x01\x02\x05R\x02\xa2\b\xd5\x02B\b\xfb\x03>\x05#\x03\xb7\x04\x05\x01x\x06\xce\x00\u007f\af\x01\x96\x06\x1d\x02\xd8\x05\x02\x03\xb3\aN\x04\x8b\v)\x06G\x0e\xff\a\xcc\f~\bX\t\x8f\aY\b\xa7\x06N\v\x9b\x06\xc3\r\xaf\ab\fi\b\x95\n?\t\x87\vI\vE\x0e.\r\xab\x0f\xbe\f\x9b\r\xe3\t\xef\n\x1e\b\x15\n\xfe\aT\v\x1f\bt\v:\bM\t7\b\xef\x06\x80\t\xb7\x05\x1d\v\xf6\x06\xfc\n\xdd\b\xeb\t5\v\xa0\n\x12\x0eT\r\xc1\x10\xe0\x0f\x88\x14m\x13\x1e\x17Q\x17\xeb\x17!\x18\xa3\x16\x83\x17\xb2\x12Y\x15\x8d\x0e\x81\x10\xed\t\x00\v\xef\x06\x89\a\xb9\x05@\x06\xe7\x03\xf8\x044\x02C\x04\x1e\x02(\x05~\x051\x06\x95\b\xd1\x04\x1c\x05\x9d\xff\xeb\xfb\xe9\xf9Y\xf4\xfa\xf5\xa8\xf1F\xf3\xe5\xee\xf2\xef\xdf\xea\x1e\xed\x1a\xe8y\xeeY\xeaX\xf2\x9b\xf2H\xf5\x82\xf9\x06\xf6\xca\xfa\x02\xf7&\xf8o\xfa\xd3\xf6\xd7\xfd\xda\xf8\xbb\xfd\x92\xfa'\xfb\x00\xf9@\xfa/\xf6]\xfb\xbb\xf6l\xfb\xa1\xfa+\xf9\x04\xfd\x98\xf7\xaf\xfbe\xf9q\xf9\xcf\xfc\xa8\xf9\xdf\xfe5\xfc\xbc\xfe\xb9\xfd.\xfe\b\xfb\xa4\xfdJ\xf5\xa0\xfb&\xf0\xfe\xf6i\xed\xd3\xf1\xbc\xeb\xfa\xee&\xebb\xef\xfe\xecR\xf2\xa0\xf1m\xf5\x1e\xf8&\xf8>\xfc\n\xfb\xf1\xfa8\xfc(\xf4/\xfa\xfe\xeaU\xf5\f\xe5\xd5\xf0S\xe3\x83\xee\xc4\xe5>\xee\x12\xec\x81\xf02\xf4\x1e\xf5\v\xfc\x85\xfaj\x00\a\xfeh\xff\xb1\xfd4\xf9x\xfa\x84\xf0\x10\xf6\xd7\xean\xf2\xc7\xec\xf3\xf0\xe0\xf4\x16\xf3\xf5\xfb/\xf7\x15\x01\x03\xfb\xc6\x06\xa9\xff\x8a\nZ\x03\t\t\xdf\x02\"\x02\x99\xfe\xea\xfc\x81\xfb,\xff\x94\xfd\xb1\x06\x10\x03\xcb\x0e!\b\xc2\x13\x9b\v7\x15\xa9\x0et\x14\x14\x10\xca\x12\xcd\r\xf4\x0f\xec\b*\nI\x03\x91\x05\xf5\xfe\x1e\b\x1d\x00\xb0\r\r\x06f\x0f\x9a\t\xf8\f\xc0\bp\b\x9a\x06\v\x04\xa2\x04\xcd\x03\x12\x03=\x06l\x02t\x05a\x00-\x05d\xfe\xc4\b-\x03\x80\v\xbd\nz\t9\f\x9e\x03\xbd\a\t\xff\xe5\x03\xc9\xffH\x04\xcb\x05\xe0\a9\f.\vv\x0fP\v/\x11\xc9\v\x1d\x11%\x0eR\x10\x86\x0e\x94\r\xb6\vC\a8\x05\"\x03\n\xfe\x8c\x05\xff\xfc\xb0\n\xec\x01\xa0\v\xf7\x04\xf3\bq\x03\xa3\x05\x81\x01d\x01\v\x01\x12\xfd$\x00\x9e\xf97\xfd\x8e\xf8\a\xfa\x15\xfc\xf6\xfa\x82\x02|\xff\x18\t\xd7\x03\xdf\v9\a\x91\tV\b\xa1\x05\xd2\x04\x06\x02\x94\x00\x98\xfc\xde\xfe^\xf6\x92\xfdD\xf4b\xfc\x15\xf8\xcf\xfe\x8e\xfc\xa6\x03$\xfe\xe6\x05\x0f\x00\x10\x06\xea\x01\r\x06F\x01\x11\x04\x8b\xfd\xd1\xfe\xe4\xf8\x9f\xf9\x0f\xf5\xca\xf8\xf3\xf2\"\xfa\x03\xf3\xe9\xf9R\xf2\xc0\xf7G\xf1O\xf7\x91\xf3\xd7\xf9\xb4\xf8K\xfcd\xfd\xfe\xfc\x17\xfe\x94\xfc~\xfd\xe4\xfdi\xff\xdb\x018\x01\xb5\x04\xe1\xfe\xb5\x02V\xf9\\\xfd\xc8\xf6N\xfbi\xf7t\xfd\xd6\xf8F\xfe?\xfb\xf1\xfcj\xfc\x1e\xfd\n\xfdI\x00\xbf\xfen\x02*\xff\xca\xff\xc7\xfan\xfa\x11\xf4\xdd\xf5Y\xf1\xf4\xf3\xa0\xf1\xd4\xf4?\xf21\xf6\xd1\xf2\x98\xf6\x89\xf45\xf7\xa9\xf8\xec\xf9A\xfbK\xfc\x92\xfa\x13\xfb(\xf9.\xf8\x10\xf8\x89\xf7\xb9\xf7\xbb\xf8\xae\xf7V\xf9K\xf7l\xf9\x84\xf5\x88\xf9\xb2\xf4W\xf8x\xf6R\xf7g\xf8&\xf8\x94\xf9\xc1\xfag\xfb\x12\xfd\xd0\xfd+\xfdm\xfe\xb3\xfc\x8c\xfce\xfc+\xfa\xbe\xfa\xb8\xf7 \xf7\xd7\xf5T\xf4\xb5\xf5\xe8\xf4\x83\xf7\xff\xf7\x05\xfbb\xfcx\xff\x89\x00M\x02D\x02\x8c\x01\xe0\x00\xb8\xff\xb5\xfe]\x01M\xff\xcc\x04\xb9\x00\xb4\a+\x01\xdc\n\xaa\x04\xb1\x10d\fN\x18\xff\x12\xb2\x1a\xd4\x12\x1b\x14r\rm\fw\aw\v\r\x05\xae\f\xcd\x05(\v\x05\x05\xb6\b\xf4\x03\b\t6\x05\xf4\x0e\x19\t~\x13h\v\x81\x0e\xf7\x06\xb3\x04\xed\xfd\xae\xff\x0e\xfaj\xfet\xfc\xb1\xfe;\xfcf\xffY\xfb\xa1\xfct\xfd6\xfcv\xff\xd2\x02=\x01\xae\b\xb2\x03\xff\x056\x037\x00\xc9\x00#\x02\xf9\x02\xb3\t\xb5\tv\x0e\xde\x0e\xa0\f\xbd\r\x94\b^\t\x80\t\x80\a\xbd\rg\b_\r\r\b&\b\xe1\x04\x9d\x05\x04\x04O\b\xc0\x06i\r\xcd\t\xc6\x0e9\f%\t\x8f\v\x17\x03o\x06v\x01J\x01\xe4\x00\xa3\xff\xce\xff\xe0\x004\x00\xa5\x02\x1a\x05#\x03F\r\x9e\x05\xd8\x12u\v\xc3\x11\xec\r_\vc\t\x88\x04\x81\x04\xd6\xfd/\x00\xc3\xf6\xb4\xfa\x03\xf2\xe6\xf7,\xf3\x18\xf9\x13\xf9.\xfb^\xfee\xfcf\x00}\xfd\x8d\xfe\xf0\xfe\xa4\xfa\xf6\xfe\n\xf6\xe8\xfb\xf8\xf0\x17\xf7 \xee$\xf5\x93\xed\xd2\xf6\\\xf0\xc3\xf8\xda\xf4\xa0\xfa1\xf7\x1b\xfcB\xf7\x10\xfc\x13\xf8+\xfd\xe7\xfat\x00c\xfc\xe1\x01v\xfc\xdf\x00\x8d\xfe\x06\x02a\x02l\x06$\x058\n\xea\x04\xa0\t\xdb\x02\x93\x05\x92\xff\r\x03\xcb\xfc{\x02\x1f\xfd0\x00\x0f\xfe\xbf\xfd{\xfce\xfc\xb4\xfa\x9b\xfb\xa1\xfb\x11\xfc\xd2\xfcJ\xfd\x15\xfa\b\xfc\x92\xf5\xac\xf8<\xf3\n\xf7\xc5\xf2\x16\xf6\xfb\xf1\xca\xf3\xf3\xef/\xf1\xce\xee\xbc\xef\x1f\xf01\xf0\xbc\xf2\xd5\xf1v\xf5\\\xf4\xd5\xf7\xe3\xf7\xc7\xf8\x82\xfb\xdc\xf8\x04\xfc\t\xf9\x91\xf9\x80\xf6\xe5\xf7;\xf1\xee\xf5\xb7\xee\x84\xf24\xf0\xa0\xf0\x19\xf2\xfe\xf0\xd7\xf3K\xf3_\xf7-\xf7\xaf\xfb-\xfa\x93\xfdA\xf9\x9b\xfcG\xf6\xe7\xfaz\xf5T\xfa\x06\xf7\xd0\xf9\x90\xf7G\xf8\x89\xf5h\xf9\x81\xf5\xcd\xfdQ\xfb\xdb\x00\xee\x00`\x01\x87\x00\xef\x02\x98\xfeD\aR\x018\f\x81\aI\x0f:\f3\x12\xb8\r}\x16\x81\x0f\u007f\x19\x17\x13\xed\x18v\x14\x0f\x15\xf2\x10\x1d\x10\b\v\n\ff\x06\xe3\t\f\x05\xb9\n\x8b\x06\x15\r$\bm\x0eE\b\xab\x0e\xe1\b\xd0\x0fO\v\xa1\x0e\xc7\v\x1a\t\xf2\x06\x96\x00\xc0\x00\xa4\xf9z\xfd6\xfa;\xfe\xb4\xfe\\\xff\xb8\x01q\xffc\x02\xdb\x00l\x058\x04\xee\t\x06\b\xff\b\x93\aC\x05\xf1\x03\xff\x02E\x03\xf7\x03R\x06q\a?\b6\n\xc8\ai\v;\aW\vu\a\x93\v\xfa\b\xb2\f!\n\xb2\f\xf6\t\xcb\th\b\x9c\x06*\a\x92\b\xbe\b\xdd\f?\f\xac\v\x94\fK\a_\b6\x05\xb9\x05\xec\x04]\x06=\x04F\x06l\x03\xad\x03I\x04\xf4\x02\xbe\x06\xb6\x058\n\xc6\bC\v\xec\t\x9e\b\xe5\a\xf0\x03\xc7\x04)\xff3\x00W\xfc\xf0\xfbW\xf9[\xfa\x98\xf6p\xf9\xed\xf6\x12\xf7\xa7\xf9?\xf6$\xfb\xef\xf8'\xf9\xba\xf9<\xf6d\xf6\xe5\xf2\n\xf3\xba\xee\xe2\xf1;\xecY\xf1\xae\xecm\xf19\xefw\xf3\xa4\xf2\x03\xf7\xab\xf6?\xfa\x03\xfbl\xfc\xce\xfd7\xff\\\xfe\x85\x01\x94\xfe}\x01\xeb\xfd\xf7\x00\x0e\xfc\xda\x01\xfd\xfaM\x02\xdc\xfc\xe9\x00{\xff[\x00 \xff\xf2\x00\xa8\xfd\xf7\x00p\xfe&\x01\xd8\x00\xe1\x01r\x00\xde\x01\xfb\xfc\xc4\xff\x8a\xfa\xc3\xfc}\xf8(\xfa\b\xf5\xa4\xf6\xdd\xf0\xae\xf1\x89\xec\xc3\xee#\xe9\x03\xef\r\xe9\x17\xf0\xef\xebz\xf1\xd4\xee!\xf3\x13\xf0\x1c\xf5\xac\xf1\xad\xf6\xf9\xf4z\xf7@\xf7\x15\xf8\xf7\xf5+\xf8\x9b\xf3\x86\xf7\xb8\xf3\xb6\xf7\x00\xf61\xf9\x9d\xf7J\xfa\xcd\xf8g\xfaG\xf9\x8d\xfa\x00\xf8\u007f\xfa-\xf6\xe8\xf8\xac\xf5\xc7\xf6\xab\xf4\x9e\xf5\x8a\xf3L\xf4q\xf5\x15\xf3\n\xfa\x9e\xf4&\xfe\x96\xf8$\xff&\xfb\xd7\xfc\x8b\xf9,\xfb\xdc\xf7\x10\xfb\xd9\xf9\xb0\xfb+\xfc\x1b\xfd\x17\xfc\xbb\xff\xa0\xfc\xee\x05\x94\x01\x1c\x0fd\t\xde\x15\xd8\x0fW\x16\x1f\x12/\x14D\x10\xd4\x13\x0e\x0f.\x13$\x0f\xb9\x13\xa0\x0e\xf8\x14\x19\x0e\x9d\x15\xf1\f\x89\x15P\r\xef\x14\xeb\x0el\x16\x81\x0f\xef\x14\xd7\fR\x0ff\ag\v\xdc\x03\xad\f\x02\x05\xfe\f\f\a\xab\a\xd0\x03G\x02)\x00\x05\xffJ\x00\xfe\xfc\xbe\x00M\xfd\xc3\xff\xa3\x00\v\x00\x88\x03\x9a\x01\xa3\x06<\x03\x81\f}\a\xc5\x10\xb8\f\xd8\x0f\x8c\rK\n\xbf\tV\x04\xa8\x05\xf8\x02\n\x04\xf2\x04\"\x05\xe9\a\xca\x05%\vM\x05>\x0e\x95\bE\x10\xfa\f\xe3\x11\x9d\x0e\x89\x10w\r2\vu\nE\x05\xea\x06\x04\x03Q\x05<\x03\xcc\x05\xf0\x02:\x05\x06\x03\xba\x03\xe0\x028\x02/\x02x\x02q\x01e\x04\x9f\x01\x14\x04\xf4\x01\x17\x02\x03\x01S\x02Y\x00\xfa\x03\xc6\x00\n\x03\x02\x00\xfb\x00\xc9\xfb\xde\xfd\xe1\xf5\a\xf9\xed\xf2D\xf5w\xf2\xaf\xf4\xd3\xf2\xea\xf4\x0f\xf4\xd9\xf5\x13\xf5\xd0\xf7\x1b\xf6\xaf\xf8\xb1\xf5\xb6\xf8;\xf3\xd3\xf7\x16\xf0\xa4\xf4\x89\xecj\xf1U\xeb\x96\xf0F\xed\n\xf2\x82\xf1\"\xf5>\xf6b\xf9)\xf9\xdc\xfb\xdf\xfa\xab\xfd\xb8\xfb \x00*\xfca\x01\xbd\xfaR\x00\xbc\xf9:\xfe\xf2\xfa~\xfe\x95\xfc\x19\x01\xe8\xfdC\x03\xde\xfd\xfb\x02\x12\xfd\xc2\x01c\xfc\a\x01\xf9\xfb\xe0\xff\xa3\xfca\xfei\xfc\xbf\xfc\x85\xfb\xa8\xfa\x9e\xfai\xf9l\xf8U\xf9\xf1\xf5\xfa\xf7E\xf2;\xf5\xf3\xed{\xf2\xda\xeb\xff\xf0\u007f\xec\xfa\xf0\x12\xef\xf8\xf1#\xf2U\xf3\xe8\xf4\x19\xf6\xe7\xf6%\xf9!\xf8\xb2\xfa\xd4\xf8=\xfb?\xf8N\xfb\n\xf8\xa8\xfa\xe1\xf8\xeb\xf9D\xfbY\xfa\xae\xfe\x17\xfdF\x00\x0e\xffc\xff\xc2\xfe\xb8\xfc&\xfc\"\xfa;\xf9\x8b\xf7\x87\xf6\a\xf4=\xf3\x9b\xf2\x8b\xf1\xca\xf5\x00\xf3\\\xfb\x15\xf7\xa3\xff\x16\xfc\"\x01\xbb\xff\xca\x00\xdf\xff\xaa\xfe\x12\xfdm\xfb,\xfa6\xf9\xb5\xf8\x13\xfa\x94\xf9\x89\xfe\x85\xfc0\x06\xf0\x01\x1c\x0fU\n\xa2\x15H\x12r\x18\x8d\x145\x19!\x13?\x16\xa1\x11@\x13\xf0\x0e\x98\x12\xc7\f\x03\x13\xa0\f\xdc\x14\xf6\r\x9c\x16\x82\x0f*\x17Q\x10\xb4\x15\xd7\r\xc0\x14\"\v\x01\x11\b\nT\fC\x06\x9d\n\xb0\x03\x0e\t\xe5\x03\"\a\xb7\x03\x00\x05!\x02m\x01\xd3\x00\xaa\xfc\xba\xfc\x13\xfd;\xfas\xfe\xbd\xfd\xae\xffW\xff\x9c\x05O\x02p\t\xc0\a]\v*\n9\rB\v\r\vj\f\x9b\x05~\b\x92\x01\xaa\x03\x9a\x01\v\x05x\x03\xd8\x06\x98\b[\b\xc8\r\xae\v9\x10\n\x0e\x16\x12\xb5\x0e\a\x11\xa5\x0eu\f\xcb\v+\bw\b\x94\x02\x1e\bT\xffi\x05\xe8\x00\x95\x03\xf6\x01\xec\x04M\x02#\x04\xab\x03O\x029\x05(\x032\x05\x96\x03\xfd\x03\xd1\x02\x86\x02\xd6\x032\xff\x00\x02\xee\xfcK\xff\x0e\xfa\x9f\xfd\x04\xf8\xfa\xf9C\xf7k\xf7\x1b\xf5\x8c\xf6^\xf5b\xf5\xe8\xf6I\xf5\x93\xf6\x14\xf8\x83\xf4\xa4\xf8\xbf\xf2\xe0\xf5\xf7\xef\xd6\xf4\xce\xea\xed\xf2\xef\xe7\xdf\xf0Q\xe8\x94\xf0\xa5\xeb<\xf2\xcd\xf0\xbc\xf5\x9b\xf4\xb7\xf9\x05\xfa\xcf\xfc-\xfe\xa5\xff\x81\xfd\x11\x01\x11\xfc\xeb\xff&\xfa\xd7\xfe\x83\xf8\xd5\xff\xbe\xf8\xf1\xff\x15\xfc\xfd\xff\x8d\xfe\xe2\x02&\x00S\x04\xa4\x03l\x04\xcc\x04\xd0\x04\xe3\x03\xb2\x03\x9f\x01T\x01\xa2\xfd\xbd\xfe\f\xfc\x9f\xfc\xd2\xfa`\xfb|\xf89\xfas\xf5\xe2\xf6\xc0\xf2\x91\xf3$\xf0\x1f\xf3_\xee\xad\xf1\xdf\xee\xac\xef\xb8\xee\x1f\xf02\xef(\xf2\xbf\xf2\xac\xf4\xbc\xf5\x90\xf7f\xf6O\xf9[\xf5\x15\xf9\xb6\xf4\xd5\xf89\xf5\xba\xf8(\xf7\x83\xf8\x14\xfa=\xfa\x1f\xfd\xc7\xfc\xaa\x012\xffQ\x05\xdb\x02\a\x05\xa0\x03?\x00\xa4\xff\x9e\xf7H\xf9g\xf1u\xf3\xc5\xef\xa1\xef\xc6\xf0\x17\xef[\xf4\xc4\xf1\xa4\xfbz\xf6%\x03\x0f\xfd\x9c\x04\x82\x00\\\x01\xc5\xfe>\xfc\xe6\xfb\x94\xf6\xb3\xf8R\xf3\x04\xf5\xc2\xf4\xc8\xf4\b\xfb\xca\xfa\xbf\x03\xaf\x02I\f'\t\xf9\x12\xca\r\x8f\x17\x81\x10\xfe\x18\x04\x13\xe4\x17\xeb\x13\xea\x16\xc7\x11j\x17}\x10\xf9\x17?\x12B\x18\x1b\x14X\x19\xd1\x13\x04\x1a\xc0\x12)\x18\xce\x0f\x00\x17Z\f\xf1\x17\xd7\r\x13\x18j\x10E\x16\xcd\x0e\xc2\x10%\v\x05\t\xa0\x06}\x04\xe6\x02\\\x027\x01G\xfe\x1f\xfd\xa7\xfcs\xf7\xaf\x01\xd4\xfbo\a[\x05\xb5\v\xb2\b\x81\f\x9c\t\xfd\a\xd8\bH\x03G\x05\xa5\x02\x85\x04\x97\x02H\x06\x92\xffk\x04\xbc\xfdz\x02\xa0\x01\xdc\x04B\t\x9f\nP\x0f~\x104\x0f\x9f\x10\xc8\f\xd6\n\n\f\xe9\b\x86\v\xd5\v.\n\x8c\v\x12\x06j\t\xf8\x00\xad\x06\x1e\x016\x04\xf5\x04\x12\x06\x17\aL\a\xde\a\xec\x04-\b\xd3\x03\x93\b\x11\x04\x01\tf\x04\xce\x05V\x06i\xff\xac\x04(\xfb\xd6\xfcf\xf9\x86\xf8\xb4\xf6\x82\xf8\xfa\xf5e\xf6T\xf6\x9d\xf4\xa1\xf4\n\xf3\x90\xf3\xdc\xf0q\xf2X\xf1\xa4\xee\x89\xf2\x88\xe9\x17\xf0t\xe4j\xebu\xe1n\xe8\xb3\xe1e\xe8\xf2\xe4r\xec\x1a\xear\xf1\x17\xf1\xd1\xf3 \xf6b\xf7\x88\xf7\xfc\xfaV\xfb\xd8\xfd\x9c\xfc0\x00,\xf9\xc7\xfe\xc9\xf7\x86\xfc\xa9\xf8\x97\xfe\xe5\xfb\xc6\x03\xd6\x00]\x06[\x04\xcd\x06\xae\x04H\x06\xac\x05\xe6\x05\xed\a(\b\x10\x06+\a\x16\x03M\x01\xa5\xff\xc5\xfd\x02\xfc\x13\xfe\xf7\xfbQ\xfd\x1f\xfa\x9c\xfa\x01\xf4)\xf68\xee=\xf0\xf2\xeb\x9c\xed\n\xecw\xeeo\xec'\xee\x90\xec\xa0\xed\xd2\xeb\t\xf07\xee\xa5\xf3\xe8\xf2N\xf7\u007f\xf3\x86\xf8}\xf1\x04\xf6\xb3\xef\x15\xf4\x86\xf13\xf5}\xf7\xa9\xf7\xfc\xfb\x1a\xfaZ\xfe\xe8\xfdO\x01\xe0\x01\x92\x03@\x04\x0f\x00\x80\x02&\xf8\x8b\xfbv\xf1\xf0\xf4\xe8\xed\x15\xf1\r\xf1\xed\xef \xf9\xf9\xf3\xa5\x01\xad\xfc\xc1\b\x11\x04\x9c\v\x9f\x05M\t/\x02\xf0\x01u\xfc\xe3\xf9`\xf6\xce\xf4h\xf3\xe5\xf5\x8c\xf3\xd8\xfdu\xf8]\a\xce\x01\x14\x124\r\xc5\x19\xb9\x14\xa2\x1c\xaf\x14\xbc\x1bV\x11\b\x16\xda\r^\x10\x0f\v\x1d\x12\xbe\n\xf2\x16\x8e\x0e\xa6\x15d\x10F\x15\x19\x10\xd9\x17\xb7\x12\x93\x18\x00\x12\xbd\x17\xc4\f\xab\x16\xf3\tL\x13\x8b\vV\x0f\xbf\n~\x0fb\t\xd4\td\ah\xffW\x00\x88\xfb\x00\xfb^\xfc\xbd\xfb\x13\xff3\xfe\xfd\x049\x01\xe9\tQ\a:\f7\vc\x0f\x02\r\x97\x10\x94\x0e\x1f\fL\v\x8d\x04\xac\x04\r\xfe\x17\xffT\xfd_\xfe\xc7\x01s\x02\x8f\x05\v\a\x1b\bG\t\x9f\f\xb6\f\xe0\x0fA\x0f\xec\x0f\x85\fX\r&\t\xe4\x06s\x06\x11\x01\xf4\x01\xa2\xff\xeb\x00u\xfd\x9f\x02\x95\xfc\x88\x00\xb5\xfe\xb0\xff\xcb\xff?\x01\t\x02\"\x01\x8d\x04\xa7\x02\xc5\x025\x05\xc5\xfe\x94\x02}\xfe\xdd\xffi\xfe\xa9\x01\x02\xfb\xc6\xff\x0e\xfaQ\xfbe\xf9\a\xfa\x02\xf7\xc2\xf8\x15\xf6\x14\xf6\xbd\xf6N\xf5F\xf6\xc2\xf6\xa2\xf3\xbe\xf6\x84\xf1s\xf5\xd9\xeef\xf3\xdb\xe9?\xf0`\xe5\x1b\xed\xae\xe3i\xea\xba\xe4\xd1\xea)\xe7\xff\xec\xbf\xed\xb3\xf0\xe8\xf5`\xf7\x19\xf9b\xfca\xf9\xb6\xfc\n\xf8\x1f\xfc-\xf5;\xfc\x92\xf3\xa4\xfbb\xf3\xa6\xfb\xaa\xf5\xf6\xfc\x91\xfal\xfeD\x01\x1c\x03S\x05{\b=\x06\xb9\b'\x05\x17\x06<\x01\xaf\x02\xde\xffJ\xff^\x00\x8f\xfe\x1d\xfe\xeb\xfd\xc6\xfb\x83\xfbF\xfbj\xf9\xd0\xfb\x86\xf9n\xf9^\xf8f\xf5\xc7\xf4[\xf2(\xf1\x14\xf1\x90\xef*\xf2\xb7\xf1\x83\xf2\v\xf4\xad\xf1\xcb\xf4\x14\xf1\x91\xf5\x02\xf2\x96\xf6.\xf5\xf7\xf7h\xf7\x16\xf9\x19\xf8\xd4\xf9q\xfa\xe2\xf97\xffK\xfc\xd5\x01\x96\x00\x06\x01b\x02\xa6\xfe\xb5\x01\a\xfas\xfe&\xf6t\xf9\x0e\xf4\xe1\xf5\xb1\xf2\xba\xf3o\xf3\x88\xf2\x87\xf7\xe8\xf4\x1c\xffD\xfb\xa0\x05\xbb\x00\xb8\a\x8a\x02\x0e\x05,\x01\xbd\xfeU\xfc\x8f\xf8I\xf6N\xf6\xe0\xf2\xb2\xf7\xba\xf4\xef\xfb\xe5\xf9\x12\x03\xf3\xffX\f.\a*\x148\x0ep\x16\x8c\x11n\x13>\x0f\xaf\x0fx\n\xfe\x0f\x17\tc\x14o\r\x19\x18\xc2\x12\xd1\x18!\x14\xd0\x18\x91\x13'\x1bs\x13\xa8\x1c\xdb\x12{\x18\x9c\x0f\xd5\x12\xe1\tP\x12Z\b\xaa\x12i\vG\x12\xd4\f\xb4\x11i\r\xb4\t\xba\nr\xfe\x92\x00u\xfc\x19\xf9\xdc\x01\x9a\xfdS\x04u\x02M\x06\x84\x01\xca\n-\x05\x06\x0e\xdc\v\x1e\x10\xd1\x0e\x89\v\xee\v,\x01@\x03\xd7\xfb\x00\xfc\xe8\xfe\xa7\xfe\xa2\x03\xff\x04\x83\x06X\b\xd4\b\xa7\v\x0e\vx\r@\r\xcb\r\xdb\r\xba\rS\v\xd3\vE\a\xe4\a\xe7\x05g\x05\x9f\b3\b{\nR\r\v\t\x8e\x0e\xc7\a-\nW\aC\aS\x06r\b\x13\x06\x8a\a\x05\a\xe3\x04\f\a\x01\x063\x049\a/\x02%\x04\xd6\x00\xc6\x01S\xfeO\x007\xfc\x11\xfd\xaa\xfar\xf9y\xf9L\xf8C\xf7\xda\xf7Q\xf5\xc7\xf7\v\xf3<\xf6R\xee\xc5\xf0\xf6\xe7\xbb\xeb\x10\xe3\xb6\xe8\"\xe2K\xe7\x01\xe2\xdd\xe6\xec\xe2\xcc\xe7\x99\xe5\x8b\xeb\xb1\xe9s\xf1\xd7\xef\x83\xf7\x02\xf4\xfd\xf9C\xf4\x9f\xf9\xe8\xf3%\xf9\x81\xf5\a\xfb\xd2\xf7\x89\xfd\x8e\xfa\xe2\xfd\x13\xfdN\x00W\xfe\xe2\x04\x99\x02~\t\xe9\b\xe2\f'\n\xdc\f\xcd\a\x13\t\x91\x05\xa2\x05T\x04@\x04S\x02\x15\x02K\xff\xfd\xfc+\xfc8\xf9\xa6\xf8Q\xf9\xd4\xf6p\xf9/\xf6\x9b\xf6\xdb\xf3{\xf2\xde\xef\xea\xef\x01\xec\xcd\xee\xe1\xebF\xee\xc3\xec%\xef\x9a\xea\xae\xefE\xe91\xef\xf1\xea\xf4\xef\xa5\xedQ\xf2 \xf0\x13\xf3\xb3\xf3c\xf4\x8c\xf7\xb9\xf7\x90\xfa\x8a\xfb\x8f\xfc\x19\xff\xae\xfb4\xff&\xf7\xb3\xfb)\xf2\a\xf8Y\xf0\xb2\xf5\xfb\xf2P\xf4\xb5\xf8^\xf6\xba\xff\xa3\xfc\xd5\x06\xd5\x02H\v\x14\a\x84\n=\x06\xe9\x05\x05\x02\x1d\x01\x02\xfe\xda\x00\xf7\xfa\x13\x010\xf9\xed\x01\x17\xfa[\b\a\x00\xa8\x119\t=\x19\x81\x11\x94\x1d\xed\x14\xa8\x1e\xed\x15|\x1b[\x15v\x17n\x11b\x15\t\r!\x13\xc2\n\x1d\x0f\x16\nK\v\x91\b\x14\f\xd3\t\x89\x11\xae\rF\x17\xb2\x10\xee\x17\xd4\x10\x86\x17\xfa\x0f\xe8\x15j\x0fM\x12\x84\f\x1b\vt\bJ\x00\xa2\x02\xa7\xfa\xf6\xfe)\xfdH\xff\xd6\x02\xc9\x02\x8d\x06\n\x06\xdf\nu\t\x1d\x0e\xfb\fB\x0e\xe6\f\xaa\x10\a\x0f\xbc\x10\xdd\x11\xb8\f\xe1\x0f\x87\t\xe9\n#\a\xfa\a\n\a\xbf\x06\x97\b\xae\x05\f\t\x82\x05\xd7\b\x15\x06\xb6\b\"\b\xab\n\x9a\tv\v\x9d\t\x16\bs\bA\x02\x85\x03V\xfd\x1b\xfd6\xfa\x17\xfa\xf3\xf7\xd0\xf9v\xf8v\xfaR\xfb\xbc\xfco\xfeS\xff(\x01-\x02.\x02\xca\x04\x97\x01g\x05\xb7\xfe\xde\x02\x8c\xfaE\x00\x12\xf8\xac\xfeV\xfa_\xffA\xfd\x9f\x01\x83\xfce\x00I\xfc\xdd\xfd\xcd\xfe\x98\xfd\x1f\xff\xf7\xfdi\xf9\xea\xfb\x15\xf1\x1c\xf7\r\xebl\xf3\xde\xe7\xc0\xf0\xe4\xe7'\xef\xda\xe9a\xee\xc7\xed\xfe\xee \xf3\xa0\xf1\xe5\xf7\x82\xf5Q\xfa\xc7\xf9X\xf6\"\xfal\xeex\xf5\x8f\xe7Y\xf0K\xe4\x1a\xee(\xe5\xfa\xedB\xe8\xfa\xee\x93\xeey\xf20\xf6\x9a\xf8\xbd\xfd_\x00\f\x03H\x06q\x03\xd0\a4\x01R\a\x04\xfe\x9d\x04}\xfd\x97\x00\xae\xfd\xa1\xfe\x18\xfb\"\xfd'\xfa\x1c\xfb\x13\xfa\xe5\xf9\u007f\xfa\xee\xf9<\xfbD\xfaz\xfbm\xfa\f\xfc\xe6\xfa\xdd\xfa\xbc\xfa\xb6\xf8\x1c\xfa\xb4\xf6/\xf9$\xf3\x97\xf7\xad\xef\x04\xf5\xb6\xedN\xf2\x9b\xee\xfa\xf1\xab\xf2\xbb\xf3\x1c\xf8\xf6\xf69\xfd\xa7\xf9d\x007\xfbF\xff\x9a\xfc\x9e\xf92\xfc\xdf\xf1\xc1\xf8\x12\xea\xae\xf2\xb8\xe3O\xec\xa7\xe1\x96\xe8\x95\xe6\x83\xe9&\xf2\xb4\xef\xdd\xfd\x02\xf86\x05G\xffw\b\xbe\x03\xc6\aM\x05\x8b\x03U\x03\x1d\xfe\x05\xfe_\xfb\xa5\xf9C\xfc\x80\xf9\x8d\x01\xfd\xfc\xa3\ng\x03\x11\x13\x02\v\x14\x19\x9a\x11N\x1b\x06\x15\xc1\x1cz\x16\xa2\x1e\xc2\x17\xeb\x1f\xdf\x17w\x1f\x89\x16\xa9\x1d\xb6\x14\xc9\x1c\x83\x14)\x1a\xe7\x12\x1b\x17\x12\x0f\xe4\x13V\v\x93\x13+\b\x94\x15\xdf\b\x8b\x15>\nk\x14\xc3\n\x06\x10\xe8\t\xef\a\x93\x06\x84\xfc\xfc\xfeb\xf5\xff\xf6\xea\xf4\x8a\xf5\xdf\xf7\xe4\xf7\"\xfd\x97\xfb\xa9\x04\xc2\x01+\f\xa7\n\xd9\x0e\x16\x0f\x94\f\x11\x0f\xc2\x06\xba\v\x1e\x03\x85\x06\x95\x00\xc1\x03\xa7\x00\xc9\x02\xfb\x05'\x06\x19\ve\v\x97\x0e\x88\x0eh\x10\x0f\x0f\xf7\x10\xc4\x10\xf5\x11\xe6\x11X\x11\xda\x0f\xab\x0f\xbf\v\x14\x0e.\n\xd9\n\xe2\n\xdc\b\xff\b'\a\xde\x04\x9e\x03\xab\x00\x83\xff\xf4\xfd\x1e\xfe\xbd\xfc\xe5\xff\x9b\xfd\xda\xfe\xc5\xff\xdc\xfa\x98\xff\xbb\xf7@\xfd?\xf6r\xfcm\xf6\xb0\xfc\xee\xf6\xf3\xf9\xd4\xf6\x96\xf6p\xf7q\xf6_\xf9\xa1\xf9a\xf94\xfbf\xf6`\xf8\x1b\xf0\xf4\xf3\x1f\xe8\xef\xef\x11\xe4\xcb\xecS\xe4_\xebW\xe4J\xebS\xe6A\xed:\xec\xe3\xf1\x98\xf4\xa6\xf8\xd4\xfa \xfeT\xfc\a\xffi\xfaA\xfd\x1a\xf6\x06\xfb\xe3\xf1\x86\xf8\xf3\xef:\xf7\xa3\xef\xb6\xf6\x99\xf1]\xf8\xd5\xf5\xfa\xfb\v\xfe\xe1\xff\xef\x04\xd3\x04>\x05b\a_\x03{\x06\x15\x01,\x04I\xfe\x97\x01.\xfb\xb1\xfeZ\xf8W\xfb\x01\xf8_\xf8&\xf8\x1b\xf6}\xf7\n\xf5\x13\xf6\xe3\xf4-\xf4\x9e\xf4m\xf2\x9c\xf3v\xf1m\xf2.\xf2\x93\xf2^\xf2\x9c\xf3\xf9\xf0%\xf4\xc5\xee\xb3\xf2\x8a\xec\xe6\xf0\x8f\xebY\xf1\xd3\xec}\xf2M\xf1\x8a\xf4n\xf7\x8c\xf7\xdc\xfe#\xfb\x85\x06>\x00u\vy\x05?\f\x88\b\a\a\x84\x06\xb9\xfd\xc6\xff \xf3/\xf7]\xebI\xef\xad\xe9\x93\xeb\xc3\xedS\xeb\xfc\xf5\x9c\xef-\x001\xf9I\t\xf2\x03,\fp\t\xf6\aU\x06\x92\x01N\xff\x9c\xfb[\xf9\x8b\xf7\x8a\xf5\xfe\xf6\xa4\xf3\x89\xfc5\xf6 \x06n\xffi\x0e-\nJ\x13\t\x11\x9c\x15\xbc\x12\xbb\x18\x9d\x13\xca\x1a\xba\x15\xc6\x1cQ\x16\x88\x1f \x17Z \xd2\x18O\x1f&\x19\xa2\x1c\x04\x17a\x19=\x13\xe8\x15 \x0f\xb1\x16\f\fD\x19\x91\f\n\x1c\xb0\x0e\xba\x1d\xe4\x120\x18R\x12G\x0e\x03\v\xf5\x03a\x02S\xfd\xdd\xfc\xeb\xf9\xe0\xf8K\xfa\xe3\xf6\xdc\xfd\xd4\xfa\xd7\x045\x02&\f\xdd\n\xb8\vW\rd\aH\t\x02\x04\xfb\x05s\x01\x93\x03\xfa\xffl\x00]\x00\xad\x00A\x02\x1a\x05\xa5\x04\x17\b\xc2\x06\xb4\nU\aK\fA\tF\f\xd9\n\x19\v\x91\n5\tT\vz\tn\v\xc8\v\xd1\b!\fL\x06\xc7\t\xa2\x04\xd7\a\x94\x04s\x05\x93\x05\xd7\x03?\a\xc0\x03N\t\xdf\x05<\t\xb0\b\x82\x06\xf2\b\x00\x03\xaa\a\x85\xff\xdd\x05=\xfb|\x00o\xf8\xcb\xf8\x1a\xf8`\xf5\x93\xf7\x11\xf5?\xf7\\\xf5/\xf6\x90\xf5\xa4\xf3\xc5\xf4\x1a\xf0\x9d\xf3$\xec\xbd\xf1\x90\xe6\xae\xed\xe2\xe1v\xe7:\xe0s\xe4-\xdf\xc6\xe3d\xe2\x91\xe6P\xe8\x83\xed\x9c\xee\x0e\xf2g\xf4\xe1\xf5R\xf6a\xf9\xf5\xf6b\xfb\x95\xf6\x84\xfcL\xf4\xe5\xfc\a\xf32\xfc\xf0\xf4\xfe\xfd\xbd\xf8\xe5\x01\x8e\xfd\xd8\x03\\\x02\x8f\x05\xb6\x05C\aJ\t\xa4\b2\v\xd6\nn\n\xfe\n\x8f\b\xa0\b\xe2\x04\xe8\x05a\x00\x04\x02\xf9\xfa\x1a\xfd\xa1\xf43\xf77\xf0\x9e\xf1<\xee\xb8\xeez\xef\xb6\xeeD\xf2\xb3\xf0G\xf4\x1e\xf3u\xf4\xbe\xf4\xcb\xf2\x88\xf4\xf0\xef\xd5\xf3\xcc\xeb-\xf2\x87\xe8u\xef\x14\xe8=\xee\x1a\xebY\xef\xa7\xf1\x87\xf2\xe9\xf8\xd7\xf6\xca\xfe\x84\xfb\xd4\x01\xc7\xfe\v\x00-\x00h\xf9\xe5\xfdS\xf25\xf8\xae\xec\xb4\xf3\x10\xea\xc4\xf0\xef\xee\x84\xf0\xa8\xf8\xa4\xf5\xf8\x02\xe6\xfd\x87\n\x81\x04\xd6\v\xe4\x06\f\t\xe3\x04D\x05f\x00\xf9\xff\x10\xfc\xed\xfa\xb2\xf70\xfa\xd0\xf5e\xff\xc9\xf9X\ay\x01\x88\r\x86\b\x90\x10\x91\rW\x13\x8c\x10\f\x18\xb4\x12n\x1d\x01\x15\xd3!Z\x17\xe7\"\xad\x17\f\x1f~\x15`\x19\n\x12\xc1\x14\xc8\x0e\xb6\x0f\xc5\vf\r\x91\a\xba\x10\xde\a\xc5\x17\xa1\f\xd6 \xdd\x12\x93#\xcc\x17\x12\x1c\xb7\x15{\x0f\x81\r\v\x05\xa0\x04\xae\xfd\xa6\xfe\xb1\xf96\xfa\x90\xfcl\xfaC\x04\x85\xffN\f\x1f\a\x88\x11\xda\r7\x12+\x11w\f\xa6\x0f\x15\x06\xc2\t\x83\x02i\x06\x15\x01\xe8\x04\u007f\x03\x16\x05\xf4\x04\xdf\x069\x05\xb1\x06\xa4\x067\a\xa4\x06@\t.\b)\t\xcf\n(\t\x92\f:\n\xe1\x0e\x88\v!\x0e(\rM\t\xae\nI\x02\xa4\x040\xf9\xd8\xfd%\xf4\xd2\xf7\x89\xf5\xd1\xf6|\xfbE\xfb\x05\x04\xd1\x01\x13\n\xcb\a:\f\f\f\xee\n\u007f\r\xd2\x05\xfb\n,\xff~\x03_\xf8\xec\xfb\x98\xf3\xdc\xf67\xf4\xba\xf4\t\xf7\"\xf6*\xf9\xae\xf7X\xf8e\xf8\xab\xf4=\xf7\x99\xf1m\xf4\xdd\xec\xb9\xf0\x0e\xe7\f\xec\xba\xe2\xdf\xe7&\xe1\xc4\xe5g\xe4\xe2\xe7\x0f\xe9\xa9\xec\xef\xeaQ\xef\x9f\xebu\xefN\xec@\xf0\x15\xedy\xf1\x83\xee&\xf3\xc5\xee\xa7\xf5\xfc\xeeN\xf7\x1c\xf3\xec\xf9+\xf8\x8e\xfe\xa6\xfa\xe4\x01\xff\xfb\xb2\x02:\xfc\x99\x02(\xfd\x9d\x02\f\x00?\x03j\x02\x96\x05K\x04\xc3\x06\xdb\x06>\x06\x06\a-\x06\x16\x05\xee\x03\x05\x01%\x00\x00\xfa\x99\xfb\xe2\xf3)\xf6Q\xf0\xfe\xf1+\xeeK\xf0\xdf\xed&\xf0\xe7\xedA\xf0Q\xee\x03\xf0\x12\xefB\xf0\xc8\xef\x8e\xf1\x13\xf0\x87\xf3I\xf0Y\xf4\x00\xf2\xda\xf3\xbc\xf3\xa8\xf3\xbd\xf5T\xf4a\xf6\x93\xf4s\xf3W\xf3\x1c\xf0S\xf2\xab\xed\t\xf2_\xeb\xe0\xf1!\xeac\xf1\x1f\xed\x1b\xf1\x8a\xf4\v\xf4\xc9\xfc-\xf9U\x02\\\xfd\xd4\x05a\x00\x1f\a\x8f\x02,\x054\x02k\x02\x9a\xff\xf5\x00)\xfd[\x01:\xfc\u007f\x04\x14\xfe\xff\b\xd0\x02\xad\rt\b\x1d\x13S\x0e\f\x17\x98\x13P\x1a\x93\x17\x8b\x1f \x1a\x06%\"\x1c\xbf%Q\x1ca\"C\x18\x19\x1d\a\x14\x8d\x17e\x10o\x13\x14\r\xed\x0f~\n\xb2\x0f\x01\t\x8f\x14\xd6\tZ\x1b\xd8\r\x8a\x1e?\x12G\x1b\xaa\x11P\x12Q\f\xa3\b\xa1\x04\xfe\x01r\xff\xff\xfc[\xfc\xc0\xfcz\xfa\x9e\x00\xdb\xfb\xb8\x05\x9f\x00U\n\x9d\a\x19\f\t\rT\n\x9e\r\xf1\aM\v\xe9\a\xf1\n9\n\x19\f\x87\f\xcb\f\xed\f\x05\r\xba\t\xcc\v\xe5\x05\xf1\b=\x04\xa1\b\x01\x04S\n\x8c\x05\x8b\n\xb4\t\xc5\n\n\x10\xd9\fs\x15\xac\x10\xa3\x12\xdf\x10\xb8\b\xe2\ba\xfd\xa6\xfe\xb0\xf4\b\xf8B\xf1\xd8\xf5B\xf2\xae\xf6\xbe\xf7O\xf9\xe4\x00\xbf\xfe\xd7\a\x03\x06\x82\b\v\t\x97\x03|\x06\xe8\xfc{\x00G\xf7\xe2\xf9\x1c\xf3m\xf5\x1d\xf2n\xf3\x85\xf3\x14\xf4-\xf6\xec\xf5L\xf8\x88\xf7n\xf64\xf8`\xf2w\xf6%\xeeH\xf3&\xeaA\xf0\x90\xe6G\xed\x9e\xe4M\xea\x9b\xe5n\xe9\xb2\xe8Q\xec\xdd\xec\x1e\xf04\xf1_\xf2\x92\xf3\x99\xf5\xde\xf4=\xf9\xb9\xf4o\xfb\xdc\xf0\xd0\xfa%\xec\xe4\xf63\xe9\x99\xf3,\xe8P\xf3\x81\xeb\xca\xf4\xcf\xf1\xf8\xf7q\xf9\xff\xfc`\x01\x01\x040\a\x1f\nH\vT\r\xf8\nu\r\xf0\x05\xba\b&\x00\xe5\x01\xaa\xfa\x87\xfc\x93\xf7\x04\xf8;\xf6\xa8\xf5\xf8\xf4\xe9\xf4\x9d\xf6\xd9\xf4F\xf9\xfb\xf60\xfa\xf9\xf8\x02\xf8\x9c\xf7\xb6\xf2m\xf4\xa5\xee\x96\xf1\xb6\xecf\xf1$\xebv\xf2\xac\xebU\xf2W\xef\x16\xf3\x8a\xf5\x85\xf59\xfb\x8f\xf8\xf8\xfbQ\xf9\x12\xf9\xd4\xf5\xe2\xf3\xf8\xf1\xef\xec8\xf0\xa8\xe7\b\xefE\xe3\t\xee\x05\xe2\xcf\xec\x1d\xe7!\xed\xbe\xefS\xf1\xf0\xf9\x1e\xf7A\x02\xa6\xfb\x9d\x05\x13\xfe\x87\x06G\xfe\x99\x06d\xfe\x1c\x06F\xff\xcb\x04\xad\xff\x06\x03\xc7\xfe\xaa\x03=\xff\xbb\x06\xac\x02.\v\xbb\at\x0f#\f\xcd\x12\xb4\x0e;\x18\x0e\x11\x9e!\u007f\x16\x81)\xa8\x1dW,.!\xfb)$ \xe6#\xee\x1b}\x1c#\x17\x06\x16\x13\x12\x80\x13\v\x0e\x9a\x12\xc8\n+\x15\xa8\b\x1d\x1a\xec\v\xf0\x1c\x93\x10y\x1a~\x11\xfa\x11\x9a\r\x1b\aL\a\xea\xfdr\x00L\xfb\xde\xfc7\xfe*\xfdw\x02\xb8\xff\xce\x06\xd0\x01\xe0\vW\x06&\x0e1\f\xac\x0f \x0eB\r\x8b\x0e\xa5\x06\x10\v\xc9\x06>\bE\t\x94\v%\t\x9c\r\xd4\x06\xf8\n\xcf\x01\xb2\a\xed\x00l\x05\xdc\x03\x88\x06C\a\x97\t8\f\x9a\v\xdc\x0e\x9f\rm\x10\xb6\x0e)\x11\x1d\x10\x91\vg\x0e(\x04\xea\x06\xd5\xfc\u007f\xfft\xfa\xc8\xfb\xec\x00\v\xfe\x9c\a\x89\x04\xfe\n\x06\b!\v.\t\x1c\b\xbc\t\xf0\x04\xb5\a\x96\xfe\x86\x03\xd1\xf6{\xfc\xe8\xf2(\xf6\n\xf4\x0f\xf5x\xf98\xf8\x93\xfc\xf2\xfb\xcb\xf9\xd6\xfa\x1e\xf5\xee\xf5\xcb\xee[\xf1\xd9\xe9\xef\xec&\xe5p\xe9\xa6\xe0\xf3\xe5\xfd\xe1e\xe5\x1e\xe7c\xeau\xed\x1e\xf01\xf3H\xf4\xc6\xf3\xc6\xf6\xee\xf0\x86\xf5x\xef\x86\xf34\xee\xef\xf3\xe4\xec\xfb\xf5\xa1\xed\xa6\xf8\xbb\xf0\x15\xfbD\xf7\r\xff\x1c\xff\xa9\x04\xaa\x04\xb0\t0\x06M\v\x17\x06\xd3\b\xc6\x05\xa9\a\x11\x04m\b\x16\x02T\a\x06\xff\xef\x03\x03\xfb\xcd\xfeS\xf9\xc9\xf9T\xf8\xc2\xf7\x80\xf6^\xf6I\xf4\xa3\xf3\x94\xf1+\xf1_\xf0\x80\xf0\xd2\xef(\xf2=\xee\xfe\xf2F\xebm\xf03\xe7O\xec\x8a\xe5\x10\xea#\xe6\xaf\xea\x87\xe6\xf6\xeb\xdb\xe6\x92\xea\x92\xe7/\xe9\xae\xe9N\xea\xc7\xed\x0f\xed\xe6\xefu\xf0\xf1\xee\x18\xf1\xd3\xed\xa2\xf0\x1b\xed?\xf2D\xeeL\xf4\xa0\xf0\x87\xf5\xdf\xf3Z\xf5\t\xfaP\xf6D\x02\xdf\xfaX\n\xed\x00|\x0f2\x06\xb9\x0f[\bX\r\\\a\xef\n\xe8\x05\xe5\n\xd9\x05.\f\\\a&\r\x98\a)\x10\xec\aH\x16\x1c\f\a\x1d\xe6\x13\x06\"\xf5\x19\x1e%\x9b\x1c\xe1$Y\x1c(\"\x14\x1a\xf1\x1c\x99\x16\xee\x17\xdd\x11\xfa\x14\x15\x0et\x12M\v\xbd\x11\x05\n\xe9\x12\u007f\nl\x18\xf9\r-\x1c\xec\x11\xfb\x18i\x10@\x12\x04\f\x89\v\xb2\t!\x06\xd3\a\v\x05\xc0\x04\x88\x06?\x04;\b\xe0\x04\xd6\rH\b\xe4\x12\x1a\r\x98\x14\xe5\x0fj\x12f\x10E\r\xeb\x0f9\tM\x0e\x04\v\xd4\x0eH\rB\x11\xe1\ne\x0e-\ap\t\xb7\x04x\x06\xea\x04\x9f\x05\xc5\x04\x8c\x05F\x03\x04\x05\xcc\x02\x9b\x05Y\x05\x13\b\x1c\nT\n\xe5\t\xb1\t\x91\x03\xe3\x04\x17\xfc\t\xfen\xf7\x9c\xf8$\xfb\xda\xf7\x15\x02\xdf\xfd.\x06C\x03:\v\x18\x06\xf0\x0e\xe7\n>\x0e\xa4\r\x89\x063\t\xd5\xf8]\xff\xbd\xef;\xf6\xe0\xed\xd3\xf2o\xf1+\xf5\xb3\xf7\x99\xf9!\xfd\xc2\xfd\xca\x00\x9e\x00\xb8\x00\x15\x01\xbb\xfb=\xfdK\xf3\xb6\xf6\xa3\xea\xc0\xf0\xe1\xe3\x1e\xea\xf2\xe2/\xe7\xd7\xe4\x81\xe9\x8f\xe7\x9c\xeaJ\xea\xae\xeb\xd1\xea=\xec\xdf\xeb\x9e\xecF\xec\x14\xf0t\xecQ\xf3\x1b\xee9\xf6\xc7\xee\xd0\xf9\xeb\xf1\xfe\xfc\x16\xf6\xc3\xffH\xf8-\x01\x90\xfa\xea\x00P\xfb5\xff\xb0\xfc\xbf\xfe%\xff\xe0\x01)\x00\xa4\x04\xea\x00m\x04\xec\xffF\x03@\xfes\x01\xfd\xfc\xbe\xffZ\xfa\xe2\xfdH\xf7\xb2\xf9\x8a\xf3\xa6\xf5\x18\xf0/\xf3\x10\xef\x0e\xf1\xb5\xee\x9b\xf0\xf6\xed?\xf0\x9f\xed\x99\xefY\xee\xb4\xf08\xefj\xf2\xb6\xed\x96\xf3t\xe8U\xef\x1f\xe2\xe9\xe7\xd5ܡ\xe2\xb7\xdbY\xdf\xc6ݣ\xdf\xc3\xe0\xb8\xe1\x19\xe5v\xe4\xdf\xe9\xbc\xea\xa2\xee\xf2\xf0\xe5\xf0\xb0\xf4Y\xf0\xff\xf5\xf8\xf2\x81\xf5\xef\xf9\x86\xf7v\x02z\xfc\xa9\t\xae\x01\xc5\r\xb9\x04\xfa\x0eE\x05\xed\r\xbe\x041\f\x82\x04^\n\xf5\x04\xf0\n\n\x064\x10`\tC\x17]\x0f \x1fD\x17\xd9$[\x1d?'[\x1fO)\xe7\x1e`*k\x1e\xed&\x88\x1cq!\xd5\x17 \x1c\xc0\x12\x95\x176\x0e\xff\x13K\vk\x10.\n8\x0eM\t\xef\x0e\xda\b]\x12/\n\xdc\x13\x89\f)\x11+\rQ\f\x01\nm\t\x19\x06\xb8\b\xb8\x04\x1b\n]\x05\b\n\x17\a\xb0\tr\x06\xf9\f\xa8\b[\x0e\f\ru\x0e\xb6\x0f\xbd\t\x81\x0e(\a=\v\x80\n\xad\v'\r\x99\rH\r\x04\x0e*\t(\f,\x05\x85\tg\x05D\b\xa1\x05\xb4\t>\x04\xee\t\xdb\x04\xfa\b\x8c\bV\b0\x0f\xc8\t\xf2\x10l\f8\r\xc7\tT\a\xd0\x04\x19\x05l\x02N\b'\x05\xc3\t\x05\b\x03\n\xc0\b3\t\xb2\a\xe7\ag\x06r\x03Q\x02B\xf9\xe0\xfa8\xeeS\xf2*\xea\x1d\xee \xec\xae\xf0n\xf1\xc4\xf4\x16\xf7\xf7\xf9\xe3\xf9\x91\xfd!\xfaT\xfe\xf6\xf6\x04\xfb&\xef\x94\xf3\xea\xe5\x05\xec\xed\xe0\xe0\xe7E\xe0\xb6\xe7?\xe4n\xe9f\xea\x8a\xeb\x1a\xee\x9f\xee\xfb\xef\xfa\xf1 \xf0\xbe\xf2\xcc\xed\x91\xf1\xdc\xea\xea\xf0I\xeaV\xf4\xe5\xecO\xfae\xf3a\xff\x05\xfb\xf5\x02x\x00N\x06\xb3\x04O\tZ\x06`\t)\x04\xb9\x05\xc1\xff\xf1\x00\xb0\xfa*\xfd@\xf8&\xfc\\\xf7\x84\xfb\xc7\xf5A\xf9\xc8\xf2\xba\xf6\f\xefS\xf4/\xed\xe0\xf2\xdc\xea\xf5\xf0^\xe7C\xed\xb7\xe6\xab\xea\xf9\xe9\x82\xec\xaf\xef$\xf2!\xf2\xa3\xf5\x84\xf0\x87\xf5\t\xed\xf4\xf2\x83\xe7\xf7\xee\x81\xe0\xda\xe86ك\xe0\xa4\xd5jں\xd9_\xda\xd7\xe0?\xe0\xc3\xe7u\xe8\xf6\xec\xf6\xed=\xee\x1d\xf0\xe0\xee\x90\xf0\x05\xf0\x93\xf1\x9d\xf2\xb2\xf3\xdc\xf9<\xf7\xa1\x03\xaa\xfd\xcb\r4\x06\x9f\x15\xe8\f&\x17\x19\x0el\x14\x94\n\xf7\x0fd\x05?\v[\x00\x95\n\xe2\xff\xf0\fB\x04\x8c\x10\x92\t\x89\x14\x8a\x0eL\x1a2\x13\xa1!\xd1\x18\xf3%\x93\x1d9&w\x1d\xdf#\x1c\x1a\xdc!.\x18s#\x05\x1a\xa0\"o\x1bh\x1c\x1c\x16$\x16\x13\r\xc8\x12\xde\a\x06\x12\xbb\b<\x11K\n\xa8\x0e[\t-\n\xbd\ar\a\xe9\a\xe1\t\xb4\v\xd7\v\xe7\r \v\xe9\t\xa2\n\x15\x05\xc5\fv\a\x1f\x10\x17\rr\x13\xc6\x0fw\x10\xe5\x0e\xb9\n*\n!\n\x83\bi\v\xbe\v\xae\te\v_\x03\x8d\x06g\xfd\b\x021\xfe\r\x02]\x03\x15\a\xa4\x05O\tC\x04\xa9\x06\xa5\x03\x92\x04\xf1\t\xc2\a\x93\x10\xad\x0eJ\x10\xdd\x0f\xd3\ri\fb\x0e1\vt\x14X\x0e\xc0\x18\xcd\x12+\x16\xc7\x11\f\x11#\f\x82\v\xfd\b\\\x06\xcd\x06Z\x00!\x033\xf9\"\xfeP\xf6%\xf9\x19\xf77\xf86\xf9\xfd\xf9\xb4\xf6B\xfcD\xf0`\xfa\x05\xec`\xf5\xf0\xe8C\xf1\x9c\xea\xca\xee\x86\xf0W\xf1$\xf6\xb0\xf6\xc4\xfa\xe4\xf9\x17\xfb\xce\xfat\xf3u\xf8\\\xe7\x9f\xf1\x9c܀\xe8c\xd6gߚ\xd8\xeb\xdb\xe7\xe2\xaa\xe2o\xf0\xf3\xf1\xee\xfcT\x01\xf9\x04\xd7\t\xe3\x051\f\x91\x01/\v\t\xff\x9c\b)\xff\xc6\x05\t\x01t\x02\xd3\x04\x8c\x02\x03\x06`\a{\x06\xad\n\x0f\x03\xca\a.\xf8\x1d\xfdI\xec\xfb\xef\xe0\xe3\xfd\xe7/\xe2u\xe6\xbd\xe4\xc7\xe8\xf7\xe6\xd0\xebl\xea\n\xf0C\xee\x8d\xf5\x15\xf0\r\xf8\xbe\xec\xf2\xf3j\xe4\x15\xec$\xe1\xe3\xe6Y\xe4\xac\xe9\xcf\xe8z\xefG\xec\x86\xf1\x8b\xeb\x1a\xefg\xe8\x98\xeaB\xe3\xcd\xe5Xڏ\xdf\xd9Ҹ\xd8\xd0Ҁ\xd7\v\xddX߀\xed\xac\xed\xad\xfd\x9a\xfc\xa8\b<\x05\x14\f\xfd\x06\x0f\v\x13\x05\xac\b\xa9\x02\xd3\a\xc3\x02\xff\nj\x04w\x10,\a\xe7\x140\n\xc4\x17\x81\v\xc9\x17X\f\x85\x13\xbd\n\x14\r\xb2\x05\xd8\nO\x03{\x12l\n\x00  \x18b'\xaf \xf7%\xf1\x1e\xe5!\x05\x1a\xa5\x1d\xb7\x17^\x1b\xb7\x16\x06\x1ag\x14\x8f\x18\xe8\x0f\xb3\x1b\xff\x0f\x90 \xd0\x15\x8b\x1f\x1c\x18\xc0\x14q\x10\xca\x04\xf8\x01\x15\xfa\xb1\xf7\x15\xfb\x9e\xf9\x1f\x04\x05\x03&\fm\n\x96\x10\x19\x0e\xee\x16J\x13\xc4\x1b\x05\x1b\xb3\x19\x91\x1b&\x12\xf1\x12#\v/\n\x06\x0e\xde\t\xfa\x152\x11\xaf\x16\xb9\x14\xf6\r\xc6\x0e\xff\x01{\x04\xb0\xfb\x81\xfd\x92\xfa\xce\xfcw\xf9#\xfdY\xf9'\xfb\x8a\xfe\xe0\xfc\x1a\b\xac\x04\xba\x0eI\r\x90\f\xbb\x0eE\x06\xc6\x060\x05\xd7\x00x\v\x1d\x04\xae\x12\x04\fM\x16Y\x11\t\x17\x1a\x12\x84\x15\x8b\x110\x11\x94\x0f\xde\x06h\ts\xf9\xd5\xff3\xf2Q\xf7G\xf3\x16\xf5i\xf7\xd4\xf9p\xfa\x81\x00\xdc\xfc|\x04l\xfdm\x03\xe9\xfa\x95\xfed\xf5t\xf8\x10\xefr\xf4#\xed\xe0\xf2\xf9\xee\x98\xf2C\xf0\xca\xf3(\xf1\x97\xf5\x1a\xf1~\xf6\xb5\xeen\xf4\x1b\xeaU\xed}\xe3\xaa\xe4E\xdd|\xe0\xeb\xdc+\xe4'\xe20\xec(\xea\x19\xf2\xb1\xf3_\xf7\xd7\xfb;\xfeX\x02.\x05\xf5\x06\x13\t\x14\x06\x88\aK\x02\xc8\x05\x13\x00\xae\a\xa7\x00Y\n\x9c\x01^\t\xff\xfe\x83\x02\xea\xf9\xc4\xf9\x03\xf4\xbb\xf3\x95\xeeB\xf0\xeb\xe8\t\xed%\xe2\x11\xe9\xd2ߪ\xe8\xf5\xe4\x1b\xeeJ\xed4\xf5\xa9\xf3\xfb\xf7\xcd\xf4^\xf6\xd5\xf1\x9b\xf4\xb5\xee\xbe\xf4Y\xea\xd8\xf3\xdb\xe3\xf7\xeei\xde \xe9\xe1\xde\xca\xe6w\xe4.\xe8\r\xe9\xd7\xe9]\xe8\xf1\xe7\xc2\xe2\xb2\xe3C\xdd\xd0\xe0\x86\xdc\x17\xe1\x1c\xdf*\xe4\xbb\xe5g\xe8\xff\xf2\xf7\xef\xdf\x02\x95\xfbl\x0eC\x05&\x11\x9e\b\b\v;\x05v\x03\x06\xff.\x00\xc5\xfb\a\xff\xe4\xfb\xf3\x00\x98\xfc\x88\x06E\xfe\x10\rS\x02\xde\x0f\xe4\x05\xbd\rp\x05\xa9\n\xb4\x04\x95\v<\x064\x14\xe1\fy\"\x13\x19\xd10\x8d&<6\x01-\xbe0\x19)\x1f%\xad\x1e}\x19\x80\x13\xf0\x11@\f\xb0\x0f2\t\xc1\x132\v\xb5\x1d\xf0\x12I%\x96\x1b\xfc$l\x1dY\x1a\x84\x16\xca\v\xc6\b\x1d\x04@\xff}\x03\x0f\xff\xae\ty\x04\xc3\x12\xd0\f'\x19\x80\x13\xb0\x1aP\x16f\x15\xeb\x14+\v\xeb\x0f\b\x03c\b\xcb\x01\xdc\x03\x8e\bW\a\xb3\x10\xab\x0f\x00\x13L\x13>\x0f\xb8\x0f\x80\x050\x06\x8b\xfbE\xfcO\xf6\xb5\xf7\xcd\xf7T\xf9_\x00\u007f\x00\xe9\ry\v\x9c\x1a\x80\x17\x98\x1f\x96\x1d\xae\x1b\x04\x1a\x8b\x14\xad\x11k\x0e\xaf\n7\r'\b\x05\x10\xd9\v\x83\x12c\x118\x13\xc7\x12\x9b\x0f^\x0f(\x05\xb8\x06|\xf71\xfb\xa8\xec:\xf2[\xe9\x14\xef\xf8\xee\xdd\xf2\xd3\xf7\xe9\xfb\xa7\xfeC\x04)\x01;\aS\xfdk\x02\xf2\xf3/\xf7\xc3\xe7\x8b\xeb&\xde\v\xe5\xf8\xdb\xfa\xe4\x10ߢ\xe7d\xe33\xe9\xa6\xe5s\xe9\xa2\xe4\xa6\xe7A\xe2\x98\xe3\xa9\xddw\xde!\xdb\x02\xdb\xdc\xdd\xff\xe0\xca\xe4\x9d\xedH\xf0\xde\xf8O\xfaq\x00\xe8\x00\xc7\x03!\x04\x9e\x05\x91\x03\xf1\x06v\x025\a\xf9\x00\x9c\x06\xd8\x00\xd2\x06V\x01\xf6\a\x84\x00\x12\b\xe4\xfe\xb8\x03\xb6\xfaG\xfc\xcb\xf5p\xf4\x15\xf1\xf3\xef\xd1\xea.\xef\xb8\xe6\xe1\xed#\xe5]\xec\x12\xe5\x93\xec\x83\xe6\xeb\xed\x1f\xe8e\xf0\x04\xe9P\xf19\xe9\xca\xefu\xe8\xc6\xee%\xe4\xe1\xed\xfa\xdcO\xeaM\xd53\xe2N\xcf?ر\xcewӲрՃ\xd7sە\xe0\f\xe2\x1f\xea\xfb\xe8 \xef\xc5\xef\xc0\xf1\xbf\xf4\xf0\xf6\x16\xf9\xbb\xfe}\xfd2\ay\x01l\x0e\x9e\x05^\x13x\n\x89\x17L\x0f\xa0\x17\xc9\x0fX\x11\x98\t\xdc\t\xfd\x01\xe0\x06\x98\xfex\n^\x00g\x12\xfa\x03\x91\x19\xaa\av\x1d\x0f\fr M\x13\xdc!\xd6\x19\x16!R\x1a\xe2!o\x19\xfb!\xf4\x19\xbd \xb3\x19\x8a \xad\x17) \xf9\x13\xed\x1bV\x10\x9b\x15q\r\x83\x13\xe6\r\x1d\x15'\x11\xea\x17q\x12}\x1a\xb6\x13\xa3\x19\xa4\x14\xba\x16\x8f\x11N\x14K\x0e\xa5\x10\xbd\f\xf2\x0e\xdc\f\xd6\x10\xde\x0f\xf3\x13\x13\x13Y\x17Y\x15=\x18-\x16\xfa\x15\xfb\x14,\x10\xc5\x11\xaa\b\x82\f\x81\x06*\n\x13\b\xab\f\x15\b\x99\f'\a\xbe\bE\x03\xec\x03p\x00\xa0\xff\xe3\xff>\xfd\xe1\xfd\xb2\xfb\xba\xfc\xcb\xfc\xff\xff\xbc\x01i\n*\t[\x16q\x12\x87\x1cl\x17\xb6\x1f\x96\x17n\x1f\xa3\x17\x10\x1d%\x17\x8b\x18\xc3\x14\xa8\x0f\x9a\x0fK\x06\xa5\a\xd8\xff\x93\x00\x84\xfc\xd6\xfb\xa5\xfa\xc1\xf9\xef\xf6r\xf7Q\xf4\"\xf6\xb0\xf2\xd8\xf9I\xf0r\xfc\xdd\xedv\xfa6\xec\x80\xf72\xed9\xf5\xc8\xf1\xbb\xf5\x1b\xf7t\xf8\xcd\xf73\xfa\x8c\xf2h\xf8\x15\xea\xfb\xf2h\xdf\xc9\xeavղ\xe0L\xd1H\xd8\xeb\xd5q\xd8\xe3\xe0\xc9\xe0\xb3\xef\xda\xedr\xfb\xf0\xfbv\xffs\x04i\xff'\at\xfd\xb4\x05X\xfbw\x01\x9b\xfc\xb3\xfe\x9f\x01-\x01\xb2\b7\b\xba\f#\x0e\xfd\t\x92\rB\x00-\x065\xf4u\xfbG\xeb\xac\xf1\xc9\xe5h\xe9\xbd\xe5#\xe6\xae\xea2\xeby\xefV\xf2\xf8\xf1:\xf7\xfd\xed\xb9\xf6\xab\xe5g\xf0\xd0\u07bf\xe9\xd4\xdb\xfa\xe6\a\xde\x06\xe8\x88\xe3\xa7\xeb\xe3\xe9/\xf1\xaf\xee\xab\xf5\xe9\xed\xab\xf4u\xe7O\xedE\xdem\xe2q\xd8\x02\xdbn\xd8\v\xdb^\xdd\xf8߹\xe4\x9f\xe6\x82\xeb\x89\xec\x05\xf20\xf1\xc3\xf9\xc9\xf5P\xff\xc1\xf9\x8e\x02j\xfd\\\a\xfb\x02\xbb\rl\nA\x15\x96\x11\xf4\x18\x87\x14\xae\x15\xa5\x0f\x82\x10R\x06M\f\xcc\xfe9\t,\xfb\xc2\t\xa9\xfb\x1d\x0f\xe5\x01\xfa\x17\xff\v\x00\"\xb8\x16\x04(\x11\x1e\xa8$I\x1dE\x1b\xa9\x15\x1c\x178\x10\x14\x17.\x11\t\x17c\x13\xd8\x1a\x13\x16| F\x1a\xc5$J\x1c\xb7$\xaf\x19\x94\x1bN\x12`\r0\b\x18\x04\xb6\x00\xbe\x04D\x01c\v\xca\a)\x11\x11\x0e\x11\x15W\x11\xc7\x16\xbe\x12\x94\x142\x11.\x0fu\rO\bp\nt\a\xb5\t\xab\x0f\x10\x0e\x1e\x1a|\x16\xb9\x1c\xf8\x1a#\x15\xde\x16\xdb\t?\r\x03\x01\xa7\x03\xde\xfa\xe5\xfc{\xf7?\xf9\r\xf9\xe8\xf9\x9e\x00\xe9\xfd\xa8\td\x02\xe4\v\x97\x05`\x04z\x04M\xfc\xce\xff\x92\xfc[\xfe\x18\x05\x9c\x03\x9c\x11\xf8\fD\x1ev\x16_'a\x1e\x01*\xb2!\xc2#C\x1d\xce\x13\xdc\x12P\x00U\x05\v\xf2(\xf9\xf9\xec-\xf3\x14\xee\xc6\xf2\xd0\xf0=\xf4U\xf5k\xf8\xaa\xfc^\xff^\x04\xa4\x05\x83\b\x85\b\v\tf\n9\a\xf5\v\xbf\x02\xb7\t8\xfa7\x02\xcf\xeb\xfd\xf5\xda۾\xe7\xf8\xd2R\xdd\x11\xd0\xe4\xd7\xc1\xd2S\xd6\xe2\xdb\x01\xdc\xd8\xe6\x1a\xe8\xbc\xf1d\xf5E\xfa\v\xff\xd0\xfc^\x02\xdd\xfb\xc1\x01\x1e\xfc\x0f\x01\x00\xfd\xc0\x00\xf1\xfb*\xff6\xf9\x81\xfd\f\xf5<\xfch\xf3=\xfc{\xf5\xd3\xfd=\xf7\xf8\xfc\xe8\xf8\x8d\xfa\xb8\xfb\xb0\xfb\xf2\xfcs\xfer\xfb\xcf\xfe\xb0\xf5\n\xfc\xe6\xec#\xf6\x93\xe6=\xefx\xe5\xf7\xea\xbe\xe6\xac\xe8\x88\xe8\xa8\xe7B\xeaG\xea\xa0\xeb\x15\xf0\x89\xebi\xf5\xa7\xe7\xc6\xf5\x06\xe2\x03\xf1O\xe0\xa9\xeb\xc8\xe3y\xe8\x12\xe9L\xe7\xb8\xecE\xe7\xca\xeda\xe8x\xea\x06\xe9\xa0\xe3_\xe7\x83\xdbd\xe2\x91\xd5\xc4\u070e\xd8\xfe\xdb\xcd\xe5\xc3\xe3v\xf7\xf6\xf1\xdd\x05H\x00J\r\x1e\t\x13\x0e\xd7\v\xfa\t\xf4\b\xa8\x04E\x02z\x00\xb7\xfb7\xffn\xf8\xe6\x01-\xf9\xa8\a\x82\xfd\v\r\xf0\x03W\x0e\xce\a\x84\r\x9e\a\xe8\x11\xd9\b#\x1du\x0f\xcb*6\x1b\xa86\xac(\x1b<f1\x818\xa11k.G+\xd7\"\x8a!q\x18\xd9\x15\xfa\x13\x83\v\xa5\x17\xa1\b\x1f\x1e\xe3\f{\"+\x14\x97!3\x19\x96\x19\x8d\x16\xdd\x10\xfa\r\xfe\v\x10\b\a\f~\aU\x10\xb8\t]\x15\x14\r\xd4\x15\xae\x10\n\x0f\x04\x10\x00\a\xe7\v\v\xff%\a\xdd\xfb,\x02\xfa\xff!\x01\xd3\b\xdc\x06\xf9\x13\xa1\x11\x12\x1a\xa6\x19\x8f\x17\x10\x1a\xc7\x0fH\x14\x02\t\xd2\fL\b\xec\bQ\f\xf4\bo\x12\xff\n\x15\x17\xc8\rh\x17\xd2\x10_\x12,\x12u\b\xbf\r\f\x00\x97\x05\xbc\xfd`\x01L\x04\xf6\x03\\\x10\x9a\fQ\x19y\x15I\x1c\x93\x18H\x17\x9b\x14\x11\v\a\fw\xfc\\\x00\x87\xef\xfe\xf2\"\xe6C\xe82\xe3\n\xe5\xcf\xe5\xf5\xe8.\xea+\xf0\xd8\xec\xfd\xf5\x96\xeeJ\xf8\x8f\xef\xca\xf8\r\xf1(\xfa\xd2\xf2\xde\xfb\x9b\xf1`\xfa\xc4\xed?\xf52\xe8\x16\xed\xe9\xe0\xa0\xe3I\xd9t\xda\xddҖ\xd2\xd1\xcf_\xce\x04\xd3\xc2\xd1{۵\xde\x12\xe7\xae\xef)\xf3\x93\xfe\x8c\xfc\x91\a@\x03\xab\v\xa0\x06\xe1\f\x8c\x06\xb6\n\xa0\x02\xc0\x04c\xfdT\xfe\xa2\xf8\x1d\xfa\xea\xf4{\xf8w\xf2\xfe\xf7\xfa\xef\xfe\xf5\x03\xed\xe2\xf2,\xec\x8f\xf1\xa8\xed\x82\xf4Q\xf0\x92\xf8\x1a\xf3\xe4\xfa\xf5\xf4z\xfah\xf4K\xf8\xac\xf0\x1c\xf5\x13\xeaV\xef\f\xe1\xf5\xe7;\xd97\xe1\x94\xd6\xec\u07ba\xd7j\xe1^ڑ\xe4\x8dܩ\xe5\xc1\xdc\x05\xe5\xa2\xdb\x0f\xe3\x04\xdci\xe2\xdc\xde\xd2\xe3\xa0\xe4\xe3\xe6\xcb\xecc\xeb8\xf3x\xf0S\xf5p\xf4M\xf4\xac\xf4\xb6\xf1\x18\xf2\xcc\xef\x98\xef\x83\xf1,\xf08\xf9a\xf5\x14\x04\xbf\xfd \x0eE\x05\x93\x13\xa9\t\xd4\x13\x98\v\xa5\x12^\f\xa3\x10&\v\xf0\x0e?\x06}\x0e\xff\x01P\x0fO\x00\xce\x12\x1f\x02\x14\x14\x19\x05\xd2\x10\xdf\x051\x10\x8e\x06x\x15O\r\x9a\x1e\x96\x17\x96)\x19 )1\x81%(1\xb4&\x84*\xc1\"\xdb!#\x1b\xfa\x1b\x88\x14\xa4\x18\x8e\x0f\xe3\x19\xd2\f\x8b\x1e\x93\x0f\x8e\"\xa9\x14,'o\x1a\x17&&\x1f\xe3 d\x1f\xeb\x1c\xff\x1d\xe3\x1b5\x1d\b\x1fd\x1d\x86 \xb0\x1cK \x01\x19\xf1\x1c\xd0\x15\x16\x13\xa4\x10\x8c\n`\n]\x04Z\x06\\\x00T\x02\xe3\x02\xc2\x00\x0e\t\x89\x05`\f\xe2\v\xa5\nJ\r\xd8\x05\xaf\n\xee\x01\x98\b}\x00\xc9\a$\x03M\a\xab\t@\t\x83\x11\x05\r\x1d\x1a\x17\x12\xdf\x1f\x1e\x18}\x1f&\x1b)\x1c_\x1a\x01\x18/\x18X\x14t\x16\x85\x12\xc8\x14)\x11\xc7\x12\xb7\x0e\xb1\x0f\x9c\vc\v\x12\bB\a\x05\x05\x11\x05\x85\xff\xfe\x01\x81\xf8\r\xfc\x8e\xf2\\\xf6B\xec\xa2\xf1\xc7\xe6\xe7\xed\x16\xe1\xb3\xea@\xdd%\xe7d\xdf\xfe\xe6\xdc\xe2(\xe9\x05\xe6\x86\xeb\x18\xe5U\xeb!\xdf\xfd\xe4\xcb\xd8<\xdc;҆\xd5\x14\xd0\x12\xd3\xd4ӂ\xd6\x13\xdc~\xdf\xc5\xe8\xb2\xecG\xf3\x8e\xfa\xb9\xf9%\x05B\xfc\x11\n\x10\xfb[\bZ\xfa\xfa\x03Z\xfa\x9e\x01\x9e\xfc\xda\x01\xec\x00\x91\x03 \x04\xd6\x042\x04B\x03\xad\xfe\xb9\xfdt\xf5\xf4\xf6B\xec\xc5\xef\x1a\xe53\xea\x1c\xe2\xcf\xe8\xf7\xe3f\xed\x84\xe7D\xf5\x98\xea\x96\xf9\x85\xe9\x93\xf7Q\xe3\xee\xef\xb2\xd9\x14\xe5-\xcf\x1b\xda\xf6\xc8\xd3\xd0\xea\xc7\xf8\xccb\xca+\xcfo\xd0\xf5\xd4'\xd67\xdby\xd8h\xdd\xd8\xd7~ܞ\xd7z\xdd~\xdbi\xe1.\xe4A\xe9\xd8\xf0'\xf2\x8c\xfd\x87\xfa\xa2\x06\x84\x00\x9a\r\xf9\x03\xfc\x10\x13\x06\x8a\x11\xfa\x05\n\x13\xdb\x06\xd4\x16\x12\f\xdd\x1d)\x14h%\x80\x1b\xbe+!\x1f\x1e0W U0' \x91/t\x1e+0m\x1e\x1c.\x06\x1e^(\x9c\x19y\x1f3\x13\x98\x18\xf8\f8\x15\xe1\b\xf7\x10I\x05\x18\x10n\x04\x90\x12\xed\b\xae\x17X\x10n\x1du\x18\xf8\x1b\xb0\x1bF\x16\x1c\x16[\x11\xe4\x0e\xea\f\xa4\t$\f~\x06\xc5\fy\x06b\r\x13\b\x89\x0eK\n\x1a\x0fM\x0e\x98\x10\xe5\x13\xac\x10C\x16w\x111\x15\xbe\x17>\x19\x00\x1f\xce!<$i&\x12$n$?\x1f\xdd\x1d\xdd\x18\x99\x15{\x0e\x84\v(\x04^\x00z\xfd^\xf8\xe9\xfa\x81\xf5,\xfd\x1f\xf9\\\xfe]\xfe\xaf\xff<\x00\xfd\x03v\x02\x17\n\x16\a\xcc\x10&\v\xae\x15e\r\xed\x17\xba\x0e\xbe\x17#\x0f\x84\x14,\r\xac\x0e~\t'\b\xbb\x04|\x03S\x00\xb8\x00\xff\xfeP\x00\x97\x01z\xff|\x04\xd8\xfa\xd4\x03@\xf4&\x00$\xef\xc3\xfb\x1a\xec\xcf\xf6o\xecG\xf3\xca\xf1-\xf56\xf9D\xfb:\xfd\t\x00/\xfc\x02\x02e\xf4\x98\xfeY\xea\xfc\xf5k\xe2\xbd\xed\x8b\xdf8\xe8\x84\xe4\x8f\xe8\xb0\xee\xf8\xf0\v\xfb\xac\xfc\xa7\x03\xd2\x06\xc5\x02\v\n\x14\xfcN\x05w\xf3\xde\xfc\xc1\xee\xd2\xf5t\xf0\\\xf4\x18\xf5H\xf8\xbf\xfa\x8e\xfd0\xff\xa5\x024\xff%\x06*\xf8I\x02#\xecf\xf6\xb6\xe2g\xea\xb9\xe0\xf1\xe4\xd3\xe5\x00\xe7Z\xeb\xdc\xea\xac\xed\xf6\xec\u007f\xeb,\xec\x06\xe4\xb7\xe8\xd7ل\xe3\x1b\xd0\b\xdda\xcd?\xdaZ\xd5\xcc\xe0\xce\xe27\xee\x84\xefH\xfb\x88\xf6\x01\x00$\xf7\xed\xfd\xe8\xf3\xda\xf8\xc2\xf0\xdd\xf2K\xefn\xef\xfd\xee\xe3\xed$\xf0\xd7\xed\x1f\xf1\xeb\xee\u007f\xef\xc1\xee\u007f\xeb_\xebI\xe8\xa2\xe6\x81\xea\xab\xe7*\xf2\xe0\xee\xea\xfb\xa1\xf7\xc1\x05\xbb\x00\xaf\f{\x06\x0f\x0e\xaa\x05\xfc\b\xd1\xff\xba\x00\xde\xf7H\xfd:\xf3\xd5\x01\xff\xf5\xdd\v\xdb\xff\xf9\x17\xeb\f\b!c\x18.#\xfd\x1d4\x1f,\x1ce\x1a\xa3\x15i\x19\xdb\x11\x1c\x1b\x94\x13\xd9 k\x183)}\x1ev//$\x1d0\xc8%\xcd)z!\xb8\x1e\xe3\x18r\x13[\x0f\x18\r\xfa\b\xf8\r\xc6\t\x89\x12\x86\x10\xb9\x17{\x15G\x19\xf7\x14\xc8\x14\xe4\x10)\x0eE\v\b\bP\x05\x81\x03\xe0\xff.\x05\xfd\xfe2\v\x86\x04\xcb\x10\x8b\f\xf1\x10\x97\x11>\v\xb4\x0f \x05x\nW\x00\x90\au\xfe\x1f\a5\x01x\bi\a\xc3\nu\rw\f*\x0f1\v\x03\r\xf0\x06f\a\x02\x01\xa8\xff\xbb\xf9S\xfb\x8b\xf5\xb9\xfa\x13\xf8\xdb\xfe2\xfe@\x06t\x04\x86\f\xe8\t\xa4\x11\xc7\x0e]\x15\x8a\x11D\x18\x8d\x13*\x19\xc0\x14G\x19\xe5\x15\xb7\x16\x95\x16\x1c\x0fi\x12\f\x03\x87\b\xda\xf6\xa5\xfc}\xef\xaa\xf3\t\xee\xc5\xf09\xf1\x9b\xf3\x8a\xf9\x8c\xfb\xee\x02S\x06W\a\xe2\r\x9d\x04k\f\xb2\xfa\x81\x02\x15\xed3\xf4\x97\xe1p\xe5=ۭ\xdc\xc1\xd8\xee\xdad\xda>\xdc\xfb\xde\"\xe2,\xe2d\xe9\x10\xe3\x99\xec\x9b\xe1\x1d\xec\x04\xe2\xef\xebg\xe7D\xefO\xf0\"\xf6\f\xf9\xb8\xfd\xb7\xfe\x9e\x03\xc3\x00\xdc\x06\xec\xff\x19\a\xd4\xfc\xda\x03\xa9\xf8\xee\xfe\xa9\xf6\x9c\xfby\xf8|\xfc\xb6\xfa*\x00\xc6\xfb\xdb\x02\xad\xfb@\x030\xf9t\x01\x82\xf5\xad\xfcv\xf2\xf5\xf6[\xef&\xf26\xed\x12\xee\xdf\xec8\xec\x14\xec\xef\xecq\xe8t\xebM\xe2\x0e\xe8\xa7\xdb\xcb\xe4\xffض\xe2\xbcڄ\xe3\xb5\xdd\xfc\xe5\"\xe2\x16\xe9>\xe8r\xed4\xefU\xf1d\xf4\xbd\xf3\x9e\xf4t\xf3\xf2\xf1R\xf0\xc6\xed(\xecg\xe8\x89\xe8\x8c\xe3Y\xe5\x9a\xe2 \xe4\x9b\xe7\xa7\xe7\xa1\xf0S\xee\x8d\xfa\x97\xf5\xda\x01\x88\xfc\x1f\x04\f\x00\xe6\x01F\xffG\xff_\xfcM\xfeN\xfaO\xfdU\xf9p\xfd\x96\xf8<\x00o\xf8(\x04\xf5\xfa\xe2\x03#\xfd\x9b\x00\xcb\xfc\x9b\xff\x13\xfe\x8c\x06\xec\x03A\x16\xc0\x0f\xe7&\x87\x1eg22)Y7\xec,\xe64\xe9)\xab,\x91!\x1b\"\xc3\x16\xc4\x1aD\x0e;\x1ag\f\xcf\x1f@\x11\x1a(\xe5\x19\x81-\x8d\"\xae*c$*#\x10\x1f\x18\x1c\a\x17\x9e\x18\xdd\x10k\x18\x93\x0e\xda\x18Q\x0e\xe8\x1a\x9e\x10\x96\x19n\x13X\x11j\x10e\b\xff\n\xdb\xffg\x05P\xfd\xd5\x00\x89\x01\xb0\x02\xb4\b#\vr\x12\xd5\x15\t\x18\xfe\x1d\xf5\x18!!m\x17e\x1f6\x13\x17\x19\t\x12\xcd\x11b\x13\xa7\rK\x15\xb6\rg\x15i\x0f\xa2\x12p\x10\xf6\x0e\xd8\x0f\xd3\f&\x0e^\x0f~\x10\x84\x14\x9e\x15\xd1\x1a\xc9\x19\xf8\"H\x1f\xf3'\xab%\xa6$+%!\x18\f\x1a\xf9\x06P\tm\xf8\a\xf9A\xef\xcd\xee\xc1\xe9/\xeb\xc4\xe7g\xeb\xca\xe8\xdb\xee\x13\xea;\xf3\xe2\xe9\xfb\xf5\x1c\xe9\xbd\xf5;\xe7k\xf1?\xe6\x9b\xed\x83\xe5\xf0\xea\x96\xe4\xd6\xe6\x9f\xe0{\xe1'\xd7\n\xd9?\xcd\x05\xcfEƥǵ\xc4\xcc\xc6\b\xcbj\xce\xee\xd6\xe6\xdc\xe1\xe5\xcf\xeeU\xf3+\xfdC\xfd\xe5\x05K\x02G\n\xed\x01W\bO\xff\x85\x02\xb8\xfc\x14\xfdz\xfb\x94\xfb-\xfb\xc5\xfc\xa7\xf9`\xfc\xf6\xf7\xe9\xfa2\xf5b\xf9\x12\xf3@\xf8a\xf3V\xf8\x00\xf4\xee\xf98\xf3Q\xfa\xdd\xef\xc1\xf8\b\xeb\xe0\xf5\xd5\xe5z\xf0\x8e\xdex\xe8@ִ\xdf1ѭ٠\xd0\x17\xd9Mҋ\xdb\\\xd2=\xdeF\xd0|\xdey\xceL\xdc\x02\xce1\xdb\xcd\xcf\x13\xdc\x0f\xd4\xf4\xdeS\xdck\xe4j\xe7\x04\xec\x90\xf0\xac\xf3\xc9\xf4)\xf6\xba\xf4\xe2\xf3\x06\xf3M\xef\x03\xf3L\xed\xe5\xf5\x15\xf0\x15\xfd\x17\xf6e\x05\x14\xfe\x81\vn\x05\x95\x0fA\n\xff\x11\xbf\vl\x12\x10\n`\x10p\au\x0f\x0e\x05\xa6\x12\x8b\x04\x10\x18v\x06C\x1b\x82\bX\x18q\a\xf0\x11\xfd\x02$\f\x82\xfe\xdf\n\x8e\xfe\\\x0e\x18\x04,\x16\x93\r\xc6\"\x99\x19u/\x8c$\xcf6N+\xa26;,\x980\xce&B*\xfc\x1e\xe6&>\x1aW(R\x1bJ/\xc5 ,72(`;\xec.\xc79\xe40\x123\xac-E+9)\xc7&\xe9$Y(\x18#,,\x1f$P/N%\x1e.\x89#`%\x1f\x1d\xb4\x18\t\x14\xc9\v\xa6\nf\x03\x1e\x04\xd6\x04\xa3\x04\x98\v\xab\n\xef\x13\a\x12:\x183\x18G\x13\x05\x18Z\n\x9e\x10\xce\x02\xa3\b\x03\x00\x91\x04P\x03\xc2\x03\xad\v\x89\x06\xd4\x14\x98\r]\x1a\xda\x13@\x1a\x1d\x17C\x13\xe6\x15)\vd\x12\xda\b\x97\x10\x92\vR\x12+\x0f\xe9\x14%\x10\x15\x145\n\xc1\x0ej\xff\xc9\x04\xe9\xf2\x8a\xf8\xe8\xe6\x1a\xed)\xe2Z\xe7\xb3\xe51\xe9&\xedv\xeem\xf3\x96\xf4\xbf\xf1\xc4\xf7U\xe8\xb7\xf23\xdb\xfb\xe7\xdd\xceT\xdd\xc3\xc8\xc4\xd4\x13˹\xd1\xe8\xd0g\xd3\xc2\xd5y\xd6\xea\xd83\xd9\xdbئڏ\xd5\xf1ڇ\xd5\xc6\xdc,\xda?\xe28\xe2\xd9\xea\xee\xea\xcc\xf3\xc6\xef\xc4\xf9\xb0\xef\x8c\xfb\xd7\xebw\xf9\xca\xe8\xaa\xf5\xf9\xe9@\xf3\xac\xef\x11\xf5\xfa\xf7\x02\xfaE\xff\xb8\xfe<\x01\xf6\x01\xaa\xfb\xcf\xff\xee\xf1\xa5\xf9\xd8\xe8\x15\xf4U\xe3\xcc\xef\xc7\xe3\xcb\xee\xce\xe8&\xf28\xed\xc8\xf5\x82\xed\xb0\xf5\xcf\xe7\xcb\xf0\x18\xdf\xfb\xe8\xfaױ\xe04\xd6\x15\xdct\xdb\xcd\xdd\xcd\xe3\x9c\xe3\x0e\xe9\x05\xe9\xec\xe7^\xe9e\xe17\xe5W\xd9\xda\xdf.\xd4(\xdbW\xd5\r\xdba\xde\x14\xe1R\xec=\xecP\xf8\x06\xf7.\xfd\x1a\xfc\xf6\xfa6\xfa4\xf7\x9e\xf4\x14\xf6\xed\xf0Q\xfa\xcc\xf2\xb4\x04O\xfa\x03\x13f\x069 U\x13-&\xdc\x1b\xce\"\f\x1c\xfb\x1a\x16\x16\xf8\x15\xc6\x0f\xee\x16\x11\fg\x1d\xb7\f(&&\x12\xfc-y\x18\xc14\xcb\x1dO8.\"t74$92I\"\xd5*\x18\x1eV&_\x1aG&\x83\x19\x93'\x8e\x1b^(=\x1d\xdf(1\x1e/*o\x1e\x9c*B\x1e\xf7(\x81\x1d\xb7&\x05\x1b\xe4#\xad\x18\r\"T\x18\xcc \x17\x1a\xc4\x1e\xb2\x1b\xd7\x1c\x92\x1c\xa6\x18\xe4\x1b>\x13^\x189\x0f5\x14M\f\xea\x10\xdd\fK\rw\x10\xa6\r\xa6\x11\xe3\x0f\xb2\x11\xdd\x0fE\x0f$\x0f\xc8\t\b\rb\x03@\bk\xfc\xc5\x01A\xf89\xfdT\xfac\xfd\v\x016\x01\xf6\a\x9b\x06p\f\xd5\v5\x113\x10F\x16\xb0\x13\xf4\x19\xf5\x15K\x1c\xbb\x16\xef\x1a\x8f\x15y\x17%\x13\x96\x13\x9d\x11{\x0f\xa4\x10;\f\r\x0f\a\a+\v\x1c\x016\x05\xf8\xfb\x87\xfe\xd8\xf3\xad\xf7\xff\xe6>\xef)\xd9\xfa\xe4\xf9\xceW\xdd\xce\xcb%\xda\xd6\xceS\xdaA\xd4k\xdco״\xdd\x1e\xd6\xd8\xdb\xfd\xd0\xf3\xd7>ȫ\xd3\x10\xc3P\xd0HƪҰ\xd0$\xdb\xf9\xe0d\xe72\xf0[\xf4\xd9\xf8\"\xfe}\xfaP\x02\xae\xf5W\x02<\xf1\x1b\x00\xd2\xf3\x92\x01\xa5\xfc\xb0\b\xc0\t\x97\x10\xd0\x13\x8f\x16\x86\x14\xfc\x16,\r\xcb\x0f\xbf\xfe#\x03\x04\xef\xb1\xf4\xcc\xe44\xea\xfe\xe2\x89\xe7\xf3\xe8\x84\xebj\xef+\xf1z\xf0\x14\xf3\a\xe9\xea\xed\x9c\xdaf\xe3c\xccN\xd7R\xc3\xc6\xce\xe1\xc3]\xcd\xcf\xcd\x0e\xd5*ۨ\xe1\xf2\xe6\xd9\xeb\x9c\xeb\x06\xf0Z\xe8\x82\xed\x92\xe0\x96\xe5\xcc\xd9\x01ޥ\xda\"\xdcc\xe3\xfa\xe1y\xec\t\xebm\xf2Z\xf15\xf5\x15\xf4\xe8\xf5m\xf3\a\xf6\x1f\xf2G\xf8\xbe\xf2\x14\x00}\xf7;\f!\x01\b\x18\xb0\vS\x1cK\x11\xe2\x17[\x0e\xc4\x0f#\a\x11\a{\xff\x92\x03\xe1\xfa\xf8\a%\xfeq\x12\xe4\b\r \xc7\x15|+\xc7 U2k&\xe83;%\x11/\xad\x1f\xca&}\x17\x1b!\xf9\x10b \xfd\x0f\xcf\"\x8f\x142%\xd7\x1a\xe8%\x90\x1d\xe0%n\x1d\"%\xdd\x1cH#~\x1b\x8c\x1fJ\x186\x1d\xcf\x15A\x1d\xf5\x15w \xfd\x17*&j\x1c1'\x1a\x1f\x9a#\xc3\x1b\xcd\x1e\x8b\x17\x01\x18\xe9\x15\x8f\x13\x89\x14l\x13\xa7\x14\x87\x15\xa1\x17\x80\x1a5\x1b\xf0\x1e\x87\x1fL\x1e\xe6 ;\x19\xe9\x1bz\x11:\x15;\n7\x0fM\x05\xb6\t\x97\x02\xb3\x04\xb1\x01\xf2\x00\xe5\xff\xc0\xfe\xda\xfa\x90\xfbA\xf3w\xf6\xcd\xec\xec\xf1\x96\xec\xb2\xf0g\xf2\xbb\xf3\xc3\xfa\x98\xf9\x0f\x03>\xff\x15\n\x8f\x04\xea\r7\t\xd7\v\f\v>\x05\\\bK\x00\f\x05\xf5\x00-\x06T\x05\x81\n\xda\b\xdc\r\xec\x06\xf8\r\xb3\x00!\n\xe0\xfa\x96\x05\x12\xf6\x11\x01\xc5\xf0M\xfb\x15\xec0\xf5\xfa\xea\x8f\xf1\xd5\xe9\xe8\xee\x95\xe5\xb7\xe9\xfb\xde\u007f\xe2N\xd6\x13\xdcm\xcf\u007f\xd6\xc5\xcd\x0e\xd3\xca\xce7\xd4\xd3\xcf`ք\xd0_\xd8\xee\xd1\xe6ڣԒ\xdd7\xda\x16\xe27\xe3\b\xe9\x8d\xed\x06\xf2\xb9\xf7#\xfb\xfe\xfeu\x02h\xff\x17\x06\xea\xfa[\x04\xec\xf5Y\x00\xe2\xf4\xd6\xfee\xf9\xea\x00\xd5\x01\x90\x058\v\xf9\n\x94\x10\x94\x0eT\ru\f\xa6\x02{\x03\x04\xf4\xd0\xf7\x0f\xe7K\xedx\xe0p\xe7\x99\xe0\x81\xe7W\xe5\xf4\xea\x13\xebu\xef\xa4\xed\xed\xf1b\xea\x84\xee\xb0\xe1\x8d\xe6\xcc\xd9\xe0ߵؾ\xde\x19\xdf}\xe3\x02\xe9\x94\xeb\xe9\xf01\xf3\xbe\xf2\x8b\xf5\x8a\xec \xf0J\xe0w\xe4d\xd3\u007fظ\xcc\xdc\xd1\xc4\xd1oհ\xe0\x87\xe1\xa3\xf2\x18\xf0\xf9\xfe{\xfbz\x02i\xff\xe5\xff\xda\xfcY\xf8|\xf5\xc1\xef\xb0\xec(\xedy\xe8\xe6\xf2\xa3\xeb+\xfct\xf3\xa9\x03\xb4\xf9#\x06\x16\xfd\xa5\x03\x83\xfep\x04\x15\x01\xba\vk\b\xcc\x17\x89\x12\xcb(x\x1e\x938-+\x16A\x8e1\bC\xb3//>\xdd)\x116X\"\x01/5\x1dR*/\x1b\x99+\xe8\x1cR.\xa9 e.k\"\x8c+\xf5 \xef%2\x1c\x9a \xb4\x17\xde\x1fZ\x15\xb2&\xf5\x17\xba+\xa3\x1b\xe7*&\x1a\xc2'\x82\x17\xa1\x1e\v\x14W\x12\x95\f\x95\a\xc2\x05l\xff\x03\x05\xb1\x00B\tQ\fF\x13\xab\x18\x1f ^!V'\a&\x9f*F&\x06,\x9b&2*3'\x8e)l%\xea(\x14%\xd8%\xf5#\xf2\"\xc2 \x84\x1f\" \x90\x1cI\x1ec\x1b\xd9\x1aq\x1a\x80\x1b\x93\x1b\x00 \x14 \xc0$\x1b#\xa6&\v\"y \x06\x1do\x163\x13\x95\f<\nM\x022\x04R\xf9\f\xff6\xf2\xba\xf9\xca\xea\x99\xf4\xd2\xe1W\xed\v\xd90\xe4\a\xd0C\xdb\xe6\xc7\xce\xd3<\xc3o\xd0m\xc4g\xd2x˯\xd8{\xd3\xf7\xdd\xd0\xd7\n\xdfU\xd7\xed\xdb\x17\xd4\x05\xd6kЍ\xd0\f\xcec\xcf%\xce\a\xd2;\xd1\x01\xd8\xf4\xd9\xfe\xe1\xa4\xe5t\xed\xac\xee\u007f\xf5%\xf3\xe6\xf89\xf4\x1d\xfb\xda\xf6\r\xfe\xc4\xfa2\x01\xae\xfc\xd6\x03\xc0\xfbz\x03o\xf9,\x00\x1f\xf59\xfba\xeft\xf3\xc4\xe7;\xeb\xc7\xdf\xfb\xe3\x86\xdb'\xe1c\xdaQ\xe2\x8eں\xe3\xe2\xd9\x0f\xe4'\xd7)\xe2P\xd4Dߒ\xd2}\xdctҩ\xdb7\xd4Nݏ\xd8-\xe0\xc8ܡ\xe2p\xdd\x01\xe3T\xd9x\xe0\nҝ\xdc_\xcd\xe7\xda\x1b\xcf!\xdd*\xd7\xfe\xe4+\xe2\x1a\xef]\xed#\xf6@\xf7\xf8\xf8\xbc\xfb\xe2\xf7\xcc\xf9t\xf4\xff\xf5|\xf1\xe3\xf4?\xf1\xd6\xf8\x89\xf4\x02\x00\x06\xfc\xf1\x05n\x03\xd0\tw\x05\x95\ve\x03\x8b\v;\x00\xb0\f7\x00\x93\x0e]\x03\xf6\x13\xc5\x06@ 3\rB.\xce\x1788C \xeb<m#\xa5=\x18$>>\xe5#\u007f>\b'\xac:\xca)\xe82\xa5%\xcc+\x9a\x1e\xab+\x89\x1b 1\xf4\x1fd8\xb7(\xb8=\xe3/\x83@\xca3\xd2C\x1e8BC\xb59\f;$3d/\x99&\xb9%P\x1b`\"\x86\x17\xb5#\x9d\x1bv\"\xc1\x1f\u007f\x1e\xa1\x1e\xd7\x1bk\x1ct\x19+\x1by\x18{\x19\xf3\x19^\x17\xc6\x1aa\x17\xea\x1dI\x1b\xdb\"[\"\x88\"\xda%c\x1c\xfa\x1f\xd4\x13\x17\x15;\v\x8e\v=\x04\\\x04\xca\x01Q\x00\x13\x01~\x00\x86\x02\x86\x03\xfb\x05h\t\f\t:\r\xa8\x0e\xb7\f\xba\x14\xc5\va\x16.\f\x0e\x14\xe3\v+\x0e\xd1\tq\x06\x99\x06\xbe\xffr\x031\xf7J\x01\x87\xee\x9b\xfc*\xeb$\xf6\xf2\xea\xa3\xf2\x05\xe8\x18\xf2\xab\xe04\xf0\x83\xd5\t\xea\xfa\xcaR\xe0\xa8\xc5\xc7\u05f5ĠҁƃК\xcb'ь\xd1\xd2\xd40\xd5|\xda\f\xd4\xed\xdej\xce\nނ\xca\xc2\xd8\xd2\xcam\xd4<Р\xd4Kڕ\xda!\xe5~\xe4!\xef\xd8\xefK\xf7\xe8\xf9\x89\xfb\x00\x01\x85\xfc\xda\x03\f\xfbF\x01^\xf7\xeb\xfb\x99\xf3Q\xf8\t\xf2{\xf8(\xf2T\xfb|\xf2O\xfd\xcc\xf2^\xfdn\xf1\xec\xfa\f\xef\xb3\xf6\xd7\xea\x9b\xf0\xea\xe4Y\xe8\x1e\xe0}\xe2\xec\xdb\xef\xe0\xf9ٜ\xe2\xf4ܘ\xe6\xdd\xe1z\xea+\xe3\xe4\xec\x9d\xe1%\xed\x0f\xe1\a\xec>\xe10\xebr\xe3\xd7\xea\xc7\xe5\\\xeb&\xe7U\xec\a\xea\xe6\xecS\xecw\xec:\xebU\xecY\xe7d\xeb\xd0\xe4\x06\xe9\x8e\xe6\xb3\xe8\xc4\xea\b\xea%\xeeq\xeb[\xf0\xb9\xec\xb9\xf4\xc3\xee\x00\xf9\x10\xf2\x80\xf9n\xf4i\xf9\x1d\xf5+\xfcx\xf7\xf3\x00\xf7\xfa\x89\x04I\xfch\x05\xbc\xfbr\a\xc2\xfb\xb6\x0e\x8e\xff\xcc\x17\f\b] \xff\x10j)\x00\x19\xa72\xc3!y:\x03*\xbe=\\-\xd8:\\*|5\xb8#\xfe1M\x1e\xa30\x9a\x1b\x011\xce\x1bC3\x0e\x1f\xd75\xd4$\xda8\xa0*\xb5:\x1d.\xc47\xd9,\t1P&\xe5*\x04 h(\xa9\x1c\"'\x98\x19j$&\x18:!E\x19A\x1fv\x18\x01\x1bn\x143\x13\xdb\r\x96\v\xff\b2\t\x1e\n\t\r\xf3\x0e\xb0\x12m\x14*\x17\xe1\x19\xe9\x18\x8f\x1f\xa8\x19\x93\"$\x18\x8c\x1f\x88\x12\xfe\x16\xd1\v\xf5\x0e-\b\x88\f\xd8\n\xcc\r\xa0\x0e\x8f\x0e\x89\x10\xe8\r\x16\x11q\x0e\x82\x12\xba\x11\xaf\x16O\x15\xbe\x18]\x16\xe4\x18^\x164\x1c\x80\x18\x16\"\x8a\x1c\x94$\xb4\x1d)\x1f\x88\x19\xfe\x15\x87\x13{\v\xec\v\xf5\xfe\v\x04\x06\xef\xf1\xf9_\xdd]\xec5\xd2\xec\xe1\xe2\xcd\xc3\xdc.\xcc\x1c\xda1\xcb\xf9\xd7bɥ\xd6\x19\xc7%\xd6RĤ\xd3\xed\xc01\xcf=\xbd\x14\xcb3\xbdR\xc9M\xc3\xe9\xcb\xd4\xcc\x0fҧ\xd5\x0f\xda\xc9ڄ\xe1\x95\xden\xe8\x13\xe5\"\xf0\x85\xed\xbc\xf6\xfb\xf6\x99\xff~\x010\v\xb8\f\xdd\x14\x9b\x13\x92\x19Q\x11P\x17\xf2\x061\x0fE\xf9B\x048\xf0\xa8\xfad\xee\xcd\xf4O\xef\xe8\xf2P\xf0c\xf3\xb4\xee\xd0\xf2\x88\xe8\x12\xed\xf0\xddf\xe1=І\xd5\xc4\xc6\tσ\xc9oσ\xd3Z\xd5*\xda/\xdbX\xd9E\xdd@\xd3\u007f\xdb\n\xcdo\xd6\xdc\xc9\xec\xd2\xfeˆչ\xd55\xdf\x1b\xe5\xb8\xec\xd6\xf2i\xf7\x95\xf6\xb5\xfa\x1e\xef\xf5\xf54\xe4\x88\xeb\xbe\xdf\xc0\xe2\xec\xe5V\xe2\xc1\xf3\x89\xebw\x03g\xf9\x8d\x0f\xc3\x04r\x14\xfe\b0\x11\xdd\x06\xf9\n\x10\x02\xab\b\xe7\xfe\x04\f\x9c\xff\xaa\x15\xbd\x04\x9d\x1eI\f\x00#\xd1\x12H$\xe5\x14\xe3#[\x13a#p\x12\x10$\x1b\x13\x9f'\xab\x15\xe5,\xd7\x1940\x93\x1d\xa0.r\x1f\x8f'o\x1cU!\x1c\x17v \xe8\x14\x82#u\x16n(\xe3\x1aC*\xd6\x1e\xb3(\x8b\x1f\xf8%I\x1f~\"\xea\x1ev T\x1e\x82 \xf1\x1d\x15$\xaa \xe7)\x84%\x15.\xdf)$,\n+\xd8%\xef%\xb3\x1f\x9f\x1e$\x1aa\x1a\u007f\x17\xef\x17_\x17\xcd\x16\x8e\x16\xba\x16C\x16\xbe\x16\xbe\x13\xf5\x15&\x0e\x95\x11\xf8\a\xad\t\x82\x02\x9f\x02\xf8\xfd\b\xfe\xc9\xf9k\xfbw\xf6J\xfa\b\xf5;\xf9\xe5\xf32\xf8\xc1\xf1\xa8\xf6\f\xf1\xd1\xf3\xde\xf4g\xf2\xd7\xfa\x9f\xf4\xd6\xffi\xf8!\x01\x1f\xfb\xec\x02X\xfe\x1e\b\xf7\x03f\x0e\xea\n-\x13\xb7\x11d\x17\t\x18U\x1c\xa3\x1d\xa6\x1d\xc2 \x8c\x17\xff\x1e\x98\v\xb9\x16t\xfe\xde\n/\xf6\v\x019\xf2\x84\xfb\xa3\xf1z\xfa\f\xf3l\xfc)\xf2\xa0\xfd_\xeco\xf9M\xe1\xaa\xee\xbaӫ\xe0\xa0\xca\xcd\xd4Qɝ\xcf\b\xcf'\xd29\xd7\xd3\xd9\xe4\xdda\xe2\xaa\xde\xe5\xe5\x11\xdb\xce\xe2\x83\xd7%\xdd\xd0ؖ\xdb\xc5\xe2F\xe3E\xf1\x12\xf1%\xffQ\x00\xab\a\x97\r\xef\aU\x13\x86\x03\xbe\x10\x1b\xfe\x01\t\x0f\xfe\xaf\x02C\x03*\x02.\t\x8f\x06\x14\f\xe1\nS\a\xd1\b\xf6\xfc\xa6\x000\xf0\x88\xf5\b\xe7j\xec \xe7\x9e\xe9\xaa\xec\\\xeb\x03\xf0\xdb\xee\x84\xeb\x87\xee\x18\xe0/\xe85\xd2W\xdd\xf3\xc6\xe6\xd1|Ã\xcc3\xc9\f\xd0\xc1ս\xdau\xe1?\xe7\"\xe6\xfa\xeeX\xe4`\xef*\xdfW\xea\xcf\xdc\x1c\xe4i\xde\xeaߊ\xe2r\xe0\xe4\xe7v\xe5\xc7\xeaj\xea\xbe\xe8W\xec\xd8\xe3[\xea\xf6\xe2\v\xe8T\xea\x88\xea\x98\xf6.\xf12\x01\xd1\xf8\xa2\x04/\xfdk\x01\x81\xfc$\xfb\xd8\xf8\xb4\xf3\x13\xf3A\xef3\xed\x14\xf2\xb8\xec\x91\xfc\xa0\xf3~\b\x88\xfd\xb7\x0fn\x05O\x10\x8a\tG\x0e\x95\tJ\x10\x90\n\xdb\x18\xb0\x0e\x12'\xc3\x16\xd05\xda!\x1f@\x95,\xa9C\xff0\xc9?\x1a.\xdf5\xbf&\x18-=\x1f,+\xcc\x1c .\xa2\x1eC3\xf3!\xc96\xdf'\x1e60,\xe61F)\xc0(\xc8\x1e\xa1\x1f\xd1\x12\xae\x1aN\r\x81\x1b?\x0f4\x1d\xb6\x12\x03\x1b\xc6\x12\x01\x18\x86\x149\x14Z\x17\xf8\x12\x0f\x17\x01\x141\x14\x17\x163\x12\xd5\x1a^\x16@\x1e\xbb\x1eo!\xf4$?!^&~\x1d@&t\x1b\xd1%%\x1cu$\xa8 \xf3\"/%\xd4!\xb7'\xdc!\t)\xf7\"\xa8(-#\xc6&\xbd \v\"X\x1d\x14\x1d\xc6\x1a\x9b\x1c^\x1a\xcb\x1f6\x1d{#i!\xf5$\x82$\x8b#/%Z\x1e\xf5 \xcc\x13\x12\x16 \x04\xfb\x061\xf1\xa0\xf6-\xe0{\xe8\x8fԅމϼك\xceG\xda6\xcc\xd0\xdb\xfc\xc6l\xd7ž\xa2\xcd´\xa7\xc2o\xae\x9f\xbb:\xaf\x12\xbb\xe0\xb9\xe2\xc0Xʶ̠\xd8\x0e\xdar\xe1N\xe3\xe8\xe2\xdf\xe5\xa9\xde*\xe3Oܮ\xe2\xab\xe2\xb2\xebe\xf3\x05\xfe\x11\a\x91\x11c\x15\xd0\x1f$\x18C$4\x0f:\x1d!\x00j\x0e\xd4\xf1m\xfc.\xeb@\xef\xff\xeb\xbd\xec\x90\xefH\xf0\xab\xef\xee\xf1o\xe5u\xea\x16\xd2\xd5\xda\x04\xbd\x1fʯ\xb1I\xbf;\xb4\xa9\xbdi\xbe\xd6ò\xc77\xcd\x1b\xc9m\xd2>´\xcf\x1e\xb8\xd3\xc7j\xb21\xc1\x95\xb9\xfeé\xcc\xd7\xd1G\xe4\x9d\xe6\x90\xf4)\xf91\xf6\x8c\xff\x1a\xec\xb9\xf9I\xde\x13\xed\xafִ\xe1\xea\xdb\xcb\xdf\xd3\xefz\xeak\t\xfa\xfdZ\x1a\xbe\x0e\xc5\x1d\xf5\x15m\x17\xa4\x12\xef\r\xb9\aa\x05\x9c\xfbC\x01\xd8\xf3\x94\x04\xe9\xf4\x01\f\xe6\xfc9\x11\x05\x05'\x10F\b\x1d\f\u007f\x06\x86\fS\x04V\x13\x9d\x04\x99\x1e\xc3\b\xc9(\x9a\x10\x1e1y\x1a\x028\xd5#\xf586(a6\x19&\x984\x9a#\xe63^#M5\x0f$ 8\xd7'\x88;\x92/\x02?]7\xc3?\x85;\x00<\xcd8\x1d9\x9c2\v9//\x03:\x80/\xa29\xb1/\x907\xed-\xa93Y*\x00/\xc1%v'\xa1\x1f~\x1b\x96\x15G\x11W\r\xf9\r\x85\r\xc7\x11\x1d\x14\xf6\x17b\x1b\x99\x1a\x80\x1d\f\x19N\x19#\x12\xb5\x10z\x05j\x05\xca\xf7\xc9\xf9k\xef\xe0\xf2\xa1\xef.\xf5\x98\xf4\x1c\xfdH\xfa\x97\x01U\xfe+\x00f\xfd#\xfcB\xf8\xa5\xf7+\xf3\x12\xf3\x93\xf5p\xf2H\x00\x80\xf9\x86\f\x8a\x06>\x16\xb7\x12m\x1c\x96\x19i &\x1d\\ 0 )\x1c@!\xa7\x17\x0e\x1f\xba\x13^\x1a\x1c\x0f\x85\x14r\x05\xc9\f\xb3\xf7,\x02\xc8\xeb\x8d\xf6\x16\xe2\xd5\xee\xb1\xd9)\xea\xd6Ӏ\xe44ϗ܋\xc8L\xd2\x13\xbf\"ő\xb6\uee7e\xb1V\xb5ǳ\t\xb8ֺ\xe9\xbf[\xc1wȏ\xc7\x1cϥ\xceH\xd4\xf6֣ف\xe1\xbd\xe1\x81\xee\xae\xeev\xff\xb7\x00\x1c\x0e^\x13\xec\x14\xfd\x1fX\x13h#\xee\x0e\x81 \xc4\r\xcd\x1b]\x0e3\x17I\x0e\xbc\x13\xcb\n\xd4\x0f\b\x04l\tU\xfa\xc5\x01\xd8\xed\xff\xf7\xe5\xe3y\xee:\xe0f\xe8/\xe2\x87\xe7\t\xe3\xf1\xe7\xdf\xdd\xd1\xe4\x84\xd3\xf9\xdc\xf6\xc4<\xd2\xf8\xb8?\xc8\xf5\xb5I\xc3+\xbb\xb8\xc5\xcd\xc6\xd4\xce\xe4\xd2=\xda\t\xdb,\xe4\xb6\xdc\xcc\xe8\x8dڹ\xe7d\xd9\xdb\xe4\xc2\xdcd\xe3\x9a\xe4\xff\xe4\xaa\xec\xff\xe8\xd6\xf1\x9f\xed\xfb\xf2\xa6\xf0\xfd\xf1I\xf1f\xf2K\xf1\xcf\xf5\xa8\xf2\xa9\xfd]\xf6\xfd\x06+\xfby\fb\xfe\x99\vm\xfeG\x06\xd0\xfb9\x02\xcc\xf9$\x02\xd0\xf9B\x04N\xfa\xb2\x06\xcd\xfa8\v\xd5\xfc\xaa\x10\xa2\x00\x8c\x11O\x02\xd0\f\xd5\x01\x98\x06\xae\xff\xe5\x06\x11\x00Q\x0f\xb4\x05\xe7\x1c\x15\x0f\x17+1\x18\xc56] 1?''\xe3A\xa5*\x0f>:+t8\xe9)\xaf5\xd7((8S*6>[/\xc1EL5 IO8\x9bF\x025\xf9A\xa4/|:*+\xe32f&\xa8-Y!S+\xe8\x1e.)\xbf\x1f\x1c%\xff E!# \xdb\x19\xc5\x1a6\f]\x12G\xfe8\bd\xf6I\x004\xf4Z\xfd\xa0\xf4\x8d\xfdm\xf7\xbd\x00M\xfb\x81\x06\x8f\x00\x1c\f\xeb\x06j\x10/\fA\x12Y\x10 \x12n\x13.\x11\x8b\x17\x8a\x13 \x1e\xd2\x18)$\x17\x1fU'6$Y)X)\xb5,\xca-E2\n1F7\xde3K9\xfe5\v8\x8e5\xb53\x1d22)\x04*\xe7\x15\xed\x19\xaa\xfcx\x03\a\xe5}\xeexԱ\xdf\xf2\xcc\xe6֟\xc9\xf7\xd2\xfe\xc3I\xcf9\xb8\xfb\xc6\\\xaaJ\xb9\x10\xa0\x83\xac6\x9dB\xa7\x9f\xa4\xbc\xac\x17\xb7W\xbb~\xce\xc0Ϧ\xe0\x1b\xe2\x1a\xe6\xfa\xea\x9d\xe0\xbf\xe9Z\xd9\xcb\xe5.\xdc\xce\xe9\xff\xec\xfc\xfa\xe8\x04\x9f\x131\x1a\xc5)\xfa#E6q I6\xb9\x13\x97)\xdd\x05*\x16\f\xffQ\x06\xd9\x00\xb0\xff!\x06&\x01Z\x05\xce\x00I\xf8<\xf8\xdb\xe0m\xe6\x14ǿ\xd1\xfd\xb4\xb1\xc1P\xb0'\xbad\xb6P\xbaW\xbb[\xbd\x8c\xb5\x8e\xbc?\xa7\r\xb5\xf1\x96٨ˋL\x9e\xef\x8aH\x9b\xfc\x98y\xa4Z\xb1¸\xb0\xc6\x05\xcec\xceA\xd8\xf7\xc9\n֢Ŕ\xd0!\xcc!\xd1\xf1\xde\xfa\xda\xc5\xf7j\xebT\x0f\x89\xfeS \x10\x11v'S\x1c\xd7&\x13\x1d3$\x87\x18\xfd%\xb9\x15\xec+\xa1\x17\x171\xb7\x1bS2\xc9\x1eQ-#\x1f4\"v\x1a\xf9\x15\xce\x0f\xde\r9\x04{\x0e\xd7\xfe\xb9\x14[\x00\xe8\x19\x0f\x049\x1a\x85\x06j\x16\xca\a\x9c\x13\xbb\t\x14\x15v\f[\x1b.\x0fP%\xee\x14\xec23!\xc2?\xbc0xE\xeb:\bD\x86=YA{=~B\xbd?`HVC\xefP=GSXmK\x88Z\x01P\x17U\xc4P\xb2J\u007fI\xbc>\x10;\xfe1\a,n&\x01!\xb8\x1d\xd6\x1a?\x17\xee\x16>\x11D\x13\x9e\bL\x0e\x91\xfc\x1b\x05\xe8\xeeH\xf6\xbd\xe4\xf3\xe7\xba\xde`\xdf\xd4\xdb\xeb\xdcj\xdd!\xe1\xf7\xe1L\xe9\xd0\xe7Q\xf1\x05\xee\x00\xf6\xac\xf5\x95\xf8\u007f\xff\xae\xfb\xe5\t%\x00\xe5\x16\xf0\b,$j\x15G/\b\"\xf66 +\x8c<]09BO5\u007fG\x9f:\x8fKL@SK\xd5C\xb7CrB{4i:\xf2\x1ep*\x98\a\xda\x14\x97\xf1\xe2\xfd\xe4\xdf6\xeb#\xd4\x13\xe0\xa2\xcc\x06\xd9\xff\xc6U\xd2\xfe\xbc\x12\xcao\xaf>\xbeץ8\xb3\xe0\xa5\xe5\xae\xea\xaeG\xb3l\xbcy\xbe\x02\xcbLϣ\xd8L\xe1P\xe4\xbf\xee\xda\xeb\x0e\xf5\r\xf1\x9e\xf8_\xfa<\x00\xf2\tX\r\xd1\x19_\x1c\xe3!\x9f(E |.=\x1a\xa1.\xb9\x13\b)\x95\x0ea\x1f\x9a\t\xcf\x14\xb2\x04\xd2\v \xfe-\x03*\xf2\x16\xf7\x80\xde\xcb\xe5\x14ǘ\xd2\x11\xb5\x14¾\xab?\xb7\xa7\xa6\v\xb2[\xa1\xb9\xae\x83\x9a\x16\xac\r\x91\x1e\xa8\xf3\x85G\xa1\x01\x80Ți\x81\x8c\x99+\x8fݠ\xa8\xa3\x84\xafZ\xb7\xb7\xbf\x12Ţ\xcc\x0f\xccp\xd4\xfa\xce\xe0\xd7u\xd0\xe9\u05ce\xd5\x16؍\xe2\xd2\xdd`\xf4#\xeb_\x04\xb2\xfaD\x0ee\x059\x14\xb6\v\xc4\x18e\x10I\x1b\xce\x12\x0f\x1b\xec\x10F\x18u\f\xcb\x10\x88\x05\x1e\x04\x8f\xfc\xcd\xf5\x84\xf2\xee\xea\xb3\xe9\xb7\xe6\x98\xe4E\xe8\x91\xe4\x87\xed\xd4\xe6\xde\xf5\x95\xea\x82\xfc`\xef\xa9\xff\x99\xf2^\x03\xe5\xf5\xc6\n&\xfc\xd0\x17\xbd\x06i'Y\x14\xc55\x92 \x90B\xc3*\x9cK?4\x9aR\xfd;GY\x14C\xc1`\xbfKlk\xaaVfx_b\xfb\u007f\vk\xff\u007f\xc5l,}\xe8g\x00u\xb6_\xc3gOT\xb2V\xfdE%H\x179\xbb?\x840d7\x97(\t*m\x1e\x87\x1b\x85\x14L\x12K\x0fY\x0f;\x10\xec\x0fg\x12\t\x0f\u007f\x12\xc7\v\xb8\x10\xa1\t\xd4\r\xb7\b\xdb\v\xf2\x05\x9e\t\xb7\x03\xb4\ar\b\xb4\f\x13\x13S\x18d\x1f\xda\"))\xae)9-4,\xc3.\xf0+\x1218,55I0\x1a9\xed5\x98=\xc7:\x8aBi?\x0eC\xb5@\xcf>o:S6\xaa/\b)\x8f%\xc5\x19\x10\x1c\xae\nn\x11\xcf\xfc\x02\x06\xee\xed\x92\xfa\xdcܯ\xec\xc6\xcd\xc8\xdc\xeb\xc2\a\xcf\x16\xbbi\xc4i\xb3\x92\xbcu\xa8\xb8\xb5\v\x9c\x04\xac.\x93*\xa1\x9b\x90L\x9c\r\x92ԝ\xf9\x99ʣ\x89\xa7{\xafͳ\x89\xben\xbc\xd3\xca\x10Õ\xd2*ʊ\xd9\xf9\xd4\xc8\xe2\x85\xe6X\xf0#\xfb\x1e\x03\x91\v\xb2\x15\x96\x13D!\xd4\x10\xfe!\xe4\x06\x96\x1a\xf2\xfcd\x10\x1c\xf8\xc1\x054\xf7\xad\xfe\xe8\xf3F\xfb\x1a\xedx\xf6L\xe2\xcb\xee,\xd1c\xe2h\xbf\xc4\xd15\xb3\xc2\xc3\"\xaeR\xbc\xec\xad\xeb\xb8ګ\x84\xb5\x9d\xa1z\xaf0\x91\xaa\xa6\xa3\x835\x9d\x00\x80V\x98ޅ\xa8\x9aE\x95\x16\xa4o\xa9-\xb3\xaf\xbb\xf6\xc3\x1c\xc8\x10\xd0\xc5н\xd6\xe0ژ\xdd\x1a\xec\xab\xe8\xbd\x02\xc6\xf7{\x17\xa5\x06%$\xbc\x10\xe2'\x04\x16\xc7%p\x18\x99\"\xc3\x19@#\xef\x1a\x03,\x8a\x1e\x9c9g&\xbfC^.XFB1\xb1B\xd0.\x9c;|(j4\x8b k/O\x19X.\x04\x15y.|\x12;*_\x0eH\"z\t\x89\x1a\x85\a\x84\x17\x89\t\x1b\x1c\xd3\x0e*%{\x15\x140\x86\x1b\xb99\xe7\"l?n*\x1cB\xc6/N@\xbc2\xd8<64+=?6\xfc?\xce9\xb4B\xc6<\x18B\xbc<a?\xf3:\xb5=S:\xb8:\xae:^5N7\xc3/\xe1.\xca+\xe8%V(F \xf7 \xe9\x1a\xd7\x15=\x13\xe5\n\x1d\v\x8d\x01\x18\x05\x97\xf8\xf0\xfev\xee\xdf\xf5\u007f\xe2}\xeab\xd86\xe0\x00\xd4O\xdbLՎ\xdcV۪\xe1\x14\xe4~\xe85\xef\x85\xeeS\xfb?\xf4b\x05\xe9\xf9\x9b\rK\x00\x1e\x15\xa6\a4\x1e\x13\x11\x03)\x84\x1c\xa73\xd6'#=\x141\x84C 7DE2:\x01Dh;gAV<\x98:\xd3;)-\xa75#\x1cX(\x96\n(\x16F\xfa\x1c\x04m\xe8u\xf4\xb7\xd4O\xe5I\xc5Kא\xbaA\xcd6\xb1\x1aǍ\xa8\xcd\xc0\xb6\xa4\xfe\xbaj\xaav\xbb7\xb9\xb0\xc4&\xcd\b\xd5x\xe0h\xe7\xa7\xf1\x05\xf8\x1c\xff\b\x05\xc2\az\r-\x0f&\x14\xe1\x18g\x1d\xfd#B*\xf6+\xab4t-^9\x8c(\xfb7\xd6\x1e\x190!\x12\x1f#\xb9\x05P\x13\xc9\xfc\xbd\x05J\xf5\xc1\xfc\x97\xea\xae\xf3O\xda\x15\xe5\xf5\xc4\x19\xd2\x12\xb0;\x04\xa3߯P\x9d\xa2\xa85\x9b\x93\xa72\x9bI\xa9\x9b\x98Z\xab\xea\x90c\xa9\x9a\x86d\xa3\x01\x80\xf6\x9cm\x82\xa4\x9a\xb3\x8eO\xa0ٞ\xa9\xac\x10\xad\xb0\xb9S\xb6\xc8\xc2ҹ\x8d\xc50\xbbc\xc5R\xc0\xb6\xc7,\xccN\xcePܨצ\xe8[\xe0[\xed\xda\xe4\xc8\xed\x06\xe6\xa0\xee\x9f\xe6\n\xf2\xd1\xe7\xeb\xf8\x10\xebR\x05[\xf3\x9c\x10\x10\xff\xc6\x14V\x06<\x13\xa9\x06q\rN\x03\xbf\a#\xff\xc8\x05\x84\xfc\\\bH\xfb\x98\v\x8b\xf9\xb7\f\x81\xf7\xc7\f\x95\xf7x\v\v\xf8S\n\x1e\xf8\xdd\r\xe5\xf9F\x18\xc0\x01\xaf\"\xaf\rc*C\x15\xda54\x1c`A3'\x91G\x101^L'8\xe1S\x80@\x9c[\x06H\xbb`UL\xd3e\xacQ\xd3h\x86V\xa7i\xf0X\xddiRZ\x13i\"\\\xb8d\xd3ZB^>U\xbbXJO'Q\x1eJ\x97G\x80B\xe6>p:b7\xcb4b1]/\xf1,\x81+:&\xfe'\xdd\x1cV \xbf\x12\x0e\x15\x1a\f\xa8\r!\t\xec\r\xcd\tj\x0e\xe2\x0f\x1b\x10Y\x16f\x17\xd6\x1c\x9c\x1f-$>#\xdb(\xaf%\xf4+\xf1(u3{-\xe1>f6[HXAJNxH7Q\x14J`N\xd6I\xfcH\xe8F\x06E\xb4A\xb5?\x95>\xe96\xfc;\t-G4\x9e \xfa'\xd9\r\xa3\x17k\xf7\x87\x01K\xe0I\xe8>\xcbF\xd2\x00\xbb\xf5¨\xb0\xf3\xb7٨q\xb0\x13\x9e3\xa9B\x94\xea\xa08\x90K\x9c\xb3\x8f*\x9d[\x95\xa9\xa2\a\xa3\xb1\xaf2\xb4\x1a\xc2!\xc4\xf7Ӭ\xd0\"\xe11ٌ\xe9\xcb\xde\xd5\xf0\x8d\xe5\x10\xf9I\xef\b\x02\x94\xf9e\f\x91\x00+\x16\a\x03#\x1b\x05\xffS\x18p\xf5;\x0er\xeb\xf4\x00\xf2\xe1\x8d\xf4V\u05eb\xe8\x8a\xccd\xdc8\xc1uϬ\xb2\xf1\xc0\x9c\x9d\x85\xaf4\x83\x1e\x9a\x00\x80d\x83\x02\x80\x02\x80\x00\x80\x00\x80\x01\x80\x01\x80\x01\x80\x00\x80\x02\x80\x02\x80\x01\x80\x00\x80\x00\x80Ņǒb\x99\r\xb06\xb0\xcf̨ƻ\xe6\x13\xdb8\xfd\xd5\xed\xb1\x11\xcf\xff\xa2#\xd1\x0f\xc63\x1f\x1dDC\xb6)\xb9Q\x0e71\\>B\xc7`!H\xe1a\xc6J\xa4`\xf4KI[\xe6G\xcaR\xda=\x94H\xd31\xfb;\xb7&K.\xca\x1c4\"-\x12,\x1a\x90\t\x9f\x16\x8a\x06\xf7\x15\xed\az\x189\n\xca\x1b?\r\xd4\x1f\\\x11\x80'\x14\x1993\x89'{C\xbb8\xc8W\xf6I\x0ek\xcaZ\x06yoi\xfd\u007f[su\u007f\xa2vxu\xfbq\x1ek\xb5h\xb6d\xc2b}_\xf5_\xb9XXX\xacN\x01L\x94?\x1a?\xa2,-19\x1a\xa0\x1f6\aN\v\b\xf7\v\xfa\x1a\xedk\xee\x17\xe6l\xe5\r\xdfD\xde\xc9\xd6O\xd8U\xcdx\xd2f\xc1\f\xcc7\xb7\xb1\xc4(\xb7\xe7\xc0̽\xdd\xc4\xca§\xcaPʸ\xcd\xe5\xd8x\xd5k\xe8\"\xe2\xbd\xf1K\xeaT\xf8T\xeb\x1a\x00r\xed\xb5\a\x81\xf4\xa5\r|\xfa^\x0fw\xfd\xb3\x0e\xf5\x00\x9a\x11\xac\a\xea\x17\x1e\x0e%\x1e\xa5\x11Y#\x90\x15\t(M\x1c]*\xb8\"'+k&B,\xf4(\xd1*\xd2+|$\xe2)\x83\x1c{!\xd9\x16r\x19\x1c\x0f\x0f\x15v\x03\x8c\r)\xf8\x82\x02\x14\xed\x92\xfa\xfb\xe2\xd7\xf4\x15\xdd]\xec\xaf؎\xe4\xf4\xd4S\xe1}կ\xe2g\xda#\xe6\x11\xdf\xd1\xe8\x8b\xe2\xa4\xec\f\xe7\x89\xf2*\xecz\xf7o\xf0\x98\xfb\x87\xf7X\x02p\x03\x87\x0f:\x11\x90\x1f6\x1f\xe6,x)\x8e7\xb1/\xfd?\x993\xc3B\xd42\xb1>\x87+\x107|!\xe4.$\x18\x15&\xea\v\x82\x19\x83\xf6\xb1\x06\x10ۍ\xef^\xc2\x00\xd9\xf3\xaf'\xc6\xf4\x9f\xae\xb5H\x90[\xa6'\x83T\x99\x00\x80M\x90\x01\x80\x92\x8a\x00\x80\xa8\x83\x01\x80\x03\x80\x00\x80\x00\x80\x06\x80\x01\x80\x00\x80\x00\x80\b\x80&\x84\x00\x80\xb0\x8b݆ \x95\x1a\x95\x14\xa2,\xa8\xe0\xb1ƽi\xc3`\xd2w\xd4k\xe3{\xe3g\xf1p\xf0\x0f\xfe\x91\xf9\xc2\x06\xaa\xfc?\t\x1a\xfc\x87\t.\xfc\xe7\v\x8d\xfe\x87\x0eU\x00\x1d\r\xc5\xfdF\b,\xf7\x8c\x04(\xf1\x83\x03Y\xedH\x04_\xe9\xba\x03\xb1\xe4\xfc\x01b\xe2\xf5\xfe\x04\xe3\xfc\xf7D\xe0g\xf4\xb4\xdb2\xfa\xdf\xdf\xe6\x04\x9e\xea1\x0fe\xf5\x9e\x17\xd0\xff\xc8!)\f\x05)\xb7\x18\x1c'*\x1e\x00)\x05\x1ep9\x99)\xa5P2B\ne\x04U\x1fp?\\\xbep+\\Xp\xae]\xf8o\x8ca\x97p\va;sPa\x04uCe\xacuyh(r=g:j0bk\\4XNMsKkE\x1aCEE\xfcBEF\xfaDC@\x1b?Y3\x135n&\xaf-\xe5\x1d\n'\xd2\x19\x8b\x1d\xa7\x14\xbf\x16|\x0e\n\x18\xa3\f\xfa\x19\x1a\x0e\"\x16\xbc\t\xb0\x0f\xca\x03\xdd\x0eM\x04\xf7\x12\xca\v\xa7\x12\x8f\x17\x8e\x11^\"\x95\x17\xdf,B#M7H.\xe9?\xb13\xc8D\xc86|E\xf9:\x82F\xcd=fK\x15A\xa6MDD(L%BOH2>lA\xc48\x856\xf6.\xdc%\x18!\xb3\x11\x05\x0f\x15\xfd\xde\xfa6\xeaz\xeb\r\xd9Y\xdfo\xc8\xcb\xd1\xf4\xb7jÐ\xaa\xa6\xb9d\xa1\x96\xb4\x81\x9bҭ\xbf\x96\xb3\xa9Y\x95\xbf\xab\U000db7b2ҧļ\xae\xb2\xbb\xc9軞ՓÚ\xdf\xc3ʡ\xe9{ԫ\xf2o\xddN\xfb\xf1\xe1\xb6\x02\r\xe2R\a\x92\xdfW\aE\xda+\x02\xb8\xcd\xff\xf6ܺ\xfa\xe4\v\xa7\xdcΥ\x93\f\xb9\x00\x80N\xa2\x03\x80\xf6\x86\x01\x80\x02\x80\x00\x80\x00\x80\x02\x80\x02\x80\x00\x80\x00\x80\x02\x80\x01\x80\x00\x80\x02\x80\x03\x80\x01\x80\x00\x80\x02\x80\x02\x80\x00\x80\x00\x80\x04\x80\xfe\x96\x15\x8b\x90\xb9C\xa8\x87\xe0\x9a\xc7'\x01%\xe4\x93\x1a\xfd\xfc\xde/\xc9\x12\x0e@\xa2#\xc5N\xe01*X\x8b=\x8bX\r?(X)=wU\xf8<\x1fL\x0550?=&74H\x1b\xc0-n\x15\x12'C\x0f\x85 \xb6\x06\xb3!\xdc\x03\x85%\xa4\f\xab+\xde\x15\x1a7\xd3\x1c\x18E\xc0(\xf6V\xa5<\xbbh?T+xIg\xfa\u007f\xb0u\xff\u007f\xfd\u007f\xfa\u007f\xff\u007f\xff\u007f\xff\u007f\xfe\u007f\xff\u007f\xff\u007f\xff\u007f\xfd\u007f\xfa\u007f\xff\u007f\xff\u007f\xfc\u007f\xf9\u007f\xff\u007f\xff\u007f\xfd\u007f\xfd\u007f\x87y m\x03g\xed^uP\xa5M\xed6m1A\x1f\x85\x17!\r\xa4\n\xa3\x04\x1b\x02\\\x01l\xf7\xbf\xfb\x81\xef\x05\xf6>\xef\xf7\xf1\x1b\xf3:\xf2\xeb\xf1\xd1\xf5\x0e\xef\xec\xf8\x98\xf4\xef\xff\xeb\xff\x1f\n\xa0\b\xcc\x0e\x8a\n\xa4\v'\x06\xd9\x04x\x02;\xfe\x83\xff \xfa\xed\xf9\x12\xf6\a\xf5!\xf0\x97\xf2\x8a\xe9\x87\xef\xb8\xe0\xcd\xe8\xbd\xd3\xee\xdcn\xc3\"\xcd3\xb2̻\x90\xa5\x8d\xae\xef\x9c\x1c\xa8\x94\x95V\xa2\xa7\x8dm\x9c\xf3\x84E\x9a\x1b\x80<\x9b\x00\x80\x02\x9a\x03\x80I\x97\x00\x80k\x98\xc0\x899\xa2C\x99\xae\xb09\xabʼQ\xbb\x05\xc7\xe8\xc7\xff\xd1A\xd3b\xda\xcd\xdd\xca\xdc\xca\xe7)\xde\x04\xf0\x81\xe3\xda\xf8\xf0\xec}\x01\x0f\xf4v\x03\xcf\xf4\xe3\x00r\xf2\xa2\xfb.\xf0\x13\xf4w\xea\xde\xee\x83\xe4?\xech\xe3\xbc\xebF\xe5t\xea\xb8\xe7\xca\xe8\xa8\xe9\x96\xe2\xc6\xe8\xb2\xd7\x03\xe2s\xd1*\xde7ҭ\xe5+\xd92\xf4\xc5\xe2\xd9\x00=\xec\xf7\t\xed\xf5\x8e\x11\xbc\x00\x98\x1a\xec\x0e\x9d$2!A0\x972\xf7?dG\xbfR\xb6_Gg\x10r\x96ux}\xa6z\xfe\u007f\x97~\xff\u007f\xff\u007f\xff\u007f\xfe\u007f\xff\u007f\xff\u007f\xfd\u007f\xfe\u007f\xff\u007f\xff\u007f\xfe\u007f\xfe\u007f\xff\u007f\x03x\xb5vBkYe\xc7\\nSMOTC\xb4B\x124\xc25\t\"R(\xb4\x0f\x9c\x1c\xc1\xfd\xd4\x0eB\xec\xeb\xfc\x03\xddP\xedQ\xd1:\xe3\xf8\xca3\xdc\x00\xc9~\xd7P\xc8\xe2\xd4jʠ\xd0P\xce.\xce{\xd3\xf3\xcd\xd4\xd9\n\xceb\u07b5\xceR\xe3\xdb\xd1\xd9\xe4R\xd7\x13\xe0\xcb\xd5V\xdag\xce\xc6\xd2Uȹ\xc84\xc17\xbd上\xaf'\xaf=\xa2٣3\x95\x82\x99V\x88/\x8f\x02\x806\x84\x02\x80\x00\x80\x00\x80\x02\x80\x02\x80\x01\x80\x00\x80\x00\x80\x01\x80\x01\x80\x17\x82\x01\x80\xe1\x8bO\x83P\x9f:\x8fY\xb5ƢQ\xcaO\xb4\xe2ޙ\xc3\xcc\xf3G\xd6`\x06\xcf\xea\xce\x17J\xfe,#j\vL)\f\x10}2\x1d\x1a\"5\r$V7n%\xc57\x95)\\+\xa4)T\x1c\x03\x1f\xa3\x17\xcd\x1a/\x1a\xb0\x1f\xf9\x17(!j\x15\xa8!\xcd\x16\xab!\xc0\x14\xb3\x1f\xf6\x13P\x1f\xeb\x1c\x89%9$V0\x84)L7\xee/\xfd;\x043+@e56C+9 I\xa7?\x8aQ\\DqV\x12F\x93V\xe2BBRi9\xa4K\xdb/;@\xae*\x893|##,$\x1ak\"\xd1\x12\xe8\x14\x16\a\xa4\vN\xfc`\x06\x0f\xf9\xa3\xf8\xe8\xf1U\xe9\xa1\xe6\xa1\xe4f\xe8\x93\xe5T\xf2\xff\xe7\xc1\xfb\xf6\xee\xf4\x04\x16\xf7S\x11q\xfe: ,\b\xb0)\xbf\x10\xdd1\xc0\x16\x84;\xbf\x1e\xcbFt-<Vm8X`VA\xeea\xa1I]a\xd0K\xc1\\]H\xc1X\xc0A\xbbU\xd8<pQ\xff:\xf6Q\x86=\xddQ\x83?-KW:\xd9=\x85.\xa9-\xd0 \xf0\x1b2\x12A\vS\x06&\xff\xf1\xfe\x06\xf6\x8f\xf9}\xf1\x99\xfa\x01\xf0)\xfe\xfd\xee\x95\xfeq\xe9\v\xfa\x98\xe5\xf4\xf4}\xeaF\xf7\xa8\xf4\xa3\x01\xc9\x00\x8b\r\xe2\x06\x1e\x16\xf7\x03#\x18\xef\xfe,\x14\x06\xf6s\v\xd7\xe4C\xfe\xfaЁ\xef\x96ò\xe4c\xbcj\xe0_\xb3\x93ٝ\xa4\xf8\xc9j\x8c\x1d\xb2\x00\x80U\x99\x05\x80B\x89\x01\x80\xae\x80\x00\x80\x03\x80\x02\x80\x02\x80\x00\x80\x01\x80\x02\x80\x06\x80\x00\x80\x00\x80\x04\x80\a\x80\x00\x80\x00\x80\x05\x80\x04\x80ۋO\x8f\xe5\xa0 \xa3X\xb8\x01\xb8\x87\xca6\xca\xe9\xd7\xd8\xd2\xf6\xe3g\xd8\xe0\xf2\xda\xe1=\x06\xdf\xef\xe5\x12Y\xfe\xbd\x17\xb4\x04\xa3\x16r\x04\xac\x10N\x02\x99\f\xc5\xfd\x13\x0e\xd1\xfc\xe9\x0e\xff\xfe\xb9\x0f\xaa\xfdC\x13y\x00\xd2\x12K\x05\x93\x13\xd5\a\xc1\x1bN\x0e\x8e)\xdd\x1a\xca3\xa3)\x88>\xa35\xe7J*DfK\x9fO\xa1P3Wq]\xa7e\x91g\xd3p\xfco\xf1u\xe2x\xfd|\xff\u007f\xff\u007f\xfe\u007f\xfc\u007f\xff\u007f\xff\u007f\xfe\u007f\xf0\u007f-xix1l\xfai\xb7d#\\\x01W\x94QeDcA\xba1J2\xe3\x1e~#\x03\r\xbe\r\xac\xf6\xa3\xf8u\xe1\x91\xe7#\xd8\x1b\xdc_\xd8\xd0\xd5a\xdc\xd6\xd8\x18\xe2\xaa\xe0t\xe9\xcf\xe3\xe1\xf13\xe8\x98\xf6\xdb\xeb\xce\xfb\\\xef\x01\x06>\xf6\x1d\x11}\x00\x84\x17\xc8\tv\x1b\x14\f\x15\x1dm\r\xe3\x19\xec\fO\x17g\b\x94\x14\x1c\x03w\f\xcc\xfan\x03\xda\xf0Z\xfaV\xe7\xb7\xedt߀\xda-\xd2c\xc9\xf4\xc0}\xbf\xf9\xb7C\xb9\x04\xb4\xe3\xb7c\xb3ʴO\xb38\xb1(\xb2\xfe\xb1ﴌ\xb7\xf0\xbb\xd7\xc1\xb2Ɯ\xd1wճ\xe6\t\xe9\x1b\xfa,\xfb[\x06\x14\t\x9a\t\xfd\x0fY\x067\x10\n\x01\x89\x10]\xff\xf3\x0e\x82\x02I\x0e\xf6\x06S\x0eG\a[\nK\x04\xac\x04/\xfe\x94\xfe\x9b\xf6\xe8\xf4\xa5\xefk\xe9z\xe9\x10\xe5E\xe7\xea\xe6M\xea\x11\xe6\xc3\xed\x91\xe7\x10\xe9\xad\xe7\xdf\xe1g\xe7\x94ނ\xea\xcb\xdf\xc4\xed\x80\xe5@\xf5\xd4\xef\x8e\x01]\xfb$\x0e\xe6\af\x17\b\x13\xe3\x1e\x95\x16\xe0%\x82\x19\xb0,\"!\x173D,[9\xf74<?\xf07gC\x988\xdbA\x935\xbd;\xa42\x167}3\xab7\x9b4\xdd:F8\xd9;\xde<\xfd<\x04=A>\x0e6\xe1<\xbf(\xff2\xc9\x1aG&X\x0ec\x1e\n\ty\x1c\xe7\v\x11\x1fV\r\x90 A\b\xc0\x1e\x98\xfb\x00\x17f\xf0\x88\fx\xe8\x92\x00M\xdeL\xf1\x0f\xd9o\xe8\x87\xda\x1f\xe5\x8f\xe0\x16\xe3U\xe1\x8a\xe1\xd0ڮۊ\xd3\xfb\xd1G\xcd\xf7\xc7Q\xcc\xfd\xc3\xca˵\xc2X\xcb!\xc1\xc3̤\xc0\xfbˠ\xbcuƈ\xb5\xfd\xba5\xac2\xb3b\xa4\x18\xb0ԡ\x81\xb1S\xa2鹆\xa7)ç\xad\xd5\xc7\x00\xb2\x9c\xc2i\xb2һ$\xaf\xf2\xba>\xaf\xbe\xbe\x83\xb4}\xc6\xea\xbcy\xd1\xdcƉ\xdb\x1fГ\u07fc\xd2\xf6\xddA\xceS\xe0\xea\xcdZ\xe3\xc1\xd6p\xef#\xe0\x05\x03\x97\xea\xaa\n\xa3\xf6|\a>\xfb\xa1\x02\a\xf8>\b\x81\xf7:\x10\x90\xfd\xeb\x16E\a\xaa\x1c\xac\x0f,\x1d\xcc\x11J\x1fs\x159$\xfd\x1eP*a(d+\x80*h*W*b0\xc22$7y=:<\x1dB\xb7>\\E\xc4;2Ih9~J\x04:}J\xb5<iL$?\xfdKL=\xd7H\xb7;\x03E\xf8>\x05Ee?\x14G\x9f8\tFF*r?\xbe\"\xce1\xd2\x1f\n+x\x10\xa1%b\x06G\x1b!\x02i\x14a\xfc\\\x10^\xf7\xc5\a\xbd\xf5\xee\xfe\xf5\xfb\"\x03\xa7\xfa\x17\a\xc2\xf3\xcf\xfd\x11\xf1\x00\xefz\xed\b\xeai\xeb\x92\xea\xe1\xee\xcb\xe8\xae\xf6\xd5\xeb\xe9\x02@\xf2x\rx\xfb\u007f\x11\xf6\xff@\x10\x06\xfe\xd5\f\xd5\xff\v\v\xee\xfe4\x0f\xcb\xfcT\x18\xf0\x01\xaf\x1f\xd5\f8!\xcd\x15\x05\x1eF\x11T\x18\xee\n\xdc\x0fA\f\x90\f\x16\x0f=\x10V\x0f\r\x10\xa2\f+\vC\t\xc9\b\\\x03\x97\v\xce\xffs\v\xf3\x00\x93\bb\x03\x06\b\x8f\x04\xd2\v\xd7\x04+\x11\xfd\a\f\x12B\x0e\xde\x10>\x0fI\x12i\vl\x17q\x0e\xae\x18W\x16\xbb\x18U\x1b\xbc\x1c\xff\x1e\xf5\x1f\xfb#\"\"u'H\x1f\xc4&\x8a\x19^#\x8c\x147#\xf4\x0fs!\xce\x0e\xed\x1b@\x12\xb8\x1b\xe4\x1a1\"\xb4 \r(\x0f\x1e\xc1${\x15\xb4\x1d\x9e\bf\x14\xa1\xf9\x99\x06\xce\xe6C\xf9\x94\xd6g\xec\xad\xcao\xe0\xf6\xbc\xed\xd1\xfb\xaaH\xc4\xe2\x97,\xba>\x8a\x84\xaf\x03\x80B\xa2\x00\x80ߖ\x01\x80Ԗ.\x85\r\x9f\xe2\x8dҡ;\x93B\xa1Q\x98\x17\xa7c\xa1\xb3\xb2\xa0\xaf\x16\xbb]\xc1(\xc2\xe5\xd3\xe9\xd1H\xe5B\xe5\xcb\xf4\xb5\xf0\x9b\x00z\xf8;\n\x1b\x03[\x11\x1e\x0e@\x14\xdc\x0e\xfc\x13\x04\x05V\v\xc5\xfb\xcb\xf8\xd9\xf1\xb6\xe5\xae\xe2\xa6\xd6\x1b\xcf\x1b\xcer\xc2P\xc5i\xc0\xfe\xbdU\xbd\xe0\xbb⸗\xba!\xb4\xa4\xbe+\xb4\xa0\xc5ĸq\xced\xc1\xce\xd8\f\xd1\xc8\xe6\xcc\xdfd\xfd\xc4\xf37\x14\xa6\fJ-e$s@\xf16'K\x1aAwM\x9dH\x1aP\xebM\xbf\\\vX\"g\xa9`rl\xbee\nc\xe2cBQ\xbaTb>V@\xde,M/\xfb\x1eA#\x14\x120\x15\xcf\x06p\a\n\x011\xff\xd1\xfd\xaa\xff\x93\xf8\xb7\xff\xf8\xefI\xf9T\xe9V\xf1o\xed\xd5\xf0\x91\xf59\xfb#\x01\x13\x06\xa5\x0e\xe8\r\x86\x18\x10\x14\xd5\x1bf\x17\xf7\x18m\x16\xd7\x1b\xc3\x14\xce'e\x18\xf15\xe5!;;<(31\x9a (!l\x0f\xa0\x10\x90\xff\xdb\x06h\xf6\xb1\n.\xf5F\f\x03\xf5\xff\x01\xa3\xecb\xf8\xc9\xe3\a\xf1\xd7\xe21\xea\x88ݥ\xe8\xc0\xd2\xe5\xeb\x18\xd5e\xf3\xdf\xe3\xc4\x03\xb6\xf6\xa4\x18\xa5\v\x8d\"\xef\x18\xe3\x1f\x03\x1d\xa1\x17\xfd\x18\xe3\x11>\x11!\x104\r\xa1\x16\xc5\x14\xaa \xa0$($\xfe%\x8a%X\x1e\x9a!\xa5\x1e\x81\x14+\x1d\xd3\x05\xc1\x11\xaf\xfdY\a\xce\xfa\t\x04\xca\xf9L\x03\x8e\xf8\xb4\xff9\xf7i\xff~\xf4\x19\x01\xae\xf4%\x00\xc6\xf3\xe3\x01\x82\xf0.\x01I\xf7\x1e\x04\b\xff\n\x0e\x99\x01r\x13\x01\x04=\x0f\x9c\x05X\fZ\x05\xb5\x12\xb7\x05\xa3\x15z\x06\x91\x16\r\x03\xbc\x16\xba\x00\x88\x12a\x01\xc5\x10\xae\xfa\xe6\x0f\xd1\xf4d\n\x12\xf6\xe7\xfe\xfd\xf4X\xf7\xb5\xf1\x8d\xf9y\xf0(\xf9\xd9\xf0|\xf5\b\xed\xcd\xf4e\xec\x8c\xf7\xd3\xf4\x9c\xfbN\xfb\x02\xfe\xc6\xfd\x92\x01=\x00\v\x05\v\x02\xc1\b\x03\xfc}\t\xd2\xf4\xc9\x02\xa2\xf8M\x03\xac\xfb\xb1\r=\xfb\xd4\x121\xfa\x06\x0f\x04\xfc\x12\bf\xfbu\b\v\xfb6\n\xa5\x01\n\nG\aj\t\xab\n\xc8\b\xae\f\xa0\t\x12\t,\x03\n\x06\x10\xfa$\fO\xf8Y\x14~\xfe\xc5\x1b\x9f\aV'\xc8\x11\x963k\x1c_5\xf9\x1fx2\xe8\x1bm)R\x12\xcf\x1a\x1f\x03I\x12\xb3\xf7a\x0e\x04\xfc\xc6\v\x81\x04A\x06.\x01\x85\xfa\xa9\xf4\xd2\xe8\xac\xe8\x9e\xd3_\xdf`Ŏ\xcf\x1c\xbf\x02\xc0\xb7\xc1\xff\xbff\xc8\x13\xca\x15\xca\xdc\xca\xcfȠ\xc70\xc3{Ý\xbb\xf3\xbd财\xbb\xc0\xb8\x1f\xbb/\xbfl\xbd\x15\xc2/\xc2\xcf\xc1\xed\xc9A\xbf\xdd\xc9Z\xc2\rŲ\xc1\x88\xca\xfb\xba\xb8\xcdE\xbaC\xcd!\xc1\x01\xd2=\xc6-\xd5\xc0\xc4l\xd3y\xc1T\xd2\t\xc01\xd4`\xbf\xf1\xd3ҿc\xd1\xc6\xca\xd3\xd7\a\xde\x11\xe9\xdc\xeb\x9b\xf2@\xe97\xf0Oځ\xebf׃\xe6\xf4\xe8\xae\xe7\x88\xfa\v\xf4\x9d\x06\x92\x01(\n!\b-\fU\bK\x11y\a\x10\b\x95\x00\xd5\x00G\xf61\x01v\xf7\x86\x04\xd0\xfc\x12\x12\x84\x016\x17x\vH\x1e]\x12\xf2\"w\x13t\x1d\xe2\x10\x8b \xeb\x10p%F\x15)/\xee\x18\x0f:\x1e$\n>O4\xe6=\x048\xd9;*3?A\xb95\x91Hl@}C\xb5BrE\xa2<\xb1MF=\x00N\xa9B\x8dEuDG>\xdd>\xa3@77\xfe=\xbb3O3k1\x18)\xaf&\xec$\x9f\x17\xf0!\x84\x16\x93\x1d\xe7\x1e\x9d\x18X\x1cF\x12\x8f\x0eh\x12\n\b\xa4\x0f\xe7\x0e[\b\xcb\v\xde\n\\\x04N\t=\x05a\xfe\xf3\xff\\\xfd\xb7\x00\xe6\xfe1\x061\xf9\xfe\xfb`\xefL\xef \xe9\xb8\xf1\xa7\xea\xe9\xf8d\xe9i\xf5|\xe6f\xf0\xb4\xddZ\xeep\xd3A\xe6\x9e\xd6\xdc\xe0\xc6\xdaD\xe0\xa8\xde)\xe4\xfb\xe8\xb0\xf3\xc4\xf2\xd9\x00\b\xf5\x9e\xfd\xcf\xf1\xd8\xf1\xc5\xe4\x99\xed*\xd6\xcc\xe7\xdd\xda\xcb\xdd\xd4\xe8\xe2߹\xee\xb1\xee\xd6\xec1\xf5\x80\xef\xc2\xec\xe2\xee\xf8\xe5\x03\xe0s߶\xd4\xce\xd5]Ц\xcc5\xd5\x01\xcd=\xe4r\xda\xf9\xeda\xe97\xf0Z\xee\x03\xf4a\xeaY\xfc=\xee8\xfd\x00\xf8\xd7\xfc\x97\xfeH\x02\xc2\xfes\x03p\xffK\aY\x04\xdb\x0eK\x0eH\x14\x8e\x16L\x18\x8f\x12\x81\x18\x80\x14\xbc\x12q\x1c\xb6\aB\x19\xe9\x06\xae\r\xad\x0eL\x11\x80\x0e\xaa\x1b\x8d\x0e\xa1\x19s\f\xb2\f\xbe\x04\xf1\x02\xdc\xfaQ\x027\xf8\xb9\x03r\xf8\x13\x05A\xf9[\xff\x1b\x06\x9e\x06W\x14\xc0\x1c\xcc\x17\xba!\xf0\x12\xe7\x11a\r\xbd\b\xfd\n\x02\x10^\x0ff\x16k\x1a\xae\x17N&\x12\x1b\xc6+\x84\x1f?.d$\xbd)K#\xb3\x1a\x8c\x11M\x12\x97\x01\xd6\x16\xee\x0e\xff$5!32'  3\xb6\x1d|/\xfd#\xcc5\xb5+\x0e=\x1e+\x89.w!\xec\"\x0e\x18\x9e-\xa3\x1e\xa7/\xbd(\xcf'\xc2!-&\xc8\x17\r\x1fy\x14)\x18\x86\x15\x0e\x1a\x0f\x12r\x1eV\x11\xf1\x1d?\x13f&\xc9\x19\xd82\xd4&+&\xfe\x1b\xdb\x15\x84\a\xd9\x17\xc9\x04Y\x1f\xff\ri'\xe5\x18\xf8-\x13\x1dE6\x90\x1e\xca9(-\x910Z6\x97&q,\xb2\x1e\xeb\x19\x97\x1c\xfe\x14\x15\x1e\x93%\\#\xee/\xc50\xfb-\xc3-\xce'\x93\x1a\xa3 \xe8\a\\\x13/\xf6\xd5\x02\xf1\xe2z\xf4\x8eՂ\xe4F՝\xe0\x9a\xccH\xe3\xc7\xc05ԭ\xbeq\xbe\xef\xb3締\xa1\xaf\xb7\xbf\x9cR\xafk\x9d\x8a\xa3P\x97\xe8\x9e^\x94\v\xa3?\x9c-\xa5\xff\xa3\x1b\xa5\x90\xa8&\xa3\x11\xaf\x12\xae\xed\xac\xa8\xbd%\xae;\xbaF\xad\xaf\xb2եT\xb1\x8d\xa64\xb6E\xabַ\xbf\xaeC\xb0\x87\xac<\xac\a\xa64\xb0ʠB\xb1h\xa1Ŭ\x0f\xa6ݨ6\xa7Ѩ棑\xab\xbb\xac\r\xae\xe4\xb4ͮԶc\xaf\xe6\xc1\xbe\xb8q҄\xcaL\xde/ָ\xe7}\xdch\xef\x88\xe6\xf1\xf2z\xef7\xfal\xf6\xa4\x05\xbc\xfb\x00\r;\a'\x14\xa9\x17\xbe\x1d\x94\"\x12\x1d\xe9 w\x17\x9d\x1d\xc1\x16\xc3 \xd0\x18<'9\x1c\xaa(L\x1bw$*\x1a\a(\xec\x1b\xad*(&0+E/\xc60\xe8+]1\xc5..2[8#;\x8a9\x95E\xe45\x89B\xfc7\xd8:j;\xfa<\xdd?\xeb?TM\xbfB\xa6W\xd3MTQ\xd4O\xd1O\xe0DrN\xbc@*C\b<\x8e4v5%*\x94&,1\xdb\x1706\x03\x1f\xe51\xbc$\x88,)\x1b\xbb*\xda\x10\x00.\xea\x11\xdb(\x89\x17\x80*\r\x15\a1\xd5\x11(0\x9f\x19\xb5.E\x1f\xff(\xe6\x12\xc0#\xee\x06\xa4\x18\xbf\x06\x94\x02\xab\x02-\xf1\x8b\xec\x8f\xf0\xd6߮\xf4\x18\xe7g\xeb<\xe8\xac\xe49\xe4g\xe71\xe29\xebH\xe2r\xf1\xef\xe32\xf4\xeb\xe9<\xfb\xd9\xf1\x98\x00\x84\xf9m\xfcQ\xfb\xcd\xf9\x92\xf5\xf2\xf9+\xf9j\x00\a\a\x05\x04\xec\n[\xfdN\x004\x00\xdc\xfe\xe8\r\xda\x0e\xf4\x0f\xd9\x14L\t\x91\x0e`\x01b\n\xfb\xf8\x1b\x01\xbe\xf1\xcb\xf1g\xe5A\xec3\xde\x02\xed\b\xe2\xff\xe7\xa5\xea\n\xe6\xe3\xf0\xe2\xec\x87\xe8\x1a\xf0\x94\xe2d\xea\x1d\xea\x8c\xea\x06\xefx\xee\x81\xf4v\xf4\xb7\xf6\xbc\xf9v\x01\xdc\x00\x92\x12\xbd\x117\x1a\xe9\x1f\x10\x1eb'S\"f%\xcb#\xc0$:\x1a\xcb)Y\v\\'\x89\x05c\x15\xa9\xfe\xcb\aM\xf8\x9b\x0e\xa3\x03\xa1\x1a\xef\t\xec\x16\xc7\x03\xb7\b7\xfe\xe6\bx\x00!\x14\a\x04+\x16d\x00\xb0\v\x00\x01\xc2\a|\b\xf7\x16\xc2\x11]%9\x16\xe3\x1f\xc9\r\xf6\x10b\x04W\fu\f!\x17\x92\x1b\xa4\x1f,\x1cb \xf5\x14\xef\x19\xdc\x1d\x02\x18\xb1$\x8a\x19b\x1bN\x11\xde\f\x81\x05\xba\xffC\xf9\u007f\x01\x1f\xf9:\x11v\x05+\x1bF\r\xca\x14\xee\x02E\b\x96\xfaw\x045\xfdq\b\xc8\xf9P\x042\xf0\x86\xfdc\xeb\xb8\xff\x99\xf4\xfc\te\xfd\xba\x13}\xff]\a\xae\xf3 \xf3>\xe2\xd1\xf1\x05\xe8\xad\xf5\xd3\xf0J\xf9\xd4\xea\x12\xfd\r\xe5\xd8\xff\x1b\xea\xae\x02&\xf1u\xfeJ\xe7\r\xf0\xde\xd4V\xd9@\xc7G\xd1L\xc5\xc8\xd6\xe5\xceS\xdbz\xd4\xfb\u070f\xd07\xdb,ч\xd7\bٴ\xcf\x04\xd5s\xc7\\\xc7w\xc8A\xc2\xd2\xd1\xd0ѕ\xd7t\xdfT\xdaa\xdf?ئ\xdb\xc9\xd8K\xda\u007fߗ\xe7\xac\xe0\xc3\xee\xf8\xe0\b\xe5\xd3\xdd<\xdeC\xe2s\xe7\x17\xf1o\xfa\xb2\xf1q\xf9\x01\xe9y\xec\x1c\xe5E\xe9\xdd\xe8\xb6\xf4A\xeb\xa1\xfb`\xf1=\xf3(\xf8/\xf6\xe5\xf1\x18\xfc]\xea\xed\xf6\xc8\xe3\"\xed\xf7\xde\xff\xe5g\xe1L\xe4\xd3\xed[\xf5v\xf0\xcb\x03d\xe7\xef\xf0L\xe0\xfc\xe0\xe6\xe8\x94\xee\xd6\x01\x97\fU\aJ\x0f\xcc\xfe\xf5\xfbe\xff\xfd\x01\x83\v\x91\x1a\xa0\x17\r!\x16\x18\xdb\x12\xb2\x15\x14\fu\x17\xc2\x18:\x1e,*\xc8+g,\x03*\xcf#\xeb)\x16%R-\xff/\x9c!\x1c&\xda\x1b\x82\x0e:\x16\xb1\x0f\xa8\x16\x01\x1b3 \x8b\x1b%\x1f\xba\x12@\x15\xb2\rU\xfd|\a\xf0\xf5\x10\xfa]\rs\x03\n!\xfe\x16\xd2-,\x1bA1\xc4\x1f*+\xb8&{(\xb1\x1e\r.\xf2\x18\xac/\x19%Q,\x8c+\xa6)h\x1f\xaf(\x84\x14:,!\x1f\u007f0?+\xc5.\x81,y*N%\x8f&O \xb2 \x9e\x1d]\x1c\xac\x1e0\x12\x16\x16\f\xfc\x96\xfa\xcb\xe6\x84\xe9\b\xecm\xf2\xbf\xf9\xa0\x01b\xf8\x02\x02\xd0\xf1\x91\xff\xc3\xf3\x05\xfc \xfa\xf7\xf9?\xee\x9b\xfa\xec\xe3\x9b\xf5\xc3\xe7\xb1\xf1\xd1\xe6A\xf2\xaa\xf1C\xf1N\x03\xf2\xf9\xbd\b\xde\x06\x9d\x01\xaa\a\xfb\xfaE\xfdh\xfcl\xedk\xeeo\xe5\x85ؑ\xe6\xb4\xd43\xde[ټ\xd3x\xdcC\xd8\xeb\xd8r\xdd\u007f\xd7_\xda5ߤ\xd8\x0f\xe8\x15ߏ\xf8\x87\xed\xeb\xffq\xf5\xe9\xf6I\xef4\xf0\xec\xea1\xfd@\xed\xb2\x12u\xf9n\r5\x05\b\b\x05\tW\x12\xf6\r*\x16[\x14\xb0\x13\xe4\x17t\b\x15\v\xd4\x04#\xf9\x92\x06\xc1\xfa\xa7\x15\x83\r\x00)\x1d\"a \xed\x18\xdc\x1b\xf8\fx\x16\xa1\x18\x17\a\xbe\x13\x1c\x13K\b\x92'\xa0\x13\n+\xdf&\xe0'\xe70\n,\xb7-T,E)W%\xe1*Z2T8n:\xbe?>:\xe465<<8\x1c/W;\x97#\x973\f!\x81(\xc0\x1c;#\x14\r\xbc\x1d\xd3\xf8E\x0f\xd8\xef\x1f\x06\xc6\xed\xb5\xfd[\xdcf\xe4\xe7\xc4\xc1\xd0\xf5\xbd\x01ԫ\xca\xe6֖\xd4@\xd4\x1eҸ\xd2/\xd0IӞ\xc7\xea\xc9?\xbf\x98\xbe|\xc52\xbfe\xd7\xf7\xcd}\xe5\x0f\xe3\xad߹\xe58\xd3\x03\xd2F\xde\x1b\xce&\xe8\xe0܇\xeeW\xe07\xfc(ݞ\xf6\xa0ۏ\xed\x9b\xd8h\xee\x1e\xcfv\xf2\x0eϒ\xeca\xda<\xe7|\xdd\xe2\xf4eֳ\x02\\\xdb\x1c\x04^\xefX\xfd\x85\xf0\xc4\xf9\x9d\xe3\t\x01\x8a\xdf\x06\t1\xe9=\x11U\xf9\x00\r\xb1\x00\xc5\xfc\xc7\xf5\a\x04\xa1\xf3\a\x1d<\t\xf6,[\x17\xda.\x05\x1b\x8f(\xe6#d!\x12&^\x1b\xf3\x11\xfe!@\a|&\u007f\x18\x01#\x9e\x1f\xab('\x1c\xe1.0\x1e`9\xf8)\xa2CE5\x00:\x970\xad%e\x1f}\x17r\x0e\v!0\x14\xd1/*&`)M&\x96\x14Y\x14Q\x11\xc0\x05\xb4\x187\r\x14\x18\xc0\x15~\x19\xa6\x18\x12'f!o0w\":*\xd9\x14\xc6!w\x11Q\x1c\xfb\x10\x94\x1d8\x0e\xbb#\x8f\x11\xa01Z\x1a\xd290'\xee$\x9b$\xa1\x10F\x159\x111\n|\x13\x94\tF\x18\x91\x11:\x1d\x96\x10\xdf\x145\t\x80\x15]\n\xa2'\x88\x14k+E\x14m'\x82\x12\xf0#\x03!\x9b\x13)\x1b\xc9\b&\x00\xea\x15\x10\x06\xa1*\xe6!\x1b.W0\xc4'6+h2\xb5.x:.:\x8f=\xb6<\xa7:\x99>\xdc9<7\xb1=(0\xb0;P1\x064\x947\xe0,\x911\x1b2\xd1*,/\xfc!\xed \xb6\x13\xca\x15\xb9\x0e\xe9\a\xdc\x0fc\a!\x10\xd1\x10\xa1\v\xf1\x14D\v\f\x10}\t\x80\f\a\x04l\x06\xdb\x05\x8a\xfd0\x03s\x04\x8d\xfa\x1d\f0\xf4\xcc\xfdf\xf6\xfb\xdf\r\xed\xe7ܧ\xe0\xb2\xe4\x05\xe4\xeb\xe7q\xec\x1f\xe4\x17\xf2\xfe\xce6\xe0\xc4\xc1\xcf\xce\xe9\xbc\xcb\xc5\xf5\xc1\xac\xc2̲\x02\xb9\x06\xa0|\xaa\xf0\x9aZ\xacb\x9b6\xa6瞕\xa60\x9cҫy\x9e_\xb0\x19\x99ΪØ\b\xa5\xa1\xaaK\xb1!\xbf\xc3ƪҬՌ\xe7\xa7\xde\x10\xeeU\xedP\xe1\x17\xea:׆\xdbf\xdf\xd5\xdf\xd2\xe8~\xf1\x00\xec\xc9\xf5\xdb\xf4\xbc\xeeS\xf2\t\xefz\xebc\xf0\xa2\xec\x0f\xf6A\xee%\xee\xbd\xed\f\xdb9\xeb\r\xdd\xe4\xe7Y\xe9\x88\xe3F\xe4\xfd\xe6.ݿ\xf9\xe0\xe94\x1a\v\x02h5\xf4\x1d'<\xdc(\x981u'\x19,D'9,;+\xa0)\xa2+\x97/<'\x993\x1b)l/z0\xa60;?\x152\x92<\xe80\xd64l*b9\x03)\xec9P+\r8\xcf'\x04.##X#)\x14\xcc\x1b\xfe\x18\xc3\x1a\xba,\xbb#Y8\x874mA\xdbD\xdaC\xe0C\x1d@\x009\xe12\x03,\x94)\xb6$\x9d0:-\xe96\x1d9\xe26\xf78\xaa(\xa5*\xd5\x14J\x1b\xb0\xff\xc2\vA\xe2\x1f\xf4(\xd6G\xe2\xe6\xdc\xe0\xe0\xf0\xe9\xe1\xe4\xf3\xea\x80\xe8O\xcf>ߊ\xbaR\xc5\n\xbe\xa8\xb7̽]\xb8\xa8\xbaa\xbb\xec\xc3\xf3\xc6r\xc5\x11\xd1\xfa\xb6\xd6š\xb8B\xb4\xcdԒ\xc5\xe2\xec\xc5\xdd(\xf3\xbd\xe6\xcc\xeaE\xe1\xdd\xe0\xc3\xd5\x12\xe2@۽\xe54\xe27\xe5\x11\xe1-\xe6\xfb\xdeS\xec\xe3\xe3\xa1\xeb\xa5\xe5\x00\xed\xd4\xe0V\xf0\x88\xebk\xe5<\xee\xbb\xe5\xee\xde\x13\xeb\xb3\xd8s\xf9\xd1\xef\x1b\n\xda\t\x96\x17\x80\x0fY\x1bd\r\xe2\x14\x0f\r-\x1d\xa3\x14\x80'< A\x1e\x88,#\x17\x80#x\x1f\x16\x1e\x03\x1d\x13\x1d>\x0fd\x15\x17\x03\xb2\x18\x17\xf5\xe5\x15\x82\xecr\x05\x14\xe5\xad\xf4H\xe0\x16\xf1k\xde\x10\xf4V\xe3\xb0\xf4=\xe4\x99\xf7U\xda\\\xf4/\xeb\xe8\xfe\x11\n\x00\x16\xca\x14\x10(\x12\x10\x13'\xa2\x1dD%y2\xf82\xfd7L=\xc19\aK63\xa5JE!\xfb7\xae\x18,+\xfe\x10\x89&\x1c\rT \x05\b\xf3\x1a\xc3\xfbR\r\x16\xfd0\n\x04\r.\x14;\x177  \t\xc8\x10\xb7\xfc\xb5\xf7\xf8\xfa\xe4\xf8?\xf8\\\xf6\x9f\xfd\xa6\xef\xce\t\n\xf7\xb2\x13\xee\v'\x1b\x13\x16H)y\x1aq2\v(+6-6\xeaA\x1f>pB\xf65\x172(%Y)\xb2\x1e\xa5+\xec*\xe9 \xde(\x9e\x16e\x1d\xfc\x19G\x1a\xcc&\x81\x1d0$9(\x8d\rD\x1d\x0e\xf7\xd4\x01\x10\xeb=\xf25\xed-\xf6\x1b\xf3\xec\xf8\xa6\xff\x1e\xf7\x94\x05\xf6\xfe\xbe\xf95\x04\xc9\xf3\x1d\a\b\xfb\xcb\n\x12\x03\xcb\n\x04\x04\xe3\x02L\xf8\xc0\xfb6\xf1\xa5\xf6\xef\xf9\xda\xf1\xc1\xf39\xf8\xcd\xdf\xca\xf5\x03\xd0q\xe7&Ϡܱ\xceQ\xd7\xff\xc6\xca\xd2\xdb\xc5\xdb\xd0\xfe\xb6\x98ʛ\xa4F\xb6\b\xa3~\xa87\xb2<\xba\xb5\xc5^Ӫ̞\xd47\xcc\x1a\xcca\xd26\xd8-\xd6\xc8\xed\xeeۖ\xe7\xca\xee0\xeas\xfdd\xfd\xa6\xfd\xe9\xff\xac\xfb\xf7\xf5Z\x05C\xfa~\n\x82\x10\xdf\x01\xcd\x17a\x02t\x0e\x92\x13\xac\x15y\r\b!\xc0\xf7t\x16q\xfbz\fn\t\x98\x12!\a=\x10[\xff\x12\x02\x04\x06n\x04\xc9\nU\x0fX\x02\x16\x17U\xfap\x10k\x06Y\r\x12\f^\x18\xdb\x032\x11\x06\x0e.\x10\xc0\x11\xb5!\xcd\x00\x9c \xb8\xf8\x88\b\xa7\x03\x1a\x013\x1d\xac\x15r,\xfb3\xd4+\x819;%\xfe!\xd4\x1a\xcf\x19|\x1d>%\xb3\x1er\x1e|\x1b\x91\x0f&\x1a\xd7\x18\xab\x10\a\x1ff\x10\t\x10\x00\x1b\xf0\x15\x0e\x1eo&\xc2 \x8b!*\x1e\x03\x13\x05 [\x13\x9a e\x1f<\x13A\x16\xa4\r\xac\a/\x1e\x90\r\x97&<\x1av\x12\xae\x10\xb9\x01\xf2\x03H\xf4\xf9\xfe_\xe3\xb6\xf15ߵ\xe6\xda\xe1\x1e\xec;ذ\xea\xf5\xc1\xf8\xdcJ\xbd\xf8\xd5\xda\xcf\xd9\xd2\xccΎө\xcb\xedڢҵ\xe95\xd2{\xee`Ɗ\xe5^\xc1Z\xdac\xd7\xe0\xdfX\xe7\xd6\xf0\xa1\xe8\xe4\xf3h\xf0\xca\xf1A\xf9\xb5\xfd\x0e\xff\x87\x10\xe5\x02\xa9\x15\xf1\xf3\xe6\xfe\xd6\xdd\u007f\xe2\r\xdfr\xe3\x9b\xedP\xf6\xf0\xea\x01\xf6$\xe1\xa3\xe7\xa1ۛ\xe3:Ϳ\xd7PВϱ\xe5\xed\xe2;\xec\x12\xee\x8f\xf0J\xe7d\xfd\x02\xed\x10\x01\xdd\xfa~\xfe\xd7\xfb\xc9\xfc\xdf\xf2\b\xfc\x1f\xf2.\xfe\xd0\xf5\x8e\x06\x04\xf9\xd8\f\xaa\xfe\xb2\x01\t\x04M\xf6\xa7\xff\xe1\xf2g\xf3\x1b\xeb\xab\xea\x15\xe3\xef\xeby\xdcx\xee\xf9\xe4s\xf4\x9e\xf6\x8c\xf9\x14\xfe>\xf4\xb3\xfb\xb3\xfb\xb4\xf0M\x06p\xf2\x86\t\xf3\t0\vX\x15\xf6\x12\xa0\tZ\x18\xbb\xff(\x15\x02\xfc~\x0f\xd9\xfd\xd7\nh\a\xf6\x14\r\x15\a'8\x0f\xed!6\xfb]\v\x19\xf5\x11\x02\xcc\xefg\x01\x97\xe5\xf5\xfb\x04\xe8\xf2\xf8q\xec\xa1\xfa\x82\xf5c\xfbg\xf8\xe9\xfd\xaa\xeb\xbc\xf3T\xf3\xca\xf2L\x02\xd2\x02Z\x00)\n\xb5\xfa\xf7\xf9\xb4\b|\xf7 \x10\x96\x11e\a\xe0\x10]\x14\x95\b\xe1\x1e\xec\x14\x92\x1a3\x17\xd1\x1f\xe6\x1dI.\x97.\x11/e-u$\xed\x1bR,\xc0\x19\xe72\xac*\xe7*\xa0)\xee!\xe7\x1e\xa1 \x8b!;,\x0f\"\xa9*\x12\x1d%\x1b\xdb\x1b\xe7\x1d\xb8\x1f2 \x8d+\x18\x1a\xa81\x0e\x10\x90\x1f\x1c\b\x88\f\xe5\r\xf1\x15g\x13\xab(\x84\x17U.9\x18\xd3! \t\xf6\x1a\xd8\xfe\xfe\x14_\xfa\x92\v\x15\xf8\x13\n}\xfdg\x05\x03\t+\v\x97\x18\x9a\x1d>#\x8a*%&N+\x91#~\x1f\xeb\x1d\xb0\x1f\xa5\x1d@(4 :$k\x15\xd2\x14\xcd\x05f\v\x9e\xf5j\x01n\xeb\x87\xf9\xe4\xe6\x03\xf6h\xe1\xee\xf0\xb8\xdeN\xee\x05\xe1\xf0\xf0\xe5\xe6\xa3\xf4\xa6\xf0\xe3\xe9\xa6\xeb\xbe\xe7 \xde\xed\xea1\xe6\xe9\xe8\xad\xf4\xa4\xebD\xf5\xf8\xef\a\xf0\x11\xef%\xef\xf7\xf0\xbb\xeaX\xefS\xec-\xf0\xcd\xf5\x12\xfcZ\xf1\x02\x04\xb2\xe4\x10\x01M\xd6\xe1\xe4\x9c\xd6\x12\xd7r\xd22\xe9k͊\xed{\xcf\f\xe24Łڿ\xc5u\xde\x18\xbe}ۖ\xbb\xdd\xd4Xǋי\xd3/\xe3I܇\xf0\x19\xd7S\xeb!ێ\xeb7\xe7P\xf7\v\xebT\xf9Y\xe5\xbd\xed\xaf\xdfV\xe5)\xec>\xf0\x96\xf6,\x00\x9a\xf1x\x01\f\xee_\xf9\xe6\xf0\xd7\xfa]\xea\xde\xf21\xe1\x96\xea\xf0\xde0\xec\xb5\xe1]\xe1\v\xedR\xddq\xf0\xed\xe0<\xe8\xca߀\xefJ\xde!\x05\x81\xf0*\xffZ\xfa\xf3\xef|\xe8\x8e\xf7\xb8\xe96\bP\xf8\xfc\t\x92\xfe\x1b\xfd\xbd\xfa\x81\xff\xdd\xff\xc8\a\xdc\x04,\v+\x00j\v~\tR\r\xa4\x18\xd1\x1e\xed\x1c\xb73\xa6\x1f;6i.\x0f6\xc07\xd1=\x939m?\x95;\xdf421.*\xf9(\x1f$\xef/_)\x05.\x864\x86/\xef97?\a@\xa9H\bE\xe2A\xb9U\xd9@_QZF\xba5\x97/\xc1#\xbd\x12\x8c\x11\xb1\b\xc8\xff\xb5\x02h\xec\x1f\xf86\xdcN\xec;\xd3V\xe5\xed\xce\xc7\xde4\xd2\xec\xde-\xdd\f\xe7\xfc\xf4'\xee\xfb\xfe\xd6\xeb\xeb\xe7\xe4\xe1E\xce\xc7\xcd\xf7\xca\x1d\xbf\xd3\xd0\x1f\xca@\xcd2ӕ\xc7K\xceuê\xcbE\xbe\xc6͞Ů\xca.ɯ\xcc\xe7\xc0\xffД\xc3=\xc7\xce\xcak\xc2\x15\xcd\xc9\xc9\xc8\xd2\xd2\xd5a\xd6\xc9\xd5\xed\xe2\x1b\xd6\f\xefb\xef\xa4\xfb\x16\x01\x1d\x034\x05y\xfbD\xff\x8d\xf17\xf0\xf9\xec\xb4\xeb\x16\xf4f\xf5\x94\xffG\xfd\xee\t\x15\x01|\x067\x04\xf3\xff\x01\xfei\n\x1a\xfc\x1d\x19\x04\f\x8b\x16\xb0\x17\xf0\x16\xc9\x12\xe4!\xea\x15o+6!\xe2$; v\x0f\xdd\x12\xd1\aY\x0f\xcf\x04\xfc\x13\xc9\x05\x82\x15\u007f\x06\xe7\x1a\x8a\n\x1b p\x06q\x19\xb6\xf7\xb1\x06\xb0\xf3L\xfdK\xfa\x14\x06,\xfa;\x15,\xf5\x8c\x11\x15\x00\xea\x01\xa2\v\x98\x05\xb3\b\x8a\x157\rI\x18D\x17\xc4\x14\xd2\x15\x11\x13\x12\x11K\n\xb9\x17\xcd\xfbB\"\xe6\x06\xf6\x171\x0f*\t\xd3\x01\xd7\x10\xcf\x00h'\xd1\x11\xd4#\xea\x15=\x13\x8a\vH\x11\r\f\xf5\x14\x99\x0fI\x0eG\x05o\x02\xe1\xf9\x00\x04W\x04\xe9\x02\x89\xfe\x89\x01x\xec\f\n\x82\xeb\xae\x10:\xfaw\x0f\x17\b\xc1\x11\xcd\x06\xf3\x1aF\xfb\xd7$\xba\x03<\x1b^\x14\x05\x13\x1d\x0e\x13\x1a8\n\xef$\x19\"\xca*\x1f-&.b!\xd9-\xc3\x1d\x06 \xd1\x1dX\x16\xbe\x1dR\x1cw\x17\x82-\x03\x18\x9e.3\x1e`\x19\xfb\x1e\xc9\x10\xb8\x12.\x1d\xf5\x0f>*\x81\x1e3 (\x1e\x01\n\xd5\v\x9f\x12\xc8\x03\xcf#\x89\x10e\x1d\x88\x12\xbe\x1ba\v\xab/H\x1dmC\xa05\xe9;90\xbc!\xf0\x1f\x98\x0f\xe5\x16\x04\x03\xc3\bz\xf9\xbe\xfc\xa6\xf6x\xfd\xfe\xfc\xd4\xfe\x90\xfe%\x019\x00\xfd\xfc\xc6\x0ea\a\xee\x13\x9e\x14\x93\x14>\b\xf0\r\b\xfa!\x05/\xf9\xd2\x06*\x03\xaa\x0f\xba\f\xfd\x1a\xd0\x0el\x0f\xde\x05d\x02>\t|\t7\x11\x84\n\xf7\n%\xf7r\xfa\xdb\xea\xd2\xf5#\xf3\xaa\xf7B\xf92\xf1\xa1\xec\x1b\xec\xb8\xde\xc7\xe6\xcb\xdf}\xe8\x18\xe5\x13\xe9\xc7ߎ\xe4\xc3\xd0(ޫ\xc6\xf2\xd9:ʵ\xd78ҵ\xce\xc8\xcd^\xcba\xc7\\\xc9;\xc9K\xcbҼ\xc9\xc5|\xb0\xbb\xb0+\xb7ܷ\xc5\xc0\xc0\xc7{\xc2\x12\xc3_\xca\xe3\xc2'\xdc\xec\xd7\x14\xedu\xe9\xdc\xf6\x99\xe6w\xfb\xa6\xea\xcf\x01l\xf0l\r\xd1\xf1\xf9\v\xa1\xf6\x8d\x00\xa8\xf2\x80\xfb\xad\xee\b\x00\x94\xf5\xcd\a\xa6\xf4a\n8\xf1N\tl\xf9\x9c\n\b\xff.\b\xed\xf7~\x04\xeb\xe9\x89\x02\xde\xebV\xfd\xdd\xec\xc5\xf7\xe3\xe1\xf3\xf7U\xe3\xfd\xf3.\xecE\xf2L\xec\x13\xffi\xf1\x80\x06[\xfa\xce\x05\x1f\xf9@\x13\x8b\xff\x03$\a\x19W\x1e[\"Z\x11\x9e\x18\xbd\x1e\xd4\x1c\xfe4W,=111\"&C0W*\x9f,\xee6F1H4*7\xb5\"b'\xd7\x18$\x12+\x1aD\x16\xde \xe5#\x05#^!\x92\x1cv\x1aR\x19\xe2\x15\xbb\x19\\\x15I\x16\xca\f\xb1\x15\xcc\v.#b\x16A2b!\xb5-\x94\x1e\xe0\"3\x14\xbc\"\xeb\x12\x1b\"/\x10\"\x16\xb3\n\n\b\xcb\x04\xd5\x06\x1b\a\xd1\n\xa7\a.\v\xa8\x01f\v\xf9\x01\xc4\t+\x04e\v\x15\xfe\x14\n \xf4\x8f\x05n\xf48\tU\x04\xbd\x1b\xbd\x0e\x1c,\x19\f='\xbf\x12\xa1!\x8a\x1f&!\x06\", \x9e\x18E\x1bC\x11\xdd\x13\xea\x18E\r\x06\x18\xce\tX\x06\xc5\xfcS\x01\xa8\xe1F\xfe\xa5\xe0O\xf2\x1b\xf5\x9d\xf7\xec\xf4\x04\x01\x8c\xe6\xd6\xf7=\xdc\xcc\xf0\x8e\xe2f\xf3\x17\xf0\v\xf2}\xf3\x0f\xef\x1e\xfc\f\xf8x\a4\x04\xf1\x03\xf5\x06\xed\xfa\xd6\x01\xcc\xf0\x19\xfdQ\xea\x8e\xf3:\xee\xa6\xeb\xd0\xef\x13\xf5\x92\xef\x1e\xfd\xa1\xf2\xdd\xf7r\xe6\xa5\xecg\xd6\x05\xdd\xc5\xd7\xd5ՙݮ\xe0k\xd4lߚ\xd7\t\xd0D\xe5\x18\xe0\xff\xe5\xfb\xec3\xea\x17\xdf\u007f\xf1\x03\xda\xd8\xf2\xf2\xedm\xf6Z\xf8x\x02\xb7\xf5\x13\x05\xda\xfc(\xf89\xfa(\xf0\xce\xf5\xfe\xeeS\xfb:\xf4\xab\xfc)\xfb\xde\xfdz\xf7\x92\xfe\xab\xf0\xa4\xf5 \xf4\xb4\xee7\xfcz\xf1\xf6\xf9U\xf5|\xf6*\xf5\xee\x01\xdb\xf9\xba\t\xcb\x02\xa7\x06\x92\x02\xac\b\xad\x02W\x154\r\xa0 G\x19\xc5%\xd8\x1c\u007f.\xf3#\xf5/\xb8+\x12#L\"@\x14\t\r?\nO\x02;\x04\xcf\x05\xa3\fZ\a\xee\x0f6\x06\x89\x00\xb1\xfbx\xf4\xd7\xea\f\xf4\x8a\xeb\x9a\xf2\x02\xedg\xea\x80\xe0I\xec*\u07bf\x00y\xec<\f\x14\xf5*\x03\x05\xebs\xfe\xfc\xe6>\x05\xa1\xf1#\x06M\xf7\xe6\xfd@\xf1{\x05S\xf2^\x17\xaa\x02\xc4\x1e\xa4\x0fP\x1f\xe9\x0f<\x17\x15\t_\r\x17\x06\xd7\x00\xcb\x00u\xfey\xf8d\x01R\xf8\xc5\xffJ\xfa\x8f\x06\xaf\x00\xe0\r\xf9\x04\xc2\x15\t\t\xfd\x16l\r\xf0\x18\x85\f\x13\"\x13\x0e\xc9 \x11\x15\xa6\x17\xdf\x17\x8e\x0f>\x12\x98\x0e\xe1\x10x\x11|\x14\x9b\rJ\x16\x96\x03\x90\x0fD\xfd\x8c\v\x9a\xf8W\x108\xf5\xdc\r\xb4\xf3\x83\x04\xc9\xf8\x92\xfe,\x01\xa2\x02 \x06\r\b\x90\x0fr\v\xbc\x1c\xd5\x13t\x1d\n\x1a\u007f\x17\xcf\x1b3\x19\xa5\x19/\x1d\xe2\x15,\x1b\x05\x13\xbe\x19\xc5\x11\xf6\x17\x04\x12\x95\x13\x9f\x0ev\x10\x84\r1\x12l\r*\x13\xc1\r\x1b\v\xf1\b\x1f\x05`\xfd\xc3\x024\xf2\r\xfbZ\xeb\xa2\xf1{\xeaC\xf5\xb2\xed\u007f\xff\xe1\xee5\n\xc9\xf1\xd0\x11\xcb\xfdF\x12u\n\xdb\f\x80\x06p\xfc\x03\xf9U\xefW\xf5\xb4\xf3F\xf9\x1d\xfc\xd6\x00S\xfbX\x02W\xf4F\xfdE\xf1\xf0\xfeb\xf4\xaf\x03\x83\xf4\xad\xff_\xf2\xb6\xfd\x8a\xf1\xa2\x00J\xf1\\\xfc\x8d\xfb\xe0\xfa\a\f0\b\x8c\x12\xf4\x11\x95\f\x1d\x0f\x02\xff`\x06J\xf2J\xfcT\xed~\xfb\x82\xf0r\x04\\\xf2\x88\a\xd6\xeb\x9d\xfdM\xe2\x1f\xf00\xd8\x03\xe9\xe2\xca\xe5\xe0.\xc7F\xda,\xcaK\xdb\x11\xcbo\xd9P\xcb~\xd3\xd2\xca\xca\xd7N\xcc\xdb\xe17\xcf\x05\xd8\u007f\xd5\xf4\xd0_\xdfH\xdd\xcc\xe9a\xeb\xcc\xe6o\xf4\xaf\xdb\xe7\xf1\xb0\xdd&\xee\x89\xe9\xa3\xeco\xec\n\xee\xde\xe6\xfb\xf4\xb5\xe2b\xfb\x83\xe2\xa4\xfa\xd7\xe8\xf4\xf87\xec\xd8\xf92\xef\x8d\xf5\x86\xf6\xc1\xf8\xc9\xf4\xde\xfa\xf0\xf0\x85\xf3c\xf9a\xfa4\xff\xa5\x06\xe6\xfe5\x05\x01\xf9\x8c\xfe\x84\xf4\x8e\xfc~\xf3\x95\xf9\xc6\xf1\xb7\xf5\xde\xee\xb9\xf7\xda\xf0\xe2\xfa\x8e\xf8v\xfe;\xf02\xfc\x8b\xe4\xcd\xf2<\xee\xd3\xf2w\xfa\xd9\xff\xf8\xfb\t\x03\xc4\xfa\x0e\xfbj\xf4\x95\xf8%\xf3\xfc\xfa)\xfaU\xff\xb5\x05\xcd\t\xa7\x11\xcb\x14O\x16'\x14\xb6\x15&\x0e+\x11\xeb\bQ\r\x02\f}\x0e\xab\f\t\x0e\xfc\x05\"\a\x96\x02\xc4\x00\xac\x05L\xfcX\x06x\xf9>\xff\xa8\xf5\xa6\xf6v\xf7R\xf5\x94\x02.\x02L\t\xb8\nN\a\xae\x06\x1b\x04\xf7\x02\xa5\b\xdb\x04\x8f\x11\xc7\x02\xab\v\xd4\x003\xfe\xf4\x03\x1e\xfb\x18\x06\xca\xfd9\x05G\xfe\x8e\x06\xe9\xfb\xd3\x04N\xfek\a\xfb\xfe\xbf\vz\xfc\xdc\v\xc8\xfc\xf8\r9\x00\xdb\x12\xa1\x01\x19\x16\xf6\xfb\xfe\n\x85\xf1\xd7\xfdB\xe9\xab\xfb\xf6\xe8\xc9\xfd\xde\xedz\xfd\xa0\xf4\v\x02\x1f\xf5\xa4\x02\xa1\xf1s\xfe^\xf3s\xff\x13\xf4\xe6\x00\xc3\xf4\xa8\xfe\x14\xf3#\xfbb\xea\x9c\xf9r\xe3\x03\xf8\xdaݷ\xf4\xab\xd8}\xf0\x9e\xd5E\xec\xe3\xddL\xf0/\xe6;\xfa)\xe1c\xf7\xcfۭ\xec\xf9\xddQ\xeaj\xe4'\xf0\xb5\xecm\xf0\x03\xf2\xb6\xeev\xf7u\xf5\xd3\xf6\xa3\xfe\xa0\xec\xa8\xf93\xe7\t\xed\x83\xe6\x9e\xecm\xe7{\xf2\x91\xe5\n\xf5\xe4\xe1=\xf2\xef\xde\x05\xedR\xdd\xd0\xe93\xd7\"\xe9\x1e\xc7\xe7\xe3ع\t\xd6E\xbd\x14\xd5J˰\xe1K\xd8J\xe9!\xe0\x9a\xf0c\xe5 \xf5}\xea\xc2\xf4\x82\xec<\xf5\x9d\xec:\xfc\xc3\xf1Z\x00\x8e\xfb\x95\xff\x17\x06w\x06\xd9\x03\x83\f\x84\xf5)\x05\xe1\xeb\xf3\xfb\xc1\xe9\xd0\xfa\xcb\xe9\x97\xfa\xe1\xe9p\xf1\xe9\xee\xcf\xed\x1c\xf1\x12\xf6\x81\xee\xf0\xfam\xed\x15\xf9\x13\xed~\xf0\xcb\xe78\xe9\xad\xde\xd1\xe6\x91\xdcJ\xe5R\xecf\xe5\x86\xf9\xa9\xe9\xac\xf4D\xf1\xf1\xee\xa9\xee^\xee\x83\xe9\xe8\xec<\xed\xc0\xeb\x90\xee\xfe\xf1j\xeeE\xfa\xc0\xf75\xf8\x8a\xfcb\xf3\xbb\xf7^\xebO\xf7\x1b\xe5\xd7\xf3\x1a\xec\x02\xef0\xf6I\xf2\x12\xfb_\xfcF\xfe\xe9\x01\xa8\a<\x04\b\x13O\n\x1e\x16\v\x11\xe9\x149\x13\x97\x18t\x13R\x1d;\x16\n\x1f\xa0\x1b>\x1e\xc3\x1f)\x1c\xf9\x1e\xb5\x1b\xda\x1a\xec\x1d\xbb\x17\x95\x19\x97\x1b\xb2\x13\xd5\x1cV\x13\xc4\x1a\x86\f\xb2\x1b\xb2\x02\xad\x17\x9b\xffT\rw\xff\xbe\x06\x8d\xfe\xa3\b\t\x01\x87\r\xac\a\x89\x11\xb3\x0e7\x11\x9a\x13\xd4\r\x85\x18\xc1\x10u\x1ef\x1a\xa3\x1ca\x1a\x0e\x0e\x11\f\x82\x05#\x06\xd3\x06a\a\xe8\x06\xe7\x04\a\xfc\"\xfff\xe7\x94\xf1\xd8\xdah\xe2\xbaך\xda9\xd97݇\xdaa\xe0Lژ݅\xd7\xde\xd9\xdb\xd8\xe9\xd6A\xe7y\xdcu\xf9\xc9\xec\xc8\x02n\xf8\x91\x01)\xf7\xb4\xfd\x11\xf6\xf8\xfe(\xf7*\xfa\xca\xf2\xb5\xec\xbc\xec\xad\xe8\xbe\xee\xf7\xee}\xf6\xde\xf3\x11\xf8\x17\xf0\x9c\xf4\xa9\xeb\xb2\xf7W\xf3\xfa\xfc\x12\xfd\x1a\xfb\xb3\xfd\xf0\xf4@\xfc\x06\xf4x\xfa\x86\xf7#\xf7\xc6\xf7i\xfa3\xf6;\xff\xf0\xfa\xda\xfb\x19\x01\xe7\xf4\xf5\xfe\x84\xf1\x1e\xfd \xf1\xfd\xffl\xf3\xe3\xff/\xfb9\xfc\x1b\x01\xfb\xfe\a\x01T\t\x85\xfc\x0f\t\xcc\xf9T\x00\x12\xfd\b\x00\xcb\x03\xe0\n\xac\x06\xb6\x10y\x06\xf6\a\x00\a\xb4\x00\xdb\x03\xa5\x00\xdb\x00\xb5\x02\xb5\xffr\x00\a\x00\xb5\xfa\xa3\xfe\xf2\xf8\n\xfc\x19\xfb\x91\xfe~\xfe\x15\xfd\xd6\xfc\xf4\xf5\xfa\xf6w\xf2\xfc\xf1\xb1\xed\xd9\xecW\xe6u\xe6\xdd\xe0M\xe3\x10\xe4T\xe5H\xf2A\xe7K\xfax\xeb\xcf\xf7\x91\xee\x90\xf8\xf1\xee\x1f\xff\xf4\xf1K\xff\xae\xf09\xffW\xe9\x1d\ay\xef\xa9\v\xcf\xfb1\v\xa3\xf9P\nE\xf8\r\x0f\xcb\xfd\x82\x0f{\x00\xde\x04\x14\xfc\xe2\xfb\x97\xf6\x02\xfa\xa4\xf5\xab\xfbC\xf5\xf8\xfe`\xf1,\xfcz\xea\a\xfaJ\xe8\xec\xff\x86\xee>\x00\xec\xf1\x82\xf9\x8c\xe8\xc5\xf9\x11\xe2\xf6\xfe\xe8\xea^\x01\x8c\xf3v\x01\x1d\xf4\x8e\x02Z\xf3\x03\n2\xfdY\x0e\xfa\x04\xcb\x113\x06\xb0\x18\xb3\tm\x1e5\f\xd8\x1b\xe5\no\x13\x1d\a\x9a\x10@\x06\xa7\x0fU\x02\x8e\x04%\xfat\xf9\xe8\xf1\xca\xf8o\xf2\xe0\xfcb\xf99\xfe\xf7\xf9U\x00\xb3\xf5\x9f\ao\xf7\xc9\x0f\xc7\xfd\xa2\x12F\xfeI\x13[\xfb\x8f\x17\xc5\x03\xbf\x1d\x0f\x10\r x\x0e\xb6!\xaa\vh$l\x16P\"o C\x1c\x13\x18\\\x16N\n\xcc\nh\t\xc4\x00\xc5\a\xc4\xfb\x8f\xfa\xc9\xf5\xbf\xf1\xe3\xf5\xea\xf0\x98\xff\x17\xf3\x14\b\xe7\xf5\xd4\x04$\xf4\x1c\xfai\xf0\xf7\xf5\xd0\xee|\xfb\xd1\xf0\xb7\x02;\xf1\f\x06\xaa\xf3\xec\x06\x85\xfc\xfe\ba\x01\xce\a)\x03M\t/\x05\xe6\r \x06\x16\n<\x03V\x02a\xfb\xc2\xff\xbc\xf97\x03\x99\xfdu\a\xd5\xfa\xa0\x03\x10\xf7\x9d\xfb,\xef\x87\xf6A\xe7a\xf42\xe8\xff\xf2(\xe83\xf5\xf4\xe5\xed\xfeA\xea\xa5\x05\x1c\xf2\x9d\x05\xdd\xf0\f\v\xb6\xf2x\x14\x19\xfdi\x18\xb7\x00\x91\x1am\xfe\x19 \xfa\x02L!\xe1\v\x86\x1b\x83\fd\x15\x0e\b\\\rT\x05\xb8\x04\xc6\xfe`\x01\xa4\xf4z\xfag\xee\x9f\xee6\xe6\x13\xea\xad\xdb\xeb\xea\xacշ\xefi٩\xf7<\xe4\x82\xfd\x86\xed#\xfd\x14\xee\xcd\xf9\xf9\xe6\xbc\xf9\xf9\xe7\x0f\xfc<\xf2\x9e\x01C\xf6\xa2\x06\x8f\xf8\xac\t\xe6\x02\xb5\rl\tV\x0f\xba\x02D\v'\xfc\x0e\x04\xd5\xfc\x15\x03E\xfe\xfd\x05\xfe\xfb\xeb\x05\x81\xfav\x040\xfd\xd4\a\x18\x00\xe5\f6\x03G\vk\x01\x1c\x03\xb9\xf7\xcd\xfaU\xf1X\xf89\xf2\x11\xf6\xe6\xf1~\xf6G\xee\x04\xfd\xf9\xef!\x00U\xf5j\xfd\xdc\xf6u\xf7z\xf5\xab\xfaL\xf5\xbf\xff\x97\xf5\xf2\xfeg\xf5\x1f\xfd_\xfa\xc1\xff\xd8\xfe\xa0\x04H\xff\xea\x05\xb8\xfdP\x05\xe2\xfa\"\x01\xea\xf4\xd9\xfb\xaf\xee\xe2\xf9\xd2\xed\x05\xff\x1f\xf0\xf6\b\xd8\xf5\xad\f/\xff\xde\v\xac\x01\xe2\a\xbd\xfes\x03q\x00\x1f\x01M\x01\xa1\xfe\xd9\x00\x1e\x02\x9c\x01\x1e\tY\x05c\x0e\x88\b\xb7\v\x81\x05\xb4\x06:\x00\xf3\fv\xff\xe1\x16\xcf\x06\xfc\x16\xd1\t\xeb\x0f\xed\x02\xa5\x0fv\x001\x14&\x05\xb3\x13\x9e\a?\x11\xda\x01q\v\xc3\xfc\xb7\x04m\xfb\x95\a\xa1\xfdd\f\x1d\x05\x11\t0\x06\x8d\x02\xfc\xfaG\xff\x13\xf1\xb2\xfc\xd4\xf0\x10\xfa\xb8\xf1\xff\xf5B\xee\xa8\xf2\x11\xea\xeb\xf2\xab\xe7\xb0\xf7P\xed\x1e\xfd\xfb\xf4j\x01\x19\xf5{\x05N\xf5\x86\x065\xf7\xd1\x04\n\xf8\xc4\x04\x81\xf7\xce\x05\xbb\xf9 \x06\x97\x03\xa3\x06\x88\t;\f\xf5\aJ\rh\x06\x94\x05K\x04\x98\xff\x88\x02\xa1\xfdN\xff/\xfe\xbc\xfb\xdd\xfc7\xf7\xd1\xfe\xba\xf4\xcc\x04\x0f\xfc\x9d\t\xbd\xff\xfb\b\xe0\xfb\xe4\x04\xba\xfa\u007f\t\xb7\xffG\x12y\x05\xb7\x14\x92\tI\x0e\xe3\t\u007f\nD\a\x04\x0e\xbe\n\x02\x13b\x11\xbc\x0fo\x10\"\x06\xee\td\x05/\x06\x88\tt\ab\x05\xe9\x04\xba\x00,\xfdq\x01s\xfb(\x03\xba\xfb\xa5\x00\xc0\xf8\x88\xfbA\xf9\xbf\xfeH\xfc\xde\v\xcb\x00\x17\x10\x1d\x03\xab\t\xe5\xfd\xad\a\xf4\xf9\x05\f\x90\xfe\xe8\f6\x06\xb5\a\xf6\x01\x15\x046\xf9\xe4\t\xfc\xf8\xff\x10*\x00\xa5\x0f\x14\a\xac\nb\x064\a\a\x01\xad\a\x1d\xffN\x065\xff\x00\x01\x8f\xfcz\xfd+\xf6\xeb\x02\xe2\xf4\xad\af\xfb\xbd\x00z\xfc\xaa\xf7\xbf\xf6\xee\xf3H\xee\xbc\xf2\x80\xed5\xf0\xe1\xf3;\xf4\xd3\xf7t\xfeQ\xfbe\x01\xd5\xfd\xa0\xf9L\xff\xb3\xf9\x93\x00\xc3\x04\xad\x02\xf9\rZ\n\xf5\x0f\xe5\x13\xaf\x0e\x89\x14\x86\x13\x0e\x0f\x90\x1a\xad\r\xef\x17\xdd\x10\xcb\x10\x04\x12\x80\x12\x83\x0f\n\x18n\x0f\x8f\x11\xe0\x0e0\t\xa2\t\x1d\x0f\xa2\v&\x16\xde\x13!\x157\x11\"\x0f\xe5\t\x03\x06\xb6\b\xf5\x06\xc6\b\xf8\x10\xe5\f,\x18\xb8\x12\xfc\x1b\a\x13\x93\x1d\xcd\x10\xbf\x18A\x10\xfd\r\xad\v\x8e\x02\x97\x02\xfc\xf8>\xf9\xf5\xf56\xf2\xaa\xf5\xd6\xef\x06\xed\xbf\xf1\\\xe2\xbf\xee,\xe2$\xe8\xb2\xea~\xea4\xee\x8d\xf2X\xea]\xef\xbb\xe9!\xe5\x04\xe8,\xe3\xf9\xe5@\xe5\xab\xed\x10\xeb\xe9\xf7\u007f\xf3.\xfa<\xf7v\xfbH\xfcz\x01`\x045\b\x9c\aZ\t\x06\x05x\xfe\xc9\xff\r\xf2\xf7\xf6\xf1\xeb\xcf\xe94\xea\xed\xe4\xc6\xed\xaa\xeb\xf3\xf5\xc7\xf5#\b\xc2\x01=\x12\x8e\b\xbf\t\xbf\x03\xc2\x06\xe3\xfb\x80\x0eM\x01\xa3\x12-\t\xc0\x11\xa4\x03\xb7\x13\x1d\x00\x82\x1dx\x0f\x82(\xb3 \x9c,\xe4\"G'\xa2\x1e\xee\x1e\xca\x18\xee\x147\x10\xe5\r\x06\b\x8d\a\\\x03\xb3\xfd0\x00\x9d\xf5\xc4\xfbT\xf0y\xf3\xd0\xf3\xeb\xf2\x16\xfb\xcd\xfc\xde\xfb\xe7\x02\xb1\xf7\x9f\xfe\xd4\xf6\xb9\xf9\xb8\xf7\xac\xf9h\xf5\xd8\xf5\xad\xf2\x12\xf0c\xf1\xcf\xf1\x19\xf3%\xfb\u007f\xf5\xc4\xfcb\xfa\xc2\xfc\x19\x00\x80\ax\x03\xf3\x11\x05\x05\x9d\x11\xd5\xffH\b\xa2\xf8\xd2\x01\xba\xf4\xdd\xfeO\xf3\x91\xf9\xda\xf8T\xf7\x97\xffz\xfd\xcd\x03?\x04 \t'\x05B\v\xfe\x06K\x04\x9d\x06N\xfcl\xfeg\xf9\x84\xf3Z\xf8\x04\xef\a\xf5\xce\xf1\xd7\xf0\xcd\xf2\xd9\xefm\xef\x10\xf1\xde\xec,\xf0P\xf0C\xeaZ\xef\xec\xe4%\xe7t\xdf\xfc\xe2Q\xd9X\xe4\x84\xd9\xea\xe3;\xe5v\xe3Q\xf7(\xef1\x01\x1d\xfe\xeb\x02\xe3\x04\xb0\a\x97\aS\x13\xe5\rb\x1a\xb2\x18\xff\x16Z\x1b\x88\x14\xf8\x16\x94\x16\xb2\x14\xab\x18\x04\x17\n\x17Y\x193\x10 \x19)\r\xc4\x15\xea\f\xcd\x13d\a\xe4\x11)\xfd\xa0\v\n\xf4\x8d\x01\xfa\xf0\xd1\xf7\x19\xf6H\xf7y\xfe5\xfe\x16\x06\xe5\x06\xd4\f\v\f,\x13Y\r\x82\x1b~\x12%#\xea\x18\xf0&\xd5\x1a\x98%\xd2\x1b\xa9 \x00\x1f\xa6\x1b\xdf\x1e \x1em\x1b\x90!i\x1c\xa0\x1d\x1d\x1fF\x17\xeb\x1c\xf7\rq\x10\xee\xfen\xfe\xd6\xef&\xf6\x1b\xe6\x9d\xf4\x90\xe4'\xf2\a\xef>\xf2M\xf8\x03\xf5Q\xf7\xdf\xf7J\xf2S\xf5a\xf3\xa1\xf2\n\xfe4\xfaU\x05\xcb\x01\xc0\x01\x92\x00f\xff\xb7\xfeV\x04\xde\x03q\ai\tN\x05\xf7\t\x02\x06M\b\r\t\xce\v\xd1\x02\x14\x10\xb5\xf8e\nQ\xf6\xbf\x03h\xf5\xbf\xfb\x97\xeew\xefG\xe2V\xea\xde\xda\xf4\xea4\xdb\r\xe9\xdb\xe1\xf3\xe7#\xed\xe5\xf0\xa0\xf7\xd7\xfa\x19\xfb\xfa\xfc\t\xf6c\xf8O\xf0\xb9\xf1#\xf1\xc5\xef\xc2\xf6`\xf2\xe0\xfb,\xf6\xb9\xfb\xd7\xf9@\xfc\x1f\xfe\x94\x00\xc2\x00&\x00\xa2\xfd\xb5\xf6C\xf7\xe1\xeb\xd5\xf6\xd6\xe5Y\xf5[\xe1\x82\xed\xe0ߙ\xe6\x05\xe7s\xe8\xcb\xee\xd2\xeeJ\xee+\xefc\xee$\xec\xbb\xf2D\xef\x9d\xf1\xf9\xf5Q\xf0P\xf7?\xf6\x91\xf6\xec\x00\x1c\xfd\xca\x03\xce\x05\xf2\x01\x97\x03O\x05[\x00z\rm\b$\x14\xd7\x14\x02\x11\xed\x14\r\tH\f\x12\x05\xe0\b\x84\x02\x06\t\a\x01\xe1\x04_\x01Q\x03h\x01\xf7\x06\xe5\x00\"\x05g\x02R\xff\x84\x05\xa3\x00\xde\b\xf7\x06^\x0f\xd4\v\xf8\x12\xb0\r\x91\x0f\xfe\t\xa5\r\xc8\t\xcc\x10\x81\x0f#\x13\x82\x12n\x125\x12\x87\x11~\x11\xa1\x14F\x126\x15+\x10\xe0\x0e\"\x0e\xf3\tl\x0f\x9e\v\xcc\x0e\x8f\f\x96\f\xab\x03\xce\bv\xfc*\x05\x9f\x00\xaa\x04\xbc\x06\x05\x04\x8f\x06\xf9\x04\xc3\x01I\x05\xba\x03\x18\x04=\x06\xbe\x06\x89\x01\xc5\x04\xbc\xfc\a\x01\xc3\xfb\xa9\x00\xaf\xfe\x05\x00\x82\xfdM\xff\xc5\xf6\x97\xfe\xbe\xf5\xe8\xfbk\xfbm\xfbB\xff9\xfe\xc6\xff\xc8\xffa\xfa\xf2\x00\xa0\xf6\xd2\xfe\x16\xf89\xfc\xb8\xfan\xff\x86\x01\xc9\x01u\x06\xae\x01\x1a\x02\xf8\x00\x1d\xf7\xf0\xfc\x9b\xf2\xe4\xfa\x8f\xf9a\xfd\xd0\xfdR\x01\xa3\xf9\xff\x02\x9a\xf5\x8c\x02\x8d\xf4\x03\x01\x97\xf5\xb1\xfd\x03\xf9{\xfe\xa9\xfeb\x03\xcb\x06\"\x03\x81\n\xc5\x00\xee\x02=\x02\xb9\xfb\xbd\x01N\x01^\x03\x95\v\xc3\b\u007f\f\xd6\x063\x06N\x02\x12\x04\xcb\x03\x01\b\x88\b\x86\v\xc3\r\x95\n_\x0f\xf0\x03U\a\x88\xf7W\xfab\xeb\x9c\xf2\xd9\xe4\xdd\xf2\xca\xe7|\xf5\x18\xf2\xcd\xf86\xf8v\x01-\xf6\x11\x02\xe3\xf7,\xfcI\xffC\xfd\xb4\x06\xe0\x02\xae\fF\aI\x15\x17\r>!\xdd\x15o*a!g.\x8c-\xed.?/B/\xcd&8+M!m\x18\x00\x1d\x1c\xfd\xcd\x0e\x98\xeb&\x01\xad\xe9]\x00t\xea\xea\x02\x92\xe9\b\xfb\x87\xe8\xc3\xf1D\xe3\x9e\xee\x04\xdc~\xe7\xd9\u05cc\xdd\xed܌\xdcL\xec|\xe6Z\xf8\xa7\xef@\xfb\xf4\xf6\f\x00\xd6\xfep\f\x81\a\xb2\x19_\x11\xdb\x19u\x16K\x11\xba\x13\xae\x10\xf0\r\xcc\rL\f0\x03&\n\xaf\xfd\xd4\x05\xb6\xfb\x1c\x03e\xf7\xc5\xfe-\xea\x02\xf9`\xe0\xc9\xf2&\xe5z\xed\xf3\xeb\xb5\xe7\xc5\xebj\xe6\xa2\xed\xd7\xe9\xe3\xf7\xd8\xefo\x02\x8a\xf7J\x02O\xfeH\x00\x86\x01\xda\b\xdf\x00r\x10q\x040\vo\b\xc5\x01|\bw\xfc\xe0\x05\x80\xf9\xd2\x01,\xfa\xbd\x05M\xf9t\fw\xf3\x9c\v\xd4\xf1\xef\x04\x93\xf8e\x02\xec\xfe\x89\x03{\x01\a\x03\x13\x01_\x01\xfa\xfc\x9d\xff\x01\xfa\xb3\xff\xe6\xfe\xdd\xff]\t\x04\x05\xca\x0f\xb1\f\xc8\x0e$\x0e\xef\x0f\xd7\x12H\x14\t\x19\x96\x18\xf4\x18\x00\x1a\a\x1a\xeb\x17\xd2\x1cf\x11\xd1\x16\xd8\x06\xf3\a\xfb\xfex\x01\xc1\xfa\x92\aZ\xfa \nh\x02\x03\b\xe4\a\xce\a\xaf\x00\xad\x04\x96\xf5\x98\xfa\x01\xf5\xb4\xf3\xcf\xfc\xdd\xf6\xa6\x01\a\xfc\xfa\x04:\xfe\xdc\t\xe9\x02@\x10\b\f\xcf\x15\x90\x15\xff\x16B\x18\x94\x19Q\x16-\x1a|\x17\xc2\x10\xb2\x18\x0f\x05\x18\x11S\x01\u007f\fw\a\xf6\x11\xce\r\a\x14\xf0\r\xf1\x11m\rx\x12c\t\xe2\x11\x13\a\xe3\r\n\a\xed\nq\x03:\ay\x01\xf4\x01\xd1\x02D\x01\x9d\x04'\aq\x04\x8e\r1\a\x11\x10,\x0e~\x13h\r\x8d\x13`\x01\xdd\n\xa4\xf5\xa7\x00]\xf0&\xfb\xb6\xf4\xd6\xfeO\xfb\x9d\x04\xba\xff\xd2\b\xff\x01\x91\f\xe7\x02z\rm\x05\xb2\x0fP\bN\x0fa\a\xcd\x06$\x03\x1a\xfel\x02\x12\x00\x9c\x01A\x06o\xff\x92\t:\xff\xe8\bH\xfe\xc9\x06\xf5\xf7\xf6\x00\x8e\xed7\xf8\x8f\xe1{\xf0\xdf\xd9W\xe8\x1a\xdb\xc6\xe6\xc7\xde\v\xea4ܖ\xe8\x0f\xd4\xdc\xe2\xbe\xcfz\xdfL\xd3\x19\xe1\x8e\xdc\xe2\xe6y\xe5\xca\xeby\xee\xf9\xee~\xfb\xeb\xf8\"\x04\xb9\x057\x06\xfc\x06\x00\n\x1d\x02\x1b\r\x86\x06\x0f\x06\xb4\t\xe4\xf8W\x01\xe9\xf16\xfb\x84\xf1J\xfd\xd3\xec2\xfb6\xe5\xfe\xf3\f\xdfV\xef\xb1\xd8b\xe7\xd0\xd0y\xdeL\xc7Nُǡ\xd5\x17\xd5\xed\xd6h\xe2\x0e\xdd\xdf\xe7\xf7\xe1\x9d\xf0\x19\xe5,\x01\xbe\xf2\xf7\v\xd9\x01R\t\x99\x01P\a&\x01\x98\f\xad\t\xb2\x0f\x17\x0f\xa7\v\x80\f\xbb\x03\xda\n\x97\xfdf\n~\xfe\xf4\x06\x03\xfd\a\x05\v\xef`\x02R\xe6E\xfa\xbf\xef\xe1\xf6\t\xf9y\xfcr\xf9\xa6\xfc\v\xfe\"\xfe\xbf\x03\xb1\x04\xb2\x044\x045\x01\xeb\x02w\xfd\xbc\x03G\x05\xe2\x04\\\x12_\n\x81\x15l\x145\x0f\xf7\x18\xa2\t\xcf\x10C\nS\v\x1f\x056\x0f\x9e\xf8s\v=\xf0\xed\xfc\xbf\xf0\x9f\xf6\xa7\xf5\b\xfcf\xf7 \x02C\xf9g\x027\x00\xa3\x01>\x00c\x02\x1b\xf8\x81\xfd\xb4\xf6`\xf5\xbf\xfeS\xf4\xc8\x06\xfa\xf9R\a7\xff\x93\n\xca\x04\xe8\x13s\x0f'\x18\xf4\x17\x8a\x12;\x19u\x06]\x13U\xff\xfc\t\xba\xfc\xce\x03\x00\xf4\xc9\xfc\xcc\xe7\x14\xf6\xf2\xe6\xd1\xf4\xa1\xee\xa8\xf9\x85\xed}\xf8~\xe3\xe3\xed^\xdf\xd0\xe9K\xe51\xec\xc0\xed\x91\xf0Z\xf6\xa8\xf6\x91\x01\xc9\xfe\x83\r\r\t\xe2\x16\xa1\x11t\x19\xea\x14M\x1a,\x170\x1e\x84\x1br\x1e:\x1f\xc3\x18\x92\x1d\b\x11\xc5\x19(\r\xf5\x16\xe9\x0e\x11\x12\x9f\x10\xda\x0f\xd2\a\x0e\r\x83\xf8\xfc\x00J\xf0T\xf7\xa3\xedb\xf3`\xee%\xf4\xff\xedJ\xf3\x8d\xf0\a\xef\xbf\xf53\xf1\x0f\xf9\x95\xf7F\xfb\xc0\xfcv\x01S\x00+\r\xa5\t\xec\x11\b\x13'\x10\xc0\x16\xcf\x0e\xb2\x16\xc0\n\xa4\x12r\x04\xb1\v\xa9\xfe\x82\x03+\xf9\xbc\xfev\xf3\xe8\xf9O\xefh\xf8#\xec\xf6\xfa\xd2\xea\xf4\xf9\xd7\xeb=\xf42\xedj\xf1O\xee5\xf4^\xf1D\xf8c\xf2\xd0\xf9\x88\xf1\xd8\xf2\xd4\xf3\xc4\xef\xeb\xfa<\xf6\xd2\x01\xff\x00\x81\x04\xe5\b4\a\xc1\vf\t\x18\x11\x8d\n\x8b\x14\x84\r\x80\x137\f0\x0f\x1f\x03\xe0\as\xf9\x04\xffR\xf6\xe9\xf9~\xf5a\xf8\xa3\xf5y\xf9\xc6\xf7\xfc\xfb\x04\xf7\xa4\xfa\xcc\xf1\xaa\xf4{\xea$\xeb\xec\xe1\xcf\xe3\xcdڵ\xdd#\xd8B\xd9!\xd9*\xdb\n\xdeT\xe1\a\xe9\x85\xec\xa6\xf5\xd6\xf9\v\xff\xec\x02=\x03\xe9\x03\x10\x05e\x06v\x05\xbd\bp\tY\x05!\x110\a\xed\x17\xce\r\xfc\x1d\xb3\x16f\x1fc\x1d>\x1f\xfa\x1f\x04 T w\x1e\xae\x1fu\x1f\x84\x1c\x9f!\xcc\x16z\x1d\xe8\x12^\x16\xf5\v\xd8\x0e\x8f\x04\xe3\x02\xdb\xfe\xb0\xf9\xe5\xf6\xbb\xf3S\xf4\\\xed\xa7\xf5\x90\xec\x92\xf2\xe1\xf5\xc0\xf4S\x01\xfe\xfc!\x03d\xfe\xb4\xfe_\xf9\x8c\xf9\xb3\xf4\xda\xf75\xf4s\xfc\xa5\xf8\xd1\x03\xc6\x00\x89\x0f4\fP\x1c\xf8\x15g!\xcb\x1c\xd7\x1e\x83\x1e\a\x1a\x96\x1a\xae\x11W\x14F\x01\xb7\v\x1e\xef}\xfdY\xe5\x1c\xf07\xe5h\xeeA\xe7M\xf3\xb6\xe9d\xf8\xf7\xf0\xc6\xfb\x9c\xf8\xd9\xfe\x8c\xfa\x94\xff\xba\xf2\b\xf8\xca\xed\xbc\xed\xb5\xf2\xb2\xe8\xba\xf8O\xec\x9f\xfaO\xf3\xa0\xfc\xfd\xfaF\x03\x04\a9\a;\x0fh\x03\xc9\v\xc6\xffR\x03T\x01\xbe\xffP\xf9\xc5\xfdj\xe5\xd4\xef\xe1\xda\x1c\xe4\xf7\xe0\xf7\xe6Q\xec\xf1\xed\xd0\xf0\xdd\xf2V\xf1\xdf\xf7\x1a\xf4\xbf\xfa\xb9\xf9\xe8\xf8\x03\xfd\x8d\xf9\xcb\xfa:\xf92\xf7\xe9\xf4\xe7\xf9\xe0\xf4\x8a\x05\x9d\xfd\x8f\x14\x0e\f\xbd\x1d]\x16\x02!\x86\x1c\xc7\"5\x1e\xf9 \xc5\x1e\xfd\x16\x96\x1dU\b\x0e\x12S\xfd}\x04]\xfb\x12\x01\xd5\x01)\b\xbc\te\f\xb1\n\x14\rH\x02\t\t|\xf9O\x00\xc6\xf9\xc7\xfai\xff\x8a\xf9\x93\x05\x97\xfd\xff\vj\x04\xd7\x10d\v\xb8\x12\xd2\rx\x12p\v\x02\x12\xe5\t-\x0e\xae\t\x88\b\xe7\a\xda\x02F\a\x01\x02\x01\b\xfa\ar\r\x1a\b\xae\x12\x8e\x01=\n\x93\xf8V\xfb\\\xeb4\xef\\\xe2\xb2\xe5\xfd\xe7\x91\xe7\xe3\xf3\x16\xf1\xfe\xfb\xbf\xf8\xbd\x03\xe4\xfe\x92\f\xaa\bd\x0eQ\r\xb0\f\xa4\b{\v\xe7\x03\xa4\a\xc2\x00\x14\x02\x84\xfd\x04\x04\xf5\x01$\v\xfd\n\xe5\x11\xa3\x10-\x12\xef\x11\xbd\f)\v\xbf\x01\x13\x02}\xf2\r\xf9\xe3\xe4)\xf0\xf5\xe4\xb3\xe7\x11\xeb\xf6\xe4k\xeb\xa2\xe6\xac\xe8\xb9\xe6<\xe5P\xe8\xd4\xe4!\xe9#\xe6\xe9\xe52\xe9W\xe7\x96\xecy\xeb\x01\xf1b\xec=\xf8\x11\xf1\xc3\x01\xde\xfc\x9d\b\xfe\x05h\x06\xaa\a\x81\xfe\x11\x06I\xfa5\x00\xc0\xfae\x00V\xf83\x02Y\xf6\x85\xfd\xb1\xfcg\xfb\xd3\al\x02}\b\x92\b\x0f\xff\xa4\x00\x1b\xf9\xa9\xf6n\xf8\xc7\xf3F\xfa<\xf2\x00\xfe.\xf3\xe6\x00\xda\xf6\xcc\xfft\xfaK\xfb\xa9\xfb\x92\xfb\x0f\xfdk\x03\xb2\xffj\x06\xdf\x03\xd1\x04]\x06\xa4\x05\xbe\bT\x06\x86\n\xc5\te\a\xad\f\xb7\x053\n\t\bf\x01\xff\a\xff\xf9\x11\x00\xa6\xfd^\xfc\u007f\x017\x01\xf1\x06U\x05\xc3\x11P\t)\x17\x8e\f\xcf\x0f\xbf\np\b<\x05\x8f\n\xae\x046\v\xdc\x05\xa8\x0e\r\b\x19\x19\x16\x12\xe7!\xf9\x1e6\x1f\x92 \x99\x15\xa8\x18\xf6\x0e\xd4\x13\xae\v)\x0f]\a\xd6\a\t\x00\x84\x01\x04\xf9d\xfa\x9c\xfd\xf0\xf8Q\a#\x01t\vR\tl\n]\bh\x05\x8b\x01\xed\xf9[\xfb@\xeaN\xef\"\xe2o\xe2\xec\xe1\xa8\xe0\x91\xe3\x02\xe5=\xe5Z\xe4^\xe9\xac\xe4\x95\xec\xfe\xeci\xf6\xec\xf6\x8d\x06\x01\x013\fD\x05\xc4\x05+\x00\xa3\xff\xb0\xf9\xca\x03\xe0\xfcZ\tE\x04\xdd\x06\x11\x03\\\x02\x8c\xfb\x86\x04\xe1\xf9-\x04\xb7\x00\xeb\xfaT\x01\x1a\xf5\x12\xfb\x97\xf5\x01\xf7_\xf5\xe8\xf2\xfd\xf2\xe9\xef\xec\xed\r\xeb)\xe8]\xe6\x94\xe71\xe2\x04\xee\x9a\xe4\xfb\xf3_\xecZ\xf8\x9c\xf3\xb2\xfbw\x00\t\xfeY\a\\\x03\x8d\x04\xee\v\xb6\x01\x00\x14<\x05W\x19\x83\v\xb5\x1b\xec\x10\x97\"W\x1aW-\x18\"G8=).8C.7*\xc1)\x84\x1c\xbf\x1d\b\x18\xe4\x11\xd3\x13\x94\x10\x88\a^\fx\xfc\x19\x02\xf2\xf4\r\xfa(\xed\xad\xf3\xeb\xe4h\xf0\xe0\xe6\x92\xf1\xb9\xf2\x96\xf8u\xfb\x9e\xfe\xf7\xfc[\xff\xac\xfb\xb0\xfa<\x01\xc1\xfaI\f\f\x00\x8e\x14\xa5\b/\x18N\x11\xaa\x17\xd3\x14\xdb\x19E\x18s\x1ec\x1c\"\x1b\x8d\x1d\xd8\x14(\x17\x87\r;\vm\x01\x1e\xff\xa7\xf5\xee\xf4t\xf0\x98\xef\xc7\xf3\xea\xefP\xf92\xf1\xf6\xfc\xce\xf3`\xfd\x95\xfax\xfc3\xfdd\xfa\x19\xf7\xef\xf6S\xf2\xc7\xf8\x1f\xf4\a\x01\xe8\xf2\xff\bK\xf5\xe6\v{\xfe\xf6\f\x89\aA\x13\xe3\fB\x1d\xc9\x14\xa4\x1c.\x1eg\x12\xbd\x18\xd7\x05\x99\f\x01\xfbD\x03/\xf0\xeb\xfb\x84\xe4\x80\xf3\xd9\xdd\xc8\xec\x14\xd7\x11\xe9\xea\xd1\x0e\xe4\xe6՞\xe8S\xd9\x18\xeefڑ\xe8<\xe2\x04\xe5Q\xead\xea\xee\xebF\xec\u007f\xee\xe5\xec:\xf9\x15\xf7\xf5\aU\x06\x84\x13\xc7\x10\x1d\x1cW\x17'\x1e\xa3\x1f\x87\x1d\xe0\"\xab!\xcf$\xa8&\xb8&\x15+o$B+\xd1 V#t\x1f\x91\x18\x17\x1b\x92\x12\xf1\r\xd2\x11\xae\ah\x06\xdc\x01\xf2\xf3\v\xf5D\xe9k\xebS\xe4H\xe8\xf6\xe13\xe4@\xdb?ۈ\xd4:ԗ\xd4q\xd09\xd3:\xd0\xd5\xd1\t\xd3|\xd3)\xd7\\\xdcS\xdd\xf1\xe6B\xe6\xf9\xeb\xd9\xecp\xf0t\xf1.\xf7\x87\xf6W\x00J\xfe=\a\xec\x06\x1a\r)\v\x8a\x12\x85\x0f\xb5\x17\xd9\x14\xb5\x1b\x86\x19\x19\x1f\x13\x1a\x94$\x8c\x19\x8e!D\x16\xa6\x17\xd2\r\xe8\x11@\b\x86\x0e\x84\t2\a6\bQ\xff1\x01B\xfd\xdb\xfc\xfa\xfd\xab\xf7\xa8\xfb\t\xf25\xf7\x95\xf06\xf3\t\xf0\\\xf2\xd5\xee\x00\xf4\xe5\xee\xa6\xf5\x00\xee\xcd\xf6\xd8\xeb\x1c\xfe\xe5\xf2`\b\a\x01\xa8\v|\b\xc9\b\x05\x05\xc3\x04\xef\x00\xa0\x02\xda\x00\xac\xfc\x80\xfdB\xf5\x03\xf8n\xf4\xb2\xf8\x89\xf8)\xff\xb2\xf9[\x03\xfe\xf9)\x02\x92\x00\xf6\x04\xe0\tI\f\x80\x0e\x8b\r\xee\x13\"\x0e\xe9 L\x16$)W \r)\x89\"s%6!\xfb$$!7*\xac!\n)\xb2\"K \x91\x1fX\x1d\xac\x1e\x15 \xc2!4\x1eL\x1fP\x16m\x16\x97\t\xf8\fU\xfa\xe8\x03\b\xec\xb3\xf7\xe6\xe1C\xed\x05\xdeR\xec{\xdf@\xef\xc2\xe6`\xf1\xe8\xeeF\xf2\xf3\xf2\xbe\xf2R\xf6\xdf\xf3\x12\xf6O\xf0\x98\xf7\x91\xed\xad\x04\xa2\xf7\x14\x14\xfb\b\xa3\x1e\x1a\x14\xf2%O\x17\x16-\v\x1f\xb0.\xb9\"\x81*\xea\x1cy\x1f=\x18\x1f\x15~\x15\xdc\x11\xe1\x15\x19\x0e0\x14\xce\x06\xa8\v\x9a\x00\xa1\x02'\x00\xd4\xff\xdd\xfbQ\xfd\n\xf1\x1a\xf4\x00\xee\x1e\xed\xd7\xf2\x17\xf1\xe6\xf3\xf1\xf2\x03\xf3\xca\xee\xf6\xf1y\xeb#\xef\xe7\xe8{\xec\\\xeb\xde\xe7\xa0\xedD\xe6\xd9\xeb\r\xea6\xed\xfd\xee\xef\xf3\xc3\xf0)\xf6\xc2\xef\xe8\xf3\xa8\xf1\xc7\xf3\xce\xf4x\xf7L\xf3\x81\xfd!\xefr\xfe\x92\xeer\xfc(\xf8c\xfe\xa5\x05\xb6\x05\x10\x06\xeb\x06\xee\xfa\xbc\xfc\xda\xf6\xfd\xf6#\xfci\xfc\xa6\xfeK\x03a\xfbt\x00\a\xfe\"\xfd\xeb\a\x19\x00[\n\x8a\x03G\x02\xb4\xfe\x12\xfc;\xf5\xb9\x00\xc1\xf8\x8b\x015\xfd\x15\xf9\x97\xf7^\xf4I\xf1\xf6\xf8\x15\xf4\xaf\xfd\xc6\xfb\x03\xfb$\xfb\xd9\xf4b\xf8c\xf0/\xf4d\xf6\xca\xf2l\xfd3\xfbW\xfaY\x00\xce\xf7\xf7\xf9\xc4\xfcy\xf5\x02\x01t\xfd\xa9\xf7\xbe\x02a\xf0\x90\xff@\xfcD\x00\xd0\b\xf8\t\xb9\x06\xa8\x10W\x03v\n\x81\f\xba\x05\xd4\x12j\n\x9a\f\xab\x0f\x88\t\f\x0fm\x12\x1a\x0e\xf5\x1f\xd8\x16\xe6%\xa5#\xa4%7(\xe1'O%I'\xad\x1d=\x1d\x12\x16\xc6\t\xe1\nm\xf7\xf4\xfb\xe6\xee=\xf3\x80\xedg\xf1\xb4\xedc\xf3\xc9\xee\xd1\xf3\xee\xf4\x00\xf6<\xfb\xa9\xf7&\xf8\xcc\xf3\f\xf1\x02\xec2\xed\"\xe4\x8e\xf0\xd7\xdf@\xf8\x00\xe3\b\x00\xd9\xf1\x19\r4\x02,\"r\x11m0\xe2 K3\x85+\xc61I,\xe2*B\"\xc3\x1d\xaf\x12D\x10\xe2\b\x95\b\x9a\x05\xaf\x02\xf8\x00h\xfa\x91\xfdg\xf4Y\xffR\xf1Y\x00\xa0\xe7\xc8\xf7\x11\xd9\xd5\xe6y\xd1h\xdb\xdb\xd0]\xd8NԎ\xd7.\xd86\xd80\xe2\xc4ܬ\xf0\xd2\xec\xd8\xfa\xd5\xfc\x88\x04a\x06\x9f\f{\v\xd6\x0fs\x0f\x99\x10\f\x15@\x0fp\x16\x8c\x0e\xb8\x12x\x10A\x10\x87\x11\xc3\x12\xef\f{\x14\r\t\xab\x11\xae\n\x11\x12\xdc\b\x06\x15\xc6\x02\x1b\x11t\xfe\xaa\x06{\xfd\x8c\xfe2\xfc\xcf\xfa\x13\xf9\x18\xf5\x93\xf8\x87\xf2\xd1\xfdW\xf3\x89\x03+\xf9S\x03%\xffl\xff\xee\xfe\xfd\xf8\x06\xfa\f\xf5h\xf4\x9d\xf3t\xf3\xa8\xee\xe2\xf06\xe8\x03\xeb\xfb\xe6!\xe7\xdb\xee\x88\xed5\xf5\x9c\xf7\xef\xf6\xd8\xf9\xb1\xf9v\xfa\x89\xfe\xc3\xff\x98\xfe?\av\xfc\xc7\x04\xe6\xfe\xb2\xfd0\x039\xfd\xc8\a\xf9\x00\xdb\b\xaf\x01m\f\xbb\x02\xa3\x12\xd9\b\xa6\x14\xea\x0e\xf8\x10^\x11\xb5\x10`\x11,\x16\xf3\x12N\x18\x94\x13\x9e\x14%\x0f[\x0f\xe2\b\xd6\x0f\x04\a\xdd\x0e\x0e\a\b\x04S\x02\x17\xf9<\xfc\xf9\xf6\xcd\xfa\xcd\xf8\xc1\xf9\xa2\xf7\xc7\xf5\x93\xf4\xed\xf5\x17\xfa%\xfam\x04\xd9\xfe@\x04\xde\xff6\xff\xb7\xfb\x1f\xfe\xc9\xf9w\x04e\xfdu\b\x8b\x03\xed\x00\x02\x05\xd6\xfb$\x02\x8f\x03\xaa\x05&\f\x90\x0e\xc6\x0f9\x14\x06\x11%\x15\xa6\x10\x14\x10\xb6\x11\x05\n!\x10t\tw\rz\v\x8d\t\u007f\nP\x03q\x04\x9c\x00\xc4\x014\x02\t\x04\xd4\x06g\x06`\t\xc3\x06\x02\n\v\x05\xd4\x04\xec\x01\x8c\xfb\v\xfdQ\xf9\x18\xf8\xee\xfa\xbf\xf6\xbd\xf8\xb4\xf8\xf0\xf5/\xf8Y\xfb\x9d\xf8\x94\x01\xad\xfbR\xfd[\xfe\x8c\xf3\x0e\xfcL\xf1\x9b\xf9\x8d\xf2R\xf80\xedy\xf2\x8b\xead\xef\xfc\xed%\xf0|\xf2=\xf2\xe4\xf0.\xf3c\xe7b\xee\xf0ݚ\xe7_\xd9_\xe3\x9f\xd7C\xe1\xcf\xd7Y\xdfo\xde:\xe1~\xeb\xff\xe8\xc9\xfb]\xf6\xf1\b\xa7\x02\xf3\x13\xfb\tt\x1c\x83\x11\xba\x1ei\x12\xb3\x1f\xdb\x0e\xb3#\a\x12\xf1&\x92\x1a\xef(!\x1fz%M \x1e\x1eV\x1f\x9a\x1d\x85\x1b\xa1\x1e\xb6\x15o\x16H\x0f\xdd\x01v\x04\t\xef\x04\xf3\xca\xe3\xec\xe3\x03\xd9\xee\xdbM\xcd\xec\xd8\xfcƻ\u05c9ɠ\xd8;\xd1b\xdc\xfa\xdc\xf8\xe2%\xeb\x1d\xebp\xf9\xa1\xf4\x06\x05\x94\x01\x1c\b\x9f\b\x8d\br\x06\x12\x0eB\a\xec\x17\xed\x12\xc7\x1ea\"w \xf0)\xd3 1,@\"\t+N$\x14(\xac\x1f,#3\x15F\x1b \x0e\x97\x11\xb3\vW\t\xdd\x06\xa2\x04\x05\x04L\x00?\x06\xdc\xff}\x0f\xb3\x04\xc5\x14\xf4\x06A\x0e\x18\x02P\n@\xfd|\x0e\x10\x00p\x14\xfc\x05\xaf\x14\xd6\b\xe0\x13\x1b\n\xf4\x18\x84\f\r\x1f\xf7\x12\xf5\x1b\xf7\x1aP\x15\u007f\x1ap\r7\x14\xf9\xff\xbd\t1\xf2\xf3\xfb\x91\xe4\xbc\xf0\xff\xd9\xcc\xe9\x1bӪ\xe2(\xd3x\xdf\xc8\xd3\xd8\xe3\xfb̖\xde\xf0\xce&\xd7\xf7ݑ\xde?\xeb\xc3\xec\x8e\xf4\xc7\xf0\x1a\x06\xaa\xf5\x0e\x18\xa8\x06\xc8\x1a\xc4\x12\x19\x18\xc9\x14\x9e\x18\xa4\x15\x1e\x19e\x17p\x17q\x15\xf0\x13\xfb\x14\x97\x11c\x17X\x119\x1bg\x12M\x1c\x8d\x10\xee\x15\xb7\x03x\t\xbc\xf3\xd1\xf7w\xeb[\xeb\x03\xe4\xd3\xe4n\xda:ܟ\xd2\x00\xd2zҫϗԏ\xd4\xfe\xd1%\xd7\xec\xd6b\xd8\xf4\xe2\xd2\xdc\xd6\xeb7\xe1G\xf3\xce\xe76\xfb\xe7\xf4j\x05\xe7\xffR\v\xd8\x03\xcc\v\a\x03m\r\x98\x05\xc5\f\xa0\n\xaa\n;\x0fO\v\xe2\x14\xe1\x10\xf6\x1a\xc0\x16\xe3\x1e\xe7\x15\xb0\x1c\xc2\x10,\x18O\t!\x10\xae\x014\x03\xcc\xfc\r\xf8\xc5\xf9\x11\xf5\x8f\xf9Z\xfa\xf2\xfc\xc8\x02\xb2\x03\xb3\na\b\xb0\r\xef\a*\b\x14\b\x04\x04\xc1\b\xfb\x04\xe7\x05g\x05\x8c\xfd\x04\xfe\xc8\xf8c\xf6\xa9\xfb,\xfa\x1f\x02m\x05Q\a\xfa\r\x05\v\x1e\x11\x90\x0f\xa9\x12\x1a\x12\xee\x13\xc0\x0eD\x116\x0eM\n\x91\rm\x06\x85\x04\xa1\x04~\xfa\x98\x00\xae\xf7\xeb\xfb\x8b\xfch\xfdR\am\ad\x0f<\x0f\xa0\x11E\r\xc8\r\xc0\x04K\x06\x00\xfcE\xfe\xf9\xf5\xb3\xf5\xc1\xf0|\xee+\xed\x8f\xe8\xae\xebv\xeaW\xee\x1c\xf4\x0e\xf7\x9f\xfbc\x02`\xfeK\x06\xa4\x03a\x03\xc7\n\xda\x03F\bA\x01\xde\xff\xe1\xf9a\x01L\xf7\x89\f\xff\x02\xcf\x11#\x10\xff\r\xfe\f\xc5\f\xe8\ba\ft\r3\x02T\x0eb\xf6\xd1\x00\x10\xf7\xe0\xf6L\xfc`\xfb\x03\xf9\x89\xfd6\xf3\xcb\xf7\xea\xf5\x98\xf7\xc0\xfa\x06\xfe\xeb\xf7\xa9\xfc\xfd\xef\x15\xf4G\xe6J\xec\xf1\xdd\x01\xe6\xf7\xd5\x1e\xe2\x17\xd5\xf3\xe1z\xde\xfa\xe5\xaa\xe2\xe4\xe7\x98\xe3`\xe82\xe9|\xeb\xbb\xf3\xc9\xf4\xa0\xfe\x9d\xfeo\b\xfa\x01u\x12\xa4\b\xc5\x13\xea\x10q\x0e]\x11\x12\r\xc4\v\x86\x11\xe9\fL\x14\xfc\x11\x8e\x10\x9a\x10\xe9\f\xf0\n\xd1\f\xbc\n\x9c\v\xa9\x0f\x98\b\xf2\f-\x02\x95\x02\x06\xf9f\xf7N\xeeX\xef\xae\xe3\xe0\xe6U\xe1\x1f\xe1\xb7\xe3z\xe4M\xe4\xeb\xe8>\xe6\x11\xe9\xd0\xe8\xd9\xeb\xd4\xe7E\xf1\x01\xe3l\xefo\xe8\x9c\xeaN\xf6V\xf2\x0f\xfd\x90\xfeB\x027\x00\x04\x0f\t\x05\xe0 \x1b\x16\xcb-\xe0&\x8c5\x04-\xac;7.:=:3\xd07\xe85\xe8-\xb8,m&\xc9 '\x1f9\x1e\x0e\x13p\x1a\xac\t\xe2\v\xbf\bw\x03\x1b\a\x85\x05d\x03\xfb\x04\xdd\xfce\xfe\xed\xf6j\xf6\x99\xedH\xf03\xde&\xe3\x03\xd7\x05֦\xdb\x10\xd9k\xe5\xb3\xe5\xc6\xee\xdf\xed\xf7\xf5\xcd\xefo\xfeS\xf8I\x03\x9f\x04\xa8\x01\xc4\x02\x9c\x02W\xfa`\x05\xd0\xfb\x94\x01!\xfd\x1a\xfc\x10\xf6\xb9\xfe\x91\xf5=\x06\xa1\x04\f\v\xc2\x10\xf0\r\xd7\x10\xbb\x12L\x11\xb3\x16,\x19\xec\x14\x1d\x1e\xf1\x12\x06\x17\xfb\x12\xf0\x0e\x82\x10\xa9\v\xc0\n\xe4\x05\xca\x02\b\xfd\xf4\xfb\x02\xfbz\xf8\xf6\xfc\xed\xf7\x84\xf9\xa0\xf8\x93\xf5H\xf0U\xf4\xd9ݦ\xea\x12˪\xd7\x12\xc1\x95ȝ\xbfpś\xbd?Ƽ\xbc\x96ã\xc6=\xca\x00\xdakޔ\xec~\xee\x99\xf9l\xf4\x1f\x05j\xfb\xf0\x10*\t\x87\x17\x85\x12\xec\x19\xc0\x11\xa9 X\x17\a.\r'\xfa:H7\xc1<\x10>\xed6\x80;\xba4\x05;,4\xa89\xc53P4\x99.5+)\"i\x1dY\x13y\x10v\a\x1f\x06\x06\xfe\xb0\x02\x8d\xf4;\xfe\xcb\xee\u007f\xf5\xd0\xecz\xf19\xeci\xf3Z\xe9\x13\xf2\xf3\xe5\xd8\xe8\x05\xe6-\xe3\xfb\xe1\x1f\xe2\x86\xddy\xdew\xe2\xe2\xdfM\xeeE\xee\xfd\xf8\xdf\x00\xec\xfd6\b\x83\x03\xea\at\f\xb0\v\xf5\x10\xbb\x12\x82\n\xe2\x12\x01\x06\x0e\r\xd6\rQ\x0e`\x18p\x17\xd8\x1a\n\x1b\x85\x1a\x18\x1a1\x1b\x1b\x1c\xc8\x18\xa4\x19\x0f\x0e6\x11i\x02=\x06\xbd\xff\u007f\x01\x06\xfd\xc9\xff\xc9\xfbC\xffm\x00F\x04\x05\x02\xc3\a\xac\xff\xe1\x035\xf9\xf9\xf8a\xf3m\xf1:\xefb\xed\x00\xe9(\xe8\xbf\xe5m\xe3Z\xe9\xe9\xe1\xae\xf4\xc5\xea\xd7\x01|\xfa\x19\r\xd4\x05+\x18\xf5\x10\xa0\x1f\x88\x18\u007f#\x94\x16\xc5 O\x11]\x17\b\f\x03\x0e\xa2\a\xe6\aU\x05\xe9\b\x8e\x06W\r\xa3\x0el\t\x1e\x16\xaa\xfe\xc9\x0e$\xfa\x15\x05\xbe\xfd\xef\x04\x85\xfd\xc1\x00\xf8\xf2\xea\xf5\x1f\xe8U\xee\v\xe8L\xf2>\xe9\xe6\xf5\xc5\xeb2\xf4\x89\xf4a\xf8#\xfb/\x01\xd7\xfc\xaa\x05h\xfax\x01\x10\xfa\xf3\xfcZ\x02\xe8\xfe\xc2\v\xf7\x06\xcb\x10\x8a\x0e\x94\x10\x15\x12\x8c\x10&\x11\x93\x11\x03\x11|\f\xfe\x11-\b\x9f\x0f\xa1\x0e1\x11$\x16Y\x16\xa0\x11n\x13T\f\x15\n\xd5\x0e\xd8\x04e\r\xc0\x02\xf5\x00\x90\xfc\v\xf0\x9e\xf1\xb4\xe3#\xe9A\xdc\xca\xe5\xa8\xde\xfa\xe6\x0f\xe9\x01\xefI\xf1X\xf3\xad\xf6y\xf0\xa3\xfb~\xf3\x93\xfe\xce\xfb\xde\xfb*\xfeF\xf6z\xfbC\xf4\xd9\xf8\xa8\xf8G\xfa\x87\xfc/\x00\xf2\xfcA\x04\xe6\xfc\x1a\n;\xf9\xe9\t\x88\xf6\x81\x02\xc3\xf21\xfc%\xf1\xd7\xf9,\xf2\x8c\xfb\xd2\xed\xc1\xfau\xeb,\xf8\xdd\xee\x85\xf4\x19\xf9\x82\xf5%\x02\xac\xfd\xd1\x00\x9b\x04I\x00Y\x06\xb5\bK\x05g\x13a\b9\x18\xcb\fL\x139\x0e\x93\vq\v\x99\t\x98\n\xf1\x05D\a\xa5\x04\xfc\xfd\xd0\x06\xd4\xfb\xa1\x03\xc6\xfc\a\x00y\xfe\xad\x00\xe6\xfe\x99\x064\x01\xb7\x05\x1f\x04R\xfdi\xfeS\xf9N\xf6\xa2\xf7\xeb\xf2-\xf5P\xf16\xeb\xd2\xea\x1d\xdf\xdf\xe3[\xd9U\xe0\xa1ֻ\xe1\xdb\xd8A\xe5s\xe1z\xea;\xec\x8d\xf4\x91\xf6\xdd\xfc\xdf\x05\x01\x04\xfd\r\x99\f\x02\x0f\xf6\r\xf1\x12#\x0f<\x1bu\x17\xb7)k$\x8f2D,\xa1701\xba=\xf76\xa4C>:\xeeB$=}9\x945(/t)q%{!\xf4\x1a\a\x19\xaa\x12\xb6\x0f\xb6\x11\x8b\rE\x13\xec\x13\xd9\fI\x15\x82\x00\xae\f\xfe\xf6\xcc\xfd\r\xf4\xcc\xf4\x90\xf0\xe4\xf2\xbd\xe4y\xeex\xd7G\xe1w\xd4\xd5\xd6\xdf\xd5A\xd9\x12\xd3\xfd\xdchӜ\xe1\xe4\xde\xc3\xec\xfd\xed\x83\xf8o\xf3\xa3\xfcy\xf2\xd7\xfb\x1c\xf9\xe6\xfe\x8e\x06<\x05\x01\x11r\r\x99\x12G\x12n\x12U\x0f\xb8\x16\xf2\r\xeb\x1b\xad\x14\x83\x19:\x1c\xcb\fk\x14(\x02\x90\x02\xa4\xfex\xfa\u007f\xf9u\xfa\x1a\xee,\xf6}\xe5y\xea\xef\xe8\xba\xe3\x82\xe5\xc3\xe5?\xd47߹\xc3r\xd2ѻ\x95͍\xbc\xac\xcda\xbd\xa4͑\xc11\xccb\xcce\xcfK\xd7A\xd9\r\xe0\xfa\xe1\x19\xe5\x94\xe6\x92\xe8\x97\xe9\x99\xec\xbf\xebx\xeb:\xef\xb5\xe8f\xf3\x13\xef\x9f\xf7\x99\xfd\xe5\xfeQ\n\xfa\a\f\x10\xc7\r\x9a\x16\x8a\x12\x96!\"\x1bp)\xfe\x1f\xdc+>%\xd3%\r&I\x1a\x81\x17 \x13\x8b\b\xd1\x0fn\x05\x8f\v\xa4\b\xe2\x04\xf3\x03\x87\x01\xc8\xfbX\xfd\xc2\xf8/\xf7k\xf8\xc4\xf5#\xf7\xae\xf5\xd8\xf5\xd3\xf5D\xf6\xd9\xf1\xdb\xf4\xfe\xee\xd8\xedv\xf9\xcd\xef\xde\b\xce\x00\xf5\x12\xfd\r\xf0\x13Z\r\xa7\x16\xb9\n\xb2\x1f\x02\x15\x1a!6 \xe0\x1c\xac\x1f\x05\x1e\x17\x1ed\"\x89\"\xd4\"\xba#\xde\x1f-\x1dd%l\x1b\f/\xa2#\xdb/\xe6$\x03,\xeb\x1f\xfe'u\x1e\x04'O\x1e\xea$\xdd\x1d\x0f\x1b[\x19\x8f\x11@\x10Y\x0e\x8a\az\v\xbd\x04\xa7\xffJ\x03\xc5\xf1\xc9\xfb\xad\xec\xd6\xf2\xc1\xec\x83\xeeK\xe8\xc8\xea\x97\xdd\x0f\xe7\xc0\xd7\xc8\xe2\xbc\xe0\v\xe2S\xec\r\xebD\xecV\xf37\xe8\x84\xf0j\xef\x89\xee#\xfaC\xf8\x92\xf7\x0f\xfc\x1d\xf4\x9d\xf5\xa2\xf6\xbe\xf4\x11\xf9@\xfb\x9b\xfb\b\x01\xa3\xf9h\x00\xdf\xfal\xfd-\x05\x86\x025\x10\x00\x0f\x86\x0f\xd0\x13d\b3\v*\x10\x11\t\xc2\x1c\x96\x14\xc7\x17k\x16\xe1\ni\t]\a\x1b\x00q\b\x80\x00!\x03\x15\xff\xb5\xf8\xdd\xfaj\xf1\xe2\xf5\xe0\xeb\xdf\xedb\xe0N\xe1\x9a\xd5[\xd8>\xd4c\xd6d\xd9\x10\xd6\xe6ٕ٩\xd4\xf0\xdb\xcd\xd60\xe0\xa7\xe3\x13\xe9U\xf4\xd0\xf6\x11\xf9s\x03r\xf1\xb7\x00\x1e\xef\x80\xf6\xa7\xf6\x9c\xf6\xcd\x06\x16\x06\x99\x10\xd8\x12\xca\x13\xa0\x15\xe5\x19\x1b\x19z\x1fE \xb5\x1e\x18#\xfe\x184\x1d|\x17\x15\x1b\xeb\x18I\x1c\x1d\x18\x9a\x18\xe9\x12\xe5\x10\xbd\x0e\xf2\nq\x10\xec\vU\x11\x04\f\x1c\f\xf3\a\x8c\xfd\xfa\xff\"\xf4\xf4\xf5\xe4\xf3s\xf4\xc1\xef\xd7\xf4\xcf\xe7\xfe\xec\n\xe6\xc9\xe2\x93\xeb\xa2\xe4\x05\xea\xa1\xeb\xb8\xe3_\xe9\x98\xe5\xf3\xe5?\xf1B\xecN\xfdY\xfap\x00\xeb\xff\xcd\x04\x15\x00\x96\t\x9b\x03.\x05p\x06\xc5\x00,\x04A\x06\t\x01\xf4\x0f\xe0\x06\xf5\x11U\x11\"\x0e\x1d\x18\x93\x11\xf9\x16\x14\x1a\x0e\x16z\x1ds\x15\xa1\x18\xda\x10\xfd\x10\x06\n[\x10z\x05@\x12A\a\x81\x10\x94\a1\x0fx\x03=\x11\\\x032\x13\x91\br\x130\rx\x11\xbb\f\xca\x10\x03\a\xbe\x10\xec\x05\xe9\b\xcc\x01\xc7\xfft\xfa\xf9\xf8\xe7\xf9\xe3\xf2\xfb\xfa\v\xeei\xf9\xdc\xea|\xf6\f\xef%\xfbK\xf7\xc8\x01\x90\xfe_\x02f\xffc\x00j\xfdj\xfe\xe8\x02\xf3\xff\x17\x05\xbd\x00\x1b\xfe+\xff\xcc\xf5}\xfb\xde\xf8\x91\xfa`\xff\xf2\x01.\x01\xf4\x05I\x022\x06O\x03\xf6\x05\xd4\x04\xde\x02<\x06\xa4\xff\xaf\x05l\x00Z\xff2\xff\x9b\xf9\xf7\xf9:\xf9\x91\xf8\xad\xf8\xdb\xf9\xc7\xf6\xff\xfa\x9e\xf7\xbf\xfa\x8f\x02\xab\x01\r\x0e\xbc\n\xd6\fa\x05y\t\x01\xfca\a\xf7\xf9+\x01`\xf9F\xfa\xd4\xf6\xd8\xf1O\xf5\x00\xec\xb1\xf2S\xef\xc7\xf2h\xf6m\xf7h\xf7\xf4\xf8\xe3\xf7\f\xf6-\xfb\x13\xf5\xf9\xfb\xcf\xf5[\xf8\xc0\xf3\x82\xed\b\xee\xf3\xe3\x95\xea\x1c\xe6D\xee\x18\xea\xcb\xf1\xdb\xe8\xf8\xf0=\xea\x8a\xef\xa3\xf0K\xf5\xe3\xf3\x8b\xfcG\xf5\x02\xf8\xbb\xfb\x8f\xf2\n\x02\xa9\xf6\xf4\x06*\xfd\x16\x05\f\x01~\x00_\xfee\aP\xfd:\x16 \t\xb8\x19\xa2\x18\x1e\x11P\x18\xc2\x11\x80\x11\x1f\x19\xc3\x14\xf6\x12\xcf\x13\xeb\x00\x8a\x05X\xf5S\xf9\xe4\xf2V\xf9\xaf\xeb\xcf\xf5=\xe7\b\xea\xb6\xec*\xe9\xe8\xf4v\xf2\xeb\xf3T\xf7\xf5\xeed\xf3\x18\xf66\xf2K\xfdT\xf8s\xfb\xd8\xf9\x02\xfd\x1c\xf8H\x00o\xfd\x9c\x06A\x02\x8c\r\xf0\x05S\b\x9b\a\xfa\xff\xe0\x02C\xfe\xd7\x00g\x01\xb3\x06L\x06\x86\n\xa5\x06\xab\n\xae\tQ\v|\x15\x06\x0fD\x1e \x16&\x1d\x85\x16@\x1a\xab\x12h\x1b\xfb\x12(\x1d\\\x17\xbb\x1d/\x1d\xbf\x1c\xde\x1e!\x1f\xa5\x1d\xcc#\x10\x1e|\"\x14\x1e{\x1a\x9c\x18S\x13\xa9\f]\x12\xac\x06\xe3\x0e\x9a\x06\x83\x04\xb3\x02\xf3\x00\xc2\xfeG\x03x\x01\xbe\xfd\xfb\x04\x11\xef\x9a\xfc\x8a\xe6o\xefk\xe41\xe9\xfeܦ\xe1s\xd5\b\xdba҄\xdb;\xd7\a\xe0\x90ܢ\xe2z\xe0P\xe3\xca\xe4\xcd\xe7\x88\xe8\x1c\xee\xd8\xf3\x9f\xf5\xe9\x03\xc5\xfe\t\x12\xa9\r\xc8\x1a\x02\x19\xc5\"\xd2\x1aW0M!\x96810K2\x066\xac)[+\xf8&$$\xd4\x1fW#A\x11\xac\x1c\xba\xfd\xb5\n\x11\xf2B\xf8\xe2\xe8\xcf\xefq\xd8|\xe5c\xca\xce\xd7\xf7é\xce\xc5\xc2\xcb\xcct\xc0M˟\xc1\xc6\xc9\x13Ǡ\xcbi\xc6m\xcam\xc0\x9f\xc5\u007f\xc5\xc9\xc6\xf9\xd7\xef\xd3u\xeb\"\xe6\x04\xfc0\xf6L\r\x19\x06\x12!\xeb\x17{2{'\xe8?h2\xa6D\xec8\x90?\xed:\x938*9\xaf7\xbf6\x05:\xc35\xa52\x953!*\xf8.n'd,\xba!Q(\xb1\x0f=\x1b?\xf7}\x04\xe2\xe71\xf1Z\xdd\xde\xe4/\xcf\xcdճ\xc0\xe7\xc5d\xbc\x99\xbf]\xc7\xc1\xca\n\xd6\xe7\xd7j\xe3n\xdc\x19\xedN\xe4\x8a\xf2\xbd\xec\xc8\xf6\xa0\xefw\xf8\x8a\xee*\xfc\xe8\xf0\xef\x03-\xfb?\x0e\x9f\b\xdc\x19\xb4\x12\\(\xa7\x1e\x949N2sC\xd3?%?\xc9=\x9c3\x1a2W.\x19(\xd7'4\"\xff\x13\xc0\x16\xd1\xfd\xe3\x03\xf3\xf5\x06\xf7\xce\xf7D\xf6\xe7\xf6\x1a\xf7\xa6\xec\x98\xf4\xed\xdf\x06\xee\x16\xdbf\xe4:\xd4\xdc\xdbe\xcc4Ҫ\xc5h\xc8ʿ\xea\xc1\xc0\xc4\fƱ\xd3\x14\xd5\xc6\xe7\x8d\xe5\xf8\xfc\x04\xf4U\n\x82\x00~\x12\xd7\n5\x18%\x0fz\x17\xd1\f\xdb\x14\x8e\fr\x12\xd7\r\x95\x14A\x12G\x1c\x89\x1c\xc7#\x12%))7)%(\xd5)'%\xc6*\xae\x1f\x1c(7\x15\x93\x1bQ\x04t\fY\xf0\xc9\xfe\xf7\xe9\f\xf2\xc1\xed2\xed\xa9\xecW\xef7\xe1\xd1\xec\xf7\xd7\x02\xe5\xcf\xdf^\xe4\x05\xe9\t\xed'\xe9~\xf2?\xeao\xf1\xd1\xee\x9e\xf1\xc8\xf1k\xf0\xc8\xf5\x06\xee>\x00]\xf6\x06\x10\xcf\t\xa4\x1d}\x1cZ&&%H,\x99(\x191\xa0,\xd08$2Z;\xb95\xf43\xa0.\x97)|\"\x19\x1d\xa6\x18i\x14C\x14\x1b\x0e\xd6\x12\xf7\x06\x0e\x0f&\x04\xee\vw\xfd\xcb\b\x83\xef\"\xfe\xa6\xe4\xcd\xef:\xdb\xec\xe5\x99\xd4T\xde&Իש\xd1cж\xcb\xd8\xcb\x02\xc7+\xcc\xffΣղ߇\xe5\xc0\xeb\x8f\xee\xef\xefH\xed\xc9\xf59\xf0\x8b\xff\xf9\xfc\xf8\x04_\x03\xb2\v\x80\x03+\x17\xc6\b%!\xfd\x14'&\x8c\x1c\x1c(\x1f!M*L'\x0f-\xad)\xb6-\v%\r(@\x1c>\x19%\x13\a\b\xec\a\xb5\xf7k\xfb\xbc\xe7;\xef\xce\xda\xce\xe3T\xd2\t\xdb\xc8\xcb:ض\xc1\xa1\xd7\xf5\xb7\xa2\xd1\x1c\xb5\x8f\xc8_\xb7\xfe\xc3j\xb8\xb2\xc0\x16\xb6M\xbc~\xba,\xbc\t\xccz\xc5<\xda7\xd6\xde\xe2}\xe1\xd9\xf3\x04\xef\xda\x01\"\x03\xb7\t3\x0e\xda\x12\\\x15\xa8\x1cW\x1d>'I#\xe9+B(\xe7/\xa0,\xb8834Z>09\xcb=\x918\xf28;7\x1b3\x063\x17(\n)0\x13b\x15\xf3\xfd\xc1\xfet\xeb\xdb\xefX\xdb_\xe4\x05\xce\xd3\xd6\xf7\xc3_\xc9I\xc6\xf9\xc40\xd0\xd7̐\xd6\xf9է\xd7\xfbց\xd5j\xd2eգ\xd1\xe0؏\xd5\xc2\xe4\xafڶ\xf6\xc9\xe8#\a\x86\xfcH\x18\x9b\r\xe4)\x1f\x1c\x15>I/\xd8L=DeKaJ\xe0E\xbaF\xf2>\xb8@\xd57~8\x10-\x970|$\xed(\x83#@&y\x1bs!!\x13\xc7\x15#\a\x9a\n\x87\xf5\x12\xff\xc4\xe6=\xedi\xdb\xd9\xdeo\xd8.\xd72\xd5\xf6\xd1\xf4\xcf'\xcdp\xd6Kɂ\xe7O\xd4\xc4\xf9\x8e\xe6\xbb\t[\xf3\x81\x16u\x00\xb2\x1f\xee\x0eS '\x18\xf9\x1b\x0f\x17\xef 7\x17\xd5'\x1b\x1f\xe9(\t\"#\"\x8c\x1e^\x1bZ\x1b\xbb\x1b$\x1f\xb2\x18\xcf \xe8\x13\x8e\x1a\x17\x0ec\x15\xff\xffd\x0e\x12\xed\x99\x01b\xdc<\xf1sԎ\xe4\x95\xd2[\xdd@\xd3{\xd7vհ\xd9|Ӻ\xe0\xa3\xd4\u007f\xe2\x87\xe3y\xe7\x8a\xf4\xea\xf2\xd0\xf66\xf7?\xf2\xa8\xee\x80\xf2\xaa\xe8\xcd\xf3\b\xec#\xf8t\xf0\x83\x06R\xf9\v\x17\x83\t\x99!0\x18\xa1'a$\xf2*\x9b,\xf4.\x05,\xde/-%0+\xe6\x1e\xef w\x18\xe2\x14\xa4\fu\x11\xb8\x06\x89\x14#\x0e\r\x17(\x18\xb9\x0f\u007f\x15\xc5\x00Z\a\xe8\xf2\xab\xfd^\xe4\xc8\xf6Z؛\xed^\xd0.\xe3\x9f\xcbs\xd7!\xc9\rϟ\xc9\xec\xcf\xc2\xd1V\xda\"ݾ\xe6\xa5\xe8\x95\xf0\xce\xf0\x83\xf5\x1f\xf5^\xf5\x01\xf9\xfe\xf1\xca\xfb\xcd\xf15\xfe3\xf8\x8a\x03b\xfbJ\x0f\x03\xff\x80\x16\x8c\b\x1a\x15h\x135\x14\xbb\x1c\xc5\x15\x80\x1f\xe9\x17.\x1dC\x18~\x17\xf6\x13Z\x10\xae\t.\t@\x03:\x02\xee\a4\x038\v\xf2\x05\xf4\x04\xc7\xff\x8c\xfa\x0f\xf8\xd9\xf6\u007f\xf8\x89\xf2\x8f\xfa\xce\xe9\x98\xf6\xdc\xe7\x02\xf0\x04\xf1\xd2\xef\"\xfa\xac\xf4\xb6\xf8\x99\xf3\xd0\xf5]\xf1\xf8\xf9\x87\xf5\xff\xff?\xfb\xcf\x02\xde\xfe\xbb\x00\xaf\xff\xf4\xfaM\xff\x02\xf4\x18\xfc\xd5\xf4\xad\xf9\xa7\xfd\xdd\xfb\xfe\x04W\xfeS\x06\xbc\xfeW\x06\xc0\xff\x9e\n\xb4\x06\xb5\x0e\x03\f4\x12\x8d\x0e\xd2\x15z\x12\xf0\x13\x12\x14\xe6\x0e\xf4\x12\xdd\fG\x0f\x8b\x12\xcb\x11\a\x16\xe6\x16Q\x13\x8b\x16\xc9\x104\x12\xbc\x11\xd3\x10\x9a\x13\x99\x13\xa5\rz\x11\x8e\x05{\n\x89\x00\xd1\x012\x02\x8e\xffe\x03\xa6\x01?\xfe|\xfd\xa1\xfb\x1c\xf9#\x04e\xfc(\x12v\b\x02\x11E\v\x05\x06\x9c\xff\x8f\x00\xa7\xf6\x9e\xfb\xe3\xf6\xa8\xf1E\xf37\xebm\xeaR\xeb@\xea\xb8\xebZ\xed\x05\xee]\xee]\xf6z\xf3\xdb\x03\xfb\xfe\x90\r\x9a\a+\x10\xfd\b\xe9\x11\v\t\xbc\x11\x06\nT\x0eF\b\xce\bh\x05L\x03\xe9\x047\x04\x9e\x02w\a\xa2\x02\xc0\x03\xef\x03\x15\xfe\x05\x03\x0e\xfa#\xfe\xc7\xf6\xf9\xf5\x05\xf1!\xef\v\xe4H\xe9P\xd3\xe3\xe1\xc5\xcbp\xdbsͺ\xdb\x0f\xd05\xddx\xd6P\xdeL\xe0_\xe7?\xea\xf4\xf20\xf2{\xf9\xfa\xf8\xf1\xfb\xe9\x02\xb8\x01\x92\b\xdc\aL\f\xef\nt\x15\v\x12\xf8\x1c\x80\x19\xa0\"\b\x1d\xa9*\xb3!\x015\xcf)\xa2=M2\xa8<\xa73u5\xa4-8.W&\x8d&\x12\x1e\xdd\x1b\x1d\x132\x11\xb0\t\xfb\x05c\x04\xa5\xf7\x14\xfa\x8e\xf0L\xef\x9f\xf0\x83\xec\xd7\xf0\xed\xed\x16\xed\xb2\xef\a\xe4\x12\xedY\xe1[\xebW\xe4\x92\xedB\xe8\x95\xef\x87\xeb\x04\xf2\x98\xeb\xe8\xf5\xcd\xec}\xf8\x8e\xee+\xfaL\xec\b\xfa\x9e\xeaj\xf7\xc8\xed\x8f\xfaB\xf0K\xffM\xee\x1e\xfe\xd9\xed<\xf8\xbb\xf6C\xf9\x8d\x02!\x04e\t^\t\x1b\x0e\xa9\x06\xd2\x0eC\x04\x90\v_\x02\xb4\n\xa1\x00Z\r\xb4\x04I\x12\"\f}\x16\xff\x10\v\x16\xec\x10m\x14\xf9\f\xda\x15\xc1\n\xf2\x15\xef\t\xdd\tI\x03q\xf8\xea\xf3\x9b\xef\x93\xe8\xfc\xe6\xf3\xe4\x99\xdc\x16\xe1\x1d\xd3\x16\xdco\xd0\xff\xd7\x0f\xd2\xd4\xd5k\xd2\xdd\xd2!\xd4T\xd4N\xd6\x13\xd8\xcd\xda/ܻ\xe0\x9f\xde\xc1\xe5S\xdfR\xeb\xec\xe2\x9a\xf1>\xeb\x19\xf5[\xf5P\xf8t\xfc\x1a\x01\xb0\x03\xcd\x12?\r\r\"\xaa\x19T)\xfb%\xa1,\x83+\xfc-\x85*\x12*!'] j\x1f\xeb\x17i\x17\xc3\x12\xa7\x14\x9b\r\xed\x13\xcc\a\x06\x10a\b\x89\v\x06\r\xc6\r\x8f\x0eJ\x10\x1f\vo\r.\x02G\x04>\xfe`\xfcR\xfe{\xfa\xa1\xfe\xe1\xf9\xc4\xfc\xe2\xf8\x9c\xfdw\xf8\xed\x01\x87\xfdr\x04\x10\x04\x8f\t\x9a\a\x11\x12H\f8\x16=\x12\xec\x11R\x13T\rq\x0f\xd4\x0f\xef\n\x92\x11\x91\n\x8a\n\xaa\nQ\x00\x17\x06\x9d\xf9\x9e\x01\xf2\xf5\xc0\xfd\xd9\xf5\xd1\xfao\xf7\r\xfb#\xf96\xfeq\xfaj\x01\x15\xfd:\x03\xc6\xff\x99\x01\xe8\xfez\xfc5\xfdU\xf8\xb0\xf9m\xf6;\xf4|\xf4\xe1\xed\xa8\xee\xfb\xea\xdd\xe8\xe2\xeec\xeb\xb4\xf1:\xf5,\xf4\xcc\xfc#\xf9\x8a\xfe\xad\x02\t\x00|\b\x96\x03\xee\n7\x02V\x13\xbd\x02\x1c\x1a\x80\tP\x15\xdf\af\b\xce\xfd\xe9\xfe\x18\xf8I\xfa\xd9\xf9\x9b\xf8\x1d\xff~\xf9b\x03\x10\xf9\xec\xfe\xcd\xf7\xb3\xf6\x90\xf6\x13\xf2Y\xf7\xef\xf0\x05\xf8\xa8\xf0\xd7\xf3\xbe\xebg\xe8\xd3\xe2B\xdd\x10\xda\v۞\xda\"\xde{\xe7U\xdee\xf0`\xe1\xd3\xed\r\xe6\xe5\xea\x00\xeb\x19\xeb\xa1\xf0X\xee\x06\xf4\xc9\xf2\xc6\xf5\xee\xf3J\xfa\xb5\xf3\xe6\x00\xe6\xf8\xfa\x05\xc4\xff\x15\fy\t\x9b\x0f\x83\x16\x1b\x13\xe9\x1b\xe4\x17~\x19\n\x19A\x16\xdf\x11%\x11\n\x0ec\x0e\x88\x10\x18\x13\xf4\r\x10\x13\x9e\vN\r%\t\xb7\b1\r\x0f\f\x17\v\xac\x13\f\x03\xe4\x0fq\x03\xd2\a\xdf\x02z\x02\xcd\x00\xf6\xfeS\x01I\xfe\b\n\x96\x05\x8e\x0f\xd8\r6\v\xc6\fT\vz\x06\x80\x0f\\\x04\f\x13\xc6\v\x85\x138\x11\xdc\x14_\x12%\x19\xd0\x12\x1e\x19\x17\x10\x83\x17\xd0\r\xcb\x12\xc0\x0f\x0e\f\x84\x0fk\x06\xd5\f\xc9\x02\xe7\a\xf1\x03\xe7\x05\x84\xff\x94\a}\xf6\xe9\x037\xf4A\xfdA\xf3V\xf8\x93\xed\xe4\xf2\x9b\xe6\xb9\xea\x8c\xe19\xe70\xe5\x82\xe9I\xeaW\xef-\xee\xc4\xf0\xe7\xf6`\xef\xfc\xfe$\xf6Y\a\xa5\x00\xa8\x0f\xac\n\x92\x13:\x0fW\x14Z\ft\x13#\f/\x17\xbf\x11\x1f\x1f$\x19\"\x1f@\x1d\x01\x1a\x1f\x18\x19\x16;\x12\xd1\x12k\x10\xbe\x0e\x03\x0e>\x04%\a\xfa\xf1\xfb\xf8\xad\xde\x0e\xe9\xaaԴݹΤ\xda\x1bˇ\xd92\xd0\x00\xdb\xda\xdaE\xe2\x0f\xe6`\xe8 \xee\x80\xea\xf4\xef\t\xed\xb8\xf4\xb0\xf0P\xf9\xa4\xf4\xa1\xfbc\xf6\x1c\xfe\xcf\xf9\x12\xfe\x1a\x00z\x05\x19\nA\x0f\x84\x18f\x19c \xd4!;#\x89\x1e\xb8\x1e\x92\x15\xeb\x14&\x0f\xc4\x0fZ\t\xe9\v\x0f\xff\xfc\x03o\xf5\xda\xfb\xbf\xf1\xf0\xf89\xf0[\xfa\x88\xf0\x14\xfb\xd8\xf1\xdd\xfbK\xeem\xfb\xca\xe7Y\xf05\xdf\xc6\xe1b\xd64\xda~͘\xd8\xf1\xc6\xe2\xd6\x04\xc9,\xd6\xf4\xcf\xfe\xd9\xe1݄\xe3\x01\xeaX\xf2\xaa\xef[\xfb\xd7\xfcH\xff\x98\x0f\xa6\x03\x8d\x1a>\t\t\x19_\v\x9b\x12\x83\b\xd0\x14e\aL\x18\xd8\f\xb5\x15\xee\fP\x15\xe7\f\x1f\x13w\x12`\x11\xf6\x12\xcf\f\xa4\r\xdb\au\x02\x85\x03\xc7\xf9\xbe\xfa5\xf5\x17\xf71\xf2\xb2\xf7\xfe\xf2\x1c\xf4\xba\xf3M\xf09\xf0c\xf0'\xf1|\xf3\x93\xf6\x8f\xf5\xcd\xf8\xb4\xee6\xf5`\xe7P\xeb#\xe6\x82\xe8\x91\xe7\xae\xeb\x1a\xea\xaf\xee\xb0\xf1_\xf1\xd4\xfe2\xfc\xfa\x06\xef\ni\fN\x14\xd1\x14\xe8\x19\x81\x1e\xa2\x1e{\x1f\x9b m\x17\xbe\x1a\xa6\x14\xdc\x12$\x15\xcc\x0fX\x0e\x89\x0f\x90\x04;\f\x0e\b\xdc\t\f\x18\xcc\x11\xe7\x1fR\x1a\xc8\x1c\x92\x18\x17\x16[\x13\xc7\x11\x92\r\\\f\xe2\x05\"\x03\xf2\xfa5\xfa\xd4\xf1A\xf8\xdb\xf0\xad\xf7\x8f\xf5\x00\xfa\x83\xfa\xa1\x06\xe8\x00b\x10~\t\x04\x14\xd1\f9\x108\f<\nm\bg\x04O\x04\xd6\xfb\x17\xff\xc2\xf8\xf9\xf7\xc9\xfeQ\xf93\x05\xd5\x03\xec\x05C\n,\bD\vN\nj\f:\v\x94\nB\x06i\x06\a\xfb\x9b\xfd\x97\xf0\x8e\xf3s\xeb\x0e\xf0_\xea\xd3\xf0t\xef\xce\xf2\x06\xf8\xec\xf9\xc5\xfe\x1a\x03\x19\t\x00\tv\x12\x10\x0f\xfe\x13\xd7\x10\xa3\x0f.\v\xe3\b\xa8\x06R\x06\x96\x04R\x03\xbe\x01M\xf8\xd5\xfa\xe6\xf3K\xf4\x05\xf1\xef\xf6\x0f\xe9\xdb\xf5l\xe9\xc6\xef\x91\xf0\xaa\xf0:\xf7\x9f\xf5\xa7\xf3+\xf5\xb7\xee\xae\xef\xb1\xed\a\xebF\xecc\xe6\xcb\xe9\"\xe4\xcc\xe5P\xe2?\xe3\x86\xe3\x87\xe3-\xea\x0f\xe9\x91\xf0\xfc\xed\xe8\xf4J\xf7\xda\xf8\x9b\x02,\x03i\x06\x98\t\xb4\x06\x86\ag\x05\xb2\x00\x00\x06\xe5\xfc'\r\x01\xff\x85\x15\xef\a\x8b\x12\x8b\x0e\xd0\x0fs\ft\x0f\xa9\x0fG\x0e\xc2\x13\xb0\b\xf7\x0e\xbf\x02\x90\a\x05\x00k\x04\x8d\xf8\x13\x00\xc5\xef\xa8\xf7,\xeb\x9b\xef\x1f\xeb\xb9\xed\xd0\xec\x88\xf1\xd4\xf0D\xf6R\xf5\xb6\xfcF\xfa\x19\x03\x13\xfe\xeb\b \x01\x81\fB\x05;\f\x9d\x06q\a;\r1\a\xbb\x12:\x0e-\x12j\x12\t\x13\xf3\x10\xbe\x1a\xce\x12\xac \x83\x19\xdf\x1aI\x183\x14\xcb\x0fE\x10g\rm\nF\r+\x04\xb5\x03f\xfd\\\xfa\xd3\xf70\xf5\x1c\xf9\xbd\xf1F\xf7\x0e\xf6;\xeeS\xf3\x82\xea&\xeb\x92\xea}\xe9k\xe9\x91\xe7\xaf\xe3\xa8\xe4\xe6\xe40\xe2\x81\xf0\xdd\xe6\xef\xf8\x1c\xf1\xe6\xfd\xd5\xf51\x05\xea\xfa\r\n\xb4\x03\xf2\x0ex\n%\x1c\xb2\x16\xb1$.%\xa0#\xce&\x1b$\x99!\xfa+\xf8\"p.*'R(\x1e\"\x1b#-\x19\x11\x1c\x9a\x13\xd1\nx\f\x17\xeeL\xf8|۔\xe3\x1a\xd4V\xde\xee\xd2\x15\xde7\xd3\xcf\xde\u007f\xd2\xc1ۥص\xd9M\xdf\x14\xdc\xf7\xe3l\xde\xee\xeb9\xe3\u007f\xf4\xac\xeb>\xf7i\xf3[\xf7\xb5\xf7;\xf9j\xfb\xb0\x03\f\x03\xd1\x13\xdb\x11N!\x10\x1f\xdf$\xc2$\xc9%\x85\"\xd5)\xfb!\x82&:\"\xda\x1dx\x1d\\\x14\xc7\x16\x80\x13\xfb\x12\xc0\ri\x0f<\xfen\x05\x1a\xf0\xcd\xfa\x8e\xe7\xc2\xf0\xbb\xeb\x18\xec\x1e\xee\xba\xec\xb4\xe1\xf8\xe5\x92\xd6N\xd86\xd2\xd2\xd2b\xccH\xd2\xdbΗ\xd0N\xd71\xd6\xf6\xe1p\xe0`\xead\xebU\xf24\xf2\x96\a\xc4\xfd\b\x1d\xb4\x11\x8b'\xe9\x1f\x82*\xe9#\xe7)\x82%k#\x05$\xce\x1a{\x1e\x9e\x11@\x17\x99\b\xf2\x0eW\xfeJ\a\x1e\xf8\xd7\xff\x97\xf5 \xfe\xd0\xec\x16\xf8\xf8\xe5\x9b\xed\f\xe8\xac\xec\xf0\xec\x9d\xee\x9e\xe5\xc7\xe9\x0e\xdar\xde\x13ٛ\u05ed\xdc\xeeן\xdb,\xd8\xe2\xdc(\xd6e\xe9*\xdeN\xfa\x94\xf1\xe4\x06\xa6\x03\xd6\x0f\xf3\n<\x1dT\x0f\xad#\x90\x14\xdf#\x8f\x15\xfd#\xae\x18\xc1$\xe5\x1c\x80(r '1j&\xfd:A0P8\x1d3\xfe5./41\xbd.\x9d\x17\xa8\"\x02\xfc\xed\x03\xbc\xefn\xed\xfa\xed\xc3\xeb\xa5\xe4}\xea~\xd8\xd1ߞܙ\xdc\"\xea\xb3\xe7\x05\xf4\x9a\xf1?\xfa4\xf6'\xfaT\xf9\x1a\xfb\xb4\xfb\x97\xfc&\xff\xcd\xf3k\xfbJ\xf0\xda\xf4\x81\xf3L\xf9G\xf7\xff\x01/\xfcB\t\b\x02\x8b\x10h\f\x11\x17o\x18\xdb\x1d@\x1fI#)   \x8d\x1c\xfc\x17\x1f\x18h\x11`\x17\x80\x11\x0e\x15\x97\x12y\v\n\x0e\xce\x01\xda\x05\u007f\x01_\x00-\xfd\xb1\x00\x11\xf3*\xfda\xeb\xce\xf5I\xe7\xbd\xf0\x96\xeb\x92\xeb\x01\xea\xd1\xe4f\xe5\xcd\xdc\xf5\xe8\xc9\xdd\xe7\xea\xd7\xe7\x82\xe3\x8e\xe9A\xdf\x15\xe2\x16\xe6\xcf\xe3\xa4\xeeh\xec\xd6\xf4\xeb\xf5\xff\xf6\xe8\xfa\xa1\xfa#\xfa\x19\x00y\xfb\xf2\a\xed\xfeh\f6\x03\x02\v4\x05`\r\xd2\a@\x10\xc0\x0e\xcc\fV\x10\x94\aG\n\x96\x04\xbf\a\xea\xfde\aY\xf2\xb2\xff\xb7\xe6_\xf5\x86\xe1\xc3\xedM\xe1\xc9\xeb\x95߽\xea\x03\xe0\x9c\xe8\x1f\xe3Y\xea\x87\xeb8\xee \xf4\xe0\xf0\xfe\xfa?\xf6a\xff\x90\xfcp\xff\xd5\xf9\xf4\xffk\xf74\x02~\xf8\xf6\x02\xf1\xfc\xe9\x012\x04\x95\x06\x17\v\xe0\rW\x14\xf6\x12\xef\x16.\x18\xa2\x16\xf4\x1d\\\x1b\xaf\x1e1\x1e=\x19G\x18\x8b\x0f\x86\rk\a\xa0\x00\xce\x00\x04\xf9\xf4\xf3\xde\xf7W\xe7z\xf5\xca\xe1\x98\xf1\xda\xe4\x1a\xf1c\xe5\x1d\xef\x82\xe6\xe1\xeaT\xee<\xeeI\xf4\x92\xf3\xa3\xf5\xba\xf2s\xf7\xf7\xed\x03\xfc\xd1\xee=\xff\x8b\xf5\x1d\x00\xca\xfb\"\x00\xa2\x00\xf2\x02\xd1\x05\x99\t\x84\f\x8c\x0f;\x11.\x14\xc2\x14\xb2\x16~\x16\xf5\x16N\x17<\x19\xae\x15\x98\x1c\xfe\x15C\x1b\xea\x17\f\x16q\x18\xda\x14\xe9\x17\x89\x17\xbd\x19C\x15\x93\x18\xc7\x11\v\x11\xa7\rZ\f\xb7\x05L\a\xf5\xf9\xf5\xfd\x91\xed.\xf3x\xe5\xd4\xea\x14\xddK\xe7\xfa\xd0\xe5\xe4\xe6\xd0\xfe\xe0\x93ܭ\xe4>\xe8\xa6\xeb\xaf\xf5\f\xed\x1f\x01\x92\xf1\x0f\ny\xf9\xb3\x0e\xde\xfd\xae\f\xb1\xfd\x86\n6\xfb&\t\xde\xfdP\x04\x9f\x03\xa6\xff\x8c\x05T\x00o\a\x89\x01\x97\b\xb1\x04\x81\aC\a\xd4\x06\xf5\x04d\x028\xfd\x8b\xfdX\xf0\xc1\xf7g\xe4\xf7\xec\x96\xdc\xee\xe5\x91\xd1\x04\xe3b\xc8\xe8\xddn\xc7\x15ܲ\xca\x1a\xdfwϐ\xdfF\xd9'\xe1I\xec4\xeb\xe0\x01\x18\xfa\x10\x14>\a\xa5&\xbf\x13\xe94\x9b \xc6;\xc9*\xfa?j1\xbeD\xca6\xdcD\x1c:\xda<\x1c9%2\xf63\xcb&((f\x18\x81\x1b-\x05\x0e\x0f\xfb\xf3\xb2\xff&\xe8\xc1\xf2\xaa\xd9\xce\xe6C\xcac\xda<\xc06\xd0\x18\xbb\x05\xc8;\xb9&\u0094\xba\xe5\xc0\xa5\xc3qňϵ\xd0\xc1\xd4\bڨ\xddd\xe0\xf8\xf0\xbe\xed\xa8\x04\b\xfeg\x15x\vc#\x90\x1a\x88-\x19&k7E/\x03=\xa76q?#7\x9eCB9\xd2> >\x9b.g8\xed \x18+\xa4\x18b!\xf8\n\xa5\x18\r\xfb\x12\v\xfd\xf2O\xfdz\xef\x87\xf4\xc2\xe1P\xe8\xde\xcfn\xd3:\xca-\xc7\xceźĊ\xbf\xbc\xc1\xc0\xbe=\xc0D\xc7\xfb\xc5\xc6\xd3Rӡ\xe0\xe7\xe4\x19\xecp\xf2\xac\xf7/\xf9\xf1\x03\xbc\x00G\n\x05\a\x9f\x119\r\xf1\x18P\x16# y\x1d\x16*0&\xae4\x84.z@\xdb5\xceF\x14?\x96FNE\x0eA\x11B#7`6\xf1) (\xfe\x17\xc6\x19\x97\x04\x15\n\x9d\xf4[\xfa\xcf\xe6^\xf0\xd2ڐ\xe6\xdbԪ\xdc\x1a\xd2\xc5\xdaJ\xcbF\xd8[ȓ\xd1Y\xc8\x05\xcfQ\xc3c\xccj\xbf'\xc9&Ƴ\xcc\xcb\xd44\xd9(\xe5\xd7\xe8\xce\xf9o\xf7{\x13\x90\t\xee(1!\xb37\xd72LF`;\x05MvB\x89NoB(L\x9b>\x01D99\x83>\r4'4t2\xd9'=+Q\x18_\x1c\xd0\x06\xee\x0ez\xf6\xef\x045\xdf\v\xf6\xcd\xc9rᄼ\x1bΙ\xb0\xd9\xc1\x01\xaa\x96\xb9\x98\xaca\xb5\xc0\xb44\xba\xa4\xbf'×\xcd\xd5\xcd\x01\xdep\xd9D\xeb^\xe6M\xf76\xf3G\ac\xff`\x16\xe2\v^!\xb6\x13\xc5)\xa7\x1a\xec0\x01$\x927\xb5-F7\\6\x183T6\xbb1\xf22\xf8)l1\u007f\x1f/*\xbe\x15d!\xe5\n\t\x16\xd1\xff\xc9\x05\\\xee\x06\xf5\x18\xdeU\xe3\x0e\xcf\x10\xd7\xc8\xc1+\xcd\b\xbeg\xc4+\xbe\x01ð\xc3.\xc9A\xcf\"ӂ\xdbL\xddx\xe8\xf5\xe2X\xf2\xea\xe7;\xf7\xa0\xec*\xffd\xf0d\x04'\xf8\x17\x04\xc6\xffD\x03\xee\x04\x97\x03M\a\xb2\b^\n\x92\x0f\xeb\x12\xac\x14H\x1aI\x18X\x1a\xc6\x19g\x15\xe7\x16w\x11\xdd\x0e1\rL\a\x16\x05\xe8\x00\xd9\xfd\x89\xf9\x86\xf9\x06\xf2\xac\xf5\x99\xf1\xfb\xf6|\xf6g\xfb\x0e\xfcB\x00a\x018\x04\a\x06(\x05\x94\x06,\x05\x04\x06_\x03\xe1\tg\x04\xa9\n\x9b\b\xc8\vL\n\xc4\x0e7\r\xdc\x0e\xad\x10\x84\r\xab\x10\x90\x0ep\x10y\x11\x8b\x13\x80\x11\xe9\x10\xa4\r\x14\b<\x04\t\xfe\xf3\xfb\xc8\xf3\xf9\xf8w\xef\x94\xf6\xfd\xef\xd4\xee1\xee\xa7\xe6\xa4\xe8\xf1\xe1\xa6\xe5C\xe1*\xe66\xe6\xd2\xeb\xf2\xeb\xf1\xf1\x81\xf5\xb1\xf7B\xfd\x98\x01\xf8\x02c\a\xcc\t\xaf\n+\x0e2\r\xf7\x10\xee\x10\xbb\x10\x1d\x14\x9c\x11+\x13&\x16\x14\x13O\x1a#\x199\x1e\xe5\x1dX%P\x1fU+@!M--\x1e\x17'Y\x176\x1bR\fF\x0e\xea\xfe\xa4\xfd\x1f\xf4G\xee:\xeaa\xe5\x8a\xe0X\xdf\xd7\xdc!ې\xe1v\u07b9\xe8D\xe4\x1e\xef\x18\xea#\xf1,\xee\xf4\xf2c\xed\xa7\xf3\x92\xed\x8a\xf08\xf12\xf0\x06\xf7\xbb\xf4\\\xfdD\xfa\xd4\a\x94\x00J\x19C\x0f\xc2)G%T-s1J0\x9d/\xc55\xf3/\xae1\xc3/\"'\xdf$\xbf\x19'\x16T\rt\n\x98\x01\xea\xfff\xfb0\xf9Q\xf9\xd6\xf9o\xf1\x84\xf9\xb9\xe5\r\xf5\x04\xe0h\xee\xb5\xe0\xb4\xea\x10\xdc\xea\xe51Ֆ\xdcz\xd0bհ\xd2d\xd3Nخ\xd5\xf8\xde\xcb\xd9\xcf\xed\xee\xe45\xff\xd1\xf7Z\n%\n\xa1\x10\x8a\x10\xaa\x18\xc6\x12\v\x1d\x98\x19\xc5\x1c2\x1d\xb8\x1b\xac\x1c\x83\x1cV\x199\x1b\x99\x16D\x13g\x13e\r\xee\x0f\x0f\n_\r2\x03\xa4\v\xea\xf8\xa3\x05\x02\xed=\xf9\xf5\xde\xd7\xe8\xafդ\xd8\xf4\xcfu\xd2\x01\xcbs\xcd7\xcd\xc2ȶ\xcf\xc5ɨ\xd3\xd9\xcd\\\xdb\xff\xd5\x18\xe1\x80\xe0\xdf\xe7\xe7\xe8-\xef3\xef\x10\xf4\xfe\xf5Y\xfa\x85\xfa\xa9\x03\xce\x02\x9f\x10\r\x0e,!\xda\x19\xd3-b'\x066j1\xc0<\x8f7\xcb?N:\x158\xf65\xb2)\x95'|\x1e\x93\x19\x1e\x15\x0f\x0f\xed\a0\x06\xd0\xfbn\xfb\x05\xf6\xf0\xf3\xe6\xf0W\xf1\x16\xef\xad\xeb\xa3\xee-\xe9\xd9\xe8\xeb\xe8\xa7\xe3\xe6\xe6\xce\xe1\x86\xe3H\xe2]\xe1 \xe1\xdf\xde\x01\xe1N\xdf\\\xe7g\xe9\xca\xf1:\xf7D\xfbk\x03\xca\n\xc7\x0e\x1c\x1e\xc3\x1b\x9e)\xfc$\x131\xdf(\xee4+*}4\t*,3a%\xbd0\xa6 \xdb*w!\x98%y\"0\"o!\xdb\x1c9\x1dA\x16\xdf\x16!\x0e1\x0f@\x04\x92\x041\xfc1\xf8\x89\xf2\xa2\xf02\xe6\xe5\xe5O\xdd\xfc\xdb\xce\xd6C\xdd0ѹ\xdd)Φڻ\xcdU\xdb\xcd\xceI\xda,Г\xda6\xd7Pޫ\xe5z\xe4\xb2\xf1'\xef\x8c\xfc\xf6\xf6\xba\n\xe2\xfd\xa4\x18G\r\xec \xe8\x19\xe3'\xb0\x1f!1 (\xa05\a.\xde1f+w*\xd4#='\x98\x1d\xa8\"\x94\x1d\xc3\x16\x01\x19\x81\nB\r(\xff\xb3\x05\x8f\xee\r\xfd\xa7\xdcN\xecw\xd1\x12\xdfO\xc7\xdb\xd32\xbbO\xc8[\xb3\x17\xbeط\x8f\xb9\x87\xbf\xca\xc0\xa0ő\xc8\xfe\xd2\xe0\xcf#\xe3K\xe0,\xef\x9f\xed\xd9\xfa\xa5\xf5\xaf\x04\x04\x02\x17\f\xd0\t\xb8\x14\xe6\x10u\x1eJ\x1c-,[%\xc79\xac/\x86D\x84:*K\x8eA\xa8HBC\x1e>\xa0<\xf3*Y-)\x0e$\x19\xb3\xf0t\xfd\x9c\xd6W\xe4\xfd\xc0\b\xd4G\xb7\xc8\xc9G\xb6\x18Ə\xbbx\xc7\xcb\xc2\xeb\xc9\x17\xcd@\xcf\xfa֣\xd6i١\xd8R\xd7:ת\xd6\xd6\u0530\xdb,\xd9\xde\xe8\xc7\xe4M\xfd\r\xf6\xb7\x12\xa2\fy*r$\x99B\x908HS\xa1Dd]\xb7H\xaa]QG$T\x8fB|FU7\xc55B)=#\xcc\x1b\x8f\x12\xbc\x0e5\t\x81\x06\xc5\x04\x16\a\x1b\xfd\xab\x05\xfb\xf0x\xfc\x00\xe7\xe5\xef\x13\xe0K\xe5\x02\xd6\"\xdc\xc4\xceS\xd3\x15\xcdK\xce6\xcc*\u0378У\xd0\xf6\xdeX\xdc\xde\xef\xa3\xec?\xff\t\xfa\xdb\b\x92\x05\xc7\x10\x15\x0e\x85\x18_\x13I\x1cd\x18\xae \xc6\x19\xdf(s\x1c\x1b3\xeb#\xb89\n*\x93;\x93-\xd0:\xe1/\xeb6\xbc.\xaf/+*b(\xe2\"\xf4\x1d\xbd\x1a]\rz\x11\x80\xfc\xf4\x04\xd5\xf0\x14\xf8\x87\xea\x14\xef\x88\xe3~\xe9:\xda\xca\xe2\r\xd4\xe3܆ֵޤ\xdc\xfb\xe5\xb2\xe1V\xe8\x83\xe5\xbb\xe60\xe78\xe6\x93\xee\x8f\xea\xd1\xf7\xbc\xf2\xdd\xfd\u007f\xf9\x82\x05Z\xff\f\v\xb3\x06.\x12J\x10<\x1dw\x1a\xa1$\xd8$x'\xad'\xf2'\x9c$\xf3$r!^$\xd5\x1c\xa5!\xb6\x19t\x19i\x15\xf6\r\xae\r\xb0\xfe\x14\x06\xfb\xea+\xfc!\xd7X\xee\xad\xc6\xed\xe1\\\xb9\xd7\xd5겐͜\xb1\u007fǧ\xb5\xccč\xbd.\xc8Q\xc7\xc4\xcd\x12\xd7\xd4\xd5+\xe9\x85\xe1\x8b\xf5?\xeet\xfc\x8f\xf6:\x03+\xfe\x19\fG\x06\xe5\x13\"\x0fq\x19\x12\x14\x85\x1e\u007f\x16\xf6\x1fr\x19E$\x05\x1c\xc2,\x92!\xfb.\x10'`(\xa6$\xca \xcb\x19\xbc\x188\x11\x16\a\xfe\aN\xf2\xb8\xf8\xec\xe4\xc2\xe9\x9a\xdbd\xe2B\xcf)\xdb\xe7ǳҾ\xc7\xd1\xd2;\xc8\xd8\xd5\xd1\xc8\xc5\xd7:\xcd\xe4\xd6\xf6\xdaNُ\xe5\xef\xe0\n\xee\x8d\xe6>\xfd\xc7\xf1\xa2\f0\x032\x18K\r\xcb%M\x16Q2\x84'\xf85\b3\xbf5'5\xd19\b6\xfc>W9?;v6;4\x86*\xb60\x1d#\x1e%O\x1e%\x10\x86\x10.\x02\b\x011\xfbu\xfb\xf7\xed\x9a\xf6\x80\xdc,\xec\xf7\xcfO\xe2\xbe\xcc\xfe\xda\xc9\xcf\x05ٔ\xd5\n\xdb\x03\xdd\xd7ܩ\xe4$\xe0j\xeb\xfb\xe3m\xf5\xe2\xea^\xff\xdd\xf7\xc8\aD\x02\x93\x15\xa2\r\xf5#\xe8\x1ej0\xba(\x1f:\xf5+\xdd@\x8d/\xf4F\x104\x16H\xbf6\f@\xb20\x86/\xf5\"U\x1c\x8b\x17\xe9\tt\x0f\a\xfc\xbe\x06Y\xf1W\xff\xed\xe8\x04\xf5\x89\xe3\xe9\xeb\x0f\xe1\xbc\xe6\xca\xdf\x1f\xe3\x03\xdcd\xe0\x99\xd7~\xdc\x0e\xd30\xd9w\xce\x10\u05fa\xcdN\xd8m\xd6K\xe0:\xe30\xee%\xf1\xe7\xf8\x89\x06\xfd\x02\xd9\x1cN\x11\n.v\x1b\xbe9]#xA\xc4,\x90C\x993\xcf>x3E9\xda/\xba2%-[&\xba+E\x1b\xa4$\xd7\x18g\x1c\xd7\x0f\xd6\x15\b\xfd\xdf\x04\xc1\xee\xd3\xf2\xbd\xe3}\xeb\xb4ԉ\xe3_\xc3{\xd6Ķ\xc3̹\xb0\x95\xc8ȯ)Ȭ\xb5\x1e\xc8\x0f\u00a0\xcd=̅\xd5\xf4\xd7(ڸ\xe8\f\xe3x\xf60\xf0\xa9\xff\xfd\xf9F\aM\xff\xc9\x0fq\aM\x1b\xa6\x11T&\xce\x1e./\xe0*\x81751\xe4;M4\xbf8\xb12\x142\x84,k)]#5\x1b[\x19\xa1\a\xc7\f\xcd\xf5\xba\xfe\xa4\xea\x90\xf1\xef\xe0\r\xe7)ح\xe1\xb6Ԏ\xdf\xdf\xd5q\u07bc\xdb\xc4\xdf\x12\xe06\xe2r\xe5\xfe\xe2\x97\xef\xc0\xe6\xf6\xf4\xf9\xed\xae\xf5\xc0\xf0m\xf4\xcb\xee\xb3\xf4\x15\xf0\xa3\xf7\x03\xf8\xf1\xf9\x97\xffp\xfe-\x05\xda\x03\xf5\nK\x06\xc1\f]\n\n\r\xbd\x0f\f\x10K\x11\xae\x0f\x93\x0e\x03\n)\n\xdd\x03\xc1\a\xe5\xfe\x06\x05\xce\xfd\x11\x03\xed\x00\xe7\x02Z\x03\x1c\x03z\x04\xf8\x03?\x03\xee\x03\xbf\x01\xc5\x05V\x03\\\vs\x06\x14\x11\x9e\t\xad\x10\x02\vL\r\x00\a\xad\r\xe1\x02o\r\xdb\x05\x87\a\xc2\av\x03\xcd\x05S\x00\x06\x02\n\xfbK\xfbO\xf9\xae\xf6\xc9\xfb3\xf6\xde\xfeM\xf8\x1f\xffL\xf9\xec\xfd\x0f\xf6a\xfbE\xf0d\xfb`\xf0\x94\xff\\\xf7\xc3\x03g\x00\xd3\x05J\x06'\a\xae\x06\xb3\b\xd4\a\x87\b\x17\b\x01\t\\\b\xe5\f\x17\x0e\x95\b\x83\x11\xd3\xff\x86\n\xb1\xfe\x04\x06\xb6\x01\xd1\v\x0f\x04\x0f\x11\x04\x02\xe1\x0eW\x00\xb1\t\x92\xfdb\x04\u007f\xf9m\xfd\xd2\xfb\x8e\xf8*\xff \xf9H\xfd>\xf8\xe1\xfa\xd5\xf3\xd3\xfbS\xf4\xfc\xfe$\xf7\xc5\x025\xfb\xe3\b+\x02\x86\r\x12\x06h\x11\xb0\x06!\x15E\b\xfc\x13\t\n\xa2\x12\x02\f\xf3\x13\xd2\f*\x0fg\f\xc6\x05\x8d\a\x0e\x01S\x03?\xfeA\x06\xa1\xfb\xbb\ax\xfc$\at\xffO\x06\x00\xfe\x85\x01.\xf6p\xfa6\xf1s\xf5\xb5\xee\xee\xf3\xbb\xea\xdc\xf2\x97\xeb\x1c\xeeE\xed\x00\xeb\xad\xe9g\xec\x1f\xe9\xd1\xed\xfc\xf1H\xf4\x17\xff\x9f\xfd@\tJ\x02\xea\x13\xb2\x06\xcd\x1fz\x0fi%\xcb\x19a$\x8d\x1d6%\xde\x1b\xec!2\x1b\xaa\x1a\xf5\x17\xf5\x16\x05\x14\xb8\x16P\x15\xeb\x14\xa5\x18\xc7\r\xd8\x14r\f\x1d\x0f\xc7\n\x1a\r\x86\x00\x95\b\xd5\xf3\xee\xffa\xe8\x05\xf7\xec\xda1\xed\xe5\xcf\xd9\xe0\xa4\xcb\xe5\xd8\xf7\xcenנ\xd5F\xdaH\xd9\xec\xdb?\xde\xc3݃\xe2\xb0\xe2+\xe8T\xe6\x80\xf4?\xeb\x16\x01\xe3\xf5\xa2\x06\x1e\x00\xfe\t\xa5\x04v\x11\xae\t\xde\x19\x91\x11\xa8!\xc6\x19W%\x00\x1f\xeb$i\x1f\xce#\x9f\x1f\x83!\xaa\x1f\x9f\x1aY }\r\x93\x1b\x94\x00\f\x0f\xe1\xf5\xed\x04\u007f\xea\x04\xfc\xe7\xdfh\xefd\xda\x1c\xe7\xd4\xd7\xc6\xe6$\u05fa\xe4a\xdb=\xe1\xfeߴ\xde\xcf\xe4\xe1\xdf\xdb\xe8\xab\xe6\xe9\xebM\xeaN\xf3\xd5\xeb\x96\xfd\xf1\xef\x8f\x05X\xf7e\x10\x87\x01\xc8\x1aE\fF!\xe1\x158#\x83\x1d\x81\"\xe8\x1f\xdf)\x1c#\xb30h)W-\xe7*\xbd#4$_\x19\x94\x1b\x8d\r\xe5\x13\xe1\x00!\fM\xf8m\x04\x16\xed\x04\xfc|\xe0\\\xf3\x91\xd9\xf7\xebS\u05fa\xe6z\xd6\xd2\xe3\x01\xd6\x1b\xe0f\xd9>\xde\xd8\xd8{ݤ\xd4\x15\xd7\xdb\xd9wԂ\xe3\t\xdfl\xeb\xba\xeb\x1a\xf6%\xf4\xde\xff^\xfd\x8b\a\xfc\x05A\x11\x16\r\xb7\x1d\xe6\x18\xb9(\xb5$H,l'\x9c-g%O.w#i)\t\"[!\xd9\x1e\xd2\x1dZ\x1a\x84\x19\xf5\x17\x13\x0e\xcf\x0f\t\x03\xca\x01@\xfdO\xfb\"\xfbl\xfe\xad\xf6j\xfc~\xf2u\xf3\xa4\xf0\x87\xec\x12\xed\x8a\xe8\x8a\xea#\xe7\xca\xea\x1b\xe7S\xebB\xe7\xfd\xed\n\xe8s\xf0\x0e\xeav\xf2\xcf\xed\xe9\xf9]\xf5\xfb\x00^\x01\x88\x06\u007f\fG\x0f\xfa\x11\xab\x18f\x13\xc6\x1dn\x17\xb6\x1c\x03\x1c*\x1b\x80\x1d\xf5\x19\x8f\x1a\x00\x16\xd7\x14\x1c\x10&\x0f=\n\x00\vl\x02\xc6\a\xc3\xfb\xbb\x02Q\xf8\xfa\xff\xca\xf1\x06\xfc\r\xec\xd5\xf39\xea\x1d\xee\xfe\xe4s\xea\x16\xe2G\xe8\xf9\xe4w\xe8\xb5\xe8~\xe9\xf8\xeb7\xeb\xae\xeff\xf0e\xf4\xa3\xf7\xf5\xf3O\xf9n\xf4\x06\xf7\xea\xfa<\xfaD\x00 \x00\x96\x01\x9e\x02\xfe\x03E\x03\xb6\tn\x05\xe5\x0e\t\aV\x17\x9e\nM\x1d\xac\x12\x89\x1c\xb0\x15O\x1b\x93\x14!\x1bT\x13\xa0\x17B\x10\xc5\x0f(\t<\nd\x04\xed\x03C\x02V\xf9g\xfcn\xf1 \xf5;\xf1c\xf4\xae\xf3)\xf8\x9b\xf3z\xf8\xbe\xf6\xb6\xf8\x87\xfb\xff\xff\x15\xfc\x10\x03K\xff\x83\xffX\x02\xce\xfe|\x00C\xff\\\x00N\xfd\a\x02\xe0\xfd\xb1\x03\xfc\x01{\x05\xe1\x06X\x06\x04\x0e8\t\x1d\x12\x97\r`\x11\x96\x10\xa8\x10\xb4\x10t\x11\xaa\f \x10}\rp\v\xad\x12\xa3\nD\x11\x9f\vK\n\u007f\a\x19\x04\x1d\x03=\xffI\x04.\xf7\xde\x03\xb8\xf23\xff;\xf5\x1c\xfb\xe7\xf6\b\xf9]\xf9\x99\xf8\x1b\xff\xbe\xfbr\xfe\x9e\xfc\xb0\xfd\x0f\xf8\xff\x02'\xf8\x94\x06p\xfd\x16\x04Y\x00\x81\x02E\x00Q\x05\xec\x03Y\x05\xe0\a\xeb\x02\x05\x06\xc2\x02\x98\x03b\x00\xef\x04\xd6\xfb)\x02\xd4\xfc\xea\xfcS\xff\xd1\xfd\xe4\xfd\xbe\xfe*\xfc\xd1\xfe!\x00D\x01\xc2\x05\xe9\x04\xfb\a*\x06\x1d\a\xd6\x04g\x03w\x03k\xff\x16\x01\x04\xfd\x98\xfdN\xfb\xd3\xfaZ\xfbN\xfa9\xfdT\xfd\b\xfc{\x00\xd1\xfb\xd9\xff\x0f\x04\x1b\x02k\r\x80\n\xe7\x0e:\v}\t\xfe\x04\xda\x03\x1d\x00\x80\xfb[\xfev\xed\xf8\xf7\x1d\xe8\x1b\xedU\xeb'\xecZ\xe8\xfc\xf1g\xe3\xfc\xf1\xd1\xe9\f\xf1\n\xf2\\\xf7\xd6\xf2\xd2\xf7\xef\xf4\xa1\xf31\xfa\xf8\xf6\xc4\xfb\x16\xfc\xc8\xf6\x8c\xfa\x94\xf4\xd8\xf6p\xf9\x11\xf9\xc2\xfd\xcf\xfe\xf1\x01\xeb\x03\x0e\x05+\b(\x06c\v\x8d\b~\f\xc2\vq\v9\x0f\xce\t\xc9\f\xb8\a3\x05\x04\x04\xd1\xfd\xb7\xfe`\xfb\x03\xfaL\xfc\x8e\xf8P\xf9\xc8\xf6\x1c\xf3\xe0\xf2\x9e\xf2\x89\xf0t\xf2O\xf1\xe4\xe9\x93\xed\xe1\xe42\xe8\xdb\xe74\xea\xa6\xe8\x95\xed\x93\xe7\x80\xec\xb9\xea\x93\xeb\xe3\xed{\xf0\x95\xf3\x15\xf7\x96\x00\xd1\xfd\x00\rE\x05\xe8\x0f\t\tH\x0f0\nc\x14\f\x10$\x19k\x18\xd1\x17\xb9\x1a\x18\x15L\x16\xab\x13\xc0\x13\xf6\r\xfc\x0fi\n%\t\xe7\n\x04\t\xd5\n\xcf\v\xe9\f\xc4\t_\x12\x82\t\xb2\x13\xff\v\xa1\x0e\xd7\t\xb2\x05\x90\x06\x9b\xfdU\x00)\xf6\xdc\xf9R\xef\x97\xf3\xa8\xef\xd9\xf0q\xee\xa4\xf1J\xec\xfd\xf0C\xf2\xc4\xf0\xbd\xf9\xe9\xf4\xb5\xfc5\xfa\t\x00^\xfa\x87\x02\xb6\xfbE\x01\n\xfe\v\xfcA\xfbw\xf7X\xf5\xd5\xf7\xef\xf3\x8a\xf9\x1a\xf8j\xfb\xd2\xfb]\x00\x9d\xfe6\b*\x06\xe2\r,\r\x01\x11>\x0f\xee\x12\x9e\x10\xa1\x11O\x10\\\r^\f\xc8\b \t\x15\x06z\a*\x02\x9d\x06&\xff\xb7\x05\a\x03a\a~\n\x87\fj\x0f\xf7\x0f3\x0fQ\x0fF\x0fc\x0e\xbd\x11O\rZ\x0f\xd5\a\x00\tJ\xff\xdc\x031\xf9w\xff\x14\xf7\xb2\xfa \xf6\xc1\xf8l\xf5\xcf\xfc\xc2\xf8\x1c\x027\x00\xde\x04\x18\x05]\x04\xcc\x03\x0f\x03\x98\xfe>\x00\xc6\xfb@\xf9\b\xf9\xa9\xf5Q\xf4\xcd\xf4F\xf2\x14\xf2\x1a\xf4\xb3\xf1?\xf7\xed\xf2\xbd\xf9~\xf6\x8b\xfd\x84\x01c\x05\xf3\t\xf2\x0eW\nH\x0eD\rD\ba\x11\xb4\n\x8c\x0f=\f\x8c\rq\t\xdf\x0f,\v\x9d\x12m\x10g\x10\xd2\x13=\x0fm\x12?\x12\xeb\x10\x1d\x0f\x8e\x11\xf1\n\xed\x0f\xd1\v]\n2\bV\a\x8a\xfc?\x00\xad\xf1n\xf5\xf3\xed7\xf3R\xe9\xb8\xf3j\xdf\xfd\xeb\xf4\xd9\xdf\xe1d\xda\xcdߜؔ\xe0\x01\xd7<\xdeI٤ܲ\xd9Mݝ\xd9\x06\xdc\x1e\xdd{ݭ\xe4\x98\xe5\xbc\xeb\xbf\xee\xd7\xed\xc1\xf5/\xf2\x83\xf7S\xfd\x8d\xfbf\x05\xf9\x02t\bV\x05\x9b\tw\a\x01\t\xa8\t\xef\b%\b\x95\aG\bU\x06\xff\t\"\a\x94\t&\x06\x00\v\xf5\x03\x8b\b\xc0\x03\xc2\x01A\x01\xc2\xfd\xe5\xfa;\xf9R\xf6y\xf4\xe8\xf58\xf0\x99\xf6u\xed!\xf2\b\xeb\x12\xf0\xc5\xea\xc0\xf7}\xf2\x9f\xff\r\xfb\xa7\x04\xa4\xfdr\t\xcc\xfc\xf7\bh\xfdv\x03\x9c\xfe\xf2\x02I\x01K\a\xbd\x05\xa5\x06\xe8\b\x02\x03\xc4\x06\xab\x06\x80\x05}\r\x8f\x0e\xa2\x10)\x16\x9f\x14\x91\x16\xb4\x15\x01\x14\xaf\x11e\x10\xd7\f\xed\n\xa8\ak\x05i\x02\xbc\x02\x9c\xfd[\x01\xd5\xfa\x14\x01B\xfdu\x01%\x03E\x04<\v\xc2\a\xf5\x12\xa2\v\x9e\x159\f\xae\x15\x15\t\x1e\x14,\a\xaa\x0e\xcc\x05\xc1\x058\x03%\xff\x96\xffG\xfeM\xfe\x86\xfb\xd6\x00\xea\xfco\x03\x82\x06\xc6\b\xe5\f\xed\x0f\t\x11j\x11\xd8\x12\xc1\vU\x0f\xb8\x03\x9e\tx\xfe\x13\x03\xa3\xfdg\xfe\x0f\xff\xd7\xfe\x98\x00\xa0\xfc\xb0\x00<\xfa\xe9\xfe\xce\xfb\x86\x01j\xfe'\bo\x04\x17\v\xcc\n\xd6\vn\x0e;\nR\x0e\x83\x06\x9b\b\x03\x02\xfc\x00?\xfbj\xf9w\xf6\xb0\xf2\xd9\xf3\x98\xec<\xf1|\xe5\xee\xee\xa8\xe3C\xed\xf6\xe6i\xef\xd6\xe9\x1a\xf3\xc2\xf0b\xf4\x13\xfa6\xf6\x92\xfc\xeb\xf7\x81\xfc\u007f\xf7\n\xff\xfb\xf8@\xffX\xfa\xd6\xff\x84\xfb;\x03\xd2\xff\xf0\x065\x06\xef\t-\f6\r\x90\x0f%\x11\xe7\x12,\x12h\x13\x9e\x0f\x1d\x0f\x88\f\x06\n\x0e\a.\x06a\xfb\xd3\xff\a\xef=\xf6\xab\xe8\x05\xf1o\xe3\xa5\xee\x0f\xe1)\xeb\x92\xe5\xff\xe9R\xe8'\xec\xc2\xea\xec\xec7\xf3\x16\xefd\xf8 \xf4\x04\xf8C\xf5\x05\xf9\xdd\xf4\xee\xfdy\xf8\xdf\x00\xca\xfe\xc7\xfe\x1b\x02\x00\x01s\x03\xcf\x06\x94\b\xcd\f\x85\r\x0e\x15\xbc\rn\x193\x0fO\x15\x91\x12@\x11g\x11\xd8\x10\x9b\x11}\x0e\xa8\x10\xbc\t+\v\xaa\x03\xfa\x06\xc5\xfd\xeb\x03V\xf9r\x01)\xfb\xee\xffJ\x01\x96\x00C\x06\x8e\x02\x1c\r\x94\x04\x02\x15B\b\r\x16\xdc\n\xf5\x14e\vN\x12\xb4\r\xbd\v\xab\f\x97\b\xa1\a*\aA\a\x1d\x04\x0e\bU\xff\xaf\x04[\xf9\x16\x02?\xfa\x17\x00s\xfd\x9e\x00\xd9\xf90\xfeM\xfcc\xf9\x10\x01\x11\xfe\xeb\xff%\x04L\xfe\xdd\x02S\xffD\xffr\x02w\xff\xbc\x03!\x01g\x06X\x03\t\b\xa3\x04\xb8\x05\xc0\x058\x04{\x04\xe1\tF\x04\xf4\x0f\x13\t0\rq\b\xa3\bD\x06X\x04\x9b\x05\\\xff\xf3\x01C\xfd\xc8\xfd)\xf9\xe7\xfa\x92\xf2\x1e\xf7t\xee4\xf3\x03\xee\x11\xf2\xe3\xedc\xf3\x89\xed\xa6\xf4\xd2\xf0<\xf7N\xf7W\xfc\xc6\xfdP\x016\x00v\x02\xbf\x00\x98\xff\x9d\xfc\xfd\xfc\x8a\xf7\x92\xfb\x8c\xfb\xee\xfdy\x02\xa3\x04\xf3\x05\xba\n\xc8\b\xed\v\xe3\nj\f\xc7\n^\r\"\b}\t\xc4\x03\xe3\x03\xf8\xf9\x06\xfc+\xf1\xf3\xf1_\xefB\xee\xd7\xe9\x90\xee\xf4\xe5\a\xecg\xe9\xf6\xecj\xech\xf1\x05\xef\xd8\xf1\x0f\xf5V\xf2\x9f\xf6\x95\xf4M\xf2r\xf2@\xef\x83\xefB\xee\xfb\xeeZ\xed\xf3\xee\xe9\xe9M\xed\xc8\xec\xd2\xef\xfd\xf2>\xf9\xdd\xf5\xef\x00J\xfd\xd9\x03\xf6\x06\x04\b\xaf\t\x9f\n\xd9\b\x81\x06}\v_\x05\r\n8\x06y\x02\x80\x02\xd3\xfc\x8b\xff\x01\xfc\xdc\xff/\xff=\x01\x8a\x05\t\x05\xb5\a\xb0\aG\aQ\x05\xc2\x05\x9f\x02h\x01\xf4\xfd\xe5\xfd\xd2\xf8\x83\xf9\x05\xf6c\xf5\xa1\xf3\xa5\xf38\xf2\x1e\xf1\xc3\xf4\x92\xf0\"\xf70\xf5\x8c\xf8\x1e\xf8\xc3\xfa\xfd\xfb\x12\xfc\xd5\x00J\xfeX\xffi\xfe\xd4\xfb\xe8\xfa\xaf\xfd\x91\xfan\x01\xe4\xfe\xe2\x05\xb6\x03\x81\n\xae\aN\x10=\r\xb6\x16l\x14\xc4\x1a\x83\x19\xe0\x1d\x87\x1a\x05\x1c\x94\x16\xb5\x15K\x10g\r\x97\t9\t\xb8\x05\xb7\x05\xb4\x05\x82\x006\x05\xb5\x00\xbd\x05\xfe\x03m\b2\a\xb7\n\xdf\n\x05\f\xc1\v\x12\x0ex\tE\v@\a>\x06F\x03l\x00\xc2\xfb\xdc\xf7L\xf4\xda\xf1\xa5\xf4\xf1\xf2\x1b\xf8\xdf\xf8H\xfba\xfba\x02\xe8\xfdW\b\x91\x03\xa5\am\x06\x9a\x05\x19\x02\xc7\x06\x99\x00r\x05\xe4\xffs\xffc\xfa\xcb\xf99\xf7.\xf7\xd0\xf7\x06\xf2~\xf7\x9b\xee\xcb\xf5\x1a\xf0\x9d\xf7\xc6\xee\x9e\xfa/\xedq\xfaG\xee\x8b\xf9\xd8\xef\"\xfa\xd9\xf2\xb8\xfa\x0f\xf8J\xfaJ\xffa\xfc\xf3\x06\xdd\x00$\x0e\x92\x06\x9f\x12\xbb\f\x02\x16&\x10\x19\x1a\xd8\x11\xcd\x1c\xc5\x13\xb1\x1c\x19\x16w\x1a\xd0\x16\xe2\x16\x81\x15\xa9\x10\x12\x11\xff\tz\nn\x03\xd4\x03\xc1\xfa\xdd\xfb\xa8\xf1~\xf5\xd1\xea\xb6\xf1Q\xe4\xfe\xed2\xe0x\xeaf܈\xe7\xa3\xd6\xe3\xe1\xa4\xd4\xc8\xdc\x00\xd5\xf4ݔ\xd8\xcb\xe0\xb1\xe0\xaa\xe3\x8f\xebH\xe9\xf2\xf8\xc7\xf1\x14\x04N\xfc\xa1\vL\x04%\x15j\n\xa4\x1d.\x14Z\"s\x1c\xec*!$\x942\xde-\xd74\x031\xb14c1\x052\xcc3u.x/\x00&)'\x97\x18)\x1d\xcf\f\xac\x10\x94\xff\xe4\x05\xf1\xf3\x8d\xfb\x94\xed`\xf2O\xe6\x97\xec\"\xe1\xbf\xe6\xa2\xde~\xe2\x8aڜ\xde\x1d\xd9\f\xd8R\xd8\x19\xd6{\xd6\bأ\xd9#\xd9[\xde\x1a\xdd\x18\xe2\x8a\xe2D\xe9\t\xea\xa8\xf1\xfb\xf6\xe1\xfe\xec\x03\xbf\x0eB\x0f\xe4\x17x\x18J \xcf\x1d\xcb%\xa3\"\xcc%\xdf#\x02(\xd3!\x88(\xd2 1$\xf7\x1f\xbf \x9d\x1c\x96\x1dq\x19!\x18\x96\x16\xb4\rt\x10\x8d\x00\xae\x06\xa1\xf5\x91\xfb\x8d\xe9c\xee\xbdݎ\xe0h\xd4`\xd7\xf1\xcb\xeeн\xc7:\xcd\xf7\xc9C\xcdy\xcd^\xcfB\xd1\xc0ԧ\xd3\xe9\xd93\xd4s\xdb\xcd\xd8'\xde\\\xe0\x17\xe3\x99\xe9\xa2\xe8d\xf3V\xf0\xc5\xfc5\xf8\xd5\t\xd6\x00\xf2\x16>\v\xb6\x1d\xb3\x12c$\xc2\x18\xc7-\xb0\"\x15. )\xf7'i%\x87$[ \xc9 ? 7\x1a\xb4\x1et\x14\a\x19\xb1\x11:\x13\xc7\n=\fO\xff\xb1\x01\v\xf7\xbe\xf8\x98\xef\xf6\xf3\xb5\xe6\x1a\xef\xf0\xdf\xde\xe7\x9bܛ\xe0\xac\xd9\xc3\xdc@\xd8\x19\xdc\xc4\xdb\b\xdez\xe0,\xdf\xeb\xe7-\xe1d\xee\x9f\xe7t\xf1]\xec\xea\xf4\xf5\xf0\xa3\xf8\xd0\xf7Y\x00\xd2\x00\x19\v\xdb\v\x98\x16\x1f\x16\xd2!\x95\x1c\x9c(\x87\"\x10+\x13'7-\xbd(\x840\xfc)@-\xad(g!#\"\"\x17\x12\x19\xe3\r!\x12\xbf\x03\xd1\fQ\xfdw\x05\xf0\xfa\xd4\xfe\xf6\xfc\xfb\xfc\xe3\x02e\xfd(\x06\x14\xfd\xac\a\x05\xfa\xf5\x05\x98\xf6\xcf\x01X\xf5\xd4\xfe\xb2\xf3\xc1\xfc\xd8\xf2\xd6\xfa\xe5\xf4\x97\xfb\xd0\xf8\xe0\xfc\xbd\xfeV\x00k\x02\xf3\v\xb0\a.\x13:\x0f$\x12U\x0f\x9e\x11\xea\v2\x0f\xfb\tt\a\xf2\x05\xc3\xfc\xf1\xfd\x9d\xf6y\xf7\xcc\xf3\x0f\xf6C\xf0\x06\xf6\xdb\xf0I\xf6\x85\xf4w\xf9\x83\xf5\x8d\xfc\xba\xf6\xfe\xfa\xc8\xfeQ\xfc\xe5\x04\x9c\x01\xd1\x02'\x00O\x03\x9a\xfc\xa2\a;\x00\xf6\b<\x06\x90\n\xca\a\xbe\x11\b\r|\x16\xef\x15?\x15\xc6\x15\xc2\x13\x01\x11v\x10\xc7\r\x19\x04\x87\x06\x9f\xf5]\xfa\xe3\xees\xf2\xbe\xe7\x18\xed)\xde7\xe5\x99\xdb\xe2\xe0T\xdc\xd3\xe3=\xda9\xe5)\xdcQ\xe2\x1f\xe0\xd6\xe2n\xdfb\xe3(\xdfc\xe0\xc0\xe0\x18\xe1\xc2\xe1\x19\xe5\xfe\xe5\b\xe9\xdc\xeca\xef\u007f\xf9\xcc\xf9\x16\a\x14\a\x18\x11\xca\x12\xb5\x1a\xb3\x1a1\x1f\xbd\x1cv!\x10\x1b\xf1\"S\x19\v\x1f\x86\x17\xc0\x17\xfe\x11y\x14\x1e\x0e\xea\x11&\x10\xd5\v~\x0f\xf8\x05\x93\n+\x01i\x06q\xfa\xb3\x02\x15\xf3\xb5\xfb\x00\xec\x84\xf1\x8b\xe5\xde\xe8e\xe0\x82\xe4j\xdb\xdb\xe1B؎\xdd\xcd\xd6[\xd9V\xd9\xe8\xda\xe7\xdfe\xe4\xdb\xe5?\xee\x8c\xea\xab\xf1\"\xef\xe8\xf1\x00\xf4\x0e\xf3\xd1\xf8\xf2\xf5y\xff4\xf9K\b\xd9\xfeB\x11f\b\xc9\x19\xdd\x10\x89!\xe2\x16\x1a)l\x1c\x95-n#\x9f,!(V+O(`'W$\x17 \xeb\x1c]\x17\xb6\x14\x0e\r\xb1\r&\n\xe2\t\x0f\v\xda\n\xc6\a\x88\t\xfd\x04\x9c\x03\x03\x03+\x00{\xff\xa9\xfd\xc6\xf9d\xf8\xee\xf6\xc2\xf2\x9c\xf1\x9a\xecO\xe89\xe5C\xe5\x9a\xe2=\xea\xde\xe6\xd0\xf1\xd9\xef<\xf8W\xf6\x9f\x00\xdd\xfcl\f\xdc\a\xdf\x17\x84\x11\x97\x1f\xbb\x16\t%\x9d\x19^% \x1bL %\x18\x00\x1c\x01\x14\xfb\x17\x9a\x11\xf1\x12\xf7\x11K\x11\x94\x15p\x12\xaf\x19\b\x12\x8c\x19\x80\x11$\x17\xf0\x10F\x15\xc0\r\x9d\x10u\b&\a1\x03\xb8\xfd9\xfa5\xf6\xd7\xeft\xf1`\xea\xcd\xeeV\xeb\xc5\xef9\xed\xd9\xf4T\xee\xb4\xf73\xf0q\xf9\x18\xf3\u007f\xfb%\xf8\xd9\xfc\x19\xfc/\xfc\xc3\xfd\x1b\xf9\xc2\xfeN\xf6\x88\xff\x94\xf7v\xfd\xc8\xf9\xeb\xfb\x99\xfa\xc4\xfd\xcd\xfe\xc8\xff\xa7\x02\xcd\x001\x03\x8a\xffY\x00w\xff\xc2\xfe\x86\xfd\x01\xff\x94\xf9C\xfc\x14\xf8|\xf7\x02\xf4\x17\xf4\xfb\xeb\xcc\xf00\xe4\xf1\xecy\xe1\x9b\xec\x83\xe2?\xee\xff\xe3f\xef\x14\xe9\t\xf2H\xefJ\xf3\x06\xf3\r\xf3B\xf6\xe5\xf4\xcb\xfaW\xf8?\xfc;\xfd\x9f\xfe\xd1\xff\x9b\x03\xe5\x02\xab\x05\x02\a\xf2\b\x1f\n\xcb\x0f\x03\x0e\r\x17\x96\x12\xd7\x1c\xfc\x15\x97!\xa5\x16K#\xcb\x16\x19#\xba\x16\xfd\x1e\xcd\x15\xac\x14\x89\x12\n\td\vi\xffh\x05\xc5\xf5/\x00c\xed1\xf7\xb7\xe9e\xf0\xf5\xe9\v\xef\xfc\xebr\xef\x86\xef\xdd\xeeC\xf4\xb0\xef#\xf6\xbb\xf1\xb7\xf4\xcd\xf2\xca\xf1\xec\xf2\x89\xf1\xbb\xf2\x00\xf4\xdc\xf55\xf4`\xfa$\xf9\x8d\xfed\x04\x12\x06\xf1\v)\r\f\x10F\x0f\x86\x13\r\x11_\x17u\x14\xea\x19A\x17X\x19\xab\x17\x13\x1b5\x17\a\x1dr\x1ai\x1b\x9b\x1b\xf9\x1a\x95\x18\f\x1c\xe0\x18\xea\x1b2\x19\x04\x1ci\x16\xac\x1b\x15\x15r\x17\xf3\x11\xbd\x10q\f\xb6\nV\b\x86\x05,\x04\xcb\xfd-\xfd\xcd\xf8x\xf5\v\xf7\x1c\xf2B\xec\b\xef\x8b\xe1\xeb\xe5\xa0\xde\xde\xdf\t\xd9g\xdez\xce\xeeة\xc9\x1f\xd2\xf1ʨ\xd3\xe4\xcb9\xd8e\xd1\xc9\xda\xda\xde\xfe\xe0\x0f\xee|\xeb\xec\xf6t\xf3Y\xfex\xf7\x93\t\xf9\xfe\x17\x11\x0f\v!\x179\x14\xca r\x1c\x91(r%\xa2+\xf0*\x01-],\xb3.9.\a.\xf9-*(N(\xc8\x1c\x8a\x1e\xc2\x0f\xb2\x12\xc4\x01\xbc\a6\xf3\xc3\xfcn\xe7\x93\xf2\xfd\xdb\xdd\xeag\xd2\x14\xe2\xb3\xcd\xfe\xd7g˲\xd1\x0f\xcaP\xce;\xcb\xc2\xca!\xd1\xf5\xccWׇ\xd1M\xdb\xfd\xd4K\xe0\b\xdb\xe7\xe69\xe4u\xecJ\xec\x0f\xf3\x12\xf2Q\xfc\x8f\xf9\xa6\x04\xa3\x03\xa0\f\xe9\f)\x16\x12\x16W\x1c\x1f\x1f\x9d\x1c($\xc1\x1c\xb4\"\xc2\x1da\"\x90\x18{#\x8c\x12\xf7\x1eI\r\xdd\x18\xaf\x06A\x11\xf0\x02\xca\aW\x02N\x038\x01\x1a\x02\xd7\xfc\xe1\xfd\x02\xfa\x86\xf9\xd0\xfa\xf3\xf6Z\xf8\r\xf3\xf0\xf1\xfb\xeb\x9d\xf0\xa1\xe6C\xf2\xf4\xe89\xee9\xe9\x91\xec\x8c\xe2~\xed\x9f\xe1-\xed1\xe8\x86\xf2\xa4\xee\x0f\xfc/\xf7\x97\x031\x00g\x06r\x04s\x06\xc0\a\x81\a\x05\nO\b\x8b\t\xb7\t)\v-\x0e\f\x0e\xd7\x11Q\x12\xb7\x13\xae\x15m\x17\xb4\x16\xad\x17\xd3\x18\x80\x188\x19,\x1e\xea\x18; \xec\x19\xb2\x1dz\x18\xae\x18\xc0\x14/\x13f\x10=\x0f\x1f\vl\tE\x05\xfc\x02\x1a\x00L\xfe\t\xfc\n\xf9\xce\xf76\xf4\\\xf4\xbb\xf3\x10\xf36\xf2\x94\xf1\x81\xed\xea\xec\b\xed=\xe9\x12\xed#\xeb\xaa\xeb\xd9\xeb'\xed\x9f\xec\x8b\xee\xc2\xf0\x8f\xf0 \xf5w\xf6\x87\xf7\x05\xfe-\xfa\x82\x03\xcf\xfdI\x05\xc9\x021\a/\t\x16\v\xc7\x0ee\vw\x0f\x02\t\xcb\v\xee\x06Q\n)\x04m\v\xcc\x00b\v\xa5\x00\x9b\n\x9c\x04\x0f\fl\b\x04\r\x96\n\x9d\nV\nZ\x06\x1e\a\x94\x02`\x00u\xfe1\xfa\xd5\xf7+\xf8\xe7\xf2G\xf7\x87\xf1\x94\xf3\xdc\xef\xaa\xf0g\xed!\xf2\\\xed\x97\xf3\xdb\xef3\xf5\xf4\xf2\xb6\xf7g\xf5\xa2\xf7\x95\xf5a\xf3f\xf2\xd5\xee\xa8\xee\xb8\xed\xd6\xef\xf3\xec\x17\xf4\x05\xed:\xf7\x92\xf0\x8a\xf9=\xf6\xdc\xfb\x87\xf8\x04\xfc\x15\xf8\x90\xfa\x80\xfaR\xfc\x14\xfbY\x00\x0e\xfc\x06\x01\xc0\x02\xb5\x01\x05\x06,\x04A\x06\xdf\x03\xbb\b\xc1\x05\x97\fe\f\xed\x0f\x95\x0fH\x15\xde\x0e\x89\x1ds\x12\x9d\x1f\xfd\x15\x85\x1a\xf6\x13\xc2\x13K\x10\x95\f}\f!\a\xe0\a\x8d\x05\xb5\x05 \x04\xf1\x06\x10\x01\xb4\x054\xfe?\x01s\xfa\xf4\xfa_\xf5\xdd\xf2J\xf2]\xee\xb1\xf0\x91\xed\x86\xed\x1c\xebj\xeb\x91\xe8\x8a\xeb\x93\xe9y\xee\x1c\xed\xeb\xf5J\xf2\"\x00\xc1\xfa\xa0\v\xbd\x04<\x15&\r1\x1be\x13\xf3\x1e\xea\x16\x88 \xa1\x19\xbd\"0\x1d\xbc$\xb6 \xa1\"'!\xe5\x1c\xb7\x1e2\x15\x1a\x1b\x86\x0f\x18\x19X\n\xd0\x15\xaa\a\xf1\x0f%\x06\xb8\v]\x00\xec\x06\xed\xf6^\xfe\x95\xee\xc0\xf4\x01\xeaU\xedo\xe7\xa1\xe8\xc1\xe3\xef\xe4|\xe3\xea\xe2\x83\xe56\xe4\xe9\xe6\xdf\xe6\xb9\xea\xbd\xea\xa7\xf0\xba\xef?\xf7\x97\xf3\xc4\xfbo\xf6\x9b\xff\x92\xfa\xe6\x02\xa9\xffG\x05!\x02\x02\b\x1d\x03\x14\n\x1f\x05k\f\xf5\a\x8a\r\x9a\n\r\x0f\xf6\f\"\x12/\x10(\x13n\x13\xe2\x12q\x13d\x14\xc0\x10\xd4\x13<\x0fl\x10\xd4\r\xa5\f#\v\xfa\a\xb1\aW\x01\xb7\x02\xa4\xf9\n\xfdX\xf1\xab\xf7\xf6\xe5\xcf\xf0q܄\xe9\xbd\xd7\xe1\xe50\xd4)\xe4\xc1\xd0\xdd\xe0\xda\xcfz\xdb\x02\xd1\xff\xd9\xdbϦ\xd9tЦ\xd7\x1f\xd5\u007f\xd9;ޭ\xdf\xd5\xebs\xea\x9e\xfb\x15\xf7\x93\v~\x02\x9c\x1bj\x0e\xcd&\xe1\x1aW.c#\xe37p*\xe2>!3\x1d?W7\xd69B6\x8e0{1\xf0#\xb9(\xf8\x170\x1f\xa4\x0e]\x18\xae\x05\xf9\x11\xbd\xf8\xff\x05\xb6\xe7\x05\xf55\xd9\xe3\xe4f\xcd\xee\xd8\xea\xc5i\xd2\x18į΅\xc4H̥ȭ˪\xcf\xe8ϟ\xd5{\xd5\xfd߿\xdaN\xf0\x16\xe7\x8f\x00t\xf61\x0e\xa5\x01\xdd\x19\xb2\v_#\xa2\x189)\x1b$E0.,+8\xcd2U:\b5\xd37\x1b4\xc62\xe92\xf0)&.\x82\x1d\x1a\"\xce\x11*\x14n\a\x93\t\xed\xfb\x93\x00\xcc\xf3\xaa\xf7{\xef#\xf2A\xeb,\xefm\xe9\x8d\xea\x12\xea\xac\xe5\"\xeb}\xe45\xea}\xe5\"\xe9\r\xe6\x8c\xeb\xed\xe7\x05\xf0B\xec*\xf5'\xf2\xe7\xfa\x83\xf8\x99\x00w\xff\xfa\b\x92\a/\x11\x9d\x0f\x8e\x15\x13\x14\xc9\x16A\x14\x99\x17\x94\x14\xb0\x15\x05\x15\xc5\x10\xce\x12\xb8\n\x89\r\xd0\x05\xb3\a\x80\x05\x80\x06\xe0\x03?\bt\xfe\xf2\x05\x95\xfa\xe2\xfd\x87\xf7\xf0\xf5\x16\xf0\xcf\xef\xa0\xe7\x90\xe7N\xe1\x1d\xe2\xa8ڸߟտܦզ\xdb\"\xdc\x1d\xdf\x19\xe2\xe3\xe4\xa1\xe6\xdd\xe9\xef\xed\xa3\xf1P\xf6f\xf9\x89\xff\xa8\xfc\x14\a\x8e\xff\x8e\b\t\x04~\bF\af\r\xf0\n\xe0\x11\xb6\x10\xd2\x13\x19\x15\xd4\x1a\xc5\x1a\xe9!-\"\x1e\"\xf4\"Y \x86\x1d\x11\x1d\x86\x18%\x13\x93\x11\xec\x05`\x06\xb0\xf9\xab\xfcy\xee\xfe\xf4l\xe4/\xec\x80\xdeV\xe5\xa2\xdbD\xe2gڥ\xe0\xb9\xdb\xeaߍ\xde\xd9\xdf3\xe2\x8b\xdf\xc6\xe4\xaa\xe0\x91\xe7#\xe3\xea\xec\xa1\xe7{\xf3~\xee\\\xfc\xdb\xf6\xc2\x06O\x01\x14\x10\\\f4\x18w\x14u\x1f\x92\x1aC%\x00 Z*\xed$%+$'\xb9'\xe3%\x03$\x03%\xe3 \xe7$M\x1d\xf8 u\x17\xdd\x19\x83\x0er\x13\\\x04\x94\r\x1d\xfa\xf5\x04S\xf1\xae\xf9\xb2\xe8\x8d\xef\xe7\xe0\x13\xe8\xa8\xdf\x00\xe4\xca\xe1\xea\xe2Z\xe1\xb7\xe1\x8b\xe1\xa2\xe0\x85\xe5\x9b\xe1\xa6\xea\xab\xe3\xd0\xef\xaa\xe5\"\xf6\x14\xeb!\xfb\xac\xf2\xfd\xff\x1d\xf8\x02\tc\xfe\xe9\x13\xfb\bS\x1b\xfa\x12\xea H\x19\xb0'\xba\x1e),\xf9#\xcd*\x87%,'j#P\x1f\x93\x1f\xbf\x15?\x1a\x9a\x10\x03\x16\xcf\vu\x131\x05 \rM\x01\x9b\x06\xdd\xfd\xae\x04A\xf8\xd6\xff+\xf4~\xf6Z\xf0\b\xf0=\xe8T\xecE\xdf/\xe8\x00\xdb\xc6\xe3G\xda0\xe1\x8d\xdc7\xe1\xb2\xe3\xa4\xe6d\xed\xd2\xee\xdc\xf8\x0e\xf6:\x01o\xfc\x0f\bV\x01!\x0f\xc0\x05J\x14F\n\xf8\x15\xb4\x0f\xf6\x14H\x12\x91\x14_\x12\xf9\x12\x0e\x12\xb4\x10q\x10)\x10\x90\x10/\x10E\x11\x80\x10\x98\x10^\x0f\xc7\rX\x0er\v\xec\v\xe9\nH\a\x9f\t\xee\x03\xc9\a\xe6\xfeK\x05\xef\xf5\x80\xff<\xed\xdc\xf8x\xe6]\xf4/\xe1f\xf0\x1c\xde8\xec\x04\xddx\xe9\x89\xdd\xcb\xe7\x80\xdf\xe8\xe6\xef\xe2\xe4\xe7!\xe7\x1b\xebk\xeb2\xeeh\xf0\r\xefd\xf7g\xf1G\xfb\xd4\xf5?\xfdF\xf9j\x01\xdf\xfb\x1e\x05\xe2\xfe\xcf\a\x19\x03\x9f\vK\a\xfc\x0ew\v\xb8\x0e\xe5\r\xbb\x0e\xef\x0f#\x0f\xff\x11\xa4\r\x85\x12\b\v\x03\x11\xf5\b\xf1\rK\x05~\f\xd2\xfe\xf6\aI\xf8K\xff\xe0\xf0,\xf7\xae\xe9\xe7\xf0\x19\xe4\xef\xeb\x01\xe2\n\xe8\xa4\xe3)\xe6\x0e\xe7q\xe5+\xec\xa6\xe6\xc5\xf2\x1a\xebh\xfa!\xf0`\x01\x86\xf53\x05\xff\xf9\xee\a\x8e\xfc\x83\n\xcd\xff\xfe\v\x1d\x03p\f\xd0\x06\xc0\x0e\u007f\vK\x14\xa4\x11#\x16:\x160\x14\xdd\x15\xa6\x129\x14\n\x13S\x14h\x13\xc4\x13x\x13\xeb\x10\xf5\x12\xd3\x0e\xd7\x10\xd7\f;\x0f\x87\v\xa0\x0e4\v\xb1\x10\xb8\v-\x14I\x0e\x0f\x16\xd0\x10\xa8\x15\xd8\x11\x92\x12\x18\x11\xef\r\xaa\x0eU\a%\t\xf0\x02\xa6\x05$\x02\xa3\x05k\x03\xa5\x06\xc1\x04\x16\tY\x02\xec\b\xd0\xff\x18\x05\x85\x00o\x02H\xff\t\x02%\xf8F\xfd\x15\xf3g\xf6g\xf4\xc9\xf5\xee\xf2\x05\xf7\xf8\xf0\x01\xf3\xa6\xf6n\xf2>\xfeg\xf90\x04p\x006\v\xc1\x05\xf8\x13\xaa\f\xca\x18J\x13\xf7\x18\x98\x15J\x1a\xf5\x16\x17\x19.\x17\x83\x13\xb8\x14\x03\rt\x11\xc8\t#\x10\x96\x068\x10\xb6\x02\x86\r\xfe\x00\x15\n1\xfd8\x06/\xf8\x03\x00.\xf5n\xf9\xb4\xf0\b\xf4\xc3\xea\u007f\xee`\xe7$\xe8\xd9\xe5@\xe4\xb5\xe1e\xe2sܿ\xde\xd7\xd8t\xdcc\xd8Rޮ\xdc\xe1\xe2\xdf\xe3\x03\xe9l\xeba\xef\xe4\xee\x98\xf3V\xf1x\xf4\xaf\xf6C\xf6\xc6\xfb\xdd\xfa\x9d\xffA\x008\x04\x8a\x05@\n\x11\v\xc4\x0e0\x0f\x9f\x11\xc8\x10t\x11\xa1\x10\xc3\x0ep\x0f\v\rf\r[\f\x84\v2\v\x9f\t\xa7\a\x0e\x06\x0f\x01W\x02\xae\xf9~\xfe\xd4\xf2\xe5\xf9\xfe\xedv\xf4R\xeb\xd3\xf0\xac\xe8;\xef\x93\xe7X\xee\xff\xe7\xdc\xed\xd7\xe6;\xeb5\xe5\xae\xe7Y\xe6\xe8\xe6\xca\xe8\x8f\xea\xf4\xee\x81\xf0\x9c\xf5\xb4\xf7\xf9\xfa\xd2\xfc\xbe\x02\x14\x00i\t\x9b\x03\x01\r\xc0\x05\xa3\x0e\xb4\x05\xcf\x0e\x9a\x06!\x0e\xd4\b&\x0e\xcd\n\xb8\f0\f+\n\x13\r\xd3\aP\r,\x06b\fR\x04\x13\n\x90\x02\xe2\x06`\x01m\x04\x88\x01\b\x01\xc3\x02h\xfe\xff\x01\x8c\xfcE\x01[\xfap\xff\xda\xf9I\xfd\x99\xfbk\xffZ\x00\x99\x02\xac\x05\xf5\x04\xd7\x06\xa0\x06\x9a\x04\x14\a\xe0\x03d\b-\x04$\n\xa5\x04\x8e\n>\x05\xbb\tI\x05\xba\b\\\x05\x15\aP\x05\x03\x03\x90\x04x\xfd`\x03\xee\xf7\x17\x01>\xf58\xfdt\xf4t\xfa\xf6\xf3.\xf8\x98\xf2!\xf5{\xef\xf3\xf1\xff\xee^\xefR\xf0C\xee\xc5\xf2\xc5\xef\xab\xf6\x9a\xf3\xd2\xf8Y\xf7\xd1\xfa\x18\xfa\xb5\xfe\xc2\xfcp\x028\x00\a\x04\xec\x01}\x068\x04\xd9\t6\bQ\v\x0f\n\xe1\v\xdc\t\x13\x0e\xe2\v\x84\x0e\x9f\x0f5\f\xbe\x10*\v\xd4\r'\f[\nh\t\xcd\a\xad\x05*\x06\xce\x03\xa2\x06\xe7\x01\x85\x046\x00\x9f\xff\xe3\xfdu\xfc\x8e\xfbI\xfb\xdc\xf6\xfb\xf7\xbd\xf2\xd5\xf3\r\xf1\xeb\xf2l\xef\x86\xf1O\xef(\xee\xcd\xf1\xf8\xec\x85\xf4\xcf\xf1\x16\xf4]\xf6a\xf5\x14\xf7L\xf9C\xf8\xc5\xfc\xb9\xfbX\xff\x84\xff\x89\x02\x03\x03\x84\x05g\a\xfe\x05\xba\t \a\v\t\v\v\x1b\t&\r\xee\n%\r\x05\v8\r\x8f\t:\v\xa1\b\xcf\x05\xf2\x05\x02\x01\u007f\x01\x18\xff)\xfem\xfc\x82\xfc\xd8\xfa\x99\xfa\n\xfc0\xfa\xda\xfc&\xfb2\xfe\r\xfd:\x01\x88\x00\xd2\x05\xbf\x04\xfd\t2\a!\ry\b\xa9\x0e\xaa\n\xfd\x0e\n\r\xa3\x11\x03\x10H\x15R\x12\xb6\x18\xac\x13a\x1a\x05\x15f\x19\x90\x15\x9a\x17b\x16\x10\x15\x06\x17\xf9\x11f\x14\xa3\f\x16\r\xc0\x04\x8b\x04\xa1\xfa}\xfb\xe3\xef\x83\xf2\x81\xe7~\xebf\xe2\xf3\xe6H\xe0\xdf\xe4\xb2\xe0\xad\xe3H\xe2\xde\xe4\xa5\xe3R\xe9\xb9\xe5\xa0\xed\xcc\xea\x85\xf0\x8c\xf1\xaa\xf4|\xf8\x17\xf9B\xfe\xfd\xfbC\x03\xc4\x00S\t\xe5\aj\x0f\xac\x0eu\x15\u007f\x14q\x1bj\x1a\xba\x1dS\x1fY\x1e\xfb\x1f#\x1f8\x1f;\x1e\x8f\x1e;\x1b\xa8\x1bn\x16\x10\x16\r\x11\x97\x0es\f\xa6\bL\x06\x9b\x03N\xfe\x10\xfd1\xf8(\xf8\xc3\xf34\xf6o\xef\xfd\xf2+\xed\x8d\xed\x1c\xeb\xa9\xe8h\xe8\u007f\xe5b\xe5\x18\xe5\xf3\xe4B\xe5\xd6\xe8\xc9\xe6\x04\xed\xa4\xebR\xf1\xd5\xf0t\xf8\x11\xf7a\xffK\xfe\xce\x03\x8d\x02\xa5\b#\x06\x02\x0fI\f\\\x13\xd7\x10Y\x14\xc6\x12\v\x13\xab\x133\x10f\x13\xdb\f%\x13v\nP\x12W\t\xbc\x10\xf7\x06\xae\x0f2\x02A\v\xb0\xfd6\x04\x8a\xfa\xff\xfe\xb8\xf6\xb6\xfa\x9e\xf3\xbb\xf6\xf0\xf4\u007f\xf5r\xf6>\xf5\x8e\xf6$\xf4S\xf8\xcb\xf3\x90\xfa\x93\xf6\x8a\xfc\xf6\xf8-\x03\xa1\xfa`\r\v\x01]\x13\xf2\aV\x15\xa1\t@\x17\xfc\v8\x19U\x11\xa1\x1ax\x15\xd1\x19\x10\x17\x1b\x17P\x16\x87\x12\xb4\x13\x94\v\xa6\x10\r\x05\xd9\f\xc8\xfe\x00\a\xec\xf7\xc6\xfe3\xf3!\xf8\xc3\xed\xf4\xf3t\xe7\x87\xef\xf0\xe2v\xe9\xd3\xe1\x8c\xe5Y\xe2\xb2\xe5\xf7\xe1\x10\xe4\xc7\xe1\x17\xe1\x8a\xe2b\xe1\x02\xe6]\xe6;\xecU\xed9\xf61\xf4\x9b\x01\xc6\xfbp\n`\x04\xdf\x11\xc3\rP\x19\xee\x15\x1f\x1f\x9a\x1c\xed\"\xb1\x1f\xd5$f \xf0$\xb4 K$G _ \xa7\x1d$\x19\u007f\x19\xd4\x11G\x14&\n\x98\r\x95\x024\x06U\xfa\x13\xfe\xa3\xee<\xf5\x1e\xe3\v\xec\xae\xdbA\xe3\xed\xd7z\xdcB\xd6E֤ؖ\xd7\xea\xd7<\xda#\xdbJ܅\xe1\u007f\u07b8\xe7\xf3\xe3\xf3\xeb\xf5\xe9T\xf1#\xee\x14\xf7\xf2\xf2\xf8\xfa\xf2\xf7%\xfe\xda\xfa\x10\x02w\xfdW\a\x00\x01\xb8\x0e\xb9\a\xfd\x13 \x0fs\x14\xe2\x11[\x12\"\x10\x05\r\xae\f\xcc\x03S\aP\xfa\xc9\xff6\xf3\xaf\xf8_\xed\x12\xf3o\xea^\xef\xa9\xe9m\xef\x84\xe7\x02\xef\xe9\xe7\x11\xed\t\xeb\xb2\xed\x9d\xed\xa6\xef|\xf2A\xf0\xd2\xf8y\xf2t\xfc\x8c\xf7\x9d\x01\xa8\xfc4\b@\x012\f\xc0\x03\xca\x0fn\x04\x1f\x13\x01\t\x1b\x14\x18\x0f\x91\x14\x81\x11/\x13\t\x12\xe1\x0e\xa8\x0fs\n\xba\t\xc0\x06$\x05\x0f\x03c\x02J\xffG\xff\x90\xfa}\xfc0\xf5\x8d\xf8\xd4\xf2d\xf3\xd7\xf1\xd4\xf0\xe2\xefS\xefh\xee\x95\xed\f\xefd\xedK\xf0f\xef\n\xf1\x9f\xefb\xf6\xc6\xf0\\\xfc\x1a\xf6r\x00\xb6\xfa|\a\x18\xff\xae\x10\xff\x06\xf2\x14-\x0f\xf3\x16a\x13\n\x1d7\x18\xae M\x1dk \x05\x1e\xd8!<\x1e\xc8 \xea \xc9\x1a\xc6\x1f\t\x170\x19\x8c\x15)\x15\xe7\x10\xbf\x11$\f\xa1\v\xb1\n(\ba\b@\a\x96\x06\xd0\x03x\b\x8c\x00K\t\x1c\xff\xdb\x04y\xfc<\xff-\xf9\xe9\xfb\xe0\xf6\x05\xf6\xfe\xf2\xd2\xf0\t\xee\xca\xef&\xec\b\xf0\xa3\xec\xa5\xf06\xee\"\xf3\xd9\xf1\f\xf6\x1e\xf6\xe1\xf72\xf8\xa0\xfa\xc4\xf9\xf5\xfc\xa2\xfb#\xfe\x04\xfe\xa9\xff\x05\x01\xd2\x01\xe4\x03n\x05\x03\ad\b\t\n\xc8\bV\v\xc8\b\xbd\t\x9e\n\xf1\t\x8e\r\xba\r\a\x10X\x10l\x10\xdb\x10B\x0f4\x0f=\f8\v\x98\a\xc2\x06\xc8\x01\xbe\x02\xab\xfbl\xfeQ\xf6\xc1\xfa\x19\xf2\x86\xf7\xda\xee\xc2\xf3\xf4\xec\x99\xf0\x1e\xeb\xd7\xedY\xe8\xa6\xeb\xf6\xe9e\xec\x93\xee)\xef\xb3\xf2F\xef\x8a\xf8\xdb\xf0X\xfdt\xf6\x14\x00]\xfa\xde\x03\a\xfc&\b_\x00\x14\bj\x04\x97\x06\x14\x06k\a'\bF\x06\u007f\n\xef\x02\"\n\v\x02\xba\a\xec\x01M\ak\x004\a{\xfd\x16\x05\xfa\xfa\xcb\x02\xfe\xf9g\x01\x94\xf9\x16\xffo\xf9;\xfdv\xf8=\xfc8\xf6\a\xfa\xce\xf5\x97\xf9\x89\xf7P\xfb\x99\xf8\xf5\xfaX\xfb2\xfa\x8c\x00+\xfdT\a\x94\x02\x85\x0e\xdc\a6\x14a\fe\x18\xc4\x10%\x1a\x8b\x12y\x19\x14\x12\xbe\x13\xd4\x0f;\f\x8c\f\xf9\x04}\bx\xfc\xc4\x01\xa4\xf7\x02\xfd\x98\xf5s\xfc\xf8\xf0\xe1\xf9>\xec\xde\xf2\xb3\xe8;\xee\xee\xe6\x85\xeb\xd2\xe8\xcc\xe9\x97\xeay\xeaL\xed\x1b\xecO\xf3\xca\xef\xb8\xfa\x82\xf5\f\x01\xaf\xfa\x1e\x05\xb8\xfe\xc3\t|\x04\xb3\x10\xc4\v\xb2\x18\x14\x12\xce\x1d\xfc\x15\xcf\x1eY\x17\x87\x1fl\x19\"\x1fn\x1c\x80\x1c\x12\x1b\xc6\x1a2\x17u\x17\xa8\x15\x17\x0f\xfc\x11\x9f\x04M\b\xa7\xfd\xde\xffQ\xf5\xc2\xfa(\xec\xf5\xf24\xeaR\xecg\xe8S\xea\x91\xe3\xda\xe6\v\xe4\xa8\xe4e\xe8\xb9\xe7\x86\xec\xf8\xe9\xf5\xf1d\xebF\xf9\xd5\xf10\xfd\xbc\xf8V\xfeV\xfc\xfa\x02\xd4\x01\xaf\aa\t\x97\n\xeb\f\x8d\x0e3\x0e\x90\x13\xae\x12\xba\x15\xd5\x18\xc4\x14\xb3\x1a\xae\x13|\x18k\x11\xd2\x14\x96\r\xef\x0f\xd8\t\x18\vp\x06\"\b\xba\x02|\x06N\xfe\x9e\x03m\xfc,\xff\xf1\xfe\xb2\xfc\x80\xfd\xfd\xfbR\xf8\x15\xf7O\xf8\xde\xf3&\xf9\x03\xf7W\xf8\x92\xf7\xa6\xfb\x92\xf5\x14\xff\xb2\xfaH\xff\x13\x00\x03\x01\xa8\xffG\x04\xc5\x01\xf4\x03\x1c\x05+\x02\xdd\x04\x86\x04\r\x06\x8a\bD\n\x98\n\"\f\x12\vY\f\x12\v\x1b\f\x94\n\xdf\v\xf6\b\x99\f\x8f\bL\v\u007f\a\x88\a\xa2\x045\x04\xbd\xff\x15\x01\xd1\xf98\xfdO\xf6}\xfa\x01\xf3\xdb\xf8#\xef\x06\xf6A\xeeT\xf3\x15\xeeY\xf2\x18\xec;\xf1\xde\xeb@\xf0d\xed\xcd\xf1\xb6\xed\xf5\xf1\x02\xed\xcf\xef\xac\xeb\xf0\xee\xc1\xea\xcc\xeed\xeb\xc3\xef\x96\xf0;\xf3\xa1\xf9\x82\xf8\xa1\x01H\xfd@\x06&\x00\a\v\xab\x03h\x11\x8e\t\x8a\x17i\x0eJ\x1b\xa7\x10.\x1bU\x11\xfa\x17>\x10\xc8\x13A\x0e\x96\r\xa0\vX\x04/\a\xfc\xfb>\x01F\xf7\xef\xfb\xa4\xf44\xf8L\xf0v\xf5\xa1\xeb\xbe\xf1\xf5\xe8\xd0\xed\xb7\xe6H\xeb\x01\xe5\xf9\xe8\xc4\xe23\xe6\xb6\xe0\xff\xe3\xdd\xe1\xa6\xe3\xa2\xe5\xb8\xe6Q\xea\xb9\xea\x8e\xf1\\\xef\x8a\xf8\xcc\xf53\x00\t\xfc\xdc\b\xd1\x03\x9f\x0e\\\v\x82\x121\x10\t\x16\x11\x13\x12\x18\xa6\x15j\x18\xcc\x17\x1b\x19^\x18x\x18\x85\x18|\x16\x95\x17\xb6\x14\x87\x16\x03\x12\xd3\x14y\x0eL\x10\xbd\n\xa2\n\xad\x05\x84\x05q\xff\x9c\x00f\xfa\xab\xfb\xa9\xf6\xf9\xf6\x15\xf5Y\xf5\xec\xf3\xdf\xf5}\xf2\x13\xf4\xef\xf2\xb9\xf0\x12\xf3\xda\xefN\xf2\x0e\xf1\xac\xf2\xa9\xf2X\xf2\xa2\xf4O\xf1\xe4\xf5'\xf1\n\xf6\xa1\xf1\xee\xf6\xd3\xf2\x1c\xf9\xea\xf58\xfb\xcf\xfa\xcb\xfe\x87\xffa\x02\xe1\x04y\x03\xfa\n\xab\x04\xc0\x0e\x85\x06N\x11\xec\b\xa3\x13\xbc\f\xd4\x14\xe6\x0eI\x17*\x0e\x8e\x18\x9d\x0e\xa7\x15\xe4\x0e&\x10/\f\x9d\v\x1f\n\x88\b\xae\t\x95\x06\xe4\aq\x06\xee\x06'\x04\f\x06\xcb\x00\xa2\x03d\xfe\x95\x02z\xfb6\x02\xc7\xf8\xea\xff\x9a\xf6\x0f\xfc|\xf3\x92\xf8\xfe\xef\"\xf5\xf0\xed\u007f\xf2W\xed.\xf2\x14\xf0\x85\xf3Q\xf5E\xf65\xfbn\xfa\x80\x01Q\xfej\x05\x1f\x00&\x06&\x00\xcb\x06\xe9\xfeM\n\xbf\xffL\v\xc8\x01\xe6\t6\x02C\t\n\x04\xe1\x056\x06\\\x01v\x04\x93\x01/\x02\xcf\x01\x92\x048\xff'\x05\v\xff\x94\x02.\x00v\x02v\xfe\\\x01#\xfd\xba\xfc\x0f\xfe\x92\xfbv\xfc\xa3\xfcr\xfci\xfc\x9e\xff\"\xfeE\x00T\xff\xc9\xfdJ\xfe\xc6\xfb<\xfd\xc8\xfa\xad\xfc\xd9\xf8\xf1\xfa\xcf\xf6D\xf9\xc3\xf6\xad\xf8\xba\xf92\xfa\x8c\xfc\xd8\xfc)\xfd \xfd\"\xfd\x1c\xfb\x05\xfe\xbe\xfa\f\xfeY\xfc\x9b\xfcb\xfc\xa7\xfc\x92\xfc\xb0\xfcg\xfe\x91\xfb\x90\xfe\x85\xfd\n\xfe\xa5\xffJ\xfe\xe1\x00\"\xff}\x02\xd8\x00M\x05\x01\x03\x14\tN\x05'\f\r\x06\f\x0e#\x06\xbc\f\xce\x06\x13\nF\aR\x066\a0\x01\xbc\x04\xb0\xfd\u007f\x01\xf5\xf9\xa3\xfe\xd7\xf6\x01\xfc&\xf7\xb2\xfa\x17\xf8\xa1\xfa\xf4\xf7\a\xf9*\xfag\xf7\xed\xfc\x8f\xf8n\xfd\xf6\xf8\x8e\xfc\xd6\xf7\xbd\xfcP\xf8\xc5\xfc\xcd\xf9\x8a\xfd{\xfa\x05\x01\xc7\xfc\xf1\x03\b\x01\x98\x05\x9c\x05J\t\x05\n\xc8\f\x05\r\x95\r\xcc\r\x9f\r\xd6\r\xf2\f_\x0eP\v\xf7\f.\t\x9b\t\xf8\aA\b\xab\x06\xe2\b\x1a\x05\xa7\b\xa0\x05\xb7\a\xed\x04\x14\x06\xc5\x01\xf8\x01\xd8\xff\xbf\xfd\xa4\xfe<\xfb\x1c\xfd\v\xf9\xaa\xfb\xd4\xf6\x06\xfc%\xf6\x00\xfew\xf6C\x00\xfc\xf6=\x04\xed\xfa\xcd\x06\x8c\x00\xce\b\x95\x03\t\f\x12\x06i\f\u007f\b\xf5\b~\b\xa0\x03\x16\x06\v\xff\t\x04\x03\xfc\x15\x03\xf7\xfb\x15\x03W\xfd\xca\x03]\xfdJ\x03\xd7\xfc\x1b\x02$\xfda\x01\xb6\xfav\xffq\xf7)\xfa\x90\xf7\xe3\xf6e\xf7\xb3\xf6\x91\xf8\xec\xf6\xc9\xfb?\xf8\x83\xfd\x98\xfa\x92\xfds\xfb\xb3\xfe\x1e\xfb\xe2\x00,\xfc\xd3\x02\x94\xfc\x9c\x05(\xfe\xcb\a\xef\x01+\t\f\x04\xd1\n\xed\x04\xea\nR\x06\x1d\n1\a\xd0\t\xb2\a\xea\ti\b\x01\fd\t5\rK\vf\v\x90\v\x16\v\x90\t\xdf\v\xe3\b}\vi\b\xe0\n$\a\x89\n|\aQ\n\x9c\b\x9d\bp\a\x93\x06\x88\x04\n\x05F\x03,\x02\xfc\x02\xe0\xfdq\x02\x99\xf9'\x01\x82\xf6M\xff)\xf3\x81\xfc\xef\xef\x02\xf9\xd1\xec\x8b\xf5\x12\xe9\x02\xf2\xf3\xe5#\xef\xfa\xe5x\xeds\xe9\xc5\xed\x83\xee=\xf1.\xf5\x9d\xf6_\xfe3\xfdJ\aM\x04e\r=\t\xb5\x144\rE\x1c\xff\x13\v\x1f\x14\x19\xd3 \x92\x1a\xbf\"'\x1d\xf7!2\x1f\xa7\x1f\xdd\x1e~\x1d\xab\x1e\xa5\x1aF\x1e\x96\x17\x16\x1bH\x14\xd7\x16\t\r~\x10\\\x01i\x06\xa6\xf5\x8f\xfb\xea\xeb\x1b\xf2\x14\xe3\xb4\xe9\xc1\xdbt\xe2T֍\xdd\xe0\xd2b\xda\xc5\xd1\\\xd7f\xd4\xce\xd6H\xd9\xc4ٰ\xdf\xe8\xdd|\xe8Q\xe3\x9e\xf2\xc4\xeb\xf9\xfcQ\xf6\xbe\x04\x9d\x01#\n\x0f\v\x95\x0f\b\x11Q\x13\xa8\x150\x14+\x19\xeb\x14\xd9\x1a\x17\x16\xec\x1bC\x16\xf1\x1b\x80\x15H\x19\x85\x12\xc3\x14\xe5\f\x8d\x0e\x80\x06~\ba\xffV\x03\xea\xf8\xe1\xfc|\xf2z\xf4\x98\xeb{\xeb\xe3\xe4\xe2\xe3w\xdf\xf2\xde\xcf\xd97\xdb?\xd5\xfd\xd6\\\xd6}\xd6\xdeٌ\xdab\xdeX\xde\xf1\xe7\xa5\xe4\xfc\xf2o\xef\xee\xfa\xf7\xf8d\x02\xfc\xfe\n\f\xb5\x05\xc9\x13g\r\x1d\x19\x02\x131\x1d*\x17\xd2\x1d5\x1a\x89\x1a\xba\x19\v\x17\xc2\x16\xdb\x13\xf3\x14\x86\x0e@\x12!\t\xb6\f\x84\x06?\a6\x03\x82\x03 \xfc\xe4\xfdf\xf4\a\xf6y\xef\xcb\xf1d\xebU\xf0s\xe6\xe8\xece\xe1(\xe8\x8f\xde\xf3\xe5eܬ\xe6\xf2܅\xe6\xfb\xe3\xdd\xe7\xa0\xea\x83\xec\\\xee\xb5\xefa\xf5G\xf3\xd9\xfd\xcb\xfb]\x04\x85\x03a\f_\bZ\x15\xac\x0e\x19\x1b\x12\x15\xec\x1el\x19\xaf\">\x1ek#+!\x9f\"m\x1f\x1e\"\x91\x1cW\x1eb\x19T\x17\x8f\x14D\x10!\x0f\xbe\n\xa8\v\xc7\x05\xfc\b\xfb\x00\xf5\x03\x83\xfa\x11\xfe\xaa\xf1d\xf7\xb5\xeaa\xf0|\xe5\xc7\xea'\xe1\xc1\xe5\xab\xde\x1e\xe1\x9f݉\xdfF\xdd\v\xe0\r߾\xe1)\xe3\b\xe6:\xe9\xe7\xea\xc1\xf1\xf7\xef`\xfbJ\xf7\x8f\x05\xf7\xffr\x10`\bX\x19A\x10\xef\x1f\n\x16\xd4%\b\x1b\xe3(\xe7\x1fd(\xb4\"^&\xdd\"\xa7$\xab#o \xa6#\x97\x1a\x89\x1e\a\x14\xf4\x16\x02\rx\x0f4\x06\x85\t\x9c\xfe\x8d\x03C\xf7N\xfc5\xf1\xfb\xf4\x11\xec\x91\xf0\x8b\xe6\f\xed%\xe1\xc3\xe7\x8e\xdd\xc3\xe3\xf1\xdcQ\xe3I\xe0?\xe6Y\xe5\x02\xea8\xeaw\xec\xcf\xef\xf4\xee<\xf6B\xf4\xff\xfc\xc7\xfa\f\x04O\x00_\n\xfd\x05v\x0em\v\xf9\x115\x0f\xce\x15\u007f\x11\n\x18\xaa\x12\xcd\x17,\x12\xbf\x15\xbb\x11\xb0\x12P\x11\xa4\x10x\x0f\xb8\r\xb8\f\xa1\b\b\t\x04\x04\xfe\x03$\x00\x82\xffE\xfc4\xfc\x9f\xfa\x1e\xf9\xb8\xf9\xa0\xf8 \xf8*\xf9\x9e\xf8\xdb\xf8G\xfaz\xf9q\xf9\x94\xf9\x11\xf7\xb5\xf7\x8f\xf6\xfb\xf6<\xf7\xd3\xf8\xb7\xf9\x92\xfa\x88\xfe\x17\xfdz\x02\x87\x00\x81\x03\x8b\x03)\x03\x99\x04\xf3\x03J\x04\xf6\x05\xa1\x04{\a-\x06\xfd\a\xd4\a\xb9\x06\xce\a\xc1\x03z\x05\x83\xfe\xbd\x01Y\xfa&\xfe$\xfam\xfd\x15\xfa\x82\xfe\x94\xf8\xff\xfd\x8d\xf7\xec\xfc\xb8\xf5%\xfcC\xf39\xf9:\xf4\x1c\xf6s\xf6*\xf6\x86\xf7:\xf7-\xfcz\xf9e\x03\xf3\xfe\x83\b\xde\x04y\v.\a\xb8\rJ\b\xe7\x0f\xc5\t\xcb\x11\a\vT\x13\xe2\f\n\x152\x0f\x12\x16\x86\x102\x16\xc6\x0f^\x15^\r$\x12\xc5\n\x8f\f+\tv\by\b\xe7\x05F\b\x8a\x01\\\x05\xca\xfb\x93\xff\xcb\xf5\xaa\xfa\xb6\xef\x18\xf6\xe5\xeb;\xf2\x99\xea\x19\xf1\x8a\xea\xde\xf1\xc3\xec\x01\xf3\x03\xf2E\xf5n\xf7\xff\xf8/\xfa\x94\xfb\xa9\xfdp\xfd`\x02\xb5\x01\n\x06\xf9\x06\xa8\t;\nL\x0e#\r1\x11S\x11\xa0\x12\xc1\x12\xab\x14\xb2\x12\xdf\x14k\x13\x01\x13\xa8\x12\xd3\x10u\x10\xe1\x0e<\x0f\xae\f\xee\r\xe0\nY\vp\b}\t\xd5\x03|\x066\xff\xb5\x01\xa7\xfb\x8d\xfd\r\xf8\xf9\xf9\xff\xf3\xb0\xf6\\\xef\xf1\xf2|\xeb\xed\xee\xda\xe8\x06\xecf\xe7\xcf\xea\xa6\xe6G\xea\x1f\xe7\xb8\xea:\xe9\x8d\xed\xb9\xec7\xf1\xa2\xef\xeb\xf2\xa5\xf2\xca\xf3\a\xf6\xcc\xf64\xf9\xed\xf9\x13\xfd\x85\xfc\x84\x01$\x00?\x055\x04\xeb\a\xdc\x06\xce\n \tQ\r\xd9\v\xca\r\xed\v4\x0e\x85\nc\x0e\xc5\n\t\f\xbc\n\xf0\x06M\a\xfa\x01\xba\x02\xb8\xfc*\xff\xdd\xf6<\xfb\xb4\xf2\xec\xf7\xd6\xef\xe2\xf5\x84\xecq\xf3]\xea#\xf1\xdd\xe9\xd8\xef\xb4\xe8o\xee\x80\xe6\xca\xeb\x8c\xe5\x03\xea\x92\xe6)\xec\x95\xe81\xef\xb4\xec\x9b\xf0\xb9\xf3\xa1\xf3\xf9\xfaS\xfao\x01\xf4\x00^\b\xcc\x05O\x0e\x12\n\xb8\x11\xa2\f\xb1\x13\xb2\r\xda\x15N\x0f\x85\x17\x92\x11d\x17s\x12\xe9\x16\x8e\x12>\x15\xa9\x12!\x13\x9f\x11>\x11\x01\x10\xb9\x0e\x96\x0eh\v\xd3\f\xca\ax\t\x05\x04\x17\x05\xe6\xfd\xca\xff\n\xf7\"\xfa\xa3\xf1\xb5\xf5\xf4\xed\x9f\xf2\xe9\xec\x01\xf1\x94\xee\x81\xf1\xb2\xf0\x94\xf3c\xf2L\xf5\xbd\xf3\xe5\xf5\x8c\xf4\x15\xf5\a\xf6\x93\xf4\x98\xf7\xee\xf5\xf2\xf8g\xf8~\xfb;\xfb\x93\xfe\xd4\xfe=\x01\x13\x02\xc0\x049\x05\x91\b\xdd\t\xd5\n\x98\rv\f&\x0f\xa8\x0el\x10\x8e\x0f\xdb\x11\xce\x0e\r\x11&\x0e\xb3\x0f\xb7\f\x9b\x0e\x93\nU\ff\t\xb7\n\x82\bT\n\xc0\x05d\b\xca\x02\xd1\x03\x88\x00\xd2\xff+\xfd\xe8\xfb\x12\xfa\x97\xf6\xce\xf7\xa1\xf2\xce\xf5S\xf1)\xf4\x83\xf1\xf1\xf3\xcc\xf1<\xf5*\xf20\xf6n\xf2\x8b\xf7\xfd\xf2K\xf9\xba\xf4\xd3\xfa9\xf7\x9c\xfb\xdb\xf8\xf5\xfb\xe3\xf84\xfcD\xf9\xef\xfc\x9f\xfa!\xfe{\xfcR\xfe\xab\xfe\xb0\xfe\x02\x01\x91\xffh\x02\x11\x00\xf7\x02\xae\x01\xc7\x03\xd2\x02!\x05Z\x02H\x05b\x03\xd4\x04\xb2\x04t\x05,\x05\x1f\x06]\aY\a\xb0\t\xa3\nv\t\x16\f\\\b\xe0\t%\a|\bM\x05\xea\a{\x04\xd1\x05\xe6\x04\xdc\x04\x80\x04}\x04\xe5\x03P\x02O\x03\b\x003\x02\x0e\xff\xec\x00L\xfe\xe2\x00\x9d\xfd2\x02\xaf\xfd\x11\x02|\xfdT\x00\xde\xfb\x97\xfd\xec\xf9\x17\xfb\x8c\xf8\xd3\xf9\xe3\xf7`\xfa\xae\xf7\x1f\xfc%\xf9\x00\xfd\x95\xfa\xcb\xfc\v\xfb\xa6\xfd\x8a\xfcm\xff\x82\xff\x00\x003\x01e\xff\x1c\x00\xb0\xfd\xc4\xfeW\xfaA\xfd!\xf6\xcc\xfa|\xf4}\xf8G\xf5\xa8\xf7\xb3\xf6\xd8\xf7\xd2\xfa\x0f\xf9\xaa\xff\x96\xfc\xf7\x02\x14\x00\xa9\x06\x89\x01\xbe\t\xa7\x02\xe6\v\f\x04'\x0e\x87\x05\x9a\x0f\xc5\a\x0e\x0e\v\t\u007f\v\x99\b|\t1\b\x04\a\xad\a\xa5\x04\x90\x06Z\x04\x14\a\x91\x05\x8b\b\xb7\x06\x05\b\x9e\x06\xcc\x05*\x04&\x02\xd4\xffr\xfe\xe5\xfa\xe9\xfa(\xf7\xa6\xf7`\xf6\xce\xf5\x06\xf9^\xf7\x13\xfd\xab\xfb\x98\x00\x12\xfe\xb7\x03\xea\xfe\xd8\x05\b\x000\a<\x00y\b+\x00\xd4\a\x03\x01\xa2\x05\x13\x01\x84\x048\x01M\x04\xd4\x02\xe9\x03\xe3\x03\xb6\x04\x1a\x05\xc7\x06\x94\bc\b\\\fA\n!\r\xdb\f\xfc\v\x00\r\x19\vq\t\xd4\a\xc0\x05!\x04\x81\x02H\x03\xf1\xfe7\x02\xe9\xfc\xf8\x00\x81\xfd\xa8\x01/\xff\x98\x02\x8f\x01#\x02\xa1\x05\x92\x02k\b,\x04_\b6\x04\xea\a\xe9\x03\xfa\a(\x04\x85\x06\xcc\x03\x90\x03#\x02\x14\x02\x8f\x01\x8b\x01\xc6\x02\xdd\x00'\x04y\x02\xae\x04\xcf\x05U\x05\x90\x06\x01\x05$\x06\x9c\x02\xf5\x06\xd7\x01\xfb\x05O\x03\xba\x03\xcf\x02\x9f\x03)\x02H\x04\x03\x05\x90\x03\xd7\x060\x03:\x05!\x03\x13\x05\xcf\x00\x16\x05\xde\xfe\x1e\x02\xad\xfeT\xff\x12\xfe\x1f\xfeC\xfd\"\xfd\x17\xfe\xa1\xfd\xc2\xfe\xb9\xffP\xfd\x04\x00o\xfc\x9c\xfeK\xfd\xaf\xfe~\xfe\x16\x00\xe8\xfe\xa7\x00\xa4\xfd\x05\xff\x8e\xfbL\xfc{\xf9\xb2\xf9R\xf9\x93\xf8\x9f\xfa}\xfa\xc9\xfbd\xfd\xb6\xfdI\xff@\x01{\x01Z\x04\xe2\x03\u007f\x06\x93\x04\xf9\a\xb6\x04\xc0\a\x18\x05\xd4\a\xee\x04_\b\xda\x05Y\a3\a\xa3\x05s\a<\x05^\a\xd8\x06_\bk\x06\xad\b1\x03\xaa\x06e\xff\xa8\x03\xae\xfb|\x01\x02\xf8\x96\xfe\xdc\xf43\xf9\x1f\xf1\xa8\xf3}\xebB\xef\xf4\xe5H\xec@\xe3\xc5\xebn\xe2\xa8\xec\xd0\xe1\x91\xeb*\xe2\x00\xe9\x04\xe4'\xe8'\xe8\xdc\xe9\x86\xed.\xedD\xf3?\xf1\xcf\xf92\xf7\x0f\x01\x06\xfex\t\xf1\x03\xd0\x11d\n\xa5\x17\xa7\x11b\x1b\x16\x17+\x1e\x0f\x19\x88\x1f2\x19\xf6\x1d\xcd\x18\xbc\x18\x82\x16A\x12N\x12\xf6\v\xa5\r\xc1\x04\x14\b\xed\xfc\xd5\x00\xac\xf5B\xfaE\xed\x1a\xf4Q\xe4=\xec\x86\xde\xf7\xe4~\xda\xd7\xdfj\u05cfۄ\xd7\x03ڔ\xd9\x1dݺۚ\xdf\x15\xe0\xb6\xe0}\xe6\b\xe5n\xed{\xec\xc0\xf4\x1a\xf4W\xfd\xc3\xfb\x88\x05\b\x03\xd8\f\\\a\n\x14\xa9\f\xb9\x19\x97\x13j\x1d\x8a\x18\x06\x1f\x97\x1b0\x1f \x1e\xb5\x1d\x99\x1d-\x1a\x99\x19\xc2\x13\n\x15\xe1\v1\x0f\xef\x04\x18\b\x99\xffk\x01`\xfa=\xfb\xf2\xf4\xca\xf5\xa4\xef\xc9\xf1\x8b\xeb\xbc\xee\xe3\xe9Z\xeci\xea\xee\xea\xaa\xea\v\xea\xa2\xea\xae\xea>\xec\xef\xec<\xee\xb8\xeei\xef\xdd\xee(\xf1\xf3\xef\x8a\xf4\xb0\xf3Q\xf9a\xf95\xffQ\xffN\x05\xb7\x05 \n\x1a\n\xfe\r\x11\f\x1e\x10\xf3\r\xdf\x10\x91\x0f\x97\x10\xe6\x0fp\x10\x9f\x0f\x15\x11\xed\x0e\x98\x11\x00\x0e>\x10`\r\t\rc\v1\tX\bC\x05w\x05\xba\x02\xf0\x02\xcf\x00\x1f\x00Q\xfe\xb3\xfd\xb2\xfb|\xfb~\xf9I\xf8P\xf7U\xf5x\xf4J\xf3w\xf1L\xf2H\xf0P\xf2\xdf\xf1Y\xf4s\xf4R\xf7L\xf6\xbd\xf8y\xf7\xe5\xf8\xa2\xf8\x94\xf9Z\xfb-\xfc\xb2\xff\xf6\xff\a\x05\x94\x03'\n\xcd\x06(\x0e\xba\t\xd3\x11\xd9\f\x1d\x14[\x0f\f\x14Z\x107\x14$\x10`\x14T\x10F\x12\x05\x10_\x0e\xaa\r\x01\n\x9a\n\x03\x05\xcf\x06G\x00\xa6\x01E\xfd\xa0\xfd\xa9\xf9s\xfb\x12\xf5L\xf9\x8e\xf1\r\xf7u\xee\xd0\xf4\x97\xebV\xf1\x96\xeb\x0e\xef\x96\xed$\xf0\xa9\xefW\xf2>\xf3\xae\xf4\xf7\xf8\xb0\xf8\xbd\xfe\x10\xfd\x1a\x05%\x011\r\xea\x06\x87\x14\xa8\r@\x1av\x12J \xf2\x16o$d\x1b\xd1%\xc3\x1d6&\xd5\x1eo%D\x1f\xfc!\xcd\x1d\x99\x1c6\x1b\xc6\x16\\\x18\x1e\x10\xf6\x13'\tp\x0e\xab\x01=\b-\xfbI\x01\xfd\xf4R\xfb\x86\xee\xda\xf5\xd0\xe8u\xf0\xd9\xe4\n\xec\x82\xe3$\xea\xe5\xe4\x9f\xe9c\xe7\x9a\xeaH\xe9\x90\xec\xa1\xeb\x93\xee\"\xef\x86\xf0u\xf4\xa3\xf3x\xfao\xf8\xb9\xff\xcd\xfc\x10\x05\x1b\x01\x0f\n\x1c\aC\x0e\xe2\f\xb7\x12|\x11\x8f\x16[\x15?\x18\x91\x17\xde\x17:\x17\xf0\x15\xa1\x15\xc2\x11\x9e\x12\xff\v\xc7\rt\a\xfb\b\x92\x04\xad\x05\x0f\x01Y\x03b\xfc\x03\x00\xc1\xf9\xee\xfbn\xf7J\xf9}\xf3\x16\xf6\xc8\xf0L\xf1\xd1\xef\xb7\xed\x11\xee\n\xec\xa7\xebR\xea \xea\"\xe9\xc7\xe96\xe9Y\xeb\xba\xea\xe2\xed\x85\xed\xf9\xf0\xac\xf0\x93\xf4\xf2\xf3\x95\xf7\xe7\xf6\x18\xfas\xf9\xba\xfd\x06\xfc!\x01\x8d\xfe\xb9\x04\xb5\x00\x9e\t\x1c\x04\xfe\r\r\t\xee\x11E\x0e\xc5\x14\xb9\x12\x19\x17\xa1\x16\xb9\x19f\x19V\x1b\xb3\x1a\xa4\x1ai\x1a$\x19\x05\x19q\x16\x1d\x17\xd5\x12P\x14\x94\x0f\x02\x11_\f#\x0eD\t\xae\v\xf1\x059\t\x11\x03q\aF\x01\xac\x05\xff\xfe\xc6\x02\x16\xfc\x11\xfeI\xfa\x90\xf9\x1d\xf9\xb0\xf7~\xf8M\xf7\xd1\xf8\xe2\xf6\a\xfb\u007f\xf7G\xff\xf2\xf9b\x04@\xfe\x80\tH\x03\xdf\x0e\xaa\b\t\x14\x83\x0e?\x17 \x13L\x18\"\x15\xce\x18w\x15\xee\x16\xeb\x15\xe2\x11\x8a\x14\x1b\r\xb1\x11\f\tZ\x10\x80\x02\xcf\r\xe7\xfa\xf8\a\xba\xf5\xdb\x02\f\xf2\f\xff\x87\xef\x88\xfa\xe4\xeeZ\xf7\xda\xec\x93\xf4e\xea\xfa\xef>\xe9\xd1\xec\xc6\xe8\x06\xec\x9e\xea\xca\xeb8\xefW\xee\xf9\xf3\xf2\xf3\xd9\xf8\xe5\xf8.\xfe8\xfc\xed\x02%\xfe\t\a\xff\xffW\n7\x03\xeb\f\xc3\x06\x16\x0e\x17\t\x95\r\x97\n\x1a\fh\v\\\bf\t\xda\x02\xad\x04C\xfd\xd2\xff\x8f\xf6=\xfc\xb7\xee\xcf\xf7y\xe9\xe6\xf1F\xe5\xf6\xec\xe9\xdf\xff\xe7/ܜ\xe2\xeb؞\xdeF\xd6Mܸ\xd5\xe4ێ\xd66\xdd\xea؊ޟ\xdcI\xe0\xc9\xdf\xfc\xe2I\xe3\x8d\xe5\xff\xe78\xe97\xec\x04\xee\x8f\xf13\xf2P\xf7\x10\xf7\xd3\xfa\xc6\xfb\xc9\xfd~\xfe\xcb\x00\xa4\x00u\x02Y\x02\x9d\x04g\x03v\x06\xb4\x04*\x06P\x04B\x04\x98\x01\x8d\x01\xc5\xff\xed\xfd\xe4\xfd\x9c\xf9\t\xfan\xf5\xab\xf6?\xf2\x1c\xf5\r\xf0\x8f\xf3-\xef\xd3\xf1\xf2\xef{\xf1\xa6\xef\x8c\xf1\xb1\xee-\xf0&\xf0~\xef\xc7\xf1\x90\xf0\xd1\xf2\x95\xf1\x98\xf5\x1e\xf3\xf1\xf8\xbb\xf6\xc3\xfa*\xfa{\xfc\b\xfc\x19\x00\xb9\xfe \x04\xd9\x01A\b\xd4\x04\xf4\v(\b\xc5\x0e.\nw\x10J\nG\x10\"\nh\x0e\xc4\t-\f\xb1\b:\n\x05\bi\t\xaf\a8\t@\aD\bQ\x06\xc6\x06\x13\x05\xbe\x04k\x03u\x02:\x01~\x01\x89\xfe\x99\x00m\xfdj\xfe\x18\xfdh\xfc\xe6\xfb\u007f\xfb\xd3\xfa\x8b\xfb2\xfb\xb0\xfc5\xfc\xe7\xfe\xdd\xfd\x1a\x01\xac\xff\xdf\x02\xd3\x00b\x04\x8a\x01P\x05\xfc\x01P\x05.\x02\xca\x05{\x02e\x06\x8a\x03\f\x05\xb2\x04\xd1\x03'\x04\r\x05\x83\x03\xa3\x06\x99\x04\xf3\a\xab\x05\a\n^\x06^\v#\a!\v?\x06\b\n\xc9\x04\x0f\b\xd4\x03[\x05\x05\x021\x03A\x00,\x02$\x00\x05\x01`\x00\x94\xffU\xffq\xffW\xfe7\x00\x88\xfe\x19\x000\xfe\xac\xffM\xfd\xd4\xfe\xb6\xfc\xb4\xfce\xfb\xa8\xfa\x81\xf9\xdf\xf8\xfc\xf8\\\xf7\x10\xf9\xeb\xf6\xce\xf8\xc7\xf7\xa6\xf9\x17\xfaV\xfb;\xfc\u007f\xfcu\xfcT\xfd\r\xfc+\xfd\xea\xfb\x1e\xfc\xd3\xfb\xb0\xfbR\xfcd\xfb\x92\xfdn\xfch\xfe\xef\xfe\x9e\xffe\x00\xf9\x01\x01\x02P\x04z\x04R\x06\x9b\x05u\b@\x06\xfc\t\xbf\a\xcf\t\x96\b\b\b~\a<\x06\xc0\x05\n\x05\x1e\x05\xbb\x03D\x04\b\x042\x03\xbb\x05Q\x038\x06\xf1\x03\xeb\x05-\x03\\\x05\xfb\x01R\x03\xc6\x00\x8e\x00\f\xfe\x14\xfe\xe1\xfa\xae\xfc)\xfa\xd8\xfc=\xfb\xa3\xfd\x11\xfc\x9c\xff\xf7\xfc=\x02\xa3\xfe\xf5\x03g\x00\xda\x041\x01\xea\x05\xe8\x01\xcf\a\x90\x04_\t\n\a\xf3\nM\bM\r\x88\n\x86\x0e\xf0\f)\x0eN\rb\x0e\xaa\r\xe2\x0eu\x0f\xc1\x0e*\x10$\x10\xd5\x0f6\x12\x8e\x10\xe9\x11\x85\x10\xd9\x0f\xbe\x0eC\x0e~\r\xbc\r\x06\rU\rV\f\b\x0e+\f\xd7\x0eT\fz\x0eX\v\x92\f\x94\t\x93\n\xec\a\xb4\n\x87\a\x99\f\x10\t\xd7\x0eY\vU\x11\xbe\f\xa3\x13\x15\x0e\u007f\x14X\x0f\xc5\x14k\x0f\x0e\x15\x8d\x0f\xa5\x148\x10j\x14\x06\x11\xad\x15\x8b\x12\x00\x178\x14F\x162\x14&\x16\xd0\x13b\x19i\x15>\x1c\xe9\x17\x90\x1bK\x18\xeb\x19\xd4\x16!\x18\xbe\x15/\x15f\x14X\x12\x9c\x11=\x10\xcf\x0f\x88\r\xa0\x0e\xae\n\xcb\f\xb6\t\xff\vk\t\xb7\v\x95\x06t\tu\x02Q\x05\xbc\xff\xcf\x01\xce\xfd\x06\x00\x89\xfc\b\xff\xce\xfa\b\xfe{\xf8s\xfcK\xf7\x8c\xfb>\xf7\xed\xfa\x06\xf8o\xfa\xd8\xf6\x16\xfa\xb3\xf4O\xf9\xd0\xf3\xb6\xf8\xf8\xf2\xc3\xf89\xf2\r\xf8+\xf3\xd1\xf7\xa7\xf3u\xf9p\xf4Z\xfb\xc3\xf5\xd8\xfcH\xf6k\xfde\xf6\xed\xfc\x9a\xf6\xd2\xfc\x16\xf7\xa8\xfc\x15\xf7\x82\xfb-\xf5X\xfaV\xf2\xa3\xf8Z\xf0a\xf6a\xf0\x1f\xf5M\xf1\xe6\xf4\x98\xf0q\xf3\x06\xee\xa9\xefM\xeb.\xec\b\xe8C\xea`\xe4[\xe7C\xe1\xdc\xe3$\xde6\xe1\xdd\xdb\x18\xdf\x1e\xdbi\xde!\xdbu\xdf\xfc\xdb\xca\xe0\xf5ݎ\xe2\xb6\xe0\x9b\xe48\xe4\xb1\xe5\x9f\xe6w\xe7\xb9\xe6\xab\xe9y\xe7_\xeb\xd9\xe9/\xee\xfd\xeb\x8b\xf0\xe1\xed\xc8\xf1\xb2\xef\xe0\xf3L\xf1g\xf6b\xf2\x1d\xf8\x93\xf3\x90\xf8\xd2\xf5\xb7\xf8\xbd\xf7\xda\xf8\xaa\xf84\xf9\xd1\xf8\xab\xf9\xa7\xf8\x1f\xfa\xb3\xf7\xe9\xf9\xe6\xf6#\xf9\xb6\xf7\xf2\xf8X\xf9i\xf9\x9d\xf9\xc9\xf9\x8c\xf8\xe6\xf8\x9f\xf85\xf7\x81\xf9Y\xf6\xe8\xf8.\xf5s\xf76\xf3\xa0\xf5\xf7\xf1K\xf3\x88\xf1\x06\xf2<\xf0.\xf3\x00\xf0\x81\xf4X\xf12\xf5\x15\xf2\xc0\xf6h\xf3\x02\xfa\xfb\xf5|\xfdr\xf8\xa8\xff\xb7\xf9\x10\x01\xb3\xfa\xe2\x02R\xfc\x9d\x04\xdf\xfd3\x06\x94\xffr\b\x0e\x03\xee\nX\a\x1f\r2\n\xf9\x0f\x9f\ve\x12\xf9\f\xd9\x12\x1a\x0em\x12\xd1\r\xe1\x12\xd1\r\xd5\x12\x81\x0e:\x11\x16\x0e\xa2\x0el\v\x14\f\xfa\b\xb9\t\x90\aP\b;\x06e\a\b\x05\xe7\x05\b\x04\xe4\x03\x15\x02\xea\x01=\x00C\x00I\xff\xc6\xff\xa8\xfe\x9f\x00\x0f\xff\u007f\x014\x00#\x02\x9f\x00\xba\x02\xc9\x00\xeb\x02\xd0\x01\v\x03V\x02\xa1\x02\xd7\x01\xa4\x02\xe1\x01I\x03M\x02\xe9\x03\x84\x02e\x03\xb6\x02-\x03\xef\x02(\x04\x9d\x03\x94\x053\x04\x1e\a\xe2\x04\x93\a\xa0\x05J\x06\xdd\x04G\x04\x03\x03\xf6\x01Y\x01\xa2\xff4\xff \xfd\xbe\xfc\xed\xfao\xfb\x10\xf9I\xfb\x80\xf7\xbd\xfa\xb8\xf6X\xf9\x80\xf52\xf8)\xf3\x9d\xf60\xf2\xe9\xf4\xa3\xf2\xdc\xf4\x1b\xf2-\xf5\x90\xf0\xd1\xf3\xd4\xef\xd9\xf2\xe1\xef\x97\xf3\xa4\xf06\xf4\xb5\xf1\xf2\xf3\xfa\xf2E\xf4\xf4\xf3\x8a\xf5\xb9\xf5M\xf7\x03\xf9\xac\xf9\x04\xfc\x18\xfd\xb6\xfd\x10\xff\xd7\xff\x85\xffl\x01\x80\x00k\x01\xfd\x00U\x01G\x00\xa2\x02<\x01\xad\x04\x87\x03|\x06\xdc\x04\xcc\x06$\x05a\x06\xb8\x04\x00\x06\x8e\x04\x18\x06\xcd\x04\x19\a\x87\x04\b\b\xd2\x04+\a&\x04e\x04\xe9\x00G\x01\xbb\xfdq\xff\x95\xfc\xf6\xfe\xc7\xfc7\xff\xce\xfd\xa2\x00\x11\xff\x8a\x02B\x00\xa8\x031\x01\xd9\x03\b\x01\xb0\x036\x00\xe6\x03_\x00\xbb\x05\x94\x01\xce\tt\x04\xae\r\x93\b\xd5\x0f\xe2\v\xe2\x11\x94\x0e9\x15\xec\x11 \x19D\x15\x90\x1d\xad\x18S\".\x1d\t$| 6\"\xf5\x1e\xcf\x1fO\x1b\xc1\x1c\a\x19`\x19\xea\x17\xb4\x17q\x17\x0e\x17[\x18\xf0\x15Q\x18@\x15Q\x16r\x14>\x14$\x12\x16\x11\xd2\x0fO\r\b\x0f\x90\v\xa0\x0eR\v\a\x0eS\n\xbd\x0f!\v2\x13\x89\x0f\xb9\x15\xc4\x12\x04\x1a\x80\x14\x17 l\x18\xb2#\xdc\x1c!&8\x1f\xfa)8\"\x94,\xae%\xd1,-&3,J%3)\xd5$\n#}\"C\x1d\xf2\x1e\x88\x19x\x1c\x12\x15L\x19\x15\x0f\xe7\x12f\b\xd0\v~\xff\xdc\x04\x9a\xf6I\xfd\xe9\xf0\x8a\xf7\xd6\xecY\xf4\xa3\xe8\x95\xf0\x90\xe5\x88\xec\xcb\xe3V\xea\xcd\xe0\xf2\xe8\x96\xdd\xe0\xe6 \xdc\xcc\xe45\xdc\xca\xe3<\xdd;\xe4\t\xe0V\xe6q\xe4>\xea\x8d\xe7\x95\xedT\xebo\xf0\xb3\xf1\xb2\xf4\x11\xf8\"\xfa\x02\xfc,\xfe\xf5\xfe\x91\x00i\x00\xb7\x02\xe0\xfe\x1c\x02(\xfc\x98\xfe&\xfai\xfc\xd9\xf5\x16\xfb\x16\xf0\xe6\xf6\xb2\xec@\xf2\xcb\xea\x9e\xef\xfa\xe7\xb6\xec\x8c\xe4\xdd\xe8d\xe1\xf1\xe5\xc7\xdeq\xe3A\xddm\xe0Z\xdc\x02\xde{\xda2\xdc\xcbו\xdak\xd72\xda\xf6\u05eb\xda\xea\xd73\xdaq\xd8^\xda\u007f\xda\xc9܍ݲ\xe0\xb2\xe0\x9a\xe3E\xe4\xf9\xe5\xc8\xe7\xef\xe8\x16\xea\xaf\xeb\x9e\xeb\x00\xeeW\xee\x92\xf1\x8f\xf0\xfb\xf4\xcd\xf1~\xf6}\xf4\xb3\xf8R\xf7B\xfc\xa9\xf8\xa0\xfd\xc1\xf8 \xfd\xaa\xf7\xdf\xfc\xfd\xf6\xe7\xfb\xcb\xf7A\xfb\xfe\xf8\x98\xfb\xf3\xf8M\xfbN\xf7\xb2\xf9\xc8\xf6F\xf8c\xf7\xdc\xf8\xcf\xf6,\xf8\xf3\xf5l\xf58\xf6\xce\xf42\xf7\xb0\xf6\x85\xf7\xfa\xf5w\xf7\x81\xf3\xf5\xf6\x99\xf2s\xf67\xf3\xf6\xf6\xd8\xf3]\xf9V\xf4\xd0\xfc\x8b\xf5\xf3\xff'\xf7(\x03{\xf94\x06]\xfc\xf7\b\xfc\xfe\x16\vb\x019\f\x81\x03M\rN\x05\xc0\r.\a\xfa\f&\ba\f\\\b\xae\v\x92\b:\vT\b\xdd\vl\bz\f:\t8\fW\t<\v\xad\b\xe2\n\xf6\bR\n\xb2\b\xe1\b\xfa\x06\xad\a\xa4\x05\xbb\x06\xd4\x04z\x05j\x03R\x05N\x02:\x06\x84\x02\x8f\a\xe6\x03\xb0\t,\x06\x05\f6\b\xa3\r'\t{\x0e \n\xc8\x0e\x00\fp\x0f\xfc\fV\x10Q\r\xf6\x0f\xbc\r\xec\x0eU\r\xba\r\xf9\f\xe6\vY\rE\nJ\r\x9f\t\xb2\f\x95\b\xc5\v\xd7\x06\xaf\t\xff\x04\x15\a\xb1\x02\xa7\x04\x01\x00\n\x02P\xfel\xff\xd2\xfd\x85\xfd\x15\xfd\xf1\xfb\xd4\xfa\xaf\xfa\xfb\xf7,\xf9#\xf6\x87\xf7y\xf5\xaf\xf6\xcd\xf5Q\xf6P\xf6\xfb\xf5\x05\xf6|\xf5Q\xf56\xf5\xd6\xf5'\xf6[\xf7\xe4\xf7\x1e\xf9\x8b\xf9\xf5\xfav\xfbE\xfc\xc9\xfd^\xfc\xac\xfe?\xfc\xac\xfe\xf1\xfc\u007f\xffr\xfd\"\x01\a\xfd\xc9\x01\x82\xfc\x8c\x01\x8c\xfc\xeb\x01\xa3\xfch\x03\x9c\xfd)\x04\x12\x00\xf7\x04V\x03Y\a\xbe\x06\x99\nF\tX\f\x12\v\xe1\f\xfd\vG\rr\f/\rD\fG\f4\vm\v\x12\vJ\v\x1a\fZ\v\x85\r\xb7\v0\x0f\x03\fL\x10\xec\v\x8c\x10\xbd\n\xd9\x10p\t\xee\x10y\tf\x10U\t\xb6\x0f\xbf\b\xcb\x0e\xa5\b\xf9\f<\b \v\b\a\x10\vT\a\x11\f[\t\xa6\f\xc4\n\x91\x0e\xfb\vV\x11\xcf\x0e\x88\x11\xa0\x10i\x106\x0f\xf5\x0f\x8f\x0e\xb8\x0e\xad\x0fA\r\x94\x0f\xd7\r\xd5\x0fr\x10v\x12F\x12+\x15\xa6\x12\xe5\x15\xff\x12\x9e\x15I\x12\"\x14\x93\x10\xa2\x11n\x10\xc1\x10\xb4\x12\x00\x13t\x16j\x15\x14\x1bF\x17\x15\x1f\xd1\x19\x98\":\x1d\xc3$\xbd\x1f\x8b&\x85!\xb7)M$\xd9+2'\xf1+\xcb'b*+&*&\x01#\xec!/\x1f\xb9\x1eh\x1d\xe0\x19\xe3\x1b\xb2\x13\xe8\x16\xe9\r\v\x11\x11\a\xb8\v6\xff\x87\x04p\xf6t\xfcZ\xecu\xf4\xc3\xe2\xd6\xeb\x05\xdd=\xe5Qچ\xe2oػ\xe0\xc7\xd8c\xdf\xf9\xd9\x1e\xe0\x9dۅ\xe1\xc1\xe0\xfd\xe3\x1e\xe7\xa0\xe8\xca\xea\x06\xedd\xee\x1b\xf0\x96\xf3\xf3\xf4\u007f\xf8\xc0\xf9y\xfd\xd4\xfd\xa6\x01U\x03~\x05\xef\b\xb2\b\x1f\r\x05\n9\x0f\x87\b\xd4\rO\x04\xeb\t\n\xfe\xa8\x05\xe4\xf6T\xff\xd0\xee\xc4\xf6\x10\xe5;\xed3\xdc\x1c\xe5\xd1\xd5=\xdfc\xd0\n\xda\n\xcb\xfb\xd3\x11\xc8\xcf\xce\xf0\xc5\xfb\xcb7\xc4A\xc9T\xc6\xeb\xc7\x01\xca\xcf\xc9s\xccF̽\xd0\xe2\xce<\xd5\xd0\xd3D\xd8\xfd\u05ec\xdd2\xdc\xcc\xe4\x81\xe3\xa1\xeb!\xeb\xd1\xf2(\xf1o\xf8\x1b\xf7y\xfa\xd7\xf9\xf7\xfa8\xfag\xfa\xa7\xfb\x83\xf9 \xfcW\xf85\xfb\x02\xf7\xb6\xfa\x87\xf5d\xfa\xb1\xf3\xfe\xf8\x81\xf1\xb8\xf6\xeb\xee\x17\xf3\xd2\xec/\xf0\xbd\xea\x03\xee\xa5\xe9^\xeb\x92\xe9\xdf\xe92\xeak\xe9\xa2\xeb\v\xea]\xed\xe4\xeb\xdf\xefJ\xee\xe5\xf2J\xf0\x00\xf6\xec\xf2\x14\xf9Z\xf5\xc6\xfcc\xf7\xc8\x00\xf6\xf9\xfb\x03\xd7\xfcm\x06*\xffc\b\xb0\x01\x99\t\xa5\x03D\vT\x05\xa6\r\xc7\a\x9b\x0f|\n\x11\x115\vw\x13A\f\u007f\x14\"\x0e\x82\x12\x0e\r\x01\x11b\n\xa1\x10\xae\t\xeb\x0e\x81\t\xe7\f1\b\xb7\v'\a-\n\xd3\x06\n\b\x80\x06\xa5\x06l\x05\x86\x06+\x04-\x06\x17\x03G\x05\xe3\x01K\x05;\x01\xaf\x05\xc2\x00\x18\x06\x02\x01\xda\x05\xa0\x01\xda\x05A\x02|\a\x82\x03S\t\xc2\x05\xcb\n\x05\b\x9d\vs\t\x1a\f>\nD\x0e\xb6\nz\x11\xc1\f\"\x13$\x0fw\x14,\x10\xd3\x15\x1e\x12-\x15 \x143\x13\xa8\x13\xb3\x10\xc9\x114\x0e\x13\x10c\f\x87\x0e\xf1\t\xfa\f\xd1\x06\x86\n\x81\x04\xcc\a\xdf\x02-\x06\xa7\x00w\x03%\xff5\x00\xf1\xfdh\xfev\xfb\xde\xfc9\xf9\x8e\xfa\x8a\xf8\r\xf9\x8a\xf7\a\xf8\xaf\xf6\xa2\xf6\x82\xf7l\xf6\x1a\xf8:\xf7N\xf8\xba\xf7w\xf9\xb3\xf8*\xfbB\xfa|\xfc\xab\xfb\x13\xfdA\xfd\xd9\xfd:\xff\xa7\xfe<\x01\x9d\xfeN\x02\x9c\xff\xc7\x02\x88\x015\x04u\x02\x99\x05\x17\x03\xc9\x05\x1c\x04\x9f\x06\xa9\x03\x83\a \x03F\a7\x04\xf2\a\x9d\x06\x9b\t\xbf\t\xf0\n\xc6\f\x8c\f\xb2\x0e}\x0ew\x0f\x80\x0f\x82\x0f\x85\x0f\x94\x10\xca\x0f\xe9\x11\xb9\x10\xa8\x11\xfe\x10\xc8\x10\xde\x0f\x8f\x0f)\x0f|\x0e\r\x0f\x06\x0e\x85\x0e\xe8\r\xf3\r.\x0fl\x0e\xec\x107\x0f\x1c\x11\x91\x0f\xce\x10\xdd\x0ey\x10;\x0e*\x10#\x0e\x87\x11\x8d\x0eM\x14\xcd\x10\x9d\x16\x03\x14\xc1\x180\x16@\x1c\xca\x18\xc8\x1f\xb3\x1b\xc9!V\x1dZ\"\xc7\x1d\x94\"Z\x1e\x17\"\x93\x1f\xe0 V \xb5\x1fg \x84\x1ds\x1f\xae\x1a\xef\x1c)\x18\xce\x19\x90\x15h\x17s\x116\x14\xb9\v\xce\x0ev\x06\xf2\t\xb6\x02\xf8\x06q\xff\x9d\x04\xdf\xfc\x1a\x02\xa9\xfbg\x00\x16\xfb\x9a\xff\xa1\xfb\xa9\xff\x82\xfd\xfc\xffH\xff\x93\x00P\xff\x11\x01\xec\xfe\xb6\x00\xd0\xff\xd9\x00b\x01\xcc\x022\x01\xcb\x04\t\xffE\x04\xbc\xfc\xbf\x02\xc3\xf9\xdd\x00\xf0\xf5r\xfd\xbc\xf1*\xf9N\xedA\xf5\x1b\xe9.\xf1^\xe5\xc6\xec\x10\xe2Z\xe8\xbcސ\xe3\xf2\xda\xe9\xdf\x03\u0600\xdd\"\xd7sܞؠ\xdcA\xdbb\xdc%\xdd*ܔߦݠ\xe1\xc3\xdf\xe1\xe2X\xe1\xfe\xe4\xc0\xe2\x82\xe7D\xe5\x88\xe8E\xe8\"\xe9\xbd\xe9\r\xea!\xebo\xe9#\xec \xe8\xd5\xeb\xd3\xe7z\xeb\xef\xe6\xd9\xeau\xe5\x8b\xe9\xc5\xe4\x8f\xe8\xf2\xe3\x0f\xe8_\xe2\x0e\xe7}\xe1n\xe5\xbc\xe10\xe4\xa0\xe10\xe3\xd7\xe0\xda\xe1u\xe1\xb9\xe1\xd3\xe2\x9e\xe2\xa6\xe4)\xe31\xe7\xc1\xe4\xf5\xe9\x87\xe7\xb2\xec\x1d\xea\xce\xee!\xec\x19\xf0d\xeey\xf0\xb7\xef\xc8\xf0-\xf0\x02\xf2\xd9\xf1\xe6\xf2@\xf3(\xf3\xfe\xf2U\xf4\xca\xf3\x16\xf6\xfa\xf5\xbd\xf6z\xf6\x86\xf6q\xf5\x16\xf7\xf7\xf5\xea\xf7\x92\xf7\x88\xf9\xa9\xf8c\xfb!\xfa:\xfd\xaa\xfb\xb9\xff\xe9\xfc\x81\x01\x8d\xfd[\x03r\xfd\xab\x04f\xfew\x04\n\x00!\x05\x94\x00\xee\x05\xf7\x00\xc3\x05(\x01O\x06\xce\x00\xea\x06\x89\x01\xfd\x06o\x02\xd2\a\xf6\x02\xb7\t\xe9\x03\xda\v\x03\x05\xfc\f\r\x05^\x0e\x8e\x05H\x10e\a`\x12<\t\xef\x13\xeb\n\b\x15Q\f[\x16\xec\r\u007f\x17F\x0fj\x18/\x10A\x19\xd2\x10\x91\x19\xe9\x11\xa2\x19+\x13\x86\x19Z\x14\xeb\x175\x14\xfd\x14X\x12\x01\x12\xc0\x10@\x0fr\x0fr\f\xff\f{\t\x00\nK\x06\x04\b\x8b\x03\x06\x06<\x01x\x03r\xff\r\x02\x1e\xff\x95\x01\xd5\xff\x80\x01!\x01\xe2\x01\x80\x03c\x02\xc5\x05{\x03\x1a\a\x90\x04\xe5\b\xf8\x04\xdd\n\xaf\x05\xd8\vp\a\x15\f\x03\t\xf2\v\x9c\t\x8c\v\xfb\t\xe2\n:\n)\n\xce\nG\t\xa4\ns\aV\t7\x04\xd2\x06\x83\x00I\x03\xa4\xfdu\x00\x9f\xfb\xc5\xfeq\xf9\xf1\xfcY\xf7_\xfb0\xf5\x17\xfa\xf8\xf3\xcf\xf8=\xf4\xb0\xf8\x91\xf5\x86\xf9^\xf7m\xfa\xa4\xf9\xa9\xfb\xe8\xfc\xa7\xfd\x06\x00B\x00\xa7\x02\xa4\x02\xf0\x06\xf9\x051\v\x94\n\xb5\r\x1c\x0e\xd1\x0f\xe4\x0fo\x11\x80\x11P\x11\xa8\x12\xb9\x0fX\x12m\x0e\x87\x11\xad\rp\x11]\f\xfb\x10W\n\xe6\x0f\x95\bX\x0e\b\b\x10\r\x97\a\xcb\v}\x06\xcb\tV\x05|\a\\\x04G\x06\xee\x03\xe1\x052\x04\x13\x06\xa1\x05\xe7\x06\v\a!\b\xf5\b{\tE\f\xe7\v\xc9\x0f\x03\x0f\x0f\x13\xb8\x11\xfc\x15<\x14$\x188\x16\x14\x19\x9a\x16f\x19\xc7\x16|\x1a\x00\x18\v\x1c\x06\x1a\xcd\x1c\x88\x1b\xe6\x1dI\x1c\xa6\x1e\x84\x1c\xee\x1d\xf5\x1b\xbc\x1c\xf2\x1aB\x1c\x1b\x1a\xb7\x1bT\x19R\x1a\x91\x17<\x19t\x16\x9c\x18\a\x16\xed\x166\x15\xe9\x15\xbb\x14\x84\x16d\x15\x99\x16\x8b\x15\x8f\x14\x98\x13C\x12V\x11\x9a\x10\xe5\x10\xae\rU\x0f\xd7\t\xba\v\x9e\x05D\b\x17\x02\x18\x06\xc7\xfe\\\x04\xef\xfc\xf3\x024\xfd\xac\x02\x89\xfdN\x02\xc5\xfc$\x01\x94\xfb\xe0\xfe\x8d\xf9\xde\xfc\xa7\xf6\xb2\xfa\xc8\xf4m\xf9:\xf3\xd8\xf8\xf5\xf0\x15\xf7\x8c\xee\x9e\xf4\xbd\xecA\xf3m\xeaY\xf24\xe8\x81\xf0\x11\xe70\xef\x89\xe6+\xed\xe4\xe5\xe2\xea\xf4\xe3\xe5\xe8\x17\xe2\x94\xe6f\xe1\x92\xe4v\xe0{\xe3\xed߈\xe14\xe1k\xe0\x13\xe2{\xe1\xae\xe2 \xe2\x01\xe5{\xe2\a\xe8\x80\xe4\xf0\xe9\xb3\xe5W\xeb:\xe5\xee\xeb\x86\xe5\xfb\xea;\xe6\x00\xea\xd1\xe6W\xeaJ\xe8b\xeaQ\xe9.\xe8\xf8\xe8\x16\xe5\xd2\xe7\xb6\xe2\xf3\xe5\xff\xdf!\xe4)\xdc\xcd\xe1\x98\xd8\x12\xdf\xf8\xd4v\xdcVү\xd90\xd23ط\xd2?\xd8xӆ\xd8l\xd6A\xda\x06\xdbQ\xde\x04\xe0\xf1\xe1E\xe5\x03\xe5\xfe\xea\xaa\xe9\xc9\xf0\t\xef%\xf6\xcf\xf3?\xfb\\\xf8q\x00e\xfd\x8e\x04\x97\x02\x8d\a\xc0\x06H\n$\t0\f\xb0\nw\f\xfa\n*\v\x85\t\xa3\bl\a\x9a\x05Z\x04g\x02s\x00\x0e\xfe^\xfc\xa0\xf9k\xf8\xc8\xf5\xc5\xf4\xc8\xf2\xab\xf1X\xf1F\xefr\xf1\xa7\xed\x1f\xf2\xf0\xec\xb0\xf3(\xed\x16\xf6\xa3\xee\xe5\xf8V\xf1/\xfcO\xf4\xae\x00\xdc\xf7\xd5\x05f\xfc\v\n\xfb\x00\x01\x0ed\x05e\x12'\nR\x16O\x0e\xea\x182\x11\x9b\x1a\xf3\x12\x97\x1b\x18\x14n\x1b+\x14\xdb\x1a\x02\x14^\x1a\xc0\x13\xbf\x19\xf7\x12#\x18\x99\x11\x9f\x15\x9a\x0f\xfa\x12h\r4\x10k\v\xf8\r\xa8\t\xcd\f\x8a\b<\v\xa9\a\x11\t3\x06\xf9\x06D\x05\xf9\x04\xc9\x04u\x03O\x04\xdc\x02\xe7\x03\x17\x03\xca\x03\x1c\x03g\x04<\x02\xd1\x04\x14\x02\x83\x04\xa9\x02\xa7\x04\xf8\x02\x81\x05\x19\x03\xb7\x05\x83\x03`\x05\f\x04\a\x053\x04\x91\x047\x04T\x04\xc5\x04?\x04\xfd\x05\x9d\x04\xe2\x06\x85\x05\xd1\a\xf7\x05\xaf\b\xba\x06\xfa\b\xa7\a\xb1\t\xde\a\xcb\n8\bH\v\xac\b6\n\"\b\xf6\a\x02\aM\x05Z\x05\xea\x01Z\x03\xd0\xfdn\x01c\xfa;\xffL\xf7\xaa\xfc\xeb\xf4\xec\xf9\v\xf3\xc6\xf7M\xf1H\xf6C\xf0#\xf5K\xf0\xc3\xf4\xf5\xf0\xea\xf4\xbd\xf1\xef\xf5\x94\xf2\x16\xf7\xb0\xf4\xbd\xf8C\xf8\xa3\xfb\x9a\xfbC\xff\xdc\xfeM\x02'\x03\xcb\x05.\bW\nb\r\x05\x0f\x1c\x12u\x13\x9b\x15\b\x17i\x17(\x19:\x18E\x1a\x90\x19\x88\x1ba\x1a\xfc\x1c\xc0\x19\xee\x1cB\x18j\x1b<\x168\x19\n\x13\xec\x15\xba\x0f\xdf\x11\xff\f\x8e\x0e[\n\x14\f\xeb\a\xf4\t\xe9\x06\xcc\b.\as\b\xc1\aT\b'\n\xa5\b0\x0e*\nI\x11l\f\a\x13\xea\rN\x15\xc0\x0f'\x17\x86\x12L\x18\x82\x14\xae\x19O\x161\x1b\x8f\x18\xad\x1b\x85\x19F\x1bu\x19=\x1b\x80\x1aG\x1c\xc4\x1b\x1d\x1da\x1c\xb8\x1ce\x1b\v\x1bk\x19C\x17Q\x17;\x13\x90\x14^\x11}\x12\xca\x10m\x12\xc5\x0fc\x12\x88\x0f\u007f\x11\xb0\x10\xce\x11\n\x11O\x12\xed\x10\xe3\x11\v\x12\x12\x12G\x13u\x12\r\x13\x85\x11\u007f\x11P\x10'\x0f\x81\x0f\x17\rj\x0e#\vx\rC\b\xf5\vt\x04\xca\b\xab\xff\xa7\x048\xfa\xff\xff0\xf5B\xfb\xb2\xf0\xc6\xf7A\xec&\xf4\x19\xe9\xfe\xef\xd6\xe6K\xed\xb8\xe4\xa7\xeb\xf8\xe3e\xea\xe7\xe3\xee\xe9c\xe3f\xe9\xdf\xe2Z\xe8\xcd\xe2\xba\xe7\xb1\xe1\xaf\xe6\xf5\xdf=\xe5%ޠ\xe4\x18܊\xe38\xd9\xc5\xe0\xa4\xd6\xd9\xdd~\xd5H\xdc\xe7\xd4\xca\xdbB\xd4\v\xdb\xc5Ӽٝ\xd3\x13\xd8\xeeӝ֟\xd3\xd7Ն\xd3\x1b\xd5\xd3\xd4H\xd5\xd6\xd6\xee\xd6\xe6\xd8\xcc\xd8~۰\xda(\xdf\xe6\xdc{\xe3\xdb\xdf\xe6\xe7\x91\xe3\xfa\xebT\xe7\xe5\xef{\xea\x87\xf2\x0e\xed\x8d\xf3k\xeeR\xf3S\xef\xa2\xf1b\xef/\xef\xe3\xed8\xec]\xec\x84\xe8\xf4\xea\x8d\xe5\x93\xe8\xbc\xe4\xaf\xe6=\xe4\x1b\xe6\xa3\xe3z\xe5\xd9\xe3c\xe4\x84\xe5}\xe44\xe80\xe6 \xebX\xe8\xad\xee\x87\xea\xda\xf2\xf7\xecc\xf7\xdf\xf0\x8e\xfc\xd1\xf5:\x02/\xfb\x16\aV\x00\x92\v\xc0\x04\xc1\x0f\x8c\bR\x127\v\xa6\x13\xd5\fM\x14&\x0e9\x14+\x0ft\x13\x1a\x0f\x01\x12\x1d\x0en\x10\x9f\f\x86\ra\n\x06\t\x0f\aw\x05\xb4\x03?\x03j\x01\xff\x00\xbb\xfe]\xff\xbd\xfb\xdd\xfe\xb5\xfa\xce\xfeq\xfa\xec\xff\xfc\xf9,\x02\xfe\xfa\x85\x04\xa9\xfc\x89\a\x98\xfe\x95\v\xf3\x01\x0e\x0f\xcf\x05q\x117\bz\x13\f\n\x87\x15y\f\xdf\x16v\x0e}\x17b\x0f9\x17\x12\x10[\x16(\x11U\x15\xa7\x11y\x14!\x11\x02\x13\xe1\x0f\xc3\x10\x0f\x0e\xf8\r\xb0\v\x1e\v\x0f\t\xb5\bs\x06\x92\x06x\x04c\x04\xc7\x02\xfc\x01\x10\x01z\xffQ\xff\x9c\xfd\xb7\xfdk\xfck\xfd\xfd\xfb\x8e\xfd[\xfcS\xfd\xeb\xfc{\xfd\x15\xfd\xb4\xfd!\xfdy\xfd\xc5\xfd\xbc\xfd>\xfeT\xfe\x8c\xfe\xab\xfe\xdd\xfe\x92\xff\x9d\xfe9\x00\xe2\xfd\x89\x00\xd4\xfd\xe8\x00?\xfe<\x01\x91\xfe\xa7\x01\xe4\xfe\x9e\x01}\xff\xa8\x01X\x00N\x02\f\x01\x15\x03\xba\x01u\x03\xf8\x02a\x04\"\x04\f\x06U\x04\xe5\x06\xaa\x03\xad\x06\xcd\x02-\x06E\x02\xe2\x05\xf0\x01i\x05\x8b\x01\xee\x04i\x00K\x04k\xfeg\x03\x00\xfd\x17\x02\xda\xfc\"\x01\xcc\xfd7\x01\x18\xff\xd9\x01\x8a\xff\xd0\x01\xde\xffw\x01\x9a\x01\xef\x01\x10\x04\x8c\x03*\a\xeb\x05\x8a\n\xcd\bR\r\xd6\v\x83\x0f\x9a\x0ef\x12{\x11\x1e\x16\x9f\x14\xcb\x19\xc0\x17\xd8\x1c\x84\x1a\f\x1f\xd8\x1cM ^\x1e\x94 \xb9\x1e\x97 \x19\x1f\xe6\x1f\x95\x1f\x18\x1e\xb8\x1el\x1c`\x1d\x8b\x1a\xd9\x1c\x13\x18x\x1bQ\x16[\x19/\x15~\x17\xdf\x147\x16\xfd\x14\xfc\x14\xba\x14\xc2\x133\x15H\x13\x83\x17\xc8\x14\xae\x19;\x17\xbc\x1b\xa2\x18\xcf\x1d\xf2\x19\xa1\x1e\x90\x1bv\x1e-\x1c\x94\x1f\xb3\x1c\xd3!\f\x1f\xb2\"\x8f \x0e\"\xda\x1f\xc6 \xa5\x1eN\x1e\x13\x1d|\x1b\xa9\x1a>\x19\xba\x18\x11\x16\xf9\x16\x91\x11D\x13!\r\xbe\x0e\x84\b\xc7\n\x10\x03>\x065\xfez\x01 \xfa\x14\xfe\xf2\xf5\xf6\xfa\xfd\xf2\x04\xf8\t\xf2\xd2\xf6x\xf1\x92\xf6\x05\xf1f\xf6V\xf1p\xf6\xbf\xf1f\xf6\a\xf2?\xf6f\xf2\xfb\xf6\x04\xf3\xb2\xf8\xf5\xf3\xf1\xf9\xf5\xf4{\xfan\xf4\x82\xfai\xf2\xec\xf8Z\xf1\xb7\xf7\u007f\xf0\x9c\xf7\x1b\xef\x87\xf6\xaf\xed\xe9\xf3^\xec+\xf1\xa2\xea\x82\xee\xb8\xe7J\xeb\x86\xe4\xe9\xe7B\xe2)\xe5F\xe0\xc3\xe34\xde \xe2G\xdc\xff߁\xd9\xf7\xdd\xed\xd5\x17\xdb6\xd3\xe0\xd7\xe5\xd1R\xd6\x02\xd1\xc8\xd5'\xd0aգ\xce}ԇ\xcd\x1dӳ͌\xd2\xe6\xce7\xd3\xea\xcfX\xd4N\xd1\x19\xd6!\xd4\xd9\xd8V\xd73\xdb\xe3\xda4\xdd\xf0\u07b6\xdf\x10\xe2\xd7\xe2]\xe4\xf3\xe46\xe8~\xe7W\xed\x95\xeb\xd2\xf12\xefd\xf5\xec\xf1~\xf8\x9a\xf4v\xfb\r\xf7\t\xfe\xbd\xf8\x99\xff\xc5\xf9\xca\xff6\xfa\xfd\xfe(\xfa0\xfe\x82\xf9\xbb\xfc,\xf8\x12\xfa\xce\xf5H\xf7\x0e\xf3:\xf5#\xf1\xce\xf3\xce\xef\xe9\xf2\x98\xee\xe5\xf2.\xee\xd6\xf3o\xee\xc8\xf4\x11\xef\x1d\xf6\xfe\xef\x88\xf8;\xf1]\xfb\xbc\xf3\xd3\xfd\xf5\xf6d\x00\xd7\xf9\x86\x03\xc3\xfc\x97\aO\x00\xa1\vH\x04Q\x0e|\a\x98\x10\xc5\t\x9d\x12\xca\v\"\x14\xab\r\xf2\x14\u007f\x0e\r\x15\xd1\x0e|\x14\xc7\x0e\xa6\x13.\x0e\xfd\x11\xca\f\xe2\x0f\x8c\n\xf7\r\xb3\b\x15\fb\a\xec\n\x14\x06\xe2\t\xb7\x04 \b\x06\x03\xc9\x06h\x01D\x06\xbc\x00\x14\x06\xc6\x00w\x06p\x00\x0e\av\x00@\a\xc6\x00B\a!\x01a\a\xaf\x01\xd6\ar\x02\xe2\a\xeb\x02\xaa\a\x02\x030\a`\x03w\x06z\x03\xf4\x05U\x03I\x05N\x031\x04\xd2\x02\xda\x02\xda\x01\x8c\x01\x1d\x01X\x00\x9a\x00L\xff\x02\x008\xfe\xd9\xffz\xfd\xa8\xff)\xfd\x81\xff\xc5\xfc\x87\xff\xf2\xfb\t\xff\xb0\xfa\x06\xfe\x1d\xfa\f\xfd0\xfa\xb6\xfc\xca\xf9\x87\xfcB\xf9_\xfc\x10\xf9I\xfc\x17\xf9=\xfc\xfe\xf8 \xfc\x00\xf9:\xfc\x88\xf9\xac\xfcN\xfa\xfa\xfc*\xfbI\xfd\xe8\xfb\xf9\xfd\xa2\xfc\x11\xffh\xfdR\x00\xaa\xfe\x89\x01\x90\xff\xdb\x02K\x008\x04\x84\x01\xc7\x05\x1f\x03=\a\xb9\x04d\b\x15\x06\x9d\t\x15\a\xb8\n\xb2\a-\vO\b\xac\v\x03\t\xaa\f\xbf\tt\rd\n\xea\r\xb3\n\xe9\r\xc1\n\xc4\r\x14\v\x9c\r\xbc\v\x86\r\xc4\f\xbd\r+\x0e\x97\x0e\xe1\x0f\xcf\x0f\xa1\x11+\x11*\x13r\x12T\x15\xdf\x13\xd7\x17\xbb\x15\x04\x1ad\x17X\x1b`\x18\xf3\x1b\xd2\x18\v\x1c\xdd\x18\x0f\x1c\xe7\x18^\x1cw\x19\xbe\x1cC\x1a\x00\x1d\xa1\x1a\xf4\x1d\xb3\x1bU\x1fb\x1d\xf2\x1f\xb8\x1e\xdc\x1fv\x1e\x8c\x1f\x81\x1d\xae\x1e\x8d\x1c_\x1d\x8a\x1b\x82\x1c\x11\x1b\xbc\x1bV\x1b\xc8\x1aP\x1be\x1a \x1bl\x1a)\x1b*\x1aG\x1b\xa6\x19#\x1b\x9e\x19\xb0\x1a+\x1ao\x1ac\x1a\x1f\x1a\xcc\x19\xe7\x18y\x185\x17\x15\x16|\x15Z\x13\x84\x13\xdf\x10A\x11\xbb\x0e%\x0f\xb3\fH\rj\nG\vu\a\xc6\b\x0f\x04\xaa\x05M\x01V\x02n\xfei\xff\xf7\xfa}\xfcy\xf7\xc0\xf9G\xf5%\xf8\xdc\xf32\xf7\xc8\xf1\xd9\xf5\xe6\xee\xc1\xf3\x11\xed#\xf2-\xec\xb5\xf1\xc4\xea`\xf14\xe8\xbf\xef\x8b\xe6h\xed4\xe6\x91\xec\xb8\xe5\x88\xec\xc0\xe4\x10\xec\xd4\xe3\xbd\xeb\xbc\xe2M\xeb\xfa\xe0\xd5\xe93\xe02\xe8u\xe0\x81\xe7\x92\xe0h\xe7\f\xe1~\xe7\xc7\xe2\r\xe8K\xe4\x9d\xe8\xa4\xe5\xc6\xe8\xa4\xe63\xe9h\xe6\xc6\xe9\x88\xe6\xd7\xe9\xc7\xe7]\xeat\xe8\x00\xebn\xe7V\xea\xcc\xe5\xb4\xe8L\xe4\x89\xe7\x9a\xe2%\xe6\xca\xe0R\xe4\xc7ާ\xe2\xf0\xdc:\xe1\xff\xda\xd7\xdf`\xd9q\xde,\xd8\xe9\xdc\xe2\xd6P۟\xd5\xc7\xd9\"ծ\xd8Y֗\xd8\xc3\xd8{\xd9\x01\xdcn\xdb\xfaߵ\xde\x10\xe5\xd9\xe2|\xea\xfc\xe6?\xef/\xeb\x19\xf4\xcb\xef6\xf9:\xf4\x02\xfep\xf8\xbc\x01\xe2\xfb\x19\x05\xe6\xfet\a\x81\x01\xac\b\x02\x03\xc9\b&\x04\xfc\a\xa0\x04M\a\xf0\x03O\x06y\x02\xc9\x04\xbb\x00\xc5\x02}\xfe\x87\x00\x99\xfb\x86\xfe\x96\xf8\xcc\xfc\xf4\xf5,\xfb\xda\xf3+\xfaI\xf2)\xfa\xb7\xf1\xb3\xfa\x05\xf2x\xfc\x12\xf37\xff\xe8\xf4\x17\x02`\xf7\xb2\x04\x9b\xf9\xb7\a*\xfc\xed\n.\xffc\r\xe4\x01\xe5\x0e\xf1\x03]\x10P\x063\x12J\t\xb9\x13\xff\v7\x14r\r\xe9\x13\xd5\r\xfd\x12\xcb\r<\x12/\ri\x11E\fs\x10r\v\f\x0f\x86\n\x97\r\xe0\b\xe6\v\xfe\x06\x88\t8\x05q\ag\x03\x13\x06L\x02\xe0\x04\xa7\x01\xa0\x03\x83\x00c\x02\x1b\xff:\x01L\xfe\xc3\xff\x9d\xfd\x91\xfe\x04\xfd\xf9\xfd\xc0\xfcf\xfd\x8a\xfcc\xfc\x06\xfc\xb2\xfbs\xfb<\xfby\xfb1\xfbv\xfbq\xfb`\xfb^\xfbg\xfb9\xfbV\xfb\x8a\xfb\x9b\xfb\x1e\xfc\x8c\xfc\xe4\xfc\x86\xfd\x11\xfeC\xfeQ\xff(\xff\xea\xff\xf8\xff\b\x00\x8a\x00\xf6\xff\xaa\x01\xa9\xffo\x02g\xff\x84\x02\x01\xff\xb9\x02o\xfe\xbe\x02[\xfe\xe1\x02\x10\xfe\x1b\x03q\xfd\a\x03\xb6\xfcY\x02\xf7\xfb\x8f\x01%\xfbo\x00z\xfaU\xff+\xfa\xc4\xfe:\xfa\xb4\xfe\xce\xfa\xf9\xfe8\xfc\x95\xff\x18\xfe\x93\x00Z\x00)\x02\xf7\x02s\x04\xb8\x050\a\xde\b\x9e\t\xd6\f\xb8\f\x17\x10\x18\x10T\x12\xad\x12#\x14\xd2\x14\x94\x15\xec\x16\x8f\x16\x1d\x18\xa2\x17V\x19^\x18\xc2\x1av\x18A\x1b\xa2\x18/\x1b6\x18\x0f\x1bD\x17\xfd\x19U\x16\xa7\x18*\x15\x85\x17\xc6\x13\xfc\x15 \x13\x1d\x14\xdb\x13\xb6\x13v\x14\f\x146\x15\xd0\x13\x1b\x16'\x14\xf8\x16\xa4\x14z\x18V\x15\xc3\x1a\x11\x17^\x1dr\x19\n \xb0\x1bM\"\x9a\x1d\xfd#T\x1f\xe6$F 0%\xde \xeb$\\!o$\xe7!*$0\"\x87#$\"!!\xc3 \xdc\x1d\xf0\x1d\xd7\x1aA\x1b\b\x18\x10\x19\x91\x14:\x16\u007f\x10\xd3\x12\xb7\f\b\x10a\n\xd9\r|\t}\f\xc8\b\xa1\v\xaf\a\x9f\n:\x06\x88\t\xab\x04\\\b\xce\x038\a\xf8\x03\x13\a\x9c\x035\ad\x01\xec\x05\xd0\xfeR\x03\xad\xfc\f\x01\x89\xfa|\xff\xd0\xf8\r\xfe^\xf7\f\xfd\x9a\xf6\xeb\xfb\xeb\xf6\xf4\xfa\x0f\xf6\xb9\xf96\xf3 \xf7\x80\xf0\xe3\xf3%\xef\x82\xf2\x97\xed\xae\xf1\x12\xec\f\xf0\xd0\xea\xe6\xeeV\xe9\x1b\xee&\xe8\x02\xed\xa3\xe8{\xec\v\xe9\x9a\xec\a\xe8k\xeb0\xe6\x14\xea\v\xe4,\xe9\n\xe32\xe8\x87\xe2\u007f\xe7J\xe1\xb9\xe6\xe5\xdeR\xe5u\xdcv\xe3\xb9\xdb]\xe2\xe9\xdb%\xe2m\xdb\x1f\xe2\xe3\xda.\xe1\x16\xdb]\xe0\xb0\xdbD\xe0\xddܮ\xe09\xde\xec\xe1\xa5ߋ\xe3\x8f\xe1s\xe5c\xe4\xe5\xe7d\xe7;\xea@\xea4\xecA\xecs\xeex\xedk\xf0\xca\xeev\xf1\xb5\xef\xdf\xf12\xf0\x05\xf2[\xf0I\xf2\xd7\xefL\xf2\xd0\xef\xe9\xf1\x1b\xf0D\xf1\x16\xf0\x99\xf0\xa8\xef\xd7\xef\x97\xef\xbb\xee\xce\xef\x05\xee\x19\xf0Q\xed\b\xf1\xe2\xec\x13\xf2k\xed\xea\xf2\x15\xee\xaf\xf4?\xef@\xf7S\xf1\x12\xfa'\xf3\xe5\xfc\t\xf5\x1e\xffd\xf7\x12\x01\u007f\xf9\x99\x03\x97\xfb\xe5\x05\x9d\xfdE\a\x17\xff\xb7\a\x1e\x00\x0f\b\xe9\x00\x82\b\xb0\x01|\bQ\x02\r\b\xa8\x02\xb7\a)\x02\xd1\a\x8b\x01\xb9\aR\x01\xbc\a6\x01\x90\a\x04\x01\xb5\x06\x1c\x00\x12\x06\xf6\xfe\xe8\x05\x91\xfe-\x06\xaa\xfee\x06\xe4\xfe\x89\x06\xa8\xff\xd9\x06x\x00\x9c\a\xdd\x00\x9a\b\x1b\x01\x04\t\x8c\x01\xa7\b\xb1\x01\xeb\a\xbb\x01r\a\xc4\x01\x1d\a\xa6\x01N\x06%\x01\xf9\x04w\x00b\x03\x9b\xff\xda\x01\x9f\xfe\x94\x00\x96\xfd\x9e\xff\xec\xfco\xfe7\xfc}\xfd.\xfb\xcb\xfc\xbf\xfa\xe7\xfb\x84\xfa\xce\xfa\v\xfa\xe8\xf9\xb8\xf9h\xf9\x87\xf9v\xf9\x83\xf9\x1e\xfa\xde\xf9\xa4\xfat\xfa\xe9\xfa\xbd\xfaj\xfbj\xfb\xc2\xfb\x10\xfc\xe7\xfbI\xfc\xe0\xfb\r\xfc\xf1\xfb\x1a\xfc\xd7\xfb&\xfc_\xfbu\xfc\xd8\xfa\xa8\xfc\x92\xfa'\xfcq\xfaL\xfb\r\xfa\xe7\xfa\x97\xf9I\xfb\xd0\xf9\xf6\xfb\xaa\xfa\xe5\xfc,\xfb\xd4\xfd\x83\xfbb\xfe\xd0\xfb\xc9\xfe\x17\xfcm\xff\x9e\xfc[\x007\xfdt\x01\x00\xfeG\x02 \xff&\x03.\x00\xa1\x04\xd3\x00\x12\x06\x03\x02Q\az\x03\xa5\b\xaa\x04\xa7\t\x9d\x05\x1f\n\xb8\x06\xca\n-\b\xa5\v\xa3\t\xa4\f\xf5\n\\\r\xba\f \x0es\x0e\x02\x0f\x9e\x0f\xc5\x0f\xd3\x10\x9f\x10\x12\x12\xe3\x11y\x13\x97\x12\x0f\x152\x13\xd9\x16a\x14\xd8\x17\x85\x15\x8c\x18\x00\x16\xe5\x19\xe2\x16\xd2\x1a\x1b\x18B\x1b\x88\x18\x05\x1c\xde\x18D\x1c\xdd\x19\xce\x1b]\x1as\x1bX\x1a\x1f\x1bK\x1aj\x1a\x9e\x19\xe9\x19\xec\x18<\x19\xc9\x18\x8d\x18\xa8\x18\xd5\x18\xc3\x18a\x19\x1d\x19\u007f\x19\xf0\x18\x88\x19a\x18#\x1a\xd6\x18\x81\x1a\xb7\x19\xf6\x1a\x1d\x1a\x95\x1b\x9a\x1ac\x1b\xae\x1a\xf2\x1a\r\x1a\x91\x1bb\x1a\xff\x1b\x96\x1b\x9c\x1b\x9a\x1b<\x1b\xc9\x1a\xc0\x1a\x83\x1a\xb4\x19\xac\x19\xd0\x17\t\x18\x96\x15<\x16\xfb\x11&\x14&\r\x9b\x10Q\t\n\r\x15\x06D\nj\x02\xb9\a\xa6\xfe\u007f\x04\xca\xfb\xbb\x01&\xf9\x1b\xff\x0f\xf7\\\xfcI\xf50\xfa8\xf3P\xf8S\xf1\x83\xf6\xc9\xef\x1f\xf5h\xee\x81\xf3\xf2\xed\x16\xf29\xee=\xf2\xb6\xeeQ\xf3\x1b\xef\xdb\xf3\xef\xef(\xf4\"\xf0W\xf4v\xef#\xf3\xd5\xee%\xf2\x83\xed\xb1\xf1u\xebE\xf0\a\xea\xae\xee\xcc\xe8\xdd\xed\xa1\xe7\x04\xed\x10\xe7\x11\xec\x16\xe7\xf2\xeb\x1b\xe7\xd6\xeby\xe6\x1b\xebZ\xe5\x84\xe9s\xe4\x1d\xe8_\xe3\f\xe7U\xe2\xf8\xe5*\xe2F\xe5\xe9\xe2\xe4\xe5d\xe3\x05\xe7\x06\xe4z\xe7n\xe5K\xe8h\xe6}\xe9\xab\xe6\x11\xea(\xe72\xea\xd3\xe7\x99\xea\xd3\xe7\xf7\xea\xab\xe7\xa2\xeaw\xe8'\xeb\\\xe9\x83\xeck\xea\xc6\xedS\xec\x1f\xef\xcc\xeen\xf1\xac\xf0m\xf3\x04\xf3\xd7\xf4\xa6\xf5\xad\xf6@\xf7\xe9\xf7\xa7\xf8!\xf8a\xfa\xc5\xf8\u007f\xfb\xbf\xf9\xdc\xfcy\xfa\xde\xfe\xf2\xfb\"\x00s\xfd\xda\x00\x9a\xfd\xb5\x01\xe5\xfd\xe0\x01\xec\xfda\x01\xec\xfcF\x00\x81\xfb\xcd\xfe\x01\xfaQ\xfd-\xf8N\xfc\xd9\xf6\x9c\xfb\a\xf6\x13\xfbF\xf5\x02\xfb\xf7\xf4\xc3\xfbq\xf5\xc1\xfc1\xf6\x15\xfe\xed\xf6C\xff\xb4\xf7\xb5\x00\x89\xf8\xfc\x01\xb5\xf9\xee\x02\xdc\xfa\xe3\x03\xcc\xfb\xdf\x04\xef\xfc\xcf\x05Z\xfe\xfe\x06\x18\x00\x02\b\xac\x01\x9b\b\xb1\x02\a\t\x86\x03\xf7\b\xdd\x03>\b5\x03\xfc\x06:\x02\xbb\x05\x93\x01\x9d\x049\x01\x0f\x03k\x00o\x01F\xffZ\x00V\xfe\xf5\xff\xec\xfd\xcf\xff\x86\xfd\xd6\xff\xaf\xfc\xae\xff\t\xfc\xc0\xfe#\xfb\xe2\xfd\xbb\xf9x\xfd\xc4\xf8\xed\xfc7\xf8D\xfc\xb5\xf7\xe2\xfb\x89\xf7s\xfb\xe8\xf7\xd5\xfa\x16\xf8\x81\xfa\x1f\xf89\xfa<\xf8\xa0\xf9\x04\xf8\xf3\xf8\x8a\xf7\u007f\xf8h\xf7\xbd\xf7a\xf7,\xf7!\xf7\xde\xf6 \xf7\xb2\xf6\xa3\xf7z\xf6\x10\xf8\xa5\xf6\xc2\xf8\xdb\xf6\xba\xf9\xf0\xf63\xfa\x84\xf7r\xfa\x8c\xf8\xfe\xfa@\xf9\n\xfb\xb7\xf9(\xfbB\xfa\x0e\xfc\b\xfb\x03\xfd\xfe\xfb\x8d\xfdD\xfd[\xfeB\xfe^\xff\x8f\xfe\xe7\xff\xf3\xfee\x00`\xffe\x01~\xff\xe9\x01\xec\xff\x1b\x02\x96\x00\x91\x02\xdc\x00\xf6\x02\xbd\x00\xff\x02\x15\x01\x8b\x03\x01\x02_\x04\xc1\x02\x1d\x05\x8d\x03\x00\x06\xa6\x04\x0e\a\xff\x05\x03\b6\a\xec\bL\b\xa3\t\xad\ts\nI\v\x92\vq\f\x00\rH\r3\x0e\x87\x0eg\x0fi\x0f\xb3\x10\x9a\x10m\x11|\x12b\x12\xda\x13\xe3\x13\xc4\x14\xb8\x14\x14\x16*\x15\x16\x17\x13\x16\xb3\x17g\x16\xa0\x18{\x16c\x19S\x17\x83\x19\xf8\x17:\x1aI\x18g\x1b\b\x198\x1c\xaa\x19\\\x1d\x86\x19\x1e\x1fM\x1a\x88\x1f\x19\x1bi\x1f\xce\x1a\x99\x1f}\x1a\x8f\x1f\x8e\x1a\x80\x1f\u007f\x1a~\x1f*\x1b\x93\x1e\xad\x1b\u007f\x1d\x14\x1b\xf9\x1c\xd4\x1a7\x1c\xbc\x1aZ\x1b\xc0\x19\xa8\x1a\xf4\x18\x9d\x19\xa7\x18a\x18\xc3\x17\xe7\x16\x85\x16\xde\x15\xb7\x15\xb6\x14\x11\x159\x13\x1e\x14\x98\x11\xdb\x12\xcd\x0ff\x11\xa0\x0e\xf7\x0f\xce\rV\x0fR\f\x81\x0e~\v{\r\xbc\n\xf4\f6\tJ\f\x19\a\xda\n\xf8\x04^\t\x8a\x02\xb7\a\x19\x00P\x05\xc5\xfd!\x03\xb9\xfb\x87\x01,\xf9\x8c\xff\xe9\xf6\x89\xfd\xf2\xf4&\xfc&\xf3}\xfaL\xf2\xca\xf8R\xf1\xd3\xf7\x9a\xef\x9d\xf6\xc9\xee\xcd\xf4\xde\xee\xf5\xf3@\xee\x9d\xf3$\xed4\xf23\xed\x80\xf1\xcc\xec\x84\xf17\xec\r\xf1\f\xec\x94\xf0\xbe\xebK\xf0\xf2\xea\x9e\xef\xb5\xe9\x9b\xee\x1b\xe8\x91\xed\xc3\xe6\x1b\xec\xa5\xe5\xbd\xea3\xe4X\xe9\xbc\xe2\x93\xe7}\xe2\xb4\xe6i\xe2\xc9\xe6h\xe1Y\xe6K\xe1\xc4\xe5\r\xe2\xf6\xe5\x0f\xe3\x12\xe6\x9a\xe4y\xe6\x06\xe6\xfb\xe7\xfd\xe7\xe1\xe9\xb4\xea\xce\xeb\xbc\xedO\xee\n\xf0\xd9\xf0\v\xf2\xc7\xf2\v\xf4\xf3\xf4f\xf5\xb6\xf6\b\xf7\xce\xf7\x10\xf9\r\xf9v\xfa9\xfa>\xfae\xfa\xf5\xf9\x16\xfa-\xfaN\xfa\xc2\xf9\x9a\xf9K\xf9S\xf8\xf8\xf8\xd1\xf7\x94\xf8\xa8\xf7\xa4\xf8\xf0\xf6\xe5\xf8\x96\xf6\xc3\xf8c\xf6,\xf9\xe0\xf5\xbf\xf9\xbd\xf5{\xfaK\xf6\x9a\xfb\x19\xf7\xc2\xfco\xf8w\xfdJ\xf9e\xfe\r\xfa\xda\xffx\xfb\x8b\x01\x1f\xfd\x83\x02R\xfe\xa8\x02\x06\xff\x1d\x03<\xffl\x04[\xff\x02\x05n\xff\xd1\x04G\xff\x89\x04\xf2\xfeA\x04\x86\xfe\xff\x037\xfe\xf3\x03\xa3\xfdZ\x04)\xfdo\x04\r\xfdd\x04\xc7\xfcf\x04\xb3\xfc\x15\x04\xc4\xfc\xc5\x03K\xfc\xb3\x03\x11\xfc:\x03z\xfc\x84\x02\xa3\xfc\xf9\x01\x8a\xfcL\x01\x8f\xfcf\x00l\xfc\xc1\xff%\xfcn\xff\x0e\xfc\xc3\xfe\xcb\xfb\x8a\xfd\x01\xfbH\xfcX\xfa\x81\xfb\xf6\xf9\xcd\xfaX\xf9L\xfa\x92\xf8\xd0\xf9s\xf85\xf9\xb4\xf8\xf9\xf8`\xf8%\xf90\xf8V\xf9\x1f\xf8\x8e\xf9\xf6\xf7\x8d\xf9\xdd\xf7k\xf9\xd3\xf7\r\xf9\x16\xf8\xaf\xf8v\xf8\\\xf8\x84\xf8&\xf8\xa1\xf8\xb0\xf7\xb1\xf8\v\xf7\xbc\xf8c\xf64\xf8\xbc\xf5q\xf7\x1e\xf5\xa4\xf6\xac\xf4A\xf6-\xf4'\xf6&\xf3\xe1\xf5\xb3\xf2\xb2\xf5\xda\xf2\xf2\xf5\x17\xf3Q\xf6\xcc\xf3\xcc\xf6\xb9\xf4\xa5\xf7\xbc\xf5\xd6\xf8\xbf\xf6\xcf\xf9\xe9\xf7\x9f\xfaZ\xf9\xc2\xfb\x19\xfb\x84\xfd\xd1\xfce\xff\a\xfe\x14\x01\x0e\xffb\x02S\x00\x9c\x03\xaf\x01\x86\x04\xa5\x02o\x05e\x03\x1b\x06G\x04\xb7\x06\xee\x04:\a\xca\x05\xbb\a\xc2\x06)\b\xc9\a\xa0\b&\b\x1f\ta\bW\t\xec\b\xcd\t\xe2\t \n\xc5\nJ\n\xc8\v\x83\n\xdd\f\xd9\n\xb4\r\x9c\vG\x0ec\fe\x0e\xf1\f\x97\x0eT\re\x0fB\x0e\xfd\x0f\xe4\x0e\\\x107\x0f\xbc\x10\xa3\x0f`\x11>\x10:\x12\x88\x10!\x13\x1c\x11\xd9\x13\xe1\x11\xa3\x14\x85\x12\x14\x15\xe8\x12\xb3\x15O\x13\x99\x16\xfc\x13\xed\x16\xa1\x143\x17\xb8\x14;\x18K\x157\x19^\x16\xda\x19\xc7\x16\xe1\x1a,\x17\xab\x1bY\x18*\x1c\xe1\x18F\x1d^\x19\xae\x1d\x03\x1a5\x1d\xd0\x19\xa5\x1c{\x19\xc1\x1b}\x19?\x1a7\x18^\x19\xa4\x16\xda\x18w\x16u\x17\xde\x15G\x168\x14\xc7\x15\xae\x13.\x14!\x13\xaa\x12\xa0\x11\xa2\x11\x84\x10\xc2\x0fv\x0f\xb8\r\x8c\r\x82\v\xee\v\xc3\b'\n\x02\a}\b\xf2\x05\x1e\bh\x04\a\b\xe7\x02\xe0\x06D\x02m\x06\xd7\x00 \x06\xf4\xfe\xad\x04G\xfdk\x03\xa5\xfa\x1a\x02\xec\xf7\xe1\xff7\xf6O\xfe.\xf5\xea\xfd\x98\xf4m\xfd\x90\xf4\xf2\xfc \xf4\x9a\xfc\xe0\xf2T\xfb\xc1\xf2\xfa\xf9X\xf3\f\xfao\xf2\xb4\xf9\x9f\xf1R\xf8l\xf1\xa7\xf7V\xf0\xb3\xf6\xc6\xef>\xf5U\xf0u\xf5\x00\xf0\xbc\xf5\x8d\xef\x8f\xf42\xf07\xf4G\xf0b\xf4\f\xef\xc5\xf2\xd9\xed\xb7\xf0\x97\xec\xcd\xefM\xebp\xee\xab\xea\b\xed|\xe9\x1d\xec\xdf\xe7\xdb\xea\x94\xe7\xfc\xe9\x9b\xe8\x81\xeaZ\xe9\xc6\xea\u007f\xe9v\xea\xfd\xe8\xfb\xe9i\xe8?\xe9\xdd\xe8\x1e\xe9H\xea\x9f\xea\xce\xeb3\xec\x86\xed\x98\xed\x1b\xef\xb2\xefP\xf1\xed\xf1\x11\xf41\xf4\xf8\xf5q\xf6J\xf7\xe3\xf7n\xf9o\xf9\xf8\xfb}\xfb\xa8\xfd\xcd\xfc~\xfe\x91\xfd\x9c\xff\x8e\xfe\x8e\x00d\xffT\x01\xa8\xff\xec\x01\xd8\xff\xd9\x01\xd5\xff\xb4\x01H\xffz\x02`\xffZ\x03\xf1\xff\x82\x03\x86\xff\xfa\x02X\xfe\xc4\x01e\xfd\x91\x00\x93\xfc\x94\x00#\xfc\x11\x01t\xfc\xc6\x00\xb2\xfc\x96\x00P\xfc-\x01d\xfc\xb5\x01\x93\xfc\xd9\x01\x96\xfcz\x01&\xfc\x04\x01\xca\xfb\xea\x00\xc6\xfb\x1d\x01\t\xfc\x8b\x01]\xfcS\x02\x11\xfd\xc3\x02\xb8\xfd\xd9\x02\x10\xfeJ\x03N\xfe\xb1\x03\x86\xfee\x03G\xfe1\x03\x02\xfe\x00\x03\n\xfeX\x02\xd5\xfd\xc4\x01H\xfd\xd8\x00$\xfdd\xff\xde\xfct\xfe\x8d\xfcE\xfe\x8f\xfc\xff\xfdr\xfc\u007f\xfd\xee\xfb\x19\xfdt\xfb.\xfc\xb1\xfaJ\xfb\xbc\xf9\xaa\xfa:\xf9V\xf9\x87\xf8\xb9\xf7f\xf7#\xf7\xfc\xf6G\xf7J\xf7\x91\xf7\x9b\xf7\xfb\xf7\xd2\xf7\\\xf8M\xf8\\\xf8\xbe\xf8o\xf8\xef\xf8\x02\xf8\xb0\xf8\x8f\xf7Y\xf8H\xf7c\xf8\xf9\xf6\xa5\xf8\xaf\xf6\x9f\xf8\xa6\xf6\xba\xf8f\xf6\xfe\xf84\xf6\xf7\xf8K\xf6\xd2\xf8\x8f\xf6\xcb\xf8\xbc\xf6\xc7\xf8\xbf\xf6\xb3\xf8\xcd\xf6\x86\xf8\xdf\xf6v\xf8\xfd\xf6l\xf8-\xf7\x90\xf8Q\xf7\xda\xf8\xba\xf7\xa1\xf9\x82\xf8m\xfad\xf9/\xfb\x93\xfa\xe5\xfb\xb2\xfb\xfb\xfca\xfc7\xfe\xc9\xfc:\xff\x00\xfd\xbe\xff-\xfd2\x00u\xfd\x89\x003\xfe\x1f\x01\x1e\xff\xdd\x01\xd6\xff\x9e\x02~\x00#\x03c\x01\xbf\x03\x83\x02Y\x04n\x03\xf5\x04\a\x04@\x05\xd9\x04\xba\x05\xc7\x05n\x06\xcf\x06F\a\xca\a\xc2\a\xcf\b^\b\xef\tl\t\xfb\n\x8b\n7\fJ\v\x82\r\xde\v{\x0eU\fj\x0f\x02\r\xea\x0f\x8d\r<\x10\xe3\r\x99\x10a\x0e\xd9\x10\xf4\x0eZ\x11)\x0fr\x12\xc1\x0f\xc8\x12A\x10\xad\x12\xda\x0f(\x13\x8f\x0f\x05\x14B\x10\xb1\x14\xc9\x10f\x15G\x11d\x15\xce\x11\xe5\x14\x98\x11\b\x15z\x11h\x153\x12X\x15\x86\x12Q\x15|\x12]\x15\xc2\x12\x97\x15\"\x13\xe5\x15\x83\x13\x16\x16\xf4\x13\xc4\x15\xdb\x13Q\x15y\x13\xc4\x14Y\x13V\x140\x13V\x14\x16\x13T\x14\xac\x13\xee\x13\xae\x13\xa0\x131\x13\xd4\x13\xfb\x12\x19\x14\x10\x13\n\x14\x15\x13\x8c\x13)\x13\xde\x12\v\x13\xc1\x12\xc5\x12{\x12\xee\x12\xa6\x11\xbf\x12H\x10\xf6\x11\x87\x0e\x1e\x11/\fg\x0f9\n*\r\xfb\a.\vU\x05>\t\x00\x03p\a\x16\x01G\x06\xf8\xfe\xc3\x04\xd0\xfc\x8b\x02k\xfaj\x00\xd5\xf7f\xfe[\xf5J\xfcW\xf3h\xfa\x1c\xf2\x18\xf9G\xf1`\xf8\x04\xf1(\xf8\x8c\xf1Y\xf8d\xf2\xe7\xf8i\xf2\x10\xf9-\xf2\x9f\xf8f\xf2\xbd\xf81\xf3E\xf9\xf2\xf3\xf3\xf91\xf4]\xfa4\xf4D\xfad\xf4\xe5\xf9\xb1\xf4\x95\xf9\xc7\xf4A\xf9F\xf4\xbe\xf8\x8e\xf3\x01\xf8\x11\xf3\v\xf77\xf2\xbe\xf5V\xf16\xf4\xb1\xf0\x9c\xf2\xa3\xef~\xf1,\xee7\xf0B\xed\xb3\xee\xf7\xec\xc1\xed\x05\xed\x94\xed\f\xed\xa5\xed%\xed\xdd\xed\x10\xed\x97\xedA\xed \xed\x16\xed\xc6\xec\a\xed\xd7\xec\xa9\xed:\xed\xe0\xeeF\xeer\xf0\xe0\xef4\xf2P\xf1\xfe\xf3\xc3\xf2M\xf5F\xf4w\xf6\x8f\xf5\xf5\xf7\xb6\xf6L\xf9l\xf7\xde\xfa^\xf8\x1f\xfc\xf4\xf9\x83\xfd\x82\xfb\xa6\xff\xe7\xfc\x8b\x01h\xfeD\x02:\xff\x9f\x02C\xff\xff\x02\x98\xff7\x03%\x00w\x03\x19\x00\xa9\x03\x06\x00%\x03\x8b\xffy\x02\xb5\xfe\xd7\x01/\xfe\xd0\x00\x80\xfd\x15\x00z\xfc\xbe\xff\xe4\xfb\x91\xff\xc2\xfb\x9d\xff\xa0\xfb\x8b\xff{\xfbO\xff8\xfb\xc9\xfe\x89\xfag\xfe\xfa\xf9q\xfe\xdc\xf9\xa3\xfe\x16\xfa/\xff\xf4\xfa\x19\x00&\xfc$\x01\x05\xfda\x02\xf7\xfd9\x03\x15\xffg\x03\xc3\xff\x04\x03\xf2\xff\xc8\x02\xc5\xff\x8b\x02\xaf\xff\f\x02\xb7\xff\xc0\x01\xae\xff\x80\x01\xa9\xff\xb2\x00\x97\xff\xcd\xff\x13\xff\xbd\xfe.\xfe\xb0\xfd\x1f\xfdj\xfc\xf2\xfb\x1d\xfb\xfb\xfa\xfa\xf9\t\xfaJ\xf9\v\xf9\xa2\xf80\xf8\x86\xf7\x96\xf7\xeb\xf6 \xf7\xc9\xf6\x00\xf7l\xf6\xda\xf6_\xf6\x95\xf6\xec\xf6\xaa\xf6!\xf7\xfa\xf6f\xf7<\xf7\xd2\xf7\xc0\xf7\xf7\xf7:\xf8\xde\xf7P\xf8\x11\xf8V\xf8O\xf8\xe9\xf8\x97\xf8\x80\xf9\xed\xf8\xc5\xf9\xde\xf8\x01\xfa\x9f\xf8\x05\xfa\x96\xf8\xea\xf9t\xf8\xb8\xf9;\xf8`\xf9\x1b\xf8:\xf9\x0f\xf8G\xf9\x10\xf8\x8a\xf9\xc1\xf7\u007f\xf9\xc6\xf7}\xf9\xd9\xf7\xc4\xf9\xe1\xf7\xdf\xf9\xec\xf7\xd7\xf9.\xf8\xda\xf9\xa9\xf8O\xfa6\xf9\xb7\xfa\xf7\xf9\x04\xfb\xcb\xfa\xa6\xfb)\xfbG\xfcj\xfb\xcf\xfc\xbb\xfb=\xfdP\xfc\x9e\xfdB\xfd\x1c\xfe\xff\xfd\x9c\xfe\xad\xfe\xd1\xfe\x9a\xff\x90\xff\x82\x00\x8a\x00\x1e\x01\x05\x01\xca\x01h\x01\xcd\x02C\x02\x91\x03\x19\x03e\x04\xcd\x03j\x05\x9d\x04\x1c\x06^\x05\xc2\x06\xdf\x05\x9e\a\xa7\x06\x98\b[\a\x9e\t=\be\n\xfc\b\x18\v\x8f\t\xb9\v\xfd\t\x1d\fE\nC\fb\n\x85\f\x8e\n\x01\r\xc3\n*\r\xd8\nM\r\x94\n\xd3\r\x91\n2\x0e\xbe\ns\x0e\xfd\n\x02\x0fn\v\xaa\x0f\x1e\f\xf6\x0f\x90\ft\x10\xee\f\xf5\x10\x9d\r\a\x11\xf4\r\x12\x11\xe9\r\x11\x11\x11\x0e\xb8\x101\x0eK\x11`\x0e\x18\x12\xad\x0f8\x12\xb7\x10\xfe\x11\xc8\x10\xd9\x11\xb6\x10\x85\x11\xa0\x10>\x11\x8e\x10\xe3\x10\xbd\x10'\x10\xaf\x10\x92\x0f$\x10\xd9\x0fP\x10D\x10\xca\x10\xce\x10\x14\x11\xec\x10v\x11o\x10\x8a\x11\x1a\x10/\x11\x96\x10K\x11\x85\x11\x19\x12\xd5\x11\xfa\x12\x9b\x11\x1a\x13{\x11\t\x13\x8d\x11\xd8\x12\xd3\x11\x1a\x13S\x11+\x13\xc0\x0f\x1f\x12\x9d\rL\x10\n\v'\x0eM\b\xec\v\xf0\x05\xfa\t-\x03 \bT\x00\xf6\x05\xe7\xfd\xbe\x03\xcf\xfby\x01\r\xfaT\xff\xe9\xf7\x8e\xfd\x92\xf5\xc1\xfb\xcd\xf3\xe8\xf93\xf2\x05\xf8\xce\xf0\x8f\xf6\u007f\xefU\xf5\xff\xee\xf6\xf4\xb1\xefp\xf5#\xf1\xa0\xf6\xbc\xf2\xcd\xf7w\xf4\xd8\xf8\x1d\xf6\xd0\xf9\x17\xf7\xa8\xfa\xce\xf7\x18\xfb#\xf8\x15\xfb\xbb\xf7h\xfa\x10\xf7\xbc\xf9\x13\xf7\xd7\xf9\x9a\xf7`\xfa\xb0\xf7j\xfa\xd0\xf6x\xf9\x8f\xf5\xa9\xf7j\xf4\xb8\xf5l\xf3\xf5\xf3i\xf2\x9d\xf2\xfe\xf0^\xf1\x8f\xef\xd2\xef\xb8\xee*\xee>\xee\x19\xed\xc7\xedd\xec\xa8\xed$\xec\x9c\xed8\xec\xf6\xedc\xec\x04\xef\xb6\xec@\xf0\x8f\xed'\xf1\x9a\xee2\xf2\x99\xef~\xf3\xf1\xf08\xf5\x81\xf2\xca\xf6\xdd\xf3\x0e\xf8b\xf5\x90\xf9S\xf7{\xfb\xa5\xf9Z\xfd\x88\xfb\xd5\xfe\xe2\xfc\xcb\xff\x11\xfe\x96\x009\xff\xaa\x01W\x00%\x03_\x01\x9c\x04j\x02o\x05\x10\x03\xfa\x05^\x03_\x06\xe3\x03\xb3\x06s\x04\xd3\x06\xa3\x04W\x06\x1f\x04\xb6\x05;\x03\x1e\x05\x8b\x02e\x04\xef\x01\x96\x03\a\x01\xab\x02&\x00\xa4\x01+\xff\xd3\x008\xfe6\x00\x97\xfd\xfb\xffR\xfd\xb4\xff5\xfd\x93\xff\x11\xfd\xcc\xff\f\xfd$\x008\xfd!\x00]\xfd\x11\x00K\xfdF\x00m\xfd\xf5\x00\x1d\xfe\x04\x02?\xff6\x03\xcf\x00X\x04?\x02\xf6\x04 \x03G\x05\x97\x03u\x05\xfd\x03&\x05\x18\x04+\x04\x90\x03\x13\x03\xd0\x02\b\x02\"\x02 \x01\xa1\x01=\x00\x05\x01&\xffg\x00\xb1\xfdl\xff\x0f\xfc\xb9\xfd\xe3\xfa\"\xfc%\xfa\xf7\xfas\xf9\x1a\xfa\xb4\xf8(\xf9\x01\xf8;\xf8\xb0\xf7s\xf7\xa1\xf7/\xf7\xae\xf7|\xf7\xed\xf7\xcc\xf7\xf9\xf7\xe2\xf7l\xf8<\xf8\xd7\xf8[\xf8\xfc\xf8A\xf8\b\xf9l\xf85\xf9\xe9\xf8B\xf9\x81\xf99\xf9\xcd\xf9W\xf9\xe3\xf9Q\xf96\xfa\t\xf9c\xfa\n\xf9_\xfa\xd5\xf8K\xfar\xf8\b\xfa\x03\xf8\xa2\xf9\xaa\xf7/\xf9\xea\xf7C\xf9\x8e\xf8\xa2\xf9\x1a\xf9\xd1\xf9e\xf9\xdc\xf9a\xf9\xbe\xf9h\xf9\xa1\xf9b\xf9\xae\xf9\x1e\xf9\xc3\xf9\xd5\xf8\x82\xf9\xac\xf8\xf5\xf8\xb7\xf8\x9b\xf8\xea\xf8\xd2\xf8\xdf\xf8\x12\xf9\x81\xf8\r\xf9Z\xf8\xe4\xf8\xa9\xf8\x11\xf9\x04\xf9|\xf9\xa5\xf9\t\xfaQ\xfa\xc8\xfa\x1e\xfb\x8a\xfb\x17\xfc+\xfc(\xfd\xe5\xfc-\xfe\xe4\xfdE\xff/\xff\x83\x00|\x002\x02\xfa\x011\x04\x82\x03\x01\x06\x11\x05r\a}\x06\x99\b\xdd\a\xb0\t\x00\t\xa9\n\xd0\tc\vL\n\xa4\v{\n\xd5\v\xa6\nG\f\xec\n\u007f\f\x16\v|\f\xc9\nn\f\u007f\ny\fm\n\x89\fI\n\x8d\f\x18\nl\f\xd5\t\x1c\f&\t\xec\v\xa8\b\xb8\v\xa4\bp\v\xcb\b0\v\xd1\b\xe8\n\x03\t\xd9\ng\t\x1a\v\xbd\tU\vJ\n\x9e\v\xb0\n\xf9\vy\v\r\f\x05\fA\fp\f\x8d\f\x11\r\xb7\f\xdd\r\xe0\f{\x0eQ\r\x10\x0f\xc2\r\xb0\x0f@\x0e\t\x10 \x0f|\x10\x12\x10E\x11\xf6\x10\xfe\x11\x05\x12\xa7\x12\xcc\x12e\x13:\x13\xb8\x13\xb8\x13\xdc\x13P\x14\x83\x14x\x14\xca\x14\xf2\x13j\x14\x13\x13\xbb\x13J\x12\t\x13\xc1\x11\x89\x12(\x11\xfb\x11\x9d\x10W\x11\xae\x0f\x86\x10$\x0eB\x0f\x18\f\x96\r\xfc\t\xe0\vO\a\xd8\tx\x04U\a\xe7\x01\xf8\x04\x17\x007\x03\xa8\xfe\xf7\x01 \xfd\xd1\x00\xf1\xfb\x9b\xff\xed\xfa\x93\xfe\xcc\xf9\x8c\xfdk\xf8j\xfc\xfb\xf6V\xfb\xe3\xf5\x83\xfa&\xf5\xb5\xf9$\xf5{\xf9\x92\xf5\xf9\xf9\xd6\xf5S\xfa\a\xf6\xa3\xfa\x8b\xf6\t\xfbG\xf7\xa8\xfb\xa8\xf7~\xfb\xbc\xf7\x12\xfb\x90\xf7\xc3\xfar\xf7B\xfat\xf7}\xf9X\xf7\f\xf9\xb1\xf6\x82\xf8\x14\xf6\x9e\xf7\x9c\xf5\xb9\xf6A\xf5\x13\xf6\x85\xf4E\xf58\xf3\xa8\xf3\x16\xf2\xfe\xf1\xa2\xf0\xa5\xf0F\xef\x11\xeff\xee\xc6\xed\xef\xed\x14\xed\x87\xed\xbd\xecR\xedN\xec\xbb\xedb\xec\x12\xee\x00\xed\xd4\xed,\xed\x9c\xed\x14\xed\x10\xeey\xed\x0f\xefk\xeey\xf0a\xef\x1d\xf2\xd3\xf0\xff\xf3\xf7\xf2\x03\xf6\xc6\xf4(\xf8\x9d\xf6-\xfa\xaf\xf8\xbc\xfb\xa9\xfa\xf5\xfc.\xfc\x10\xfe\xc7\xfd\x8d\xff^\xff\xf0\x00\xe0\x00\xf1\x01\xd5\x01\xac\x02\x85\x02\v\x03\r\x03q\x03\x86\x03\xf1\x03\xd0\x03\x17\x04\x05\x04\t\x04\xd8\x03\xd8\x03\x80\x03\xbd\x03\x13\x03\xb6\x03\xa3\x02/\x03\n\x02Q\x02\x10\x01|\x01:\x00\x13\x01\xa7\xff\xce\x00%\xff\xf4\x00\xf7\xfe/\x01I\xff~\x01\x9e\xff\x05\x02\xfe\xff\xa2\x02\x9e\x00\xc8\x02\x00\x01\xb4\x02\xf8\x00\xba\x02\x10\x019\x03k\x01\xba\x03\b\x02\xe2\x03\x9e\x02\xf5\x03\x14\x03U\x04\xcc\x03\xd7\x04\x97\x04`\x05\xeb\x04\xa4\x05\x02\x05\x88\x05\xcc\x04\xfb\x04\f\x04\xc4\x04c\x03\x9e\x04!\x03\xe5\x03\x94\x02\xbc\x02\x85\x01\xa8\x01\xbd\x00\xd1\x00\x06\x004\x00b\xffR\xff\xa9\xfe0\xfew\xfd\xd8\xfc\xfc\xfb\x86\xfb\x99\xfa\x80\xfaJ\xf9\xc3\xf9v\xf8\xfb\xf8\xce\xf7B\xf8/\xf7\xe6\xf7\xbc\xf6\xc5\xf7\xa2\xf6l\xf7\x87\xf6\n\xf7`\xf6\xbf\xf6S\xf6\xba\xf6F\xf6\xd1\xf6D\xf6F\xf7z\xf6\xec\xf7!\xf7x\xf8\xcd\xf7\x06\xf9\xac\xf8h\xf9W\xf9W\xf9\x8f\xf9\x19\xf9q\xf9\xb7\xf8n\xf9\x89\xf8b\xf9-\xf8 \xf9\xb3\xf7|\xf8\x1b\xf7\x9d\xf7\x97\xf6\x0e\xf71\xf6\xcc\xf6\xd5\xf5\x80\xf6N\xf5'\xf6\xb1\xf4\x8f\xf5\xf7\xf3\x91\xf4\xc5\xf3\xee\xf3\xd0\xf3\xc4\xf3\xb3\xf3\xbd\xf3\x8e\xf3\xa6\xf3\x98\xf3\x8e\xf3\xef\xf3\xdb\xf3E\xf4u\xf4\xe3\xf41\xf5\x80\xf5\x1c\xf6\xfc\xf5\x06\xf7\xd1\xf6\xe2\xf7\xe8\xf7\xc3\xf8\xc0\xf8\xb7\xf9\x92\xf9\xb9\xfap\xfa\x9b\xfbd\xfb\x83\xfc%\xfcF\xfd\x06\xfd*\xfe\x13\xfeH\xff?\xff\xa0\x00\x91\x00\xf1\x01\xea\x01\x16\x03\x91\x03\x1f\x04\x05\x05\x1c\x05\x19\x06\xcb\x05I\a~\x06P\bN\a\xbe\b\xbe\a\xfe\b\xef\a\x8e\tg\b,\n4\t\xa8\n\xc3\t!\v\xf6\tm\v\xf3\t4\v\x89\t\xfd\n\xd2\b\xd9\n\x84\bs\nd\b\xf3\tA\b\x9d\t\x18\b{\t:\b\x8f\t\x84\b\xf4\t\xee\bv\n\\\t\xf9\n\xe7\t\x9e\v\x8f\nE\f\x1c\v]\r\x10\f9\x0ek\r\xc7\x0ek\x0e\x88\x0f4\x0fK\x10/\x10\xeb\x10O\x11\x99\x11-\x12\x88\x12\xec\x128\x13\xbe\x13\xa8\x13\x17\x14\xe6\x13\x05\x14(\x14\xf5\x13H\x144\x14\x11\x14-\x14\xd6\x13\x02\x14\xd3\x13\xf0\x13\xaf\x13\xd5\x13O\x13f\x13\xf5\x12\xd1\x12\x90\x129\x12E\x12X\x11\n\x12\xb0\x10\xb8\x11b\x10!\x11\x19\x10\xd9\x10\xcc\x0f\xed\x10\xf4\x0fv\x10\xe1\x0fR\x0f\xe2\x0e\xe6\r\x86\r\xa0\v\xdb\v9\t\xd3\t\x01\a\xee\a\x14\x05\x96\x06e\x03\x92\x05\xb8\x01\x83\x047\x00b\x03\f\xffC\x02\xa9\xfd\x1d\x01\x91\xfbb\xff\x8b\xf9R\xfd\xe0\xf7\xc3\xfbN\xf6`\xfaV\xf5A\xf9@\xf5\xf1\xf8L\xf5Z\xf9o\xf5o\xf9\xfd\xf5\xa5\xf9\x8f\xf6+\xfa\xde\xf63\xfa@\xf7\x00\xfa\xc9\xf7\v\xfa\xac\xf7\xc0\xf9\x17\xf7\xac\xf8A\xf6\xb6\xf7b\xf5\xf7\xf6\xf8\xf4\x90\xf6|\xf4j\xf6\xd7\xf3\x00\xf6\x14\xf3O\xf56\xf2J\xf4O\xf1\xc2\xf2\x8d\xf0I\xf1\xdc\xef5\xf0\xc7\xee\x13\xef \xee2\xee\t\xee4\xees\xee\xab\xee'\xefU\xef\x85\xef\x15\xf0\x95\xef\x92\xf0\xe8\xef\xe0\xf0\x89\xf0P\xf1L\xf1\a\xf2m\xf2\xdf\xf2\xf9\xf3|\xf4\xc1\xf5T\xf6U\xf7\xd5\xf7\x92\xf8)\xf9\xaa\xf9\x8c\xfap\xfa\xa7\xfbN\xfb\x96\xfc_\xfcW\xfd\x8f\xfd#\xfe\x9e\xfe\a\xff\xf6\xff\xe4\xff\xb3\x01\f\x01\xf3\x02T\x02\xa4\x03\xe6\x02\x14\x046\x03\xc5\x04\xca\x03\x98\x05|\x04D\x06\a\x05\xd3\x06`\x05&\a\x9f\x05\x13\ap\x05\xe8\x06<\x05\xd4\x06d\x05\xcb\x06\x95\x05\xc0\x06\xbe\x05~\x06\xd6\x05\x80\x06\xc5\x05r\x06\xa0\x05]\x06z\x05g\x06I\x05\xa0\x06R\x05\xbf\x06<\x05\x19\a\x1a\x05K\aF\x05\x94\a\xad\x05\xff\a\xda\x05'\b\xf1\x05\x06\b\xf1\x05\xfa\a\xc2\x05>\b\xe0\x05\x90\b\xf5\x05\xc7\b\x95\x05\x96\b\v\x05\xe4\aE\x04\xfb\x06[\x03\xc3\x05t\x02`\x04\x8f\x01$\x03Y\x00\x1b\x02#\xff!\x01,\xfe\x18\x00W\xfd\x05\xffJ\xfc\x16\xfe\x1c\xfb\x1c\xfd\x04\xfa\x16\xfc\x1f\xf9:\xfbr\xf8z\xfa\xf6\xf7\xbf\xf9\xbc\xf7b\xf9\xc3\xf7k\xf9\xe6\xf7<\xf9\xde\xf7\xbb\xf8\xc6\xf7Q\xf8\xc0\xf7\xcb\xf7\x8a\xf7\x80\xf7P\xf7\\\xf7b\xf7\x11\xf7U\xf7\xfa\xf6[\xf7\xcf\xf6\x9e\xf7=\xf6\xb4\xf7\xc0\xf5W\xf7c\xf5\xbb\xf6\xcd\xf4\xe5\xf5_\xf4\b\xf5\xec\xf3v\xf4*\xf3\xed\xf3\x88\xf28\xf3\\\xf2\r\xf3E\xf21\xf35\xf2`\xf3'\xf2c\xf3\x13\xf2e\xf3\x02\xf2*\xf3\xdc\xf1\xb7\xf2\x8a\xf1G\xf2j\xf1:\xf2\x1c\xf1\x80\xf2\xd3\xf0\xb9\xf2\xe4\xf0,\xf3Y\xf1\x01\xf4\xb7\xf1\xab\xf4\xde\xf1\x04\xf5!\xf2@\xf5_\xf2\x81\xf5\xb6\xf2\xa4\xf5\\\xf3\x04\xf6d\xf4\xdf\xf6Q\xf5\xdf\xf7\x96\xf6\xf1\xf8\xec\xf7X\xfaD\xf9\xba\xfb\xb5\xfa\xde\xfc,\xfc\x02\xfe\x80\xfd/\xff\xe2\xfe3\x00Y\x008\x01\xbc\x016\x02\x0f\x03'\x03U\x04(\x04X\x05\xfa\x04\xee\x05\x93\x05=\x06\xe5\x05\x91\x06,\x06\xfd\x06H\x06~\af\x06\xf3\a[\x06Y\bs\x06\xb0\b\x8c\x06\x16\t\xdc\x06\xc0\t1\aZ\n\xdd\a\xc3\nR\b\xfd\n{\bR\v\xaf\b\xac\vO\t\xcc\v\xbc\t8\f\xfe\t=\r\xeb\n\x0f\x0e;\f\x9e\x0e\xe2\f[\x0f\x91\r\xb6\x0fy\x0e\xc5\x0f\xba\x0e/\x10\xe9\x0ee\x10R\x0f,\x10`\x0fD\x10f\x0fi\x10\xba\x0f\x83\x10\xb4\x0f\xfa\x10\xb1\x0f[\x11\x16\x10,\x11\xde\x0fd\x11\xac\x0f\x96\x11\xe0\x0f\xd2\x11\xc1\x0fN\x12\xd5\x0f\x82\x12T\x10Q\x12P\x10\x1f\x12\b\x10\xe7\x11\f\x10V\x11z\x0f?\x11\x04\x0f(\x119\x0f|\x10\xe9\x0eM\x10\x8a\x0eQ\x10\x9f\x0e\xd5\x0fz\x0eY\x0f\xec\r\xf8\x0e\xcb\rY\x0ej\r\xd5\r\xc9\f'\r\x93\f\xf3\v\xe3\vF\n\xad\n\v\b;\t\xac\x05d\a\xe8\x03\xc1\x05\x91\x02\x11\x05E\x01e\x04\x1e\x00p\x03\b\xff\xc1\x02+\xfdM\x01\x95\xfa\xd4\xfe\xee\xf7V\xfcN\xf5D\xfa\x8a\xf2\xfc\xf7\x8a\xf0\x14\xf6U\xef\x14\xf5\x1c\xef\xab\xf4\xe0\xef\xe0\xf4\xdb\xf0\x96\xf5~\xf1\xf3\xf5\xf7\xf1\xdd\xf5#\xf2\x89\xf5z\xf2=\xf5I\xf3\x82\xf5\xd6\xf3K\xf6\xf9\xf3\x95\xf6f\xf47\xf7\xe8\xf4\xf9\xf7\xd4\xf5\x93\xf8\xda\xf6\x8c\xf9;\xf7(\xfa\xfb\xf6t\xf9~\xf6r\xf8\x99\xf5\x90\xf7t\xf4+\xf6N\xf3\x10\xf5\x98\xf1$\xf4$\xf0\xa0\xf2\xa2\xef\x9d\xf1\xf0\xef\x9c\xf1M\xf0\xf5\xf1\x98\xf0\xef\xf1\xc7\xf0\x16\xf2\xbd\xf0\xa1\xf1\x17\xf1S\xf1\xb7\xf1\xaf\xf1\xfc\xf2\x9c\xf2\xcd\xf4S\xf4\xa2\xf6g\xf6\xaf\xf8\x1f\xf8\x86\xfa\xe4\xf9\xd8\xfb\x98\xfb\x02\xfd\xd8\xfc\x1e\xfe\x1b\xfe\x86\xff\xd4\xff/\x01\x11\x01\xe3\x02j\x02\x94\x04\xf6\x03h\x06\x80\x05\xe0\a\xc5\x06u\b\x80\a\x9c\b\xe0\a\xcd\b\"\bD\t\x8a\b\x06\n\x19\t\xac\nu\t\xe9\n\xad\t\x83\n9\t\x1d\n\u007f\b\xbb\t\x16\b-\t\xa9\aZ\b\u007f\x06\x99\a\xb1\x05\xf2\x06\x1a\x05\x85\x06?\x04\x05\x06\x9d\x03>\x05\xe5\x02<\x04\xc3\x01M\x03\x8d\x00\xec\x02\xad\xff\xe2\x02&\xff\xef\x02\xe3\xfeT\x03\xe0\xfe\xf6\x03\x0e\xff\x80\x04}\xff\xe9\x04\xe3\xff/\x05:\x00c\x05\x8a\x00\xc1\x05\xe2\x00k\x06\x1a\x01\x8b\x06K\x01\xef\x055\x01(\x05\xe8\x00O\x04\xba\x00i\x03~\x00\xb7\x02\xdb\xff\xaf\x01E\xffw\x00{\xfep\xffn\xfd\xe0\xfd#\xfc\xfe\xfb\xbd\xfa\xef\xf9\x18\xf9\xc4\xf7\x90\xf7\xf2\xf5<\xf6\xf3\xf4g\xf5=\xf4\xe2\xf4w\xf3F\xf4\xfa\xf2\xa7\xf3\x8a\xf2S\xf3\t\xf2\x12\xf3[\xf1\xc5\xf2\xcc\xf0i\xf2\x99\xf0\x85\xf2\xbb\xf0\xb0\xf2\v\xf1\xfc\xf2d\xf1a\xf3\xad\xf1\xbc\xf3z\xf1\xe0\xf3b\xf1\xe4\xf3\x9f\xf1\x1e\xf4\xa9\xf1K\xf4y\xf1\x1b\xf4\xa3\xf1\xe5\xf3\xcd\xf1\xee\xf3\xe0\xf1\f\xf4\xd9\xf1\xf7\xf3\x9d\xf1\xfb\xf37\xf1\xc5\xf3\f\xf1\x80\xf3G\xf1f\xf3\x96\xf1\x80\xf3\xc7\xf1\xa7\xf3\xcb\xf1\xac\xf3\xe4\xf1\xca\xf3\x1a\xf2(\xf41\xf2\xac\xf4P\xf2\xef\xf4j\xf2\xec\xf4\x84\xf2\xdc\xf4\xed\xf2\xfc\xf4\x97\xf3s\xf5\t\xf4!\xf6\xa4\xf4\x0f\xf7\xb1\xf5\x01\xf8\xc8\xf6\x1a\xf9\xff\xf7\xf5\xf9c\xf9\xd1\xfa\x92\xfa\xe8\xfb\xb6\xfb\xe9\xfcS\xfd\x01\xfe\xf4\xfe\x8f\xffk\x00!\x01\xf9\x01\x96\x02\xc5\x030\x04\xcc\x05\xd0\x05\xc5\a \a\xf2\b\x14\b\x9e\tp\bI\n\xc9\b\xc0\n\\\t\b\v\xc2\t;\v\xc4\t@\v\xaa\t6\v}\t\x8c\v^\t\xa8\v*\t{\v\xe4\b\xe4\nY\bK\n\x9d\a>\nk\a'\n\x85\a\xd0\tg\a\xf4\tj\a\x99\n\xe2\aC\v]\b$\f\x0f\t\r\r\x04\n\x9a\r\xd6\nd\x0e\x99\vg\x0f`\f\xdc\x0f\xd9\f\x1e\x10:\rU\x10\xc6\r\xc5\x10W\x0e}\x11,\x0f\x06\x12\xc9\x0fP\x12\xd9\x0fu\x12\xe6\x0f\xd0\x12\x1c\x10\xe1\x12\x1f\x10\xb3\x12\x15\x10U\x12<\x10\xda\x11?\x10\xb4\x11g\x10\xc3\x11\x95\x10\\\x11z\x10\xb6\x10\xe0\x0fG\x10i\x0f\xe0\x0f3\x0f;\x0f\xe2\x0e\x17\x0f\xf0\x0e\xc5\x0e\xf1\x0e\x1d\x0e\xc1\x0ev\rD\x0e\xe2\f\xb1\r0\f'\r[\vk\f|\n\xbb\v\xe4\t4\v\xb4\t\x19\v\x8e\t)\v\x12\t\x03\vk\b\x8a\n\x96\a\xad\t\x01\x06b\bd\x04\x14\a\n\x03/\x06\xac\x01~\x05\xc2\x00\xd5\x04\x06\x00w\x04\xf3\xfe\xc4\x03\xc3\xfd\x8e\x02:\xfc>\x01h\xfa\xb2\xff\xc1\xf8\xfc\xfdj\xf7s\xfcM\xf6%\xfb\x82\xf5x\xfa\xe0\xf4$\xfaM\xf4\x89\xf92\xf4\xff\xf8\xd2\xf3\x9c\xf8\xac\xf34\xf8\"\xf4,\xf8w\xf4\xa5\xf8\xad\xf4\x93\xf8\xf3\xf4;\xf8\x02\xf5\x03\xf8\xeb\xf4\x98\xf7\xd9\xf4s\xf7\xbc\xf4\xa4\xf7\xd7\xf4\x02\xf8-\xf5C\xf8\xb8\xf5u\xf8\xf0\xf5V\xf8\xb1\xf5\x94\xf7&\xf5\xb0\xf6\xaf\xf4\xc1\xf5\x97\xf4>\xf5g\xf4\xe8\xf4p\xf4\xb6\xf4\x1c\xf5\x1d\xf5\xd4\xf5\xe8\xf5@\xf6e\xf6\x92\xf6;\xf6\x01\xf7\x8d\xf6\xa7\xf7\x16\xf7\xcd\xf8\xd1\xf7,\xfa%\xf9M\xfbU\xfaV\xfc#\xfbS\xfd\x0e\xfc\x13\xfe)\xfd\x99\xfe\xf8\xfd\x00\xff\xa6\xfe\xa9\xffW\xff\xdc\x00!\x00;\x02-\x01v\x03\x19\x02&\x04\xa4\x02\x97\x04\xe0\x02\xb2\x04\xee\x02\xdf\x04\xc1\x022\x05\xe6\x02\xa9\x05l\x03|\x06\x05\x04r\a\xaa\x041\b3\x05x\b6\x054\b\xdf\x04\xf6\a\x93\x04\f\bo\x04!\b\x89\x04-\b\xa0\x04R\b\xb7\x04}\b\xde\x04\x9d\b\xe4\x04\x86\b\xd2\x04\x02\b^\x04m\a\xcd\x03(\ap\x03.\a*\x03@\a\xf1\x02\x0e\a\xa5\x02:\x06\"\x02\\\x05\x8d\x013\x05n\x01<\x05\xb1\x01#\x05\xca\x01\xf5\x04\xb8\x01\x8c\x04g\x01=\x04%\x01\xaf\x03\xc8\x00\xba\x02\x1c\x00\x81\x01c\xff\x8b\x00\xd6\xfe\xf2\xff\xa1\xfel\xff\x92\xfe\xa7\xfe<\xfe\xbb\xfd\x9f\xfd\xe9\xfc\xfd\xfc%\xfcS\xfc!\xfb\x87\xfb\xea\xf9\x9b\xfa\xb2\xf8\xae\xf9\xad\xf7\xdb\xf8\x0e\xf7P\xf8\x1f\xf6\xdd\xf7\xcc\xf4\xf8\xf6Y\xf3>\xf6-\xf2\xc1\xf5\x83\xf1+\xf5@\xf1\xa1\xf4\xd7\xf0\x12\xf4\u007f\xf0\x98\xf3K\xf0f\xf33\xf09\xf3\xcd\xef\xf0\xf2\xe1\xee\x94\xf2\x1b\xee;\xf2\f\xee\n\xf2\x85\xee,\xf2\xe2\xee9\xf2)\xef\x11\xf2\u007f\xef\xe3\xf1\xf3\xef\xf9\xf1u\xf06\xf2\xd3\xf0x\xf2\xec\xf0\x8d\xf2\xec\xf0\xa2\xf22\xf1\xef\xf2\xb6\xf1Z\xf3\xde\xf1c\xf3\xa1\xf1/\xf3\x90\xf1!\xf3\xc3\xf1.\xf3\x05\xf2C\xf3d\xf2r\xf3\xa7\xf2\xd5\xf3\x98\xf2\x1e\xf4\xcf\xf2W\xf4T\xf3\xd3\xf4\xd9\xf3\x8b\xf5D\xf4\x1a\xf6\xd8\xf4\x9a\xf6\xb6\xf5?\xf7\xcc\xf6\xe4\xf7\xca\xf7\x84\xf8\x9a\xf8*\xf9x\xf9!\xfax\xfaR\xfb\xc4\xfb\x97\xfcW\xfd\xf5\xfd\x80\xfe\xfd\xfe\x93\xff\xcf\xff\x04\x01\xb4\x00S\x02\x8b\x01&\x03\xf9\x01\x94\x03;\x02\b\x04\x82\x02\xd1\x045\x03\xab\x05\x01\x04f\x06\x81\x04\xfb\x06\xab\x04g\a\xe4\x04\xd9\a\xfa\x04\x93\bW\x05\f\t\xa6\x05=\t\xb5\x05y\t\xe4\x05\b\nM\x06\xb1\n\xe3\x06Y\v\x8a\ak\v\n\bh\v:\b\xdb\vi\bo\f\xfc\b\xe9\ft\tT\r\b\n\xb1\r\x9d\n\x13\x0e\xfc\n\x9a\x0eL\v\xc4\x0e\xb2\vr\x0e\xbd\v@\x0e\xc5\v\r\x0e\xe2\v\xc1\r\xbc\v\xd9\r\x84\v\xe9\r\xd0\v\xbf\r*\f\xc9\rq\f\n\x0e\xd6\f\xe0\r\b\r\xc5\r\xf9\f\xcf\rA\rs\rY\r\x1b\rA\r\xcb\f4\r{\f7\rG\f\x1e\rQ\fV\r_\f\xa8\r\x8e\f\xd9\r\x92\f\x00\x0e\x1c\f\x98\r\xb2\v\xea\fv\v\x9c\f\a\v0\f\x96\n\xaa\v;\n_\v\xd8\t5\v\xbd\t\x05\v\a\n)\v/\nB\vm\n<\v\xd6\n|\v$\v\xbd\v|\v\xdf\v\xc9\v#\f~\v\x16\f\xb4\nj\v\xd2\t\xc9\n\xb5\b\x00\n\x8f\a\xdd\b'\x06\xe4\ap\x04\xa9\x062\x03b\x05\xfe\x01g\x04\x06\x00\xfc\x02\xb6\xfd\xca\x00^\xfb\xb0\xfe\f\xf9\x9e\xfc\x01\xf7\x86\xfa\a\xf5\xe4\xf8\x0f\xf3u\xf7\x01\xf2\x81\xf6\xc4\xf1f\xf6\xec\xf1\x8c\xf6\x8f\xf2\xda\xf6@\xf3j\xf76\xf4\x12\xf8\xa3\xf5\xcf\xf8\xf7\xf6\xb5\xf9\x04\xf8n\xfa\xed\xf8(\xfb\xb5\xf9&\xfcj\xfa\x13\xfdy\xfb\xec\xfdh\xfc\xa5\xfe\xf7\xfc\x04\xffa\xfd\x11\xffr\xfd\x01\xff<\xfdk\xfe\xdf\xfcm\xfd\xbe\xfb'\xfc\x89\xfa\xa2\xfa\xa9\xf9y\xf9\xfa\xf8\xbe\xf80\xf8\f\xf8u\xf7(\xf7\x03\xf7w\xf6\xb2\xf6\xcf\xf5\x9a\xf6>\xf5\xcf\xf6\x00\xf5\xd0\xf6\xc4\xf4\b\xf7\x96\xf4|\xf7\xf2\xf4[\xf8\xac\xf5T\xf9\xae\xf6\x05\xfa\x98\xf7\x94\xfa\x92\xf8s\xfb\x96\xf9\xdf\xfc\xd3\xfa[\xfe,\xfc\u007f\xff\u007f\xfdK\x00x\xfe;\x01b\xffv\x02\xa3\x00}\x03\xd8\x01\x0e\x04\xa9\x02\xcd\x04h\x03\x98\x05|\x04}\x06S\x05S\a\xc4\x05\xa6\a\x06\x06n\a\xfa\x05\x05\a\xb4\x05\xdd\x06\x85\x05[\x06\x10\x05\x9b\x05V\x04\xcb\x04~\x03(\x04\xd1\x02\xd1\x03\x13\x02?\x03=\x019\x02V\x00\x8c\x01\x9d\xff\x00\x01\x12\xff\x9c\x00\x86\xfeP\x00\x01\xfe\v\x00\x87\xfd\xa2\xff\n\xfd\x89\xff\xce\xfc\xca\xff\xde\xfc\xd1\xff\xf5\xfc\x00\x00\x02\xfd\x1a\x008\xfd\x81\x00\xb0\xfd.\x01R\xfe\xa3\x01\xc3\xfe\x83\x01\x02\xffY\x01*\xff\n\x01L\xff\xa8\x00=\xff7\x00\x1f\xff\xd1\xff\x06\xff\x87\xff\xeb\xfe\x17\xff\xbe\xfe~\xfel\xfe\xa2\xfd\xe3\xfd\xe3\xfcu\xfd\xe8\xfb\xeb\xfc\xde\xfaS\xfc\x1a\xfa\xc2\xfb\x84\xf9;\xfb\x8b\xf8\x8e\xfar\xf7\x9b\xf9\x8f\xf6\xe4\xf8\xd0\xf5G\xf8\x13\xf5\xbd\xf7<\xf4%\xf7\x85\xf3\xa9\xf68\xf3b\xf6/\xf3/\xf6>\xf3\xe4\xf5\xe9\xf2\xad\xf5h\xf25\xf5+\xf2\xec\xf47\xf2\t\xf5N\xf2\x15\xf5i\xf2\xe3\xf4\xcf\xf2\xd8\xf4N\xf3\v\xf5\x90\xf3K\xf5\x0e\xf4`\xf5~\xf4\x94\xf5\xac\xf4\xc3\xf5\xa7\xf4\xd4\xf5\xe7\xf4\xbd\xf5,\xf5\xa0\xf5$\xf5\xa2\xf5\xf8\xf4\x83\xf5\x06\xf5~\xf5C\xf5\xbb\xf5:\xf5\xdd\xf5\a\xf5\xc2\xf53\xf5\xbf\xf5E\xf5\xd3\xf5/\xf5\xb8\xf5)\xf5\x9f\xf5\x01\xf5\xb3\xf5\xc8\xf4\xae\xf5\x05\xf5\xfa\xf5\x8a\xf5\x96\xf6\x1c\xf6,\xf7\xb5\xf6\xa0\xf7U\xf7\v\xf8\x18\xf8\x98\xf8\xf1\xf8E\xf9\xac\xf9\xf7\xf9^\xfa\xb9\xfa\u007f\xfb\xbe\xfb\xd0\xfc\xf7\xfc\\\xfe4\xfe\xf3\xff\x91\xff(\x01\xaa\x00#\x02_\x013\x03\x1a\x02$\x04\xf3\x02\xf6\x04\xa1\x03\xe5\x05O\x04\xc8\x06\xf9\x04|\a\x8e\x05`\b\x1b\x06\x17\t\xac\x06\x8f\t\x05\a\xd4\t1\a\x02\nV\a\x14\nT\a'\n@\a\xfc\t\x15\a\xe6\t\xea\x06(\n!\a\x8a\n\xbe\a\xd2\n1\bL\v\xc3\b\x9f\vS\t\xb0\v\x93\t\xf2\v\xa8\tB\f\xf4\tP\f>\n[\f|\n\x9d\f\x19\v\xd4\f\xce\vV\r_\f\xbf\r\xfb\f\xb8\rF\r\xc4\rf\r\xdb\r\x93\r\xfb\r\xd5\r.\x0e\n\x0e\\\x0eI\x0eF\x0ex\x0e\\\x0e\x8f\x0e\xa4\x0e\x06\x0f\xa8\x0e^\x0f\xd4\x0es\x0f\xce\x0e\x9e\x0f\x99\x0e\xb7\x0fy\x0e\x98\x0fj\x0eI\x0f\x18\x0e\xfb\x0e\xce\ry\x0e\x94\r<\x0e\xfd\f\xf0\r\x8e\f_\r\x06\f\xe4\fa\vv\f\x14\v\xdd\v\xb9\nc\v\x1d\n\xd9\n\x8e\t\xe6\t\x94\t\x85\t\xd3\t\xc2\t\n\n\xe4\tb\n\xfa\t\xb8\n?\n\x1f\vk\n\xa5\v\xc7\n\xf8\v)\v\xcd\v\x10\vA\vr\n\xbc\n\x1f\n'\n\xc9\t2\t\x13\t\xc0\a\xf3\a\x18\x06\xca\x06\x9a\x04\x88\x05:\x03\x92\x04\xa4\x01H\x03\xed\xff\xd0\x01\xa4\xfd\x1a\x00I\xfb\xee\xfdR\xf9\x19\xfc\x90\xf7\xb6\xfa\xf0\xf5g\xf9\xc1\xf4d\xf8P\xf4 \xf8w\xf4J\xf8C\xf5\xac\xf8\x05\xf6P\xf9\x19\xf6k\xf9\x14\xf6&\xf9\x8a\xf6e\xf9V\xf7\xe9\xf9-\xf8t\xfa\x84\xf8\xf4\xfa\xe9\xf8W\xfb\xcb\xf9\xe7\xfb\x8a\xfa\xaf\xfc\xde\xfa\xe3\xfc\n\xfb\x9b\xfc\v\xfb\xa9\xfc\xd0\xfaS\xfc\xd9\xfa\xc8\xfb\x94\xfa.\xfb\xbc\xf9Y\xfa\x0f\xf9/\xf9\xcd\xf8\xd9\xf8\xb4\xf8\xca\xf8H\xf8,\xf8\xa4\xf7\xb0\xf7\x8d\xf7\x1e\xf7\xbc\xf7\xeb\xf6\xb7\xf7\xad\xf6r\xf74\xf6\x03\xf7\xa6\xf5\x02\xf7\x9c\xf5\x93\xf7*\xf6`\xf8\xc2\xf6\xe1\xf8\x86\xf7\xee\xf8\xfd\xf7_\xf9d\xf8#\xfaO\xf9\xff\xfaX\xfa\x86\xfb\f\xfb\x0f\xfc\xd5\xfbf\xfd\r\xfd&\xff\x81\xfe\xa4\x00\x03\x00\x93\x01\x04\x01X\x02\xc8\x01!\x03\xac\x02\xb7\x03t\x03?\x04\xa1\x03v\x04\xd6\x03\x95\x047\x04\xca\x04\x1f\x04\x17\x05\x14\x04\xd0\x04\xd0\x03\x0e\x04\x1a\x039\x03H\x02\x02\x03\xe9\x01\xd8\x02\x8d\x01e\x02\x0f\x01\xd1\x01L\x00n\x01\xae\xff\x11\x011\xff\xbb\x00\xe3\xfe\\\x00D\xfe\xfc\xff\xcd\xfd\xae\xff\\\xfd\xf2\xff[\xfdv\x00\xa0\xfd\xd5\x00\x01\xfe\xd9\x00\xf9\xfd\xc0\x00\xd2\xfd\xa9\x00\xe6\xfd\xc6\x00 \xfe\xac\x00>\xfe\x1b\x00\v\xfe\x83\xff\x90\xfd\x11\xff-\xfd\xab\xfe!\xfdM\xfeD\xfd\xf3\xfd]\xfd\xb3\xfd\x90\xfd\x8e\xfd\xa1\xfd\x98\xfd\xbd\xfdT\xfd\xe8\xfd\x9d\xfc\x85\xfd\xb0\xfb\xb7\xfc\xf9\xfa\x14\xfc\x93\xfa\xb3\xfb\x1f\xfak\xfb\xb4\xf9M\xfb\x9a\xf9Y\xfb\xcd\xf9b\xfb\xbf\xf9F\xfb@\xf9\xc5\xfaN\xf8\x06\xfa6\xf7J\xf99\xf6~\xf8\x9f\xf5\xd9\xf7\xee\xf47\xf7\x83\xf4\xaa\xf65\xf4W\xf6\x05\xf45\xf6\xdd\xf3\xf7\xf5h\xf3\x8e\xf5\xdc\xf2,\xf5|\xf2\xc2\xf4\x83\xf2\x88\xf4\xba\xf2v\xf4\xb9\xf2H\xf4\xa5\xf2\x0f\xf4\xa4\xf2\xff\xf3\xe0\xf2\x12\xf4+\xf3+\xf4P\xf3;\xf43\xf3/\xf4G\xf3;\xf4\xa5\xf3M\xf4\xc9\xf3\x98\xf4\xbe\xf3\xa8\xf4\xb7\xf3\xbe\xf4\xec\xf3\x06\xf5F\xf4`\xf5\x86\xf4\x9c\xf5\xbd\xf4\xc0\xf5\n\xf5\xf1\xf5S\xf52\xf6\xbf\xf5\x82\xf6<\xf6\x04\xf7\xd6\xf6\x89\xf7\x97\xf72\xf8\x86\xf8\xe2\xf8[\xf9\x82\xf9\x0e\xfa\xf6\xf9\xcc\xfaX\xfab\xfb\xd6\xfa\xfe\xfbx\xfb\xe3\xfc&\xfc\xe2\xfd\x12\xfd\xdb\xfe\xf9\xfd\xdc\xff\xc3\xfe\xf6\x00t\xff\xcd\x01<\x00X\x02\xc2\x00\xea\x02U\x01\x97\x03\b\x02]\x04\xad\x024\x05`\x03/\x06&\x04\x04\a\xdd\x04\xa3\a>\x05<\b\xaa\x05\xc8\b \x06\x1f\t\x8d\x06}\t\xf2\x06\xe9\t]\aC\n\xcb\a\xb3\nB\b\r\v\xb8\b\\\v\x15\t`\vy\ti\v\u007f\t\x82\v\x9e\t\xa5\v\xce\t\xb2\v\x19\n\xc1\vF\n\xff\vq\n\x17\f\xc8\n*\f\xf4\nN\f8\v]\fW\vi\fx\v\x85\fq\v\xc5\f\x9c\v\x06\r\xdc\v$\r2\f\x94\r\xaf\f\xd4\r7\r\xf7\r\x8c\r\x0e\x0e\xe4\r\x12\x0e\x01\x0e\xfb\r\x0f\x0e\xd0\r\xed\r\xa7\r\xf2\rV\r\xaf\r\x1a\r~\r\xda\f^\r\xb8\fL\r\x9f\f>\r\xff\v\xc9\fj\v\f\f\xe6\n;\v`\ny\n\x17\n\xd5\t\x95\tL\t*\t\xbe\b\xec\bK\b\xfb\b=\b\n\tJ\bc\tw\b|\t\xa7\b\x9b\t|\b\xfd\t\x9b\bO\n\xf9\b\xaf\nL\t\xf0\n{\t&\v\xcd\tn\v*\ns\v^\n\xd6\n\x1b\n\xef\t\x8b\t}\tK\t\xb0\b\xe0\b^\a\xd9\a\xa6\x05\x88\x06\xe7\x03@\x05h\x02\x19\x04\x10\x01\x01\x038\xff\xd2\x01\xdd\xfc\x1d\x00\xcc\xfad\xfeo\xf9@\xfd\x83\xf8u\xfc\xb1\xf7\xbb\xfb\xcd\xf6\xed\xfa;\xf6-\xfa\xeb\xf5\xbe\xf9\x15\xf6\xd9\xf9\xc7\xf6T\xfad\xf7\xde\xfa\xbe\xf79\xfb\x87\xf8\x97\xfb\xc3\xf9O\xfc\x92\xfa\xb6\xfc\a\xfb\xe3\xfcH\xfb\f\xfdz\xfb1\xfd\x9d\xfb,\xfd\xb1\xfb(\xfd\x8f\xfbI\xfds\xfb\xf7\xfcj\xfb\x96\xfc\x05\xfb\x01\xfcG\xfa\x14\xfbz\xf9.\xfa\xb6\xf8O\xf98\xf8\x8b\xf8\xf9\xf7\x13\xf8\\\xf7\x8f\xf7\xdc\xf6\xbb\xf6\xa5\xf6U\xf6W\xf6\x1c\xf6S\xf6\xf7\xf5\x97\xf6\x1d\xf6\x99\xf6;\xf6\x8e\xf65\xf6\x00\xf7l\xf6\xd9\xf7'\xf7\x9b\xf8\xea\xf77\xf9\xbe\xf8\x9e\xf9j\xf9B\xfa\r\xfab\xfbE\xfbM\xfc\u007f\xfc\xff\xfc4\xfd\xe1\xfd\xcb\xfd\x02\xff\xa9\xfe\xe2\xff\x99\xff\x8e\x00<\x00C\x01\xf1\x00\xc1\x01\x9d\x01\x9f\x02/\x02S\x03\x9c\x02\xd5\x03\xe0\x02\xce\x03\xfa\x02\x80\x03\xb6\x02\x82\x03e\x02s\x03>\x02\xd7\x02\xdc\x01\x10\x02;\x01\xd3\x01\x03\x01\x00\x02\x06\x01\xb8\x01\xe3\x00O\x01?\x00\xdb\x00\x9e\xff\xa3\x00i\xff\xa4\x00K\xff\xc6\x00)\xff\xe7\x00\a\xff\xfd\x00\xe0\xfe\xdb\x00\xcd\xfe\x13\x01\xd4\xfeE\x01\x03\xff#\x01%\xff\x18\x019\xff\x8d\x01\x81\xff?\x02\"\x00\xb7\x02\xa6\x00\xdf\x02\xd1\x00\xe1\x02\xe1\x00\xcb\x02\v\x01m\x02\xfc\x00\xae\x01\xaa\x00\xe3\x00\x8d\x00E\x00z\x00\x05\x00y\x00-\x00\xa2\x00,\x00\xe4\x00\x90\xff\xa1\x00\xa8\xfe\xd4\xff\x11\xfe\x10\xffz\xfd\x98\xfe\x92\xfc\v\xfe]\xfb\x15\xfdE\xfa@\xfc\x95\xf9\xd1\xfb\x0f\xf9s\xfbn\xf8\xef\xfa\x9d\xf7\x81\xfa\x03\xf7\xdd\xf9\xad\xf6R\xf9\x86\xf6\xcf\xf8-\xf6l\xf8\x96\xf5\xe3\xf7\x15\xf5Z\xf7\xb9\xf4\xf0\xf6v\xf4\xa5\xf6T\xf4p\xf6d\xf4x\xf6\xb6\xf4\x8d\xf6\x01\xf5\x9e\xf6\\\xf5t\xf6\x8b\xf5\xf5\xf5s\xf5\x8f\xf5\"\xf56\xf5\t\xf5 \xf5\x1c\xf5!\xf5>\xf5*\xf5\x16\xf5\"\xf5\xf5\xf4\x0e\xf5\x16\xf50\xf5\x18\xf5\x1c\xf5\xca\xf4\xf1\xf4\x80\xf4\x94\xf4K\xf4W\xf4`\xf4d\xf4r\xf4\xa9\xf4j\xf4\xdc\xf4t\xf4\x12\xf5\x89\xf4\x10\xf5\xe7\xf4V\xf5I\xf5\xc3\xf5\xda\xf5M\xf6|\xf6\xcf\xf6X\xf7y\xf7`\xf8-\xf8V\xf9\xd3\xf8E\xfa\xaa\xf9\xfb\xfa}\xfa\xba\xfbM\xfb\xdb\xfcW\xfc\x04\xfeq\xfdG\xff\x9a\xfe[\x00\xb6\xffI\x01\x96\x00%\x02U\x01\xce\x02\xe8\x01N\x03;\x02\xa7\x03\x88\x02\x1a\x04\x11\x03\xa2\x04\xb2\x030\x058\x04\xa5\x05\xa8\x04\v\x06\xf1\x04e\x06\x1f\x05\xb5\x06e\x05\xf6\x06\x8c\x05\xfe\x06\xad\x05\v\a\xb4\x05S\a\xd9\x05\xc5\a4\x06;\b\xbc\x06\x9e\b8\a\xe3\b\x9b\af\t\xff\a\xec\t\x8b\bC\n\xe7\bF\n\xec\ba\n\xd5\bt\n\b\t\xd2\n-\tG\v\x91\t\x8f\v\xe9\t\xaf\v;\n\xe6\v\x89\n'\f\xbc\nk\f\xe0\nX\f\xfd\n<\f\xdd\n{\f\x02\v\xb6\fr\v\xc9\f\xe7\v\xb1\f\x10\f\xca\f?\f\xcd\fr\f\xd8\f\xb7\f\xbf\f\xc0\f\x90\f\x9c\fI\fv\f\xda\v7\f\x94\v\xb7\v&\vV\v\x80\n\xd3\n\xda\tC\nq\t\x96\t1\t\x19\t\xf0\b\xcb\b\x92\ba\bH\b\xe5\a\x19\b]\a\x02\b\r\a\xe2\a\xc3\x06\xdc\a\x83\x06\b\b\x96\x06q\b\xe4\x06\xf3\bK\aQ\t\xb4\a\x8e\t\xea\a\xc5\t$\b0\no\b\xc2\n\xc7\b\r\v$\t)\vZ\tm\v\x89\t\x8d\v\xda\t\x94\v\b\n8\v\x00\nm\n\x93\t\xb5\t\x04\t\xe8\b\xa5\b\x17\b<\b\a\a\xa9\ak\x05\x99\x06\xa0\x03]\x05\x1e\x02-\x04\xfc\x00X\x03\x9f\xffz\x02\x1f\xfe]\x01\xe4\xfcY\x00\x8a\xfbF\xff\x82\xfa\x1a\xfeM\xf9\x12\xfd\x03\xf8\f\xfc\xea\xf6\b\xfb\xb6\xf6\x90\xfa\x16\xf7\xb6\xfa\xa6\xf7 \xfbh\xf8\xa7\xfb\x1a\xf9\x1a\xfc\xac\xf9N\xfc\x00\xfa{\xfc\xff\xf9a\xfc2\xfaX\xfc\x9d\xfa\xc6\xfc\x05\xfbS\xfd\x8a\xfb\xbd\xfd\r\xfc#\xfe3\xfcU\xfeC\xfcg\xfeS\xfcN\xfe\xc9\xfb\xd0\xfd\x97\xfa\xa9\xfcg\xf9D\xfbp\xf8\x11\xfa\xa3\xf7\xed\xf8\xb0\xf6\x01\xf8M\xf5\t\xf7-\xf4\x01\xf6\x97\xf38\xf5j\xf3\xee\xf4[\xf3\xba\xf4<\xf3\x8a\xf4\xf1\xf2#\xf4\xf1\xf2\xb1\xf3\n\xf3\x92\xf33\xf3\xa8\xf3\x96\xf3\xfe\xf3C\xf4\xe0\xf4m\xf5\xfb\xf5\x17\xf7`\xf7\xd9\xf8\xe0\xf89\xfaa\xfa}\xfb\xb4\xfb\xb3\xfc\xcb\xfc\xc7\xfd\x96\xfd\xe4\xfeZ\xfe\xb6\xff-\xffv\x00\xee\xff\xa8\x01\xcb\x00\xa6\x02\xe6\x01\f\x03\xa0\x02:\x03\xd1\x02x\x035\x03\xbe\x03\xa6\x03\t\x04\xdb\x03\xff\x03g\x03K\x03\xa0\x02u\x02\x90\x01\xc7\x01\xb8\x00H\x01\x10\x00\x99\x00q\xff\x92\xff\xaf\xfe\x05\xff\xf4\xfd\x03\xff\x95\xfdI\xffu\xfd\x12\xff\x16\xfd\x97\xfeg\xfcG\xfe\xbb\xfb8\xfe\x82\xfbe\xfe\xa7\xfb\xa3\xfe\xd5\xfb\a\xff<\xfc\xaf\xff\b\xfd\xb7\x00\n\xfe\xe9\x01+\xff\xab\x02\x1e\x00\xe2\x02\xd6\x00\x04\x03H\x01\x90\x03\xca\x01\x12\x04G\x02\x1d\x04\x9c\x02\x03\x04\xe0\x02\x19\x04B\x03/\x04\xb1\x03\xc0\x03\xa1\x03\xbe\x02\xff\x02\xa7\x01$\x02\xaa\x00R\x01\x03\x00m\x00C\xff\x9e\xff\x01\xfe\x88\xfe\xf1\xfcy\xfd\x06\xfc\xbe\xfc_\xfbM\xfc\xa2\xfa\x9d\xfb\x9c\xf9\x9c\xfa\xa9\xf8\x9d\xf9\x19\xf8\xd1\xf8\xc0\xf7<\xf8f\xf7\xbd\xf7\xe3\xf6-\xf7\x8e\xf6\xea\xf6\xa1\xf6\xee\xf6\x01\xf7\r\xf7\x05\xf7H\xf7\xe8\xf6R\xf7\xee\xf65\xf7+\xf7F\xf7a\xf7~\xf7W\xf7}\xf7\x12\xf7U\xf7\x1e\xf7d\xf7b\xf7\xaa\xf7|\xf7\xe2\xf7\x93\xf7\xf9\xf7|\xf7\xfd\xf74\xf7\x10\xf8#\xf7\x0f\xf8\xe2\xf6\xeb\xf7^\xf6\x8c\xf7\xc6\xf5\x01\xf7b\xf5\x82\xf69\xf5\x18\xf6\x1e\xf5\xdd\xf5\xec\xf4\xaa\xf5n\xf4b\xf5\x1d\xf4*\xf5\t\xf4\b\xf5&\xf4)\xf56\xf4\x1e\xf5j\xf4\x1a\xf5\xf2\xf4E\xf5\xc2\xf5\xf8\xf5^\xf6\x88\xf6\xcf\xf6\xf9\xf6P\xf7\x95\xf75\xf8l\xf8z\xf9\x95\xf9\xb2\xfa\xc9\xfa\xeb\xfb\xe8\xfb\x1a\xfd\xf9\xfcL\xfe\x1a\xfe\x95\xff,\xff\xa6\x001\x00}\x01\x0f\x01(\x02\xbe\x01\x16\x03v\x02\xf2\x03`\x03\xa7\x04\x13\x04+\x05\x99\x04|\x05\xe4\x04\xb7\x05\x17\x05\xee\x051\x05\xf7\x05\f\x05\xfc\x05\xd7\x04\x0f\x06\xd9\x04J\x06\x17\x05\xaa\x06K\x05\xbf\x06n\x05\x88\x06m\x05j\x06K\x05\x83\x067\x05\xe2\x06>\x056\a9\x05\x88\a^\x05\x13\b\xc0\x05\x04\t\x80\x06\xca\tv\a\x19\n!\bR\np\b\xc9\n\xf3\ba\v\x88\t\xec\v\xf4\tK\fi\n\xd9\f\xec\nJ\rw\v\xa9\r\xef\v\f\x0eZ\f2\x0e\xd0\f:\x0e\n\r8\x0e0\rs\x0eE\r|\x0eP\r\x17\x0e\x0f\rj\r\x85\f\x11\r\xe3\v\xeb\f~\v\xb2\f#\v;\f\xad\n\xd6\v4\n}\v\xe7\t\x17\vx\t\x98\n\xe9\b\xfe\t9\bP\t~\a\xca\b\xed\x06G\b=\x06\xfb\a\xa8\x05\xd6\a\u007f\x05\x9e\a\x90\x05i\an\x05Z\a\x85\x058\a\xae\x05\x16\a\xae\x05[\a\xd7\x05\xbd\aV\x06\a\b\xc5\x06;\b)\ai\by\a\xa5\b\xed\a9\t\xb4\b\xbc\t\x95\t\xe5\t\x1d\n\x06\n`\ns\n\xc7\n\x01\vG\v\\\v\xb6\v\"\v\xc8\vx\n\x8b\v\xe5\t\x1f\vN\t\x8e\n\x85\b\xd3\t\xdc\aJ\t\xd3\x06\xcb\b9\x05\xb2\a\x83\x03\x1a\x06\xe3\x01\x8e\x04\xa9\x00T\x03\xa2\xffe\x02h\xfe\x81\x01\xc7\xfc:\x00d\xfb\xe6\xfe,\xfa\xd7\xfd\xe8\xf8\xdc\xfc\x83\xf7\xde\xfb+\xf6\b\xfb?\xf50\xfaT\xf5#\xfa\xf6\xf5\xcc\xfa\xdd\xf6\xbe\xfb\xca\xf7\x83\xfc*\xf8\x1f\xfd\xe7\xf7\b\xfd\xf9\xf7\xb2\xfcL\xf8\xea\xfci\xf8\"\xfde\xf8L\xfd\xe3\xf8\xa7\xfd\xaa\xf91\xfef\xfa\x85\xfe\xd0\xfa\xb7\xfe\xc0\xfa\x91\xfeC\xfa\xd9\xfdJ\xf9\xaf\xfc5\xf8(\xfb\x8b\xf7\xe3\xf9\xe7\xf6\x1a\xf9;\xf62\xf8\xb8\xf5N\xf7m\xf5\xc9\xf6\n\xf5.\xf6\xd5\xf4w\xf5\xbc\xf4\x17\xf5\x9d\xf4\xc9\xf4\x8c\xf4U\xf4\xa0\xf4\n\xf4\xf5\xf4!\xf4\x87\xf5\x8b\xf4,\xf6>\xf5.\xf7&\xf6\x8c\xf8_\xf7\x9e\xf9\xa4\xf8\x91\xfa\xa1\xf9\xea\xfb\xcc\xfa\xfe\xfc+\xfc\xae\xfd\x1b\xfd\x92\xfe\xb1\xfd\xcd\xff\xcd\xfe\x17\x01\x1e\x00<\x02(\x01B\x03\xff\x01\xe6\x03\xa4\x02\x13\x04\xc6\x02\xef\x03\x91\x02\xc5\x03[\x02\xc6\x03D\x02|\x030\x02B\x03\xc7\x01F\x03=\x01*\x03\xdd\x00h\x02%\x00\xb6\x015\xff)\x01v\xfe\xae\x00 \xfe3\x00\x90\xfd\t\x000\xfd@\x00k\xfdj\x00\xb5\xfdl\x00\x97\xfdi\x00j\xfd\xac\x00w\xfd\xf1\x00\xf6\xfd?\x01\x93\xfe\xb3\x01\x1e\xff)\x02\xa6\xff\xe8\x02\x86\x00\xb4\x03x\x015\x04A\x02N\x04\xcb\x02\x18\x04\xf8\x02\x01\x04\b\x03\x06\x04,\x03\xf1\x03\x1b\x03\xb5\x03\x18\x03}\x03$\x03E\x032\x03\xf9\x02%\x03\x96\x02\x18\x03;\x02\xcf\x02\xb4\x01@\x02\x13\x01\x8a\x01d\x00\xa2\x00\xba\xff\xac\xff\x00\xff\xeb\xfe\xe8\xfd\x1d\xfe\xf8\xfc?\xfd[\xfc\xc0\xfc!\xfc\x88\xfc\x9b\xfbC\xfc\xf6\xfa\xbe\xfbM\xfa\x1c\xfb\xbf\xf9G\xfa\a\xf9\x90\xf9F\xf8\xe9\xf8\xc1\xf7q\xf8i\xf7N\xf8\x12\xf73\xf8\xe2\xf6!\xf8\xd7\xf6-\xf8\xc0\xf6b\xf8\xa2\xf6s\xf8}\xf6\x8a\xf8\x88\xf6}\xf8\x92\xf6<\xf8i\xf6\xf8\xf7I\xf6\xd9\xf7.\xf6\xc4\xf7\xfb\xf5\xa3\xf7\xcd\xf5a\xf7\xa1\xf5%\xf7\x8c\xf54\xf7\x8a\xf56\xf7\x94\xf5\x00\xf7x\xf5\x86\xf6V\xf5\xf8\xf5C\xf5\x8e\xf5\x11\xf5Z\xf5\xf5\xf43\xf5\xf5\xf4\x0e\xf5\x0e\xf5\x12\xf5_\xf5-\xf5\xc4\xf5e\xf5\x19\xf6\xbe\xf5i\xf6\t\xf6\xdc\xf6{\xf6V\xf7\x1c\xf7\xe4\xf7\xae\xf7\x9b\xf8`\xf8c\xf9=\xf9\"\xfa\x19\xfa\x00\xfb\xe8\xfa\xe2\xfb\xdb\xfb\xb5\xfc\xd4\xfc\x93\xfd\xbf\xfdq\xfe\xd7\xfeM\xff\xc6\xff\x18\x00\x91\x00\xf4\x00?\x01\xa8\x01\xc1\x01[\x026\x02\x03\x03\xcc\x02\x8b\x03V\x03\xf2\x03\x83\x03\x9d\x04\xd1\x03^\x05?\x04\xc6\x05q\x04\xe8\x05A\x04\xd5\x05\xfe\x03\xd9\x05\xbe\x03\xf1\x05\x90\x03\t\x06\x82\x032\x06\x93\x03>\x06\xc4\x03|\x06\xcc\x03\xc2\x06\xde\x03=\a\x15\x04\xa9\aX\x04\xd4\a~\x04\t\b\xba\x04G\b\x16\x05\xa5\b{\x05\x00\t\x01\x06]\t\x9f\x06\x94\t!\a\xdc\t\x8d\aL\n\x14\b\xc4\n\x94\b\x14\v\xf6\bJ\vW\tN\v\x83\t0\v\x84\t\xf5\ns\t\xc1\ne\t\x9e\n\x85\tj\n\xad\t0\n\x99\t\xe3\tY\t\xba\t\f\t\x85\t\xb3\b\x1b\tD\b\x8c\b\xac\a,\b\"\a\xd4\a\xc1\x06t\a\u007f\x06\x1c\aM\x06\xb9\x06\xf5\x05>\x06\u007f\x05\xa7\x05\xdb\x04*\x05R\x04\xea\x04\x10\x04\xc9\x04\xf9\x03\xcf\x04\xdf\x03\xe9\x04\x11\x04\x0e\x05R\x04\xf7\x04\x93\x04\xee\x04\xb6\x04\xd5\x04\xc4\x04\xae\x04\xab\x04\xbd\x04\xdb\x04\xcb\x04)\x05\xc4\x04h\x05\v\x05\x9c\x05N\x05\xf3\x05p\x05/\x06M\x05D\x06&\x05P\x06\xf5\x04U\x06\xd9\x04\x19\x06\xe6\x04\b\x06\xd9\x04\x1f\x06\xa7\x04\xf2\x05W\x04\x8b\x05%\x04D\x05i\x04M\x05y\x04\x8d\x05\x8b\x04\xd0\x05\xa5\x04\x12\x06\xf6\x04O\x06]\x05\xaa\x06\x82\x05\xd5\x06E\x05\xaf\x06\xe6\x04u\x06\xc8\x04e\x06\xb5\x04\x94\x06l\x04\xb0\x06\x9a\x03x\x06e\x02\xdb\x05\x81\x01L\x05\xde\x00\xc4\x04\xf2\xff.\x04\xd6\xfeM\x03\xef\xfdx\x02G\xfd\xe3\x01\x94\xfcR\x01\xe2\xfb\x81\x00\x05\xfb\xbf\xff\xec\xf9\x04\xff.\xf9:\xfe\xe7\xf8\xb6\xfd\xf2\xf8\x88\xfd5\xf9p\xfdx\xf9q\xfd\xb4\xf9o\xfd\x04\xfa?\xfd\"\xfa\xff\xfc\x14\xfa\xab\xfc\xbf\xf9.\xfc\x93\xf9\xb6\xfby\xf9I\xfb\xb3\xf9\x06\xfb\xfb\xf9\x01\xfb\n\xfa\x04\xfb\xe2\xf9\xbd\xfa\xbe\xf9R\xfa\xcc\xf9\x10\xfa\xfb\xf9\xd3\xf9\xeb\xf9\x8a\xf9\xc0\xf9\x1d\xf9\x81\xf9\xb4\xf8x\xf9\x89\xf8\x8c\xf9v\xf8\x82\xf9a\xf8m\xf9\x89\xf8z\xf9\xb7\xf8\xb0\xf9\xe8\xf8\xf9\xf9\t\xf9\xfd\xf9\xf9\xf8\xbb\xf9\xb4\xf8\x9c\xf9\x91\xf8\xb0\xf9\xc2\xf8\xff\xf9\xf4\xf8N\xfa-\xf9\xda\xfa\xb3\xf9y\xfbc\xfa\x14\xfc\xe1\xfa\x90\xfc:\xfb\xdd\xfcq\xfb\x15\xfd\xad\xfb\x8a\xfd\x0f\xfcm\xfe\xb0\xfci\xffq\xfd-\x003\xfe\x04\x01\xd9\xfe\xe1\x01\x94\xffs\x02<\x00\xaa\x02f\x00\xb3\x02\x85\x00\xbc\x02\xd0\x00\xe1\x02\xec\x00\r\x03\x13\x01L\x03S\x01\xa5\x03\xab\x01\xbc\x03\f\x02\xab\x03\f\x02\x96\x03\xfe\x01t\x03\xe0\x01f\x03\xe5\x01s\x03\xdb\x01{\x03\x02\x02@\x03\x06\x02\x12\x03\xe2\x01A\x03\x1b\x02b\x03\x80\x02D\x03\x90\x02V\x03u\x02\xa6\x03\x9f\x02\xbf\x03\xcc\x02\xa7\x03\xab\x02q\x03\x94\x02\x19\x03\x98\x02\xe8\x02\x8e\x02\xe4\x02\xa4\x02#\x03\xd3\x02\x80\x03\x1f\x03~\x03C\x03n\x03\x04\x03@\x03\xbc\x02 \x03\x85\x02\xc1\x02e\x02o\x029\x02\xf6\x01\x05\x02o\x01\x90\x01\xeb\x00\xf1\x00L\x00Q\x00\x90\xff\xb5\xff\xab\xfe\n\xff\xae\xfdO\xfe\xd4\xfc\x8b\xfd\x11\xfc\xec\xfcg\xfbJ\xfc\xc9\xfa\xce\xfb\"\xfa/\xfbA\xf9\x85\xfaa\xf8\xa7\xf9\xc6\xf7\x02\xf9[\xf7\xac\xf8\x16\xf7\x84\xf8\xf9\xf6[\xf8%\xf7C\xf88\xf7\x12\xf8F\xf7\xd6\xf74\xf7\xcd\xf7\x10\xf7\xce\xf7\xba\xf6x\xf7{\xf6\xfd\xf6\x8c\xf6\xc6\xf6\xc9\xf6\xcf\xf6\xfe\xf6\xcf\xf6$\xf7\xe3\xf6F\xf7\xe6\xf6%\xf7\xe2\xf6\x1e\xf7\xf8\xf6\x1c\xf7\xfa\xf6\"\xf7\x1d\xf7(\xf7\x11\xf74\xf7\xfe\xf6P\xf7\x18\xf7\x82\xf7h\xf7\xc7\xf7\xca\xf7\x0e\xf8E\xf8G\xf8\xac\xf8\u007f\xf8\xfa\xf8\x99\xf8.\xf9\x9d\xf8F\xf9\xc0\xf8b\xf9\xf4\xf8\xb3\xf9C\xf9\x1d\xfa\xa8\xf9t\xfa,\xfa\xf3\xfa\xfc\xfa\x94\xfb\xcb\xfb5\xfc\u007f\xfc\xa3\xfc\x01\xfd\xf9\xfc\x80\xfd[\xfd,\xfe\xdc\xfd\x03\xffs\xfe\xf3\xff'\xff\xd0\x00\xdf\xff\xa9\x01\x8b\x00\x87\x02\x16\x01J\x03\x91\x01\xec\x03\x00\x02K\x04V\x02\x90\x04\x9a\x02\xe3\x04\xe5\x02'\x05\x16\x03c\x05;\x03\xac\x05i\x03\xcb\x05\xac\x03\xea\x05\xda\x03\n\x06\n\x04 \x06[\x04\x1f\x06\xbc\x04+\x06\xf0\x04C\x06\x01\x05\x88\x06\"\x05\xe4\x06c\x05\b\a\xb4\x059\a\n\x06q\ao\x06\x9d\a\xd4\x06\xe5\a(\a\a\bv\a\x13\b\x9f\a!\b\xa8\a!\b\x97\a-\b\x88\a.\b\x81\a\x14\bt\a\xfa\a_\a\xf4\aQ\a\x13\be\a\x04\b`\a\xdd\a9\a\xbf\a\x1f\a\x81\a\xfd\x06N\a\xce\x06\x06\af\x06\xc1\x06\a\x06e\x06\xba\x05\x14\x06z\x05\xc4\x05-\x05s\x05\xe0\x04\x1a\x05\xa3\x04\x96\x04X\x04\xfa\x03\xf0\x03k\x03w\x03\xc7\x02\xef\x021\x02T\x02\xb0\x01\xc6\x01V\x01}\x01\xfe\x00[\x01\xcb\x00:\x01\xd2\x00U\x01\xb4\x00z\x01\x81\x00^\x01n\x00?\x01k\x00T\x01w\x00^\x01\xc1\x00\u007f\x01\xfe\x00\xd1\x01 \x01\a\x02D\x01!\x02t\x01H\x02\x91\x01c\x02\xbc\x01x\x02\xcf\x01\xb5\x02\xa3\x01\xc6\x02n\x01\xac\x02i\x01\x9c\x02\x94\x01\xbf\x02\xc9\x01\xf6\x02\xf3\x01\x1f\x03^\x02q\x03\x9e\x02\xd1\x03\xcb\x02\x16\x04\xf6\x02_\x04\x01\x03\x85\x04+\x03\xcb\x04O\x03\xff\x04\xbf\x03R\x05!\x04\xbe\x05\x9b\x045\x06\xf2\x04{\x06)\x05\xa6\x06\x8e\x05\xf1\x06\x91\x05=\an\x051\ab\x05\x1a\ae\x052\a-\x05%\a\xfa\x04\xde\x06\x9c\x04\x89\x06\xdf\x03\xfb\x05K\x03c\x05\xcd\x02\xe8\x042\x02\x81\x04L\x01\xe3\x03\xd9\x00K\x03\xba\x00\x10\x03\xa8\x00\xd1\x024\x00F\x02w\xfft\x01\xb3\xfe\x9b\x00\xcf\xfd\xbf\xff=\xfd\a\xff\xe3\xfc\xa5\xfe\xb9\xfc\x8b\xfe\xdf\xfc\xb9\xfe3\xfd\xf0\xfe\xa4\xfdP\xff\xe1\xfdL\xff\xdb\xfd\x1a\xff\x8c\xfd\xb0\xfe\x13\xfd\x1d\xfe\x9a\xfc\x86\xfd?\xfc\x11\xfd4\xfc\xef\xfcg\xfc\x17\xfd\x9e\xfc]\xfd\xd0\xfcR\xfd\xee\xfc\x1e\xfd\xfc\xfc\xe1\xfc\x81\xfc,\xfcw\xfb\xe8\xfad\xfa\x91\xf9\x8d\xf9\x96\xf8\x05\xf9\xed\xf7\xb6\xf8\xa5\xf7\x9d\xf8\xbe\xf7\xd9\xf8\xec\xf7,\xf9\f\xf8q\xf9\v\xf8i\xf9\xd9\xf7*\xf9\x8a\xf7\x02\xf9S\xf7\xfa\xf8K\xf73\xf9~\xf7\xa1\xf9\xd0\xf7,\xfa\x9f\xf8\xd3\xfav\xf9\xbe\xfb\\\xfa\x9a\xfc\x03\xfb>\xfd\x96\xfb\x85\xfd\xea\xfb\xe7\xfdI\xfcC\xfe\xb4\xfc\xc9\xfe\b\xfdb\xff\x8e\xfd\xf2\xffB\xfe\\\x00\xe2\xfe\xce\x00f\xffF\x01\xf9\xff\x89\x01[\x00\xb5\x01\x9a\x00<\x02\xd7\x00\x9e\x02E\x01\xeb\x02{\x01\x1f\x03\x91\x01-\x03\xc9\x01\x1c\x03\xe2\x01\xf0\x02\xd1\x01\xc2\x02\xac\x01\x95\x02\xa6\x01\x83\x02\xab\x01s\x02\x94\x01~\x02\x8a\x01\x90\x02\x8b\x01\x8b\x02\x88\x01d\x02\x87\x018\x02n\x01\x0f\x02\u007f\x01\f\x02\xa4\x014\x02\xdd\x01R\x02\x12\x02P\x02D\x02{\x02^\x02\xf9\x02\xa3\x02]\x03\x04\x03\\\x03\x04\x03'\x03\xc4\x02\x11\x03\xb6\x02\xfc\x02\xb0\x02\xc8\x02\x94\x02\x8e\x02e\x023\x02\v\x02\xb7\x01\xb8\x01`\x014\x01\xfe\x00\xbf\x00v\x002\x00\xd3\xff\x89\xffC\xff\x05\xff\x9b\xfe}\xfe\xd1\xfd\xd5\xfd\x1d\xfd?\xfd\x9d\xfc\xe2\xfcQ\xfc\xaa\xfc\xfc\xfb5\xfc\x9f\xfb\xc3\xfbJ\xfb9\xfb\xd8\xfa\xc6\xfaQ\xfaO\xfa\xe1\xf9\xd4\xf9m\xf9n\xf9\xff\xf8\x17\xf9\xef\xf8\xeb\xf8\xfb\xf8\x05\xf9\xf5\xf8\b\xf9\xc8\xf8\xcd\xf8\xa7\xf8\x88\xf8\x86\xf8N\xf8a\xf8\x01\xf8F\xf8\xe4\xf7G\xf8\b\xf8c\xf8[\xf8f\xf8h\xf8o\xf8d\xf8|\xf8`\xf8e\xf8j\xf8!\xf8=\xf8\x12\xf8\xf0\xf7\x15\xf8\xc3\xf7\xf1\xf7\xa6\xf7\xb4\xf7x\xf7\x95\xf7^\xf7i\xf7b\xf7M\xf7.\xf7\x1d\xf7\x0e\xf7\x16\xf7$\xf7\x12\xf76\xf7$\xf7@\xf7}\xf7y\xf7\xea\xf7\xb9\xf7W\xf8\x0e\xf8\xbc\xf8\x9f\xf8F\xf9J\xf9\xc8\xf9\x04\xfat\xfa\xbb\xfa&\xfbm\xfb\xff\xfb\t\xfc\xcf\xfc\xcd\xfc\xa9\xfd\x83\xfdw\xfe&\xfe<\xff\xd8\xfe\xef\xff\x87\xff\xa3\x00h\x00h\x01&\x01\x19\x02\xcd\x01\xb1\x026\x02&\x03\xb3\x02\x92\x03\x17\x03\f\x04r\x03d\x04\xcf\x03\xb1\x04\x0e\x04\x05\x05^\x04_\x05\xbb\x04\xb3\x05\"\x05\xc2\x05'\x05\xa4\x05%\x05\x82\x05\x17\x05u\x05\x1d\x05d\x05\x12\x05l\x05\xeb\x04_\x05\xd8\x04:\x05\xbb\x04\"\x05\xa1\x04\r\x05v\x04\xfd\x04k\x04\x05\x05\x81\x04\xfe\x04\xa4\x04\xff\x04\xcd\x04,\x05\xef\x04T\x05\b\x05~\x05 \x05\xa4\x05F\x05\xbe\x05f\x05\xca\x05\x8a\x05\xee\x05\xa4\x05,\x06\xb9\x05@\x06\xde\x057\x06\xd8\x05\x17\x06\xbf\x05\xef\x05\xaa\x05\xb5\x05r\x05|\x050\x05 \x05\xcf\x04\xab\x04h\x049\x04\xd8\x03\xd3\x03a\x03\x83\x03\xd7\x02\x1e\x03x\x02\xa5\x02&\x02B\x02\xe4\x01\x04\x02\xb7\x01\x9c\x01r\x01O\x01\a\x01\b\x01\xbc\x00\xa3\x00d\x008\x00\x01\x00\a\x00\xb1\xff\xdf\xff\xb6\xff\xb9\xff\xa9\xff\x94\xff\x9c\xff\xb6\xff\xb4\xff\xea\xff\xea\xff\xed\xff\xf4\xff\xf6\xff\xfe\xff\x12\x00\x1c\x00\x10\x00E\x00\b\x00<\x00\"\x00[\x00e\x00\xa8\x00\xc5\x00\x17\x01>\x01\xa7\x01\xac\x010\x02\x19\x02\xaa\x02\x8b\x02\x12\x03\xfa\x02r\x03,\x03\xc4\x037\x03\xc0\x03^\x03\xd4\x03\xa6\x03*\x04\xf2\x03\x96\x04]\x04\x1a\x05\x9e\x04x\x05\t\x05\xb7\x05O\x05\xe1\x05\x89\x05\x03\x06\xa9\x05\x14\x06\xd3\x05#\x06\x01\x06g\x06e\x06\xb2\x06\xe9\x06G\a\x8d\a\xef\a\x03\b\xa3\b\xad\b4\t'\t\xb9\tq\t\xf7\t\x94\t\a\n\xa4\t\x14\n\xc4\tA\n\x05\n~\nJ\n\xe3\nx\n7\vS\nJ\v\xe3\t\x0f\v@\t\x80\n<\b\xa9\t\xf0\x06{\b\xb1\x05F\a\xc4\x04]\x06\xe3\x03\xb1\x05!\x03\x16\x05\x9f\x02\xb6\x04#\x02d\x04j\x01\xcd\x03t\x00\xd6\x02\xc1\xff\xdb\x01\\\xffN\x01\xd8\xfe\xc2\x00\u007f\xfe6\x00\x88\xfe\x14\x00\xc2\xfeB\x00\xef\xfef\x00.\xffn\x00\x15\xffO\x00\xa2\xfe\xde\xff)\xfe-\xff\xcd\xfd\xaf\xfei\xfd/\xfe\xf4\xfc\x9b\xfd\x8c\xfc\xfb\xfc_\xfc\x9e\xfc\x12\xfcL\xfc\x8b\xfb\xbd\xfb\xfb\xfa\xfe\xfan\xfah\xfa\xd5\xf9\xb4\xf9A\xf9\xf7\xf8\xd7\xf8E\xf8Z\xf8\xaf\xf7\v\xf83\xf7\x16\xf8\v\xf7G\xf8\x03\xf7U\xf8\x19\xf76\xf8\x03\xf7\x11\xf8\xf2\xf6\xfa\xf7\xbf\xf6\xe4\xf7\x9c\xf6\x05\xf8\xa1\xf6`\xf8\x04\xf7\xf4\xf8\xa7\xf7\xa0\xf9i\xf8y\xfa-\xf9H\xfb\xf8\xf9\x05\xfc\xac\xfa\x9d\xfcP\xfb(\xfd\xd0\xfb\xf2\xfd\x86\xfc\xe4\xfe\x95\xfd\xb9\xff\x83\xfem\x00M\xff\x03\x01\xd8\xffu\x01I\x00\xdc\x01\xb1\x00\x1a\x02\x0f\x01G\x02\\\x01M\x02\x93\x01L\x02\xcc\x01=\x02\xd9\x01?\x02\xdd\x01\x01\x02\xcf\x01\x92\x01\x93\x01>\x01@\x01U\x018\x01f\x01U\x01q\x01V\x01i\x01k\x01\xbe\x01\xa5\x01#\x02\xeb\x01\x8b\x020\x02\xd0\x02t\x02%\x03\xc3\x02y\x036\x03\xec\x03\x83\x03k\x04\xb2\x03\xb2\x04\xe7\x03\xd8\x04\x16\x04\x06\x05K\x04\x19\x05{\x04\t\x05\x80\x04\xf8\x04~\x04\xfe\x04\xa7\x04$\x05\xd3\x04,\x05\xd6\x04\x12\x05\x93\x04\xd0\x046\x04n\x04\xba\x03\xf7\x03H\x03\x9b\x03\xf3\x024\x03\x91\x02\xb4\x02<\x02\x05\x02\xc2\x01L\x01%\x01o\x00E\x00\x97\xff\x83\xff\xc5\xfe\xd3\xfe\x13\xfe0\xfeg\xfd\x98\xfd\xd8\xfc\xfa\xfc\x17\xfco\xfc$\xfb\xc2\xfb\x1b\xfa\xe0\xfa1\xf9\r\xfa\xb1\xf8\x86\xf9w\xf8R\xf9\u007f\xf8d\xf9\x8f\xf8`\xf9\x97\xf80\xf9|\xf8\xf4\xf8[\xf8\xb6\xf8\x17\xf8i\xf8\xaf\xf7\n\xf8W\xf7\xce\xf7:\xf7\xaa\xf73\xf7\xb4\xf7#\xf7\x9b\xf7\xfb\xf6R\xf7\xc7\xf6)\xf7\x85\xf6\xeb\xf6?\xf6\xc1\xf6\x11\xf6\xab\xf6\xe1\xf5\x8a\xf6\xc3\xf5x\xf6\xbd\xf5X\xf6\xcc\xf5R\xf6\xd2\xf5R\xf6\xc9\xf5r\xf6\xea\xf5\x97\xf6$\xf6\xdf\xf6]\xf6*\xf7\xab\xf6{\xf7\x05\xf7\xc4\xf7q\xf7&\xf8\xcd\xf7t\xf8\x1e\xf8\xd1\xf8x\xf8C\xf9\xf0\xf8\xdb\xf9m\xf9g\xfa\x14\xfa\xfb\xfa\xb7\xfa\x96\xfb`\xfbB\xfc\xf7\xfb\xd8\xfc\x8c\xfc`\xfd)\xfd\x02\xfe\xc1\xfd\x9b\xfe\x94\xfeU\xffn\xff\v\x00H\x00\xac\x00\xe5\x00\x18\x01Q\x01|\x01\x8c\x01\xd5\x01\xc4\x01\x11\x02\xd9\x01.\x02\n\x02S\x02E\x02y\x02y\x02\xaa\x02i\x02\x99\x02N\x02{\x02;\x02X\x02B\x02q\x02X\x02\x81\x02_\x02\x9c\x02o\x02\xad\x02\x96\x02\xba\x02\xce\x02\xd0\x02\xe7\x02\xda\x02\xee\x02\xde\x02\x18\x03\xfd\x02V\x03C\x03\xc4\x03z\x03 \x04\xa4\x03R\x04\xb4\x03\x8f\x04\xd9\x03\xbd\x04\xff\x03\xc9\x04\xf0\x03\xa4\x04\xc9\x03e\x04\x87\x03.\x04t\x03\f\x04V\x03\x05\x04%\x03\xf3\x03\xff\x02\xd5\x03\xec\x02\xb8\x03\xf2\x02\x95\x03\xd9\x02V\x03\x8a\x02\xf2\x02\x0f\x02\x85\x02\xb3\x01;\x02a\x01\xf8\x01\r\x01\x90\x01\xcd\x00\x17\x01d\x00v\x00\xfe\xff\xe9\xff\x88\xff\u007f\xff\x13\xff0\xff\x9f\xfe\xa5\xfe:\xfe5\xfe\xc9\xfd\xc1\xfdl\xfds\xfd+\xfdK\xfd\x03\xfd?\xfd\b\xfd9\xfd\x1d\xfd^\xfd2\xfd\xae\xfd_\xfd\f\xfe\xb1\xfdq\xfe\a\xfe\x93\xfeB\xfe\xb2\xfec\xfe\x03\xff\xb3\xfeG\xff\r\xff\xc4\xffn\xff3\x00\xd0\xffy\x00\x16\x00\x8a\x00F\x00\xa5\x00;\x00\xb7\x00W\x00\xb6\x00k\x00\xa9\x00\x80\x00\xaf\x00\xa6\x00\xcd\x00\xe0\x00\xf3\x00\xf5\x00+\x01\x1b\x01\x86\x01\x80\x01\xd4\x01\xf1\x01)\x02N\x02\x83\x02\xa8\x02\xd5\x02\a\x036\x03w\x03\x96\x03\xf6\x03\r\x04y\x04|\x04\xf7\x04\xf6\x04k\x05j\x05\xec\x05\xed\x05l\x06e\x06\xe7\x06\xe7\x06c\a`\a\xde\a\xe4\a_\bl\b\xea\b\n\t\x92\t\xa5\tB\n\x0f\n\xb6\n\x89\n\x05\v\x13\v}\v\xe2\v\x03\f\x8f\f\xb5\f\xfb\f=\rQ\r\x8c\r\x9d\r\xcb\r\xcc\r\xe7\r\xd6\r\xd6\r\x9a\r\x86\r\x14\r\x0e\r\x81\f{\f\xee\v\xe7\v\"\vJ\v\x05\nz\n\xc7\bn\t\xaf\ai\b\xb1\x06\x81\a\x93\x05l\x06n\x04@\x05C\x03A\x044\x02J\x03*\x01P\x02-\x00Q\x01@\xffx\x00v\xfe\xbe\xff\xdf\xfd<\xffc\xfd\xaf\xfe\r\xfd;\xfe\xcf\xfc\xcc\xfd\x90\xfcp\xfd^\xfc\x1e\xfd'\xfc\xd8\xfc\xfc\xfb\x91\xfc\xe9\xfbc\xfc\x10\xfcj\xfcL\xfc\x8f\xfcc\xfc\xa2\xfcb\xfc\x86\xfc/\xfc>\xfc\xee\xfb\xdb\xfb\x9d\xfby\xfbn\xfb\x1d\xfb\x18\xfb\xce\xfa\xdc\xfad\xfa\xa7\xfa!\xfau\xfa\xb7\xf9\xf2\xf9@\xf9k\xf9\x8c\xf8\xe7\xf8\xed\xf7S\xf87\xf7\x8f\xf7w\xf61\xf7\xe1\xf5\x05\xf7\xc0\xf5\xef\xf6\xb7\xf5\xc3\xf6\x9f\xf5\xe7\xf6\xb3\xf5U\xf7\x1e\xf6\x1b\xf8\xf4\xf6\xd7\xf8\xba\xf7\u007f\xf9\x90\xf8 \xfa:\xf9\xc1\xfa\xe8\xf9\x8b\xfb\xa1\xfaj\xfc\x99\xfb6\xfd\x9d\xfc9\xfe\xc9\xfdn\xff\x1c\xff\x9c\x00a\x00U\x01F\x01\xed\x01\xd7\x01t\x02q\x02\xf2\x02\xdf\x02O\x03+\x03\x90\x03d\x03\xd2\x03\xbb\x03 \x04\x19\x04q\x04w\x04\xb9\x04\xae\x04\xd3\x04\xd9\x04\xd4\x04\xf5\x04\xd2\x04\t\x05\xe4\x04%\x05\xec\x04\xf9\x04\xc2\x04\xae\x04\xd1\x04d\x04\xeb\x04^\x04\xe5\x04Q\x04\xca\x041\x04\x8b\x04\t\x04W\x04\xe1\x03L\x04\xce\x03]\x04\xbc\x03Z\x04\x9a\x03L\x04v\x03V\x04b\x03k\x04W\x03b\x04K\x03v\x04W\x03b\x04\x84\x03j\x04\x9c\x03y\x04\xb2\x03\xad\x04\xde\x03\xa8\x04\x06\x04t\x04\xec\x03;\x04\xaf\x03\v\x04f\x03\xb0\x03\x02\x03I\x03\x81\x02\xd1\x02\x1b\x02+\x02\x99\x01Z\x01\xed\x00\x8d\x00\r\x00\xd6\xfft\xff#\xff\xd2\xfeN\xfe\x15\xfer\xfd\x1f\xfd\xa5\xfc8\xfc\xd4\xfbg\xfb\x10\xfb\x8a\xfaG\xfa\xd9\xf9\x9f\xf9f\xf9\r\xf9\n\xf9\xbc\xf8\xc5\xf8\x80\xf8\x82\xf8$\xf8@\xf8\xca\xf7\xcc\xf7u\xf7u\xf7L\xf7?\xf7\x1b\xf7$\xf7\xed\xf6\b\xf7\xd2\xf6\x17\xf7\xe0\xf6b\xf7\xe8\xf6\x8b\xf7\x03\xf7\x91\xf7\x1f\xf7\xb8\xf7&\xf7\xc9\xf7/\xf7\xdd\xf7\x19\xf7\xda\xf7)\xf7\xcf\xf71\xf7\xea\xf7T\xf7\x14\xf8\x8a\xf7V\xf8\xc1\xf7\x89\xf8\xe3\xf7\xa5\xf8\xea\xf7\xbf\xf8\xf2\xf7\xc4\xf8\x15\xf8\xb3\xf8[\xf8\xb9\xf8\x9c\xf8\xda\xf8\xb5\xf8\xe5\xf8\xf2\xf8\b\xf95\xf9l\xf9\x8d\xf9\xcc\xf9\xe5\xf98\xfaG\xfa\x99\xfa\xb8\xfa'\xfbO\xfb\x9e\xfb\xff\xfb/\xfc\xaa\xfc\xec\xfc6\xfd\x86\xfd\xb9\xfd1\xfeo\xfe\xef\xfeD\xff\xdd\xff\x1b\x00\xa5\x00\xbd\x00b\x01L\x01\xe6\x01\xdb\x01{\x02f\x02\xf5\x02\xd2\x02A\x03(\x03\u007f\x03[\x03\xb5\x03x\x03\xe5\x03\x96\x03\xe7\x03\xc4\x03\xf6\x03\xd2\x03\xf9\x03\xb8\x03\xf9\x03\xb0\x03\xdb\x03\xb1\x03\xbf\x03\xaf\x03}\x03t\x03\x1f\x03@\x03\xcf\x02\x1a\x03\x9a\x02\xf0\x02Y\x02\xd5\x02\x13\x02\xb7\x02\xbc\x01\xa8\x02\x91\x01\x83\x02R\x01_\x02\x0f\x016\x02\xc8\x00\xf9\x01v\x00\xad\x01)\x00s\x01\xe8\xff4\x01\xbb\xff\xed\x00\x9a\xff\xb5\x00m\xff\x85\x00U\xffh\x00D\xffX\x00H\xffI\x00s\xff7\x00\x88\xff\x1f\x00\x8c\xff\t\x00\x81\xff\x01\x00y\xff\f\x00p\xff\r\x00\u007f\xff\xe6\xffs\xff\xae\xffH\xff\x90\xff,\xff`\xff\x16\xff/\xff\xe3\xfe\xde\xfe\x8a\xfe\x83\xfe,\xfeM\xfe\xd5\xfd)\xfe\x98\xfd\xe6\xfdW\xfd\x90\xfd\x0e\xfd@\xfd\xc6\xfc\t\xfd\x9f\xfc\v\xfd\x8c\xfc\x11\xfd\xa3\xfc\f\xfd\xb6\xfc\xfe\xfc\xb4\xfc9\xfd\xe7\xfc\x96\xfd6\xfd\xf6\xfdm\xfd@\xfe\xb6\xfd\x85\xfe\x17\xfe\xca\xfe\x85\xfe5\xff\xfd\xfe\x88\xff\x81\xff\xd8\xff\x05\x00\x0f\x00O\x00N\x00\xa4\x00\x8f\x00\xe2\x00\xe4\x00/\x01:\x01\x91\x01\x91\x01\x03\x02\xf9\x01\x8a\x023\x02\xfa\x02b\x02<\x03\x8c\x02n\x03\xd1\x02\xa1\x03!\x03\xdd\x03b\x03\xf5\x03\xa3\x03\x17\x04\x02\x04E\x04M\x04t\x04\x87\x04\xa8\x04\x99\x04\xbb\x04\xa0\x04\xcf\x04\xb5\x04\xe7\x04\xe0\x04\x14\x05\x17\x058\x05E\x05U\x05\x85\x05\x93\x05\x90\x05\xaa\x05\xbc\x05\xcc\x05\v\x06(\x06Y\x06\x92\x06\xa7\x06\x1d\a\x03\a\x9a\aw\a\x19\b\xec\a\x86\bm\b\xfb\b\xde\bH\tT\t\xb0\t\xc5\t\a\n\x18\nC\nn\n\x88\n\xb4\n\xd7\n\xce\n\xf6\n\xc4\n\xf2\n\xaa\n\xd5\n\x8b\n\xad\nq\n\x80\nq\nR\n?\n*\n\xab\t\xa8\t\xe7\b\xd1\b@\b\x1f\b\x9b\a\x90\a\xe7\x06\x06\a=\x06w\x06\xa1\x05\xee\x05\x04\x05F\x05W\x04}\x04\x9c\x03\x93\x03\xa3\x02\xb9\x02t\x01\x9a\x01V\x00\xa6\x00X\xff\xd5\xff\x85\xfeJ\xff\xd6\xfd\xd3\xfe,\xfdN\xfe\x94\xfc\xc4\xfd\x03\xfc\x1e\xfd\xb9\xfb\xac\xfc\xa5\xfb\x9a\xfc\xbf\xfb\xce\xfc\xb8\xfb\x01\xfd\x9f\xfb\xf6\xfc\xc1\xfb\xf8\xfc\xf2\xfb\xfc\xfc\r\xfc\x16\xfd\x11\xfc\xfd\xfc\xf0\xfb\xe4\xfc\xaf\xfb\xac\xfc\x9a\xfbj\xfc\xc2\xfbL\xfc\xac\xfb=\xfcE\xfb\xd8\xfb\xd6\xfaB\xfbs\xfa\xce\xfa\x16\xfa^\xfa\xac\xf9\xe7\xf9=\xf9w\xf9\xc6\xf8\x0f\xf9r\xf8\x96\xf8c\xf8>\xf8\x83\xf8V\xf8\xb1\xf8\xab\xf8\xdd\xf8 \xf9E\xf9\x9f\xf9\xce\xf9$\xfaG\xfa\x84\xfa\xb6\xfa\xcd\xfa.\xfb'\xfb\x97\xfb\xa2\xfb\t\xfc\x1d\xfc\xa0\xfc\xc5\xfc\x92\xfd\xc8\xfd\x99\xfe\x06\xff\x8e\xff%\x005\x00\xe7\x00\xb5\x00K\x01'\x01\x96\x01\x9c\x01\b\x02\x04\x02|\x02d\x02\xe7\x02\xe8\x02e\x03\x8d\x03\xff\x03$\x04j\x04\x90\x04\xb8\x04\xa1\x04\xc8\x04\x8d\x04\xb7\x04`\x04~\x04\x1d\x04/\x04\xeb\x03\xd7\x03\xd8\x03\x9a\x03\xce\x03^\x03\xbc\x03(\x03\xa8\x03\xdb\x02\x91\x03\xab\x02\x89\x03\xb0\x02z\x03\xba\x02E\x03\xc4\x02\a\x03x\x02\xe1\x029\x02\xfd\x02,\x022\x03U\x02c\x03\x82\x02\x80\x03\xb9\x02\x8a\x03\xf9\x02\xa0\x03\x1a\x03\xc6\x03+\x03\xd0\x03/\x03\u007f\x03\xe6\x02\f\x03x\x02\xd0\x02\x19\x02\xa9\x02\xdd\x01l\x02\x95\x01\x10\x02D\x01\xa6\x01\xe9\x002\x01Y\x00\xd6\x00\xc6\xffZ\x00\"\xff\xc4\xff\xb8\xfe@\xffi\xfe\xd8\xfe\x15\xfes\xfe\x9b\xfd\x1f\xfe\x14\xfd\xb1\xfd\x9b\xfc5\xfd:\xfc\x9c\xfc\xcb\xfb\xf6\xfb?\xfbT\xfb\xcc\xfa\xde\xfa|\xfa\x8e\xfa[\xfa>\xfa)\xfa\xd2\xf9\xd4\xf9v\xf9_\xf9\x10\xf9\b\xf9\xb9\xf8\xcd\xf8o\xf8\xbc\xf8E\xf8\xbc\xf87\xf8\xc4\xf8g\xf8\xee\xf8\x98\xf81\xf9\xd9\xf8p\xf9\xff\xf8\xa7\xf9\x16\xf9\xdc\xf9\x1e\xf9\xd8\xf9J\xf9\xf2\xf9\x80\xf9\x15\xfa\xc2\xf9d\xfa\xeb\xf9\x97\xfa\xf2\xf9\x95\xfa\xfa\xf9~\xfa\xfb\xf9w\xfa\x12\xfa\x98\xfa\x06\xfa\xa5\xfa\xfb\xf9\x88\xfa\xf3\xf9b\xfa\x0f\xfa]\xfa&\xfa\x8f\xfaF\xfa\xba\xfaO\xfa\xe8\xfa_\xfa\f\xfb\x8e\xfaZ\xfb\xd6\xfa\xc0\xfb9\xfb4\xfc\xa5\xfb\xb0\xfc\xf2\xfb\x0e\xfdH\xfcw\xfd\xc3\xfc\xe6\xfdK\xfdu\xfe\xc0\xfd\a\xff$\xfe\x85\xff\x8f\xfe\xf5\xff\x10\xffV\x00y\xff\xae\x00\xe7\xff\xfe\x009\x00Y\x01\x82\x00\xa4\x01\xe1\x00\xc9\x01P\x01\xf5\x01\xc4\x01>\x02\r\x02\x89\x02#\x02\x8e\x02!\x02\x81\x02\"\x02d\x02\x1b\x02-\x02\v\x02\xe5\x01\xf3\x01\xa8\x01\xe0\x01x\x01\xc9\x01G\x01\xb7\x01\b\x01\x8a\x01\xd0\x00P\x01u\x00\x04\x01\x0f\x00\xb4\x00\xc1\xffz\x00p\xffM\x00&\xff8\x00\xf7\xfe\x11\x00\xd8\xfe\xf1\xff\xc8\xfe\xb3\xff\xa9\xfe\x86\xffl\xfes\xff^\xfei\xffd\xfeT\xff\x80\xfe=\xffy\xfe\x16\xffr\xfe\x17\xffR\xfe\x17\xffJ\xfe\x1e\xffQ\xfe\x05\xffS\xfe\xfb\xfeR\xfe\xf7\xfeZ\xfe\x0e\xffs\xfe\x1b\xff\u007f\xfe/\xff\x8d\xfe1\xff\x92\xfeF\xff\xa8\xfeQ\xff\xa7\xfe`\xff\xab\xfek\xff\xb7\xfeg\xff\xd2\xfe\\\xff\xd7\xfe4\xff\xba\xfe\x12\xff\xa1\xfe\xf4\xfe\x93\xfe\xbe\xfe\x85\xfe\x9b\xfe\x81\xfe_\xfe^\xfeO\xfe1\xfe:\xfe'\xfeM\xfe/\xfeY\xfe8\xfeM\xfe6\xfeX\xfe/\xfes\xfeQ\xfe\xaf\xfe\xa5\xfe\x01\xff\b\xff8\xffc\xff\x86\xff\xa9\xff\xc4\xff\xec\xff\x19\x00I\x00k\x00\xa3\x00\xcf\x00\x18\x018\x01\x9a\x01\x97\x01\x01\x02\xee\x01b\x026\x02\xb2\x02\\\x02\xf3\x02r\x02\x1e\x03\x86\x02\x1a\x03\x8f\x02'\x03\xa9\x02@\x03\xbe\x02U\x03\xea\x02o\x03\xf9\x02\x87\x03\n\x03z\x03$\x03\x96\x03.\x03\xb1\x03A\x03\xce\x03_\x03\xe2\x03]\x03\xf2\x03r\x03\xfc\x03\xa3\x03(\x04\xbf\x03V\x04\xea\x03s\x04.\x04\xc6\x04b\x04 \x05\xc9\x04e\x05.\x05\xc2\x05S\x05\xef\x05\x8a\x05\x0f\x06\xcc\x059\x06\x16\x06w\x06D\x06\x94\x06P\x06\xa9\x06i\x06\xc8\x06\xa4\x06\xf2\x06\xde\x06 \a\x00\a;\a\xf1\x067\a\xd0\x06\x12\a\xad\x06\xe5\x06\x97\x06\xb7\x06|\x06\x85\x06a\x06N\x068\x06!\x06E\x06\b\x06`\x06\x0f\x06b\x06\"\x061\x06\xfb\x05\x0f\x06\xce\x05\x13\x06\xa6\x052\x06\xad\x05D\x06\xa2\x05G\x06\xa6\x058\x06\xb1\x05%\x06\xa3\x054\x06\xba\x05V\x06\xe9\x05J\x06\x1c\x06\x1b\x06\x15\x06\xb3\x05\xb8\x05R\x05_\x05\xd5\x04\xf5\x04\x87\x04\xa2\x04\x16\x04n\x04\xa9\x03\x1c\x04^\x03\xe1\x033\x03\xc7\x03\xc0\x02\x88\x03\b\x02\xd2\x02+\x01\x0f\x02m\x00Q\x01\xdf\xff\xba\x00j\xffA\x00!\xff\x05\x00\xf4\xfe\xf3\xff\xb2\xfe\xc2\xff\x98\xfe\x8f\xffP\xfeH\xff\xeb\xfd\xf4\xfeq\xfdy\xfe>\xfdK\xfe+\xfd&\xfeI\xfd\x16\xfeS\xfd\x14\xfef\xfd\x16\xfe}\xfd/\xfeu\xfd+\xfej\xfd\x1d\xfeQ\xfd\x0f\xfeC\xfd\x03\xfeY\xfd\x00\xfeu\xfd\xfb\xfd\xa3\xfd\xe2\xfd\xa4\xfd\xe8\xfd\x8e\xfd\xda\xfdh\xfd\xc6\xfdC\xfd\xb4\xfd\r\xfd\xab\xfd\xee\xfc\x87\xfd\xe7\xfck\xfd\xcc\xfcL\xfd\xe2\xfcI\xfd\x0f\xfdZ\xfd&\xfd\x84\xfd\x0e\xfdu\xfd)\xfdU\xfds\xfdd\xfd\xb5\xfd\xa3\xfd\xd1\xfd\xba\xfd\xf5\xfd\xcd\xfd%\xfe\r\xfeQ\xfeP\xfe\xaf\xfe\x9d\xfe7\xff\xf6\xfe\xc9\xff\x83\xff#\x00\xca\xff\x88\x00\xf0\xff\xcd\x000\x00\xe8\x00c\x00\xf6\x00|\x00,\x01\xc9\x00l\x013\x01\xce\x01\x8a\x01)\x02\xce\x01[\x02\f\x02T\x02\x02\x02o\x02\xf5\x01\x90\x02\xf9\x01\xad\x02\x0f\x02\xba\x02\x00\x02\xc6\x02\x12\x02\xe1\x02\"\x02\xcb\x02\x04\x02\x9b\x02\xa1\x01V\x02q\x01\x10\x02=\x01\xd7\x01\x13\x01\xa6\x01\xcd\x00\x91\x01\x89\x00\x82\x01.\x00\\\x01\xe5\xffD\x01\xbd\xff'\x01\xa6\xff\xe8\x00n\xff\xb6\x00=\xff\x96\x00!\xff\x8b\x00\x10\xffj\x00\xe4\xfe[\x00\xb1\xfe_\x00\xad\xfeJ\x00\x94\xfe8\x00\x98\xfe\x13\x00\x95\xfe\xfb\xff\xa0\xfe\xb8\xffo\xfe\x94\xff>\xfew\xff\x19\xfe9\xff\x13\xfe\xfe\xfe\xe8\xfd\xc1\xfe\xd0\xfdi\xfe\xb0\xfd\xf7\xfdX\xfd\xbc\xfd+\xfd\x91\xfd'\xfdC\xfd\xf8\xfc\xd8\xfc\x8d\xfc\x8d\xfc2\xfc5\xfc\f\xfc\xed\xfb\xce\xfb\xab\xfb\x9a\xfb|\xfbd\xfb7\xfb.\xfb\xf6\xfa\xef\xfa\xa1\xfa\xb9\xfaP\xfa\x8a\xfa\x05\xfaR\xfa\xe5\xf9)\xfa\xe4\xf9'\xfa\xf1\xf92\xfa\x04\xfa.\xfa\xff\xf9.\xfa\"\xfa9\xfa(\xfaF\xfa)\xfaT\xfa3\xfav\xfa[\xfa\xb6\xfan\xfa\xd5\xfa\x84\xfa\xe6\xfa\xa2\xfa\xf8\xfa\xca\xfa\x18\xfb\xee\xfaG\xfb\x1b\xfb\x8c\xfb<\xfb\xc2\xfb^\xfb\xf9\xfbo\xfb2\xfc\x9c\xfbH\xfc\xc4\xfb{\xfc\xdc\xfb\x87\xfc\xde\xfb\xa8\xfc\xe0\xfb\xac\xfc\xec\xfb\xcb\xfc\r\xfc\xea\xfcR\xfc\"\xfd\xa1\xfcb\xfd\xd5\xfc\x91\xfd\r\xfd\xae\xfdH\xfd\xb1\xfdV\xfd\xa8\xfde\xfd\x99\xfd]\xfd\x98\xfdg\xfd\x90\xfd\x8b\xfd\xa2\xfd\xdc\xfd\xb4\xfd\x1d\xfe\xe6\xfd>\xfe\xf2\xfdd\xfe\xfd\xfd\x8d\xfe\x1f\xfe\xb1\xfe)\xfe\xd9\xfe1\xfe\xf4\xfe;\xfe\x14\xffS\xfe)\xffP\xfeV\xfff\xfep\xffs\xfel\xff\x8e\xfe]\xff\x9c\xfeO\xff\x9c\xfe]\xff\x9c\xfeL\xff\x8c\xfe9\xffi\xfe6\xffF\xfe\x11\xff=\xfe\xfd\xfe\x1b\xfe\xd5\xfe\xf8\xfd\xb8\xfe\xdc\xfd\x8f\xfe\xe0\xfd}\xfe\xce\xfdw\xfe\xb4\xfdd\xfe\xa5\xfdh\xfe\x9d\xfdl\xfe\xaa\xfd\x95\xfe\xd7\xfd\xba\xfe\t\xfe\xd7\xfe\x19\xfe\xdb\xfe\x1d\xfe\xf6\xfe6\xfe\x17\xff`\xfe4\xffi\xfe=\xff\x84\xfe?\xff\xb8\xfe>\xff\xe1\xfe[\xff\x06\xff\x94\xff2\xff\x9d\xff=\xff\xa9\xffL\xff\x89\xffC\xffu\xff[\xff\x86\xfff\xff\x91\xff\x94\xff\x92\xff\xaa\xff\x93\xff\xca\xff\x86\xff\xc2\xff\x8b\xff\xd3\xff{\xff\xe0\xff_\xff\xc7\xffC\xff\xb5\xff>\xff\xc2\xffQ\xff\xdc\xffa\xff\xef\xff\x87\xff\f\x00\xa3\xff*\x00\xa1\xff6\x00\xa9\xff-\x00\xb6\xffB\x00\xbb\xffS\x00\xc5\xff\\\x00\xd9\xffv\x00\x0f\x00\xb2\x00:\x00\xe4\x00h\x00\xf4\x00m\x00\x1c\x01\x8d\x00L\x01\xa4\x00o\x01\xc1\x00\x81\x01\xcd\x00\x86\x01\xea\x00\x96\x01\x01\x01\xa8\x015\x01\xcb\x01A\x01\xd5\x01J\x01\xde\x01O\x01\xf6\x01x\x01,\x02\xb9\x01j\x02\xf9\x01\x8e\x02\x13\x02\x90\x02!\x02z\x02K\x02\x80\x02\x86\x02\xb4\x02\xca\x02\xef\x02\x0e\x03K\x037\x03\x82\x03N\x03\xb2\x03}\x03\xbe\x03\xb9\x03\xd6\x03\xde\x03\xe3\x03\xda\x03\xd0\x03\xc9\x03\xb9\x03\xdd\x03\xc1\x03\x0e\x04\xe0\x03B\x04\x18\x04Q\x04-\x04>\x04\"\x049\x04\x1e\x04b\x04=\x04q\x04R\x04}\x04X\x04}\x04^\x04\x9c\x04\u007f\x04\xd0\x04\x94\x04\x1c\x05\xd6\x04(\x05\x14\x05\x15\x05-\x05\x0f\x05A\x05\x18\x05^\x05=\x05m\x05V\x05|\x055\x05r\x05\xf7\x04H\x05\xd0\x04\x15\x05\xbe\x04\xfb\x04\x93\x04\xea\x04j\x04\xdc\x04>\x04\xc3\x041\x04\xa6\x04A\x04\x84\x04B\x04{\x04#\x04W\x04\xfd\x037\x04\xf8\x03\x1b\x04\xe3\x03\x1e\x04\xf6\x03\f\x04\x1d\x04.\x04r\x04l\x04\xa5\x04\xb3\x04\xf6\x04\xe8\x04&\x05!\x05O\x05I\x05t\x05d\x05\x8f\x05}\x05\x94\x05\x88\x05}\x05t\x05c\x05\\\x05T\x05l\x05:\x05p\x05\x14\x05g\x05\xe5\x04a\x05\x90\x044\x05%\x04\xd6\x04\xc9\x03b\x04[\x03\xfa\x03\xd1\x02x\x03T\x02\xff\x02\xf0\x01\xb8\x02\x8c\x01j\x02E\x01\x1e\x02,\x01\x00\x02\x03\x01\xf7\x01\xc3\x00\xac\x01\x98\x00S\x01v\x00\x12\x01C\x00\xde\x00\x01\x00\x93\x00\xf6\xffp\x00\x10\x00\x8a\x00,\x00\xa9\x00\x17\x00\xb1\x00\n\x00\xbb\x00\xf2\xff\xb5\x00\x96\xfft\x00S\xff\xfe\xff<\xff\xcb\xff\x1e\xff\xab\xff\xed\xfe\x85\xff\xcc\xfed\xff\xca\xfe[\xff\xba\xfeL\xff\x9c\xfe)\xff\x8d\xfe\x11\xffb\xfe\xfa\xfe8\xfe\xba\xfe+\xfe\x85\xfe=\xfey\xfe\"\xfeg\xfe\x0e\xfeN\xfe6\xfe^\xfeB\xfe\x86\xfeF\xfet\xfeM\xfe{\xfe\x87\xfe\x94\xfe\xac\xfe\xb3\xfe\xcc\xfe\xb6\xfe\x04\xff\xc1\xfe:\xff\xcf\xfe5\xff\xd2\xfeM\xff\xdb\xfem\xff\xf2\xfe\x9d\xff\x12\xff\xc3\xff0\xff\a\x00[\xffh\x00\xb0\xff\xa8\x00\xdb\xff\xbf\x00\xe3\xff\xdf\x00\xe7\xff\xf3\x00\xf2\xff\xda\x00\xff\xff\xc9\x00\x05\x00\xd6\x00\r\x00\xc9\x00\x1c\x00\xd9\x00.\x00\xfc\x00K\x00\x19\x01l\x00\x10\x01b\x00#\x01U\x00\"\x01=\x00\n\x01\x15\x00\xde\x00\xe4\xff\xc0\x00\xe1\xff~\x00\xcd\xfff\x00\x9f\xff_\x00\x95\xfft\x00\xa7\xffh\x00\xb2\xffX\x00\x91\xff5\x00i\xff\xf3\xff \xff\x99\xff\xc5\xfe`\xff\x9f\xfe\x15\xff\x85\xfe\xc7\xfeU\xfe\x90\xfe\x1e\xfe\x85\xfe1\xfep\xfe2\xfe>\xfe\x00\xfe\x18\xfe\xcd\xfd\xe7\xfd\xaa\xfd\x95\xfdZ\xfdG\xfd\x11\xfdC\xfd\x1a\xfdR\xfdW\xfd;\xfdJ\xfd%\xfd?\xfd2\xfdN\xfdH\xfdw\xfd2\xfdl\xfd\x0f\xfdT\xfd\xeb\xfc?\xfd\xc7\xfc\x18\xfd\x9b\xfc\xfc\xfc|\xfc\xf6\xfcP\xfc\xe7\xfc8\xfc\xcf\xfcZ\xfc\xde\xfcy\xfc\x03\xfd\x8d\xfc\x18\xfd\x83\xfc\b\xfdr\xfc\xe5\xfc;\xfc\x97\xfc\xff\xfbM\xfc\xf4\xfb4\xfc\x17\xfcP\xfc:\xfcw\xfcR\xfc\xa3\xfcv\xfc\xd0\xfc\xa7\xfc\xed\xfc\xb5\xfc\xf7\xfc\xa6\xfc\xf0\xfc\x93\xfc\xba\xfcw\xfc\xa3\xfc\u007f\xfc\x99\xfcx\xfc\xa1\xfc\x84\xfc\xa8\xfc\x8e\xfc\xad\xfc\x96\xfc\xd6\xfc\x97\xfc\xec\xfc\x9b\xfc\xf6\xfc\x9b\xfc\xe9\xfc\x93\xfc\xdd\xfc\x8c\xfc\xc9\xfc\x83\xfc\xb0\xfcn\xfc\x91\xfch\xfc|\xfcS\xfcu\xfcF\xfcw\xfc9\xfc\x81\xfcE\xfc\xa8\xfcf\xfc\xb7\xfc\x9f\xfc\xda\xfc\xb6\xfc\xf1\xfc\xcd\xfc\xe1\xfc\xf0\xfc\xd6\xfc\r\xfd\xd7\xfc\r\xfd\xd5\xfc.\xfd\xe1\xfc_\xfd\x1d\xfd\x93\xfdj\xfd\xbe\xfd\x8e\xfd\xd3\xfd\x9e\xfd\xf6\xfd\x99\xfd\x05\xfe\xb4\xfd\x14\xfe\xa4\xfd\x1a\xfe\x96\xfd3\xfe\x91\xfd<\xfe\x94\xfd<\xfe\xac\xfd@\xfe\xd5\xfd?\xfe\xf0\xfdG\xfe\xf6\xfd>\xfe\xf0\xfd6\xfe\xfa\xfd<\xfe\xe4\xfdF\xfe\xd9\xfdF\xfe\xc1\xfd<\xfe\xd4\xfdE\xfe\xdc\xfdO\xfe\x02\xfej\xfe-\xfe{\xfeL\xfe\x89\xfeW\xfe}\xfeD\xfeu\xfe0\xfem\xfe(\xfeS\xfe\x0e\xfeE\xfe\xff\xfd<\xfe\v\xfe<\xfe\"\xfeJ\xfeH\xfe[\xfeb\xfea\xfey\xfeb\xfe\x80\xfe[\xfe\x87\xfeE\xfes\xfe4\xfei\xfe1\xfed\xfe*\xfev\xfe?\xfe\x94\xfea\xfe\xc6\xfez\xfe\xf2\xfe\x80\xfe\x15\xff\x85\xfe%\xffs\xfe\x16\xff}\xfe\x01\xff\u007f\xfe\xf2\xfex\xfe\xe4\xfeo\xfe\xd0\xfeW\xfe\xc6\xfeI\xfe\xc1\xfem\xfe\xcc\xfe\x8d\xfe\xf6\xfe\xac\xfe\x12\xff\xd9\xfe-\xff\x01\xff9\xff\"\xffG\xffJ\xffR\xffr\xffT\xff\x98\xffx\xff\xcf\xff\xb2\xff\"\x00\xec\xffX\x00\x17\x00\x95\x00S\x00\xba\x00}\x00\xdb\x00\x98\x00\x00\x01\xa3\x00\x1f\x01\xb3\x00A\x01\xbc\x00a\x01\xd2\x00\x85\x01\xf0\x00\xa5\x01\x00\x01\xd2\x01\x16\x01\xe7\x015\x01\xec\x01T\x01\x05\x02q\x01\x04\x02w\x01\x10\x02~\x01\x1a\x02\x85\x01;\x02\x92\x01d\x02\xc2\x01\xa3\x02\xf8\x01\xe2\x02:\x02\xe5\x02X\x02\xeb\x02j\x02\xee\x02y\x02\xfa\x02|\x02\xf3\x02\u007f\x02\xe9\x02\x87\x02\xda\x02\x88\x02\xd3\x02}\x02\xd6\x02\x80\x02\xce\x02\x85\x02\xd7\x02\x85\x02\xc7\x02\x87\x02\xb0\x02\x87\x02\xad\x02~\x02\xb4\x02\x94\x02\xb0\x02\x92\x02\x9b\x02\x8d\x02\x8c\x02u\x02\x90\x02v\x02\xaa\x02|\x02\xd1\x02\xab\x02\xe7\x02\xd0\x02\xee\x02\xef\x02\x01\x03\x10\x03%\x030\x03W\x03I\x03f\x03b\x03z\x03l\x03l\x03s\x03m\x03h\x03g\x03l\x03{\x03\x89\x03t\x03\x9f\x03r\x03\xb5\x03}\x03\xcb\x03\x87\x03\xe1\x03\x82\x03\xdf\x03\x81\x03\xd5\x03v\x03\xd3\x03T\x03\xaf\x03R\x03\x86\x03D\x03\x80\x03<\x03\x82\x03\x17\x03\u007f\x03\b\x03u\x03\xf8\x02r\x03\x06\x03p\x03\xff\x02m\x03\v\x03u\x03\x13\x03\x82\x03\x16\x03f\x03!\x03b\x030\x03W\x03/\x03X\x03@\x03t\x03Z\x03\x9f\x03q\x03\xba\x03\x8f\x03\xcb\x03\xd2\x03\xf8\x03!\x046\x04l\x04T\x04\xa5\x04l\x04\xbd\x04k\x04\xcc\x04l\x04\xc6\x04d\x04\xbe\x04k\x04\xb0\x04j\x04\xa2\x04p\x04\x88\x04o\x04p\x04^\x04l\x04P\x042\x04 \x04\xc5\x03\xd1\x03X\x03V\x03\xf5\x02\xf8\x02\x9d\x02\xb1\x02T\x02\x81\x02\r\x02\\\x02\xbf\x01E\x02\x8d\x01\b\x02w\x01\xe7\x01b\x01\xd4\x018\x01\xae\x01\v\x01\x8a\x01\xfe\x00k\x01\xfe\x00a\x01\xff\x00d\x01\x14\x01\x82\x01,\x01\xbd\x01S\x01\xe3\x01g\x01\x00\x02\x8b\x01\x00\x02\x88\x01\x19\x02y\x01\xf1\x01T\x01\xd7\x010\x01\xa2\x01\x0e\x01\x82\x01\xee\x00O\x01\xb2\x001\x01\x83\x00\xf6\x00K\x00\xb9\x00\x19\x00q\x00\xf2\xff\x1a\x00\xbe\xff\xd8\xffy\xff\x86\xff.\xff3\xff\xf6\xfe\xdd\xfe\xb8\xfe\xa2\xfe\x81\xfer\xfed\xfeW\xfe^\xfe_\xfeZ\xfei\xfeA\xfe_\xfe!\xfe5\xfe\x19\xfe'\xfe\x12\xfe'\xfe\x1b\xfe'\xfe\x1b\xfe,\xfe:\xfe>\xfe0\xfeP\xfe7\xfe\\\xfe:\xfem\xfe'\xfez\xfe\x0f\xfec\xfe(\xfe^\xfeG\xfef\xfe[\xfek\xfe|\xfeq\xfe\x9e\xfe}\xfe\xbc\xfe\xa4\xfe\xc5\xfe\xb1\xfe\xc2\xfe\xb6\xfe\xce\xfe\xc8\xfe\xc6\xfe\xdc\xfe\xd8\xfe\xdf\xfe\xef\xfe\xd8\xfe\x13\xff\xe6\xfe\x1e\xff\xfb\xfeU\xff(\xffZ\xffB\xffX\xffA\xffW\xff5\xffo\xffN\xffu\xffl\xffe\xff`\xffb\xffZ\xffv\xffS\xffn\xffX\xffs\xffJ\xffz\xffR\xff\x90\xffs\xff\x8a\xffz\xff\x8d\xff{\xff\x9e\xff\x81\xff\xbd\xff\xa9\xff\xe5\xff\xcd\xff\a\x00\xee\xff4\x00\x00\x005\x00\x01\x00G\x00\x06\x003\x00\b\x00\x1f\x00\xfd\xff\xf6\xff\xee\xff\x10\x00\xf9\xff\x1f\x00(\x00(\x000\x00'\x00:\x00%\x00\x15\x00\xe7\xff\xe1\xffz\xff\x81\xff\x1b\xff*\xff\xda\xfe\xfb\xfe\x9d\xfe\xdd\xfer\xfe\xc0\xfeQ\xfe\xa8\xfeA\xfe\x9d\xfe5\xfe\x8e\xfe\x0e\xfek\xfe\xcd\xfd.\xfe~\xfd\xd0\xfdG\xfd\x8e\xfd)\xfdY\xfd\xfb\xfcD\xfd\xd9\xfc(\xfd\xe2\xfc2\xfd\xd7\xfc/\xfd\xb5\xfc\x12\xfd\x9e\xfc\xe8\xfc\x84\xfc\xc6\xfct\xfc\x9c\xfcU\xfca\xfcS\xfc;\xfcL\xfc$\xfcP\xfc\x14\xfcS\xfc\x18\xfcP\xfc\x1f\xfci\xfc!\xfcl\xfc\x1e\xfc\x8a\xfc(\xfc\x83\xfc\x16\xfcy\xfc\xfa\xfby\xfc\xe5\xfb\x88\xfc\xe3\xfb\x8e\xfc\xf5\xfb\x8d\xfc\x06\xfc\x9d\xfc\x1a\xfc\xa7\xfc0\xfc\x96\xfc8\xfc\x85\xfc0\xfcs\xfc$\xfcv\xfc$\xfc}\xfc9\xfc\x88\xfcH\xfc\x90\xfcH\xfc\xad\xfcW\xfc\xcb\xfc\x8a\xfc\xee\xfc\xaf\xfc\v\xfd\xe0\xfc'\xfd\x01\xfdE\xfd-\xfde\xfdU\xfd\x83\xfd_\xfd\x9e\xfdx\xfd\xbb\xfd\x85\xfd\xdd\xfd\xaf\xfd\x01\xfe\xdb\xfd%\xfe\x15\xfeD\xfe=\xfeU\xfeZ\xfeY\xfeq\xfen\xfer\xfeu\xfeo\xfe\x87\xfer\xfe\x83\xfe{\xfe\x86\xfe\x85\xfe\u007f\xfe\x87\xfe\x84\xfe\x94\xfe\x94\xfe\xaf\xfe\xaf\xfe\xd2\xfe\xab\xfe\xdd\xfe\xb0\xfe\xe7\xfe\xba\xfe\xe3\xfe\xcb\xfe\xeb\xfe\xd2\xfe\xe7\xfe\xd4\xfe\xe5\xfe\xd9\xfe\xf9\xfe\xda\xfe\x04\xff\xe1\xfe\x0e\xff\xe9\xfe\x0e\xff\xed\xfe\x19\xff\xfc\xfe+\xff\x10\xffG\xff>\xffY\xffb\xffq\xff\x91\xffy\xff\xb5\xff\x92\xff\xdb\xff\xaa\xff\xfa\xff\xc4\xff\x12\x00\xdb\xff;\x00\xf7\xffK\x00\x1e\x00j\x00E\x00x\x00M\x00\x86\x00J\x00\x89\x00C\x00\xa3\x00>\x00\xaa\x00F\x00\xbb\x00O\x00\xae\x00P\x00\xa7\x00I\x00\x88\x00'\x00q\x00\x17\x00M\x00\x03\x00=\x00\xed\xff\x1f\x00\xdc\xff#\x00\xce\xff\x1d\x00\xcc\xff-\x00\xcf\xff\x1f\x00\xce\xff\x19\x00\xc1\xff\xfe\xff\xa8\xff\xe3\xff\x94\xff\xd1\xff\x87\xff\xbe\xffx\xff\xa8\xffw\xff\xa9\xffh\xff\xa8\xffY\xff\xc2\xffY\xff\xbb\xffe\xff\xba\xffm\xff\xa8\xffb\xff\x8a\xffI\xff\x85\xffF\xff\x87\xff>\xff\x99\xffT\xff\xa5\xffa\xff\xc1\xffv\xff\xca\xff\x86\xff\xe8\xff\x9f\xff\xe0\xff\xba\xff\xeb\xff\xcc\xff\xed\xff\xd8\xff\xf9\xff\xfb\xff\x1d\x00%\x00:\x00M\x00l\x00\x82\x00\x87\x00\xa7\x00\xb1\x00\xd4\x00\xdb\x00\xf1\x00\v\x01#\x01\x1b\x01H\x01&\x01q\x01/\x01\x8a\x01N\x01\xb1\x01]\x01\xcb\x01\x99\x01\xfa\x01\xbb\x01-\x02\xe3\x01X\x02\x05\x02i\x02\"\x02q\x02$\x02y\x02\x1f\x02p\x02\x11\x02p\x02\x00\x02n\x02\xf1\x01n\x02\xea\x01e\x02\xe8\x01_\x02\xed\x01W\x02\xfb\x01Z\x02\x10\x02[\x02#\x02i\x02\"\x02h\x02+\x02g\x02%\x02x\x020\x02w\x02;\x02\x92\x02S\x02\x9c\x02i\x02\xb9\x02\x90\x02\xd3\x02\xa6\x02\xf2\x02\xc8\x02\xfe\x02\xc6\x02\x10\x03\xc6\x02\x02\x03\xab\x02\xfc\x02\xac\x02\xeb\x02\x96\x02\xec\x02\x86\x02\xd6\x02c\x02\xb8\x02k\x02\xac\x02z\x02\xb3\x02m\x02\xb0\x02^\x02\x94\x026\x02o\x02*\x02O\x02'\x02H\x023\x02G\x02?\x02W\x02Y\x02b\x02\x87\x02\x86\x02\xa6\x02\x86\x02\xae\x02\x83\x02\xbb\x02\u007f\x02\xbd\x02\x85\x02\xcf\x02\x95\x02\xe4\x02\xa6\x02\b\x03\xc3\x02.\x03\xd9\x02C\x03\xdb\x02U\x03\xe6\x02s\x03\xe6\x02\x9a\x03\x01\x03\xb9\x030\x03\xd7\x03N\x03\xf8\x03\u007f\x03A\x04\xaa\x03~\x04\xec\x03\xb7\x04\x11\x04\xca\x04\x18\x04\xce\x04-\x04\xbd\x042\x04\x9f\x043\x04d\x04\x1f\x04,\x04\xfb\x03\x00\x04\xe9\x03\xd7\x03\xdb\x03\x90\x03\xb4\x03#\x03^\x03\xab\x02\xed\x02/\x02\x81\x02\xb4\x01$\x02R\x01\xe2\x01\xe5\x00\x96\x01\x9d\x00n\x01U\x004\x01+\x00\x12\x01\xff\xff\xf0\x00\xd8\xff\xcb\x00\xbb\xff\xb0\x00\xaa\xff\x99\x00\x9b\xff\x91\x00\xb0\xff\x96\x00\xa8\xff\xa9\x00\x9a\xff\xab\x00\u007f\xff\xa4\x00m\xff\x8c\x00_\xffs\x00T\xfff\x002\xffM\x00\"\xff(\x00\x13\xff\a\x00\x15\xff\xf9\xff\x00\xff\xe0\xff\xf2\xfe\xba\xff\xd1\xfe\x96\xff\xbc\xfep\xff\xaf\xfeB\xff\xa2\xfe\x1b\xff\x93\xfe\xf2\xfez\xfe\xd7\xfeu\xfe\xbc\xfet\xfe\xb4\xfeu\xfe\xa1\xfez\xfe\x8f\xfe\x95\xfe\x93\xfe\x93\xfe\x9d\xfe{\xfey\xfee\xfeU\xfe[\xfe:\xfeF\xfe6\xfe.\xfe-\xfeF\xfeC\xfey\xfet\xfe\xb5\xfe\xa9\xfe\xeb\xfe\xc8\xfe\x15\xff\xef\xfeK\xff\x17\xffo\xff;\xff\x87\xffe\xff\xb9\xff\x81\xff\xe3\xff\xb1\xff\x1a\x00\xe6\xffC\x00\x1f\x00X\x00%\x00`\x002\x00|\x00A\x00\x95\x00e\x00\x92\x00~\x00\x93\x00\x81\x00\x9e\x00\x85\x00\xa9\x00\x80\x00\xa7\x00n\x00\xa1\x00`\x00\x8a\x00>\x00\x83\x00/\x00\u007f\x00&\x00\x88\x00#\x00\x8b\x00\x1e\x00\x89\x00\x11\x00\x8a\x00\x0f\x00r\x00\xf1\xffj\x00\xc2\xffI\x00\x9e\xff3\x00}\xff\v\x00k\xff\xe9\xffI\xff\xbe\xff\x1c\xff\xb9\xff\x0f\xff\xac\xff\f\xff\xad\xff\x1d\xff\xad\xff*\xff\xb3\xff%\xff\xb9\xff6\xff\xbf\xff-\xff\xb2\xff\x1e\xff\xa2\xff\x11\xff\x99\xff\a\xff\xa1\xff\x1c\xff\xb2\xff2\xff\xc6\xffT\xff\xc4\xffd\xff\xc0\xffc\xff\x9c\xffH\xffn\xff\x0e\xffP\xff\xdd\xfe#\xff\xc2\xfe\xfe\xfe\xaf\xfe\xd3\xfe\xa1\xfe\xb7\xfe\x87\xfe\xaf\xfe\x84\xfe\x99\xfe{\xfew\xfe]\xfeF\xfe3\xfe\x1f\xfe\r\xfe\xe2\xfd\xd5\xfd\xd0\xfd\xb4\xfd\xb3\xfd\x99\xfd\x94\xfd\x8e\xfd\x80\xfdp\xfdm\xfdg\xfdY\xfdT\xfd?\xfdG\xfd\x05\xfd\x1b\xfd\xe7\xfc\xff\xfc\xcc\xfc\xdf\xfc\xbe\xfc\xbc\xfc\xc3\xfc\xae\xfc\xcb\xfc\xb8\xfc\xd9\xfc\xcb\xfc\xe5\xfc\xe0\xfc\xf4\xfc\x00\xfd\x19\xfd\x1f\xfd7\xfdB\xfdT\xfdX\xfdm\xfdf\xfd\x92\xfdr\xfd\xad\xfd\x8c\xfd\xc3\xfd\x93\xfd\xcb\xfd\xb7\xfd\xd1\xfd\xcb\xfd\xda\xfd\xdc\xfd\xe0\xfd\xeb\xfd\xe1\xfd\xef\xfd\xe6\xfd\xed\xfd\xe2\xfd\xef\xfd\xf5\xfd\xef\xfd\xef\xfd\xeb\xfd\xf9\xfd\xe8\xfd\x02\xfe\xe3\xfd\x1f\xfe\x04\xfe9\xfe&\xfeT\xfeR\xfep\xfet\xfe\xab\xfe\xa9\xfe\xd2\xfe\xcd\xfe\x0f\xff\xf1\xfe7\xff\v\xffX\xff(\xfft\xff>\xff\x99\xffZ\xff\xb7\xff~\xff\xb7\xff\x94\xff\xbd\xff\xae\xff\xc9\xff\xba\xff\xd4\xff\xc6\xff\xdf\xff\xbb\xff\xf3\xff\xc9\xff\xef\xff\xc4\xff\xf4\xff\xbb\xff\xed\xff\xc2\xff\xf0\xff\xb8\xff\xec\xff\xb5\xff\xde\xff\xad\xff\xd7\xff\xa6\xff\xce\xff\xa6\xff\xc1\xff\x86\xff\xb8\xffr\xff\x9c\xffQ\xffs\xff6\xffR\xff\x0e\xff=\xff\xfa\xfe2\xff\xda\xfe\x18\xff\xc5\xfe\x03\xff\xb0\xfe\xf0\xfe\xab\xfe\xe6\xfe\xa9\xfe\xe5\xfe\x94\xfe\xe6\xfe\x95\xfe\t\xff\xa7\xfe$\xff\xc9\xfe[\xff\xf1\xfe\u007f\xff\x17\xff\xac\xff>\xff\xd4\xffh\xff\xfd\xff\x93\xff\"\x00\xc3\xff.\x00\xdb\xffA\x00\xf8\xffJ\x00\r\x00M\x00\x15\x00>\x00\x11\x000\x00\x03\x00\x12\x00\xf0\xff\x00\x00\xdc\xff\xde\xff\xc7\xff\xc7\xff\xbb\xff\xa9\xff\xa5\xff\x8f\xff\x9d\xffu\xffv\xffW\xffm\xffZ\xffZ\xffW\xffi\xffl\xffp\xff\x81\xff\x87\xff\x9d\xff\x9d\xff\xbc\xff\xc6\xff\xc2\xff\xd4\xff\xc3\xff\xe0\xff\xb9\xff\xea\xff\xbb\xff\xfa\xff\xc1\xff\t\x00\xc2\xff\x10\x00\xd1\xff\x1e\x00\xd3\xff5\x00\xdb\xff7\x00\xdc\xffG\x00\xd8\xffD\x00\xda\xffP\x00\xde\xffZ\x00\xdd\xffj\x00\xeb\xffn\x00\xe6\xffo\x00\xfe\xffv\x00\x02\x00\u007f\x00\x1f\x00\x93\x008\x00\xa5\x00Y\x00\xc5\x00a\x00\xcc\x00t\x00\xd6\x00q\x00\xd6\x00h\x00\xca\x00]\x00\xc2\x00R\x00\xb3\x00a\x00\xc1\x00[\x00\xbb\x00f\x00\xca\x00c\x00\xcd\x00o\x00\xd7\x00f\x00\xce\x00e\x00\xd0\x00i\x00\xe4\x00j\x00\xe9\x00v\x00\xf1\x00y\x00\xee\x00\x8d\x00\xf4\x00\x94\x00\xf8\x00\xa6\x00\xfc\x00\xb1\x00\x15\x01\xc8\x00(\x01\xd9\x00N\x01\xdc\x00U\x01\xf6\x00]\x01\x03\x01[\x01\x1d\x01]\x01#\x01V\x01>\x01c\x01P\x01|\x01r\x01\x9c\x01\x8d\x01\xc4\x01\xb1\x01\xe8\x01\xcb\x01\xf4\x01\xe3\x01\x10\x02\xef\x01\xfb\x01\xe7\x01\x04\x02\xea\x01\xf4\x01\xdc\x01\xf5\x01\xd3\x01\xef\x01\xb7\x01\xda\x01\x9d\x01\xb9\x01\x80\x01\xa5\x01W\x01}\x01(\x01[\x01\xf2\x00(\x01\xbf\x00\xf6\x00\x8c\x00\xc9\x00k\x00\xa1\x00M\x00\x83\x00:\x00k\x00<\x00d\x00=\x00r\x00W\x00\x88\x00b\x00\x9c\x00p\x00\xa5\x00\x84\x00\xb5\x00\x9e\x00\xc1\x00\xbc\x00\xd9\x00\xe3\x00\xf7\x00\x0f\x012\x011\x01d\x01I\x01\x81\x01_\x01\x8e\x01z\x01\x9f\x01\u007f\x01\xb0\x01\x8d\x01\xb2\x01\x90\x01\xb8\x01\xa6\x01\xcc\x01\xaa\x01\xdc\x01\xb4\x01\xe1\x01\xae\x01\xe3\x01\xb1\x01\xd4\x01\xae\x01\xc9\x01\x9f\x01\xba\x01\x98\x01\xba\x01\x80\x01\xa8\x01\x84\x01\xa0\x01{\x01\x9b\x01h\x01\x89\x01h\x01t\x01h\x01o\x01\x82\x01\x85\x01\x87\x01\x8f\x01\x98\x01\xab\x01\xb8\x01\xcb\x01\xde\x01\xf5\x01\x0e\x02\"\x02D\x02_\x02`\x02r\x02{\x02\x96\x02\x8e\x02\xa8\x02\xa0\x02\xc5\x02\xa7\x02\xd4\x02\x9c\x02\xdc\x02\x8a\x02\xdb\x02o\x02\xd8\x02A\x02\xab\x02\x06\x02t\x02\xc6\x016\x02}\x01\xfa\x017\x01\xb3\x01\xf1\x00g\x01\xbe\x00 \x01\x90\x00\xf7\x00[\x00\xc4\x004\x00\x90\x00\x0f\x00c\x00\x04\x00N\x00\xf5\xff<\x00\xf4\xff2\x00\xf0\xff \x00\x05\x00$\x00\x18\x00(\x00@\x00A\x00I\x00X\x00m\x00g\x00u\x00\x83\x00q\x00y\x00`\x00b\x00[\x00I\x00Q\x00I\x006\x00#\x00$\x00\x12\x00\x15\x00\xf1\xff\x0e\x00\xec\xff\xfc\xff\xcf\xff\xe2\xff\xbe\xff\xca\xff\x91\xff\xaf\xffr\xff\x97\xffD\xff|\xff1\xffU\xff\a\xffB\xff\xf5\xfe:\xff\xe1\xfeO\xff\xf0\xfeM\xff\xeb\xfeH\xff\xeb\xfeO\xff\xee\xfeU\xff\xfb\xfeJ\xff\xfb\xfeE\xff\xf0\xfeR\xff\xf8\xfeb\xff\n\xff\x83\xff'\xff\x93\xff5\xff\xc3\xff[\xff\xe9\xff\x8a\xff\x18\x00\xc4\xff3\x00\xf2\xffF\x00\x13\x00V\x00\x1e\x00`\x007\x00h\x003\x00W\x00.\x00f\x00$\x00u\x005\x00y\x00=\x00z\x006\x00b\x00'\x00T\x00\x13\x00(\x00\xf7\xff\x02\x00\xc2\xff\xe7\xff\x9a\xff\xdd\xffx\xff\xdd\xffh\xff\xcd\xff\\\xff\xcb\xffG\xff\xc9\xff2\xff\xc4\xff.\xff\xbb\xff\x1e\xff\xbd\xff(\xff\xc3\xff0\xff\xcb\xff<\xff\xd8\xffK\xff\xdf\xffL\xff\xe2\xffU\xff\xf3\xffZ\xff\xfc\xffe\xff\x03\x00{\xff\x02\x00\x81\xff\x06\x00\x8b\xff\x03\x00\xa2\xff\x01\x00\x9b\xff\xed\xff\x9a\xff\xdb\xff\u007f\xff\xcb\xffw\xff\xb5\xffj\xff\xa3\xff\\\xff\x8f\xffK\xff\x85\xffD\xff\x80\xff@\xffq\xff4\xffU\xff\x1c\xff,\xff\xf4\xfe\x16\xff\xc8\xfe\xfc\xfe\xae\xfe\xed\xfe\x83\xfe\xcf\xfep\xfe\xc0\xfeV\xfe\xb7\xfeO\xfe\xba\xfeH\xfe\xa9\xfe:\xfe\xa1\xfe \xfe\x96\xfe\x1b\xfe\x85\xfe\xfa\xfdq\xfe\xe4\xfd`\xfe\xb5\xfdU\xfe\xb0\xfdE\xfe\xac\xfdL\xfe\xb3\xfdY\xfe\xc9\xfdl\xfe\xe2\xfdw\xfe\xfa\xfds\xfe\x03\xfem\xfe\xfb\xfdO\xfe\xe8\xfdA\xfe\xd0\xfd;\xfe\xcc\xfdB\xfe\xd6\xfdB\xfe\xed\xfdE\xfe\xfa\xfdU\xfe\x17\xfe`\xfe*\xfeV\xfe3\xfe^\xfe=\xfed\xfeK\xfel\xfeR\xfev\xfeX\xfe\x82\xfeb\xfe\xa1\xfe\u007f\xfe\xae\xfe\x95\xfe\xcd\xfe\xb3\xfe\xdb\xfe\xc9\xfe\xef\xfe\xed\xfe\x0f\xff\a\xff#\xff\x1d\xffB\xff4\xffL\xff2\xff_\xff;\xffy\xff<\xff{\xffA\xff\x85\xffI\xff\x95\xffU\xff\xa8\xffl\xff\xb5\xffz\xff\xbc\xff~\xff\xbf\xff\x84\xff\xc3\xff}\xff\xaf\xffw\xff\xad\xffo\xff\xad\xffo\xff\xad\xffw\xff\xb0\xff\x83\xff\xa3\xff\x8c\xff\x97\xff\x93\xff\x89\xff\x92\xff\x81\xff\x89\xff\x81\xff\x89\xff\x80\xff\x80\xff\x83\xffy\xff\x8d\xff\x84\xff\x8f\xff|\xff\x8e\xff\x83\xff\x8f\xff\x82\xff\x8e\xff\x92\xff\x95\xff\xa0\xff\x9e\xff\xac\xff\x9f\xff\xad\xff\xa6\xff\xab\xff\xaa\xff\xa7\xff\xa9\xff\x9e\xff\xa8\xff\xa0\xff\x95\xff\x95\xff\x97\xff\xa0\xff\x8a\xff\x9e\xff~\xff\xab\xffq\xff\xa2\xffi\xff\xa5\xff]\xff\x98\xffL\xff\x83\xff?\xfft\xff/\xffk\xff*\xffb\xff+\xffi\xff1\xffp\xff1\xff\x84\xff:\xff\x93\xff3\xff\xa4\xff,\xff\x97\xff,\xff\x98\xff&\xff\x8b\xff\"\xff\x8d\xff(\xff\x8f\xff \xff\x8a\xff.\xff\x8f\xff+\xff\x8c\xff3\xff\x94\xff'\xff\x97\xff.\xff\x99\xff,\xff\x9b\xff/\xff\x8d\xff/\xff\x8d\xff5\xff\x84\xff<\xff\x8f\xffX\xff\x93\xffp\xff\xaa\xff\x8a\xff\xbe\xff\x99\xff\xd8\xff\xa1\xff\xed\xff\xa4\xff\xf8\xff\xa2\xff\xf1\xff\x96\xff\xec\xff\x96\xff\xeb\xff\x93\xff\xed\xff\xa0\xff\xff\xff\x9a\xff\xff\xff\xa0\xff\x05\x00\x9a\xff\x03\x00\x8f\xff\x03\x00\x8f\xff\xff\xff\x87\xff\xff\xff\x8b\xff\xfc\xff\x91\xff\xfd\xff\x9c\xff\xfc\xff\xb2\xff\x13\x00\xc7\xff\x1f\x00\xe7\xff2\x00\xef\xffD\x00\n\x00S\x00\x0e\x00s\x00&\x00\x89\x000\x00\x99\x00<\x00\x9b\x00S\x00\xa2\x00[\x00\xad\x00o\x00\xbb\x00y\x00\xbc\x00\x81\x00\xc4\x00x\x00\xb4\x00v\x00\xc1\x00v\x00\xb8\x00z\x00\xbd\x00r\x00\xac\x00k\x00\x9e\x00b\x00\x8d\x00f\x00}\x00X\x00|\x00U\x00n\x00?\x00f\x00.\x00R\x00\x0e\x00B\x00\x03\x00,\x00\xe1\xff$\x00\xdd\xff\x0f\x00\xcc\xff\a\x00\xd3\xff\xf6\xff\xce\xff\xf6\xff\xce\xff\xeb\xff\xc8\xff\xea\xff\xcb\xff\xe7\xff\xcf\xff\xec\xff\xea\xff\x03\x00\xf9\xff\x17\x00\x16\x00.\x000\x00@\x00Z\x00X\x00}\x00r\x00\x9d\x00\x86\x00\xb1\x00\xaa\x00\xc3\x00\xbc\x00\xcb\x00\xdb\x00\xcf\x00\xd8\x00\xdc\x00\xe3\x00\xdc\x00\xea\x00\xe7\x00\xf0\x00\xe0\x00\xea\x00\xe0\x00\xe6\x00\xd3\x00\xd6\x00\xc0\x00\xca\x00\xb1\x00\xc2\x00\xae\x00\xc2\x00\x98\x00\xb4\x00\x9b\x00\xae\x00\x8b\x00\xa4\x00\x92\x00\xaa\x00\x94\x00\xa2\x00\x9c\x00\xae\x00\xa8\x00\xa9\x00\xaf\x00\xba\x00\xbb\x00\xbd\x00\xbf\x00\xc6\x00\xca\x00\xd7\x00\xc8\x00\xdc\x00\xdd\x00\xeb\x00\xf2\x00\xfc\x00\x06\x01\x12\x01 \x01\"\x011\x01%\x019\x01'\x013\x01\x1d\x01+\x01\x1f\x012\x01'\x018\x01:\x01C\x01I\x01;\x01F\x01<\x01E\x013\x01;\x01/\x01-\x01(\x01%\x01\x18\x01\x14\x01\x12\x01\x1e\x01\x16\x01\x18\x01\x19\x01+\x01\x1d\x01+\x01+\x01:\x01/\x01;\x01H\x01H\x01S\x01I\x01s\x01a\x01\x83\x01q\x01\xa9\x01\x86\x01\xc7\x01\xa1\x01\xf2\x01\xc0\x01\x1a\x02\xe2\x010\x02\x02\x02B\x02\x18\x02E\x02\x1b\x02W\x02%\x02^\x02$\x02n\x02)\x02y\x021\x02z\x026\x02\x84\x02;\x02n\x02=\x02^\x02&\x026\x02\x14\x02\x18\x02\xef\x01\xe3\x01\xca\x01\xc5\x01\xa0\x01\x9e\x01z\x01\x82\x01h\x01w\x01Q\x01Z\x01N\x01G\x018\x01\x18\x01&\x01\xfe\x00\x02\x01\xe4\x00\xf9\x00\xcb\x00\xdf\x00\xbe\x00\xd0\x00\xb4\x00\xc4\x00\xc8\x00\xce\x00\xcc\x00\xcf\x00\xcb\x00\xcb\x00\xce\x00\xc2\x00\xc8\x00\xc5\x00\xc6\x00\xc1\x00\xbb\x00\xb6\x00\xbb\x00\xb1\x00\xbd\x00\xb1\x00\xb3\x00\xa3\x00\xb2\x00\x8f\x00\xad\x00\x80\x00\xaa\x00~\x00\x95\x00m\x00\x8c\x00b\x00o\x00I\x00V\x008\x00C\x00 \x005\x00\x17\x00&\x00\x05\x00\x1e\x00\xf1\xff\x15\x00\xe3\xff\t\x00\xd8\xff\xed\xff\xc0\xff\xc1\xff\x98\xff\xad\xff\x8b\xff\x91\xffx\xff\u007f\xffi\xff~\xffj\xff\x8a\xfft\xff\x93\xff\x81\xff\x8b\xff\x80\xff\x92\xffu\xff\x97\xff\x84\xff\xa3\xff\x81\xff\xa2\xff\x95\xff\xbc\xff\xa7\xff\xd7\xff\xc8\xff\xfa\xff\xe8\xff\x19\x00\x05\x005\x00\x1f\x00?\x00+\x009\x00,\x00C\x00,\x00?\x002\x002\x005\x00\"\x00(\x00\x1d\x00\x1f\x00\n\x00\x18\x00\xf8\xff\x02\x00\xe4\xff\xf1\xff\xd4\xff\xdb\xff\xad\xff\xc7\xff\x92\xff\x9a\xff\x84\xff\x85\xffs\xffn\xffa\xff^\xffe\xff`\xffh\xff^\xff\u007f\xffg\xff|\xffb\xff\x8f\xffc\xff\x87\xff\\\xff\u007f\xffD\xff\u007f\xff3\xff|\xff1\xff\x86\xff.\xff{\xff8\xff\x8a\xff5\xff\x8b\xffF\xff\x84\xff?\xff\x80\xff1\xffz\xff&\xff\u007f\xff#\xffv\xff\x1d\xff}\xff\x13\xff\x88\xff\x1b\xff\x95\xff,\xff\x92\xff7\xff\x97\xff<\xff\x90\xff>\xff\x8d\xff:\xff\x8c\xff<\xff\x85\xffB\xff\x82\xff9\xffo\xff9\xffg\xff*\xff]\xff*\xffL\xff\x1f\xff5\xff\x1f\xff \xff\x0f\xff\b\xff\r\xff\x01\xff\xff\xfe\xf7\xfe\xf8\xfe\xfd\xfe\xee\xfe\xfb\xfe\xf0\xfe\xf9\xfe\xe5\xfe\xfa\xfe\xe4\xfe\x03\xff\xe9\xfe\x02\xff\xed\xfe\x03\xff\xf8\xfe\x06\xff\xf0\xfe\x05\xff\xeb\xfe\a\xff\xe7\xfe\x13\xff\xec\xfe\x18\xff\xf0\xfe$\xff\x03\xff!\xff\x00\xff%\xff\x04\xff(\xff\x03\xff&\xff\x0f\xff(\xff\r\xff\"\xff\x10\xff'\xff\x0e\xff(\xff\x11\xff-\xff\x12\xff,\xff\x17\xff$\xff\x18\xff)\xff\x17\xff(\xff\x13\xff(\xff\x15\xff!\xff \xff\x18\xff%\xff\x0f\xff$\xff\xfa\xfe\x14\xff\xf1\xfe\a\xff\xdf\xfe\xfd\xfe\xd5\xfe\xf4\xfe\xcb\xfe\xf8\xfe\xc9\xfe\xf3\xfe\xd3\xfe\b\xff\xd9\xfe\x12\xff\xf0\xfe%\xff\xf7\xfe4\xff\x04\xff=\xff\x10\xffH\xff$\xffJ\xff>\xff`\xffO\xffj\xffr\xff\x85\xffy\xff\x92\xff\x94\xff\xa6\xff\x9c\xff\xb3\xff\xa9\xff\xc2\xff\xac\xff\xce\xff\xa7\xff\xc5\xff\x9f\xff\xce\xff\xa0\xff\xc1\xff\x9e\xff\xba\xff\x9a\xff\xb2\xff\x91\xff\xa3\xff\x83\xff\x9f\xff{\xff\x96\xffv\xff\x97\xffp\xff\x8e\xffg\xff\x85\xff\\\xffx\xffT\xff_\xffJ\xffP\xff>\xff>\xff0\xff4\xff\x1e\xff+\xff\x14\xff!\xff\b\xff\x13\xff\b\xff\x17\xff\x00\xff\x11\xff\xf5\xfe\x0e\xff\xf2\xfe\x10\xff\xf0\xfe\b\xff\xf6\xfe\x1f\xff\a\xff\x17\xff\x12\xff2\xff\x1f\xff2\xff)\xffI\xff;\xffV\xffM\xffn\xffX\xffw\xffZ\xff\x86\xfff\xff\x8b\xffb\xff\x8d\xffl\xff\x85\xffe\xff\x87\xffh\xff\x88\xffe\xff\x8f\xff_\xff\x95\xff[\xff\x8e\xffQ\xff\x91\xffU\xff\x8b\xffK\xff\x85\xffJ\xffz\xffP\xffv\xff\\\xffy\xffh\xff\x86\xffu\xff\x93\xff\x87\xff\xa5\xff\x95\xff\xb5\xff\x99\xff\xc0\xff\xac\xff\xc1\xff\xb3\xff\xd9\xff\xb5\xff\xd4\xff\xbc\xff\xe3\xff\xb8\xff\xd9\xff\xbc\xff\xe2\xff\xc2\xff\xe9\xff\xc7\xff\xf4\xff\xcd\xff\x05\x00\xd6\xff\r\x00\xd5\xff\x1f\x00\xe0\xff%\x00\xde\xff.\x00\xea\xff,\x00\xf7\xff0\x00\xfa\xff4\x00\x19\x00D\x00 \x00T\x00<\x00k\x00;\x00\x81\x00I\x00\x82\x007\x00\x84\x00>\x00q\x00*\x00j\x00$\x00X\x00\x1b\x00M\x00\x18\x00C\x00\x12\x006\x00\x11\x00;\x00\n\x00+\x00\x03\x00.\x00\xff\xff\x1e\x00\xf8\xff\x1c\x00\x01\x00\x0e\x00\a\x00\x19\x00\x1b\x00\x15\x00*\x00$\x00<\x00*\x00I\x00<\x00S\x00M\x00^\x00Z\x00h\x00t\x00f\x00x\x00g\x00\x82\x00b\x00t\x00_\x00t\x00X\x00m\x00W\x00o\x00J\x00o\x00D\x00m\x007\x00q\x00(\x00_\x00\x1a\x00_\x00\t\x00M\x00\xfe\xff<\x00\xf1\xff(\x00\xf0\xff%\x00\xf0\xff\x1b\x00\xed\xff\"\x00\xe7\xff\x17\x00\xec\xff\x16\x00\xe2\xff\x10\x00\xef\xff\x12\x00\xeb\xff\x19\x00\xeb\xff\x14\x00\xf1\xff\x19\x00\xed\xff\x11\x00\x00\x00\x1d\x00\x10\x00!\x00 \x001\x007\x00I\x00F\x00X\x00R\x00k\x00`\x00r\x00j\x00\x83\x00v\x00\x85\x00q\x00\x8e\x00\u007f\x00\x8a\x00\x83\x00\x92\x00\x86\x00\x93\x00\x89\x00\x99\x00\x89\x00\x98\x00\x80\x00\x8e\x00|\x00\x85\x00m\x00{\x00d\x00j\x00S\x00\\\x00I\x00E\x00G\x00;\x00E\x008\x00N\x002\x00V\x00<\x00f\x00?\x00u\x00N\x00~\x00V\x00\x94\x00i\x00\x9f\x00y\x00\xaf\x00\x80\x00\xc3\x00\x8e\x00\xdc\x00\x9c\x00\xef\x00\xaf\x00\a\x01\xc9\x00\x14\x01\xdc\x00 \x01\xf1\x00\"\x01\xf7\x00\x18\x01\xf8\x00\x14\x01\xef\x00\a\x01\xef\x00\x06\x01\xe0\x00\xfe\x00\xe3\x00\xfd\x00\xda\x00\xff\x00\xe0\x00\x01\x01\xdb\x00\xf8\x00\xda\x00\xf5\x00\xd2\x00\xe6\x00\xcc\x00\xdf\x00\xbf\x00\xd9\x00\xbc\x00\xdb\x00\xb7\x00\xe1\x00\xb2\x00\xe6\x00\xb9\x00\xf5\x00\xb4\x00\xf5\x00\xbb\x00\a\x01\xbd\x00\x01\x01\xbd\x00\xff\x00\xb7\x00\xf8\x00\xaf\x00\xee\x00\xa4\x00\xeb\x00\x98\x00\xed\x00\x92\x00\xec\x00\x90\x00\xf9\x00\x8e\x00\xf3\x00\x8c\x00\xfd\x00\x8d\x00\xf5\x00\x8d\x00\xf3\x00\x8b\x00\xf0\x00\x89\x00\xe2\x00\x81\x00\xe7\x00\x83\x00\xe2\x00{\x00\xe8\x00\x87\x00\xe9\x00\x82\x00\xe4\x00\x8b\x00\xe8\x00\x81\x00\xe4\x00\x8b\x00\xe3\x00\x90\x00\xe7\x00\x94\x00\xe2\x00\x96\x00\xe8\x00\x99\x00\xe5\x00\x9c\x00\xf8\x00\xa7\x00\a\x01\xb5\x00\x05\x01\xb8\x00\x15\x01\xbc\x00\x0e\x01\xc4\x00\x1f\x01\xd1\x00\x15\x01\xd6\x00\x14\x01\xd9\x00\x0e\x01\xd7\x00\t\x01\xd2\x00\x03\x01\xd1\x00\xfb\x00\xc2\x00\xed\x00\xbe\x00\xd5\x00\xa3\x00\xbe\x00\x99\x00\xa6\x00\x85\x00\x82\x00v\x00j\x00Z\x00H\x00J\x00<\x005\x00)\x00.\x00*\x00 \x00\x1e\x00%\x00\x1e\x00\x16\x00\x1a\x00\x17\x00\x18\x00\x16\x00\"\x00\x19\x00#\x00$\x002\x00/\x00B\x00=\x00S\x00J\x00a\x00Q\x00h\x00T\x00t\x00X\x00q\x00U\x00u\x00T\x00j\x00L\x00r\x00M\x00d\x00O\x00e\x00@\x00Z\x00=\x00M\x00$\x00=\x00\x14\x004\x00\xfe\xff&\x00\xec\xff\x16\x00\xec\xff\f\x00\xd9\xff\x04\x00\xdb\xff\x00\x00\xcf\xff\xf1\xff\xc7\xff\xe9\xff\xc1\xff\xde\xff\xb3\xff\xd7\xff\xb1\xff\xc6\xff\x9f\xff\xc5\xff\x98\xff\xbf\xff\x9b\xff\xbb\xff\x9b\xff\xb3\xff\x95\xff\xb5\xff\xa2\xff\xb6\xff\xa2\xff\xbe\xff\xab\xff\xba\xff\xb2\xff\xc7\xff\xad\xff\xc5\xff\xb9\xff\xc7\xff\xac\xff\xcf\xff\xb2\xff\xce\xff\xb2\xff\xde\xff\xbb\xff\xd4\xff\xc2\xff\xdf\xff\xba\xff\xd6\xff\xbe\xff\xd4\xff\xa7\xff\xbd\xff\x9f\xff\xa8\xff\u007f\xff\xa0\xfft\xff\x8f\xff\\\xff\x84\xffP\xff\x81\xffK\xffv\xff;\xffj\xff9\xffh\xff'\xffX\xff(\xffX\xff\x1c\xffS\xff\x16\xffN\xff\x1d\xffY\xff\x16\xffY\xff\"\xffl\xff)\xfft\xff7\xffx\xffB\xff\x85\xffO\xff~\xffQ\xff\x81\xffX\xffw\xffV\xffx\xffM\xffp\xffX\xffr\xffJ\xffa\xffQ\xffh\xffK\xff`\xffM\xff_\xffO\xffZ\xffM\xffV\xffI\xffW\xffM\xffQ\xffC\xffU\xffJ\xffR\xff@\xffU\xffA\xffV\xffG\xff]\xffE\xffV\xffJ\xff_\xffP\xffX\xffP\xff_\xffW\xff[\xffS\xffd\xff[\xffc\xffc\xffk\xffa\xffe\xffl\xffs\xffh\xffn\xffx\xffr\xffy\xffo\xff~\xffr\xff\x87\xffw\xff\x89\xff\u007f\xff\x8f\xff\x82\xff\x94\xff\u007f\xff\x8f\xff~\xff\x93\xffs\xff\x80\xffk\xffz\xff[\xffj\xffK\xffd\xffA\xffY\xff:\xffP\xff5\xffM\xff1\xff@\xff3\xffC\xff)\xff9\xff-\xff3\xff\x1f\xff-\xff%\xff%\xff$\xff.\xff,\xff1\xff,\xffA\xff<\xffD\xff:\xffO\xff?\xffP\xffE\xffU\xffB\xffV\xffC\xffV\xff<\xffS\xff<\xffS\xff<\xff[\xffB\xff_\xff>\xffe\xffD\xff`\xffA\xffd\xffC\xffd\xffE\xffc\xffB\xffd\xffG\xffe\xffF\xffb\xffP\xffj\xffV\xffo\xffe\xffz\xfff\xffy\xffj\xffz\xffk\xffx\xffe\xffu\xffl\xffj\xff_\xffk\xffb\xff^\xffU\xfff\xffW\xff\\\xffY\xff`\xffW\xffi\xff]\xffh\xff[\xffp\xffe\xffz\xfft\xff}\xff{\xff\x90\xff\x8d\xff\x9a\xff\x8e\xff\xab\xff\x9b\xff\xc0\xff\xa7\xff\xd0\xff\xae\xff\xe3\xff\xbb\xff\xed\xff\xc4\xff\x03\x00\xca\xff\a\x00\xd4\xff\x0f\x00\xd7\xff\r\x00\xd9\xff\x13\x00\xdb\xff\x0f\x00\xd5\xff\x12\x00\xd5\xff\a\x00\xde\xff\x0f\x00\xd7\xff\x03\x00\xea\xff\x15\x00\xe1\xff\x0f\x00\xf6\xff\x19\x00\xf3\xff\x11\x00\xff\xff\x11\x00\x03\x00\x13\x00\x04\x00\x14\x00\b\x00\x1a\x00\v\x00\x1f\x00\t\x00'\x00\f\x00&\x00\a\x002\x00\x04\x00\x1f\x00\xfc\xff%\x00\xf5\xff\x15\x00\xee\xff\x0f\x00\xea\xff\x0f\x00\xde\xff\b\x00\xe4\xff\x11\x00\xde\xff\x0e\x00\xe4\xff\x13\x00\xed\xff\x1a\x00\xf3\xff%\x00\x03\x00.\x00\x05\x00<\x00\x0e\x009\x00\x13\x00L\x00\x19\x00C\x00)\x00[\x00%\x00W\x007\x00g\x00/\x00h\x00;\x00j\x002\x00g\x00*\x00a\x00\"\x00T\x00\x11\x00I\x00\n\x00>\x00\x00\x001\x00\xf6\xff,\x00\xf6\xff)\x00\xe7\xff\"\x00\xf0\xff&\x00\xe4\xff#\x00\xeb\xff$\x00\xe1\xff#\x00\xe0\xff!\x00\xde\xff\x1c\x00\xe3\xff \x00\xdc\xff\x1f\x00\xe9\xff'\x00\xe7\xff.\x00\xee\xff4\x00\xfa\xff=\x00\xfb\xffD\x00\a\x00E\x00\t\x00U\x00\x11\x00H\x00\x17\x00R\x00\x1b\x00I\x00&\x00U\x001\x00]\x009\x00p\x00I\x00x\x00Q\x00\x86\x00U\x00\x87\x00]\x00\x86\x00W\x00~\x00U\x00v\x00R\x00p\x00E\x00f\x00I\x00a\x00;\x00_\x00<\x00[\x00.\x00[\x008\x00U\x00,\x00Q\x001\x00G\x00*\x00K\x00+\x00@\x00,\x00D\x001\x00?\x00A\x00O\x00G\x00a\x00`\x00x\x00a\x00\x8a\x00p\x00\x99\x00n\x00\x9b\x00w\x00\x9f\x00~\x00\x9f\x00{\x00\x9f\x00|\x00\xa3\x00v\x00\x9d\x00{\x00\xa1\x00x\x00\xa0\x00v\x00\xa0\x00s\x00\x9e\x00t\x00\x92\x00p\x00\x8d\x00i\x00~\x00d\x00u\x00[\x00n\x00`\x00h\x00V\x00h\x00U\x00d\x00Q\x00g\x00P\x00^\x00I\x00c\x00F\x00S\x00B\x00P\x00>\x00B\x003\x00A\x006\x004\x00&\x002\x00,\x00.\x00 \x000\x00/\x009\x00(\x00B\x006\x00B\x000\x00M\x004\x00@\x006\x00G\x007\x00I\x00C\x00F\x00F\x00Z\x00Q\x00`\x00K\x00o\x00U\x00u\x00O\x00p\x00V\x00q\x00S\x00n\x00S\x00d\x00O\x00_\x00T\x00T\x00Q\x00X\x00Z\x00T\x00V\x00[\x00d\x00\\\x00d\x00c\x00h\x00e\x00o\x00e\x00d\x00a\x00m\x00]\x00f\x00Q\x00i\x00Y\x00o\x00U\x00n\x00]\x00o\x00`\x00k\x00e\x00h\x00\\\x00_\x00\\\x00[\x00M\x00Q\x00F\x00E\x008\x00A\x004\x00;\x00.\x00<\x003\x00<\x005\x00D\x00>\x00A\x00F\x00H\x00F\x00?\x00D\x00O\x00I\x00D\x00A\x00M\x00A\x00I\x00=\x00R\x00>\x00N\x00C\x00V\x00E\x00O\x00I\x00X\x00E\x00T\x00G\x00b\x00@\x00e\x00B\x00n\x00@\x00r\x006\x00}\x00A\x00\x82\x009\x00\x93\x00N\x00\x97\x00O\x00\xa3\x00a\x00\xa2\x00a\x00\xa3\x00a\x00\xa8\x00_\x00\xa4\x00Y\x00\x9c\x00O\x00\x9a\x00H\x00\x8d\x00@\x00\x83\x006\x00s\x00,\x00i\x00$\x00\\\x00\x1f\x00T\x00\x16\x00I\x00\x12\x00A\x00\x06\x009\x00\xff\xff-\x00\xf3\xff*\x00\xec\xff&\x00\xf1\xff/\x00\xf3\xff2\x00\b\x008\x00\x11\x00<\x00#\x00<\x00&\x00D\x00,\x00G\x00+\x00I\x00-\x00O\x002\x00Y\x009\x00\\\x00>\x00i\x00J\x00q\x00X\x00w\x00^\x00{\x00h\x00z\x00e\x00s\x00Z\x00p\x00X\x00d\x00D\x00d\x00G\x00_\x00>\x00Y\x00?\x00S\x00;\x00H\x009\x00;\x00+\x00+\x00 \x00\x1e\x00\t\x00\f\x00\xf8\xff\x02\x00\xe4\xff\xf8\xff\xd9\xff\xf1\xff\xce\xff\xe9\xff\xc5\xff\xe1\xff\xbf\xff\xde\xff\xc0\xff\xde\xff\xbd\xff\xda\xff\xbb\xff\xd9\xff\xc2\xff\xdd\xff\xbb\xff\xdd\xff\xba\xff\xe6\xff\xbd\xff\xed\xff\xbd\xff\xef\xff\xcb\xff\xf6\xff\xcd\xff\xfb\xff\xda\xff\x02\x00\xe9\xff\n\x00\xf1\xff\x02\x00\xf6\xff\x15\x00\xf9\xff\b\x00\xfa\xff\x14\x00\xf4\xff\v\x00\xee\xff\x0f\x00\xef\xff\x14\x00\xe6\xff\x0f\x00\xf3\xff\x16\x00\xea\xff\x0e\x00\xf9\xff\t\x00\xf0\xff\x00\x00\xed\xff\xf4\xff\xe2\xff\xe8\xff\xce\xff\xe3\xff\xcb\xff\xe0\xff\xc1\xff\xdc\xff\xbc\xff\xd9\xff\xbf\xff\xdd\xff\xba\xff\xd1\xff\xc1\xff\xd5\xff\xb9\xff\xc1\xff\xb1\xff\xc8\xff\xae\xff\xba\xff\xab\xff\xbc\xff\x9d\xff\xbb\xff\xa3\xff\xc3\xff\xa0\xff\xcd\xff\xa7\xff\xd8\xff\xb4\xff\xe2\xff\xb8\xff\xef\xff\xd2\xff\xee\xff\xce\xff\xf4\xff\xdf\xff\xf2\xff\xd1\xff\xee\xff\xde\xff\xf0\xff\xd2\xff\xf4\xff\xe1\xff\xee\xff\xd8\xff\xf9\xff\xe8\xff\xf0\xff\xe1\xff\xf6\xff\xeb\xff\xe8\xff\xe3\xff\xe5\xff\xdf\xff\xd8\xff\xd5\xff\xce\xff\xc6\xff\xc1\xff\xb5\xff\xb1\xff\xac\xff\xb5\xff\xa0\xff\xa6\xff\x9f\xff\xaa\xff\x97\xff\x9e\xff\x9c\xff\xa2\xff\x9a\xff\x9a\xff\x99\xff\x9e\xff\x98\xff\x95\xff\x9a\xff\x99\xff\x98\xff\x9c\xff\x98\xff\xa4\xff\x99\xff\xaa\xff\x9d\xff\xad\xff\xa2\xff\xb6\xff\xac\xff\xb6\xff\xb2\xff\xb9\xff\xc0\xff\xb2\xff\xb9\xff\xbc\xff\xc7\xff\xb1\xff\xc0\xff\xb3\xff\xb7\xff\xae\xff\xb9\xff\xad\xff\xb2\xff\xa0\xff\xa9\xff\x9e\xff\xa6\xff\x87\xff\xa0\xff\x86\xff\x9d\xffq\xff\x9c\xffj\xff\x95\xffd\xff\x98\xff`\xff\x93\xffY\xff\x8d\xff]\xff\x8b\xffZ\xff\x89\xff^\xff\x85\xff_\xff\x8f\xffe\xff\x90\xffi\xff\x9b\xfft\xff\xa5\xff|\xff\xa7\xff\x86\xff\xaf\xff\x8b\xff\xb9\xff\x95\xff\xb4\xff\x99\xff\xbd\xff\xa7\xff\xbf\xff\xaa\xff\xc5\xff\xb9\xff\xca\xff\xbd\xff\xd4\xff\xc0\xff\xd1\xff\xbd\xff\xd8\xff\xc1\xff\xd3\xff\xbb\xff\xce\xff\xbc\xff\xd2\xff\xb4\xff\xc8\xff\xb8\xff\xcc\xff\xb3\xff\xc5\xff\xaf\xff\xc9\xff\xb3\xff\xc7\xff\xae\xff\xd1\xff\xa8\xff\xc9\xff\xb0\xff\xcd\xff\xa1\xff\xd0\xff\xac\xff\xcb\xff\xa4\xff\xd0\xff\xae\xff\xcf\xff\xab\xff\xd1\xff\xb5\xff\xde\xff\xb5\xff\xe2\xff\xc3\xff\xf3\xff\xc4\xff\xf5\xff\xc9\xff\x00\x00\xca\xff\xfb\xff\xcd\xff\a\x00\xd4\xff\xfc\xff\xcc\xff\x05\x00\xdf\xff\xfe\xff\xce\xff\xfd\xff\xe1\xff\x00\x00\xcf\xff\xfc\xff\xd7\xff\xf8\xff\xc7\xff\xf6\xff\xc0\xff\xe8\xff\xbb\xff\xe4\xff\xaf\xff\xd5\xff\xac\xff\xcd\xff\xa4\xff\xc4\xff\x99\xff\xb2\xff\x93\xff\xaf\xff\x91\xff\xa1\xff\x89\xff\xa8\xff\x8c\xff\xa2\xff\x82\xff\xa8\xff\x88\xff\xa7\xff\x85\xff\xac\xff\x8c\xff\xab\xff\x92\xff\xb2\xff\x99\xff\xb1\xff\xa0\xff\xbb\xff\xa7\xff\xc0\xff\xb3\xff\xcb\xff\xb6\xff\xd4\xff\xc4\xff\xdd\xff\xbe\xff\xe1\xff\xcf\xff\xea\xff\xca\xff\xe9\xff\xd7\xff\xf1\xff\xda\xff\xf2\xff\xdf\xff\xf6\xff\xe7\xff\xfc\xff\xea\xff\xfd\xff\xf0\xff\xff\xff\xf1\xff\x01\x00\xed\xff\xfe\xff\xf1\xff\a\x00\xec\xff\x00\x00\xec\xff\x04\x00\xef\xff\xff\xff\xec\xff\xff\xff\xef\xff\x01\x00\xee\xff\x00\x00\xf2\xff\a\x00\xef\xff\b\x00\xf6\xff\n\x00\xf0\xff\x0e\x00\xf3\xff\x0e\x00\xf5\xff\x13\x00\xf1\xff\v\x00\xf7\xff\x13\x00\xf8\xff\x11\x00\x02\x00\x1e\x00\x00\x00!\x00\b\x00&\x00\x01\x00,\x00\x06\x00.\x00\x01\x00/\x00\x02\x00/\x00\xf9\xff(\x00\xf9\xff\"\x00\xf8\xff$\x00\xf3\xff\x18\x00\xf9\xff\x1a\x00\xed\xff\x16\x00\xf1\xff\x10\x00\xec\xff\r\x00\xe6\xff\t\x00\xe8\xff\x06\x00\xe3\xff\xfd\xff\xe5\xff\xf8\xff\xed\xff\xf5\xff\xed\xff\xf0\xff\xfa\xff\xf3\xff\xf8\xff\xef\xff\x01\x00\xf8\xff\x03\x00\xf6\xff\x0e\x00\x02\x00\r\x00\x06\x00\x16\x00\r\x00\x11\x00\v\x00\x19\x00\x0e\x00\x18\x00\r\x00 \x00\x12\x00\x1f\x00\x14\x00%\x00\x15\x00!\x00\x17\x00\"\x00\x1a\x00\"\x00\x18\x00\x1a\x00\x15\x00\x14\x00\x11\x00\x12\x00\n\x00\b\x00\b\x00\x12\x00\b\x00\t\x00\xff\xff\v\x00\x02\x00\v\x00\xf8\xff\a\x00\x00\x00\a\x00\xf6\xff\x04\x00\xf7\xff\x05\x00\xf9\xff\xfe\xff\xef\xff\x06\x00\xf7\xff\xf5\xff\xed\xff\x05\x00\xf0\xff\xf6\xff\xeb\xff\xff\xff\xec\xff\xfb\xff\xea\xff\xfc\xff\xee\xff\xfe\xff\xed\xff\xf8\xff\xeb\xff\xf9\xff\xf1\xff\xfa\xff\xe6\xff\xfc\xff\xed\xff\xf6\xff\xe9\xff\xfd\xff\xe7\xff\xf1\xff\xe6\xff\xfa\xff\xe6\xff\xf5\xff\xe8\xff\xfe\xff\xeb\xff\xf8\xff\xf0\xff\x04\x00\xee\xff\xfe\xff\xf0\xff\n\x00\xed\xff\t\x00\xf2\xff\x15\x00\xf0\xff\x16\x00\xf8\xff'\x00\xfa\xff.\x00\n\x00:\x00\x0f\x00C\x00\x1c\x00M\x00\"\x00Q\x00.\x00[\x00.\x00^\x006\x00c\x003\x00f\x00<\x00d\x00;\x00n\x00A\x00c\x00A\x00j\x00B\x00`\x00B\x00\\\x00=\x00W\x009\x00K\x00+\x00I\x00(\x00@\x00 \x00;\x00\x1b\x00<\x00\x1f\x004\x00\x15\x00;\x00\x1a\x002\x00\x16\x009\x00\x18\x007\x00\x1a\x005\x00\x1d\x00<\x00\x1b\x008\x00\x1f\x00=\x00\x1f\x00@\x00%\x00E\x00(\x00I\x000\x00Q\x003\x00S\x00;\x00U\x009\x00]\x00>\x00T\x00=\x00^\x007\x00W\x00:\x00Z\x003\x00V\x00;\x00V\x00/\x00Q\x009\x00V\x002\x00P\x002\x00Q\x002\x00Q\x00+\x00E\x00)\x00K\x00%\x00>\x00\x1c\x00@\x00\x1e\x00:\x00\x19\x00;\x00\x18\x007\x00\x1c\x009\x00\x14\x006\x00\x1b\x005\x00\x14\x002\x00\x19\x00/\x00\x14\x00/\x00\x15\x00/\x00\x16\x00/\x00\x15\x00,\x00\x14\x003\x00\x16\x00.\x00\x17\x005\x00\x1c\x00/\x00\"\x006\x00!\x00,\x00)\x006\x00$\x00/\x00'\x005\x00 \x003\x00(\x005\x00&\x00=\x00/\x00:\x00/\x00B\x003\x009\x002\x00?\x003\x005\x004\x00>\x001\x009\x006\x00?\x00.\x00;\x002\x00@\x00,\x00>\x00/\x00:\x00+\x008\x00-\x003\x00&\x003\x00&\x001\x00\x1c\x00.\x00\x1f\x00,\x00\x19\x00/\x00\x16\x00,\x00\x1b\x00+\x00\x17\x000\x00\"\x00*\x00\x1c\x002\x00&\x00)\x00)\x005\x00-\x00.\x002\x00;\x005\x008\x009\x00:\x00;\x00@\x00C\x00;\x00?\x00D\x00I\x00=\x00I\x00C\x00I\x00A\x00O\x00@\x00H\x00C\x00L\x00<\x00C\x00=\x00C\x008\x00;\x007\x00<\x004\x009\x00-\x006\x000\x004\x00&\x00/\x00#\x00*\x00!\x00)\x00\x15\x00\x1c\x00\x18\x00\x1a\x00\f\x00\n\x00\x0f\x00\x0f\x00\t\x00\x02\x00\r\x00\f\x00\x06\x00\x00\x00\f\x00\b\x00\t\x00\xff\xff\t\x00\x04\x00\x0f\x00\x01\x00\a\x00\x01\x00\x15\x00\x03\x00\x11\x00\x04\x00\x19\x00\f\x00\x17\x00\x10\x00$\x00\x16\x00!\x00!\x00%\x00\x1e\x00#\x00*\x00\x1e\x00%\x00#\x00&\x00\x18\x00\"\x00\x18\x00\x1f\x00\x17\x00\x1c\x00\x0e\x00#\x00\x17\x00\x1a\x00\f\x00$\x00\x10\x00\x17\x00\x06\x00\x18\x00\x05\x00\x13\x00\x00\x00\x0e\x00\x00\x00\f\x00\x00\x00\x05\x00\x03\x00\x0e\x00\x05\x00\x05\x00\a\x00\x13\x00\a\x00\x0f\x00\n\x00\x13\x00\t\x00\x18\x00\n\x00\x14\x00\x01\x00\x17\x00\r\x00\x14\x00\x00\x00\x11\x00\r\x00\x13\x00\x00\x00\x14\x00\t\x00\x15\x00\x03\x00\x1b\x00\x05\x00\x19\x00\x04\x00!\x00\xfd\xff\x1a\x00\x03\x00\x1d\x00\xf9\xff\x12\x00\xfe\xff\x15\x00\xf7\xff\x13\x00\xf9\xff\f\x00\xf4\xff\x18\x00\xf6\xff\x06\x00\xee\xff\x15\x00\xed\xff\x06\x00\xe9\xff\t\x00\xe0\xff\x02\x00\xdf\xff\xfb\xff\xda\xff\xf8\xff\xd6\xff\xf1\xff\xd3\xff\xee\xff\xcc\xff\xe7\xff\xcc\xff\xe4\xff\xc3\xff\xde\xff\xc7\xff\xe4\xff\xc0\xff\xdc\xff\xc2\xff\xe5\xff\xc2\xff\xdd\xff\xc5\xff\xe5\xff\xc1\xff\xdf\xff\xc8\xff\xe8\xff\xca\xff\xe3\xff\xc4\xff\xee\xff\xd3\xff\xec\xff\xcd\xff\xf6\xff\xdd\xff\xfb\xff\xdb\xff\x03\x00\xe8\xff\x05\x00\xe7\xff\f\x00\xef\xff\v\x00\xf2\xff\x10\x00\xf2\xff\x0e\x00\xf8\xff\x11\x00\xf6\xff\x12\x00\xfa\xff\x12\x00\xfa\xff\x1a\x00\xf9\xff\x12\x00\xf7\xff\x1c\x00\xf7\xff\r\x00\xee\xff\x11\x00\xec\xff\x02\x00\xe6\xff\xff\xff\xde\xff\xf5\xff\xdd\xff\xf2\xff\xd2\xff\xec\xff\xd1\xff\xed\xff\xcb\xff\xe4\xff\xcc\xff\xe5\xff\xc2\xff\xda\xff\xc9\xff\xdc\xff\xc0\xff\xd4\xff\xcc\xff\xda\xff\xc8\xff\xd6\xff\xcf\xff\xd9\xff\xd3\xff\xe0\xff\xd8\xff\xe1\xff\xde\xff\xec\xff\xe4\xff\xf1\xff\xe5\xff\xf7\xff\xec\xff\xfd\xff\xee\xff\x00\x00\xf4\xff\x04\x00\xec\xff\xff\xff\xf0\xff\x00\x00\xe4\xff\xf9\xff\xe6\xff\xfc\xff\xdf\xff\xf6\xff\xd8\xff\xf6\xff\xd4\xff\xf2\xff\xc9\xff\xeb\xff\xc9\xff\xed\xff\xc3\xff\xde\xff\xbe\xff\xe0\xff\xba\xff\xd1\xff\xb3\xff\xd2\xff\xb1\xff\xcb\xff\xb0\xff\xcc\xff\xb0\xff\xca\xff\xaf\xff\xce\xff\xb0\xff\xd0\xff\xb5\xff\xcf\xff\xb5\xff\xd4\xff\xbe\xff\xd1\xff\xbb\xff\xd5\xff\xc6\xff\xd6\xff\xc4\xff\xd9\xff\xcf\xff\xe1\xff\xce\xff\xe3\xff\xd1\xff\xec\xff\xd8\xff\xe7\xff\xd5\xff\xf1\xff\xdd\xff\xf1\xff\xd9\xff\xf0\xff\xda\xff\xf7\xff\xd9\xff\xeb\xff\xd5\xff\xf2\xff\xd3\xff\xe7\xff\xca\xff\xe5\xff\xc7\xff\xe7\xff\xbc\xff\xd9\xff\xb9\xff\xe5\xff\xaf\xff\xcd\xff\xa9\xff\xd5\xff\x9f\xff\xbd\xff\x9c\xff\xbf\xff\x95\xff\xb0\xff\x95\xff\xb2\xff\x91\xff\xab\xff\x92\xff\xb1\xff\x94\xff\xae\xff\x97\xff\xb4\xff\x9b\xff\xaf\xff\x9c\xff\xb7\xff\xa4\xff\xb1\xff\xa7\xff\xbe\xff\xb1\xff\xbc\xff\xb6\xff\xc5\xff\xbf\xff\xc6\xff\xc0\xff\xcd\xff\xc9\xff\xd2\xff\xc6\xff\xd8\xff\xd4\xff\xdb\xff\xcb\xff\xe1\xff\xd4\xff\xdf\xff\xcf\xff\xe3\xff\xd5\xff\xdd\xff\xd2\xff\xdd\xff\xd7\xff\xdf\xff\xd1\xff\xd5\xff\xd6\xff\xe1\xff\xcf\xff\xd6\xff\xd1\xff\xe0\xff\xcb\xff\xd7\xff\xd2\xff\xdf\xff\xc9\xff\xd3\xff\xd2\xff\xde\xff\xd0\xff\xce\xff\xce\xff\xd6\xff\xcf\xff\xcc\xff\xcf\xff\xd1\xff\xce\xff\xcf\xff\xd2\xff\xd3\xff\xd0\xff\xd3\xff\xd2\xff\xd5\xff\xd4\xff\xd9\xff\xd6\xff\xd6\xff\xda\xff\xe0\xff\xdd\xff\xde\xff\xde\xff\xe5\xff\xe2\xff\xe8\xff\xe4\xff\xe9\xff\xe3\xff\xf2\xff\xec\xff\xf3\xff\xe5\xff\xf7\xff\xf3\xff\xfc\xff\xea\xff\xfc\xff\xf4\xff\xfe\xff\xed\xff\xff\xff\xf4\xff\xf9\xff\xef\xff\xfa\xff\xf0\xff\xf8\xff\xee\xff\xf3\xff\xf0\xff\xfd\xff\xec\xff\xec\xff\xed\xff\xf4\xff\xe8\xff\xe3\xff\xea\xff\xea\xff\xe5\xff\xdd\xff\xe6\xff\xe1\xff\xe6\xff\xd9\xff\xe4\xff\xd6\xff\xe4\xff\xd7\xff\xe1\xff\xd3\xff\xe0\xff\xd4\xff\xe2\xff\xd1\xff\xdd\xff\xd4\xff\xe3\xff\xd5\xff\xdc\xff\xd2\xff\xe1\xff\xd7\xff\xe1\xff\xd4\xff\xe2\xff\xda\xff\xe4\xff\xdb\xff\xeb\xff\xe2\xff\xed\xff\xe6\xff\xf5\xff\xee\xff\xf5\xff\xf0\xff\xfc\xff\xf3\xff\xff\xff\xfb\xff\x04\x00\xfa\xff\x06\x00\x00\x00\n\x00\x01\x00\f\x00\x04\x00\r\x00\x06\x00\r\x00\n\x00\x0f\x00\x02\x00\a\x00\v\x00\r\x00\x01\x00\a\x00\x04\x00\x06\x00\x02\x00\a\x00\xfa\xff\x02\x00\xf6\xff\x02\x00\xf6\xff\xff\xff\xee\xff\xfe\xff\xf1\xff\xf9\xff\xee\xff\x00\x00\xee\xff\xf8\xff\xee\xff\x00\x00\xf4\xff\xfd\xff\xf0\xff\x05\x00\xf7\xff\x03\x00\xf4\xff\r\x00\xf7\xff\x02\x00\xf4\xff\x10\x00\xf7\xff\a\x00\xf4\xff\x10\x00\xfa\xff\x0e\x00\xf8\xff\x10\x00\xfb\xff\x13\x00\xfc\xff\x13\x00\xfd\xff\x16\x00\xf9\xff\x14\x00\xfd\xff\x18\x00\xf3\xff\x16\x00\xfd\xff\x15\x00\xf0\xff\x17\x00\xff\xff\x17\x00\xf4\xff\x16\x00\xfd\xff\x1b\x00\xfa\xff\x18\x00\x00\x00\x1e\x00\xfb\xff\x1b\x00\x02\x00\x1d\x00\xfd\xff\x1d\x00\x01\x00\x1e\x00\x04\x00 \x00\xff\xff \x00\t\x00$\x00\x03\x00%\x00\r\x00#\x00\a\x00%\x00\x11\x00!\x00\x05\x00#\x00\x12\x00\"\x00\n\x00%\x00\x0e\x00$\x00\x12\x00(\x00\x0e\x00&\x00\x17\x00&\x00\x0e\x00+\x00\x19\x00#\x00\x10\x00/\x00\x18\x00'\x00\x16\x00-\x00\x16\x001\x00\x1b\x00.\x00\x17\x007\x00\x1c\x002\x00\x1c\x009\x00\x1d\x009\x00\x1e\x00>\x00!\x00:\x00\x1e\x00A\x00!\x00>\x00\x18\x00B\x00#\x00E\x00\x15\x00@\x00 \x00H\x00\x13\x00B\x00\x1a\x00B\x00\x10\x00D\x00\x17\x00<\x00\x0f\x00=\x00\x0f\x008\x00\f\x00=\x00\b\x005\x00\t\x00:\x00\b\x002\x00\x03\x002\x00\a\x00/\x00\x03\x00-\x00\x02\x00*\x00\x05\x00,\x00\x04\x00'\x00\n\x00/\x00\x0f\x00+\x00\x0e\x003\x00\x15\x00/\x00\x12\x002\x00\x1a\x007\x00\x14\x000\x00!\x00>\x00\x18\x004\x00#\x009\x00\x1c\x00:\x00\"\x00:\x00 \x00:\x00\x1a\x00<\x00!\x006\x00\x19\x00;\x00\x1c\x005\x00\x15\x007\x00\x17\x00)\x00\r\x006\x00\x0f\x00'\x00\b\x00.\x00\t\x00+\x00\x06\x00%\x00\a\x00/\x00\x04\x00)\x00\x05\x000\x00\v\x000\x00\x06\x003\x00\x12\x008\x00\r\x009\x00\x18\x00:\x00\x14\x00?\x00\x1d\x00=\x00\x1a\x00E\x00#\x00D\x00%\x00G\x00*\x00F\x00.\x00H\x00+\x00B\x00-\x00E\x00'\x00?\x00)\x00;\x00!\x00:\x00#\x005\x00\x1a\x006\x00\x1d\x00-\x00\x1b\x002\x00\x16\x00'\x00\x19\x00,\x00\x10\x00)\x00\x15\x00)\x00\x0f\x00*\x00\x12\x00*\x00\x0f\x00&\x00\x17\x00/\x00\x11\x00'\x00\x15\x003\x00\x17\x00&\x00\x19\x007\x00\x1b\x00+\x00\x19\x003\x00\x1d\x00/\x00\x1c\x003\x00\x1c\x00.\x00\x1b\x002\x00\x17\x00,\x00\x1b\x00+\x00\x1a\x000\x00\x14\x00%\x00\x19\x00(\x00\x0f\x00\"\x00\x11\x00#\x00\x0f\x00\x1b\x00\f\x00!\x00\x10\x00\x18\x00\n\x00\x1e\x00\r\x00\x1f\x00\v\x00\x1b\x00\x10\x00!\x00\f\x00\x1a\x00\r\x00!\x00\r\x00\x1c\x00\n\x00!\x00\x0f\x00\x1b\x00\f\x00#\x00\f\x00\x1e\x00\x14\x00(\x00\x0f\x00$\x00\x1a\x00+\x00\x15\x00)\x00\x1a\x00'\x00\x14\x00)\x00\x18\x00\"\x00\x13\x00\"\x00\x16\x00 \x00\x12\x00\x16\x00\x13\x00 \x00\x13\x00\x12\x00\x14\x00\x19\x00\x14\x00\x0f\x00\x10\x00\x10\x00\x0f\x00\f\x00\x10\x00\a\x00\b\x00\a\x00\r\x00\x03\x00\x04\x00\x03\x00\v\x00\x01\x00\x04\x00\xfe\xff\b\x00\x03\x00\x03\x00\xfe\xff\x06\x00\x06\x00\x02\x00\xfd\xff\x04\x00\x03\x00\x01\x00\x00\x00\x01\x00\x03\x00\xfe\xff\x05\x00\x02\x00\x02\x00\x01\x00\a\x00\xfe\xff\x06\x00\x05\x00\v\x00\xfc\xff\x05\x00\x04\x00\f\x00\x01\x00\a\x00\x05\x00\r\x00\x03\x00\b\x00\x06\x00\n\x00\b\x00\t\x00\b\x00\v\x00\x10\x00\x06\x00\f\x00\x05\x00\x11\x00\x04\x00\x0e\x00\x00\x00\x10\x00\xff\xff\x12\x00\xfd\xff\x0f\x00\xfd\xff\x13\x00\xfc\xff\x11\x00\xfc\xff\x12\x00\xfe\xff\x14\x00\xfd\xff\x10\x00\x00\x00\x16\x00\xfc\xff\x11\x00\x00\x00\x13\x00\x00\x00\x11\x00\x00\x00\x0f\x00\x02\x00\x12\x00\xfe\xff\r\x00\x01\x00\x10\x00\xfb\xff\r\x00\x00\x00\x10\x00\xf7\xff\r\x00\xf7\xff\r\x00\xf5\xff\t\x00\xec\xff\x03\x00\xed\xff\x03\x00\xe4\xff\xfb\xff\xe2\xff\xfd\xff\xe0\xff\xf4\xff\xd4\xff\xf3\xff\xd5\xff\xf0\xff\xcb\xff\xeb\xff\xcc\xff\xeb\xff\xc6\xff\xec\xff\xc5\xff\xe6\xff\xc4\xff\xe7\xff\xc0\xff\xe6\xff\xc1\xff\xe2\xff\xbd\xff\xe6\xff\xbf\xff\xe6\xff\xc1\xff\xe5\xff\xc1\xff\xeb\xff\xc3\xff\xee\xff\xc8\xff\xef\xff\xc9\xff\xf5\xff\xcc\xff\xf3\xff\xce\xff\xf6\xff\xd0\xff\xf9\xff\xd1\xff\xf7\xff\xd6\xff\xfa\xff\xd1\xff\xf7\xff\xd7\xff\xfa\xff\xd5\xff\xf7\xff\xd7\xff\xfa\xff\xd4\xff\xf7\xff\xd4\xff\xfa\xff\xd4\xff\xf4\xff\xd1\xff\xf7\xff\xd0\xff\xee\xff\xc8\xff\xf3\xff\xc9\xff\xe8\xff\xc3\xff\xea\xff\xc4\xff\xe4\xff\xc3\xff\xe4\xff\xbb\xff\xe5\xff\xc2\xff\xde\xff\xbb\xff\xe3\xff\xbd\xff\xdd\xff\xbf\xff\xe0\xff\xb9\xff\xe1\xff\xc2\xff\xdd\xff\xbd\xff\xe2\xff\xc2\xff\xe0\xff\xc3\xff\xe0\xff\xc3\xff\xe2\xff\xc6\xff\xe3\xff\xc4\xff\xe5\xff\xc6\xff\xe6\xff\xc3\xff\xe5\xff\xc5\xff\xe4\xff\xc3\xff\xe9\xff\xbf\xff\xe2\xff\xc6\xff\xea\xff\xbb\xff\xe0\xff\xc5\xff\xea\xff\xbc\xff\xe1\xff\xc0\xff\xe6\xff\xbf\xff\xe3\xff\xbb\xff\xe3\xff\xbc\xff\xe2\xff\xbb\xff\xe2\xff\xb9\xff\xe2\xff\xbf\xff\xe4\xff\xbc\xff\xe4\xff\xbf\xff\xe5\xff\xc2\xff\xe6\xff\xba\xff\xea\xff\xc6\xff\xec\xff\xbd\xff\xec\xff\xc6\xff\xed\xff\xc2\xff\xef\xff\xc7\xff\xf0\xff\xc3\xff\xef\xff\xcf\xff\xf5\xff\xc5\xff\xed\xff\xce\xff\xf5\xff\xc7\xff\xf2\xff\xca\xff\xf3\xff\xc9\xff\xf4\xff\xcb\xff\xf2\xff\xc6\xff\xf1\xff\xcc\xff\xed\xff\xc5\xff\xf1\xff\xcb\xff\xeb\xff\xc6\xff\xf0\xff\xc9\xff\xe9\xff\xc8\xff\xec\xff\xca\xff\xec\xff\xc7\xff\xed\xff\xca\xff\xec\xff\xcb\xff\xee\xff\xc8\xff\xec\xff\xcf\xff\xea\xff\xc8\xff\xec\xff\xd0\xff\xe8\xff\xce\xff\xea\xff\xce\xff\xea\xff\xd3\xff\xea\xff\xcf\xff\xec\xff\xd0\xff\xe9\xff\xd1\xff\xee\xff\xcf\xff\xe8\xff\xd1\xff\xef\xff\xcf\xff\xe9\xff\xd1\xff\xeb\xff\xcc\xff\xe9\xff\xcf\xff\xe9\xff\xcd\xff\xea\xff\xc9\xff\xea\xff\xce\xff\xeb\xff\xc8\xff\xeb\xff\xca\xff\xe9\xff\xcd\xff\xeb\xff\xca\xff\xe8\xff\xd1\xff\xea\xff\xce\xff\xea\xff\xd4\xff\xe8\xff\xd3\xff\xed\xff\xd7\xff\xea\xff\xd8\xff\xef\xff\xdb\xff\xeb\xff\xde\xff\xf4\xff\xdf\xff\xed\xff\xe3\xff\xf5\xff\xe4\xff\xf2\xff\xea\xff\xf4\xff\xe7\xff\xfb\xff\xf1\xff\xfa\xff\xe8\xff\xff\xff\xf2\xff\xfd\xff\xf0\xff\x02\x00\xf3\xff\xff\xff\xf3\xff\x06\x00\xf4\xff\xfd\xff\xf3\xff\t\x00\xf3\xff\xfc\xff\xf6\xff\x06\x00\xf0\xff\xfe\xff\xf5\xff\x03\x00\xf0\xff\xff\xff\xf4\xff\x02\x00\xf0\xff\xfd\xff\xf1\xff\x00\x00\xf2\xff\xfd\xff\xef\xff\xff\xff\xf9\xff\xfe\xff\xef\xff\xfe\xff\xfc\xff\x00\x00\xf9\xff\x00\x00\xfd\xff\x02\x00\x05\x00\x02\x00\x01\x00\b\x00\t\x00\x06\x00\t\x00\f\x00\r\x00\r\x00\x0e\x00\x0f\x00\x13\x00\x11\x00\x13\x00\x12\x00\x13\x00\x15\x00\x17\x00\x12\x00\x10\x00\x16\x00\x1a\x00\x13\x00\x10\x00\x17\x00\x17\x00\x13\x00\x10\x00\x17\x00\x11\x00\x12\x00\x13\x00\x16\x00\x0f\x00\x12\x00\x15\x00\x0f\x00\f\x00\x15\x00\x13\x00\n\x00\x0e\x00\x14\x00\x0f\x00\t\x00\x13\x00\x0f\x00\x0e\x00\r\x00\x14\x00\f\x00\x14\x00\x0f\x00\x13\x00\x0e\x00\x19\x00\f\x00\x17\x00\x13\x00\x17\x00\n\x00\x1a\x00\x14\x00\x17\x00\n\x00\x1c\x00\x13\x00 \x00\v\x00\x19\x00\x13\x00#\x00\x0e\x00\x17\x00\x13\x00'\x00\x11\x00\x1c\x00\x10\x00#\x00\x13\x00 \x00\r\x00\"\x00\x17\x00%\x00\t\x00#\x00\x15\x00\"\x00\n\x00#\x00\x10\x00!\x00\r\x00(\x00\x10\x00\"\x00\x0f\x00(\x00\x10\x00%\x00\r\x00&\x00\x0f\x00,\x00\x12\x00)\x00\x11\x00-\x00\x15\x002\x00\x16\x002\x00\x17\x005\x00\x1a\x007\x00\x1d\x008\x00\x1e\x00<\x00\x1f\x00:\x00$\x00<\x00\x1f\x00=\x00*\x00<\x00#\x00>\x00(\x00A\x00&\x00;\x00%\x00B\x00&\x009\x00$\x00A\x00'\x00<\x00#\x00;\x00)\x00?\x00\"\x00:\x00'\x00@\x00%\x00>\x00%\x00?\x00&\x00B\x00&\x00>\x00&\x00C\x00%\x00>\x00(\x00@\x00%\x00@\x00&\x00A\x00&\x00=\x00$\x00=\x00&\x00=\x00$\x00:\x00\"\x00;\x00\"\x009\x00\x1b\x006\x00\"\x005\x00\x18\x005\x00\x1e\x003\x00\x16\x003\x00\x16\x00/\x00\x14\x002\x00\x13\x002\x00\x12\x000\x00\x11\x002\x00\x12\x000\x00\x14\x004\x00\x13\x002\x00\x15\x005\x00\x11\x000\x00\x19\x003\x00\x12\x005\x00\x19\x00/\x00\x14\x002\x00\x17\x003\x00\x1a\x00-\x00\x14\x007\x00\x1b\x00.\x00\x16\x002\x00\x15\x002\x00\x1c\x00.\x00\x10\x001\x00\x17\x00-\x00\x11\x00-\x00\x10\x00*\x00\x13\x00*\x00\n\x00&\x00\x12\x00&\x00\x06\x00%\x00\f\x00#\x00\t\x00$\x00\x05\x00#\x00\v\x00 \x00\x01\x00\"\x00\t\x00\"\x00\x02\x00 \x00\v\x00(\x00\x04\x00\"\x00\f\x00,\x00\a\x00'\x00\x0f\x00-\x00\x0e\x00.\x00\x0f\x000\x00\x18\x006\x00\x10\x000\x00\x1e\x005\x00\x18\x007\x00\x1e\x004\x00#\x00<\x00\x1d\x002\x00$\x00<\x00\x1f\x002\x00#\x008\x00\"\x005\x00 \x004\x00#\x003\x00\x1e\x000\x00 \x002\x00\x1e\x00.\x00\x1d\x00/\x00\x1d\x00/\x00\x19\x00*\x00\x1c\x000\x00\x18\x00(\x00\x1b\x00/\x00\x1c\x00'\x00\x17\x00*\x00\x1d\x00+\x00\x18\x00&\x00\x1a\x00,\x00\x19\x00(\x00\x19\x00+\x00\x19\x00)\x00\x18\x00)\x00\x1b\x00*\x00\x1a\x00(\x00\x19\x00+\x00\x1b\x00&\x00\x14\x00)\x00\x1c\x00\"\x00\x12\x00%\x00\x13\x00#\x00\x10\x00\x1d\x00\x10\x00 \x00\f\x00\x1b\x00\x0e\x00\x1c\x00\b\x00\x1b\x00\n\x00\x12\x00\n\x00\x16\x00\x01\x00\x0e\x00\n\x00\x0e\x00\xfa\xff\f\x00\a\x00\n\x00\xfe\xff\n\x00\x01\x00\n\x00\x04\x00\f\x00\xff\xff\v\x00\x05\x00\f\x00\x01\x00\r\x00\x03\x00\v\x00\x00\x00\f\x00\x05\x00\x0f\x00\xff\xff\v\x00\x05\x00\x10\x00\x02\x00\r\x00\a\x00\x0e\x00\x05\x00\r\x00\x05\x00\r\x00\a\x00\v\x00\x02\x00\x0e\x00\n\x00\x05\x00\xfe\xff\t\x00\b\x00\a\x00\xff\xff\x05\x00\x04\x00\b\x00\x01\x00\x02\x00\x00\x00\x06\x00\x02\x00\x01\x00\xfe\xff\x04\x00\x01\x00\x00\x00\xfe\xff\x02\x00\xfc\xff\x01\x00\x00\x00\xfc\xff\xfa\xff\x01\x00\xfe\xff\xfb\xff\xfa\xff\x02\x00\xfd\xff\xf8\xff\xf7\xff\x00\x00\xff\xff\xf8\xff\xf7\xff\xfe\xff\xfd\xff\xf8\xff\xf8\xff\xfe\xff\xfa\xff\xf6\xff\xfb\xff\xfc\xff\xf6\xff\xf9\xff\xf9\xff\xf7\xff\xf7\xff\xfe\xff\xfa\xff\xf4\xff\xf5\xff\xfa\xff\xf6\xff\xf3\xff\xf2\xff\xf5\xff\xf9\xff\xf6\xff\xf0\xff\xf1\xff\xfb\xff\xf6\xff\xef\xff\xf3\xff\xfb\xff\xf5\xff\xf0\xff\xf4\xff\xfe\xff\xf5\xff\xee\xff\xf5\xff\xff\xff\xf7\xff\xf6\xff\xf7\xff\xfa\xff\xf6\xff\xff\xff\xf5\xff\xf5\xff\xf7\xff\xfe\xff\xf5\xff\xfa\xff\xf4\xff\xf8\xff\xf5\xff\xfe\xff\xf0\xff\xf2\xff\xf4\xff\xfc\xff\xed\xff\xf5\xff\xef\xff\xf4\xff\xea\xff\xf6\xff\xea\xff\xe9\xff\xe3\xff\xf4\xff\xe5\xff\xe7\xff\xdd\xff\xed\xff\xdf\xff\xe6\xff\xd9\xff\xe7\xff\xd8\xff\xe6\xff\xd5\xff\xe4\xff\xd9\xff\xe6\xff\xd0\xff\xe3\xff\xd9\xff\xe7\xff\xcf\xff\xe6\xff\xd6\xff\xe4\xff\xd4\xff\xea\xff\xd1\xff\xe0\xff\xd7\xff\xed\xff\xd6\xff\xe3\xff\xd6\xff\xee\xff\xdc\xff\xe5\xff\xdb\xff\xee\xff\xe0\xff\xe9\xff\xdb\xff\xeb\xff\xe1\xff\xf1\xff\xde\xff\xe9\xff\xe0\xff\xf3\xff\xe2\xff\xe6\xff\xe0\xff\xf1\xff\xe1\xff\xeb\xff\xe5\xff\xef\xff\xe2\xff\xec\xff\xe2\xff\xf0\xff\xe2\xff\xe9\xff\xdd\xff\xf0\xff\xe0\xff\xe7\xff\xda\xff\xeb\xff\xda\xff\xe7\xff\xd7\xff\xe4\xff\xd4\xff\xe7\xff\xd5\xff\xe5\xff\xd2\xff\xe3\xff\xcf\xff\xe3\xff\xd3\xff\xe1\xff\xc9\xff\xe0\xff\xd2\xff\xe3\xff\xc8\xff\xde\xff\xd0\xff\xe1\xff\xc8\xff\xdc\xff\xd1\xff\xe1\xff\xc7\xff\xdb\xff\xd5\xff\xe6\xff\xc9\xff\xe0\xff\xd9\xff\xe2\xff\xcb\xff\xe8\xff\xd9\xff\xe0\xff\xd2\xff\xef\xff\xd8\xff\xe5\xff\xdb\xff\xeb\xff\xd5\xff\xec\xff\xdf\xff\xea\xff\xd3\xff\xed\xff\xdf\xff\xea\xff\xd6\xff\xf0\xff\xdd\xff\xe7\xff\xdc\xff\xf1\xff\xd6\xff\xe8\xff\xe0\xff\xee\xff\xd0\xff\xea\xff\xdd\xff\xe9\xff\xd2\xff\xea\xff\xd7\xff\xe8\xff\xd2\xff\xe7\xff\xd5\xff\xe9\xff\xd1\xff\xe6\xff\xd4\xff\xea\xff\xd2\xff\xe8\xff\xd4\xff\xe8\xff\xcf\xff\xe9\xff\xd5\xff\xe4\xff\xcd\xff\xeb\xff\xd5\xff\xe4\xff\xcf\xff\xee\xff\xd3\xff\xe3\xff\xd1\xff\xed\xff\xd4\xff\xe4\xff\xd1\xff\xe8\xff\xd8\xff\xe9\xff\xd1\xff\xe7\xff\xd6\xff\xec\xff\xd1\xff\xe6\xff\xd8\xff\xed\xff\xcf\xff\xe7\xff\xda\xff\xf0\xff\xd2\xff\xe8\xff\xda\xff\xef\xff\xd3\xff\xe9\xff\xda\xff\xef\xff\xd1\xff\xea\xff\xdd\xff\xec\xff\xd3\xff\xed\xff\xdb\xff\xeb\xff\xd3\xff\xea\xff\xdc\xff\xef\xff\xd5\xff\xe7\xff\xde\xff\xee\xff\xda\xff\xec\xff\xdd\xff\xe8\xff\xdd\xff\xf1\xff\xde\xff\xe9\xff\xe2\xff\xf1\xff\xe1\xff\xec\xff\xe5\xff\xf3\xff\xe6\xff\xef\xff\xeb\xff\xf7\xff\xea\xff\xf2\xff\xf1\xff\xfa\xff\xed\xff\xf7\xff\xf6\xff\xfc\xff\xf3\xff\xf8\xff\xf7\xff\x00\x00\xf9\xff\xff\xff\xfa\xff\xfd\xff\xfc\xff\x04\x00\xff\xff\xf9\xff\xfc\xff\a\x00\xfe\xff\xfd\xff\xff\xff\x05\x00\xfb\xff\x00\x00\x00\x00\x04\x00\xfa\xff\xff\xff\xff\xff\x04\x00\xfb\xff\x00\x00\x00\x00\a\x00\xf9\xff\xfe\xff\xff\xff\x06\x00\xf7\xff\x01\x00\xfc\xff\x01\x00\xfb\xff\b\x00\xfa\xff\xfe\xff\xfa\xff\v\x00\xf9\xff\xff\xff\xfa\xff\t\x00\xfa\xff\x03\x00\xfa\xff\x06\x00\xf9\xff\x04\x00\xfb\xff\x05\x00\xf9\xff\x03\x00\xfa\xff\x06\x00\xfd\xff\x01\x00\xf8\xff\x06\x00\x01\x00\x02\x00\xf9\xff\x05\x00\x04\x00\x03\x00\xfd\xff\x05\x00\x04\x00\x01\x00\xff\xff\x06\x00\b\x00\x02\x00\x03\x00\a\x00\b\x00\x06\x00\x04\x00\x06\x00\n\x00\n\x00\x05\x00\a\x00\f\x00\n\x00\b\x00\b\x00\f\x00\r\x00\x0e\x00\x06\x00\n\x00\x0e\x00\x13\x00\f\x00\a\x00\r\x00\x16\x00\x10\x00\t\x00\x0e\x00\x12\x00\x11\x00\x11\x00\x10\x00\x0f\x00\x14\x00\x16\x00\r\x00\x0f\x00\x17\x00\x16\x00\x10\x00\x17\x00\x15\x00\x0f\x00\x14\x00\x1a\x00\x10\x00\x11\x00\x15\x00\x17\x00\x12\x00\x15\x00\x13\x00\x17\x00\x12\x00\x13\x00\x14\x00\x17\x00\x10\x00\x15\x00\x13\x00\x15\x00\x11\x00\x13\x00\x10\x00\x14\x00\x10\x00\x10\x00\x13\x00\x14\x00\r\x00\x12\x00\x19\x00\x11\x00\v\x00\x17\x00\x16\x00\x0f\x00\f\x00\x15\x00\x11\x00\x10\x00\x0f\x00\x13\x00\x0e\x00\x12\x00\x0e\x00\x14\x00\x0e\x00\x13\x00\v\x00\x14\x00\x12\x00\x17\x00\n\x00\x12\x00\x15\x00\x1b\x00\r\x00\x18\x00\x11\x00\x1a\x00\x12\x00\x1e\x00\x11\x00\x1a\x00\x19\x00\x1e\x00\x12\x00!\x00\x1c\x00 \x00\x18\x00\"\x00\x1a\x00$\x00\x1c\x00!\x00\x1d\x00%\x00\x1f\x00'\x00 \x00#\x00\x1e\x00*\x00#\x00$\x00\x1f\x00)\x00\"\x00%\x00\"\x00(\x00!\x00%\x00%\x00(\x00!\x00%\x00$\x00%\x00\"\x00&\x00!\x00&\x00$\x00&\x00#\x00\"\x00\"\x00*\x00%\x00!\x00 \x00-\x00#\x00 \x00\"\x00'\x00\x1d\x00&\x00$\x00#\x00\x1b\x00,\x00\x1e\x00!\x00\"\x00*\x00\x19\x00\x1f\x00#\x00&\x00\x17\x00\x1e\x00\x1d\x00#\x00\x16\x00\"\x00\x1c\x00\"\x00\x12\x00 \x00\x1d\x00\"\x00\r\x00\x1f\x00\x1d\x00#\x00\r\x00\x1c\x00\x18\x00%\x00\x0f\x00\x1c\x00\x16\x00#\x00\x0f\x00#\x00\x18\x00\"\x00\x11\x00'\x00\x16\x00$\x00\x15\x00&\x00\x13\x00%\x00\x15\x00&\x00\x13\x00$\x00\x18\x00(\x00\x13\x00#\x00\x1a\x00)\x00\x14\x00'\x00\x1a\x00$\x00\x16\x00*\x00\x18\x00\"\x00\x1b\x00&\x00\x16\x00%\x00\x1c\x00%\x00\x14\x00#\x00\x1e\x00#\x00\x0e\x00 \x00\x1f\x00!\x00\x10\x00\"\x00\x18\x00\x1e\x00\x13\x00\"\x00\x15\x00\x1c\x00\x11\x00\x1e\x00\x16\x00\x1d\x00\x0e\x00\x1d\x00\x14\x00\x1b\x00\x0e\x00\x1d\x00\x13\x00\x1a\x00\x0f\x00\x1e\x00\x14\x00\x1c\x00\x10\x00 \x00\x12\x00\x19\x00\x10\x00!\x00\x12\x00\x18\x00\r\x00\"\x00\x12\x00\x19\x00\r\x00\x1e\x00\x10\x00\x1b\x00\x0e\x00\x1a\x00\v\x00\x1c\x00\x0f\x00\x1b\x00\a\x00\x18\x00\x10\x00\x18\x00\x06\x00\x14\x00\r\x00\x19\x00\t\x00\x11\x00\t\x00\x18\x00\v\x00\x11\x00\v\x00\x17\x00\x05\x00\x11\x00\x0e\x00\x15\x00\a\x00\x13\x00\r\x00\x12\x00\f\x00\x16\x00\a\x00\x14\x00\x0e\x00\x10\x00\x05\x00\x1a\x00\x0f\x00\f\x00\a\x00\x19\x00\v\x00\x0f\x00\v\x00\x18\x00\t\x00\x10\x00\t\x00\x15\x00\v\x00\x0f\x00\t\x00\x13\x00\n\x00\x11\x00\v\x00\x12\x00\b\x00\x10\x00\r\x00\x0e\x00\b\x00\x10\x00\v\x00\r\x00\t\x00\x0f\x00\b\x00\n\x00\n\x00\r\x00\a\x00\b\x00\b\x00\f\x00\x05\x00\a\x00\x05\x00\t\x00\x04\x00\x03\x00\x01\x00\b\x00\x01\x00\xff\xff\xfc\xff\x05\x00\xfd\xff\x01\x00\xf9\xff\xfd\xff\xfc\xff\x02\x00\xf6\xff\xfe\xff\xfe\xff\xfd\xff\xf3\xff\x03\x00\xfc\xff\xf9\xff\xf5\xff\x00\x00\xfa\xff\xfe\xff\xf9\xff\xfb\xff\xf6\xff\x00\x00\xfa\xff\xf9\xff\xfa\xff\x03\x00\xf8\xff\xf5\xff\xfd\xff\x06\x00\xf7\xff\xf4\xff\xfa\xff\x03\x00\xf9\xff\xf7\xff\xf9\xff\xfb\xff\xf9\xff\xfa\xff\xf6\xff\xf8\xff\xfa\xff\xfc\xff\xf2\xff\xf6\xff\xfb\xff\xfa\xff\xf1\xff\xf4\xff\xf6\xff\xf8\xff\xf4\xff\xf3\xff\xf3\xff\xf7\xff\xf4\xff\xf0\xff\xf2\xff\xf4\xff\xf6\xff\xf0\xff\xee\xff\xf0\xff\xf8\xff\xf0\xff\xec\xff\xea\xff\xf3\xff\xf1\xff\xf1\xff\xe8\xff\xee\xff\xee\xff\xf2\xff\xeb\xff\xee\xff\xe6\xff\xf3\xff\xed\xff\xee\xff\xe4\xff\xf1\xff\xea\xff\xec\xff\xe6\xff\xf1\xff\xe8\xff\xed\xff\xe7\xff\xf2\xff\xe7\xff\xeb\xff\xe6\xff\xf4\xff\xe8\xff\xe9\xff\xe7\xff\xf4\xff\xe8\xff\xe8\xff\xe6\xff\xf3\xff\xe7\xff\xe8\xff\xe6\xff\xf1\xff\xe2\xff\xe9\xff\xe9\xff\xeb\xff\xde\xff\xeb\xff\xe5\xff\xe6\xff\xe2\xff\xe9\xff\xde\xff\xe7\xff\xe1\xff\xe8\xff\xdf\xff\xe4\xff\xdd\xff\xe9\xff\xdf\xff\xe2\xff\xdf\xff\xe7\xff\xdb\xff\xe4\xff\xe3\xff\xe4\xff\xda\xff\xe7\xff\xe3\xff\xe5\xff\xda\xff\xe6\xff\xe0\xff\xe3\xff\xdd\xff\xe7\xff\xdd\xff\xe1\xff\xe0\xff\xe7\xff\xda\xff\xe3\xff\xe2\xff\xe8\xff\xdc\xff\xe0\xff\xde\xff\xe8\xff\xe2\xff\xe3\xff\xda\xff\xe3\xff\xe2\xff\xe5\xff\xdd\xff\xe3\xff\xdb\xff\xe2\xff\xdf\xff\xe9\xff\xde\xff\xde\xff\xdc\xff\xe9\xff\xdf\xff\xe2\xff\xdc\xff\xe8\xff\xde\xff\xe4\xff\xdf\xff\xe6\xff\xde\xff\xe5\xff\xdc\xff\xe4\xff\xde\xff\xe5\xff\xd9\xff\xe4\xff\xdc\xff\xe2\xff\xda\xff\xe9\xff\xdb\xff\xdf\xff\xda\xff\xea\xff\xd9\xff\xe0\xff\xdd\xff\xe8\xff\xd7\xff\xe4\xff\xe1\xff\xe9\xff\xd9\xff\xe2\xff\xdf\xff\xeb\xff\xdf\xff\xe6\xff\xdd\xff\xeb\xff\xe5\xff\xe7\xff\xdb\xff\xec\xff\xe8\xff\xec\xff\xde\xff\xec\xff\xe8\xff\xee\xff\xe3\xff\xed\xff\xe3\xff\xf0\xff\xe7\xff\xf1\xff\xe1\xff\xef\xff\xe7\xff\xf2\xff\xe0\xff\xee\xff\xe7\xff\xf5\xff\xdf\xff\xeb\xff\xe6\xff\xf1\xff\xe1\xff\xf1\xff\xe2\xff\xed\xff\xe5\xff\xf4\xff\xe1\xff\xec\xff\xe5\xff\xf4\xff\xdf\xff\xed\xff\xe4\xff\xf4\xff\xe1\xff\xee\xff\xe1\xff\xf1\xff\xe3\xff\xf0\xff\xe2\xff\xf0\xff\xe2\xff\xf0\xff\xe6\xff\xf3\xff\xe3\xff\xee\xff\xe6\xff\xf4\xff\xe7\xff\xf0\xff\xe2\xff\xf4\xff\xeb\xff\xef\xff\xe2\xff\xf7\xff\xec\xff\xee\xff\xe8\xff\xfb\xff\xea\xff\xec\xff\xed\xff\xf9\xff\xea\xff\xf1\xff\xef\xff\xf4\xff\xe9\xff\xf4\xff\xf0\xff\xf4\xff\xea\xff\xf5\xff\xec\xff\xf6\xff\xef\xff\xf4\xff\xe9\xff\xf5\xff\xf1\xff\xf6\xff\xea\xff\xf2\xff\xee\xff\xf5\xff\xed\xff\xf1\xff\xec\xff\xf7\xff\xee\xff\xf0\xff\xe9\xff\xf8\xff\xed\xff\xf1\xff\xed\xff\xfc\xff\xed\xff\xf2\xff\xef\xff\xfb\xff\xee\xff\xf6\xff\xef\xff\xfb\xff\xf3\xff\xf9\xff\xef\xff\xfe\xff\xf7\xff\xfa\xff\xef\xff\xfd\xff\xf7\xff\xfd\xff\xf4\xff\xfe\xff\xf3\xff\x00\x00\xfa\xff\xfb\xff\xf5\xff\xff\xff\xf7\xff\xfb\xff\xf9\xff\xfd\xff\xf7\xff\xfe\xff\xf9\xff\xfa\xff\xfc\xff\xfd\xff\xf5\xff\xfe\xff\x01\x00\xfb\xff\xf4\xff\x02\x00\x02\x00\xfa\xff\xfc\xff\x05\x00\xfc\xff\xfa\xff\x01\x00\x05\x00\xfb\xff\xfe\xff\xff\xff\x06\x00\x00\x00\x01\x00\xfe\xff\a\x00\x02\x00\x04\x00\x00\x00\x05\x00\x00\x00\a\x00\x00\x00\b\x00\x02\x00\a\x00\xfd\xff\a\x00\b\x00\f\x00\xfd\xff\x03\x00\t\x00\x10\x00\x04\x00\x04\x00\x06\x00\x11\x00\f\x00\a\x00\x05\x00\x11\x00\r\x00\n\x00\t\x00\x10\x00\x0e\x00\v\x00\v\x00\x12\x00\x10\x00\x0e\x00\n\x00\x10\x00\x11\x00\x14\x00\x0e\x00\r\x00\x10\x00\x16\x00\x0f\x00\x12\x00\x12\x00\x12\x00\x0f\x00\x16\x00\x14\x00\x10\x00\x10\x00\x17\x00\x15\x00\x14\x00\x10\x00\x16\x00\x15\x00\x16\x00\x10\x00\x12\x00\x15\x00\x19\x00\x14\x00\x13\x00\x13\x00\x18\x00\x18\x00\x16\x00\x0f\x00\x15\x00\x1a\x00\x1b\x00\x11\x00\x14\x00\x1b\x00\x18\x00\x13\x00\x15\x00\x1a\x00\x17\x00\x18\x00\x18\x00\x16\x00\x16\x00\x1d\x00\x18\x00\x15\x00\x18\x00\x1e\x00\x17\x00\x17\x00\x1a\x00\x1e\x00\x16\x00\x1a\x00\x1b\x00\x1f\x00\x16\x00\x1b\x00\x1c\x00\x1e\x00\x19\x00 \x00\x1b\x00\x1b\x00\x1b\x00#\x00\x1a\x00\x1a\x00\x1d\x00!\x00\x1b\x00\x1b\x00\x1e\x00!\x00\x1b\x00\x1a\x00\x1e\x00\"\x00\x1a\x00\x18\x00\x1e\x00 \x00\x1e\x00\x1a\x00\x18\x00\x1d\x00!\x00\x1e\x00\x19\x00\x1b\x00\x1e\x00\x1f\x00\x1d\x00\x16\x00\x1e\x00#\x00\x1f\x00\x19\x00\x1e\x00!\x00#\x00\x1c\x00\x19\x00\x1d\x00&\x00!\x00\x1c\x00\x19\x00#\x00#\x00\x1f\x00\x18\x00\"\x00\x1e\x00\x1f\x00\x1e\x00!\x00\x1c\x00 \x00\x1e\x00\x1f\x00\x1d\x00 \x00\x1a\x00\x1f\x00\x1f\x00\x1e\x00\x1a\x00\x1e\x00\x1c\x00\x1a\x00\x1c\x00\x1e\x00\x1a\x00\x19\x00\x1c\x00\x1c\x00\x1a\x00\x17\x00\x1b\x00\x1c\x00\x19\x00\x16\x00\x1e\x00\x1b\x00\x16\x00\x16\x00\x1d\x00\x18\x00\x18\x00\x16\x00\x1a\x00\x18\x00\x1a\x00\x17\x00\x19\x00\x17\x00\x1e\x00\x1a\x00\x18\x00\x16\x00!\x00\x1c\x00\x1a\x00\x12\x00\x1e\x00\"\x00\x1f\x00\x0e\x00\x1a\x00%\x00\"\x00\x11\x00\x19\x00#\x00\"\x00\x17\x00\x1b\x00\x1e\x00$\x00\x19\x00\x1a\x00\x1c\x00\"\x00\x1b\x00\x1b\x00\x1a\x00 \x00\x1e\x00\x1b\x00\x13\x00\x1f\x00 \x00\x1a\x00\x13\x00\x1a\x00\x1d\x00\x1b\x00\x14\x00\x17\x00\x1a\x00\x17\x00\x12\x00\x19\x00\x18\x00\x14\x00\x15\x00\x18\x00\x14\x00\x16\x00\x14\x00\x12\x00\x13\x00\x17\x00\x12\x00\x13\x00\x12\x00\x13\x00\x12\x00\x17\x00\x0f\x00\x13\x00\x15\x00\x17\x00\x0e\x00\x13\x00\x12\x00\x17\x00\x13\x00\x14\x00\f\x00\x17\x00\x16\x00\x14\x00\x0e\x00\x15\x00\x10\x00\x17\x00\x14\x00\x13\x00\t\x00\x18\x00\x17\x00\x12\x00\b\x00\x17\x00\x17\x00\x13\x00\a\x00\x15\x00\x16\x00\x14\x00\x06\x00\x11\x00\x16\x00\x12\x00\x06\x00\x0e\x00\x13\x00\x10\x00\a\x00\f\x00\r\x00\x11\x00\n\x00\n\x00\t\x00\x12\x00\n\x00\t\x00\b\x00\x0e\x00\x06\x00\b\x00\b\x00\n\x00\x05\x00\v\x00\x04\x00\a\x00\b\x00\r\x00\x03\x00\x05\x00\x04\x00\r\x00\x06\x00\a\x00\x00\x00\t\x00\v\x00\t\x00\x00\x00\x06\x00\b\x00\b\x00\x02\x00\b\x00\x03\x00\x05\x00\x05\x00\b\x00\x00\x00\x03\x00\x02\x00\x02\x00\xfe\xff\x05\x00\x00\x00\xff\xff\xfc\xff\x04\x00\xfe\xff\xfc\xff\xf8\xff\x01\x00\xfc\xff\xf9\xff\xf7\xff\x00\x00\xfa\xff\xf5\xff\xf5\xff\xfd\xff\xf8\xff\xf7\xff\xf4\xff\xf9\xff\xf4\xff\xfa\xff\xf5\xff\xf6\xff\xf5\xff\xf9\xff\xf1\xff\xf6\xff\xf8\xff\xf8\xff\xec\xff\xf8\xff\xfb\xff\xf6\xff\xed\xff\xfa\xff\xf8\xff\xf2\xff\xed\xff\xfa\xff\xf4\xff\xf2\xff\xf2\xff\xf8\xff\xef\xff\xf3\xff\xf1\xff\xf9\xff\xee\xff\xf2\xff\xf3\xff\xf8\xff\xed\xff\xf2\xff\xf5\xff\xf6\xff\xeb\xff\xf4\xff\xf3\xff\xf2\xff\xf0\xff\xf5\xff\xef\xff\xf4\xff\xf1\xff\xf0\xff\xef\xff\xf5\xff\xf2\xff\xef\xff\xeb\xff\xf5\xff\xf1\xff\xee\xff\xec\xff\xf2\xff\xee\xff\xec\xff\xec\xff\xee\xff\xe8\xff\xed\xff\xed\xff\xec\xff\xe4\xff\xed\xff\xea\xff\xea\xff\xe3\xff\xec\xff\xe4\xff\xe8\xff\xe7\xff\xed\xff\xdf\xff\xe8\xff\xe5\xff\xeb\xff\xe1\xff\xe8\xff\xe3\xff\xe9\xff\xe1\xff\xec\xff\xdf\xff\xe5\xff\xe5\xff\xee\xff\xdc\xff\xe4\xff\xe4\xff\xef\xff\xde\xff\xe8\xff\xe3\xff\xe8\xff\xe2\xff\xed\xff\xde\xff\xe6\xff\xe3\xff\xeb\xff\xdf\xff\xea\xff\xe3\xff\xe6\xff\xdd\xff\xed\xff\xe1\xff\xe5\xff\xe1\xff\xee\xff\xdf\xff\xe6\xff\xe6\xff\xed\xff\xda\xff\xe8\xff\xe9\xff\xe8\xff\xdb\xff\xeb\xff\xe6\xff\xe8\xff\xde\xff\xeb\xff\xe4\xff\xe8\xff\xdc\xff\xea\xff\xe7\xff\xe9\xff\xd8\xff\xe9\xff\xe7\xff\xe9\xff\xda\xff\xe7\xff\xe1\xff\xe7\xff\xe0\xff\xe8\xff\xdb\xff\xe6\xff\xe1\xff\xe6\xff\xda\xff\xe5\xff\xe0\xff\xe5\xff\xdb\xff\xe5\xff\xe0\xff\xe6\xff\xdb\xff\xe4\xff\xe1\xff\xe8\xff\xdd\xff\xe2\xff\xde\xff\xe7\xff\xe1\xff\xe2\xff\xe0\xff\xe5\xff\xe0\xff\xe2\xff\xe1\xff\xe2\xff\xdd\xff\xe0\xff\xe4\xff\xe3\xff\xe0\xff\xdd\xff\xe3\xff\xe9\xff\xe0\xff\xdc\xff\xe2\xff\xe5\xff\xe2\xff\xe1\xff\xe0\xff\xe3\xff\xe2\xff\xe5\xff\xdf\xff\xe2\xff\xe6\xff\xe3\xff\xe0\xff\xe6\xff\xe1\xff\xe1\xff\xe4\xff\xeb\xff\xe0\xff\xe2\xff\xe6\xff\xe7\xff\xe0\xff\xe8\xff\xe6\xff\xe4\xff\xe0\xff\xeb\xff\xe6\xff\xe5\xff\xe0\xff\xe7\xff\xe4\xff\xe8\xff\xe0\xff\xe5\xff\xe4\xff\xe8\xff\xdf\xff\xe5\xff\xe8\xff\xe7\xff\xdc\xff\xe8\xff\xe9\xff\xe4\xff\xde\xff\xe7\xff\xe7\xff\xe8\xff\xdd\xff\xe2\xff\xeb\xff\xed\xff\xdb\xff\xdf\xff\xec\xff\xee\xff\xe0\xff\xe3\xff\xea\xff\xea\xff\xe6\xff\xe8\xff\xea\xff\xeb\xff\xe9\xff\xe8\xff\xea\xff\xeb\xff\xee\xff\xec\xff\xe8\xff\xe9\xff\xf1\xff\xf1\xff\xe9\xff\xe6\xff\xf1\xff\xef\xff\xeb\xff\xe7\xff\xef\xff\xeb\xff\xeb\xff\xec\xff\xf3\xff\xea\xff\xe9\xff\xec\xff\xf3\xff\xeb\xff\xeb\xff\xe9\xff\xf0\xff\xed\xff\xec\xff\xeb\xff\xee\xff\xec\xff\xec\xff\xea\xff\xef\xff\xec\xff\xed\xff\xeb\xff\xef\xff\xef\xff\xee\xff\xed\xff\xef\xff\xf1\xff\xf1\xff\xee\xff\xf0\xff\xf2\xff\xf1\xff\xf2\xff\xf1\xff\xf1\xff\xf5\xff\xf8\xff\xf5\xff\xef\xff\xf6\xff\xf7\xff\xf6\xff\xf3\xff\xfb\xff\xfa\xff\xf8\xff\xf6\xff\x00\x00\xfb\xff\xfb\xff\xf8\xff\xfe\xff\xfb\xff\x01\x00\xfa\xff\xfa\xff\xfa\xff\x01\x00\xfb\xff\xfc\xff\xfa\xff\x01\x00\xfa\xff\xff\xff\xfa\xff\xff\xff\xfd\xff\x01\x00\xf9\xff\xfc\xff\xfd\xff\x00\x00\xfb\xff\xfe\xff\xfb\xff\xfe\xff\xfd\xff\x00\x00\xfb\xff\xfc\xff\xfd\xff\x00\x00\xfc\xff\xfc\xff\xfe\xff\x03\x00\xfc\xff\xfc\xff\x00\x00\x03\x00\xfd\xff\x01\x00\x02\x00\x00\x00\xfc\xff\x06\x00\x04\x00\x04\x00\xfd\xff\x01\x00\x05\x00\n\x00\xfe\xff\x02\x00\x04\x00\v\x00\x02\x00\a\x00\x03\x00\b\x00\x02\x00\a\x00\x03\x00\v\x00\x05\x00\b\x00\x04\x00\v\x00\x06\x00\v\x00\x04\x00\t\x00\a\x00\r\x00\x04\x00\n\x00\b\x00\v\x00\x04\x00\t\x00\b\x00\f\x00\a\x00\b\x00\t\x00\x0e\x00\b\x00\b\x00\v\x00\r\x00\t\x00\v\x00\n\x00\f\x00\v\x00\r\x00\t\x00\x10\x00\r\x00\t\x00\b\x00\x14\x00\x11\x00\f\x00\x06\x00\x11\x00\x12\x00\x12\x00\t\x00\x0e\x00\x0e\x00\x13\x00\x0e\x00\x11\x00\n\x00\x13\x00\x10\x00\x12\x00\t\x00\x14\x00\x0f\x00\x0f\x00\f\x00\x17\x00\r\x00\x0f\x00\x0f\x00\x15\x00\v\x00\x10\x00\x0f\x00\x14\x00\v\x00\x14\x00\x10\x00\x13\x00\t\x00\x15\x00\x11\x00\x11\x00\v\x00\x18\x00\x0f\x00\x14\x00\v\x00\x16\x00\x10\x00\x17\x00\v\x00\x14\x00\x14\x00\x17\x00\t\x00\x17\x00\x13\x00\x15\x00\v\x00\x1a\x00\x13\x00\x16\x00\x0f\x00\x18\x00\x11\x00\x18\x00\x12\x00\x16\x00\x11\x00\x1a\x00\x12\x00\x16\x00\x11\x00\x19\x00\x16\x00\x15\x00\x10\x00\x19\x00\x18\x00\x17\x00\x13\x00\x1b\x00\x14\x00\x16\x00\x19\x00\x1a\x00\x13\x00\x16\x00\x19\x00\x19\x00\x15\x00\x18\x00\x1a\x00\x1a\x00\x17\x00\x19\x00\x1a\x00\x1a\x00\x19\x00\x1b\x00\x15\x00\x1b\x00\x1d\x00\x1a\x00\x17\x00\x1d\x00\x1d\x00\x1d\x00\x17\x00\x1b\x00\x1a\x00!\x00\x1a\x00\x18\x00\x18\x00\x1f\x00\x1a\x00\x1d\x00\x17\x00\x19\x00\x19\x00\x1e\x00\x18\x00\x17\x00\x18\x00\x1f\x00\x18\x00\x18\x00\x17\x00\x1c\x00\x19\x00\x1a\x00\x14\x00\x19\x00\x1a\x00\x1e\x00\x16\x00\x17\x00\x19\x00\x1f\x00\x19\x00\x18\x00\x17\x00\x1b\x00\x1a\x00\x1a\x00\x18\x00\x1e\x00\x1b\x00\x19\x00\x18\x00\x1f\x00\x1c\x00\x1a\x00\x15\x00\x1c\x00 \x00\x1f\x00\x16\x00\x1a\x00\x1e\x00\x1c\x00\x18\x00\x1e\x00\x1a\x00\x1b\x00\x19\x00\x1c\x00\x18\x00\x1d\x00\x1a\x00\x17\x00\x15\x00\"\x00\x1b\x00\x17\x00\x11\x00\x1e\x00\x1e\x00\x1a\x00\x0e\x00\x19\x00\x1b\x00\x1c\x00\x11\x00\x19\x00\x16\x00\x18\x00\x15\x00\x1c\x00\x14\x00\x14\x00\x11\x00\x1c\x00\x18\x00\x13\x00\x10\x00\x1b\x00\x1a\x00\x12\x00\x11\x00\x1d\x00\x17\x00\x0f\x00\x15\x00\x1c\x00\x15\x00\x12\x00\x17\x00\x1b\x00\x13\x00\x13\x00\x19\x00\x1b\x00\x14\x00\x10\x00\x19\x00\x1a\x00\x14\x00\x15\x00\x17\x00\x13\x00\x14\x00\x19\x00\x14\x00\x11\x00\x11\x00\x16\x00\x15\x00\x15\x00\r\x00\x14\x00\x14\x00\x12\x00\x0e\x00\x17\x00\x0f\x00\x0e\x00\x15\x00\x17\x00\n\x00\x0e\x00\x15\x00\x12\x00\b\x00\x0f\x00\x12\x00\x11\x00\v\x00\x0f\x00\x0e\x00\x11\x00\v\x00\v\x00\x0e\x00\x14\x00\f\x00\t\x00\x0e\x00\x15\x00\r\x00\t\x00\r\x00\x10\x00\r\x00\v\x00\x10\x00\x0f\x00\t\x00\f\x00\r\x00\x0f\x00\f\x00\t\x00\b\x00\x11\x00\x0e\x00\a\x00\a\x00\x11\x00\n\x00\b\x00\n\x00\v\x00\a\x00\t\x00\v\x00\r\x00\x04\x00\x04\x00\b\x00\x10\x00\x04\x00\x03\x00\x05\x00\x0e\x00\x04\x00\x05\x00\x05\x00\v\x00\x00\x00\b\x00\a\x00\x06\x00\x01\x00\t\x00\t\x00\x02\x00\xfe\xff\n\x00\t\x00\x01\x00\xfc\xff\n\x00\t\x00\xff\xff\x00\x00\t\x00\x02\x00\x02\x00\x04\x00\x04\x00\x02\x00\x06\x00\x02\x00\x00\x00\x05\x00\x02\x00\xfe\xff\x03\x00\x05\x00\xfe\xff\xfe\xff\x04\x00\x00\x00\x00\x00\x01\x00\x04\x00\xf9\xff\xff\xff\x01\x00\x01\x00\xf7\xff\xfe\xff\x01\x00\x00\x00\xf7\xff\xff\xff\x00\x00\xfd\xff\xf6\xff\xfe\xff\xfd\xff\xfb\xff\xf5\xff\x00\x00\xfa\xff\xf8\xff\xf3\xff\xff\xff\xfc\xff\xf9\xff\xf1\xff\xfd\xff\xfe\xff\xfd\xff\xf3\xff\xf7\xff\xf9\xff\x00\x00\xfb\xff\xf6\xff\xf1\xff\xfe\xff\xfd\xff\xf9\xff\xf0\xff\xf9\xff\xf9\xff\xfb\xff\xf5\xff\xf6\xff\xf3\xff\xfb\xff\xf7\xff\xf8\xff\xf4\xff\xf9\xff\xf6\xff\xf7\xff\xf5\xff\xf7\xff\xf3\xff\xf6\xff\xf6\xff\xf6\xff\xef\xff\xf3\xff\xf6\xff\xf7\xff\xee\xff\xf1\xff\xf5\xff\xf9\xff\xf0\xff\xf0\xff\xf3\xff\xf7\xff\xf0\xff\xf0\xff\xf0\xff\xf6\xff\xf5\xff\xee\xff\xeb\xff\xf5\xff\xf3\xff\xf0\xff\xeb\xff\xf3\xff\xf1\xff\xf2\xff\xee\xff\xf3\xff\xee\xff\xf0\xff\xf0\xff\xf6\xff\xec\xff\xec\xff\xf1\xff\xf8\xff\xeb\xff\xe9\xff\xf0\xff\xf5\xff\xeb\xff\xea\xff\xec\xff\xf4\xff\xed\xff\xea\xff\xe9\xff\xf1\xff\xee\xff\xec\xff\xea\xff\xec\xff\xe8\xff\xf0\xff\xee\xff\xea\xff\xe4\xff\xec\xff\xee\xff\xec\xff\xe5\xff\xe9\xff\xed\xff\xec\xff\xe5\xff\xea\xff\xeb\xff\xec\xff\xe6\xff\xe9\xff\xeb\xff\xef\xff\xe8\xff\xe9\xff\xea\xff\xf0\xff\xe8\xff\xe6\xff\xeb\xff\xf3\xff\xe9\xff\xe4\xff\xea\xff\xf5\xff\xea\xff\xe5\xff\xea\xff\xf2\xff\xe8\xff\xe8\xff\xeb\xff\xef\xff\xe6\xff\xe9\xff\xea\xff\xef\xff\xe8\xff\xe7\xff\xe9\xff\xeb\xff\xe7\xff\xea\xff\xeb\xff\xe8\xff\xe6\xff\xeb\xff\xed\xff\xea\xff\xe6\xff\xe6\xff\xed\xff\xeb\xff\xe8\xff\xe6\xff\xec\xff\xe9\xff\xec\xff\xe6\xff\xec\xff\xeb\xff\xed\xff\xe5\xff\xef\xff\xeb\xff\xeb\xff\xe7\xff\xf2\xff\xea\xff\xec\xff\xea\xff\xf1\xff\xe9\xff\xed\xff\xea\xff\xee\xff\xe9\xff\xef\xff\xeb\xff\xef\xff\xe8\xff\xee\xff\xee\xff\xee\xff\xe4\xff\xee\xff\xef\xff\xef\xff\xe4\xff\xed\xff\xec\xff\xef\xff\xe6\xff\xe9\xff\xea\xff\xf1\xff\xe7\xff\xeb\xff\xe8\xff\xf0\xff\xea\xff\xed\xff\xe6\xff\xef\xff\xea\xff\xec\xff\xe9\xff\xee\xff\xe5\xff\xee\xff\xec\xff\xef\xff\xe4\xff\xf0\xff\xea\xff\xee\xff\xea\xff\xef\xff\xe6\xff\xf2\xff\xec\xff\xf2\xff\xe9\xff\xf0\xff\xec\xff\xf2\xff\xeb\xff\xf0\xff\xea\xff\xf4\xff\xed\xff\xf2\xff\xe8\xff\xf1\xff\xef\xff\xf6\xff\xe6\xff\xef\xff\xee\xff\xf7\xff\xea\xff\xf3\xff\xec\xff\xf2\xff\xeb\xff\xf8\xff\xec\xff\xec\xff\xe9\xff\xfb\xff\xed\xff\xef\xff\xea\xff\xf7\xff\xec\xff\xf4\xff\xee\xff\xf2\xff\xeb\xff\xf8\xff\xee\xff\xf1\xff\xee\xff\xf9\xff\xee\xff\xf4\xff\xee\xff\xf8\xff\xf1\xff\xf7\xff\xed\xff\xf7\xff\xf5\xff\xf8\xff\xec\xff\xfa\xff\xf6\xff\xf9\xff\xf2\xff\xfb\xff\xf3\xff\xf9\xff\xf7\xff\xfc\xff\xef\xff\xf8\xff\xf7\xff\x00\x00\xf3\xff\xf6\xff\xf6\xff\xff\xff\xf1\xff\xf8\xff\xf7\xff\xfc\xff\xf3\xff\xfa\xff\xf6\xff\xfd\xff\xf5\xff\xf9\xff\xf1\xff\xfe\xff\xfb\xff\xfc\xff\xef\xff\xfa\xff\xfc\xff\xff\xff\xf1\xff\xfa\xff\xfa\xff\xfc\xff\xf5\xff\x00\x00\xf8\xff\xf8\xff\xf6\xff\x03\x00\xfa\xff\xf9\xff\xf5\xff\x01\x00\xfc\xff\xfb\xff\xf8\xff\x02\x00\xfa\xff\xfe\xff\xfe\xff\x00\x00\xf9\xff\xff\xff\xfa\xff\x00\x00\xfb\xff\x00\x00\xfc\xff\x00\x00\xfc\xff\x02\x00\xfb\xff\x00\x00\xfe\xff\x04\x00\xf8\xff\x03\x00\x00\x00\x02\x00\xf8\xff\x05\x00\xff\xff\x01\x00\xfa\xff\x05\x00\x00\x00\x01\x00\xfa\xff\a\x00\xfe\xff\x00\x00\xfd\xff\x06\x00\xfc\xff\x06\x00\x00\x00\x05\x00\x00\x00\n\x00\xfc\xff\x04\x00\x06\x00\a\x00\xfa\xff\n\x00\b\x00\x05\x00\xfe\xff\n\x00\x04\x00\x05\x00\x01\x00\n\x00\x01\x00\x06\x00\x02\x00\r\x00\x03\x00\x05\x00\x00\x00\v\x00\a\x00\n\x00\x00\x00\b\x00\x05\x00\x0e\x00\x05\x00\x06\x00\x02\x00\f\x00\a\x00\n\x00\x05\x00\b\x00\x04\x00\x0e\x00\b\x00\b\x00\x05\x00\f\x00\n\x00\f\x00\x05\x00\v\x00\t\x00\v\x00\n\x00\r\x00\b\x00\n\x00\n\x00\x0f\x00\v\x00\v\x00\a\x00\x0f\x00\v\x00\r\x00\n\x00\f\x00\t\x00\x10\x00\x0e\x00\x0e\x00\x06\x00\x10\x00\x11\x00\x0f\x00\a\x00\x11\x00\x10\x00\r\x00\n\x00\x11\x00\f\x00\x0f\x00\r\x00\x0f\x00\f\x00\x11\x00\x0e\x00\r\x00\f\x00\x10\x00\x0e\x00\x12\x00\t\x00\x0f\x00\x13\x00\x13\x00\t\x00\x11\x00\x10\x00\x0e\x00\x10\x00\x17\x00\f\x00\v\x00\x0f\x00\x17\x00\x13\x00\x0f\x00\t\x00\x10\x00\x19\x00\x13\x00\v\x00\r\x00\x15\x00\x16\x00\x0e\x00\v\x00\x16\x00\x17\x00\r\x00\n\x00\x16\x00\x17\x00\x0f\x00\r\x00\x15\x00\x13\x00\x11\x00\x0f\x00\x13\x00\x13\x00\x12\x00\x0f\x00\x12\x00\x12\x00\x13\x00\x10\x00\x11\x00\x15\x00\x11\x00\r\x00\x15\x00\x17\x00\x0e\x00\x0f\x00\x18\x00\x11\x00\f\x00\x14\x00\x15\x00\x0f\x00\x0f\x00\x14\x00\x13\x00\x10\x00\x11\x00\x14\x00\x12\x00\x0f\x00\x14\x00\x17\x00\x0e\x00\f\x00\x18\x00\x19\x00\x0e\x00\v\x00\x18\x00\x19\x00\x11\x00\r\x00\x12\x00\x16\x00\x13\x00\x10\x00\x12\x00\x14\x00\x16\x00\x10\x00\x0f\x00\x14\x00\x19\x00\x11\x00\x10\x00\x13\x00\x18\x00\x12\x00\x11\x00\x12\x00\x19\x00\x14\x00\x10\x00\x13\x00\x1a\x00\x11\x00\x0f\x00\x13\x00\x18\x00\x12\x00\x12\x00\x14\x00\x18\x00\x13\x00\x0f\x00\x11\x00\x1a\x00\x15\x00\x0f\x00\x0f\x00\x19\x00\x18\x00\x12\x00\v\x00\x13\x00\x17\x00\x14\x00\x0e\x00\x13\x00\x12\x00\x14\x00\x11\x00\x11\x00\x0e\x00\x12\x00\x13\x00\x14\x00\f\x00\x12\x00\x11\x00\x14\x00\x0e\x00\x11\x00\x10\x00\x12\x00\x0e\x00\x14\x00\f\x00\x0e\x00\x12\x00\x16\x00\b\x00\f\x00\x13\x00\x17\x00\b\x00\r\x00\x0f\x00\x14\x00\x0f\x00\x10\x00\t\x00\x11\x00\x13\x00\x12\x00\a\x00\r\x00\x12\x00\x11\x00\v\x00\x11\x00\f\x00\r\x00\x0e\x00\x13\x00\f\x00\r\x00\f\x00\x11\x00\x0e\x00\x11\x00\r\x00\x0e\x00\f\x00\x10\x00\x0e\x00\x0e\x00\n\x00\x0f\x00\x0e\x00\x0f\x00\n\x00\f\x00\f\x00\x12\x00\n\x00\v\x00\t\x00\x12\x00\r\x00\v\x00\x05\x00\x10\x00\x0f\x00\v\x00\x05\x00\x10\x00\f\x00\v\x00\n\x00\f\x00\x04\x00\f\x00\x0e\x00\f\x00\x01\x00\t\x00\f\x00\x0e\x00\x04\x00\b\x00\t\x00\n\x00\x03\x00\f\x00\v\x00\a\x00\xfe\xff\f\x00\r\x00\x06\x00\x00\x00\v\x00\n\x00\b\x00\x02\x00\b\x00\x05\x00\x06\x00\x04\x00\n\x00\x03\x00\x04\x00\x04\x00\n\x00\x02\x00\x06\x00\x02\x00\x03\x00\x04\x00\v\x00\xff\xff\xfd\xff\x02\x00\f\x00\x02\x00\xfc\xff\xff\xff\t\x00\x04\x00\xfd\xff\xfe\xff\a\x00\x00\x00\xff\xff\x02\x00\x06\x00\xfe\xff\xff\xff\x02\x00\x03\x00\xfe\xff\xff\xff\x01\x00\x01\x00\xfc\xff\xff\xff\x02\x00\xff\xff\xfb\xff\x00\x00\xfe\xff\xfe\xff\x01\x00\x00\x00\xf8\xff\xfe\xff\x00\x00\xfe\xff\xfb\xff\xfe\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xf9\xff\xfc\xff\xfd\xff\xfd\xff\xfb\xff\xfc\xff\xfc\xff\xfb\xff\xfa\xff\xfd\xff\xfb\xff\xfc\xff\xf9\xff\xfc\xff\xf9\xff\xf9\xff\xf9\xff\xfd\xff\xf8\xff\xf5\xff\xfa\xff\xfb\xff\xf6\xff\xf6\xff\xf5\xff\xf9\xff\xf8\xff\xf9\xff\xf4\xff\xf8\xff\xf8\xff\xf6\xff\xf3\xff\xfb\xff\xf6\xff\xf4\xff\xf3\xff\xfa\xff\xf4\xff\xf5\xff\xf5\xff\xf9\xff\xf1\xff\xf4\xff\xf6\xff\xfa\xff\xf0\xff\xf2\xff\xf4\xff\xfb\xff\xf1\xff\xf2\xff\xf3\xff\xfa\xff\xf1\xff\xf3\xff\xf3\xff\xf8\xff\xf0\xff\xf5\xff\xf5\xff\xf3\xff\xed\xff\xf8\xff\xf6\xff\xf1\xff\xeb\xff\xf8\xff\xf6\xff\xf2\xff\xec\xff\xf6\xff\xf2\xff\xf1\xff\xee\xff\xf5\xff\xef\xff\xf0\xff\xf2\xff\xf4\xff\xeb\xff\xf0\xff\xf4\xff\xf3\xff\xec\xff\xf0\xff\xf0\xff\xf1\xff\xf0\xff\xf2\xff\xee\xff\xee\xff\xee\xff\xf3\xff\xf0\xff\xee\xff\xed\xff\xf2\xff\xef\xff\xef\xff\xf1\xff\xf2\xff\xeb\xff\xee\xff\xf4\xff\xf2\xff\xeb\xff\xef\xff\xf2\xff\xf2\xff\xee\xff\xef\xff\xee\xff\xf2\xff\xef\xff\xef\xff\xef\xff\xf2\xff\xf0\xff\xed\xff\xeb\xff\xf5\xff\xf3\xff\xe9\xff\xeb\xff\xf7\xff\xf1\xff\xe7\xff\xed\xff\xf7\xff\xee\xff\xeb\xff\xef\xff\xf2\xff\xec\xff\xef\xff\xf0\xff\xf0\xff\xed\xff\xf2\xff\xef\xff\xee\xff\xee\xff\xf0\xff\xef\xff\xee\xff\xed\xff\xf0\xff\xef\xff\xee\xff\xef\xff\xee\xff\xee\xff\xf0\xff\xef\xff\xee\xff\xee\xff\xee\xff\xef\xff\xf1\xff\xee\xff\xeb\xff\xf0\xff\xf2\xff\xed\xff\xee\xff\xf0\xff\xec\xff\xef\xff\xf3\xff\xef\xff\xec\xff\xef\xff\xf0\xff\xf1\xff\xee\xff\xef\xff\xef\xff\xef\xff\xee\xff\xf1\xff\xee\xff\xed\xff\xed\xff\xf4\xff\xef\xff\xec\xff\xeb\xff\xf5\xff\xf2\xff\xec\xff\xe9\xff\xf3\xff\xf0\xff\xee\xff\xee\xff\xf0\xff\xee\xff\xf3\xff\xf1\xff\xeb\xff\xeb\xff\xf4\xff\xf1\xff\xee\xff\xef\xff\xf0\xff\xed\xff\xf1\xff\xf3\xff\xee\xff\xed\xff\xf0\xff\xf1\xff\xf1\xff\xf0\xff\xee\xff\xf0\xff\xf3\xff\xf0\xff\xed\xff\xf0\xff\xf4\xff\xf0\xff\xef\xff\xed\xff\xf1\xff\xf2\xff\xf1\xff\xee\xff\xf0\xff\xf3\xff\xf3\xff\xee\xff\xf2\xff\xf1\xff\xf0\xff\xf0\xff\xf3\xff\xee\xff\xf1\xff\xf3\xff\xf3\xff\xef\xff\xf1\xff\xf0\xff\xf3\xff\xf0\xff\xf1\xff\xf2\xff\xf4\xff\xed\xff\xf3\xff\xf6\xff\xf2\xff\xf0\xff\xf6\xff\xf1\xff\xef\xff\xf4\xff\xf8\xff\xf1\xff\xef\xff\xf4\xff\xf8\xff\xf4\xff\xf1\xff\xf2\xff\xf6\xff\xf4\xff\xf4\xff\xf5\xff\xf5\xff\xf4\xff\xf6\xff\xf7\xff\xf6\xff\xf3\xff\xf5\xff\xf7\xff\xf6\xff\xf5\xff\xf8\xff\xf7\xff\xf5\xff\xf7\xff\xf9\xff\xf5\xff\xf8\xff\xf9\xff\xf8\xff\xf5\xff\xfc\xff\xf9\xff\xf4\xff\xf6\xff\xfe\xff\xfa\xff\xf5\xff\xf5\xff\xfd\xff\xff\xff\xfa\xff\xf1\xff\xfa\xff\x00\x00\xfd\xff\xf2\xff\xf9\xff\xfe\xff\xfd\xff\xf4\xff\xfd\xff\xfb\xff\xfc\xff\xf7\xff\xff\xff\xfb\xff\xfa\xff\xf6\xff\x02\x00\xfe\xff\xf9\xff\xf4\xff\x03\x00\x00\x00\xf9\xff\xf4\xff\x05\x00\xff\xff\xf9\xff\xf6\xff\x04\x00\xff\xff\xfc\xff\xfa\xff\x01\x00\xfd\xff\xfe\xff\xfd\xff\x02\x00\xfc\xff\xfd\xff\xfe\xff\x03\x00\xfe\xff\xff\xff\xfd\xff\x02\x00\x00\x00\xff\xff\xfd\xff\x04\x00\x01\x00\x00\x00\xff\xff\x02\x00\x01\x00\x01\x00\xff\xff\x03\x00\x04\x00\x02\x00\x00\x00\x06\x00\x06\x00\x03\x00\x01\x00\x05\x00\x05\x00\b\x00\x03\x00\x02\x00\t\x00\f\x00\x03\x00\x03\x00\x05\x00\n\x00\b\x00\b\x00\x00\x00\b\x00\r\x00\a\x00\xff\xff\f\x00\f\x00\x04\x00\x01\x00\f\x00\n\x00\a\x00\x03\x00\t\x00\b\x00\t\x00\x05\x00\n\x00\x06\x00\b\x00\x05\x00\n\x00\a\x00\b\x00\a\x00\n\x00\x06\x00\v\x00\n\x00\t\x00\b\x00\r\x00\a\x00\t\x00\x0f\x00\x0f\x00\x04\x00\n\x00\x0f\x00\x10\x00\t\x00\b\x00\t\x00\x13\x00\x10\x00\t\x00\b\x00\x10\x00\x0f\x00\x0e\x00\v\x00\r\x00\n\x00\x0e\x00\x11\x00\x11\x00\t\x00\x0e\x00\x10\x00\x0f\x00\v\x00\x12\x00\r\x00\t\x00\n\x00\x16\x00\x0f\x00\n\x00\n\x00\x12\x00\r\x00\x0f\x00\x0f\x00\x0e\x00\v\x00\x10\x00\x0e\x00\x0f\x00\x10\x00\x10\x00\v\x00\x10\x00\x0f\x00\x0f\x00\x0f\x00\x10\x00\x0e\x00\x10\x00\x12\x00\x0e\x00\x0e\x00\x15\x00\x11\x00\r\x00\x0f\x00\x14\x00\x12\x00\x0f\x00\x10\x00\x11\x00\x11\x00\x13\x00\x0f\x00\r\x00\x13\x00\x14\x00\x0e\x00\x0e\x00\x14\x00\x12\x00\x0e\x00\x12\x00\x14\x00\x0f\x00\v\x00\x12\x00\x17\x00\x11\x00\n\x00\x13\x00\x17\x00\x10\x00\x0e\x00\x14\x00\x13\x00\x0f\x00\x10\x00\x15\x00\x11\x00\x0f\x00\x10\x00\x12\x00\x13\x00\x14\x00\x0f\x00\x0f\x00\x13\x00\x17\x00\x12\x00\x0e\x00\x0f\x00\x17\x00\x17\x00\x11\x00\f\x00\x13\x00\x18\x00\x13\x00\r\x00\x11\x00\x16\x00\x15\x00\x13\x00\x0f\x00\x12\x00\x17\x00\x14\x00\x0e\x00\x12\x00\x15\x00\x12\x00\x12\x00\x15\x00\x0f\x00\x12\x00\x16\x00\x12\x00\r\x00\x16\x00\x15\x00\x0f\x00\f\x00\x14\x00\x13\x00\x11\x00\r\x00\x12\x00\x10\x00\x12\x00\x0e\x00\x10\x00\x0f\x00\x0f\x00\x0e\x00\x12\x00\x10\x00\x10\x00\x0f\x00\x11\x00\x0f\x00\x0f\x00\r\x00\x12\x00\x0e\x00\x0e\x00\x0f\x00\x13\x00\x0e\x00\x11\x00\x0f\x00\x10\x00\f\x00\x12\x00\x11\x00\x12\x00\f\x00\x10\x00\x12\x00\x13\x00\f\x00\x0f\x00\x0f\x00\x11\x00\x0f\x00\x12\x00\v\x00\x0e\x00\x11\x00\x12\x00\t\x00\x0e\x00\x10\x00\x12\x00\t\x00\x0e\x00\x10\x00\x0e\x00\b\x00\x11\x00\x0f\x00\f\x00\b\x00\x10\x00\f\x00\r\x00\v\x00\v\x00\b\x00\x10\x00\f\x00\n\x00\x06\x00\f\x00\r\x00\x0e\x00\x06\x00\t\x00\v\x00\r\x00\x06\x00\n\x00\v\x00\x06\x00\x05\x00\x10\x00\n\x00\x02\x00\x04\x00\x10\x00\a\x00\x05\x00\a\x00\n\x00\x04\x00\n\x00\b\x00\a\x00\x03\x00\x06\x00\a\x00\t\x00\x05\x00\x03\x00\x02\x00\b\x00\x05\x00\x03\x00\x03\x00\x05\x00\x03\x00\x05\x00\x03\x00\x01\x00\x01\x00\x05\x00\x02\x00\x01\x00\x00\x00\x03\x00\x04\x00\x02\x00\xfc\xff\x01\x00\x05\x00\x04\x00\xfb\xff\xff\xff\x02\x00\x02\x00\xfe\xff\x02\x00\xfd\xff\x01\x00\x01\x00\x03\x00\xfb\xff\xff\xff\xfe\xff\x04\x00\xfd\xff\xfe\xff\xfd\xff\x06\x00\xfc\xff\xfd\xff\xfc\xff\x05\x00\xfd\xff\xfc\xff\xfa\xff\x04\x00\xfe\xff\xfc\xff\xfb\xff\x03\x00\xfa\xff\xfa\xff\xfa\xff\xfe\xff\xfc\xff\xfe\xff\xf7\xff\xfc\xff\xfd\xff\xfe\xff\xf8\xff\xfd\xff\xf9\xff\xfa\xff\xfa\xff\xfd\xff\xf8\xff\xfb\xff\xfa\xff\xfb\xff\xf7\xff\xfc\xff\xfa\xff\xfb\xff\xf4\xff\xf9\xff\xfa\xff\xfc\xff\xf5\xff\xf9\xff\xf8\xff\xfa\xff\xf8\xff\xfc\xff\xf3\xff\xf8\xff\xfa\xff\xfc\xff\xf2\xff\xf6\xff\xf5\xff\xfd\xff\xf7\xff\xf7\xff\xf0\xff\xfa\xff\xfa\xff\xf5\xff\xef\xff\xfc\xff\xf8\xff\xf3\xff\xf0\xff\xfd\xff\xf6\xff\xf3\xff\xf0\xff\xf8\xff\xf8\xff\xf5\xff\xed\xff\xf8\xff\xf8\xff\xf5\xff\xee\xff\xf7\xff\xf7\xff\xf5\xff\xf2\xff\xf6\xff\xf5\xff\xf5\xff\xf2\xff\xf5\xff\xf5\xff\xf8\xff\xf2\xff\xf2\xff\xf6\xff\xfc\xff\xf0\xff\xef\xff\xf8\xff\xfb\xff\xef\xff\xf0\xff\xf6\xff\xf9\xff\xf3\xff\xf2\xff\xf2\xff\xf9\xff\xf4\xff\xf1\xff\xf1\xff\xf8\xff\xf4\xff\xf3\xff\xf1\xff\xf7\xff\xf3\xff\xf2\xff\xf1\xff\xf5\xff\xf1\xff\xf4\xff\xf0\xff\xef\xff\xf4\xff\xfa\xff\xef\xff\xe9\xff\xf3\xff\xfb\xff\xf0\xff\xeb\xff\xf0\xff\xf6\xff\xf2\xff\xee\xff\xf2\xff\xf3\xff\xf0\xff\xf4\xff\xf0\xff\xee\xff\xf2\xff\xf8\xff\xee\xff\xeb\xff\xf7\xff\xf4\xff\xec\xff\xf3\xff\xf6\xff\xee\xff\xec\xff\xf9\xff\xf7\xff\xeb\xff\xec\xff\xf8\xff\xf5\xff\xee\xff\xee\xff\xf5\xff\xf0\xff\xf0\xff\xf3\xff\xf1\xff\xed\xff\xef\xff\xf3\xff\xf1\xff\xee\xff\xf2\xff\xf1\xff\xee\xff\xee\xff\xf2\xff\xf0\xff\xf1\xff\xef\xff\xef\xff\xf0\xff\xf0\xff\xef\xff\xf1\xff\xef\xff\xef\xff\xee\xff\xf0\xff\xf1\xff\xf1\xff\xf0\xff\xee\xff\xf3\xff\xf2\xff\xec\xff\xef\xff\xf6\xff\xf2\xff\xed\xff\xef\xff\xf2\xff\xf3\xff\xf3\xff\xf1\xff\xee\xff\xef\xff\xf4\xff\xf4\xff\xf0\xff\xed\xff\xf4\xff\xf5\xff\xf0\xff\xf1\xff\xf5\xff\xf2\xff\xf1\xff\xf2\xff\xf1\xff\xef\xff\xf7\xff\xf2\xff\xec\xff\xf0\xff\xf9\xff\xf3\xff\xf0\xff\xee\xff\xf3\xff\xf3\xff\xf4\xff\xed\xff\xf1\xff\xf4\xff\xf5\xff\xef\xff\xf2\xff\xf2\xff\xf3\xff\xf0\xff\xf3\xff\xf2\xff\xf4\xff\xf0\xff\xf2\xff\xf3\xff\xf3\xff\xf2\xff\xf2\xff\xf0\xff\xf5\xff\xf5\xff\xf2\xff\xf0\xff\xf5\xff\xf4\xff\xf5\xff\xf1\xff\xf2\xff\xf4\xff\xfa\xff\xf4\xff\xf0\xff\xf1\xff\xfa\xff\xf5\xff\xf3\xff\xf1\xff\xf5\xff\xf5\xff\xf7\xff\xf3\xff\xf2\xff\xf5\xff\xf9\xff\xf3\xff\xf5\xff\xf3\xff\xf6\xff\xf5\xff\xf5\xff\xf3\xff\xfa\xff\xf8\xff\xf1\xff\xf3\xff\xfc\xff\xf6\xff\xf3\xff\xf7\xff\xf6\xff\xf3\xff\xfa\xff\xfc\xff\xf3\xff\xf2\xff\xfb\xff\xfb\xff\xf6\xff\xf5\xff\xf9\xff\xfa\xff\xf7\xff\xf6\xff\xf9\xff\xfa\xff\xf9\xff\xf7\xff\xf8\xff\xf8\xff\xfa\xff\xfa\xff\xf8\xff\xf6\xff\xf9\xff\xfb\xff\xfa\xff\xf5\xff\xf8\xff\xfd\xff\xfc\xff\xf5\xff\xf9\xff\xfa\xff\xfd\xff\xfb\xff\xfa\xff\xf4\xff\xff\xff\x02\x00\xf9\xff\xf3\xff\x00\x00\xff\xff\xf9\xff\xf8\xff\x00\x00\xfa\xff\xfc\xff\xfd\xff\xff\xff\xfa\xff\x00\x00\xfb\xff\xfc\xff\xfe\xff\x02\x00\xfa\xff\xfe\xff\x00\x00\x00\x00\xfb\xff\x02\x00\x00\x00\xfe\xff\xfb\xff\x02\x00\xff\xff\x01\x00\xff\xff\x01\x00\xfd\xff\x00\x00\x00\x00\x04\x00\xff\xff\xfe\xff\xfe\xff\x06\x00\x03\x00\x02\x00\xff\xff\x02\x00\x00\x00\x04\x00\x05\x00\x04\x00\xfc\xff\x01\x00\a\x00\n\x00\xfe\xff\xfd\xff\x05\x00\f\x00\x03\x00\x01\x00\xff\xff\a\x00\x06\x00\a\x00\x02\x00\x04\x00\x03\x00\n\x00\a\x00\x05\x00\x01\x00\t\x00\x06\x00\x04\x00\x03\x00\v\x00\x06\x00\x03\x00\x05\x00\f\x00\x05\x00\x05\x00\a\x00\v\x00\x02\x00\x06\x00\v\x00\n\x00\x02\x00\t\x00\t\x00\a\x00\x06\x00\f\x00\x05\x00\b\x00\n\x00\b\x00\x03\x00\f\x00\n\x00\a\x00\x03\x00\v\x00\b\x00\v\x00\a\x00\b\x00\x05\x00\r\x00\b\x00\v\x00\x05\x00\v\x00\n\x00\x0f\x00\x05\x00\n\x00\n\x00\x0f\x00\x04\x00\r\x00\n\x00\x0e\x00\a\x00\x11\x00\a\x00\v\x00\t\x00\x14\x00\t\x00\n\x00\a\x00\x13\x00\r\x00\x0e\x00\a\x00\x0e\x00\v\x00\x13\x00\f\x00\f\x00\b\x00\x0f\x00\r\x00\x12\x00\b\x00\v\x00\x0e\x00\x14\x00\n\x00\n\x00\x0f\x00\x12\x00\t\x00\f\x00\x0f\x00\x12\x00\n\x00\v\x00\r\x00\x14\x00\v\x00\n\x00\r\x00\x11\x00\n\x00\x0e\x00\r\x00\r\x00\v\x00\x12\x00\n\x00\v\x00\r\x00\x14\x00\n\x00\n\x00\n\x00\x16\x00\f\x00\n\x00\n\x00\x14\x00\f\x00\x0f\x00\v\x00\x0f\x00\v\x00\x14\x00\f\x00\v\x00\v\x00\x14\x00\r\x00\x0e\x00\f\x00\x10\x00\x0e\x00\x11\x00\n\x00\x0e\x00\x0e\x00\x10\x00\n\x00\x0f\x00\x0f\x00\f\x00\r\x00\x11\x00\r\x00\r\x00\f\x00\r\x00\x0e\x00\x0e\x00\f\x00\v\x00\f\x00\x13\x00\x10\x00\b\x00\x06\x00\x11\x00\x12\x00\v\x00\b\x00\x0f\x00\x10\x00\x10\x00\r\x00\t\x00\f\x00\x12\x00\r\x00\v\x00\r\x00\x10\x00\v\x00\r\x00\x0f\x00\x0e\x00\n\x00\r\x00\x0f\x00\x10\x00\v\x00\v\x00\x0e\x00\x10\x00\v\x00\f\x00\x11\x00\x0e\x00\t\x00\r\x00\x12\x00\f\x00\a\x00\x0e\x00\x11\x00\v\x00\n\x00\x0e\x00\f\x00\v\x00\r\x00\r\x00\v\x00\f\x00\r\x00\r\x00\f\x00\r\x00\v\x00\v\x00\f\x00\r\x00\f\x00\f\x00\v\x00\f\x00\f\x00\r\x00\n\x00\v\x00\f\x00\r\x00\v\x00\v\x00\n\x00\f\x00\f\x00\n\x00\n\x00\f\x00\f\x00\f\x00\v\x00\n\x00\r\x00\v\x00\b\x00\t\x00\x0f\x00\n\x00\x04\x00\b\x00\x10\x00\n\x00\x05\x00\x06\x00\f\x00\t\x00\b\x00\x06\x00\b\x00\x06\x00\n\x00\x05\x00\t\x00\b\x00\x04\x00\x02\x00\r\x00\b\x00\x03\x00\x02\x00\v\x00\a\x00\x04\x00\x03\x00\b\x00\x04\x00\x04\x00\x03\x00\n\x00\x04\x00\x03\x00\x02\x00\t\x00\x03\x00\x05\x00\x04\x00\x04\x00\x01\x00\t\x00\x04\x00\x02\x00\x02\x00\b\x00\x01\x00\x05\x00\x04\x00\x04\x00\x00\x00\a\x00\x02\x00\x04\x00\x02\x00\x04\x00\x01\x00\x05\x00\xff\xff\x05\x00\x04\x00\x02\x00\xfe\xff\x05\x00\x04\x00\x02\x00\xfe\xff\x03\x00\xff\xff\x02\x00\x02\x00\x04\x00\xfd\xff\x02\x00\x01\x00\x04\x00\xff\xff\x02\x00\xfe\xff\x02\x00\xff\xff\x04\x00\xff\xff\x00\x00\xff\xff\x02\x00\xfd\xff\x03\x00\x01\x00\xff\xff\xf9\xff\x04\x00\x03\x00\x01\x00\xf8\xff\xfe\xff\x03\x00\x05\x00\xf8\xff\xfd\xff\x02\x00\x01\x00\xf9\xff\xff\xff\x02\x00\x00\x00\xf7\xff\xff\xff\x02\x00\xff\xff\xf8\xff\xff\xff\xff\xff\xfd\xff\xfc\xff\x00\x00\xfa\xff\xfc\xff\xff\xff\x00\x00\xfb\xff\xfd\xff\xfd\xff\xfe\xff\xfb\xff\x00\x00\xfc\xff\xfb\xff\xfc\xff\x03\x00\xfa\xff\xf9\xff\xff\xff\x04\x00\xfa\xff\xf9\xff\xfd\xff\x00\x00\xfb\xff\xff\xff\xf9\xff\xfa\xff\xfd\xff\x04\x00\xf7\xff\xf7\xff\xff\xff\x02\x00\xf5\xff\xfa\xff\xfd\xff\xff\xff\xf6\xff\xfd\xff\xfb\xff\xfb\xff\xf5\xff\xfd\xff\xfb\xff\xfc\xff\xf6\xff\xfb\xff\xf9\xff\xfc\xff\xf9\xff\xfd\xff\xf5\xff\xf9\xff\xf9\xff\xfd\xff\xf5\xff\xf9\xff\xfa\xff\xfb\xff\xf4\xff\xfb\xff\xfb\xff\xfa\xff\xf2\xff\xfa\xff\xfd\xff\xfb\xff\xf1\xff\xf9\xff\xfd\xff\xfd\xff\xf6\xff\xf8\xff\xf7\xff\xff\xff\xfb\xff\xf7\xff\xf5\xff\xfe\xff\xf8\xff\xf9\xff\xf9\xff\xfc\xff\xf4\xff\xfc\xff\xfb\xff\xf9\xff\xf4\xff\xfd\xff\xfb\xff\xfa\xff\xf2\xff\xfb\xff\xfb\xff\xfa\xff\xf6\xff\xfd\xff\xf5\xff\xf8\xff\xf9\xff\xfc\xff\xf3\xff\xf9\xff\xf9\xff\xf9\xff\xf4\xff\xfc\xff\xf6\xff\xf8\xff\xf6\xff\xf8\xff\xf6\xff\xfc\xff\xf8\xff\xf7\xff\xf2\xff\xfa\xff\xfa\xff\xf8\xff\xf1\xff\xf8\xff\xf9\xff\xf9\xff\xf2\xff\xf7\xff\xf5\xff\xfa\xff\xf6\xff\xf4\xff\xf2\xff\xfe\xff\xf9\xff\xf3\xff\xef\xff\xfc\xff\xfa\xff\xf6\xff\xef\xff\xfa\xff\xf6\xff\xf8\xff\xf4\xff\xf7\xff\xf1\xff\xf8\xff\xf4\xff\xf8\xff\xf2\xff\xf7\xff\xf3\xff\xf8\xff\xf5\xff\xf6\xff\xf0\xff\xf9\xff\xf5\xff\xf4\xff\xf2\xff\xfb\xff\xf4\xff\xf3\xff\xf3\xff\xfb\xff\xf3\xff\xf5\xff\xf4\xff\xf8\xff\xf2\xff\xf7\xff\xf3\xff\xf9\xff\xf4\xff\xf7\xff\xf4\xff\xf7\xff\xf4\xff\xf7\xff\xf5\xff\xfa\xff\xf3\xff\xf6\xff\xf7\xff\xfa\xff\xf2\xff\xf7\xff\xf6\xff\xf5\xff\xf4\xff\xfd\xff\xf5\xff\xf0\xff\xf5\xff\x00\x00\xf4\xff\xf4\xff\xf4\xff\xf9\xff\xf6\xff\xf9\xff\xf5\xff\xf5\xff\xf6\xff\xfb\xff\xf5\xff\xf5\xff\xf3\xff\xfc\xff\xf7\xff\xf5\xff\xf4\xff\xfa\xff\xf7\xff\xf7\xff\xf3\xff\xf6\xff\xf9\xff\xfc\xff\xf2\xff\xf6\xff\xf9\xff\xfa\xff\xf6\xff\xf9\xff\xf5\xff\xf6\xff\xf9\xff\xfb\xff\xf5\xff\xf7\xff\xf8\xff\xfa\xff\xf8\xff\xf8\xff\xf8\xff\xf9\xff\xf8\xff\xfa\xff\xf9\xff\xfa\xff\xf8\xff\xfa\xff\xfa\xff\xfa\xff\xf7\xff\xfa\xff\xfa\xff\xf9\xff\xf8\xff\xfc\xff\xfb\xff\xf7\xff\xf8\xff\xfe\xff\xfa\xff\xf6\xff\xf8\xff\xff\xff\xfb\xff\xf7\xff\xfb\xff\xfc\xff\xf8\xff\xfa\xff\xfe\xff\xfb\xff\xf6\xff\xfd\xff\xff\xff\xfa\xff\xf9\xff\xfc\xff\xfb\xff\xfd\xff\xfe\xff\xfb\xff\xfa\xff\xfe\xff\xfe\xff\xfb\xff\xfd\xff\xff\xff\xfb\xff\xfc\xff\xff\xff\xff\xff\xfb\xff\xfc\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\xfe\xff\xff\xff\xfd\xff\xfd\xff\xff\xff\x01\x00\xfd\xff\xfb\xff\xfd\xff\x01\x00\x00\x00\xfd\xff\xfa\xff\x01\x00\x03\x00\xfc\xff\xfb\xff\x03\x00\xfd\xff\xfa\xff\x01\x00\x01\x00\xfa\xff\xfc\xff\x03\x00\x00\x00\xfb\xff\xfe\xff\x01\x00\xfe\xff\xfa\xff\x00\x00\x03\x00\xfb\xff\xfb\xff\x01\x00\x01\x00\xfb\xff\xfe\xff\x02\x00\x00\x00\xfb\xff\x01\x00\x02\x00\x00\x00\xf9\xff\x00\x00\x02\x00\x00\x00\xfe\xff\x02\x00\xfe\xff\x03\x00\x02\x00\x00\x00\xfc\xff\x05\x00\x01\x00\xfe\xff\x01\x00\x06\x00\xfe\xff\x00\x00\x03\x00\x04\x00\xfd\xff\x04\x00\x02\x00\x02\x00\x01\x00\x05\x00\xff\xff\x01\x00\x05\x00\x05\x00\xff\xff\x05\x00\x03\x00\x01\x00\x01\x00\a\x00\x01\x00\x01\x00\x00\x00\x04\x00\x05\x00\x04\x00\xff\xff\x04\x00\x04\x00\x02\x00\x01\x00\t\x00\x03\x00\xfe\xff\x02\x00\b\x00\x04\x00\x02\x00\x00\x00\x06\x00\x05\x00\x05\x00\x00\x00\x06\x00\a\x00\x06\x00\x00\x00\x06\x00\a\x00\b\x00\x02\x00\b\x00\x05\x00\x05\x00\x06\x00\v\x00\x03\x00\x06\x00\x06\x00\t\x00\x04\x00\n\x00\b\x00\b\x00\x03\x00\v\x00\r\x00\t\x00\x00\x00\f\x00\r\x00\b\x00\x05\x00\x0e\x00\b\x00\x06\x00\n\x00\x0f\x00\b\x00\t\x00\a\x00\f\x00\v\x00\r\x00\a\x00\n\x00\f\x00\r\x00\b\x00\f\x00\n\x00\v\x00\t\x00\x0e\x00\f\x00\r\x00\t\x00\n\x00\x0e\x00\x10\x00\b\x00\v\x00\x0e\x00\x0f\x00\b\x00\r\x00\r\x00\f\x00\v\x00\r\x00\v\x00\x10\x00\r\x00\v\x00\v\x00\x12\x00\f\x00\b\x00\x0f\x00\x15\x00\t\x00\x05\x00\x10\x00\x17\x00\b\x00\a\x00\x12\x00\x14\x00\x06\x00\v\x00\x14\x00\x10\x00\x06\x00\x0f\x00\x11\x00\f\x00\t\x00\x10\x00\x11\x00\x0e\x00\b\x00\x0e\x00\x12\x00\x0e\x00\x06\x00\x0e\x00\x10\x00\r\x00\a\x00\x12\x00\x11\x00\n\x00\b\x00\x11\x00\x0f\x00\f\x00\v\x00\x0e\x00\n\x00\x0f\x00\x0e\x00\f\x00\n\x00\x0f\x00\f\x00\r\x00\f\x00\x0e\x00\n\x00\v\x00\r\x00\x10\x00\n\x00\v\x00\f\x00\x10\x00\t\x00\n\x00\x0e\x00\x0f\x00\a\x00\t\x00\r\x00\x10\x00\t\x00\t\x00\t\x00\r\x00\x0e\x00\v\x00\x05\x00\n\x00\r\x00\f\x00\b\x00\b\x00\r\x00\x0f\x00\a\x00\x06\x00\x10\x00\x0e\x00\x03\x00\b\x00\x12\x00\r\x00\x03\x00\b\x00\x0f\x00\x0e\x00\x06\x00\x04\x00\x0e\x00\x11\x00\x06\x00\x02\x00\x0f\x00\x13\x00\x05\x00\x03\x00\r\x00\r\x00\x06\x00\t\x00\f\x00\b\x00\a\x00\f\x00\b\x00\b\x00\v\x00\b\x00\x06\x00\n\x00\f\x00\b\x00\x05\x00\n\x00\x06\x00\b\x00\f\x00\t\x00\x05\x00\t\x00\t\x00\b\x00\b\x00\v\x00\a\x00\x06\x00\t\x00\f\x00\t\x00\x05\x00\t\x00\r\x00\x06\x00\x06\x00\f\x00\t\x00\x06\x00\v\x00\v\x00\x05\x00\b\x00\x0e\x00\n\x00\x05\x00\x05\x00\n\x00\f\x00\b\x00\x06\x00\a\x00\t\x00\r\x00\b\x00\x03\x00\a\x00\r\x00\a\x00\x04\x00\v\x00\v\x00\x04\x00\t\x00\b\x00\a\x00\x06\x00\n\x00\t\x00\b\x00\a\x00\v\x00\b\x00\a\x00\b\x00\v\x00\x06\x00\a\x00\v\x00\n\x00\x06\x00\t\x00\t\x00\a\x00\a\x00\n\x00\b\x00\x06\x00\a\x00\v\x00\t\x00\x06\x00\x05\x00\t\x00\t\x00\n\x00\x06\x00\x03\x00\a\x00\f\x00\t\x00\x03\x00\x04\x00\b\x00\v\x00\x06\x00\x02\x00\x05\x00\t\x00\x06\x00\x03\x00\x06\x00\b\x00\a\x00\x04\x00\x02\x00\x06\x00\b\x00\x03\x00\x01\x00\b\x00\b\x00\x02\x00\x01\x00\b\x00\a\x00\x00\x00\x00\x00\t\x00\x06\x00\xff\xff\x04\x00\a\x00\x00\x00\x02\x00\a\x00\x01\x00\xfd\xff\a\x00\a\x00\x00\x00\xfc\xff\x02\x00\b\x00\x04\x00\xfa\xff\xff\xff\x06\x00\x04\x00\xfc\xff\xfe\xff\x02\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xfc\xff\xff\xff\x03\x00\x02\x00\xfb\xff\xfe\xff\x00\x00\x03\x00\xff\xff\xfc\xff\xfe\xff\x01\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\xfc\xff\x00\x00\x00\x00\xfd\xff\xfd\xff\x02\x00\xfc\xff\xfc\xff\xff\xff\x00\x00\xfa\xff\xfe\xff\xfe\xff\xfc\xff\xfb\xff\x01\x00\xfe\xff\xf9\xff\xf8\xff\x03\x00\xff\xff\xf7\xff\xf8\xff\x01\x00\xfc\xff\xfa\xff\xfb\xff\xfe\xff\xf9\xff\xfa\xff\xfb\xff\xfe\xff\xfa\xff\xf8\xff\xf8\xff\x00\x00\xfb\xff\xf7\xff\xfa\xff\xfd\xff\xf6\xff\xfa\xff\xff\xff\xfa\xff\xf2\xff\xfb\xff\xff\xff\xf8\xff\xf7\xff\xfa\xff\xf8\xff\xf9\xff\xfa\xff\xfb\xff\xf5\xff\xf8\xff\xfd\xff\xfa\xff\xf4\xff\xf8\xff\xfc\xff\xfa\xff\xf7\xff\xf9\xff\xf5\xff\xf9\xff\xfe\xff\xf8\xff\xf2\xff\xfa\xff\xfc\xff\xf8\xff\xf5\xff\xfa\xff\xf9\xff\xf8\xff\xf6\xff\xf7\xff\xf9\xff\xfb\xff\xf4\xff\xf7\xff\xfa\xff\xfb\xff\xf6\xff\xf6\xff\xf7\xff\xfb\xff\xf7\xff\xf6\xff\xf6\xff\xfd\xff\xf8\xff\xf3\xff\xf4\xff\xfd\xff\xfb\xff\xf7\xff\xf3\xff\xfa\xff\xfb\xff\xf8\xff\xf5\xff\xfa\xff\xf9\xff\xf6\xff\xf5\xff\xfd\xff\xfa\xff\xf5\xff\xf4\xff\xfc\xff\xf9\xff\xf4\xff\xf6\xff\xfc\xff\xf8\xff\xf5\xff\xf6\xff\xfc\xff\xf8\xff\xf5\xff\xf8\xff\xfb\xff\xf6\xff\xf5\xff\xf7\xff\xfb\xff\xf9\xff\xf7\xff\xf5\xff\xf7\xff\xf9\xff\xfa\xff\xf6\xff\xf7\xff\xf9\xff\xf6\xff\xf5\xff\xf9\xff\xfa\xff\xf6\xff\xf5\xff\xf7\xff\xfb\xff\xf8\xff\xf4\xff\xf8\xff\xfa\xff\xf5\xff\xf4\xff\xfb\xff\xf9\xff\xf4\xff\xf7\xff\xf8\xff\xf7\xff\xf9\xff\xf7\xff\xf5\xff\xf8\xff\xfb\xff\xf7\xff\xf6\xff\xf8\xff\xf7\xff\xf7\xff\xf9\xff\xf9\xff\xf5\xff\xf5\xff\xfc\xff\xfb\xff\xf6\xff\xf2\xff\xf7\xff\xfd\xff\xfa\xff\xf2\xff\xf5\xff\xfc\xff\xfb\xff\xf4\xff\xf5\xff\xfa\xff\xf9\xff\xf3\xff\xf7\xff\xfb\xff\xf7\xff\xf2\xff\xf7\xff\xf8\xff\xfa\xff\xf5\xff\xf2\xff\xf4\xff\xff\xff\xf8\xff\xef\xff\xf4\xff\x00\x00\xf6\xff\xef\xff\xf5\xff\xff\xff\xf5\xff\xf2\xff\xf4\xff\xfa\xff\xf7\xff\xf5\xff\xf4\xff\xf9\xff\xf6\xff\xf8\xff\xf5\xff\xf8\xff\xf4\xff\xf9\xff\xf7\xff\xf4\xff\xf5\xff\xfe\xff\xf7\xff\xf4\xff\xf5\xff\xfb\xff\xf4\xff\xf8\xff\xf8\xff\xf7\xff\xf5\xff\xf9\xff\xf6\xff\xf9\xff\xf8\xff\xf4\xff\xf2\xff\xfd\xff\xfa\xff\xf3\xff\xf3\xff\xfc\xff\xf8\xff\xf8\xff\xf5\xff\xf5\xff\xf8\xff\xfd\xff\xf6\xff\xf5\xff\xf6\xff\xfc\xff\xf8\xff\xf7\xff\xf6\xff\xfa\xff\xf9\xff\xf5\xff\xf6\xff\xfd\xff\xf8\xff\xf5\xff\xf9\xff\xfd\xff\xf8\xff\xf7\xff\xfa\xff\xfa\xff\xf8\xff\xf9\xff\xf9\xff\xf8\xff\xfa\xff\xfa\xff\xf9\xff\xf9\xff\xfb\xff\xfb\xff\xf8\xff\xf8\xff\xfd\xff\xfb\xff\xf9\xff\xf8\xff\xfb\xff\xfc\xff\xfb\xff\xfa\xff\xfb\xff\xfb\xff\xfa\xff\xf9\xff\xfe\xff\xfd\xff\xfa\xff\xf7\xff\xfc\xff\x00\x00\xfd\xff\xf5\xff\xfa\xff\x01\x00\xfe\xff\xf5\xff\xfa\xff\x00\x00\xff\xff\xf8\xff\xfa\xff\xfc\xff\x02\x00\xfc\xff\xf7\xff\xf9\xff\x02\x00\xff\xff\xf9\xff\xfa\xff\x01\x00\xfc\xff\xfb\xff\xff\xff\x00\x00\xfa\xff\xf9\xff\xff\xff\x04\x00\xfb\xff\xfa\xff\xfe\xff\x02\x00\xff\xff\xfe\xff\xfc\xff\xfe\xff\x01\x00\x00\x00\xfc\xff\x00\x00\xfe\xff\xff\xff\x03\x00\x00\x00\xfb\xff\x00\x00\x05\x00\x01\x00\xfb\xff\x00\x00\x03\x00\x01\x00\xfe\xff\x02\x00\x02\x00\xff\xff\xfe\xff\x05\x00\x04\x00\xfe\xff\xfd\xff\x06\x00\x05\x00\xfd\xff\xfd\xff\x05\x00\x03\x00\xff\xff\x02\x00\a\x00\x01\x00\xfd\xff\x04\x00\b\x00\xff\xff\xfd\xff\a\x00\x06\x00\xff\xff\x02\x00\a\x00\x04\x00\x01\x00\x03\x00\x04\x00\x03\x00\x06\x00\x05\x00\x01\x00\x03\x00\b\x00\x05\x00\x01\x00\x05\x00\x06\x00\x02\x00\x04\x00\b\x00\x03\x00\x02\x00\a\x00\b\x00\x03\x00\x03\x00\x04\x00\x05\x00\x04\x00\b\x00\x05\x00\x03\x00\x02\x00\b\x00\t\x00\x04\x00\x01\x00\x06\x00\b\x00\a\x00\x04\x00\x05\x00\x04\x00\a\x00\x05\x00\x04\x00\x04\x00\b\x00\x06\x00\a\x00\x06\x00\x03\x00\x05\x00\t\x00\x06\x00\x05\x00\x04\x00\b\x00\b\x00\x06\x00\x04\x00\a\x00\n\x00\x05\x00\x01\x00\t\x00\n\x00\x06\x00\x05\x00\b\x00\x06\x00\a\x00\a\x00\t\x00\x06\x00\x06\x00\b\x00\t\x00\a\x00\b\x00\a\x00\a\x00\a\x00\v\x00\x06\x00\x03\x00\n\x00\x0f\x00\x06\x00\x04\x00\n\x00\f\x00\x06\x00\a\x00\n\x00\n\x00\x05\x00\n\x00\v\x00\a\x00\b\x00\r\x00\b\x00\a\x00\f\x00\v\x00\x06\x00\v\x00\r\x00\x06\x00\a\x00\x0e\x00\r\x00\a\x00\t\x00\f\x00\f\x00\a\x00\v\x00\x0e\x00\n\x00\a\x00\v\x00\n\x00\v\x00\x0e\x00\f\x00\x04\x00\t\x00\x12\x00\x0e\x00\x04\x00\a\x00\r\x00\r\x00\v\x00\f\x00\a\x00\t\x00\x0f\x00\v\x00\a\x00\n\x00\f\x00\v\x00\n\x00\n\x00\n\x00\r\x00\v\x00\b\x00\v\x00\r\x00\v\x00\n\x00\v\x00\f\x00\f\x00\n\x00\n\x00\v\x00\f\x00\f\x00\n\x00\t\x00\v\x00\r\x00\n\x00\n\x00\v\x00\n\x00\n\x00\x0e\x00\v\x00\t\x00\v\x00\x0e\x00\x06\x00\t\x00\x0e\x00\f\x00\x05\x00\n\x00\x0f\x00\f\x00\x04\x00\n\x00\x0f\x00\r\x00\x04\x00\b\x00\x0e\x00\f\x00\x06\x00\v\x00\n\x00\b\x00\v\x00\x10\x00\x06\x00\x03\x00\r\x00\x12\x00\x06\x00\x05\x00\f\x00\x0e\x00\a\x00\t\x00\n\x00\f\x00\b\x00\t\x00\n\x00\r\x00\t\x00\b\x00\v\x00\r\x00\x06\x00\b\x00\r\x00\r\x00\x05\x00\t\x00\f\x00\v\x00\b\x00\n\x00\t\x00\f\x00\n\x00\a\x00\a\x00\x11\x00\n\x00\x03\x00\n\x00\x10\x00\a\x00\a\x00\f\x00\f\x00\x05\x00\t\x00\f\x00\n\x00\b\x00\n\x00\b\x00\t\x00\v\x00\v\x00\x05\x00\a\x00\n\x00\r\x00\b\x00\x04\x00\a\x00\x10\x00\t\x00\x02\x00\b\x00\f\x00\x05\x00\a\x00\v\x00\b\x00\x03\x00\t\x00\n\x00\a\x00\x05\x00\a\x00\t\x00\b\x00\x05\x00\t\x00\b\x00\x05\x00\x05\x00\v\x00\b\x00\x03\x00\x06\x00\n\x00\x06\x00\x05\x00\a\x00\x06\x00\x04\x00\x06\x00\a\x00\x06\x00\x05\x00\x04\x00\x04\x00\a\x00\x06\x00\x03\x00\x02\x00\a\x00\x06\x00\x02\x00\x03\x00\b\x00\x06\x00\x01\x00\x00\x00\x06\x00\a\x00\x06\x00\xff\xff\x01\x00\a\x00\x05\x00\xfd\xff\x05\x00\a\x00\x00\x00\x00\x00\v\x00\x01\x00\xfa\xff\x03\x00\f\x00\xfe\xff\xfb\xff\x05\x00\v\x00\x00\x00\xfc\xff\x01\x00\a\x00\x00\x00\xff\xff\x02\x00\x03\x00\xfd\xff\x02\x00\x04\x00\xff\xff\xfe\xff\x06\x00\x01\x00\xfc\xff\xff\xff\x06\x00\x00\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\xff\xff\xfb\xff\x03\x00\x03\x00\xfc\xff\xfb\xff\x04\x00\x00\x00\xfc\xff\xff\xff\x04\x00\xfb\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\xfe\xff\xfc\xff\x01\x00\x01\x00\xfe\xff\xf9\xff\x00\x00\xfe\xff\xfd\xff\xfe\xff\xfe\xff\xfa\xff\x01\x00\xfe\xff\xfc\xff\xfa\xff\x00\x00\xfc\xff\xfd\xff\xfc\xff\xfd\xff\xfa\xff\xfe\xff\xfc\xff\xff\xff\xf8\xff\xf9\xff\xff\xff\x02\x00\xf8\xff\xf7\xff\xfb\xff\x01\x00\xfc\xff\xf8\xff\xf8\xff\xfd\xff\xfd\xff\xfb\xff\xf8\xff\xfa\xff\xfb\xff\xfd\xff\xfa\xff\xf9\xff\xfa\xff\xfb\xff\xf7\xff\xfb\xff\xfc\xff\xfb\xff\xf8\xff\xfa\xff\xf9\xff\xfd\xff\xfc\xff\xf8\xff\xf5\xff\xfc\xff\xfb\xff\xf8\xff\xf8\xff\xfd\xff\xf7\xff\xf9\xff\xfb\xff\xf8\xff\xf5\xff\xfd\xff\xfc\xff\xf3\xff\xf4\xff\x00\x00\xfb\xff\xf3\xff\xf6\xff\xfc\xff\xf8\xff\xf6\xff\xf9\xff\xfb\xff\xf7\xff\xf5\xff\xf9\xff\xfc\xff\xf6\xff\xf6\xff\xfb\xff\xf8\xff\xf6\xff\xfa\xff\xf8\xff\xf6\xff\xfa\xff\xf7\xff\xf5\xff\xf9\xff\xfc\xff\xf6\xff\xf4\xff\xf8\xff\xfa\xff\xf9\xff\xf5\xff\xf6\xff\xfa\xff\xf5\xff\xf7\xff\xfb\xff\xf8\xff\xf2\xff\xf7\xff\xfe\xff\xf8\xff\xf2\xff\xf5\xff\xf9\xff\xf9\xff\xf6\xff\xf5\xff\xf5\xff\xf8\xff\xf8\xff\xf4\xff\xf5\xff\xfa\xff\xf4\xff\xf3\xff\xfa\xff\xf9\xff\xf0\xff\xf6\xff\xfb\xff\xf4\xff\xf1\xff\xfb\xff\xf7\xff\xf2\xff\xf5\xff\xf7\xff\xf4\xff\xf8\xff\xf4\xff\xf0\xff\xf7\xff\xfd\xff\xf2\xff\xf0\xff\xf7\xff\xfa\xff\xf3\xff\xf3\xff\xf3\xff\xf6\xff\xf6\xff\xf7\xff\xf4\xff\xf3\xff\xf6\xff\xfa\xff\xf2\xff\xf1\xff\xf8\xff\xf7\xff\xef\xff\xf6\xff\xfd\xff\xf3\xff\xeb\xff\xf9\xff\xfe\xff\xf2\xff\xed\xff\xf9\xff\xfc\xff\xf1\xff\xef\xff\xfa\xff\xfa\xff\xf2\xff\xee\xff\xf7\xff\xfc\xff\xf6\xff\xed\xff\xf3\xff\xfb\xff\xf8\xff\xef\xff\xf5\xff\xf8\xff\xf7\xff\xf1\xff\xf3\xff\xf7\xff\xf7\xff\xf3\xff\xf5\xff\xf5\xff\xf7\xff\xf3\xff\xf7\xff\xf7\xff\xf4\xff\xf3\xff\xf9\xff\xf6\xff\xf3\xff\xf8\xff\xfb\xff\xf1\xff\xf3\xff\xfd\xff\xfa\xff\xf0\xff\xf3\xff\xfd\xff\xfb\xff\xf1\xff\xf5\xff\xfa\xff\xf8\xff\xf4\xff\xf9\xff\xf9\xff\xf4\xff\xf7\xff\xfc\xff\xf6\xff\xf5\xff\xf8\xff\xf9\xff\xf6\xff\xf7\xff\xf9\xff\xf9\xff\xf4\xff\xf7\xff\xfb\xff\xfa\xff\xf2\xff\xf7\xff\xfc\xff\xf9\xff\xf4\xff\xf9\xff\xf8\xff\xf9\xff\xf8\xff\xf8\xff\xf5\xff\xfa\xff\xf9\xff\xf9\xff\xf6\xff\xfa\xff\xf7\xff\xf9\xff\xfa\xff\xf9\xff\xf6\xff\xfd\xff\xf9\xff\xf6\xff\xf8\xff\xff\xff\xf9\xff\xf5\xff\xf8\xff\xff\xff\xfb\xff\xf7\xff\xf7\xff\x02\x00\xfb\xff\xf4\xff\xf9\xff\x03\x00\xfa\xff\xf6\xff\xfc\xff\x00\x00\xf9\xff\xfa\xff\xfa\xff\xfd\xff\xfb\xff\xfd\xff\xfa\xff\xf8\xff\xfa\xff\x02\x00\xfc\xff\xf6\xff\xf9\xff\x02\x00\xfb\xff\xf9\xff\xfa\xff\xfc\xff\xfc\xff\x00\x00\xfa\xff\xf9\xff\xfc\xff\x00\x00\xfc\xff\xfd\xff\xf8\xff\xfc\xff\x00\x00\x00\x00\xf6\xff\xfc\xff\x00\x00\xfe\xff\xfa\xff\xff\xff\xfe\xff\xfe\xff\xfc\xff\xff\xff\xfb\xff\x00\x00\x01\x00\xfd\xff\xf9\xff\x02\x00\x04\x00\xfe\xff\xf9\xff\x01\x00\x02\x00\x00\x00\xfb\xff\x01\x00\x01\x00\x00\x00\xfc\xff\x01\x00\x01\x00\x01\x00\xff\xff\xfe\xff\xfd\xff\x05\x00\x03\x00\xfe\xff\xfb\xff\x04\x00\x04\x00\x00\x00\xfc\xff\x04\x00\x01\x00\x00\x00\xff\xff\x05\x00\x00\x00\xff\xff\x01\x00\x05\x00\x01\x00\x03\x00\x01\x00\x00\x00\x01\x00\b\x00\x02\x00\xff\xff\x04\x00\a\x00\xff\xff\x03\x00\b\x00\x04\x00\xfd\xff\x04\x00\b\x00\x04\x00\x01\x00\x06\x00\x04\x00\x02\x00\x03\x00\a\x00\x04\x00\x03\x00\x04\x00\x04\x00\x04\x00\x05\x00\x05\x00\x03\x00\x02\x00\b\x00\x05\x00\x01\x00\x03\x00\b\x00\x05\x00\x02\x00\x02\x00\x06\x00\x06\x00\a\x00\x01\x00\x03\x00\t\x00\a\x00\x02\x00\x03\x00\x06\x00\a\x00\x03\x00\x06\x00\t\x00\a\x00\x02\x00\x06\x00\v\x00\x06\x00\x02\x00\t\x00\n\x00\x04\x00\x05\x00\f\x00\t\x00\x02\x00\x06\x00\r\x00\x05\x00\x04\x00\n\x00\v\x00\x05\x00\a\x00\b\x00\a\x00\x06\x00\n\x00\t\x00\b\x00\x03\x00\b\x00\f\x00\f\x00\x02\x00\x02\x00\n\x00\x0f\x00\x06\x00\x03\x00\x04\x00\f\x00\v\x00\t\x00\x02\x00\x05\x00\f\x00\x0e\x00\x04\x00\x04\x00\t\x00\r\x00\b\x00\x05\x00\a\x00\r\x00\b\x00\x06\x00\v\x00\f\x00\x05\x00\a\x00\r\x00\v\x00\x03\x00\t\x00\r\x00\t\x00\a\x00\f\x00\n\x00\x05\x00\t\x00\x0f\x00\t\x00\x04\x00\t\x00\x0f\x00\v\x00\x05\x00\a\x00\r\x00\n\x00\x06\x00\b\x00\r\x00\n\x00\a\x00\b\x00\r\x00\t\x00\a\x00\t\x00\v\x00\b\x00\t\x00\v\x00\v\x00\a\x00\a\x00\n\x00\x0e\x00\n\x00\x05\x00\x06\x00\x0f\x00\x0e\x00\a\x00\x06\x00\v\x00\n\x00\v\x00\v\x00\a\x00\a\x00\x0f\x00\r\x00\x04\x00\b\x00\x10\x00\t\x00\x03\x00\v\x00\x10\x00\a\x00\x06\x00\v\x00\f\x00\t\x00\b\x00\n\x00\t\x00\n\x00\t\x00\a\x00\n\x00\f\x00\b\x00\x05\x00\n\x00\r\x00\x06\x00\x06\x00\r\x00\v\x00\x05\x00\t\x00\r\x00\x05\x00\x06\x00\r\x00\t\x00\x03\x00\n\x00\x10\x00\x06\x00\x02\x00\v\x00\r\x00\a\x00\x06\x00\b\x00\b\x00\t\x00\f\x00\b\x00\x03\x00\b\x00\r\x00\v\x00\x04\x00\x04\x00\f\x00\r\x00\x05\x00\x03\x00\b\x00\v\x00\b\x00\a\x00\x06\x00\x06\x00\v\x00\a\x00\x02\x00\b\x00\f\x00\x05\x00\x03\x00\t\x00\a\x00\x04\x00\t\x00\a\x00\x03\x00\a\x00\n\x00\x04\x00\x04\x00\t\x00\a\x00\x01\x00\x05\x00\n\x00\x06\x00\x00\x00\x06\x00\n\x00\x05\x00\x01\x00\b\x00\b\x00\x02\x00\x03\x00\b\x00\x06\x00\x03\x00\x05\x00\a\x00\x06\x00\x05\x00\x03\x00\x03\x00\x06\x00\a\x00\x01\x00\x01\x00\t\x00\b\x00\xfe\xff\xff\xff\v\x00\b\x00\xfd\xff\x02\x00\t\x00\x05\x00\x01\x00\x03\x00\x04\x00\x03\x00\x04\x00\x05\x00\x02\x00\x04\x00\x02\x00\x03\x00\a\x00\x06\x00\xfd\xff\x00\x00\t\x00\b\x00\xfe\xff\x00\x00\a\x00\a\x00\x01\x00\x02\x00\x01\x00\x04\x00\x06\x00\x03\x00\xfd\xff\x04\x00\x05\x00\x00\x00\x01\x00\a\x00\xff\xff\xfe\xff\x05\x00\x05\x00\xfe\xff\x01\x00\x03\x00\x00\x00\xff\xff\x04\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\x03\x00\x01\x00\xfb\xff\xfd\xff\b\x00\xff\xff\xf9\xff\xff\xff\a\x00\xfe\xff\xfb\xff\x00\x00\x05\x00\xfd\xff\xfb\xff\xff\xff\x05\x00\x00\x00\xfc\xff\xfc\xff\x03\x00\x00\x00\xff\xff\xfd\xff\xff\xff\x00\x00\x02\x00\xfe\xff\xfc\xff\xfe\xff\x04\x00\xfe\xff\xfc\xff\xfe\xff\x01\x00\xff\xff\xff\xff\xfc\xff\xfe\xff\x00\x00\x00\x00\xfa\xff\xff\xff\x02\x00\xff\xff\xf9\xff\xfd\xff\x00\x00\x02\x00\xf9\xff\xf9\xff\xff\xff\x03\x00\xfa\xff\xfa\xff\xfe\xff\xff\xff\xf9\xff\xfe\xff\xfd\xff\xfd\xff\xf9\xff\xfd\xff\xfe\xff\xff\xff\xf9\xff\xfa\xff\xfb\xff\x00\x00\xfa\xff\xfc\xff\xfa\xff\xfc\xff\xfb\xff\x00\x00\xfa\xff\xf8\xff\xf9\xff\x01\x00\xfa\xff\xf8\xff\xf9\xff\x00\x00\xfa\xff\xf9\xff\xf8\xff\xfe\xff\xfc\xff\xfa\xff\xf5\xff\xfc\xff\xfe\xff\xfa\xff\xf4\xff\xfe\xff\x00\x00\xf7\xff\xf4\xff\x00\x00\xfd\xff\xf5\xff\xf6\xff\xfe\xff\xfc\xff\xf9\xff\xf7\xff\xfb\xff\xfb\xff\xfa\xff\xf8\xff\xfb\xff\xf9\xff\xfb\xff\xf9\xff\xf8\xff\xf9\xff\xfd\xff\xf7\xff\xf8\xff\xfa\xff\xfb\xff\xf8\xff\xfb\xff\xf8\xff\xf9\xff\xf9\xff\xfa\xff\xf8\xff\xfa\xff\xf7\xff\xf9\xff\xf9\xff\xfb\xff\xf6\xff\xf8\xff\xf9\xff\xf9\xff\xf8\xff\xf8\xff\xf6\xff\xfb\xff\xfb\xff\xf7\xff\xf3\xff\xfb\xff\xfb\xff\xf5\xff\xf6\xff\xfb\xff\xf5\xff\xf6\xff\xfd\xff\xf9\xff\xf1\xff\xf8\xff\xfb\xff\xf8\xff\xf5\xff\xf6\xff\xf8\xff\xfa\xff\xf7\xff\xf6\xff\xf7\xff\xfa\xff\xf6\xff\xf5\xff\xf8\xff\xf9\xff\xf6\xff\xf5\xff\xf8\xff\xfb\xff\xf5\xff\xf5\xff\xf7\xff\xf9\xff\xf6\xff\xf6\xff\xf8\xff\xf7\xff\xf4\xff\xf8\xff\xfa\xff\xf5\xff\xf1\xff\xfa\xff\xfb\xff\xf4\xff\xf2\xff\xf7\xff\xf9\xff\xfa\xff\xf2\xff\xf3\xff\xf9\xff\xfa\xff\xf4\xff\xf2\xff\xf6\xff\xfa\xff\xf8\xff\xf5\xff\xf4\xff\xf7\xff\xf6\xff\xf7\xff\xf8\xff\xf2\xff\xf2\xff\xfd\xff\xfa\xff\xf3\xff\xf5\xff\xf9\xff\xf5\xff\xf6\xff\xf9\xff\xf5\xff\xf4\xff\xfb\xff\xf8\xff\xf4\xff\xf6\xff\xfa\xff\xf7\xff\xf5\xff\xf6\xff\xf6\xff\xf8\xff\xfa\xff\xf5\xff\xf5\xff\xf7\xff\xf9\xff\xf8\xff\xf5\xff\xf4\xff\xfb\xff\xf9\xff\xf2\xff\xf3\xff\xfe\xff\xf9\xff\xf0\xff\xf6\xff\xfe\xff\xf7\xff\xf3\xff\xf9\xff\xfa\xff\xf4\xff\xf6\xff\xfa\xff\xf8\xff\xf3\xff\xf7\xff\xfb\xff\xf8\xff\xf4\xff\xf8\xff\xfa\xff\xf7\xff\xf5\xff\xf9\xff\xf7\xff\xf7\xff\xfb\xff\xfa\xff\xf2\xff\xf8\xff\xff\xff\xf8\xff\xf1\xff\xfa\xff\xfd\xff\xf7\xff\xf6\xff\xfb\xff\xf8\xff\xf7\xff\xfa\xff\xfa\xff\xf6\xff\xf8\xff\xf9\xff\xfa\xff\xf9\xff\xf9\xff\xf8\xff\xf9\xff\xfb\xff\xf9\xff\xf4\xff\xf9\xff\xff\xff\xfa\xff\xf3\xff\xfa\xff\xfe\xff\xf8\xff\xf7\xff\xfc\xff\xfb\xff\xf6\xff\xf9\xff\xfe\xff\xfb\xff\xf7\xff\xf8\xff\xfc\xff\xfe\xff\xfa\xff\xf7\xff\xf8\xff\xff\xff\xfe\xff\xf7\xff\xf6\xff\xfe\xff\xfe\xff\xf8\xff\xf7\xff\xfe\xff\xfe\xff\xf9\xff\xf6\xff\xfe\xff\x00\x00\xfb\xff\xf6\xff\xfb\xff\xfd\xff\xfe\xff\xfc\xff\xfa\xff\xf9\xff\xff\xff\xfd\xff\xfb\xff\xf9\xff\xfd\xff\xfe\xff\xfd\xff\xfa\xff\xfb\xff\xfd\xff\x01\x00\xfb\xff\xf8\xff\xfd\xff\x02\x00\xfc\xff\xf9\xff\xfd\xff\xff\xff\xfd\xff\xfd\xff\xfc\xff\xfd\xff\x00\x00\xff\xff\xf8\xff\xfd\xff\x05\x00\xfe\xff\xf5\xff\x00\x00\x06\x00\xfc\xff\xf7\xff\x03\x00\x02\x00\xf9\xff\xfc\xff\x05\x00\xfe\xff\xfa\xff\xff\xff\x04\x00\xff\xff\xfc\xff\xfd\xff\x01\x00\xfe\xff\xff\xff\xfe\xff\x00\x00\xff\xff\x00\x00\xff\xff\xff\xff\xfe\xff\x02\x00\x00\x00\xfd\xff\xfd\xff\x05\x00\x03\x00\xfd\xff\xfa\xff\x04\x00\x04\x00\x00\x00\xfd\xff\x01\x00\x00\x00\x02\x00\x03\x00\x04\x00\xfb\xff\xfe\xff\a\x00\x06\x00\xfa\xff\xff\xff\b\x00\x02\x00\xfa\xff\x04\x00\x05\x00\x00\x00\xff\xff\x04\x00\x04\x00\x02\x00\xff\xff\x00\x00\x04\x00\x06\x00\xfe\xff\xff\xff\x05\x00\x06\x00\xff\xff\x01\x00\x03\x00\x03\x00\x01\x00\x02\x00\x02\x00\x05\x00\x03\x00\x00\x00\x01\x00\b\x00\x03\x00\xff\xff\x01\x00\x06\x00\x06\x00\x04\x00\xfe\xff\x01\x00\t\x00\b\x00\xfe\xff\x01\x00\b\x00\a\x00\x01\x00\x03\x00\x04\x00\x06\x00\x06\x00\x04\x00\x02\x00\x05\x00\x06\x00\b\x00\x04\x00\x02\x00\x02\x00\b\x00\b\x00\x05\x00\x03\x00\x05\x00\x04\x00\a\x00\b\x00\x03\x00\x01\x00\b\x00\x06\x00\x02\x00\x05\x00\n\x00\x02\x00\x02\x00\b\x00\a\x00\x02\x00\x05\x00\a\x00\x03\x00\x03\x00\a\x00\x05\x00\x04\x00\x05\x00\x06\x00\x05\x00\x06\x00\x06\x00\x03\x00\x04\x00\b\x00\b\x00\x03\x00\x02\x00\b\x00\t\x00\x05\x00\x04\x00\a\x00\x06\x00\x04\x00\x06\x00\v\x00\x06\x00\x02\x00\x06\x00\v\x00\a\x00\x03\x00\a\x00\n\x00\x03\x00\x05\x00\n\x00\n\x00\x01\x00\x05\x00\v\x00\b\x00\x02\x00\x05\x00\n\x00\t\x00\x02\x00\x06\x00\t\x00\b\x00\x04\x00\a\x00\a\x00\x06\x00\x06\x00\t\x00\x06\x00\x04\x00\a\x00\v\x00\a\x00\x04\x00\x05\x00\n\x00\t\x00\x05\x00\x05\x00\t\x00\t\x00\a\x00\x05\x00\t\x00\b\x00\x06\x00\a\x00\b\x00\a\x00\a\x00\a\x00\b\x00\b\x00\x05\x00\x04\x00\v\x00\n\x00\x04\x00\x03\x00\v\x00\v\x00\a\x00\x02\x00\x06\x00\v\x00\b\x00\x03\x00\t\x00\b\x00\x05\x00\x06\x00\n\x00\x06\x00\a\x00\a\x00\a\x00\a\x00\t\x00\x05\x00\x04\x00\a\x00\v\x00\x06\x00\x04\x00\x06\x00\v\x00\x06\x00\x02\x00\a\x00\r\x00\x05\x00\x01\x00\b\x00\v\x00\x04\x00\x03\x00\b\x00\t\x00\x05\x00\x03\x00\b\x00\v\x00\x03\x00\x00\x00\b\x00\n\x00\x03\x00\x03\x00\t\x00\b\x00\x02\x00\x02\x00\t\x00\n\x00\x03\x00\x02\x00\b\x00\t\x00\x03\x00\x03\x00\a\x00\b\x00\x03\x00\x03\x00\a\x00\t\x00\x04\x00\x02\x00\x03\x00\n\x00\b\x00\x01\x00\x02\x00\n\x00\a\x00\x01\x00\x04\x00\b\x00\x04\x00\x06\x00\x05\x00\x03\x00\x05\x00\x06\x00\x01\x00\x05\x00\t\x00\x04\x00\xff\xff\x06\x00\b\x00\x03\x00\x01\x00\x06\x00\x05\x00\x05\x00\x05\x00\x02\x00\x03\x00\b\x00\x05\x00\xfe\xff\x03\x00\v\x00\x04\x00\xfe\xff\x04\x00\b\x00\x01\x00\x00\x00\x05\x00\x05\x00\x01\x00\x03\x00\x04\x00\x03\x00\x02\x00\x02\x00\x02\x00\x05\x00\x04\x00\x01\x00\xfe\xff\x04\x00\t\x00\x03\x00\xfa\xff\x00\x00\t\x00\b\x00\xfd\xff\xfd\xff\x04\x00\a\x00\x00\x00\x01\x00\x01\x00\x02\x00\x03\x00\x05\x00\xff\xff\x00\x00\x03\x00\x03\x00\xfe\xff\x03\x00\x03\x00\xff\xff\xfe\xff\x05\x00\x02\x00\x00\x00\x01\x00\x01\x00\xfe\xff\x02\x00\x03\x00\x02\x00\xfd\xff\xff\xff\x01\x00\x03\x00\x00\x00\xfd\xff\x00\x00\x04\x00\xfe\xff\xfc\xff\x02\x00\x03\x00\xfd\xff\xfe\xff\x01\x00\x03\x00\xff\xff\xfd\xff\x00\x00\x02\x00\xfd\xff\xfe\xff\x04\x00\x00\x00\xf7\xff\x00\x00\b\x00\xff\xff\xf5\xff\xfe\xff\x06\x00\x02\x00\xfa\xff\xfb\xff\x00\x00\x03\x00\xfe\xff\xfb\xff\xfe\xff\x01\x00\xfd\xff\xfe\xff\xfc\xff\xfd\xff\xff\xff\x00\x00\xfb\xff\xfe\xff\xff\xff\xfb\xff\xfb\xff\x02\x00\xfd\xff\xf8\xff\xfa\xff\x03\x00\xff\xff\xf8\xff\xf8\xff\x03\x00\xff\xff\xf8\xff\xf9\xff\x00\x00\xfd\xff\xfc\xff\xfb\xff\xfb\xff\xfd\xff\x00\x00\xf9\xff\xfa\xff\xfe\xff\x00\x00\xf8\xff\xf9\xff\xfe\xff\xff\xff\xfb\xff\xfa\xff\xf9\xff\xfe\xff\xff\xff\xfd\xff\xf7\xff\xfa\xff\xfe\xff\xff\xff\xfa\xff\xf9\xff\xfa\xff\xfd\xff\xfd\xff\xff\xff\xfa\xff\xf8\xff\xf9\xff\xfe\xff\xfd\xff\xfd\xff\xf8\xff\xf7\xff\xfa\xff\x02\x00\xfc\xff\xf7\xff\xf6\xff\xfc\xff\xfd\xff\xfc\xff\xf6\xff\xf9\xff\xfc\xff\xfd\xff\xfa\xff\xfa\xff\xf7\xff\xfc\xff\xfd\xff\xf9\xff\xf5\xff\xfc\xff\xfb\xff\xfa\xff\xfa\xff\xfa\xff\xf7\xff\xfc\xff\xfb\xff\xf8\xff\xfa\xff\xfd\xff\xf7\xff\xf8\xff\xfc\xff\xfc\xff\xf6\xff\xf7\xff\xfa\xff\xfe\xff\xfb\xff\xf5\xff\xf5\xff\xfd\xff\xfe\xff\xfa\xff\xf5\xff\xf8\xff\xf9\xff\xfd\xff\xfc\xff\xf6\xff\xf5\xff\xfb\xff\xfd\xff\xfa\xff\xf6\xff\xf9\xff\xf8\xff\xfb\xff\xfa\xff\xf6\xff\xf6\xff\xfc\xff\xfb\xff\xf6\xff\xf6\xff\xfb\xff\xf7\xff\xf6\xff\xfb\xff\xfb\xff\xf5\xff\xf8\xff\xfb\xff\xf8\xff\xf7\xff\xf9\xff\xf7\xff\xf8\xff\xf9\xff\xf8\xff\xf8\xff\xf9\xff\xf9\xff\xf8\xff\xf6\xff\xf8\xff\xf9\xff\xf7\xff\xf8\xff\xfb\xff\xf6\xff\xf4\xff\xfb\xff\xfa\xff\xf4\xff\xf7\xff\xf9\xff\xf8\xff\xf8\xff\xf7\xff\xf5\xff\xf8\xff\xf9\xff\xf7\xff\xf6\xff\xf8\xff\xf8\xff\xf9\xff\xf9\xff\xf7\xff\xf5\xff\xf8\xff\xfa\xff\xf7\xff\xf3\xff\xf7\xff\xfb\xff\xfa\xff\xf4\xff\xf7\xff\xfa\xff\xf9\xff\xf8\xff\xf8\xff\xf4\xff\xf7\xff\xfb\xff\xf9\xff\xf4\xff\xf8\xff\xfa\xff\xfa\xff\xf8\xff\xf6\xff\xf4\xff\xfa\xff\xfa\xff\xf7\xff\xf6\xff\xf9\xff\xf7\xff\xfa\xff\xf9\xff\xf7\xff\xf6\xff\xf8\xff\xf7\xff\xfa\xff\xfa\xff\xf6\xff\xf5\xff\xfd\xff\xf9\xff\xf3\xff\xf8\xff\xfb\xff\xf5\xff\xf8\xff\xfc\xff\xf8\xff\xf3\xff\xfb\xff\xfb\xff\xf5\xff\xf5\xff\xfb\xff\xfa\xff\xf7\xff\xf8\xff\xfb\xff\xf8\xff\xf6\xff\xf8\xff\xfc\xff\xf9\xff\xf5\xff\xf6\xff\xfc\xff\xfc\xff\xf7\xff\xf6\xff\xf9\xff\xfa\xff\xfb\xff\xf7\xff\xf4\xff\xfa\xff\xff\xff\xf7\xff\xf3\xff\xfc\xff\xff\xff\xf7\xff\xf5\xff\xfb\xff\xfc\xff\xf9\xff\xf8\xff\xf9\xff\xfb\xff\xfb\xff\xf8\xff\xf7\xff\xfd\xff\xfe\xff\xf6\xff\xf5\xff\xfe\xff\xfd\xff\xf8\xff\xf7\xff\xf9\xff\xfc\xff\xff\xff\xf9\xff\xf4\xff\xfb\xff\x01\x00\xf7\xff\xf6\xff\xfd\xff\xfd\xff\xf7\xff\xf9\xff\xfd\xff\xfd\xff\xf8\xff\xf8\xff\xfa\xff\xfd\xff\xfc\xff\xf9\xff\xf8\xff\xfd\xff\xff\xff\xf9\xff\xf7\xff\xfe\xff\xff\xff\xf7\xff\xfb\xff\x01\x00\xfa\xff\xf7\xff\x00\x00\xff\xff\xf9\xff\xfa\xff\x00\x00\xfe\xff\xf8\xff\xfa\xff\x02\x00\x00\x00\xf7\xff\xf9\xff\x04\x00\x00\x00\xf8\xff\xf9\xff\xfe\xff\x00\x00\xfe\xff\xfa\xff\xf9\xff\xff\xff\x03\x00\xfc\xff\xf7\xff\xfc\xff\x02\x00\xfe\xff\xfa\xff\xfe\xff\x00\x00\xfc\xff\xfb\xff\xfe\xff\xff\xff\xfe\xff\xfc\xff\xfe\xff\xff\xff\xfe\xff\xff\xff\xfe\xff\xfa\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\xfc\xff\xfe\xff\x00\x00\x01\x00\xfd\xff\xfb\xff\x00\x00\x02\x00\xff\xff\xfb\xff\xff\xff\x03\x00\xff\xff\xfd\xff\xff\xff\xff\xff\x01\x00\x02\x00\xfd\xff\xfa\xff\x02\x00\x06\x00\xfd\xff\xfa\xff\x00\x00\x04\x00\x00\x00\xfc\xff\xff\xff\x03\x00\x01\x00\xfe\xff\x00\x00\x02\x00\xfd\xff\xfd\xff\x04\x00\x05\x00\xfc\xff\xfb\xff\x05\x00\x05\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\x01\x00\x01\x00\x00\x00\x03\x00\x01\x00\xfe\xff\x03\x00\x05\x00\x01\x00\xfe\xff\x01\x00\x04\x00\x01\x00\x00\x00\x04\x00\x03\x00\xff\xff\x00\x00\x04\x00\a\x00\x00\x00\xfe\xff\x03\x00\a\x00\x03\x00\x00\x00\x02\x00\x05\x00\x02\x00\x04\x00\x01\x00\x02\x00\x05\x00\x05\x00\x00\x00\x03\x00\x05\x00\x05\x00\xff\xff\x03\x00\x05\x00\x04\x00\x01\x00\x06\x00\x04\x00\x03\x00\x03\x00\x05\x00\xff\xff\x02\x00\x06\x00\a\x00\x00\x00\x04\x00\x06\x00\x05\x00\x02\x00\x04\x00\x01\x00\x05\x00\x05\x00\x05\x00\x00\x00\x05\x00\t\x00\x06\x00\xfd\xff\x04\x00\t\x00\a\x00\xff\xff\x04\x00\x05\x00\a\x00\x06\x00\x03\x00\xfd\xff\b\x00\r\x00\x02\x00\xfb\xff\t\x00\n\x00\x04\x00\x02\x00\b\x00\x05\x00\x04\x00\x05\x00\a\x00\x04\x00\x05\x00\x05\x00\b\x00\x05\x00\x05\x00\x05\x00\b\x00\x05\x00\x04\x00\x05\x00\t\x00\x04\x00\x03\x00\x06\x00\r\x00\x03\x00\xfe\xff\t\x00\x0f\x00\x00\x00\x01\x00\v\x00\t\x00\xfe\xff\x06\x00\f\x00\x05\x00\x01\x00\t\x00\a\x00\x04\x00\x06\x00\n\x00\x03\x00\x04\x00\x06\x00\t\x00\x05\x00\x06\x00\x04\x00\a\x00\b\x00\a\x00\x03\x00\x06\x00\a\x00\b\x00\x02\x00\x06\x00\n\x00\a\x00\x01\x00\a\x00\b\x00\x06\x00\x03\x00\t\x00\x06\x00\x04\x00\x06\x00\n\x00\x05\x00\x04\x00\x05\x00\n\x00\x06\x00\x06\x00\x05\x00\x06\x00\a\x00\b\x00\x04\x00\x06\x00\x06\x00\t\x00\x05\x00\x06\x00\x06\x00\t\x00\x04\x00\x05\x00\a\x00\b\x00\x05\x00\n\x00\x05\x00\x03\x00\x06\x00\v\x00\x06\x00\x06\x00\x03\x00\a\x00\n\x00\t\x00\x00\x00\a\x00\v\x00\b\x00\x00\x00\a\x00\t\x00\b\x00\x02\x00\a\x00\x06\x00\a\x00\a\x00\t\x00\x02\x00\a\x00\v\x00\x05\x00\xfd\xff\v\x00\f\x00\x05\x00\x02\x00\b\x00\x04\x00\a\x00\b\x00\x06\x00\x01\x00\b\x00\a\x00\a\x00\x04\x00\b\x00\x04\x00\x03\x00\x04\x00\v\x00\x04\x00\x03\x00\x03\x00\t\x00\x05\x00\x05\x00\x02\x00\a\x00\x06\x00\x05\x00\x02\x00\v\x00\x05\x00\x02\x00\x05\x00\t\x00\x00\x00\x05\x00\n\x00\x06\x00\xfe\xff\n\x00\t\x00\x04\x00\x00\x00\a\x00\x05\x00\x06\x00\x04\x00\a\x00\x02\x00\x05\x00\x05\x00\b\x00\x02\x00\x04\x00\x03\x00\x06\x00\x06\x00\a\x00\xff\xff\x04\x00\a\x00\x06\x00\x00\x00\x06\x00\x04\x00\x04\x00\x04\x00\x05\x00\x00\x00\x06\x00\x05\x00\x04\x00\x01\x00\x05\x00\x03\x00\x05\x00\x01\x00\x03\x00\x04\x00\x06\x00\xff\xff\x04\x00\x05\x00\x05\x00\x00\x00\x03\x00\x02\x00\x04\x00\x03\x00\x03\x00\x00\x00\x04\x00\x01\x00\x03\x00\x03\x00\x04\x00\x01\x00\x02\x00\x03\x00\x05\x00\xff\xff\xff\xff\x03\x00\a\x00\xfe\xff\x00\x00\x05\x00\x03\x00\xfd\xff\x03\x00\x04\x00\x01\x00\xff\xff\x03\x00\x01\x00\x01\x00\xff\xff\x04\x00\x01\x00\xfc\xff\x01\x00\t\x00\xfe\xff\xfa\xff\x01\x00\a\x00\xff\xff\xff\xff\xff\xff\x00\x00\x00\x00\x04\x00\xfe\xff\xfc\xff\xff\xff\x05\x00\x00\x00\xfd\xff\xfd\xff\x05\x00\x02\x00\xfc\xff\xfa\xff\x05\x00\x03\x00\xfc\xff\xfb\xff\x02\x00\x01\x00\x00\x00\xfc\xff\xff\xff\x00\x00\x02\x00\xfc\xff\xfd\xff\x00\x00\x02\x00\xfb\xff\xfc\xff\x01\x00\x03\x00\xf8\xff\xfc\xff\x01\x00\x01\x00\xf9\xff\xfe\xff\x01\x00\xff\xff\xf9\xff\xfe\xff\xff\xff\xfe\xff\xfa\xff\x00\x00\xfc\xff\xfc\xff\xfe\xff\x00\x00\xf9\xff\xfd\xff\x00\x00\xfe\xff\xf9\xff\xff\xff\xfc\xff\xfc\xff\xfd\xff\xfe\xff\xf9\xff\xff\xff\x00\x00\xfb\xff\xf8\xff\x00\x00\xfe\xff\xfa\xff\xf9\xff\x01\x00\xfd\xff\xf8\xff\xfa\xff\x02\x00\xfd\xff\xf8\xff\xfa\xff\xfe\xff\xfc\xff\xfc\xff\xfb\xff\xfb\xff\xf9\xff\xfc\xff\xfd\xff\xfd\xff\xfb\xff\xf9\xff\xfa\xff\x00\x00\xfc\xff\xf6\xff\xfa\xff\xff\xff\xfa\xff\xf9\xff\xfd\xff\xfb\xff\xf8\xff\xfc\xff\xfb\xff\xfa\xff\xfb\xff\xfb\xff\xf8\xff\xfa\xff\xfe\xff\xfc\xff\xf5\xff\xf6\xff\x00\x00\xfe\xff\xf6\xff\xf7\xff\xfb\xff\xfc\xff\xfb\xff\xf9\xff\xf8\xff\xf9\xff\xfb\xff\xf9\xff\xfc\xff\xfa\xff\xf5\xff\xfa\xff\x00\x00\xf9\xff\xf3\xff\xfa\xff\x01\x00\xf7\xff\xf2\xff\xfa\xff\x01\x00\xf8\xff\xf5\xff\xfb\xff\xfa\xff\xf6\xff\xfd\xff\xfc\xff\xf5\xff\xf5\xff\xfb\xff\xfc\xff\xfb\xff\xf8\xff\xf6\xff\xf9\xff\xfe\xff\xf8\xff\xf6\xff\xfa\xff\xfb\xff\xf7\xff\xf7\xff\xfb\xff\xfb\xff\xf7\xff\xf8\xff\xfa\xff\xf8\xff\xf8\xff\xfc\xff\xf9\xff\xf6\xff\xfa\xff\xfa\xff\xf5\xff\xf8\xff\xfd\xff\xfb\xff\xf3\xff\xf7\xff\xfc\xff\xfc\xff\xf5\xff\xf5\xff\xfb\xff\xfb\xff\xf6\xff\xfa\xff\xfb\xff\xf9\xff\xf3\xff\xf9\xff\xfd\xff\xfb\xff\xf5\xff\xf6\xff\xfc\xff\xfe\xff\xf5\xff\xf9\xff\xfc\xff\xf7\xff\xf3\xff\xff\xff\xff\xff\xf6\xff\xf3\xff\xfd\xff\xfd\xff\xf9\xff\xf7\xff\xfa\xff\xf9\xff\xfb\xff\xfb\xff\xf9\xff\xf6\xff\xfe\xff\xfc\xff\xf5\xff\xf9\xff\xff\xff\xf9\xff\xf7\xff\xfa\xff\xfc\xff\xf9\xff\xfc\xff\xf8\xff\xf8\xff\xfb\xff\xfc\xff\xf8\xff\xf8\xff\xf9\xff\xff\xff\xfa\xff\xf5\xff\xf6\xff\x02\x00\xfe\xff\xf3\xff\xf4\xff\x01\x00\xfc\xff\xf6\xff\xf6\xff\xff\xff\xfd\xff\xfa\xff\xf6\xff\xfb\xff\xfd\xff\xfd\xff\xf5\xff\xf8\xff\xfd\xff\x00\x00\xf8\xff\xf8\xff\xfc\xff\xff\xff\xf8\xff\xfa\xff\xfd\xff\xfc\xff\xf7\xff\xfe\xff\xfe\xff\xfa\xff\xf7\xff\xfe\xff\xfd\xff\xfc\xff\xf9\xff\xfc\xff\xfb\xff\xfd\xff\xfa\xff\xfd\xff\xfd\xff\xfc\xff\xf6\xff\xfd\xff\x00\x00\xfe\xff\xf5\xff\xfb\xff\x00\x00\x00\x00\xf8\xff\xfa\xff\xfb\xff\x01\x00\xfd\xff\xfc\xff\xf7\xff\xfe\xff\x02\x00\x00\x00\xf4\xff\xfc\xff\x03\x00\x02\x00\xf6\xff\xfc\xff\xfe\xff\x02\x00\xfc\xff\xfd\xff\xfc\xff\x02\x00\xfd\xff\xfd\xff\xfc\xff\x01\x00\xfb\xff\xff\xff\xfe\xff\x03\x00\xfa\xff\xfc\xff\x01\x00\x03\x00\xf8\xff\xfc\xff\x00\x00\x03\x00\xfc\xff\xff\xff\xfa\xff\xff\xff\x02\x00\x01\x00\xf7\xff\xfe\xff\x02\x00\x04\x00\xf8\xff\xfb\xff\x01\x00\x04\x00\xfb\xff\xfd\xff\xfe\xff\x01\x00\xfe\xff\x01\x00\xfd\xff\xfe\xff\xff\xff\x02\x00\xfd\xff\x01\x00\xff\xff\x00\x00\xfd\xff\x00\x00\x01\x00\x03\x00\xfd\xff\xff\xff\x00\x00\x05\x00\xff\xff\xff\xff\xff\xff\x03\x00\x00\x00\x02\x00\xfe\xff\x01\x00\x01\x00\x04\x00\xff\xff\x00\x00\xff\xff\x03\x00\x00\x00\x03\x00\xfd\xff\x01\x00\x04\x00\x04\x00\xfc\xff\x01\x00\x03\x00\x03\x00\xfd\xff\x03\x00\x03\x00\x02\x00\xfe\xff\x04\x00\x03\x00\x01\x00\xfd\xff\a\x00\x05\x00\x00\x00\xfe\xff\a\x00\x04\x00\x01\x00\xff\xff\x06\x00\x04\x00\x03\x00\x00\x00\x04\x00\x06\x00\x05\x00\xfe\xff\x06\x00\a\x00\x02\x00\xff\xff\a\x00\x04\x00\x03\x00\x02\x00\x06\x00\x05\x00\a\x00\x01\x00\x03\x00\x06\x00\x05\x00\xff\xff\b\x00\a\x00\x03\x00\x02\x00\n\x00\x05\x00\x00\x00\x04\x00\n\x00\x04\x00\x01\x00\x03\x00\n\x00\x05\x00\x03\x00\x06\x00\x06\x00\x02\x00\x06\x00\b\x00\a\x00\x01\x00\x02\x00\b\x00\n\x00\x04\x00\x01\x00\x04\x00\t\x00\b\x00\x06\x00\x01\x00\x03\x00\b\x00\n\x00\x06\x00\x03\x00\x02\x00\b\x00\n\x00\b\x00\x02\x00\x02\x00\a\x00\v\x00\x06\x00\x03\x00\x06\x00\n\x00\x06\x00\x06\x00\a\x00\x06\x00\x05\x00\t\x00\x06\x00\x05\x00\b\x00\t\x00\x04\x00\b\x00\n\x00\x03\x00\x01\x00\r\x00\n\x00\x03\x00\x06\x00\v\x00\x04\x00\x04\x00\v\x00\n\x00\x01\x00\x06\x00\v\x00\a\x00\x04\x00\b\x00\b\x00\x06\x00\b\x00\b\x00\x06\x00\b\x00\a\x00\x06\x00\x06\x00\b\x00\t\x00\b\x00\x05\x00\a\x00\n\x00\x06\x00\x06\x00\a\x00\x06\x00\b\x00\f\x00\x06\x00\x00\x00\b\x00\x0e\x00\a\x00\x02\x00\x05\x00\n\x00\b\x00\a\x00\a\x00\a\x00\x04\x00\x06\x00\v\x00\n\x00\x04\x00\x05\x00\x05\x00\b\x00\v\x00\t\x00\x01\x00\x06\x00\x0e\x00\b\x00\xff\xff\t\x00\f\x00\x05\x00\x03\x00\f\x00\t\x00\x05\x00\t\x00\t\x00\x01\x00\b\x00\f\x00\x06\x00\x03\x00\f\x00\t\x00\x04\x00\b\x00\n\x00\x02\x00\a\x00\v\x00\a\x00\x03\x00\n\x00\a\x00\x04\x00\b\x00\t\x00\x03\x00\t\x00\b\x00\x05\x00\x03\x00\n\x00\b\x00\x05\x00\x03\x00\b\x00\b\x00\n\x00\x03\x00\x03\x00\x04\x00\r\x00\a\x00\x01\x00\x03\x00\f\x00\x06\x00\x06\x00\x05\x00\a\x00\x03\x00\a\x00\x05\x00\a\x00\x06\x00\a\x00\x01\x00\a\x00\t\x00\b\x00\x00\x00\x04\x00\x06\x00\t\x00\x03\x00\x04\x00\x04\x00\b\x00\x05\x00\a\x00\x01\x00\x04\x00\a\x00\t\x00\x00\x00\x03\x00\x06\x00\t\x00\x03\x00\x05\x00\x01\x00\x05\x00\a\x00\a\x00\xff\xff\x05\x00\a\x00\x06\x00\xfe\xff\a\x00\b\x00\x03\x00\xff\xff\t\x00\x05\x00\x01\x00\x01\x00\t\x00\x02\x00\x02\x00\x03\x00\a\x00\x03\x00\x04\x00\xff\xff\x05\x00\x05\x00\x06\x00\x00\x00\x04\x00\x03\x00\x06\x00\x01\x00\x02\x00\x02\x00\a\x00\x00\x00\x03\x00\x03\x00\x06\x00\x00\x00\x04\x00\x01\x00\x03\x00\x01\x00\a\x00\xff\xff\x00\x00\x03\x00\a\x00\xfd\xff\x03\x00\x03\x00\x02\x00\xfe\xff\a\x00\x01\x00\xff\xff\xff\xff\a\x00\x01\x00\x01\x00\xfe\xff\x04\x00\xff\xff\x03\x00\x01\x00\x01\x00\xfb\xff\x04\x00\x05\x00\x01\x00\xf8\xff\x03\x00\x04\x00\x04\x00\xfa\xff\xfd\xff\x03\x00\b\x00\xfa\xff\xfd\xff\x02\x00\x04\x00\xfa\xff\x00\x00\x02\x00\x02\x00\xfd\xff\x01\x00\xfd\xff\x03\x00\x01\x00\xfe\xff\xfa\xff\x03\x00\x03\x00\xff\xff\xf8\xff\x04\x00\x03\x00\xfd\xff\xfa\xff\x03\x00\x01\x00\xfd\xff\xfa\xff\x02\x00\x00\x00\xff\xff\xfa\xff\x01\x00\x01\x00\xfe\xff\xfa\xff\x00\x00\xfd\xff\xff\xff\xfe\xff\xfe\xff\xfa\xff\x01\x00\xff\xff\xfe\xff\xf9\xff\xff\xff\xfd\xff\xfe\xff\xfc\xff\xfb\xff\xfb\xff\x03\x00\xfc\xff\xf9\xff\xf9\xff\x02\x00\xfe\xff\xfb\xff\xf7\xff\xfe\xff\x00\x00\xfe\xff\xf7\xff\xfc\xff\xfe\xff\x00\x00\xfa\xff\xfa\xff\xfa\xff\x02\x00\xfe\xff\xf7\xff\xf6\xff\x03\x00\x00\x00\xf9\xff\xf6\xff\xfe\xff\xff\xff\xfe\xff\xf8\xff\xfa\xff\xfb\xff\xfe\xff\xfc\xff\xfc\xff\xf7\xff\xfc\xff\x00\x00\xfb\xff\xf5\xff\xfd\xff\xfe\xff\xfb\xff\xf8\xff\xfc\xff\xfb\xff\xfd\xff\xf7\xff\xf8\xff\xfe\xff\xff\xff\xf5\xff\xf7\xff\xfd\xff\xff\xff\xf5\xff\xf8\xff\xfe\xff\xfd\xff\xf5\xff\xfa\xff\xfc\xff\xfb\xff\xf7\xff\xfc\xff\xf9\xff\xf8\xff\xfb\xff\xfd\xff\xf8\xff\xfa\xff\xf8\xff\xfb\xff\xfc\xff\xfc\xff\xf6\xff\xf7\xff\xfe\xff\xff\xff\xf3\xff\xf6\xff\x00\x00\xff\xff\xf2\xff\xf5\xff\x00\x00\xff\xff\xf5\xff\xf5\xff\xfa\xff\xff\xff\xfb\xff\xf6\xff\xf6\xff\xfd\xff\xfc\xff\xf6\xff\xf9\xff\xfc\xff\xf8\xff\xf8\xff\xfa\xff\xf9\xff\xfa\xff\xfd\xff\xf8\xff\xf5\xff\xfa\xff\xfd\xff\xfb\xff\xf7\xff\xf5\xff\xfb\xff\xfe\xff\xf9\xff\xf4\xff\xfa\xff\xfd\xff\xf7\xff\xf6\xff\xfc\xff\xfd\xff\xf5\xff\xf4\xff\xfe\xff\xfe\xff\xf6\xff\xf4\xff\xfc\xff\xfc\xff\xf4\xff\xf9\xff\xfe\xff\xf7\xff\xf2\xff\xfb\xff\xff\xff\xf7\xff\xf5\xff\xfc\xff\xf9\xff\xf8\xff\xf9\xff\xfa\xff\xf6\xff\xf8\xff\xfc\xff\xfb\xff\xf4\xff\xfa\xff\xff\xff\xfa\xff\xf2\xff\xf8\xff\xfe\xff\xfd\xff\xf4\xff\xf6\xff\xfa\xff\xfe\xff\xfa\xff\xf7\xff\xf7\xff\xfc\xff\xf9\xff\xf9\xff\xf8\xff\xfc\xff\xf7\xff\xf9\xff\xfb\xff\xfc\xff\xf8\xff\xfb\xff\xf8\xff\xf9\xff\xf9\xff\xff\xff\xfa\xff\xf6\xff\xf6\xff\x00\x00\xff\xff\xf8\xff\xf3\xff\xfe\xff\xfe\xff\xfb\xff\xf8\xff\xfc\xff\xf7\xff\xf9\xff\xfe\xff\x01\x00\xf5\xff\xf6\xff\xfd\xff\x03\x00\xf7\xff\xf5\xff\xfb\xff\x02\x00\xf8\xff\xf9\xff\xfc\xff\xfd\xff\xf7\xff\xfe\xff\xfb\xff\xfa\xff\xfb\xff\x00\x00\xf8\xff\xf7\xff\xfc\xff\x02\x00\xf8\xff\xf8\xff\xfa\xff\x01\x00\xfb\xff\xfb\xff\xf9\xff\xfd\xff\xfb\xff\xfe\xff\xfa\xff\xfc\xff\xfc\xff\xfe\xff\xf7\xff\xfe\xff\xff\xff\xfc\xff\xf8\xff\xff\xff\xfd\xff\xfe\xff\xfb\xff\xfd\xff\xfa\xff\x01\x00\xfd\xff\xfb\xff\xfb\xff\x02\x00\xfd\xff\xfc\xff\xf9\xff\xff\xff\xfe\xff\x01\x00\xfc\xff\xfd\xff\xfa\xff\x02\x00\x00\x00\xfb\xff\xf6\xff\x03\x00\x02\x00\xfc\xff\xf6\xff\x03\x00\x02\x00\xfd\xff\xf9\xff\x02\x00\xfb\xff\xff\xff\xff\xff\xfe\xff\xf9\xff\x04\x00\x00\x00\xfd\xff\xfb\xff\x01\x00\xfc\xff\x01\x00\xfe\xff\xfd\xff\xfc\xff\x06\x00\xfe\xff\xfc\xff\xfe\xff\x05\x00\xfb\xff\xfd\xff\x01\x00\x04\x00\xf8\xff\xfe\xff\x03\x00\x04\x00\xfa\xff\xff\xff\x01\x00\x03\x00\xfa\xff\x01\x00\x02\x00\x03\x00\xfb\xff\xff\xff\x01\x00\x04\x00\xfc\xff\x01\x00\x01\x00\x03\x00\xfc\xff\x05\x00\x02\x00\xfe\xff\xfd\xff\a\x00\xfe\xff\x00\x00\x02\x00\x04\x00\xfc\xff\x04\x00\x03\x00\x01\x00\xfe\xff\a\x00\x00\x00\xff\xff\x00\x00\t\x00\x01\x00\xfe\xff\x00\x00\n\x00\x00\x00\xfe\xff\x01\x00\b\x00\x00\x00\x01\x00\x03\x00\x05\x00\xff\xff\x04\x00\x02\x00\x04\x00\x00\x00\x03\x00\x02\x00\x05\x00\x01\x00\x03\x00\x01\x00\x06\x00\x02\x00\x01\x00\x02\x00\t\x00\x00\x00\x01\x00\x04\x00\a\x00\xff\xff\x04\x00\x04\x00\x04\x00\x03\x00\a\x00\x00\x00\x05\x00\x04\x00\x03\x00\x02\x00\b\x00\x03\x00\x04\x00\x02\x00\b\x00\x05\x00\x05\x00\x00\x00\x04\x00\x06\x00\a\x00\x02\x00\x03\x00\x02\x00\v\x00\x05\x00\x03\x00\x03\x00\b\x00\x02\x00\x05\x00\a\x00\x06\x00\xff\xff\b\x00\t\x00\x04\x00\xff\xff\n\x00\t\x00\x03\x00\x00\x00\n\x00\a\x00\x03\x00\x03\x00\t\x00\x03\x00\x05\x00\t\x00\x06\x00\x00\x00\v\x00\t\x00\x01\x00\xff\xff\r\x00\n\x00\x01\x00\x00\x00\n\x00\v\x00\x06\x00\xff\xff\b\x00\b\x00\x06\x00\x04\x00\b\x00\x04\x00\x06\x00\n\x00\t\x00\x00\x00\x05\x00\t\x00\t\x00\x03\x00\x05\x00\a\x00\t\x00\x05\x00\x06\x00\a\x00\b\x00\x03\x00\a\x00\b\x00\x05\x00\x03\x00\n\x00\b\x00\x04\x00\x04\x00\t\x00\b\x00\x05\x00\x03\x00\a\x00\t\x00\a\x00\x02\x00\a\x00\v\x00\x06\x00\x02\x00\b\x00\b\x00\x06\x00\x04\x00\x06\x00\a\x00\b\x00\x06\x00\x04\x00\x05\x00\n\x00\a\x00\x05\x00\x05\x00\x06\x00\x06\x00\b\x00\a\x00\x06\x00\x04\x00\x05\x00\b\x00\v\x00\x06\x00\x01\x00\x05\x00\n\x00\x06\x00\x04\x00\a\x00\a\x00\x03\x00\b\x00\n\x00\x05\x00\x03\x00\x06\x00\b\x00\x06\x00\x06\x00\x05\x00\x04\x00\b\x00\b\x00\x05\x00\x03\x00\x04\x00\t\x00\b\x00\x05\x00\x04\x00\x05\x00\x06\x00\a\x00\x06\x00\x03\x00\x04\x00\t\x00\x06\x00\x02\x00\x06\x00\t\x00\x05\x00\x02\x00\x06\x00\t\x00\x05\x00\x03\x00\x04\x00\x06\x00\a\x00\x06\x00\x03\x00\x04\x00\x06\x00\a\x00\x05\x00\x04\x00\x04\x00\a\x00\a\x00\x04\x00\x01\x00\a\x00\x06\x00\x03\x00\x04\x00\a\x00\x04\x00\x04\x00\x05\x00\x05\x00\x03\x00\x06\x00\x05\x00\x03\x00\x04\x00\x06\x00\x03\x00\x04\x00\x03\x00\x05\x00\x04\x00\x04\x00\x05\x00\x05\x00\x02\x00\x03\x00\x04\x00\a\x00\x03\x00\x02\x00\x02\x00\x05\x00\x05\x00\x04\x00\x01\x00\x02\x00\x04\x00\t\x00\x04\x00\xfe\xff\x01\x00\n\x00\x02\x00\xfd\xff\x03\x00\t\x00\x02\x00\xff\xff\x03\x00\x06\x00\x02\x00\x03\x00\x01\x00\x02\x00\x02\x00\x04\x00\x02\x00\x02\x00\x01\x00\x05\x00\x02\x00\x00\x00\x01\x00\a\x00\x02\x00\xff\xff\x00\x00\x04\x00\x01\x00\x03\x00\x02\x00\x02\x00\x00\x00\x03\x00\x02\x00\x02\x00\x00\x00\x03\x00\x00\x00\xfe\xff\x00\x00\t\x00\x04\x00\xfc\xff\xfc\xff\b\x00\x05\x00\xfc\xff\xfb\xff\x06\x00\x05\x00\x00\x00\xfb\xff\x03\x00\x05\x00\x01\x00\xfc\xff\x02\x00\x00\x00\x01\x00\x01\x00\x02\x00\xfc\xff\xff\xff\x01\x00\x04\x00\xff\xff\xff\xff\xfd\xff\x03\x00\x01\x00\xff\xff\xfb\xff\xff\xff\x01\x00\x05\x00\xfe\xff\xfb\xff\xfd\xff\x06\x00\xff\xff\xfc\xff\xfc\xff\x03\x00\x01\x00\xfd\xff\xfa\xff\x03\x00\x02\x00\xfe\xff\xf8\xff\x00\x00\x01\x00\x02\x00\xfd\xff\xfb\xff\xfb\xff\x04\x00\x01\x00\xfc\xff\xf8\xff\x01\x00\x00\x00\xfe\xff\xfc\xff\x01\x00\xfe\xff\xfe\xff\xfd\xff\xff\xff\xfc\xff\x00\x00\xfd\xff\xfc\xff\xfc\xff\x02\x00\xfe\xff\xfc\xff\xfb\xff\x02\x00\xfc\xff\xfa\xff\xfd\xff\x02\x00\xfb\xff\xfa\xff\xfc\xff\x04\x00\xfc\xff\xf9\xff\xfc\xff\x02\x00\xfd\xff\xfa\xff\xf8\xff\x00\x00\xff\xff\xfd\xff\xf7\xff\xff\xff\x00\x00\xfc\xff\xf8\xff\x00\x00\xfc\xff\xfa\xff\xfa\xff\x00\x00\xfb\xff\xfd\xff\xfb\xff\xfc\xff\xfa\xff\x01\x00\xfb\xff\xfa\xff\xf7\xff\xfe\xff\x00\x00\xfe\xff\xf4\xff\xfe\xff\x02\x00\xfb\xff\xf2\xff\x00\x00\x01\x00\xfa\xff\xf5\xff\xff\xff\xfe\xff\xfe\xff\xf7\xff\xfa\xff\xfc\xff\x00\x00\xf9\xff\xf9\xff\xfa\xff\x01\x00\xfa\xff\xf8\xff\xfa\xff\x00\x00\xf9\xff\xfb\xff\xfb\xff\xfa\xff\xf9\xff\x00\x00\xfa\xff\xfa\xff\xfa\xff\xfc\xff\xf7\xff\xfe\xff\xfd\xff\xf9\xff\xf8\xff\xfd\xff\xfb\xff\xfe\xff\xf8\xff\xf7\xff\xf9\xff\x00\x00\xf9\xff\xfa\xff\xfc\xff\xf9\xff\xf7\xff\x02\x00\xfa\xff\xf4\xff\xf8\xff\x01\x00\xf9\xff\xf8\xff\xf9\xff\xfe\xff\xfa\xff\xf8\xff\xf7\xff\xff\xff\xfc\xff\xf5\xff\xf6\xff\x01\x00\xf9\xff\xf7\xff\xfa\xff\xfc\xff\xf6\xff\xfc\xff\xfc\xff\xf8\xff\xf7\xff\xfd\xff\xf8\xff\xf9\xff\xfa\xff\xfc\xff\xf7\xff\xfb\xff\xfa\xff\xf9\xff\xf9\xff\xfc\xff\xf8\xff\xfa\xff\xfa\xff\xfc\xff\xf7\xff\xfa\xff\xfb\xff\xfa\xff\xf7\xff\xfc\xff\xfb\xff\xfb\xff\xf7\xff\xfc\xff\xf9\xff\xfa\xff\xfa\xff\xfb\xff\xf6\xff\xfc\xff\xfe\xff\xfa\xff\xf4\xff\xfd\xff\xfe\xff\xf9\xff\xf5\xff\xfe\xff\xfc\xff\xf8\xff\xf7\xff\xff\xff\xfb\xff\xf7\xff\xf9\xff\xff\xff\xf9\xff\xf8\xff\xfa\xff\xfb\xff\xf7\xff\xfe\xff\xfd\xff\xf7\xff\xf5\xff\xff\xff\xff\xff\xf8\xff\xf4\xff\xfc\xff\xff\xff\xfc\xff\xf4\xff\xfc\xff\xff\xff\xf9\xff\xf4\xff\xfe\xff\x00\x00\xf9\xff\xf5\xff\xfb\xff\xfc\xff\xff\xff\xf8\xff\xf6\xff\xfb\xff\x03\x00\xfa\xff\xf5\xff\xf9\xff\xff\xff\xfc\xff\xfb\xff\xf8\xff\xfd\xff\xfa\xff\xfc\xff\xfc\xff\xfc\xff\xf7\xff\xf9\xff\x00\x00\x00\x00\xf4\xff\xfa\xff\xff\xff\xfd\xff\xf9\xff\xfc\xff\xfa\xff\xfc\xff\xfe\xff\xfd\xff\xf7\xff\xfb\xff\xfd\xff\xff\xff\xfa\xff\xf9\xff\xfd\xff\x00\x00\xfa\xff\xfa\xff\xfd\xff\xfe\xff\xf9\xff\xfb\xff\xfe\xff\xff\xff\xf9\xff\xfc\xff\x00\x00\xfd\xff\xf9\xff\xfe\xff\xfd\xff\xfa\xff\xfd\xff\x03\x00\xfb\xff\xf6\xff\xfc\xff\x04\x00\xfc\xff\xf8\xff\xfe\xff\x00\x00\xfa\xff\xfe\xff\x00\x00\xfb\xff\xf8\xff\xfe\xff\x00\x00\xfe\xff\xfb\xff\xfd\xff\xff\xff\xfd\xff\xfb\xff\xfe\xff\xfe\xff\xfd\xff\xfc\xff\xfc\xff\xff\xff\x02\x00\xfc\xff\xf9\xff\x00\x00\x01\x00\xfb\xff\xfc\xff\x02\x00\xff\xff\xfb\xff\xfe\xff\x01\x00\xfd\xff\xfd\xff\xff\xff\x00\x00\xfe\xff\x00\x00\xff\xff\xfd\xff\xff\xff\x01\x00\xfd\xff\xff\xff\xff\xff\x00\x00\xfe\xff\x00\x00\x00\x00\xff\xff\xfe\xff\x01\x00\xfe\xff\xfe\xff\x02\x00\x03\x00\xfb\xff\xfd\xff\x04\x00\x03\x00\xfb\xff\xff\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x00\x00\xff\xff\x00\x00\x02\x00\x00\x00\x00\x00\xfe\xff\xff\xff\x03\x00\x04\x00\xfb\xff\xfb\xff\x05\x00\x05\x00\xfc\xff\xfe\xff\x04\x00\x03\x00\xfd\xff\xfe\xff\x03\x00\x04\x00\xfe\xff\xfe\xff\x04\x00\x04\x00\xfe\xff\x01\x00\x04\x00\x01\x00\xff\xff\x01\x00\x01\x00\x05\x00\x04\x00\xfd\xff\xfe\xff\b\x00\x04\x00\xfe\xff\xff\xff\x04\x00\x04\x00\x04\x00\x00\x00\xfe\xff\x03\x00\x06\x00\x02\x00\x01\x00\x01\x00\x02\x00\x03\x00\x06\x00\x01\x00\xff\xff\x01\x00\x05\x00\x03\x00\x02\x00\x02\x00\x03\x00\x02\x00\x04\x00\x03\x00\x03\x00\x01\x00\x03\x00\x04\x00\x03\x00\x00\x00\x04\x00\x04\x00\x01\x00\x02\x00\x06\x00\x02\x00\x03\x00\x04\x00\x04\x00\x02\x00\x02\x00\x03\x00\a\x00\x03\x00\x00\x00\x01\x00\n\x00\x05\x00\xfe\xff\xff\xff\t\x00\t\x00\x00\x00\xfd\xff\t\x00\a\x00\x02\x00\x01\x00\x05\x00\x02\x00\x04\x00\x06\x00\x06\x00\x02\x00\x04\x00\x02\x00\a\x00\x06\x00\x01\x00\x00\x00\b\x00\a\x00\x03\x00\x00\x00\x06\x00\x06\x00\x05\x00\x02\x00\x05\x00\x04\x00\x05\x00\x06\x00\x06\x00\x00\x00\x05\x00\x06\x00\x04\x00\x03\x00\a\x00\x04\x00\x04\x00\x04\x00\x05\x00\x04\x00\a\x00\x04\x00\x03\x00\x05\x00\a\x00\x04\x00\x03\x00\x04\x00\b\x00\x03\x00\x01\x00\x06\x00\v\x00\x03\x00\xff\xff\x04\x00\n\x00\x05\x00\x03\x00\x05\x00\x05\x00\x03\x00\b\x00\a\x00\x01\x00\x00\x00\n\x00\b\x00\x03\x00\x03\x00\a\x00\x04\x00\x06\x00\a\x00\x05\x00\x02\x00\x06\x00\x05\x00\b\x00\x06\x00\x04\x00\x02\x00\x06\x00\a\x00\b\x00\x05\x00\x04\x00\x03\x00\b\x00\a\x00\x06\x00\x04\x00\x04\x00\x03\x00\n\x00\n\x00\x01\x00\xfe\xff\v\x00\f\x00\x02\x00\xfe\xff\t\x00\t\x00\x04\x00\x03\x00\x06\x00\a\x00\x06\x00\x03\x00\x06\x00\x05\x00\x04\x00\x04\x00\a\x00\a\x00\x04\x00\x02\x00\x06\x00\b\x00\a\x00\x01\x00\x04\x00\b\x00\x06\x00\x05\x00\x05\x00\x04\x00\x05\x00\x05\x00\x05\x00\x05\x00\t\x00\x04\x00\x01\x00\b\x00\b\x00\x03\x00\x04\x00\x06\x00\x05\x00\x05\x00\a\x00\x04\x00\x03\x00\x06\x00\x06\x00\x05\x00\x03\x00\x03\x00\t\x00\b\x00\x01\x00\x01\x00\b\x00\a\x00\x02\x00\x03\x00\x06\x00\x05\x00\x06\x00\x06\x00\x03\x00\x01\x00\a\x00\t\x00\x01\x00\x01\x00\n\x00\x05\x00\xff\xff\x05\x00\t\x00\x03\x00\x01\x00\x05\x00\x06\x00\x03\x00\x05\x00\x05\x00\x05\x00\x02\x00\x04\x00\a\x00\x04\x00\x02\x00\x05\x00\x04\x00\x03\x00\x04\x00\a\x00\x04\x00\x03\x00\x03\x00\x03\x00\x04\x00\x05\x00\x04\x00\x03\x00\x01\x00\x04\x00\x06\x00\x04\x00\x02\x00\x03\x00\x03\x00\x04\x00\x04\x00\x03\x00\x04\x00\x03\x00\x00\x00\x04\x00\b\x00\x03\x00\xfd\xff\x02\x00\b\x00\x05\x00\xfe\xff\x01\x00\x06\x00\x03\x00\x01\x00\x04\x00\x02\x00\x00\x00\x04\x00\x04\x00\xfe\xff\x01\x00\x05\x00\x04\x00\x00\x00\x02\x00\x02\x00\x03\x00\x02\x00\x01\x00\x00\x00\x04\x00\x04\x00\xff\xff\x00\x00\a\x00\x04\x00\xfd\xff\xff\xff\a\x00\x02\x00\xfe\xff\x01\x00\x04\x00\x03\x00\x00\x00\x00\x00\x03\x00\x02\x00\x01\x00\x00\x00\x01\x00\x01\x00\x01\x00\x00\x00\x01\x00\x02\x00\x02\x00\xff\xff\x01\x00\x02\x00\xff\xff\x00\x00\x04\x00\xfe\xff\xfc\xff\x02\x00\x06\x00\xff\xff\xfb\xff\xfe\xff\x04\x00\x03\x00\xfe\xff\xfc\xff\x02\x00\x01\x00\xfd\xff\x01\x00\x03\x00\xfa\xff\xfc\xff\x03\x00\x04\x00\xfd\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xfc\xff\xfd\xff\x03\x00\x01\x00\xfe\xff\xfb\xff\x00\x00\x06\x00\xff\xff\xf7\xff\x00\x00\x05\x00\xfe\xff\xfc\xff\x01\x00\xfe\xff\xfe\xff\x01\x00\xff\xff\xfc\xff\x00\x00\x01\x00\xfe\xff\xfb\xff\xff\xff\x03\x00\xfe\xff\xf9\xff\x00\x00\x02\x00\xfa\xff\xfc\xff\x04\x00\xff\xff\xf9\xff\xfd\xff\x02\x00\x00\x00\xfa\xff\xfa\xff\x01\x00\x01\x00\xfb\xff\xfd\xff\x01\x00\xfd\xff\xfa\xff\x00\x00\x02\x00\xfc\xff\xf9\xff\xfe\xff\x02\x00\xfe\xff\xfa\xff\xfd\xff\xfe\xff\xfe\xff\x00\x00\xfd\xff\xf8\xff\xfd\xff\x04\x00\xfe\xff\xf7\xff\xfc\xff\x01\x00\xfe\xff\xfc\xff\xfc\xff\xfd\xff\xff\xff\xfd\xff\xfa\xff\xfe\xff\xff\xff\xfc\xff\xfa\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfa\xff\xfb\xff\x00\x00\xfe\xff\xfa\xff\xfc\xff\xfe\xff\xfb\xff\xfb\xff\xff\xff\xfe\xff\xfa\xff\xfb\xff\xfe\xff\xff\xff\xfb\xff\xfa\xff\xfd\xff\xfd\xff\xfa\xff\xfc\xff\x00\x00\xfd\xff\xf8\xff\xfc\xff\x00\x00\xfc\xff\xf7\xff\xfc\xff\x00\x00\xfc\xff\xfa\xff\xfd\xff\xfc\xff\xfb\xff\xfe\xff\xff\xff\xf7\xff\xf9\xff\x01\x00\xfd\xff\xf9\xff\xfc\xff\xfb\xff\xfc\xff\xff\xff\xfd\xff\xf7\xff\xfd\xff\xff\xff\xfb\xff\xfa\xff\xfc\xff\xfb\xff\xfb\xff\xfe\xff\xfe\xff\xf9\xff\xfb\xff\xfd\xff\xfe\xff\xfc\xff\xf8\xff\xfa\xff\x00\x00\xfd\xff\xf7\xff\xf9\xff\x02\x00\xfd\xff\xf6\xff\xfc\xff\x00\x00\xf9\xff\xfa\xff\x00\x00\xfb\xff\xf4\xff\xff\xff\x02\x00\xf8\xff\xf7\xff\xff\xff\xfe\xff\xfa\xff\xfb\xff\xfc\xff\xfa\xff\xfe\xff\xff\xff\xf8\xff\xf8\xff\x00\x00\xff\xff\xf9\xff\xf9\xff\xfe\xff\xfc\xff\xfb\xff\xfe\xff\xfd\xff\xf9\xff\xfa\xff\xfe\xff\x01\x00\xfa\xff\xf6\xff\xfc\xff\x02\x00\xfd\xff\xf8\xff\xfb\xff\xff\xff\xfb\xff\xfa\xff\xfd\xff\x00\x00\xfc\xff\xf9\xff\xfb\xff\x00\x00\xfe\xff\xfa\xff\xf9\xff\xfd\xff\xfe\xff\xfd\xff\xfb\xff\xfc\xff\xfe\xff\xff\xff\xfb\xff\xf9\xff\xfc\xff\x00\x00\xfe\xff\xfb\xff\xfa\xff\xfc\xff\xfd\xff\xff\xff\xfd\xff\xf9\xff\xfa\xff\x00\x00\xfe\xff\xf9\xff\xff\xff\x01\x00\xf5\xff\xf7\xff\x06\x00\x01\x00\xf3\xff\xfa\xff\x05\x00\xfd\xff\xf8\xff\xfe\xff\xff\xff\xfc\xff\xfb\xff\xfc\xff\xff\xff\x02\x00\xfb\xff\xf6\xff\x00\x00\x05\x00\xfa\xff\xf6\xff\x00\x00\x03\x00\xfa\xff\xfc\xff\x00\x00\xfc\xff\xfc\xff\x01\x00\xff\xff\xfb\xff\xfc\xff\xff\xff\xfd\xff\xfe\xff\xff\xff\xfd\xff\xfc\xff\x00\x00\x00\x00\xfd\xff\xfb\xff\xff\xff\x02\x00\xfe\xff\xfb\xff\xfd\xff\x01\x00\xff\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\x00\x00\xfd\xff\xfe\xff\x00\x00\xfd\xff\xfe\xff\x01\x00\xfe\xff\xfb\xff\x00\x00\x02\x00\xfc\xff\xfd\xff\x02\x00\x01\x00\xfa\xff\xfc\xff\x03\x00\x02\x00\xfc\xff\xfd\xff\x00\x00\x02\x00\xfe\xff\xfd\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\x02\x00\x02\x00\xfc\xff\xfe\xff\x02\x00\x03\x00\xfd\xff\xfc\xff\x00\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x03\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x02\x00\xfe\xff\xfe\xff\x04\x00\x03\x00\xfb\xff\xff\xff\x06\x00\x00\x00\xf9\xff\x02\x00\a\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x05\x00\x00\x00\xfc\xff\x00\x00\x06\x00\x01\x00\xfe\xff\x03\x00\x03\x00\x01\x00\x01\x00\x02\x00\x00\x00\x00\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x06\x00\x01\x00\xfd\xff\x04\x00\x06\x00\xfd\xff\x00\x00\x06\x00\x03\x00\xfd\xff\x01\x00\a\x00\x03\x00\xfe\xff\x02\x00\x06\x00\x03\x00\xfe\xff\x02\x00\x06\x00\x03\x00\x00\x00\x03\x00\x03\x00\x03\x00\x05\x00\x02\x00\xfe\xff\x04\x00\a\x00\x02\x00\x00\x00\x04\x00\x05\x00\x02\x00\x03\x00\x05\x00\x02\x00\x01\x00\x03\x00\x05\x00\x03\x00\x02\x00\x04\x00\x03\x00\x02\x00\x04\x00\x05\x00\x03\x00\x01\x00\x03\x00\x05\x00\x04\x00\x04\x00\x02\x00\x00\x00\x06\x00\t\x00\x02\x00\xfe\xff\x05\x00\b\x00\x01\x00\x01\x00\x06\x00\x04\x00\x03\x00\x05\x00\x04\x00\x03\x00\x05\x00\x04\x00\x03\x00\x03\x00\x03\x00\x05\x00\a\x00\x02\x00\x01\x00\b\x00\b\x00\x02\x00\x01\x00\x05\x00\b\x00\x05\x00\x00\x00\x03\x00\b\x00\x05\x00\x01\x00\x04\x00\a\x00\x06\x00\x05\x00\x04\x00\x02\x00\x06\x00\a\x00\x02\x00\x01\x00\b\x00\a\x00\x00\x00\x04\x00\n\x00\x04\x00\x01\x00\x06\x00\x06\x00\x03\x00\x05\x00\x04\x00\x03\x00\a\x00\a\x00\x02\x00\x02\x00\b\x00\b\x00\x04\x00\x03\x00\x03\x00\x05\x00\n\x00\x06\x00\xff\xff\x02\x00\v\x00\a\x00\x01\x00\x04\x00\a\x00\x05\x00\x04\x00\x06\x00\x06\x00\x02\x00\x05\x00\t\x00\a\x00\x01\x00\x03\x00\b\x00\a\x00\x05\x00\x03\x00\x03\x00\t\x00\t\x00\x02\x00\x00\x00\b\x00\n\x00\x02\x00\x02\x00\b\x00\b\x00\x03\x00\x01\x00\x06\x00\t\x00\x05\x00\x02\x00\x05\x00\a\x00\x05\x00\x03\x00\x05\x00\a\x00\x04\x00\x00\x00\b\x00\v\x00\x00\x00\xff\xff\v\x00\b\x00\xfe\xff\x04\x00\v\x00\x03\x00\x00\x00\x06\x00\b\x00\x05\x00\x02\x00\x02\x00\b\x00\a\x00\x02\x00\x02\x00\x05\x00\x06\x00\x06\x00\x03\x00\x02\x00\x05\x00\b\x00\x04\x00\x01\x00\x03\x00\x06\x00\a\x00\x04\x00\x01\x00\a\x00\x05\x00\x03\x00\x06\x00\x04\x00\x01\x00\x05\x00\x06\x00\x03\x00\x04\x00\a\x00\x03\x00\x02\x00\x04\x00\x04\x00\x06\x00\x06\x00\x01\x00\x01\x00\x05\x00\x06\x00\x04\x00\x03\x00\x03\x00\x03\x00\x05\x00\x05\x00\x02\x00\x02\x00\x06\x00\x04\x00\xff\xff\x03\x00\t\x00\x04\x00\xfe\xff\x03\x00\b\x00\x03\x00\xff\xff\x04\x00\t\x00\x03\x00\xfd\xff\x03\x00\t\x00\x03\x00\xfe\xff\x04\x00\a\x00\x01\x00\x00\x00\x06\x00\a\x00\xff\xff\xfd\xff\a\x00\a\x00\xfe\xff\x00\x00\b\x00\x05\x00\xfd\xff\x03\x00\a\x00\x02\x00\x01\x00\x01\x00\x02\x00\x05\x00\x05\x00\xff\xff\xfe\xff\a\x00\x06\x00\xfe\xff\xfe\xff\x04\x00\x06\x00\x01\x00\xfe\xff\x04\x00\x05\x00\xfe\xff\xff\xff\a\x00\x03\x00\xfc\xff\x00\x00\x06\x00\x03\x00\x00\x00\x01\x00\x00\x00\x01\x00\x04\x00\x03\x00\xfe\xff\x00\x00\x05\x00\x00\x00\xff\xff\x04\x00\x03\x00\xfe\xff\xfe\xff\x06\x00\x04\x00\xfd\xff\xfe\xff\x04\x00\x04\x00\xfd\xff\xff\xff\x05\x00\x01\x00\xff\xff\x00\x00\x01\x00\x01\x00\x01\x00\xff\xff\xff\xff\x00\x00\x02\x00\x01\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x00\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x00\x00\x00\x00\x00\x00\xfe\xff\xfd\xff\x03\x00\x02\x00\xfc\xff\xfa\xff\x01\x00\x04\x00\x00\x00\xfa\xff\xfd\xff\x02\x00\x01\x00\xfd\xff\xff\xff\xfe\xff\xfd\xff\x00\x00\x04\x00\xfb\xff\xfa\xff\x02\x00\x01\x00\xfb\xff\xff\xff\xff\xff\xfe\xff\x00\x00\x01\x00\xf9\xff\xfd\xff\x04\x00\xfd\xff\xf8\xff\x00\x00\x02\x00\xff\xff\xf9\xff\xfd\xff\x03\x00\x01\x00\xf8\xff\xf9\xff\x01\x00\x03\x00\xfb\xff\xfa\xff\xfd\xff\x01\x00\xff\xff\xfb\xff\xfc\xff\x00\x00\xfd\xff\xfc\xff\xfd\xff\xfe\xff\xfc\xff\xff\xff\xfc\xff\xfb\xff\xff\xff\xfe\xff\xf9\xff\xfe\xff\x00\x00\xfb\xff\xf9\xff\x01\x00\xff\xff\xf9\xff\xf9\xff\x01\x00\xff\xff\xf8\xff\xf9\xff\x02\x00\xff\xff\xf7\xff\xfa\xff\x01\x00\xfd\xff\xfa\xff\xfc\xff\xfe\xff\xf9\xff\xfc\xff\xff\xff\xfc\xff\xf9\xff\xfc\xff\xfe\xff\xfe\xff\xf8\xff\xf9\xff\xfe\xff\xfe\xff\xf9\xff\xfb\xff\xfe\xff\xfd\xff\xfa\xff\xfa\xff\xfb\xff\xfe\xff\xfc\xff\xfb\xff\xf9\xff\xfb\xff\xfe\xff\xff\xff\xfa\xff\xf6\xff\xfa\xff\x01\x00\xfe\xff\xf6\xff\xf6\xff\x00\x00\x01\x00\xf8\xff\xf6\xff\xfe\xff\xff\xff\xf9\xff\xf9\xff\xfc\xff\xf9\xff\xfb\xff\xff\xff\xfb\xff\xf5\xff\xfb\xff\x01\x00\xfb\xff\xf4\xff\xf9\xff\x00\x00\xfd\xff\xf6\xff\xfa\xff\xfd\xff\xf8\xff\xfa\xff\xff\xff\xf9\xff\xf5\xff\xfd\xff\x01\x00\xf7\xff\xf7\xff\xfd\xff\xfd\xff\xf9\xff\xfb\xff\xfa\xff\xf9\xff\xfb\xff\xfe\xff\xf8\xff\xf8\xff\xfb\xff\xfe\xff\xfc\xff\xfa\xff\xf8\xff\xfa\xff\xfb\xff\xfc\xff\xfa\xff\xfa\xff\xf9\xff\xfd\xff\xfb\xff\xf9\xff\xf9\xff\xfc\xff\xfb\xff\xfa\xff\xf8\xff\xfc\xff\xfb\xff\xfa\xff\xfa\xff\xfb\xff\xf9\xff\xfc\xff\xfb\xff\xf7\xff\xf8\xff\x01\x00\xfb\xff\xf4\xff\xf8\xff\x00\x00\xfe\xff\xf7\xff\xf4\xff\xfe\xff\xfe\xff\xf7\xff\xf6\xff\x00\x00\xfc\xff\xf6\xff\xf9\xff\x01\x00\xfb\xff\xf4\xff\xf9\xff\x01\x00\xfa\xff\xf7\xff\xf8\xff\xfe\xff\xfc\xff\xfb\xff\xf9\xff\xf9\xff\xfb\xff\xfe\xff\xfa\xff\xf8\xff\xfa\xff\xfe\xff\xf9\xff\xfa\xff\xfd\xff\xfb\xff\xf6\xff\xfc\xff\xfc\xff\xfa\xff\xfb\xff\xfe\xff\xf7\xff\xf9\xff\xfd\xff\xfe\xff\xfa\xff\xf9\xff\xf9\xff\xfe\xff\xfd\xff\xfb\xff\xf8\xff\xfc\xff\xfc\xff\xfe\xff\xfa\xff\xf9\xff\xfb\xff\xfe\xff\xfb\xff\xfb\xff\xfa\xff\xfd\xff\xfb\xff\xfd\xff\xfa\xff\xfb\xff\xfb\xff\xfd\xff\xfb\xff\xfc\xff\xfc\xff\xfe\xff\xfa\xff\xfc\xff\xfd\xff\xfb\xff\xfa\xff\xfe\xff\xfd\xff\xfc\xff\xfb\xff\xfe\xff\xfc\xff\xfc\xff\xfb\xff\xfd\xff\xfc\xff\xfc\xff\xfb\xff\xfe\xff\xfe\xff\xfd\xff\xfb\xff\xfd\xff\xfb\xff\xff\xff\x00\x00\xfa\xff\xf7\xff\x01\x00\x01\x00\xf9\xff\xf9\xff\x03\x00\xfe\xff\xf8\xff\xfd\xff\x03\x00\xfd\xff\xf9\xff\xfd\xff\x00\x00\xfc\xff\xfd\xff\xfe\xff\xfc\xff\xfb\xff\x00\x00\x01\x00\xfc\xff\xf9\xff\xff\xff\x02\x00\xfd\xff\xf9\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\xfc\xff\xfd\xff\x03\x00\xff\xff\xfa\xff\xfe\xff\x01\x00\xfd\xff\xff\xff\x00\x00\xfb\xff\xfc\xff\x03\x00\xfe\xff\xfc\xff\x01\x00\xff\xff\xfb\xff\x01\x00\x03\x00\xfc\xff\xf9\xff\x02\x00\x03\x00\xfe\xff\xfd\xff\xfe\xff\xff\xff\x03\x00\x00\x00\xfb\xff\xfc\xff\x05\x00\x03\x00\xfc\xff\xfd\xff\x02\x00\x01\x00\xff\xff\xfe\xff\xff\xff\x01\x00\x04\x00\xfe\xff\xfb\xff\x01\x00\x05\x00\xff\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xff\xff\xff\xff\x02\x00\x02\x00\xff\xff\xfe\xff\x02\x00\x03\x00\xff\xff\xfc\xff\x03\x00\x05\x00\xfe\xff\xfc\xff\x05\x00\x05\x00\xfc\xff\xfd\xff\x05\x00\x03\x00\xff\xff\x01\x00\x00\x00\xff\xff\x06\x00\x04\x00\xfb\xff\xfe\xff\a\x00\x03\x00\xfc\xff\x02\x00\x05\x00\x01\x00\x01\x00\x02\x00\x02\x00\x00\x00\x01\x00\x04\x00\x01\x00\xfe\xff\x02\x00\a\x00\x03\x00\xfe\xff\x01\x00\x04\x00\x02\x00\x02\x00\x01\x00\xff\xff\x04\x00\a\x00\x00\x00\xff\xff\x04\x00\x03\x00\xff\xff\x05\x00\x05\x00\xfe\xff\x00\x00\a\x00\x03\x00\x00\x00\x02\x00\x04\x00\x00\x00\x03\x00\x06\x00\x02\x00\xff\xff\x03\x00\x05\x00\x04\x00\x01\x00\x04\x00\x01\x00\x01\x00\x05\x00\x06\x00\x01\x00\x00\x00\x03\x00\b\x00\x05\x00\x01\x00\xff\xff\x05\x00\x05\x00\x03\x00\x04\x00\x04\x00\x00\x00\x05\x00\b\x00\x02\x00\x00\x00\x06\x00\x06\x00\x02\x00\x02\x00\a\x00\x05\x00\x01\x00\x01\x00\b\x00\x06\x00\x03\x00\x03\x00\x05\x00\x03\x00\x06\x00\x05\x00\x02\x00\x02\x00\b\x00\x04\x00\x03\x00\x05\x00\x06\x00\x02\x00\x04\x00\x05\x00\x03\x00\x04\x00\n\x00\x01\x00\xff\xff\t\x00\v\x00\xfd\xff\x00\x00\n\x00\a\x00\xff\xff\a\x00\b\x00\x01\x00\x00\x00\n\x00\b\x00\x00\x00\x00\x00\v\x00\a\x00\x01\x00\x03\x00\t\x00\x04\x00\x02\x00\x05\x00\b\x00\x03\x00\x04\x00\x05\x00\x06\x00\x04\x00\x06\x00\x05\x00\x06\x00\x03\x00\x06\x00\a\x00\x05\x00\x02\x00\x06\x00\x05\x00\x04\x00\x06\x00\n\x00\x03\x00\x03\x00\x04\x00\b\x00\a\x00\x03\x00\x01\x00\b\x00\b\x00\x04\x00\x01\x00\t\x00\x06\x00\x02\x00\x05\x00\n\x00\x04\x00\x02\x00\x03\x00\v\x00\b\x00\x01\x00\xff\xff\n\x00\n\x00\x03\x00\x01\x00\b\x00\x04\x00\x06\x00\b\x00\x05\x00\xff\xff\a\x00\t\x00\x05\x00\x01\x00\b\x00\x06\x00\x03\x00\x04\x00\t\x00\x05\x00\x02\x00\x02\x00\v\x00\a\x00\x03\x00\x00\x00\x06\x00\n\x00\b\x00\xfe\xff\x02\x00\n\x00\n\x00\xfd\xff\x03\x00\v\x00\b\x00\x00\x00\x05\x00\x03\x00\x06\x00\a\x00\x05\x00\x00\x00\a\x00\x06\x00\x03\x00\x04\x00\v\x00\x01\x00\xff\xff\a\x00\f\x00\x00\x00\x01\x00\x05\x00\a\x00\x02\x00\x06\x00\x04\x00\x05\x00\x04\x00\x06\x00\x03\x00\x06\x00\x02\x00\x06\x00\x06\x00\x04\x00\xff\xff\b\x00\a\x00\x03\x00\x01\x00\b\x00\x04\x00\x03\x00\x03\x00\t\x00\x02\x00\x02\x00\x05\x00\x06\x00\x00\x00\x06\x00\x04\x00\x02\x00\x02\x00\a\x00\x02\x00\x03\x00\x05\x00\a\x00\xfe\xff\x03\x00\x06\x00\x04\x00\xff\xff\b\x00\x04\x00\xff\xff\x01\x00\v\x00\x04\x00\xff\xff\x00\x00\x06\x00\x03\x00\x06\x00\x01\x00\x00\x00\x02\x00\t\x00\x03\x00\xff\xff\x00\x00\t\x00\x03\x00\x01\x00\xff\xff\x05\x00\x05\x00\x04\x00\xfe\xff\x01\x00\x06\x00\b\x00\xfc\xff\xff\xff\x06\x00\a\x00\xfc\xff\xff\xff\x05\x00\a\x00\x01\x00\xff\xff\xff\xff\a\x00\x04\x00\xfd\xff\xfc\xff\b\x00\x04\x00\xff\xff\xfe\xff\x05\x00\x04\x00\x00\x00\xfd\xff\x04\x00\x04\x00\x02\x00\xfe\xff\x03\x00\x01\x00\x02\x00\x00\x00\x00\x00\x02\x00\x05\x00\xfe\xff\x00\x00\x04\x00\x04\x00\xfd\xff\x01\x00\x03\x00\x03\x00\xfe\xff\xff\xff\x02\x00\a\x00\xfe\xff\xfc\xff\x01\x00\x06\x00\x01\x00\xff\xff\xfd\xff\x00\x00\x02\x00\x05\x00\xfd\xff\xfe\xff\x00\x00\x03\x00\x00\x00\x01\x00\x00\x00\xff\xff\xfd\xff\x03\x00\x00\x00\xfe\xff\xff\xff\x02\x00\xfd\xff\x01\x00\x02\x00\xff\xff\xfd\xff\x03\x00\xfe\xff\xfc\xff\x02\x00\x05\x00\xfb\xff\xfd\xff\x01\x00\x02\x00\xfc\xff\xff\xff\x03\x00\x00\x00\xfa\xff\x01\x00\x02\x00\xff\xff\xfc\xff\x00\x00\xff\xff\xff\xff\xff\xff\x00\x00\xff\xff\x01\x00\xfa\xff\xfe\xff\x04\x00\x00\x00\xf7\xff\xff\xff\x04\x00\xfe\xff\xfa\xff\x02\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\xfa\xff\xfc\xff\x01\x00\xff\xff\xfa\xff\x00\x00\x00\x00\xfe\xff\xfb\xff\xff\xff\xff\xff\xfd\xff\xfc\xff\xff\xff\xfd\xff\xfe\xff\xff\xff\xfe\xff\xfa\xff\xfe\xff\x01\x00\xfe\xff\xfa\xff\xfd\xff\x01\x00\x00\x00\xf9\xff\xfd\xff\x00\x00\xfc\xff\xfc\xff\x02\x00\xff\xff\xf8\xff\xfc\xff\x03\x00\xfe\xff\xf8\xff\xfc\xff\x02\x00\xfe\xff\xfc\xff\xfe\xff\xfc\xff\xfb\xff\x00\x00\xff\xff\xf9\xff\xfd\xff\x02\x00\xfa\xff\xfa\xff\x00\x00\xfe\xff\xf9\xff\xfd\xff\xff\xff\xfc\xff\xfc\xff\xff\xff\xfd\xff\xfb\xff\xfb\xff\xfd\xff\xfe\xff\xfd\xff\xfa\xff\xfa\xff\xfd\xff\x00\x00\xfe\xff\xfa\xff\xf8\xff\xfd\xff\x02\x00\xfe\xff\xf5\xff\xf9\xff\x01\x00\xff\xff\xfb\xff\xfc\xff\xfa\xff\xf9\xff\xfe\xff\x02\x00\xfd\xff\xf7\xff\xf9\xff\x00\x00\x01\x00\xf9\xff\xf9\xff\x00\x00\xfc\xff\xf8\xff\xfe\xff\x00\x00\xfb\xff\xf9\xff\xfd\xff\xfd\xff\xfd\xff\xfc\xff\xfa\xff\xfc\xff\xff\xff\xfb\xff\xf8\xff\xfd\xff\x00\x00\xfb\xff\xfa\xff\xfb\xff\xfc\xff\xfe\xff\xff\xff\xf8\xff\xf7\xff\x01\x00\x02\x00\xf5\xff\xf7\xff\x02\x00\x00\x00\xf9\xff\xf9\xff\xfb\xff\xff\xff\xff\xff\xfa\xff\xf8\xff\xfd\xff\xfe\xff\xfc\xff\xfd\xff\xfc\xff\xf9\xff\xfc\xff\xfd\xff\xfc\xff\xfc\xff\xfe\xff\xfb\xff\xf9\xff\xff\xff\xff\xff\xf9\xff\xfb\xff\xfc\xff\xfc\xff\xfc\xff\xff\xff\xfd\xff\xf9\xff\xfc\xff\x01\x00\xfc\xff\xfb\xff\xfc\xff\xfd\xff\xfa\xff\xfd\xff\x01\x00\xfc\xff\xf6\xff\xff\xff\x01\x00\xfc\xff\xfa\xff\xfd\xff\xfb\xff\xfe\xff\xff\xff\xfc\xff\xf9\xff\xfe\xff\xfd\xff\xfe\xff\xfd\xff\xfa\xff\xfb\xff\x00\x00\xfd\xff\xfc\xff\xfb\xff\xfd\xff\xfe\xff\xfe\xff\xfb\xff\xfa\xff\xfe\xff\x02\x00\xfc\xff\xf8\xff\xfc\xff\x02\x00\xfe\xff\xf9\xff\xfa\xff\x00\x00\x00\x00\xfe\xff\xfa\xff\xfe\xff\xff\xff\xfc\xff\xfc\xff\x00\x00\xfc\xff\xfb\xff\xfe\xff\x01\x00\xfd\xff\xfc\xff\xfc\xff\x00\x00\xfe\xff\xfd\xff\xfb\xff\x00\x00\x00\x00\xfe\xff\xfb\xff\xff\xff\x00\x00\xff\xff\xf9\xff\xfe\xff\x02\x00\x01\x00\xf9\xff\xfd\xff\x01\x00\x00\x00\xfa\xff\xfe\xff\x00\x00\xff\xff\xfa\xff\x00\x00\x01\x00\xff\xff\xfc\xff\x00\x00\xfe\xff\xfc\xff\xfd\xff\x02\x00\xfe\xff\xfc\xff\xfe\xff\x04\x00\xff\xff\xfa\xff\xfd\xff\x05\x00\xff\xff\xfa\xff\xfd\xff\x04\x00\xfe\xff\xfc\xff\x00\x00\x03\x00\xfd\xff\xfd\xff\xff\xff\x02\x00\xff\xff\x00\x00\xfe\xff\xff\xff\xfe\xff\x02\x00\x01\x00\x00\x00\xfc\xff\xff\xff\x01\x00\x04\x00\x00\x00\xfc\xff\xfd\xff\x05\x00\x01\x00\xfd\xff\xff\xff\x04\x00\xfd\xff\xff\xff\x04\x00\x02\x00\xf9\xff\xff\xff\a\x00\x03\x00\xf9\xff\xff\xff\x03\x00\x03\x00\xff\xff\x00\x00\xfe\xff\x02\x00\x04\x00\x01\x00\xfc\xff\x03\x00\x03\x00\xfd\xff\xfe\xff\b\x00\x02\x00\xfb\xff\x00\x00\b\x00\x02\x00\xfe\xff\x00\x00\x03\x00\x02\x00\x02\x00\xff\xff\x02\x00\x02\x00\x01\x00\x02\x00\x03\x00\xff\xff\x02\x00\x04\x00\x01\x00\x00\x00\x03\x00\x01\x00\x03\x00\x03\x00\xfe\xff\xff\xff\t\x00\x04\x00\xfc\xff\xfe\xff\b\x00\a\x00\xff\xff\xfc\xff\x05\x00\x05\x00\x02\x00\x02\x00\x02\x00\xff\xff\x03\x00\x04\x00\x02\x00\x01\x00\x05\x00\x03\x00\x00\x00\x00\x00\a\x00\a\x00\xfe\xff\xfc\xff\b\x00\a\x00\x00\x00\x02\x00\x06\x00\x01\x00\x01\x00\x05\x00\x05\x00\x02\x00\x02\x00\x02\x00\x05\x00\x04\x00\x03\x00\x02\x00\x02\x00\x03\x00\x06\x00\x04\x00\x00\x00\x02\x00\x06\x00\x04\x00\x05\x00\x02\x00\xfe\xff\x04\x00\v\x00\x03\x00\xfc\xff\x02\x00\n\x00\x06\x00\x02\x00\x01\x00\x03\x00\x04\x00\x04\x00\x04\x00\x04\x00\x03\x00\x02\x00\x04\x00\b\x00\x04\x00\x01\x00\x03\x00\x05\x00\x04\x00\x03\x00\x05\x00\x03\x00\x02\x00\x05\x00\a\x00\x05\x00\x01\x00\x03\x00\x06\x00\x03\x00\x03\x00\x06\x00\x05\x00\x01\x00\x05\x00\b\x00\x03\x00\x00\x00\x06\x00\x06\x00\x02\x00\x02\x00\a\x00\a\x00\x03\x00\x00\x00\a\x00\a\x00\x03\x00\x02\x00\x05\x00\x03\x00\x06\x00\x06\x00\x02\x00\x01\x00\b\x00\a\x00\x01\x00\x02\x00\b\x00\x05\x00\x04\x00\x02\x00\x03\x00\b\x00\b\x00\xff\xff\x02\x00\n\x00\x06\x00\xfe\xff\x06\x00\t\x00\x01\x00\x01\x00\b\x00\x06\x00\x04\x00\x04\x00\x02\x00\x03\x00\v\x00\x06\x00\xfd\xff\x02\x00\v\x00\x05\x00\x01\x00\x04\x00\x06\x00\x04\x00\x05\x00\x06\x00\x05\x00\x01\x00\x03\x00\x06\x00\x06\x00\x02\x00\x04\x00\x06\x00\x02\x00\x03\x00\t\x00\x04\x00\x00\x00\x03\x00\a\x00\x04\x00\x03\x00\x04\x00\x04\x00\x03\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x04\x00\x04\x00\x04\x00\a\x00\x03\x00\x00\x00\x04\x00\b\x00\x02\x00\x00\x00\x06\x00\a\x00\x00\x00\x01\x00\x05\x00\a\x00\x04\x00\x00\x00\x00\x00\x06\x00\a\x00\x03\x00\x00\x00\x03\x00\x04\x00\x05\x00\x04\x00\x02\x00\xff\xff\x03\x00\t\x00\x04\x00\xfc\xff\x03\x00\t\x00\x03\x00\xfe\xff\x03\x00\x04\x00\x03\x00\x05\x00\x03\x00\x00\x00\x02\x00\x05\x00\x04\x00\x01\x00\x01\x00\x04\x00\x05\x00\x00\x00\x00\x00\a\x00\x05\x00\xff\xff\x00\x00\x04\x00\x05\x00\x03\x00\x00\x00\xff\xff\x03\x00\x06\x00\x01\x00\xfe\xff\x04\x00\x06\x00\x01\x00\xff\xff\x02\x00\x03\x00\x02\x00\x01\x00\x00\x00\x01\x00\x03\x00\x03\x00\x01\x00\x01\x00\x00\x00\x02\x00\x04\x00\xff\xff\xfe\xff\x05\x00\x03\x00\xfd\xff\x00\x00\x05\x00\x01\x00\x00\x00\x03\x00\xff\xff\xfe\xff\x05\x00\x03\x00\xfd\xff\xff\xff\x04\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x04\x00\xff\xff\xfc\xff\x03\x00\x05\x00\xff\xff\xfd\xff\x02\x00\x03\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\x00\x00\x04\x00\x00\x00\xfb\xff\x01\x00\x04\x00\xff\xff\xfd\xff\x00\x00\x02\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x03\x00\xfd\xff\xfc\xff\x01\x00\x03\x00\x00\x00\xfe\xff\xfd\xff\xff\xff\x02\x00\x01\x00\xfc\xff\xfd\xff\x00\x00\x02\x00\xff\xff\xfd\xff\xff\xff\x00\x00\xfd\xff\x00\x00\x02\x00\xfd\xff\xfb\xff\x02\x00\x02\x00\xfb\xff\xfc\xff\x04\x00\xff\xff\xfa\xff\xfe\xff\x03\x00\xff\xff\xfc\xff\xff\xff\xff\xff\xfd\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfc\xff\xfb\xff\x02\x00\x03\x00\xf9\xff\xf9\xff\x02\x00\x02\x00\xfc\xff\xfc\xff\xff\xff\xfe\xff\xfc\xff\xfe\xff\x00\x00\xfe\xff\xfb\xff\xff\xff\x01\x00\xfe\xff\xfb\xff\xfb\xff\xfd\xff\x00\x00\x00\x00\xfa\xff\xfa\xff\x03\x00\x01\x00\xf8\xff\xfb\xff\x01\x00\xfe\xff\xfa\xff\xfc\xff\x00\x00\x00\x00\xf9\xff\xf7\xff\x03\x00\x06\x00\xf6\xff\xf4\xff\x03\x00\x03\x00\xf8\xff\xf8\xff\xff\xff\x00\x00\xfc\xff\xfc\xff\xfc\xff\xfb\xff\xfe\xff\xfe\xff\xf9\xff\xfc\xff\xff\xff\xfb\xff\xfb\xff\x01\x00\xfc\xff\xf5\xff\xfe\xff\x04\x00\xf9\xff\xf6\xff\x00\x00\x00\x00\xf7\xff\xf8\xff\x01\x00\x01\x00\xf8\xff\xf7\xff\xfe\xff\x01\x00\xfb\xff\xf7\xff\xfc\xff\x00\x00\xfb\xff\xf8\xff\xfd\xff\x00\x00\xf9\xff\xf8\xff\xff\xff\xff\xff\xf9\xff\xfa\xff\xfd\xff\xfb\xff\xfc\xff\xfe\xff\xf8\xff\xf9\xff\x01\x00\x00\x00\xf6\xff\xf8\xff\x00\x00\xff\xff\xf7\xff\xf9\xff\x00\x00\xfe\xff\xf8\xff\xfb\xff\xfd\xff\xfb\xff\xfb\xff\x00\x00\xfa\xff\xf6\xff\xfd\xff\x02\x00\xfb\xff\xf8\xff\xfa\xff\xfd\xff\xfe\xff\xfe\xff\xf9\xff\xfa\xff\xfc\xff\xfd\xff\xfb\xff\xfc\xff\xfc\xff\xfb\xff\xfb\xff\xfc\xff\xfc\xff\xfc\xff\xf8\xff\xfc\xff\xff\xff\xfd\xff\xfa\xff\xfa\xff\xfc\xff\xff\xff\xfa\xff\xf8\xff\xfc\xff\x00\x00\xfc\xff\xf8\xff\xf9\xff\xff\xff\xff\xff\xfb\xff\xf7\xff\xfd\xff\xff\xff\xfb\xff\xfa\xff\xfb\xff\xfa\xff\xff\xff\xfd\xff\xf8\xff\xfb\xff\x01\x00\xfb\xff\xf8\xff\xfd\xff\xfe\xff\xfb\xff\xfb\xff\xfb\xff\xfd\xff\xfe\xff\xfc\xff\xf9\xff\xfc\xff\xff\xff\xfe\xff\xf8\xff\xf8\xff\x00\x00\x00\x00\xf9\xff\xfb\xff\xfd\xff\xfb\xff\xfc\xff\xff\xff\xfa\xff\xf8\xff\x00\x00\x00\x00\xfa\xff\xf9\xff\xfb\xff\xff\xff\xff\xff\xfc\xff\xf7\xff\xfa\xff\x04\x00\x02\x00\xf5\xff\xf5\xff\x03\x00\x05\x00\xf7\xff\xf7\xff\x00\x00\x00\x00\xfc\xff\xfd\xff\xfb\xff\xfb\xff\x01\x00\x01\x00\xf9\xff\xfa\xff\x01\x00\xfe\xff\xf8\xff\xff\xff\x00\x00\xfa\xff\xfc\xff\x01\x00\xfd\xff\xfa\xff\xfe\xff\xff\xff\xfc\xff\xfe\xff\xfd\xff\xfd\xff\xff\xff\xfc\xff\xfb\xff\x01\x00\xff\xff\xf9\xff\xfa\xff\x04\x00\x02\x00\xf7\xff\xf8\xff\x04\x00\x04\x00\xf7\xff\xf7\xff\x06\x00\x02\x00\xf5\xff\xfa\xff\x06\x00\xff\xff\xfa\xff\xff\xff\x00\x00\xfb\xff\xfd\xff\x01\x00\xfe\xff\xfb\xff\xff\xff\xff\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\xfe\xff\xfd\xff\xfe\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\xfd\xff\x00\x00\x00\x00\xfd\xff\xfd\xff\x01\x00\xff\xff\xfd\xff\xfd\xff\x01\x00\x00\x00\xfd\xff\xfc\xff\x00\x00\xff\xff\xfe\xff\xff\xff\x01\x00\xfd\xff\xfd\xff\x01\x00\x02\x00\xfb\xff\xfe\xff\x03\x00\x01\x00\xfb\xff\xfd\xff\x02\x00\x03\x00\xfe\xff\xfb\xff\xff\xff\x06\x00\x01\x00\xfb\xff\xfd\xff\x03\x00\x01\x00\xfe\xff\xfe\xff\x00\x00\x02\x00\x02\x00\xfd\xff\xfe\xff\x04\x00\x03\x00\xfa\xff\xfe\xff\x05\x00\x02\x00\xfd\xff\x01\x00\x03\x00\xfe\xff\xfe\xff\x05\x00\x03\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\x03\x00\x01\x00\xfd\xff\x00\x00\x05\x00\x01\x00\xff\xff\x03\x00\x02\x00\xfe\xff\x01\x00\x04\x00\x01\x00\xff\xff\x01\x00\x01\x00\x03\x00\x03\x00\xff\xff\x01\x00\x03\x00\x00\x00\x00\x00\x04\x00\x02\x00\xff\xff\x03\x00\x04\x00\x00\x00\x02\x00\x01\x00\x00\x00\x05\x00\x05\x00\xff\xff\xff\xff\x05\x00\x05\x00\x00\x00\x00\x00\x02\x00\x03\x00\x03\x00\x04\x00\x01\x00\xff\xff\x05\x00\a\x00\xff\xff\xfe\xff\x05\x00\x06\x00\x01\x00\x02\x00\x03\x00\x03\x00\x03\x00\x02\x00\x02\x00\x06\x00\x04\x00\xfe\xff\x02\x00\t\x00\x03\x00\xff\xff\x04\x00\a\x00\x01\x00\x00\x00\x05\x00\b\x00\x01\x00\xff\xff\x05\x00\b\x00\x02\x00\x01\x00\x04\x00\x05\x00\x03\x00\x05\x00\x02\x00\x01\x00\x05\x00\a\x00\x02\x00\x00\x00\x05\x00\t\x00\x02\x00\x00\x00\x04\x00\a\x00\x03\x00\x01\x00\x05\x00\b\x00\x02\x00\x01\x00\x05\x00\x05\x00\x02\x00\x06\x00\x05\x00\x02\x00\x03\x00\a\x00\x04\x00\x03\x00\x05\x00\x04\x00\x02\x00\a\x00\a\x00\x03\x00\x00\x00\a\x00\b\x00\x02\x00\x01\x00\b\x00\a\x00\x03\x00\x02\x00\x06\x00\x06\x00\x06\x00\x02\x00\x03\x00\x06\x00\a\x00\x03\x00\x04\x00\x05\x00\x06\x00\x03\x00\x05\x00\x05\x00\x05\x00\x03\x00\x06\x00\a\x00\x05\x00\xff\xff\a\x00\n\x00\x03\x00\xfe\xff\a\x00\t\x00\x04\x00\x03\x00\b\x00\x02\x00\x03\x00\b\x00\t\x00\x00\x00\x01\x00\t\x00\b\x00\x02\x00\x04\x00\x04\x00\x06\x00\x06\x00\x06\x00\x03\x00\x03\x00\x04\x00\b\x00\b\x00\x04\x00\xff\xff\x05\x00\v\x00\b\x00\xfe\xff\x02\x00\b\x00\a\x00\x03\x00\x06\x00\x05\x00\x03\x00\x05\x00\b\x00\x03\x00\x03\x00\x05\x00\x06\x00\x04\x00\x06\x00\x04\x00\x04\x00\x06\x00\x06\x00\x02\x00\x05\x00\x06\x00\x05\x00\x04\x00\x05\x00\x04\x00\x06\x00\x04\x00\x06\x00\x04\x00\x02\x00\x04\x00\v\x00\x06\x00\xfd\xff\x03\x00\x0e\x00\x04\x00\xfd\xff\x05\x00\f\x00\x01\x00\x00\x00\t\x00\a\x00\x00\x00\x06\x00\x06\x00\x04\x00\x04\x00\x05\x00\x03\x00\x06\x00\x04\x00\x02\x00\x04\x00\n\x00\x03\x00\x00\x00\x05\x00\n\x00\x02\x00\xfd\xff\x05\x00\x0e\x00\x01\x00\xfe\xff\b\x00\n\x00\xff\xff\x02\x00\a\x00\x04\x00\xff\xff\x06\x00\b\x00\x03\x00\x00\x00\b\x00\x06\x00\x01\x00\x02\x00\t\x00\x04\x00\x00\x00\x02\x00\b\x00\x04\x00\x02\x00\x03\x00\x06\x00\x02\x00\x05\x00\a\x00\x03\x00\xfd\xff\x06\x00\a\x00\x02\x00\x00\x00\a\x00\x03\x00\x00\x00\x05\x00\t\x00\x00\x00\x00\x00\x04\x00\a\x00\x02\x00\x01\x00\x03\x00\x06\x00\x02\x00\x04\x00\x02\x00\x02\x00\x03\x00\x06\x00\x01\x00\x02\x00\x03\x00\x02\x00\x03\x00\b\x00\x00\x00\xfd\xff\x05\x00\v\x00\xff\xff\xff\xff\x04\x00\x04\x00\x01\x00\x06\x00\x01\x00\xfe\xff\x05\x00\n\x00\xfd\xff\xfd\xff\x06\x00\b\x00\xff\xff\xff\xff\x01\x00\b\x00\x05\x00\xfd\xff\xfc\xff\t\x00\x05\x00\xfc\xff\xff\xff\b\x00\x00\x00\xff\xff\x04\x00\x05\x00\xfc\xff\x01\x00\x06\x00\x03\x00\xfc\xff\x02\x00\x03\x00\x01\x00\xfe\xff\x03\x00\x03\x00\x03\x00\xfd\xff\xfe\xff\x02\x00\a\x00\xfe\xff\xfb\xff\x01\x00\a\x00\x00\x00\xfe\xff\x01\x00\x05\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x01\x00\x02\x00\xfd\xff\x00\x00\x04\x00\x02\x00\xfb\xff\x00\x00\x04\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x05\x00\xfb\xff\xfe\xff\x04\x00\x00\x00\xfa\xff\x02\x00\x03\x00\xff\xff\xfd\xff\xff\xff\x00\x00\x04\x00\x00\x00\xfa\xff\xfb\xff\x06\x00\x03\x00\xfa\xff\xfa\xff\x05\x00\x03\x00\xfb\xff\xfc\xff\x02\x00\xff\xff\xff\xff\xff\xff\xfe\xff\xfe\xff\x01\x00\xfd\xff\xfe\xff\x01\x00\xff\xff\xf9\xff\x00\x00\x04\x00\xfd\xff\xf8\xff\x01\x00\x02\x00\xfd\xff\xfc\xff\x00\x00\xfe\xff\x00\x00\xfd\xff\xfa\xff\xfe\xff\x06\x00\xfe\xff\xf9\xff\xfe\xff\x01\x00\xfe\xff\xfe\xff\xfd\xff\xfc\xff\xfd\xff\x01\x00\xff\xff\xfe\xff\xfd\xff\xfc\xff\xfb\xff\x00\x00\x02\x00\xfc\xff\xf8\xff\x00\x00\x02\x00\xfc\xff\xfa\xff\x00\x00\xff\xff\xfa\xff\xfb\xff\x02\x00\x01\x00\xfb\xff\xf9\xff\xff\xff\x00\x00\xfd\xff\xfb\xff\xfe\xff\xff\xff\xfd\xff\xfc\xff\xff\xff\xfe\xff\xfb\xff\xfc\xff\x00\x00\xfe\xff\xfb\xff\xfb\xff\x00\x00\x01\x00\xfb\xff\xf7\xff\xfe\xff\x05\x00\xfd\xff\xf5\xff\xfd\xff\x02\x00\xfd\xff\xfa\xff\xfe\xff\xfc\xff\xfb\xff\x01\x00\xfd\xff\xf8\xff\xff\xff\x01\x00\xfa\xff\xfa\xff\xfe\xff\xfd\xff\xfd\xff\xff\xff\xfb\xff\xf9\xff\xff\xff\xff\xff\xfc\xff\xfe\xff\xfc\xff\xf8\xff\xfe\xff\x03\x00\xfc\xff\xf6\xff\xfc\xff\x02\x00\xfe\xff\xf8\xff\xfb\xff\x00\x00\xfe\xff\xfa\xff\xfb\xff\xfe\xff\xfc\xff\xfd\xff\xfd\xff\xfb\xff\xfb\xff\xfe\xff\xff\xff\xfb\xff\xf8\xff\xfe\xff\x00\x00\xfc\xff\xfb\xff\xfe\xff\xfa\xff\xfb\xff\x01\x00\xfc\xff\xf5\xff\xff\xff\x05\x00\xf9\xff\xf4\xff\x01\x00\x03\x00\xf9\xff\xf6\xff\x00\x00\x02\x00\xfa\xff\xf8\xff\xff\xff\xfe\xff\xfc\xff\xfb\xff\xfb\xff\xfc\xff\x03\x00\xfe\xff\xf4\xff\xfa\xff\x06\x00\xfd\xff\xf8\xff\xfb\xff\xfe\xff\xfe\xff\xfe\xff\xfa\xff\xfc\xff\xfd\xff\xfa\xff\xfd\xff\x04\x00\xfa\xff\xf5\xff\x00\x00\x04\x00\xf8\xff\xf9\xff\xff\xff\xfd\xff\xf9\xff\x00\x00\x00\x00\xfa\xff\xf9\xff\x00\x00\xfe\xff\xfb\xff\xfd\xff\xfe\xff\xf9\xff\xff\xff\x01\x00\xfa\xff\xf8\xff\x02\x00\x01\x00\xf7\xff\xf8\xff\x03\x00\x00\x00\xfc\xff\xf9\xff\xfc\xff\xff\xff\x01\x00\xfb\xff\xfa\xff\xff\xff\x00\x00\xfa\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfd\xff\xfd\xff\xff\xff\xfc\xff\xfc\xff\xff\xff\x00\x00\xfb\xff\xfb\xff\xfe\xff\x00\x00\xfe\xff\xfd\xff\xfa\xff\xfd\xff\x00\x00\x02\x00\xfa\xff\xf9\xff\xff\xff\x02\x00\xfc\xff\xfc\xff\xfc\xff\xfe\xff\x00\x00\xff\xff\xf9\xff\xff\xff\x01\x00\xfd\xff\xfc\xff\x02\x00\xfd\xff\xfa\xff\xfe\xff\x03\x00\xfc\xff\xfa\xff\xff\xff\x04\x00\xfd\xff\xfc\xff\xfe\xff\x00\x00\xfe\xff\xfe\xff\xfd\xff\x00\x00\xfe\xff\xff\xff\xff\xff\xfe\xff\xfc\xff\x03\x00\xff\xff\xfa\xff\xff\xff\x05\x00\xfc\xff\xfb\xff\x01\x00\x03\x00\xfb\xff\xfd\xff\xff\xff\x02\x00\xff\xff\xff\xff\xfb\xff\x01\x00\x03\x00\xfe\xff\xf8\xff\x02\x00\x05\x00\xfe\xff\xf9\xff\x02\x00\x01\x00\x00\x00\xfe\xff\x00\x00\xfe\xff\x01\x00\x00\x00\xfe\xff\xfe\xff\x04\x00\x00\x00\xfd\xff\xfe\xff\x04\x00\xff\xff\xfe\xff\x00\x00\x03\x00\xfe\xff\x00\x00\x03\x00\x01\x00\xfa\xff\x02\x00\x04\x00\x01\x00\xfb\xff\x02\x00\x05\x00\x01\x00\xfb\xff\x01\x00\x02\x00\x01\x00\xfe\xff\x03\x00\x01\x00\x02\x00\x00\x00\xfe\xff\xff\xff\a\x00\xff\xff\xfc\xff\x01\x00\b\x00\xff\xff\xfd\xff\x02\x00\x05\x00\xff\xff\xff\xff\xff\xff\x04\x00\x03\x00\x01\x00\xfd\xff\x03\x00\x04\x00\x01\x00\xfd\xff\x02\x00\x03\x00\x03\x00\x01\x00\x02\x00\x01\x00\x01\x00\x00\x00\x06\x00\x03\x00\xff\xff\xfe\xff\x05\x00\x06\x00\x03\x00\xfe\xff\x00\x00\x04\x00\x06\x00\x01\x00\x04\x00\x01\x00\xfe\xff\x04\x00\t\x00\x01\x00\xfe\xff\x01\x00\x06\x00\x05\x00\x03\x00\xfd\xff\x03\x00\b\x00\x03\x00\xff\xff\x04\x00\x04\x00\x03\x00\x01\x00\x04\x00\x03\x00\x03\x00\x01\x00\x04\x00\x06\x00\x02\x00\x01\x00\x06\x00\x03\x00\x01\x00\x03\x00\x05\x00\x01\x00\x02\x00\x06\x00\x04\x00\xff\xff\x05\x00\x06\x00\x01\x00\x01\x00\x06\x00\x04\x00\x02\x00\x04\x00\x05\x00\x02\x00\x04\x00\x03\x00\x03\x00\x04\x00\x06\x00\x01\x00\x02\x00\a\x00\a\x00\x00\x00\x02\x00\x06\x00\x05\x00\x03\x00\x05\x00\x03\x00\x03\x00\x05\x00\x05\x00\x02\x00\a\x00\x05\x00\x01\x00\x03\x00\a\x00\x04\x00\x04\x00\x04\x00\x03\x00\x04\x00\a\x00\x04\x00\x03\x00\x03\x00\x04\x00\x05\x00\x05\x00\x04\x00\x06\x00\x03\x00\x02\x00\x06\x00\b\x00\x04\x00\x02\x00\x03\x00\a\x00\a\x00\x04\x00\x00\x00\x04\x00\t\x00\x06\x00\x02\x00\x03\x00\x05\x00\x06\x00\x05\x00\x06\x00\x04\x00\x02\x00\x05\x00\b\x00\x06\x00\x01\x00\x01\x00\x06\x00\b\x00\x05\x00\x03\x00\x06\x00\x06\x00\x02\x00\x04\x00\a\x00\x05\x00\x02\x00\x04\x00\a\x00\x05\x00\x04\x00\x06\x00\x06\x00\x04\x00\x02\x00\x04\x00\b\x00\x06\x00\x02\x00\x03\x00\x06\x00\x05\x00\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x00\x05\x00\x06\x00\x04\x00\x02\x00\x04\x00\a\x00\x05\x00\x02\x00\x04\x00\x06\x00\x04\x00\x03\x00\x04\x00\x06\x00\x05\x00\x02\x00\x03\x00\x06\x00\x06\x00\x02\x00\x01\x00\b\x00\a\x00\x00\x00\x01\x00\b\x00\x06\x00\x01\x00\x02\x00\a\x00\x06\x00\x02\x00\x01\x00\a\x00\x06\x00\x01\x00\x02\x00\x06\x00\x04\x00\x04\x00\x05\x00\x02\x00\x01\x00\a\x00\a\x00\x02\x00\x01\x00\x04\x00\x04\x00\x03\x00\x03\x00\x04\x00\x05\x00\x04\x00\x02\x00\x03\x00\x04\x00\x05\x00\x01\x00\x01\x00\x05\x00\x05\x00\x03\x00\x04\x00\x03\x00\x02\x00\x03\x00\x06\x00\x01\x00\x01\x00\x05\x00\x05\x00\x01\x00\x03\x00\x05\x00\x02\x00\x02\x00\x04\x00\x01\x00\x03\x00\x04\x00\x04\x00\x02\x00\x01\x00\x02\x00\x06\x00\x05\x00\x00\x00\xff\xff\x05\x00\x05\x00\x02\x00\x00\x00\x03\x00\x04\x00\x03\x00\x00\x00\x03\x00\x05\x00\x03\x00\x00\x00\xff\xff\x02\x00\b\x00\x03\x00\xfd\xff\x02\x00\a\x00\xff\xff\xff\xff\b\x00\x04\x00\xf9\xff\x01\x00\t\x00\x03\x00\xfd\xff\x01\x00\x04\x00\x02\x00\x00\x00\x03\x00\x01\x00\xff\xff\x02\x00\x05\x00\x00\x00\x00\x00\x04\x00\x02\x00\xfc\xff\x03\x00\x06\x00\xfe\xff\xfd\xff\x06\x00\x02\x00\xfd\xff\x01\x00\x04\x00\xfe\xff\x02\x00\x04\x00\xfd\xff\xfb\xff\a\x00\x05\x00\xfa\xff\xfb\xff\a\x00\x04\x00\xfc\xff\xfd\xff\x05\x00\x03\x00\xfd\xff\xfb\xff\x02\x00\x06\x00\x01\x00\xf8\xff\xff\xff\b\x00\x02\x00\xfa\xff\xff\xff\x03\x00\x00\x00\xfe\xff\x00\x00\x00\x00\x01\x00\xff\xff\xfd\xff\x00\x00\x03\x00\xff\xff\xfc\xff\xfe\xff\x03\x00\x02\x00\xfd\xff\xfc\xff\x00\x00\x02\x00\x00\x00\xfd\xff\xfd\xff\x00\x00\x03\x00\xfd\xff\xf8\xff\x01\x00\a\x00\xfc\xff\xf7\xff\x00\x00\x05\x00\xfd\xff\xfa\xff\xff\xff\x01\x00\xfd\xff\xfd\xff\xff\xff\x00\x00\xfd\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xfc\xff\xfe\xff\x00\x00\xfe\xff\xfb\xff\xff\xff\x01\x00\xfe\xff\xfa\xff\xfd\xff\x01\x00\xfe\xff\xf9\xff\xff\xff\x02\x00\xfc\xff\xfb\xff\x01\x00\xfe\xff\xfc\xff\xfe\xff\xfe\xff\xfa\xff\xfd\xff\x02\x00\xfe\xff\xf8\xff\xfe\xff\x03\x00\xff\xff\xf6\xff\xfc\xff\x04\x00\xfd\xff\xf8\xff\xfe\xff\x00\x00\xfd\xff\xfb\xff\xff\xff\xff\xff\xfc\xff\xfa\xff\xfe\xff\x00\x00\xfc\xff\xfa\xff\xfe\xff\xff\xff\xfc\xff\xfa\xff\xff\xff\xff\xff\xf9\xff\xfa\xff\x01\x00\xff\xff\xf7\xff\xfb\xff\x02\x00\xfd\xff\xfa\xff\xfc\xff\xfd\xff\xfd\xff\xfd\xff\xfc\xff\xfc\xff\xfe\xff\xfc\xff\xf9\xff\xfe\xff\x00\x00\xfb\xff\xfa\xff\xfe\xff\xfd\xff\xfa\xff\xfb\xff\xff\xff\xfd\xff\xf7\xff\xfb\xff\x02\x00\xfc\xff\xf8\xff\xfe\xff\xff\xff\xf9\xff\xfa\xff\xfe\xff\xfd\xff\xfd\xff\xfb\xff\xf7\xff\xfe\xff\x03\x00\xfa\xff\xf6\xff\xfc\xff\xff\xff\xfd\xff\xfd\xff\xfb\xff\xf9\xff\xfd\xff\xfe\xff\xfd\xff\xfb\xff\xfa\xff\xfc\xff\xfd\xff\xfc\xff\xfb\xff\xfd\xff\xfe\xff\xf9\xff\xfb\xff\x00\x00\xfe\xff\xf8\xff\xf9\xff\xff\xff\xfe\xff\xf9\xff\xfb\xff\xff\xff\xfe\xff\xfa\xff\xf8\xff\xfd\xff\xff\xff\xfa\xff\xf9\xff\xff\xff\xfe\xff\xf8\xff\xfb\xff\x00\x00\xfd\xff\xf7\xff\xfa\xff\x01\x00\xff\xff\xf9\xff\xf8\xff\xfd\xff\xfe\xff\xfb\xff\xfb\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfd\xff\xfc\xff\xfb\xff\xfa\xff\xfc\xff\xfe\xff\xfd\xff\xfa\xff\xfb\xff\xfe\xff\xfd\xff\xf9\xff\xfc\xff\xfe\xff\xfd\xff\xfa\xff\xfc\xff\xfe\xff\xfd\xff\xfb\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfe\xff\xfd\xff\xfa\xff\xfa\xff\xff\xff\x00\x00\xfb\xff\xf8\xff\xfe\xff\x01\x00\xfb\xff\xf8\xff\x00\x00\xff\xff\xfa\xff\xfb\xff\x01\x00\xfe\xff\xf7\xff\xfb\xff\x03\x00\xfc\xff\xf8\xff\xfe\xff\x02\x00\xfa\xff\xf9\xff\x00\x00\x01\x00\xfa\xff\xfa\xff\xfd\xff\x02\x00\xfe\xff\xfa\xff\xf9\xff\x01\x00\x02\x00\xf9\xff\xf9\xff\x03\x00\xff\xff\xfb\xff\xfc\xff\xff\xff\xfb\xff\xff\xff\x00\x00\xfb\xff\xfa\xff\x02\x00\x00\x00\xfc\xff\xf9\xff\xff\xff\x02\x00\xff\xff\xf6\xff\xfc\xff\x04\x00\x02\x00\xf7\xff\xfc\xff\x02\x00\xff\xff\xfa\xff\xff\xff\xff\xff\xfe\xff\xfb\xff\xfe\xff\xff\xff\x00\x00\xfd\xff\xfe\xff\xfd\xff\xfe\xff\xff\xff\x00\x00\xfa\xff\xfd\xff\x02\x00\x02\x00\xf9\xff\xfc\xff\x01\x00\x02\x00\xfd\xff\xfb\xff\xfd\xff\x03\x00\xff\xff\xfd\xff\xfd\xff\x00\x00\x00\x00\xff\xff\xfc\xff\xff\xff\x01\x00\x01\x00\xfb\xff\xfd\xff\x02\x00\x02\x00\xfe\xff\xfd\xff\xfe\xff\x02\x00\x00\x00\x00\x00\xfd\xff\xff\xff\x00\x00\x00\x00\xff\xff\x01\x00\xfe\xff\xff\xff\x02\x00\x01\x00\xfb\xff\xff\xff\x03\x00\x01\x00\xfc\xff\x00\x00\x01\x00\x00\x00\x00\x00\x01\x00\xfd\xff\x00\x00\x02\x00\x01\x00\xfe\xff\x01\x00\x01\x00\xfe\xff\xfd\xff\x05\x00\x03\x00\xfd\xff\xfd\xff\x04\x00\x03\x00\xfd\xff\xfd\xff\x05\x00\x01\x00\xfd\xff\x01\x00\x06\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xfb\xff\x02\x00\x05\x00\xff\xff\xfd\xff\x05\x00\x03\x00\xfd\xff\xfe\xff\x06\x00\x02\x00\xfd\xff\x00\x00\x05\x00\x00\x00\xff\xff\x02\x00\x02\x00\xff\xff\x04\x00\x04\x00\xff\xff\xfd\xff\x03\x00\x06\x00\x02\x00\xfb\xff\x01\x00\a\x00\x03\x00\xfd\xff\x01\x00\x04\x00\x02\x00\x00\x00\x03\x00\x03\x00\x02\x00\x00\x00\x02\x00\x01\x00\x02\x00\x03\x00\x02\x00\x00\x00\x02\x00\x03\x00\x05\x00\x01\x00\xff\xff\x01\x00\x05\x00\x03\x00\x01\x00\xff\xff\x01\x00\x05\x00\b\x00\xff\xff\xfb\xff\x03\x00\v\x00\x02\x00\xfb\xff\x00\x00\b\x00\x05\x00\xfe\xff\xfe\xff\b\x00\x05\x00\xfd\xff\x00\x00\b\x00\x05\x00\x00\x00\x00\x00\x02\x00\x03\x00\x06\x00\x00\x00\x00\x00\x04\x00\x03\x00\x02\x00\x06\x00\x03\x00\xfc\xff\x02\x00\v\x00\x01\x00\xfc\xff\x05\x00\b\x00\x01\x00\x00\x00\x03\x00\x03\x00\x03\x00\x05\x00\x04\x00\x01\x00\x00\x00\x06\x00\a\x00\xff\xff\xfd\xff\a\x00\n\x00\x01\x00\xfd\xff\x05\x00\b\x00\x02\x00\xfe\xff\x03\x00\b\x00\x03\x00\x00\x00\x03\x00\x06\x00\x05\x00\x00\x00\x02\x00\x05\x00\x04\x00\x04\x00\x02\x00\x01\x00\x05\x00\a\x00\x00\x00\x01\x00\b\x00\x04\x00\xff\xff\x05\x00\a\x00\x00\x00\x02\x00\t\x00\x02\x00\xfe\xff\a\x00\t\x00\xfd\xff\xff\xff\v\x00\a\x00\xfd\xff\x02\x00\t\x00\x05\x00\xff\xff\x03\x00\x05\x00\x05\x00\x05\x00\x00\x00\x00\x00\n\x00\t\x00\xfe\xff\xfc\xff\t\x00\t\x00\xff\xff\x00\x00\a\x00\x05\x00\x03\x00\x03\x00\x04\x00\x03\x00\x02\x00\x03\x00\x05\x00\x04\x00\x02\x00\x02\x00\x05\x00\x04\x00\x04\x00\x03\x00\x02\x00\x04\x00\x05\x00\x03\x00\x03\x00\x05\x00\x03\x00\x00\x00\x04\x00\a\x00\x05\x00\x00\x00\x02\x00\x05\x00\a\x00\x05\x00\xff\xff\x00\x00\b\x00\a\x00\x02\x00\x01\x00\x05\x00\x04\x00\x04\x00\x03\x00\x02\x00\x03\x00\x05\x00\x04\x00\x05\x00\x03\x00\x02\x00\x02\x00\x04\x00\x04\x00\x04\x00\x01\x00\x03\x00\x05\x00\x03\x00\x03\x00\x06\x00\x01\x00\x00\x00\x04\x00\a\x00\x02\x00\x01\x00\x03\x00\x03\x00\x02\x00\x06\x00\x04\x00\x00\x00\x02\x00\x06\x00\x01\x00\x01\x00\x06\x00\x06\x00\xfe\xff\x01\x00\x06\x00\x05\x00\x01\x00\x03\x00\x04\x00\x01\x00\x01\x00\b\x00\x04\x00\xff\xff\x00\x00\a\x00\x05\x00\x00\x00\x01\x00\x04\x00\x02\x00\x04\x00\x04\x00\x02\x00\xff\xff\x05\x00\x06\x00\x01\x00\xfe\xff\x04\x00\x04\x00\x03\x00\x04\x00\x02\x00\xff\xff\x03\x00\x04\x00\x02\x00\xff\xff\x03\x00\x06\x00\x04\x00\xff\xff\xff\xff\x05\x00\x05\x00\xff\xff\x00\x00\x02\x00\x03\x00\x03\x00\x03\x00\x01\x00\x01\x00\x00\x00\x04\x00\x05\x00\xff\xff\xfe\xff\x04\x00\x04\x00\x01\x00\x01\x00\x01\x00\xff\xff\x04\x00\x05\x00\xfd\xff\xfe\xff\x06\x00\x03\x00\xff\xff\x01\x00\x02\x00\x00\x00\x00\x00\x02\x00\x03\x00\x01\x00\xfe\xff\xff\xff\x05\x00\x03\x00\xfd\xff\xff\xff\x03\x00\x00\x00\x01\x00\x03\x00\x01\x00\xfd\xff\x00\x00\x01\x00\x03\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x01\x00\xff\xff\xff\xff\x00\x00\x00\x00\x04\x00\xff\xff\xfa\xff\x02\x00\x06\x00\xfd\xff\xfd\xff\x01\x00\x00\x00\x00\x00\x02\x00\xff\xff\xff\xff\xfe\xff\xff\xff\x00\x00\x03\x00\xfe\xff\xfc\xff\x00\x00\x02\x00\xff\xff\xff\xff\xff\xff\x01\x00\xfe\xff\xfe\xff\xfe\xff\x02\x00\xff\xff\xfe\xff\xff\xff\x00\x00\x00\x00\xff\xff\xfd\xff\x00\x00\xff\xff\xff\xff\xfc\xff\x01\x00\x00\x00\xfe\xff\xfd\xff\xff\xff\x00\x00\x01\x00\xfc\xff\xfc\xff\x00\x00\x03\x00\xfa\xff\xfa\xff\x01\x00\x03\x00\xfb\xff\xfd\xff\x01\x00\xff\xff\xfb\xff\xfe\xff\xfe\xff\xff\xff\xfd\xff\xfd\xff\xfd\xff\x00\x00\xff\xff\xfe\xff\xfa\xff\xfd\xff\x01\x00\x01\x00\xfb\xff\xf9\xff\xfe\xff\x05\x00\xfd\xff\xf8\xff\xfd\xff\x02\x00\xfd\xff\xfc\xff\xff\xff\xfc\xff\xf9\xff\x02\x00\x01\x00\xf9\xff\xf8\xff\x02\x00\x03\x00\xf9\xff\xf8\xff\x00\x00\xfe\xff\xfd\xff\xfb\xff\xfd\xff\x00\x00\xfe\xff\xfa\xff\xfd\xff\x00\x00\xfd\xff\xf9\xff\xff\xff\xfe\xff\xfa\xff\xfd\xff\x01\x00\xfc\xff\xf9\xff\xfc\xff\x01\x00\xfe\xff\xfa\xff\xf9\xff\xfe\xff\x01\x00\xfd\xff\xf9\xff\xfc\xff\xfd\xff\xfe\xff\xfe\xff\xfc\xff\xf9\xff\xfe\xff\x00\x00\xfc\xff\xf9\xff\xfd\xff\xff\xff\xfc\xff\xf9\xff\xfd\xff\x00\x00\xfd\xff\xf9\xff\xfd\xff\xfd\xff\xfc\xff\xfe\xff\xfe\xff\xf8\xff\xf9\xff\x03\x00\x01\x00\xf5\xff\xf9\xff\x02\x00\x00\x00\xf9\xff\xfb\xff\xfe\xff\xfd\xff\xfc\xff\xfc\xff\xfc\xff\xfe\xff\xfc\xff\xfa\xff\xfd\xff\x00\x00\xfb\xff\xfa\xff\xfd\xff\xfe\xff\xfc\xff\xfb\xff\xfb\xff\xfe\xff\xff\xff\xfb\xff\xf8\xff\xfe\xff\x00\x00\xfc\xff\xf9\xff\xfc\xff\xff\xff\xfe\xff\xfa\xff\xfb\xff\xfd\xff\xfd\xff\xfb\xff\xfe\xff\xfe\xff\xf9\xff\xfa\xff\x00\x00\xfe\xff\xfa\xff\xfa\xff\xfe\xff\xfd\xff\xfb\xff\xfe\xff\xfd\xff\xf8\xff\xfc\xff\x02\x00\xfb\xff\xf5\xff\xfe\xff\x03\x00\xfb\xff\xf8\xff\xfe\xff\xfe\xff\xfa\xff\xfc\xff\xfe\xff\xfd\xff\xfa\xff\xfb\xff\x00\x00\xff\xff\xf8\xff\xf9\xff\xff\xff\xff\xff\xfb\xff\xfb\xff\xfd\xff\xfd\xff\xfc\xff\xfc\xff\xfd\xff\xfe\xff\xfb\xff\xfc\xff\xff\xff\xfc\xff\xfb\xff\xfe\xff\xfc\xff\xfb\xff\xfe\xff\xfe\xff\xfc\xff\xfc\xff\xfb\xff\xfd\xff\x00\x00\xfe\xff\xf7\xff\xfa\xff\x03\x00\x01\x00\xf8\xff\xf7\xff\x00\x00\x03\x00\xf9\xff\xf9\xff\x00\x00\xff\xff\xf8\xff\xfb\xff\x03\x00\xfe\xff\xf7\xff\xfd\xff\x02\x00\xfd\xff\xf8\xff\xfd\xff\xff\xff\xfd\xff\xfd\xff\xfc\xff\xfc\xff\x00\x00\x00\x00\xfa\xff\xf9\xff\x00\x00\xff\xff\xfc\xff\xfd\xff\xfe\xff\xfc\xff\xfd\xff\x00\x00\xfd\xff\xfa\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\xfd\xff\xfc\xff\x00\x00\x00\x00\xfa\xff\xfb\xff\x02\x00\xfe\xff\xf9\xff\xfe\xff\x02\x00\xfb\xff\xfb\xff\x01\x00\x00\x00\xfa\xff\xfc\xff\x00\x00\x00\x00\xfc\xff\xfb\xff\xfd\xff\x03\x00\xff\xff\xf9\xff\xfd\xff\x01\x00\xfd\xff\xff\xff\xff\xff\xfc\xff\xfd\xff\x02\x00\xfe\xff\xfa\xff\xfd\xff\x01\x00\xfe\xff\xfe\xff\xfe\xff\x00\x00\xfd\xff\xfc\xff\x01\x00\x02\x00\xfb\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\xff\xff\xfd\xff\xfe\xff\x00\x00\x00\x00\xfe\xff\xff\xff\xfe\xff\xfe\xff\xff\xff\x02\x00\xfe\xff\xfb\xff\xfe\xff\x04\x00\x01\x00\xfb\xff\xfc\xff\x03\x00\x01\x00\xfe\xff\xfe\xff\x02\x00\x00\x00\xfc\xff\xfe\xff\x04\x00\xff\xff\xfd\xff\xfe\xff\x01\x00\x01\x00\x01\x00\xfe\xff\xff\xff\x01\x00\x01\x00\xfc\xff\x01\x00\x04\x00\x00\x00\xfa\xff\xfe\xff\x04\x00\x05\x00\xfb\xff\xfa\xff\x04\x00\b\x00\xfc\xff\xfa\xff\x03\x00\x06\x00\xfb\xff\xfd\xff\x04\x00\x03\x00\xfb\xff\x00\x00\x06\x00\x03\x00\xfb\xff\x00\x00\x05\x00\x03\x00\xfa\xff\x01\x00\x06\x00\x02\x00\xfb\xff\xff\xff\x06\x00\x05\x00\xfa\xff\xfd\xff\x05\x00\a\x00\xfe\xff\xfc\xff\x00\x00\x05\x00\x02\x00\xff\xff\xff\xff\x04\x00\x01\x00\x00\x00\x02\x00\x03\x00\xff\xff\x01\x00\x01\x00\x02\x00\x02\x00\x02\x00\xff\xff\x03\x00\x04\x00\xff\xff\xfe\xff\a\x00\x04\x00\xfd\xff\xff\xff\x06\x00\x03\x00\x01\x00\x01\x00\x02\x00\x01\x00\x04\x00\x03\x00\x00\x00\x00\x00\x04\x00\x02\x00\x02\x00\x03\x00\x02\x00\x00\x00\x05\x00\x03\x00\x00\x00\x03\x00\x06\x00\x00\x00\xff\xff\x05\x00\a\x00\x01\x00\xff\xff\x01\x00\b\x00\x06\x00\xfe\xff\xff\xff\b\x00\x05\x00\xff\xff\x01\x00\x05\x00\x01\x00\x04\x00\x05\x00\x01\x00\x00\x00\a\x00\x06\x00\xff\xff\xff\xff\a\x00\x06\x00\x01\x00\x00\x00\x04\x00\x05\x00\x05\x00\xff\xff\x00\x00\a\x00\a\x00\x00\x00\x00\x00\x05\x00\x05\x00\x02\x00\x03\x00\x02\x00\x05\x00\x03\x00\x00\x00\x03\x00\t\x00\x04\x00\xfe\xff\x02\x00\t\x00\x03\x00\x01\x00\x03\x00\x03\x00\x03\x00\x06\x00\x03\x00\x02\x00\x05\x00\x04\x00\xff\xff\x04\x00\a\x00\x03\x00\x01\x00\x03\x00\x05\x00\a\x00\x02\x00\xfe\xff\x05\x00\b\x00\x00\x00\x01\x00\b\x00\x04\x00\xfe\xff\x06\x00\t\x00\xff\xff\xff\xff\b\x00\x05\x00\x01\x00\x04\x00\x04\x00\x00\x00\x03\x00\b\x00\x05\x00\xfe\xff\x02\x00\t\x00\x05\x00\xfe\xff\x01\x00\x06\x00\x04\x00\x02\x00\x04\x00\x03\x00\x02\x00\x05\x00\x03\x00\x00\x00\x04\x00\x06\x00\x01\x00\xff\xff\x06\x00\a\x00\x00\x00\x00\x00\x05\x00\x06\x00\x03\x00\x01\x00\x03\x00\x03\x00\x03\x00\x04\x00\x03\x00\x03\x00\x02\x00\x03\x00\x05\x00\x04\x00\x01\x00\x01\x00\x05\x00\x05\x00\x00\x00\x00\x00\x06\x00\a\x00\x01\x00\xff\xff\x04\x00\x05\x00\x03\x00\x04\x00\x00\x00\xfd\xff\a\x00\n\x00\xff\xff\xfb\xff\x06\x00\b\x00\xff\xff\x01\x00\x05\x00\x02\x00\x01\x00\x02\x00\x04\x00\x02\x00\x00\x00\x01\x00\x05\x00\x05\x00\x00\x00\xff\xff\x03\x00\x05\x00\x03\x00\x00\x00\x01\x00\x04\x00\x04\x00\xff\xff\x01\x00\x05\x00\x03\x00\xfe\xff\x01\x00\x05\x00\x03\x00\x01\x00\x04\x00\x01\x00\xff\xff\x03\x00\x06\x00\x00\x00\x00\x00\x03\x00\x02\x00\x01\x00\x04\x00\x02\x00\x00\x00\x01\x00\x03\x00\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x03\x00\x01\x00\xff\xff\x03\x00\x05\x00\xfd\xff\xff\xff\x06\x00\x02\x00\xfd\xff\x04\x00\x04\x00\xfc\xff\xff\xff\b\x00\x00\x00\xfa\xff\x04\x00\b\x00\xfc\xff\xfb\xff\b\x00\x05\x00\xf8\xff\xff\xff\b\x00\x02\x00\xfd\xff\xff\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x04\x00\x04\x00\xfc\xff\xff\xff\x05\x00\xff\xff\xff\xff\x03\x00\xfe\xff\xff\xff\x05\x00\x02\x00\xfb\xff\xfe\xff\x04\x00\x03\x00\xfe\xff\xfd\xff\x03\x00\x03\x00\xfb\xff\xff\xff\a\x00\xff\xff\xf8\xff\x03\x00\b\x00\xfb\xff\xfa\xff\x04\x00\x03\x00\xfe\xff\xff\xff\x01\x00\xfe\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x03\x00\x03\x00\xfc\xff\xfb\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x01\x00\x01\x00\xfe\xff\xfe\xff\x00\x00\x01\x00\xfe\xff\xfc\xff\xff\xff\x04\x00\xff\xff\xf9\xff\x00\x00\x03\x00\xfd\xff\xfd\xff\x01\x00\xfe\xff\xfe\xff\x02\x00\xfe\xff\xfb\xff\x00\x00\x03\x00\xfd\xff\xf9\xff\x00\x00\x02\x00\xff\xff\xfd\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\xfd\xff\x01\x00\xfe\xff\xfb\xff\x00\x00\x02\x00\xfb\xff\xfb\xff\x01\x00\x00\x00\xfa\xff\xfe\xff\x02\x00\xfb\xff\xfa\xff\x01\x00\x01\x00\xfd\xff\xf9\xff\xfe\xff\x04\x00\xfe\xff\xf7\xff\xfe\xff\x04\x00\xfc\xff\xf8\xff\x01\x00\x00\x00\xfa\xff\xfd\xff\x01\x00\xff\xff\xfa\xff\xfb\xff\xff\xff\x00\x00\xfe\xff\xfa\xff\xfc\xff\x01\x00\x00\x00\xf9\xff\xfb\xff\x02\x00\xfd\xff\xf8\xff\xff\xff\x00\x00\xfa\xff\xfb\xff\x02\x00\xfd\xff\xf7\xff\xfd\xff\x02\x00\xfc\xff\xf9\xff\xfd\xff\x01\x00\xfb\xff\xfa\xff\xfe\xff\xff\xff\xfd\xff\xfb\xff\xf9\xff\xff\xff\x01\x00\xfc\xff\xf8\xff\xff\xff\x00\x00\xfa\xff\xfa\xff\x00\x00\xfd\xff\xfb\xff\xfd\xff\xfe\xff\xfb\xff\xfb\xff\xff\xff\x00\x00\xf8\xff\xf7\xff\x00\x00\x04\x00\xfa\xff\xf5\xff\xfd\xff\x02\x00\xfb\xff\xf9\xff\xfd\xff\xfd\xff\xfb\xff\xfd\xff\xfd\xff\xfa\xff\xfb\xff\x00\x00\xfb\xff\xf8\xff\xff\xff\x00\x00\xf8\xff\xf9\xff\x00\x00\xfe\xff\xf6\xff\xfd\xff\x01\x00\xfb\xff\xf8\xff\xfb\xff\xfd\xff\x00\x00\xfd\xff\xf6\xff\xf9\xff\x03\x00\xfd\xff\xf7\xff\xfc\xff\xff\xff\xf8\xff\xfb\xff\x02\x00\xfe\xff\xf6\xff\xfa\xff\x00\x00\xff\xff\xf8\xff\xfa\xff\xfd\xff\xfe\xff\xfb\xff\xfb\xff\xfd\xff\xfe\xff\xfa\xff\xf8\xff\xfd\xff\x01\x00\xf9\xff\xf8\xff\xfe\xff\xff\xff\xfb\xff\xf9\xff\xfb\xff\xfe\xff\xfc\xff\xfa\xff\xfa\xff\xff\xff\xfe\xff\xf8\xff\xfb\xff\xfe\xff\xfc\xff\xfa\xff\xfb\xff\xfe\xff\xfd\xff\xfa\xff\xfa\xff\xfe\xff\xff\xff\xf9\xff\xf9\xff\xff\xff\xfe\xff\xf8\xff\xfb\xff\xff\xff\xfb\xff\xf9\xff\xff\xff\xff\xff\xf9\xff\xfa\xff\x00\x00\xfc\xff\xf9\xff\xfd\xff\xfe\xff\xfb\xff\xfc\xff\xfe\xff\xfa\xff\xfa\xff\x00\x00\xfe\xff\xf9\xff\xfb\xff\xff\xff\xfe\xff\xfc\xff\xfd\xff\xfb\xff\xfa\xff\xfd\xff\x00\x00\xfd\xff\xf7\xff\xf9\xff\x03\x00\x02\x00\xf8\xff\xf8\xff\x00\x00\x00\x00\xfb\xff\xfb\xff\xfd\xff\xfd\xff\xfd\xff\xfc\xff\xff\xff\xfe\xff\xf9\xff\xfb\xff\x02\x00\xff\xff\xf7\xff\xfc\xff\x03\x00\xfe\xff\xf9\xff\xfc\xff\x00\x00\xfd\xff\xfb\xff\xfd\xff\x00\x00\x00\x00\xfa\xff\xfa\xff\x01\x00\x02\x00\xf9\xff\xfa\xff\x02\x00\xfe\xff\xfa\xff\xff\xff\x00\x00\xfc\xff\xfa\xff\x01\x00\x00\x00\xfc\xff\xfb\xff\xfe\xff\x01\x00\xfd\xff\xfa\xff\xfe\xff\x00\x00\x00\x00\xfc\xff\xfb\xff\xff\xff\x03\x00\xfe\xff\xf9\xff\xfd\xff\x02\x00\xff\xff\xfc\xff\xfc\xff\x00\x00\x02\x00\xfc\xff\xfa\xff\x01\x00\x03\x00\xfe\xff\xfb\xff\xfe\xff\x00\x00\x00\x00\x00\x00\xfe\xff\xfb\xff\xfe\xff\x04\x00\x02\x00\xfa\xff\xfe\xff\x02\x00\xff\xff\xfd\xff\x00\x00\x01\x00\xfe\xff\xfd\xff\x00\x00\x01\x00\xff\xff\xfe\xff\x02\x00\xfe\xff\xfb\xff\x01\x00\x05\x00\xfe\xff\xfb\xff\x01\x00\x04\x00\xfc\xff\xfc\xff\x05\x00\x05\x00\xf9\xff\xfa\xff\x05\x00\x06\x00\xfc\xff\xfc\xff\x02\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x04\x00\xfe\xff\xfe\xff\x00\x00\x01\x00\x03\x00\x03\x00\xfd\xff\xfd\xff\x03\x00\x05\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x02\x00\x01\x00\x00\x00\xff\xff\x00\x00\x05\x00\x03\x00\xfc\xff\xfe\xff\x05\x00\x05\x00\xff\xff\xfb\xff\x01\x00\b\x00\x04\x00\xfb\xff\xfe\xff\x05\x00\x03\x00\x01\x00\x01\x00\xff\xff\x01\x00\x04\x00\x04\x00\xff\xff\xfd\xff\x03\x00\a\x00\x02\x00\xfd\xff\x00\x00\x05\x00\x04\x00\x00\x00\xff\xff\x01\x00\x05\x00\x03\x00\x00\x00\x02\x00\x02\x00\x00\x00\x02\x00\x06\x00\x02\x00\xfc\xff\x03\x00\b\x00\x00\x00\xfe\xff\x05\x00\x04\x00\xff\xff\x02\x00\x05\x00\x01\x00\x00\x00\x05\x00\x04\x00\xff\xff\x00\x00\x06\x00\x05\x00\xff\xff\x01\x00\x05\x00\x03\x00\x02\x00\x04\x00\x01\x00\x01\x00\x06\x00\x03\x00\x00\x00\x05\x00\x05\x00\x00\x00\x01\x00\x05\x00\x05\x00\x02\x00\x01\x00\x03\x00\x05\x00\x04\x00\x02\x00\x02\x00\x03\x00\x05\x00\x04\x00\x02\x00\x03\x00\x06\x00\x03\x00\x00\x00\x04\x00\x06\x00\x03\x00\x02\x00\x03\x00\x05\x00\x03\x00\x03\x00\x05\x00\x04\x00\x01\x00\x04\x00\x06\x00\x03\x00\x00\x00\x04\x00\x06\x00\x05\x00\x02\x00\x03\x00\x04\x00\x03\x00\x02\x00\x05\x00\x03\x00\x03\x00\x06\x00\x05\x00\x00\x00\x04\x00\t\x00\x04\x00\xfd\xff\x04\x00\b\x00\x05\x00\x01\x00\x05\x00\x05\x00\x02\x00\x05\x00\x06\x00\x01\x00\x04\x00\x06\x00\x03\x00\x01\x00\b\x00\x05\x00\x01\x00\x03\x00\x06\x00\x04\x00\x03\x00\x02\x00\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x00\x03\x00\x04\x00\x06\x00\x02\x00\x02\x00\x04\x00\x06\x00\x04\x00\x03\x00\x05\x00\x03\x00\x02\x00\a\x00\x03\x00\x00\x00\x06\x00\t\x00\x00\x00\x00\x00\a\x00\a\x00\x01\x00\x02\x00\x05\x00\x04\x00\x03\x00\x06\x00\x02\x00\x02\x00\x06\x00\x06\x00\x00\x00\x02\x00\x06\x00\x05\x00\x02\x00\x05\x00\x03\x00\x02\x00\x05\x00\x05\x00\x01\x00\x03\x00\x05\x00\x05\x00\x04\x00\x04\x00\x01\x00\x02\x00\x06\x00\x05\x00\xff\xff\x03\x00\b\x00\x04\x00\xff\xff\x04\x00\a\x00\x04\x00\xfe\xff\x03\x00\b\x00\x04\x00\xff\xff\x03\x00\x06\x00\x04\x00\x02\x00\x05\x00\x01\x00\x02\x00\x06\x00\x04\x00\x00\x00\x03\x00\x05\x00\x05\x00\x00\x00\x00\x00\x03\x00\b\x00\x02\x00\xff\xff\x05\x00\a\x00\xff\xff\x00\x00\x06\x00\x06\x00\xff\xff\xff\xff\x05\x00\b\x00\x00\x00\xff\xff\x03\x00\x05\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x05\x00\x02\x00\x00\x00\x01\x00\x06\x00\x05\x00\xff\xff\xfe\xff\x05\x00\x05\x00\x01\x00\xff\xff\x04\x00\x04\x00\x00\x00\x02\x00\x05\x00\x00\x00\xff\xff\x02\x00\x06\x00\x01\x00\x00\x00\x03\x00\x01\x00\x00\x00\x04\x00\x03\x00\x00\x00\xff\xff\x03\x00\x03\x00\x02\x00\x00\x00\x01\x00\x02\x00\x03\x00\x00\x00\x00\x00\x02\x00\x03\x00\x00\x00\x01\x00\x02\x00\x00\x00\xff\xff\x05\x00\x02\x00\xfc\xff\x00\x00\a\x00\x01\x00\xfc\xff\x01\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x03\x00\xff\xff\xff\xff\x02\x00\x02\x00\xfe\xff\xff\xff\x02\x00\x02\x00\xff\xff\xfd\xff\xff\xff\x05\x00\x03\x00\xfa\xff\xfd\xff\a\x00\x02\x00\xfa\xff\xff\xff\x05\x00\x00\x00\xfc\xff\x00\x00\x01\x00\x00\x00\x01\x00\xfd\xff\xfd\xff\x04\x00\x02\x00\xf9\xff\xfd\xff\x06\x00\x02\x00\xfa\xff\xfd\xff\x01\x00\x01\x00\x01\x00\xfd\xff\xf9\xff\x00\x00\x05\x00\xff\xff\xfc\xff\xfe\xff\xfe\xff\x00\x00\x03\x00\xfe\xff\xf8\xff\xfd\xff\x05\x00\x01\x00\xfa\xff\xfc\xff\x00\x00\x01\x00\xff\xff\xfd\xff\xfe\xff\xfe\xff\xff\xff\xff\xff\xfe\xff\xfc\xff\xfe\xff\x02\x00\xfd\xff\xfa\xff\x01\x00\x02\x00\xfc\xff\xf8\xff\xff\xff\x05\x00\xfe\xff\xf6\xff\xfd\xff\x05\x00\xff\xff\xf7\xff\xfd\xff\x02\x00\xff\xff\xfc\xff\xfd\xff\xfe\xff\xfe\xff\xfc\xff\xfd\xff\xff\xff\xfd\xff\xfc\xff\x00\x00\xfe\xff\xfb\xff\xfe\xff\xfe\xff\xfc\xff\xfe\xff\xff\xff\xfd\xff\xfb\xff\xfe\xff\xff\xff\xfe\xff\xfa\xff\xfb\xff\x01\x00\x00\x00\xfa\xff\xfa\xff\xff\xff\x02\x00\xfb\xff\xf7\xff\xfd\xff\x04\x00\xfd\xff\xf5\xff\xfd\xff\x03\x00\xfc\xff\xf9\xff\xfe\xff\x00\x00\xfa\xff\xfb\xff\xff\xff\xfd\xff\xfb\xff\xfe\xff\xfc\xff\xfb\xff\xff\xff\xfe\xff\xfa\xff\xfc\xff\xfe\xff\xfe\xff\xfc\xff\xfb\xff\xfb\xff\xfe\xff\xfd\xff\xfd\xff\xfe\xff\xfa\xff\xfa\xff\x01\x00\xff\xff\xf8\xff\xf9\xff\x00\x00\xff\xff\xfc\xff\xfa\xff\xfc\xff\xfe\xff\xfc\xff\xfa\xff\xfd\xff\xfe\xff\xfd\xff\xfb\xff\xfa\xff\xfd\xff\x00\x00\xfa\xff\xf7\xff\xff\xff\x02\x00\xf9\xff\xf7\xff\xff\xff\x01\x00\xf9\xff\xf8\xff\xff\xff\xfe\xff\xfa\xff\xff\xff\xfe\xff\xf8\xff\xfa\xff\x00\x00\xfc\xff\xf9\xff\xfd\xff\xff\xff\xfb\xff\xfc\xff\xfd\xff\xfd\xff\xfd\xff\xfb\xff\xf8\xff\xfe\xff\x01\x00\xfb\xff\xf6\xff\xfe\xff\x00\x00\xfa\xff\xfa\xff\xff\xff\xfc\xff\xfb\xff\xfc\xff\xfd\xff\xfc\xff\xfd\xff\xfb\xff\xfb\xff\xfc\xff\xfd\xff\xfd\xff\xfe\xff\xf8\xff\xfc\xff\x01\x00\xfb\xff\xf8\xff\xfe\xff\xff\xff\xfd\xff\xfa\xff\xfd\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfc\xff\xfd\xff\xfc\xff\xfc\xff\xfb\xff\x01\x00\xff\xff\xf8\xff\xf8\xff\x00\x00\x01\x00\xfb\xff\xf8\xff\xfd\xff\xff\xff\xff\xff\xfb\xff\xfc\xff\xfd\xff\xfd\xff\xfc\xff\xfc\xff\xfd\xff\xfe\xff\xfc\xff\xfc\xff\xfc\xff\x00\x00\xff\xff\xfa\xff\xf8\xff\xfe\xff\x02\x00\xff\xff\xf9\xff\xfa\xff\xfe\xff\x02\x00\xfd\xff\xf8\xff\xfb\xff\x03\x00\xfe\xff\xf8\xff\xfe\xff\x02\x00\xfa\xff\xfa\xff\xff\xff\x00\x00\xfb\xff\xfe\xff\xff\xff\xfd\xff\xfb\xff\xfe\xff\xff\xff\xfc\xff\xfa\xff\x02\x00\x01\x00\xfa\xff\xf9\xff\x01\x00\x01\x00\xfc\xff\xfb\xff\xff\xff\xfe\xff\xfe\xff\x00\x00\x00\x00\xf9\xff\xfc\xff\x01\x00\x00\x00\xfd\xff\xff\xff\xfd\xff\xfe\xff\x00\x00\x00\x00\xfc\xff\xfd\xff\xfd\xff\x01\x00\x00\x00\xfd\xff\xfc\xff\x00\x00\x00\x00\x00\x00\xfd\xff\xfe\xff\xff\xff\xff\xff\xfc\xff\x01\x00\x01\x00\xfb\xff\xfb\xff\a\x00\x02\x00\xf8\xff\xfd\xff\x06\x00\xfd\xff\xfb\xff\x02\x00\x03\x00\xfa\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x00\x00\xfe\xff\x00\x00\x00\x00\x00\x00\xfd\xff\x01\x00\x01\x00\xfe\xff\xfc\xff\x04\x00\x04\x00\xfe\xff\xf9\xff\x00\x00\x04\x00\x02\x00\xfd\xff\xfd\xff\xfe\xff\x04\x00\x02\x00\x00\x00\xfc\xff\xff\xff\x04\x00\x02\x00\xfc\xff\x00\x00\x02\x00\x00\x00\xfe\xff\x03\x00\x00\x00\xff\xff\x01\x00\x04\x00\xfe\xff\xfd\xff\x02\x00\a\x00\xfd\xff\xfa\xff\x05\x00\b\x00\xfb\xff\xfc\xff\x03\x00\x05\x00\xff\xff\x00\x00\xfe\xff\x01\x00\x06\x00\x00\x00\xfa\xff\x03\x00\x06\x00\x01\x00\xfa\xff\x01\x00\x05\x00\x05\x00\xfe\xff\xfb\xff\x02\x00\a\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x01\x00\x06\x00\x03\x00\xfb\xff\xfc\xff\b\x00\b\x00\xfd\xff\xfc\xff\x03\x00\x02\x00\x04\x00\x04\x00\xfe\xff\xfc\xff\x06\x00\x06\x00\x00\x00\xff\xff\x02\x00\x02\x00\x03\x00\x01\x00\x01\x00\x04\x00\x04\x00\xff\xff\x00\x00\x04\x00\x06\x00\x00\x00\xfd\xff\x02\x00\a\x00\x02\x00\x00\x00\x03\x00\x04\x00\x00\x00\x01\x00\x04\x00\x04\x00\x01\x00\xff\xff\x02\x00\a\x00\x02\x00\xfd\xff\x03\x00\t\x00\x01\x00\xfc\xff\x04\x00\a\x00\x01\x00\xff\xff\x03\x00\x05\x00\x02\x00\x00\x00\x03\x00\x05\x00\x02\x00\x00\x00\x02\x00\x03\x00\x02\x00\x05\x00\x05\x00\xfe\xff\x00\x00\b\x00\x06\x00\xfe\xff\xff\xff\a\x00\x06\x00\xff\xff\x01\x00\x06\x00\x05\x00\x00\x00\x00\x00\a\x00\x06\x00\xfe\xff\x01\x00\x06\x00\x05\x00\x03\x00\x01\x00\x01\x00\x05\x00\x06\x00\x03\x00\xff\xff\x02\x00\x06\x00\x06\x00\x02\x00\xfe\xff\x03\x00\n\x00\x03\x00\xfe\xff\x02\x00\a\x00\x04\x00\x03\x00\x01\x00\x00\x00\x06\x00\b\x00\xff\xff\x00\x00\x06\x00\x05\x00\x02\x00\x04\x00\x01\x00\x02\x00\x05\x00\x04\x00\x01\x00\x06\x00\x05\x00\xfe\xff\x01\x00\n\x00\x06\x00\xfe\xff\xfe\xff\b\x00\b\x00\x01\x00\xff\xff\x04\x00\x06\x00\x04\x00\x02\x00\x04\x00\x02\x00\x03\x00\x05\x00\x05\x00\x00\x00\x02\x00\x05\x00\x05\x00\x03\x00\x04\x00\x02\x00\x04\x00\x04\x00\x03\x00\x01\x00\x05\x00\x05\x00\x02\x00\x01\x00\a\x00\x04\x00\x02\x00\x02\x00\x06\x00\x02\x00\x01\x00\x05\x00\x06\x00\x00\x00\x03\x00\x06\x00\x04\x00\xff\xff\x05\x00\a\x00\x01\x00\xff\xff\x06\x00\x06\x00\x02\x00\x00\x00\a\x00\x04\x00\x00\x00\x02\x00\a\x00\x04\x00\x00\x00\x02\x00\x06\x00\x03\x00\x03\x00\x02\x00\x04\x00\x04\x00\x04\x00\x01\x00\x02\x00\x05\x00\x05\x00\x00\x00\x02\x00\x03\x00\x05\x00\x05\x00\x03\x00\xfe\xff\x04\x00\b\x00\x02\x00\xfd\xff\x05\x00\a\x00\x03\x00\xfe\xff\x04\x00\a\x00\x03\x00\xfe\xff\x04\x00\b\x00\x02\x00\xfe\xff\x05\x00\x04\x00\x02\x00\x03\x00\x06\x00\x01\x00\x00\x00\x04\x00\x05\x00\x01\x00\x01\x00\x01\x00\x05\x00\x05\x00\x01\x00\xfe\xff\x06\x00\a\x00\xfe\xff\xfd\xff\a\x00\x05\x00\xff\xff\x00\x00\x06\x00\x01\x00\xff\xff\x03\x00\x05\x00\x01\x00\x02\x00\x02\x00\x01\x00\x02\x00\x06\x00\x00\x00\xfd\xff\x03\x00\a\x00\x00\x00\x00\x00\x03\x00\x02\x00\xff\xff\x05\x00\x04\x00\xfe\xff\x00\x00\a\x00\x00\x00\xff\xff\x04\x00\x02\x00\xfe\xff\x04\x00\x03\x00\xfe\xff\xff\xff\a\x00\x02\x00\xfd\xff\x01\x00\x03\x00\x00\x00\x01\x00\x03\x00\x01\x00\xfd\xff\x03\x00\x04\x00\xfe\xff\xfd\xff\x05\x00\x01\x00\xfc\xff\x02\x00\a\x00\xff\xff\xfb\xff\x01\x00\x05\x00\xff\xff\xfd\xff\xff\xff\x03\x00\x03\x00\x00\x00\xff\xff\x00\x00\x00\x00\x01\x00\xff\xff\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\xfc\xff\x01\x00\x04\x00\xff\xff\xfa\xff\x02\x00\x03\x00\xfd\xff\x00\x00\x04\x00\xfc\xff\xfb\xff\x04\x00\x04\x00\xf9\xff\xfc\xff\x06\x00\x02\x00\xf9\xff\x00\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x00\x00\xfe\xff\x00\x00\xfe\xff\xfc\xff\x03\x00\x00\x00\xfa\xff\xff\xff\x03\x00\xfe\xff\xfc\xff\x00\x00\x01\x00\xfb\xff\xfe\xff\x01\x00\xff\xff\xfb\xff\x00\x00\x03\x00\xfd\xff\xf9\xff\xff\xff\x02\x00\x01\x00\xfc\xff\xfb\xff\xfe\xff\x02\x00\xff\xff\xfc\xff\xfb\xff\xfe\xff\x01\x00\x00\x00\xfb\xff\xfc\xff\xff\xff\x00\x00\xfc\xff\xfd\xff\xfe\xff\xfe\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfc\xff\x00\x00\xff\xff\xfb\xff\xfb\xff\x01\x00\xff\xff\xfa\xff\xfc\xff\x01\x00\xfd\xff\xfd\xff\xfd\xff\xfc\xff\xfe\xff\x01\x00\xfb\xff\xf9\xff\xff\xff\x01\x00\xfb\xff\xfd\xff\xfd\xff\xfc\xff\xfe\xff\xfe\xff\xfc\xff\xfe\xff\xfd\xff\xfc\xff\xfc\xff\x00\x00\xfe\xff\xf9\xff\xfa\xff\x01\x00\x00\x00\xfa\xff\xf8\xff\xff\xff\x01\x00\xfd\xff\xfa\xff\xfa\xff\xfe\xff\x00\x00\xfa\xff\xfa\xff\xff\xff\x00\x00\xfa\xff\xfc\xff\xff\xff\xfa\xff\xfb\xff\x01\x00\xfb\xff\xf8\xff\xff\xff\x01\x00\xfa\xff\xfb\xff\xfe\xff\xfb\xff\xfc\xff\x01\x00\xfb\xff\xf9\xff\xfd\xff\xfe\xff\xfd\xff\xfc\xff\xfc\xff\xfe\xff\xfc\xff\xfb\xff\xfc\xff\x00\x00\xf9\xff\xf8\xff\x01\x00\xff\xff\xf8\xff\xfc\xff\xff\xff\xfb\xff\xfb\xff\xfe\xff\xfb\xff\xfb\xff\xfe\xff\xfb\xff\xfb\xff\xff\xff\xfb\xff\xf8\xff\xfe\xff\xff\xff\xfa\xff\xfc\xff\xfe\xff\xfb\xff\xfc\xff\xfb\xff\xfb\xff\x00\x00\xfe\xff\xf5\xff\xfa\xff\x05\x00\xfe\xff\xf4\xff\xfb\xff\x01\x00\xfd\xff\xfb\xff\xfb\xff\xfb\xff\xff\xff\xfe\xff\xfa\xff\xfa\xff\xfc\xff\xfe\xff\xfe\xff\xfb\xff\xf9\xff\xfc\xff\x01\x00\xfb\xff\xf8\xff\xfe\xff\xff\xff\xf8\xff\xfa\xff\x01\x00\xfd\xff\xf7\xff\xfd\xff\x00\x00\xfc\xff\xf9\xff\xfc\xff\xfe\xff\xfd\xff\xfc\xff\xfb\xff\xf9\xff\xff\xff\x02\x00\xfb\xff\xf3\xff\xfd\xff\x05\x00\xfc\xff\xf6\xff\xfd\xff\xfe\xff\xfd\xff\xfd\xff\xfb\xff\xfb\xff\x00\x00\xfb\xff\xf9\xff\xfe\xff\x00\x00\xfa\xff\xfb\xff\xfd\xff\xfd\xff\xfc\xff\xfd\xff\xfb\xff\xfe\xff\xfd\xff\xfc\xff\xfb\xff\xfc\xff\xfe\xff\x00\x00\xfa\xff\xfa\xff\xfe\xff\xff\xff\xfb\xff\xfc\xff\xfc\xff\xfd\xff\xfd\xff\xfe\xff\xfb\xff\xfc\xff\xfd\xff\xfe\xff\xfc\xff\xfc\xff\xfd\xff\xfd\xff\xf9\xff\xfd\xff\x01\x00\xfd\xff\xf8\xff\xfe\xff\x00\x00\xfe\xff\xfb\xff\xfb\xff\xfa\xff\x00\x00\x02\x00\xfb\xff\xf7\xff\x00\x00\x02\x00\xfd\xff\xf8\xff\xfd\xff\x00\x00\xfe\xff\xfb\xff\xfe\xff\xfe\xff\xfc\xff\xfe\xff\x01\x00\xfb\xff\xfb\xff\xfe\xff\x00\x00\xfe\xff\xfc\xff\xfb\xff\xff\xff\x00\x00\xfd\xff\xfc\xff\xfe\xff\xfc\xff\xff\xff\x00\x00\xfd\xff\xfb\xff\x00\x00\xfd\xff\xfc\xff\xff\xff\x00\x00\xfd\xff\xfd\xff\xfc\xff\x00\x00\x01\x00\xfe\xff\xf8\xff\xfd\xff\x04\x00\x01\x00\xf8\xff\xfc\xff\x03\x00\x01\x00\xf9\xff\xfe\xff\x03\x00\xfd\xff\xf9\xff\x00\x00\x02\x00\xff\xff\xfc\xff\xfe\xff\xff\xff\x01\x00\xff\xff\xfc\xff\xfd\xff\x02\x00\xfe\xff\xfb\xff\xff\xff\x04\x00\xfe\xff\xfa\xff\xff\xff\x04\x00\xfe\xff\xfb\xff\xfe\xff\x02\x00\xfe\xff\xfc\xff\xff\xff\x03\x00\xfe\xff\xfc\xff\x00\x00\x02\x00\xfc\xff\xff\xff\x02\x00\xff\xff\xfb\xff\x01\x00\x03\x00\xfe\xff\xfb\xff\x02\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xfe\xff\xfd\xff\x03\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\xfa\xff\x00\x00\x06\x00\x02\x00\xf9\xff\xff\xff\a\x00\x02\x00\xf9\xff\xff\xff\x05\x00\x04\x00\xfc\xff\xfd\xff\x02\x00\a\x00\x02\x00\xfb\xff\xfd\xff\x05\x00\x03\x00\xff\xff\xff\xff\x03\x00\xfe\xff\x01\x00\x05\x00\x01\x00\xfb\xff\x02\x00\x05\x00\x00\x00\xfe\xff\x03\x00\x00\x00\x01\x00\x02\x00\x02\x00\x00\x00\x01\x00\x01\x00\x04\x00\x03\x00\xfe\xff\xfd\xff\x06\x00\x04\x00\xfe\xff\x00\x00\x06\x00\x00\x00\xfe\xff\x05\x00\x05\x00\xfc\xff\x00\x00\x06\x00\x02\x00\xff\xff\x04\x00\x01\x00\xff\xff\x04\x00\x05\x00\xfe\xff\x00\x00\x05\x00\x04\x00\x00\x00\x01\x00\x01\x00\x02\x00\x03\x00\x05\x00\x02\x00\xff\xff\x01\x00\x05\x00\x04\x00\x02\x00\xff\xff\x01\x00\x05\x00\a\x00\x00\x00\xfd\xff\x06\x00\t\x00\xfc\xff\xfd\xff\b\x00\a\x00\xfe\xff\x00\x00\x05\x00\x05\x00\x03\x00\x02\x00\x00\x00\x03\x00\x04\x00\x03\x00\x02\x00\x03\x00\x02\x00\x03\x00\x04\x00\x04\x00\x01\x00\x02\x00\x05\x00\x05\x00\x01\x00\x02\x00\x04\x00\x03\x00\x01\x00\x05\x00\x06\x00\x02\x00\x00\x00\x05\x00\x05\x00\x01\x00\x02\x00\x06\x00\x03\x00\x00\x00\x03\x00\t\x00\x05\x00\xfe\xff\xfe\xff\x06\x00\t\x00\x04\x00\xfd\xff\x00\x00\b\x00\b\x00\x00\x00\xff\xff\x05\x00\x06\x00\x03\x00\x03\x00\x02\x00\x03\x00\x05\x00\x03\x00\x01\x00\x04\x00\x05\x00\x03\x00\x03\x00\x04\x00\x01\x00\x03\x00\x06\x00\x05\x00\x01\x00\x00\x00\x05\x00\t\x00\x03\x00\xfd\xff\x01\x00\n\x00\a\x00\xff\xff\x01\x00\a\x00\x06\x00\x01\x00\x00\x00\x05\x00\a\x00\x02\x00\x00\x00\x05\x00\a\x00\x03\x00\x03\x00\x03\x00\x01\x00\x05\x00\a\x00\x01\x00\xff\xff\x05\x00\a\x00\x03\x00\x01\x00\x03\x00\a\x00\x04\x00\xff\xff\x03\x00\a\x00\x03\x00\x01\x00\x03\x00\x06\x00\x05\x00\x01\x00\x02\x00\b\x00\x04\x00\xfd\xff\x02\x00\t\x00\x05\x00\x00\x00\x01\x00\x04\x00\x06\x00\x05\x00\x02\x00\x01\x00\x03\x00\x05\x00\x03\x00\x03\x00\x02\x00\x01\x00\x05\x00\b\x00\x02\x00\xfe\xff\x04\x00\a\x00\x01\x00\x02\x00\x03\x00\x03\x00\x05\x00\x04\x00\x01\x00\x04\x00\x03\x00\x02\x00\x02\x00\x04\x00\x02\x00\x04\x00\x04\x00\x01\x00\x01\x00\x05\x00\x04\x00\x02\x00\x01\x00\x02\x00\x03\x00\x05\x00\x02\x00\x01\x00\x01\x00\x03\x00\x04\x00\x02\x00\x01\x00\x04\x00\x03\x00\x02\x00\x01\x00\x04\x00\x03\x00\x01\x00\x01\x00\x03\x00\x02\x00\x01\x00\x03\x00\x06\x00\x00\x00\xff\xff\x04\x00\x06\x00\xff\xff\xff\xff\x05\x00\x04\x00\xff\xff\x02\x00\x04\x00\x03\x00\xff\xff\x02\x00\x04\x00\x02\x00\x00\x00\x04\x00\x02\x00\x01\x00\x02\x00\x03\x00\xfe\xff\x03\x00\x06\x00\x01\x00\xfc\xff\x04\x00\x06\x00\x00\x00\xff\xff\x06\x00\x00\x00\xfd\xff\x04\x00\t\x00\xfd\xff\xfc\xff\x04\x00\x06\x00\x00\x00\xff\xff\x01\x00\x03\x00\x01\x00\x01\x00\x00\x00\x03\x00\x02\x00\x01\x00\x00\x00\x02\x00\x01\x00\x02\x00\x01\x00\x00\x00\xfe\xff\x02\x00\x05\x00\x01\x00\xfd\xff\x03\x00\x02\x00\xff\xff\x00\x00\x03\x00\x00\x00\xfe\xff\x01\x00\x04\x00\xfe\xff\xff\xff\x02\x00\x01\x00\xff\xff\x01\x00\x01\x00\x00\x00\xff\xff\x01\x00\xff\xff\x02\x00\x01\x00\xfd\xff\xff\xff\x04\x00\xff\xff\xfe\xff\x00\x00\x01\x00\xff\xff\x02\x00\xff\xff\xfc\xff\x02\x00\a\x00\xfb\xff\xf9\xff\x02\x00\x06\x00\xfd\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\x01\x00\x00\x00\xfd\xff\xfc\xff\x02\x00\x02\x00\xfe\xff\xfb\xff\x02\x00\x02\x00\xfd\xff\xfa\xff\x01\x00\x02\x00\xfe\xff\xfa\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x00\x00\xfd\xff\xff\xff\x00\x00\xfd\xff\xfb\xff\x03\x00\xff\xff\xf9\xff\xff\xff\x05\x00\xfa\xff\xf7\xff\x02\x00\x06\x00\xfa\xff\xf9\xff\xfe\xff\x03\x00\x00\x00\xfb\xff\xfa\xff\x01\x00\x00\x00\xfd\xff\xfe\xff\xff\xff\xfa\xff\xff\xff\x00\x00\xfd\xff\xfc\xff\xff\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\xfd\xff\xfe\xff\xfc\xff\xff\xff\xfd\xff\xfb\xff\xfe\xff\x00\x00\xfc\xff\xfd\xff\xfc\xff\xfc\xff\xfe\xff\x01\x00\xfc\xff\xfa\xff\xfc\xff\xff\xff\xff\xff\xfc\xff\xfa\xff\xff\xff\xfe\xff\xfa\xff\xfd\xff\x03\x00\xfc\xff\xf7\xff\xfc\xff\x02\x00\xff\xff\xfa\xff\xfa\xff\xff\xff\xff\xff\xfb\xff\xfa\xff\xff\xff\xff\xff\xfb\xff\xfa\xff\xff\xff\x00\x00\xfb\xff\xf8\xff\xff\xff\x00\x00\xfb\xff\xf9\xff\xfd\xff\xff\xff\xfe\xff\xfb\xff\xfa\xff\xfd\xff\x00\x00\xfc\xff\xfa\xff\xfa\xff\xfd\xff\x00\x00\xfe\xff\xf7\xff\xf9\xff\x01\x00\x01\x00\xf8\xff\xf9\xff\xfe\xff\xfe\xff\xfc\xff\xfc\xff\xfc\xff\xfb\xff\xfc\xff\xfe\xff\xfc\xff\xfb\xff\xfb\xff\xfe\xff\xfd\xff\xfa\xff\xfb\xff\xff\xff\xfd\xff\xfa\xff\xfa\xff\xfc\xff\xfe\xff\xfe\xff\xfa\xff\xfa\xff\xfe\xff\xff\xff\xfa\xff\xfb\xff\xfd\xff\xfb\xff\xfc\xff\xfe\xff\xfd\xff\xfa\xff\xfb\xff\xfd\xff\xfc\xff\xfd\xff\xfc\xff\xfa\xff\xfc\xff\xfe\xff\xfc\xff\xfa\xff\xfe\xff\xfe\xff\xf7\xff\xf9\xff\x02\x00\x01\x00\xf7\xff\xf7\xff\x01\x00\x00\x00\xf7\xff\xf9\xff\x01\x00\xff\xff\xf7\xff\xfa\xff\x00\x00\xff\xff\xf9\xff\xfa\xff\xff\xff\xfd\xff\xf9\xff\xfb\xff\xff\xff\xfd\xff\xf9\xff\xfd\xff\xfe\xff\xfa\xff\xfd\xff\x01\x00\xfb\xff\xf6\xff\xfd\xff\x02\x00\xfb\xff\xf8\xff\xff\xff\x00\x00\xfa\xff\xf9\xff\xfe\xff\x00\x00\xfc\xff\xfa\xff\xfc\xff\xfd\xff\xfe\xff\xfe\xff\xfc\xff\xf9\xff\xfc\xff\x02\x00\xff\xff\xf7\xff\xfb\xff\x02\x00\xfe\xff\xf7\xff\xfc\xff\x01\x00\xfc\xff\xfb\xff\xff\xff\xfd\xff\xfc\xff\xfd\xff\xfb\xff\xfb\xff\x00\x00\xfd\xff\xf9\xff\xff\xff\x01\x00\xfb\xff\xfb\xff\xfd\xff\xff\xff\xfe\xff\xfb\xff\xfb\xff\xff\xff\x00\x00\xfc\xff\xfb\xff\xff\xff\xfd\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfb\xff\xfe\xff\x02\x00\xfd\xff\xf9\xff\xfc\xff\x02\x00\x00\x00\xfb\xff\xfb\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfc\xff\xfa\xff\xff\xff\x01\x00\xfe\xff\xfa\xff\xff\xff\x00\x00\xfe\xff\xfe\xff\xfd\xff\xfb\xff\x01\x00\x00\x00\xfa\xff\xfa\xff\x05\x00\x02\x00\xf8\xff\xfb\xff\x02\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\xfd\xff\xff\xff\x00\x00\xfe\xff\xfc\xff\x01\x00\x01\x00\xfb\xff\xfb\xff\x06\x00\x01\x00\xf8\xff\xfd\xff\x05\x00\x01\x00\xfd\xff\xfb\xff\xff\xff\x03\x00\x01\x00\xfa\xff\xff\xff\x02\x00\xff\xff\x00\x00\x02\x00\xfa\xff\xfe\xff\x05\x00\x01\x00\xf9\xff\x00\x00\x05\x00\xff\xff\xfa\xff\x02\x00\x03\x00\xff\xff\xfd\xff\x01\x00\xff\xff\x01\x00\x02\x00\xff\xff\xfc\xff\x02\x00\x03\x00\xfe\xff\xfd\xff\x03\x00\x00\x00\xff\xff\x00\x00\x02\x00\x01\x00\x00\x00\xfd\xff\x01\x00\x04\x00\x01\x00\xfb\xff\x01\x00\x04\x00\x00\x00\xff\xff\x03\x00\xff\xff\x00\x00\x03\x00\x02\x00\xfd\xff\x00\x00\x04\x00\x03\x00\xfe\xff\xff\xff\x01\x00\x03\x00\x02\x00\x02\x00\xff\xff\xfe\xff\x02\x00\a\x00\x01\x00\xfb\xff\x00\x00\t\x00\x03\x00\xfb\xff\xff\xff\b\x00\x02\x00\xfc\xff\x02\x00\x06\x00\x00\x00\x01\x00\x04\x00\x01\x00\xfd\xff\x04\x00\a\x00\x00\x00\xfe\xff\x04\x00\x04\x00\x01\x00\x01\x00\x04\x00\x00\x00\xff\xff\x04\x00\x06\x00\x03\x00\x01\x00\xff\xff\x03\x00\x04\x00\x02\x00\x02\x00\x03\x00\x02\x00\x02\x00\x02\x00\x05\x00\x02\x00\x02\x00\x01\x00\x01\x00\x06\x00\x05\x00\xff\xff\x02\x00\x05\x00\x03\x00\x00\x00\x04\x00\x04\x00\x01\x00\x01\x00\x06\x00\x03\x00\xff\xff\x02\x00\a\x00\x04\x00\x00\x00\x02\x00\x06\x00\x02\x00\x01\x00\x04\x00\x06\x00\x01\x00\xfe\xff\x05\x00\n\x00\x01\x00\xfd\xff\x04\x00\b\x00\x02\x00\x00\x00\x03\x00\x05\x00\x04\x00\x04\x00\x01\x00\x00\x00\x06\x00\b\x00\xfe\xff\xff\xff\b\x00\a\x00\xff\xff\x02\x00\x05\x00\x02\x00\x03\x00\a\x00\x00\x00\x00\x00\b\x00\x06\x00\xff\xff\x02\x00\x05\x00\x04\x00\x02\x00\x03\x00\x03\x00\x04\x00\x05\x00\x03\x00\x02\x00\x04\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x00\x05\x00\x04\x00\x01\x00\x04\x00\x06\x00\x00\x00\x04\x00\b\x00\x02\x00\x00\x00\x05\x00\x05\x00\x02\x00\x04\x00\x04\x00\x00\x00\a\x00\x06\x00\x00\x00\x03\x00\x06\x00\x03\x00\x02\x00\x04\x00\x03\x00\x01\x00\x06\x00\a\x00\x00\x00\xfe\xff\b\x00\t\x00\xff\xff\xff\xff\x06\x00\x05\x00\x02\x00\x04\x00\x03\x00\x00\x00\x05\x00\a\x00\x01\x00\x00\x00\x05\x00\x06\x00\x02\x00\x03\x00\x04\x00\x00\x00\x05\x00\b\x00\x01\x00\x00\x00\x05\x00\x05\x00\x02\x00\x03\x00\x04\x00\x03\x00\x05\x00\x03\x00\x01\x00\x05\x00\x05\x00\x02\x00\x02\x00\x03\x00\x06\x00\x05\x00\x00\x00\x00\x00\a\x00\b\x00\x00\x00\xfe\xff\x04\x00\a\x00\x04\x00\x00\x00\x00\x00\x04\x00\x06\x00\x04\x00\x01\x00\x00\x00\x05\x00\x05\x00\x01\x00\x01\x00\x01\x00\x03\x00\a\x00\x04\x00\xfe\xff\x00\x00\b\x00\x05\x00\xfe\xff\x00\x00\x06\x00\x05\x00\xff\xff\x00\x00\b\x00\x06\x00\xfd\xff\xfe\xff\a\x00\x06\x00\xff\xff\xff\xff\x05\x00\x05\x00\x00\x00\xfe\xff\x05\x00\a\x00\x00\x00\xfe\xff\x04\x00\x05\x00\x02\x00\x01\x00\x01\x00\xff\xff\x04\x00\x06\x00\x01\x00\xfe\xff\x01\x00\x05\x00\x04\x00\x00\x00\x00\x00\x01\x00\x03\x00\x03\x00\x02\x00\x00\x00\x00\x00\x03\x00\x05\x00\x01\x00\xfe\xff\x01\x00\x06\x00\x02\x00\xfd\xff\x01\x00\x04\x00\x00\x00\x01\x00\x05\x00\x01\x00\xfc\xff\x03\x00\x06\x00\xfe\xff\xfd\xff\x04\x00\x02\x00\xff\xff\x02\x00\x02\x00\xff\xff\x01\x00\x04\x00\x01\x00\xfe\xff\x00\x00\x02\x00\x02\x00\x01\x00\xff\xff\xfd\xff\x02\x00\x06\x00\xff\xff\xfc\xff\x04\x00\x04\x00\xfc\xff\xfe\xff\x05\x00\x02\x00\xfd\xff\xfe\xff\x01\x00\x03\x00\x02\x00\xfc\xff\xff\xff\x06\x00\x01\x00\xfa\xff\xff\xff\x04\x00\xff\xff\xfe\xff\x02\x00\x00\x00\xfd\xff\x00\x00\x04\x00\x01\x00\xfa\xff\xfc\xff\x05\x00\x05\x00\xfb\xff\xfa\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x02\x00\x03\x00\xfa\xff\xfb\xff\x05\x00\x01\x00\xfb\xff\xff\xff\x02\x00\xfe\xff\xfd\xff\x00\x00\xff\xff\xff\xff\xfe\xff\xfc\xff\x02\x00\x02\x00\xfb\xff\xfd\xff\x03\x00\xfe\xff\xfb\xff\x00\x00\xff\xff\xfc\xff\xff\xff\x00\x00\xfd\xff\xfe\xff\x02\x00\xff\xff\xfa\xff\xfc\xff\x01\x00\x01\x00\xfc\xff\xfc\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\xfd\xff\xfb\xff\xfe\xff\x03\x00\xff\xff\xf9\xff\xfe\xff\x03\x00\xfd\xff\xf9\xff\xfe\xff\x01\x00\xfe\xff\xfc\xff\xfd\xff\xff\xff\x00\x00\xfc\xff\xfb\xff\xff\xff\x00\x00\xfd\xff\xfb\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\xfb\xff\xfa\xff\x00\x00\x02\x00\xfc\xff\xf9\xff\xfe\xff\x00\x00\xfd\xff\xfc\xff\xfd\xff\xfd\xff\xfd\xff\xfe\xff\x00\x00\xfe\xff\xf8\xff\xfb\xff\x03\x00\x00\x00\xf8\xff\xfa\xff\x01\x00\xff\xff\xfb\xff\xfd\xff\xfe\xff\xfb\xff\xfd\xff\x01\x00\xfc\xff\xf8\xff\xfe\xff\x01\x00\xfb\xff\xfa\xff\x00\x00\xfe\xff\xf9\xff\xfe\xff\x00\x00\xfa\xff\xfa\xff\xff\xff\xff\xff\xfb\xff\xfb\xff\xff\xff\xfe\xff\xfb\xff\xfb\xff\xfe\xff\xfe\xff\xfc\xff\xfb\xff\xfc\xff\xfe\xff\xff\xff\xfb\xff\xf9\xff\xfd\xff\x01\x00\xfd\xff\xfb\xff\xfb\xff\xfd\xff\x00\x00\xfd\xff\xf7\xff\xfd\xff\x02\x00\xfb\xff\xf8\xff\x00\x00\x00\x00\xfc\xff\xfb\xff\xfa\xff\xfd\xff\x01\x00\xfc\xff\xf8\xff\xfd\xff\x01\x00\xfd\xff\xfb\xff\xfd\xff\xfc\xff\xfb\xff\xfe\xff\xfd\xff\xfc\xff\xfd\xff\xfd\xff\xfc\xff\xfd\xff\xfe\xff\xfc\xff\xfa\xff\xfd\xff\xff\xff\xfc\xff\xfa\xff\xfe\xff\x00\x00\xfd\xff\xf8\xff\xfc\xff\x02\x00\xfd\xff\xf6\xff\xfc\xff\x02\x00\xff\xff\xfb\xff\xfc\xff\xfb\xff\xfc\xff\x00\x00\xff\xff\xf8\xff\xfa\xff\xff\xff\x01\x00\xfd\xff\xfb\xff\xfb\xff\xfc\xff\xfe\xff\xff\xff\xfa\xff\xfb\xff\x00\x00\xfe\xff\xf9\xff\xff\xff\x00\x00\xf9\xff\xfa\xff\x01\x00\xfd\xff\xfa\xff\xfd\xff\xfe\xff\xfc\xff\xfe\xff\xfd\xff\xfb\xff\xfd\xff\x00\x00\xfc\xff\xfa\xff\xfe\xff\x00\x00\xfb\xff\xfa\xff\xff\xff\x01\x00\xfa\xff\xfa\xff\x01\x00\xff\xff\xfa\xff\xfd\xff\xff\xff\xfb\xff\xfb\xff\x01\x00\xff\xff\xfa\xff\xfb\xff\x00\x00\xff\xff\xfc\xff\xfb\xff\xfd\xff\xfe\xff\x00\x00\xfe\xff\xfa\xff\xfc\xff\x02\x00\xfe\xff\xf8\xff\xfc\xff\x04\x00\xff\xff\xf8\xff\xfd\xff\x01\x00\xfd\xff\xfd\xff\xfe\xff\xff\xff\xfc\xff\xfd\xff\xff\xff\xff\xff\xfe\xff\xfb\xff\xfb\xff\x02\x00\x00\x00\xfd\xff\xfc\xff\xfe\xff\xfe\xff\xff\xff\xff\xff\xfd\xff\xfb\xff\xff\xff\x00\x00\xfe\xff\xff\xff\xff\xff\xfc\xff\xfe\xff\x00\x00\xfe\xff\xfb\xff\xff\xff\x01\x00\xfd\xff\xfc\xff\x00\x00\xff\xff\xfd\xff\xfe\xff\x01\x00\xfd\xff\xfc\xff\x01\x00\x02\x00\xfc\xff\xfd\xff\xff\xff\xfe\xff\xff\xff\x01\x00\xfc\xff\xfc\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\xff\xff\x00\x00\x01\x00\xff\xff\xfb\xff\xfe\xff\x03\x00\x01\x00\xfb\xff\xfd\xff\x02\x00\x03\x00\xfc\xff\xfc\xff\x03\x00\x02\x00\xfb\xff\xfe\xff\x03\x00\x00\x00\xfc\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\x02\x00\xff\xff\xfd\xff\x01\x00\x02\x00\xff\xff\xfe\xff\x01\x00\x00\x00\xfe\xff\x02\x00\x02\x00\xfc\xff\xff\xff\x05\x00\x00\x00\xfb\xff\x01\x00\x05\x00\xff\xff\xfd\xff\x02\x00\x01\x00\xff\xff\x01\x00\x01\x00\xff\xff\xff\xff\x02\x00\x03\x00\x00\x00\xff\xff\x00\x00\x03\x00\x01\x00\xfe\xff\x01\x00\x01\x00\xff\xff\x02\x00\x03\x00\x00\x00\xfe\xff\x02\x00\x03\x00\xff\xff\x00\x00\x03\x00\x00\x00\xfe\xff\x03\x00\x05\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\xff\xff\x01\x00\x03\x00\x02\x00\xfe\xff\x00\x00\x05\x00\x03\x00\xfd\xff\xfe\xff\x05\x00\x06\x00\x00\x00\xfe\xff\x01\x00\x05\x00\x03\x00\xff\xff\x00\x00\x01\x00\x03\x00\x05\x00\x02\x00\xfd\xff\x00\x00\b\x00\x06\x00\xfc\xff\xfc\xff\a\x00\b\x00\xfd\xff\xfb\xff\x06\x00\t\x00\x00\x00\xfe\xff\x03\x00\x04\x00\x02\x00\x03\x00\x03\x00\xff\xff\x00\x00\x06\x00\x06\x00\x00\x00\xfe\xff\x04\x00\a\x00\x02\x00\xff\xff\x04\x00\x05\x00\x00\x00\x00\x00\x04\x00\x03\x00\x03\x00\x03\x00\x00\x00\x02\x00\b\x00\x03\x00\xfd\xff\x02\x00\b\x00\x01\x00\xfe\xff\x05\x00\x06\x00\x01\x00\x01\x00\x03\x00\x06\x00\x03\x00\x00\x00\x01\x00\x04\x00\x05\x00\x03\x00\x01\x00\x03\x00\x04\x00\x04\x00\x01\x00\x02\x00\x05\x00\x03\x00\x01\x00\x04\x00\x03\x00\x03\x00\x04\x00\x03\x00\x01\x00\x03\x00\x04\x00\x03\x00\x02\x00\x04\x00\x04\x00\x02\x00\x01\x00\x05\x00\x06\x00\x01\x00\x00\x00\x05\x00\x04\x00\x04\x00\x04\x00\x00\x00\xff\xff\b\x00\b\x00\xff\xff\xff\xff\a\x00\x05\x00\x01\x00\x03\x00\x05\x00\x01\x00\x02\x00\x04\x00\x05\x00\x02\x00\x02\x00\x03\x00\x04\x00\x03\x00\x02\x00\x04\x00\x06\x00\x00\x00\x01\x00\x06\x00\x05\x00\x00\x00\x02\x00\x03\x00\x03\x00\x05\x00\x04\x00\x00\x00\x04\x00\a\x00\x02\x00\xff\xff\x05\x00\x05\x00\x01\x00\x03\x00\x05\x00\x01\x00\x02\x00\x05\x00\x05\x00\x02\x00\x02\x00\x02\x00\x04\x00\x06\x00\x03\x00\x01\x00\x03\x00\x02\x00\x05\x00\x06\x00\x02\x00\xfe\xff\x03\x00\b\x00\x04\x00\x00\x00\x02\x00\x03\x00\x06\x00\x02\x00\x02\x00\x05\x00\x03\x00\x01\x00\x04\x00\x03\x00\x02\x00\x03\x00\x04\x00\x02\x00\x03\x00\x03\x00\x03\x00\x04\x00\x04\x00\xff\xff\x03\x00\x06\x00\x03\x00\x01\x00\x02\x00\x04\x00\x05\x00\x00\x00\x00\x00\x06\x00\b\x00\xfe\xff\xfe\xff\a\x00\x06\x00\xff\xff\x02\x00\x06\x00\x03\x00\xfe\xff\x02\x00\a\x00\x05\x00\xfe\xff\xff\xff\x05\x00\a\x00\x01\x00\x00\x00\x02\x00\x03\x00\x03\x00\x04\x00\x03\x00\x01\x00\xfe\xff\x04\x00\b\x00\x02\x00\xfb\xff\x03\x00\b\x00\x02\x00\xfe\xff\x03\x00\x03\x00\x02\x00\x02\x00\x00\x00\x02\x00\a\x00\xff\xff\xfc\xff\a\x00\t\x00\xfc\xff\xfb\xff\x06\x00\b\x00\xfe\xff\xfd\xff\x05\x00\x06\x00\xff\xff\xff\xff\x04\x00\x03\x00\xff\xff\x01\x00\x01\x00\x01\x00\x03\x00\x03\x00\x00\x00\x00\x00\x02\x00\x02\x00\x02\x00\x01\x00\xff\xff\x02\x00\x01\x00\x01\x00\x02\x00\x01\x00\xff\xff\x03\x00\x04\x00\xfd\xff\xfe\xff\x06\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x01\x00\x01\x00\x00\x00\xff\xff\x01\x00\x03\x00\x00\x00\xfe\xff\x01\x00\x04\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xff\xff\x02\x00\x01\x00\xfd\xff\x00\x00\x05\x00\x00\x00\xfc\xff\x01\x00\x04\x00\xff\xff\xfe\xff\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\xff\xff\xff\xff\x02\x00\x02\x00\xff\xff\xfc\xff\x00\x00\x02\x00\x01\x00\xff\xff\xfe\xff\xff\xff\x03\x00\xff\xff\xfd\xff\x02\x00\x03\x00\xfa\xff\xfd\xff\x05\x00\x02\x00\xfb\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\xff\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\x01\x00\x00\x00\xfd\xff\xff\xff\x03\x00\xfe\xff\xfc\xff\x00\x00\x01\x00\xfd\xff\xff\xff\x00\x00\xfe\xff\xfd\xff\x01\x00\x01\x00\xfc\xff\xfc\xff\x03\x00\x01\x00\xfb\xff\xfd\xff\x03\x00\x00\x00\xfc\xff\xfe\xff\x02\x00\xfe\xff\xfc\xff\x00\x00\x00\x00\xfd\xff\xfe\xff\xfe\xff\x00\x00\x01\x00\xfe\xff\xfb\xff\x00\x00\x02\x00\xfb\xff\xfa\xff\x02\x00\x01\x00\xfc\xff\xfd\xff\x00\x00\xfd\xff\xff\xff\x00\x00\xfb\xff\xfc\xff\x03\x00\xff\xff\xfb\xff\xfe\xff\xff\xff\xfc\xff\xff\xff\x00\x00\xfd\xff\xfc\xff\xff\xff\xfe\xff\xff\xff\xfd\xff\xfc\xff\xfe\xff\xff\xff\xfd\xff\xfe\xff\xfe\xff\xfe\xff\xfc\xff\xfc\xff\xff\xff\x00\x00\xfb\xff\xfb\xff\x01\x00\x01\x00\xf9\xff\xfa\xff\x01\x00\xff\xff\xfa\xff\xfc\xff\x00\x00\x00\x00\xfc\xff\xfa\xff\xfe\xff\x01\x00\xfc\xff\xf8\xff\x00\x00\x03\x00\xf9\xff\xfa\xff\x02\x00\xff\xff\xf9\xff\xfa\xff\x01\x00\x01\x00\xf9\xff\xf9\xff\x02\x00\x01\x00\xf9\xff\xfb\xff\x00\x00\xfe\xff\xfd\xff\xfd\xff\xfa\xff\xfe\xff\x02\x00\xfb\xff\xf9\xff\x00\x00\x00\x00\xf9\xff\xfa\xff\x01\x00\x02\x00\xfb\xff\xf8\xff\xfe\xff\x03\x00\xfd\xff\xf7\xff\xfc\xff\x00\x00\xff\xff\xfe\xff\xfb\xff\xfb\xff\x00\x00\xff\xff\xfa\xff\xfc\xff\xff\xff\xfd\xff\xfe\xff\xfe\xff\xfa\xff\xfc\xff\x02\x00\xfe\xff\xf8\xff\xfc\xff\x01\x00\xfe\xff\xfa\xff\xfc\xff\x00\x00\xfe\xff\xfc\xff\xfe\xff\xfd\xff\xfd\xff\xff\xff\xfd\xff\xfa\xff\xfd\xff\x02\x00\xfe\xff\xfa\xff\xfc\xff\xff\xff\xff\xff\xfd\xff\xfd\xff\xfd\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfc\xff\xff\xff\xfe\xff\xfc\xff\x00\x00\xfd\xff\xfa\xff\xff\xff\x00\x00\xfc\xff\xfb\xff\xfe\xff\xfe\xff\xfd\xff\xfd\xff\xfd\xff\xff\xff\xfd\xff\xfc\xff\x00\x00\xfd\xff\xf9\xff\x00\x00\x01\x00\xf7\xff\xfa\xff\x05\x00\x00\x00\xf7\xff\xfd\xff\x03\x00\xfd\xff\xfa\xff\xfe\xff\x00\x00\xfe\xff\xfd\xff\xfd\xff\xfc\xff\xfe\xff\x03\x00\xfd\xff\xf7\xff\xff\xff\x06\x00\xfe\xff\xf7\xff\xfd\xff\x04\x00\x00\x00\xfb\xff\xfb\xff\x00\x00\x02\x00\xfd\xff\xfb\xff\xff\xff\x00\x00\xfd\xff\xfd\xff\x01\x00\xff\xff\xfc\xff\xfe\xff\x01\x00\xfd\xff\xfb\xff\x01\x00\x02\x00\xfa\xff\xfa\xff\x03\x00\x04\x00\xfa\xff\xfa\xff\x01\x00\x02\x00\xfc\xff\xfd\xff\x01\x00\xff\xff\xfb\xff\xff\xff\x01\x00\xff\xff\xfe\xff\xfe\xff\xfc\xff\x00\x00\x03\x00\xff\xff\xfa\xff\xfd\xff\x02\x00\x02\x00\xfc\xff\xff\xff\x02\x00\xfd\xff\xfa\xff\x02\x00\x05\x00\xfc\xff\xf9\xff\x03\x00\x02\x00\xfd\xff\xff\xff\x02\x00\xfb\xff\xfc\xff\x05\x00\x03\x00\xf9\xff\xfd\xff\x03\x00\x00\x00\xfc\xff\x01\x00\x01\x00\xfe\xff\xff\xff\x02\x00\xff\xff\xfe\xff\xff\xff\xff\xff\x00\x00\x03\x00\xff\xff\xfc\xff\xff\xff\x05\x00\x00\x00\xfc\xff\xfe\xff\x00\x00\x03\x00\x03\x00\xfb\xff\xfc\xff\x05\x00\x05\x00\xfa\xff\xfd\xff\x04\x00\x02\x00\xfd\xff\xff\xff\x01\x00\x01\x00\x01\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x04\x00\xfd\xff\xfe\xff\x02\x00\x03\x00\xff\xff\xfd\xff\x01\x00\x04\x00\x01\x00\xfe\xff\xff\xff\x04\x00\x02\x00\xff\xff\xfe\xff\x00\x00\x04\x00\x02\x00\xfc\xff\x00\x00\a\x00\x03\x00\xfb\xff\x00\x00\x05\x00\x03\x00\xff\xff\xfd\xff\x00\x00\a\x00\x04\x00\xfe\xff\xfd\xff\x05\x00\x05\x00\xfe\xff\x00\x00\x04\x00\x00\x00\xff\xff\x03\x00\x05\x00\x01\x00\x00\x00\x00\x00\x02\x00\x04\x00\x02\x00\x00\x00\x02\x00\x01\x00\xff\xff\x03\x00\b\x00\x00\x00\xfb\xff\x03\x00\n\x00\x01\x00\xfa\xff\x02\x00\t\x00\x02\x00\xfd\xff\x00\x00\x06\x00\x05\x00\x00\x00\xfd\xff\x03\x00\b\x00\x02\x00\xfb\xff\x02\x00\a\x00\x02\x00\xff\xff\x02\x00\x02\x00\x03\x00\x05\x00\x01\x00\xff\xff\x03\x00\x04\x00\x03\x00\x01\x00\x00\x00\x02\x00\a\x00\x04\x00\xfb\xff\x02\x00\v\x00\x02\x00\xfd\xff\x04\x00\x04\x00\x00\x00\x04\x00\x06\x00\x00\x00\xff\xff\x04\x00\x06\x00\x04\x00\x01\x00\xff\xff\x02\x00\a\x00\x06\x00\x00\x00\xfe\xff\x04\x00\b\x00\x03\x00\x00\x00\x02\x00\x03\x00\x04\x00\x05\x00\x04\x00\x01\x00\x01\x00\x03\x00\x05\x00\x05\x00\x02\x00\x00\x00\x03\x00\x06\x00\x04\x00\x00\x00\x02\x00\a\x00\x05\x00\xfe\xff\x01\x00\t\x00\x05\x00\xfd\xff\x02\x00\b\x00\x05\x00\x02\x00\x02\x00\x01\x00\x05\x00\b\x00\x01\x00\xfe\xff\x05\x00\a\x00\x03\x00\x01\x00\x03\x00\x05\x00\x04\x00\x02\x00\x04\x00\x05\x00\x00\x00\x02\x00\b\x00\x03\x00\xff\xff\x05\x00\a\x00\x02\x00\x02\x00\x05\x00\x03\x00\x01\x00\x04\x00\x06\x00\x03\x00\x02\x00\x03\x00\x05\x00\x05\x00\x04\x00\x02\x00\x01\x00\x03\x00\x06\x00\x04\x00\x02\x00\x05\x00\x06\x00\x01\x00\x01\x00\x05\x00\x05\x00\x02\x00\x02\x00\x04\x00\x05\x00\x03\x00\x04\x00\x05\x00\x02\x00\x00\x00\x04\x00\x05\x00\x04\x00\x04\x00\x03\x00\x00\x00\x05\x00\t\x00\x03\x00\xfe\xff\x02\x00\a\x00\x06\x00\x02\x00\x01\x00\x02\x00\x06\x00\x06\x00\x03\x00\x01\x00\x02\x00\x05\x00\x06\x00\x02\x00\x00\x00\x03\x00\b\x00\x03\x00\xff\xff\x03\x00\b\x00\x03\x00\xfd\xff\x05\x00\n\x00\xff\xff\xfe\xff\b\x00\a\x00\xfe\xff\x03\x00\b\x00\x01\x00\xff\xff\a\x00\x06\x00\xff\xff\x01\x00\b\x00\x04\x00\xff\xff\x03\x00\a\x00\x03\x00\xff\xff\x02\x00\b\x00\x05\x00\xff\xff\x00\x00\a\x00\x05\x00\xff\xff\x02\x00\a\x00\x03\x00\xff\xff\x02\x00\a\x00\x04\x00\xff\xff\xff\xff\a\x00\a\x00\xff\xff\xff\xff\x06\x00\x03\x00\x00\x00\x03\x00\x05\x00\x01\x00\x01\x00\x03\x00\x03\x00\x03\x00\x03\x00\xff\xff\x00\x00\x05\x00\a\x00\x00\x00\xfd\xff\x04\x00\a\x00\x01\x00\xfe\xff\x02\x00\x06\x00\x02\x00\x00\x00\x01\x00\x04\x00\x04\x00\xff\xff\xff\xff\x05\x00\x04\x00\x01\x00\xff\xff\x02\x00\x04\x00\x03\x00\xfe\xff\x00\x00\x05\x00\x04\x00\xfe\xff\x00\x00\x04\x00\x03\x00\xff\xff\x00\x00\x03\x00\x03\x00\xfe\xff\x01\x00\x04\x00\x01\x00\x00\x00\x02\x00\x00\x00\x00\x00\x03\x00\x04\x00\xfc\xff\xfd\xff\x04\x00\x05\x00\xff\xff\xff\xff\x01\x00\x01\x00\x01\x00\x03\x00\xfe\xff\xfe\xff\x04\x00\x03\x00\xfb\xff\x00\x00\x05\x00\x00\x00\xfd\xff\x02\x00\x02\x00\x00\x00\x00\x00\x00\x00\xfe\xff\x03\x00\x02\x00\xff\xff\xff\xff\x01\x00\x01\x00\x01\x00\xfe\xff\xfe\xff\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\xff\xff\xff\xff\x02\x00\x01\x00\xfc\xff\xfc\xff\x06\x00\x03\x00\xfa\xff\xfd\xff\x06\x00\x00\x00\xfa\xff\x01\x00\x03\x00\xfb\xff\xff\xff\x03\x00\xfe\xff\xfc\xff\x02\x00\xff\xff\xfd\xff\x01\x00\xff\xff\xfd\xff\x02\x00\xfe\xff\xfb\xff\x01\x00\x03\x00\xfb\xff\xfd\xff\x02\x00\xff\xff\xfd\xff\x00\x00\xfd\xff\xfd\xff\x01\x00\x01\x00\xfb\xff\xfd\xff\x01\x00\x01\x00\xfd\xff\xfc\xff\xfd\xff\x01\x00\x01\x00\xfd\xff\xfc\xff\x00\x00\xff\xff\xfd\xff\xff\xff\x00\x00\xfb\xff\xfd\xff\x02\x00\x01\x00\xf9\xff\xfb\xff\x04\x00\x00\x00\xf7\xff\xfd\xff\x06\x00\x00\x00\xf6\xff\xfc\xff\x04\x00\x01\x00\xf8\xff\xfa\xff\x02\x00\x02\x00\xfc\xff\xfb\xff\xfe\xff\x00\x00\xfd\xff\xfb\xff\xfe\xff\x02\x00\xfd\xff\xf8\xff\xfe\xff\x04\x00\xfd\xff\xf8\xff\xfd\xff\x01\x00\xfe\xff\xfd\xff\xfe\xff\xfd\xff\xfb\xff\xfe\xff\x01\x00\xfe\xff\xf9\xff\xfb\xff\x02\x00\x01\x00\xfa\xff\xfa\xff\xff\xff\x00\x00\xfc\xff\xfa\xff\xff\xff\x00\x00\xfb\xff\xfa\xff\xff\xff\x02\x00\xfb\xff\xf7\xff\xff\xff\x03\x00\xfc\xff\xf7\xff\xfd\xff\x02\x00\xfe\xff\xf8\xff\xfb\xff\x02\x00\xff\xff\xf9\xff\xfb\xff\xff\xff\xfe\xff\xfb\xff\xfc\xff\xfe\xff\xfe\xff\xfb\xff\xfc\xff\xff\xff\xfd\xff\xfa\xff\xfd\xff\xfe\xff\xfc\xff\xfd\xff\xff\xff\xfb\xff\xfa\xff\xff\xff\xff\xff\xfa\xff\xfa\xff\xff\xff\xff\xff\xf9\xff\xfb\xff\x01\x00\xfd\xff\xf8\xff\xfc\xff\x01\x00\xfe\xff\xfa\xff\xfb\xff\xfc\xff\xff\xff\xfe\xff\xfa\xff\xfc\xff\xfe\xff\xfc\xff\xfd\xff\xfc\xff\xfb\xff\xfe\xff\xfe\xff\xf9\xff\xfe\xff\x02\x00\xf9\xff\xf8\xff\x02\x00\xfd\xff\xf7\xff\xfd\xff\x02\x00\xfd\xff\xf9\xff\xfb\xff\xfe\xff\xff\xff\xfe\xff\xfb\xff\xfa\xff\xfc\xff\x01\x00\x00\x00\xfa\xff\xf8\xff\xfe\xff\x00\x00\xfe\xff\xfc\xff\xfa\xff\xfc\xff\xff\xff\xfd\xff\xfc\xff\xfe\xff\xfe\xff\xf9\xff\xfc\xff\x03\x00\xfd\xff\xf5\xff\xfd\xff\x04\x00\xfd\xff\xf5\xff\xfb\xff\x03\x00\x01\x00\xf9\xff\xf7\xff\xfd\xff\x04\x00\xfe\xff\xf8\xff\xfa\xff\x00\x00\xfe\xff\xfd\xff\xfe\xff\xfa\xff\xfa\xff\x01\x00\xff\xff\xfb\xff\xfa\xff\xfe\xff\x00\x00\xfe\xff\xfa\xff\xfb\xff\xff\xff\x00\x00\xfd\xff\xfb\xff\xfb\xff\xff\xff\x00\x00\xfc\xff\xfc\xff\xff\xff\xfa\xff\xfe\xff\x03\x00\xfc\xff\xf7\xff\xff\xff\x02\x00\xfd\xff\xfa\xff\xfe\xff\xfe\xff\x00\x00\xff\xff\xfb\xff\xfa\xff\xff\xff\x02\x00\xfe\xff\xf8\xff\xfd\xff\x02\x00\x00\x00\xf9\xff\xfc\xff\x02\x00\xfe\xff\xf9\xff\xfe\xff\x03\x00\x00\x00\xf7\xff\xfb\xff\x04\x00\x02\x00\xf8\xff\xfc\xff\x03\x00\xfe\xff\xf9\xff\x02\x00\x01\x00\xf8\xff\xfc\xff\x06\x00\xff\xff\xf9\xff\xff\xff\x04\x00\xfb\xff\xfa\xff\x02\x00\x01\x00\xfb\xff\xff\xff\xff\xff\xfe\xff\x00\x00\x00\x00\xfb\xff\xff\xff\x03\x00\xfe\xff\xfb\xff\x00\x00\x00\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\x01\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\x03\x00\x02\x00\xfa\xff\xfc\xff\x04\x00\x03\x00\xfa\xff\xfd\xff\x04\x00\x01\x00\xfd\xff\x00\x00\x00\x00\xfe\xff\x00\x00\x02\x00\xfd\xff\xfe\xff\x02\x00\x00\x00\xff\xff\x02\x00\x00\x00\xfd\xff\x01\x00\x04\x00\xfd\xff\xfd\xff\x03\x00\x02\x00\xfd\xff\x00\x00\x03\x00\x00\x00\xfe\xff\x02\x00\x01\x00\xfd\xff\xff\xff\x04\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x03\x00\x00\x00\xff\xff\x00\x00\x01\x00\x02\x00\x00\x00\xff\xff\x00\x00\x02\x00\x03\x00\x00\x00\xff\xff\x01\x00\x02\x00\x01\x00\xff\xff\x00\x00\x02\x00\x03\x00\x00\x00\xff\xff\x02\x00\x00\x00\xff\xff\x04\x00\x03\x00\xfd\xff\x00\x00\a\x00\x00\x00\xfb\xff\x03\x00\x04\x00\xff\xff\x01\x00\x04\x00\x03\x00\xff\xff\xfe\xff\x03\x00\x06\x00\xff\xff\xfc\xff\x04\x00\x06\x00\x00\x00\x00\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x00\x00\x00\x00\x03\x00\x04\x00\x02\x00\x00\x00\x02\x00\x06\x00\x02\x00\xfe\xff\x01\x00\x06\x00\x02\x00\xfe\xff\x04\x00\x04\x00\x00\x00\x04\x00\x02\x00\xff\xff\x05\x00\x05\x00\xfe\xff\xff\xff\a\x00\x04\x00\xfd\xff\x03\x00\a\x00\x02\x00\x00\x00\x00\x00\x04\x00\x06\x00\x03\x00\xfe\xff\x00\x00\a\x00\x06\x00\x00\x00\x00\x00\x02\x00\x04\x00\x06\x00\x03\x00\xfe\xff\x02\x00\a\x00\x03\x00\x00\x00\x03\x00\x04\x00\x03\x00\x02\x00\x01\x00\x03\x00\x05\x00\x04\x00\x01\x00\x01\x00\x05\x00\x06\x00\x02\x00\xff\xff\x03\x00\x05\x00\x04\x00\x02\x00\x01\x00\x05\x00\a\x00\x00\x00\xfd\xff\x05\x00\n\x00\x00\x00\xfd\xff\x06\x00\b\x00\x01\x00\x02\x00\x05\x00\x02\x00\x00\x00\x05\x00\x04\x00\x02\x00\x06\x00\x04\x00\xfe\xff\x06\x00\b\x00\xff\xff\xfe\xff\b\x00\b\x00\xfe\xff\x00\x00\b\x00\x06\x00\x02\x00\xff\xff\x02\x00\a\x00\x06\x00\x00\x00\x01\x00\x06\x00\x05\x00\x01\x00\x04\x00\x05\x00\x02\x00\x02\x00\x05\x00\x03\x00\x03\x00\x05\x00\x03\x00\x00\x00\x06\x00\b\x00\x01\x00\xfe\xff\x05\x00\t\x00\x04\x00\x00\x00\x03\x00\x05\x00\x05\x00\x01\x00\x03\x00\x05\x00\x02\x00\x03\x00\x06\x00\x06\x00\x03\x00\xff\xff\x05\x00\a\x00\x02\x00\x00\x00\x06\x00\x05\x00\x00\x00\x04\x00\t\x00\x00\x00\xfe\xff\b\x00\t\x00\xff\xff\x00\x00\x05\x00\a\x00\x02\x00\x01\x00\x04\x00\x05\x00\x02\x00\x03\x00\x06\x00\x04\x00\xff\xff\x04\x00\a\x00\x03\x00\xfe\xff\x04\x00\a\x00\x03\x00\x00\x00\x04\x00\x05\x00\x03\x00\x02\x00\x05\x00\x02\x00\x01\x00\x04\x00\x06\x00\x00\x00\x02\x00\a\x00\x05\x00\xfe\xff\x02\x00\a\x00\x03\x00\xfe\xff\x04\x00\x06\x00\x03\x00\x00\x00\x05\x00\x04\x00\xff\xff\x02\x00\a\x00\x01\x00\x01\x00\x04\x00\x05\x00\x01\x00\x02\x00\x01\x00\x04\x00\x06\x00\x01\x00\xfe\xff\x06\x00\x04\x00\x00\x00\x02\x00\x06\x00\xff\xff\x01\x00\x06\x00\x02\x00\xfe\xff\x05\x00\x04\x00\x01\x00\x00\x00\x02\x00\x04\x00\x06\x00\xff\xff\xfe\xff\x04\x00\a\x00\x00\x00\x00\x00\x01\x00\x03\x00\x04\x00\x03\x00\xfe\xff\x03\x00\x04\x00\x01\x00\x00\x00\x04\x00\x02\x00\x00\x00\x01\x00\x04\x00\x00\x00\x01\x00\x02\x00\x04\x00\x01\x00\xff\xff\x02\x00\x03\x00\xff\xff\x02\x00\x03\x00\x01\x00\xff\xff\x03\x00\x01\x00\x00\x00\x03\x00\x03\x00\xfc\xff\x00\x00\x05\x00\x03\x00\xfd\xff\xff\xff\x02\x00\x02\x00\x02\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x03\x00\xfb\xff\xff\xff\a\x00\x01\x00\xfb\xff\x02\x00\x03\x00\xfd\xff\xfe\xff\x05\x00\x01\x00\xfd\xff\x00\x00\x03\x00\x00\x00\xff\xff\x00\x00\x01\x00\xff\xff\x00\x00\x01\x00\x01\x00\xff\xff\x00\x00\xfd\xff\x01\x00\x04\x00\xff\xff\xfb\xff\xff\xff\x03\x00\x03\x00\xfd\xff\xfd\xff\x00\x00\x03\x00\xff\xff\xfd\xff\xff\xff\x00\x00\xfe\xff\x02\x00\x02\x00\xfd\xff\xfb\xff\x02\x00\x03\x00\xfd\xff\xfb\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x00\x00\xfd\xff\xff\xff\x01\x00\xff\xff\xfb\xff\x00\x00\x03\x00\xfe\xff\xfb\xff\xfe\xff\x01\x00\x03\x00\xfb\xff\xf9\xff\x03\x00\x05\x00\xfa\xff\xf9\xff\x02\x00\x03\x00\xfc\xff\xfc\xff\xfe\xff\x01\x00\x00\x00\xfd\xff\xfb\xff\xfe\xff\x02\x00\x01\x00\xf9\xff\xfb\xff\x05\x00\x02\x00\xf5\xff\xfc\xff\a\x00\x00\x00\xf5\xff\xfe\xff\x05\x00\xfd\xff\xf9\xff\x00\x00\x01\x00\xfd\xff\xfa\xff\xfe\xff\x01\x00\x00\x00\xfc\xff\xfa\xff\xfd\xff\x03\x00\x00\x00\xf9\xff\xfa\xff\x02\x00\x00\x00\xfb\xff\xfd\xff\xff\xff\xfc\xff\xfe\xff\xff\xff\xfd\xff\xfc\xff\xff\xff\xfd\xff\xfc\xff\xff\xff\xff\xff\xfa\xff\xfd\xff\x01\x00\xfe\xff\xfa\xff\xfe\xff\xfe\xff\xfc\xff\xfe\xff\xfd\xff\xfb\xff\x00\x00\xff\xff\xfc\xff\xfb\xff\xfc\xff\xfe\xff\x00\x00\xfd\xff\xf8\xff\xfc\xff\x04\x00\xfe\xff\xf8\xff\xfa\xff\x00\x00\x00\x00\xfc\xff\xfa\xff\xfd\xff\x00\x00\xfe\xff\xfa\xff\xfb\xff\xff\xff\x00\x00\xfb\xff\xfa\xff\xff\xff\x01\x00\xfa\xff\xf8\xff\x01\x00\x01\x00\xf8\xff\xf9\xff\x02\x00\x01\x00\xf8\xff\xfb\xff\x00\x00\xfc\xff\xfc\xff\x00\x00\xfe\xff\xf8\xff\xfd\xff\x01\x00\xfc\xff\xfb\xff\xfb\xff\xfc\xff\x00\x00\x00\x00\xf9\xff\xf7\xff\x02\x00\x02\x00\xfa\xff\xf9\xff\xfd\xff\xff\xff\xfd\xff\xfb\xff\xfb\xff\xfd\xff\xff\xff\xfd\xff\xfc\xff\xfc\xff\xfd\xff\xfd\xff\xfe\xff\xfc\xff\xfa\xff\xfe\xff\x01\x00\xfb\xff\xf7\xff\xff\xff\x04\x00\xfb\xff\xf6\xff\xfc\xff\x04\x00\xff\xff\xf7\xff\xfa\xff\xff\xff\xff\xff\xfe\xff\xfc\xff\xfb\xff\xfb\xff\xff\xff\xfe\xff\xfb\xff\xfd\xff\xfe\xff\xfd\xff\xfc\xff\xfb\xff\xff\xff\x00\x00\xfb\xff\xf7\xff\xff\xff\x03\x00\xfb\xff\xf8\xff\xff\xff\x00\x00\xfb\xff\xfa\xff\xff\xff\xff\xff\xfc\xff\xfa\xff\xfe\xff\x01\x00\xfd\xff\xfa\xff\xfd\xff\xfe\xff\xfe\xff\xfc\xff\xfd\xff\xfd\xff\xfc\xff\xfd\xff\x00\x00\xfe\xff\xfb\xff\xfb\xff\x00\x00\xfe\xff\xfc\xff\xfc\xff\xfe\xff\xff\xff\xfd\xff\xfb\xff\xff\xff\xff\xff\xfc\xff\xfb\xff\xff\xff\x00\x00\xfd\xff\xf8\xff\xff\xff\x04\x00\xfd\xff\xf7\xff\xff\xff\x03\x00\xfd\xff\xf9\xff\x00\x00\xfe\xff\xfc\xff\xff\xff\x01\x00\xfd\xff\xfd\xff\xfc\xff\xff\xff\xff\xff\xfd\xff\xfb\xff\x01\x00\x01\x00\xfc\xff\xfd\xff\x01\x00\xfe\xff\xfc\xff\xfb\xff\x02\x00\x01\x00\xfb\xff\xfd\xff\x01\x00\xfd\xff\xff\xff\x01\x00\xfe\xff\xfa\xff\x00\x00\x02\x00\xfe\xff\xfb\xff\xff\xff\x02\x00\x00\x00\xf9\xff\xff\xff\x04\x00\xfe\xff\xfa\xff\x01\x00\x01\x00\xfe\xff\xfd\xff\x00\x00\x00\x00\xfe\xff\xfe\xff\x00\x00\xfe\xff\x01\x00\x00\x00\xfc\xff\xfc\xff\x04\x00\x02\x00\xfa\xff\xfb\xff\x05\x00\x02\x00\xfb\xff\xfb\xff\x03\x00\x02\x00\xff\xff\xfe\xff\xfe\xff\xfd\xff\x04\x00\x02\x00\xfb\xff\xfd\xff\x05\x00\x01\x00\xfb\xff\xfd\xff\x05\x00\x04\x00\xfd\xff\xf9\xff\x03\x00\x06\x00\xfe\xff\xfb\xff\x03\x00\x01\x00\xff\xff\x01\x00\x01\x00\xfe\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x06\x00\xff\xff\xfb\xff\x00\x00\x05\x00\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x01\x00\x00\x00\x00\x00\x01\x00\xff\xff\x03\x00\x03\x00\xff\xff\xfd\xff\x04\x00\x03\x00\xff\xff\x00\x00\x03\x00\x00\x00\x01\x00\x02\x00\x02\x00\x00\x00\x01\x00\xff\xff\x02\x00\x04\x00\x03\x00\xfd\xff\xff\xff\x04\x00\x06\x00\xfe\xff\xfe\xff\x04\x00\x06\x00\xfd\xff\xfe\xff\x04\x00\x04\x00\x00\x00\x02\x00\x01\x00\x02\x00\x01\x00\x02\x00\x02\x00\x03\x00\xff\xff\x00\x00\x05\x00\x06\x00\xfe\xff\xfe\xff\x03\x00\x05\x00\x00\x00\x01\x00\x04\x00\x02\x00\x00\x00\a\x00\x03\x00\xfb\xff\xff\xff\t\x00\x03\x00\xfd\xff\x02\x00\b\x00\x03\x00\x00\x00\x02\x00\x03\x00\x00\x00\x03\x00\x02\x00\x02\x00\x06\x00\x04\x00\xfd\xff\x03\x00\a\x00\x02\x00\xfe\xff\x05\x00\x05\x00\xff\xff\x02\x00\b\x00\x03\x00\xfd\xff\x00\x00\v\x00\x05\x00\xfa\xff\x02\x00\v\x00\x01\x00\xfe\xff\a\x00\x05\x00\xfc\xff\x04\x00\t\x00\x01\x00\xfd\xff\x04\x00\t\x00\x05\x00\xfd\xff\x01\x00\a\x00\x06\x00\x01\x00\x01\x00\x02\x00\x05\x00\a\x00\x03\x00\xfe\xff\x03\x00\x06\x00\x04\x00\x02\x00\x03\x00\x02\x00\x02\x00\a\x00\x05\x00\xfe\xff\x03\x00\a\x00\x02\x00\x00\x00\x06\x00\x05\x00\x01\x00\x02\x00\x05\x00\x04\x00\x02\x00\x02\x00\x05\x00\x05\x00\x03\x00\x03\x00\x02\x00\x01\x00\a\x00\x06\x00\xfe\xff\x01\x00\t\x00\a\x00\xff\xff\x01\x00\x05\x00\x04\x00\x03\x00\x04\x00\x02\x00\x01\x00\a\x00\a\x00\xff\xff\x01\x00\a\x00\x06\x00\x02\x00\x01\x00\x03\x00\a\x00\x05\x00\xff\xff\x01\x00\b\x00\x06\x00\x00\x00\xff\xff\a\x00\t\x00\x01\x00\xfd\xff\x06\x00\n\x00\x00\x00\xfe\xff\b\x00\x05\x00\x00\x00\x03\x00\x05\x00\x03\x00\x03\x00\x03\x00\x04\x00\x03\x00\x05\x00\x02\x00\x01\x00\x05\x00\x06\x00\x00\x00\x01\x00\x06\x00\x05\x00\x01\x00\x05\x00\x04\x00\x00\x00\x03\x00\t\x00\x00\x00\xff\xff\a\x00\x06\x00\x00\x00\x03\x00\x06\x00\x02\x00\x00\x00\x05\x00\x03\x00\x03\x00\x04\x00\x04\x00\x00\x00\x02\x00\a\x00\x04\x00\xfe\xff\x02\x00\x06\x00\x05\x00\x00\x00\x02\x00\x05\x00\x05\x00\xff\xff\x01\x00\b\x00\x04\x00\xfb\xff\x04\x00\n\x00\x01\x00\xfd\xff\x06\x00\x05\x00\x00\x00\x02\x00\x06\x00\x00\x00\xff\xff\a\x00\a\x00\xfc\xff\xff\xff\t\x00\a\x00\xfc\xff\xff\xff\x06\x00\x03\x00\x00\x00\x04\x00\x01\x00\x02\x00\x03\x00\x03\x00\x01\x00\x02\x00\x03\x00\x01\x00\xfe\xff\x03\x00\x06\x00\x04\x00\xfe\xff\xff\xff\x04\x00\x06\x00\x01\x00\xff\xff\xff\xff\x04\x00\x06\x00\x02\x00\xfc\xff\x00\x00\a\x00\x04\x00\xfd\xff\x02\x00\x04\x00\x00\x00\x01\x00\x03\x00\xfe\xff\x01\x00\x06\x00\x03\x00\xfd\xff\x02\x00\x05\x00\x00\x00\xfe\xff\x03\x00\x02\x00\x00\x00\x00\x00\x04\x00\x02\x00\xff\xff\x00\x00\x03\x00\x00\x00\x00\x00\x03\x00\x02\x00\xfc\xff\x01\x00\x06\x00\x00\x00\xfb\xff\x03\x00\x04\x00\xff\xff\x00\x00\x02\x00\xff\xff\x03\x00\x01\x00\xfe\xff\x00\x00\x02\x00\xff\xff\x00\x00\x03\x00\x01\x00\xfe\xff\x03\x00\x00\x00\xfe\xff\x01\x00\x03\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\xfe\xff\x01\x00\x01\x00\xfe\xff\xff\xff\x04\x00\xff\xff\xfd\xff\x00\x00\x02\x00\xff\xff\x00\x00\xff\xff\xff\xff\x01\x00\x02\x00\xfd\xff\xfd\xff\x01\x00\x02\x00\xfd\xff\xff\xff\x01\x00\xff\xff\xff\xff\x02\x00\xfc\xff\xfd\xff\x03\x00\x00\x00\xf9\xff\x01\x00\x06\x00\xfd\xff\xf9\xff\x01\x00\x02\x00\x00\x00\xfd\xff\xfb\xff\xfe\xff\x06\x00\x00\x00\xf8\xff\xfd\xff\x04\x00\x00\x00\xfd\xff\xfe\xff\xfe\xff\xfe\xff\x01\x00\xfd\xff\xfd\xff\xff\xff\x00\x00\xfe\xff\xff\xff\xff\xff\xfc\xff\xfd\xff\x01\x00\xfe\xff\xfc\xff\xfd\xff\x02\x00\x00\x00\xfd\xff\xfb\xff\xfc\xff\x00\x00\x04\x00\xfc\xff\xf6\xff\xff\xff\b\x00\xfc\xff\xf6\xff\xff\xff\x03\x00\xfb\xff\xfc\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\xfd\xff\xfd\xff\xfe\xff\xfe\xff\xfc\xff\xff\xff\xfe\xff\xfc\xff\xfd\xff\x00\x00\xfd\xff\xfc\xff\xfe\xff\x00\x00\xfb\xff\xfc\xff\x00\x00\xfe\xff\xfa\xff\xff\xff\x00\x00\xfc\xff\xfb\xff\xff\xff\xff\xff\xfe\xff\xfa\xff\xfb\xff\xff\xff\x01\x00\xfc\xff\xfb\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfb\xff\xfe\xff\xfc\xff\xfd\xff\x00\x00\xff\xff\xfa\xff\xfb\xff\xfe\xff\xff\xff\xfe\xff\xfc\xff\xf9\xff\xfe\xff\x00\x00\xfd\xff\xfc\xff\xfd\xff\xfc\xff\xfe\xff\xfe\xff\xfb\xff\xfb\xff\xff\xff\xfd\xff\xfc\xff\xff\xff\xfd\xff\xf9\xff\xff\xff\xff\xff\xf9\xff\xfb\xff\x01\x00\xfe\xff\xfa\xff\xfd\xff\xfe\xff\xfc\xff\xfe\xff\xfd\xff\xfb\xff\xfb\xff\xfe\xff\xff\xff\xfc\xff\xfa\xff\xfd\xff\xff\xff\xfc\xff\xfb\xff\xff\xff\xfd\xff\xfb\xff\xfe\xff\xfc\xff\xfb\xff\xff\xff\xff\xff\xf9\xff\xfa\xff\x01\x00\xff\xff\xfa\xff\xfb\xff\xfd\xff\xff\xff\xff\xff\xfa\xff\xf9\xff\x00\x00\x00\x00\xfa\xff\xfc\xff\x00\x00\xfd\xff\xf9\xff\xfd\xff\x00\x00\xfc\xff\xfb\xff\xfb\xff\xfe\xff\x01\x00\xfe\xff\xf9\xff\xfa\xff\x01\x00\xff\xff\xfa\xff\xfc\xff\xfb\xff\xfd\xff\x01\x00\xff\xff\xf7\xff\xfc\xff\x04\x00\xfb\xff\xf7\xff\x00\x00\x01\x00\xfb\xff\xf9\xff\xfd\xff\x01\x00\x02\x00\xf9\xff\xf6\xff\x00\x00\x04\x00\xfa\xff\xf8\xff\xff\xff\x01\x00\xfc\xff\xfb\xff\xff\xff\xff\xff\xfa\xff\xfd\xff\x00\x00\xfe\xff\xfa\xff\xfc\xff\x00\x00\xff\xff\xfb\xff\xfd\xff\x00\x00\xfe\xff\xfa\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\xfd\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfa\xff\xfd\xff\x00\x00\xff\xff\xfb\xff\xfb\xff\x00\x00\x01\x00\xfc\xff\xfc\xff\xfe\xff\x00\x00\xfe\xff\xfc\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfb\xff\xfe\xff\xff\xff\x00\x00\xfe\xff\xfc\xff\xfd\xff\x00\x00\x00\x00\xfd\xff\xfb\xff\xff\xff\x01\x00\xff\xff\xfb\xff\xfd\xff\x02\x00\x02\x00\xfa\xff\xf9\xff\x02\x00\x03\x00\xfb\xff\xfd\xff\x01\x00\xfe\xff\xfc\xff\xff\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\x00\x00\x00\x00\xfc\xff\xfb\xff\x01\x00\x03\x00\xfc\xff\xfd\xff\x02\x00\x00\x00\xfc\xff\xfd\xff\x01\x00\x01\x00\xfd\xff\xfe\xff\xfe\xff\x01\x00\x02\x00\xfe\xff\xfc\xff\x01\x00\x00\x00\xfe\xff\x00\x00\x00\x00\xfd\xff\x00\x00\x00\x00\xfe\xff\xff\xff\x01\x00\xfd\xff\xff\xff\x01\x00\xff\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\x03\x00\x04\x00\xf9\xff\xfa\xff\x04\x00\x06\x00\xfd\xff\xfd\xff\x02\x00\x01\x00\x00\x00\x01\x00\xfe\xff\xfd\xff\x00\x00\x05\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\x00\x00\xff\xff\xff\xff\x02\x00\x02\x00\xff\xff\x01\x00\xff\xff\xff\xff\x02\x00\x01\x00\xfe\xff\x02\x00\x03\x00\xfc\xff\xff\xff\a\x00\x01\x00\xfa\xff\x00\x00\a\x00\x00\x00\xfd\xff\x01\x00\x02\x00\x00\x00\x01\x00\x02\x00\xff\xff\x00\x00\x05\x00\x00\x00\xfd\xff\x02\x00\x03\x00\xff\xff\x00\x00\x03\x00\x02\x00\x00\x00\x01\x00\x01\x00\x01\x00\x01\x00\x02\x00\x01\x00\xff\xff\x00\x00\x05\x00\x05\x00\xfd\xff\xfc\xff\x06\x00\x06\x00\xfc\xff\xfd\xff\b\x00\x04\x00\xfb\xff\x02\x00\a\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x06\x00\x04\x00\xfe\xff\xfd\xff\x05\x00\a\x00\x00\x00\xfe\xff\x02\x00\x03\x00\x02\x00\x03\x00\x02\x00\xff\xff\x03\x00\x05\x00\x01\x00\xff\xff\x03\x00\x05\x00\x01\x00\xff\xff\x04\x00\x06\x00\x02\x00\xfd\xff\x02\x00\x06\x00\x04\x00\x00\x00\x00\x00\x02\x00\x06\x00\x04\x00\xff\xff\x01\x00\x05\x00\x02\x00\x01\x00\x04\x00\x05\x00\x00\x00\x02\x00\x04\x00\x01\x00\x03\x00\x05\x00\x01\x00\x01\x00\x04\x00\x05\x00\x02\x00\x03\x00\x03\x00\x00\x00\x02\x00\x06\x00\x04\x00\x01\x00\x01\x00\x05\x00\x04\x00\x01\x00\x01\x00\x06\x00\x04\x00\xff\xff\x01\x00\b\x00\x05\x00\xfd\xff\x01\x00\n\x00\x03\x00\xfd\xff\x04\x00\n\x00\x01\x00\xfe\xff\x05\x00\a\x00\x00\x00\x01\x00\x06\x00\x05\x00\xfe\xff\x02\x00\n\x00\a\x00\xfb\xff\xfe\xff\n\x00\v\x00\xfc\xff\xfb\xff\a\x00\v\x00\x02\x00\xfd\xff\x00\x00\b\x00\t\x00\x01\x00\xfb\xff\x03\x00\v\x00\a\x00\xfc\xff\xfe\xff\b\x00\b\x00\x01\x00\x00\x00\x04\x00\x04\x00\x00\x00\x04\x00\a\x00\x03\x00\xfe\xff\x02\x00\t\x00\x06\x00\xfd\xff\xfe\xff\a\x00\v\x00\x00\x00\xfa\xff\x04\x00\f\x00\x03\x00\xfd\xff\x03\x00\a\x00\x02\x00\x02\x00\x05\x00\x03\x00\xff\xff\x04\x00\b\x00\x03\x00\xfe\xff\x03\x00\a\x00\x05\x00\xff\xff\x01\x00\x06\x00\x05\x00\x00\x00\x01\x00\x05\x00\x04\x00\x02\x00\x06\x00\x02\x00\xff\xff\x04\x00\a\x00\x01\x00\xfe\xff\x04\x00\a\x00\x01\x00\x02\x00\x05\x00\x04\x00\xff\xff\x02\x00\b\x00\x05\x00\xfd\xff\xff\xff\a\x00\b\x00\x00\x00\xfe\xff\x02\x00\b\x00\x06\x00\x00\x00\xfe\xff\x05\x00\x06\x00\x01\x00\x01\x00\x03\x00\x02\x00\x05\x00\x04\x00\xff\xff\x00\x00\a\x00\x04\x00\x00\x00\x01\x00\x04\x00\x04\x00\x02\x00\x01\x00\x03\x00\x03\x00\x02\x00\xff\xff\x03\x00\a\x00\x03\x00\xfc\xff\x03\x00\t\x00\x01\x00\xfb\xff\x04\x00\a\x00\x01\x00\xfe\xff\x03\x00\x04\x00\x03\x00\x01\x00\x02\x00\x03\x00\x04\x00\x00\x00\xfd\xff\x03\x00\b\x00\x00\x00\xfe\xff\x04\x00\x06\x00\x00\x00\x01\x00\x03\x00\x00\x00\x01\x00\x04\x00\x01\x00\x00\x00\x04\x00\x04\x00\xfe\xff\x01\x00\x04\x00\x02\x00\x01\x00\x02\x00\x01\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\xfe\xff\x00\x00\x06\x00\x04\x00\xfc\xff\x00\x00\x04\x00\x03\x00\x00\x00\xff\xff\x00\x00\x04\x00\x03\x00\xfe\xff\xfc\xff\x06\x00\x06\x00\xfd\xff\xfc\xff\x04\x00\x05\x00\xff\xff\xfd\xff\x02\x00\x02\x00\xff\xff\xff\xff\x04\x00\x02\x00\xff\xff\x01\x00\x02\x00\xff\xff\x00\x00\x01\x00\x00\x00\xff\xff\x01\x00\x03\x00\x00\x00\xfd\xff\x02\x00\x04\x00\xfe\xff\xfc\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x01\x00\xff\xff\x00\x00\x01\x00\x00\x00\xfe\xff\x02\x00\x03\x00\xfb\xff\xfb\xff\x05\x00\x03\x00\xfc\xff\xff\xff\x02\x00\xfe\xff\x00\x00\x02\x00\xff\xff\xfc\xff\xfe\xff\x04\x00\x03\x00\xf9\xff\xfc\xff\x06\x00\x02\x00\xfb\xff\xfe\xff\xff\xff\x00\x00\x03\x00\xff\xff\xfa\xff\x00\x00\x03\x00\xfe\xff\xfe\xff\xff\xff\xfd\xff\x01\x00\x02\x00\xfd\xff\xfd\xff\x01\x00\xff\xff\xfd\xff\x01\x00\xff\xff\xfb\xff\x00\x00\x04\x00\xfe\xff\xf9\xff\x00\x00\x03\x00\xfd\xff\xfc\xff\x00\x00\x01\x00\xfc\xff\xfd\xff\x01\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\xfe\xff\xfb\xff\xfd\xff\xff\xff\x02\x00\xff\xff\xfb\xff\xfd\xff\x00\x00\x02\x00\xfe\xff\xfb\xff\xfd\xff\x01\x00\xff\xff\xf9\xff\xff\xff\x05\x00\xfc\xff\xf9\xff\x00\x00\x03\x00\xfc\xff\xfb\xff\xfe\xff\xff\xff\xff\xff\xff\xff\xfd\xff\xfc\xff\xfe\xff\x03\x00\xfe\xff\xf7\xff\xfd\xff\a\x00\xfe\xff\xf4\xff\xfd\xff\b\x00\xff\xff\xf5\xff\xfd\xff\x06\x00\xfe\xff\xf7\xff\xfd\xff\x03\x00\xfe\xff\xfb\xff\xfe\xff\xff\xff\xfd\xff\xff\xff\xff\xff\xfc\xff\xfb\xff\xff\xff\x00\x00\xfe\xff\xfc\xff\xfe\xff\xfe\xff\xfd\xff\xff\xff\xff\xff\xfb\xff\xfb\xff\xff\xff\x02\x00\xfd\xff\xfb\xff\xfc\xff\xff\xff\x00\x00\xfe\xff\xfb\xff\xfc\xff\x00\x00\x00\x00\xfc\xff\xfd\xff\xfc\xff\xfe\xff\x00\x00\xff\xff\xfa\xff\xfd\xff\x01\x00\xfe\xff\xfb\xff\xff\xff\xfd\xff\xfb\xff\x00\x00\x02\x00\xf9\xff\xfb\xff\x03\x00\xfe\xff\xf8\xff\xff\xff\x00\x00\xfb\xff\xfd\xff\x02\x00\xfd\xff\xfa\xff\xfe\xff\x00\x00\xfc\xff\xfc\xff\xfe\xff\x00\x00\xfd\xff\xfc\xff\xfe\xff\x00\x00\xfd\xff\xfc\xff\xfc\xff\xff\xff\xff\xff\xfe\xff\xfd\xff\xfc\xff\xfc\xff\x00\x00\x00\x00\xfc\xff\xfa\xff\x01\x00\x00\x00\xfa\xff\xfc\xff\x00\x00\xfe\xff\xfd\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xfd\xff\x02\x00\xff\xff\xf8\xff\xfc\xff\x04\x00\xfe\xff\xfa\xff\xfd\xff\x00\x00\xff\xff\xfd\xff\xfc\xff\x00\x00\x00\x00\xfc\xff\xfc\xff\x00\x00\xfd\xff\xfd\xff\x00\x00\xfe\xff\xfa\xff\x01\x00\x01\x00\xfb\xff\xfa\xff\x01\x00\x01\x00\xfc\xff\xfc\xff\x00\x00\xff\xff\xfd\xff\xfb\xff\x00\x00\x02\x00\xfd\xff\xf8\xff\x00\x00\x05\x00\xfd\xff\xf7\xff\x00\x00\x03\x00\xfe\xff\xfc\xff\x00\x00\xfe\xff\xfd\xff\x00\x00\xff\xff\xfa\xff\x00\x00\x03\x00\xfe\xff\xf9\xff\x01\x00\x03\x00\xfd\xff\xfb\xff\x00\x00\x00\x00\xfd\xff\xfd\xff\x02\x00\xff\xff\xfb\xff\xfd\xff\x04\x00\x02\x00\xfc\xff\xf9\xff\x00\x00\x05\x00\xfe\xff\xf7\xff\x00\x00\x06\x00\xfe\xff\xf8\xff\x03\x00\x04\x00\xfb\xff\xfb\xff\x02\x00\xff\xff\xfe\xff\x01\x00\xfe\xff\xfc\xff\x04\x00\x02\x00\xfa\xff\xfc\xff\x04\x00\x01\x00\xfc\xff\xfd\xff\x03\x00\x02\x00\xfc\xff\xfc\xff\x04\x00\x01\x00\xfc\xff\xfe\xff\x02\x00\xff\xff\xfd\xff\x02\x00\x03\x00\xfb\xff\xfd\xff\x03\x00\x03\x00\xfd\xff\xfd\xff\x00\x00\x00\x00\xff\xff\x02\x00\x00\x00\xfe\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\xfe\xff\x02\x00\x01\x00\x00\x00\xfe\xff\x00\x00\x02\x00\x01\x00\xfd\xff\x00\x00\x01\x00\x00\x00\x00\x00\x03\x00\xff\xff\xfc\xff\x02\x00\x05\x00\xfd\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\x01\x00\x01\x00\xff\xff\xff\xff\x02\x00\x03\x00\xff\xff\xfc\xff\x03\x00\x04\x00\xfe\xff\xfc\xff\x04\x00\x03\x00\xfd\xff\x01\x00\x04\x00\xfd\xff\xfe\xff\x03\x00\x03\x00\xfe\xff\x00\x00\x02\x00\x01\x00\x02\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x01\x00\xff\xff\x03\x00\x03\x00\xfe\xff\xff\xff\x06\x00\x03\x00\xfd\xff\xff\xff\x06\x00\x04\x00\xfc\xff\xfd\xff\b\x00\x05\x00\xfc\xff\xfe\xff\x04\x00\x03\x00\x03\x00\x03\x00\xff\xff\xfd\xff\x04\x00\x06\x00\x00\x00\xfc\xff\x02\x00\x06\x00\x01\x00\xff\xff\x03\x00\x00\x00\x01\x00\x05\x00\x01\x00\xfe\xff\x03\x00\x04\x00\x02\x00\xff\xff\x00\x00\x03\x00\x05\x00\x01\x00\xff\xff\x04\x00\x03\x00\xff\xff\x03\x00\x03\x00\x00\x00\x01\x00\x04\x00\x02\x00\x00\x00\x02\x00\x03\x00\x04\x00\x04\x00\xff\xff\xfe\xff\x05\x00\b\x00\xfe\xff\xfa\xff\x06\x00\v\x00\xff\xff\xfb\xff\x05\x00\a\x00\x00\x00\x00\x00\x03\x00\x01\x00\x01\x00\x05\x00\x03\x00\xff\xff\x01\x00\x06\x00\x04\x00\xff\xff\x01\x00\x04\x00\x04\x00\x02\x00\xff\xff\x01\x00\a\x00\x06\x00\xfd\xff\xfd\xff\b\x00\a\x00\xfc\xff\x00\x00\b\x00\x01\x00\x00\x00\a\x00\x03\x00\xfd\xff\x03\x00\x05\x00\x00\x00\x03\x00\x05\x00\x00\x00\x01\x00\x04\x00\x03\x00\x02\x00\x03\x00\x02\x00\x02\x00\x03\x00\x04\x00\x03\x00\xff\xff\xff\xff\x06\x00\a\x00\x01\x00\xfe\xff\x03\x00\a\x00\x03\x00\xff\xff\x01\x00\x04\x00\x05\x00\x02\x00\x00\x00\x01\x00\x05\x00\x04\x00\x00\x00\x02\x00\x04\x00\x02\x00\x04\x00\x02\x00\x00\x00\x03\x00\x06\x00\x01\x00\xfe\xff\x05\x00\a\x00\xff\xff\x00\x00\x06\x00\x05\x00\xfe\xff\x01\x00\x06\x00\x03\x00\x00\x00\x02\x00\x02\x00\x04\x00\x04\x00\x02\x00\x00\x00\x02\x00\x05\x00\x04\x00\x00\x00\x00\x00\x03\x00\x06\x00\x02\x00\xff\xff\x03\x00\x05\x00\x00\x00\x01\x00\x06\x00\x03\x00\xfe\xff\x03\x00\x05\x00\x02\x00\x01\x00\x03\x00\x01\x00\x03\x00\x04\x00\x01\x00\x01\x00\x04\x00\x02\x00\x01\x00\x02\x00\x04\x00\x03\x00\x02\x00\x01\x00\x03\x00\x02\x00\x02\x00\x04\x00\x02\x00\xfe\xff\x04\x00\a\x00\x01\x00\xfd\xff\x03\x00\x04\x00\x01\x00\x02\x00\x04\x00\x00\x00\x02\x00\x04\x00\x02\x00\x00\x00\x02\x00\x03\x00\x02\x00\x00\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x02\x00\x03\x00\x01\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x01\x00\x03\x00\x02\x00\xfe\xff\x01\x00\a\x00\x01\x00\xfd\xff\x03\x00\x05\x00\xfe\xff\x01\x00\x06\x00\x00\x00\xfd\xff\x05\x00\x05\x00\xff\xff\xfd\xff\x03\x00\x03\x00\x00\x00\x02\x00\x03\x00\xfe\xff\x01\x00\a\x00\x03\x00\xfa\xff\x00\x00\x06\x00\x00\x00\xfe\xff\x04\x00\x01\x00\xff\xff\x02\x00\x03\x00\x00\x00\x00\x00\x01\x00\x01\x00\x01\x00\x02\x00\x00\x00\xff\xff\xff\xff\x03\x00\x04\x00\x00\x00\xfd\xff\x00\x00\x02\x00\x04\x00\x03\x00\xfc\xff\xfa\xff\x05\x00\b\x00\xfe\xff\xf8\xff\x01\x00\x06\x00\x02\x00\xfe\xff\xff\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x05\x00\x04\x00\xfd\xff\xfe\xff\x01\x00\x01\x00\xff\xff\x00\x00\x01\x00\x00\x00\xfe\xff\x01\x00\x03\x00\xff\xff\xfc\xff\x02\x00\x02\x00\xfc\xff\x00\x00\x04\x00\xfd\xff\xfe\xff\x02\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xfd\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\xfb\xff\x03\x00\x06\x00\xfd\xff\xf9\xff\x01\x00\x03\x00\x00\x00\xff\xff\xfe\xff\xfc\xff\x00\x00\x04\x00\x00\x00\xf9\xff\xfe\xff\x03\x00\x03\x00\xff\xff\xfb\xff\xfd\xff\x04\x00\x01\x00\xfb\xff\xfd\xff\x01\x00\x00\x00\x01\x00\xfd\xff\xfc\xff\x02\x00\x02\x00\xfb\xff\xfe\xff\x01\x00\xff\xff\xff\xff\x01\x00\xfc\xff\xfd\xff\x02\x00\xff\xff\xfb\xff\x00\x00\x02\x00\xfe\xff\xfc\xff\x01\x00\xff\xff\xfc\xff\xff\xff\x02\x00\xfd\xff\xfc\xff\xff\xff\x02\x00\x00\x00\xfc\xff\xfb\xff\x00\x00\x01\x00\xfe\xff\xfd\xff\x00\x00\xfd\xff\xfe\xff\x01\x00\xff\xff\xfd\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\x00\x00\xfd\xff\xfd\xff\x01\x00\xfe\xff\xfb\xff\x01\x00\x01\x00\xfa\xff\xfc\xff\x03\x00\xfe\xff\xfb\xff\x02\x00\x00\x00\xf8\xff\xfc\xff\x02\x00\x01\x00\xfc\xff\xfb\xff\x00\x00\x02\x00\xfe\xff\xfb\xff\xfc\xff\xff\xff\x00\x00\xfe\xff\xfb\xff\xfd\xff\x01\x00\xff\xff\xfc\xff\xfe\xff\xfe\xff\xfd\xff\xfe\xff\x00\x00\xfd\xff\xfb\xff\xff\xff\xff\xff\xfc\xff\xfe\xff\x00\x00\xfd\xff\xfb\xff\x00\x00\xff\xff\xfd\xff\xfe\xff\xfb\xff\xfd\xff\x01\x00\xff\xff\xfc\xff\xfd\xff\xff\xff\xfd\xff\xfd\xff\x00\x00\xfe\xff\xfc\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfa\xff\xfc\xff\x02\x00\x00\x00\xf9\xff\xfd\xff\x01\x00\xfd\xff\xfc\xff\x00\x00\xfe\xff\xfa\xff\xff\xff\x01\x00\xfc\xff\xfc\xff\xfd\xff\xff\xff\xff\xff\xfc\xff\xfd\xff\x00\x00\xfd\xff\xfa\xff\x00\x00\x02\x00\xfb\xff\xfb\xff\xfe\xff\x00\x00\xfe\xff\xfe\xff\xfc\xff\xfc\xff\x00\x00\xfe\xff\xfd\xff\xff\xff\xfd\xff\xfc\xff\xfe\xff\x01\x00\xfc\xff\xfa\xff\xfe\xff\x01\x00\x00\x00\xfc\xff\xfb\xff\xfe\xff\x00\x00\xfe\xff\xfb\xff\xfd\xff\xfe\xff\xff\xff\x00\x00\xfd\xff\xfa\xff\xfe\xff\x01\x00\xfe\xff\xfa\xff\xfd\xff\x01\x00\xff\xff\xf9\xff\xfe\xff\x04\x00\xfc\xff\xf6\xff\x00\x00\x04\x00\xfd\xff\xfb\xff\xfe\xff\xfd\xff\xfe\xff\x00\x00\xff\xff\xfa\xff\xfc\xff\x01\x00\x02\x00\xfb\xff\xfa\xff\x00\x00\x01\x00\xfd\xff\xfc\xff\xfc\xff\x00\x00\x00\x00\xfd\xff\xfc\xff\x00\x00\xfe\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xff\xff\x01\x00\xff\xff\xf9\xff\xfc\xff\x03\x00\x02\x00\xfa\xff\xfa\xff\x01\x00\x03\x00\xfd\xff\xfc\xff\xfd\xff\xff\xff\xff\xff\xff\xff\xfe\xff\xfe\xff\xfc\xff\x01\x00\x03\x00\xfb\xff\xf8\xff\x03\x00\x04\x00\xfa\xff\xfa\xff\x03\x00\xff\xff\xfd\xff\x00\x00\xfe\xff\xfc\xff\x02\x00\x02\x00\xfb\xff\xfa\xff\x03\x00\x02\x00\xfb\xff\xfa\xff\x02\x00\x04\x00\xfd\xff\xfa\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x02\x00\xff\xff\xfc\xff\xff\xff\x02\x00\xff\xff\xfd\xff\xfd\xff\x00\x00\x00\x00\x00\x00\xfe\xff\xff\xff\xff\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\x01\x00\xff\xff\xff\xff\x00\x00\x00\x00\xfd\xff\xff\xff\xff\xff\x01\x00\x01\x00\xfe\xff\xfd\xff\x01\x00\x01\x00\x00\x00\xfe\xff\xff\xff\xfd\xff\x02\x00\x04\x00\xfe\xff\xfb\xff\x00\x00\x03\x00\x01\x00\xfc\xff\xff\xff\x00\x00\x02\x00\x00\x00\xfe\xff\x00\x00\x02\x00\xfe\xff\x00\x00\x01\x00\xfe\xff\xfe\xff\x04\x00\x00\x00\xfd\xff\x01\x00\x03\x00\xfd\xff\xfd\xff\x03\x00\x03\x00\xfc\xff\xff\xff\x02\x00\x01\x00\xfe\xff\x02\x00\x01\x00\xfd\xff\x00\x00\x04\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\x00\x00\x02\x00\xff\xff\xfd\xff\x02\x00\a\x00\xfd\xff\xfa\xff\x03\x00\x06\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\x00\x00\x02\x00\xff\xff\xff\xff\x02\x00\x04\x00\x00\x00\xfe\xff\xfe\xff\x05\x00\x06\x00\xfc\xff\xf8\xff\x06\x00\t\x00\xfd\xff\xfc\xff\x04\x00\x02\x00\x01\x00\x02\x00\x01\x00\xfc\xff\x02\x00\a\x00\x00\x00\xfc\xff\x02\x00\x05\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x03\x00\x02\x00\x00\x00\xff\xff\x03\x00\x04\x00\x02\x00\xff\xff\xff\xff\x03\x00\x05\x00\x01\x00\xfe\xff\x00\x00\x03\x00\x03\x00\x04\x00\x00\x00\xfe\xff\x04\x00\x04\x00\xfd\xff\x02\x00\x06\x00\xff\xff\xfe\xff\x06\x00\x03\x00\xff\xff\x03\x00\x01\x00\xfe\xff\x05\x00\x06\x00\xfd\xff\xfe\xff\t\x00\x03\x00\xfc\xff\x02\x00\x04\x00\x00\x00\x02\x00\x04\x00\x00\x00\x00\x00\x04\x00\x02\x00\x02\x00\x03\x00\x00\x00\x01\x00\x05\x00\x00\x00\xff\xff\x05\x00\x05\x00\xff\xff\xff\xff\x04\x00\x05\x00\x01\x00\x00\x00\x02\x00\x04\x00\x03\x00\x02\x00\x01\x00\x02\x00\x03\x00\x01\x00\x00\x00\x05\x00\x05\x00\xff\xff\x01\x00\x06\x00\x03\x00\xff\xff\x01\x00\x04\x00\x02\x00\x01\x00\x04\x00\x04\x00\x00\x00\x01\x00\x06\x00\x04\x00\xff\xff\x01\x00\x03\x00\x03\x00\x04\x00\x02\x00\x00\x00\x03\x00\x06\x00\x02\x00\xff\xff\x03\x00\x05\x00\x02\x00\x02\x00\x02\x00\x02\x00\x03\x00\x04\x00\x03\x00\xff\xff\x01\x00\x06\x00\x05\x00\x03\x00\xff\xff\x01\x00\x06\x00\x03\x00\x01\x00\x02\x00\x01\x00\x05\x00\x06\x00\x01\x00\xfe\xff\x04\x00\a\x00\x01\x00\xff\xff\x04\x00\x04\x00\x04\x00\x01\x00\x00\x00\x04\x00\x05\x00\x01\x00\x00\x00\x03\x00\x06\x00\x02\x00\x00\x00\x03\x00\x06\x00\x01\x00\x01\x00\x04\x00\x02\x00\x01\x00\x03\x00\x03\x00\x04\x00\x03\x00\x02\x00\x01\x00\x03\x00\x02\x00\x02\x00\x03\x00\x02\x00\x03\x00\x04\x00\x00\x00\x02\x00\x04\x00\x03\x00\x00\x00\x02\x00\x04\x00\x04\x00\x01\x00\x00\x00\x03\x00\x05\x00\x00\x00\x00\x00\x04\x00\x05\x00\x01\x00\x01\x00\x02\x00\x02\x00\x03\x00\x03\x00\x03\x00\x03\x00\xff\xff\x01\x00\x05\x00\x05\x00\xff\xff\xff\xff\x05\x00\x04\x00\x00\x00\x01\x00\x03\x00\x05\x00\x01\x00\x00\x00\x02\x00\x05\x00\x02\x00\x00\x00\x02\x00\x03\x00\xff\xff\x02\x00\x06\x00\x03\x00\xfc\xff\x01\x00\b\x00\x04\x00\xfd\xff\x00\x00\x04\x00\x03\x00\x00\x00\x02\x00\x02\x00\x03\x00\x02\x00\x02\x00\x00\x00\x01\x00\x03\x00\x01\x00\xff\xff\x03\x00\x03\x00\x03\x00\x01\x00\x00\x00\x00\x00\x03\x00\x02\x00\x00\x00\x02\x00\x02\x00\xff\xff\x03\x00\x04\x00\x01\x00\xfe\xff\x02\x00\x03\x00\x00\x00\x00\x00\x03\x00\x02\x00\x00\x00\x01\x00\x05\x00\x00\x00\xfe\xff\x02\x00\x04\x00\xff\xff\xfe\xff\x02\x00\x05\x00\x01\x00\xfe\xff\xff\xff\x05\x00\x03\x00\xfe\xff\x00\x00\x04\x00\xff\xff\x00\x00\x03\x00\xff\xff\xfe\xff\x04\x00\x01\x00\xff\xff\x01\x00\x01\x00\xff\xff\x02\x00\x02\x00\x00\x00\xfe\xff\x02\x00\x02\x00\xff\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xfd\xff\x05\x00\x02\x00\xff\xff\xfe\xff\xfe\xff\x01\x00\x06\x00\x01\x00\xfa\xff\xfe\xff\x06\x00\x02\x00\xfb\xff\xfc\xff\x04\x00\x04\x00\xfe\xff\xfb\xff\x02\x00\x04\x00\xff\xff\xfd\xff\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\xff\xff\xfd\xff\x00\x00\x03\x00\x00\x00\xfc\xff\xff\xff\x02\x00\x02\x00\xfe\xff\xfc\xff\xff\xff\x03\x00\x02\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\x01\x00\xfd\xff\xfe\xff\xff\xff\x01\x00\x00\x00\xff\xff\xff\xff\xfe\xff\xff\xff\x00\x00\xff\xff\xff\xff\x00\x00\x00\x00\xfa\xff\xfe\xff\x06\x00\x00\x00\xf8\xff\xff\xff\x04\x00\xfd\xff\xfc\xff\x03\x00\xff\xff\xfa\xff\x00\x00\x03\x00\xfe\xff\xfe\xff\xfe\xff\xfd\xff\xff\xff\x02\x00\xfd\xff\xfc\xff\x02\x00\x01\x00\xfc\xff\xfd\xff\xff\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\x00\x00\xff\xff\xfc\xff\xff\xff\x00\x00\xfb\xff\x00\x00\x02\x00\xf9\xff\xfc\xff\a\x00\x00\x00\xf4\xff\xfe\xff\b\x00\xfe\xff\xf7\xff\xfd\xff\x03\x00\x01\x00\xf9\xff\xfa\xff\x05\x00\x05\x00\xf7\xff\xf8\xff\x05\x00\x02\x00\xf8\xff\xfc\xff\x03\x00\xfe\xff\xfb\xff\x00\x00\x01\x00\xfc\xff\xfa\xff\xff\xff\x02\x00\xfe\xff\xfa\xff\xfb\xff\x01\x00\x02\x00\xfd\xff\xfa\xff\xfd\xff\x01\x00\xff\xff\xfb\xff\xfc\xff\x01\x00\xff\xff\xfa\xff\xfe\xff\x02\x00\xfc\xff\xf9\xff\x00\x00\x02\x00\xfb\xff\xfb\xff\x01\x00\x01\x00\xfc\xff\xfa\xff\xfc\xff\x01\x00\x00\x00\xfa\xff\xfc\xff\x01\x00\xfe\xff\xfd\xff\xff\xff\xfc\xff\xf9\xff\x01\x00\x03\x00\xf9\xff\xf8\xff\x02\x00\x03\x00\xfc\xff\xf7\xff\xfe\xff\x03\x00\xfc\xff\xf9\xff\x01\x00\x00\x00\xf9\xff\xfd\xff\x03\x00\xfc\xff\xfa\xff\xff\xff\xff\xff\xfc\xff\xff\xff\xff\xff\xfb\xff\xfb\xff\xff\xff\x01\x00\xff\xff\xf9\xff\xfc\xff\x00\x00\x00\x00\xfd\xff\xfc\xff\xfc\xff\xfe\xff\x01\x00\xfe\xff\xf9\xff\xfe\xff\x00\x00\xfc\xff\xfb\xff\x02\x00\x01\x00\xf9\xff\xfa\xff\x00\x00\x01\x00\xfd\xff\xfb\xff\xfd\xff\xfd\xff\x00\x00\x00\x00\xfc\xff\xfb\xff\xfd\xff\xfe\xff\xff\xff\xfe\xff\xfd\xff\xfe\xff\xff\xff\xfb\xff\xfc\xff\x02\x00\xff\xff\xf8\xff\xfd\xff\x00\x00\xff\xff\xff\xff\xfe\xff\xfa\xff\xfc\xff\x02\x00\x00\x00\xfa\xff\xfd\xff\xff\xff\xff\xff\xff\xff\xfc\xff\xfa\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\xff\xff\xfc\xff\xfe\xff\x02\x00\xfc\xff\xf9\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\x00\x00\xfe\xff\xfc\xff\xfe\xff\x00\x00\x00\x00\xfd\xff\xf9\xff\xff\xff\x05\x00\xff\xff\xf7\xff\xfe\xff\x06\x00\xff\xff\xf8\xff\xfe\xff\x00\x00\xfe\xff\xff\xff\x01\x00\xfd\xff\xf9\xff\xff\xff\a\x00\xfe\xff\xf6\xff\xfe\xff\x05\x00\xfe\xff\xfb\xff\x00\x00\x00\x00\xfb\xff\xff\xff\x02\x00\xfe\xff\xfc\xff\x00\x00\x00\x00\xfe\xff\xfd\xff\xff\xff\x00\x00\x00\x00\xfe\xff\xfe\xff\xff\xff\x00\x00\xfe\xff\xfe\xff\x00\x00\x00\x00\xfd\xff\xfe\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\xfd\xff\x01\x00\x02\x00\xfe\xff\xfb\xff\x00\x00\x03\x00\xff\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x03\x00\x02\x00\xfc\xff\xfc\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x01\x00\xfd\xff\xff\xff\x02\x00\x00\x00\xfe\xff\x01\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\xfc\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\x00\x00\x02\x00\x00\x00\xff\xff\xff\xff\xff\xff\x04\x00\x02\x00\xfb\xff\xfd\xff\x05\x00\x02\x00\xfc\xff\xff\xff\x03\x00\xff\xff\x00\x00\x01\x00\x01\x00\xff\xff\xff\xff\x02\x00\x02\x00\xfd\xff\x00\x00\x04\x00\x01\x00\xfc\xff\x00\x00\x03\x00\x02\x00\x00\x00\xff\xff\xfd\xff\x02\x00\x05\x00\x00\x00\xfc\xff\x01\x00\x05\x00\x01\x00\xfc\xff\x00\x00\x04\x00\x03\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x04\x00\x03\x00\xfc\xff\xfe\xff\x05\x00\x06\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\x00\x00\x01\x00\x03\x00\x03\x00\xff\xff\x00\x00\x02\x00\x03\x00\xfe\xff\xff\xff\x06\x00\x06\x00\xfc\xff\xfc\xff\x06\x00\x06\x00\xfe\xff\x00\x00\x01\x00\xff\xff\x03\x00\b\x00\x00\x00\xfa\xff\x01\x00\n\x00\x04\x00\xfb\xff\xfe\xff\x06\x00\x03\x00\xfe\xff\x01\x00\x06\x00\x01\x00\x00\x00\x03\x00\x03\x00\x00\x00\x00\x00\x03\x00\x03\x00\x00\x00\x01\x00\x02\x00\x04\x00\x04\x00\x00\x00\xfd\xff\x02\x00\a\x00\x05\x00\xfd\xff\xfd\xff\x05\x00\a\x00\x00\x00\xfe\xff\x03\x00\x03\x00\x01\x00\x05\x00\x03\x00\xfe\xff\xff\xff\x05\x00\x05\x00\x01\x00\xfe\xff\x01\x00\x05\x00\x05\x00\x01\x00\xff\xff\x02\x00\x05\x00\x02\x00\xff\xff\x01\x00\x06\x00\x03\x00\x00\x00\x02\x00\x02\x00\x03\x00\x05\x00\x01\x00\xfe\xff\x02\x00\b\x00\x04\x00\xfe\xff\xff\xff\x04\x00\x06\x00\x02\x00\xff\xff\x03\x00\x04\x00\x01\x00\x02\x00\x04\x00\x02\x00\x01\x00\x03\x00\x04\x00\x02\x00\x02\x00\x01\x00\x02\x00\x05\x00\x01\x00\x00\x00\x06\x00\x05\x00\xfe\xff\x00\x00\t\x00\x04\x00\xfb\xff\x02\x00\t\x00\x02\x00\xfd\xff\x03\x00\x06\x00\x02\x00\x00\x00\x03\x00\x04\x00\x02\x00\x01\x00\x03\x00\x04\x00\x01\x00\x01\x00\x04\x00\x02\x00\x01\x00\x03\x00\x04\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x04\x00\x05\x00\x00\x00\xff\xff\x03\x00\a\x00\x02\x00\xfd\xff\x02\x00\a\x00\x03\x00\xfe\xff\x01\x00\x06\x00\x03\x00\x00\x00\x03\x00\x03\x00\xff\xff\x02\x00\a\x00\x02\x00\xfc\xff\x03\x00\a\x00\x03\x00\x00\x00\x00\x00\x02\x00\x05\x00\x03\x00\xff\xff\x01\x00\x05\x00\x02\x00\x01\x00\x02\x00\x02\x00\x01\x00\x03\x00\x03\x00\x01\x00\x01\x00\x03\x00\x02\x00\x02\x00\x03\x00\x02\x00\xff\xff\x01\x00\x05\x00\x04\x00\x00\x00\x01\x00\x01\x00\x02\x00\x05\x00\x03\x00\xfe\xff\x00\x00\x04\x00\x03\x00\x03\x00\x03\x00\xfe\xff\xfe\xff\x05\x00\b\x00\x00\x00\xfc\xff\x02\x00\x06\x00\x02\x00\xff\xff\x02\x00\x03\x00\xff\xff\x02\x00\x06\x00\x00\x00\xfd\xff\x05\x00\x06\x00\xfd\xff\xfe\xff\x06\x00\x02\x00\xfe\xff\x03\x00\x05\x00\xff\xff\xfe\xff\x05\x00\x04\x00\xfe\xff\x00\x00\x03\x00\x01\x00\x00\x00\x04\x00\x03\x00\xfe\xff\x01\x00\x05\x00\x01\x00\xff\xff\x01\x00\x01\x00\x01\x00\x04\x00\x03\x00\xfd\xff\xfe\xff\x05\x00\x05\x00\xfd\xff\xfc\xff\x05\x00\x06\x00\xfd\xff\xfe\xff\x06\x00\x02\x00\xfd\xff\x00\x00\x01\x00\x01\x00\x02\x00\x00\x00\x00\x00\x02\x00\x01\x00\x00\x00\x02\x00\x01\x00\xff\xff\xff\xff\x01\x00\x03\x00\x00\x00\xfe\xff\x04\x00\x03\x00\xfd\xff\xff\xff\x03\x00\x00\x00\x00\x00\x01\x00\xff\xff\x01\x00\x04\x00\xfe\xff\xff\xff\x04\x00\xff\xff\xfb\xff\x04\x00\x06\x00\xfc\xff\xfc\xff\x05\x00\x02\x00\xfd\xff\x00\x00\x02\x00\xff\xff\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\xff\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x04\x00\x05\x00\xfc\xff\xfa\xff\x03\x00\x06\x00\xfe\xff\xfa\xff\x00\x00\x06\x00\x01\x00\xfb\xff\xfe\xff\x03\x00\x03\x00\xff\xff\xfc\xff\xfe\xff\x04\x00\x02\x00\xf9\xff\xfe\xff\x06\x00\x01\x00\xfb\xff\x00\x00\x02\x00\xff\xff\xff\xff\xfe\xff\xfe\xff\x02\x00\x01\x00\xfd\xff\xff\xff\x01\x00\xfd\xff\x02\x00\x04\x00\xfb\xff\xf9\xff\x03\x00\x04\x00\xfd\xff\xfe\xff\x00\x00\xfd\xff\x00\x00\x04\x00\xfe\xff\xf9\xff\x00\x00\x04\x00\xfe\xff\xfc\xff\x02\x00\x01\x00\xfc\xff\xfe\xff\x00\x00\x00\x00\xff\xff\xfe\xff\xff\xff\x00\x00\x00\x00\xfc\xff\xfe\xff\x04\x00\x00\x00\xf9\xff\xfd\xff\x04\x00\x01\x00\xfc\xff\xfe\xff\xff\xff\xff\xff\xfe\xff\xfd\xff\x00\x00\x03\x00\xfd\xff\xfa\xff\x01\x00\x03\x00\xfc\xff\xfd\xff\x00\x00\xfb\xff\xfd\xff\x06\x00\x01\x00\xf8\xff\xfc\xff\x04\x00\x00\x00\xfb\xff\xfd\xff\xfe\xff\x00\x00\x02\x00\xfd\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfb\xff\xff\xff\x01\x00\xfd\xff\xfd\xff\x00\x00\x00\x00\xfb\xff\xff\xff\x02\x00\xfc\xff\xfc\xff\x02\x00\x00\x00\xfb\xff\xfb\xff\x00\x00\x00\x00\xff\xff\x00\x00\xfe\xff\xfa\xff\xfe\xff\x04\x00\xfe\xff\xf7\xff\xff\xff\x04\x00\xff\xff\xfb\xff\xfe\xff\x01\x00\xfd\xff\xfa\xff\x00\x00\x03\x00\xfd\xff\xf8\xff\x01\x00\x04\x00\xfb\xff\xfb\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\xfe\xff\xfe\xff\x00\x00\xfd\xff\xfb\xff\x01\x00\x02\x00\xfa\xff\xfc\xff\x02\x00\xfe\xff\xfc\xff\x01\x00\xfe\xff\xfa\xff\x00\x00\x03\x00\xfa\xff\xfa\xff\x03\x00\x02\x00\xfa\xff\xfb\xff\x01\x00\x01\x00\xfc\xff\xfc\xff\xff\xff\x00\x00\xff\xff\xfe\xff\xfc\xff\xfd\xff\x00\x00\x00\x00\xfd\xff\xfc\xff\xfd\xff\x00\x00\x00\x00\xfe\xff\xfe\xff\xff\xff\xfc\xff\xfd\xff\x02\x00\xff\xff\xf8\xff\xfe\xff\x05\x00\xff\xff\xf9\xff\xff\xff\x01\x00\xfe\xff\xfd\xff\xfe\xff\xfe\xff\xff\xff\xff\xff\xff\xff\xfc\xff\xfc\xff\x01\x00\x02\x00\xfc\xff\xfb\xff\x01\x00\x02\x00\xfa\xff\xfb\xff\x02\x00\x01\x00\xfc\xff\xfe\xff\xff\xff\xff\xff\xff\xff\xfd\xff\xfc\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\x02\x00\xff\xff\xfc\xff\xfd\xff\x00\x00\x01\x00\xfe\xff\xfa\xff\x00\x00\x03\x00\xfe\xff\xfa\xff\x01\x00\x02\x00\xfc\xff\xfc\xff\x00\x00\x01\x00\xff\xff\xfb\xff\xfe\xff\x03\x00\x01\x00\xf9\xff\xfd\xff\x04\x00\x00\x00\xfb\xff\xff\xff\xff\xff\xfd\xff\x01\x00\x02\x00\xfb\xff\xfc\xff\x03\x00\x03\x00\xfb\xff\xfb\xff\x00\x00\x03\x00\xff\xff\xfd\xff\xff\xff\x00\x00\xff\xff\x00\x00\xfe\xff\xfd\xff\x00\x00\x02\x00\xfe\xff\xff\xff\x00\x00\xff\xff\xff\xff\x00\x00\x00\x00\xfe\xff\xfd\xff\x02\x00\x02\x00\xfc\xff\xfc\xff\x04\x00\x04\x00\xfa\xff\xfa\xff\x06\x00\x05\x00\xf9\xff\xfb\xff\b\x00\x01\x00\xf6\xff\x00\x00\t\x00\xff\xff\xf9\xff\xff\xff\x04\x00\x02\x00\xff\xff\xfc\xff\x00\x00\x03\x00\xfe\xff\xfe\xff\x03\x00\x00\x00\xff\xff\x00\x00\xfe\xff\x00\x00\x04\x00\xff\xff\xfb\xff\x01\x00\x04\x00\xff\xff\xfe\xff\x00\x00\x01\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x00\x00\x02\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x02\x00\xfe\xff\xfc\xff\x02\x00\x05\x00\x01\x00\xfe\xff\x00\x00\x00\x00\x02\x00\x02\x00\xff\xff\xfe\xff\x02\x00\x03\x00\xff\xff\x00\x00\x02\x00\x00\x00\x01\x00\x01\x00\x00\x00\x01\x00\x01\x00\xff\xff\x01\x00\x03\x00\x01\x00\xff\xff\x00\x00\x02\x00\x04\x00\x00\x00\xfc\xff\x01\x00\a\x00\x00\x00\xfc\xff\x02\x00\x04\x00\x01\x00\x01\x00\xff\xff\xfe\xff\x04\x00\a\x00\xfc\xff\xfb\xff\a\x00\a\x00\xfb\xff\xfd\xff\b\x00\x04\x00\xf9\xff\xff\xff\n\x00\x04\x00\xfa\xff\xff\xff\a\x00\x05\x00\xfb\xff\xfe\xff\t\x00\x05\x00\xf9\xff\xfd\xff\v\x00\x05\x00\xfa\xff\x01\x00\x06\x00\x01\x00\x00\x00\x03\x00\x00\x00\xff\xff\x05\x00\x04\x00\xfe\xff\x01\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x06\x00\x00\x00\xfd\xff\x03\x00\x05\x00\x03\x00\x00\x00\x00\x00\x03\x00\x05\x00\x02\x00\xff\xff\x00\x00\x00\x00\x04\x00\a\x00\x01\x00\xfc\xff\x04\x00\a\x00\xff\xff\xff\xff\x05\x00\x02\x00\x00\x00\x03\x00\x04\x00\x02\x00\x01\x00\x00\x00\x01\x00\x03\x00\x04\x00\x03\x00\x00\x00\x01\x00\x05\x00\x03\x00\x00\x00\x02\x00\x04\x00\x00\x00\xff\xff\x05\x00\x06\x00\x00\x00\xff\xff\x03\x00\x06\x00\x02\x00\xfe\xff\x02\x00\x06\x00\x01\x00\x00\x00\x04\x00\x04\x00\x01\x00\x01\x00\x02\x00\x02\x00\x02\x00\x03\x00\x01\x00\x03\x00\x04\x00\x01\x00\x02\x00\x04\x00\x02\x00\x01\x00\x01\x00\x03\x00\x02\x00\x02\x00\x02\x00\x03\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x06\x00\x04\x00\xfd\xff\x00\x00\x05\x00\x04\x00\x03\x00\x02\x00\xff\xff\x02\x00\x05\x00\x04\x00\xff\xff\xff\xff\x04\x00\x06\x00\x01\x00\xff\xff\x01\x00\x05\x00\x05\x00\x01\x00\xfd\xff\x03\x00\a\x00\x02\x00\x00\x00\x04\x00\x00\x00\xff\xff\a\x00\a\x00\xfc\xff\xfe\xff\a\x00\x06\x00\x00\x00\xff\xff\x03\x00\x06\x00\x01\x00\x00\x00\x03\x00\x04\x00\x01\x00\x02\x00\x03\x00\x00\x00\x02\x00\x05\x00\x01\x00\x01\x00\x04\x00\x02\x00\xff\xff\x04\x00\x04\x00\xff\xff\x00\x00\x06\x00\x03\x00\xfe\xff\x01\x00\x06\x00\x03\x00\xff\xff\x00\x00\x04\x00\x03\x00\x02\x00\x01\x00\x02\x00\x01\x00\x01\x00\x04\x00\x03\x00\xfe\xff\x01\x00\x05\x00\x03\x00\xff\xff\x02\x00\x04\x00\x01\x00\xff\xff\x03\x00\x04\x00\x02\x00\xff\xff\x01\x00\x02\x00\x03\x00\x03\x00\x01\x00\x00\x00\x02\x00\x02\x00\x04\x00\x01\x00\xfe\xff\x02\x00\a\x00\x01\x00\xfc\xff\x02\x00\x06\x00\x00\x00\x00\x00\x04\x00\x02\x00\xfd\xff\x03\x00\x06\x00\x00\x00\xfe\xff\x04\x00\x03\x00\xff\xff\x01\x00\x05\x00\x00\x00\xfe\xff\x01\x00\x06\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x05\x00\x02\x00\xfd\xff\x00\x00\x03\x00\x03\x00\x00\x00\x03\x00\x01\x00\xfd\xff\x03\x00\x05\x00\xfe\xff\xff\xff\x03\x00\x02\x00\xfe\xff\x01\x00\x04\x00\x00\x00\xfe\xff\x04\x00\x05\x00\xfd\xff\xfb\xff\x06\x00\x06\x00\xfc\xff\xfd\xff\x05\x00\x01\x00\xfe\xff\x02\x00\x02\x00\xff\xff\x00\x00\x02\x00\x03\x00\x00\x00\xff\xff\xff\xff\x01\x00\x03\x00\x00\x00\xfd\xff\x01\x00\x03\x00\x02\x00\xff\xff\xfd\xff\x00\x00\x06\x00\x02\x00\xfb\xff\xfe\xff\x05\x00\x00\x00\xfd\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x05\x00\xff\xff\xfd\xff\xff\xff\x03\x00\x03\x00\xff\xff\xfb\xff\x01\x00\x04\x00\xff\xff\xfb\xff\x02\x00\x04\x00\xfe\xff\xfd\xff\x04\x00\x03\x00\xfb\xff\xfb\xff\x05\x00\x04\x00\xfc\xff\xfc\xff\x02\x00\x00\x00\xff\xff\x02\x00\x02\x00\xfb\xff\xfc\xff\x05\x00\x04\x00\xfb\xff\xfd\xff\x02\x00\x00\x00\xfe\xff\x01\x00\xff\xff\xfe\xff\x00\x00\x02\x00\xfe\xff\xfc\xff\x01\x00\x03\x00\xff\xff\xfd\xff\xfe\xff\x01\x00\x00\x00\xff\xff\xfe\xff\x00\x00\x00\x00\xfe\xff\xfe\xff\x02\x00\xff\xff\xfb\xff\xff\xff\x04\x00\xfe\xff\xfb\xff\x02\x00\x05\x00\xf8\xff\xf9\xff\a\x00\x04\x00\xf6\xff\xfc\xff\x06\x00\x00\x00\xfb\xff\x00\x00\xff\xff\xfe\xff\xff\xff\xff\xff\xfe\xff\x00\x00\xfe\xff\xfe\xff\x02\x00\xfe\xff\xfa\xff\x02\x00\x03\x00\xfa\xff\xfb\xff\x04\x00\xff\xff\xf9\xff\xff\xff\x04\x00\xff\xff\xfa\xff\xfd\xff\x02\x00\x00\x00\xfd\xff\xfd\xff\xfe\xff\xff\xff\xff\xff\xfc\xff\xfe\xff\x03\x00\x00\x00\xf9\xff\xfc\xff\x02\x00\x01\x00\xfd\xff\xfb\xff\xfc\xff\x03\x00\x03\x00\xfa\xff\xf8\xff\x01\x00\x04\x00\xfd\xff\xf8\xff\xfe\xff\x03\x00\x01\x00\xfa\xff\xfb\xff\x03\x00\xff\xff\xf8\xff\xff\xff\x03\x00\xfc\xff\xfb\xff\x01\x00\xfd\xff\xfe\xff\x04\x00\xfa\xff\xf6\xff\x03\x00\x04\x00\xfa\xff\xfb\xff\x00\x00\xfe\xff\xff\xff\x00\x00\xfb\xff\xfd\xff\x01\x00\xfc\xff\xfc\xff\x01\x00\xfd\xff\xfb\xff\x01\x00\x01\x00\xfb\xff\xfc\xff\x01\x00\xff\xff\xfa\xff\xfd\xff\x01\x00\xff\xff\xfb\xff\xfe\xff\x02\x00\xfd\xff\xfa\xff\xff\xff\x01\x00\xfd\xff\xfb\xff\xfe\xff\x01\x00\xff\xff\xfa\xff\xfd\xff\x03\x00\xfe\xff\xf8\xff\xfe\xff\x05\x00\xfe\xff\xf7\xff\xfd\xff\x02\x00\x00\x00\xfc\xff\xfb\xff\xfe\xff\x01\x00\xff\xff\xfb\xff\xfd\xff\x01\x00\xfe\xff\xfb\xff\xfe\xff\xff\xff\xfd\xff\xff\xff\xfe\xff\xfc\xff\xff\xff\x00\x00\xfd\xff\xfc\xff\xfd\xff\xff\xff\x01\x00\xfe\xff\xf8\xff\xfd\xff\x04\x00\x00\x00\xfa\xff\xfc\xff\xff\xff\x00\x00\xfe\xff\xfd\xff\xfe\xff\xfc\xff\xfc\xff\x02\x00\x00\x00\xfa\xff\xfd\xff\x02\x00\xfe\xff\xfb\xff\xff\xff\x00\x00\xfc\xff\xfc\xff\x00\x00\x01\x00\xfb\xff\xfa\xff\x02\x00\x03\x00\xf9\xff\xf9\xff\x03\x00\x03\x00\xf9\xff\xfa\xff\x02\x00\x03\x00\xfc\xff\xf9\xff\xfc\xff\x02\x00\x03\x00\xfd\xff\xf7\xff\xfe\xff\x06\x00\xfe\xff\xf7\xff\x00\x00\x03\x00\xfb\xff\xfb\xff\x03\x00\xff\xff\xfa\xff\xff\xff\x01\x00\xfc\xff\xfd\xff\x00\x00\xff\xff\xfe\xff\xff\xff\xfd\xff\xfd\xff\xff\xff\x01\x00\xfe\xff\xfd\xff\xfe\xff\xff\xff\x00\x00\xff\xff\xfb\xff\xfe\xff\x00\x00\xff\xff\xff\xff\xff\xff\xfc\xff\xfe\xff\x03\x00\xfe\xff\xf8\xff\x01\x00\x03\x00\xfc\xff\xfc\xff\x02\x00\xff\xff\xfc\xff\x00\x00\x00\x00\xfc\xff\xff\xff\x00\x00\xff\xff\xfe\xff\x00\x00\xfe\xff\xfd\xff\x01\x00\x01\x00\xfb\xff\xfe\xff\x02\x00\xff\xff\xfc\xff\x01\x00\xff\xff\xfc\xff\x01\x00\x02\x00\xfb\xff\xfd\xff\x02\x00\x01\x00\xfd\xff\xff\xff\xfe\xff\xff\xff\x01\x00\xff\xff\xfd\xff\x00\x00\x00\x00\xff\xff\xff\xff\x00\x00\xfe\xff\x00\x00\x01\x00\xff\xff\xfc\xff\xff\xff\x02\x00\x01\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xfc\xff\x00\x00\x01\x00\x00\x00\xfe\xff\xff\xff\xff\xff\x00\x00\x01\x00\xff\xff\xfe\xff\x00\x00\x00\x00\x01\x00\xff\xff\x00\x00\x00\x00\xfe\xff\xff\xff\x03\x00\x00\x00\xfc\xff\xff\xff\x03\x00\x01\x00\xff\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xfc\xff\x02\x00\x04\x00\xfe\xff\xfb\xff\x01\x00\x05\x00\x02\x00\xfc\xff\xff\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x00\x00\x03\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x03\x00\xfe\xff\xfc\xff\x02\x00\x04\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\x01\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x06\x00\xff\xff\xfb\xff\x00\x00\x06\x00\x02\x00\xfc\xff\xff\xff\x04\x00\x03\x00\xff\xff\xfe\xff\x03\x00\x01\x00\xfe\xff\x03\x00\x04\x00\xfc\xff\xfe\xff\a\x00\x03\x00\xfc\xff\xff\xff\x02\x00\x03\x00\x02\x00\xff\xff\xff\xff\x04\x00\x02\x00\xfe\xff\x02\x00\x03\x00\xfe\xff\x00\x00\x05\x00\x03\x00\xfc\xff\xff\xff\x06\x00\x03\x00\xfc\xff\x01\x00\x05\x00\x00\x00\xfe\xff\x03\x00\x03\x00\x00\x00\xff\xff\x03\x00\x03\x00\xff\xff\xfe\xff\x03\x00\x04\x00\x00\x00\x00\x00\x02\x00\x01\x00\x02\x00\x00\x00\x00\x00\x03\x00\x03\x00\xff\xff\x00\x00\x03\x00\x05\x00\x01\x00\xfc\xff\xff\xff\t\x00\x05\x00\xfa\xff\xff\xff\b\x00\x02\x00\xfe\xff\x01\x00\x04\x00\x03\x00\x01\x00\xfe\xff\x02\x00\x06\x00\x02\x00\xfd\xff\x01\x00\x04\x00\x03\x00\x02\x00\x00\x00\xff\xff\x05\x00\x05\x00\xfd\xff\xfd\xff\a\x00\x06\x00\xfd\xff\xff\xff\x06\x00\x03\x00\x00\x00\x02\x00\x01\x00\xff\xff\x05\x00\x05\x00\xfe\xff\x00\x00\x05\x00\x03\x00\x01\x00\x01\x00\x01\x00\x02\x00\x04\x00\x01\x00\x00\x00\x04\x00\x02\x00\xff\xff\x03\x00\x05\x00\x00\x00\xff\xff\x05\x00\x03\x00\xff\xff\x03\x00\x03\x00\xff\xff\x02\x00\x04\x00\x01\x00\x02\x00\x03\x00\x00\x00\x01\x00\x05\x00\x02\x00\xfe\xff\x02\x00\x03\x00\x03\x00\x04\x00\x00\x00\x00\x00\x04\x00\x04\x00\xfe\xff\xfe\xff\x05\x00\x05\x00\x00\x00\x01\x00\x04\x00\x02\x00\x00\x00\x03\x00\x03\x00\x00\x00\x00\x00\x04\x00\x04\x00\x01\x00\xff\xff\x02\x00\x05\x00\x02\x00\x00\x00\x01\x00\x02\x00\x03\x00\x02\x00\x02\x00\x01\x00\x02\x00\x03\x00\x02\x00\x00\x00\x00\x00\x04\x00\x04\x00\x00\x00\x00\x00\x02\x00\x04\x00\x03\x00\x01\x00\xff\xff\x01\x00\x06\x00\x03\x00\xfc\xff\x01\x00\a\x00\x01\x00\xff\xff\x04\x00\x02\x00\x00\x00\x02\x00\x04\x00\x00\x00\xff\xff\x03\x00\x03\x00\x01\x00\x00\x00\x02\x00\x04\x00\xff\xff\x00\x00\a\x00\x03\x00\xf9\xff\xff\xff\n\x00\x05\x00\xfa\xff\xfe\xff\x06\x00\x05\x00\xff\xff\xfd\xff\x01\x00\a\x00\x03\x00\xfc\xff\xff\xff\x06\x00\x03\x00\xfd\xff\xff\xff\x04\x00\x03\x00\x00\x00\x00\x00\x04\x00\x01\x00\x00\x00\x03\x00\xff\xff\xfd\xff\x03\x00\x05\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x06\x00\x03\x00\xfb\xff\x00\x00\x05\x00\xff\xff\x00\x00\x04\x00\x00\x00\xfd\xff\x04\x00\x04\x00\xfe\xff\xff\xff\x03\x00\x02\x00\x00\x00\xff\xff\x03\x00\x03\x00\xfe\xff\xfd\xff\x03\x00\x04\x00\xff\xff\x00\x00\x03\x00\xff\xff\x01\x00\x02\x00\xff\xff\xff\xff\x03\x00\x01\x00\xfd\xff\x01\x00\x06\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x02\x00\xfe\xff\xff\xff\x01\x00\x00\x00\x01\x00\x02\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\x03\x00\xff\xff\xff\xff\x00\x00\x00\x00\x03\x00\x02\x00\xfb\xff\xfe\xff\x05\x00\x02\x00\xfb\xff\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\xfe\xff\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\xfd\xff\x02\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\xff\xff\x02\x00\xff\xff\x00\x00\x00\x00\x00\x00\xff\xff\xfe\xff\x00\x00\x03\x00\xfe\xff\xfd\xff\x00\x00\x01\x00\xff\xff\xff\xff\xff\xff\x01\x00\x00\x00\xfe\xff\xfe\xff\x02\x00\x00\x00\xfc\xff\x01\x00\x01\x00\xfa\xff\x00\x00\x05\x00\x00\x00\xf9\xff\xfd\xff\x05\x00\x01\x00\xfa\xff\xfe\xff\x03\x00\xff\xff\xfb\xff\x02\x00\x02\x00\xfd\xff\xfd\xff\xff\xff\x00\x00\x01\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\x00\x00\xfe\xff\xfd\xff\xff\xff\x01\x00\x00\x00\xfb\xff\xfd\xff\x03\x00\x02\x00\xfa\xff\xfc\xff\x02\x00\x01\x00\xfe\xff\xfe\xff\xfd\xff\xff\xff\x01\x00\xff\xff\xfd\xff\x00\x00\xfd\xff\xfd\xff\x02\x00\x00\x00\xfc\xff\xff\xff\x00\x00\xfe\xff\x00\x00\xff\xff\xfb\xff\xff\xff\x01\x00\xff\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\x00\x00\xff\xff\xfd\xff\xfe\xff\x00\x00\x00\x00\xfc\xff\xfe\xff\x01\x00\xfd\xff\xfb\xff\x02\x00\x02\x00\xfc\xff\xfb\xff\x01\x00\x01\x00\xfc\xff\xfb\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\x01\x00\xff\xff\xfc\xff\xfc\xff\x01\x00\x01\x00\xfa\xff\xfb\xff\x02\x00\x02\x00\xfd\xff\xfb\xff\xfe\xff\xff\xff\x00\x00\xff\xff\xfc\xff\xfd\xff\xfe\xff\xfe\xff\x01\x00\xff\xff\xfb\xff\xfd\xff\x02\x00\x00\x00\xfd\xff\xfd\xff\xfc\xff\xfe\xff\x03\x00\xff\xff\xf7\xff\xfd\xff\x06\x00\x00\x00\xf9\xff\xfc\xff\x02\x00\x01\x00\xfb\xff\xfe\xff\x01\x00\xfb\xff\xfc\xff\x02\x00\x00\x00\xfb\xff\xfd\xff\x01\x00\xfd\xff\xfe\xff\x02\x00\xfc\xff\xfa\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\xff\xff\xff\xff\xff\xff\xfd\xff\xfc\xff\x00\x00\x01\x00\xfb\xff\xfc\xff\x02\x00\x00\x00\xfb\xff\xfe\xff\x02\x00\xfc\xff\xfa\xff\x02\x00\x01\x00\xfb\xff\xfd\xff\xff\xff\xfe\xff\x00\x00\xff\xff\xfb\xff\xfd\xff\x01\x00\xff\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\xfd\xff\xfe\xff\x02\x00\xff\xff\xfa\xff\xfd\xff\x01\x00\x00\x00\xfe\xff\xfd\xff\xfd\xff\xff\xff\x02\x00\xfd\xff\xfa\xff\x00\x00\x03\x00\xfb\xff\xf9\xff\x02\x00\x05\x00\xfb\xff\xf9\xff\x01\x00\x04\x00\xfe\xff\xf9\xff\xfc\xff\x03\x00\x03\x00\xfc\xff\xf9\xff\xff\xff\x04\x00\x00\x00\xfa\xff\xfd\xff\x01\x00\xfe\xff\xfe\xff\x03\x00\xfe\xff\xf9\xff\xff\xff\x03\x00\xfe\xff\xfb\xff\x00\x00\x01\x00\xfc\xff\xfd\xff\x02\x00\x02\x00\xfb\xff\xfb\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x01\x00\x02\x00\xfd\xff\xfc\xff\x00\x00\x00\x00\xfd\xff\xff\xff\x00\x00\xfd\xff\xfe\xff\x04\x00\x00\x00\xfa\xff\xfd\xff\x02\x00\x00\x00\xfe\xff\x00\x00\xff\xff\xfc\xff\x00\x00\x01\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\x03\x00\x01\x00\xf9\xff\xfc\xff\x06\x00\x02\x00\xfa\xff\xfc\xff\x03\x00\x02\x00\xfd\xff\xfd\xff\x02\x00\x01\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xfd\xff\xfe\xff\x01\x00\x01\x00\xff\xff\xfd\xff\xff\xff\x03\x00\x02\x00\xfb\xff\xfc\xff\x03\x00\x04\x00\xfd\xff\xfb\xff\x00\x00\x04\x00\x01\x00\xfe\xff\xfd\xff\xff\xff\x01\x00\x02\x00\xfe\xff\xfc\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\x03\x00\x03\x00\xfa\xff\xff\xff\x06\x00\xfe\xff\xfa\xff\x02\x00\x05\x00\xfe\xff\xfb\xff\x02\x00\x03\x00\x00\x00\xff\xff\xfe\xff\x00\x00\x02\x00\xff\xff\xff\xff\x01\x00\x01\x00\xff\xff\x00\x00\x01\x00\x00\x00\x01\x00\x01\x00\xfe\xff\x00\x00\x02\x00\x00\x00\xff\xff\x01\x00\x01\x00\x01\x00\xff\xff\xfe\xff\x03\x00\x04\x00\xfd\xff\xfd\xff\x04\x00\x03\x00\xfc\xff\xff\xff\x05\x00\x01\x00\xfb\xff\xff\xff\a\x00\x03\x00\xfa\xff\x00\x00\a\x00\x01\x00\xfa\xff\xff\xff\x06\x00\x01\x00\xfd\xff\x02\x00\x02\x00\x00\x00\x02\x00\x03\x00\xfd\xff\xfe\xff\x05\x00\x02\x00\xfd\xff\x02\x00\x03\x00\xfe\xff\xff\xff\x04\x00\x02\x00\xff\xff\x01\x00\x02\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x02\x00\x03\x00\x00\x00\xff\xff\x02\x00\x02\x00\x01\x00\x00\x00\x00\x00\x01\x00\x03\x00\x02\x00\x00\x00\x00\x00\x01\x00\x02\x00\x02\x00\xff\xff\x00\x00\x04\x00\x02\x00\xff\xff\x00\x00\x02\x00\x03\x00\x02\x00\x00\x00\xfe\xff\x04\x00\x06\x00\xfd\xff\xfd\xff\x06\x00\x05\x00\xfc\xff\xff\xff\x05\x00\x02\x00\x01\x00\x01\x00\x01\x00\x03\x00\x01\x00\x00\x00\x02\x00\x03\x00\x00\x00\x00\x00\x02\x00\x03\x00\x03\x00\x01\x00\xfe\xff\x01\x00\x06\x00\x02\x00\xfc\xff\x01\x00\a\x00\x04\x00\xfc\xff\xfe\xff\b\x00\x05\x00\xfc\xff\xfe\xff\x05\x00\x04\x00\xfe\xff\x02\x00\x05\x00\xff\xff\xff\xff\x03\x00\x03\x00\x02\x00\x01\x00\x00\x00\x01\x00\x06\x00\x03\x00\xfc\xff\xfe\xff\x05\x00\x06\x00\xff\xff\xfd\xff\x04\x00\x06\x00\x02\x00\xff\xff\x00\x00\x01\x00\x04\x00\x04\x00\xfe\xff\xfe\xff\x05\x00\x05\x00\x01\x00\x00\x00\xff\xff\x01\x00\x06\x00\x02\x00\xfe\xff\x02\x00\x04\x00\x01\x00\x02\x00\x02\x00\xfe\xff\x01\x00\x06\x00\x01\x00\xfe\xff\x04\x00\x06\x00\x00\x00\xfe\xff\x02\x00\x03\x00\x01\x00\x02\x00\x02\x00\x00\x00\x01\x00\x05\x00\x03\x00\xff\xff\x00\x00\x02\x00\x02\x00\x03\x00\x03\x00\xff\xff\xfe\xff\x06\x00\x06\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\x03\x00\x03\x00\xfe\xff\xff\xff\b\x00\x04\x00\xfb\xff\x00\x00\x06\x00\x00\x00\x00\x00\x05\x00\x01\x00\xfe\xff\x03\x00\x04\x00\x00\x00\x00\x00\x02\x00\x02\x00\x01\x00\x00\x00\x03\x00\x03\x00\xff\xff\x00\x00\x06\x00\x02\x00\xfd\xff\x01\x00\x04\x00\x03\x00\x00\x00\xfe\xff\x02\x00\x05\x00\x04\x00\xfe\xff\xfe\xff\x03\x00\x05\x00\x01\x00\xfe\xff\x02\x00\x03\x00\x00\x00\x02\x00\x02\x00\x00\x00\x00\x00\x04\x00\x02\x00\xfd\xff\x01\x00\a\x00\x01\x00\xfc\xff\x01\x00\x06\x00\x01\x00\xfd\xff\x02\x00\x05\x00\xff\xff\xff\xff\x03\x00\x04\x00\xff\xff\xff\xff\x03\x00\x03\x00\xff\xff\x00\x00\x02\x00\x02\x00\x00\x00\x02\x00\x01\x00\xff\xff\x00\x00\x05\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x03\x00\x01\x00\xfe\xff\xff\xff\x03\x00\x04\x00\xff\xff\x00\x00\x01\x00\x00\x00\x01\x00\x03\x00\x00\x00\xfe\xff\x00\x00\x05\x00\x02\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xff\xff\x05\x00\x00\x00\xff\xff\x01\x00\x00\x00\x00\x00\x03\x00\x00\x00\xfd\xff\x02\x00\x04\x00\xfd\xff\xff\xff\x03\x00\x00\x00\xff\xff\x03\x00\xff\xff\xfd\xff\x04\x00\x04\x00\xfb\xff\xfe\xff\x05\x00\x00\x00\xfb\xff\x04\x00\x04\x00\xfc\xff\xfd\xff\x04\x00\x03\x00\xfe\xff\xfd\xff\x01\x00\x02\x00\x01\x00\xfe\xff\xfe\xff\x02\x00\x04\x00\xfd\xff\xfd\xff\x02\x00\x02\x00\xfd\xff\x00\x00\x03\x00\xfe\xff\xfc\xff\x04\x00\x04\x00\xfc\xff\xf9\xff\x04\x00\a\x00\xfd\xff\xf8\xff\x03\x00\x06\x00\xfe\xff\xfa\xff\x01\x00\x04\x00\xff\xff\xfb\xff\x00\x00\x02\x00\x02\x00\xff\xff\xfc\xff\xfe\xff\x04\x00\x02\x00\xfc\xff\xfb\xff\x02\x00\x03\x00\x00\x00\xfc\xff\xfe\xff\x03\x00\x02\x00\xfa\xff\xfd\xff\x04\x00\x01\x00\xfa\xff\x00\x00\x04\x00\xfe\xff\xfc\xff\x03\x00\x00\x00\xfb\xff\xff\xff\x04\x00\xfe\xff\xfb\xff\xff\xff\x03\x00\x00\x00\xfd\xff\xfe\xff\x00\x00\x00\x00\xff\xff\xfe\xff\x01\x00\xff\xff\xfd\xff\xfe\xff\x03\x00\x01\x00\xfa\xff\xfd\xff\x04\x00\x01\x00\xfb\xff\xfc\xff\x04\x00\x01\x00\xfb\xff\xff\xff\x01\x00\xfd\xff\xff\xff\x01\x00\xff\xff\xfc\xff\x00\x00\x02\x00\xfd\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x03\x00\xff\xff\xfa\xff\x00\x00\x03\x00\xfe\xff\xfb\xff\xfd\xff\x03\x00\x01\x00\xfb\xff\xfc\xff\x02\x00\x01\x00\xfc\xff\xfd\xff\x01\x00\x00\x00\xfe\xff\xfc\xff\xfe\xff\x02\x00\x01\x00\xfa\xff\xfc\xff\x04\x00\x01\x00\xf9\xff\xfe\xff\x04\x00\xff\xff\xf9\xff\xfd\xff\x03\x00\x03\x00\xfc\xff\xf8\xff\xff\xff\x05\x00\x00\x00\xfb\xff\xfb\xff\xff\xff\x02\x00\x01\x00\xfc\xff\xfb\xff\x01\x00\x01\x00\xfc\xff\xfe\xff\x00\x00\xfe\xff\xff\xff\x01\x00\xfc\xff\xfc\xff\x03\x00\x00\x00\xf8\xff\xfe\xff\x04\x00\xfe\xff\xfa\xff\x01\x00\x02\x00\xfc\xff\xfe\xff\x00\x00\xfb\xff\xfc\xff\x04\x00\x02\x00\xf8\xff\xfb\xff\x04\x00\x03\x00\xfb\xff\xfa\xff\xff\xff\x01\x00\x00\x00\xfe\xff\xfb\xff\xfd\xff\x02\x00\x01\x00\xfb\xff\xfc\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\xff\xff\xfe\xff\xfd\xff\x00\x00\x01\x00\xfc\xff\xfb\xff\x02\x00\x02\x00\xfa\xff\xfb\xff\x03\x00\x02\x00\xfb\xff\xfb\xff\x01\x00\x02\x00\xfd\xff\xfc\xff\xfd\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\x00\x00\x01\x00\xfd\xff\xfb\xff\xfe\xff\x01\x00\xff\xff\xfc\xff\xff\xff\x00\x00\xfe\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\xff\xff\xfe\xff\xfe\xff\xff\xff\xfd\xff\xfe\xff\x01\x00\xfe\xff\xfe\xff\xff\xff\xfd\xff\xff\xff\x01\x00\xfe\xff\xfa\xff\xfe\xff\x02\x00\xff\xff\xfc\xff\xff\xff\x02\x00\xfe\xff\xfb\xff\x00\x00\x01\x00\xfd\xff\xfc\xff\x01\x00\x00\x00\xfc\xff\xff\xff\x01\x00\xfe\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\x01\x00\xff\xff\xfd\xff\xfe\xff\x00\x00\x00\x00\xfd\xff\xfe\xff\x00\x00\xfe\xff\xff\xff\xff\xff\xff\xff\xff\xff\x00\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\x00\x00\xfc\xff\xfd\xff\x03\x00\xff\xff\xfb\xff\x01\x00\x02\x00\xfb\xff\xfe\xff\x03\x00\xff\xff\xfc\xff\x00\x00\x00\x00\xff\xff\xff\xff\xfe\xff\x00\x00\x01\x00\xfd\xff\xfd\xff\x01\x00\x02\x00\xfc\xff\xfd\xff\x01\x00\x01\x00\xff\xff\x00\x00\xfe\xff\xfd\xff\x01\x00\x02\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xfa\xff\x01\x00\x03\x00\xfc\xff\xfc\xff\x03\x00\x02\x00\xfd\xff\xfd\xff\x02\x00\x00\x00\xfe\xff\xff\xff\xff\xff\xfd\xff\x00\x00\x04\x00\x01\x00\xfa\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\xfd\xff\x01\x00\x04\x00\xfe\xff\xfb\xff\x01\x00\x05\x00\xfc\xff\xfa\xff\x03\x00\x04\x00\xfe\xff\xfd\xff\xfe\xff\x01\x00\x02\x00\x00\x00\xfc\xff\x01\x00\x04\x00\xfd\xff\xfb\xff\x03\x00\x04\x00\xfd\xff\xf9\xff\x02\x00\x06\x00\x00\x00\xfb\xff\xfe\xff\x02\x00\x03\x00\x01\x00\xfd\xff\xfb\xff\x04\x00\x04\x00\xfb\xff\xfc\xff\x04\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\x01\x00\x05\x00\xfe\xff\xfd\xff\x02\x00\x01\x00\xfc\xff\x02\x00\x05\x00\xfe\xff\xfc\xff\x03\x00\x03\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\a\x00\x04\x00\xf9\xff\xfc\xff\b\x00\x03\x00\xfa\xff\xff\xff\x04\x00\xff\xff\x00\x00\x03\x00\x00\x00\xfd\xff\x02\x00\x02\x00\xfd\xff\x01\x00\x06\x00\xff\xff\xfb\xff\x01\x00\x06\x00\x00\x00\xfd\xff\x00\x00\x02\x00\x01\x00\x01\x00\x00\x00\x01\x00\x01\x00\x00\x00\x01\x00\x01\x00\x00\x00\x01\x00\xff\xff\x01\x00\x04\x00\x01\x00\xfc\xff\x00\x00\x06\x00\x03\x00\xfd\xff\xfe\xff\x00\x00\x05\x00\x04\x00\xfc\xff\xfd\xff\x06\x00\x03\x00\xfd\xff\x01\x00\x04\x00\xfd\xff\xfe\xff\x06\x00\x04\x00\xfc\xff\xfe\xff\x04\x00\x03\x00\xff\xff\x01\x00\x01\x00\xfe\xff\x02\x00\x06\x00\x00\x00\xfc\xff\x00\x00\x04\x00\x02\x00\xff\xff\xff\xff\x03\x00\x05\x00\x00\x00\xfc\xff\x02\x00\x05\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xfe\xff\x01\x00\x05\x00\x00\x00\xff\xff\x04\x00\x00\x00\xfd\xff\x01\x00\x05\x00\x03\x00\xfd\xff\xfe\xff\x04\x00\x05\x00\x00\x00\xfe\xff\x01\x00\x02\x00\x01\x00\x03\x00\x01\x00\xfc\xff\x02\x00\b\x00\x00\x00\xfa\xff\x03\x00\b\x00\xff\xff\xfb\xff\x03\x00\x06\x00\x00\x00\xfe\xff\x02\x00\x03\x00\x01\x00\x01\x00\x01\x00\x00\x00\x01\x00\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\xff\xff\x05\x00\x04\x00\xfc\xff\xff\xff\x06\x00\x01\x00\xfe\xff\x04\x00\x02\x00\xfc\xff\x02\x00\a\x00\xff\xff\xfc\xff\x05\x00\x05\x00\xfc\xff\xfe\xff\x05\x00\x06\x00\xff\xff\xfb\xff\x01\x00\a\x00\x03\x00\xfb\xff\xff\xff\x06\x00\x03\x00\xfe\xff\x00\x00\x04\x00\x00\x00\x00\x00\x03\x00\xff\xff\xfe\xff\x05\x00\x05\x00\xfc\xff\xff\xff\x06\x00\x01\x00\xff\xff\x01\x00\x01\x00\x01\x00\x00\x00\x01\x00\x03\x00\x01\x00\xff\xff\x02\x00\x04\x00\xfe\xff\xfe\xff\x05\x00\x03\x00\xfd\xff\x00\x00\x05\x00\x01\x00\xfd\xff\x04\x00\x04\x00\xfc\xff\xfe\xff\a\x00\x04\x00\xfc\xff\xfd\xff\x04\x00\x06\x00\x01\x00\xfa\xff\x01\x00\t\x00\x00\x00\xfa\xff\x02\x00\x05\x00\x00\x00\xff\xff\x03\x00\x01\x00\x01\x00\x02\x00\xfe\xff\x00\x00\x05\x00\x00\x00\xfe\xff\x03\x00\x03\x00\xfe\xff\x00\x00\x03\x00\x00\x00\xff\xff\x03\x00\x01\x00\x01\x00\x01\x00\xff\xff\x02\x00\x02\x00\xff\xff\x00\x00\x01\x00\x01\x00\x01\x00\x04\x00\xff\xff\xfc\xff\x03\x00\x05\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x04\x00\x01\x00\xfb\xff\x01\x00\x06\x00\x01\x00\xfd\xff\x00\x00\x02\x00\x03\x00\x00\x00\xfd\xff\x01\x00\x05\x00\xff\xff\xfd\xff\x03\x00\x04\x00\xfd\xff\xff\xff\x04\x00\x01\x00\xfc\xff\x01\x00\x05\x00\x00\x00\xfc\xff\x00\x00\x04\x00\x04\x00\xfd\xff\xfd\xff\x02\x00\x03\x00\xff\xff\x00\x00\x02\x00\xfe\xff\xfe\xff\x05\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x03\x00\x00\x00\xfd\xff\x00\x00\x02\x00\x01\x00\xff\xff\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\xfe\xff\x00\x00\x02\x00\x03\x00\xfd\xff\xfc\xff\x04\x00\x04\x00\xfa\xff\xfd\xff\x05\x00\x03\x00\xfd\xff\xff\xff\x01\x00\x01\x00\x00\x00\xff\xff\xfd\xff\x01\x00\x04\x00\x00\x00\xfc\xff\x00\x00\x01\x00\x01\x00\x02\x00\xff\xff\xfa\xff\x00\x00\x06\x00\x00\x00\xfc\xff\x00\x00\x00\x00\x01\x00\x02\x00\xff\xff\xfb\xff\x01\x00\x03\x00\xfe\xff\xff\xff\x02\x00\xff\xff\xff\xff\x00\x00\xff\xff\xfe\xff\x01\x00\x00\x00\xfe\xff\x00\x00\x03\x00\xff\xff\xfd\xff\xff\xff\x01\x00\x00\x00\xff\xff\xfe\xff\xff\xff\x01\x00\x03\x00\xff\xff\xfb\xff\xff\xff\x04\x00\x00\x00\xfc\xff\xfd\xff\x02\x00\x03\x00\xfe\xff\xfb\xff\x01\x00\x05\x00\xfd\xff\xf9\xff\x03\x00\x03\x00\xfb\xff\xfd\xff\x04\x00\x00\x00\xfc\xff\x01\x00\x02\x00\xfc\xff\xfe\xff\x01\x00\xff\xff\xfd\xff\x02\x00\x00\x00\xfb\xff\x00\x00\x05\x00\xfe\xff\xfa\xff\xff\xff\x05\x00\xff\xff\xfa\xff\xff\xff\x04\x00\x00\x00\xfa\xff\xfd\xff\x05\x00\x01\x00\xfa\xff\xfc\xff\x04\x00\x02\x00\xfb\xff\xfe\xff\x02\x00\xfe\xff\xfd\xff\x01\x00\x01\x00\xfb\xff\xfe\xff\x02\x00\xff\xff\xfd\xff\x00\x00\x01\x00\xfc\xff\xfc\xff\x04\x00\x00\x00\xfa\xff\xff\xff\x04\x00\xfd\xff\xfa\xff\x03\x00\x02\x00\xf9\xff\xfd\xff\x04\x00\x00\x00\xfa\xff\xff\xff\x02\x00\xfe\xff\xfe\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\xfe\xff\xff\xff\x01\x00\xff\xff\xfc\xff\xfd\xff\x02\x00\x02\x00\xfd\xff\xfb\xff\xff\xff\x02\x00\x00\x00\xfb\xff\xfe\xff\x02\x00\xfe\xff\xfc\xff\x00\x00\x02\x00\xfe\xff\xfd\xff\x00\x00\xfd\xff\xfe\xff\x02\x00\x00\x00\xfb\xff\xfb\xff\x02\x00\x05\x00\xfd\xff\xf9\xff\x00\x00\x02\x00\xfd\xff\xfe\xff\x00\x00\xfd\xff\xfd\xff\x02\x00\x00\x00\xfe\xff\xff\xff\xfe\xff\xfd\xff\xff\xff\x01\x00\x00\x00\xfc\xff\xfc\xff\x01\x00\x03\x00\xfc\xff\xfb\xff\x02\x00\x01\x00\xfc\xff\xfe\xff\x01\x00\x01\x00\xfc\xff\xfb\xff\x02\x00\x03\x00\xfb\xff\xfa\xff\x02\x00\x02\x00\xfc\xff\xff\xff\x01\x00\xfe\xff\xfd\xff\xff\xff\x01\x00\xff\xff\xfb\xff\xfc\xff\x02\x00\x02\x00\xfb\xff\xfe\xff\x03\x00\xfe\xff\xfb\xff\x00\x00\x03\x00\xfc\xff\xf9\xff\x00\x00\x04\x00\x00\x00\xfb\xff\xfc\xff\x01\x00\x03\x00\x00\x00\xfb\xff\xfb\xff\x00\x00\x03\x00\x00\x00\xfc\xff\xfc\xff\x00\x00\x02\x00\xff\xff\xfd\xff\xfd\xff\xff\xff\x02\x00\xff\xff\xfc\xff\xff\xff\x01\x00\xff\xff\xfd\xff\xff\xff\xff\xff\xfe\xff\x00\x00\xfe\xff\xfe\xff\x01\x00\x00\x00\xfd\xff\xfd\xff\x01\x00\x02\x00\xfd\xff\xfb\xff\xff\xff\x03\x00\xff\xff\xfd\xff\xff\xff\x00\x00\xff\xff\xfe\xff\xff\xff\x00\x00\xfd\xff\xfd\xff\x03\x00\x03\x00\xfa\xff\xfa\xff\x03\x00\x04\x00\xfc\xff\xfc\xff\x00\x00\xff\xff\xff\xff\x03\x00\xff\xff\xf8\xff\x00\x00\a\x00\xfd\xff\xf8\xff\x00\x00\x06\x00\xff\xff\xf9\xff\xfe\xff\x04\x00\x01\x00\xfd\xff\xfe\xff\xff\xff\xfe\xff\x00\x00\x03\x00\x00\x00\xf9\xff\xfd\xff\x05\x00\x02\x00\xfa\xff\xfd\xff\x03\x00\x01\x00\xfe\xff\xfe\xff\xff\xff\x00\x00\xfe\xff\xff\xff\x01\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x00\x00\xfe\xff\xfe\xff\xff\xff\x01\x00\x00\x00\xff\xff\x00\x00\xff\xff\xfe\xff\x00\x00\x02\x00\xff\xff\xfc\xff\x02\x00\x03\x00\xfd\xff\xfc\xff\x01\x00\x03\x00\xfe\xff\xfd\xff\x01\x00\x00\x00\x01\x00\x02\x00\xfe\xff\xfb\xff\xff\xff\x05\x00\x03\x00\xfb\xff\xfc\xff\x02\x00\x04\x00\xfe\xff\xfc\xff\x01\x00\x02\x00\xff\xff\xff\xff\x00\x00\xff\xff\x00\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x00\x00\xfd\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfd\xff\x00\x00\x03\x00\xff\xff\xfb\xff\x01\x00\a\x00\xfe\xff\xf9\xff\x02\x00\x06\x00\xff\xff\xfd\xff\xff\xff\xff\xff\x02\x00\x05\x00\xfd\xff\xf9\xff\x04\x00\a\x00\xfb\xff\xfb\xff\x05\x00\x03\x00\xfc\xff\x00\x00\x04\x00\xff\xff\xfd\xff\x01\x00\x02\x00\x01\x00\xff\xff\xfd\xff\x01\x00\x05\x00\x01\x00\xfc\xff\xfe\xff\x04\x00\x03\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\xff\xff\x00\x00\x01\x00\xff\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x03\x00\x05\x00\xff\xff\xfe\xff\x01\x00\x00\x00\x01\x00\x03\x00\xfe\xff\xfc\xff\x04\x00\x05\x00\xfc\xff\xfc\xff\x06\x00\x06\x00\xfb\xff\xfa\xff\x04\x00\a\x00\x00\x00\xfa\xff\xff\xff\a\x00\x03\x00\xfb\xff\xfd\xff\x06\x00\x04\x00\xf9\xff\xfe\xff\n\x00\x04\x00\xf7\xff\xfd\xff\b\x00\x02\x00\xfe\xff\x02\x00\xff\xff\xfe\xff\x04\x00\x04\x00\xfc\xff\xfd\xff\x05\x00\x04\x00\xff\xff\xfe\xff\x00\x00\x04\x00\x03\x00\xff\xff\xfb\xff\x00\x00\n\x00\x03\x00\xf8\xff\xfd\xff\x06\x00\x06\x00\xfe\xff\xfc\xff\x00\x00\x05\x00\x05\x00\xfc\xff\xfc\xff\x06\x00\x05\x00\xfc\xff\xfe\xff\x04\x00\x01\x00\xff\xff\x02\x00\x02\x00\xff\xff\x01\x00\x02\x00\x00\x00\x02\x00\x02\x00\xff\xff\xff\xff\x01\x00\x04\x00\x01\x00\xfe\xff\x02\x00\x03\x00\xff\xff\x01\x00\x02\x00\x00\x00\x01\x00\x02\x00\xfe\xff\x01\x00\a\x00\xff\xff\xfb\xff\x03\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\x00\x02\x00\xfc\xff\x00\x00\a\x00\x00\x00\xfb\xff\x04\x00\x04\x00\xfd\xff\x00\x00\x04\x00\x02\x00\xfd\xff\x00\x00\x04\x00\x02\x00\x00\x00\x00\x00\x00\x00\x01\x00\x03\x00\x03\x00\xfe\xff\xfd\xff\x03\x00\x04\x00\x01\x00\x00\x00\xff\xff\x00\x00\x02\x00\x04\x00\x00\x00\xfd\xff\x01\x00\x03\x00\x04\x00\x00\x00\xfc\xff\x00\x00\x04\x00\x03\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\x01\x00\x01\x00\xff\xff\xfd\xff\x03\x00\a\x00\xfe\xff\xfb\xff\x02\x00\x06\x00\x01\x00\xfc\xff\xff\xff\x03\x00\x04\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x02\x00\x01\x00\x00\x00\x00\x00\x00\x00\x03\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x01\x00\xfe\xff\x03\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x01\x00\x01\x00\x00\x00\xfe\xff\x00\x00\x04\x00\x03\x00\xff\xff\xfc\xff\x00\x00\a\x00\x04\x00\xfb\xff\xfc\xff\x04\x00\x03\x00\xfe\xff\x02\x00\x02\x00\xfd\xff\x00\x00\x05\x00\x01\x00\xfc\xff\x00\x00\x03\x00\x01\x00\x00\x00\xff\xff\x02\x00\x03\x00\xff\xff\xfe\xff\x00\x00\x01\x00\x02\x00\x01\x00\xfe\xff\xff\xff\x04\x00\x03\x00\xfe\xff\xfd\xff\x00\x00\x03\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x02\x00\xff\xff\xfd\xff\xfd\xff\x04\x00\x06\x00\xfc\xff\xf9\xff\x05\x00\x06\x00\xfd\xff\xfe\xff\x02\x00\xfe\xff\xff\xff\x03\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x02\x00\xfd\xff\xfe\xff\x04\x00\x03\x00\xfb\xff\xfc\xff\x04\x00\x03\x00\xfd\xff\xfd\xff\x02\x00\x04\x00\xfe\xff\xfc\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x05\x00\x00\x00\xfb\xff\x03\x00\x03\x00\xfb\xff\xff\xff\x05\x00\xfe\xff\xfb\xff\x02\x00\x04\x00\xfe\xff\xfb\xff\x01\x00\x04\x00\xfe\xff\xfc\xff\x02\x00\x03\x00\xfc\xff\xff\xff\x03\x00\xff\xff\xfc\xff\xff\xff\x03\x00\x00\x00\xfd\xff\xff\xff\x01\x00\x01\x00\x00\x00\x00\x00\xfd\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x01\x00\x01\x00\x02\x00\xff\xff\xfb\xff\xff\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xfe\xff\x00\x00\x01\x00\xfe\xff\xff\xff\x00\x00\xfe\xff\x00\x00\x03\x00\xfd\xff\xfa\xff\x02\x00\x04\x00\xfc\xff\xfd\xff\x02\x00\xff\xff\xfd\xff\x02\x00\x01\x00\xfa\xff\xfc\xff\x05\x00\x03\x00\xfa\xff\xfb\xff\x03\x00\x03\x00\xfe\xff\xfc\xff\xfd\xff\x00\x00\x03\x00\xfe\xff\xfa\xff\x02\x00\x05\x00\xfb\xff\xfc\xff\x04\x00\xfe\xff\xf9\xff\x01\x00\x04\x00\xfe\xff\xfb\xff\xff\xff\x04\x00\x02\x00\xfa\xff\xfa\xff\x03\x00\x03\x00\xfa\xff\xfd\xff\x03\x00\x00\x00\xfd\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\x01\x00\x03\x00\xfd\xff\xf8\xff\x01\x00\a\x00\xfd\xff\xf7\xff\x00\x00\x05\x00\x00\x00\xfb\xff\xfd\xff\x00\x00\x00\x00\x00\x00\xfe\xff\xfd\xff\x00\x00\xff\xff\x00\x00\xfe\xff\xfc\xff\x00\x00\x01\x00\xfe\xff\xfe\xff\x00\x00\xfe\xff\xfe\xff\x02\x00\xfe\xff\xfb\xff\x00\x00\x02\x00\xfd\xff\xfd\xff\x00\x00\xff\xff\xfe\xff\xff\xff\xff\xff\x00\x00\x00\x00\xfd\xff\xfc\xff\x01\x00\x02\x00\xfd\xff\xfc\xff\xff\xff\xff\xff\x01\x00\x02\x00\xfc\xff\xf9\xff\x02\x00\x04\x00\xfd\xff\xfb\xff\xff\xff\x01\x00\x01\x00\xfd\xff\xfc\xff\x01\x00\x02\x00\xfb\xff\xfc\xff\x02\x00\x02\x00\xfc\xff\xfd\xff\x01\x00\x00\x00\xfd\xff\xfd\xff\xff\xff\x00\x00\x00\x00\xff\xff\xfc\xff\xff\xff\x02\x00\xfe\xff\xfb\xff\x00\x00\x02\x00\xfd\xff\xfd\xff\x00\x00\xfe\xff\xfe\xff\x00\x00\xff\xff\xfc\xff\x00\x00\x02\x00\xfc\xff\xfd\xff\x02\x00\xfe\xff\xfd\xff\x00\x00\xff\xff\xfe\xff\x00\x00\xff\xff\xfc\xff\xff\xff\x03\x00\xfe\xff\xfc\xff\xff\xff\x01\x00\xff\xff\xfd\xff\x00\x00\xff\xff\xfd\xff\x01\x00\x00\x00\xfd\xff\xfd\xff\x00\x00\x02\x00\xfe\xff\xfc\xff\x00\x00\x01\x00\xff\xff\xfd\xff\xfe\xff\x00\x00\x02\x00\xff\xff\xfa\xff\xff\xff\x06\x00\xfe\xff\xf8\xff\x00\x00\x05\x00\xfe\xff\xfb\xff\x01\x00\x02\x00\xfc\xff\xfe\xff\x03\x00\xff\xff\xfb\xff\x00\x00\x01\x00\xfe\xff\x00\x00\x01\x00\xfb\xff\xff\xff\x06\x00\xfe\xff\xf8\xff\x01\x00\x04\x00\xfc\xff\xfc\xff\x03\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\xff\xff\xfc\xff\x00\x00\x03\x00\xfd\xff\xfb\xff\x04\x00\x05\x00\xfa\xff\xfb\xff\x05\x00\x03\x00\xf9\xff\xfc\xff\x06\x00\x02\x00\xf9\xff\xfd\xff\x05\x00\x02\x00\xfc\xff\xfd\xff\x01\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x00\x00\xfb\xff\xfe\xff\x05\x00\x03\x00\xfb\xff\xfb\xff\x02\x00\x03\x00\xff\xff\xff\xff\xfe\xff\xfe\xff\x02\x00\x02\x00\xfd\xff\xff\xff\x02\x00\xff\xff\xfe\xff\x01\x00\x01\x00\xff\xff\xff\xff\xff\xff\x00\x00\x01\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x01\x00\xfd\xff\xfe\xff\x02\x00\x00\x00\xfe\xff\x01\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\xff\xff\x00\x00\x01\x00\xff\xff\xff\xff\x00\x00\x01\x00\x02\x00\x01\x00\xfc\xff\xfc\xff\x05\x00\x04\x00\xfe\xff\xfc\xff\xfe\xff\x03\x00\x05\x00\xfe\xff\xfb\xff\x02\x00\x05\x00\xfe\xff\xfb\xff\x01\x00\x06\x00\x00\x00\xf9\xff\xfe\xff\a\x00\x04\x00\xfc\xff\xfd\xff\x01\x00\x03\x00\x02\x00\xfd\xff\xfe\xff\x01\x00\x01\x00\x01\x00\x01\x00\xff\xff\x00\x00\x03\x00\xff\xff\xfc\xff\x02\x00\x02\x00\x01\x00\x01\x00\xfe\xff\xff\xff\x04\x00\x03\x00\xfc\xff\xfc\xff\x04\x00\x04\x00\xfd\xff\xfe\xff\x03\x00\x03\x00\x01\x00\xfe\xff\xfe\xff\x02\x00\x03\x00\xfd\xff\xfd\xff\x05\x00\x03\x00\xfe\xff\x01\x00\x01\x00\xff\xff\x02\x00\x02\x00\xfc\xff\xff\xff\x04\x00\x01\x00\xff\xff\x01\x00\x01\x00\x00\x00\x02\x00\x02\x00\xfe\xff\x00\x00\x01\x00\x00\x00\x01\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x02\x00\x03\x00\xfe\xff\xff\xff\x04\x00\x01\x00\xfd\xff\x01\x00\x04\x00\x01\x00\xfd\xff\xff\xff\x04\x00\x05\x00\xfc\xff\xfa\xff\a\x00\t\x00\xfa\xff\xf9\xff\x06\x00\b\x00\xfd\xff\xfa\xff\x02\x00\x06\x00\x02\x00\xfe\xff\x00\x00\x02\x00\xff\xff\x00\x00\x03\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x04\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x04\x00\xff\xff\xff\xff\x05\x00\x00\x00\xfb\xff\x04\x00\x06\x00\xfd\xff\xfd\xff\x03\x00\x03\x00\x00\x00\x02\x00\x01\x00\xff\xff\x00\x00\x02\x00\x02\x00\x00\x00\x00\x00\x03\x00\x00\x00\xfe\xff\x04\x00\x05\x00\xfd\xff\xfd\xff\x03\x00\x03\x00\x01\x00\x02\x00\xfd\xff\xfe\xff\a\x00\x06\x00\xfb\xff\xfc\xff\x04\x00\x04\x00\x00\x00\xff\xff\x00\x00\x03\x00\x03\x00\x01\x00\xfd\xff\x00\x00\x04\x00\x01\x00\xff\xff\x03\x00\x02\x00\xff\xff\x01\x00\x04\x00\xff\xff\xfe\xff\x02\x00\x03\x00\xff\xff\x01\x00\x03\x00\x00\x00\x01\x00\x04\x00\x00\x00\xfd\xff\x01\x00\x05\x00\xff\xff\xfd\xff\x04\x00\x06\x00\xfe\xff\xfb\xff\x04\x00\b\x00\xfd\xff\xf8\xff\x03\x00\v\x00\x01\x00\xf8\xff\xff\xff\t\x00\x03\x00\xfa\xff\xfe\xff\x05\x00\x04\x00\xff\xff\xff\xff\x03\x00\x00\x00\x01\x00\x04\x00\xff\xff\xfb\xff\x03\x00\a\x00\xfe\xff\xfd\xff\x04\x00\x01\x00\x01\x00\x04\x00\x00\x00\xfb\xff\x01\x00\a\x00\x01\x00\xfa\xff\x00\x00\a\x00\x03\x00\xfc\xff\x00\x00\x04\x00\x00\x00\xfe\xff\x02\x00\x02\x00\x00\x00\x00\x00\x01\x00\x01\x00\x02\x00\x01\x00\xfe\xff\x00\x00\x04\x00\x01\x00\xfd\xff\x00\x00\x05\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x01\x00\x03\x00\x01\x00\xfd\xff\x00\x00\x05\x00\x01\x00\xfc\xff\xff\xff\x06\x00\x02\x00\xfd\xff\xfe\xff\x04\x00\x04\x00\xfd\xff\xfe\xff\x03\x00\x00\x00\x00\x00\x04\x00\x02\x00\xfb\xff\x00\x00\x05\x00\x01\x00\xfe\xff\xff\xff\x01\x00\x03\x00\x00\x00\xff\xff\x01\x00\x01\x00\xff\xff\x02\x00\x03\x00\xfd\xff\xfd\xff\x05\x00\x03\x00\xfd\xff\xfe\xff\x03\x00\x03\x00\xff\xff\xfe\xff\x01\x00\x01\x00\x00\x00\x00\x00\x02\x00\x00\x00\xfd\xff\x01\x00\x05\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x01\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\xfe\xff\x01\x00\x04\x00\x00\x00\xfc\xff\xff\xff\x04\x00\x01\x00\xfb\xff\x00\x00\x04\x00\xff\xff\xfd\xff\x02\x00\x01\x00\xfe\xff\x01\x00\x02\x00\xfd\xff\x00\x00\x03\x00\xff\xff\xfe\xff\xff\xff\x01\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x00\x00\xfc\xff\x01\x00\x03\x00\xfe\xff\xfd\xff\x02\x00\x01\x00\xff\xff\xff\xff\xfe\xff\x01\x00\x04\x00\xfe\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xfe\xff\x00\x00\xff\xff\x00\x00\x03\x00\xfe\xff\xfd\xff\x01\x00\x01\x00\xfe\xff\x00\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x00\x00\xff\xff\xff\xff\xff\xff\x00\x00\x02\x00\x00\x00\xfd\xff\xfe\xff\x01\x00\x01\x00\x00\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\x00\x00\x00\x00\xfd\xff\xff\xff\x02\x00\xff\xff\xfd\xff\x01\x00\x00\x00\xfd\xff\x02\x00\x02\x00\xfc\xff\xff\xff\x01\x00\xfe\xff\xff\xff\x02\x00\xfe\xff\xfd\xff\x02\x00\x01\x00\xfc\xff\xff\xff\x03\x00\xff\xff\xfc\xff\xff\xff\x03\x00\x01\x00\xfa\xff\xfd\xff\x05\x00\x02\x00\xfb\xff\xfc\xff\x04\x00\x02\x00\xfa\xff\xfd\xff\x04\x00\x01\x00\xfc\xff\xff\xff\x01\x00\xfe\xff\x00\x00\x00\x00\xfc\xff\xff\xff\x03\x00\xff\xff\xfd\xff\x00\x00\xff\xff\x00\x00\x02\x00\xfb\xff\xfb\xff\x05\x00\x03\x00\xf9\xff\xfc\xff\x04\x00\x02\x00\xfe\xff\xfe\xff\xfe\xff\xff\xff\xff\xff\xff\xff\x00\x00\x01\x00\xfe\xff\xfc\xff\x01\x00\x03\x00\xfd\xff\xfb\xff\x01\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfa\xff\xfd\xff\x04\x00\x02\x00\xfa\xff\xfd\xff\x04\x00\x01\x00\xfa\xff\xfe\xff\x05\x00\x01\x00\xf9\xff\xfd\xff\x02\x00\x03\x00\xff\xff\xfb\xff\xfe\xff\x02\x00\xff\xff\xfd\xff\xff\xff\x02\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\x01\x00\xfd\xff\xfd\xff\x01\x00\xff\xff\xfe\xff\x00\x00\x00\x00\xfc\xff\xff\xff\x02\x00\xff\xff\xfe\xff\x01\x00\xfe\xff\xfc\xff\x00\x00\x03\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\xff\xff\xfc\xff\xff\xff\x03\x00\xff\xff\xfb\xff\xfe\xff\x04\x00\x00\x00\xfb\xff\xfd\xff\x02\x00\x01\x00\xff\xff\xfe\xff\xfd\xff\xff\xff\x03\x00\xfe\xff\xfc\xff\x00\x00\x03\x00\xfe\xff\xfd\xff\x00\x00\x00\x00\xfe\xff\xff\xff\xff\xff\x00\x00\x00\x00\x00\x00\xfd\xff\xff\xff\x01\x00\xff\xff\xfd\xff\x00\x00\x00\x00\x00\x00\x00\x00\xfe\xff\xfd\xff\x03\x00\x02\x00\xfb\xff\xfc\xff\x04\x00\x01\x00\xfc\xff\xfe\xff\x02\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xfc\xff\x00\x00\x01\x00\xfe\xff\xff\xff\x02\x00\xfe\xff\xfe\xff\xff\xff\x00\x00\x01\x00\x01\x00\xfd\xff\xfd\xff\x01\x00\x04\x00\xfe\xff\xfa\xff\xfe\xff\x04\x00\x02\x00\xfe\xff\xfd\xff\x00\x00\xff\xff\x02\x00\x00\x00\xfc\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\xff\xff\x02\x00\xff\xff\x00\x00\xff\xff\xfe\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x01\x00\xff\xff\xff\xff\x01\x00\x02\x00\xfb\xff\xfe\xff\x04\x00\x01\x00\xfc\xff\x00\x00\x01\x00\xff\xff\x00\x00\x01\x00\xfd\xff\x00\x00\x02\x00\x00\x00\xfd\xff\x00\x00\x01\x00\x01\x00\xff\xff\xfe\xff\x00\x00\x02\x00\xff\xff\xff\xff\x00\x00\x01\x00\xff\xff\xff\xff\xff\xff\x01\x00\x01\x00\xff\xff\xff\xff\x03\x00\x00\x00\xfd\xff\xff\xff\x02\x00\x01\x00\x00\x00\xfc\xff\xff\xff\x05\x00\x03\x00\xfa\xff\xfe\xff\x06\x00\x02\x00\xfa\xff\xff\xff\x04\x00\x02\x00\xfd\xff\xff\xff\x01\x00\x02\x00\x01\x00\xff\xff\xfe\xff\x01\x00\x02\x00\x01\x00\xfe\xff\x00\x00\x01\x00\xff\xff\x00\x00\x04\x00\x00\x00\xfc\xff\x01\x00\x06\x00\xfe\xff\xfd\xff\x02\x00\x01\x00\xfe\xff\x02\x00\x03\x00\xff\xff\xfe\xff\x03\x00\x02\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\xfe\xff\xff\xff\x05\x00\x02\x00\xfd\xff\xff\xff\x02\x00\x00\x00\x01\x00\x02\x00\x00\x00\xfe\xff\x01\x00\x03\x00\x03\x00\xfe\xff\xfd\xff\x02\x00\x05\x00\xff\xff\xfd\xff\x02\x00\x03\x00\xff\xff\x01\x00\x02\x00\x01\x00\x00\x00\x00\x00\xff\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x04\x00\x03\x00\xfe\xff\xfe\xff\x04\x00\x04\x00\xff\xff\xfc\xff\x03\x00\x06\x00\xfe\xff\xfd\xff\x03\x00\x02\x00\x01\x00\x00\x00\x00\x00\x02\x00\x04\x00\x00\x00\xfd\xff\x03\x00\x04\x00\xfc\xff\x01\x00\b\x00\xff\xff\xf9\xff\x05\x00\b\x00\xfc\xff\xfd\xff\x06\x00\x02\x00\xfe\xff\x01\x00\x04\x00\x01\x00\xfe\xff\x01\x00\x05\x00\x00\x00\xfe\xff\x03\x00\x03\x00\xfe\xff\x01\x00\x04\x00\x01\x00\x01\x00\x02\x00\xfe\xff\x02\x00\x05\x00\xfe\xff\xfd\xff\x05\x00\x06\x00\xff\xff\xfc\xff\x03\x00\x06\x00\x00\x00\xfe\xff\x01\x00\x02\x00\x04\x00\x02\x00\xfe\xff\x00\x00\x06\x00\x02\x00\xfb\xff\x02\x00\a\x00\xff\xff\xfd\xff\x03\x00\x04\x00\x00\x00\x01\x00\x02\x00\x00\x00\x01\x00\x03\x00\x02\x00\xff\xff\x00\x00\x04\x00\x02\x00\xff\xff\x02\x00\x03\x00\xfe\xff\x00\x00\x06\x00\x03\x00\xfd\xff\x00\x00\x05\x00\x03\x00\x00\x00\xfe\xff\x00\x00\x06\x00\x03\x00\xfd\xff\x00\x00\x05\x00\x03\x00\x00\x00\x00\x00\x01\x00\x03\x00\x03\x00\x00\x00\xfe\xff\x02\x00\x05\x00\x02\x00\xff\xff\xff\xff\x04\x00\x05\x00\xff\xff\xff\xff\x02\x00\x03\x00\x02\x00\x00\x00\x01\x00\x03\x00\x02\x00\x00\x00\xff\xff\x03\x00\x04\x00\x01\x00\x00\x00\x00\x00\x02\x00\x04\x00\x01\x00\x00\x00\x02\x00\x02\x00\xff\xff\x01\x00\x05\x00\x02\x00\xfd\xff\x01\x00\x05\x00\x02\x00\xff\xff\x01\x00\x03\x00\x02\x00\x00\x00\x01\x00\x02\x00\x01\x00\x00\x00\x03\x00\x02\x00\xfe\xff\x02\x00\x05\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x04\x00\x00\x00\xff\xff\x04\x00\x02\x00\xfe\xff\x02\x00\x06\x00\x00\x00\xfd\xff\x03\x00\x04\x00\x01\x00\xfe\xff\x01\x00\x05\x00\x00\x00\xfd\xff\x04\x00\x06\x00\xfe\xff\xfb\xff\x06\x00\a\x00\xfe\xff\xfc\xff\x02\x00\x05\x00\x01\x00\xfe\xff\x01\x00\x04\x00\x03\x00\xfe\xff\x00\x00\x03\x00\x01\x00\x00\x00\x02\x00\x02\x00\x00\x00\x01\x00\x03\x00\xff\xff\xff\xff\x05\x00\x02\x00\xfb\xff\x02\x00\b\x00\x00\x00\xfb\xff\x02\x00\x04\x00\x01\x00\x00\x00\x00\x00\x00\x00\x04\x00\x02\x00\xfe\xff\x00\x00\x02\x00\x01\x00\x02\x00\x00\x00\xff\xff\x02\x00\x04\x00\x00\x00\x00\x00\x00\x00\xff\xff\x02\x00\x03\x00\x00\x00\x00\x00\x01\x00\x01\x00\x01\x00\x02\x00\xff\xff\x00\x00\x03\x00\x00\x00\xfd\xff\x03\x00\x05\x00\xff\xff\xfc\xff\x03\x00\x04\x00\xfe\xff\xfe\xff\x03\x00\x02\x00\x00\x00\xff\xff\x00\x00\x02\x00\x03\x00\xff\xff\xfd\xff\x00\x00\x04\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x04\x00\xff\xff\xfd\xff\x03\x00\x04\x00\xfb\xff\xff\xff\b\x00\x00\x00\xf9\xff\x03\x00\x05\x00\xfd\xff\xff\xff\x04\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xfe\xff\x01\x00\x01\x00\xfd\xff\x01\x00\x05\x00\xfd\xff\xfb\xff\x04\x00\a\x00\xfd\xff\xfa\xff\x03\x00\x06\x00\xfd\xff\xfb\xff\x00\x00\x06\x00\x02\x00\xfa\xff\xff\xff\x05\x00\x02\x00\xff\xff\xfc\xff\xff\xff\x03\x00\x03\x00\xfc\xff\xfd\xff\x05\x00\x01\x00\xfb\xff\x03\x00\x04\x00\xfc\xff\xfc\xff\x04\x00\x02\x00\xfe\xff\xfe\xff\x00\x00\x01\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\xfb\xff\xff\xff\x03\x00\xff\xff\xff\xff\x02\x00\xfe\xff\xfc\xff\x02\x00\x06\x00\xfd\xff\xf9\xff\x01\x00\a\x00\xff\xff\xf9\xff\x00\x00\x06\x00\xfe\xff\xfd\xff\x00\x00\x00\x00\x00\x00\x00\x00\xfe\xff\x00\x00\x01\x00\xfe\xff\xfd\xff\x05\x00\x01\x00\xf8\xff\xfe\xff\b\x00\x01\x00\xf8\xff\xfd\xff\x06\x00\x01\x00\xfb\xff\xfc\xff\x02\x00\x04\x00\xfe\xff\xfa\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x01\x00\x03\x00\xfe\xff\xfb\xff\x00\x00\x04\x00\x02\x00\xf9\xff\xfb\xff\x05\x00\x04\x00\xfb\xff\xfc\xff\x02\x00\x02\x00\xfd\xff\xfe\xff\x01\x00\x01\x00\xfd\xff\xfe\xff\x01\x00\x00\x00\xfd\xff\xff\xff\x01\x00\x00\x00\xfe\xff\x01\x00\x00\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xfb\xff\xfd\xff\x03\x00\x02\x00\xfe\xff\xfc\xff\x00\x00\x03\x00\xfe\xff\xfb\xff\x00\x00\x01\x00\xff\xff\xfe\xff\xfe\xff\x00\x00\x02\x00\xfe\xff\xfc\xff\x00\x00\x01\x00\xfd\xff\xfe\xff\x01\x00\x00\x00\xff\xff\xff\xff\xfd\xff\xfe\xff\x01\x00\x00\x00\xfd\xff\xff\xff\x01\x00\xfe\xff\xfc\xff\x02\x00\x03\x00\xfb\xff\xfb\xff\x03\x00\x02\x00\xfc\xff\xfd\xff\x01\x00\x00\x00\xfe\xff\xfd\xff\x00\x00\x03\x00\xff\xff\xf8\xff\xff\xff\a\x00\xff\xff\xf9\xff\xfe\xff\x03\x00\x02\x00\xfb\xff\xfb\xff\x03\x00\x04\x00\xfb\xff\xfb\xff\x04\x00\x01\x00\xfa\xff\xff\xff\x03\x00\xfe\xff\xfc\xff\x00\x00\x02\x00\x00\x00\xfc\xff\xfd\xff\x02\x00\x01\x00\xfb\xff\xfe\xff\x04\x00\xff\xff\xfa\xff\x00\x00\x04\x00\xfd\xff\xf9\xff\x02\x00\x04\x00\xfc\xff\xfc\xff\x03\x00\x01\x00\xfa\xff\x00\x00\x04\x00\xfb\xff\xfb\xff\x04\x00\x02\x00\xfb\xff\xfe\xff\x01\x00\xff\xff\x01\x00\xfe\xff\xfb\xff\x01\x00\x04\x00\xfd\xff\xfa\xff\x01\x00\x03\x00\xff\xff\xfd\xff\xfe\xff\x00\x00\x00\x00\xff\xff\xfe\xff\xfe\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x01\x00\x00\x00\xff\xff\x00\x00\xfd\xff\xfe\xff\x02\x00\x01\x00\xfc\xff\xfd\xff\x03\x00\x00\x00\xfc\xff\x01\x00\x00\x00\xfc\xff\x00\x00\x01\x00\xfd\xff\xff\xff\x02\x00\xff\xff\xff\xff\x01\x00\xfd\xff\xfc\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x00\x00\x01\x00\x00\x00\xfe\xff\xff\xff\x01\x00\xfd\xff\xfe\xff\x02\x00\x00\x00\xfd\xff\x00\x00\x01\x00\xfc\xff\xff\xff\x04\x00\x00\x00\xfc\xff\xfd\xff\x02\x00\x02\x00\xff\xff\xfe\xff\xfe\xff\xff\xff\x00\x00\x03\x00\x01\x00\xfa\xff\xfd\xff\x04\x00\x02\x00\xfd\xff\xff\xff\x00\x00\xfe\xff\xff\xff\x04\x00\x02\x00\xfa\xff\xfc\xff\x03\x00\x03\x00\xff\xff\xfc\xff\x00\x00\x02\x00\x01\x00\xff\xff\xfd\xff\xff\xff\x01\x00\x02\x00\x01\x00\xfc\xff\xfd\xff\x03\x00\x03\x00\xfe\xff\xfc\xff\x01\x00\x03\x00\x00\x00\xfe\xff\xfd\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x03\x00\xfd\xff\xfd\xff\x00\x00\x02\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\x03\x00\x00\x00\xfd\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x01\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x00\x00\xfd\xff\x01\x00\xff\xff\xfe\xff\x03\x00\x02\x00\xfc\xff\xff\xff\x04\x00\x00\x00\xfc\xff\x02\x00\x04\x00\xfd\xff\xfd\xff\x02\x00\x02\x00\x01\x00\xfd\xff\xfe\xff\x05\x00\x02\x00\xfa\xff\xff\xff\a\x00\x00\x00\xf8\xff\x02\x00\b\x00\xfe\xff\xfa\xff\x03\x00\x06\x00\xfe\xff\xfa\xff\x01\x00\x05\x00\x01\x00\xfd\xff\xfe\xff\x03\x00\x05\x00\xff\xff\xfe\xff\xff\xff\xff\xff\x03\x00\x04\x00\xfd\xff\xfb\xff\x03\x00\x06\x00\xff\xff\x00\x00\x01\x00\xfe\xff\x00\x00\x04\x00\xff\xff\xfd\xff\x03\x00\x01\x00\xfd\xff\x03\x00\x05\x00\xfe\xff\xfb\xff\x03\x00\x05\x00\xfe\xff\xfc\xff\x03\x00\x05\x00\xff\xff\xfc\xff\x02\x00\x05\x00\xff\xff\xfc\xff\x02\x00\x04\x00\x00\x00\xfd\xff\x03\x00\x04\x00\xfc\xff\xfe\xff\x06\x00\x03\x00\xfc\xff\xfe\xff\x04\x00\x03\x00\x00\x00\xfe\xff\x00\x00\x04\x00\x00\x00\xfc\xff\x04\x00\x06\x00\xfc\xff\xfb\xff\x06\x00\x05\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\x00\x00\x00\x00\x02\x00\xff\xff\xff\xff\x03\x00\x02\x00\xfe\xff\x02\x00\x05\x00\xfe\xff\xfc\xff\x05\x00\x04\x00\xfd\xff\xfd\xff\x03\x00\x05\x00\x00\x00\xfe\xff\x02\x00\x02\x00\xff\xff\x02\x00\x03\x00\xfc\xff\x00\x00\x06\x00\x01\x00\xfd\xff\x01\x00\x03\x00\x02\x00\x00\x00\xfe\xff\x00\x00\x06\x00\x03\x00\xfb\xff\xfc\xff\a\x00\b\x00\xfb\xff\xf8\xff\x06\x00\t\x00\xff\xff\xfa\xff\x01\x00\x05\x00\x01\x00\xff\xff\x01\x00\x02\x00\x01\x00\xff\xff\x01\x00\x02\x00\x02\x00\x00\x00\x00\x00\x03\x00\x02\x00\xfe\xff\x00\x00\x03\x00\x02\x00\x00\x00\xff\xff\x01\x00\x06\x00\x01\x00\xfa\xff\x01\x00\b\x00\x00\x00\xfc\xff\x04\x00\x04\x00\xfc\xff\x00\x00\x05\x00\x02\x00\xff\xff\xfe\xff\x00\x00\x05\x00\x04\x00\xfe\xff\xfc\xff\x03\x00\x06\x00\x00\x00\xfc\xff\x02\x00\x05\x00\xfe\xff\xfd\xff\x06\x00\x05\x00\xfc\xff\xfd\xff\x05\x00\x04\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xfe\xff\x03\x00\x03\x00\xfe\xff\x00\x00\x04\x00\x02\x00\xfe\xff\x01\x00\x01\x00\xff\xff\x03\x00\x04\x00\xfe\xff\xfd\xff\x05\x00\x05\x00\xfd\xff\xfd\xff\x04\x00\x03\x00\xfd\xff\x00\x00\x05\x00\x02\x00\xfc\xff\xff\xff\x05\x00\x02\x00\xff\xff\x00\x00\x01\x00\x00\x00\x02\x00\x04\x00\xff\xff\xfc\xff\x01\x00\x05\x00\x01\x00\xfe\xff\x02\x00\x02\x00\x00\x00\x01\x00\xff\xff\xff\xff\x05\x00\x01\x00\xfa\xff\x02\x00\n\x00\xff\xff\xf8\xff\x04\x00\b\x00\xfc\xff\xfa\xff\x03\x00\a\x00\x01\x00\xfb\xff\xff\xff\x06\x00\x04\x00\xfc\xff\xfd\xff\x04\x00\x02\x00\x00\x00\x01\x00\xff\xff\xff\xff\x03\x00\x03\x00\xfd\xff\xfe\xff\x04\x00\x03\x00\xff\xff\xfd\xff\x00\x00\x05\x00\x03\x00\xfb\xff\xfd\xff\x05\x00\x03\x00\xfe\xff\x00\x00\x00\x00\xfe\xff\x04\x00\x04\x00\xfb\xff\xfe\xff\x06\x00\x02\x00\xfa\xff\xff\xff\b\x00\x02\x00\xf9\xff\xfe\xff\x05\x00\x02\x00\xff\xff\x01\x00\x00\x00\xfe\xff\x02\x00\x03\x00\xfd\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x02\x00\xfd\xff\x00\x00\x02\x00\xfd\xff\xff\xff\x05\x00\x00\x00\xfb\xff\x02\x00\x06\x00\xfd\xff\xfb\xff\x04\x00\x04\x00\xfc\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\xff\xff\x00\x00\x00\x00\x02\x00\x00\x00\xfe\xff\x01\x00\x03\x00\xff\xff\xfd\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x02\x00\xff\xff\xfd\xff\x02\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xfc\xff\x02\x00\x05\x00\xfc\xff\xfa\xff\x02\x00\x06\x00\x00\x00\xfc\xff\xff\xff\x00\x00\x01\x00\x02\x00\x00\x00\xfc\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\xff\xff\x01\x00\x00\x00\x00\x00\xff\xff\xfe\xff\x02\x00\x02\x00\xfd\xff\xfd\xff\x02\x00\x03\x00\xff\xff\xfc\xff\xfe\xff\x03\x00\x02\x00\xfd\xff\xfe\xff\x02\x00\x01\x00\xfd\xff\xfe\xff\x01\x00\x01\x00\x00\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\xfd\xff\xfd\xff\x04\x00\x02\x00\xfb\xff\xfe\xff\x03\x00\x00\x00\xfd\xff\xfe\xff\x00\x00\x02\x00\x02\x00\xfd\xff\xfd\xff\x03\x00\x02\x00\xfb\xff\xfc\xff\x03\x00\x02\x00\xfd\xff\xff\xff\x01\x00\x00\x00\x00\x00\x00\x00\xfd\xff\xfd\xff\x03\x00\x03\x00\xfb\xff\xfb\xff\x04\x00\x04\x00\xfc\xff\xfd\xff\x01\x00\xff\xff\xff\xff\x01\x00\x00\x00\xfd\xff\xfe\xff\x02\x00\x02\x00\xfd\xff\xfb\xff\x01\x00\x04\x00\xfe\xff\xfc\xff\x01\x00\x02\x00\xff\xff\xfc\xff\xfd\xff\x02\x00\x04\x00\x00\x00\xfa\xff\xfc\xff\x05\x00\x05\x00\xfa\xff\xf7\xff\x03\x00\x06\x00\xfe\xff\xfd\xff\xff\xff\xff\xff\x00\x00\x00\x00\xff\xff\xff\xff\xff\xff\xfe\xff\x01\x00\x02\x00\xfb\xff\xfd\xff\x04\x00\xfe\xff\xfd\xff\x03\x00\x01\x00\xfb\xff\xfd\xff\x04\x00\x01\x00\xfc\xff\xfc\xff\x00\x00\x05\x00\xff\xff\xfa\xff\xff\xff\x03\x00\x00\x00\xfd\xff\xff\xff\xfe\xff\x00\x00\x03\x00\xfe\xff\xfb\xff\x00\x00\x03\x00\xfe\xff\xfc\xff\x01\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x00\x00\xfd\xff\x00\x00\x00\x00\xfd\xff\x00\x00\x03\x00\xff\xff\xf9\xff\xfe\xff\x06\x00\x03\x00\xfa\xff\xfa\xff\x03\x00\x04\x00\xfd\xff\xfc\xff\xff\xff\x01\x00\x00\x00\xfe\xff\xff\xff\x00\x00\x00\x00\xff\xff\xff\xff\xfe\xff\xff\xff\x03\x00\xfe\xff\xf9\xff\x00\x00\x05\x00\x00\x00\xfb\xff\xff\xff\x02\x00\xff\xff\xff\xff\x00\x00\xfd\xff\xfc\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\xff\xff\xfa\xff\x01\x00\x04\x00\xfd\xff\xfb\xff\x01\x00\x02\x00\xfe\xff\xff\xff\xff\xff\xfd\xff\x02\x00\x03\x00\xfa\xff\xfc\xff\a\x00\x01\x00\xf7\xff\xff\xff\x06\x00\xff\xff\xfc\xff\x00\x00\x00\x00\xfe\xff\x00\x00\x01\x00\xff\xff\xfd\xff\xfe\xff\x03\x00\x01\x00\xfb\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xfb\xff\xff\xff\x03\x00\xff\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xfc\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\xfd\xff\x02\x00\x03\x00\xfd\xff\xfc\xff\x02\x00\x04\x00\xfe\xff\xfa\xff\x01\x00\x03\x00\xfe\xff\xfe\xff\xff\xff\x01\x00\x01\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xfa\xff\xfd\xff\a\x00\x00\x00\xf9\xff\x00\x00\x05\x00\xff\xff\xfb\xff\x01\x00\x02\x00\xff\xff\x00\x00\xff\xff\xfe\xff\xff\xff\x01\x00\x01\x00\xff\xff\xff\xff\xff\xff\xff\xff\x02\x00\x01\x00\xfd\xff\xfe\xff\x02\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xfc\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\xfd\xff\x01\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x00\x00\xfe\xff\x00\x00\x01\x00\xff\xff\xff\xff\x01\x00\xfe\xff\x00\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xff\xff\xfd\xff\x01\x00\x04\x00\xfd\xff\xfb\xff\x04\x00\x05\x00\xfb\xff\xfc\xff\x05\x00\x01\x00\xfd\xff\x00\x00\x01\x00\x01\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x00\x00\x00\x00\x03\x00\xfe\xff\xfc\xff\x03\x00\x04\x00\xfe\xff\xfc\xff\x00\x00\x02\x00\x01\x00\x02\x00\xfe\xff\xfc\xff\x03\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x03\x00\xff\xff\xff\xff\x00\x00\x00\x00\x02\x00\x01\x00\xfd\xff\xfe\xff\x04\x00\x02\x00\xfb\xff\xff\xff\x05\x00\x01\x00\xfc\xff\x00\x00\x04\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x03\x00\x01\x00\xff\xff\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\xff\xff\xfe\xff\x02\x00\x03\x00\xff\xff\xff\xff\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\xfe\xff\x01\x00\x04\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x04\x00\xfe\xff\xfc\xff\x01\x00\x04\x00\x03\x00\xfd\xff\xfd\xff\x03\x00\x04\x00\xff\xff\xfc\xff\x02\x00\x03\x00\xfe\xff\x01\x00\x01\x00\xff\xff\x02\x00\x02\x00\xfd\xff\xfe\xff\x06\x00\x02\x00\xf9\xff\x00\x00\b\x00\x00\x00\xf9\xff\x01\x00\a\x00\x00\x00\xfc\xff\x00\x00\x04\x00\x01\x00\xfd\xff\x00\x00\x04\x00\xff\xff\xfd\xff\x03\x00\x04\x00\xff\xff\xfe\xff\x00\x00\x01\x00\x02\x00\x02\x00\xfd\xff\xfe\xff\x05\x00\x03\x00\xfc\xff\xff\xff\x04\x00\x01\x00\xfd\xff\x00\x00\x03\x00\x01\x00\xfe\xff\x00\x00\x03\x00\x01\x00\xfd\xff\x02\x00\x04\x00\xfc\xff\xfe\xff\x06\x00\x01\x00\xfb\xff\x02\x00\x06\x00\xfd\xff\xfc\xff\x05\x00\x04\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\x00\x00\x02\x00\x02\x00\xfe\xff\xff\xff\x04\x00\x02\x00\xfe\xff\x00\x00\x03\x00\xff\xff\xfd\xff\x03\x00\x04\x00\xff\xff\xfd\xff\x00\x00\x05\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x03\x00\xfc\xff\xfe\xff\x05\x00\x03\x00\xfd\xff\xfd\xff\x03\x00\x04\x00\x00\x00\xff\xff\x00\x00\x01\x00\x02\x00\x00\x00\xfe\xff\x02\x00\x03\x00\xfe\xff\xff\xff\x02\x00\x02\x00\x01\x00\xfe\xff\xfd\xff\x04\x00\a\x00\xfd\xff\xf8\xff\x03\x00\b\x00\x00\x00\xfa\xff\xff\xff\x04\x00\x02\x00\x00\x00\x01\x00\xff\xff\x00\x00\x04\x00\x00\x00\xfc\xff\x01\x00\x04\x00\x00\x00\xfe\xff\x01\x00\x01\x00\x00\x00\x03\x00\x02\x00\xfc\xff\xfe\xff\x05\x00\x03\x00\xfd\xff\xfd\xff\x02\x00\x04\x00\x01\x00\xfd\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\x01\x00\x03\x00\xff\xff\x01\x00\x02\x00\xfd\xff\xff\xff\x06\x00\x01\x00\xf9\xff\x01\x00\a\x00\x00\x00\xfe\xff\xff\xff\x00\x00\x02\x00\x02\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x04\x00\x02\x00\xfb\xff\xfe\xff\x06\x00\x01\x00\xfc\xff\x02\x00\x04\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xff\xff\x00\x00\x00\x00\x01\x00\x02\x00\xff\xff\xff\xff\x03\x00\x01\x00\xfc\xff\xff\xff\x06\x00\x02\x00\xfa\xff\xfd\xff\x06\x00\x04\x00\xfc\xff\xfd\xff\x03\x00\x01\x00\xfe\xff\x02\x00\x02\x00\xfd\xff\x00\x00\x04\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x00\x00\xfd\xff\x01\x00\x03\x00\xff\xff\xff\xff\x03\x00\x01\x00\xfc\xff\xff\xff\x05\x00\x01\x00\xfc\xff\xfe\xff\x02\x00\x05\x00\x01\x00\xfa\xff\xfd\xff\x06\x00\x04\x00\xfb\xff\xfd\xff\x03\x00\x02\x00\x01\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x01\x00\x01\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xff\xff\xff\xff\xff\xff\x00\x00\x05\x00\x00\x00\xfa\xff\x00\x00\x03\x00\xff\xff\x01\x00\x01\x00\xfd\xff\x01\x00\x03\x00\xfe\xff\xff\xff\x01\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xfb\xff\xfe\xff\x05\x00\x03\x00\xfc\xff\xfc\xff\x03\x00\x03\x00\xff\xff\xfd\xff\xff\xff\x02\x00\x00\x00\xfe\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x03\x00\x03\x00\xfd\xff\xfc\xff\x02\x00\x03\x00\xff\xff\xfd\xff\x00\x00\x02\x00\xfe\xff\xff\xff\x04\x00\xff\xff\xfb\xff\x02\x00\x05\x00\xfc\xff\xfb\xff\x05\x00\x04\x00\xfb\xff\xfb\xff\x03\x00\x05\x00\xfe\xff\xfb\xff\x00\x00\x04\x00\x02\x00\xfd\xff\xfd\xff\x00\x00\x02\x00\x02\x00\xfe\xff\xfc\xff\x00\x00\x05\x00\x00\x00\xfa\xff\x00\x00\x05\x00\xff\xff\xfc\xff\xff\xff\x02\x00\x02\x00\xff\xff\xfd\xff\x00\x00\x01\x00\xff\xff\xff\xff\x01\x00\xff\xff\xfe\xff\x00\x00\x03\x00\x00\x00\xfd\xff\xff\xff\x02\x00\x00\x00\xfd\xff\x00\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xfc\xff\xfe\xff\x03\x00\x00\x00\xff\xff\xff\xff\xfd\xff\x01\x00\x05\x00\xff\xff\xfa\xff\xff\xff\x02\x00\x02\x00\x00\x00\xfc\xff\xfd\xff\x03\x00\x04\x00\xfe\xff\xfb\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x02\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfe\xff\xff\xff\xff\xff\xff\xff\x02\x00\x02\x00\xfc\xff\xfd\xff\x04\x00\x02\x00\xfc\xff\xfd\xff\x03\x00\x00\x00\xfd\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x00\x00\x04\x00\x00\x00\xfb\xff\xff\xff\x02\x00\xff\xff\xff\xff\x01\x00\xff\xff\xfe\xff\x01\x00\x00\x00\xff\xff\x01\x00\x00\x00\xfc\xff\xfe\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x01\x00\x02\x00\xfc\xff\xfc\xff\x04\x00\x03\x00\xf9\xff\xfb\xff\a\x00\x03\x00\xf9\xff\xfe\xff\x04\x00\x00\x00\xff\xff\x00\x00\xfe\xff\xfe\xff\x01\x00\x00\x00\x00\x00\xff\xff\xfb\xff\x01\x00\a\x00\xfd\xff\xf8\xff\x03\x00\x06\x00\xfb\xff\xfb\xff\x05\x00\x02\x00\xfa\xff\xfe\xff\x04\x00\x00\x00\xfd\xff\xff\xff\x00\x00\x02\x00\x00\x00\xfc\xff\xff\xff\x04\x00\x00\x00\xfb\xff\x00\x00\x04\x00\xff\xff\xfd\xff\xff\xff\x01\x00\x00\x00\xfe\xff\x00\x00\x01\x00\xfe\xff\x00\x00\x02\x00\xfe\xff\xfd\xff\x02\x00\x00\x00\xfd\xff\x02\x00\x01\x00\xfb\xff\x01\x00\x04\x00\xfc\xff\xfc\xff\x05\x00\x02\x00\xfa\xff\xfe\xff\x04\x00\x01\x00\xfd\xff\xfe\xff\x03\x00\x00\x00\xfd\xff\x00\x00\x02\x00\x00\x00\xfd\xff\xff\xff\x02\x00\x00\x00\xfe\xff\xfd\xff\x03\x00\x04\x00\xfc\xff\xfb\xff\x02\x00\x04\x00\xff\xff\xfd\xff\xfd\xff\xff\xff\x06\x00\x02\x00\xf9\xff\xfd\xff\x06\x00\x02\x00\xf9\xff\xfd\xff\x06\x00\x02\x00\xf9\xff\xfe\xff\x06\x00\x00\x00\xfc\xff\xff\xff\x02\x00\x00\x00\xfd\xff\x00\x00\x03\x00\x00\x00\xfc\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xfd\xff\xfd\xff\x02\x00\x01\x00\xff\xff\x01\x00\xff\xff\xff\xff\x02\x00\x01\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfd\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\xff\xff\xfd\xff\xff\xff\x04\x00\x03\x00\xfa\xff\xfc\xff\x04\x00\x04\x00\xfe\xff\xfd\xff\x02\x00\x00\x00\xfc\xff\x02\x00\x05\x00\xfd\xff\xfa\xff\x03\x00\x05\x00\xfd\xff\xfc\xff\x01\x00\x02\x00\x00\x00\x00\x00\xff\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xfd\xff\x00\x00\x03\x00\x01\x00\xfd\xff\xfe\xff\x02\x00\x02\x00\xff\xff\x00\x00\xff\xff\xfe\xff\x02\x00\x03\x00\xfe\xff\xfb\xff\x00\x00\x06\x00\x02\x00\xfb\xff\xfc\xff\x03\x00\x05\x00\xff\xff\xfc\xff\xfe\xff\x03\x00\x05\x00\xfe\xff\xfb\xff\x01\x00\x03\x00\x00\x00\xfe\xff\x00\x00\x01\x00\x01\x00\x00\x00\xfd\xff\x01\x00\x06\x00\xfe\xff\xf9\xff\x01\x00\b\x00\x00\x00\xf8\xff\x01\x00\a\x00\xff\xff\xfb\xff\x01\x00\x03\x00\xfe\xff\xff\xff\x03\x00\x00\x00\xfe\xff\x01\x00\x02\x00\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\xff\xff\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x01\x00\xfd\xff\xff\xff\x04\x00\x02\x00\xfd\xff\xfe\xff\x04\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x05\x00\x02\x00\xfc\xff\xfb\xff\x03\x00\b\x00\x00\x00\xf8\xff\xfe\xff\a\x00\x05\x00\xfc\xff\xfa\xff\x02\x00\x06\x00\x00\x00\xfc\xff\x02\x00\x04\x00\xfc\xff\xfe\xff\x06\x00\x01\x00\xfa\xff\xff\xff\x05\x00\x03\x00\xfe\xff\xfd\xff\x00\x00\x02\x00\x02\x00\x01\x00\xfe\xff\xfd\xff\x03\x00\x05\x00\xff\xff\xfd\xff\x00\x00\x01\x00\x01\x00\x01\x00\xfe\xff\x00\x00\x05\x00\x00\x00\xfc\xff\x01\x00\x04\x00\x01\x00\xfd\xff\xff\xff\x02\x00\x03\x00\xff\xff\xfc\xff\x01\x00\x05\x00\x00\x00\xfc\xff\x01\x00\x06\x00\xff\xff\xfa\xff\x02\x00\a\x00\xfe\xff\xfb\xff\x02\x00\x04\x00\x01\x00\xfe\xff\xfd\xff\x02\x00\x04\x00\xff\xff\xff\xff\x04\x00\x01\x00\xfc\xff\xff\xff\x04\x00\x02\x00\xff\xff\xfd\xff\x00\x00\x05\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x03\x00\xff\xff\x00\x00\xff\xff\xfe\xff\x01\x00\x04\x00\x01\x00\xfd\xff\xff\xff\x05\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x00\x00\xfc\xff\x03\x00\x04\x00\xfe\xff\xff\xff\x01\x00\x02\x00\x01\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x03\x00\xff\xff\xfd\xff\x01\x00\x04\x00\x00\x00\xfd\xff\x00\x00\x03\x00\x02\x00\xff\xff\xfd\xff\x01\x00\x04\x00\x00\x00\xfc\xff\x00\x00\x02\x00\x02\x00\x02\x00\xfe\xff\xfd\xff\x02\x00\x05\x00\x00\x00\xf9\xff\x00\x00\b\x00\x02\x00\xf9\xff\xfe\xff\a\x00\x03\x00\xfb\xff\xfe\xff\x03\x00\x03\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xff\xff\x00\x00\x02\x00\x01\x00\xfe\xff\x00\x00\x03\x00\x00\x00\xfc\xff\x02\x00\x06\x00\xfe\xff\xfb\xff\x04\x00\x03\x00\xfc\xff\x00\x00\x05\x00\xfe\xff\xfc\xff\x03\x00\x04\x00\x01\x00\xff\xff\xfb\xff\x00\x00\a\x00\x00\x00\xf9\xff\x02\x00\x06\x00\xfe\xff\xfe\xff\x04\x00\x00\x00\xfd\xff\x00\x00\x01\x00\x00\x00\x01\x00\x02\x00\x00\x00\xfe\xff\x00\x00\x01\x00\x02\x00\x00\x00\xfd\xff\x00\x00\x05\x00\x02\x00\xfb\xff\xfc\xff\x04\x00\x04\x00\xfd\xff\xfc\xff\x03\x00\x05\x00\xff\xff\xfc\xff\x02\x00\x02\x00\xfc\xff\xff\xff\x05\x00\x00\x00\xfb\xff\x02\x00\x05\x00\xfd\xff\xfe\xff\x03\x00\x00\x00\xfe\xff\x00\x00\x01\x00\x01\x00\x00\x00\xfe\xff\x00\x00\x04\x00\xff\xff\xfd\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x01\x00\xff\xff\xff\xff\x01\x00\x02\x00\xff\xff\xfe\xff\x03\x00\x01\x00\xfb\xff\x00\x00\x05\x00\x01\x00\xfb\xff\xfe\xff\x05\x00\x03\x00\xfc\xff\xfd\xff\x02\x00\x02\x00\x00\x00\x00\x00\xff\xff\xff\xff\x00\x00\x00\x00\x00\x00\x02\x00\x01\x00\xfc\xff\xfd\xff\x06\x00\x03\x00\xf9\xff\xfd\xff\x06\x00\x02\x00\xfc\xff\x00\x00\x02\x00\xfe\xff\x00\x00\x01\x00\xff\xff\x00\x00\x01\x00\xff\xff\xff\xff\x02\x00\x02\x00\xfc\xff\xfc\xff\x05\x00\x05\x00\xfa\xff\xfc\xff\x05\x00\x03\x00\xfc\xff\xff\xff\x02\x00\xfc\xff\xff\xff\b\x00\x00\x00\xf5\xff\x00\x00\f\x00\xff\xff\xf6\xff\x00\x00\x06\x00\x00\x00\xfb\xff\x00\x00\x05\x00\x00\x00\xfa\xff\x00\x00\a\x00\xff\xff\xf9\xff\x01\x00\x04\x00\xff\xff\xff\xff\x00\x00\xff\xff\xff\xff\x01\x00\x01\x00\xff\xff\xff\xff\x00\x00\x01\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\x03\x00\x01\x00\xfd\xff\xfd\xff\x02\x00\x03\x00\xfd\xff\xfc\xff\x05\x00\x03\x00\xf9\xff\xfd\xff\x06\x00\x02\x00\xfb\xff\xfe\xff\x02\x00\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x01\x00\xff\xff\xfd\xff\x00\x00\x03\x00\x00\x00\xfc\xff\xff\xff\x02\x00\x02\x00\x00\x00\xfc\xff\xfe\xff\x04\x00\x02\x00\xfa\xff\xfe\xff\a\x00\x00\x00\xfa\xff\x01\x00\x02\x00\xfe\xff\x00\x00\x00\x00\xff\xff\x01\x00\x00\x00\xff\xff\x00\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\x00\x00\x00\x00\xfd\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xfd\xff\x01\x00\xff\xff\x00\x00\x03\x00\xfe\xff\xfd\xff\x01\x00\x02\x00\x00\x00\xff\xff\xfe\xff\xfe\xff\x01\x00\x01\x00\x00\x00\x01\x00\xfe\xff\xfd\xff\x02\x00\x02\x00\xfe\xff\xfe\xff\xff\xff\x02\x00\x01\x00\xfd\xff\x01\x00\x03\x00\xfb\xff\xfb\xff\x06\x00\x05\x00\xfa\xff\xfb\xff\x03\x00\x04\x00\x00\x00\xfc\xff\xfc\xff\x04\x00\x06\x00\xfb\xff\xf9\xff\x02\x00\x05\x00\x00\x00\xfc\xff\xff\xff\x03\x00\x03\x00\xfd\xff\xfb\xff\x02\x00\x02\x00\xfc\xff\x00\x00\x04\x00\xff\xff\xfe\xff\x00\x00\xff\xff\x01\x00\x00\x00\xfd\xff\x01\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x00\x00\xfa\xff\x00\x00\x03\x00\xff\xff\x00\x00\x00\x00\xff\xff\xff\xff\x00\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x00\x00\xfb\xff\x01\x00\t\x00\xfe\xff\xf3\xff\x01\x00\f\x00\x00\x00\xf6\xff\xfe\xff\a\x00\x03\x00\xfb\xff\xfe\xff\x01\x00\x00\x00\x02\x00\x00\x00\xfb\xff\x00\x00\x05\x00\xff\xff\xfb\xff\x02\x00\x04\x00\xfe\xff\xfc\xff\x01\x00\x03\x00\xfe\xff\xfc\xff\x01\x00\x02\x00\x01\x00\xff\xff\xfe\xff\xff\xff\x02\x00\x02\x00\xfc\xff\xfd\xff\x03\x00\x02\x00\xff\xff\xfd\xff\x01\x00\x02\x00\xfd\xff\xfe\xff\x03\x00\x02\x00\xfd\xff\xfc\xff\x02\x00\x04\x00\x00\x00\xfb\xff\xfe\xff\x04\x00\x01\x00\xfd\xff\x00\x00\x00\x00\xff\xff\x02\x00\x01\x00\xfc\xff\x00\x00\x04\x00\xfe\xff\xfb\xff\x02\x00\x04\x00\xfe\xff\xfc\xff\x02\x00\x03\x00\xfe\xff\xff\xff\x00\x00\xfd\xff\x01\x00\x05\x00\x00\x00\xf8\xff\xff\xff\t\x00\x01\x00\xf9\xff\xfe\xff\x04\x00\x01\x00\xfd\xff\x02\x00\x01\x00\xfd\xff\x01\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x00\x00\x01\x00\x01\x00\x01\x00\xfd\xff\xfc\xff\x04\x00\x05\x00\xfb\xff\xfc\xff\x05\x00\x02\x00\xfc\xff\x00\x00\x02\x00\xfe\xff\xff\xff\x01\x00\x00\x00\x01\x00\x01\x00\xfd\xff\xff\xff\x04\x00\x01\x00\xfc\xff\xfe\xff\x02\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x03\x00\x01\x00\xfe\xff\xfe\xff\x01\x00\x03\x00\xfe\xff\xfd\xff\x02\x00\x03\x00\x00\x00\xfd\xff\x01\x00\x01\x00\xfe\xff\x01\x00\x02\x00\xff\xff\xfd\xff\xff\xff\x05\x00\x01\x00\xfb\xff\x00\x00\x05\x00\x00\x00\xfb\xff\x01\x00\x05\x00\xfd\xff\xfc\xff\x03\x00\x04\x00\xfe\xff\xfc\xff\x02\x00\x04\x00\xfe\xff\xfd\xff\x02\x00\x03\x00\xfe\xff\xfd\xff\x01\x00\x04\x00\x00\x00\xfc\xff\x01\x00\x03\x00\xfd\xff\xff\xff\x05\x00\x01\x00\xfa\xff\xfe\xff\x05\x00\x03\x00\xfd\xff\xfd\xff\x01\x00\x03\x00\x01\x00\xff\xff\xfe\xff\xff\xff\x04\x00\x02\x00\xfb\xff\xff\xff\x05\x00\x01\x00\xfc\xff\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\xff\xff\x01\x00\x02\x00\x00\x00\xfe\xff\xff\xff\x01\x00\x04\x00\x01\x00\xfb\xff\xff\xff\x06\x00\x02\x00\xf9\xff\xfd\xff\a\x00\x02\x00\xfc\xff\x00\x00\x03\x00\x00\x00\x00\x00\x01\x00\xfd\xff\x00\x00\x04\x00\xfe\xff\xfe\xff\x04\x00\x02\x00\xfc\xff\xfe\xff\x04\x00\x03\x00\xfe\xff\xfe\xff\x01\x00\x03\x00\x00\x00\xfe\xff\x01\x00\xff\xff\xff\xff\x04\x00\x00\x00\xfd\xff\x01\x00\x04\x00\x01\x00\xfc\xff\xfe\xff\x03\x00\x04\x00\xff\xff\xfb\xff\x01\x00\x04\x00\x00\x00\xff\xff\xff\xff\x01\x00\x03\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x02\x00\xff\xff\xfe\xff\x01\x00\x04\x00\xff\xff\xfd\xff\x02\x00\x01\x00\x00\x00\x02\x00\x00\x00\xfd\xff\x00\x00\x04\x00\x00\x00\xfd\xff\x01\x00\x02\x00\x00\x00\x01\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xfd\xff\x04\x00\x02\x00\xfd\xff\x02\x00\x03\x00\xfd\xff\xfe\xff\x04\x00\x02\x00\xfb\xff\x00\x00\x05\x00\x00\x00\xff\xff\x01\x00\xff\xff\xfe\xff\x04\x00\x03\x00\xfb\xff\xfe\xff\x05\x00\x03\x00\xfd\xff\xfd\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xff\xff\xff\xff\x00\x00\x01\x00\x03\x00\x00\x00\xfd\xff\x00\x00\x03\x00\x01\x00\xfd\xff\x01\x00\x02\x00\xfe\xff\x01\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\x01\x00\x01\x00\xfe\xff\x01\x00\x04\x00\xff\xff\xfc\xff\x02\x00\x04\x00\x00\x00\xfd\xff\xff\xff\x02\x00\x01\x00\x00\x00\x00\x00\xff\xff\x01\x00\x03\x00\xff\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\x01\x00\x02\x00\xfe\xff\x00\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x01\x00\x01\x00\x01\x00\xfd\xff\xfe\xff\x04\x00\x02\x00\xfc\xff\xff\xff\x03\x00\x02\x00\x00\x00\xff\xff\xff\xff\x00\x00\x03\x00\x00\x00\xfc\xff\x00\x00\x03\x00\x02\x00\xfe\xff\xff\xff\x01\x00\x00\x00\x01\x00\x01\x00\x01\x00\x00\x00\xfd\xff\x00\x00\x04\x00\x01\x00\xfc\xff\xff\xff\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\xfd\xff\x00\x00\x03\x00\xff\xff\xfd\xff\x02\x00\x04\x00\xff\xff\xfd\xff\x01\x00\x02\x00\xff\xff\xff\xff\x00\x00\x01\x00\x00\x00\x00\x00\x01\x00\x01\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xff\xff\xff\xff\x01\x00\x03\x00\xff\xff\xfc\xff\x02\x00\x04\x00\xfd\xff\xfc\xff\x04\x00\x05\x00\xfc\xff\xfc\xff\x02\x00\x02\x00\x00\x00\x01\x00\xff\xff\xfd\xff\x03\x00\x05\x00\xfc\xff\xfa\xff\x04\x00\x06\x00\xfc\xff\xfc\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x02\x00\xff\xff\xfe\xff\x00\x00\x02\x00\x00\x00\x00\x00\xff\xff\xff\xff\x03\x00\x03\x00\xfd\xff\xfa\xff\x02\x00\a\x00\xfe\xff\xfa\xff\x01\x00\x06\x00\x00\x00\xfc\xff\x00\x00\x02\x00\x00\x00\xfe\xff\x00\x00\x01\x00\xff\xff\x00\x00\x01\x00\xff\xff\x00\x00\x04\x00\xff\xff\xfb\xff\x01\x00\x04\x00\xff\xff\xfb\xff\x00\x00\x05\x00\x01\x00\xfd\xff\xff\xff\x01\x00\x00\x00\x02\x00\x01\x00\xfc\xff\xfe\xff\x04\x00\x00\x00\xfd\xff\x02\x00\x01\x00\xfd\xff\x00\x00\x03\x00\x01\x00\xfe\xff\xff\xff\x00\x00\x00\x00\x01\x00\x00\x00\xfe\xff\x00\x00\x02\x00\xff\xff\xfd\xff\x02\x00\x04\x00\xfe\xff\xfb\xff\x00\x00\x05\x00\x03\x00\xfb\xff\xfa\xff\x04\x00\b\x00\xfd\xff\xf7\xff\x00\x00\b\x00\x01\x00\xfa\xff\xff\xff\x02\x00\x00\x00\x01\x00\x01\x00\xff\xff\xfe\xff\xff\xff\x01\x00\x02\x00\xfe\xff\xfc\xff\x02\x00\x04\x00\xff\xff\xfe\xff\xff\xff\x00\x00\x02\x00\x00\x00\xfc\xff\x00\x00\x04\x00\x00\x00\xfc\xff\xff\xff\x03\x00\x02\x00\xfd\xff\xfd\xff\x02\x00\x03\x00\xfe\xff\xfc\xff\x02\x00\x04\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfd\xff\x00\x00\x01\x00\xfe\xff\x00\x00\x03\x00\xff\xff\xfd\xff\x00\x00\x01\x00\x00\x00\x00\x00\xff\xff\xfe\xff\x00\x00\x04\x00\x02\x00\xfb\xff\xfb\xff\x04\x00\x06\x00\xfc\xff\xf9\xff\x02\x00\x05\x00\x00\x00\xfd\xff\xff\xff\x00\x00\x01\x00\x00\x00\xfe\xff\x01\x00\x01\x00\xfd\xff\x00\x00\x04\x00\xff\xff\xfb\xff\x01\x00\x03\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x00\x00\xfc\xff\x00\x00\x03\x00\xff\xff\xfe\xff\x02\x00\x02\x00\xfc\xff\xfd\xff\x04\x00\x01\x00\xfb\xff\xff\xff\x04\x00\x00\x00\xfd\xff\x00\x00\x01\x00\x00\x00\x00\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x00\x00\x01\x00\x01\x00\xff\xff\xfe\xff\x00\x00\x01\x00\xff\xff\xff\xff\x00\x00\x01\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x00\x00\xfd\xff\xff\xff\x02\x00\x03\x00\x00\x00\xfb\xff\xfe\xff\x05\x00\x01\x00\xfb\xff\x00\x00\x02\x00\xfe\xff\x00\x00\x02\x00\xfe\xff\xff\xff\x03\x00\x00\x00\xfd\xff\xfe\xff\x01\x00\x03\x00\x00\x00\xfb\xff\xff\xff\x06\x00\x00\x00\xfa\xff\x01\x00\x05\x00\xfe\xff\xfb\xff\x02\x00\x03\x00\xfe\xff\xfe\xff\x01\x00\x01\x00\xff\xff\xff\xff\x01\x00\x02\x00\xfe\xff\xfb\xff\x02\x00\x06\x00\xfd\xff\xfa\xff\x04\x00\x04\x00\xfe\xff\xfd\xff\xff\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x00\x00\x00\x00\x01\x00\x03\x00\xfe\xff\xfa\xff\x03\x00\a\x00\xfd\xff\xf9\xff\xff\xff\x05\x00\x03\x00\xfe\xff\xfc\xff\xff\xff\x06\x00\x02\x00\xf9\xff\xfe\xff\x05\x00\x02\x00\xfc\xff\xfe\xff\x02\x00\x02\x00\x01\x00\xfd\xff\xfd\xff\x03\x00\x04\x00\xfd\xff\xfc\xff\x04\x00\x01\x00\xfb\xff\x01\x00\x05\x00\xff\xff\xfb\xff\x00\x00\x04\x00\x01\x00\xfe\xff\xfd\xff\x01\x00\x03\x00\xfe\xff\xff\xff\x03\x00\xff\xff\xfc\xff\x01\x00\x04\x00\xff\xff\xfe\xff\x01\x00\xff\xff\xff\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x01\x00\x01\x00\x01\x00\xfe\xff\xff\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x03\x00\xff\xff\xfc\xff\x02\x00\x03\x00\x00\x00\xfd\xff\xff\xff\x03\x00\x03\x00\xfe\xff\xfa\xff\x01\x00\a\x00\xfe\xff\xfa\xff\x02\x00\x04\x00\xfe\xff\xff\xff\x02\x00\x00\x00\xfe\xff\x00\x00\x01\x00\x00\x00\x00\x00\xff\xff\xff\xff\x02\x00\x02\x00\xfe\xff\xfd\xff\x02\x00\x04\x00\xff\xff\xfb\xff\x00\x00\x05\x00\x00\x00\xfd\xff\xff\xff\x00\x00\x02\x00\x03\x00\x00\x00\xfb\xff\xff\xff\x04\x00\x01\x00\x00\x00\xff\xff\xfc\xff\x00\x00\x06\x00\x02\x00\xfb\xff\xfe\xff\x02\x00\x02\x00\x02\x00\xff\xff\xfb\xff\x00\x00\a\x00\x01\x00\xfa\xff\xff\xff\x04\x00\x00\x00\xfe\xff\x03\x00\x00\x00\xfc\xff\x02\x00\x05\x00\xfc\xff\xfb\xff\x05\x00\x04\x00\xfb\xff\xfd\xff\x05\x00\x03\x00\xfd\xff\xfd\xff\x00\x00\x05\x00\x00\x00\xfb\xff\x01\x00\x04\x00\xff\xff\xfd\xff\x02\x00\x02\x00\xfe\xff\x00\x00\x00\x00\x01\x00\x01\x00\xff\xff\x01\x00\x01\x00\xff\xff\xfe\xff\x01\x00\x03\x00\xfe\xff\xfd\xff\x01\x00\x05\x00\x01\x00\xfa\xff\xff\xff\x05\x00\x01\x00\xfc\xff\xff\xff\x04\x00\x02\x00\xfe\xff\xff\xff\x01\x00\x00\x00\x00\x00\xff\xff\x00\x00\x02\x00\x00\x00\x00\x00\x01\x00\x01\x00\xff\xff\xff\xff\x02\x00\x00\x00\xff\xff\x00\x00\x02\x00\x01\x00\xfd\xff\x00\x00\x03\x00\x00\x00\xfd\xff\x00\x00\x04\x00\x00\x00\xff\xff\x00\x00\xff\xff\x01\x00\x03\x00\xfe\xff\xfb\xff\x03\x00\x05\x00\xfe\xff\xff\xff\x01\x00\xff\xff\x00\x00\x02\x00\x01\x00\xff\xff\xfe\xff\x00\x00\x04\x00\x02\x00\xfc\xff\xfd\xff\x04\x00\x03\x00\xfd\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\xfd\xff\x02\x00\x04\x00\xfe\xff\xfc\xff\x04\x00\x06\x00\xfc\xff\xfb\xff\x04\x00\x05\x00\xfc\xff\xfa\xff\x04\x00\x06\x00\xfd\xff\xfd\xff\x04\x00\x01\x00\xfb\xff\x02\x00\x05\x00\xfc\xff\xfd\xff\x06\x00\x02\x00\xfa\xff\xff\xff\x06\x00\x00\x00\xfa\xff\x01\x00\x06\x00\xff\xff\xfd\xff\x02\x00\x00\x00\xff\xff\x03\x00\x00\x00\xfc\xff\x00\x00\x03\x00\x01\x00\x01\x00\x00\x00\xfc\xff\x01\x00\x05\x00\xfe\xff\xfd\xff\x02\x00\x01\x00\xfe\xff\x01\x00\x05\x00\xfe\xff\xf9\xff\x02\x00\a\x00\xff\xff\xfa\xff\x01\x00\x05\x00\xff\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x03\x00\x02\x00\xfd\xff\xfd\xff\x03\x00\x05\x00\xfe\xff\xfc\xff\x01\x00\x01\x00\x00\x00\x03\x00\x01\x00\xfd\xff\x00\x00\x03\x00\xff\xff\xfd\xff\x02\x00\x03\x00\xfc\xff\xfe\xff\a\x00\x02\x00\xf9\xff\xff\xff\x06\x00\x00\x00\xfe\xff\x01\x00\xff\xff\x01\x00\x02\x00\xfc\xff\xfe\xff\x06\x00\x02\x00\xfb\xff\xfe\xff\x02\x00\x03\x00\x01\x00\xfd\xff\xfe\xff\x02\x00\x02\x00\x01\x00\x01\x00\xfd\xff\xfc\xff\x03\x00\x04\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xff\xff\x02\x00\x02\x00\xfc\xff\xfd\xff\x04\x00\x03\x00\xfe\xff\xfd\xff\x00\x00\x03\x00\x03\x00\xff\xff\xfc\xff\x00\x00\x03\x00\x00\x00\xff\xff\x00\x00\xff\xff\x00\x00\x02\x00\x02\x00\xff\xff\xfd\xff\xff\xff\x03\x00\x03\x00\xff\xff\xfd\xff\xff\xff\x01\x00\x01\x00\x01\x00\x00\x00\xff\xff\xff\xff\xff\xff\x03\x00\x04\x00\xfd\xff\xfa\xff\x02\x00\x06\x00\xff\xff\xfb\xff\xff\xff\x04\x00\x03\x00\xfc\xff\xfd\xff\x05\x00\x04\x00\xfa\xff\xfd\xff\a\x00\x01\x00\xf9\xff\x00\x00\x06\x00\x00\x00\xfb\xff\x01\x00\x04\x00\x00\x00\xff\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x01\x00\x01\x00\x00\x00\x01\x00\x00\x00\xff\xff\xff\xff\x01\x00\x00\x00\xff\xff\x01\x00\x01\x00\xfe\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xfd\xff\x03\x00\x03\x00\xfd\xff\xfd\xff\x02\x00\x03\x00\xfd\xff\xfd\xff\x04\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x03\x00\xff\xff\xfe\xff\x00\x00\x01\x00\xff\xff\x01\x00\x02\x00\xfe\xff\xfe\xff\x03\x00\x01\x00\xfd\xff\x01\x00\x03\x00\xfe\xff\xfd\xff\x01\x00\x03\x00\x00\x00\xfd\xff\xfe\xff\x03\x00\x04\x00\xff\xff\xfd\xff\xff\xff\x02\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x01\x00\xff\xff\xff\xff\xfe\xff\x02\x00\x05\x00\xfd\xff\xf9\xff\x03\x00\a\x00\xff\xff\xfa\xff\xfe\xff\x04\x00\x05\x00\xfe\xff\xf9\xff\x02\x00\b\x00\xfc\xff\xfa\xff\x04\x00\x04\x00\xfe\xff\xfd\xff\x01\x00\x01\x00\x00\x00\x01\x00\xff\xff\x00\x00\x00\x00\xff\xff\x01\x00\x01\x00\x00\x00\xfd\xff\xfe\xff\x04\x00\x02\x00\xfe\xff\xff\xff\xff\xff\x01\x00\x02\x00\xfe\xff\xfe\xff\x02\x00\x01\x00\xfd\xff\x01\x00\x03\x00\xfd\xff\xfe\xff\x02\x00\x01\x00\xfe\xff\xff\xff\x04\x00\x01\x00\xfc\xff\xfe\xff\x03\x00\x03\x00\xfd\xff\xfc\xff\x01\x00\x04\x00\x02\x00\xfd\xff\xfc\xff\x01\x00\x06\x00\x01\x00\xf9\xff\xfe\xff\x06\x00\x02\x00\xfb\xff\xfe\xff\x04\x00\x02\x00\xfd\xff\xfd\xff\x03\x00\x04\x00\xfd\xff\xfc\xff\x01\x00\x04\x00\x00\x00\xfd\xff\x00\x00\x01\x00\x01\x00\xff\xff\xfc\xff\x01\x00\x06\x00\xff\xff\xf9\xff\x02\x00\x06\x00\xfd\xff\xfc\xff\x02\x00\x01\x00\xff\xff\x00\x00\x01\x00\x00\x00\xff\xff\x01\x00\x00\x00\xff\xff\xff\xff\x00\x00\x02\x00\xff\xff\xfe\xff\x01\x00\x02\x00\x00\x00\xfd\xff\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\xfe\xff\xff\xff\x04\x00\x03\x00\xfb\xff\xfb\xff\x05\x00\x05\x00\xfc\xff\xfd\xff\x01\x00\x00\x00\x00\x00\x02\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x00\x00\xfe\xff\xff\xff\x00\x00\x01\x00\x01\x00\xff\xff\xff\xff\x02\x00\x00\x00\xfc\xff\x00\x00\x05\x00\x00\x00\xfb\xff\x00\x00\x04\x00\x00\x00\xfe\xff\x01\x00\x00\x00\xff\xff\x01\x00\xff\xff\xff\xff\x01\x00\xff\xff\x00\x00\x04\x00\x00\x00\xfb\xff\x00\x00\x03\x00\x01\x00\xff\xff\xfc\xff\xff\xff\x06\x00\x02\x00\xfa\xff\xfe\xff\x05\x00\x01\x00\xfc\xff\x00\x00\x04\x00\x00\x00\xfc\xff\x00\x00\x04\x00\xff\xff\xfc\xff\x01\x00\x04\x00\x00\x00\xfb\xff\x00\x00\x06\x00\x00\x00\xfa\xff\x01\x00\x05\x00\xfe\xff\xfc\xff\x02\x00\x02\x00\xff\xff\xfe\xff\xff\xff\x03\x00\x03\x00\xfc\xff\xfd\xff\x04\x00\x02\x00\xfc\xff\x00\x00\x04\x00\xfe\xff\xfc\xff\x03\x00\x04\x00\xfd\xff\xfc\xff\x02\x00\x03\x00\xfe\xff\x00\x00\x03\x00\xfd\xff\xfe\xff\x05\x00\xff\xff\xfa\xff\x03\x00\a\x00\xfc\xff\xfa\xff\x04\x00\x04\x00\xfd\xff\xfc\xff\x03\x00\x03\x00\xfc\xff\x00\x00\x04\x00\x00\x00\xfd\xff\xfe\xff\x01\x00\x03\x00\x03\x00\xfc\xff\xfa\xff\x04\x00\x06\x00\xfe\xff\xfc\xff\xff\xff\x02\x00\x05\x00\xff\xff\xfa\xff\x02\x00\x05\x00\xfc\xff\xfc\xff\x04\x00\x02\x00\xfd\xff\x01\x00\x02\x00\xfd\xff\xff\xff\x04\x00\x02\x00\xfc\xff\xfc\xff\x03\x00\x03\x00\xfe\xff\xfe\xff\x01\x00\x02\x00\xff\xff\xfe\xff\x01\x00\x02\x00\xff\xff\xfe\xff\x02\x00\x02\x00\xfe\xff\xfd\xff\x01\x00\x03\x00\xff\xff\xfe\xff\x01\x00\x01\x00\x00\x00\x00\x00\xff\xff\x00\x00\x01\x00\x01\x00\xff\xff\xff\xff\x01\x00\xff\xff\xff\xff\x03\x00\x01\x00\xfc\xff\xfe\xff\x06\x00\x02\x00\xf9\xff\xfe\xff\a\x00\x02\x00\xfa\xff\x00\x00\x05\x00\xfe\xff\xfc\xff\x02\x00\x02\x00\xfe\xff\x00\x00\x03\x00\x00\x00\xfe\xff\xff\xff\x02\x00\x02\x00\xfc\xff\xfd\xff\x05\x00\x02\x00\xfc\xff\x00\x00\x02\x00\xff\xff\x01\x00\x01\x00\xfd\xff\xff\xff\x02\x00\x00\x00\x00\x00\x01\x00\xfe\xff\xff\xff\x03\x00\x02\x00\xfe\xff\xfd\xff\x00\x00\x03\x00\x01\x00\xfd\xff\xff\xff\x03\x00\x00\x00\xfe\xff\x00\x00\x02\x00\x01\x00\xfd\xff\xfd\xff\x04\x00\x06\x00\xfc\xff\xf8\xff\x03\x00\b\x00\xfe\xff\xf8\xff\x02\x00\b\x00\xfe\xff\xfa\xff\x02\x00\x04\x00\xfe\xffLIST*\x00\x00\x00INFOINAM\n\x00\x00\x0019.11.16\x00\x00IART\f\x00\x00\x00InspectorJ\x00\x00id3 2\x00\x00\x00ID3\x03\x00\x00\x00\x00\x00(TIT2\x00\x00\x00\t\x00\x00\x0019.11.16TPE1\x00\x00\x00\v\x00\x00\x00InspectorJ")
@@ -0,0 +1,120 @@
+package sfxr
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// presets generate a random effect of a kind, like the buttons down the side
+// of sfxr. The same seed always gives the same effect.
+var presets = map[string]func(r *rand.Rand) *Params{
+	"pickup": func(r *rand.Rand) *Params {
+		return &Params{
+			Wave:    Square,
+			Sustain: between(r, 0.02, 0.08),
+			Punch:   between(r, 0.3, 0.6),
+			Decay:   between(r, 0.1, 0.3),
+			Freq:    between(r, 700, 1400),
+			ArpMod:  between(r, 1.3, 1.6),
+			ArpTime: between(r, 0.03, 0.08),
+		}
+	},
+	"laser": func(r *rand.Rand) *Params {
+		return &Params{
+			Wave:      pick(r, Square, Saw, Sine),
+			Sustain:   between(r, 0.05, 0.15),
+			Punch:     between(r, 0, 0.3),
+			Decay:     between(r, 0.05, 0.2),
+			Freq:      between(r, 800, 2000),
+			MinFreq:   between(r, 60, 200),
+			Slide:     -between(r, 8, 20),
+			Duty:      between(r, 0.1, 0.5),
+			DutySweep: between(r, 0, 2),
+			HighPass:  between(r, 0, 300),
+		}
+	},
+	"explosion": func(r *rand.Rand) *Params {
+		return &Params{
+			Wave:    Noise,
+			Sustain: between(r, 0.05, 0.2),
+			Punch:   between(r, 0.3, 0.8),
+			Decay:   between(r, 0.3, 0.8),
+			Freq:    between(r, 60, 400),
+			Slide:   -between(r, 0, 3),
+			LowPass: between(r, 1000, 6000),
+			Volume:  0.7,
+			Seed:    r.Int63(),
+		}
+	},
+	"powerup": func(r *rand.Rand) *Params {
+		return &Params{
+			Wave:         pick(r, Square, Saw),
+			Sustain:      between(r, 0.1, 0.3),
+			Decay:        between(r, 0.1, 0.4),
+			Freq:         between(r, 200, 500),
+			Slide:        between(r, 2, 6),
+			VibratoDepth: between(r, 0, 0.1),
+			VibratoSpeed: between(r, 10, 25),
+		}
+	},
+	"hit": func(r *rand.Rand) *Params {
+		return &Params{
+			Wave:     pick(r, Square, Saw, Noise),
+			Sustain:  between(r, 0.01, 0.05),
+			Decay:    between(r, 0.05, 0.2),
+			Freq:     between(r, 150, 600),
+			Slide:    -between(r, 4, 12),
+			HighPass: between(r, 0, 400),
+			Seed:     r.Int63(),
+		}
+	},
+	"jump": func(r *rand.Rand) *Params {
+		return &Params{
+			Wave:    Square,
+			Sustain: between(r, 0.05, 0.15),
+			Decay:   between(r, 0.05, 0.2),
+			Freq:    between(r, 250, 600),
+			Slide:   between(r, 3, 8),
+			Duty:    between(r, 0.2, 0.5),
+		}
+	},
+	"blip": func(r *rand.Rand) *Params {
+		return &Params{
+			Wave:    pick(r, Square, Sine),
+			Sustain: between(r, 0.03, 0.08),
+			Decay:   between(r, 0.01, 0.05),
+			Freq:    between(r, 400, 1200),
+			Duty:    between(r, 0.2, 0.5),
+		}
+	},
+}
+
+// Presets returns the names of the presets, sorted
+func Presets() []string {
+	var names []string
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Preset returns a random effect of the named kind
+func Preset(name string, seed int64) (*Params, error) {
+	preset, ok := presets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown preset %q", name)
+	}
+
+	return preset(rand.New(rand.NewSource(seed))), nil
+}
+
+func between(r *rand.Rand, min, max float64) float64 {
+	return min + r.Float64()*(max-min)
+}
+
+func pick(r *rand.Rand, waves ...Wave) Wave {
+	return waves[r.Intn(len(waves))]
+}
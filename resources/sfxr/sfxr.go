@@ -0,0 +1,275 @@
+// Package sfxr synthesises retro sound effects from a handful of parameters,
+// in the style of DrPetter's sfxr. Effects are stored as small JSON files
+// with the .sfxr extension and rendered when the game loads them, and the
+// sfxr command previews them and exports them to WAV.
+package sfxr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Wave is the shape of the oscillator
+type Wave string
+
+// Waves
+const (
+	Square   Wave = "square"
+	Saw      Wave = "saw"
+	Sine     Wave = "sine"
+	Triangle Wave = "triangle"
+	Noise    Wave = "noise"
+)
+
+// Params describe a sound effect. Times are in seconds and frequencies in
+// hertz. Zero values turn a feature off.
+type Params struct {
+	Wave Wave `json:"wave"`
+
+	// Envelope. The sound lasts for Attack+Sustain+Decay. Punch boosts the
+	// start of the sustain by that fraction of full volume.
+	Attack  float64 `json:"attack,omitempty"`
+	Sustain float64 `json:"sustain"`
+	Punch   float64 `json:"punch,omitempty"`
+	Decay   float64 `json:"decay,omitempty"`
+
+	// Pitch. Slide changes the frequency by that many octaves per second,
+	// and DeltaSlide changes Slide by that much per second. The sound stops
+	// early if the frequency slides below MinFreq.
+	Freq       float64 `json:"freq"`
+	MinFreq    float64 `json:"minFreq,omitempty"`
+	Slide      float64 `json:"slide,omitempty"`
+	DeltaSlide float64 `json:"deltaSlide,omitempty"`
+
+	// Vibrato wobbles the frequency by Depth of itself, Speed times a second
+	VibratoDepth float64 `json:"vibratoDepth,omitempty"`
+	VibratoSpeed float64 `json:"vibratoSpeed,omitempty"`
+
+	// Arpeggio multiplies the frequency by ArpMod once ArpTime has passed
+	ArpMod  float64 `json:"arpMod,omitempty"`
+	ArpTime float64 `json:"arpTime,omitempty"`
+
+	// Duty is the fraction of each square wave cycle spent high, default
+	// 0.5. DutySweep changes it by that much per second.
+	Duty      float64 `json:"duty,omitempty"`
+	DutySweep float64 `json:"dutySweep,omitempty"`
+
+	// One pole filters. LowPass cuts frequencies above it, HighPass cuts
+	// frequencies below it.
+	LowPass  float64 `json:"lowPass,omitempty"`
+	HighPass float64 `json:"highPass,omitempty"`
+
+	// Volume of the whole effect, default 0.5
+	Volume float64 `json:"volume,omitempty"`
+
+	// Seed of the noise wave, so an effect always sounds the same
+	Seed int64 `json:"seed,omitempty"`
+}
+
+// maxDuration keeps a bad parameter file from rendering for ever
+const maxDuration = 10
+
+// Parse decodes and validates effect parameters
+func Parse(data []byte) (*Params, error) {
+	p := &Params{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("invalid sfxr parameters: %v", err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Validate checks the parameters describe a sound that can be rendered
+func (p *Params) Validate() error {
+	switch p.Wave {
+	case Square, Saw, Sine, Triangle, Noise:
+	default:
+		return fmt.Errorf("unknown wave %q", p.Wave)
+	}
+
+	switch d := p.Duration(); {
+	case p.Attack < 0 || p.Sustain < 0 || p.Decay < 0:
+		return errors.New("envelope times can't be negative")
+	case d <= 0:
+		return errors.New("sound has no duration")
+	case d > maxDuration:
+		return fmt.Errorf("sound lasts %.1fs, longer than %ds", d, maxDuration)
+	case p.Freq <= 0:
+		return errors.New("freq must be positive")
+	case p.Duty < 0 || p.Duty > 1:
+		return errors.New("duty must be between 0 and 1")
+	case p.Volume < 0:
+		return errors.New("volume can't be negative")
+	}
+
+	return nil
+}
+
+// Duration returns how long the sound lasts in seconds
+func (p *Params) Duration() float64 {
+	return p.Attack + p.Sustain + p.Decay
+}
+
+// JSON encodes the parameters in the .sfxr file format
+func (p *Params) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(p, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// Render synthesises the sound as mono samples between -1 and 1
+func (p *Params) Render(sampleRate int) []float64 {
+	dt := 1 / float64(sampleRate)
+	rnd := rand.New(rand.NewSource(p.Seed))
+
+	duty := p.Duty
+	if duty == 0 {
+		duty = 0.5
+	}
+	volume := p.Volume
+	if volume == 0 {
+		volume = 0.5
+	}
+
+	// The noise wave holds a random value for each 1/32 of a cycle, so it
+	// still has a pitch
+	var noise [32]float64
+	fillNoise := func() {
+		for i := range noise {
+			noise[i] = rnd.Float64()*2 - 1
+		}
+	}
+	fillNoise()
+
+	n := int(p.Duration() * float64(sampleRate))
+	samples := make([]float64, 0, n)
+
+	freq, slide := p.Freq, p.Slide
+	arpDone := p.ArpMod == 0
+	var phase, lowPass, highPassIn, highPassOut float64
+	for i := 0; i < n; i++ {
+		t := float64(i) * dt
+
+		if !arpDone && t >= p.ArpTime {
+			freq *= p.ArpMod
+			arpDone = true
+		}
+		freq *= math.Exp2(slide * dt)
+		slide += p.DeltaSlide * dt
+		if freq < p.MinFreq {
+			break
+		}
+
+		f := freq
+		if p.VibratoDepth != 0 {
+			f *= 1 + p.VibratoDepth*math.Sin(2*math.Pi*p.VibratoSpeed*t)
+		}
+
+		phase += f * dt
+		if phase >= 1 {
+			phase -= math.Floor(phase)
+			if p.Wave == Noise {
+				fillNoise()
+			}
+		}
+
+		var s float64
+		switch p.Wave {
+		case Square:
+			d := math.Max(0.05, math.Min(0.95, duty+p.DutySweep*t))
+			s = -1
+			if phase < d {
+				s = 1
+			}
+		case Saw:
+			s = 2*phase - 1
+		case Sine:
+			s = math.Sin(2 * math.Pi * phase)
+		case Triangle:
+			s = 1 - 4*math.Abs(phase-0.5)
+		case Noise:
+			s = noise[int(phase*float64(len(noise)))%len(noise)]
+		}
+
+		if p.LowPass > 0 {
+			lowPass += (1 - math.Exp(-2*math.Pi*p.LowPass*dt)) * (s - lowPass)
+			s = lowPass
+		}
+		if p.HighPass > 0 {
+			rc := 1 / (2 * math.Pi * p.HighPass)
+			highPassOut = rc / (rc + dt) * (highPassOut + s - highPassIn)
+			highPassIn = s
+			s = highPassOut
+		}
+
+		s *= p.envelope(t) * volume
+		samples = append(samples, math.Max(-1, math.Min(1, s)))
+	}
+
+	return samples
+}
+
+// envelope returns the volume of the sound t seconds in
+func (p *Params) envelope(t float64) float64 {
+	switch {
+	case t < p.Attack:
+		return t / p.Attack
+	case t < p.Attack+p.Sustain:
+		return 1 + p.Punch*(1-(t-p.Attack)/p.Sustain)
+	case p.Decay > 0:
+		return math.Max(0, 1-(t-p.Attack-p.Sustain)/p.Decay)
+	default:
+		return 0
+	}
+}
+
+// PCM encodes samples as little endian 16 bit PCM, copying each sample to
+// every channel
+func PCM(samples []float64, channels int) []byte {
+	pcm := make([]byte, 0, len(samples)*channels*2)
+	for _, s := range samples {
+		v := uint16(int16(s * math.MaxInt16))
+		for c := 0; c < channels; c++ {
+			pcm = append(pcm, byte(v), byte(v>>8))
+		}
+	}
+
+	return pcm
+}
+
+// WAV encodes samples as a 16 bit mono PCM WAV file
+func WAV(samples []float64, sampleRate int) []byte {
+	const bytesPerSample = 2
+	pcm := PCM(samples, 1)
+
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(36+len(pcm)))
+	b.WriteString("WAVEfmt ")
+	for _, v := range []interface{}{
+		uint32(16),                          // format chunk size
+		uint16(1),                           // PCM
+		uint16(1),                           // channels
+		uint32(sampleRate),                  // sample rate
+		uint32(sampleRate * bytesPerSample), // byte rate
+		uint16(bytesPerSample),              // block align
+		uint16(bytesPerSample * 8),          // bits per sample
+	} {
+		binary.Write(&b, binary.LittleEndian, v)
+	}
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, uint32(len(pcm)))
+	b.Write(pcm)
+
+	return b.Bytes()
+}
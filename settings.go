@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// Settings are the player's preferences and campaign progress, kept between
+// sessions
+type Settings struct {
+	MasterVolume float64 `json:"masterVolume"`
+	SFXVolume    float64 `json:"sfxVolume"`
+	MusicVolume  float64 `json:"musicVolume"`
+	Muted        bool    `json:"muted"`
+
+	// ScreenEffects enables screen shake, hit-stop, flashes and the damage
+	// vignette
+	ScreenEffects bool `json:"screenEffects"`
+
+	// PostEffects is the chain of post effects the screen is drawn through,
+	// in order, and Palette the colours the palette effect uses
+	PostEffects []string `json:"postEffects"`
+	Palette     string   `json:"palette"`
+
+	// Completed lists the ids of the campaign levels the player has
+	// completed
+	Completed []string `json:"completed"`
+}
+
+// DefaultSettings returns the settings used before the player changes any
+func DefaultSettings() *Settings {
+	return &Settings{
+		MasterVolume:  1,
+		SFXVolume:     1,
+		MusicVolume:   0.6,
+		ScreenEffects: true,
+	}
+}
+
+// LoadSettings returns the saved settings, or the defaults if there are none
+// or they can't be read
+func LoadSettings() *Settings {
+	s := DefaultSettings()
+
+	data, err := readSettings()
+	if err != nil {
+		log.Printf("error loading settings: %v", err)
+		return s
+	}
+	if data == nil {
+		return s
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		log.Printf("error loading settings: %v", err)
+		return DefaultSettings()
+	}
+
+	return s
+}
+
+// Save stores the settings for the next session
+func (s *Settings) Save() error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return writeSettings(data)
+}
@@ -0,0 +1,35 @@
+//go:build js
+// +build js
+
+package main
+
+import "syscall/js"
+
+// settingsKey is the local storage key the settings are saved under
+const settingsKey = "gametest.settings"
+
+// readSettings returns the saved settings from the browser's local storage,
+// or nil if there are none
+func readSettings() ([]byte, error) {
+	storage := js.Global().Get("localStorage")
+	if !storage.Truthy() {
+		return nil, nil
+	}
+
+	v := storage.Call("getItem", settingsKey)
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	return []byte(v.String()), nil
+}
+
+func writeSettings(data []byte) error {
+	storage := js.Global().Get("localStorage")
+	if !storage.Truthy() {
+		return nil
+	}
+
+	storage.Call("setItem", settingsKey, string(data))
+	return nil
+}
@@ -0,0 +1,47 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// settingsPath returns the file the settings are saved in, under the user's
+// config directory
+func settingsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gametest", "settings.json"), nil
+}
+
+// readSettings returns the saved settings file, or nil if there is none
+func readSettings() ([]byte, error) {
+	path, err := settingsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return data, err
+}
+
+func writeSettings(data []byte) error {
+	path, err := settingsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,148 @@
+package sim
+
+import "image"
+
+// Frame is one frame of an animation clip
+type Frame struct {
+	rect     image.Rectangle // area of the sprite sheet to draw
+	duration float64         // seconds the frame is shown for
+	event    string          // fired when the frame is reached, if not empty
+}
+
+// Clip is a named animation: a sequence of frames from one image that either
+// loops or plays once. The simulation only needs the frames' sizes, so the
+// image is named by its asset id for the game to draw.
+type Clip struct {
+	name   string
+	sheet  string     // asset id of the image
+	mask   *AlphaMask // opaque pixels of the image, for pixel perfect collision
+	frames []Frame
+	loop   bool
+	hitbox Hitbox // defaults to the whole frame if not set
+}
+
+// duration returns how long one play through of the clip takes in seconds
+func (c *Clip) duration() float64 {
+	d := 0.0
+	for _, f := range c.frames {
+		d += f.duration
+	}
+	return d
+}
+
+// AnimationEvent is a frame event fired by an entity's animation
+type AnimationEvent struct {
+	Entity Entity
+	Name   string
+}
+
+// Animator plays an entity's animation clips
+type Animator struct {
+	Clips   map[string]*Clip
+	clip    *Clip
+	frame   int
+	elapsed float64 // seconds spent on the current frame
+	done    bool    // a clip that doesn't loop has finished
+	fired   bool    // the current frame's event has been fired
+
+	// OnComplete is called once when a clip that doesn't loop finishes
+	OnComplete func(w *World, e Entity)
+}
+
+// NewAnimator returns an animator playing the named clip
+func NewAnimator(clips map[string]*Clip, name string) *Animator {
+	a := &Animator{Clips: clips}
+	a.Play(name)
+	return a
+}
+
+// Play switches to the named clip, starting it from the beginning. Playing
+// the clip that is already playing does nothing.
+func (a *Animator) Play(name string) {
+	clip := a.Clips[name]
+	if clip == a.clip {
+		return
+	}
+
+	a.clip = clip
+	a.frame = 0
+	a.elapsed = 0
+	a.done = false
+	a.fired = false
+	a.OnComplete = nil
+}
+
+// PlayOnce switches to the named clip and calls onComplete when it finishes
+func (a *Animator) PlayOnce(name string, onComplete func(w *World, e Entity)) {
+	a.Play(name)
+	a.OnComplete = onComplete
+}
+
+// Clip returns the clip being played
+func (a *Animator) Clip() *Clip {
+	return a.clip
+}
+
+// Frame returns the frame being shown
+func (a *Animator) Frame() Frame {
+	return a.clip.frames[a.frame]
+}
+
+// Hitbox returns the current clip's hitbox
+func (a *Animator) Hitbox() Hitbox {
+	return a.clip.hitbox
+}
+
+// Sprite returns the asset id of the image to draw and the area of it the
+// current frame is
+func (a *Animator) Sprite() (string, image.Rectangle) {
+	return a.clip.sheet, a.Frame().rect
+}
+
+// Refresh keeps the animator valid after its clips are reloaded in place,
+// restarting the current clip if it no longer has the frame being shown
+func (a *Animator) Refresh() {
+	if a.frame < len(a.clip.frames) {
+		return
+	}
+
+	a.frame = 0
+	a.elapsed = 0
+	a.fired = false
+}
+
+// advance moves the animation on by dt seconds, returning the events of any
+// frames reached
+func (a *Animator) advance(w *World, e Entity, dt float64) []AnimationEvent {
+	var events []AnimationEvent
+	fire := func() {
+		if f := a.Frame(); f.event != "" && !a.fired {
+			events = append(events, AnimationEvent{e, f.event})
+		}
+		a.fired = true
+	}
+
+	fire()
+	if a.done || a.clip.duration() <= 0 {
+		return events
+	}
+
+	a.elapsed += dt
+	for a.elapsed >= a.Frame().duration {
+		a.elapsed -= a.Frame().duration
+		if a.frame == len(a.clip.frames)-1 && !a.clip.loop {
+			a.done = true
+			a.elapsed = 0
+			if a.OnComplete != nil {
+				a.OnComplete(w, e)
+			}
+			break
+		}
+
+		a.frame = (a.frame + 1) % len(a.clip.frames)
+		a.fired = false
+		fire()
+	}
+
+	return events
+}
@@ -0,0 +1,196 @@
+package sim
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+)
+
+// Aseprite JSON sprite sheet export, as written by File > Export Sprite Sheet
+// with "JSON Data" ticked. Only the fields the game uses are decoded.
+//
+// Each frame tag becomes an animation clip with the tag's name. Tags loop
+// unless their repeat count is set to 1. Frame events are given in the tag's
+// user data as a comma separated list of event@frame, where frame counts from
+// the start of the tag, e.g. "burst@0,spawn@3".
+//
+// A slice named "hitbox" sets the hitbox of every clip, and a slice named
+// "hitbox:<tag>" overrides it for one clip. Slices are rectangles; setting a
+// slice's user data to "circle" makes it the circle inscribed in the slice.
+type asepriteSheet struct {
+	Frames asepriteFrames `json:"frames"`
+	Meta   struct {
+		Image     string `json:"image"`
+		FrameTags []struct {
+			Name      string `json:"name"`
+			From      int    `json:"from"`
+			To        int    `json:"to"`
+			Direction string `json:"direction"`
+			Repeat    string `json:"repeat"`
+			Data      string `json:"data"`
+		} `json:"frameTags"`
+		Slices []struct {
+			Name string `json:"name"`
+			Data string `json:"data"`
+			Keys []struct {
+				Frame  int          `json:"frame"`
+				Bounds asepriteRect `json:"bounds"`
+			} `json:"keys"`
+		} `json:"slices"`
+	} `json:"meta"`
+}
+
+type asepriteRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type asepriteFrame struct {
+	Frame    asepriteRect `json:"frame"`
+	Rotated  bool         `json:"rotated"`
+	Trimmed  bool         `json:"trimmed"`
+	Duration int          `json:"duration"` // milliseconds
+}
+
+// asepriteFrames decodes frames exported either as an array or as a hash
+// keyed by filename, keeping the export order in both cases
+type asepriteFrames []asepriteFrame
+
+func (f *asepriteFrames) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, (*[]asepriteFrame)(f))
+	}
+
+	// Hash form: read the keys in order, as a map would lose it
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	for dec.More() {
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		var frame asepriteFrame
+		if err := dec.Decode(&frame); err != nil {
+			return err
+		}
+		*f = append(*f, frame)
+	}
+
+	return nil
+}
+
+// LoadAsepriteClips builds animation clips from an Aseprite JSON export of
+// the sprite sheet with the given asset id. mask may be nil if the clips
+// aren't used for pixel perfect collision.
+func LoadAsepriteClips(data []byte, id string, mask *AlphaMask) (map[string]*Clip, error) {
+	var sheet asepriteSheet
+	if err := json.Unmarshal(data, &sheet); err != nil {
+		return nil, fmt.Errorf("error decoding aseprite json: %v", err)
+	}
+
+	for i, f := range sheet.Frames {
+		if f.Rotated || f.Trimmed {
+			return nil, fmt.Errorf("frame %d of %s is rotated or trimmed, export without trimming or packing", i, sheet.Meta.Image)
+		}
+	}
+
+	clips := make(map[string]*Clip)
+	for _, tag := range sheet.Meta.FrameTags {
+		if tag.From < 0 || tag.To >= len(sheet.Frames) || tag.From > tag.To {
+			return nil, fmt.Errorf("tag %q of %s has invalid frames %d-%d", tag.Name, sheet.Meta.Image, tag.From, tag.To)
+		}
+
+		var order []int
+		for i := tag.From; i <= tag.To; i++ {
+			order = append(order, i)
+		}
+		switch tag.Direction {
+		case "", "forward":
+		case "reverse":
+			for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+				order[i], order[j] = order[j], order[i]
+			}
+		case "pingpong":
+			for i := tag.To - 1; i > tag.From; i-- {
+				order = append(order, i)
+			}
+		default:
+			return nil, fmt.Errorf("tag %q of %s has unsupported direction %q", tag.Name, sheet.Meta.Image, tag.Direction)
+		}
+
+		clip := &Clip{
+			name:  tag.Name,
+			sheet: id,
+			mask:  mask,
+			loop:  tag.Repeat != "1",
+		}
+		for _, i := range order {
+			r := sheet.Frames[i].Frame
+			clip.frames = append(clip.frames, Frame{
+				rect:     image.Rect(r.X, r.Y, r.X+r.W, r.Y+r.H),
+				duration: float64(sheet.Frames[i].Duration) / 1000,
+			})
+		}
+
+		if tag.Data != "" {
+			for _, ev := range strings.Split(tag.Data, ",") {
+				parts := strings.SplitN(strings.TrimSpace(ev), "@", 2)
+				if len(parts) != 2 {
+					return nil, fmt.Errorf("tag %q of %s has invalid event %q, want event@frame", tag.Name, sheet.Meta.Image, ev)
+				}
+				n, err := strconv.Atoi(parts[1])
+				if err != nil || n < 0 || n >= len(clip.frames) {
+					return nil, fmt.Errorf("tag %q of %s has invalid event frame %q", tag.Name, sheet.Meta.Image, parts[1])
+				}
+				clip.frames[n].event = parts[0]
+			}
+		}
+
+		clips[tag.Name] = clip
+	}
+
+	var shared *Hitbox
+	perClip := make(map[string]Hitbox)
+	for _, s := range sheet.Meta.Slices {
+		if len(s.Keys) == 0 {
+			continue
+		}
+		b := s.Keys[0].Bounds
+		h := RectHitbox(float64(b.X), float64(b.Y), float64(b.W), float64(b.H))
+		if s.Data == "circle" {
+			r := float64(b.W) / 2
+			if b.H < b.W {
+				r = float64(b.H) / 2
+			}
+			h = CircleHitbox(float64(b.X)+float64(b.W)/2, float64(b.Y)+float64(b.H)/2, r)
+		}
+
+		switch {
+		case s.Name == "hitbox":
+			shared = &h
+		case strings.HasPrefix(s.Name, "hitbox:"):
+			name := strings.TrimPrefix(s.Name, "hitbox:")
+			if _, ok := clips[name]; !ok {
+				return nil, fmt.Errorf("slice %q of %s names an unknown tag", s.Name, sheet.Meta.Image)
+			}
+			perClip[name] = h
+		}
+	}
+	for name, c := range clips {
+		if shared != nil {
+			c.hitbox = *shared
+		}
+		if h, ok := perClip[name]; ok {
+			c.hitbox = h
+		}
+	}
+
+	return clips, nil
+}
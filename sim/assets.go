@@ -0,0 +1,300 @@
+package sim
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/png"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/paulcockrell/gametest/resources"
+	"github.com/paulcockrell/gametest/resources/levelgen"
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+// Assets loads the assets listed in the manifest that the simulation reads,
+// the animation clips of sprite sheets and levels, and holds them by id.
+// Other assets are only marked loaded; the game's registry embeds this one
+// and loads the images, sounds and shaders it draws and plays itself.
+//
+// Assets are loaded explicitly, either all those a level needs up front or
+// one at a time behind a loading screen, and looking one up before it is
+// loaded is a bug.
+type Assets struct {
+	read     manifest.ReadFunc
+	manifest *manifest.Manifest
+
+	loaded map[string]bool
+	clips  map[string]map[string]*Clip
+	levels map[string]*manifest.Level
+}
+
+// NewAssets reads the manifest through read, which returns files by their
+// manifest path. Nothing else is loaded until asked for.
+func NewAssets(read manifest.ReadFunc) (*Assets, error) {
+	a := &Assets{
+		read:   read,
+		loaded: make(map[string]bool),
+		clips:  make(map[string]map[string]*Clip),
+		levels: make(map[string]*manifest.Level),
+	}
+	if err := a.ReadManifest(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// NewPackedAssets returns a registry of the assets packed into the binary
+func NewPackedAssets() (*Assets, error) {
+	return NewAssets(resources.File)
+}
+
+// DirReader returns a function that reads asset files from dir, which holds
+// manifest.json, rather than the packed ones
+func DirReader(dir string) manifest.ReadFunc {
+	return func(path string) ([]byte, error) {
+		return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+	}
+}
+
+// ReadManifest reads the manifest again, e.g. when its file changes
+func (a *Assets) ReadManifest() error {
+	data, err := a.read("manifest.json")
+	if err != nil {
+		return err
+	}
+	m, err := manifest.Parse(data)
+	if err != nil {
+		return err
+	}
+	a.manifest = m
+
+	return nil
+}
+
+// Manifest returns the manifest assets are loaded from
+func (a *Assets) Manifest() *manifest.Manifest {
+	return a.manifest
+}
+
+// Required returns the ids of every asset needed to play a level: the
+// preloaded assets, the ones the level lists and the level itself. Generated
+// levels need the preloaded assets and generatedAssets.
+func (a *Assets) Required(level string) ([]string, error) {
+	listed := generatedAssets
+	if _, _, ok := levelgen.ParseID(level); !ok {
+		l, err := a.asset(level, manifest.TypeLevel)
+		if err != nil {
+			return nil, err
+		}
+		listed = l.Assets
+	}
+
+	var ids []string
+	for _, m := range a.manifest.Assets {
+		if m.Preload {
+			ids = append(ids, m.ID)
+		}
+	}
+	ids = append(ids, listed...)
+	ids = append(ids, level)
+
+	return ids, nil
+}
+
+// LoadAll loads every asset in ids that isn't loaded yet
+func (a *Assets) LoadAll(ids []string) error {
+	for _, id := range ids {
+		if err := a.Load(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Loaded returns true if the asset has been loaded
+func (a *Assets) Loaded(id string) bool {
+	return a.loaded[id]
+}
+
+// Load loads one asset, doing nothing if it's already loaded. Ids of
+// generated levels, which aren't in the manifest, are generated instead.
+func (a *Assets) Load(id string) error {
+	if a.loaded[id] {
+		return nil
+	}
+	if algorithm, seed, ok := levelgen.ParseID(id); ok {
+		l, err := generateLevel(algorithm, seed)
+		if err != nil {
+			return fmt.Errorf("error generating level %q: %v", id, err)
+		}
+		a.levels[id] = l
+		a.loaded[id] = true
+		return nil
+	}
+
+	m, err := a.manifest.Asset(id)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case m.Type == manifest.TypeImage && m.Animations != "":
+		clips, err := a.decodeClips(m)
+		if err != nil {
+			return err
+		}
+		a.clips[id] = clips
+	case m.Type == manifest.TypeLevel:
+		l, err := a.decodeLevel(m)
+		if err != nil {
+			return err
+		}
+		a.levels[id] = l
+	}
+	a.loaded[id] = true
+
+	return nil
+}
+
+// Reload loads an asset again, replacing the loaded version in place so that
+// everything holding it picks up the change
+func (a *Assets) Reload(m *manifest.Asset) error {
+	switch {
+	case m.Type == manifest.TypeImage && m.Animations != "":
+		clips, err := a.decodeClips(m)
+		if err != nil {
+			return err
+		}
+
+		// Animators hold pointers to the clips, so the clips are
+		// overwritten rather than replaced
+		old := a.clips[m.ID]
+		for name := range old {
+			if _, ok := clips[name]; !ok {
+				return fmt.Errorf("clip %q was removed, restart to pick up the change", name)
+			}
+		}
+		for name, c := range clips {
+			if o, ok := old[name]; ok {
+				*o = *c
+			} else {
+				old[name] = c
+			}
+		}
+	case m.Type == manifest.TypeLevel:
+		l, err := a.decodeLevel(m)
+		if err != nil {
+			return err
+		}
+		a.levels[m.ID] = l
+	}
+
+	return nil
+}
+
+// Clips returns the animation clips of a loaded sprite sheet asset
+func (a *Assets) Clips(id string) map[string]*Clip {
+	a.MustBeLoaded(id)
+	return a.clips[id]
+}
+
+// Level returns a loaded level asset
+func (a *Assets) Level(id string) *manifest.Level {
+	a.MustBeLoaded(id)
+	return a.levels[id]
+}
+
+// ReplaceLevel swaps a loaded level asset for an edited version of it
+func (a *Assets) ReplaceLevel(id string, l *manifest.Level) {
+	a.MustBeLoaded(id)
+	a.levels[id] = l
+}
+
+// MustBeLoaded panics if an asset hasn't been loaded
+func (a *Assets) MustBeLoaded(id string) {
+	if !a.loaded[id] {
+		panic(fmt.Sprintf("asset %q used before it was loaded", id))
+	}
+}
+
+// IDs returns the ids of every loaded asset, sorted
+func (a *Assets) IDs() []string {
+	var ids []string
+	for id := range a.loaded {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// asset looks up an asset in the manifest, checking it is of the expected
+// type
+func (a *Assets) asset(id, typ string) (*manifest.Asset, error) {
+	m, err := a.manifest.Asset(id)
+	if err != nil {
+		return nil, err
+	}
+	if m.Type != typ {
+		return nil, fmt.Errorf("asset %q is a %s, not a %s", id, m.Type, typ)
+	}
+
+	return m, nil
+}
+
+// decodeClips loads the animation clips of a sprite sheet asset, along with
+// an alpha mask if the asset is marked pixel perfect
+func (a *Assets) decodeClips(m *manifest.Asset) (map[string]*Clip, error) {
+	var mask *AlphaMask
+	if m.PixelPerfect {
+		data, err := a.read(m.Path)
+		if err != nil {
+			return nil, err
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding image %q: %v", m.ID, err)
+		}
+		mask = NewAlphaMask(img)
+	}
+
+	data, err := a.read(m.Animations)
+	if err != nil {
+		return nil, err
+	}
+	clips, err := LoadAsepriteClips(data, m.ID, mask)
+	if err != nil {
+		return nil, fmt.Errorf("error loading animations of %q: %v", m.ID, err)
+	}
+
+	return clips, nil
+}
+
+// decodeLevel reads a level asset
+func (a *Assets) decodeLevel(m *manifest.Asset) (*manifest.Level, error) {
+	data, err := a.read(m.Path)
+	if err != nil {
+		return nil, err
+	}
+	l, err := manifest.ParseLevel(data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading level %q: %v", m.ID, err)
+	}
+
+	return l, nil
+}
+
+// generateLevel generates a level the size of the screen
+func generateLevel(algorithm string, seed int64) (*manifest.Level, error) {
+	return levelgen.Generate(levelgen.Options{
+		Algorithm: algorithm,
+		Seed:      seed,
+		Width:     LevelXNum,
+		Height:    LevelYNum,
+		Spawns:    generatedSpawns,
+		Tiles:     levelgen.DefaultTiles,
+	})
+}
@@ -0,0 +1,50 @@
+package sim
+
+type BulletActions uint8
+
+const (
+	BulletLeft BulletActions = 1 << iota
+	BulletRight
+	BulletUp
+	BulletDown
+	BulletHit
+)
+
+func (ba BulletActions) Has(flags BulletActions) bool {
+	return ba&flags != 0
+}
+
+// bulletSpeed is how far a bullet travels in pixels per second
+const bulletSpeed = 300
+
+// SpawnBullet adds a bullet to the world at the given position, travelling
+// in the given direction
+func SpawnBullet(w *World, assets *Assets, x, y float64, a BulletActions) Entity {
+	v := &Velocity{}
+	if a.Has(BulletLeft) {
+		v.X -= bulletSpeed
+	}
+	if a.Has(BulletRight) {
+		v.X += bulletSpeed
+	}
+	if a.Has(BulletUp) {
+		v.Y -= bulletSpeed
+	}
+	if a.Has(BulletDown) {
+		v.Y += bulletSpeed
+	}
+
+	return w.Spawn(Components{
+		Tag:      TagBullet,
+		Position: NewPosition(x, y),
+		Velocity: v,
+		Animator: NewAnimator(assets.Clips("bullet"), "fly"),
+		Collider: &Collider{
+			Layer: LayerPlayerBullet,
+			Mask:  LayerEnemy | LayerWall,
+		},
+		Bounds:  &Bounds{Mode: BoundsDespawn},
+		Emitter: &Emitter{Sound: "shoot"},
+		Trail:   &Trail{Effect: bulletTrailEffect},
+	})
+}
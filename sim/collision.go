@@ -0,0 +1,180 @@
+package sim
+
+import (
+	"image"
+	"math"
+	"sort"
+)
+
+// CollisionLayer is a bitmask of the groups a collider belongs to
+type CollisionLayer uint16
+
+const (
+	LayerPlayer CollisionLayer = 1 << iota
+	LayerEnemy
+	LayerPlayerBullet
+	LayerEnemyBullet
+	LayerPickup
+	LayerWall
+)
+
+// collisionCellSize is the width and height of a spatial hash cell in pixels.
+// It's about the size of the largest sprite so most colliders touch at most
+// four cells.
+const collisionCellSize = 32
+
+// canCollide returns true if either collider is looking for the other's layer
+func canCollide(a, b *Collider) bool {
+	return a.Mask&b.Layer != 0 || b.Mask&a.Layer != 0
+}
+
+type cellKey struct {
+	x, y int
+}
+
+// body is a collider placed in the world
+type body struct {
+	e        Entity
+	hitbox   Hitbox // in world space
+	collider *Collider
+
+	// For pixel perfect colliders, the sprite sheet mask, the top left of the
+	// current frame in the sheet, and where that frame is in the world
+	mask  *AlphaMask
+	frame image.Point
+	x, y  float64
+}
+
+// SpatialHash is a broadphase that buckets colliders into a uniform grid, so
+// each collider is only tested against those in the cells it overlaps rather
+// than against every other collider
+type SpatialHash struct {
+	cellSize float64
+	cells    map[cellKey][]int
+	bodies   []body
+	seen     []int // the last body each body was a candidate for, to skip duplicates
+}
+
+// NewSpatialHash builds an empty spatial hash with square cells of the given
+// size
+func NewSpatialHash(cellSize float64) *SpatialHash {
+	return &SpatialHash{
+		cellSize: cellSize,
+		cells:    make(map[cellKey][]int),
+	}
+}
+
+// Clear removes every body
+func (h *SpatialHash) Clear() {
+	h.bodies = h.bodies[:0]
+}
+
+// cellRange returns the first and last cells covered by a hitbox
+func (h *SpatialHash) cellRange(hb Hitbox) (x0, y0, x1, y1 int) {
+	x, y, width, height := hb.Bounds()
+	x0 = int(math.Floor(x / h.cellSize))
+	y0 = int(math.Floor(y / h.cellSize))
+	x1 = int(math.Floor((x + width) / h.cellSize))
+	y1 = int(math.Floor((y + height) / h.cellSize))
+	return
+}
+
+// Insert adds a body
+func (h *SpatialHash) Insert(b body) {
+	h.bodies = append(h.bodies, b)
+}
+
+// Contacts returns every pair of inserted bodies that overlap and whose
+// layers and masks allow them to collide. Pairs are ordered by the insertion
+// order of their bodies, so the result is deterministic.
+func (h *SpatialHash) Contacts() []Contact {
+	// The grid is rebuilt from scratch, keeping allocated cells for reuse
+	for k, c := range h.cells {
+		h.cells[k] = c[:0]
+	}
+	if cap(h.seen) < len(h.bodies) {
+		h.seen = make([]int, len(h.bodies))
+	}
+	h.seen = h.seen[:len(h.bodies)]
+	for i := range h.seen {
+		h.seen[i] = -1
+	}
+
+	var contacts []Contact
+	var candidates []int
+	for i := range h.bodies {
+		a := &h.bodies[i]
+		x0, y0, x1, y1 := h.cellRange(a.hitbox)
+
+		// Only bodies inserted before this one are in the grid yet, so each
+		// pair is found exactly once
+		candidates = candidates[:0]
+		for cx := x0; cx <= x1; cx++ {
+			for cy := y0; cy <= y1; cy++ {
+				key := cellKey{cx, cy}
+				for _, j := range h.cells[key] {
+					if h.seen[j] == i {
+						continue
+					}
+					h.seen[j] = i
+					candidates = append(candidates, j)
+				}
+				h.cells[key] = append(h.cells[key], i)
+			}
+		}
+
+		sort.Ints(candidates)
+		for _, j := range candidates {
+			b := &h.bodies[j]
+			if !canCollide(a.collider, b.collider) || !overlaps(a, b) {
+				continue
+			}
+			contacts = append(contacts, Contact{b.e, a.e})
+		}
+	}
+
+	return contacts
+}
+
+// overlaps returns true if two bodies' hitboxes intersect and, for pixel
+// perfect bodies, an opaque pixel of one lies on a solid part of the other
+func overlaps(a, b *body) bool {
+	if !a.hitbox.Overlaps(b.hitbox) {
+		return false
+	}
+	if a.mask == nil && b.mask == nil {
+		return true
+	}
+
+	// Test the centre of every pixel in the intersection of the two hitboxes'
+	// bounds
+	ax, ay, aw, ah := a.hitbox.Bounds()
+	bx, by, bw, bh := b.hitbox.Bounds()
+	x0 := int(math.Floor(math.Max(ax, bx)))
+	y0 := int(math.Floor(math.Max(ay, by)))
+	x1 := int(math.Ceil(math.Min(ax+aw, bx+bw)))
+	y1 := int(math.Ceil(math.Min(ay+ah, by+bh)))
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			px, py := float64(x)+0.5, float64(y)+0.5
+			if a.solid(px, py) && b.solid(px, py) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// solid returns true if the world space point is inside the body's hitbox
+// and, if it is pixel perfect, on an opaque pixel of its sprite
+func (b *body) solid(px, py float64) bool {
+	if !b.hitbox.Contains(px, py) {
+		return false
+	}
+	if b.mask == nil {
+		return true
+	}
+
+	return b.mask.Opaque(b.frame.X+int(math.Floor(px-b.x)), b.frame.Y+int(math.Floor(py-b.y)))
+}
@@ -0,0 +1,94 @@
+package sim
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bodiesPerScreen is how many test bodies are scattered over each screen's
+// worth of area, so the number of neighbours each body has, and so the work
+// per body, is the same however many there are
+const bodiesPerScreen = 100
+
+// testBodies scatters n colliders over a square area that grows with n:
+// mostly enemies, with a bullet for every four of them and VaxerMan
+func testBodies(n int) []body {
+	r := rand.New(rand.NewSource(1))
+	colliders := []*Collider{
+		{Layer: LayerPlayer, Mask: LayerEnemy | LayerPickup},
+		{Layer: LayerEnemy, Mask: LayerPlayer | LayerPlayerBullet},
+		{Layer: LayerEnemy, Mask: LayerPlayer | LayerPlayerBullet},
+		{Layer: LayerEnemy, Mask: LayerPlayer | LayerPlayerBullet},
+		{Layer: LayerPlayerBullet, Mask: LayerEnemy},
+	}
+
+	size := ScreenWidth * math.Sqrt(float64(n)/bodiesPerScreen)
+	bodies := make([]body, n)
+	for i := range bodies {
+		x, y := r.Float64()*size, r.Float64()*size
+		bodies[i] = body{
+			e:        Entity(i + 1),
+			hitbox:   RectHitbox(x, y, 12, 12),
+			collider: colliders[i%len(colliders)],
+		}
+	}
+	return bodies
+}
+
+// naiveContacts tests every pair of bodies, in the order Contacts returns
+// them, for comparison with the spatial hash
+func naiveContacts(bodies []body) []Contact {
+	var contacts []Contact
+	for i := range bodies {
+		a := &bodies[i]
+		for j := 0; j < i; j++ {
+			b := &bodies[j]
+			if canCollide(a.collider, b.collider) && overlaps(a, b) {
+				contacts = append(contacts, Contact{b.e, a.e})
+			}
+		}
+	}
+	return contacts
+}
+
+func TestContactsMatchNaive(t *testing.T) {
+	bodies := testBodies(1000)
+	h := NewSpatialHash(collisionCellSize)
+	for _, b := range bodies {
+		h.Insert(b)
+	}
+
+	got, want := h.Contacts(), naiveContacts(bodies)
+	if len(want) == 0 {
+		t.Fatal("no contacts to compare")
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("spatial hash found %d contacts, pairwise %d", len(got), len(want))
+	}
+}
+
+func BenchmarkContacts(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		bodies := testBodies(n)
+
+		b.Run(fmt.Sprintf("hash/%d", n), func(b *testing.B) {
+			h := NewSpatialHash(collisionCellSize)
+			for i := 0; i < b.N; i++ {
+				h.Clear()
+				for _, body := range bodies {
+					h.Insert(body)
+				}
+				h.Contacts()
+			}
+		})
+
+		b.Run(fmt.Sprintf("naive/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				naiveContacts(bodies)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package sim
+
+import "time"
+
+// Tag says what kind of game object an entity is, used when deciding what
+// happens when two entities collide
+type Tag uint8
+
+const (
+	TagNone Tag = iota
+	TagPlayer
+	TagEnemy
+	TagBullet
+	TagPickup
+)
+
+// Position is where an entity is in pixels. PrevX and PrevY hold the position
+// at the previous step, used to interpolate rendering.
+type Position struct {
+	X, Y         float64
+	PrevX, PrevY float64
+}
+
+// NewPosition returns a position that starts at x, y with nothing to
+// interpolate from
+func NewPosition(x, y float64) *Position {
+	return &Position{X: x, Y: y, PrevX: x, PrevY: y}
+}
+
+// Set moves the position without interpolating from the old one
+func (p *Position) Set(x, y float64) {
+	p.X, p.Y = x, y
+	p.PrevX, p.PrevY = x, y
+}
+
+// Velocity is in pixels per second
+type Velocity struct {
+	X, Y float64
+}
+
+// Collider lets other colliders hit an entity. Its shape is the hitbox of
+// the entity's current animation clip, falling back to Hitbox for entities
+// without one. It is in the Layer groups and collides with colliders in the Mask
+// groups. PixelPerfect colliders only count hits on the opaque pixels of
+// their sprite.
+type Collider struct {
+	Hitbox       Hitbox
+	Layer        CollisionLayer
+	Mask         CollisionLayer
+	PixelPerfect bool
+}
+
+// Health is how much damage an entity can take before dying
+type Health struct {
+	Current, Max int
+}
+
+// AIBehaviour decides how an entity with an AI component moves
+type AIBehaviour uint8
+
+const (
+	AIPlayer AIBehaviour = iota // steered by the player's input
+	AIDrift                     // keeps going in a straight line
+	AIChase                     // heads straight for Target
+)
+
+type AI struct {
+	Behaviour AIBehaviour
+	Actions   VaxerManActions // what the player is doing and facing, for AIPlayer
+	Target    Entity          // what to chase, for AIChase
+	Speed     float64         // in pixels per second, for AIChase
+}
+
+// BoundsMode decides what happens to an entity that leaves the screen
+type BoundsMode uint8
+
+const (
+	BoundsDespawn BoundsMode = iota
+	BoundsClamp
+)
+
+type Bounds struct {
+	Mode BoundsMode
+}
+
+// Lifetime despawns an entity once Remaining seconds have passed
+type Lifetime struct {
+	Remaining float64
+}
+
+// Weapon lets an entity fire bullets
+type Weapon struct {
+	Cooldown float64 // minimum seconds between shots
+	Timer    float64 // seconds until the weapon can fire again
+	MaxShots int     // how many of its bullets can be live at once
+}
+
+// CanFire returns true if the weapon has cooled down
+func (w *Weapon) CanFire() bool {
+	return w.Timer <= 0
+}
+
+// Infectious entities damage the player on contact, once or, if Cooldown is
+// set, again every Cooldown seconds
+type Infectious struct {
+	Damage   int
+	Cooldown float64
+	Timer    float64 // seconds until it can infect again
+}
+
+// Pickup entities are collected when VaxerMan touches them, restoring Heal
+// health
+type Pickup struct {
+	Heal int
+}
+
+// Emitter entities make a sound that is heard from where they are. It plays
+// once when the entity appears, or for as long as it has the component if
+// Loop is set. Sound is presentation only and never affects the simulation.
+type Emitter struct {
+	Sound string // asset id
+	Loop  bool
+}
+
+// Trail entities give off particles behind them as they move. Like Emitter,
+// it is presentation only.
+type Trail struct {
+	Effect *ParticleEffect
+}
+
+// Tint entities are drawn with their sprite's colours scaled, to tell them
+// apart from others with the same sprite. It is presentation only.
+type Tint struct {
+	R, G, B float64
+}
+
+// Flash entities are drawn white until Until, to show they've been hurt. It
+// is presentation only.
+type Flash struct {
+	Until time.Time
+}
@@ -0,0 +1,115 @@
+package sim
+
+// Entity identifies a game object in a World. What an entity is and does is
+// decided entirely by which components it has.
+type Entity uint32
+
+// Components is the set of components an entity can have. A nil field means
+// the entity doesn't have that component.
+type Components struct {
+	Tag        Tag
+	Position   *Position
+	Velocity   *Velocity
+	Animator   *Animator
+	Collider   *Collider
+	Health     *Health
+	AI         *AI
+	Bounds     *Bounds
+	Lifetime   *Lifetime
+	Weapon     *Weapon
+	Infectious *Infectious
+	Pickup     *Pickup
+	Emitter    *Emitter
+	Trail      *Trail
+	Tint       *Tint
+	Flash      *Flash
+}
+
+// World holds every entity and its components. Entities are always visited
+// in the order they were spawned, which keeps the simulation deterministic
+// for replays.
+type World struct {
+	nextID     Entity
+	entities   []Entity
+	components map[Entity]*Components
+	despawned  map[Entity]bool
+}
+
+// NewWorld builds an empty world
+func NewWorld() *World {
+	return &World{
+		nextID:     1,
+		components: make(map[Entity]*Components),
+		despawned:  make(map[Entity]bool),
+	}
+}
+
+// Spawn adds a new entity with the given components
+func (w *World) Spawn(c Components) Entity {
+	e := w.nextID
+	w.nextID++
+
+	w.entities = append(w.entities, e)
+	w.components[e] = &c
+
+	return e
+}
+
+// Despawn marks an entity for removal. It stays visible to systems until the
+// end of the current step.
+func (w *World) Despawn(e Entity) {
+	if _, ok := w.components[e]; ok {
+		w.despawned[e] = true
+	}
+}
+
+// Get returns the components of an entity, or nil if it doesn't exist
+func (w *World) Get(e Entity) *Components {
+	return w.components[e]
+}
+
+// Alive returns true if the entity exists and hasn't been despawned
+func (w *World) Alive(e Entity) bool {
+	_, ok := w.components[e]
+	return ok && !w.despawned[e]
+}
+
+// Each calls fn for every live entity in spawn order
+func (w *World) Each(fn func(e Entity, c *Components)) {
+	for _, e := range w.entities {
+		if w.despawned[e] {
+			continue
+		}
+		fn(e, w.components[e])
+	}
+}
+
+// Count returns how many live entities have the given tag
+func (w *World) Count(tag Tag) int {
+	n := 0
+	w.Each(func(e Entity, c *Components) {
+		if c.Tag == tag {
+			n++
+		}
+	})
+
+	return n
+}
+
+// Flush removes all despawned entities
+func (w *World) Flush() {
+	if len(w.despawned) == 0 {
+		return
+	}
+
+	live := w.entities[:0]
+	for _, e := range w.entities {
+		if w.despawned[e] {
+			delete(w.components, e)
+			continue
+		}
+		live = append(live, e)
+	}
+	w.entities = live
+	w.despawned = make(map[Entity]bool)
+}
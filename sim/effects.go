@@ -0,0 +1,102 @@
+package sim
+
+import (
+	"image/color"
+	"math"
+)
+
+// ParticleEffect describes the particles an effect gives off. Spreads are
+// the most a value can vary from its base either way.
+type ParticleEffect struct {
+	Count int     // particles in a burst
+	Rate  float64 // particles per second given off by a trail
+
+	Lifetime, LifetimeSpread float64 // seconds
+	Speed, SpeedSpread       float64 // pixels per second
+	Spread                   float64 // radians either side of the direction
+
+	Gravity float64 // downwards acceleration in pixels per second squared
+	Drag    float64 // fraction of velocity lost per second
+	Size    float64 // width and height in pixels
+
+	// Colour fades from From to To over a particle's life, alpha included
+	From, To color.RGBA
+}
+
+// Particle effects
+var (
+	virusBurstEffect = &ParticleEffect{
+		Count:    24,
+		Lifetime: 0.6, LifetimeSpread: 0.3,
+		Speed: 60, SpeedSpread: 40,
+		Spread:  math.Pi,
+		Gravity: 40,
+		Drag:    2,
+		Size:    2,
+		From:    color.RGBA{R: 144, G: 248, B: 168, A: 255},
+		To:      color.RGBA{R: 40, G: 104, B: 32, A: 0},
+	}
+	bulletImpactEffect = &ParticleEffect{
+		Count:    8,
+		Lifetime: 0.25, LifetimeSpread: 0.1,
+		Speed: 90, SpeedSpread: 40,
+		Spread: math.Pi / 3,
+		Drag:   4,
+		Size:   1,
+		From:   color.RGBA{R: 255, G: 255, A: 255},
+		To:     color.RGBA{R: 255, A: 0},
+	}
+	infectionEffect = &ParticleEffect{
+		Count:    20,
+		Lifetime: 0.8, LifetimeSpread: 0.3,
+		Speed: 40, SpeedSpread: 20,
+		Spread:  math.Pi,
+		Gravity: -30,
+		Drag:    1,
+		Size:    2,
+		From:    color.RGBA{R: 248, G: 88, B: 80, A: 255},
+		To:      color.RGBA{R: 120, G: 20, B: 20, A: 0},
+	}
+	pickupEffect = &ParticleEffect{
+		Count:    16,
+		Lifetime: 0.5, LifetimeSpread: 0.2,
+		Speed: 50, SpeedSpread: 10,
+		Spread:  math.Pi,
+		Gravity: -60,
+		Drag:    3,
+		Size:    1,
+		From:    color.RGBA{R: 255, G: 255, B: 160, A: 255},
+		To:      color.RGBA{R: 255, G: 200, A: 0},
+	}
+	cleanEffect = &ParticleEffect{
+		Count:    12,
+		Lifetime: 0.5, LifetimeSpread: 0.2,
+		Speed: 30, SpeedSpread: 15,
+		Spread:  math.Pi,
+		Gravity: -40,
+		Drag:    2,
+		Size:    1,
+		From:    color.RGBA{R: 200, G: 240, B: 255, A: 255},
+		To:      color.RGBA{R: 120, G: 200, B: 255, A: 0},
+	}
+	debrisEffect = &ParticleEffect{
+		Count:    16,
+		Lifetime: 0.5, LifetimeSpread: 0.2,
+		Speed: 70, SpeedSpread: 30,
+		Spread:  math.Pi,
+		Gravity: 200,
+		Drag:    1,
+		Size:    2,
+		From:    color.RGBA{R: 162, G: 106, B: 69, A: 255},
+		To:      color.RGBA{R: 97, G: 45, B: 39, A: 0},
+	}
+	bulletTrailEffect = &ParticleEffect{
+		Rate:     60,
+		Lifetime: 0.2, LifetimeSpread: 0.05,
+		Speed: 15, SpeedSpread: 10,
+		Spread: math.Pi / 4,
+		Size:   1,
+		From:   color.RGBA{R: 255, G: 153, A: 200},
+		To:     color.RGBA{R: 255, A: 0},
+	}
+)
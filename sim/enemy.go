@@ -0,0 +1,127 @@
+package sim
+
+import (
+	"math/rand"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+const (
+	// MaxEnemies sets the limit of enemies that can be 'alive' at any one time
+	MaxEnemies = 3
+
+	// enemySpeedStep is the unit enemy speeds are picked in, in pixels per
+	// second
+	enemySpeedStep = 60
+
+	// enemyDamage is how much health VaxerMan loses when infected
+	enemyDamage = 10
+
+	// bossCooldown is how many seconds a boss waits before it can infect
+	// VaxerMan again
+	bossCooldown = 1.0
+)
+
+// bossTint is the colour bosses are tinted to stand out from other viruses
+var bossTint = Tint{R: 1, G: 0.35, B: 0.35}
+
+// SpawnEnemy adds an enemy to the world at the given position and velocity
+func SpawnEnemy(w *World, a *Assets, x, y, vx, vy float64) Entity {
+	return w.Spawn(Components{
+		Tag:      TagEnemy,
+		Position: NewPosition(x, y),
+		Velocity: &Velocity{X: vx, Y: vy},
+		Animator: NewAnimator(a.Clips("enemy"), "alive"),
+		Collider: &Collider{
+			Layer: LayerEnemy,
+			Mask:  LayerPlayer | LayerPlayerBullet | LayerWall,
+		},
+		AI:         &AI{Behaviour: AIDrift},
+		Bounds:     &Bounds{Mode: BoundsDespawn},
+		Infectious: &Infectious{Damage: enemyDamage},
+		Emitter:    &Emitter{Sound: "hum", Loop: true},
+	})
+}
+
+// SpawnBoss adds a boss to the world at the given position: a virus that
+// chases target and takes b.Health bullets to kill
+func SpawnBoss(w *World, a *Assets, b *manifest.Boss, target Entity, x, y float64) Entity {
+	tint := bossTint
+	return w.Spawn(Components{
+		Tag:      TagEnemy,
+		Position: NewPosition(x, y),
+		Velocity: &Velocity{},
+		Animator: NewAnimator(a.Clips("enemy"), "alive"),
+		Collider: &Collider{
+			Layer: LayerEnemy,
+			Mask:  LayerPlayer | LayerPlayerBullet | LayerWall,
+		},
+		Health: &Health{Current: b.Health, Max: b.Health},
+		AI: &AI{
+			Behaviour: AIChase,
+			Target:    target,
+			Speed:     b.Speed,
+		},
+		Bounds:     &Bounds{Mode: BoundsClamp},
+		Infectious: &Infectious{Damage: b.Damage, Cooldown: bossCooldown},
+		Emitter:    &Emitter{Sound: "hum", Loop: true},
+		Tint:       &tint,
+	})
+}
+
+// HitEnemy plays the enemy's hit animation through once before it dies. It
+// can't infect or be shot again in the meantime.
+func HitEnemy(c *Components) {
+	c.Collider = nil
+	c.Infectious = nil
+	c.Emitter = nil
+	c.Animator.PlayOnce("hit", func(w *World, e Entity) {
+		w.Despawn(e)
+	})
+}
+
+// GenerateEnemyStartPos randomly generates position and velocity values
+func GenerateEnemyStartPos(rand *rand.Rand) (x, y, vx, vy float64) {
+	coinFlipOne := rand.Intn(2)
+	coinFlipTwo := rand.Intn(2)
+	if coinFlipOne == 1 {
+		x = float64(rand.Intn(ScreenWidth))
+		if coinFlipTwo == 1 {
+			y = 0
+		} else {
+			y = ScreenHeight
+		}
+	} else {
+		y = float64(rand.Intn(ScreenHeight))
+		if coinFlipTwo == 1 {
+			x = 0
+		} else {
+			x = ScreenWidth
+		}
+	}
+	vx = float64(rand.Intn(3-1)+1) * enemySpeedStep
+	vy = float64(rand.Intn(3-1)+1) * enemySpeedStep
+	if x > ScreenWidth/2 {
+		vx *= -1
+	}
+	if y > ScreenHeight/2 {
+		vy *= -1
+	}
+
+	return
+}
+
+// GenerateEnemyVelocity randomly generates a velocity heading diagonally in
+// any direction
+func GenerateEnemyVelocity(rand *rand.Rand) (vx, vy float64) {
+	vx = float64(rand.Intn(2)+1) * enemySpeedStep
+	vy = float64(rand.Intn(2)+1) * enemySpeedStep
+	if rand.Intn(2) == 1 {
+		vx *= -1
+	}
+	if rand.Intn(2) == 1 {
+		vy *= -1
+	}
+
+	return
+}
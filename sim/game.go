@@ -0,0 +1,487 @@
+// Package sim is the game's simulation: VaxerMan, the viruses and the level
+// they play out on, stepped at a fixed rate from the player's inputs. It
+// never draws, plays sounds or reads the keyboard, and doesn't import ebiten,
+// so a run can be replayed and verified without a window or a display.
+package sim
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+// Simulation timing constants. The simulation always advances in steps of
+// Dt seconds, however often the game draws, so movement and animation behave
+// the same at any display refresh rate.
+const (
+	Rate = 60
+	Dt   = 1.0 / Rate
+)
+
+// Scoring constants
+const (
+	pointsPerKill = 10
+	killsPerWave  = 10
+)
+
+// Feedback shows the player what happens in the simulation: sounds,
+// particles and screen effects. The simulation never reads anything back
+// from it, so headless games, such as replays being verified, leave it nil.
+type Feedback interface {
+	PlaySound(id string)                               // a sound effect, centred
+	PlaySoundFrom(id string, x, y float64)             // a sound effect from x, y
+	Burst(effect *ParticleEffect, x, y, angle float64) // particles from x, y heading in the direction of angle
+	Killed(enemy *Components)                          // an enemy was shot dead
+	Wounded(enemy *Components)                         // an enemy was shot but not killed
+	Infected(vaxerman *Components)                     // VaxerMan was hurt
+}
+
+// Game is one run of a level, from VaxerMan appearing until he dies or the
+// level is won
+type Game struct {
+	assets   *Assets
+	feedback Feedback // nil when headless
+
+	world      *World
+	vaxerman   Entity
+	levelID    string
+	level      *Level
+	boss       Entity // the boss, on levels with a boss goal
+	broadphase *SpatialHash
+
+	// All randomness in the simulation comes from rand, seeded with seed, so
+	// that a run can be reproduced from its seed and inputs
+	seed  int64
+	rand  *rand.Rand
+	ticks int
+
+	score int
+	kills int
+	wave  int
+	won   bool // the level's goal has been met
+
+	replay *Replay // inputs recorded for the run
+}
+
+// NewGame starts a run of the level with the given asset id, whose assets
+// must already be loaded, seeded with seed. feedback may be nil to run
+// headless.
+func NewGame(a *Assets, level string, seed int64, feedback Feedback) *Game {
+	g := &Game{
+		assets:     a,
+		feedback:   feedback,
+		world:      NewWorld(),
+		levelID:    level,
+		level:      NewLevel(level, a.Level(level)),
+		broadphase: NewSpatialHash(collisionCellSize),
+		seed:       seed,
+		rand:       rand.New(rand.NewSource(seed)),
+		wave:       1,
+		replay:     &Replay{Seed: seed, Level: level},
+	}
+
+	g.vaxerman = SpawnVaxerMan(g.world, a, ScreenWidth/2, ScreenHeight/2)
+	if s := g.level.startCell; s != nil {
+		if i, ok := g.level.cellIndex(*s); ok {
+			standOn(g.world.Get(g.vaxerman), i)
+		}
+	}
+	if goal := g.level.goal; goal != nil && goal.Kind == manifest.GoalBoss {
+		g.boss = g.spawnBoss(goal.Boss)
+	}
+
+	return g
+}
+
+// NewHeadlessGame loads the assets a level needs into a, then starts a run of
+// it that is only stepped, never drawn, and which makes no sound
+func NewHeadlessGame(a *Assets, level string, seed int64) (*Game, error) {
+	ids, err := a.Required(level)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.LoadAll(ids); err != nil {
+		return nil, err
+	}
+
+	return NewGame(a, level, seed, nil), nil
+}
+
+// Step advances the simulation by one fixed step of Dt seconds using the
+// given input, which is recorded in the run's replay until the run is over
+func (g *Game) Step(in Input) {
+	if !g.Over() {
+		g.replay.Inputs = append(g.replay.Inputs, in)
+	}
+	g.ticks++
+
+	aiSystem(g.world, g.assets, in, Dt)
+	infectionSlowSystem(g.world, g.level)
+	movementSystem(g.world, Dt)
+	boundsSystem(g.world)
+	tileCollisionSystem(g.world, g.level)
+	g.handleAnimationEvents(animationSystem(g.world, Dt))
+	lifetimeSystem(g.world, Dt)
+	infectiousSystem(g.world, Dt)
+	g.handleTileEvents(tileHitSystem(g.world, g.level))
+	g.resolveContacts(collisionSystem(g.world, g.broadphase))
+	g.spawnEnemies()
+	g.updateInfection()
+	g.checkGoal()
+
+	g.world.Flush()
+}
+
+// World returns the entities of the run
+func (g *Game) World() *World {
+	return g.world
+}
+
+// VaxerMan returns the player's entity
+func (g *Game) VaxerMan() Entity {
+	return g.vaxerman
+}
+
+// Boss returns the boss's entity, on levels with a boss goal
+func (g *Game) Boss() Entity {
+	return g.boss
+}
+
+// Level returns the level being played
+func (g *Game) Level() *Level {
+	return g.level
+}
+
+// LevelID returns the asset id of the level being played
+func (g *Game) LevelID() string {
+	return g.levelID
+}
+
+// Ticks returns how many steps the run has taken
+func (g *Game) Ticks() int {
+	return g.ticks
+}
+
+// Score returns the points scored so far
+func (g *Game) Score() int {
+	return g.score
+}
+
+// Kills returns how many viruses have been shot
+func (g *Game) Kills() int {
+	return g.kills
+}
+
+// Wave returns the wave of viruses being fought
+func (g *Game) Wave() int {
+	return g.wave
+}
+
+// Won returns true once the level's goal has been met
+func (g *Game) Won() bool {
+	return g.won
+}
+
+// VaxerManDead returns true once VaxerMan has been infected too many times
+func (g *Game) VaxerManDead() bool {
+	return IsVaxerManDead(g.world.Get(g.vaxerman))
+}
+
+// Over returns true once VaxerMan is dead or the level is won
+func (g *Game) Over() bool {
+	return g.won || g.VaxerManDead()
+}
+
+// Replay returns the replay of the run, with the result it has got so far
+func (g *Game) Replay() *Replay {
+	g.replay.Score = g.score
+	g.replay.Wave = g.wave
+	g.replay.Hash = g.StateHash()
+	return g.replay
+}
+
+// playSound plays the sound effect with the given asset id, unless the game
+// is headless
+func (g *Game) playSound(id string) {
+	if g.feedback == nil {
+		return
+	}
+
+	g.feedback.PlaySound(id)
+}
+
+// playSoundAt plays the sound effect with the given asset id from the centre
+// of an entity, unless the game is headless
+func (g *Game) playSoundAt(id string, c *Components) {
+	x, y := EntityCentre(c)
+	g.playSoundFrom(id, x, y)
+}
+
+// playSoundFrom plays the sound effect with the given asset id from x, y,
+// unless the game is headless
+func (g *Game) playSoundFrom(id string, x, y float64) {
+	if g.feedback == nil {
+		return
+	}
+
+	g.feedback.PlaySoundFrom(id, x, y)
+}
+
+// burst gives off an effect's particles from the centre of an entity, unless
+// the game is headless
+func (g *Game) burst(effect *ParticleEffect, c *Components, angle float64) {
+	x, y := EntityCentre(c)
+	g.burstAt(effect, x, y, angle)
+}
+
+// burstAt gives off an effect's particles from x, y, unless the game is
+// headless
+func (g *Game) burstAt(effect *ParticleEffect, x, y, angle float64) {
+	if g.feedback == nil {
+		return
+	}
+
+	g.feedback.Burst(effect, x, y, angle)
+}
+
+// killFeedback shows that an enemy was shot dead, unless the game is
+// headless
+func (g *Game) killFeedback(enemy *Components) {
+	if g.feedback == nil {
+		return
+	}
+
+	g.feedback.Killed(enemy)
+}
+
+// woundFeedback shows that an enemy was shot but not killed, unless the game
+// is headless
+func (g *Game) woundFeedback(enemy *Components) {
+	if g.feedback == nil {
+		return
+	}
+
+	g.feedback.Wounded(enemy)
+}
+
+// infectionFeedback shows that VaxerMan was hurt, unless the game is
+// headless
+func (g *Game) infectionFeedback(vaxerman *Components) {
+	if g.feedback == nil {
+		return
+	}
+
+	g.feedback.Infected(vaxerman)
+}
+
+// resolveContacts applies the game rules to colliding entities: enemies
+// infect VaxerMan, bullets kill enemies and VaxerMan collects pickups
+func (g *Game) resolveContacts(contacts []Contact) {
+	for _, contact := range contacts {
+		a, b := contact.A, contact.B
+		ac, bc := g.world.Get(a), g.world.Get(b)
+		if ac.Tag > bc.Tag {
+			a, b = b, a
+			ac, bc = bc, ac
+		}
+
+		switch {
+		case ac.Tag == TagPlayer && bc.Tag == TagEnemy:
+			// Most enemies can only infect VaxerMan once; those with a
+			// cooldown can again once it has passed
+			if bc.Infectious == nil || bc.Infectious.Timer > 0 || IsVaxerManDead(ac) {
+				continue
+			}
+			g.playSound("sneeze")
+			g.burst(infectionEffect, ac, 0)
+			g.infectionFeedback(ac)
+			InfectVaxerMan(ac, bc.Infectious.Damage)
+			if bc.Infectious.Cooldown > 0 {
+				bc.Infectious.Timer = bc.Infectious.Cooldown
+			} else {
+				bc.Infectious = nil
+			}
+
+		case ac.Tag == TagEnemy && bc.Tag == TagBullet:
+			if ac.Collider == nil || !g.world.Alive(b) {
+				continue
+			}
+			// Enemies with health, such as bosses, take a bullet off it
+			// until they run out
+			if h := ac.Health; h != nil {
+				h.Current--
+				if h.Current > 0 {
+					g.burst(bulletImpactEffect, bc, math.Atan2(-bc.Velocity.Y, -bc.Velocity.X))
+					g.woundFeedback(ac)
+					g.world.Despawn(b)
+					continue
+				}
+			}
+			HitEnemy(ac)
+			g.burst(virusBurstEffect, ac, 0)
+			g.burst(bulletImpactEffect, bc, math.Atan2(-bc.Velocity.Y, -bc.Velocity.X))
+			g.killFeedback(ac)
+			g.world.Despawn(b)
+			g.addKill()
+
+		case ac.Tag == TagPlayer && bc.Tag == TagPickup:
+			if IsVaxerManDead(ac) || !g.world.Alive(b) {
+				continue
+			}
+			HealVaxerMan(ac, bc.Pickup.Heal)
+			g.playSound("pickup")
+			g.burst(pickupEffect, bc, -math.Pi/2)
+			g.world.Despawn(b)
+		}
+	}
+}
+
+// handleTileEvents applies the game rules to tiles hit by bullets: broken
+// crates drop their pickups
+func (g *Game) handleTileEvents(events []TileEvent) {
+	for _, event := range events {
+		x, y := TileCentre(event.Cell)
+		switch event.Kind {
+		case TileBlocked, TileDamaged:
+			if c := g.world.Get(event.Bullet); c != nil {
+				g.burst(bulletImpactEffect, c, math.Atan2(-c.Velocity.Y, -c.Velocity.X))
+			}
+		case TileCleaned:
+			g.burstAt(cleanEffect, x, y, -math.Pi/2)
+		case TileBroken:
+			g.burstAt(debrisEffect, x, y, -math.Pi/2)
+			g.playSoundFrom("boom", x, y)
+			if event.Behaviour.Drop != "" {
+				SpawnPickup(g.world, event.Behaviour.Drop, x, y)
+			}
+		case TileSwitched:
+			g.playSoundFrom("switch", x, y)
+		case TileOpened:
+			g.burstAt(debrisEffect, x, y, -math.Pi/2)
+		case TileDisinfected:
+			g.burstAt(cleanEffect, x, y, -math.Pi/2)
+		}
+	}
+}
+
+// handleAnimationEvents applies the game rules to animation frame events
+func (g *Game) handleAnimationEvents(events []AnimationEvent) {
+	for _, event := range events {
+		switch event.Name {
+		case "burst":
+			if c := g.world.Get(event.Entity); c != nil {
+				g.playSoundAt("boom", c)
+			}
+		}
+	}
+}
+
+// spawnEnemies randomly brings in a new enemy from one of the level's spawn
+// points, or from the edge of the screen if it has none
+func (g *Game) spawnEnemies() {
+	if g.world.Count(TagEnemy) >= g.maxEnemies() || g.rand.Intn(20) != 1 {
+		return
+	}
+
+	if spawns := g.level.spawns; len(spawns) > 0 {
+		if i, ok := g.level.cellIndex(spawns[g.rand.Intn(len(spawns))]); ok {
+			g.spawnEnemyAt(i)
+		}
+		return
+	}
+	x, y, vx, vy := GenerateEnemyStartPos(g.rand)
+	SpawnEnemy(g.world, g.assets, x, y, vx, vy)
+}
+
+// spawnEnemyAt spawns an enemy in the middle of cell i, heading off in a
+// random direction
+func (g *Game) spawnEnemyAt(i int) {
+	r := g.assets.Clips("enemy")["alive"].frames[0].rect
+	x, y := TileCentre(i)
+	vx, vy := GenerateEnemyVelocity(g.rand)
+	SpawnEnemy(g.world, g.assets, x-float64(r.Dx())/2, y-float64(r.Dy())/2, vx, vy)
+}
+
+// spawnBoss brings in a level's boss at its first spawn point, or at the top
+// middle of the screen if it has none
+func (g *Game) spawnBoss(b *manifest.Boss) Entity {
+	r := g.assets.Clips("enemy")["alive"].frames[0].rect
+	x, y := float64(ScreenWidth-r.Dx())/2, 0.0
+	if len(g.level.spawns) > 0 {
+		if i, ok := g.level.cellIndex(g.level.spawns[0]); ok {
+			x, y = TileCentre(i)
+			x, y = x-float64(r.Dx())/2, y-float64(r.Dy())/2
+		}
+	}
+	return SpawnBoss(g.world, g.assets, b, g.vaxerman, x, y)
+}
+
+// checkGoal completes the level once its goal is met: enough waves survived,
+// the boss killed, the exit reached or enough of the level disinfected
+func (g *Game) checkGoal() {
+	goal := g.level.goal
+	vm := g.world.Get(g.vaxerman)
+	if goal == nil || g.won || IsVaxerManDead(vm) {
+		return
+	}
+
+	switch goal.Kind {
+	case manifest.GoalWaves:
+		g.won = g.wave > goal.Waves
+	case manifest.GoalBoss:
+		// A boss loses its collider once killed, before it despawns
+		boss := g.world.Get(g.boss)
+		g.won = boss == nil || boss.Collider == nil
+	case manifest.GoalExit:
+		// Level files are checked for an exit, but a level being edited can
+		// be without one
+		if g.level.exitCell == nil {
+			return
+		}
+		exit, ok := g.level.cellIndex(*g.level.exitCell)
+		if i, on := g.level.feetCell(vm); ok && on && i == exit {
+			g.won = true
+		}
+	case manifest.GoalCleanse:
+		g.won = g.level.Disinfected() >= goal.Clean
+	}
+}
+
+// updateInfection spreads the infection over the level every infectionTicks
+// steps. Heavily infected cells hurt VaxerMan and spawn viruses.
+func (g *Game) updateInfection() {
+	rules := g.level.infectionRules
+	if rules == nil || g.ticks%infectionTicks != 0 {
+		return
+	}
+
+	g.level.spreadInfection(g.rand)
+
+	vm := g.world.Get(g.vaxerman)
+	if rules.Damage > 0 && g.ticks%Rate == 0 && !IsVaxerManDead(vm) && g.level.infectionUnder(vm) >= infectionHeavy {
+		g.infectionFeedback(vm)
+		InfectVaxerMan(vm, rules.Damage)
+	}
+
+	if rules.Spawn > 0 && g.world.Count(TagEnemy) < g.maxEnemies() && g.rand.Float64() < rules.Spawn*infectionTicks*Dt {
+		x, y := EntityCentre(vm)
+		if i, ok := g.level.heavyCell(g.rand, x, y); ok {
+			g.spawnEnemyAt(i)
+		}
+	}
+}
+
+// addKill scores a shot enemy, moving on to the next wave every killsPerWave
+// kills
+func (g *Game) addKill() {
+	g.kills++
+	g.score += pointsPerKill * g.wave
+	g.wave = g.kills/killsPerWave + 1
+}
+
+// maxEnemies returns how many enemies may be alive at once in the current
+// wave
+func (g *Game) maxEnemies() int {
+	return MaxEnemies + g.wave - 1
+}
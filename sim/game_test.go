@@ -0,0 +1,27 @@
+package sim
+
+import "testing"
+
+func TestExitGoalWithoutExit(t *testing.T) {
+	const level = "generated:caves:42"
+	a, err := NewPackedAssets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewHeadlessGame(a, level, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// As left by the editor once the exit is removed
+	edited := *a.Level(level)
+	edited.Exit = nil
+	a.ReplaceLevel(level, &edited)
+
+	g := NewGame(a, level, 1, nil)
+	for i := 0; i < Rate; i++ {
+		g.Step(0)
+	}
+	if g.Won() {
+		t.Error("level without an exit was won")
+	}
+}
@@ -0,0 +1,125 @@
+package sim
+
+import (
+	"image"
+	"math"
+)
+
+// HitboxShape is the kind of shape a hitbox is
+type HitboxShape uint8
+
+const (
+	HitboxRect HitboxShape = iota
+	HitboxCircle
+)
+
+// Hitbox is a collision shape relative to the top left of an entity's sprite
+// frame. Rects use X, Y as their top left corner and Width, Height as their
+// size; circles use X, Y as their centre and Radius.
+type Hitbox struct {
+	Shape         HitboxShape
+	X, Y          float64
+	Width, Height float64
+	Radius        float64
+}
+
+// RectHitbox returns a rectangular hitbox inset into a sprite frame
+func RectHitbox(x, y, width, height float64) Hitbox {
+	return Hitbox{Shape: HitboxRect, X: x, Y: y, Width: width, Height: height}
+}
+
+// CircleHitbox returns a circular hitbox centred at x, y in a sprite frame
+func CircleHitbox(x, y, radius float64) Hitbox {
+	return Hitbox{Shape: HitboxCircle, X: x, Y: y, Radius: radius}
+}
+
+// IsZero returns true if no hitbox has been defined
+func (h Hitbox) IsZero() bool {
+	return h == Hitbox{}
+}
+
+// Bounds returns the box enclosing the hitbox
+func (h Hitbox) Bounds() (x, y, width, height float64) {
+	if h.Shape == HitboxCircle {
+		return h.X - h.Radius, h.Y - h.Radius, h.Radius * 2, h.Radius * 2
+	}
+
+	return h.X, h.Y, h.Width, h.Height
+}
+
+// Translate returns the hitbox moved by dx, dy
+func (h Hitbox) Translate(dx, dy float64) Hitbox {
+	h.X += dx
+	h.Y += dy
+	return h
+}
+
+// Contains returns true if the point is inside the hitbox
+func (h Hitbox) Contains(px, py float64) bool {
+	if h.Shape == HitboxCircle {
+		dx, dy := px-h.X, py-h.Y
+		return dx*dx+dy*dy <= h.Radius*h.Radius
+	}
+
+	return px >= h.X && px < h.X+h.Width && py >= h.Y && py < h.Y+h.Height
+}
+
+// Overlaps returns true if two hitboxes in the same space intersect
+func (h Hitbox) Overlaps(o Hitbox) bool {
+	switch {
+	case h.Shape == HitboxRect && o.Shape == HitboxRect:
+		return h.X < o.X+o.Width && o.X < h.X+h.Width &&
+			h.Y < o.Y+o.Height && o.Y < h.Y+h.Height
+	case h.Shape == HitboxCircle && o.Shape == HitboxCircle:
+		dx, dy := h.X-o.X, h.Y-o.Y
+		r := h.Radius + o.Radius
+		return dx*dx+dy*dy < r*r
+	case h.Shape == HitboxCircle:
+		return o.Overlaps(h)
+	}
+
+	// Rect against circle: find the closest point of the rect to the centre
+	cx := math.Max(h.X, math.Min(o.X, h.X+h.Width))
+	cy := math.Max(h.Y, math.Min(o.Y, h.Y+h.Height))
+	dx, dy := o.X-cx, o.Y-cy
+	return dx*dx+dy*dy < o.Radius*o.Radius
+}
+
+// AlphaMask records which pixels of an image are opaque enough to collide
+// with
+type AlphaMask struct {
+	width, height int
+	opaque        []bool
+}
+
+// alphaThreshold is the alpha above which a pixel counts as solid
+const alphaThreshold = 0x8000
+
+// NewAlphaMask builds a mask from a decoded image. Masks are built from the
+// source image rather than the ebiten image, so they can be used headlessly.
+func NewAlphaMask(img image.Image) *AlphaMask {
+	b := img.Bounds()
+	m := &AlphaMask{
+		width:  b.Dx(),
+		height: b.Dy(),
+		opaque: make([]bool, b.Dx()*b.Dy()),
+	}
+	for y := 0; y < m.height; y++ {
+		for x := 0; x < m.width; x++ {
+			_, _, _, a := img.At(b.Min.X+x, b.Min.Y+y).RGBA()
+			m.opaque[y*m.width+x] = a > alphaThreshold
+		}
+	}
+
+	return m
+}
+
+// Opaque returns true if the pixel at x, y is solid. Pixels outside the
+// image are never solid.
+func (m *AlphaMask) Opaque(x, y int) bool {
+	if x < 0 || y < 0 || x >= m.width || y >= m.height {
+		return false
+	}
+
+	return m.opaque[y*m.width+x]
+}
@@ -0,0 +1,178 @@
+package sim
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+// Infection constants
+const (
+	// InfectionMax is how infected a fully infected cell is
+	InfectionMax = 100
+
+	// infectionHeavy is how infected a cell must be to spread the virus,
+	// spawn viruses and hurt VaxerMan
+	infectionHeavy = InfectionMax * 3 / 4
+
+	// infectionTicks is how many simulation steps pass between each spread
+	// of the infection
+	infectionTicks = Rate / 4
+
+	// infectionSpawnDistance is how close, in pixels, to VaxerMan a virus can
+	// crawl out of the floor
+	infectionSpawnDistance = 48
+)
+
+// seedInfection starts the infection of a level, with the cells holding a
+// contaminated tile fully infected
+func (l *Level) seedInfection() {
+	l.infection = make([]int, len(l.layers[0]))
+	l.spread = make([]int, len(l.layers[0]))
+	for i := range l.infection {
+		if _, b := l.behaviourAt(i); b != nil && b.Kind == manifest.TileContaminated {
+			l.infection[i] = InfectionMax
+		}
+	}
+}
+
+// spreadInfection advances the infection by infectionTicks steps. Infected
+// cells grow worse, and heavily infected ones may infect the clean cells
+// beside them. Solid cells never catch it.
+func (l *Level) spreadInfection(r *rand.Rand) {
+	rules := l.infectionRules
+	dt := infectionTicks * Dt
+	grow := int(math.Round(rules.Grow * InfectionMax * dt))
+	chance := rules.Spread * dt
+
+	next := l.spread
+	for i, v := range l.infection {
+		if v > 0 {
+			v += grow
+			if v > InfectionMax {
+				v = InfectionMax
+			}
+		}
+		next[i] = v
+	}
+
+	cols, rows := LevelXNum, l.Rows()
+	for i, v := range l.infection {
+		if v < infectionHeavy {
+			continue
+		}
+		c, row := i%cols, i/cols
+		for _, n := range [4][2]int{{c, row - 1}, {c - 1, row}, {c + 1, row}, {c, row + 1}} {
+			if n[0] < 0 || n[0] >= cols || n[1] < 0 || n[1] >= rows {
+				continue
+			}
+			j := n[1]*cols + n[0]
+			if next[j] > 0 || l.isSolid(j) {
+				continue
+			}
+			if r.Float64() < chance {
+				next[j] = grow
+				if next[j] < 1 {
+					next[j] = 1
+				}
+			}
+		}
+	}
+
+	l.infection, l.spread = next, l.infection
+}
+
+// cleanse removes some of the infection from cell i, returning what
+// happened, or nil if it wasn't infected. A contaminated tile is cleaned once
+// its cell is clean.
+func (l *Level) cleanse(i int, bullet Entity) []TileEvent {
+	if l.infection == nil || l.infection[i] == 0 {
+		return nil
+	}
+
+	amount := int(math.Round(l.infectionRules.Cleanse * InfectionMax))
+	if amount < 1 {
+		amount = 1
+	}
+	l.infection[i] -= amount
+	if l.infection[i] > 0 {
+		return []TileEvent{{Kind: TileDisinfected, Cell: i, Bullet: bullet}}
+	}
+
+	l.infection[i] = 0
+	if layer, b := l.behaviourAt(i); b != nil && b.Kind == manifest.TileContaminated {
+		l.setTile(layer, i, b.Becomes)
+		return []TileEvent{{Kind: TileCleaned, Cell: i, Behaviour: b, Bullet: bullet}}
+	}
+	return []TileEvent{{Kind: TileDisinfected, Cell: i, Bullet: bullet}}
+}
+
+// infectionUnder returns how infected the cell under an entity's feet is
+func (l *Level) infectionUnder(c *Components) int {
+	if l.infection == nil {
+		return 0
+	}
+
+	i, ok := l.feetCell(c)
+	if !ok {
+		return 0
+	}
+	return l.infection[i]
+}
+
+// Disinfected returns the fraction of the level's floor that is clean
+func (l *Level) Disinfected() float64 {
+	floor, clean := 0, 0
+	for i, v := range l.infection {
+		if l.isSolid(i) {
+			continue
+		}
+		floor++
+		if v == 0 {
+			clean++
+		}
+	}
+	if floor == 0 {
+		return 1
+	}
+	return float64(clean) / float64(floor)
+}
+
+// heavyCell picks a heavily infected cell at random that is further than
+// infectionSpawnDistance from x, y, returning false if there are none
+func (l *Level) heavyCell(r *rand.Rand, x, y float64) (int, bool) {
+	var cells []int
+	for i, v := range l.infection {
+		if v < infectionHeavy {
+			continue
+		}
+		cx, cy := TileCentre(i)
+		if math.Hypot(cx-x, cy-y) > infectionSpawnDistance {
+			cells = append(cells, i)
+		}
+	}
+	if len(cells) == 0 {
+		return 0, false
+	}
+	return cells[r.Intn(len(cells))], true
+}
+
+// infectionSlowSystem slows the player down by how infected the cell under
+// its feet is. The player's velocity is set afresh every step, so this must
+// run between aiSystem and movementSystem.
+func infectionSlowSystem(w *World, l *Level) {
+	if l.infection == nil || l.infectionRules.Slow == 0 {
+		return
+	}
+
+	w.Each(func(e Entity, c *Components) {
+		if c.AI == nil || c.AI.Behaviour != AIPlayer || c.Velocity == nil || c.Position == nil {
+			return
+		}
+
+		f := 1 - l.infectionRules.Slow*float64(l.infectionUnder(c))/InfectionMax
+		c.Velocity.X *= f
+		c.Velocity.Y *= f
+	})
+}
@@ -0,0 +1,19 @@
+package sim
+
+// Input is a bitmask of the controls held down during a single update. The
+// simulation only ever reads player controls through an Input so that a run
+// can be recorded and replayed exactly.
+type Input uint8
+
+const (
+	InputLeft Input = 1 << iota
+	InputRight
+	InputUp
+	InputDown
+	InputFire
+	InputRestart
+)
+
+func (in Input) Has(flags Input) bool {
+	return in&flags != 0
+}
@@ -0,0 +1,158 @@
+package sim
+
+import "github.com/paulcockrell/gametest/resources/manifest"
+
+// Playfield constants
+const (
+	// ScreenWidth and ScreenHeight are the size of the playfield in pixels
+	ScreenWidth  = 240
+	ScreenHeight = 240
+
+	// TileSize is the width and height of a tile in pixels
+	TileSize = 16
+
+	// TileXNum is how many tiles wide the tile sheet is
+	TileXNum = 25
+
+	// LevelXNum is how many tiles wide levels are
+	LevelXNum = ScreenWidth / TileSize
+
+	// LevelYNum is how many tiles high generated levels are
+	LevelYNum = ScreenHeight / TileSize
+
+	// generatedSpawns is how many virus spawn points generated levels have
+	generatedSpawns = 3
+
+	// TilesAsset is the id of the tile sheet levels are drawn with
+	TilesAsset = "tiles"
+)
+
+// generatedAssets are the assets generated levels need besides the preloaded
+// ones, as they have no manifest entry to list them in
+var generatedAssets = []string{"enemy", "hum", TilesAsset}
+
+// Level is a grid of tiles in layers, and the state of those tiles in a run.
+//
+// Gameplay can change tiles, such as breaking crates and opening doors. The
+// level's layers are a copy of the level asset's, and every change made in a
+// run is recorded so that it survives the level file being reloaded. So is
+// how infected each cell is, on levels the virus spreads over.
+type Level struct {
+	id         string // asset id the level was loaded from
+	layers     [][]int
+	foreground map[int]bool
+	lighting   *manifest.Lighting // nil if the level is fully lit
+	startCell  *manifest.Cell     // nil to start in the middle of the screen
+	exitCell   *manifest.Cell     // nil if the level has no exit
+	spawns     []manifest.Cell    // where viruses appear, if not at the edges
+	goal       *manifest.Goal     // nil if the level is played until VaxerMan dies
+
+	solid      map[int]bool
+	behaviours map[int]*manifest.TileBehaviour
+	changes    map[tileKey]int // tiles changed this run
+	hits       map[int]int     // bullets taken by each cell's reacting tile
+	lastHit    map[int]Entity  // the last bullet to hit each cell
+
+	infectionRules *manifest.Infection // nil if the level has no infection
+	infection      []int               // how infected each cell is
+	spread         []int               // the next infection, reused each spread
+
+	revision int // counts changes to the tiles, for those drawing them
+}
+
+// NewLevel builds a level from the level asset m with the given id
+func NewLevel(id string, m *manifest.Level) *Level {
+	l := &Level{
+		id:      id,
+		changes: make(map[tileKey]int),
+		hits:    make(map[int]int),
+		lastHit: make(map[int]Entity),
+	}
+	l.Set(m)
+	return l
+}
+
+// Set replaces the level's tiles, lighting, infection rules, start, exit,
+// spawns and goal, e.g. when its file changes, keeping the changes made to its
+// tiles and how infected they are this run
+func (l *Level) Set(m *manifest.Level) {
+	l.layers = make([][]int, len(m.Layers))
+	for i, layer := range m.Layers {
+		l.layers[i] = append([]int(nil), layer...)
+	}
+	for k, t := range l.changes {
+		if k.layer < len(l.layers) && k.cell < len(l.layers[k.layer]) {
+			l.layers[k.layer][k.cell] = t
+		}
+	}
+
+	l.solid = make(map[int]bool)
+	for _, t := range m.Solid {
+		l.solid[t] = true
+	}
+	l.behaviours = make(map[int]*manifest.TileBehaviour)
+	for i := range m.Tiles {
+		l.behaviours[m.Tiles[i].Tile] = &m.Tiles[i]
+	}
+
+	l.infectionRules = m.Infection
+	switch {
+	case m.Infection == nil:
+		l.infection = nil
+	case len(l.infection) != len(l.layers[0]):
+		l.seedInfection()
+	}
+
+	l.lighting = m.Lighting
+	l.startCell, l.exitCell, l.spawns = m.Start, m.Exit, m.Spawns
+	l.goal = m.Goal
+	l.foreground = make(map[int]bool)
+	for _, t := range m.Foreground {
+		l.foreground[t] = true
+	}
+	l.revision++
+}
+
+// ID returns the asset id the level was loaded from
+func (l *Level) ID() string {
+	return l.id
+}
+
+// Layers returns the level's tiles, layer by layer. They must not be
+// changed.
+func (l *Level) Layers() [][]int {
+	return l.layers
+}
+
+// Rows returns how many tiles high the level is
+func (l *Level) Rows() int {
+	return len(l.layers[0]) / LevelXNum
+}
+
+// Foreground returns true if tile t is drawn over entities rather than under
+// them
+func (l *Level) Foreground(t int) bool {
+	return l.foreground[t]
+}
+
+// Lighting returns the level's lighting, or nil if it is fully lit
+func (l *Level) Lighting() *manifest.Lighting {
+	return l.lighting
+}
+
+// Goal returns what completes the level, or nil if it is played until
+// VaxerMan dies
+func (l *Level) Goal() *manifest.Goal {
+	return l.goal
+}
+
+// Infection returns how infected each cell is, or nil if the level has no
+// infection
+func (l *Level) Infection() []int {
+	return l.infection
+}
+
+// Revision returns a number that changes whenever the level's tiles do
+func (l *Level) Revision() int {
+	return l.revision
+}
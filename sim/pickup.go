@@ -0,0 +1,53 @@
+package sim
+
+import (
+	"image"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+const (
+	// healthPickupHeal is how much health a health pickup restores
+	healthPickupHeal = 20
+
+	// pickupLifetime is how many seconds a pickup waits to be collected
+	pickupLifetime = 10
+
+	// healthPickupTile is the tile health pickups are drawn with
+	healthPickupTile = 339
+)
+
+// SpawnPickup adds a pickup of the given kind to the world, centred on x, y
+func SpawnPickup(w *World, kind string, x, y float64) Entity {
+	c := Components{
+		Tag:      TagPickup,
+		Position: NewPosition(x-TileSize/2, y-TileSize/2),
+		Collider: &Collider{
+			Layer: LayerPickup,
+			Mask:  LayerPlayer,
+		},
+		Lifetime: &Lifetime{Remaining: pickupLifetime},
+	}
+
+	switch kind {
+	case manifest.PickupHealth:
+		c.Animator = NewAnimator(tileClips(healthPickupTile), "tile")
+		c.Pickup = &Pickup{Heal: healthPickupHeal}
+	}
+
+	return w.Spawn(c)
+}
+
+// tileClips returns a single clip named "tile" that shows tile t of the tile
+// sheet, for entities drawn with a tile
+func tileClips(t int) map[string]*Clip {
+	sx, sy := (t%TileXNum)*TileSize, (t/TileXNum)*TileSize
+	return map[string]*Clip{
+		"tile": {
+			name:   "tile",
+			sheet:  TilesAsset,
+			frames: []Frame{{rect: image.Rect(sx, sy, sx+TileSize, sy+TileSize), duration: 1}},
+			loop:   true,
+		},
+	}
+}
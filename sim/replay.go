@@ -0,0 +1,226 @@
+package sim
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+)
+
+// Replay file layout (little endian):
+//
+//	magic    [4]byte "VXRP"
+//	version  uint8
+//	seed     int64
+//	score    uint32
+//	wave     uint32
+//	hash     uint64
+//	ticks    uint32
+//	levelLen uint8
+//	level    [levelLen]byte, the asset id of the level played
+//	inputs   [ticks]uint8
+//
+// There is one input per fixed simulation step of Dt seconds.
+const (
+	replayMagic   = "VXRP"
+	replayVersion = 4
+
+	// maxReplayTicks is the longest run a replay can hold, an hour of
+	// steps. Replays come from untrusted clients, so the tick count in the
+	// header is checked against it before anything is allocated for the
+	// inputs.
+	maxReplayTicks = 60 * 60 * Rate
+)
+
+// Replay holds everything needed to re-run a game: the seed it was started
+// with, the level, the input for every simulation step, and the result the
+// client claims it got
+type Replay struct {
+	Seed   int64
+	Level  string
+	Score  int
+	Wave   int
+	Hash   uint64
+	Inputs []Input
+}
+
+type replayHeader struct {
+	Magic   [4]byte
+	Version uint8
+	Seed    int64
+	Score   uint32
+	Wave    uint32
+	Hash    uint64
+	Ticks   uint32
+}
+
+// WriteTo encodes the replay to w
+func (r *Replay) WriteTo(w io.Writer) (int64, error) {
+	h := replayHeader{
+		Version: replayVersion,
+		Seed:    r.Seed,
+		Score:   uint32(r.Score),
+		Wave:    uint32(r.Wave),
+		Hash:    r.Hash,
+		Ticks:   uint32(len(r.Inputs)),
+	}
+	copy(h.Magic[:], replayMagic)
+	if len(r.Level) > math.MaxUint8 {
+		return 0, fmt.Errorf("level id %q is too long", r.Level)
+	}
+	if err := binary.Write(w, binary.LittleEndian, &h); err != nil {
+		return 0, err
+	}
+
+	data := append([]byte{byte(len(r.Level))}, r.Level...)
+	for _, in := range r.Inputs {
+		data = append(data, byte(in))
+	}
+	n, err := w.Write(data)
+
+	return int64(binary.Size(h) + n), err
+}
+
+// ReadReplay decodes a replay written by WriteTo
+func ReadReplay(rd io.Reader) (*Replay, error) {
+	var h replayHeader
+	if err := binary.Read(rd, binary.LittleEndian, &h); err != nil {
+		return nil, fmt.Errorf("error reading replay header: %v", err)
+	}
+	if string(h.Magic[:]) != replayMagic {
+		return nil, errors.New("not a replay file")
+	}
+	if h.Version != replayVersion {
+		return nil, fmt.Errorf("unsupported replay version %d", h.Version)
+	}
+	if h.Ticks > maxReplayTicks {
+		return nil, fmt.Errorf("replay of %d ticks is longer than the maximum of %d", h.Ticks, maxReplayTicks)
+	}
+
+	var levelLen [1]byte
+	if _, err := io.ReadFull(rd, levelLen[:]); err != nil {
+		return nil, fmt.Errorf("error reading replay level: %v", err)
+	}
+	level := make([]byte, levelLen[0])
+	if _, err := io.ReadFull(rd, level); err != nil {
+		return nil, fmt.Errorf("error reading replay level: %v", err)
+	}
+
+	inputs := make([]byte, h.Ticks)
+	if _, err := io.ReadFull(rd, inputs); err != nil {
+		return nil, fmt.Errorf("error reading replay inputs: %v", err)
+	}
+
+	r := &Replay{
+		Seed:   h.Seed,
+		Level:  string(level),
+		Score:  int(h.Score),
+		Wave:   int(h.Wave),
+		Hash:   h.Hash,
+		Inputs: make([]Input, len(inputs)),
+	}
+	for i, in := range inputs {
+		r.Inputs[i] = Input(in)
+	}
+
+	return r, nil
+}
+
+// LoadReplay reads a replay from the file at path
+func LoadReplay(path string) (*Replay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadReplay(bufio.NewReader(f))
+}
+
+// Save writes the replay to the file at path
+func (r *Replay) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	if _, err := r.WriteTo(w); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Run plays the replay's inputs through a fresh headless game, loading the
+// assets it needs into a, and returns the game in its final state
+func (r *Replay) Run(a *Assets) (*Game, error) {
+	g, err := NewHeadlessGame(a, r.Level, r.Seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, in := range r.Inputs {
+		g.Step(in)
+	}
+
+	return g, nil
+}
+
+// StateHash returns a hash of the simulation state, used to check that a
+// replay reproduces the exact same game rather than just the same score
+func (g *Game) StateHash() uint64 {
+	h := fnv.New64a()
+	write := func(vs ...int) {
+		for _, v := range vs {
+			binary.Write(h, binary.LittleEndian, int64(v))
+		}
+	}
+	writeFloat := func(vs ...float64) {
+		for _, v := range vs {
+			binary.Write(h, binary.LittleEndian, math.Float64bits(v))
+		}
+	}
+
+	write(g.ticks, g.score, g.wave, g.kills)
+	g.level.writeState(write)
+
+	g.world.Each(func(e Entity, c *Components) {
+		write(int(e), int(c.Tag))
+		if p := c.Position; p != nil {
+			writeFloat(p.X, p.Y)
+		}
+		if v := c.Velocity; v != nil {
+			writeFloat(v.X, v.Y)
+		}
+		if a := c.Animator; a != nil {
+			h.Write([]byte(a.Clip().name))
+			write(a.frame)
+		}
+		if h := c.Health; h != nil {
+			write(h.Current)
+		}
+		if w := c.Weapon; w != nil {
+			writeFloat(w.Timer)
+		}
+		if in := c.Infectious; in != nil {
+			write(in.Damage)
+			if in.Cooldown > 0 {
+				writeFloat(in.Timer)
+			}
+		}
+		if c.Pickup != nil {
+			write(c.Pickup.Heal)
+		}
+	})
+
+	return h.Sum64()
+}
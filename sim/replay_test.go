@@ -0,0 +1,117 @@
+package sim
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// testInputs returns n inputs that change at random every 20 updates, like a
+// player running about and shooting
+func testInputs(n int) []Input {
+	r := rand.New(rand.NewSource(7))
+	inputs := make([]Input, n)
+	var in Input
+	for i := range inputs {
+		if i%20 == 0 {
+			in = Input(r.Intn(int(InputFire) << 1))
+		}
+		inputs[i] = in
+	}
+	return inputs
+}
+
+// testReplay returns the encoding of a short replay, and the replay itself
+func testReplay(t *testing.T) ([]byte, *Replay) {
+	r := &Replay{
+		Seed:   42,
+		Level:  "level_lab",
+		Score:  120,
+		Wave:   2,
+		Hash:   0x90d0c8f42d267c9d,
+		Inputs: testInputs(500),
+	}
+
+	var buf bytes.Buffer
+	n, err := r.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(buf.Len()) {
+		t.Fatalf("WriteTo returned %d bytes written, wrote %d", n, buf.Len())
+	}
+	return buf.Bytes(), r
+}
+
+func TestReplayRoundTrip(t *testing.T) {
+	data, want := testReplay(t)
+
+	got, err := ReadReplay(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadReplay returned %+v, want %+v", got, want)
+	}
+}
+
+func TestReadReplayTruncated(t *testing.T) {
+	data, _ := testReplay(t)
+	header := binary.Size(replayHeader{})
+
+	for _, n := range []int{0, 3, header - 1, header, header + 1, len(data) - 1} {
+		if _, err := ReadReplay(bytes.NewReader(data[:n])); err == nil {
+			t.Errorf("ReadReplay of the first %d of %d bytes succeeded", n, len(data))
+		}
+	}
+}
+
+func TestReadReplayBadMagic(t *testing.T) {
+	data, _ := testReplay(t)
+	data[0] = 'X'
+
+	if _, err := ReadReplay(bytes.NewReader(data)); err == nil {
+		t.Error("ReadReplay of a file with the wrong magic succeeded")
+	}
+}
+
+func TestReadReplayTooLong(t *testing.T) {
+	h := replayHeader{Version: replayVersion, Ticks: maxReplayTicks + 1}
+	copy(h.Magic[:], replayMagic)
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, &h)
+
+	if _, err := ReadReplay(&buf); err == nil {
+		t.Errorf("ReadReplay of a replay claiming %d ticks succeeded", h.Ticks)
+	}
+}
+
+func TestStateHashDeterministic(t *testing.T) {
+	inputs := testInputs(3000)
+	assets, err := NewPackedAssets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := func(level string, seed int64) uint64 {
+		g, err := NewHeadlessGame(assets, level, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, in := range inputs {
+			g.Step(in)
+		}
+		return g.StateHash()
+	}
+
+	for _, level := range []string{"level_one", "level_lab", "level_hive"} {
+		a, b := run(level, 42), run(level, 42)
+		if a != b {
+			t.Errorf("two runs of %s with the same seed and inputs hashed to %016x and %016x", level, a, b)
+		}
+		if c := run(level, 43); c == a {
+			t.Errorf("runs of %s with different seeds both hashed to %016x", level, a)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package sim
+
+import "math"
+
+// Contact is a pair of entities whose colliders overlap
+type Contact struct {
+	A, B Entity
+}
+
+// aiSystem decides where entities with an AI component want to go
+func aiSystem(w *World, a *Assets, in Input, dt float64) {
+	w.Each(func(e Entity, c *Components) {
+		if c.AI == nil {
+			return
+		}
+
+		switch c.AI.Behaviour {
+		case AIPlayer:
+			updatePlayer(w, a, c, in, dt)
+			c.Animator.Play(vaxermanClipName(c.AI.Actions))
+		case AIDrift:
+			// Keeps its velocity
+		case AIChase:
+			chase(w, c)
+		}
+	})
+}
+
+// chase heads an entity straight for the centre of its target at its speed,
+// stopping once it's there or the target is gone
+func chase(w *World, c *Components) {
+	c.Velocity.X, c.Velocity.Y = 0, 0
+	t := w.Get(c.AI.Target)
+	if t == nil {
+		return
+	}
+
+	x, y := EntityCentre(c)
+	tx, ty := EntityCentre(t)
+	dx, dy := tx-x, ty-y
+	if d := math.Hypot(dx, dy); d >= 1 {
+		c.Velocity.X = dx / d * c.AI.Speed
+		c.Velocity.Y = dy / d * c.AI.Speed
+	}
+}
+
+// movementSystem moves entities by their velocity
+func movementSystem(w *World, dt float64) {
+	w.Each(func(e Entity, c *Components) {
+		if c.Position == nil {
+			return
+		}
+
+		p := c.Position
+		p.PrevX, p.PrevY = p.X, p.Y
+		if c.Velocity != nil {
+			p.X += c.Velocity.X * dt
+			p.Y += c.Velocity.Y * dt
+		}
+	})
+}
+
+// boundsSystem despawns entities that have left the screen, or pushes them
+// back on to it
+func boundsSystem(w *World) {
+	w.Each(func(e Entity, c *Components) {
+		if c.Bounds == nil || c.Position == nil {
+			return
+		}
+
+		p := c.Position
+		switch c.Bounds.Mode {
+		case BoundsDespawn:
+			if p.X < 0 || p.Y < 0 || p.X > ScreenWidth || p.Y > ScreenHeight {
+				w.Despawn(e)
+			}
+		case BoundsClamp:
+			var width, height float64
+			if c.Animator != nil {
+				r := c.Animator.Frame().rect
+				width, height = float64(r.Dx()), float64(r.Dy())
+			}
+			if p.X < 0 {
+				p.X = 0
+			}
+			if p.X > ScreenWidth-width {
+				p.X = ScreenWidth - width
+			}
+			if p.Y < 0 {
+				p.Y = 0
+			}
+			if p.Y > ScreenHeight-height {
+				p.Y = ScreenHeight - height
+			}
+		}
+	})
+}
+
+// animationSystem advances animations, returning the frame events fired in
+// spawn order
+func animationSystem(w *World, dt float64) []AnimationEvent {
+	var events []AnimationEvent
+	w.Each(func(e Entity, c *Components) {
+		if c.Animator != nil {
+			events = append(events, c.Animator.advance(w, e, dt)...)
+		}
+	})
+
+	return events
+}
+
+// lifetimeSystem despawns entities whose lifetime has run out
+func lifetimeSystem(w *World, dt float64) {
+	w.Each(func(e Entity, c *Components) {
+		if c.Lifetime == nil {
+			return
+		}
+
+		c.Lifetime.Remaining -= dt
+		if c.Lifetime.Remaining < 0 {
+			w.Despawn(e)
+		}
+	})
+}
+
+// infectiousSystem counts down until infectious entities with a cooldown can
+// infect again
+func infectiousSystem(w *World, dt float64) {
+	w.Each(func(e Entity, c *Components) {
+		if c.Infectious != nil && c.Infectious.Timer > 0 {
+			c.Infectious.Timer -= dt
+		}
+	})
+}
+
+// collisionSystem returns every pair of entities whose colliders overlap and
+// are allowed to collide, in spawn order
+func collisionSystem(w *World, h *SpatialHash) []Contact {
+	h.Clear()
+	w.Each(func(e Entity, c *Components) {
+		if c.Collider == nil || c.Position == nil {
+			return
+		}
+
+		b := body{
+			e:        e,
+			hitbox:   ColliderHitbox(c).Translate(c.Position.X, c.Position.Y),
+			collider: c.Collider,
+		}
+		if c.Collider.PixelPerfect && c.Animator != nil && c.Animator.Clip().mask != nil {
+			b.mask = c.Animator.Clip().mask
+			b.frame = c.Animator.Frame().rect.Min
+			b.x, b.y = c.Position.X, c.Position.Y
+		}
+		h.Insert(b)
+	})
+
+	return h.Contacts()
+}
+
+// ColliderHitbox returns the shape an entity collides with, relative to its
+// position: its current clip's hitbox, else its collider's hitbox, else its
+// whole animation frame
+func ColliderHitbox(c *Components) Hitbox {
+	if c.Animator != nil {
+		if h := c.Animator.Hitbox(); !h.IsZero() {
+			return h
+		}
+		if c.Collider.Hitbox.IsZero() {
+			r := c.Animator.Frame().rect
+			return RectHitbox(0, 0, float64(r.Dx()), float64(r.Dy()))
+		}
+	}
+
+	return c.Collider.Hitbox
+}
+
+// EntityCentre returns the centre of an entity's current frame, or its
+// position if it has no animation
+func EntityCentre(c *Components) (x, y float64) {
+	x, y = c.Position.X, c.Position.Y
+	if c.Animator != nil {
+		r := c.Animator.Frame().rect
+		x += float64(r.Dx()) / 2
+		y += float64(r.Dy()) / 2
+	}
+
+	return x, y
+}
@@ -0,0 +1,301 @@
+package sim
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+// tileFootHeight is how tall, in pixels, the part of an entity that collides
+// with solid tiles is. Only an entity's feet collide, so that in the top-down
+// view it can stand in front of a wall.
+const tileFootHeight = 6
+
+// tileKey is a cell of one layer of a level
+type tileKey struct {
+	layer, cell int
+}
+
+// TileEventKind is what happened to a tile hit by a bullet
+type TileEventKind uint8
+
+const (
+	TileBlocked     TileEventKind = iota // a solid tile stopped a bullet
+	TileDamaged                          // a tile took a bullet but hasn't changed yet
+	TileCleaned                          // a contaminated tile was cleaned
+	TileBroken                           // a crate was broken
+	TileSwitched                         // a switch was thrown
+	TileOpened                           // a door was opened by a switch
+	TileDisinfected                      // a bullet cleansed some of a cell's infection
+)
+
+// TileEvent is a change to a level's tiles made by gameplay
+type TileEvent struct {
+	Kind      TileEventKind
+	Cell      int
+	Behaviour *manifest.TileBehaviour // nil for TileBlocked and TileDisinfected
+	Bullet    Entity                  // the bullet that caused it
+}
+
+// cellAt returns the cell at x, y, or false if it is off the level
+func (l *Level) cellAt(x, y float64) (int, bool) {
+	c, r := int(math.Floor(x/TileSize)), int(math.Floor(y/TileSize))
+	if c < 0 || c >= LevelXNum || r < 0 || r >= l.Rows() {
+		return 0, false
+	}
+	return r*LevelXNum + c, true
+}
+
+// cellIndex returns the index of a cell, or false if it is off the level
+func (l *Level) cellIndex(c manifest.Cell) (int, bool) {
+	if c.X < 0 || c.X >= LevelXNum || c.Y < 0 || c.Y >= l.Rows() {
+		return 0, false
+	}
+	return c.Y*LevelXNum + c.X, true
+}
+
+// feetCell returns the cell under the middle of an entity's feet, or false
+// if it is off the level
+func (l *Level) feetCell(c *Components) (int, bool) {
+	x, y, width, height := ColliderHitbox(c).Bounds()
+	return l.cellAt(c.Position.X+x+width/2, c.Position.Y+y+height-tileFootHeight/2)
+}
+
+// standOn moves an entity so that the middle of its feet is in the middle of
+// cell i
+func standOn(c *Components, i int) {
+	x, y, width, height := ColliderHitbox(c).Bounds()
+	cx, cy := TileCentre(i)
+	p := c.Position
+	p.X, p.Y = cx-x-width/2, cy-y-height+tileFootHeight/2
+	p.PrevX, p.PrevY = p.X, p.Y
+}
+
+// behaviourAt returns the topmost tile in cell i that reacts to gameplay,
+// and the layer it's on
+func (l *Level) behaviourAt(i int) (int, *manifest.TileBehaviour) {
+	for layer := len(l.layers) - 1; layer >= 0; layer-- {
+		if b, ok := l.behaviours[l.layers[layer][i]]; ok {
+			return layer, b
+		}
+	}
+	return 0, nil
+}
+
+// isSolid returns true if any tile in cell i blocks movement and bullets
+func (l *Level) isSolid(i int) bool {
+	for _, layer := range l.layers {
+		t := layer[i]
+		if l.solid[t] {
+			return true
+		}
+		if b, ok := l.behaviours[t]; ok && b.Kind != manifest.TileContaminated {
+			return true
+		}
+	}
+	return false
+}
+
+// solidIn returns true if a solid tile overlaps the box at x, y. The edges of
+// the level are not solid.
+func (l *Level) solidIn(x, y, width, height float64) bool {
+	c0, r0 := int(math.Floor(x/TileSize)), int(math.Floor(y/TileSize))
+	c1, r1 := int(math.Ceil((x+width)/TileSize))-1, int(math.Ceil((y+height)/TileSize))-1
+	for r := r0; r <= r1; r++ {
+		for c := c0; c <= c1; c++ {
+			if c < 0 || c >= LevelXNum || r < 0 || r >= l.Rows() {
+				continue
+			}
+			if l.isSolid(r*LevelXNum + c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// setTile changes the tile in a cell of a layer, recording the change for
+// the rest of the run
+func (l *Level) setTile(layer, i, t int) {
+	l.layers[layer][i] = t
+	l.changes[tileKey{layer, i}] = t
+	delete(l.hits, i)
+	delete(l.lastHit, i)
+	l.revision++
+}
+
+// hit applies a bullet passing over cell i to its tiles. It returns what
+// happened and whether the bullet is stopped. A bullet only hits each cell
+// once, however many steps it spends over it.
+func (l *Level) hit(i int, bullet Entity) ([]TileEvent, bool) {
+	if l.lastHit[i] == bullet {
+		return nil, false
+	}
+	solid := l.isSolid(i)
+	layer, b := l.behaviourAt(i)
+	if b != nil && b.Kind == manifest.TileContaminated && l.infection != nil && l.infection[i] > 0 {
+		// Cleaned once its infection has been cleansed
+		return nil, false
+	}
+	if b == nil || b.Kind == manifest.TileDoor {
+		if solid {
+			return []TileEvent{{Kind: TileBlocked, Cell: i, Behaviour: b, Bullet: bullet}}, true
+		}
+		return nil, false
+	}
+	l.lastHit[i] = bullet
+
+	hits := b.Hits
+	if hits == 0 {
+		hits = 1
+	}
+	l.hits[i]++
+	if l.hits[i] < hits {
+		return []TileEvent{{Kind: TileDamaged, Cell: i, Behaviour: b, Bullet: bullet}}, solid
+	}
+
+	l.setTile(layer, i, b.Becomes)
+	events := []TileEvent{{Cell: i, Behaviour: b, Bullet: bullet}}
+	switch b.Kind {
+	case manifest.TileContaminated:
+		events[0].Kind = TileCleaned
+	case manifest.TileCrate:
+		events[0].Kind = TileBroken
+	case manifest.TileSwitch:
+		events[0].Kind = TileSwitched
+		events = append(events, l.open(b.Group, bullet)...)
+	}
+
+	return events, solid
+}
+
+// open opens every door in group
+func (l *Level) open(group string, bullet Entity) []TileEvent {
+	var events []TileEvent
+	for i := range l.layers[0] {
+		for layer := range l.layers {
+			b, ok := l.behaviours[l.layers[layer][i]]
+			if !ok || b.Kind != manifest.TileDoor || b.Group != group {
+				continue
+			}
+			l.setTile(layer, i, b.Becomes)
+			events = append(events, TileEvent{Kind: TileOpened, Cell: i, Behaviour: b, Bullet: bullet})
+		}
+	}
+	return events
+}
+
+// writeState writes the changes made to the level this run and how infected
+// it is, in a fixed order, for the state hash
+func (l *Level) writeState(write func(vs ...int)) {
+	keys := make([]tileKey, 0, len(l.changes))
+	for k := range l.changes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].layer != keys[j].layer {
+			return keys[i].layer < keys[j].layer
+		}
+		return keys[i].cell < keys[j].cell
+	})
+	for _, k := range keys {
+		write(k.layer, k.cell, l.changes[k])
+	}
+
+	cells := make([]int, 0, len(l.hits))
+	for i := range l.hits {
+		cells = append(cells, i)
+	}
+	sort.Ints(cells)
+	for _, i := range cells {
+		write(i, l.hits[i])
+	}
+
+	for _, v := range l.infection {
+		write(v)
+	}
+}
+
+// tileCollisionSystem stops entities that collide with walls from walking
+// into solid tiles. Each axis is undone separately, so entities slide along
+// walls rather than sticking to them, and drifting viruses bounce off them
+// on the axes that were undone. Bullets are left to tileHitSystem.
+func tileCollisionSystem(w *World, l *Level) {
+	w.Each(func(e Entity, c *Components) {
+		if c.Tag == TagBullet || c.Collider == nil || c.Collider.Mask&LayerWall == 0 || c.Position == nil {
+			return
+		}
+
+		x, y, width, height := ColliderHitbox(c).Bounds()
+		blocked := func(px, py float64) bool {
+			return l.solidIn(px+x, py+y+height-tileFootHeight, width, tileFootHeight)
+		}
+
+		p := c.Position
+		// Let entities that start inside a wall walk out of it
+		if !blocked(p.X, p.Y) || blocked(p.PrevX, p.PrevY) {
+			return
+		}
+		var undoX, undoY bool
+		switch {
+		case !blocked(p.X, p.PrevY):
+			undoY = true
+		case !blocked(p.PrevX, p.Y):
+			undoX = true
+		default:
+			undoX, undoY = true, true
+		}
+		if undoX {
+			p.X = p.PrevX
+		}
+		if undoY {
+			p.Y = p.PrevY
+		}
+
+		if c.AI == nil || c.AI.Behaviour != AIDrift || c.Velocity == nil {
+			return
+		}
+		if undoX {
+			c.Velocity.X = -c.Velocity.X
+		}
+		if undoY {
+			c.Velocity.Y = -c.Velocity.Y
+		}
+	})
+}
+
+// tileHitSystem applies bullets to the tiles they pass over, despawning those
+// stopped by solid tiles or used up cleansing infected cells. It returns what
+// happened to the tiles, in spawn order of the bullets.
+func tileHitSystem(w *World, l *Level) []TileEvent {
+	var events []TileEvent
+	w.Each(func(e Entity, c *Components) {
+		if c.Tag != TagBullet || c.Collider == nil || c.Collider.Mask&LayerWall == 0 || c.Position == nil {
+			return
+		}
+
+		i, ok := l.cellAt(EntityCentre(c))
+		if !ok {
+			return
+		}
+		hit, stopped := l.hit(i, e)
+		events = append(events, hit...)
+		if !stopped {
+			if cleansed := l.cleanse(i, e); cleansed != nil {
+				events = append(events, cleansed...)
+				stopped = true
+			}
+		}
+		if stopped {
+			w.Despawn(e)
+		}
+	})
+
+	return events
+}
+
+// TileCentre returns the centre of the i-th tile of a level layer
+func TileCentre(i int) (x, y float64) {
+	return (float64(i%LevelXNum) + 0.5) * TileSize, (float64(i/LevelXNum) + 0.5) * TileSize
+}
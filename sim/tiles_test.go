@@ -0,0 +1,59 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/paulcockrell/gametest/resources/manifest"
+)
+
+// wallLevel returns a level with a wall of solid tiles down column col
+func wallLevel(col int) *Level {
+	const wall = 1
+	layer := make([]int, LevelXNum*LevelXNum)
+	for r := 0; r < LevelXNum; r++ {
+		layer[r*LevelXNum+col] = wall
+	}
+	return NewLevel("test", &manifest.Level{
+		Layers: [][]int{layer},
+		Solid:  []int{wall},
+	})
+}
+
+func TestVirusStopsAtSolidTile(t *testing.T) {
+	a, err := NewPackedAssets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Load("enemy"); err != nil {
+		t.Fatal(err)
+	}
+
+	const col, speed = 10, 60
+	l := wallLevel(col)
+	w := NewWorld()
+	e := SpawnEnemy(w, a, 100, 100, speed, 0)
+	c := w.Get(e)
+
+	x, _, width, _ := ColliderHitbox(c).Bounds()
+	edge := float64(col * TileSize)
+	furthest := 0.0
+	for i := 0; i < Rate; i++ {
+		movementSystem(w, Dt)
+		tileCollisionSystem(w, l)
+
+		right := c.Position.X + x + width
+		if right > edge {
+			t.Fatalf("step %d: virus reached x %v, inside the wall at %v", i, right, edge)
+		}
+		if right > furthest {
+			furthest = right
+		}
+	}
+
+	if furthest < edge-speed*Dt {
+		t.Errorf("virus stopped at x %v, short of the wall at %v", furthest, edge)
+	}
+	if c.Velocity.X >= 0 {
+		t.Errorf("virus still heading into the wall with velocity %v", c.Velocity.X)
+	}
+}
@@ -0,0 +1,233 @@
+package sim
+
+const (
+	maxBullets = 3
+
+	// vaxermanSpeed is how far VaxerMan runs in pixels per second
+	vaxermanSpeed = 120
+
+	// fireCooldown is the minimum time between shots in seconds
+	fireCooldown = 5.0 / 60
+)
+
+type VaxerManActions uint16
+
+const (
+	VaxerManIdle VaxerManActions = 1 << iota
+	VaxerManRun
+	VaxerManShoot
+	VaxerManLeft
+	VaxerManRight
+	VaxerManUp
+	VaxerManDown
+	VaxerManHit
+	VaxerManDead
+)
+
+func (ra VaxerManActions) Has(flags VaxerManActions) bool {
+	return ra&flags != 0
+}
+
+// SpawnVaxerMan adds VaxerMan to the world at the given position
+func SpawnVaxerMan(w *World, a *Assets, x, y float64) Entity {
+	var actions VaxerManActions = VaxerManIdle | VaxerManRight
+
+	return w.Spawn(Components{
+		Tag:      TagPlayer,
+		Position: NewPosition(x, y),
+		Velocity: &Velocity{},
+		Animator: NewAnimator(a.Clips("vaxerman"), vaxermanClipName(actions)),
+		Collider: &Collider{
+			Layer:        LayerPlayer,
+			Mask:         LayerEnemy | LayerEnemyBullet | LayerPickup | LayerWall,
+			PixelPerfect: true,
+		},
+		Health: &Health{Current: 100, Max: 100},
+		AI: &AI{
+			Behaviour: AIPlayer,
+			Actions:   actions,
+		},
+		Bounds: &Bounds{Mode: BoundsClamp},
+		Weapon: &Weapon{
+			Cooldown: fireCooldown,
+			MaxShots: maxBullets,
+		},
+	})
+}
+
+// InfectVaxerMan decrements VaxerMan's health, if health reaches zero it sets
+// VaxerMan to dead
+func InfectVaxerMan(c *Components, damage int) {
+	c.Health.Current -= damage
+	if c.Health.Current <= 0 {
+		c.Health.Current = 0
+		c.AI.Actions = VaxerManDead
+	}
+}
+
+// HealVaxerMan restores some of VaxerMan's health, up to the maximum
+func HealVaxerMan(c *Components, heal int) {
+	c.Health.Current += heal
+	if c.Health.Current > c.Health.Max {
+		c.Health.Current = c.Health.Max
+	}
+}
+
+// IsVaxerManDead returns true if VaxerMan's actions contains VaxerManDead
+func IsVaxerManDead(c *Components) bool {
+	return c.AI.Actions.Has(VaxerManDead)
+}
+
+// updatePlayer steers VaxerMan from the player's input
+func updatePlayer(w *World, a *Assets, c *Components, in Input, dt float64) {
+	v := c.AI
+
+	if c.Weapon.Timer > 0 {
+		c.Weapon.Timer -= dt
+	}
+
+	// If VaxerMan is dead, do nothing
+	if IsVaxerManDead(c) {
+		c.Velocity.X = 0
+		c.Velocity.Y = 0
+		return
+	}
+
+	// Reset velocity values
+	c.Velocity.X = 0
+	c.Velocity.Y = 0
+
+	// Reset movement to default idle
+	v.Actions = v.Actions &^ (VaxerManRun | VaxerManShoot)
+	v.Actions = v.Actions | VaxerManIdle
+
+	// Respond to keyboard inputs
+	// VaxerManLeft
+	if in.Has(InputLeft) {
+		v.Actions = VaxerManLeft | VaxerManRun
+		c.Velocity.X -= vaxermanSpeed
+	}
+
+	// VaxerManRight
+	if in.Has(InputRight) {
+		v.Actions = VaxerManRight | VaxerManRun
+		c.Velocity.X += vaxermanSpeed
+	}
+
+	// VaxerManUp
+	if in.Has(InputUp) {
+		v.Actions = VaxerManUp | VaxerManRun
+		c.Velocity.Y -= vaxermanSpeed
+	}
+
+	// VaxerManDown
+	if in.Has(InputDown) {
+		v.Actions = VaxerManDown | VaxerManRun
+		c.Velocity.Y += vaxermanSpeed
+	}
+
+	// SPACE - Spacebar
+	if in.Has(InputFire) && c.Weapon.CanFire() {
+		if w.Count(TagBullet) < c.Weapon.MaxShots {
+			fireBullet(w, a, c)
+			c.Weapon.Timer = c.Weapon.Cooldown
+		}
+		v.Actions = v.Actions &^ (VaxerManIdle | VaxerManRun)
+		v.Actions = v.Actions | VaxerManShoot
+	}
+}
+
+// vaxermanClipName returns the animation clip to play for VaxerMan's
+// actions, e.g. "run_left"
+func vaxermanClipName(a VaxerManActions) string {
+	var action, direction string
+	switch a.action() {
+	case VaxerManRun:
+		action = "run"
+	case VaxerManShoot:
+		action = "shoot"
+	default:
+		action = "idle"
+	}
+	switch a.direction() {
+	case VaxerManLeft:
+		direction = "left"
+	case VaxerManUp:
+		direction = "up"
+	case VaxerManDown:
+		direction = "down"
+	default:
+		direction = "right"
+	}
+
+	return action + "_" + direction
+}
+
+// fireBullet spawns a bullet just in front of VaxerMan, travelling the way he
+// is facing
+func fireBullet(w *World, a *Assets, c *Components) {
+	s, bs := c.Animator.Frame().rect, a.Clips("bullet")["fly"].frames[0].rect
+	bx, by := c.Position.X, c.Position.Y
+	if c.AI.Actions.Has(VaxerManLeft) {
+		bx -= float64(s.Dx() / 2)
+		by += float64((s.Dx() / 2) - (bs.Dy() / 2))
+	}
+	if c.AI.Actions.Has(VaxerManRight) {
+		bx += float64(s.Dx())
+		by += float64((s.Dx() / 2) - (bs.Dy() / 2))
+	}
+	if c.AI.Actions.Has(VaxerManUp) {
+		by -= float64(s.Dy() / 2)
+		bx += float64((s.Dy() / 2) - (bs.Dx() / 2))
+	}
+	if c.AI.Actions.Has(VaxerManDown) {
+		by += float64(s.Dy())
+		bx += float64((s.Dy() / 2) - (bs.Dx() / 2))
+	}
+
+	SpawnBullet(w, a, bx, by, vaxermanDirToBulletDir(c.AI.Actions))
+}
+
+func (a VaxerManActions) direction() VaxerManActions {
+	switch {
+	case a.Has(VaxerManLeft):
+		return VaxerManLeft
+	case a.Has(VaxerManRight):
+		return VaxerManRight
+	case a.Has(VaxerManUp):
+		return VaxerManUp
+	case a.Has(VaxerManDown):
+		return VaxerManDown
+	default:
+		return VaxerManRight
+	}
+}
+
+func (a VaxerManActions) action() VaxerManActions {
+	switch {
+	case a.Has(VaxerManIdle):
+		return VaxerManIdle
+	case a.Has(VaxerManRun):
+		return VaxerManRun
+	case a.Has(VaxerManShoot):
+		return VaxerManShoot
+	default:
+		return VaxerManIdle
+	}
+}
+
+func vaxermanDirToBulletDir(a VaxerManActions) BulletActions {
+	var direction BulletActions
+	switch {
+	case a.Has(VaxerManLeft):
+		direction = BulletLeft
+	case a.Has(VaxerManRight):
+		direction = BulletRight
+	case a.Has(VaxerManUp):
+		direction = BulletUp
+	case a.Has(VaxerManDown):
+		direction = BulletDown
+	}
+
+	return direction
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/audio"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+const (
+	sampleRate = 44100
+
+	// maxVoices is how many instances of one sound effect can play at once.
+	// Playing another stops the oldest.
+	maxVoices = 4
+
+	// musicFade is how long music takes to crossfade in seconds
+	musicFade = 1.5
+
+	// volumeStep is how much a volume key changes a volume by
+	volumeStep = 0.1
+)
+
+// SoundCategory groups sounds that share a volume setting
+type SoundCategory uint8
+
+const (
+	SoundSFX SoundCategory = iota
+	SoundMusic
+)
+
+// musicTrack is a piece of music that is playing or fading out
+type musicTrack struct {
+	id     string
+	player *audio.Player
+	level  float64 // fade level, from 0 to 1
+	target float64 // level being faded towards
+}
+
+// voice is one playing instance of a sound effect. Positional voices are
+// panned and attenuated by where they are relative to the listener.
+type voice struct {
+	player     *audio.Player
+	pan        *pannedStream
+	positional bool
+	x, y       float64
+}
+
+// emitter is the sound of an entity, which follows it as it moves
+type emitter struct {
+	voice *voice // nil if the sound failed to play
+	loop  bool
+}
+
+// SoundManager plays sound effects and music at the volumes in the player's
+// settings
+type SoundManager struct {
+	context  *audio.Context
+	settings *Settings
+
+	voices   map[string][]*voice // sound effect instances, oldest first
+	emitters map[sim.Entity]*emitter
+
+	// Position of the listener, which positional sounds are heard from
+	listenerX, listenerY float64
+
+	music  *musicTrack   // the track playing, or fading in
+	fading []*musicTrack // tracks fading out
+	failed string        // id of the music that last failed to play
+	last   time.Time
+}
+
+// NewSoundManager returns a sound manager using the volumes in settings
+func NewSoundManager(context *audio.Context, settings *Settings) *SoundManager {
+	return &SoundManager{
+		context:  context,
+		settings: settings,
+		voices:   make(map[string][]*voice),
+		emitters: make(map[sim.Entity]*emitter),
+	}
+}
+
+// Volume returns the volume sounds in the category are played at, taking the
+// master volume and mute into account
+func (s *SoundManager) Volume(c SoundCategory) float64 {
+	if s.settings.Muted {
+		return 0
+	}
+
+	v := s.settings.SFXVolume
+	if c == SoundMusic {
+		v = s.settings.MusicVolume
+	}
+
+	return clamp01(s.settings.MasterVolume) * clamp01(v)
+}
+
+// PlaySFX plays the sound effect with the given asset id, centred.
+// Instances already playing carry on, up to maxVoices of them.
+func (s *SoundManager) PlaySFX(id string) {
+	s.play(id, false, 0, 0)
+}
+
+// PlaySFXAt plays the sound effect with the given asset id as if it came
+// from x, y
+func (s *SoundManager) PlaySFXAt(id string, x, y float64) {
+	s.play(id, true, x, y)
+}
+
+func (s *SoundManager) play(id string, positional bool, x, y float64) *voice {
+	// Drop instances that have finished, and the oldest if there are too many
+	var voices []*voice
+	for _, v := range s.voices[id] {
+		if v.player.IsPlaying() {
+			voices = append(voices, v)
+		} else {
+			v.player.Close()
+		}
+	}
+	if len(voices) >= maxVoices {
+		voices[0].player.Close()
+		voices = voices[1:]
+	}
+	s.voices[id] = voices
+
+	v, err := s.newVoice(id, false)
+	if v == nil {
+		if err != nil {
+			log.Printf("error playing %s: %v", id, err)
+		}
+		return nil
+	}
+	v.positional, v.x, v.y = positional, x, y
+	s.updateGains(v)
+	v.player.SetVolume(s.Volume(SoundSFX))
+	v.player.Play()
+
+	s.voices[id] = append(voices, v)
+	return v
+}
+
+// SetListener moves the listener that positional sounds are heard from
+func (s *SoundManager) SetListener(x, y float64) {
+	s.listenerX, s.listenerY = x, y
+}
+
+// Emit plays the sound of an entity at x, y, or moves it there if it is
+// already playing. Looping sounds play until StopEmitters is called without
+// the entity; other sounds play once.
+func (s *SoundManager) Emit(e sim.Entity, id string, loop bool, x, y float64) {
+	if em, ok := s.emitters[e]; ok {
+		if em.voice != nil {
+			em.voice.x, em.voice.y = x, y
+		}
+		return
+	}
+
+	em := &emitter{loop: loop}
+	s.emitters[e] = em
+	if !loop {
+		em.voice = s.play(id, true, x, y)
+		return
+	}
+
+	// Looping sounds aren't voices of their sound effect, so they don't
+	// count towards maxVoices
+	v, err := s.newVoice(id, true)
+	if v == nil {
+		if err != nil {
+			log.Printf("error playing %s: %v", id, err)
+		}
+		return
+	}
+	v.positional, v.x, v.y = true, x, y
+	s.updateGains(v)
+	v.player.SetVolume(s.Volume(SoundSFX))
+	v.player.Play()
+	em.voice = v
+}
+
+// StopEmitters stops the looping sounds of every entity not in live. Sounds
+// that play once are left to finish.
+func (s *SoundManager) StopEmitters(live map[sim.Entity]bool) {
+	for e, em := range s.emitters {
+		if live[e] {
+			continue
+		}
+		if em.loop && em.voice != nil {
+			em.voice.player.Close()
+		}
+		delete(s.emitters, e)
+	}
+}
+
+// updateGains pans and attenuates a positional voice by where it is relative
+// to the listener
+func (s *SoundManager) updateGains(v *voice) {
+	if v.positional {
+		v.pan.SetGains(spatialGains(v.x-s.listenerX, v.y-s.listenerY))
+	}
+}
+
+// PlayMusic crossfades to the looping music with the given asset id. An
+// empty id fades the music out. Asking for the track already playing, or
+// for the track that just failed to play, does nothing.
+func (s *SoundManager) PlayMusic(id string) {
+	if s.music != nil && s.music.id == id || s.music == nil && id == "" {
+		return
+	}
+	if id != "" && id == s.failed {
+		return
+	}
+
+	if s.music != nil {
+		s.music.target = 0
+		s.fading = append(s.fading, s.music)
+		s.music = nil
+	}
+	if id == "" {
+		return
+	}
+
+	v, err := s.newVoice(id, true)
+	if v == nil {
+		if err != nil {
+			log.Printf("error playing %s: %v", id, err)
+		}
+		s.failed = id
+		return
+	}
+
+	s.failed = ""
+	s.music = &musicTrack{id: id, player: v.player, target: 1}
+	v.player.SetVolume(0)
+	v.player.Play()
+}
+
+// newVoice returns a stopped voice of the sound with the given asset id,
+// looping it forever if loop is set
+func (s *SoundManager) newVoice(id string, loop bool) (*voice, error) {
+	stream, err := assets.OpenSound(id)
+	if stream == nil {
+		return nil, err
+	}
+	var src audio.ReadSeekCloser = stream
+	if loop {
+		src = audio.NewInfiniteLoop(stream, stream.Length())
+	}
+
+	pan := newPannedStream(src)
+	p, err := audio.NewPlayer(s.context, pan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &voice{player: p, pan: pan}, nil
+}
+
+// Update moves crossfades on, applies volume changes to music and pans
+// positional sounds to where they now are. It is called once per frame.
+func (s *SoundManager) Update() {
+	now := time.Now()
+	dt := 0.0
+	if !s.last.IsZero() {
+		dt = now.Sub(s.last).Seconds()
+	}
+	s.last = now
+
+	step := dt / musicFade
+	volume := s.Volume(SoundMusic)
+
+	if s.music != nil {
+		s.music.level = math.Min(s.music.level+step, s.music.target)
+		s.music.player.SetVolume(volume * s.music.level)
+	}
+
+	fading := s.fading[:0]
+	for _, t := range s.fading {
+		t.level = math.Max(t.level-step, 0)
+		if t.level == 0 {
+			t.player.Close()
+			continue
+		}
+		t.player.SetVolume(volume * t.level)
+		fading = append(fading, t)
+	}
+	s.fading = fading
+
+	for _, voices := range s.voices {
+		for _, v := range voices {
+			s.updateGains(v)
+		}
+	}
+	for _, em := range s.emitters {
+		if em.voice != nil {
+			s.updateGains(em.voice)
+		}
+	}
+}
+
+// HandleInput applies the volume keys, saving the settings if they changed.
+// It returns a description of the change to show the player, or an empty
+// string if there was none.
+func (s *SoundManager) HandleInput(in VolumeInput) string {
+	st := s.settings
+	var msg string
+	switch {
+	case in.Has(VolumeMute):
+		st.Muted = !st.Muted
+		msg = "Sound on"
+		if st.Muted {
+			msg = "Muted"
+		}
+	case in.Has(VolumeMasterDown | VolumeMasterUp):
+		st.MasterVolume = stepVolume(st.MasterVolume, in.Has(VolumeMasterUp))
+		msg = volumeMessage("Volume", st.MasterVolume)
+	case in.Has(VolumeMusicDown | VolumeMusicUp):
+		st.MusicVolume = stepVolume(st.MusicVolume, in.Has(VolumeMusicUp))
+		msg = volumeMessage("Music", st.MusicVolume)
+	case in.Has(VolumeSFXDown | VolumeSFXUp):
+		st.SFXVolume = stepVolume(st.SFXVolume, in.Has(VolumeSFXUp))
+		msg = volumeMessage("Effects", st.SFXVolume)
+	default:
+		return ""
+	}
+
+	// Music picks the change up in Update
+	for _, voices := range s.voices {
+		for _, v := range voices {
+			v.player.SetVolume(s.Volume(SoundSFX))
+		}
+	}
+	for _, em := range s.emitters {
+		if em.voice != nil {
+			em.voice.player.SetVolume(s.Volume(SoundSFX))
+		}
+	}
+
+	if err := st.Save(); err != nil {
+		log.Printf("error saving settings: %v", err)
+	}
+
+	return msg
+}
+
+func volumeMessage(name string, v float64) string {
+	return fmt.Sprintf("%s: %d%%", name, int(math.Round(v*100)))
+}
+
+func stepVolume(v float64, up bool) float64 {
+	if up {
+		v += volumeStep
+	} else {
+		v -= volumeStep
+	}
+
+	// Round to whole steps so repeated presses land back on 0 and 1
+	return clamp01(math.Round(v/volumeStep) * volumeStep)
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// renderSystem queues every entity with an animation to be drawn, alpha of
+// the way between its previous and current positions. Flashing entities are
+// drawn white. If visible is set, entities other than VaxerMan are only drawn
+// where it returns true.
+func renderSystem(w *sim.World, q *RenderQueue, alpha float64, visible func(x, y float64) bool) {
+	now := time.Now()
+	w.Each(func(e sim.Entity, c *sim.Components) {
+		if c.Animator == nil || c.Position == nil {
+			return
+		}
+		if visible != nil && c.Tag != sim.TagPlayer && !visible(sim.EntityCentre(c)) {
+			return
+		}
+
+		p := c.Position
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(lerp(p.PrevX, p.X, alpha), lerp(p.PrevY, p.Y, alpha))
+		if c.Flash != nil && now.Before(c.Flash.Until) {
+			// Keep the sprite's alpha but turn every pixel white
+			op.ColorM.Scale(0, 0, 0, 1)
+			op.ColorM.Translate(1, 1, 1, 0)
+		} else if t := c.Tint; t != nil {
+			op.ColorM.Scale(t.R, t.G, t.B, 1)
+		}
+
+		// Extract sprite frame
+		sheet, frame := c.Animator.Sprite()
+		spriteSubImage := assets.Image(sheet).SubImage(frame).(*ebiten.Image)
+
+		// Sort by the bottom of the sprite, where the entity stands
+		depth := lerp(p.PrevY, p.Y, alpha) + float64(frame.Dy())
+		q.AddImage(LayerEntities, depth, spriteSubImage, op)
+	})
+}
+
+// soundSystem plays the sounds of emitting entities from their centres, and
+// moves the listener to the listener entity's centre
+func soundSystem(w *sim.World, s *SoundManager, listener sim.Entity) {
+	if c := w.Get(listener); c != nil && c.Position != nil {
+		s.SetListener(sim.EntityCentre(c))
+	}
+
+	live := make(map[sim.Entity]bool)
+	w.Each(func(e sim.Entity, c *sim.Components) {
+		if c.Emitter == nil || c.Position == nil {
+			return
+		}
+
+		live[e] = true
+		x, y := sim.EntityCentre(c)
+		s.Emit(e, c.Emitter.Sound, c.Emitter.Loop, x, y)
+	})
+	s.StopEmitters(live)
+}
+
+// particleSystem gives off the particles of entities with trails, behind them
+// as they move
+func particleSystem(w *sim.World, s *ParticleSystem) {
+	live := make(map[sim.Entity]bool)
+	w.Each(func(e sim.Entity, c *sim.Components) {
+		if c.Trail == nil || c.Position == nil {
+			return
+		}
+
+		live[e] = true
+		angle := 0.0
+		if v := c.Velocity; v != nil {
+			angle = math.Atan2(-v.Y, -v.X)
+		}
+		x, y := sim.EntityCentre(c)
+		s.Trail(e, c.Trail.Effect, x, y, angle)
+	})
+	s.StopTrails(live)
+}
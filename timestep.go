@@ -0,0 +1,66 @@
+package main
+
+import (
+	"time"
+
+	"github.com/paulcockrell/gametest/sim"
+)
+
+// Simulation timing constants, the simulation's. It always advances in steps
+// of simDt seconds regardless of how often ebiten calls Update, so movement
+// and animation behave the same at any display refresh rate.
+const (
+	simRate = sim.Rate
+	simDt   = sim.Dt
+
+	// maxFrameTime caps how much time a single Update may simulate, so a
+	// stalled tab or window drag doesn't cause a burst of catch-up steps
+	maxFrameTime = 0.25
+)
+
+// Clock accumulates real time and hands it out in fixed simDt steps
+type Clock struct {
+	last        time.Time
+	accumulator float64
+}
+
+// Advance adds the real time elapsed since the previous call to the
+// accumulator
+func (c *Clock) Advance() {
+	now := time.Now()
+	if !c.last.IsZero() {
+		frameTime := now.Sub(c.last).Seconds()
+		if frameTime > maxFrameTime {
+			frameTime = maxFrameTime
+		}
+		c.accumulator += frameTime
+	}
+	c.last = now
+}
+
+// Hold lets real time pass without adding it to the accumulator, pausing the
+// simulation
+func (c *Clock) Hold() {
+	c.last = time.Now()
+}
+
+// Step consumes one fixed step from the accumulator, returning false when
+// there isn't enough time left for a whole step
+func (c *Clock) Step() bool {
+	if c.accumulator < simDt {
+		return false
+	}
+	c.accumulator -= simDt
+	return true
+}
+
+// Alpha returns how far between the previous and the next simulation step
+// the current frame is, used to interpolate rendered positions
+func (c *Clock) Alpha() float64 {
+	return c.accumulator / simDt
+}
+
+// lerp linearly interpolates between a and b
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}